@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// AchievementHandler unlocks achievements as users win, lose and hit the lottery
+type AchievementHandler struct {
+	uowFactory UnitOfWorkFactory
+}
+
+// NewAchievementHandler creates a new achievement handler
+func NewAchievementHandler(uowFactory UnitOfWorkFactory) *AchievementHandler {
+	return &AchievementHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleBalanceChange advances achievement progress from a win, loss or lottery win
+func (h *AchievementHandler) HandleBalanceChange(ctx context.Context, event interface{}) error {
+	e, err := AssertEventType[events.BalanceChangeEvent](event, "BalanceChangeEvent")
+	if err != nil {
+		return err
+	}
+
+	if !e.TransactionType.IsGamblingRelated() && e.TransactionType != entities.TransactionTypeLottoWin {
+		return nil
+	}
+
+	uow := h.uowFactory.CreateForGuild(e.GuildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	achievementService := uow.Services().AchievementService()
+
+	unlocked, err := achievementService.RecordBalanceChange(ctx, e.UserID, e.TransactionType)
+	if err != nil {
+		return fmt.Errorf("failed to record achievement progress for user %d: %w", e.UserID, err)
+	}
+
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit achievement progress: %w", err)
+	}
+
+	for _, achievement := range unlocked {
+		log.WithFields(log.Fields{
+			"guildID":     e.GuildID,
+			"discordID":   e.UserID,
+			"achievement": achievement.Key,
+			"source":      e.TransactionType,
+		}).Info("Achievement unlocked")
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// BalanceFreezeExpiryInterval is how often the expiry worker looks for freezes that have run out
+const BalanceFreezeExpiryInterval = 5 * time.Minute
+
+// BalanceFreezeExpiryWorker lifts timed balance freezes once they run out, recording them in the freeze log
+// as expired. Freezes stop blocking at their expiry regardless; the worker closes them out.
+type BalanceFreezeExpiryWorker struct {
+	uowFactory UnitOfWorkFactory
+}
+
+// NewBalanceFreezeExpiryWorker creates a new balance freeze expiry worker
+func NewBalanceFreezeExpiryWorker(uowFactory UnitOfWorkFactory) *BalanceFreezeExpiryWorker {
+	return &BalanceFreezeExpiryWorker{
+		uowFactory: uowFactory,
+	}
+}
+
+// Job returns the scheduler job that expires freezes every interval.
+// It runs on start to close out freezes that expired while the bot was offline.
+func (w *BalanceFreezeExpiryWorker) Job(interval time.Duration) Job {
+	return Job{
+		Name:       "balance-freeze-expiry",
+		Interval:   interval,
+		Jitter:     30 * time.Second,
+		RunOnStart: true,
+		Run:        w.processExpiredFreezes,
+	}
+}
+
+// processExpiredFreezes lifts every freeze that has expired
+func (w *BalanceFreezeExpiryWorker) processExpiredFreezes(ctx context.Context) error {
+	// Cross-guild query to find expired freezes
+	uow := w.uowFactory.CreateForGuild(0)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	freezes, err := uow.BalanceFreezeRepository().GetExpiredFreezes(ctx, time.Now().UTC())
+	uow.Rollback()
+	if err != nil {
+		return fmt.Errorf("failed to get expired balance freezes: %w", err)
+	}
+
+	if len(freezes) == 0 {
+		return nil
+	}
+
+	var successCount, failureCount int
+	for _, freeze := range freezes {
+		if err := w.expireFreeze(ctx, freeze.GuildID, freeze.ID); err != nil {
+			log.Errorf("Error expiring balance freeze %d for guild %d: %v", freeze.ID, freeze.GuildID, err)
+			failureCount++
+		} else {
+			successCount++
+		}
+	}
+
+	log.Infof("Balance freeze expiry complete: %d unfrozen, %d failed", successCount, failureCount)
+	return nil
+}
+
+// expireFreeze lifts a single freeze in its own guild-scoped transaction
+func (w *BalanceFreezeExpiryWorker) expireFreeze(ctx context.Context, guildID, freezeID int64) error {
+	uow := w.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	if _, err := uow.Services().BalanceFreezeService().ExpireFreeze(ctx, freezeID); err != nil {
+		return err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// BalanceHistoryRollupInterval is how often the rollup worker checks for settled days to roll up
+const BalanceHistoryRollupInterval = time.Hour
+
+// balanceHistoryArchiveBatchSize is how many raw entries are archived per transaction
+const balanceHistoryArchiveBatchSize = 5000
+
+// BalanceHistoryRollupWorker rolls settled days of balance history up into daily per-user aggregates,
+// then archives raw entries older than the retention period
+type BalanceHistoryRollupWorker struct {
+	uowFactory      UnitOfWorkFactory
+	retentionMonths int
+}
+
+// NewBalanceHistoryRollupWorker creates a new balance history rollup worker. A retention of 0 months keeps raw entries forever.
+func NewBalanceHistoryRollupWorker(uowFactory UnitOfWorkFactory, retentionMonths int) *BalanceHistoryRollupWorker {
+	return &BalanceHistoryRollupWorker{
+		uowFactory:      uowFactory,
+		retentionMonths: retentionMonths,
+	}
+}
+
+// Job returns the scheduler job that rolls up and archives balance history every interval.
+// It runs on start to catch up on days that settled while the bot was offline.
+func (w *BalanceHistoryRollupWorker) Job(interval time.Duration) Job {
+	return Job{
+		Name:       "balance-history-rollup",
+		Interval:   interval,
+		Jitter:     5 * time.Minute,
+		RunOnStart: true,
+		Run:        w.rollUpAndArchive,
+	}
+}
+
+// rollUpAndArchive rolls up every settled day, then archives expired raw entries
+func (w *BalanceHistoryRollupWorker) rollUpAndArchive(ctx context.Context) error {
+	now := time.Now().UTC()
+
+	var totalDays int
+	for {
+		days, err := w.rollUpBatch(ctx, now)
+		if err != nil {
+			return err
+		}
+		if days == 0 {
+			break
+		}
+		totalDays += days
+	}
+	if totalDays > 0 {
+		log.Infof("Balance history rollup complete: %d days rolled up", totalDays)
+	}
+
+	if w.retentionMonths <= 0 {
+		return nil
+	}
+
+	var totalArchived int64
+	for {
+		archived, err := w.archiveBatch(ctx, now)
+		if err != nil {
+			return err
+		}
+		totalArchived += archived
+		if archived < balanceHistoryArchiveBatchSize {
+			break
+		}
+	}
+	if totalArchived > 0 {
+		log.Infof("Balance history archive complete: %d entries older than %d months archived", totalArchived, w.retentionMonths)
+	}
+
+	return nil
+}
+
+// rollUpBatch rolls up the next batch of days in its own cross-guild transaction
+func (w *BalanceHistoryRollupWorker) rollUpBatch(ctx context.Context, now time.Time) (int, error) {
+	uow := w.uowFactory.CreateForGuild(0) // 0 guildID for cross-guild rollups
+	if err := uow.Begin(ctx); err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	days, err := uow.Services().BalanceHistoryRollupService().RollUp(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return days, nil
+}
+
+// archiveBatch archives one batch of expired raw entries in its own cross-guild transaction
+func (w *BalanceHistoryRollupWorker) archiveBatch(ctx context.Context, now time.Time) (int64, error) {
+	uow := w.uowFactory.CreateForGuild(0) // 0 guildID for cross-guild archiving
+	if err := uow.Begin(ctx); err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	archived, err := uow.Services().BalanceHistoryRollupService().ArchiveExpired(ctx, now, w.retentionMonths, balanceHistoryArchiveBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return archived, nil
+}
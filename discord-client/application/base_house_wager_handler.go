@@ -2,13 +2,13 @@ package application
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"gambler/discord-client/application/dto"
 	"gambler/discord-client/domain/entities"
 	"gambler/discord-client/domain/interfaces"
-	"gambler/discord-client/domain/services"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -17,6 +17,7 @@ import (
 type BaseHouseWagerHandler struct {
 	uowFactory    UnitOfWorkFactory
 	discordPoster DiscordPoster
+	outbox        *DiscordOutboxDispatcher
 }
 
 // NewBaseHouseWagerHandler creates a new base house wager handler
@@ -27,6 +28,7 @@ func NewBaseHouseWagerHandler(
 	return &BaseHouseWagerHandler{
 		uowFactory:    uowFactory,
 		discordPoster: discordPoster,
+		outbox:        NewDiscordOutboxDispatcher(uowFactory, discordPoster),
 	}
 }
 
@@ -41,7 +43,13 @@ type WagerCreationConfig struct {
 	OddsMultipliers     []float64
 	VotingPeriodMinutes int
 	ChannelIDGetter     func(*entities.GuildSettings) *int64
-	ChannelName         string // For error messages (e.g., "lol-channel", "tft-channel")
+	ChannelName         string                                                   // For error messages (e.g., "lol-channel", "tft-channel")
+	OptionCapGetter     func(*entities.GuildSettings) *int64                     // Optional - max total bet applied to every option
+	OptionsGetter       func(*entities.GuildSettings) ([]string, []float64)      // Optional - per guild options and odds, overriding Options and OddsMultipliers
+	ThumbnailURL        string                                                   // Optional - small image shown beside the embed, e.g. the queue icon
+	OddsSeeder          func(context.Context, ServiceFactory) ([]float64, error) // Optional - per guild odds from past results, overriding OddsMultipliers
+	GuildFilter         func(*entities.GuildSettings) bool                       // Optional - skips guilds it returns false for, e.g. ones not taking non-ranked games
+	RecordPlayer        bool                                                     // Optional - records the summoner as a player so watched teammates can join the wager
 }
 
 // CreateHouseWagerForGuild creates a house wager for a specific guild using the provided configuration
@@ -69,17 +77,69 @@ func (h *BaseHouseWagerHandler) CreateHouseWagerForGuild(
 		return fmt.Errorf("failed to get guild settings: %w", err)
 	}
 
+	// Skip guilds whose settings exclude this game
+	if config.GuildFilter != nil && !config.GuildFilter(guildSettings) {
+		uow.Rollback()
+		log.WithFields(log.Fields{
+			"guild":  guild.GuildID,
+			"gameId": config.GameID,
+		}).Debug("Guild settings exclude this game, skipping")
+		return nil
+	}
+
+	// Skip guilds that have turned off wagers on this game
+	if feature, ok := entities.FeatureForExternalSystem(config.ExternalSystem); ok {
+		enabled, err := uow.Services().FeatureFlagService().IsEnabled(ctx, feature)
+		if err != nil {
+			uow.Rollback()
+			return fmt.Errorf("failed to check feature flag: %w", err)
+		}
+		if !enabled {
+			uow.Rollback()
+			log.WithFields(log.Fields{
+				"guild":   guild.GuildID,
+				"feature": feature,
+			}).Debug("House wagers disabled for guild, skipping")
+			return nil
+		}
+	}
+
 	// Create group wager service
-	groupWagerService := services.NewGroupWagerService(
-		uow.GroupWagerRepository(),
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	groupWagerService := uow.Services().GroupWagerService()
+
+	// Let the guild's settings pick the options, e.g. its TFT placement layout
+	if config.OptionsGetter != nil {
+		config.Options, config.OddsMultipliers = config.OptionsGetter(guildSettings)
+	}
+
+	// Price the wager from the guild's past results, keeping the static odds if that fails
+	if config.OddsSeeder != nil {
+		seededOdds, err := config.OddsSeeder(ctx, uow.Services())
+		if err != nil {
+			log.WithFields(log.Fields{
+				"guild": guild.GuildID,
+				"error": err,
+			}).Warn("Failed to seed house wager odds, using static odds")
+		} else {
+			config.OddsMultipliers = seededOdds
+		}
+	}
+
+	// Cap every option at the guild's configured limit so the house can't be overexposed on one outcome
+	var maxTotalAmounts []int64
+	if config.OptionCapGetter != nil {
+		if optionCap := config.OptionCapGetter(guildSettings); optionCap != nil {
+			maxTotalAmounts = make([]int64, len(config.Options))
+			for i := range maxTotalAmounts {
+				maxTotalAmounts[i] = *optionCap
+			}
+		}
+	}
 
 	// Use nil for system-created house wagers (no specific creator)
 	wagerDetail, err := groupWagerService.CreateGroupWager(
 		ctx,
+		guild.GuildID,
 		nil,
 		config.Condition,
 		config.Options,
@@ -88,6 +148,7 @@ func (h *BaseHouseWagerHandler) CreateHouseWagerForGuild(
 		0, // Channel ID will be set after posting
 		entities.GroupWagerTypeHouse,
 		config.OddsMultipliers,
+		maxTotalAmounts,
 	)
 	if err != nil {
 		uow.Rollback()
@@ -96,6 +157,19 @@ func (h *BaseHouseWagerHandler) CreateHouseWagerForGuild(
 
 	// Set the external reference for this game
 	wagerDetail.Wager.SetExternalReference(config.ExternalSystem, config.GameID)
+	if config.ThumbnailURL != "" {
+		wagerDetail.Wager.ThumbnailURL = &config.ThumbnailURL
+	}
+
+	// Tag the player if they have linked their Riot account, which also keeps them off their own game
+	link, err := uow.RiotAccountLinkRepository().GetByRiotID(ctx, config.SummonerName, config.TagLine)
+	if err != nil {
+		uow.Rollback()
+		return fmt.Errorf("failed to get riot account link: %w", err)
+	}
+	if link != nil {
+		wagerDetail.Wager.SubjectDiscordID = &link.DiscordID
+	}
 
 	log.WithFields(log.Fields{
 		"guild":          guild.GuildID,
@@ -104,12 +178,19 @@ func (h *BaseHouseWagerHandler) CreateHouseWagerForGuild(
 		"externalSystem": config.ExternalSystem,
 	}).Debug("Setting external reference for house wager")
 
-	// Update the wager with the external reference
+	// Update the wager with the external reference and player
 	if err := uow.GroupWagerRepository().Update(ctx, wagerDetail.Wager); err != nil {
 		uow.Rollback()
 		return fmt.Errorf("failed to update wager with external reference: %w", err)
 	}
 
+	if config.RecordPlayer {
+		if _, err := uow.GroupWagerRepository().AddHousePlayer(ctx, wagerDetail.Wager.ID, config.SummonerName, config.TagLine); err != nil {
+			uow.Rollback()
+			return fmt.Errorf("failed to record house wager player: %w", err)
+		}
+	}
+
 	// Build DTO for Discord posting
 	channelID := int64(0)
 	channelIDPtr := config.ChannelIDGetter(guildSettings)
@@ -127,28 +208,16 @@ func (h *BaseHouseWagerHandler) CreateHouseWagerForGuild(
 	// Ensure guild ID is set correctly (in case it's not set in the wager)
 	postDTO.GuildID = guild.GuildID
 
-	// Post to Discord
-	postResult, err := h.discordPoster.PostHouseWager(ctx, postDTO)
+	// Queue the post with the wager so it only goes out if the wager is committed.
+	// The dispatcher records the posted message and thread on the wager.
+	post, err := h.houseWagerOutboxMessage(entities.DiscordOutboxActionPost, postDTO, wagerDetail.Wager.ID)
 	if err != nil {
-		log.WithFields(log.Fields{
-			"guild":   guild.GuildID,
-			"wagerID": wagerDetail.Wager.ID,
-			"error":   err,
-		}).Error("Failed to post house wager to Discord")
-		// Don't return error - wager is created, just not posted
-	} else {
-		// Update the wager with messageID and channelID
-		wagerDetail.Wager.MessageID = postResult.MessageID
-		wagerDetail.Wager.ChannelID = postResult.ChannelID
-		if err := uow.GroupWagerRepository().Update(ctx, wagerDetail.Wager); err != nil {
-			log.WithFields(log.Fields{
-				"guild":     guild.GuildID,
-				"wagerID":   wagerDetail.Wager.ID,
-				"messageID": postResult.MessageID,
-				"channelID": postResult.ChannelID,
-				"error":     err,
-			}).Error("Failed to update wager with message info")
-		}
+		uow.Rollback()
+		return err
+	}
+	if err := uow.Services().DiscordOutboxService().Enqueue(ctx, post); err != nil {
+		uow.Rollback()
+		return fmt.Errorf("failed to queue house wager post: %w", err)
 	}
 
 	// Commit the transaction
@@ -156,6 +225,8 @@ func (h *BaseHouseWagerHandler) CreateHouseWagerForGuild(
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	h.outbox.Dispatch(ctx, guild.GuildID, post.ID)
+
 	log.WithFields(log.Fields{
 		"guild":    guild.GuildID,
 		"wagerID":  wagerDetail.Wager.ID,
@@ -165,12 +236,87 @@ func (h *BaseHouseWagerHandler) CreateHouseWagerForGuild(
 	return nil
 }
 
+// JoinHouseWager adds a summoner to the guild's wager on a game another watched summoner is already in,
+// rewriting its condition to list every player. Returns false when the guild has no wager on the game.
+func (h *BaseHouseWagerHandler) JoinHouseWager(
+	ctx context.Context,
+	guildID int64,
+	externalRef entities.ExternalReference,
+	summonerName, tagLine string,
+	condition func(players []*entities.HouseWagerPlayer) string,
+) (bool, error) {
+	uow := h.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	wager, err := uow.GroupWagerRepository().GetByExternalReference(ctx, externalRef)
+	if err != nil {
+		return false, fmt.Errorf("failed to get wager by external reference: %w", err)
+	}
+	if wager == nil {
+		return false, nil
+	}
+	// A settled wager can't take new players, and the game can't have a second wager
+	if wager.IsResolved() || wager.IsCancelled() {
+		return true, nil
+	}
+
+	added, err := uow.GroupWagerRepository().AddHousePlayer(ctx, wager.ID, summonerName, tagLine)
+	if err != nil {
+		return false, fmt.Errorf("failed to add house wager player: %w", err)
+	}
+	if !added {
+		return true, nil
+	}
+
+	players, err := uow.GroupWagerRepository().GetHousePlayers(ctx, wager.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get house wager players: %w", err)
+	}
+	wager.Condition = condition(players)
+	if err := uow.GroupWagerRepository().Update(ctx, wager); err != nil {
+		return false, fmt.Errorf("failed to update wager condition: %w", err)
+	}
+
+	wagerDetail, err := uow.GroupWagerRepository().GetDetailByID(ctx, wager.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get wager detail: %w", err)
+	}
+	edit, err := h.houseWagerOutboxMessage(entities.DiscordOutboxActionEdit, h.BuildHouseWagerDTO(wagerDetail), wager.ID)
+	if err != nil {
+		return false, err
+	}
+	if err := uow.Services().DiscordOutboxService().Enqueue(ctx, edit); err != nil {
+		return false, fmt.Errorf("failed to queue house wager update: %w", err)
+	}
+
+	if err := uow.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	h.outbox.Dispatch(ctx, guildID, edit.ID)
+
+	log.WithFields(log.Fields{
+		"guild":    guildID,
+		"wagerID":  wager.ID,
+		"summoner": fmt.Sprintf("%s#%s", summonerName, tagLine),
+		"players":  len(players),
+	}).Info("Added summoner to house wager for shared game")
+
+	return true, nil
+}
+
 // WagerResolutionConfig holds configuration for resolving a house wager
 type WagerResolutionConfig struct {
 	ExternalSystem        entities.ExternalSystem
 	WinnerSelector        func([]entities.GroupWagerOption, interface{}) int64
 	GameResult            interface{}
 	CancellationThreshold *int32 // nil means no cancellation logic
+	CancelIfUndetermined  bool   // Refund instead of failing when the result has no winner, e.g. a prop whose data never arrived
+	ImageURL              string // Optional - large image shown under the settled embed, e.g. the champion played
+	CancelReason          string // Optional - refunds the wager for this reason instead of settling it, e.g. players on opposing teams
 }
 
 // ResolveHouseWager resolves a specific house wager using the provided configuration
@@ -204,18 +350,18 @@ func (h *BaseHouseWagerHandler) ResolveHouseWager(
 	}
 
 	// Create group wager service once
-	groupWagerService := services.NewGroupWagerService(
-		uow.GroupWagerRepository(),
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	groupWagerService := uow.Services().GroupWagerService()
+
+	if config.CancelReason != "" {
+		return h.cancelWager(ctx, uow, groupWagerService, wagerDetail, guildID, wagerID, config.CancelReason)
+	}
 
 	// Check for cancellation conditions if threshold is provided
 	if config.CancellationThreshold != nil {
 		if durationResult, ok := config.GameResult.(dto.GameEndedDTO); ok {
 			if durationResult.DurationSeconds < *config.CancellationThreshold {
-				return h.cancelWager(ctx, uow, groupWagerService, wagerDetail, guildID, wagerID, durationResult.DurationSeconds)
+				reason := fmt.Sprintf("game ended after %d seconds (forfeit/remake)", durationResult.DurationSeconds)
+				return h.cancelWager(ctx, uow, groupWagerService, wagerDetail, guildID, wagerID, reason)
 			}
 		}
 	}
@@ -231,10 +377,22 @@ func (h *BaseHouseWagerHandler) ResolveHouseWager(
 	winningOptionID := config.WinnerSelector(options, config.GameResult)
 
 	if winningOptionID == 0 {
+		if config.CancelIfUndetermined {
+			return h.cancelWager(ctx, uow, groupWagerService, wagerDetail, guildID, wagerID, "game result did not include the data to settle this wager")
+		}
 		uow.Rollback()
 		return fmt.Errorf("could not determine winning option")
 	}
 
+	// Show what the game was played with on the settled embed
+	if config.ImageURL != "" {
+		wagerDetail.Wager.ImageURL = &config.ImageURL
+		if err := uow.GroupWagerRepository().Update(ctx, wagerDetail.Wager); err != nil {
+			uow.Rollback()
+			return fmt.Errorf("failed to set wager image: %w", err)
+		}
+	}
+
 	log.WithFields(log.Fields{
 		"guild":           guildID,
 		"wagerID":         wagerID,
@@ -271,23 +429,24 @@ func (h *BaseHouseWagerHandler) ResolveHouseWager(
 	return nil
 }
 
-// cancelWager handles the cancellation logic for short games
+// cancelWager cancels a house wager that cannot be settled, such as a forfeited game, and refunds participants
 func (h *BaseHouseWagerHandler) cancelWager(
 	ctx context.Context,
 	uow UnitOfWork,
 	groupWagerService interfaces.GroupWagerService,
 	wagerDetail *entities.GroupWagerDetail,
 	guildID, wagerID int64,
-	durationSeconds int32,
+	reason string,
 ) error {
 	log.WithFields(log.Fields{
-		"guild":           guildID,
-		"wagerID":         wagerID,
-		"durationSeconds": durationSeconds,
-	}).Info("Game ended with short duration (forfeit/remake), cancelling wager and refunding participants")
+		"guild":   guildID,
+		"wagerID": wagerID,
+		"reason":  reason,
+	}).Info("Cancelling house wager and refunding participants")
 
-	// Cancel the wager (nil indicates system cancellation)
-	if err := groupWagerService.CancelGroupWager(ctx, wagerID, nil); err != nil {
+	// Cancel the wager and return every bet (nil indicates system cancellation)
+	refunds, err := groupWagerService.RefundAllParticipants(ctx, wagerID, nil)
+	if err != nil {
 		log.WithFields(log.Fields{
 			"guild":   guildID,
 			"wagerID": wagerID,
@@ -297,9 +456,18 @@ func (h *BaseHouseWagerHandler) cancelWager(
 		return fmt.Errorf("failed to cancel group wager: %w", err)
 	}
 
-	// Store message info before committing
-	messageID := wagerDetail.Wager.MessageID
-	channelID := wagerDetail.Wager.ChannelID
+	// Queue the cancelled embed with the refunds. Its message is looked up when it is sent,
+	// so a wager cancelled before its post went out is still updated once it does.
+	wagerDetail.Wager.State = entities.GroupWagerStateCancelled
+	edit, err := h.houseWagerOutboxMessage(entities.DiscordOutboxActionEdit, h.BuildHouseWagerDTO(wagerDetail), wagerID)
+	if err != nil {
+		uow.Rollback()
+		return err
+	}
+	if err := uow.Services().DiscordOutboxService().Enqueue(ctx, edit); err != nil {
+		uow.Rollback()
+		return fmt.Errorf("failed to queue house wager update: %w", err)
+	}
 
 	// Commit the transaction
 	if err := uow.Commit(); err != nil {
@@ -307,37 +475,35 @@ func (h *BaseHouseWagerHandler) cancelWager(
 	}
 
 	log.WithFields(log.Fields{
-		"guild":   guildID,
-		"wagerID": wagerID,
+		"guild":         guildID,
+		"wagerID":       wagerID,
+		"refunded":      refunds.ParticipantCount(),
+		"totalRefunded": refunds.TotalRefunded,
 	}).Info("Successfully cancelled house wager and refunded participants")
 
-	// Update the Discord message to show cancelled state
-	if messageID != 0 && channelID != 0 {
-		// Update the state to cancelled since we know it was just cancelled
-		wagerDetail.Wager.State = entities.GroupWagerStateCancelled
+	h.outbox.Dispatch(ctx, guildID, edit.ID)
 
-		// Build DTO for Discord update using the helper function
-		updateDTO := h.BuildHouseWagerDTO(wagerDetail)
+	return nil
+}
 
-		// Update the Discord message
-		if err := h.discordPoster.UpdateHouseWager(ctx, messageID, channelID, updateDTO); err != nil {
-			log.WithFields(log.Fields{
-				"guild":     guildID,
-				"wagerID":   wagerID,
-				"messageID": messageID,
-				"channelID": channelID,
-				"error":     err,
-			}).Error("Failed to update Discord message for cancelled house wager")
-		} else {
-			log.WithFields(log.Fields{
-				"guild":     guildID,
-				"wagerID":   wagerID,
-				"messageID": messageID,
-			}).Info("Successfully updated Discord message for cancelled house wager")
-		}
+// houseWagerOutboxMessage builds the outbox message posting or editing a house wager's message.
+// Edits share a dedup key so only the latest queued embed is sent.
+func (h *BaseHouseWagerHandler) houseWagerOutboxMessage(action entities.DiscordOutboxAction, postDTO dto.HouseWagerPostDTO, wagerID int64) (*entities.DiscordOutboxMessage, error) {
+	payload, err := json.Marshal(postDTO)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode house wager message: %w", err)
 	}
 
-	return nil
+	dedupKey := fmt.Sprintf("house_wager:%d:%s", wagerID, action)
+	return &entities.DiscordOutboxMessage{
+		GuildID:      postDTO.GuildID,
+		Action:       action,
+		Kind:         entities.DiscordOutboxKindHouseWager,
+		ChannelID:    postDTO.ChannelID,
+		GroupWagerID: &wagerID,
+		Payload:      payload,
+		DedupKey:     &dedupKey,
+	}, nil
 }
 
 // BuildHouseWagerDTO builds a HouseWagerPostDTO from a GroupWagerDetail
@@ -352,16 +518,23 @@ func (h *BaseHouseWagerHandler) BuildHouseWagerDTO(detail *entities.GroupWagerDe
 
 	// Build DTO
 	result := dto.HouseWagerPostDTO{
-		GuildID:      detail.Wager.GuildID,
-		ChannelID:    detail.Wager.ChannelID,
-		WagerID:      detail.Wager.ID,
-		Title:        title,
-		Description:  description,
-		State:        string(detail.Wager.State),
-		Options:      make([]dto.WagerOptionDTO, len(detail.Options)),
-		VotingEndsAt: detail.Wager.VotingEndsAt,
-		Participants: make([]dto.ParticipantDTO, len(detail.Participants)),
-		TotalPot:     detail.Wager.TotalPot,
+		GuildID:         detail.Wager.GuildID,
+		ChannelID:       detail.Wager.ChannelID,
+		WagerID:         detail.Wager.ID,
+		Title:           title,
+		Description:     description,
+		State:           string(detail.Wager.State),
+		Options:         make([]dto.WagerOptionDTO, len(detail.Options)),
+		VotingEndsAt:    detail.Wager.VotingEndsAt,
+		Participants:    make([]dto.ParticipantDTO, len(detail.Participants)),
+		PlayerDiscordID: detail.Wager.SubjectDiscordID,
+		TotalPot:        detail.Wager.TotalPot,
+	}
+	if detail.Wager.ThumbnailURL != nil {
+		result.ThumbnailURL = *detail.Wager.ThumbnailURL
+	}
+	if detail.Wager.ImageURL != nil {
+		result.ImageURL = *detail.Wager.ImageURL
 	}
 
 	// Convert options
@@ -373,14 +546,30 @@ func (h *BaseHouseWagerHandler) BuildHouseWagerDTO(detail *entities.GroupWagerDe
 			Multiplier:  opt.OddsMultiplier,
 			TotalAmount: opt.TotalAmount,
 		}
+		if opt.HasCap() {
+			result.Options[i].MaxTotalAmount = opt.MaxTotalAmount
+		}
+		if opt.ButtonEmoji != nil {
+			result.Options[i].ButtonEmoji = *opt.ButtonEmoji
+		}
+		if opt.ButtonStyle != nil {
+			result.Options[i].ButtonStyle = string(*opt.ButtonStyle)
+		}
+	}
+
+	// Map options by ID for payout multiplier lookup
+	optionsByID := make(map[int64]*entities.GroupWagerOption, len(detail.Options))
+	for _, opt := range detail.Options {
+		optionsByID[opt.ID] = opt
 	}
 
 	// Convert participants
 	for i, participant := range detail.Participants {
 		result.Participants[i] = dto.ParticipantDTO{
-			DiscordID: participant.DiscordID,
-			OptionID:  participant.OptionID,
-			Amount:    participant.Amount,
+			DiscordID:  participant.DiscordID,
+			OptionID:   participant.OptionID,
+			Amount:     participant.Amount,
+			Multiplier: participant.GetPayoutMultiplier(optionsByID[participant.OptionID]),
 		}
 	}
 
@@ -89,14 +89,16 @@ func TestBaseHouseWagerHandler_BuildHouseWagerDTO(t *testing.T) {
 				},
 				Participants: []dto.ParticipantDTO{
 					{
-						DiscordID: 111111111111111111,
-						OptionID:  1,
-						Amount:    100,
+						DiscordID:  111111111111111111,
+						OptionID:   1,
+						Amount:     100,
+						Multiplier: 2.0,
 					},
 					{
-						DiscordID: 222222222222222222,
-						OptionID:  2,
-						Amount:    150,
+						DiscordID:  222222222222222222,
+						OptionID:   2,
+						Amount:     150,
+						Multiplier: 2.0,
 					},
 				},
 			},
@@ -174,19 +176,22 @@ func TestBaseHouseWagerHandler_BuildHouseWagerDTO(t *testing.T) {
 				},
 				Participants: []dto.ParticipantDTO{
 					{
-						DiscordID: 333333333333333333,
-						OptionID:  1,
-						Amount:    200,
+						DiscordID:  333333333333333333,
+						OptionID:   1,
+						Amount:     200,
+						Multiplier: 1.8,
 					},
 					{
-						DiscordID: 444444444444444444,
-						OptionID:  1,
-						Amount:    400,
+						DiscordID:  444444444444444444,
+						OptionID:   1,
+						Amount:     400,
+						Multiplier: 1.8,
 					},
 					{
-						DiscordID: 555555555555555555,
-						OptionID:  2,
-						Amount:    400,
+						DiscordID:  555555555555555555,
+						OptionID:   2,
+						Amount:     400,
+						Multiplier: 2.2,
 					},
 				},
 			},
@@ -322,19 +327,22 @@ func TestBaseHouseWagerHandler_BuildHouseWagerDTO(t *testing.T) {
 				},
 				Participants: []dto.ParticipantDTO{
 					{
-						DiscordID: 777777777777777777,
-						OptionID:  1,
-						Amount:    500,
+						DiscordID:  777777777777777777,
+						OptionID:   1,
+						Amount:     500,
+						Multiplier: 1.9,
 					},
 					{
-						DiscordID: 888888888888888888,
-						OptionID:  1,
-						Amount:    800,
+						DiscordID:  888888888888888888,
+						OptionID:   1,
+						Amount:     800,
+						Multiplier: 1.9,
 					},
 					{
-						DiscordID: 999999999999999999,
-						OptionID:  2,
-						Amount:    1200,
+						DiscordID:  999999999999999999,
+						OptionID:   2,
+						Amount:     1200,
+						Multiplier: 2.1,
 					},
 				},
 			},
@@ -0,0 +1,185 @@
+package contracts
+
+import (
+	"errors"
+	"fmt"
+
+	"gambler/discord-client/application/dto"
+	events "gambler/discord-client/proto/events"
+	eventsv2 "gambler/discord-client/proto/events/v2"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// LoL game state contract versions understood by the translator
+const (
+	LoLSchemaV1 uint32 = 1
+	LoLSchemaV2 uint32 = 2
+
+	// LatestLoLSchema is the newest version this client maps explicitly.
+	// Payloads from newer producers are read with the latest known schema.
+	LatestLoLSchema = LoLSchemaV2
+)
+
+var (
+	// ErrMalformedPayload is returned when a payload is not a valid LoL game state message
+	ErrMalformedPayload = errors.New("malformed LoL game state payload")
+	// ErrUnhandledTransition is returned for state transitions that are not a game start or end
+	ErrUnhandledTransition = errors.New("unhandled LoL game state transition")
+)
+
+// Unknown fields are dropped rather than rejected so older clients keep
+// working when the tracker appends fields to the contract.
+var unmarshalOptions = proto.UnmarshalOptions{DiscardUnknown: true}
+
+// lolQueueTypes maps Riot queue IDs to the queue type names v1 producers send
+var lolQueueTypes = map[int32]string{
+	400: "NORMAL_DRAFT",
+	420: "RANKED_SOLO_5x5",
+	430: "NORMAL_BLIND",
+	440: "RANKED_FLEX_SR",
+	450: "ARAM",
+	490: "QUICKPLAY",
+	700: "CLASH",
+}
+
+// LoLEventTranslator maps versioned LoL game state payloads to application DTOs
+type LoLEventTranslator struct{}
+
+// NewLoLEventTranslator creates a new LoL event translator
+func NewLoLEventTranslator() *LoLEventTranslator {
+	return &LoLEventTranslator{}
+}
+
+// SchemaVersion reports which contract version a payload was written with.
+// v1 producers never set schema_version, so an unset version means v1.
+func (t *LoLEventTranslator) SchemaVersion(data []byte) (uint32, error) {
+	event := &eventsv2.LoLGameStateChanged{}
+	if err := unmarshalOptions.Unmarshal(data, event); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrMalformedPayload, err)
+	}
+
+	if event.SchemaVersion == 0 {
+		return LoLSchemaV1, nil
+	}
+	return event.SchemaVersion, nil
+}
+
+// Translate decodes a LoL game state payload of any known version.
+// Returns either a GameStartedDTO or GameEndedDTO based on the state transition.
+func (t *LoLEventTranslator) Translate(data []byte) (interface{}, error) {
+	version, err := t.SchemaVersion(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if version == LoLSchemaV1 {
+		return t.translateV1(data)
+	}
+	return t.translateV2(data)
+}
+
+// translateV1 maps a payload written against events/lol_events.proto
+func (t *LoLEventTranslator) translateV1(data []byte) (interface{}, error) {
+	event := &events.LoLGameStateChanged{}
+	if err := unmarshalOptions.Unmarshal(data, event); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedPayload, err)
+	}
+
+	notInGame := event.PreviousStatus == events.GameStatus_GAME_STATUS_NOT_IN_GAME
+	inGame := event.PreviousStatus == events.GameStatus_GAME_STATUS_IN_GAME
+	switch {
+	case notInGame && event.CurrentStatus == events.GameStatus_GAME_STATUS_IN_GAME:
+		return dto.GameStartedDTO{
+			GameID:       event.GetGameId(),
+			SummonerName: event.GameName,
+			TagLine:      event.TagLine,
+			QueueType:    event.GetQueueType(),
+			EventTime:    event.EventTime.AsTime(),
+		}, nil
+	case inGame && event.CurrentStatus == events.GameStatus_GAME_STATUS_NOT_IN_GAME:
+		if event.GameResult == nil {
+			return nil, fmt.Errorf("game ended without result data for summoner %s#%s",
+				event.GameName, event.TagLine)
+		}
+		return dto.GameEndedDTO{
+			GameID:          event.GetGameId(),
+			SummonerName:    event.GameName,
+			TagLine:         event.TagLine,
+			Won:             event.GameResult.Won,
+			DurationSeconds: event.GameResult.DurationSeconds,
+			QueueType:       event.GameResult.QueueType,
+			ChampionPlayed:  event.GameResult.ChampionPlayed,
+			EventTime:       event.EventTime.AsTime(),
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s -> %s", ErrUnhandledTransition, event.PreviousStatus, event.CurrentStatus)
+	}
+}
+
+// translateV2 maps a payload written against events/v2/lol_events.proto.
+// v2 producers may send a numeric queue ID instead of the queue type name.
+func (t *LoLEventTranslator) translateV2(data []byte) (interface{}, error) {
+	event := &eventsv2.LoLGameStateChanged{}
+	if err := unmarshalOptions.Unmarshal(data, event); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedPayload, err)
+	}
+
+	notInGame := event.PreviousStatus == eventsv2.GameStatus_GAME_STATUS_NOT_IN_GAME
+	inGame := event.PreviousStatus == eventsv2.GameStatus_GAME_STATUS_IN_GAME
+	switch {
+	case notInGame && event.CurrentStatus == eventsv2.GameStatus_GAME_STATUS_IN_GAME:
+		return dto.GameStartedDTO{
+			GameID:       event.GetGameId(),
+			SummonerName: event.GameName,
+			TagLine:      event.TagLine,
+			QueueType:    queueTypeOf(event.GetQueueType(), event.QueueId),
+			PropMarkets:  propMarketsOf(event.PropMarkets),
+			EventTime:    event.EventTime.AsTime(),
+		}, nil
+	case inGame && event.CurrentStatus == eventsv2.GameStatus_GAME_STATUS_NOT_IN_GAME:
+		result := event.GameResult
+		if result == nil {
+			return nil, fmt.Errorf("game ended without result data for summoner %s#%s",
+				event.GameName, event.TagLine)
+		}
+		return dto.GameEndedDTO{
+			GameID:          event.GetGameId(),
+			SummonerName:    event.GameName,
+			TagLine:         event.TagLine,
+			Won:             result.Won,
+			DurationSeconds: result.DurationSeconds,
+			QueueType:       queueTypeOf(result.QueueType, result.QueueId),
+			ChampionPlayed:  result.ChampionPlayed,
+			TeamFirstBlood:  result.TeamFirstBlood,
+			EventTime:       event.EventTime.AsTime(),
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s -> %s", ErrUnhandledTransition, event.PreviousStatus, event.CurrentStatus)
+	}
+}
+
+// lolPropMarkets maps contract prop markets to the ones the client settles
+var lolPropMarkets = map[eventsv2.PropMarket]dto.LoLPropMarket{
+	eventsv2.PropMarket_PROP_MARKET_GAME_DURATION: dto.LoLPropGameDuration,
+	eventsv2.PropMarket_PROP_MARKET_FIRST_BLOOD:   dto.LoLPropFirstBlood,
+}
+
+// propMarketsOf keeps the advertised prop markets this client knows how to settle
+func propMarketsOf(markets []eventsv2.PropMarket) []dto.LoLPropMarket {
+	var props []dto.LoLPropMarket
+	for _, market := range markets {
+		if prop, ok := lolPropMarkets[market]; ok {
+			props = append(props, prop)
+		}
+	}
+	return props
+}
+
+// queueTypeOf prefers the queue type name and falls back to the queue ID
+func queueTypeOf(queueType string, queueID int32) string {
+	if queueType != "" {
+		return queueType
+	}
+	return lolQueueTypes[queueID]
+}
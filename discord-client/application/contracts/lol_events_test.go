@@ -0,0 +1,290 @@
+package contracts
+
+import (
+	"testing"
+	"time"
+
+	"gambler/discord-client/application/dto"
+	events "gambler/discord-client/proto/events"
+	eventsv2 "gambler/discord-client/proto/events/v2"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var eventTime = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func marshal(t *testing.T, msg proto.Message) []byte {
+	t.Helper()
+	data, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	return data
+}
+
+func TestLoLEventTranslator_V1(t *testing.T) {
+	translator := NewLoLEventTranslator()
+
+	t.Run("game started", func(t *testing.T) {
+		data := marshal(t, &events.LoLGameStateChanged{
+			GameName:       "Faker",
+			TagLine:        "KR1",
+			PreviousStatus: events.GameStatus_GAME_STATUS_NOT_IN_GAME,
+			CurrentStatus:  events.GameStatus_GAME_STATUS_IN_GAME,
+			EventTime:      timestamppb.New(eventTime),
+			GameId:         proto.String("12345"),
+			QueueType:      proto.String("RANKED_SOLO_5x5"),
+		})
+
+		version, err := translator.SchemaVersion(data)
+		require.NoError(t, err)
+		assert.Equal(t, LoLSchemaV1, version)
+
+		result, err := translator.Translate(data)
+		require.NoError(t, err)
+		assert.Equal(t, dto.GameStartedDTO{
+			GameID:       "12345",
+			SummonerName: "Faker",
+			TagLine:      "KR1",
+			QueueType:    "RANKED_SOLO_5x5",
+			EventTime:    eventTime,
+		}, result)
+	})
+
+	t.Run("game ended", func(t *testing.T) {
+		data := marshal(t, &events.LoLGameStateChanged{
+			GameName:       "Faker",
+			TagLine:        "KR1",
+			PreviousStatus: events.GameStatus_GAME_STATUS_IN_GAME,
+			CurrentStatus:  events.GameStatus_GAME_STATUS_NOT_IN_GAME,
+			EventTime:      timestamppb.New(eventTime),
+			GameId:         proto.String("12345"),
+			GameResult: &events.GameResult{
+				Won:             true,
+				DurationSeconds: 1800,
+				QueueType:       "RANKED_FLEX_SR",
+				ChampionPlayed:  "Ahri",
+			},
+		})
+
+		result, err := translator.Translate(data)
+		require.NoError(t, err)
+		assert.Equal(t, dto.GameEndedDTO{
+			GameID:          "12345",
+			SummonerName:    "Faker",
+			TagLine:         "KR1",
+			Won:             true,
+			DurationSeconds: 1800,
+			QueueType:       "RANKED_FLEX_SR",
+			ChampionPlayed:  "Ahri",
+			EventTime:       eventTime,
+		}, result)
+	})
+
+	t.Run("game ended without result", func(t *testing.T) {
+		data := marshal(t, &events.LoLGameStateChanged{
+			PreviousStatus: events.GameStatus_GAME_STATUS_IN_GAME,
+			CurrentStatus:  events.GameStatus_GAME_STATUS_NOT_IN_GAME,
+		})
+
+		_, err := translator.Translate(data)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrMalformedPayload)
+	})
+
+	t.Run("unhandled transition", func(t *testing.T) {
+		data := marshal(t, &events.LoLGameStateChanged{
+			PreviousStatus: events.GameStatus_GAME_STATUS_IN_GAME,
+			CurrentStatus:  events.GameStatus_GAME_STATUS_IN_GAME,
+		})
+
+		_, err := translator.Translate(data)
+		assert.ErrorIs(t, err, ErrUnhandledTransition)
+	})
+}
+
+func TestLoLEventTranslator_V2(t *testing.T) {
+	translator := NewLoLEventTranslator()
+
+	t.Run("queue ID used when queue type is unset", func(t *testing.T) {
+		data := marshal(t, &eventsv2.LoLGameStateChanged{
+			SchemaVersion:  LoLSchemaV2,
+			GameName:       "Faker",
+			TagLine:        "KR1",
+			PreviousStatus: eventsv2.GameStatus_GAME_STATUS_IN_GAME,
+			CurrentStatus:  eventsv2.GameStatus_GAME_STATUS_NOT_IN_GAME,
+			EventTime:      timestamppb.New(eventTime),
+			GameId:         proto.String("12345"),
+			QueueId:        440,
+			PlatformId:     "KR",
+			GameResult: &eventsv2.GameResult{
+				Won:             false,
+				DurationSeconds: 1500,
+				ChampionPlayed:  "Azir",
+				QueueId:         440,
+			},
+		})
+
+		version, err := translator.SchemaVersion(data)
+		require.NoError(t, err)
+		assert.Equal(t, LoLSchemaV2, version)
+
+		result, err := translator.Translate(data)
+		require.NoError(t, err)
+		assert.Equal(t, dto.GameEndedDTO{
+			GameID:          "12345",
+			SummonerName:    "Faker",
+			TagLine:         "KR1",
+			DurationSeconds: 1500,
+			QueueType:       "RANKED_FLEX_SR",
+			ChampionPlayed:  "Azir",
+			EventTime:       eventTime,
+		}, result)
+	})
+
+	t.Run("queue type preferred over queue ID", func(t *testing.T) {
+		data := marshal(t, &eventsv2.LoLGameStateChanged{
+			SchemaVersion:  LoLSchemaV2,
+			PreviousStatus: eventsv2.GameStatus_GAME_STATUS_NOT_IN_GAME,
+			CurrentStatus:  eventsv2.GameStatus_GAME_STATUS_IN_GAME,
+			QueueType:      proto.String("RANKED_SOLO_5x5"),
+			QueueId:        450,
+		})
+
+		result, err := translator.Translate(data)
+		require.NoError(t, err)
+		assert.Equal(t, "RANKED_SOLO_5x5", result.(dto.GameStartedDTO).QueueType)
+	})
+
+	t.Run("unknown queue ID", func(t *testing.T) {
+		data := marshal(t, &eventsv2.LoLGameStateChanged{
+			SchemaVersion:  LoLSchemaV2,
+			PreviousStatus: eventsv2.GameStatus_GAME_STATUS_NOT_IN_GAME,
+			CurrentStatus:  eventsv2.GameStatus_GAME_STATUS_IN_GAME,
+			QueueId:        9999,
+		})
+
+		result, err := translator.Translate(data)
+		require.NoError(t, err)
+		assert.Empty(t, result.(dto.GameStartedDTO).QueueType)
+	})
+
+	t.Run("prop markets on start skip unknown markets", func(t *testing.T) {
+		data := marshal(t, &eventsv2.LoLGameStateChanged{
+			SchemaVersion:  LoLSchemaV2,
+			PreviousStatus: eventsv2.GameStatus_GAME_STATUS_NOT_IN_GAME,
+			CurrentStatus:  eventsv2.GameStatus_GAME_STATUS_IN_GAME,
+			QueueId:        420,
+			PropMarkets: []eventsv2.PropMarket{
+				eventsv2.PropMarket_PROP_MARKET_FIRST_BLOOD,
+				eventsv2.PropMarket_PROP_MARKET_UNSPECIFIED,
+				eventsv2.PropMarket(99),
+				eventsv2.PropMarket_PROP_MARKET_GAME_DURATION,
+			},
+		})
+
+		result, err := translator.Translate(data)
+		require.NoError(t, err)
+		assert.Equal(t, []dto.LoLPropMarket{dto.LoLPropFirstBlood, dto.LoLPropGameDuration}, result.(dto.GameStartedDTO).PropMarkets)
+	})
+
+	t.Run("first blood carried on result only when reported", func(t *testing.T) {
+		ended := func(firstBlood *bool) dto.GameEndedDTO {
+			data := marshal(t, &eventsv2.LoLGameStateChanged{
+				SchemaVersion:  LoLSchemaV2,
+				PreviousStatus: eventsv2.GameStatus_GAME_STATUS_IN_GAME,
+				CurrentStatus:  eventsv2.GameStatus_GAME_STATUS_NOT_IN_GAME,
+				GameResult:     &eventsv2.GameResult{Won: true, DurationSeconds: 1800, TeamFirstBlood: firstBlood},
+			})
+			result, err := translator.Translate(data)
+			require.NoError(t, err)
+			return result.(dto.GameEndedDTO)
+		}
+
+		assert.Nil(t, ended(nil).TeamFirstBlood)
+		reported := ended(proto.Bool(false)).TeamFirstBlood
+		require.NotNil(t, reported)
+		assert.False(t, *reported)
+	})
+}
+
+func TestLoLEventTranslator_BackwardCompatibility(t *testing.T) {
+	translator := NewLoLEventTranslator()
+
+	t.Run("v1 payload decodes as v2 message", func(t *testing.T) {
+		data := marshal(t, &events.LoLGameStateChanged{
+			GameName:       "Faker",
+			TagLine:        "KR1",
+			PreviousStatus: events.GameStatus_GAME_STATUS_IN_GAME,
+			CurrentStatus:  events.GameStatus_GAME_STATUS_NOT_IN_GAME,
+			GameId:         proto.String("12345"),
+			QueueType:      proto.String("ARAM"),
+			GameResult:     &events.GameResult{Won: true, QueueType: "ARAM", ChampionPlayed: "Lux"},
+		})
+
+		event := &eventsv2.LoLGameStateChanged{}
+		require.NoError(t, proto.Unmarshal(data, event))
+		assert.Zero(t, event.SchemaVersion)
+		assert.Equal(t, "Faker", event.GameName)
+		assert.Equal(t, "12345", event.GetGameId())
+		assert.Equal(t, "ARAM", event.GetQueueType())
+		assert.Equal(t, "Lux", event.GameResult.ChampionPlayed)
+	})
+
+	t.Run("v2 payload decodes as v1 message", func(t *testing.T) {
+		data := marshal(t, &eventsv2.LoLGameStateChanged{
+			SchemaVersion:  LoLSchemaV2,
+			GameName:       "Faker",
+			TagLine:        "KR1",
+			PreviousStatus: eventsv2.GameStatus_GAME_STATUS_NOT_IN_GAME,
+			CurrentStatus:  eventsv2.GameStatus_GAME_STATUS_IN_GAME,
+			GameId:         proto.String("12345"),
+			QueueType:      proto.String("RANKED_SOLO_5x5"),
+			QueueId:        420,
+			PlatformId:     "KR",
+		})
+
+		event := &events.LoLGameStateChanged{}
+		require.NoError(t, proto.Unmarshal(data, event))
+		assert.Equal(t, "Faker", event.GameName)
+		assert.Equal(t, events.GameStatus_GAME_STATUS_IN_GAME, event.CurrentStatus)
+		assert.Equal(t, "RANKED_SOLO_5x5", event.GetQueueType())
+	})
+
+	t.Run("unknown fields from newer producers are ignored", func(t *testing.T) {
+		data := marshal(t, &eventsv2.LoLGameStateChanged{
+			SchemaVersion:  3,
+			GameName:       "Faker",
+			TagLine:        "KR1",
+			PreviousStatus: eventsv2.GameStatus_GAME_STATUS_NOT_IN_GAME,
+			CurrentStatus:  eventsv2.GameStatus_GAME_STATUS_IN_GAME,
+			GameId:         proto.String("12345"),
+			QueueId:        420,
+		})
+		data = protowire.AppendTag(data, 99, protowire.BytesType)
+		data = protowire.AppendString(data, "field added in a future version")
+		data = protowire.AppendTag(data, 100, protowire.VarintType)
+		data = protowire.AppendVarint(data, 7)
+
+		version, err := translator.SchemaVersion(data)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(3), version)
+
+		result, err := translator.Translate(data)
+		require.NoError(t, err)
+		assert.Equal(t, dto.GameStartedDTO{
+			GameID:       "12345",
+			SummonerName: "Faker",
+			TagLine:      "KR1",
+			QueueType:    "RANKED_SOLO_5x5",
+			EventTime:    time.Unix(0, 0).UTC(),
+		}, result)
+	})
+
+	t.Run("malformed payload", func(t *testing.T) {
+		_, err := translator.Translate([]byte{0xff, 0xff, 0xff})
+		assert.ErrorIs(t, err, ErrMalformedPayload)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"gambler/discord-client/application/dto"
+	"gambler/discord-client/domain/entities"
 	"gambler/discord-client/domain/services"
 
 	log "github.com/sirupsen/logrus"
@@ -31,58 +32,31 @@ func NewDailyAwardsWorker(
 	}
 }
 
-// Start begins the daily awards worker
-func (w *DailyAwardsWorkerImpl) Start(ctx context.Context, notificationHour int) func() {
-	stopChan := make(chan struct{})
-
-	// Calculate time until next notification
-	calculateNextRun := func() time.Duration {
-		now := time.Now().UTC()
-		next := time.Date(now.Year(), now.Month(), now.Day(), notificationHour, 0, 0, 0, time.UTC)
-
-		// If the notification time has already passed today, schedule for tomorrow
-		if now.After(next) || now.Equal(next) {
-			next = next.Add(24 * time.Hour)
-		}
-
-		return next.Sub(now)
-	}
-
-	// Process daily awards for all guilds
-	processDailyAwards := func() {
-		log.Info("Processing daily awards for all guilds")
-
-		if err := w.processAllGuilds(ctx); err != nil {
-			log.Errorf("Error processing daily awards: %v", err)
-		}
+// Job returns the scheduler job that posts daily awards at notificationHour UTC each day
+func (w *DailyAwardsWorkerImpl) Job(notificationHour int) Job {
+	return Job{
+		Name:     "daily-awards",
+		Interval: 24 * time.Hour,
+		NextRun: func(ctx context.Context, now time.Time) time.Time {
+			return nextDailyRun(now, notificationHour)
+		},
+		Run: func(ctx context.Context) error {
+			log.Info("Processing daily awards for all guilds")
+			return w.processAllGuilds(ctx)
+		},
 	}
+}
 
-	// Start the worker goroutine
-	go func() {
-		log.Infof("Daily awards worker started, next run at %02d:00 UTC", notificationHour)
-
-		for {
-			// Calculate time until next run
-			waitDuration := calculateNextRun()
-			log.Infof("Daily awards worker waiting %v until next run", waitDuration)
-
-			select {
-			case <-ctx.Done():
-				log.Info("Daily awards worker shutting down (context cancelled)...")
-				return
-			case <-stopChan:
-				log.Info("Daily awards worker shutting down (stop requested)...")
-				return
-			case <-time.After(waitDuration):
-				processDailyAwards()
-			}
-		}
-	}()
+// nextDailyRun returns the next time the clock reaches hour:00 UTC after now
+func nextDailyRun(now time.Time, hour int) time.Time {
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, time.UTC)
 
-	// Return cleanup function
-	return func() {
-		close(stopChan)
+	// If the notification time has already passed today, schedule for tomorrow
+	if !now.Before(next) {
+		next = next.Add(24 * time.Hour)
 	}
+	return next
 }
 
 // processAllGuilds processes daily awards for all guilds
@@ -131,14 +105,17 @@ func (w *DailyAwardsWorkerImpl) processGuildAwards(ctx context.Context, guild dt
 	}
 	defer uow.Rollback()
 
+	enabled, err := uow.Services().FeatureFlagService().IsEnabled(ctx, entities.FeatureDailyAwards)
+	if err != nil {
+		return fmt.Errorf("failed to check feature flag: %w", err)
+	}
+	if !enabled {
+		log.Debugf("Daily awards disabled for guild %d, skipping", guild.GuildID)
+		return nil
+	}
+
 	// Create daily awards service
-	dailyAwardsService := services.NewDailyAwardsService(
-		uow.WordleCompletionRepo(),
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.BetRepository(),
-		uow.GroupWagerRepository(),
-	)
+	dailyAwardsService := uow.Services().DailyAwardsService()
 
 	// Get daily awards summary
 	summary, err := dailyAwardsService.GetDailyAwardsSummary(ctx, guild.GuildID)
@@ -0,0 +1,183 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/application/dto"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Discord outbox dispatcher tuning
+const (
+	DiscordOutboxInterval = 15 * time.Second
+	discordOutboxBatch    = 50 // Messages sent per run, so a rate limited channel can't hold up the scheduler
+)
+
+// DiscordOutboxDispatcher sends Discord actions queued in the outbox once the transaction that queued them
+// has committed, and retries failed ones with backoff
+type DiscordOutboxDispatcher struct {
+	uowFactory UnitOfWorkFactory
+	executor   interfaces.DiscordOutboxExecutor
+}
+
+// NewDiscordOutboxDispatcher creates a new outbox dispatcher sending through the Discord poster
+func NewDiscordOutboxDispatcher(uowFactory UnitOfWorkFactory, discordPoster DiscordPoster) *DiscordOutboxDispatcher {
+	return &DiscordOutboxDispatcher{
+		uowFactory: uowFactory,
+		executor:   &discordPosterExecutor{poster: discordPoster},
+	}
+}
+
+// Job returns the scheduler job that retries due messages every interval.
+// It runs on start to send messages queued while the bot was offline.
+func (d *DiscordOutboxDispatcher) Job(interval time.Duration) Job {
+	return Job{
+		Name:       "discord-outbox",
+		Interval:   interval,
+		Jitter:     2 * time.Second,
+		RunOnStart: true,
+		Run:        d.dispatchDue,
+	}
+}
+
+// Dispatch sends messages a guild has just committed so they go out without waiting for the job.
+// Messages that fail are left for the job to retry.
+func (d *DiscordOutboxDispatcher) Dispatch(ctx context.Context, guildID int64, messageIDs ...int64) {
+	for _, messageID := range messageIDs {
+		if _, err := d.dispatch(ctx, guildID, messageID); err != nil {
+			log.WithFields(log.Fields{
+				"guild":           guildID,
+				"outboxMessageID": messageID,
+				"error":           err,
+			}).Error("Failed to dispatch discord outbox message")
+		}
+	}
+}
+
+// dispatchDue sends every message that is due, up to the batch size
+func (d *DiscordOutboxDispatcher) dispatchDue(ctx context.Context) error {
+	// Cross-guild query to find due messages
+	uow := d.uowFactory.CreateForGuild(0)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	messages, err := uow.DiscordOutboxRepository().GetDueMessages(ctx, time.Now().UTC(), discordOutboxBatch)
+	uow.Rollback()
+	if err != nil {
+		return fmt.Errorf("failed to get due discord outbox messages: %w", err)
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	var sentCount, retryCount, failedCount int
+	for _, message := range messages {
+		result, err := d.dispatch(ctx, message.GuildID, message.ID)
+		if err != nil {
+			log.Errorf("Error dispatching discord outbox message %d for guild %d: %v", message.ID, message.GuildID, err)
+			failedCount++
+			continue
+		}
+		if result == nil {
+			continue
+		}
+
+		switch result.Status {
+		case entities.DiscordOutboxStatusSent:
+			sentCount++
+		case entities.DiscordOutboxStatusFailed:
+			failedCount++
+			log.WithFields(log.Fields{
+				"outboxMessageID": result.ID,
+				"guildID":         result.GuildID,
+				"action":          result.Action,
+				"attempts":        result.Attempts,
+			}).Warn("Discord outbox message failed permanently")
+		default:
+			retryCount++
+		}
+	}
+
+	log.Infof("Discord outbox dispatch complete: %d sent, %d retrying, %d failed", sentCount, retryCount, failedCount)
+	return nil
+}
+
+// dispatch sends a single message in its own guild-scoped transaction
+func (d *DiscordOutboxDispatcher) dispatch(ctx context.Context, guildID, messageID int64) (*entities.DiscordOutboxMessage, error) {
+	uow := d.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	message, err := uow.Services().DiscordOutboxService().Dispatch(ctx, messageID, d.executor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return message, nil
+}
+
+// discordPosterExecutor performs outbox actions through the Discord poster, rendering payloads by kind
+type discordPosterExecutor struct {
+	poster DiscordPoster
+}
+
+// Execute posts, edits or pins the outbox message
+func (e *discordPosterExecutor) Execute(ctx context.Context, message *entities.DiscordOutboxMessage) (*entities.DiscordOutboxResult, error) {
+	if message.Action == entities.DiscordOutboxActionPin {
+		if err := e.poster.PinMessage(ctx, message.ChannelID, *message.MessageID); err != nil {
+			return nil, fmt.Errorf("failed to pin message: %w", err)
+		}
+		return &entities.DiscordOutboxResult{MessageID: *message.MessageID, ChannelID: message.ChannelID}, nil
+	}
+
+	switch message.Kind {
+	case entities.DiscordOutboxKindGroupWager:
+		postResult, err := e.poster.PostGroupWager(ctx, message.GuildID, message.ChannelID, *message.GroupWagerID)
+		if err != nil {
+			return nil, err
+		}
+		return &entities.DiscordOutboxResult{
+			MessageID: postResult.MessageID,
+			ChannelID: postResult.ChannelID,
+			ThreadID:  postResult.ThreadID,
+		}, nil
+	case entities.DiscordOutboxKindHouseWager:
+		var postDTO dto.HouseWagerPostDTO
+		if err := json.Unmarshal(message.Payload, &postDTO); err != nil {
+			return nil, fmt.Errorf("failed to decode house wager payload: %w", err)
+		}
+
+		if message.Action == entities.DiscordOutboxActionEdit {
+			if err := e.poster.UpdateHouseWager(ctx, *message.MessageID, message.ChannelID, postDTO); err != nil {
+				return nil, err
+			}
+			return &entities.DiscordOutboxResult{MessageID: *message.MessageID, ChannelID: message.ChannelID}, nil
+		}
+
+		postDTO.ChannelID = message.ChannelID
+		postResult, err := e.poster.PostHouseWager(ctx, postDTO)
+		if err != nil {
+			return nil, err
+		}
+		return &entities.DiscordOutboxResult{
+			MessageID: postResult.MessageID,
+			ChannelID: postResult.ChannelID,
+			ThreadID:  postResult.ThreadID,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown discord outbox kind %q", message.Kind)
+	}
+}
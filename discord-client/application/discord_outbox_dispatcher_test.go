@@ -0,0 +1,103 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"gambler/discord-client/application/dto"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscordPosterExecutor_Execute(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	payload, err := json.Marshal(dto.HouseWagerPostDTO{GuildID: 1, WagerID: 7, Title: "Will they win?"})
+	require.NoError(t, err)
+
+	t.Run("posts a house wager to the queued channel", func(t *testing.T) {
+		poster := &MockDiscordPoster{}
+		executor := &discordPosterExecutor{poster: poster}
+
+		result, err := executor.Execute(ctx, &entities.DiscordOutboxMessage{
+			Action:    entities.DiscordOutboxActionPost,
+			Kind:      entities.DiscordOutboxKindHouseWager,
+			ChannelID: 456,
+			Payload:   payload,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, poster.Posts, 1)
+		assert.Equal(t, "Will they win?", poster.Posts[0].Title)
+		assert.Equal(t, int64(456), poster.Posts[0].ChannelID)
+		assert.Equal(t, int64(456), result.ChannelID)
+		assert.NotZero(t, result.MessageID)
+	})
+
+	t.Run("posts a group wager from the wager itself", func(t *testing.T) {
+		poster := &MockDiscordPoster{}
+		executor := &discordPosterExecutor{poster: poster}
+		wagerID := int64(42)
+
+		result, err := executor.Execute(ctx, &entities.DiscordOutboxMessage{
+			GuildID:      1,
+			Action:       entities.DiscordOutboxActionPost,
+			Kind:         entities.DiscordOutboxKindGroupWager,
+			ChannelID:    456,
+			GroupWagerID: &wagerID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{42}, poster.GroupWagerPosts)
+		assert.Equal(t, int64(456), result.ChannelID)
+		assert.NotZero(t, result.MessageID)
+	})
+
+	t.Run("pins a message", func(t *testing.T) {
+		poster := &MockDiscordPoster{}
+		executor := &discordPosterExecutor{poster: poster}
+		messageID := int64(999)
+
+		_, err := executor.Execute(ctx, &entities.DiscordOutboxMessage{
+			Action:    entities.DiscordOutboxActionPin,
+			ChannelID: 456,
+			MessageID: &messageID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{999}, poster.PinnedMessages)
+	})
+
+	t.Run("returns Discord errors for a retry", func(t *testing.T) {
+		executor := &discordPosterExecutor{poster: &MockDiscordPoster{Error: errors.New("HTTP 503 Service Unavailable")}}
+		messageID := int64(999)
+
+		_, err := executor.Execute(ctx, &entities.DiscordOutboxMessage{
+			Action:    entities.DiscordOutboxActionEdit,
+			Kind:      entities.DiscordOutboxKindHouseWager,
+			ChannelID: 456,
+			MessageID: &messageID,
+			Payload:   payload,
+		})
+
+		assert.ErrorContains(t, err, "503")
+	})
+
+	t.Run("rejects unknown kinds", func(t *testing.T) {
+		executor := &discordPosterExecutor{poster: &MockDiscordPoster{}}
+
+		_, err := executor.Execute(ctx, &entities.DiscordOutboxMessage{
+			Action:    entities.DiscordOutboxActionPost,
+			Kind:      "scoreboard",
+			ChannelID: 456,
+			Payload:   payload,
+		})
+
+		assert.ErrorContains(t, err, "scoreboard")
+	})
+}
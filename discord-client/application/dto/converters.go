@@ -40,8 +40,15 @@ func GroupWagerDetailToHouseWagerPostDTO(detail *entities.GroupWagerDetail) Hous
 		VotingEndsAt:    detail.Wager.VotingEndsAt,
 		WinningOptionID: detail.Wager.WinningOptionID,
 		Participants:    make([]ParticipantDTO, len(detail.Participants)),
+		PlayerDiscordID: detail.Wager.SubjectDiscordID,
 		TotalPot:        detail.Wager.TotalPot,
 	}
+	if detail.Wager.ThumbnailURL != nil {
+		dto.ThumbnailURL = *detail.Wager.ThumbnailURL
+	}
+	if detail.Wager.ImageURL != nil {
+		dto.ImageURL = *detail.Wager.ImageURL
+	}
 
 	// Convert options
 	for i, opt := range detail.Options {
@@ -54,12 +61,19 @@ func GroupWagerDetailToHouseWagerPostDTO(detail *entities.GroupWagerDetail) Hous
 		}
 	}
 
+	// Map options by ID for payout multiplier lookup
+	optionsByID := make(map[int64]*entities.GroupWagerOption, len(detail.Options))
+	for _, opt := range detail.Options {
+		optionsByID[opt.ID] = opt
+	}
+
 	// Convert participants
 	for i, participant := range detail.Participants {
 		dto.Participants[i] = ParticipantDTO{
-			DiscordID: participant.DiscordID,
-			OptionID:  participant.OptionID,
-			Amount:    participant.Amount,
+			DiscordID:  participant.DiscordID,
+			OptionID:   participant.OptionID,
+			Amount:     participant.Amount,
+			Multiplier: participant.GetPayoutMultiplier(optionsByID[participant.OptionID]),
 		}
 	}
 
@@ -2,12 +2,21 @@ package dto
 
 import "time"
 
+// LoLPropMarket identifies a prop bet the tracker can settle for a LoL game
+type LoLPropMarket string
+
+const (
+	LoLPropGameDuration LoLPropMarket = "game_duration" // Game length over/under
+	LoLPropFirstBlood   LoLPropMarket = "first_blood"   // Whether the player's team draws first blood
+)
+
 // GameStartedDTO represents a game that has started
 type GameStartedDTO struct {
 	GameID       string
 	SummonerName string
 	TagLine      string
 	QueueType    string
+	PropMarkets  []LoLPropMarket // Props the game result will carry data for
 	EventTime    time.Time
 }
 
@@ -20,6 +29,7 @@ type GameEndedDTO struct {
 	DurationSeconds int32
 	QueueType       string
 	ChampionPlayed  string
+	TeamFirstBlood  *bool // nil when the tracker did not report first blood
 	EventTime       time.Time
 }
 
@@ -16,6 +16,9 @@ type HouseWagerPostDTO struct {
 	Options         []WagerOptionDTO
 	VotingEndsAt    *time.Time // When the voting period ends
 	WinningOptionID *int64     // ID of the winning option (only set when resolved)
+	PlayerDiscordID *int64     // Linked user whose game the wager is on, tagged in the embed
+	ThumbnailURL    string     // Small image beside the embed, e.g. the queue icon, empty for none
+	ImageURL        string     // Large image under the embed, e.g. the champion played, empty for none
 
 	// Participant information for real-time display
 	Participants []ParticipantDTO
@@ -24,18 +27,22 @@ type HouseWagerPostDTO struct {
 
 // WagerOptionDTO represents a single option in a house wager
 type WagerOptionDTO struct {
-	ID          int64
-	Text        string
-	Order       int16
-	Multiplier  float64
-	TotalAmount int64 // Total amount bet on this option
+	ID             int64
+	Text           string
+	Order          int16
+	Multiplier     float64
+	TotalAmount    int64  // Total amount bet on this option
+	MaxTotalAmount *int64 // Cap on the total amount, nil when uncapped
+	ButtonEmoji    string // Bet button emoji, empty for the numbered default
+	ButtonStyle    string // Bet button colour (primary, secondary, success or danger), empty for the default
 }
 
 // ParticipantDTO represents a participant in a house wager
 type ParticipantDTO struct {
-	DiscordID int64
-	OptionID  int64
-	Amount    int64
+	DiscordID  int64
+	OptionID   int64
+	Amount     int64
+	Multiplier float64 // Odds the bet pays out at, locked in when the bet was placed
 }
 
 // PostResult contains the result of posting a wager to Discord
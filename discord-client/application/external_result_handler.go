@@ -0,0 +1,122 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gambler/discord-client/domain/entities"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrNoLinkedWager is returned when an external result doesn't match any unresolved group wager
+var ErrNoLinkedWager = errors.New("no unresolved group wager is linked to this match")
+
+// ExternalResultHandler resolves group wagers linked to external matches when a result provider reports the outcome
+type ExternalResultHandler struct {
+	uowFactory UnitOfWorkFactory
+}
+
+// NewExternalResultHandler creates a new external result handler
+func NewExternalResultHandler(uowFactory UnitOfWorkFactory) *ExternalResultHandler {
+	return &ExternalResultHandler{uowFactory: uowFactory}
+}
+
+// HandleResult resolves the linked wager in every guild that has one open
+func (h *ExternalResultHandler) HandleResult(ctx context.Context, result entities.ExternalResult) error {
+	if !result.Reference.IsValid() || strings.TrimSpace(result.WinningOption) == "" {
+		return fmt.Errorf("external result needs a system, match ID and winning option")
+	}
+
+	// Cross-guild query to find guilds with a wager on this match
+	uow := h.uowFactory.CreateForGuild(0)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	guildIDs, err := uow.GroupWagerRepository().GetGuildsWithOpenExternalReference(ctx, result.Reference)
+	uow.Rollback()
+	if err != nil {
+		return fmt.Errorf("failed to get guilds linked to match: %w", err)
+	}
+
+	if len(guildIDs) == 0 {
+		return ErrNoLinkedWager
+	}
+
+	var errs []error
+	for _, guildID := range guildIDs {
+		if err := h.resolveInGuild(ctx, guildID, result); err != nil {
+			log.WithFields(log.Fields{
+				"guild":   guildID,
+				"system":  result.Reference.System,
+				"matchID": result.Reference.ID,
+				"error":   err,
+			}).Error("Failed to resolve group wager from external result")
+			errs = append(errs, fmt.Errorf("guild %d: %w", guildID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// resolveInGuild resolves the guild's wager linked to the result's match
+func (h *ExternalResultHandler) resolveInGuild(ctx context.Context, guildID int64, result entities.ExternalResult) error {
+	uow := h.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	wager, err := uow.GroupWagerRepository().GetByExternalReference(ctx, result.Reference)
+	if err != nil {
+		return fmt.Errorf("failed to get linked wager: %w", err)
+	}
+	if wager == nil {
+		return ErrNoLinkedWager
+	}
+
+	groupWagerService := uow.Services().GroupWagerService()
+
+	detail, err := groupWagerService.GetGroupWagerDetail(ctx, wager.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get wager detail: %w", err)
+	}
+
+	winningOptionID := selectExternalResultWinner(detail.Options, result.WinningOption)
+	if winningOptionID == 0 {
+		return fmt.Errorf("wager #%d has no option matching %q", wager.ID, result.WinningOption)
+	}
+
+	// nil resolver indicates system resolution, the same as game tracked house wagers
+	resolved, err := groupWagerService.ResolveGroupWager(ctx, wager.ID, nil, winningOptionID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve group wager: %w", err)
+	}
+
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"guild":        guildID,
+		"wagerID":      wager.ID,
+		"matchID":      result.Reference.ID,
+		"totalPot":     resolved.TotalPot,
+		"winnersCount": len(resolved.Winners),
+	}).Info("Resolved group wager from external result")
+
+	return nil
+}
+
+// selectExternalResultWinner returns the ID of the option whose text matches the reported winner, or 0 if none does
+func selectExternalResultWinner(options []*entities.GroupWagerOption, winningOption string) int64 {
+	winningOption = strings.TrimSpace(winningOption)
+	for _, opt := range options {
+		if opt != nil && strings.EqualFold(strings.TrimSpace(opt.OptionText), winningOption) {
+			return opt.ID
+		}
+	}
+	return 0
+}
@@ -0,0 +1,35 @@
+package application
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectExternalResultWinner(t *testing.T) {
+	t.Parallel()
+
+	options := []*entities.GroupWagerOption{
+		{ID: 1, OptionText: "Team Liquid"},
+		{ID: 2, OptionText: "Cloud9 "},
+	}
+
+	tests := []struct {
+		name          string
+		winningOption string
+		expected      int64
+	}{
+		{name: "exact match", winningOption: "Team Liquid", expected: 1},
+		{name: "case and whitespace ignored", winningOption: " cloud9", expected: 2},
+		{name: "unknown option", winningOption: "G2 Esports", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, selectExternalResultWinner(options, tt.winningOption))
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package application
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"gambler/discord-client/domain/entities"
+)
+
+const (
+	// communityDragonGameModeURL hosts the client's game mode icons
+	communityDragonGameModeURL = "https://raw.communitydragon.org/latest/plugins/rcp-be-lol-game-data/global/default/content/src/leagueclient/gamemodeassets"
+
+	// dataDragonChampionSplashURL hosts champion splash art, which unlike icons needs no patch version
+	dataDragonChampionSplashURL = "https://ddragon.leagueoflegends.com/cdn/img/champion/splash"
+)
+
+// gameModeIcons maps the LoL queue types wagers are created for to their game mode icon.
+// Every registered TFT queue uses the TFT icon.
+var gameModeIcons = map[string]string{
+	"RANKED_SOLO_5x5": "classic_sru",
+	"RANKED_FLEX_SR":  "classic_sru",
+	"NORMAL_DRAFT":    "classic_sru",
+	"NORMAL_BLIND":    "classic_sru",
+	"QUICKPLAY":       "classic_sru",
+	"CLASH":           "classic_sru",
+	"ARAM":            "aram",
+	"ARAM_CLASH":      "aram",
+}
+
+// championAssetIDs lists champions whose asset ID isn't their display name without spaces and punctuation
+var championAssetIDs = map[string]string{
+	"Bel'Veth":       "Belveth",
+	"Cho'Gath":       "Chogath",
+	"Kai'Sa":         "Kaisa",
+	"Kha'Zix":        "Khazix",
+	"LeBlanc":        "Leblanc",
+	"Nunu & Willump": "Nunu",
+	"Renata Glasc":   "Renata",
+	"Vel'Koz":        "Velkoz",
+	"Wukong":         "MonkeyKing",
+}
+
+// queueIconURL returns the game mode icon for a queue type, or "" for queues without one
+func queueIconURL(queueType string) string {
+	mode, ok := gameModeIcons[queueType]
+	if _, isTFT := entities.LookupTFTQueue(queueType); isTFT {
+		mode, ok = "tft", true
+	}
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/img/game-select-icon-active.png", communityDragonGameModeURL, mode)
+}
+
+// championImageURL returns the splash art of a champion, accepting display names ("Kai'Sa") or
+// asset IDs ("Kaisa"). Returns "" when no champion was reported.
+func championImageURL(champion string) string {
+	champion = strings.TrimSpace(champion)
+	if champion == "" {
+		return ""
+	}
+
+	assetID, ok := championAssetIDs[champion]
+	if !ok {
+		assetID = strings.Map(func(r rune) rune {
+			switch r {
+			case ' ', '\'', '.', '&':
+				return -1
+			}
+			return r
+		}, champion)
+	}
+	return fmt.Sprintf("%s/%s_0.jpg", dataDragonChampionSplashURL, url.PathEscape(assetID))
+}
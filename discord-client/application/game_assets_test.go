@@ -0,0 +1,45 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueIconURL(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, communityDragonGameModeURL+"/classic_sru/img/game-select-icon-active.png", queueIconURL("RANKED_SOLO_5x5"))
+	assert.Equal(t, queueIconURL("RANKED_SOLO_5x5"), queueIconURL("RANKED_FLEX_SR"))
+	assert.Equal(t, communityDragonGameModeURL+"/tft/img/game-select-icon-active.png", queueIconURL("TFT_RANKED_DOUBLE_UP"))
+	assert.Equal(t, communityDragonGameModeURL+"/aram/img/game-select-icon-active.png", queueIconURL("ARAM"))
+	assert.Empty(t, queueIconURL("CUSTOM_GAME"))
+}
+
+func TestChampionImageURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		champion string
+		want     string
+	}{
+		{champion: "Ahri", want: "Ahri"},
+		{champion: "Master Yi", want: "MasterYi"},
+		{champion: "Dr. Mundo", want: "DrMundo"},
+		{champion: "Rek'Sai", want: "RekSai"},
+		{champion: "Kai'Sa", want: "Kaisa"},
+		{champion: "Wukong", want: "MonkeyKing"},
+		{champion: "MonkeyKing", want: "MonkeyKing"},
+		{champion: "Nunu & Willump", want: "Nunu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.champion, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, dataDragonChampionSplashURL+"/"+tt.want+"_0.jpg", championImageURL(tt.champion))
+		})
+	}
+
+	assert.Empty(t, championImageURL(" "))
+}
@@ -0,0 +1,132 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// MinOddsRefreshVotingPeriodMinutes is the shortest voting period that qualifies a house wager
+// for scheduled odds refresh. Short-lived wagers (e.g. a single game) keep their opening odds.
+const MinOddsRefreshVotingPeriodMinutes = 24 * 60
+
+// HouseWagerOddsRefreshWorker periodically refreshes odds on long-running house wagers
+type HouseWagerOddsRefreshWorker struct {
+	uowFactory   UnitOfWorkFactory
+	oddsProvider OddsProvider
+}
+
+// NewHouseWagerOddsRefreshWorker creates a new house wager odds refresh worker
+func NewHouseWagerOddsRefreshWorker(uowFactory UnitOfWorkFactory, oddsProvider OddsProvider) *HouseWagerOddsRefreshWorker {
+	return &HouseWagerOddsRefreshWorker{
+		uowFactory:   uowFactory,
+		oddsProvider: oddsProvider,
+	}
+}
+
+// Job returns the scheduler job that refreshes odds every interval
+func (w *HouseWagerOddsRefreshWorker) Job(interval time.Duration) Job {
+	return Job{
+		Name:     "house-wager-odds-refresh",
+		Interval: interval,
+		Jitter:   time.Minute,
+		Run:      w.refreshAllGuilds,
+	}
+}
+
+// refreshAllGuilds refreshes odds for every guild with active wagers
+func (w *HouseWagerOddsRefreshWorker) refreshAllGuilds(ctx context.Context) error {
+	// Cross-guild query to find guilds with active wagers
+	uow := w.uowFactory.CreateForGuild(0)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	guildIDs, err := uow.GroupWagerRepository().GetGuildsWithActiveWagers(ctx)
+	uow.Rollback()
+	if err != nil {
+		return fmt.Errorf("failed to get guilds with active wagers: %w", err)
+	}
+
+	for _, guildID := range guildIDs {
+		if err := w.refreshGuild(ctx, guildID); err != nil {
+			log.Errorf("Error refreshing house wager odds for guild %d: %v", guildID, err)
+		}
+	}
+
+	return nil
+}
+
+// refreshGuild refreshes odds for each eligible house wager in a guild
+func (w *HouseWagerOddsRefreshWorker) refreshGuild(ctx context.Context, guildID int64) error {
+	uow := w.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	activeState := entities.GroupWagerStateActive
+	activeWagers, err := uow.GroupWagerRepository().GetAll(ctx, &activeState)
+	uow.Rollback()
+	if err != nil {
+		return fmt.Errorf("failed to get active wagers: %w", err)
+	}
+
+	for _, wager := range activeWagers {
+		if !isOddsRefreshEligible(wager) {
+			continue
+		}
+		if err := w.refreshWager(ctx, guildID, wager.ID); err != nil {
+			log.Errorf("Error refreshing odds for house wager %d: %v", wager.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// refreshWager fetches current odds from the provider and applies them to a single house wager
+func (w *HouseWagerOddsRefreshWorker) refreshWager(ctx context.Context, guildID, groupWagerID int64) error {
+	uow := w.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	groupWagerService := uow.Services().GroupWagerService()
+
+	detail, err := groupWagerService.GetGroupWagerDetail(ctx, groupWagerID)
+	if err != nil {
+		return fmt.Errorf("failed to get wager detail: %w", err)
+	}
+
+	// Only wagers tied to an external event have provider odds
+	if detail.Wager.ExternalRef == nil {
+		return nil
+	}
+
+	odds, err := w.oddsProvider.GetOdds(ctx, detail)
+	if err != nil {
+		return fmt.Errorf("failed to get odds from provider: %w", err)
+	}
+	if len(odds) == 0 {
+		return nil
+	}
+
+	if _, err := groupWagerService.UpdateHouseWagerOdds(ctx, groupWagerID, nil, odds); err != nil {
+		return fmt.Errorf("failed to update odds: %w", err)
+	}
+
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// isOddsRefreshEligible reports whether a wager is a long-running house wager still taking bets
+func isOddsRefreshEligible(wager *entities.GroupWager) bool {
+	return wager.IsHouseWager() &&
+		wager.VotingPeriodMinutes >= MinOddsRefreshVotingPeriodMinutes &&
+		wager.CanAcceptBets()
+}
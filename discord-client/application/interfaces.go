@@ -3,12 +3,14 @@ package application
 import (
 	"context"
 	"gambler/discord-client/application/dto"
+	"gambler/discord-client/domain/entities"
 )
 
 // PostResult contains the result of posting a message to Discord
 type PostResult struct {
 	MessageID int64
 	ChannelID int64
+	ThreadID  int64 // Discussion thread attached to the message, 0 if none was created
 }
 
 // DiscordPoster defines the interface for posting messages to Discord
@@ -22,11 +24,20 @@ type DiscordPoster interface {
 	// UpdateHouseWager updates an existing house wager message in Discord
 	UpdateHouseWager(ctx context.Context, messageID, channelID int64, dto dto.HouseWagerPostDTO) error
 
+	// PostGroupWager posts a group wager's message, rendered from the wager's current state, to the channel
+	PostGroupWager(ctx context.Context, guildID, channelID, groupWagerID int64) (*PostResult, error)
+
 	// UpdateGroupWager updates an existing group wager message in Discord
 	UpdateGroupWager(ctx context.Context, messageID, channelID int64, detail interface{}) error
 
 	// PostDailyAwards posts daily awards summary to the appropriate Discord channel
 	PostDailyAwards(ctx context.Context, dto dto.DailyAwardsPostDTO) error
+
+	// ArchiveWagerThread locks and archives the discussion thread of a closed wager
+	ArchiveWagerThread(ctx context.Context, threadID int64) error
+
+	// PinMessage pins a message in its channel
+	PinMessage(ctx context.Context, channelID, messageID int64) error
 }
 
 // WagerStateEventHandler defines the interface for handling internal wager state change events
@@ -35,6 +46,27 @@ type WagerStateEventHandler interface {
 	// HandleGroupWagerStateChange handles GroupWagerStateChangeEvent from internal service operations
 	// It fetches updated wager data, creates appropriate DTOs, and updates Discord messages
 	HandleGroupWagerStateChange(ctx context.Context, event interface{}) error
+
+	// HandleGroupWagerOddsChange handles GroupWagerOddsChangeEvent when house wager odds are updated
+	HandleGroupWagerOddsChange(ctx context.Context, event interface{}) error
+}
+
+// OddsProvider supplies current odds for house wagers backed by an external event
+type OddsProvider interface {
+	// GetOdds returns the latest odds multiplier for each option, keyed by option ID.
+	// Options missing from the result keep their current odds.
+	GetOdds(ctx context.Context, detail *entities.GroupWagerDetail) (map[int64]float64, error)
+}
+
+// ResultHandler settles the group wagers linked to an external match result
+type ResultHandler func(ctx context.Context, result entities.ExternalResult) error
+
+// ResultProvider delivers results for group wagers linked to external matches, such as esports games
+// that have no game events of their own
+type ResultProvider interface {
+	// Start begins delivering results to the handler. Push based providers keep the handler
+	// and return immediately; polling providers deliver results until ctx is cancelled.
+	Start(ctx context.Context, handler ResultHandler) error
 }
 
 // LoLEventHandler defines the interface for handling LoL game events
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"gambler/discord-client/application/dto"
 	"gambler/discord-client/domain/entities"
@@ -11,9 +13,17 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// lolTeamResultTimeout is how long a team wager waits for the rest of its players' results once the first
+// arrives. Every watched player in a game is reported within a poll or two of it ending.
+const lolTeamResultTimeout = 10 * time.Minute
+
 // LoLHandlerImpl implements the LoLEventHandler interface
 type LoLHandlerImpl struct {
 	baseHandler *BaseHouseWagerHandler
+
+	teamResultTimeout time.Duration
+	mu                sync.Mutex
+	teamResultTimers  map[int64]*time.Timer // Fallback settlements of team wagers keyed by wager ID
 }
 
 // NewLoLHandler creates a new LoL event handler
@@ -22,25 +32,68 @@ func NewLoLHandler(
 	discordPoster DiscordPoster,
 ) *LoLHandlerImpl {
 	return &LoLHandlerImpl{
-		baseHandler: NewBaseHouseWagerHandler(uowFactory, discordPoster),
+		baseHandler:       NewBaseHouseWagerHandler(uowFactory, discordPoster),
+		teamResultTimeout: lolTeamResultTimeout,
+		teamResultTimers:  make(map[int64]*time.Timer),
 	}
 }
 
-// formatQueueType converts queue type strings to user-friendly display names.
-// Returns an empty string for unknown queue types.
-// Only ranked queue types are supported to restrict wagers to competitive games.
-func formatQueueType(queueType string) string {
-	switch queueType {
-	case "RANKED_SOLO_5x5":
-		return "Ranked Solo/Duo"
-	case "RANKED_FLEX_SR":
-		return "Ranked Flex"
-	default:
-		return "" // Unknown or non-ranked queue type
+// lolQueueProfile describes how house wagers are offered for a queue type
+type lolQueueProfile struct {
+	Title           string    // Display name shown in the wager embed
+	Ranked          bool      // Non-ranked queues only create wagers in guilds that opt in
+	OddsMultipliers []float64 // Win/Loss odds, or the fallback when odds are seeded from history
+	SeedOdds        bool      // Whether to price from the summoner's past results
+}
+
+// lolQueueProfiles lists the queue types house wagers are created for. Ranked games are priced
+// from the summoner's history; ARAM, normals and custom games use presets since random
+// champions and mixed lobbies say little about the summoner's form. Clash counts as ranked
+// since teams play it for tournament standing, but uses presets since it pits premade teams.
+var lolQueueProfiles = map[string]lolQueueProfile{
+	"RANKED_SOLO_5x5": {Title: "Ranked Solo/Duo", Ranked: true, OddsMultipliers: []float64{2.0, 2.0}, SeedOdds: true},
+	"RANKED_FLEX_SR":  {Title: "Ranked Flex", Ranked: true, OddsMultipliers: []float64{1.9, 1.9}, SeedOdds: true},
+	"CLASH":           {Title: "Clash", Ranked: true, OddsMultipliers: []float64{2.0, 2.0}},
+	"ARAM_CLASH":      {Title: "ARAM Clash", Ranked: true, OddsMultipliers: []float64{1.8, 1.8}},
+	"NORMAL_DRAFT":    {Title: "Normal Draft", OddsMultipliers: []float64{1.9, 1.9}},
+	"NORMAL_BLIND":    {Title: "Normal Blind", OddsMultipliers: []float64{1.9, 1.9}},
+	"QUICKPLAY":       {Title: "Quickplay", OddsMultipliers: []float64{1.9, 1.9}},
+	"ARAM":            {Title: "ARAM", OddsMultipliers: []float64{1.8, 1.8}},
+	"CUSTOM_GAME":     {Title: "Custom Game", OddsMultipliers: []float64{2.0, 2.0}},
+}
+
+// lolQueueProfileFor returns the profile for a queue type, or false for queues wagers aren't offered on
+func lolQueueProfileFor(queueType string) (lolQueueProfile, bool) {
+	profile, ok := lolQueueProfiles[queueType]
+	return profile, ok
+}
+
+// lolGameOptions are the win/loss options of a game's house wager, with green and red bet buttons.
+// The colours are stripped from the option text, so resolution still matches "Win" and "Loss".
+var lolGameOptions = []string{
+	entities.GroupWagerOptionLine{Text: "Win", Style: entities.GroupWagerOptionStyleSuccess}.String(),
+	entities.GroupWagerOptionLine{Text: "Loss", Style: entities.GroupWagerOptionStyleDanger}.String(),
+}
+
+// lolGameCondition describes a game wager on its watched players, linking the first player's live game
+func lolGameCondition(players []*entities.HouseWagerPlayer, queueTitle string) string {
+	names := make([]string, len(players))
+	for i, player := range players {
+		names[i] = player.SummonerName
 	}
+	playerList := names[0]
+	if len(names) > 1 {
+		playerList = strings.Join(names[:len(names)-1], ", ") + " & " + names[len(names)-1]
+	}
+
+	// URL-encode the game name and tag with %20 for spaces
+	encodedGameName := strings.ReplaceAll(players[0].SummonerName, " ", "%20")
+	porofessorURL := fmt.Sprintf("https://porofessor.gg/live/na/%s-%s", encodedGameName, players[0].TagLine)
+	return fmt.Sprintf("%s - **%s**\n[Match Details](%s)", playerList, queueTitle, porofessorURL)
 }
 
-// HandleGameStarted creates house wagers when a game starts
+// HandleGameStarted creates house wagers when a game starts. Watched summoners in the same game, such as a
+// Clash team, share one wager: the first to report creates it and the rest join it.
 func (h *LoLHandlerImpl) HandleGameStarted(ctx context.Context, gameStarted dto.GameStartedDTO) error {
 	log.WithFields(log.Fields{
 		"summoner": fmt.Sprintf("%s#%s", gameStarted.SummonerName, gameStarted.TagLine),
@@ -49,8 +102,8 @@ func (h *LoLHandlerImpl) HandleGameStarted(ctx context.Context, gameStarted dto.
 	}).Info("handling Game start")
 
 	// Validate queue type - drop event if unknown
-	formattedQueue := formatQueueType(gameStarted.QueueType)
-	if formattedQueue == "" {
+	queue, ok := lolQueueProfileFor(gameStarted.QueueType)
+	if !ok {
 		log.WithFields(log.Fields{
 			"summoner": fmt.Sprintf("%s#%s", gameStarted.SummonerName, gameStarted.TagLine),
 			"gameId":   gameStarted.GameID,
@@ -79,15 +132,33 @@ func (h *LoLHandlerImpl) HandleGameStarted(ctx context.Context, gameStarted dto.
 		return nil
 	}
 
+	props := offeredLoLProps(gameStarted.PropMarkets)
+	externalRef := entities.ExternalReference{
+		System: entities.SystemLeagueOfLegends,
+		ID:     gameStarted.GameID,
+	}
+	playerCondition := func(players []*entities.HouseWagerPlayer) string {
+		return lolGameCondition(players, queue.Title)
+	}
+
 	// Create a house wager for each watching guild
-	// Currently only creating wagers for ranked games.
+	// Non-ranked games only reach guilds that opted in to them.
 	for _, guild := range guilds {
-		// Format the complete description with summoner info and Porofessor link for active wager
-		// URL-encode the game name and tag with %20 for spaces
-		encodedGameName := strings.ReplaceAll(gameStarted.SummonerName, " ", "%20")
-		porofessorURL := fmt.Sprintf("https://porofessor.gg/live/na/%s-%s", encodedGameName, gameStarted.TagLine)
-		condition := fmt.Sprintf("%s - **%s**\n[Match Details](%s)",
-			gameStarted.SummonerName, formattedQueue, porofessorURL)
+		// Join the wager a watched teammate already started; its props cover the game
+		joined, err := h.baseHandler.JoinHouseWager(ctx, guild.GuildID, externalRef, gameStarted.SummonerName, gameStarted.TagLine, playerCondition)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"guild":    guild.GuildID,
+				"summoner": fmt.Sprintf("%s#%s", gameStarted.SummonerName, gameStarted.TagLine),
+				"error":    err,
+			}).Error("Failed to join house wager for shared game")
+			continue
+		}
+		if joined {
+			continue
+		}
+
+		condition := playerCondition([]*entities.HouseWagerPlayer{{SummonerName: gameStarted.SummonerName, TagLine: gameStarted.TagLine}})
 
 		config := WagerCreationConfig{
 			ExternalSystem:      entities.SystemLeagueOfLegends,
@@ -95,13 +166,28 @@ func (h *LoLHandlerImpl) HandleGameStarted(ctx context.Context, gameStarted dto.
 			SummonerName:        gameStarted.SummonerName,
 			TagLine:             gameStarted.TagLine,
 			Condition:           condition,
-			Options:             []string{"Win", "Loss"},
-			OddsMultipliers:     []float64{2.0, 2.0}, // 2:1 odds for now
-			VotingPeriodMinutes: 5,                   // 5 minutes for betting
+			Options:             lolGameOptions,
+			OddsMultipliers:     queue.OddsMultipliers,
+			VotingPeriodMinutes: 5, // 5 minutes for betting
 			ChannelIDGetter: func(gs *entities.GuildSettings) *int64 {
 				return gs.LolChannelID
 			},
 			ChannelName: "lol-channel",
+			OptionCapGetter: func(gs *entities.GuildSettings) *int64 {
+				return gs.HouseOptionCap
+			},
+			ThumbnailURL: queueIconURL(gameStarted.QueueType),
+			RecordPlayer: true,
+		}
+		if !queue.Ranked {
+			config.GuildFilter = func(gs *entities.GuildSettings) bool {
+				return gs.LolNonRankedWagers
+			}
+		}
+		if queue.SeedOdds {
+			config.OddsSeeder = func(ctx context.Context, services ServiceFactory) ([]float64, error) {
+				return services.OddsEngine().LoLWinLossOdds(ctx, gameStarted.SummonerName)
+			}
 		}
 
 		if err := h.baseHandler.CreateHouseWagerForGuild(ctx, guild, config); err != nil {
@@ -111,6 +197,27 @@ func (h *LoLHandlerImpl) HandleGameStarted(ctx context.Context, gameStarted dto.
 				"error":    err,
 			}).Error("Failed to create house wager for guild")
 			// Continue with other guilds
+			continue
+		}
+
+		// Offer the props the tracker will report results for, each with its own external ID
+		for _, prop := range props {
+			propConfig := config
+			propConfig.GameID = prop.propGameID(gameStarted.GameID)
+			propConfig.Condition = prop.condition(gameStarted.SummonerName)
+			propConfig.Options = prop.options
+			propConfig.OddsMultipliers = prop.oddsMultipliers
+			propConfig.OddsSeeder = nil
+			propConfig.RecordPlayer = false
+
+			if err := h.baseHandler.CreateHouseWagerForGuild(ctx, guild, propConfig); err != nil {
+				log.WithFields(log.Fields{
+					"guild":    guild.GuildID,
+					"summoner": fmt.Sprintf("%s#%s", gameStarted.SummonerName, gameStarted.TagLine),
+					"prop":     prop.market,
+					"error":    err,
+				}).Error("Failed to create prop house wager for guild")
+			}
 		}
 	}
 
@@ -145,97 +252,331 @@ func (h *LoLHandlerImpl) HandleGameEnded(ctx context.Context, gameEnded dto.Game
 		return nil
 	}
 
-	// Create external reference for this game
-	externalRef := entities.ExternalReference{
-		System: entities.SystemLeagueOfLegends,
-		ID:     gameEnded.GameID,
+	// LoL winner selector
+	lolWinnerSelector := func(options []entities.GroupWagerOption, result interface{}) int64 {
+		gameResult := result.(dto.GameEndedDTO)
+		for _, opt := range options {
+			if (gameResult.Won && opt.OptionText == "Win") || (!gameResult.Won && opt.OptionText == "Loss") {
+				return opt.ID
+			}
+		}
+		return 0
+	}
+
+	// The win/loss wager and every prop share the forfeit rule; a prop whose data is
+	// missing from the result is refunded rather than left open
+	type gameWager struct {
+		gameID     string
+		config     WagerResolutionConfig
+		teamResult bool // Settled by the shared result of every watched player in the game
+	}
+	forfeitThreshold := int32(600) // 10 minutes
+	championImage := championImageURL(gameEnded.ChampionPlayed)
+	wagers := []gameWager{
+		{
+			gameID: gameEnded.GameID,
+			config: WagerResolutionConfig{
+				ExternalSystem:        entities.SystemLeagueOfLegends,
+				WinnerSelector:        lolWinnerSelector,
+				GameResult:            gameEnded,
+				CancellationThreshold: &forfeitThreshold,
+				ImageURL:              championImage,
+			},
+			teamResult: true,
+		},
+	}
+	for _, prop := range lolProps {
+		wagers = append(wagers, gameWager{
+			gameID: prop.propGameID(gameEnded.GameID),
+			config: WagerResolutionConfig{
+				ExternalSystem:        entities.SystemLeagueOfLegends,
+				WinnerSelector:        prop.selectWinner,
+				GameResult:            gameEnded,
+				CancellationThreshold: &forfeitThreshold,
+				CancelIfUndetermined:  true,
+				ImageURL:              championImage,
+			},
+		})
+	}
+
+	// A finished game moves the summoner's win streak; remakes don't count either way, so an open
+	// streak wager waits for the next real game
+	var previousStreak, currentStreak *entities.SummonerStreak
+	if gameEnded.DurationSeconds >= forfeitThreshold {
+		previousStreak, currentStreak, err = tempUow.SummonerWatchRepository().RecordGameResult(ctx, gameEnded.SummonerName, gameEnded.TagLine, gameEnded.GameID, gameEnded.Won)
+		if err != nil {
+			return fmt.Errorf("failed to record game result: %w", err)
+		}
+		if err := tempUow.Commit(); err != nil {
+			return fmt.Errorf("failed to commit game result: %w", err)
+		}
+	}
+
+	// This game settles the wager on extending the streak it continued or broke
+	if previousStreak != nil && previousStreak.HasWager() {
+		wagers = append(wagers, gameWager{
+			gameID: previousStreak.WagerGameID(),
+			config: WagerResolutionConfig{
+				ExternalSystem: entities.SystemLeagueOfLegends,
+				WinnerSelector: selectLoLStreakWinner,
+				GameResult:     gameEnded,
+			},
+		})
 	}
 
 	// Look up and resolve wagers for each guild
 	resolvedCount := 0
 	for _, guild := range guilds {
-		// Create a scoped UoW for this guild to query wagers
-		guildUow := h.baseHandler.uowFactory.CreateForGuild(guild.GuildID)
-		if err := guildUow.Begin(ctx); err != nil {
-			log.WithFields(log.Fields{
-				"guild": guild.GuildID,
-				"error": err,
-			}).Error("Failed to begin transaction for guild")
-			continue
+		for _, w := range wagers {
+			externalRef := entities.ExternalReference{
+				System: entities.SystemLeagueOfLegends,
+				ID:     w.gameID,
+			}
+			if h.resolveGuildWager(ctx, guild.GuildID, externalRef, w.config, w.teamResult) {
+				resolvedCount++
+			}
 		}
+	}
 
-		// Find the wager for this game in this guild
-		log.WithFields(log.Fields{
-			"guild":          guild.GuildID,
-			"gameId":         gameEnded.GameID,
-			"externalSystem": externalRef.System,
-		}).Debug("Looking up wager by external reference")
+	log.WithFields(log.Fields{
+		"gameId":        gameEnded.GameID,
+		"resolvedCount": resolvedCount,
+		"totalGuilds":   len(guilds),
+	}).Info("Completed resolving house wagers for game")
 
-		wager, err := guildUow.GroupWagerRepository().GetByExternalReference(ctx, externalRef)
-		if err != nil {
+	if currentStreak != nil && currentStreak.HasWager() {
+		h.createStreakWagers(ctx, guilds, gameEnded, currentStreak)
+	}
+
+	return nil
+}
+
+// createStreakWagers offers each watching guild a wager on whether the summoner extends their win streak
+func (h *LoLHandlerImpl) createStreakWagers(ctx context.Context, guilds []*entities.GuildSummonerWatch, gameEnded dto.GameEndedDTO, streak *entities.SummonerStreak) {
+	config := WagerCreationConfig{
+		ExternalSystem:      entities.SystemLeagueOfLegends,
+		GameID:              streak.WagerGameID(),
+		SummonerName:        gameEnded.SummonerName,
+		TagLine:             gameEnded.TagLine,
+		Condition:           lolStreakCondition(gameEnded.SummonerName, streak.Length),
+		Options:             lolStreakOptions,
+		OddsMultipliers:     lolStreakOddsMultipliers(streak.Length),
+		VotingPeriodMinutes: 5,
+		ChannelIDGetter: func(gs *entities.GuildSettings) *int64 {
+			return gs.LolChannelID
+		},
+		ChannelName: "lol-channel",
+		OptionCapGetter: func(gs *entities.GuildSettings) *int64 {
+			return gs.HouseOptionCap
+		},
+		ThumbnailURL: queueIconURL(gameEnded.QueueType),
+	}
+
+	for _, guild := range guilds {
+		if err := h.baseHandler.CreateHouseWagerForGuild(ctx, guild, config); err != nil {
 			log.WithFields(log.Fields{
-				"guild":  guild.GuildID,
-				"gameId": gameEnded.GameID,
-				"error":  err,
-			}).Error("Failed to query wager by external reference")
-			guildUow.Rollback()
-			continue
+				"guild":    guild.GuildID,
+				"summoner": fmt.Sprintf("%s#%s", gameEnded.SummonerName, gameEnded.TagLine),
+				"streak":   streak.Length,
+				"error":    err,
+			}).Error("Failed to create streak house wager for guild")
 		}
+	}
+}
 
-		if wager == nil {
-			log.WithFields(log.Fields{
-				"guild":  guild.GuildID,
-				"gameId": gameEnded.GameID,
-			}).Debug("No wager found for this game in guild")
-			guildUow.Rollback()
-			continue
+// resolveGuildWager resolves the guild's wager for an external reference, if it has one. A team result wager
+// waits for every watched player's result. Returns true if a wager was resolved or cancelled.
+func (h *LoLHandlerImpl) resolveGuildWager(ctx context.Context, guildID int64, externalRef entities.ExternalReference, config WagerResolutionConfig, teamResult bool) bool {
+	// Create a scoped UoW for this guild to query wagers
+	guildUow := h.baseHandler.uowFactory.CreateForGuild(guildID)
+	if err := guildUow.Begin(ctx); err != nil {
+		log.WithFields(log.Fields{
+			"guild": guildID,
+			"error": err,
+		}).Error("Failed to begin transaction for guild")
+		return false
+	}
+
+	// Find the wager for this game in this guild
+	log.WithFields(log.Fields{
+		"guild":          guildID,
+		"gameId":         externalRef.ID,
+		"externalSystem": externalRef.System,
+	}).Debug("Looking up wager by external reference")
+
+	wager, err := guildUow.GroupWagerRepository().GetByExternalReference(ctx, externalRef)
+	guildUow.Rollback() // Close the query transaction
+	if err != nil {
+		log.WithFields(log.Fields{
+			"guild":  guildID,
+			"gameId": externalRef.ID,
+			"error":  err,
+		}).Error("Failed to query wager by external reference")
+		return false
+	}
+
+	if wager == nil {
+		log.WithFields(log.Fields{
+			"guild":  guildID,
+			"gameId": externalRef.ID,
+		}).Debug("No wager found for this game in guild")
+		return false
+	}
+
+	log.WithFields(log.Fields{
+		"guild":   guildID,
+		"gameId":  externalRef.ID,
+		"wagerID": wager.ID,
+	}).Debug("Found wager for external reference")
+
+	if teamResult {
+		var ready bool
+		config, ready = h.teamResultConfig(ctx, guildID, wager.ID, config)
+		if !ready {
+			return false
 		}
+	}
 
+	// Resolve the wager
+	if err := h.baseHandler.ResolveHouseWager(ctx, guildID, wager.ID, config); err != nil {
 		log.WithFields(log.Fields{
-			"guild":   guild.GuildID,
-			"gameId":  gameEnded.GameID,
+			"guild":   guildID,
 			"wagerID": wager.ID,
-		}).Debug("Found wager for external reference")
+			"error":   err,
+		}).Error("Failed to resolve house wager")
+		return false
+	}
+	return true
+}
 
-		guildUow.Rollback() // Close the query transaction
+// teamResultConfig records the reporting player's result on a game wager and settles it on the result its
+// players share. Returns false while teammates' results are still to come; if they don't arrive in time the
+// wager settles on the results it has. Wagers without recorded players settle on the report as is.
+func (h *LoLHandlerImpl) teamResultConfig(ctx context.Context, guildID, wagerID int64, config WagerResolutionConfig) (WagerResolutionConfig, bool) {
+	gameEnded := config.GameResult.(dto.GameEndedDTO)
+	fields := log.Fields{
+		"guild":    guildID,
+		"wagerID":  wagerID,
+		"summoner": fmt.Sprintf("%s#%s", gameEnded.SummonerName, gameEnded.TagLine),
+	}
 
-		// LoL winner selector
-		lolWinnerSelector := func(options []entities.GroupWagerOption, result interface{}) int64 {
-			gameResult := result.(dto.GameEndedDTO)
-			for _, opt := range options {
-				if (gameResult.Won && opt.OptionText == "Win") || (!gameResult.Won && opt.OptionText == "Loss") {
-					return opt.ID
-				}
-			}
-			return 0
-		}
+	uow := h.baseHandler.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.WithFields(fields).WithError(err).Error("Failed to begin transaction for guild")
+		return config, false
+	}
+	defer uow.Rollback()
 
-		forfeitThreshold := int32(600) // 10 minutes
-		config := WagerResolutionConfig{
-			ExternalSystem:        entities.SystemLeagueOfLegends,
-			WinnerSelector:        lolWinnerSelector,
-			GameResult:            gameEnded,
-			CancellationThreshold: &forfeitThreshold,
-		}
+	if err := uow.GroupWagerRepository().RecordHousePlayerResult(ctx, wagerID, gameEnded.SummonerName, gameEnded.TagLine, gameEnded.Won); err != nil {
+		log.WithFields(fields).WithError(err).Error("Failed to record house wager player result")
+		return config, false
+	}
+	players, err := uow.GroupWagerRepository().GetHousePlayers(ctx, wagerID)
+	if err != nil {
+		log.WithFields(fields).WithError(err).Error("Failed to get house wager players")
+		return config, false
+	}
+	if err := uow.Commit(); err != nil {
+		log.WithFields(fields).WithError(err).Error("Failed to commit house wager player result")
+		return config, false
+	}
 
-		// Resolve the wager
-		if err := h.baseHandler.ResolveHouseWager(ctx, guild.GuildID, wager.ID, config); err != nil {
-			log.WithFields(log.Fields{
-				"guild":   guild.GuildID,
-				"wagerID": wager.ID,
-				"error":   err,
-			}).Error("Failed to resolve house wager")
-			// Continue with other guilds
-		} else {
-			resolvedCount++
-		}
+	if len(players) == 0 {
+		return config, true
 	}
 
-	log.WithFields(log.Fields{
-		"gameId":        gameEnded.GameID,
-		"resolvedCount": resolvedCount,
-		"totalGuilds":   len(guilds),
-	}).Info("Completed resolving house wagers for game")
+	outcome := entities.SharedTeamOutcome(players)
+	if outcome == entities.HouseWagerTeamPending {
+		log.WithFields(fields).Info("Waiting for teammates' results before resolving house wager")
+		h.scheduleTeamResultFallback(guildID, wagerID, config)
+		return config, false
+	}
+	h.stopTeamResultFallback(wagerID)
+	return withTeamOutcome(config, outcome), true
+}
 
-	return nil
+// withTeamOutcome settles a game wager on its players' team outcome. Players on opposing teams have no
+// shared result, so their wager is refunded.
+func withTeamOutcome(config WagerResolutionConfig, outcome entities.HouseWagerTeamOutcome) WagerResolutionConfig {
+	gameEnded := config.GameResult.(dto.GameEndedDTO)
+	switch outcome {
+	case entities.HouseWagerTeamSplit:
+		config.CancelReason = "watched players were on opposing teams"
+	case entities.HouseWagerTeamWon:
+		gameEnded.Won = true
+		config.GameResult = gameEnded
+	case entities.HouseWagerTeamLost:
+		gameEnded.Won = false
+		config.GameResult = gameEnded
+	}
+	return config
+}
+
+// scheduleTeamResultFallback settles a team wager on the results reported so far if the rest haven't arrived
+// by the timeout. The first report arms it; later reports leave it running.
+func (h *LoLHandlerImpl) scheduleTeamResultFallback(guildID, wagerID int64, config WagerResolutionConfig) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, scheduled := h.teamResultTimers[wagerID]; scheduled {
+		return
+	}
+	h.teamResultTimers[wagerID] = time.AfterFunc(h.teamResultTimeout, func() {
+		h.mu.Lock()
+		delete(h.teamResultTimers, wagerID)
+		h.mu.Unlock()
+
+		h.settleOnReportedResults(context.Background(), guildID, wagerID, config)
+	})
+}
+
+// stopTeamResultFallback cancels a team wager's fallback once every player's result has arrived
+func (h *LoLHandlerImpl) stopTeamResultFallback(wagerID int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if timer, scheduled := h.teamResultTimers[wagerID]; scheduled {
+		timer.Stop()
+		delete(h.teamResultTimers, wagerID)
+	}
+}
+
+// settleOnReportedResults settles a team wager whose players' results didn't all arrive on the results that
+// did. A wager settled or cancelled in the meantime is left alone.
+func (h *LoLHandlerImpl) settleOnReportedResults(ctx context.Context, guildID, wagerID int64, config WagerResolutionConfig) {
+	fields := log.Fields{
+		"guild":   guildID,
+		"wagerID": wagerID,
+	}
+
+	uow := h.baseHandler.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.WithFields(fields).WithError(err).Error("Failed to begin transaction for guild")
+		return
+	}
+	wager, err := uow.GroupWagerRepository().GetByID(ctx, wagerID)
+	if err != nil {
+		uow.Rollback()
+		log.WithFields(fields).WithError(err).Error("Failed to get house wager")
+		return
+	}
+	players, err := uow.GroupWagerRepository().GetHousePlayers(ctx, wagerID)
+	uow.Rollback()
+	if err != nil {
+		log.WithFields(fields).WithError(err).Error("Failed to get house wager players")
+		return
+	}
+
+	if wager == nil || wager.IsResolved() || wager.IsCancelled() {
+		return
+	}
+	outcome := entities.ReportedTeamOutcome(players)
+	if outcome == entities.HouseWagerTeamPending {
+		return
+	}
+
+	log.WithFields(fields).Warn("Teammates' results never arrived, settling house wager on the results reported")
+	if err := h.baseHandler.ResolveHouseWager(ctx, guildID, wagerID, withTeamOutcome(config, outcome)); err != nil {
+		log.WithFields(fields).WithError(err).Error("Failed to resolve house wager")
+	}
 }
@@ -2,6 +2,7 @@ package application_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"gambler/discord-client/application"
@@ -433,7 +434,134 @@ func TestLoLHandler_UnknownQueueType_DropsEvent(t *testing.T) {
 	assert.Nil(t, wager) // Wager should not exist for unknown queue type
 }
 
+func TestLoLHandler_NonRankedQueue_RequiresOptIn(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDatabase(t)
+	defer testDB.Cleanup(t)
+
+	uowFactory := infrastructure.NewUnitOfWorkFactory(testDB.DB, infrastructure.NewNoopEventPublisher())
+
+	ctx := context.Background()
+	guildID := int64(88888)
+	summonerName := "AramPlayer"
+	tagLine := "NA1"
+
+	setupTestData(t, ctx, uowFactory, guildID, summonerName, tagLine)
+
+	mockPoster := &application.MockDiscordPoster{}
+	handler := application.NewLoLHandler(uowFactory, mockPoster)
+
+	// ARAM games are dropped until the guild opts in to non-ranked wagers
+	err := handler.HandleGameStarted(ctx, dto.GameStartedDTO{
+		SummonerName: summonerName,
+		TagLine:      tagLine,
+		GameID:       "test-game-aram-1",
+		QueueType:    "ARAM",
+	})
+	require.NoError(t, err)
+	assert.Len(t, mockPoster.Posts, 0)
+
+	uow := uowFactory.CreateForGuild(guildID)
+	require.NoError(t, uow.Begin(ctx))
+	require.NoError(t, uow.Services().GuildSettingsService().UpdateLolNonRankedWagers(ctx, guildID, true))
+	require.NoError(t, uow.Commit())
+
+	err = handler.HandleGameStarted(ctx, dto.GameStartedDTO{
+		SummonerName: summonerName,
+		TagLine:      tagLine,
+		GameID:       "test-game-aram-2",
+		QueueType:    "ARAM",
+	})
+	require.NoError(t, err)
+	require.Len(t, mockPoster.Posts, 1)
+	assert.Contains(t, mockPoster.Posts[0].Title, "ARAM")
+	require.Len(t, mockPoster.Posts[0].Options, 2)
+	assert.Equal(t, 1.8, mockPoster.Posts[0].Options[0].Multiplier)
+}
+
 // setupTestData creates the necessary guild settings and summoner watch for testing
+func TestLoLHandler_SharedGame(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDatabase(t)
+	defer testDB.Cleanup(t)
+
+	noopPublisher := infrastructure.NewNoopEventPublisher()
+	uowFactory := infrastructure.NewUnitOfWorkFactory(testDB.DB, noopPublisher)
+
+	ctx := context.Background()
+	guildID := int64(77777)
+	setupTestData(t, ctx, uowFactory, guildID, "ClashTop", "NA1")
+	setupTestData(t, ctx, uowFactory, guildID, "ClashMid", "NA1")
+
+	mockPoster := &application.MockDiscordPoster{}
+	handler := application.NewLoLHandler(uowFactory, mockPoster)
+
+	getWager := func(t *testing.T, gameID string) *entities.GroupWager {
+		uow := uowFactory.CreateForGuild(guildID)
+		require.NoError(t, uow.Begin(ctx))
+		defer uow.Rollback()
+
+		wager, err := uow.GroupWagerRepository().GetByExternalReference(ctx, entities.ExternalReference{
+			System: entities.SystemLeagueOfLegends,
+			ID:     gameID,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, wager)
+		return wager
+	}
+
+	startGame := func(t *testing.T, gameID string) {
+		for _, summoner := range []string{"ClashTop", "ClashMid"} {
+			require.NoError(t, handler.HandleGameStarted(ctx, dto.GameStartedDTO{
+				SummonerName: summoner,
+				TagLine:      "NA1",
+				GameID:       gameID,
+				QueueType:    "CLASH",
+			}))
+		}
+	}
+
+	endGame := func(t *testing.T, gameID, summoner string, won bool) {
+		require.NoError(t, handler.HandleGameEnded(ctx, dto.GameEndedDTO{
+			SummonerName:    summoner,
+			TagLine:         "NA1",
+			GameID:          gameID,
+			Won:             won,
+			DurationSeconds: 1800,
+		}))
+	}
+
+	t.Run("teammates share one wager settled by their team", func(t *testing.T) {
+		startGame(t, "clash-game-1")
+
+		assert.Len(t, mockPoster.Posts, 1, "the second summoner joins rather than posting again")
+		wager := getWager(t, "clash-game-1")
+		assert.True(t, strings.HasPrefix(wager.Condition, "ClashTop & ClashMid - **Clash**"))
+
+		endGame(t, "clash-game-1", "ClashTop", true)
+		assert.Equal(t, entities.GroupWagerStateActive, getWager(t, "clash-game-1").State, "waits for every player's result")
+
+		endGame(t, "clash-game-1", "ClashMid", true)
+		assert.Equal(t, entities.GroupWagerStateResolved, getWager(t, "clash-game-1").State)
+	})
+
+	t.Run("opposing players refund the wager", func(t *testing.T) {
+		startGame(t, "clash-game-2")
+
+		endGame(t, "clash-game-2", "ClashTop", true)
+		endGame(t, "clash-game-2", "ClashMid", false)
+		assert.Equal(t, entities.GroupWagerStateCancelled, getWager(t, "clash-game-2").State)
+	})
+}
+
 func setupTestData(t *testing.T, ctx context.Context, uowFactory application.UnitOfWorkFactory, guildID int64, summonerName, tagLine string) {
 	uow := uowFactory.CreateForGuild(guildID)
 	require.NoError(t, uow.Begin(ctx))
@@ -541,3 +669,70 @@ func TestLoLHandler_ForfeitRemake(t *testing.T) {
 	require.NotNil(t, wager)
 	assert.Equal(t, entities.GroupWagerStateCancelled, wager.State)
 }
+
+func TestLoLHandler_WinStreakWager(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDatabase(t)
+	defer testDB.Cleanup(t)
+
+	uowFactory := infrastructure.NewUnitOfWorkFactory(testDB.DB, infrastructure.NewNoopEventPublisher())
+
+	ctx := context.Background()
+	guildID := int64(88888)
+	summonerName := "StreakPlayer"
+	tagLine := "NA1"
+	setupTestData(t, ctx, uowFactory, guildID, summonerName, tagLine)
+
+	mockPoster := &application.MockDiscordPoster{}
+	handler := application.NewLoLHandler(uowFactory, mockPoster)
+
+	endGame := func(gameID string, won bool, durationSeconds int32) {
+		err := handler.HandleGameEnded(ctx, dto.GameEndedDTO{
+			SummonerName:    summonerName,
+			TagLine:         tagLine,
+			GameID:          gameID,
+			Won:             won,
+			DurationSeconds: durationSeconds,
+		})
+		require.NoError(t, err)
+	}
+
+	getWager := func(externalID string) *entities.GroupWager {
+		uow := uowFactory.CreateForGuild(guildID)
+		require.NoError(t, uow.Begin(ctx))
+		defer uow.Rollback()
+
+		wager, err := uow.GroupWagerRepository().GetByExternalReference(ctx, entities.ExternalReference{
+			System: entities.SystemLeagueOfLegends,
+			ID:     externalID,
+		})
+		require.NoError(t, err)
+		return wager
+	}
+
+	endGame("streak-1", true, 1800)
+	endGame("streak-2", true, 1800)
+	assert.Empty(t, mockPoster.Posts, "two wins are not a streak yet")
+
+	endGame("streak-3", true, 1800)
+	endGame("streak-3", true, 1800) // A repeated result must not offer the wager twice
+	require.Len(t, mockPoster.Posts, 1)
+	assert.Contains(t, mockPoster.Posts[0].Title, summonerName)
+
+	streakWager := getWager("streak-1:streak:3")
+	require.NotNil(t, streakWager)
+	assert.Equal(t, entities.GroupWagerStateActive, streakWager.State)
+
+	// A remake neither extends nor breaks the streak
+	endGame("streak-remake", false, 300)
+	assert.Equal(t, entities.GroupWagerStateActive, getWager("streak-1:streak:3").State)
+
+	// The next real game settles the wager and the streak ends
+	endGame("streak-4", false, 1800)
+	assert.Equal(t, entities.GroupWagerStateResolved, getWager("streak-1:streak:3").State)
+	assert.Len(t, mockPoster.Posts, 1, "a broken streak offers no new wager")
+}
@@ -0,0 +1,99 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"gambler/discord-client/application/dto"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoLQueueProfileFor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		queueType string
+		supported bool
+		title     string
+		ranked    bool
+		seedOdds  bool
+		odds      []float64
+	}{
+		{queueType: "RANKED_SOLO_5x5", supported: true, title: "Ranked Solo/Duo", ranked: true, seedOdds: true, odds: []float64{2.0, 2.0}},
+		{queueType: "RANKED_FLEX_SR", supported: true, title: "Ranked Flex", ranked: true, seedOdds: true, odds: []float64{1.9, 1.9}},
+		{queueType: "ARAM", supported: true, title: "ARAM", odds: []float64{1.8, 1.8}},
+		{queueType: "NORMAL_DRAFT", supported: true, title: "Normal Draft", odds: []float64{1.9, 1.9}},
+		{queueType: "CUSTOM_GAME", supported: true, title: "Custom Game", odds: []float64{2.0, 2.0}},
+		{queueType: "CLASH", supported: true, title: "Clash", ranked: true, odds: []float64{2.0, 2.0}},
+		{queueType: "URF", supported: false},
+		{queueType: "", supported: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.queueType, func(t *testing.T) {
+			profile, ok := lolQueueProfileFor(tt.queueType)
+			assert.Equal(t, tt.supported, ok)
+			if !tt.supported {
+				return
+			}
+			assert.Equal(t, tt.title, profile.Title)
+			assert.Equal(t, tt.ranked, profile.Ranked)
+			assert.Equal(t, tt.seedOdds, profile.SeedOdds)
+			assert.Equal(t, tt.odds, profile.OddsMultipliers)
+		})
+	}
+}
+
+func TestLoLGameCondition(t *testing.T) {
+	t.Parallel()
+
+	players := []*entities.HouseWagerPlayer{
+		{SummonerName: "Top Laner", TagLine: "NA1"},
+		{SummonerName: "Jungler", TagLine: "NA2"},
+		{SummonerName: "Support", TagLine: "NA3"},
+	}
+
+	assert.Equal(t, "Top Laner - **Clash**\n[Match Details](https://porofessor.gg/live/na/Top%20Laner-NA1)",
+		lolGameCondition(players[:1], "Clash"))
+	assert.Equal(t, "Top Laner & Jungler - **Clash**\n[Match Details](https://porofessor.gg/live/na/Top%20Laner-NA1)",
+		lolGameCondition(players[:2], "Clash"))
+	assert.Equal(t, "Top Laner, Jungler & Support - **Clash**\n[Match Details](https://porofessor.gg/live/na/Top%20Laner-NA1)",
+		lolGameCondition(players, "Clash"))
+}
+
+func TestWithTeamOutcome(t *testing.T) {
+	t.Parallel()
+
+	config := WagerResolutionConfig{GameResult: dto.GameEndedDTO{SummonerName: "Top Laner", Won: false}}
+
+	won := withTeamOutcome(config, entities.HouseWagerTeamWon)
+	assert.True(t, won.GameResult.(dto.GameEndedDTO).Won)
+	assert.Empty(t, won.CancelReason)
+
+	lost := withTeamOutcome(WagerResolutionConfig{GameResult: dto.GameEndedDTO{Won: true}}, entities.HouseWagerTeamLost)
+	assert.False(t, lost.GameResult.(dto.GameEndedDTO).Won)
+
+	split := withTeamOutcome(config, entities.HouseWagerTeamSplit)
+	assert.Equal(t, "watched players were on opposing teams", split.CancelReason)
+}
+
+func TestLoLHandler_TeamResultFallback(t *testing.T) {
+	t.Parallel()
+
+	handler := &LoLHandlerImpl{
+		teamResultTimeout: time.Hour,
+		teamResultTimers:  make(map[int64]*time.Timer),
+	}
+	config := WagerResolutionConfig{GameResult: dto.GameEndedDTO{}}
+
+	handler.scheduleTeamResultFallback(1, 10, config)
+	first := handler.teamResultTimers[10]
+	handler.scheduleTeamResultFallback(1, 10, config)
+	assert.Same(t, first, handler.teamResultTimers[10], "a later report leaves the first fallback running")
+
+	handler.stopTeamResultFallback(10)
+	assert.NotContains(t, handler.teamResultTimers, int64(10))
+	assert.False(t, first.Stop(), "the fallback was already stopped")
+}
@@ -0,0 +1,92 @@
+package application
+
+import (
+	"fmt"
+
+	"gambler/discord-client/application/dto"
+	"gambler/discord-client/domain/entities"
+)
+
+// LoLDurationPropLineMinutes is the game length the duration prop is set at
+const LoLDurationPropLineMinutes = 30
+
+// lolProp describes an extra house wager offered alongside the win/loss wager of a LoL game
+type lolProp struct {
+	market          dto.LoLPropMarket
+	suffix          string // Appended to the game ID to give the prop its own external reference
+	condition       func(summonerName string) string
+	options         []string
+	oddsMultipliers []float64
+	// winner returns the index of the winning option, or false when the result lacks the data
+	winner func(result dto.GameEndedDTO) (int, bool)
+}
+
+// lolProps lists every prop the handler can offer, in the order they are posted
+var lolProps = []lolProp{
+	{
+		market: dto.LoLPropGameDuration,
+		suffix: "duration",
+		condition: func(summonerName string) string {
+			return fmt.Sprintf("%s - **Game lasts over %d minutes?**", summonerName, LoLDurationPropLineMinutes)
+		},
+		options:         []string{fmt.Sprintf("Over %d min", LoLDurationPropLineMinutes), fmt.Sprintf("Under %d min", LoLDurationPropLineMinutes)},
+		oddsMultipliers: []float64{2.0, 2.0},
+		winner: func(result dto.GameEndedDTO) (int, bool) {
+			if result.DurationSeconds >= LoLDurationPropLineMinutes*60 {
+				return 0, true
+			}
+			return 1, true
+		},
+	},
+	{
+		market: dto.LoLPropFirstBlood,
+		suffix: "first_blood",
+		condition: func(summonerName string) string {
+			return fmt.Sprintf("%s - **Team draws first blood?**", summonerName)
+		},
+		options:         []string{"Yes", "No"},
+		oddsMultipliers: []float64{2.0, 2.0},
+		winner: func(result dto.GameEndedDTO) (int, bool) {
+			if result.TeamFirstBlood == nil {
+				return 0, false
+			}
+			if *result.TeamFirstBlood {
+				return 0, true
+			}
+			return 1, true
+		},
+	},
+}
+
+// propGameID returns the external ID of a prop wager for a game
+func (p lolProp) propGameID(gameID string) string {
+	return fmt.Sprintf("%s:%s", gameID, p.suffix)
+}
+
+// offeredLoLProps returns the props whose data the tracker promised for the game
+func offeredLoLProps(markets []dto.LoLPropMarket) []lolProp {
+	var offered []lolProp
+	for _, prop := range lolProps {
+		for _, market := range markets {
+			if prop.market == market {
+				offered = append(offered, prop)
+				break
+			}
+		}
+	}
+	return offered
+}
+
+// selectWinner maps the prop's winning option to the wager's option ID by its text
+func (p lolProp) selectWinner(options []entities.GroupWagerOption, result interface{}) int64 {
+	index, ok := p.winner(result.(dto.GameEndedDTO))
+	if !ok {
+		return 0
+	}
+	for _, opt := range options {
+		if opt.OptionText == p.options[index] {
+			return opt.ID
+		}
+	}
+	return 0
+}
@@ -0,0 +1,67 @@
+package application
+
+import (
+	"testing"
+
+	"gambler/discord-client/application/dto"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lolPropFor(t *testing.T, market dto.LoLPropMarket) lolProp {
+	t.Helper()
+	props := offeredLoLProps([]dto.LoLPropMarket{market})
+	require.Len(t, props, 1)
+	return props[0]
+}
+
+func propOptions(prop lolProp) []entities.GroupWagerOption {
+	options := make([]entities.GroupWagerOption, len(prop.options))
+	for i, text := range prop.options {
+		options[i] = entities.GroupWagerOption{ID: int64(i + 1), OptionText: text, OptionOrder: int16(i)}
+	}
+	return options
+}
+
+func TestOfferedLoLProps(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, offeredLoLProps(nil))
+
+	props := offeredLoLProps([]dto.LoLPropMarket{dto.LoLPropFirstBlood, dto.LoLPropGameDuration})
+	require.Len(t, props, 2)
+	// Props are posted in a fixed order regardless of how the tracker lists them
+	assert.Equal(t, dto.LoLPropGameDuration, props[0].market)
+	assert.Equal(t, "123:duration", props[0].propGameID("123"))
+	assert.Equal(t, "123:first_blood", props[1].propGameID("123"))
+}
+
+func TestLoLProp_SelectWinner(t *testing.T) {
+	t.Parallel()
+
+	duration := lolPropFor(t, dto.LoLPropGameDuration)
+	firstBlood := lolPropFor(t, dto.LoLPropFirstBlood)
+	yes, no := true, false
+
+	tests := []struct {
+		name   string
+		prop   lolProp
+		result dto.GameEndedDTO
+		want   int64
+	}{
+		{name: "long game is over", prop: duration, result: dto.GameEndedDTO{DurationSeconds: LoLDurationPropLineMinutes * 60}, want: 1},
+		{name: "short game is under", prop: duration, result: dto.GameEndedDTO{DurationSeconds: LoLDurationPropLineMinutes*60 - 1}, want: 2},
+		{name: "team drew first blood", prop: firstBlood, result: dto.GameEndedDTO{TeamFirstBlood: &yes}, want: 1},
+		{name: "enemy drew first blood", prop: firstBlood, result: dto.GameEndedDTO{TeamFirstBlood: &no}, want: 2},
+		{name: "first blood not reported", prop: firstBlood, result: dto.GameEndedDTO{}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.prop.selectWinner(propOptions(tt.prop), tt.result))
+		})
+	}
+}
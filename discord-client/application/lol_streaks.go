@@ -0,0 +1,44 @@
+package application
+
+import (
+	"fmt"
+
+	"gambler/discord-client/application/dto"
+	"gambler/discord-client/domain/entities"
+)
+
+// Streak wager odds: extending gets harder as the streak grows, so the payout for "Yes" climbs with it
+const (
+	lolStreakBaseYesOdds    = 2.0
+	lolStreakYesOddsPerGame = 0.25
+	lolStreakMaxYesOdds     = 4.0
+	lolStreakNoOdds         = 1.8
+)
+
+// lolStreakOptions are the options of a streak wager, in the order lolStreakOddsMultipliers prices them
+var lolStreakOptions = []string{"Yes", "No"}
+
+// lolStreakOddsMultipliers prices a streak wager for a streak of the given length
+func lolStreakOddsMultipliers(length int) []float64 {
+	yes := lolStreakBaseYesOdds + lolStreakYesOddsPerGame*float64(length-entities.MinWinStreakForWager)
+	return []float64{min(yes, lolStreakMaxYesOdds), lolStreakNoOdds}
+}
+
+// lolStreakCondition describes the streak wager for a summoner
+func lolStreakCondition(summonerName string, length int) string {
+	return fmt.Sprintf("%s - **🔥 %d game winstreak! Will they extend the streak?**", summonerName, length)
+}
+
+// selectLoLStreakWinner resolves a streak wager with the result of the summoner's next game
+func selectLoLStreakWinner(options []entities.GroupWagerOption, result interface{}) int64 {
+	winner := lolStreakOptions[1]
+	if result.(dto.GameEndedDTO).Won {
+		winner = lolStreakOptions[0]
+	}
+	for _, opt := range options {
+		if opt.OptionText == winner {
+			return opt.ID
+		}
+	}
+	return 0
+}
@@ -0,0 +1,30 @@
+package application
+
+import (
+	"testing"
+
+	"gambler/discord-client/application/dto"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoLStreakOddsMultipliers(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []float64{2.0, 1.8}, lolStreakOddsMultipliers(3))
+	assert.Equal(t, []float64{2.5, 1.8}, lolStreakOddsMultipliers(5))
+	assert.Equal(t, []float64{4.0, 1.8}, lolStreakOddsMultipliers(20), "the payout for extending is capped")
+}
+
+func TestSelectLoLStreakWinner(t *testing.T) {
+	t.Parallel()
+
+	options := []entities.GroupWagerOption{
+		{ID: 10, OptionText: "Yes"},
+		{ID: 11, OptionText: "No"},
+	}
+
+	assert.Equal(t, int64(10), selectLoLStreakWinner(options, dto.GameEndedDTO{Won: true}))
+	assert.Equal(t, int64(11), selectLoLStreakWinner(options, dto.GameEndedDTO{Won: false}))
+}
@@ -2,12 +2,12 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"gambler/discord-client/domain/entities"
 	"gambler/discord-client/domain/interfaces"
-	"gambler/discord-client/domain/services"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -38,79 +38,47 @@ func NewLotteryDrawWorker(uowFactory UnitOfWorkFactory, lotteryPoster LotteryPos
 	}
 }
 
-// Start begins the lottery draw worker
-func (w *LotteryDrawWorker) Start(ctx context.Context) func() {
-	stopChan := make(chan struct{})
+// LotteryIdleCheckInterval is how often the lottery draw worker checks for new draws when none are pending
+const LotteryIdleCheckInterval = 1 * time.Hour
+
+// Job returns the scheduler job that conducts lottery draws as they come due.
+// It runs on start to process draws that came due while the bot was offline.
+func (w *LotteryDrawWorker) Job() Job {
+	return Job{
+		Name:       "lottery-draw",
+		Interval:   LotteryIdleCheckInterval,
+		NextRun:    w.nextDrawTime,
+		RunOnStart: true,
+		Run:        w.processAllPendingDraws,
+	}
+}
 
-	// Get next draw time from database
-	getNextDrawTime := func() *time.Time {
-		uow := w.uowFactory.CreateForGuild(0)
-		if err := uow.Begin(ctx); err != nil {
-			log.Errorf("Failed to begin transaction for next draw time: %v", err)
-			return nil
-		}
-		defer uow.Rollback()
+// nextDrawTime returns when the next pending draw is due, or a zero time to check again after the idle interval
+func (w *LotteryDrawWorker) nextDrawTime(ctx context.Context, now time.Time) time.Time {
+	uow := w.uowFactory.CreateForGuild(0)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Failed to begin transaction for next draw time: %v", err)
+		return time.Time{}
+	}
+	defer uow.Rollback()
 
-		nextTime, err := uow.LotteryDrawRepository().GetNextPendingDrawTime(ctx)
-		if err != nil {
-			log.Errorf("Failed to get next draw time: %v", err)
-			return nil
-		}
-		return nextTime
-	}
-
-	// Start the worker goroutine
-	go func() {
-		log.Info("Lottery draw worker started")
-
-		for {
-			// First, process any past-due draws
-			if err := w.processAllPendingDraws(ctx); err != nil {
-				log.Errorf("Error processing pending draws: %v", err)
-			}
-
-			// Get next scheduled draw time
-			nextDrawTime := getNextDrawTime()
-			if nextDrawTime == nil {
-				// No pending draws, check again in 1 hour
-				log.Info("No pending lottery draws, checking again in 1 hour")
-				select {
-				case <-ctx.Done():
-					log.Info("Lottery draw worker shutting down (context cancelled)...")
-					return
-				case <-stopChan:
-					log.Info("Lottery draw worker shutting down (stop requested)...")
-					return
-				case <-time.After(1 * time.Hour):
-					continue
-				}
-			}
-
-			waitDuration := time.Until(*nextDrawTime)
-			if waitDuration <= 0 {
-				// Draw time already passed, loop to process immediately
-				continue
-			}
-
-			log.Infof("Next lottery draw at %v (in %v)", nextDrawTime.UTC(), waitDuration)
-
-			select {
-			case <-ctx.Done():
-				log.Info("Lottery draw worker shutting down (context cancelled)...")
-				return
-			case <-stopChan:
-				log.Info("Lottery draw worker shutting down (stop requested)...")
-				return
-			case <-time.After(waitDuration):
-				// Timer fired, loop to process
-			}
-		}
-	}()
+	nextTime, err := uow.LotteryDrawRepository().GetNextPendingDrawTime(ctx)
+	if err != nil {
+		log.Errorf("Failed to get next draw time: %v", err)
+		return time.Time{}
+	}
+	if nextTime == nil {
+		log.Info("No pending lottery draws, checking again in 1 hour")
+		return time.Time{}
+	}
 
-	// Return cleanup function
-	return func() {
-		close(stopChan)
+	// A draw that is still pending after its time failed to process, retry shortly rather than immediately
+	if !nextTime.After(now) {
+		return now.Add(time.Minute)
 	}
+
+	log.Infof("Next lottery draw at %v (in %v)", nextTime.UTC(), nextTime.Sub(now))
+	return *nextTime
 }
 
 // processAllPendingDraws processes all lottery draws that are ready
@@ -130,6 +98,9 @@ func (w *LotteryDrawWorker) processAllPendingDraws(ctx context.Context) error {
 	}
 	uow.Rollback() // Close the read transaction
 
+	// Post any draws left without a message, e.g. by a restart between conducting a draw and posting its successor
+	defer w.resumeUnpostedDraws(ctx)
+
 	if len(pendingDraws) == 0 {
 		log.Info("No pending lottery draws to process")
 		return nil
@@ -182,20 +153,18 @@ func (w *LotteryDrawWorker) processGuildDraw(ctx context.Context, draw *entities
 	channelID := guildSettings.GetLottoChannelID()
 
 	// Create lottery service
-	lotteryService := services.NewLotteryService(
-		uow.LotteryDrawRepository(),
-		uow.LotteryTicketRepository(),
-		uow.LotteryWinnerRepository(),
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.GroupWagerRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.GuildSettingsRepository(),
-		uow.EventBus(),
-	)
+	lotteryService := uow.Services().LotteryService()
 
 	// Conduct the draw
 	result, err := lotteryService.ConductDraw(ctx, draw)
+	if errors.Is(err, entities.ErrLotteryDrawAlreadyCompleted) || errors.Is(err, entities.ErrLotteryDrawInProgress) {
+		// Another instance or an earlier attempt has this draw; it posts the results
+		log.WithFields(log.Fields{
+			"draw_id":  draw.ID,
+			"guild_id": draw.GuildID,
+		}).WithError(err).Info("Skipping lottery draw handled elsewhere")
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("failed to conduct draw: %w", err)
 	}
@@ -218,6 +187,17 @@ func (w *LotteryDrawWorker) processGuildDraw(ctx context.Context, draw *entities
 		// Don't return error - the draw was processed successfully
 	}
 
+	if err := w.queueResultWebhooks(ctx, draw, result); err != nil {
+		log.Errorf("Failed to queue lottery result webhooks for draw %d: %v", draw.ID, err)
+	}
+
+	// Buy subscribers' tickets for the new draw before announcing it
+	if result.NextDraw != nil {
+		if err := w.processSubscriptions(ctx, draw.GuildID); err != nil {
+			log.Errorf("Failed to process lottery subscriptions for guild %d: %v", draw.GuildID, err)
+		}
+	}
+
 	// Post new draw message
 	if result.NextDraw != nil {
 		if err := w.postNewDrawMessage(ctx, result.NextDraw, channelID); err != nil {
@@ -226,17 +206,144 @@ func (w *LotteryDrawWorker) processGuildDraw(ctx context.Context, draw *entities
 	}
 
 	log.WithFields(log.Fields{
-		"draw_id":        draw.ID,
-		"guild_id":       draw.GuildID,
-		"winning_number": result.WinningNumber,
-		"pot_amount":     result.PotAmount,
-		"winner_count":   len(result.Winners),
-		"rolled_over":    result.RolledOver,
+		"draw_id":          draw.ID,
+		"guild_id":         draw.GuildID,
+		"winning_number":   result.WinningNumber,
+		"pot_amount":       result.PotAmount,
+		"winner_count":     len(result.Winners),
+		"rolled_over":      result.RolledOver,
+		"consolation_paid": entities.TotalLotteryConsolation(result.ConsolationPayouts),
 	}).Info("Lottery draw completed")
 
 	return nil
 }
 
+// queueResultWebhooks queues the draw's result for the guild's webhooks subscribed to lottery results
+func (w *LotteryDrawWorker) queueResultWebhooks(ctx context.Context, draw *entities.LotteryDraw, result *interfaces.LotteryDrawResult) error {
+	uow := w.uowFactory.CreateForGuild(draw.GuildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	if _, err := uow.Services().WebhookService().Enqueue(ctx, draw.GuildID, entities.WebhookEventLotteryResult, newWebhookLotteryResultData(draw, result)); err != nil {
+		return err
+	}
+
+	return uow.Commit()
+}
+
+// newWebhookLotteryResultData builds the lottery_result data of a conducted draw
+func newWebhookLotteryResultData(draw *entities.LotteryDraw, result *interfaces.LotteryDrawResult) entities.WebhookLotteryResultData {
+	data := entities.WebhookLotteryResultData{
+		DrawID:        draw.ID,
+		WinningNumber: result.WinningNumber,
+		PotAmount:     result.PotAmount,
+		WinnerIDs:     make([]string, len(result.Winners)),
+		RolledOver:    result.RolledOver,
+	}
+	for i, winner := range result.Winners {
+		data.WinnerIDs[i] = entities.FormatWebhookID(winner.DiscordID)
+	}
+	if len(result.Winners) > 0 {
+		data.PrizePerWinner = result.PotAmount / int64(len(result.Winners))
+	}
+	return data
+}
+
+// resumeUnpostedDraws posts the message of every open draw that doesn't have one yet. Draws are conducted and
+// their successors created in one transaction, but the successor is posted afterwards, so a crash in between
+// would otherwise leave the guild without a lottery message until someone reconfigured the channel.
+func (w *LotteryDrawWorker) resumeUnpostedDraws(ctx context.Context) {
+	uow := w.uowFactory.CreateForGuild(0) // 0 guildID for cross-guild query
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Failed to begin transaction for unposted lottery draws: %v", err)
+		return
+	}
+
+	draws, err := uow.LotteryDrawRepository().GetOpenDrawsWithoutMessage(ctx, time.Now().UTC())
+	uow.Rollback() // Close the read transaction
+	if err != nil {
+		log.Errorf("Failed to get unposted lottery draws: %v", err)
+		return
+	}
+
+	for _, draw := range draws {
+		if err := w.resumeUnpostedDraw(ctx, draw); err != nil {
+			log.Errorf("Failed to post lottery draw %d for guild %d: %v", draw.ID, draw.GuildID, err)
+		}
+	}
+}
+
+// resumeUnpostedDraw posts an open draw's message to the guild's lottery channel, if it has one
+func (w *LotteryDrawWorker) resumeUnpostedDraw(ctx context.Context, draw *entities.LotteryDraw) error {
+	uow := w.uowFactory.CreateForGuild(draw.GuildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	guildSettings, err := uow.GuildSettingsRepository().GetOrCreateGuildSettings(ctx, draw.GuildID)
+	uow.Rollback()
+	if err != nil {
+		return fmt.Errorf("failed to get guild settings: %w", err)
+	}
+
+	// The draw is posted when the guild sets its lottery channel
+	if !guildSettings.HasLottoChannel() {
+		return nil
+	}
+
+	log.WithFields(log.Fields{
+		"draw_id":  draw.ID,
+		"guild_id": draw.GuildID,
+	}).Info("Posting unposted lottery draw")
+
+	return w.postNewDrawMessage(ctx, draw, guildSettings.GetLottoChannelID())
+}
+
+// processSubscriptions buys tickets for the guild's subscribers in the newly created draw
+func (w *LotteryDrawWorker) processSubscriptions(ctx context.Context, guildID int64) error {
+	uow := w.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	// Subscriptions keep buying tickets only while the guild runs the lottery. Draws themselves still
+	// conclude so tickets bought before the lottery was turned off are honored.
+	enabled, err := uow.Services().FeatureFlagService().IsEnabled(ctx, entities.FeatureLottery)
+	if err != nil {
+		return fmt.Errorf("failed to check feature flag: %w", err)
+	}
+	if !enabled {
+		log.Debugf("Lottery disabled for guild %d, skipping subscriptions", guildID)
+		return nil
+	}
+
+	subscriptionService := uow.Services().LotterySubscriptionService()
+
+	result, err := subscriptionService.ProcessSubscriptions(ctx, guildID)
+	if err != nil {
+		return err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if len(result.Purchased) > 0 || len(result.Skipped) > 0 {
+		log.WithFields(log.Fields{
+			"guild_id":       guildID,
+			"purchased":      len(result.Purchased),
+			"skipped":        len(result.Skipped),
+			"tickets_bought": result.TicketsBought,
+			"total_cost":     result.TotalCost,
+		}).Info("Processed lottery subscriptions")
+	}
+
+	return nil
+}
+
 // postNewDrawMessage posts a new lottery draw message for rollover
 func (w *LotteryDrawWorker) postNewDrawMessage(ctx context.Context, draw *entities.LotteryDraw, channelID int64) error {
 	// Create UoW for this guild
@@ -247,17 +354,7 @@ func (w *LotteryDrawWorker) postNewDrawMessage(ctx context.Context, draw *entiti
 	defer uow.Rollback()
 
 	// Get draw info
-	lotteryService := services.NewLotteryService(
-		uow.LotteryDrawRepository(),
-		uow.LotteryTicketRepository(),
-		uow.LotteryWinnerRepository(),
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.GroupWagerRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.GuildSettingsRepository(),
-		uow.EventBus(),
-	)
+	lotteryService := uow.Services().LotteryService()
 
 	drawInfo, err := lotteryService.GetDrawInfo(ctx, draw.GuildID)
 	if err != nil {
@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ParlaySettlementHandler settles parlay legs when the group wagers they ride on finish
+type ParlaySettlementHandler struct {
+	uowFactory UnitOfWorkFactory
+}
+
+// NewParlaySettlementHandler creates a new parlay settlement handler
+func NewParlaySettlementHandler(uowFactory UnitOfWorkFactory) *ParlaySettlementHandler {
+	return &ParlaySettlementHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleGroupWagerStateChange settles parlays with a leg on a wager that was resolved or cancelled
+func (h *ParlaySettlementHandler) HandleGroupWagerStateChange(ctx context.Context, event interface{}) error {
+	e, err := AssertEventType[events.GroupWagerStateChangeEvent](event, "GroupWagerStateChangeEvent")
+	if err != nil {
+		return err
+	}
+
+	if e.NewState != string(entities.GroupWagerStateResolved) && e.NewState != string(entities.GroupWagerStateCancelled) {
+		return nil
+	}
+
+	uow := h.uowFactory.CreateForGuild(e.GuildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	parlayService := uow.Services().ParlayService()
+
+	settled, err := parlayService.SettleParlaysForWager(ctx, e.GroupWagerID)
+	if err != nil {
+		return fmt.Errorf("failed to settle parlays for group wager %d: %w", e.GroupWagerID, err)
+	}
+
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit parlay settlement: %w", err)
+	}
+
+	for _, parlay := range settled {
+		log.WithFields(log.Fields{
+			"parlayID":     parlay.ID,
+			"groupWagerID": e.GroupWagerID,
+			"discordID":    parlay.DiscordID,
+			"status":       parlay.Status,
+		}).Info("Parlay settled")
+	}
+
+	return nil
+}
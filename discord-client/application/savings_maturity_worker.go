@@ -0,0 +1,90 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// SavingsMaturityInterval is how often the savings maturity worker looks for matured deposits
+const SavingsMaturityInterval = 15 * time.Minute
+
+// SavingsMaturityWorker releases matured savings deposits and credits their bonuses
+type SavingsMaturityWorker struct {
+	uowFactory UnitOfWorkFactory
+}
+
+// NewSavingsMaturityWorker creates a new savings maturity worker
+func NewSavingsMaturityWorker(uowFactory UnitOfWorkFactory) *SavingsMaturityWorker {
+	return &SavingsMaturityWorker{
+		uowFactory: uowFactory,
+	}
+}
+
+// Job returns the scheduler job that processes matured deposits every interval.
+// It runs on start to pay out deposits that matured while the bot was offline.
+func (w *SavingsMaturityWorker) Job(interval time.Duration) Job {
+	return Job{
+		Name:       "savings-maturity",
+		Interval:   interval,
+		Jitter:     time.Minute,
+		RunOnStart: true,
+		Run:        w.processMaturedDeposits,
+	}
+}
+
+// processMaturedDeposits pays out every deposit that has reached maturity
+func (w *SavingsMaturityWorker) processMaturedDeposits(ctx context.Context) error {
+	// Cross-guild query to find matured deposits
+	uow := w.uowFactory.CreateForGuild(0)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	deposits, err := uow.SavingsDepositRepository().GetMaturedDeposits(ctx, time.Now().UTC())
+	uow.Rollback()
+	if err != nil {
+		return fmt.Errorf("failed to get matured deposits: %w", err)
+	}
+
+	if len(deposits) == 0 {
+		return nil
+	}
+
+	log.Infof("Found %d matured savings deposits to process", len(deposits))
+
+	var successCount, failureCount int
+	for _, deposit := range deposits {
+		if err := w.matureDeposit(ctx, deposit.GuildID, deposit.ID); err != nil {
+			log.Errorf("Error maturing savings deposit %d for guild %d: %v", deposit.ID, deposit.GuildID, err)
+			failureCount++
+		} else {
+			successCount++
+		}
+	}
+
+	log.Infof("Savings maturity complete: %d succeeded, %d failed", successCount, failureCount)
+	return nil
+}
+
+// matureDeposit pays out a single deposit in its own guild-scoped transaction
+func (w *SavingsMaturityWorker) matureDeposit(ctx context.Context, guildID, depositID int64) error {
+	uow := w.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	savingsService := uow.Services().SavingsService()
+
+	if _, err := savingsService.MatureDeposit(ctx, depositID); err != nil {
+		return err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
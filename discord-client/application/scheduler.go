@@ -0,0 +1,246 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// JobOverdueGrace is how long past its scheduled run an idle job may be before it is reported unhealthy
+const JobOverdueGrace = 5 * time.Minute
+
+// Job is a unit of recurring background work run by the Scheduler
+type Job struct {
+	Name string
+
+	// Interval is the time between the end of one run and the start of the next
+	Interval time.Duration
+
+	// NextRun overrides Interval for jobs on a calendar or data driven schedule (e.g. lottery draws).
+	// It returns when the job should next run, or a zero time to fall back to Interval.
+	NextRun func(ctx context.Context, now time.Time) time.Time
+
+	// Jitter adds a random delay of up to this long before each run so shards do not run in lockstep
+	Jitter time.Duration
+
+	// RunOnStart runs the job as soon as the scheduler starts to catch up on work missed while offline
+	RunOnStart bool
+
+	Run func(ctx context.Context) error
+}
+
+// JobStatus reports the last run of a registered job
+type JobStatus struct {
+	Name         string        `json:"name"`
+	Interval     time.Duration `json:"interval"`
+	Running      bool          `json:"running"`
+	Runs         int           `json:"runs"`
+	Failures     int           `json:"failures"`
+	LastStarted  time.Time     `json:"last_started"`
+	LastFinished time.Time     `json:"last_finished"`
+	LastDuration time.Duration `json:"last_duration"`
+	LastError    string        `json:"last_error,omitempty"`
+	NextRun      time.Time     `json:"next_run"`
+	Healthy      bool          `json:"healthy"`
+}
+
+// scheduledJob pairs a job with its run history
+type scheduledJob struct {
+	job    Job
+	status JobStatus
+}
+
+// Scheduler runs registered background jobs on their own schedules with panic recovery
+// and keeps the last run of each job for health checks
+type Scheduler struct {
+	mu      sync.Mutex
+	jobs    []*scheduledJob
+	started bool
+	wg      sync.WaitGroup
+	now     func() time.Time
+	jitter  func(max time.Duration) time.Duration
+}
+
+// NewScheduler creates an empty scheduler
+func NewScheduler() *Scheduler {
+	return &Scheduler{
+		now: time.Now,
+		jitter: func(max time.Duration) time.Duration {
+			return time.Duration(rand.Int63n(int64(max) + 1))
+		},
+	}
+}
+
+// Register adds a job to the scheduler. Jobs must be registered before Start.
+func (s *Scheduler) Register(job Job) error {
+	if job.Name == "" {
+		return fmt.Errorf("job name is required")
+	}
+	if job.Run == nil {
+		return fmt.Errorf("job %s has no run function", job.Name)
+	}
+	if job.Interval <= 0 && job.NextRun == nil {
+		return fmt.Errorf("job %s needs an interval or a next run function", job.Name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return fmt.Errorf("cannot register job %s after the scheduler has started", job.Name)
+	}
+	for _, existing := range s.jobs {
+		if existing.job.Name == job.Name {
+			return fmt.Errorf("job %s is already registered", job.Name)
+		}
+	}
+
+	s.jobs = append(s.jobs, &scheduledJob{
+		job:    job,
+		status: JobStatus{Name: job.Name, Interval: job.Interval},
+	})
+	return nil
+}
+
+// Start runs every registered job in its own goroutine until ctx is cancelled.
+// Returns a cleanup function that stops the jobs and waits for in-flight runs to finish.
+func (s *Scheduler) Start(ctx context.Context) func() {
+	ctx, cancel := context.WithCancel(ctx)
+
+	s.mu.Lock()
+	s.started = true
+	jobs := append([]*scheduledJob(nil), s.jobs...)
+	s.mu.Unlock()
+
+	for _, sj := range jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, sj)
+	}
+	log.WithField("jobs", len(jobs)).Info("Scheduler started")
+
+	return func() {
+		cancel()
+		s.wg.Wait()
+		log.Info("Scheduler stopped")
+	}
+}
+
+// Status returns the run history of every registered job in registration order
+func (s *Scheduler) Status() []JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	statuses := make([]JobStatus, len(s.jobs))
+	for i, sj := range s.jobs {
+		status := sj.status
+		overdue := !status.Running && !status.NextRun.IsZero() && now.After(status.NextRun.Add(JobOverdueGrace))
+		status.Healthy = status.LastError == "" && !overdue
+		statuses[i] = status
+	}
+	return statuses
+}
+
+// loop waits for each scheduled run of a job until ctx is cancelled
+func (s *Scheduler) loop(ctx context.Context, sj *scheduledJob) {
+	defer s.wg.Done()
+
+	logger := log.WithField("job", sj.job.Name)
+	logger.Info("Job scheduled")
+
+	first := sj.job.RunOnStart
+	for {
+		var wait time.Duration
+		if !first {
+			wait = s.nextWait(ctx, sj)
+		}
+		first = false
+
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				logger.Info("Job stopped")
+				return
+			case <-timer.C:
+			}
+		} else if ctx.Err() != nil {
+			logger.Info("Job stopped")
+			return
+		}
+
+		s.runOnce(ctx, sj)
+	}
+}
+
+// nextWait records when the job runs next and returns how long to wait for it
+func (s *Scheduler) nextWait(ctx context.Context, sj *scheduledJob) time.Duration {
+	now := s.now()
+
+	var next time.Time
+	if sj.job.NextRun != nil {
+		next = sj.job.NextRun(ctx, now)
+	}
+	if next.IsZero() {
+		next = now.Add(sj.job.Interval)
+	}
+	if sj.job.Jitter > 0 {
+		next = next.Add(s.jitter(sj.job.Jitter))
+	}
+
+	s.mu.Lock()
+	sj.status.NextRun = next
+	s.mu.Unlock()
+
+	return next.Sub(now)
+}
+
+// runOnce runs the job a single time, recovering from panics and recording the outcome
+func (s *Scheduler) runOnce(ctx context.Context, sj *scheduledJob) {
+	started := s.now()
+	s.mu.Lock()
+	sj.status.Running = true
+	sj.status.LastStarted = started
+	s.mu.Unlock()
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+				log.WithField("job", sj.job.Name).Errorf("Job panicked: %v\n%s", r, debug.Stack())
+			}
+		}()
+		return sj.job.Run(ctx)
+	}()
+
+	finished := s.now()
+	duration := finished.Sub(started)
+
+	s.mu.Lock()
+	sj.status.Running = false
+	sj.status.Runs++
+	sj.status.LastFinished = finished
+	sj.status.LastDuration = duration
+	sj.status.LastError = ""
+	if err != nil {
+		sj.status.Failures++
+		sj.status.LastError = err.Error()
+	}
+	s.mu.Unlock()
+
+	logger := log.WithFields(log.Fields{
+		"job":      sj.job.Name,
+		"duration": duration,
+	})
+	if err != nil {
+		logger.WithError(err).Error("Job failed")
+		return
+	}
+	logger.Debug("Job completed")
+}
@@ -0,0 +1,192 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_Register(t *testing.T) {
+	noop := func(ctx context.Context) error { return nil }
+
+	tests := []struct {
+		name        string
+		job         Job
+		errContains string
+	}{
+		{name: "missing name", job: Job{Interval: time.Minute, Run: noop}, errContains: "name is required"},
+		{name: "missing run", job: Job{Name: "a", Interval: time.Minute}, errContains: "no run function"},
+		{name: "missing schedule", job: Job{Name: "a", Run: noop}, errContains: "interval or a next run"},
+		{name: "duplicate name", job: Job{Name: "dup", Interval: time.Minute, Run: noop}, errContains: "already registered"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheduler := NewScheduler()
+			require.NoError(t, scheduler.Register(Job{Name: "dup", Interval: time.Minute, Run: noop}))
+
+			err := scheduler.Register(tt.job)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
+func TestScheduler_RunsJobs(t *testing.T) {
+	t.Run("runs on start and on each interval", func(t *testing.T) {
+		var runs atomic.Int32
+		scheduler := NewScheduler()
+		require.NoError(t, scheduler.Register(Job{
+			Name:       "counter",
+			Interval:   10 * time.Millisecond,
+			RunOnStart: true,
+			Run: func(ctx context.Context) error {
+				runs.Add(1)
+				return nil
+			},
+		}))
+
+		stop := scheduler.Start(context.Background())
+		assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, 5*time.Millisecond)
+		stop()
+
+		status := scheduler.Status()[0]
+		assert.Equal(t, "counter", status.Name)
+		assert.GreaterOrEqual(t, status.Runs, 3)
+		assert.Zero(t, status.Failures)
+		assert.True(t, status.Healthy)
+	})
+
+	t.Run("panics are recovered and recorded", func(t *testing.T) {
+		var runs atomic.Int32
+		scheduler := NewScheduler()
+		require.NoError(t, scheduler.Register(Job{
+			Name:       "panicky",
+			Interval:   10 * time.Millisecond,
+			RunOnStart: true,
+			Run: func(ctx context.Context) error {
+				runs.Add(1)
+				panic("boom")
+			},
+		}))
+
+		stop := scheduler.Start(context.Background())
+		assert.Eventually(t, func() bool { return runs.Load() >= 2 }, time.Second, 5*time.Millisecond)
+		stop()
+
+		status := scheduler.Status()[0]
+		assert.GreaterOrEqual(t, status.Failures, 2)
+		assert.Contains(t, status.LastError, "panic: boom")
+		assert.False(t, status.Healthy)
+	})
+
+	t.Run("errors are recorded and cleared by the next success", func(t *testing.T) {
+		var runs atomic.Int32
+		scheduler := NewScheduler()
+		require.NoError(t, scheduler.Register(Job{
+			Name:       "flaky",
+			Interval:   10 * time.Millisecond,
+			RunOnStart: true,
+			Run: func(ctx context.Context) error {
+				if runs.Add(1) == 1 {
+					return errors.New("database unavailable")
+				}
+				return nil
+			},
+		}))
+
+		stop := scheduler.Start(context.Background())
+		assert.Eventually(t, func() bool { return runs.Load() >= 2 }, time.Second, 5*time.Millisecond)
+		stop()
+
+		status := scheduler.Status()[0]
+		assert.Equal(t, 1, status.Failures)
+		assert.Empty(t, status.LastError)
+	})
+
+	t.Run("stop cancels jobs waiting for their next run", func(t *testing.T) {
+		scheduler := NewScheduler()
+		require.NoError(t, scheduler.Register(Job{
+			Name:     "hourly",
+			Interval: time.Hour,
+			Run:      func(ctx context.Context) error { return nil },
+		}))
+
+		stop := scheduler.Start(context.Background())
+		done := make(chan struct{})
+		go func() {
+			stop()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("scheduler did not stop")
+		}
+		assert.Zero(t, scheduler.Status()[0].Runs)
+	})
+}
+
+func TestScheduler_NextWait(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	scheduler := NewScheduler()
+	scheduler.now = func() time.Time { return now }
+	scheduler.jitter = func(max time.Duration) time.Duration { return max }
+
+	t.Run("interval with jitter", func(t *testing.T) {
+		sj := &scheduledJob{job: Job{Interval: time.Hour, Jitter: time.Minute}}
+		assert.Equal(t, time.Hour+time.Minute, scheduler.nextWait(context.Background(), sj))
+		assert.Equal(t, now.Add(time.Hour+time.Minute), sj.status.NextRun)
+	})
+
+	t.Run("next run overrides interval", func(t *testing.T) {
+		sj := &scheduledJob{job: Job{
+			Interval: time.Hour,
+			NextRun:  func(ctx context.Context, now time.Time) time.Time { return now.Add(5 * time.Minute) },
+		}}
+		assert.Equal(t, 5*time.Minute, scheduler.nextWait(context.Background(), sj))
+	})
+
+	t.Run("zero next run falls back to interval", func(t *testing.T) {
+		sj := &scheduledJob{job: Job{
+			Interval: time.Hour,
+			NextRun:  func(ctx context.Context, now time.Time) time.Time { return time.Time{} },
+		}}
+		assert.Equal(t, time.Hour, scheduler.nextWait(context.Background(), sj))
+	})
+}
+
+func TestScheduler_StatusOverdue(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	scheduler := NewScheduler()
+	scheduler.now = func() time.Time { return now }
+	scheduler.jobs = []*scheduledJob{
+		{status: JobStatus{Name: "on-time", NextRun: now.Add(-time.Minute)}},
+		{status: JobStatus{Name: "overdue", NextRun: now.Add(-JobOverdueGrace - time.Minute)}},
+		{status: JobStatus{Name: "long-run", Running: true, NextRun: now.Add(-time.Hour)}},
+	}
+
+	statuses := scheduler.Status()
+	assert.True(t, statuses[0].Healthy)
+	assert.False(t, statuses[1].Healthy)
+	assert.True(t, statuses[2].Healthy)
+}
+
+func TestNextDailyRun(t *testing.T) {
+	assert.Equal(t, time.Date(2025, 6, 1, 18, 0, 0, 0, time.UTC), nextDailyRun(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC), 18))
+	assert.Equal(t, time.Date(2025, 6, 2, 18, 0, 0, 0, time.UTC), nextDailyRun(time.Date(2025, 6, 1, 18, 0, 0, 0, time.UTC), 18))
+}
+
+func TestNextWeeklyRun(t *testing.T) {
+	sunday := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 6, 1, 18, 0, 0, 0, time.UTC), nextWeeklyRun(sunday, time.Sunday, 18))
+	assert.Equal(t, time.Date(2025, 6, 8, 18, 0, 0, 0, time.UTC), nextWeeklyRun(sunday.Add(6*time.Hour), time.Sunday, 18))
+	assert.Equal(t, time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC), nextWeeklyRun(sunday, time.Monday, 9))
+	assert.Equal(t, time.Date(2025, 6, 7, 0, 0, 0, 0, time.UTC), nextWeeklyRun(sunday, time.Saturday, 0))
+}
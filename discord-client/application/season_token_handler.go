@@ -0,0 +1,65 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/events"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// SeasonTokenHandler credits season tokens to users as they win bits
+type SeasonTokenHandler struct {
+	uowFactory UnitOfWorkFactory
+}
+
+// NewSeasonTokenHandler creates a new season token handler
+func NewSeasonTokenHandler(uowFactory UnitOfWorkFactory) *SeasonTokenHandler {
+	return &SeasonTokenHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleBalanceChange credits the season tokens earned by a winning balance change.
+// Guilds without season tokens and wins below the guild's rate are skipped by the service.
+func (h *SeasonTokenHandler) HandleBalanceChange(ctx context.Context, event interface{}) error {
+	e, err := AssertEventType[events.BalanceChangeEvent](event, "BalanceChangeEvent")
+	if err != nil {
+		return err
+	}
+
+	if !e.TransactionType.IsWinType() || e.ChangeAmount <= 0 {
+		return nil
+	}
+
+	uow := h.uowFactory.CreateForGuild(e.GuildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	seasonTokenService := uow.Services().SeasonTokenService()
+
+	earned, err := seasonTokenService.EarnFromWin(ctx, e.GuildID, e.UserID, e.ChangeAmount, e.TransactionType)
+	if err != nil {
+		return fmt.Errorf("failed to credit season tokens to user %d: %w", e.UserID, err)
+	}
+	if earned == nil {
+		return nil
+	}
+
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit season token credit: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"guildID":   e.GuildID,
+		"discordID": e.UserID,
+		"season":    earned.Season,
+		"tokens":    earned.ChangeAmount,
+		"source":    e.TransactionType,
+	}).Debug("Season tokens earned")
+
+	return nil
+}
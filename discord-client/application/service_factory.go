@@ -0,0 +1,317 @@
+package application
+
+import (
+	"gambler/discord-client/domain/interfaces"
+	"gambler/discord-client/domain/services"
+)
+
+// ServiceFactory builds domain services wired to the repositories and event bus of a unit of work,
+// so every caller constructs a service the same way. Services must only be requested after Begin.
+type ServiceFactory interface {
+	AchievementService() interfaces.AchievementService
+	AdminService() interfaces.AdminService
+	BalanceFreezeService() interfaces.BalanceFreezeService
+	BalanceHistoryRollupService() interfaces.BalanceHistoryRollupService
+	DailyAwardsService() *services.DailyAwardsService
+	DataRetentionService() interfaces.DataRetentionService
+	DiscordOutboxService() interfaces.DiscordOutboxService
+	DuelService() interfaces.DuelService
+	ExperimentService() interfaces.ExperimentService
+	ExportService() interfaces.ExportService
+	FeatureFlagService() interfaces.FeatureFlagService
+	GamblingService() interfaces.GamblingService
+	GroupWagerFollowService() interfaces.GroupWagerFollowService
+	GroupWagerService() interfaces.GroupWagerService
+	GuildResolverService() interfaces.GuildResolverService
+	GuildSettingsService() interfaces.GuildSettingsService
+	// GuildSettingsServiceWithChannels also rejects channels which do not exist in the guild
+	GuildSettingsServiceWithChannels(channelLookup interfaces.ChannelLookup) interfaces.GuildSettingsService
+	HighRollerService() interfaces.HighRollerService
+	LotteryService() interfaces.LotteryService
+	LotterySubscriptionService() interfaces.LotterySubscriptionService
+	OddsEngine() interfaces.OddsEngine
+	ParlayService() interfaces.ParlayService
+	RiotAccountLinkService() interfaces.RiotAccountLinkService
+	SavingsService() interfaces.SavingsService
+	SeasonTokenService() interfaces.SeasonTokenService
+	SnapshotService() interfaces.SnapshotService
+	SummonerWatchService() interfaces.SummonerWatchService
+	UserMetricsService() interfaces.UserMetricsService
+	UserPreferencesService() interfaces.UserPreferencesService
+	UserService() interfaces.UserService
+	WagerService() interfaces.WagerService
+	WebhookService() interfaces.WebhookService
+	// WeeklyDigestService takes its metrics service so prediction stats can come from the read replica
+	WeeklyDigestService(metricsService interfaces.UserMetricsService) interfaces.WeeklyDigestService
+}
+
+// ReadOnlyServiceFactory builds services for heavy read paths over replica-backed repositories.
+// Like the repositories, the services may lag the primary and must never decide a write.
+type ReadOnlyServiceFactory interface {
+	GlobalLeaderboardService() interfaces.GlobalLeaderboardService
+	UserMetricsService() interfaces.UserMetricsService
+}
+
+// unitOfWorkServices implements ServiceFactory over a unit of work
+type unitOfWorkServices struct {
+	uow UnitOfWork
+}
+
+// NewServiceFactory creates a service factory over the repositories of uow
+func NewServiceFactory(uow UnitOfWork) ServiceFactory {
+	return &unitOfWorkServices{uow: uow}
+}
+
+func (f *unitOfWorkServices) AchievementService() interfaces.AchievementService {
+	return services.NewAchievementService(f.uow.AchievementRepository(), f.uow.UserStatsRepository())
+}
+
+func (f *unitOfWorkServices) AdminService() interfaces.AdminService {
+	return services.NewAdminService(
+		f.uow.UserRepository(),
+		f.uow.BalanceHistoryRepository(),
+		f.uow.EventBus(),
+	)
+}
+
+func (f *unitOfWorkServices) BalanceFreezeService() interfaces.BalanceFreezeService {
+	return services.NewBalanceFreezeService(f.uow.BalanceFreezeRepository(), f.uow.UserRepository())
+}
+
+func (f *unitOfWorkServices) BalanceHistoryRollupService() interfaces.BalanceHistoryRollupService {
+	return services.NewBalanceHistoryRollupService(f.uow.BalanceHistoryRollupRepository())
+}
+
+func (f *unitOfWorkServices) DailyAwardsService() *services.DailyAwardsService {
+	return services.NewDailyAwardsService(
+		f.uow.WordleCompletionRepo(),
+		f.uow.UserRepository(),
+		f.uow.WagerRepository(),
+		f.uow.BetRepository(),
+		f.uow.GroupWagerRepository(),
+	)
+}
+
+func (f *unitOfWorkServices) DataRetentionService() interfaces.DataRetentionService {
+	return services.NewDataRetentionService(f.uow.DataRetentionRepository())
+}
+
+func (f *unitOfWorkServices) DiscordOutboxService() interfaces.DiscordOutboxService {
+	return services.NewDiscordOutboxService(f.uow.DiscordOutboxRepository(), f.uow.GroupWagerRepository())
+}
+
+func (f *unitOfWorkServices) DuelService() interfaces.DuelService {
+	return services.NewDuelService(
+		f.uow.DuelRepository(),
+		f.uow.UserRepository(),
+		f.uow.BalanceHistoryRepository(),
+		f.uow.EventBus(),
+	)
+}
+
+func (f *unitOfWorkServices) ExperimentService() interfaces.ExperimentService {
+	return services.NewExperimentService(f.uow.ExperimentRepository())
+}
+
+func (f *unitOfWorkServices) ExportService() interfaces.ExportService {
+	return services.NewExportService(
+		f.uow.BalanceHistoryRepository(),
+		f.uow.GroupWagerRepository(),
+		f.uow.LotteryDrawRepository(),
+	)
+}
+
+func (f *unitOfWorkServices) FeatureFlagService() interfaces.FeatureFlagService {
+	return services.NewFeatureFlagService(f.uow.GuildFeatureFlagRepository())
+}
+
+func (f *unitOfWorkServices) GamblingService() interfaces.GamblingService {
+	return services.NewGamblingService(
+		f.uow.UserRepository(),
+		f.uow.BetRepository(),
+		f.uow.BalanceHistoryRepository(),
+		f.uow.GuildSettingsRepository(),
+		f.uow.EventBus(),
+	)
+}
+
+func (f *unitOfWorkServices) GroupWagerFollowService() interfaces.GroupWagerFollowService {
+	return services.NewGroupWagerFollowService(f.uow.GroupWagerRepository(), f.uow.GroupWagerFollowerRepository())
+}
+
+func (f *unitOfWorkServices) GroupWagerService() interfaces.GroupWagerService {
+	return services.NewGroupWagerService(
+		f.uow.GroupWagerRepository(),
+		f.uow.UserRepository(),
+		f.uow.BalanceHistoryRepository(),
+		f.uow.GuildSettingsRepository(),
+		f.uow.GuildResolverRepository(),
+		f.uow.LotteryDrawRepository(),
+		f.uow.EventBus(),
+	)
+}
+
+func (f *unitOfWorkServices) GuildResolverService() interfaces.GuildResolverService {
+	return services.NewGuildResolverService(f.uow.GuildResolverRepository())
+}
+
+func (f *unitOfWorkServices) GuildSettingsService() interfaces.GuildSettingsService {
+	return services.NewGuildSettingsService(f.uow.GuildSettingsRepository())
+}
+
+func (f *unitOfWorkServices) GuildSettingsServiceWithChannels(channelLookup interfaces.ChannelLookup) interfaces.GuildSettingsService {
+	return services.NewGuildSettingsServiceWithChannels(f.uow.GuildSettingsRepository(), channelLookup)
+}
+
+func (f *unitOfWorkServices) HighRollerService() interfaces.HighRollerService {
+	return services.NewHighRollerService(
+		f.uow.HighRollerPurchaseRepository(),
+		f.uow.UserRepository(),
+		f.uow.WagerRepository(),
+		f.uow.GroupWagerRepository(),
+		f.uow.BalanceHistoryRepository(),
+		f.uow.GuildSettingsRepository(),
+		f.uow.EventBus(),
+	)
+}
+
+func (f *unitOfWorkServices) LotteryService() interfaces.LotteryService {
+	return services.NewLotteryService(
+		f.uow.LotteryDrawRepository(),
+		f.uow.LotteryTicketRepository(),
+		f.uow.LotteryWinnerRepository(),
+		f.uow.UserRepository(),
+		f.uow.WagerRepository(),
+		f.uow.GroupWagerRepository(),
+		f.uow.BalanceHistoryRepository(),
+		f.uow.GuildSettingsRepository(),
+		f.uow.EventBus(),
+	)
+}
+
+func (f *unitOfWorkServices) LotterySubscriptionService() interfaces.LotterySubscriptionService {
+	return services.NewLotterySubscriptionService(f.uow.LotterySubscriptionRepository(), f.LotteryService())
+}
+
+func (f *unitOfWorkServices) OddsEngine() interfaces.OddsEngine {
+	return services.NewOddsEngine(f.uow.GroupWagerRepository())
+}
+
+func (f *unitOfWorkServices) ParlayService() interfaces.ParlayService {
+	return services.NewParlayService(
+		f.uow.ParlayRepository(),
+		f.uow.GroupWagerRepository(),
+		f.uow.UserRepository(),
+		f.uow.BalanceHistoryRepository(),
+		f.uow.GuildSettingsRepository(),
+		f.uow.EventBus(),
+	)
+}
+
+func (f *unitOfWorkServices) RiotAccountLinkService() interfaces.RiotAccountLinkService {
+	return services.NewRiotAccountLinkService(f.uow.RiotAccountLinkRepository())
+}
+
+func (f *unitOfWorkServices) SavingsService() interfaces.SavingsService {
+	return services.NewSavingsService(
+		f.uow.SavingsDepositRepository(),
+		f.uow.UserRepository(),
+		f.uow.BalanceHistoryRepository(),
+		f.uow.GuildSettingsRepository(),
+		f.uow.EventBus(),
+	)
+}
+
+func (f *unitOfWorkServices) SeasonTokenService() interfaces.SeasonTokenService {
+	return services.NewSeasonTokenService(f.uow.SeasonTokenRepository(), f.uow.GuildSettingsRepository())
+}
+
+func (f *unitOfWorkServices) SnapshotService() interfaces.SnapshotService {
+	return services.NewSnapshotService(
+		f.uow.GuildSnapshotRepository(),
+		f.uow.UserRepository(),
+		f.uow.GroupWagerRepository(),
+		f.uow.LotteryDrawRepository(),
+		f.uow.LotteryTicketRepository(),
+		f.uow.BalanceHistoryRepository(),
+		f.uow.EventBus(),
+	)
+}
+
+func (f *unitOfWorkServices) SummonerWatchService() interfaces.SummonerWatchService {
+	return services.NewSummonerWatchService(f.uow.SummonerWatchRepository())
+}
+
+func (f *unitOfWorkServices) UserMetricsService() interfaces.UserMetricsService {
+	return services.NewUserMetricsService(
+		f.uow.UserRepository(),
+		f.uow.UserStatsRepository(),
+		f.uow.BetRepository(),
+		f.uow.GroupWagerRepository(),
+		f.uow.BalanceHistoryRepository(),
+		f.uow.BalanceHistoryRollupRepository(),
+	)
+}
+
+func (f *unitOfWorkServices) UserPreferencesService() interfaces.UserPreferencesService {
+	return services.NewUserPreferencesService(f.uow.UserPreferencesRepository())
+}
+
+func (f *unitOfWorkServices) UserService() interfaces.UserService {
+	return services.NewUserService(
+		f.uow.UserRepository(),
+		f.uow.BalanceHistoryRepository(),
+		f.uow.GuildSettingsRepository(),
+		f.uow.EventBus(),
+	)
+}
+
+func (f *unitOfWorkServices) WagerService() interfaces.WagerService {
+	return services.NewWagerService(
+		f.uow.UserRepository(),
+		f.uow.WagerRepository(),
+		f.uow.WagerVoteRepository(),
+		f.uow.BalanceHistoryRepository(),
+		f.uow.GuildSettingsRepository(),
+		f.uow.EventBus(),
+	)
+}
+
+func (f *unitOfWorkServices) WebhookService() interfaces.WebhookService {
+	return services.NewWebhookService(f.uow.GuildWebhookRepository(), f.uow.WebhookDeliveryRepository())
+}
+
+func (f *unitOfWorkServices) WeeklyDigestService(metricsService interfaces.UserMetricsService) interfaces.WeeklyDigestService {
+	return services.NewWeeklyDigestService(
+		f.uow.BalanceHistoryRepository(),
+		f.uow.GroupWagerRepository(),
+		f.uow.LotteryDrawRepository(),
+		f.uow.HighRollerPurchaseRepository(),
+		metricsService,
+	)
+}
+
+// readOnlyServices implements ReadOnlyServiceFactory over read-only repositories
+type readOnlyServices struct {
+	repos ReadOnlyRepositories
+}
+
+// NewReadOnlyServiceFactory creates a service factory over read-only repositories
+func NewReadOnlyServiceFactory(repos ReadOnlyRepositories) ReadOnlyServiceFactory {
+	return &readOnlyServices{repos: repos}
+}
+
+func (f *readOnlyServices) GlobalLeaderboardService() interfaces.GlobalLeaderboardService {
+	return services.NewGlobalLeaderboardService(f.repos.GlobalLeaderboardRepository())
+}
+
+func (f *readOnlyServices) UserMetricsService() interfaces.UserMetricsService {
+	return services.NewUserMetricsService(
+		f.repos.UserRepository(),
+		f.repos.UserStatsRepository(),
+		f.repos.BetRepository(),
+		f.repos.GroupWagerRepository(),
+		f.repos.BalanceHistoryRepository(),
+		f.repos.BalanceHistoryRollupRepository(),
+	)
+}
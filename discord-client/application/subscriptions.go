@@ -21,10 +21,23 @@ func RegisterApplicationSubscriptions(
 	uowFactory UnitOfWorkFactory,
 	discordPoster DiscordPoster,
 	userResolver UserResolver,
+	bigWinThreshold int64,
 ) error {
 	// Create the wager state event handler
 	wagerStateHandler := NewWagerStateEventHandler(uowFactory, discordPoster)
 
+	// Create the parlay settlement handler
+	parlayHandler := NewParlaySettlementHandler(uowFactory)
+
+	// Create the season token handler
+	seasonTokenHandler := NewSeasonTokenHandler(uowFactory)
+
+	// Create the achievement handler
+	achievementHandler := NewAchievementHandler(uowFactory)
+
+	// Create the webhook event handler
+	webhookHandler := NewWebhookEventHandler(uowFactory, bigWinThreshold)
+
 	// Create the Wordle handler
 	wordleHandler := NewWordleHandler(uowFactory, userResolver)
 
@@ -37,6 +50,40 @@ func RegisterApplicationSubscriptions(
 			})
 		log.Info("Registered local handler for GroupWagerStateChange events")
 
+		localRegistry.RegisterLocalHandler(events.EventTypeGroupWagerStateChange,
+			func(ctx context.Context, event events.Event) error {
+				return parlayHandler.HandleGroupWagerStateChange(ctx, event)
+			})
+		log.Info("Registered local handler for parlay settlement")
+
+		localRegistry.RegisterLocalHandler(events.EventTypeGroupWagerOddsChange,
+			func(ctx context.Context, event events.Event) error {
+				return wagerStateHandler.HandleGroupWagerOddsChange(ctx, event)
+			})
+		log.Info("Registered local handler for GroupWagerOddsChange events")
+
+		localRegistry.RegisterLocalHandler(events.EventTypeBalanceChange,
+			func(ctx context.Context, event events.Event) error {
+				return seasonTokenHandler.HandleBalanceChange(ctx, event)
+			})
+		log.Info("Registered local handler for season token earnings")
+
+		localRegistry.RegisterLocalHandler(events.EventTypeBalanceChange,
+			func(ctx context.Context, event events.Event) error {
+				return achievementHandler.HandleBalanceChange(ctx, event)
+			})
+		log.Info("Registered local handler for achievement progress")
+
+		localRegistry.RegisterLocalHandler(events.EventTypeBalanceChange,
+			func(ctx context.Context, event events.Event) error {
+				return webhookHandler.HandleBalanceChange(ctx, event)
+			})
+		localRegistry.RegisterLocalHandler(events.EventTypeGroupWagerStateChange,
+			func(ctx context.Context, event events.Event) error {
+				return webhookHandler.HandleGroupWagerStateChange(ctx, event)
+			})
+		log.Info("Registered local handlers for outbound webhooks")
+
 		// Register Discord message handler for Wordle bot processing
 		localRegistry.RegisterLocalHandler(events.EventTypeDiscordMessage,
 			func(ctx context.Context, event events.Event) error {
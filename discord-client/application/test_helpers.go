@@ -8,8 +8,11 @@ import (
 
 // MockDiscordPoster implements DiscordPoster for testing
 type MockDiscordPoster struct {
-	Posts []dto.HouseWagerPostDTO
-	Error error
+	Posts           []dto.HouseWagerPostDTO
+	GroupWagerPosts []int64
+	ArchivedThreads []int64
+	PinnedMessages  []int64
+	Error           error
 }
 
 func (m *MockDiscordPoster) PostHouseWager(ctx context.Context, dto dto.HouseWagerPostDTO) (*PostResult, error) {
@@ -34,6 +37,20 @@ func (m *MockDiscordPoster) UpdateHouseWager(ctx context.Context, messageID, cha
 	return nil
 }
 
+// PostGroupWager mock implementation
+func (m *MockDiscordPoster) PostGroupWager(ctx context.Context, guildID, channelID, groupWagerID int64) (*PostResult, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	m.GroupWagerPosts = append(m.GroupWagerPosts, groupWagerID)
+
+	return &PostResult{
+		MessageID: 123456789, // Mock Discord message ID
+		ChannelID: channelID,
+	}, nil
+}
+
 // UpdateGroupWager mock implementation
 func (m *MockDiscordPoster) UpdateGroupWager(ctx context.Context, messageID, channelID int64, detail interface{}) error {
 	if m.Error != nil {
@@ -51,3 +68,21 @@ func (m *MockDiscordPoster) PostDailyAwards(ctx context.Context, dto dto.DailyAw
 	// For tests, we don't need to track daily awards posts, just return success
 	return nil
 }
+
+// ArchiveWagerThread mock implementation
+func (m *MockDiscordPoster) ArchiveWagerThread(ctx context.Context, threadID int64) error {
+	if m.Error != nil {
+		return m.Error
+	}
+	m.ArchivedThreads = append(m.ArchivedThreads, threadID)
+	return nil
+}
+
+// PinMessage mock implementation
+func (m *MockDiscordPoster) PinMessage(ctx context.Context, channelID, messageID int64) error {
+	if m.Error != nil {
+		return m.Error
+	}
+	m.PinnedMessages = append(m.PinnedMessages, messageID)
+	return nil
+}
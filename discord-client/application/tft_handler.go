@@ -25,28 +25,29 @@ func NewTFTHandler(
 	}
 }
 
-// formatTFTQueueType converts TFT queue type strings to user-friendly display names.
-// Returns an empty string for unknown queue types.
-// Only ranked queue types are supported to restrict wagers to competitive games.
-func formatTFTQueueType(queueType string) string {
-	switch queueType {
-	case "TFT_RANKED":
-		return "Ranked TFT"
-	case "TFT_RANKED_DOUBLE_UP":
-		return "Ranked Double Up"
-	default:
-		return "" // Unknown or non-ranked queue type
+// tftPlacementOptions returns the wager options and odds for a game in the queue. Standard queues use
+// the guild's placement layout; other queues use their preset.
+func tftPlacementOptions(queue entities.TFTQueue, gs *entities.GuildSettings) ([]string, []float64) {
+	ranges := queue.Placements(gs.GetTFTPlacementLayout())
+
+	options := make([]string, len(ranges))
+	oddsMultipliers := make([]float64, len(ranges))
+	for i, r := range ranges {
+		options[i] = r.Label
+		oddsMultipliers[i] = r.Odds
 	}
+	return options, oddsMultipliers
 }
 
-// isDoubleUpQueue checks if the queue type is a Double Up mode
-func isDoubleUpQueue(queueType string) bool {
-	switch queueType {
-	case "TFT_DOUBLE_UP", "TFT_NORMAL_DOUBLE_UP", "TFT_RANKED_DOUBLE_UP":
-		return true
-	default:
-		return false
+// selectTFTWinningOption returns the ID of the option whose placements include the result, or 0 if none do.
+// Options are matched by label rather than the guild's current layout so changing layouts never strands a running wager.
+func selectTFTWinningOption(options []entities.GroupWagerOption, placement int32) int64 {
+	for _, opt := range options {
+		if r, ok := entities.ParseTFTPlacementOption(opt.OptionText); ok && r.Contains(placement) {
+			return opt.ID
+		}
 	}
+	return 0
 }
 
 // HandleGameStarted creates house wagers when a TFT game starts
@@ -57,9 +58,9 @@ func (h *TFTHandlerImpl) HandleGameStarted(ctx context.Context, gameStarted dto.
 		"queue":    gameStarted.QueueType,
 	}).Info("handling TFT game start")
 
-	// Validate queue type - drop event if unknown
-	formattedQueue := formatTFTQueueType(gameStarted.QueueType)
-	if formattedQueue == "" {
+	// Validate queue type - drop event if the queue isn't registered
+	queue, ok := entities.LookupTFTQueue(gameStarted.QueueType)
+	if !ok {
 		log.WithFields(log.Fields{
 			"summoner": fmt.Sprintf("%s#%s", gameStarted.SummonerName, gameStarted.TagLine),
 			"gameId":   gameStarted.GameID,
@@ -91,20 +92,7 @@ func (h *TFTHandlerImpl) HandleGameStarted(ctx context.Context, gameStarted dto.
 	// Create a house wager for each watching guild
 	for _, guild := range guilds {
 		// Format the condition with the queue type
-		condition := fmt.Sprintf("%s - **%s**", gameStarted.SummonerName, formattedQueue)
-
-		// Check if this is a Double Up mode (4 teams instead of 8 players)
-		var options []string
-		var oddsMultipliers []float64
-		if isDoubleUpQueue(gameStarted.QueueType) {
-			// Double Up has 4 teams, so placements are 1-4
-			options = []string{"1", "2", "3", "4"}
-			oddsMultipliers = []float64{4.0, 4.0, 4.0, 4.0}
-		} else {
-			// Regular TFT has 8 players with placement ranges
-			options = []string{"1-2", "3-4", "5-6", "7-8"}
-			oddsMultipliers = []float64{4.0, 4.0, 4.0, 4.0}
-		}
+		condition := fmt.Sprintf("%s - **%s**", gameStarted.SummonerName, queue.Name)
 
 		config := WagerCreationConfig{
 			ExternalSystem:      entities.SystemTFT,
@@ -112,13 +100,18 @@ func (h *TFTHandlerImpl) HandleGameStarted(ctx context.Context, gameStarted dto.
 			SummonerName:        gameStarted.SummonerName,
 			TagLine:             gameStarted.TagLine,
 			Condition:           condition,
-			Options:             options,
-			OddsMultipliers:     oddsMultipliers,
-			VotingPeriodMinutes: 5, // 5 minutes for betting
+			VotingPeriodMinutes: queue.VotingPeriodMinutes,
 			ChannelIDGetter: func(gs *entities.GuildSettings) *int64 {
 				return gs.TftChannelID
 			},
 			ChannelName: "tft-channel",
+			OptionCapGetter: func(gs *entities.GuildSettings) *int64 {
+				return gs.HouseOptionCap
+			},
+			OptionsGetter: func(gs *entities.GuildSettings) ([]string, []float64) {
+				return tftPlacementOptions(queue, gs)
+			},
+			ThumbnailURL: queueIconURL(gameStarted.QueueType),
 		}
 
 		if err := h.baseHandler.CreateHouseWagerForGuild(ctx, guild, config); err != nil {
@@ -219,38 +212,7 @@ func (h *TFTHandlerImpl) HandleGameEnded(ctx context.Context, gameEnded dto.TFTG
 		// TFT winner selector: Match placement to the correct option
 		tftWinnerSelector := func(options []entities.GroupWagerOption, result interface{}) int64 {
 			gameResult := result.(dto.TFTGameEndedDTO)
-			placement := gameResult.Placement
-			
-			// Find the winning option by checking if the placement matches
-			for _, opt := range options {
-				// Check for exact match (Double Up: "1", "2", "3", "4")
-				if opt.OptionText == fmt.Sprintf("%d", placement) {
-					return opt.ID
-				}
-				
-				// Check for range match (Regular TFT: "1-2", "3-4", "5-6", "7-8")
-				switch opt.OptionText {
-				case "1-2":
-					if placement == 1 || placement == 2 {
-						return opt.ID
-					}
-				case "3-4":
-					if placement == 3 || placement == 4 {
-						return opt.ID
-					}
-				case "5-6":
-					if placement == 5 || placement == 6 {
-						return opt.ID
-					}
-				case "7-8":
-					if placement == 7 || placement == 8 {
-						return opt.ID
-					}
-				}
-			}
-			
-			// No matching option found
-			return 0
+			return selectTFTWinningOption(options, gameResult.Placement)
 		}
 
 		// TFT has no 10-minute cancellation logic (unlike LoL)
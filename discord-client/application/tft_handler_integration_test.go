@@ -355,13 +355,16 @@ func TestTFTHandler_DoubleUpPlacement(t *testing.T) {
 		expectedOptions   []string
 		expectedWinOption string
 	}{
-		// Double Up games (4 teams) - only ranked queue types create wagers
+		// Double Up games (4 teams) - only registered queue types create wagers
 		{"Double Up 1st", "TFT_RANKED_DOUBLE_UP", 1, []string{"1", "2", "3", "4"}, "1"},
 		{"Double Up 2nd", "TFT_RANKED_DOUBLE_UP", 2, []string{"1", "2", "3", "4"}, "2"},
 		{"Double Up 3rd", "TFT_RANKED_DOUBLE_UP", 3, []string{"1", "2", "3", "4"}, "3"},
 		{"Double Up 4th", "TFT_RANKED_DOUBLE_UP", 4, []string{"1", "2", "3", "4"}, "4"},
 		// Regular TFT for comparison
 		{"Regular TFT 5th", "TFT_RANKED", 5, []string{"1-2", "3-4", "5-6", "7-8"}, "5-6"},
+		// Hyper Roll uses the turbo preset
+		{"Hyper Roll 1st", "TFT_HYPER_ROLL", 1, []string{"1", "2-4", "5-8"}, "1"},
+		{"Hyper Roll 3rd", "TFT_HYPER_ROLL", 3, []string{"1", "2-4", "5-8"}, "2-4"},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,54 @@
+package application
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTFTPlacementOptions(t *testing.T) {
+	t.Parallel()
+
+	exact := string(entities.TFTPlacementLayoutExact)
+	settings := &entities.GuildSettings{TftPlacementLayout: &exact}
+
+	ranked, _ := entities.LookupTFTQueue("TFT_RANKED")
+	doubleUp, _ := entities.LookupTFTQueue("TFT_RANKED_DOUBLE_UP")
+	hyperRoll, _ := entities.LookupTFTQueue("TFT_HYPER_ROLL")
+
+	options, odds := tftPlacementOptions(ranked, &entities.GuildSettings{})
+	assert.Equal(t, []string{"1-2", "3-4", "5-6", "7-8"}, options)
+	assert.Equal(t, []float64{4.0, 4.0, 4.0, 4.0}, odds)
+
+	options, odds = tftPlacementOptions(ranked, settings)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5", "6", "7", "8"}, options)
+	assert.Equal(t, 8.0, odds[0])
+
+	// Double Up ignores the layout since only 4 teams place
+	options, _ = tftPlacementOptions(doubleUp, settings)
+	assert.Equal(t, []string{"1", "2", "3", "4"}, options)
+
+	// Hyper Roll uses the turbo preset regardless of layout
+	options, odds = tftPlacementOptions(hyperRoll, settings)
+	assert.Equal(t, []string{"1", "2-4", "5-8"}, options)
+	assert.Equal(t, []float64{8.0, 2.67, 2.0}, odds)
+}
+
+func TestSelectTFTWinningOption(t *testing.T) {
+	t.Parallel()
+
+	pairs := []entities.GroupWagerOption{
+		{ID: 1, OptionText: "1-2"}, {ID: 2, OptionText: "3-4"}, {ID: 3, OptionText: "5-6"}, {ID: 4, OptionText: "7-8"},
+	}
+	topBottom := []entities.GroupWagerOption{
+		{ID: 10, OptionText: "Top 4"}, {ID: 11, OptionText: "Bottom 4"},
+	}
+
+	assert.Equal(t, int64(2), selectTFTWinningOption(pairs, 4))
+	assert.Equal(t, int64(4), selectTFTWinningOption(pairs, 8))
+	assert.Equal(t, int64(10), selectTFTWinningOption(topBottom, 4))
+	assert.Equal(t, int64(11), selectTFTWinningOption(topBottom, 5))
+	assert.Equal(t, int64(0), selectTFTWinningOption(topBottom, 9))
+}
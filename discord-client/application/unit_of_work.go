@@ -20,6 +20,7 @@ type UnitOfWork interface {
 	// Repository getters
 	UserRepository() interfaces.UserRepository
 	BalanceHistoryRepository() interfaces.BalanceHistoryRepository
+	BalanceHistoryRollupRepository() interfaces.BalanceHistoryRollupRepository
 	BetRepository() interfaces.BetRepository
 	WagerRepository() interfaces.WagerRepository
 	WagerVoteRepository() interfaces.WagerVoteRepository
@@ -31,11 +32,53 @@ type UnitOfWork interface {
 	LotteryDrawRepository() interfaces.LotteryDrawRepository
 	LotteryTicketRepository() interfaces.LotteryTicketRepository
 	LotteryWinnerRepository() interfaces.LotteryWinnerRepository
+	ExperimentRepository() interfaces.ExperimentRepository
+	SavingsDepositRepository() interfaces.SavingsDepositRepository
+	BalanceFreezeRepository() interfaces.BalanceFreezeRepository
+	ParlayRepository() interfaces.ParlayRepository
+	GuildResolverRepository() interfaces.GuildResolverRepository
+	GuildFeatureFlagRepository() interfaces.GuildFeatureFlagRepository
+	GuildWebhookRepository() interfaces.GuildWebhookRepository
+	WebhookDeliveryRepository() interfaces.WebhookDeliveryRepository
+	DiscordOutboxRepository() interfaces.DiscordOutboxRepository
+	GroupWagerFollowerRepository() interfaces.GroupWagerFollowerRepository
+	GuildSnapshotRepository() interfaces.GuildSnapshotRepository
+	DuelRepository() interfaces.DuelRepository
+	LotterySubscriptionRepository() interfaces.LotterySubscriptionRepository
+	UserPreferencesRepository() interfaces.UserPreferencesRepository
+	RiotAccountLinkRepository() interfaces.RiotAccountLinkRepository
+	UserStatsRepository() interfaces.UserStatsRepository
+	SeasonTokenRepository() interfaces.SeasonTokenRepository
+	DataRetentionRepository() interfaces.DataRetentionRepository
+	AchievementRepository() interfaces.AchievementRepository
 	EventBus() interfaces.EventPublisher
+
+	// Services returns a factory for domain services wired to this unit of work's repositories
+	Services() ServiceFactory
 }
 
 // UnitOfWorkFactory defines the interface for creating UnitOfWork instances
 type UnitOfWorkFactory interface {
 	// CreateForGuild creates a new UnitOfWork instance scoped to a specific guild
 	CreateForGuild(guildID int64) UnitOfWork
+	// CreateReadOnlyForGuild returns guild-scoped repositories for heavy read paths such as stats and scoreboards
+	CreateReadOnlyForGuild(guildID int64) ReadOnlyRepositories
+}
+
+// ReadOnlyRepositories exposes repositories that read from the replica when one is configured.
+// They run outside any transaction and may lag the primary, so they must never be used to decide a write.
+type ReadOnlyRepositories interface {
+	UserRepository() interfaces.UserRepository
+	WagerRepository() interfaces.WagerRepository
+	BetRepository() interfaces.BetRepository
+	GroupWagerRepository() interfaces.GroupWagerRepository
+	BalanceHistoryRepository() interfaces.BalanceHistoryRepository
+	BalanceHistoryRollupRepository() interfaces.BalanceHistoryRollupRepository
+	UserStatsRepository() interfaces.UserStatsRepository
+
+	// GlobalLeaderboardRepository reads across every guild that shares its stats, not just this one
+	GlobalLeaderboardRepository() interfaces.GlobalLeaderboardRepository
+
+	// Services returns a factory for the read-only services wired to these repositories
+	Services() ReadOnlyServiceFactory
 }
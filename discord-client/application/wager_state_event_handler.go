@@ -6,7 +6,6 @@ import (
 
 	"gambler/discord-client/application/dto"
 	"gambler/discord-client/domain/events"
-	"gambler/discord-client/domain/services"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -41,49 +40,77 @@ func (h *wagerStateEventHandler) HandleGroupWagerStateChange(ctx context.Context
 		return fmt.Errorf("missing messageID or channelID")
 	}
 
+	return h.refreshWagerMessage(ctx, e.GuildID, e.GroupWagerID)
+}
+
+// HandleGroupWagerOddsChange handles GroupWagerOddsChangeEvent and refreshes the wager message with the new odds
+func (h *wagerStateEventHandler) HandleGroupWagerOddsChange(ctx context.Context, event interface{}) error {
+	e, err := AssertEventType[events.GroupWagerOddsChangeEvent](event, "GroupWagerOddsChangeEvent")
+	if err != nil {
+		return err
+	}
+
+	log.Infof("WagerStateEventHandler: handling odds change for wager %d", e.GroupWagerID)
+
+	// Skip if no message to update
+	if e.MessageID == 0 || e.ChannelID == 0 {
+		log.Errorf("Failed to handle group wager odds change, missing messageID or channelID.")
+		return fmt.Errorf("missing messageID or channelID")
+	}
+
+	return h.refreshWagerMessage(ctx, e.GuildID, e.GroupWagerID)
+}
+
+// refreshWagerMessage fetches the latest wager detail and re-renders its Discord message
+func (h *wagerStateEventHandler) refreshWagerMessage(ctx context.Context, guildID, groupWagerID int64) error {
 	// Create guild-scoped unit of work using GuildID from event
-	uow := h.uowFactory.CreateForGuild(e.GuildID)
+	uow := h.uowFactory.CreateForGuild(guildID)
 	if err := uow.Begin(ctx); err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer uow.Rollback()
 
 	// Instantiate service with repositories from UnitOfWork
-	groupWagerService := services.NewGroupWagerService(
-		uow.GroupWagerRepository(),
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	groupWagerService := uow.Services().GroupWagerService()
 
 	// Fetch the latest wager detail
-	detail, err := groupWagerService.GetGroupWagerDetail(ctx, e.GroupWagerID)
+	detail, err := groupWagerService.GetGroupWagerDetail(ctx, groupWagerID)
 	if err != nil {
-		return fmt.Errorf("failed to get wager detail for ID %d: %w", e.GroupWagerID, err)
+		return fmt.Errorf("failed to get wager detail for ID %d: %w", groupWagerID, err)
 	}
 
 	if detail == nil {
-		return fmt.Errorf("wager with ID %d not found", e.GroupWagerID)
+		return fmt.Errorf("wager with ID %d not found", groupWagerID)
 	}
 
 	// We don't need to commit this transaction since we're only reading
 	// But we need to properly close it
 	if err := uow.Commit(); err != nil {
-		log.Warnf("Failed to commit read-only transaction for wager %d: %v", e.GroupWagerID, err)
+		log.Warnf("Failed to commit read-only transaction for wager %d: %v", groupWagerID, err)
 	}
 
 	// Determine wager type and update accordingly
+	var updateErr error
 	if detail.Wager.IsHouseWager() {
 
 		// Convert to HouseWagerPostDTO using our converter
 		houseWagerDTO := dto.GroupWagerDetailToHouseWagerPostDTO(detail)
 
 		// Update the Discord message
-		return h.discordPoster.UpdateHouseWager(ctx, detail.Wager.MessageID, detail.Wager.ChannelID, houseWagerDTO)
+		updateErr = h.discordPoster.UpdateHouseWager(ctx, detail.Wager.MessageID, detail.Wager.ChannelID, houseWagerDTO)
 	} else {
 		log.Infof("Updating group wager message: wagerID=%d, state=%s", detail.Wager.ID, detail.Wager.State)
 
 		// For regular group wagers, pass the detail directly
-		return h.discordPoster.UpdateGroupWager(ctx, detail.Wager.MessageID, detail.Wager.ChannelID, detail)
+		updateErr = h.discordPoster.UpdateGroupWager(ctx, detail.Wager.MessageID, detail.Wager.ChannelID, detail)
+	}
+
+	// Close the discussion thread once the wager is resolved or cancelled
+	if detail.Wager.ShouldArchiveThread() {
+		if err := h.discordPoster.ArchiveWagerThread(ctx, *detail.Wager.ThreadID); err != nil {
+			log.Warnf("Failed to archive discussion thread for wager %d: %v", detail.Wager.ID, err)
+		}
 	}
+
+	return updateErr
 }
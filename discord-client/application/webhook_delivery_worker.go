@@ -0,0 +1,113 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Webhook delivery worker tuning
+const (
+	WebhookDeliveryInterval = 30 * time.Second
+	webhookDeliveryBatch    = 50 // Deliveries sent per run, so a slow endpoint can't hold up the scheduler
+)
+
+// WebhookDeliveryWorker sends queued webhook deliveries and retries failed ones with backoff
+type WebhookDeliveryWorker struct {
+	uowFactory UnitOfWorkFactory
+	sender     interfaces.WebhookSender
+}
+
+// NewWebhookDeliveryWorker creates a new webhook delivery worker
+func NewWebhookDeliveryWorker(uowFactory UnitOfWorkFactory, sender interfaces.WebhookSender) *WebhookDeliveryWorker {
+	return &WebhookDeliveryWorker{
+		uowFactory: uowFactory,
+		sender:     sender,
+	}
+}
+
+// Job returns the scheduler job that sends due deliveries every interval.
+// It runs on start to send deliveries queued while the bot was offline.
+func (w *WebhookDeliveryWorker) Job(interval time.Duration) Job {
+	return Job{
+		Name:       "webhook-delivery",
+		Interval:   interval,
+		Jitter:     5 * time.Second,
+		RunOnStart: true,
+		Run:        w.processDueDeliveries,
+	}
+}
+
+// processDueDeliveries sends every delivery that is due, up to the batch size
+func (w *WebhookDeliveryWorker) processDueDeliveries(ctx context.Context) error {
+	// Cross-guild query to find due deliveries
+	uow := w.uowFactory.CreateForGuild(0)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	deliveries, err := uow.WebhookDeliveryRepository().GetDueDeliveries(ctx, time.Now().UTC(), webhookDeliveryBatch)
+	uow.Rollback()
+	if err != nil {
+		return fmt.Errorf("failed to get due webhook deliveries: %w", err)
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	var deliveredCount, retryCount, failedCount int
+	for _, delivery := range deliveries {
+		result, err := w.deliver(ctx, delivery.GuildID, delivery.ID)
+		if err != nil {
+			log.Errorf("Error sending webhook delivery %d for guild %d: %v", delivery.ID, delivery.GuildID, err)
+			failedCount++
+			continue
+		}
+		if result == nil {
+			continue
+		}
+
+		switch result.Status {
+		case entities.WebhookDeliveryStatusDelivered:
+			deliveredCount++
+		case entities.WebhookDeliveryStatusFailed:
+			failedCount++
+			log.WithFields(log.Fields{
+				"deliveryID": result.ID,
+				"webhookID":  result.WebhookID,
+				"guildID":    result.GuildID,
+				"attempts":   result.Attempts,
+			}).Warn("Webhook delivery failed permanently")
+		default:
+			retryCount++
+		}
+	}
+
+	log.Infof("Webhook deliveries complete: %d delivered, %d retrying, %d failed", deliveredCount, retryCount, failedCount)
+	return nil
+}
+
+// deliver sends a single delivery in its own guild-scoped transaction
+func (w *WebhookDeliveryWorker) deliver(ctx context.Context, guildID, deliveryID int64) (*entities.WebhookDelivery, error) {
+	uow := w.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	delivery, err := uow.Services().WebhookService().Deliver(ctx, deliveryID, w.sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,106 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// WebhookEventHandler queues webhook deliveries for big wins and resolved wagers
+type WebhookEventHandler struct {
+	uowFactory      UnitOfWorkFactory
+	bigWinThreshold int64
+}
+
+// NewWebhookEventHandler creates a new webhook event handler. Wins of at least bigWinThreshold are sent as big wins.
+func NewWebhookEventHandler(uowFactory UnitOfWorkFactory, bigWinThreshold int64) *WebhookEventHandler {
+	return &WebhookEventHandler{
+		uowFactory:      uowFactory,
+		bigWinThreshold: bigWinThreshold,
+	}
+}
+
+// HandleBalanceChange queues a big_win delivery for a win of at least the big win threshold
+func (h *WebhookEventHandler) HandleBalanceChange(ctx context.Context, event interface{}) error {
+	e, err := AssertEventType[events.BalanceChangeEvent](event, "BalanceChangeEvent")
+	if err != nil {
+		return err
+	}
+
+	if !e.TransactionType.IsWinType() || e.ChangeAmount < h.bigWinThreshold {
+		return nil
+	}
+
+	return h.enqueue(ctx, e.GuildID, entities.WebhookEventBigWin, func(ServiceFactory) (any, error) {
+		return entities.WebhookBigWinData{
+			DiscordID:       entities.FormatWebhookID(e.UserID),
+			Amount:          e.ChangeAmount,
+			NewBalance:      e.NewBalance,
+			TransactionType: e.TransactionType,
+		}, nil
+	})
+}
+
+// HandleGroupWagerStateChange queues a wager_resolved delivery when a group or house wager is resolved
+func (h *WebhookEventHandler) HandleGroupWagerStateChange(ctx context.Context, event interface{}) error {
+	e, err := AssertEventType[events.GroupWagerStateChangeEvent](event, "GroupWagerStateChangeEvent")
+	if err != nil {
+		return err
+	}
+
+	if e.NewState != string(entities.GroupWagerStateResolved) {
+		return nil
+	}
+
+	return h.enqueue(ctx, e.GuildID, entities.WebhookEventWagerResolved, func(services ServiceFactory) (any, error) {
+		detail, err := services.GroupWagerService().GetGroupWagerDetail(ctx, e.GroupWagerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get group wager %d: %w", e.GroupWagerID, err)
+		}
+		return entities.NewWebhookWagerResolvedData(detail), nil
+	})
+}
+
+// enqueue queues the event for the guild's subscribed webhooks. The payload is only built when a webhook
+// is subscribed, so guilds without webhooks don't pay for it.
+func (h *WebhookEventHandler) enqueue(ctx context.Context, guildID int64, eventType entities.WebhookEventType, buildData func(ServiceFactory) (any, error)) error {
+	uow := h.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	subscribed, err := uow.GuildWebhookRepository().GetSubscribed(ctx, eventType)
+	if err != nil {
+		return fmt.Errorf("failed to get webhooks subscribed to %s: %w", eventType, err)
+	}
+	if len(subscribed) == 0 {
+		return nil
+	}
+
+	data, err := buildData(uow.Services())
+	if err != nil {
+		return err
+	}
+
+	queued, err := uow.Services().WebhookService().Enqueue(ctx, guildID, eventType, data)
+	if err != nil {
+		return fmt.Errorf("failed to queue %s webhooks: %w", eventType, err)
+	}
+
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit webhook deliveries: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"guildID":   guildID,
+		"eventType": eventType,
+		"queued":    queued,
+	}).Debug("Queued webhook deliveries")
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// WeeklyDigestPoster defines the interface for posting the weekly economy digest to Discord
+type WeeklyDigestPoster interface {
+	// PostWeeklyDigest posts the digest embed to the given channel
+	PostWeeklyDigest(ctx context.Context, channelID int64, digest *entities.WeeklyDigest) error
+}
+
+// WeeklyDigestWorker compiles and posts the weekly economy digest of every guild with a digest channel
+type WeeklyDigestWorker struct {
+	uowFactory     UnitOfWorkFactory
+	guildDiscovery GuildDiscoveryService
+	digestPoster   WeeklyDigestPoster
+}
+
+// NewWeeklyDigestWorker creates a new weekly digest worker
+func NewWeeklyDigestWorker(uowFactory UnitOfWorkFactory, guildDiscovery GuildDiscoveryService, digestPoster WeeklyDigestPoster) *WeeklyDigestWorker {
+	return &WeeklyDigestWorker{
+		uowFactory:     uowFactory,
+		guildDiscovery: guildDiscovery,
+		digestPoster:   digestPoster,
+	}
+}
+
+// Job returns the scheduler job that posts the digest at hour:00 UTC on the given day each week
+func (w *WeeklyDigestWorker) Job(day time.Weekday, hour int) Job {
+	return Job{
+		Name:     "weekly-digest",
+		Interval: 7 * 24 * time.Hour,
+		NextRun: func(ctx context.Context, now time.Time) time.Time {
+			return nextWeeklyRun(now, day, hour)
+		},
+		Run: func(ctx context.Context) error {
+			log.Info("Posting weekly digest for all guilds")
+			return w.processAllGuilds(ctx, time.Now())
+		},
+	}
+}
+
+// nextWeeklyRun returns the next time the clock reaches hour:00 UTC on day after now
+func nextWeeklyRun(now time.Time, day time.Weekday, hour int) time.Time {
+	next := nextDailyRun(now, hour)
+	for next.Weekday() != day {
+		next = next.Add(24 * time.Hour)
+	}
+	return next
+}
+
+// processAllGuilds posts the digest covering the week up to now in every guild this shard serves
+func (w *WeeklyDigestWorker) processAllGuilds(ctx context.Context, now time.Time) error {
+	guilds, err := w.guildDiscovery.GetGuildsWithPrimaryChannel(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get guilds: %w", err)
+	}
+
+	to := now.UTC().Truncate(time.Hour)
+	from := to.AddDate(0, 0, -7)
+
+	var postedCount, failureCount int
+	for _, guild := range guilds {
+		posted, err := w.processGuildDigest(ctx, guild.GuildID, from, to)
+		if err != nil {
+			log.Errorf("Error posting weekly digest for guild %d: %v", guild.GuildID, err)
+			failureCount++
+			continue
+		}
+		if posted {
+			postedCount++
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"total_guilds": len(guilds),
+		"posted":       postedCount,
+		"failed":       failureCount,
+		"from":         from.Format(time.DateOnly),
+		"to":           to.Format(time.DateOnly),
+	}).Info("Completed weekly digest processing")
+
+	return nil
+}
+
+// processGuildDigest compiles and posts a guild's digest. Returns false if the guild has no digest
+// channel or nothing happened during the week.
+func (w *WeeklyDigestWorker) processGuildDigest(ctx context.Context, guildID int64, from, to time.Time) (bool, error) {
+	uow := w.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	settings, err := uow.GuildSettingsRepository().GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	if !settings.HasDigestChannel() {
+		return false, nil
+	}
+
+	// Prediction stats scan every resolved wager in the guild, so they come from the read replica
+	metricsService := w.uowFactory.CreateReadOnlyForGuild(guildID).Services().UserMetricsService()
+	digestService := uow.Services().WeeklyDigestService(metricsService)
+
+	digest, err := digestService.BuildDigest(ctx, guildID, from, to)
+	if err != nil {
+		return false, fmt.Errorf("failed to build digest: %w", err)
+	}
+
+	// Rollback the read-only transaction before talking to Discord
+	uow.Rollback()
+
+	if !digest.HasActivity() {
+		log.Debugf("No economy activity for guild %d this week, skipping digest", guildID)
+		return false, nil
+	}
+
+	if err := w.digestPoster.PostWeeklyDigest(ctx, *settings.DigestChannelID, digest); err != nil {
+		return false, fmt.Errorf("failed to post digest: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"guild_id":   guildID,
+		"channel_id": *settings.DigestChannelID,
+	}).Info("Weekly digest posted")
+
+	return true, nil
+}
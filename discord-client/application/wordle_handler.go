@@ -10,7 +10,6 @@ import (
 	"gambler/discord-client/config"
 	"gambler/discord-client/domain/entities"
 	"gambler/discord-client/domain/events"
-	"gambler/discord-client/domain/services"
 
 	"github.com/jackc/pgx/v5"
 
@@ -76,7 +75,7 @@ func (h *wordleHandler) HandleDiscordMessage(ctx context.Context, event interfac
 	}
 	defer uow.Rollback()
 
-	guildSettingsService := services.NewGuildSettingsService(uow.GuildSettingsRepository())
+	guildSettingsService := uow.Services().GuildSettingsService()
 	settings, err := guildSettingsService.GetOrCreateSettings(ctx, guildID)
 	if err != nil {
 		return fmt.Errorf("failed to get guild settings: %w", err)
@@ -171,8 +170,8 @@ func (h *wordleHandler) processWordleResult(ctx context.Context, result WordleRe
 	}
 
 	// Get or create user first (required for FK constraint on wordle_completions)
-	userService := services.NewUserService(uow.UserRepository(), uow.BalanceHistoryRepository(), uow.EventBus())
-	user, err := userService.GetOrCreateUser(ctx, userID, fmt.Sprintf("User%d", userID))
+	userService := uow.Services().UserService()
+	user, err := userService.GetOrCreateUser(ctx, guildID, userID, fmt.Sprintf("User%d", userID))
 	if err != nil {
 		return fmt.Errorf("failed to get or create user: %w", err)
 	}
@@ -189,13 +188,7 @@ func (h *wordleHandler) processWordleResult(ctx context.Context, result WordleRe
 	}
 
 	// Create DailyAwardsService with guild-scoped repositories
-	dailyAwardsService := services.NewDailyAwardsService(
-		uow.WordleCompletionRepo(),
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.BetRepository(),
-		uow.GroupWagerRepository(),
-	)
+	dailyAwardsService := uow.Services().DailyAwardsService()
 
 	// Calculate reward including streak bonus
 	reward, err := dailyAwardsService.CalculateWordleReward(ctx, uow.WordleCompletionRepo(), userID, guildID, score)
@@ -3,25 +3,43 @@ package bot
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 
 	"gambler/discord-client/application"
 	"gambler/discord-client/application/dto"
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/bot/features/audit"
 	"gambler/discord-client/bot/features/balance"
 	"gambler/discord-client/bot/features/betting"
 	"gambler/discord-client/bot/features/dailyawards"
+	"gambler/discord-client/bot/features/digest"
+	"gambler/discord-client/bot/features/duel"
+	"gambler/discord-client/bot/features/export"
+	"gambler/discord-client/bot/features/featureflags"
+	"gambler/discord-client/bot/features/freeze"
+	"gambler/discord-client/bot/features/gambabreak"
 	"gambler/discord-client/bot/features/groupwagers"
 	"gambler/discord-client/bot/features/highroller"
 	"gambler/discord-client/bot/features/housewagers"
+	"gambler/discord-client/bot/features/link"
 	"gambler/discord-client/bot/features/lottery"
+	"gambler/discord-client/bot/features/lowbalance"
+	"gambler/discord-client/bot/features/parlay"
+	"gambler/discord-client/bot/features/preferences"
+	"gambler/discord-client/bot/features/resolver"
+	"gambler/discord-client/bot/features/rules"
+	"gambler/discord-client/bot/features/savings"
 	"gambler/discord-client/bot/features/settings"
 	"gambler/discord-client/bot/features/stats"
 	"gambler/discord-client/bot/features/summoner"
 	"gambler/discord-client/bot/features/transfer"
+	"gambler/discord-client/bot/features/wagerfollowers"
 	"gambler/discord-client/bot/features/wagers"
+	"gambler/discord-client/bot/features/webhooks"
+	"gambler/discord-client/domain/entities"
 	"gambler/discord-client/domain/interfaces"
-	"gambler/discord-client/domain/services"
 
 	summoner_pb "gambler/discord-client/proto/services"
 
@@ -34,6 +52,8 @@ type Config struct {
 	Token          string
 	GuildID        string
 	GambaChannelID string
+	ShardID        int // Shard served by this process
+	ShardCount     int // Total number of shards, 0 uses Discord's recommended count
 }
 
 // Bot manages the Discord bot and all feature modules
@@ -44,6 +64,7 @@ type Bot struct {
 	uowFactory     application.UnitOfWorkFactory
 	summonerClient summoner_pb.SummonerTrackingServiceClient
 	userResolver   application.UserResolver
+	shard          shardState
 
 	// Event publishing
 	eventPublisher interfaces.EventPublisher
@@ -59,12 +80,36 @@ type Bot struct {
 	settings    *settings.Feature
 	summoner    *summoner.Feature
 	dailyAwards *dailyawards.Feature
+	digest      *digest.Feature
 	highroller  *highroller.Feature
 	lottery     *lottery.Feature
-
-	// Worker cleanup functions
-	stopGroupWagerWorker  func()
-	stopDailyAwardsWorker func()
+	audit       *audit.Feature
+	lowBalance  *lowbalance.Feature
+	followers   *wagerfollowers.Feature
+	gambaBreak  *gambabreak.Feature
+	rules       *rules.Feature
+	savings     *savings.Feature
+	parlay      *parlay.Feature
+	resolver    *resolver.Feature
+	freeze      *freeze.Feature
+	duel        *duel.Feature
+	export      *export.Feature
+	features    *featureflags.Feature
+	preferences *preferences.Feature
+	link        *link.Feature
+	webhooks    *webhooks.Feature
+
+	// Slash command handlers wrapped in the command pipeline, keyed by command name
+	commands map[string]common.CommandHandler
+
+	// Background job scheduler, reported by the debug API health checks
+	scheduler *application.Scheduler
+
+	// Receives external match results through the debug API, nil when the webhook is disabled
+	resultWebhook http.Handler
+
+	// Latest guild economy snapshots, exported by the debug API metrics endpoint
+	economyGauges economyGaugeCache
 }
 
 // New creates a new bot instance with all features
@@ -76,8 +121,13 @@ func New(config Config, uowFactory application.UnitOfWorkFactory, summonerClient
 	}
 	dg.Identify.Intents = discordgo.IntentsAll
 
+	if err := configureSharding(dg, config); err != nil {
+		return nil, err
+	}
+
 	// Create shared components
 	userResolver := NewUserResolver(dg)
+	limiter := common.NewRateLimiter(loadRateLimit(uowFactory))
 
 	// Create bot instance
 	bot := &Bot{
@@ -90,43 +140,65 @@ func New(config Config, uowFactory application.UnitOfWorkFactory, summonerClient
 	}
 
 	// Create feature modules
-	bot.betting = betting.New(uowFactory)
+	bot.betting = betting.New(uowFactory, limiter)
 	bot.wagers = wagers.NewFeature(dg, uowFactory, config.GuildID)
-	bot.groupWagers = groupwagers.NewFeature(dg, uowFactory)
-	bot.houseWagers = housewagers.NewFeature(dg, uowFactory)
+	bot.groupWagers = groupwagers.NewFeature(dg, uowFactory, limiter)
+	bot.houseWagers = housewagers.NewFeature(dg, uowFactory, limiter)
 	bot.stats = stats.NewFeature(dg, uowFactory, config.GuildID, userResolver)
 	bot.balance = balance.New(uowFactory)
-	bot.transfer = transfer.New(uowFactory)
+	bot.transfer = transfer.New(uowFactory, limiter)
 	bot.summoner = summoner.NewFeature(dg, uowFactory, summonerClient, config.GuildID)
 	bot.dailyAwards = dailyawards.NewFeature(dg, uowFactory)
+	bot.digest = digest.NewFeature(dg)
 	bot.highroller = highroller.NewFeature(dg, uowFactory)
-	bot.lottery = lottery.NewFeature(dg, uowFactory)
+	bot.lottery = lottery.NewFeature(dg, uowFactory, limiter)
+	bot.audit = audit.NewFeature(dg, uowFactory)
+	bot.lowBalance = lowbalance.NewFeature(dg, uowFactory)
+	bot.followers = wagerfollowers.NewFeature(dg, uowFactory)
+	bot.gambaBreak = gambabreak.New(uowFactory)
+	bot.rules = rules.New(uowFactory)
+	bot.savings = savings.New(uowFactory)
+	bot.parlay = parlay.New(uowFactory)
+	bot.resolver = resolver.New(uowFactory)
+	bot.freeze = freeze.New(uowFactory)
+	bot.duel = duel.New(uowFactory)
+	bot.export = export.New()
+	bot.features = featureflags.New(uowFactory)
+	bot.preferences = preferences.New(uowFactory)
+	bot.link = link.New(uowFactory)
+	bot.webhooks = webhooks.New(uowFactory)
 	// Settings depends on lottery for posting lottery messages when channel is configured
 	bot.settings = settings.NewFeature(dg, uowFactory, bot.lottery)
+	bot.commands = bot.buildCommandRoutes()
+
+	// New group wagers are posted through the outbox, which posts them back through the feature
+	bot.groupWagers.SetOutboxDispatcher(application.NewDiscordOutboxDispatcher(uowFactory, bot.GetDiscordPoster()))
 
 	// Register handlers
 	dg.AddHandler(bot.handleCommands)
 	dg.AddHandler(bot.handleInteractions)
 	dg.AddHandler(bot.handleGuildCreate)
+	dg.AddHandler(bot.handleGuildDelete)
+	dg.AddHandler(bot.handleGuildMemberAdd)
 	dg.AddHandler(bot.handleMessageCreate)
+	dg.AddHandler(bot.handleMessageReactionAdd)
+	dg.AddHandler(bot.handleConnect)
+	dg.AddHandler(bot.handleDisconnect)
 
 	// Open websocket connection
+	log.Infof("Opening gateway connection for shard %d/%d", dg.ShardID, dg.ShardCount)
 	if err := dg.Open(); err != nil {
-		return nil, fmt.Errorf("error opening connection: %w", err)
+		return nil, fmt.Errorf("error opening connection for shard %d: %w", dg.ShardID, err)
 	}
 
-	// Register slash commands with Discord
-	if err := bot.registerCommands(); err != nil {
-		dg.Close()
-		return nil, fmt.Errorf("error registering commands: %w", err)
+	// Commands are global, so only the primary shard registers them
+	if bot.IsPrimaryShard() {
+		if err := bot.registerCommands(); err != nil {
+			dg.Close()
+			return nil, fmt.Errorf("error registering commands: %w", err)
+		}
 	}
 
-
-	// Start background workers
-	ctx := context.Background()
-	bot.stopGroupWagerWorker = bot.StartGroupWagerExpirationWorker(ctx)
-	log.Info("Background workers started")
-
 	// Always start debug API
 	debugPort := 8899
 	if err := bot.StartDebugAPI(debugPort); err != nil {
@@ -139,6 +211,7 @@ func New(config Config, uowFactory application.UnitOfWorkFactory, summonerClient
 // GetDiscordPoster returns a DiscordPoster implementation that supports both operations
 func (b *Bot) GetDiscordPoster() application.DiscordPoster {
 	return &discordPoster{
+		session:     b.session,
 		houseWagers: b.houseWagers,
 		groupWagers: b.groupWagers,
 		dailyAwards: b.dailyAwards,
@@ -147,15 +220,7 @@ func (b *Bot) GetDiscordPoster() application.DiscordPoster {
 
 // Close gracefully shuts down the bot
 func (b *Bot) Close() error {
-	// Stop background workers
-	if b.stopGroupWagerWorker != nil {
-		b.stopGroupWagerWorker()
-	}
-	if b.stopDailyAwardsWorker != nil {
-		b.stopDailyAwardsWorker()
-	}
-	log.Info("Background workers stopped")
-
+	log.Infof("Closing gateway connection for shard %d/%d", b.session.ShardID, b.session.ShardCount)
 	return b.session.Close()
 }
 
@@ -164,9 +229,14 @@ func (b *Bot) GetSession() *discordgo.Session {
 	return b.session
 }
 
-// SetDailyAwardsWorkerCleanup sets the cleanup function for the daily awards worker
-func (b *Bot) SetDailyAwardsWorkerCleanup(cleanup func()) {
-	b.stopDailyAwardsWorker = cleanup
+// SetScheduler sets the background job scheduler whose status the debug API reports
+func (b *Bot) SetScheduler(scheduler *application.Scheduler) {
+	b.scheduler = scheduler
+}
+
+// SetResultWebhook sets the handler the debug API serves external match results with
+func (b *Bot) SetResultWebhook(handler http.Handler) {
+	b.resultWebhook = handler
 }
 
 // GetConfig returns the bot configuration
@@ -179,31 +249,30 @@ func (b *Bot) GetLotteryPoster() application.LotteryPoster {
 	return b.lottery
 }
 
-// handleCommands routes slash commands to appropriate handlers
-func (b *Bot) handleCommands(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	if i.Type != discordgo.InteractionApplicationCommand {
-		return
-	}
+// GetWeeklyDigestPoster returns the digest feature as a WeeklyDigestPoster
+func (b *Bot) GetWeeklyDigestPoster() application.WeeklyDigestPoster {
+	return b.digest
+}
+
+// loadRateLimit reads a guild's interaction rate limit from its settings
+func loadRateLimit(uowFactory application.UnitOfWorkFactory) common.RateLimitLoader {
+	return func(ctx context.Context, guildID int64) (entities.RateLimit, error) {
+		uow := uowFactory.CreateForGuild(guildID)
+		if err := uow.Begin(ctx); err != nil {
+			return entities.RateLimit{}, fmt.Errorf("failed to begin transaction: %w", err)
+		}
+		defer uow.Rollback()
+
+		settings, err := uow.GuildSettingsRepository().GetOrCreateGuildSettings(ctx, guildID)
+		if err != nil {
+			return entities.RateLimit{}, fmt.Errorf("failed to get guild settings: %w", err)
+		}
+
+		if err := uow.Commit(); err != nil {
+			return entities.RateLimit{}, fmt.Errorf("failed to commit transaction: %w", err)
+		}
 
-	switch i.ApplicationCommandData().Name {
-	case "balance":
-		b.balance.HandleCommand(s, i)
-	case "gamble":
-		b.betting.HandleCommand(s, i)
-	case "donate":
-		b.transfer.HandleCommand(s, i)
-	case "wager":
-		b.wagers.HandleCommand(s, i)
-	case "groupwager":
-		b.groupWagers.HandleCommand(s, i)
-	case "stats":
-		b.stats.HandleCommand(s, i)
-	case "settings":
-		b.settings.HandleCommand(s, i)
-	case "summoner":
-		b.summoner.HandleCommand(s, i)
-	case "highroller":
-		b.highroller.HandleCommand(s, i)
+		return settings.GetRateLimit(), nil
 	}
 }
 
@@ -212,10 +281,16 @@ func (b *Bot) handleInteractions(s *discordgo.Session, i *discordgo.InteractionC
 	switch i.Type {
 	case discordgo.InteractionMessageComponent:
 		customID := i.MessageComponentData().CustomID
+		if feature, ok := interactionFeature(customID); ok && !b.featureEnabled(s, i, feature) {
+			return
+		}
 		b.routeComponentInteraction(s, i, customID)
 
 	case discordgo.InteractionModalSubmit:
 		customID := i.ModalSubmitData().CustomID
+		if feature, ok := interactionFeature(customID); ok && !b.featureEnabled(s, i, feature) {
+			return
+		}
 		b.routeModalInteraction(s, i, customID)
 	}
 }
@@ -240,6 +315,9 @@ func (b *Bot) routeComponentInteraction(s *discordgo.Session, i *discordgo.Inter
 
 	case strings.HasPrefix(customID, "lotto_"):
 		b.lottery.HandleInteraction(s, i)
+
+	case strings.HasPrefix(customID, "duel_"):
+		b.duel.HandleInteraction(s, i)
 	}
 }
 
@@ -260,6 +338,9 @@ func (b *Bot) routeModalInteraction(s *discordgo.Session, i *discordgo.Interacti
 
 	case strings.HasPrefix(customID, "lotto_"):
 		b.lottery.HandleInteraction(s, i)
+
+	case strings.HasPrefix(customID, "settings_"):
+		b.settings.HandleInteraction(s, i)
 	}
 }
 
@@ -282,9 +363,7 @@ func (b *Bot) handleGuildCreate(s *discordgo.Session, g *discordgo.GuildCreate)
 	defer uow.Rollback()
 
 	// Instantiate service with repositories from UnitOfWork
-	guildSettingsService := services.NewGuildSettingsService(
-		uow.GuildSettingsRepository(),
-	)
+	guildSettingsService := uow.Services().GuildSettingsService()
 
 	// Get or create settings for this guild
 	settings, err := guildSettingsService.GetOrCreateSettings(ctx, guildID)
@@ -303,9 +382,92 @@ func (b *Bot) handleGuildCreate(s *discordgo.Session, g *discordgo.GuildCreate)
 		g.Name, settings.GuildID, settings.PrimaryChannelID, settings.HighRollerRoleID, settings.LolChannelID)
 }
 
+// handleGuildDelete purges a guild's data when the bot is removed from it
+func (b *Bot) handleGuildDelete(s *discordgo.Session, g *discordgo.GuildDelete) {
+	// Guilds also go unavailable during Discord outages, which must not lose any data
+	if g.Unavailable {
+		log.Warnf("Guild %s became unavailable", g.ID)
+		return
+	}
+
+	report, err := b.PurgeGuildData(g.ID)
+	if err != nil {
+		log.Errorf("Failed to purge data for removed guild %s: %v", g.ID, err)
+		return
+	}
+
+	log.Infof("Bot removed from guild %s, purged %d rows", g.ID, report.TotalDeleted())
+}
+
+// handleGuildMemberAdd creates an account for a joining member and welcomes them in the primary channel
+// when the guild has enabled welcoming new members
+func (b *Bot) handleGuildMemberAdd(s *discordgo.Session, m *discordgo.GuildMemberAdd) {
+	if m.User == nil || m.User.Bot {
+		return
+	}
+
+	guildID, err := strconv.ParseInt(m.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID %s: %v", m.GuildID, err)
+		return
+	}
+	if !b.OwnsGuild(guildID) {
+		return
+	}
+
+	discordID, err := strconv.ParseInt(m.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse member ID %s: %v", m.User.ID, err)
+		return
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := b.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Failed to begin transaction: %v", err)
+		return
+	}
+	defer uow.Rollback()
+
+	settings, err := uow.GuildSettingsRepository().GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		log.Errorf("Failed to get guild settings for guild %d: %v", guildID, err)
+		return
+	}
+	if !settings.WelcomeNewMembers {
+		return
+	}
+
+	userService := uow.Services().UserService()
+
+	user, err := userService.GetOrCreateUser(ctx, guildID, discordID, m.User.Username)
+	if err != nil {
+		log.Errorf("Failed to create account for new member %d in guild %d: %v", discordID, guildID, err)
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Failed to commit transaction: %v", err)
+		return
+	}
+
+	if !settings.HasPrimaryChannel() {
+		return
+	}
+
+	msg := fmt.Sprintf("👋 Welcome <@%d>! You have **%s bits** to play with. Use `/balance` to check your balance and `/rules` to see how wagers work.",
+		discordID, common.FormatBalance(user.Balance))
+	if _, err := s.ChannelMessageSend(strconv.FormatInt(*settings.PrimaryChannelID, 10), msg); err != nil {
+		log.Errorf("Failed to send welcome message for member %d in guild %d: %v", discordID, guildID, err)
+	}
+}
+
 // discordPoster implements the application.DiscordPoster interface
 // by delegating to the appropriate feature based on the operation
 type discordPoster struct {
+	session     *discordgo.Session
 	houseWagers *housewagers.Feature
 	groupWagers *groupwagers.Feature
 	dailyAwards *dailyawards.Feature
@@ -321,6 +483,11 @@ func (p *discordPoster) UpdateHouseWager(ctx context.Context, messageID, channel
 	return p.houseWagers.UpdateHouseWager(ctx, messageID, channelID, dto)
 }
 
+// PostGroupWager delegates to the groupWagers feature
+func (p *discordPoster) PostGroupWager(ctx context.Context, guildID, channelID, groupWagerID int64) (*application.PostResult, error) {
+	return p.groupWagers.PostGroupWager(ctx, guildID, channelID, groupWagerID)
+}
+
 // UpdateGroupWager delegates to the groupWagers feature
 func (p *discordPoster) UpdateGroupWager(ctx context.Context, messageID, channelID int64, detail interface{}) error {
 	return p.groupWagers.UpdateGroupWager(ctx, messageID, channelID, detail)
@@ -332,6 +499,21 @@ func (p *discordPoster) PostDailyAwards(ctx context.Context, dto dto.DailyAwards
 	return p.dailyAwards.PostDailyAwardsSummaryFromDTO(ctx, dto)
 }
 
+// ArchiveWagerThread delegates to the groupWagers feature
+func (p *discordPoster) ArchiveWagerThread(ctx context.Context, threadID int64) error {
+	return p.groupWagers.ArchiveWagerThread(ctx, threadID)
+}
+
+// PinMessage pins a message in its channel
+func (p *discordPoster) PinMessage(ctx context.Context, channelID, messageID int64) error {
+	return p.session.ChannelMessagePin(strconv.FormatInt(channelID, 10), strconv.FormatInt(messageID, 10))
+}
+
+// handleMessageReactionAdd delegates reactions to the groupWagers feature, which takes bets from them
+func (b *Bot) handleMessageReactionAdd(s *discordgo.Session, r *discordgo.MessageReactionAdd) {
+	b.groupWagers.HandleReactionAdd(s, r)
+}
+
 // handleMessageCreate handles incoming Discord messages and publishes them to NATS if configured
 func (b *Bot) handleMessageCreate(s *discordgo.Session, m *discordgo.MessageCreate) {
 	// Skip messages from our own bot to avoid loops
@@ -0,0 +1,44 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/domain/entities"
+)
+
+// BulkAdjustBalances applies a CSV of discord_id,mode,amount,reason balance adjustments to a guild.
+// Either every row is applied or none are. A dry run reports the resulting balances without changing them.
+func (b *Bot) BulkAdjustBalances(guildIDStr, csv string, dryRun bool) (*entities.BulkBalanceAdjustmentReport, error) {
+	guildID, err := strconv.ParseInt(guildIDStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid guild ID: %w", err)
+	}
+
+	adjustments, err := entities.ParseBulkBalanceCSV(strings.NewReader(csv))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	uow := b.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	report, err := uow.Services().AdminService().BulkAdjustBalances(ctx, guildID, adjustments, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	if err := uow.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit balance adjustments: %w", err)
+	}
+	return report, nil
+}
@@ -0,0 +1,103 @@
+package bot
+
+import (
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// commandMiddleware runs around every slash command. Errors are reported outermost so panics
+// recovered further in still reach the user, and logging sits outside recovery to time failures.
+func (b *Bot) commandMiddleware() []common.CommandMiddleware {
+	return []common.CommandMiddleware{
+		common.ReportErrors(),
+		common.LogRequests(),
+		common.Recover(),
+		common.ParseIDs(),
+	}
+}
+
+// buildCommandRoutes maps each slash command to its handler wrapped in the shared middleware,
+// the feature gate for gated commands and the command's own middleware
+func (b *Bot) buildCommandRoutes() map[string]common.CommandHandler {
+	legacy := common.AdaptInteractionHandler
+	routes := map[string]struct {
+		handler    common.CommandHandler
+		middleware []common.CommandMiddleware
+	}{
+		"balance":     {handler: legacy(b.balance.HandleCommand)},
+		"gamble":      {handler: legacy(b.betting.HandleCommand)},
+		"donate":      {handler: legacy(b.transfer.HandleCommand)},
+		"wager":       {handler: legacy(b.wagers.HandleCommand)},
+		"groupwager":  {handler: legacy(b.groupWagers.HandleCommand)},
+		"stats":       {handler: legacy(b.stats.HandleCommand)},
+		"leaderboard": {handler: legacy(b.stats.HandleLeaderboardCommand)},
+		"settings":    {handler: legacy(b.settings.HandleCommand)},
+		"economy":     {handler: legacy(b.settings.HandleEconomyCommand)},
+		"summoner":    {handler: legacy(b.summoner.HandleCommand)},
+		"watch":       {handler: legacy(b.summoner.HandleWatchCommand)},
+		"highroller":  {handler: b.handleHighRollerCommand},
+		"lotto":       {handler: legacy(b.lottery.HandleCommand)},
+		"gamba-break": {handler: legacy(b.gambaBreak.HandleCommand)},
+		"rules":       {handler: legacy(b.rules.HandleCommand)},
+		"savings":     {handler: legacy(b.savings.HandleCommand)},
+		"parlay":      {handler: legacy(b.parlay.HandleCommand)},
+		"resolver":    {handler: legacy(b.resolver.HandleCommand)},
+		"freeze":      {handler: legacy(b.freeze.HandleFreezeCommand)},
+		"unfreeze":    {handler: legacy(b.freeze.HandleUnfreezeCommand)},
+		"duel":        {handler: legacy(b.duel.HandleCommand)},
+		"export": {
+			handler:    b.export.HandleCommand,
+			middleware: []common.CommandMiddleware{common.Defer(true), common.WithUnitOfWork(b.uowFactory)},
+		},
+		"features":    {handler: legacy(b.features.HandleCommand)},
+		"preferences": {handler: legacy(b.preferences.HandleCommand)},
+		"link":        {handler: legacy(b.link.HandleCommand)},
+		"webhooks":    {handler: legacy(b.webhooks.HandleCommand)},
+	}
+
+	handlers := make(map[string]common.CommandHandler, len(routes))
+	for name, route := range routes {
+		middleware := b.commandMiddleware()
+		if feature, ok := commandFeatures[name]; ok {
+			middleware = append(middleware, b.requireFeature(feature))
+		}
+		middleware = append(middleware, route.middleware...)
+		handlers[name] = common.Chain(route.handler, middleware...)
+	}
+	return handlers
+}
+
+// handleHighRollerCommand logs high roller failures, which the feature already responded to
+func (b *Bot) handleHighRollerCommand(c *common.CommandContext) error {
+	if err := b.highroller.HandleCommand(c.Session, c.Interaction); err != nil {
+		c.Log.WithError(err).Error("High roller command failed")
+	}
+	return nil
+}
+
+// requireFeature stops a command when its feature is turned off in the guild
+func (b *Bot) requireFeature(feature entities.Feature) common.CommandMiddleware {
+	return func(next common.CommandHandler) common.CommandHandler {
+		return func(c *common.CommandContext) error {
+			if !b.featureEnabled(c.Session, c.Interaction, feature) {
+				return nil
+			}
+			return next(c)
+		}
+	}
+}
+
+// handleCommands routes slash commands through the command pipeline
+func (b *Bot) handleCommands(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if i.Type != discordgo.InteractionApplicationCommand {
+		return
+	}
+
+	handler, ok := b.commands[i.ApplicationCommandData().Name]
+	if !ok {
+		return
+	}
+	_ = handler(common.NewCommandContext(s, i))
+}
@@ -3,6 +3,8 @@ package bot
 import (
 	"fmt"
 
+	"gambler/discord-client/domain/entities"
+
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -86,6 +88,97 @@ func (b *Bot) registerCommands() error {
 					Type:        discordgo.ApplicationCommandOptionSubCommand,
 					Name:        "create",
 					Description: "Create a new group wager (opens modal for details)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "voting_period",
+							Description: "Preset voting period to pre-fill (default: the server's default)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "1 hour", Value: "1h"},
+								{Name: "6 hours", Value: "6h"},
+								{Name: "24 hours", Value: "1d"},
+								{Name: "1 week", Value: "1w"},
+							},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "quick",
+					Description: "Create a group wager in one line, without the modal",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "condition",
+							Description: "What is being wagered on",
+							Required:    true,
+							MaxLength:   200,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "options",
+							Description: "Options separated by |, e.g. Yes|No|Draw",
+							Required:    true,
+							MaxLength:   1000,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "period",
+							Description: "Voting period, e.g. 2h, 1:30, 1d (default: the server's default)",
+							Required:    false,
+							MaxLength:   10,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "type",
+							Description: "Pool wagers set odds from the bets; house wagers pay fixed odds (resolvers only)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Pool", Value: string(entities.GroupWagerTypePool)},
+								{Name: "House", Value: string(entities.GroupWagerTypeHouse)},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "odds",
+							Description: "House odds per option separated by |, e.g. 1.8|2.1",
+							Required:    false,
+							MaxLength:   100,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "react",
+					Description: "Create a group wager that takes bets from reactions on an existing message",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "message",
+							Description: "Link or ID of the message to take reactions on",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "stake",
+							Description: "Bits bet by each reaction (default: 100)",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "voting_period",
+							Description: "Preset voting period to pre-fill (default: the server's default)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "1 hour", Value: "1h"},
+								{Name: "6 hours", Value: "6h"},
+								{Name: "24 hours", Value: "1d"},
+								{Name: "1 week", Value: "1w"},
+							},
+						},
+					},
 				},
 				{
 					Type:        discordgo.ApplicationCommandOptionSubCommand,
@@ -106,6 +199,25 @@ func (b *Bot) registerCommands() error {
 						},
 					},
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "correct",
+					Description: "Change the winning option of a group wager resolved moments ago (resolvers only)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "id",
+							Description: "Group wager ID to correct",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "winning_option",
+							Description: "Exact text of the option that actually won",
+							Required:    true,
+						},
+					},
+				},
 				{
 					Type:        discordgo.ApplicationCommandOptionSubCommand,
 					Name:        "cancel",
@@ -119,6 +231,96 @@ func (b *Bot) registerCommands() error {
 						},
 					},
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "restore",
+					Description: "Undo a group wager cancellation made within the last hour",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "id",
+							Description: "Group wager ID to restore",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "reorder",
+					Description: "Rearrange the options on your group wager before anyone has bet",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "id",
+							Description: "Group wager ID to reorder",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "order",
+							Description: "Current option numbers in their new order, e.g. 3,1,2",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "setodds",
+					Description: "Update the odds on a house wager option (resolvers only)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "id",
+							Description: "House wager ID to update",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "option",
+							Description: "Exact text of the option to update",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionNumber,
+							Name:        "odds",
+							Description: "New odds multiplier (e.g. 2.5)",
+							Required:    true,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "link-match",
+					Description: "Link a group wager to an external match so its reported result resolves it (resolvers only)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "id",
+							Description: "Group wager ID to link",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "match_id",
+							Description: "ID of the match in the result provider",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "stats",
+					Description: "Show stats for the group wagers a user has created",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "user",
+							Description: "The creator to show (default: you)",
+							Required:    false,
+						},
+					},
+				},
 			},
 		},
 		{
@@ -143,6 +345,97 @@ func (b *Bot) registerCommands() error {
 						},
 					},
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "history",
+					Description: "Graph a player's wins, losses and balance over the last 30 days",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "user",
+							Description: "User to check stats for (defaults to you)",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "system",
+							Description: "Only count wins and losses from this game (defaults to all)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "All", Value: "all"},
+								{Name: "League of Legends", Value: "league_of_legends"},
+								{Name: "Teamfight Tactics", Value: "teamfight_tactics"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "leaderboard",
+			Description: "View guild and cross-server leaderboards",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "predictions",
+					Description: "Rank players by how accurately they predict group wagers",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "system",
+							Description: "Only count predictions on wagers from this game (defaults to all)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "All", Value: "all"},
+								{Name: "League of Legends", Value: "lol"},
+								{Name: "Teamfight Tactics", Value: "tft"},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "window",
+							Description: "Only count wagers created within this period (defaults to all time)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "All time", Value: string(entities.LeaderboardWindowAllTime)},
+								{Name: "Last 7 days", Value: string(entities.LeaderboardWindow7Days)},
+								{Name: "Last 30 days", Value: string(entities.LeaderboardWindow30Days)},
+								{Name: "Last 90 days", Value: string(entities.LeaderboardWindow90Days)},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "min_wagers",
+							Description: "Minimum predictions a player needs to be ranked (defaults to 5)",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "global",
+					Description: "Rank players across every server that shares its prediction stats",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "sort",
+							Description: "Stat to rank by (defaults to accuracy)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Prediction accuracy", Value: string(entities.GlobalLeaderboardSortAccuracy)},
+								{Name: "Profit as % of volume", Value: string(entities.GlobalLeaderboardSortProfit)},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "min_wagers",
+							Description: "Minimum predictions a player needs to be ranked (defaults to 10)",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+						},
+					},
+				},
 			},
 		},
 		{
@@ -255,84 +548,1018 @@ func (b *Bot) registerCommands() error {
 						},
 					},
 				},
-			},
-		},
-		{
-			Name:        "summoner",
-			Description: "League of Legends summoner tracking",
-			Options: []*discordgo.ApplicationCommandOption{
 				{
 					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "watch",
-					Description: "Start tracking a summoner",
+					Name:        "lotto-rollover-cap",
+					Description: "Cap the lottery pot that rolls over; the excess goes to ticket holders (omit to remove)",
 					Options: []*discordgo.ApplicationCommandOption{
 						{
-							Type:        discordgo.ApplicationCommandOptionString,
-							Name:        "game_name",
-							Description: "League of Legends game name (e.g., Faker)",
-							Required:    true,
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "amount",
+							Description: "Max bits carried into the next draw",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
 						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "audit-channel",
+					Description: "Set the channel for the balance change audit log",
+					Options: []*discordgo.ApplicationCommandOption{
 						{
-							Type:        discordgo.ApplicationCommandOptionString,
-							Name:        "tag",
-							Description: "Riot ID tag line (e.g., KR1)",
-							Required:    true,
+							Type:        discordgo.ApplicationCommandOptionChannel,
+							Name:        "channel",
+							Description: "The channel for audit messages (leave empty to disable)",
+							Required:    false,
 						},
 					},
 				},
 				{
 					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "unwatch",
-					Description: "Stop tracking a summoner",
+					Name:        "digest-channel",
+					Description: "Set the channel for the weekly economy digest",
 					Options: []*discordgo.ApplicationCommandOption{
 						{
-							Type:        discordgo.ApplicationCommandOptionString,
-							Name:        "game_name",
-							Description: "League of Legends game name (e.g., Faker)",
-							Required:    true,
+							Type:        discordgo.ApplicationCommandOptionChannel,
+							Name:        "channel",
+							Description: "The channel for the weekly digest (leave empty to disable)",
+							Required:    false,
 						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "audit-threshold",
+					Description: "Set the minimum balance change posted to the audit log",
+					Options: []*discordgo.ApplicationCommandOption{
 						{
-							Type:        discordgo.ApplicationCommandOptionString,
-							Name:        "tag",
-							Description: "Riot ID tag line (e.g., KR1)",
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "amount",
+							Description: "Minimum change in bits (default: 10000)",
 							Required:    true,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
 						},
 					},
 				},
-			},
-		},
-		{
-			Name:        "highroller",
-			Description: "Purchase and manage the high roller role",
-			Options: []*discordgo.ApplicationCommandOption{
 				{
 					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "buy",
-					Description: "Purchase the high roller role",
+					Name:        "curfew",
+					Description: "Set quiet hours during which new bets and wagers are rejected (omit both hours to disable)",
 					Options: []*discordgo.ApplicationCommandOption{
 						{
 							Type:        discordgo.ApplicationCommandOptionInteger,
-							Name:        "amount",
-							Description: "Amount to offer for the role",
-							Required:    true,
+							Name:        "start_hour",
+							Description: "Hour betting closes (0-23)",
+							Required:    false,
+							MinValue:    func() *float64 { v := 0.0; return &v }(),
+							MaxValue:    23,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "end_hour",
+							Description: "Hour betting reopens (0-23)",
+							Required:    false,
+							MinValue:    func() *float64 { v := 0.0; return &v }(),
+							MaxValue:    23,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "timezone",
+							Description: "Timezone of the hours, e.g. Europe/Berlin (default: UTC)",
+							Required:    false,
 						},
 					},
 				},
 				{
 					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "info",
-					Description: "Show current high roller, price, and recent history",
+					Name:        "rules",
+					Description: "Edit the wager rules and dispute policy shown by /rules",
 				},
-			},
-		},
-	}
-
-	for _, cmd := range commands {
-		_, err := b.session.ApplicationCommandCreate(b.session.State.User.ID, "", cmd)
-		if err != nil {
-			return fmt.Errorf("cannot create '%s' command: %w", cmd.Name, err)
-		}
-	}
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "savings-bonus",
+					Description: "Set the savings bonus earned per locked week (omit to restore the default)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "percent",
+							Description: "Percent of the deposit earned per week (default: 2)",
+							Required:    false,
+							MinValue:    func() *float64 { v := 0.0; return &v }(),
+							MaxValue:    25,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "stuck-wagers",
+					Description: "Remind resolvers about and auto-cancel wagers stuck pending resolution (omit both to disable)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "reminder_hours",
+							Description: "Hours pending resolution before resolvers are pinged",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+							MaxValue:    720,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "cancel_hours",
+							Description: "Hours pending resolution before the wager is cancelled and all bets refunded",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+							MaxValue:    720,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "resolver-assignment",
+					Description: "Assign each wager awaiting resolution to the next resolver in rotation and ping them",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "enabled",
+							Description: "Whether to assign resolvers automatically",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "snipe-protection",
+					Description: "Extend voting when a bet lands in its final minutes (omit both to disable)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "window_minutes",
+							Description: "Bets placed this many minutes before voting ends extend it",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+							MaxValue:    entities.MaxSnipeProtectionMinutes,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "extension_minutes",
+							Description: "Minutes each late bet adds to the voting period",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+							MaxValue:    entities.MaxSnipeProtectionMinutes,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "house-option-cap",
+					Description: "Cap the total bet on each option of LoL/TFT house wagers (omit to remove the cap)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "amount",
+							Description: "Max total bits that can be bet on a single option",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "starting-balance",
+					Description: "Set the bits new players start with (omit to restore the default)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "amount",
+							Description: "Starting balance for new players",
+							Required:    false,
+							MinValue:    func() *float64 { v := 0.0; return &v }(),
+							MaxValue:    1000000,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "welcome-new-members",
+					Description: "Create accounts for joining members and welcome them in the primary channel",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "enabled",
+							Description: "Whether to welcome new members",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "rate-limit",
+					Description: "Limit how fast each player can bet, buy lottery tickets and transfer (omit both to restore defaults)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "per_minute",
+							Description: "Actions each player regains per minute (0 disables the limit)",
+							Required:    false,
+							MinValue:    func() *float64 { v := 0.0; return &v }(),
+							MaxValue:    120,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "burst",
+							Description: "Actions a player can take back to back",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+							MaxValue:    20,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "tft-layout",
+					Description: "Choose how TFT wagers split placements into options (omit to restore 1-2/3-4/5-6/7-8)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "layout",
+							Description: "Option layout for regular TFT games (Double Up offers 1-4, Hyper Roll 1 / 2-4 / 5-8)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Pairs: 1-2 / 3-4 / 5-6 / 7-8 at 4x", Value: string(entities.TFTPlacementLayoutPairs)},
+								{Name: "Exact placement: 1 through 8 at 8x", Value: string(entities.TFTPlacementLayoutExact)},
+								{Name: "Top 4 / Bottom 4 at 2x", Value: string(entities.TFTPlacementLayoutTopBottom)},
+							},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "bet-mode",
+					Description: "Choose how bettors pick a group wager option (omit to restore buttons)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "mode",
+							Description: "Buttons suit a few options, a dropdown keeps large wagers tidy",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "One button per option", Value: string(entities.GroupWagerBetModeButtons)},
+								{Name: "Dropdown menu", Value: string(entities.GroupWagerBetModeMenu)},
+							},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "own-game-bets",
+					Description: "Choose whether players with a linked Riot account can bet on their own LoL/TFT games",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "blocked",
+							Description: "Whether to stop players betting on their own games",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "lol-queues",
+					Description: "Choose whether ARAM, normal and custom LoL games create house wagers, not just ranked",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "non-ranked",
+							Description: "Whether to create wagers for non-ranked games",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "global-leaderboard",
+					Description: "Choose whether this server's predictions count toward the cross-server leaderboard",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "share",
+							Description: "Whether to share prediction stats and show /leaderboard global",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "voting-period",
+					Description: "Set the voting period pre-filled for new group wagers (omit to restore 24 hours)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "period",
+							Description: "Hours (24), hours:minutes (1:30) or a unit such as 6h, 2d or 1w",
+							Required:    false,
+							MaxLength:   10,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "pot-tax",
+					Description: "Add a share of each resolved group wager's pot to the lottery (omit to disable)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "percent",
+							Description: "Percent of the pot taken from the winnings (1-10)",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+							MaxValue:    10.0,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "check",
+					Description: "Check the server's settings for problems such as deleted channels",
+				},
+			},
+		},
+		{
+			Name:        "economy",
+			Description: "Configure guild economy limits (admin only)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "balance-floor",
+					Description: "Set the reserve balance players cannot bet below (omit to remove)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "amount",
+							Description: "Bits players must keep in reserve",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+							MaxValue:    1000000,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "min-bet",
+					Description: "Set the smallest bet, wager or lottery purchase allowed (omit to remove)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "amount",
+							Description: "Minimum bet in bits",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+							MaxValue:    float64(entities.MaxMinBetAmount),
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "season-tokens",
+					Description: "Set up the seasonal token currency players earn from wins (omit name to disable)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "Name of the season token (e.g., Crowns)",
+							Required:    false,
+							MaxLength:   32,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "rate",
+							Description: "Bits won per token earned (default: 1000)",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "new-season",
+					Description: "Start a new season; season token balances start again from zero",
+				},
+			},
+		},
+		{
+			Name:        "summoner",
+			Description: "League of Legends summoner tracking",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "watch",
+					Description: "Start tracking a summoner",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "game_name",
+							Description: "League of Legends game name (e.g., Faker)",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "tag",
+							Description: "Riot ID tag line (e.g., KR1)",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "unwatch",
+					Description: "Stop tracking a summoner",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "game_name",
+							Description: "League of Legends game name (e.g., Faker)",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "tag",
+							Description: "Riot ID tag line (e.g., KR1)",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "watch",
+			Description: "Manage the summoners this server watches",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Start watching a summoner",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "riot_id",
+							Description: "Riot ID in Name#TAG format (e.g., Faker#KR1)",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Stop watching a summoner",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "riot_id",
+							Description: "Riot ID in Name#TAG format (e.g., Faker#KR1)",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "List the summoners this server watches",
+				},
+			},
+		},
+		{
+			Name:        "highroller",
+			Description: "Purchase and manage the high roller role",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "buy",
+					Description: "Purchase the high roller role",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "amount",
+							Description: "Amount to offer for the role",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "info",
+					Description: "Show current high roller, price, and recent history",
+				},
+			},
+		},
+		{
+			Name:        "lotto",
+			Description: "Lottery information",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "numbers",
+					Description: "Show hot and cold winning numbers and your most played numbers",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "count",
+							Description: "Number of recent winning numbers to show (default: 10)",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+							MaxValue:    25.0,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "history",
+					Description: "Show the results of past draws",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "count",
+							Description: "Number of draws to show (default: 10)",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+							MaxValue:    25.0,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "page",
+							Description: "Page of older draws to show (default: 1)",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "mywins",
+					Description: "Show your lottery wins",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "count",
+							Description: "Number of wins to show (default: 10)",
+							Required:    false,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+							MaxValue:    25.0,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "subscribe",
+					Description: "Automatically buy tickets at the start of each new draw",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "tickets",
+							Description: "Number of tickets to buy each draw",
+							Required:    true,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+							MaxValue:    float64(entities.MaxLotterySubscriptionTickets),
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "unsubscribe",
+					Description: "Stop automatically buying tickets each draw",
+				},
+			},
+		},
+		{
+			Name:        "gamba-break",
+			Description: "Take a break from gambling (cannot be undone until it expires)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "duration",
+					Description: "How long to take a break, e.g. 12h, 3d, 2w",
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:        "preferences",
+			Description: "View or change your personal display preferences",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "odds-format",
+					Description: "How odds are shown to you",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Multiplier (2.50x)", Value: string(entities.OddsFormatMultiplier)},
+						{Name: "Decimal (2.50)", Value: string(entities.OddsFormatDecimal)},
+						{Name: "Fractional (3/2)", Value: string(entities.OddsFormatFractional)},
+						{Name: "American (+150)", Value: string(entities.OddsFormatAmerican)},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "global-leaderboard",
+					Description: "Whether you appear on the cross-server leaderboard of servers that share stats",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "link",
+			Description: "Link your own Riot account to tag you in wagers on your games",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "riot",
+					Description: "Link your Riot account",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "riot_id",
+							Description: "Your Riot ID in Name#TAG format (e.g., Faker#KR1)",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "show",
+					Description: "Show your linked Riot account",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Unlink your Riot account",
+				},
+			},
+		},
+		{
+			Name:        "rules",
+			Description: "Show this server's wager rules and dispute policy",
+		},
+		{
+			Name:        "savings",
+			Description: "Lock bits for a fixed term to earn a bonus",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "deposit",
+					Description: "Lock bits for 1-4 weeks (withdrawing early forfeits the bonus)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "amount",
+							Description: "Amount of bits to lock",
+							Required:    true,
+							MinValue:    func() *float64 { v := 100.0; return &v }(),
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "weeks",
+							Description: "Term length in weeks",
+							Required:    true,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "1 week", Value: 1},
+								{Name: "2 weeks", Value: 2},
+								{Name: "3 weeks", Value: 3},
+								{Name: "4 weeks", Value: 4},
+							},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "withdraw",
+					Description: "Release a deposit (before maturity the bonus is forfeited)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "id",
+							Description: "Deposit ID shown by /savings list",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "Show your locked savings",
+				},
+			},
+		},
+		{
+			Name:        "parlay",
+			Description: "Combine picks on several house wagers into one ticket",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "place",
+					Description: "Place a parlay on 2-5 active house wagers (every leg must win)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "amount",
+							Description: "Amount of bits to stake",
+							Required:    true,
+							MinValue:    func() *float64 { v := 1.0; return &v }(),
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "legs",
+							Description: "Wager ID and option number for each leg, e.g. 12:1, 15:2",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "Show your recent parlays",
+				},
+			},
+		},
+		{
+			Name:        "duel",
+			Description: "Challenge another user to a heads-or-tails coin flip",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "The user to challenge",
+					Required:    true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "amount",
+					Description: "Amount of bits each side stakes",
+					Required:    true,
+					MinValue:    func() *float64 { v := 1.0; return &v }(),
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "best-of-3",
+					Description: "Play a best of 3 series instead of a single flip",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "resolver",
+			Description: "Manage who can resolve group wagers (Admin only)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Allow a user or every member of a role to resolve group wagers",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "user",
+							Description: "The user to grant",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionRole,
+							Name:        "role",
+							Description: "The role to grant",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Stop a user or role from resolving group wagers",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "user",
+							Description: "The user to revoke",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionRole,
+							Name:        "role",
+							Description: "The role to revoke",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "Show the users and roles that can resolve group wagers",
+				},
+			},
+		},
+		{
+			Name:        "freeze",
+			Description: "Block a user's bets, transfers and lottery tickets (Admin only)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "The user to freeze",
+					Required:    true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "reason",
+					Description: "Why the balance is frozen (shown to the user)",
+					Required:    false,
+					MaxLength:   200,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "duration",
+					Description: "How long to freeze, e.g. 6h, 3d, 2w (omit to freeze until lifted)",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "unfreeze",
+			Description: "Lift a user's balance freeze (Admin only)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "The user to unfreeze",
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:        "features",
+			Description: "Turn bot features on or off for this server (Admin only)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "Show which features are turned on",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "enable",
+					Description: "Turn a feature on",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "feature",
+							Description: "The feature to turn on",
+							Required:    true,
+							Choices:     featureChoices(),
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "disable",
+					Description: "Turn a feature off",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "feature",
+							Description: "The feature to turn off",
+							Required:    true,
+							Choices:     featureChoices(),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "webhooks",
+			Description: "Send bot events to external services (Admin only)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "Show this server's webhooks",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Add a webhook, receiving every event unless some are chosen",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "url",
+							Description: "HTTPS URL the signed JSON payloads are posted to",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        string(entities.WebhookEventBigWin),
+							Description: "Send big wins",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        string(entities.WebhookEventLotteryResult),
+							Description: "Send lottery draw results",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        string(entities.WebhookEventWagerResolved),
+							Description: "Send group and house wager resolutions",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Remove a webhook",
+					Options:     []*discordgo.ApplicationCommandOption{webhookIDOption()},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "test",
+					Description: "Send a test payload to a webhook",
+					Options:     []*discordgo.ApplicationCommandOption{webhookIDOption()},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "deliveries",
+					Description: "Show a webhook's recent deliveries",
+					Options:     []*discordgo.ApplicationCommandOption{webhookIDOption()},
+				},
+			},
+		},
+		{
+			Name:        "export",
+			Description: "Download economy data for a date range (Admin only)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "dataset",
+					Description: "Which data to export",
+					Required:    true,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Balance history", Value: "balance-history"},
+						{Name: "Group wager outcomes", Value: "wager-outcomes"},
+						{Name: "Lottery results", Value: "lottery-results"},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "from",
+					Description: "First day to include (YYYY-MM-DD, UTC)",
+					Required:    true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "to",
+					Description: "Last day to include (YYYY-MM-DD, UTC, default: today)",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "format",
+					Description: "File format (default: CSV)",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "CSV", Value: "csv"},
+						{Name: "JSON", Value: "json"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, cmd := range commands {
+		_, err := b.session.ApplicationCommandCreate(b.session.State.User.ID, "", cmd)
+		if err != nil {
+			return fmt.Errorf("cannot create '%s' command: %w", cmd.Name, err)
+		}
+	}
 
 	return nil
 }
+
+// featureChoices lists every toggleable feature as a command option choice
+func featureChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(entities.FeatureDefinitions))
+	for _, definition := range entities.FeatureDefinitions {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  definition.Name,
+			Value: string(definition.Feature),
+		})
+	}
+	return choices
+}
+
+// webhookIDOption is the webhook ID option shared by the /webhooks subcommands
+func webhookIDOption() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionInteger,
+		Name:        "id",
+		Description: "The webhook ID shown by /webhooks list",
+		Required:    true,
+		MinValue:    func() *float64 { v := 1.0; return &v }(),
+	}
+}
@@ -0,0 +1,46 @@
+package common
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// OptionButtonEmoji returns the component emoji for a wager option's configured emoji, which is either a
+// unicode emoji or a custom emoji written as <:name:id> or <a:name:id>. Falls back to the given unicode
+// emoji when the option has none.
+func OptionButtonEmoji(emoji string, fallback string) *discordgo.ComponentEmoji {
+	if emoji == "" {
+		return &discordgo.ComponentEmoji{Name: fallback}
+	}
+
+	if strings.HasPrefix(emoji, "<") && strings.HasSuffix(emoji, ">") {
+		parts := strings.Split(emoji[1:len(emoji)-1], ":")
+		if len(parts) == 3 {
+			return &discordgo.ComponentEmoji{
+				Name:     parts[1],
+				ID:       parts[2],
+				Animated: parts[0] == "a",
+			}
+		}
+	}
+
+	return &discordgo.ComponentEmoji{Name: emoji}
+}
+
+// OptionButtonStyle returns the button style for a wager option's configured colour, falling back to the
+// given style when the option has none
+func OptionButtonStyle(style string, fallback discordgo.ButtonStyle) discordgo.ButtonStyle {
+	switch style {
+	case "primary":
+		return discordgo.PrimaryButton
+	case "secondary":
+		return discordgo.SecondaryButton
+	case "success":
+		return discordgo.SuccessButton
+	case "danger":
+		return discordgo.DangerButton
+	default:
+		return fallback
+	}
+}
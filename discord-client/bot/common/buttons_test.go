@@ -0,0 +1,34 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionButtonEmoji(t *testing.T) {
+	tests := []struct {
+		name  string
+		emoji string
+		want  discordgo.ComponentEmoji
+	}{
+		{"Fallback", "", discordgo.ComponentEmoji{Name: "1️⃣"}},
+		{"Unicode", "🐯", discordgo.ComponentEmoji{Name: "🐯"}},
+		{"Custom", "<:t1:123456>", discordgo.ComponentEmoji{Name: "t1", ID: "123456"}},
+		{"Animated", "<a:spin:42>", discordgo.ComponentEmoji{Name: "spin", ID: "42", Animated: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, *OptionButtonEmoji(tt.emoji, "1️⃣"))
+		})
+	}
+}
+
+func TestOptionButtonStyle(t *testing.T) {
+	assert.Equal(t, discordgo.SuccessButton, OptionButtonStyle("success", discordgo.PrimaryButton))
+	assert.Equal(t, discordgo.DangerButton, OptionButtonStyle("danger", discordgo.PrimaryButton))
+	assert.Equal(t, discordgo.PrimaryButton, OptionButtonStyle("", discordgo.PrimaryButton))
+	assert.Equal(t, discordgo.SecondaryButton, OptionButtonStyle("", discordgo.SecondaryButton))
+}
@@ -0,0 +1,43 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"gambler/discord-client/domain/interfaces"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// discordChannelLookup checks channels against the session state, falling back to the Discord API
+type discordChannelLookup struct {
+	session *discordgo.Session
+}
+
+// NewChannelLookup creates a channel lookup backed by a Discord session
+func NewChannelLookup(s *discordgo.Session) interfaces.ChannelLookup {
+	return &discordChannelLookup{session: s}
+}
+
+// ChannelExists reports whether the channel exists and belongs to the guild
+func (l *discordChannelLookup) ChannelExists(ctx context.Context, guildID, channelID int64) (bool, error) {
+	id := strconv.FormatInt(channelID, 10)
+
+	channel, err := l.session.State.Channel(id)
+	if err != nil {
+		channel, err = l.session.Channel(id, discordgo.WithContext(ctx))
+		if err != nil {
+			// Discord answers 404 for deleted channels and 403 for ones the bot cannot see
+			var restErr *discordgo.RESTError
+			if errors.As(err, &restErr) && restErr.Response != nil &&
+				(restErr.Response.StatusCode == http.StatusNotFound || restErr.Response.StatusCode == http.StatusForbidden) {
+				return false, nil
+			}
+			return false, err
+		}
+	}
+
+	return channel.GuildID == strconv.FormatInt(guildID, 10), nil
+}
@@ -0,0 +1,256 @@
+package common
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"gambler/discord-client/application"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// CommandContext is what the command pipeline prepares for a slash command handler
+type CommandContext struct {
+	Ctx           context.Context
+	Session       *discordgo.Session
+	Interaction   *discordgo.InteractionCreate
+	CorrelationID string
+	Log           *log.Entry
+
+	GuildID  int64                  // Set by ParseIDs
+	UserID   int64                  // Set by ParseIDs
+	Deferred bool                   // Set by Defer, so responses go out as follow-ups
+	UoW      application.UnitOfWork // Set by WithUnitOfWork, begun and committed around the handler
+}
+
+// CommandHandler handles a slash command. A returned error is shown to the user with HandleError,
+// so handlers return BotErrors or domain errors rather than responding to failures themselves.
+type CommandHandler func(c *CommandContext) error
+
+// CommandMiddleware wraps a command handler with behaviour shared between commands
+type CommandMiddleware func(next CommandHandler) CommandHandler
+
+// Chain wraps handler in middleware, with the first middleware outermost
+func Chain(handler CommandHandler, middleware ...CommandMiddleware) CommandHandler {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		handler = middleware[i](handler)
+	}
+	return handler
+}
+
+// AdaptInteractionHandler runs a handler that responds to the interaction itself inside the pipeline,
+// so features that have not moved to CommandHandler still get panic recovery and request logging
+func AdaptInteractionHandler(handler InteractionHandler) CommandHandler {
+	return func(c *CommandContext) error {
+		handler(c.Session, c.Interaction)
+		return nil
+	}
+}
+
+// NewCommandContext starts the pipeline context for a slash command interaction
+func NewCommandContext(s *discordgo.Session, i *discordgo.InteractionCreate) *CommandContext {
+	correlationID := newCorrelationID()
+	fields := log.Fields{
+		"correlation_id": correlationID,
+		"command":        CommandPath(i),
+		"guild_id":       i.GuildID,
+	}
+	if i.Member != nil && i.Member.User != nil {
+		fields["user_id"] = i.Member.User.ID
+	}
+
+	ctx := WithCorrelationID(context.Background(), correlationID)
+	return &CommandContext{
+		Ctx:           WithMemberRoles(ctx, i),
+		Session:       s,
+		Interaction:   i,
+		CorrelationID: correlationID,
+		Log:           log.WithFields(fields),
+	}
+}
+
+// RespondWithError tells the user a command failed, as a follow-up once the response was deferred
+func (c *CommandContext) RespondWithError(message string) {
+	if c.Deferred {
+		FollowUpWithError(c.Session, c.Interaction, message)
+		return
+	}
+	RespondWithError(c.Session, c.Interaction, message)
+}
+
+// RespondWithEmbed sends an embed, as a follow-up once the response was deferred
+func (c *CommandContext) RespondWithEmbed(embed *discordgo.MessageEmbed, components []discordgo.MessageComponent, ephemeral bool) error {
+	if c.Deferred {
+		_, err := FollowUpWithEmbed(c.Session, c.Interaction, embed, components, ephemeral)
+		return err
+	}
+	return RespondWithEmbed(c.Session, c.Interaction, embed, components, ephemeral)
+}
+
+// Recover turns a panicking handler into an error response instead of losing the interaction
+func Recover() CommandMiddleware {
+	return func(next CommandHandler) CommandHandler {
+		return func(c *CommandContext) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					c.Log.WithField("panic", r).Errorf("Recovered from panic in command handler\n%s", debug.Stack())
+					err = fmt.Errorf("panic in command handler: %v", r)
+				}
+			}()
+			return next(c)
+		}
+	}
+}
+
+// ReportErrors shows errors returned by the handler to the user. Only one response is sent, so it
+// belongs outside Recover, which converts panics into errors.
+func ReportErrors() CommandMiddleware {
+	return func(next CommandHandler) CommandHandler {
+		return func(c *CommandContext) error {
+			err := next(c)
+			if err == nil {
+				return nil
+			}
+
+			if c.Interaction.Member == nil || c.Interaction.Member.User == nil {
+				// HandleError logs the member, which commands used in DMs don't have
+				message, ok := UserErrorMessage(err)
+				if !ok {
+					message = "Something went wrong. Please try again later."
+				}
+				c.RespondWithError(message)
+				return err
+			}
+			HandleError(c.Session, c.Interaction, err, c.Deferred)
+			return err
+		}
+	}
+}
+
+// LogRequests logs each command with its correlation ID, how long it took and whether it failed
+func LogRequests() CommandMiddleware {
+	return func(next CommandHandler) CommandHandler {
+		return func(c *CommandContext) error {
+			start := time.Now()
+			err := next(c)
+
+			entry := c.Log.WithField("duration_ms", time.Since(start).Milliseconds())
+			if err != nil {
+				entry.WithError(err).Info("Command failed")
+			} else {
+				entry.Info("Command handled")
+			}
+			return err
+		}
+	}
+}
+
+// ParseIDs parses the guild and user IDs of the interaction, rejecting commands used outside a guild
+func ParseIDs() CommandMiddleware {
+	return func(next CommandHandler) CommandHandler {
+		return func(c *CommandContext) error {
+			i := c.Interaction
+			if i.Member == nil || i.Member.User == nil {
+				return NewUserError("This command can only be used in a server.", "command used outside a guild")
+			}
+
+			guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse guild ID %q: %w", i.GuildID, err)
+			}
+			userID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse user ID %q: %w", i.Member.User.ID, err)
+			}
+
+			c.GuildID = guildID
+			c.UserID = userID
+			return next(c)
+		}
+	}
+}
+
+// Defer acknowledges the interaction before the handler runs, for commands that may take longer
+// than Discord's three second response window
+func Defer(ephemeral bool) CommandMiddleware {
+	return func(next CommandHandler) CommandHandler {
+		return func(c *CommandContext) error {
+			if err := DeferResponse(c.Session, c.Interaction, ephemeral); err != nil {
+				// Without an acknowledged interaction there is nothing to follow up on
+				c.Log.WithError(err).Error("Failed to defer command response")
+				return nil
+			}
+			c.Deferred = true
+			return next(c)
+		}
+	}
+}
+
+// WithUnitOfWork begins a unit of work for the guild before the handler runs, committing it when the
+// handler succeeds and rolling it back when it fails. Requires ParseIDs earlier in the chain.
+func WithUnitOfWork(factory application.UnitOfWorkFactory) CommandMiddleware {
+	return func(next CommandHandler) CommandHandler {
+		return func(c *CommandContext) error {
+			uow := factory.CreateForGuild(c.GuildID)
+			if err := uow.Begin(c.Ctx); err != nil {
+				return fmt.Errorf("failed to begin transaction: %w", err)
+			}
+			defer uow.Rollback()
+
+			c.UoW = uow
+			if err := next(c); err != nil {
+				return err
+			}
+
+			if err := uow.Commit(); err != nil {
+				return fmt.Errorf("failed to commit transaction: %w", err)
+			}
+			return nil
+		}
+	}
+}
+
+// CommandPath returns the command name with its subcommand group and subcommand, e.g. "settings pot-tax"
+func CommandPath(i *discordgo.InteractionCreate) string {
+	data := i.ApplicationCommandData()
+	parts := []string{data.Name}
+	options := data.Options
+	for len(options) > 0 {
+		option := options[0]
+		if option.Type != discordgo.ApplicationCommandOptionSubCommand && option.Type != discordgo.ApplicationCommandOptionSubCommandGroup {
+			break
+		}
+		parts = append(parts, option.Name)
+		options = option.Options
+	}
+	return strings.Join(parts, " ")
+}
+
+type correlationIDKey struct{}
+
+// WithCorrelationID attaches the ID tying together the logs of one command to the context
+func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, correlationID)
+}
+
+// CorrelationIDFromContext returns the command correlation ID attached to the context, if any
+func CorrelationIDFromContext(ctx context.Context) string {
+	correlationID, _ := ctx.Value(correlationIDKey{}).(string)
+	return correlationID
+}
+
+// newCorrelationID returns a short random ID for a command's log lines
+func newCorrelationID() string {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,183 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gambler/discord-client/application"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUnitOfWork records the transaction lifecycle the pipeline drives
+type fakeUnitOfWork struct {
+	application.UnitOfWork
+	beginErr   error
+	began      bool
+	committed  bool
+	rolledBack bool
+}
+
+func (u *fakeUnitOfWork) Begin(ctx context.Context) error {
+	u.began = true
+	return u.beginErr
+}
+
+func (u *fakeUnitOfWork) Commit() error {
+	u.committed = true
+	return nil
+}
+
+func (u *fakeUnitOfWork) Rollback() error {
+	if !u.committed {
+		u.rolledBack = true
+	}
+	return nil
+}
+
+type fakeUnitOfWorkFactory struct {
+	application.UnitOfWorkFactory
+	uow     *fakeUnitOfWork
+	guildID int64
+}
+
+func (f *fakeUnitOfWorkFactory) CreateForGuild(guildID int64) application.UnitOfWork {
+	f.guildID = guildID
+	return f.uow
+}
+
+func newTestInteraction(name string, options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			Type:    discordgo.InteractionApplicationCommand,
+			GuildID: "123",
+			Member:  &discordgo.Member{User: &discordgo.User{ID: "456"}},
+			Data: discordgo.ApplicationCommandInteractionData{
+				Name:    name,
+				Options: options,
+			},
+		},
+	}
+}
+
+func TestChain_RunsMiddlewareInOrder(t *testing.T) {
+	var calls []string
+	record := func(name string) CommandMiddleware {
+		return func(next CommandHandler) CommandHandler {
+			return func(c *CommandContext) error {
+				calls = append(calls, name+" before")
+				err := next(c)
+				calls = append(calls, name+" after")
+				return err
+			}
+		}
+	}
+
+	handler := Chain(func(c *CommandContext) error {
+		calls = append(calls, "handler")
+		return nil
+	}, record("outer"), record("inner"))
+
+	require.NoError(t, handler(NewCommandContext(nil, newTestInteraction("balance"))))
+	assert.Equal(t, []string{"outer before", "inner before", "handler", "inner after", "outer after"}, calls)
+}
+
+func TestRecover_ConvertsPanicToError(t *testing.T) {
+	handler := Chain(func(c *CommandContext) error {
+		panic("boom")
+	}, Recover())
+
+	err := handler(NewCommandContext(nil, newTestInteraction("balance")))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+}
+
+func TestParseIDs(t *testing.T) {
+	t.Run("parses guild and user", func(t *testing.T) {
+		c := NewCommandContext(nil, newTestInteraction("balance"))
+		err := Chain(func(c *CommandContext) error { return nil }, ParseIDs())(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(123), c.GuildID)
+		assert.Equal(t, int64(456), c.UserID)
+	})
+
+	t.Run("rejects commands outside a guild", func(t *testing.T) {
+		i := newTestInteraction("balance")
+		i.Member = nil
+		called := false
+		err := Chain(func(c *CommandContext) error {
+			called = true
+			return nil
+		}, ParseIDs())(NewCommandContext(nil, i))
+
+		_, isUserError := UserErrorMessage(err)
+		assert.True(t, isUserError)
+		assert.False(t, called)
+	})
+}
+
+func TestWithUnitOfWork(t *testing.T) {
+	t.Run("commits when the handler succeeds", func(t *testing.T) {
+		factory := &fakeUnitOfWorkFactory{uow: &fakeUnitOfWork{}}
+		handler := Chain(func(c *CommandContext) error {
+			assert.Same(t, factory.uow, c.UoW)
+			return nil
+		}, ParseIDs(), WithUnitOfWork(factory))
+
+		require.NoError(t, handler(NewCommandContext(nil, newTestInteraction("export"))))
+		assert.Equal(t, int64(123), factory.guildID)
+		assert.True(t, factory.uow.committed)
+		assert.False(t, factory.uow.rolledBack)
+	})
+
+	t.Run("rolls back when the handler fails", func(t *testing.T) {
+		factory := &fakeUnitOfWorkFactory{uow: &fakeUnitOfWork{}}
+		handlerErr := errors.New("export failed")
+		handler := Chain(func(c *CommandContext) error {
+			return handlerErr
+		}, ParseIDs(), WithUnitOfWork(factory))
+
+		assert.ErrorIs(t, handler(NewCommandContext(nil, newTestInteraction("export"))), handlerErr)
+		assert.False(t, factory.uow.committed)
+		assert.True(t, factory.uow.rolledBack)
+	})
+
+	t.Run("skips the handler when the transaction can't begin", func(t *testing.T) {
+		factory := &fakeUnitOfWorkFactory{uow: &fakeUnitOfWork{beginErr: errors.New("pool exhausted")}}
+		called := false
+		handler := Chain(func(c *CommandContext) error {
+			called = true
+			return nil
+		}, ParseIDs(), WithUnitOfWork(factory))
+
+		assert.Error(t, handler(NewCommandContext(nil, newTestInteraction("export"))))
+		assert.False(t, called)
+	})
+}
+
+func TestNewCommandContext_CorrelationID(t *testing.T) {
+	first := NewCommandContext(nil, newTestInteraction("balance"))
+	second := NewCommandContext(nil, newTestInteraction("balance"))
+
+	assert.NotEmpty(t, first.CorrelationID)
+	assert.NotEqual(t, first.CorrelationID, second.CorrelationID)
+	assert.Equal(t, first.CorrelationID, CorrelationIDFromContext(first.Ctx))
+	assert.Empty(t, CorrelationIDFromContext(context.Background()))
+}
+
+func TestCommandPath(t *testing.T) {
+	i := newTestInteraction("settings", &discordgo.ApplicationCommandInteractionDataOption{
+		Name: "pot-tax",
+		Type: discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: "percent", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(5)},
+		},
+	})
+
+	assert.Equal(t, "settings pot-tax", CommandPath(i))
+	assert.Equal(t, "balance", CommandPath(newTestInteraction("balance")))
+}
@@ -21,6 +21,9 @@ const (
 const (
 	WagerVotingDuration = 24 * 60 * 60 // 24 hours in seconds
 	MinWagerAmount      = 100
+
+	// RulesFooterHint points bettors at the guild's resolution and dispute policy
+	RulesFooterHint = "📜 /rules for resolution & disputes"
 )
 
 // UI constants
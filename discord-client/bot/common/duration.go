@@ -0,0 +1,73 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidDuration is returned when a typed duration is not in a format ParseDurationMinutes understands
+var ErrInvalidDuration = errors.New("use hours (24), hours:minutes (1:30) or a unit such as 90m, 6h, 2d or 1w")
+
+// durationUnits are the suffixes ParseDurationMinutes accepts, in minutes
+var durationUnits = map[byte]int{
+	'm': 1,
+	'h': 60,
+	'd': 24 * 60,
+	'w': 7 * 24 * 60,
+}
+
+// ParseDurationMinutes parses a duration typed by a user into minutes. It accepts plain hours ("24"),
+// hours and minutes ("1:30", ":45") and a single unit suffix ("90m", "6h", "2d", "1w").
+func ParseDurationMinutes(text string) (int, error) {
+	text = strings.ToLower(strings.TrimSpace(text))
+	if text == "" {
+		return 0, ErrInvalidDuration
+	}
+
+	if hoursText, minutesText, ok := strings.Cut(text, ":"); ok {
+		hours := 0
+		if hoursText = strings.TrimSpace(hoursText); hoursText != "" {
+			var err error
+			if hours, err = strconv.Atoi(hoursText); err != nil || hours < 0 {
+				return 0, ErrInvalidDuration
+			}
+		}
+		minutes, err := strconv.Atoi(strings.TrimSpace(minutesText))
+		if err != nil {
+			return 0, ErrInvalidDuration
+		}
+		if minutes < 0 || minutes >= 60 {
+			return 0, fmt.Errorf("minutes must be between 0 and 59")
+		}
+		return hours*60 + minutes, nil
+	}
+
+	unit := 60 // Plain numbers are hours
+	if perUnit, ok := durationUnits[text[len(text)-1]]; ok {
+		unit = perUnit
+		text = strings.TrimSpace(text[:len(text)-1])
+	}
+
+	value, err := strconv.Atoi(text)
+	if err != nil || value < 0 {
+		return 0, ErrInvalidDuration
+	}
+	return value * unit, nil
+}
+
+// FormatDurationMinutes formats minutes so ParseDurationMinutes reads them back, using the largest
+// whole unit ("1w", "2d", "6h") or hours:minutes otherwise
+func FormatDurationMinutes(minutes int) string {
+	switch {
+	case minutes > 0 && minutes%durationUnits['w'] == 0:
+		return fmt.Sprintf("%dw", minutes/durationUnits['w'])
+	case minutes > 0 && minutes%durationUnits['d'] == 0:
+		return fmt.Sprintf("%dd", minutes/durationUnits['d'])
+	case minutes%60 == 0:
+		return fmt.Sprintf("%dh", minutes/60)
+	default:
+		return fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
+	}
+}
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDurationMinutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{input: "24", want: 1440},
+		{input: " 6 ", want: 360},
+		{input: "1:30", want: 90},
+		{input: ":45", want: 45},
+		{input: "0:05", want: 5},
+		{input: "90m", want: 90},
+		{input: "6h", want: 360},
+		{input: "6H", want: 360},
+		{input: "2d", want: 2880},
+		{input: "1w", want: 10080},
+		{input: "", wantErr: true},
+		{input: "soon", wantErr: true},
+		{input: "1:60", wantErr: true},
+		{input: "1:2:3", wantErr: true},
+		{input: "-1", wantErr: true},
+		{input: "h", wantErr: true},
+		{input: "1.5h", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseDurationMinutes(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatDurationMinutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		minutes int
+		want    string
+	}{
+		{minutes: 60, want: "1h"},
+		{minutes: 360, want: "6h"},
+		{minutes: 1440, want: "1d"},
+		{minutes: 10080, want: "1w"},
+		{minutes: 90, want: "1:30"},
+		{minutes: 5, want: "0:05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			formatted := FormatDurationMinutes(tt.minutes)
+			assert.Equal(t, tt.want, formatted)
+
+			// Formatted durations parse back to the same minutes
+			parsed, err := ParseDurationMinutes(formatted)
+			require.NoError(t, err)
+			assert.Equal(t, tt.minutes, parsed)
+		})
+	}
+}
@@ -1,7 +1,12 @@
 package common
 
 import (
+	"errors"
 	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"gambler/discord-client/domain/entities"
 
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
@@ -48,6 +53,71 @@ func NewSystemError(err error, logMessage string) *BotError {
 	}
 }
 
+// domainErrorMessages maps domain errors to the message shown to users. An empty message shows
+// the error's own text, for errors that already say what went wrong along with the details.
+var domainErrorMessages = []struct {
+	err     error
+	message string
+}{
+	{entities.ErrGroupWagerNotFound, "That wager doesn't exist anymore."},
+	{entities.ErrWagerNotFound, "That wager doesn't exist anymore."},
+	{entities.ErrVotingEnded, "Betting has closed on this wager."},
+	{entities.ErrNotAuthorized, "You don't have permission to do that."},
+	{entities.ErrUserNotFound, "That user doesn't have an account in this server yet."},
+	{entities.ErrInsufficientBalance, ""},
+	{entities.ErrNonPositiveAmount, ""},
+	{entities.ErrAmountNotAffordable, ""},
+	{entities.ErrBettingCurfewActive, ""},
+	{entities.ErrGamblingBreakActive, ""},
+	{entities.ErrBalanceFrozen, ""},
+	{entities.ErrBalanceNotFrozen, ""},
+	{entities.ErrBelowBalanceFloor, ""},
+	{entities.ErrBelowMinBet, ""},
+	{entities.ErrGroupWagerFull, ""},
+	{entities.ErrNotInvitedToGroupWager, ""},
+	{entities.ErrBetOnOwnGame, ""},
+	{entities.ErrFeatureDisabled, ""},
+}
+
+// UserErrorMessage returns the message to show a user for a domain error, and false when the
+// error isn't one users can act on
+func UserErrorMessage(err error) (string, bool) {
+	var botErr *BotError
+	if errors.As(err, &botErr) && botErr.UserMessage != "" {
+		return botErr.UserMessage, true
+	}
+
+	for _, entry := range domainErrorMessages {
+		if !errors.Is(err, entry.err) {
+			continue
+		}
+		if entry.message != "" {
+			return entry.message, true
+		}
+		return capitalize(err.Error()), true
+	}
+
+	return "", false
+}
+
+// DescribeError returns the message to show for a failed action, using the friendly message for
+// domain errors and falling back to the action and the error text
+func DescribeError(action string, err error) string {
+	if message, ok := UserErrorMessage(err); ok {
+		return message
+	}
+	return fmt.Sprintf("%s: %v", action, err)
+}
+
+// capitalize upper-cases the first letter of a message
+func capitalize(message string) string {
+	r, size := utf8.DecodeRuneInString(message)
+	if r == utf8.RuneError {
+		return message
+	}
+	return string(unicode.ToUpper(r)) + message[size:]
+}
+
 // RespondWithError sends an error message as an interaction response
 func RespondWithError(s *discordgo.Session, i *discordgo.InteractionCreate, message string) {
 	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
@@ -91,6 +161,19 @@ func HandleError(s *discordgo.Session, i *discordgo.InteractionCreate, err error
 		} else {
 			RespondWithError(s, i, botErr.UserMessage)
 		}
+	} else if message, ok := UserErrorMessage(err); ok {
+		// Domain errors are expected outcomes the user can act on
+		log.WithFields(log.Fields{
+			"user_id": i.Member.User.ID,
+			"command": i.ApplicationCommandData().Name,
+			"error":   err.Error(),
+		}).Warn("Domain error in bot command")
+
+		if deferred {
+			FollowUpWithError(s, i, message)
+		} else {
+			RespondWithError(s, i, message)
+		}
 	} else {
 		// Unexpected error - log full details but show generic message to user
 		log.WithFields(log.Fields{
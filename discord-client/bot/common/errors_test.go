@@ -0,0 +1,59 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserErrorMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+		ok       bool
+	}{
+		{
+			name:     "mapped message",
+			err:      fmt.Errorf("failed to place bet: %w", entities.ErrVotingEnded),
+			expected: "Betting has closed on this wager.",
+			ok:       true,
+		},
+		{
+			name:     "own text is capitalized",
+			err:      fmt.Errorf("%w: have 500 available, need 1.0k", entities.ErrInsufficientBalance),
+			expected: "Insufficient balance: have 500 available, need 1.0k",
+			ok:       true,
+		},
+		{
+			name:     "bot error user message",
+			err:      NewUserError("Pick an option first.", "no option selected"),
+			expected: "Pick an option first.",
+			ok:       true,
+		},
+		{
+			name: "unexpected error",
+			err:  errors.New("connection reset"),
+			ok:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, ok := UserErrorMessage(tt.err)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, message)
+		})
+	}
+}
+
+func TestDescribeError(t *testing.T) {
+	assert.Equal(t, "That wager doesn't exist anymore.",
+		DescribeError("Failed to cancel wager", fmt.Errorf("failed to get wager: %w", entities.ErrWagerNotFound)))
+	assert.Equal(t, "Failed to cancel wager: connection reset",
+		DescribeError("Failed to cancel wager", errors.New("connection reset")))
+}
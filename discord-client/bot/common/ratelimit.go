@@ -0,0 +1,162 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// RateLimitAction groups interactions that draw from the same token bucket
+type RateLimitAction string
+
+const (
+	RateLimitBet      RateLimitAction = "bet"
+	RateLimitLottery  RateLimitAction = "lottery"
+	RateLimitTransfer RateLimitAction = "transfer"
+)
+
+// maxIdleBuckets is how many buckets are kept before full (idle) ones are dropped
+const maxIdleBuckets = 10000
+
+// InteractionHandler handles a single Discord interaction
+type InteractionHandler func(s *discordgo.Session, i *discordgo.InteractionCreate)
+
+// RateLimitLoader returns the rate limit configured for a guild
+type RateLimitLoader func(ctx context.Context, guildID int64) (entities.RateLimit, error)
+
+type rateLimitKey struct {
+	guildID   int64
+	discordID int64
+	action    RateLimitAction
+}
+
+type tokenBucket struct {
+	tokens   float64
+	limit    entities.RateLimit
+	refilled time.Time
+}
+
+// refill tops the bucket up for the time elapsed since it was last refilled
+func (b *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.refilled).Minutes()
+	if elapsed > 0 {
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed*float64(b.limit.PerMinute))
+		b.refilled = now
+	}
+}
+
+// RateLimiter keeps a token bucket per user, guild and action so spam and
+// accidental double submits are rejected before they reach the services
+type RateLimiter struct {
+	mu        sync.Mutex
+	buckets   map[rateLimitKey]*tokenBucket
+	loadLimit RateLimitLoader
+	now       func() time.Time
+}
+
+// NewRateLimiter creates a rate limiter that reads each guild's limit with loadLimit
+func NewRateLimiter(loadLimit RateLimitLoader) *RateLimiter {
+	return &RateLimiter{
+		buckets:   make(map[rateLimitKey]*tokenBucket),
+		loadLimit: loadLimit,
+		now:       time.Now,
+	}
+}
+
+// Allow takes a token from the user's bucket for the action.
+// When the bucket is empty it returns false and how long until the next token.
+func (l *RateLimiter) Allow(guildID, discordID int64, action RateLimitAction, limit entities.RateLimit) (bool, time.Duration) {
+	if !limit.Enabled() {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	key := rateLimitKey{guildID: guildID, discordID: discordID, action: action}
+	bucket, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxIdleBuckets {
+			l.pruneLocked(now)
+		}
+		bucket = &tokenBucket{tokens: float64(limit.Burst), limit: limit, refilled: now}
+		l.buckets[key] = bucket
+	}
+
+	// Pick up settings changes without granting a fresh burst
+	if bucket.limit != limit {
+		bucket.refill(now)
+		bucket.limit = limit
+		bucket.tokens = math.Min(bucket.tokens, float64(limit.Burst))
+	}
+	bucket.refill(now)
+
+	if bucket.tokens < 1 {
+		wait := time.Duration((1 - bucket.tokens) / float64(limit.PerMinute) * float64(time.Minute))
+		return false, wait
+	}
+
+	bucket.tokens--
+	return true, 0
+}
+
+// pruneLocked drops buckets that have refilled completely, since they behave like new ones
+func (l *RateLimiter) pruneLocked(now time.Time) {
+	for key, bucket := range l.buckets {
+		bucket.refill(now)
+		if bucket.tokens >= float64(bucket.limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// Guard wraps a handler so it only runs while the user has a token for the action.
+// Otherwise the user is told how long to wait and the handler is skipped.
+func (l *RateLimiter) Guard(action RateLimitAction, handler InteractionHandler) InteractionHandler {
+	return func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+		if l == nil || i.Member == nil || i.Member.User == nil {
+			handler(s, i)
+			return
+		}
+
+		guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+		if err != nil {
+			handler(s, i)
+			return
+		}
+		discordID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+		if err != nil {
+			handler(s, i)
+			return
+		}
+
+		limit, err := l.loadLimit(context.Background(), guildID)
+		if err != nil {
+			log.WithError(err).Warnf("Failed to load rate limit for guild %d, using defaults", guildID)
+			limit = entities.RateLimit{PerMinute: entities.DefaultRateLimitPerMinute, Burst: entities.DefaultRateLimitBurst}
+		}
+
+		allowed, wait := l.Allow(guildID, discordID, action, limit)
+		if !allowed {
+			log.WithFields(log.Fields{
+				"guild":  guildID,
+				"user":   discordID,
+				"action": action,
+				"wait":   wait,
+			}).Debug("Rate limited interaction")
+			RespondWithError(s, i, fmt.Sprintf("⏳ Slow down! Try again in %d seconds.", int(math.Ceil(wait.Seconds()))))
+			return
+		}
+
+		handler(s, i)
+	}
+}
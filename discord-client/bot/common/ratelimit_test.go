@@ -0,0 +1,95 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRateLimiter(now *time.Time) *RateLimiter {
+	limiter := NewRateLimiter(nil)
+	limiter.now = func() time.Time { return *now }
+	return limiter
+}
+
+func TestRateLimiter_Allow(t *testing.T) {
+	limit := entities.RateLimit{PerMinute: 6, Burst: 2}
+
+	t.Run("burst then refill", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		limiter := newTestRateLimiter(&now)
+
+		allowed, _ := limiter.Allow(1, 100, RateLimitBet, limit)
+		assert.True(t, allowed)
+		allowed, _ = limiter.Allow(1, 100, RateLimitBet, limit)
+		assert.True(t, allowed)
+
+		// Bucket is empty, one token refills every 10 seconds
+		allowed, wait := limiter.Allow(1, 100, RateLimitBet, limit)
+		assert.False(t, allowed)
+		assert.Equal(t, 10*time.Second, wait)
+
+		now = now.Add(10 * time.Second)
+		allowed, _ = limiter.Allow(1, 100, RateLimitBet, limit)
+		assert.True(t, allowed)
+	})
+
+	t.Run("buckets are per user, guild and action", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		limiter := newTestRateLimiter(&now)
+		single := entities.RateLimit{PerMinute: 1, Burst: 1}
+
+		allowed, _ := limiter.Allow(1, 100, RateLimitBet, single)
+		assert.True(t, allowed)
+		allowed, _ = limiter.Allow(1, 100, RateLimitBet, single)
+		assert.False(t, allowed)
+
+		allowed, _ = limiter.Allow(1, 200, RateLimitBet, single)
+		assert.True(t, allowed, "other user")
+		allowed, _ = limiter.Allow(2, 100, RateLimitBet, single)
+		assert.True(t, allowed, "other guild")
+		allowed, _ = limiter.Allow(1, 100, RateLimitTransfer, single)
+		assert.True(t, allowed, "other action")
+	})
+
+	t.Run("disabled limit always allows", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		limiter := newTestRateLimiter(&now)
+		disabled := entities.RateLimit{PerMinute: 0, Burst: 1}
+
+		for range 10 {
+			allowed, _ := limiter.Allow(1, 100, RateLimitLottery, disabled)
+			assert.True(t, allowed)
+		}
+	})
+
+	t.Run("lowering the limit does not grant a fresh burst", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		limiter := newTestRateLimiter(&now)
+
+		for range 5 {
+			allowed, _ := limiter.Allow(1, 100, RateLimitBet, entities.RateLimit{PerMinute: 1, Burst: 5})
+			assert.True(t, allowed)
+		}
+
+		allowed, _ := limiter.Allow(1, 100, RateLimitBet, entities.RateLimit{PerMinute: 1, Burst: 2})
+		assert.False(t, allowed)
+	})
+
+	t.Run("idle buckets are pruned", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		limiter := newTestRateLimiter(&now)
+
+		for id := range int64(maxIdleBuckets) {
+			limiter.Allow(1, id, RateLimitBet, limit)
+		}
+		assert.Len(t, limiter.buckets, maxIdleBuckets)
+
+		now = now.Add(time.Minute)
+		limiter.Allow(1, maxIdleBuckets, RateLimitBet, limit)
+		assert.Len(t, limiter.buckets, 1)
+	})
+}
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"fmt"
+
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
 )
@@ -163,3 +165,38 @@ func UnpinMessage(s *discordgo.Session, channelID string, messageID string) {
 		log.Warnf("Failed to unpin message %s in channel %s: %v", messageID, channelID, err)
 	}
 }
+
+// maxThreadNameLength is Discord's limit on thread names
+const maxThreadNameLength = 100
+
+// StartMessageThread creates a discussion thread attached to a message.
+// Returns the thread ID, or an empty string if the thread could not be created.
+func StartMessageThread(s *discordgo.Session, channelID string, messageID string, name string) string {
+	if runes := []rune(name); len(runes) > maxThreadNameLength {
+		name = string(runes[:maxThreadNameLength-3]) + "..."
+	}
+
+	thread, err := s.MessageThreadStartComplex(channelID, messageID, &discordgo.ThreadStart{
+		Name:                name,
+		AutoArchiveDuration: 10080, // 7 days
+	})
+	if err != nil {
+		log.Warnf("Failed to start thread on message %s in channel %s: %v", messageID, channelID, err)
+		return ""
+	}
+	return thread.ID
+}
+
+// ArchiveThread locks and archives a thread
+func ArchiveThread(s *discordgo.Session, threadID string) error {
+	archived := true
+	locked := true
+	_, err := s.ChannelEdit(threadID, &discordgo.ChannelEdit{
+		Archived: &archived,
+		Locked:   &locked,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to archive thread %s: %w", threadID, err)
+	}
+	return nil
+}
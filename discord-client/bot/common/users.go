@@ -1,8 +1,11 @@
 package common
 
 import (
+	"context"
 	"strconv"
 
+	"gambler/discord-client/domain/utils"
+
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
 )
@@ -82,3 +85,26 @@ func IsUserAdmin(s *discordgo.Session, guildID, userID string) bool {
 
 	return false
 }
+
+// WithMemberRoles attaches the interacting member's roles to the context so services can check role-based permissions
+func WithMemberRoles(ctx context.Context, i *discordgo.InteractionCreate) context.Context {
+	return WithGuildMemberRoles(ctx, i.Member)
+}
+
+// WithGuildMemberRoles attaches a guild member's role IDs to the context, for events other than interactions
+// that carry the member, e.g. reactions
+func WithGuildMemberRoles(ctx context.Context, member *discordgo.Member) context.Context {
+	if member == nil {
+		return ctx
+	}
+
+	roleIDs := make([]int64, 0, len(member.Roles))
+	for _, roleID := range member.Roles {
+		id, err := strconv.ParseInt(roleID, 10, 64)
+		if err != nil {
+			continue
+		}
+		roleIDs = append(roleIDs, id)
+	}
+	return utils.WithMemberRoles(ctx, roleIDs)
+}
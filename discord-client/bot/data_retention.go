@@ -0,0 +1,61 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gambler/discord-client/domain/entities"
+)
+
+// PurgeGuildData deletes all stored data for a guild
+func (b *Bot) PurgeGuildData(guildIDStr string) (*entities.DataRetentionReport, error) {
+	guildID, err := strconv.ParseInt(guildIDStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid guild ID: %w", err)
+	}
+
+	ctx := context.Background()
+	uow := b.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	report, err := uow.Services().DataRetentionService().PurgeGuild(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit guild purge: %w", err)
+	}
+	return report, nil
+}
+
+// DeleteUserData anonymizes a user's shared history and deletes the rest of their data in every guild
+func (b *Bot) DeleteUserData(discordIDStr string) (*entities.DataRetentionReport, error) {
+	discordID, err := strconv.ParseInt(discordIDStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid discord ID: %w", err)
+	}
+
+	ctx := context.Background()
+
+	// User data spans every guild, so any guild ID works for the unit of work
+	uow := b.uowFactory.CreateForGuild(0)
+	if err := uow.Begin(ctx); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	report, err := uow.Services().DataRetentionService().DeleteUserData(ctx, discordID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit user data deletion: %w", err)
+	}
+	return report, nil
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"time"
 
+	"gambler/discord-client/application"
+
 	log "github.com/sirupsen/logrus"
 )
 
@@ -39,6 +41,49 @@ func (b *Bot) StartDebugAPI(port int) error {
 		w.Write([]byte("OK"))
 	})
 	
+	// Shard health endpoint, reports unavailable while the gateway connection is down
+	mux.HandleFunc("/health/shard", func(w http.ResponseWriter, r *http.Request) {
+		health := b.GetShardHealth()
+		w.Header().Set("Content-Type", "application/json")
+		if !health.Connected {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(health)
+	})
+	
+	// Worker health endpoint, reports unavailable when a job failed its last run or is overdue
+	mux.HandleFunc("/health/workers", func(w http.ResponseWriter, r *http.Request) {
+		var statuses []application.JobStatus
+		if b.scheduler != nil {
+			statuses = b.scheduler.Status()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		for _, status := range statuses {
+			if !status.Healthy {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				break
+			}
+		}
+		json.NewEncoder(w).Encode(statuses)
+	})
+	
+	// Prometheus metrics endpoint with per-guild economy gauges
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		if err := writeEconomyMetrics(w, b.economyGauges.snapshot()); err != nil {
+			log.Warnf("Failed to write metrics: %v", err)
+		}
+	})
+	
+	// External match result webhook, resolves group wagers linked with /groupwager link-match
+	mux.HandleFunc("/webhooks/results", func(w http.ResponseWriter, r *http.Request) {
+		if b.resultWebhook == nil {
+			http.Error(w, "Result webhook not configured", http.StatusNotFound)
+			return
+		}
+		b.resultWebhook.ServeHTTP(w, r)
+	})
+	
 	// Get guilds endpoint
 	mux.HandleFunc("/debug/guilds", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
@@ -100,6 +145,165 @@ func (b *Bot) StartDebugAPI(port int) error {
 			
 			respondWithSuccess(w, "Daily awards summary posted successfully")
 			
+		case "experiment-rollout":
+			key := cmd.Params["key"]
+			percent := cmd.Params["percent"]
+			
+			if key == "" || percent == "" {
+				respondWithError(w, "Missing key or percent", http.StatusBadRequest)
+				return
+			}
+			
+			if err := b.UpdateExperimentRollout(key, percent, cmd.Params["enabled"]); err != nil {
+				respondWithError(w, fmt.Sprintf("Failed to update experiment rollout: %v", err), http.StatusInternalServerError)
+				return
+			}
+			
+			respondWithSuccess(w, "Experiment rollout updated successfully")
+			
+		case "experiment-report":
+			key := cmd.Params["key"]
+			
+			if key == "" {
+				respondWithError(w, "Missing key", http.StatusBadRequest)
+				return
+			}
+			
+			report, err := b.GetExperimentReport(key)
+			if err != nil {
+				respondWithError(w, fmt.Sprintf("Failed to get experiment report: %v", err), http.StatusInternalServerError)
+				return
+			}
+			
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(DebugResponse{
+				Success: true,
+				Message: fmt.Sprintf("Report for experiment %s", key),
+				Data:    report,
+			})
+			
+		case "purge-guild":
+			guildID := cmd.Params["guild_id"]
+			
+			if guildID == "" {
+				respondWithError(w, "Missing guild_id", http.StatusBadRequest)
+				return
+			}
+			
+			report, err := b.PurgeGuildData(guildID)
+			if err != nil {
+				respondWithError(w, fmt.Sprintf("Failed to purge guild: %v", err), http.StatusInternalServerError)
+				return
+			}
+			
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(DebugResponse{
+				Success: true,
+				Message: fmt.Sprintf("Purged %d rows for guild %s", report.TotalDeleted(), guildID),
+				Data:    report,
+			})
+			
+		case "delete-user-data":
+			discordID := cmd.Params["discord_id"]
+			
+			if discordID == "" {
+				respondWithError(w, "Missing discord_id", http.StatusBadRequest)
+				return
+			}
+			
+			report, err := b.DeleteUserData(discordID)
+			if err != nil {
+				respondWithError(w, fmt.Sprintf("Failed to delete user data: %v", err), http.StatusInternalServerError)
+				return
+			}
+			
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(DebugResponse{
+				Success: true,
+				Message: fmt.Sprintf("Anonymized %d rows and deleted %d rows for user %s",
+					report.TotalAnonymized(), report.TotalDeleted(), discordID),
+				Data: report,
+			})
+			
+		case "bulk-adjust-balances":
+			guildID := cmd.Params["guild_id"]
+			csv := cmd.Params["csv"]
+			
+			if guildID == "" || csv == "" {
+				respondWithError(w, "Missing guild_id or csv", http.StatusBadRequest)
+				return
+			}
+			
+			report, err := b.BulkAdjustBalances(guildID, csv, cmd.Params["dry_run"] == "true")
+			if err != nil {
+				respondWithError(w, fmt.Sprintf("Failed to adjust balances: %v", err), http.StatusBadRequest)
+				return
+			}
+			
+			message := fmt.Sprintf("Adjusted %d balances by %d bits", report.ChangedCount(), report.TotalChange)
+			if report.DryRun {
+				message = fmt.Sprintf("Dry run: would adjust %d balances by %d bits", report.ChangedCount(), report.TotalChange)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(DebugResponse{
+				Success: true,
+				Message: message,
+				Data:    report,
+			})
+			
+		case "create-snapshot":
+			guildID := cmd.Params["guild_id"]
+			
+			if guildID == "" {
+				respondWithError(w, "Missing guild_id", http.StatusBadRequest)
+				return
+			}
+			
+			snapshot, err := b.CreateGuildSnapshot(guildID, cmd.Params["reason"])
+			if err != nil {
+				respondWithError(w, fmt.Sprintf("Failed to create snapshot: %v", err), http.StatusInternalServerError)
+				return
+			}
+			
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(DebugResponse{
+				Success: true,
+				Message: fmt.Sprintf("Created snapshot %d of %d balances for guild %s", snapshot.ID, len(snapshot.Data.Balances), guildID),
+				Data:    snapshot,
+			})
+			
+		case "restore-snapshot":
+			guildID := cmd.Params["guild_id"]
+			snapshotID := cmd.Params["snapshot_id"]
+			dryRun := cmd.Params["dry_run"] == "true"
+			
+			if guildID == "" || snapshotID == "" {
+				respondWithError(w, "Missing guild_id or snapshot_id", http.StatusBadRequest)
+				return
+			}
+			// Restoring overwrites every balance in the guild, so require the guild ID to be repeated
+			if !dryRun && cmd.Params["confirm"] != guildID {
+				respondWithError(w, "Restoring a snapshot requires confirm set to the guild_id, or dry_run=true to preview it", http.StatusBadRequest)
+				return
+			}
+			
+			report, err := b.RestoreGuildSnapshot(guildID, snapshotID, dryRun)
+			if err != nil {
+				respondWithError(w, fmt.Sprintf("Failed to restore snapshot: %v", err), http.StatusBadRequest)
+				return
+			}
+			
+			message := fmt.Sprintf("Restored %d balances by %d bits", report.ChangedCount(), report.TotalChange)
+			if report.DryRun {
+				message = fmt.Sprintf("Dry run: would restore %d balances by %d bits", report.ChangedCount(), report.TotalChange)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(DebugResponse{
+				Success: true,
+				Message: message,
+				Data:    report,
+			})
+			
 		default:
 			respondWithError(w, fmt.Sprintf("Unknown action: %s", cmd.Action), http.StatusBadRequest)
 		}
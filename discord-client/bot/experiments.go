@@ -0,0 +1,55 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gambler/discord-client/domain/entities"
+)
+
+// UpdateExperimentRollout changes the rollout percentage of an experiment
+func (b *Bot) UpdateExperimentRollout(key, percentStr, enabledStr string) error {
+	percent, err := strconv.Atoi(percentStr)
+	if err != nil {
+		return fmt.Errorf("invalid rollout percent: %w", err)
+	}
+
+	enabled := true
+	if enabledStr != "" {
+		enabled, err = strconv.ParseBool(enabledStr)
+		if err != nil {
+			return fmt.Errorf("invalid enabled flag: %w", err)
+		}
+	}
+
+	ctx := context.Background()
+
+	// Experiments are not guild scoped, so any guild ID works for the unit of work
+	uow := b.uowFactory.CreateForGuild(0)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	experimentService := uow.Services().ExperimentService()
+	if err := experimentService.UpdateRollout(ctx, key, percent, enabled); err != nil {
+		return err
+	}
+
+	return uow.Commit()
+}
+
+// GetExperimentReport returns per-variant metrics for an experiment
+func (b *Bot) GetExperimentReport(key string) ([]*entities.ExperimentVariantReport, error) {
+	ctx := context.Background()
+
+	uow := b.uowFactory.CreateForGuild(0)
+	if err := uow.Begin(ctx); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	experimentService := uow.Services().ExperimentService()
+	return experimentService.GetReport(ctx, key)
+}
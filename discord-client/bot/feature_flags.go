@@ -0,0 +1,69 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// commandFeatures maps slash commands to the feature that gates them
+var commandFeatures = map[string]entities.Feature{
+	"lotto": entities.FeatureLottery,
+	"duel":  entities.FeatureDuels,
+}
+
+// interactionFeatures maps component and modal custom ID prefixes to the feature that gates them
+var interactionFeatures = map[string]entities.Feature{
+	"lotto_": entities.FeatureLottery,
+	"duel_":  entities.FeatureDuels,
+}
+
+// interactionFeature returns the feature that gates a component or modal interaction
+func interactionFeature(customID string) (entities.Feature, bool) {
+	for prefix, feature := range interactionFeatures {
+		if strings.HasPrefix(customID, prefix) {
+			return feature, true
+		}
+	}
+	return "", false
+}
+
+// featureEnabled reports whether a feature is turned on in the interaction's guild, telling the user
+// when it is not. Failing to load the flags lets the interaction through rather than turning everything off.
+func (b *Bot) featureEnabled(s *discordgo.Session, i *discordgo.InteractionCreate, feature entities.Feature) bool {
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		return true
+	}
+
+	ctx := context.Background()
+
+	uow := b.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Failed to begin transaction for feature flag check: %v", err)
+		return true
+	}
+	defer uow.Rollback()
+
+	enabled, err := uow.Services().FeatureFlagService().IsEnabled(ctx, feature)
+	if err != nil {
+		log.Errorf("Failed to check feature %s for guild %d: %v", feature, guildID, err)
+		return true
+	}
+
+	if !enabled {
+		name := string(feature)
+		if definition, ok := feature.Definition(); ok {
+			name = definition.Name
+		}
+		common.RespondWithError(s, i, fmt.Sprintf("%s is disabled in this server.", name))
+	}
+	return enabled
+}
@@ -0,0 +1,22 @@
+package bot
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInteractionFeature(t *testing.T) {
+	feature, ok := interactionFeature("lotto_buy_modal")
+	assert.True(t, ok)
+	assert.Equal(t, entities.FeatureLottery, feature)
+
+	feature, ok = interactionFeature("duel_accept_42")
+	assert.True(t, ok)
+	assert.Equal(t, entities.FeatureDuels, feature)
+
+	_, ok = interactionFeature("group_wager_bet_1")
+	assert.False(t, ok)
+}
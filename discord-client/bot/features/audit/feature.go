@@ -0,0 +1,71 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gambler/discord-client/application"
+	"gambler/discord-client/domain/events"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// Feature posts large balance changes to each guild's configured audit channel
+type Feature struct {
+	session    *discordgo.Session
+	uowFactory application.UnitOfWorkFactory
+}
+
+// NewFeature creates a new audit log feature instance
+func NewFeature(session *discordgo.Session, uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		session:    session,
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleBalanceChange posts a BalanceChangeEvent to the guild's audit channel when it meets the audit threshold
+func (f *Feature) HandleBalanceChange(ctx context.Context, event events.Event) error {
+	e, err := application.AssertEventType[events.BalanceChangeEvent](event, "BalanceChangeEvent")
+	if err != nil {
+		return err
+	}
+
+	// Balance changes outside a guild have no audit channel
+	if e.GuildID == 0 {
+		return nil
+	}
+
+	uow := f.uowFactory.CreateForGuild(e.GuildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	guildSettingsService := uow.Services().GuildSettingsService()
+	settings, err := guildSettingsService.GetOrCreateSettings(ctx, e.GuildID)
+	if err != nil {
+		return fmt.Errorf("failed to get guild settings: %w", err)
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Warnf("Failed to commit read-only transaction for guild %d: %v", e.GuildID, err)
+	}
+
+	if !settings.ShouldAuditBalanceChange(e.ChangeAmount) {
+		return nil
+	}
+
+	channelID := strconv.FormatInt(settings.GetAuditChannelID(), 10)
+	if _, err := f.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content: FormatBalanceChangeAudit(e),
+		// Mention users for display without pinging them
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	}); err != nil {
+		return fmt.Errorf("failed to post audit message to channel %s: %w", channelID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package audit
+
+import (
+	"fmt"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+)
+
+// FormatBalanceChangeAudit formats a balance change as a single-line audit log entry
+func FormatBalanceChangeAudit(e events.BalanceChangeEvent) string {
+	sign := "+"
+	change := e.ChangeAmount
+	if change < 0 {
+		sign = "-"
+		change = -change
+	}
+
+	return fmt.Sprintf("%s `%s` <@%d> **%s%s** (%s → %s)",
+		getTransactionEmoji(e.TransactionType),
+		e.TransactionType,
+		e.UserID,
+		sign,
+		common.FormatBalance(change),
+		common.FormatBalance(e.OldBalance),
+		common.FormatBalance(e.NewBalance),
+	)
+}
+
+// getTransactionEmoji returns an emoji indicator for the transaction category
+func getTransactionEmoji(transactionType entities.TransactionType) string {
+	switch {
+	case transactionType.IsWinType(), transactionType == entities.TransactionTypeLottoWin:
+		return "🟢"
+	case transactionType.IsLossType():
+		return "🔴"
+	case transactionType.IsTransferType():
+		return "🔁"
+	case transactionType.IsSystemGenerated():
+		return "⚙️"
+	default:
+		return "📝"
+	}
+}
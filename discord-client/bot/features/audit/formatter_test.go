@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatBalanceChangeAudit(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    events.BalanceChangeEvent
+		expected string
+	}{
+		{
+			name: "group wager win",
+			event: events.BalanceChangeEvent{
+				UserID:          111,
+				OldBalance:      10000,
+				NewBalance:      35000,
+				TransactionType: entities.TransactionTypeGroupWagerWin,
+				ChangeAmount:    25000,
+			},
+			expected: "🟢 `group_wager_win` <@111> **+25,000** (10,000 → 35,000)",
+		},
+		{
+			name: "transfer out",
+			event: events.BalanceChangeEvent{
+				UserID:          222,
+				OldBalance:      50000,
+				NewBalance:      20000,
+				TransactionType: entities.TransactionTypeTransferOut,
+				ChangeAmount:    -30000,
+			},
+			expected: "🔁 `transfer_out` <@222> **-30,000** (50,000 → 20,000)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatBalanceChangeAudit(tt.event))
+		})
+	}
+}
@@ -2,11 +2,13 @@ package balance
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"gambler/discord-client/bot/common"
-	"gambler/discord-client/domain/services"
+	"gambler/discord-client/domain/entities"
 
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
@@ -41,20 +43,32 @@ func (f *Feature) handleBalance(s *discordgo.Session, i *discordgo.InteractionCr
 	defer uow.Rollback()
 
 	// Instantiate user service with repositories from UnitOfWork
-	userService := services.NewUserService(
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	userService := uow.Services().UserService()
 
 	// Get or create user
-	user, err := userService.GetOrCreateUser(ctx, discordID, i.Member.User.Username)
+	user, err := userService.GetOrCreateUser(ctx, guildID, discordID, i.Member.User.Username)
 	if err != nil {
 		log.Errorf("Error getting user %d: %v", discordID, err)
 		common.RespondWithError(s, i, "Unable to retrieve balance. Please try again.")
 		return
 	}
 
+	locked, err := userService.GetLockedBalanceBreakdown(ctx, discordID)
+	if err != nil {
+		log.Errorf("Error getting locked balance for user %d: %v", discordID, err)
+		common.RespondWithError(s, i, "Unable to retrieve balance. Please try again.")
+		return
+	}
+
+	// Season tokens are optional per guild, so a disabled currency is not an error here
+	seasonTokenService := uow.Services().SeasonTokenService()
+	tokens, err := seasonTokenService.GetBalance(ctx, guildID, discordID)
+	if err != nil && !errors.Is(err, entities.ErrSeasonTokensDisabled) {
+		log.Errorf("Error getting season tokens for user %d: %v", discordID, err)
+		common.RespondWithError(s, i, "Unable to retrieve balance. Please try again.")
+		return
+	}
+
 	// Commit the transaction
 	if err := uow.Commit(); err != nil {
 		log.Errorf("Error committing transaction: %v", err)
@@ -67,6 +81,10 @@ func (f *Feature) handleBalance(s *discordgo.Session, i *discordgo.InteractionCr
 
 	// Format and send response
 	message := fmt.Sprintf("%s, your current balance: **%s bits**", displayName, common.FormatBalance(user.AvailableBalance))
+	message += formatLockedBalance(locked)
+	if tokens != nil {
+		message += fmt.Sprintf("\nSeason %d %s: **%s**", tokens.Season, tokens.TokenName, common.FormatBalance(tokens.Balance))
+	}
 	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
 		Data: &discordgo.InteractionResponseData{
@@ -78,3 +96,30 @@ func (f *Feature) handleBalance(s *discordgo.Session, i *discordgo.InteractionCr
 		log.Errorf("Error responding to balance command: %v", err)
 	}
 }
+
+// formatLockedBalance lists the non-zero parts of a user's locked balance
+func formatLockedBalance(locked *entities.LockedBalanceBreakdown) string {
+	var lines []string
+	if locked.InWagers > 0 {
+		lines = append(lines, fmt.Sprintf("Locked in wagers: %s bits", common.FormatBalance(locked.InWagers)))
+	}
+	if locked.InGroupWagers > 0 {
+		lines = append(lines, fmt.Sprintf("Locked in group wagers: %s bits", common.FormatBalance(locked.InGroupWagers)))
+	}
+	if locked.InLottery > 0 {
+		lines = append(lines, fmt.Sprintf("In the current lottery: %s bits", common.FormatBalance(locked.InLottery)))
+	}
+	if locked.InSavings > 0 {
+		lines = append(lines, fmt.Sprintf("Locked in savings: %s bits", common.FormatBalance(locked.InSavings)))
+	}
+	if locked.InParlays > 0 {
+		lines = append(lines, fmt.Sprintf("Staked on open parlays: %s bits", common.FormatBalance(locked.InParlays)))
+	}
+	if locked.InDuels > 0 {
+		lines = append(lines, fmt.Sprintf("Staked on open duels: %s bits", common.FormatBalance(locked.InDuels)))
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "\n" + strings.Join(lines, "\n")
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"gambler/discord-client/application"
+	"gambler/discord-client/bot/common"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -14,12 +15,14 @@ import (
 // Feature represents the betting feature
 type Feature struct {
 	uowFactory application.UnitOfWorkFactory
+	limiter    *common.RateLimiter
 }
 
 // New creates a new betting feature instance
-func New(uowFactory application.UnitOfWorkFactory) *Feature {
+func New(uowFactory application.UnitOfWorkFactory, limiter *common.RateLimiter) *Feature {
 	f := &Feature{
 		uowFactory: uowFactory,
+		limiter:    limiter,
 	}
 
 	// Start session cleanup
@@ -53,17 +56,17 @@ func (f *Feature) startSessionCleanup() {
 	}
 }
 
-// createUnitOfWork creates and begins a guild-scoped unit of work from a Discord interaction
-func (f *Feature) createUnitOfWork(ctx context.Context, i *discordgo.InteractionCreate) (application.UnitOfWork, error) {
+// createUnitOfWork creates and begins a guild-scoped unit of work from a Discord interaction, returning it with the guild ID
+func (f *Feature) createUnitOfWork(ctx context.Context, i *discordgo.InteractionCreate) (application.UnitOfWork, int64, error) {
 	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing guild ID %s: %w", i.GuildID, err)
+		return nil, 0, fmt.Errorf("error parsing guild ID %s: %w", i.GuildID, err)
 	}
 
 	uow := f.uowFactory.CreateForGuild(guildID)
 	if err := uow.Begin(ctx); err != nil {
-		return nil, fmt.Errorf("error beginning transaction: %w", err)
+		return nil, 0, fmt.Errorf("error beginning transaction: %w", err)
 	}
 
-	return uow, nil
+	return uow, guildID, nil
 }
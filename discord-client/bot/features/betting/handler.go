@@ -2,11 +2,12 @@ package betting
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
 	"gambler/discord-client/bot/common"
-	"gambler/discord-client/domain/services"
+	"gambler/discord-client/domain/entities"
 
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
@@ -50,7 +51,7 @@ func (f *Feature) prepareGambleData(ctx context.Context, s *discordgo.Session, i
 	}
 
 	// Create guild-scoped unit of work
-	uow, err := f.createUnitOfWork(ctx, i)
+	uow, guildID, err := f.createUnitOfWork(ctx, i)
 	if err != nil {
 		log.Errorf("Error creating unit of work: %v", err)
 		return nil, nil, 0, fmt.Errorf("unable to create transaction: %w", err)
@@ -58,14 +59,10 @@ func (f *Feature) prepareGambleData(ctx context.Context, s *discordgo.Session, i
 	defer uow.Rollback()
 
 	// Instantiate services with repositories from UnitOfWork
-	userService := services.NewUserService(
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	userService := uow.Services().UserService()
 
 	// Get or create user
-	user, err := userService.GetOrCreateUser(ctx, discordID, i.Member.User.Username)
+	user, err := userService.GetOrCreateUser(ctx, guildID, discordID, i.Member.User.Username)
 	if err != nil {
 		log.Errorf("Error getting/creating user %d: %v", discordID, err)
 		return nil, nil, 0, fmt.Errorf("unable to get user: %w", err)
@@ -142,18 +139,18 @@ func (f *Feature) handleComponentInteraction(s *discordgo.Session, i *discordgo.
 	case "bet_new":
 		f.handleNewBet(s, i)
 	case "bet_repeat":
-		f.handleRepeatSameBet(s, i)
+		f.limiter.Guard(common.RateLimitBet, f.handleRepeatSameBet)(s, i)
 	case "bet_double":
-		f.handleDoubleBet(s, i)
+		f.limiter.Guard(common.RateLimitBet, f.handleDoubleBet)(s, i)
 	case "bet_halve":
-		f.handleHalveBet(s, i)
+		f.limiter.Guard(common.RateLimitBet, f.handleHalveBet)(s, i)
 	}
 }
 
 // handleModalSubmit handles bet amount modal submissions
 func (f *Feature) handleModalSubmit(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	if i.ModalSubmitData().CustomID == "bet_amount_modal" {
-		f.handleBetModal(s, i)
+		f.limiter.Guard(common.RateLimitBet, f.handleBetModal)(s, i)
 	}
 }
 
@@ -179,7 +176,7 @@ func (f *Feature) handleOddsSelection(s *discordgo.Session, i *discordgo.Interac
 	channelID := i.ChannelID
 
 	// Create guild-scoped unit of work
-	uow, err := f.createUnitOfWork(ctx, i)
+	uow, guildID, err := f.createUnitOfWork(ctx, i)
 	if err != nil {
 		log.Errorf("Error creating unit of work: %v", err)
 		common.RespondWithError(s, i, "Unable to process request. Please try again.")
@@ -188,14 +185,10 @@ func (f *Feature) handleOddsSelection(s *discordgo.Session, i *discordgo.Interac
 	defer uow.Rollback()
 
 	// Instantiate services with repositories from UnitOfWork
-	userService := services.NewUserService(
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	userService := uow.Services().UserService()
 
 	// Get current balance
-	user, err := userService.GetOrCreateUser(ctx, discordID, i.Member.User.Username)
+	user, err := userService.GetOrCreateUser(ctx, guildID, discordID, i.Member.User.Username)
 	if err != nil {
 		log.Errorf("Error getting user balance: %v", err)
 		common.RespondWithError(s, i, "Unable to fetch current balance. Please try again.")
@@ -251,16 +244,16 @@ func (f *Feature) handleBetModal(s *discordgo.Session, i *discordgo.InteractionC
 	}
 
 	// Extract bet amount from modal
-	var betAmount int64
+	var amount entities.Amount
 	data := i.ModalSubmitData()
 	for _, comp := range data.Components {
 		if row, ok := comp.(*discordgo.ActionsRow); ok {
 			for _, innerComp := range row.Components {
 				if textInput, ok := innerComp.(*discordgo.TextInput); ok {
 					if textInput.CustomID == "bet_amount_input" {
-						betAmount, err = strconv.ParseInt(textInput.Value, 10, 64)
+						amount, err = entities.ParseAmount(textInput.Value)
 						if err != nil {
-							common.RespondWithError(s, i, "Invalid bet amount. Please enter a number.")
+							common.RespondWithError(s, i, fmt.Sprintf("Invalid bet amount: %v.", err))
 							return
 						}
 					}
@@ -269,10 +262,13 @@ func (f *Feature) handleBetModal(s *discordgo.Session, i *discordgo.InteractionC
 		}
 	}
 
-	// Validate bet amount
-	if err := validateBetAmount(betAmount, session.CurrentBalance); err != nil {
-		common.RespondWithError(s, i, err.Error())
-		return
+	// Fixed amounts are checked against the session balance up front; relative amounts are
+	// resolved against the live balance when the bet is placed
+	if !amount.IsRelative() {
+		if err := validateBetAmount(amount.Value, session.CurrentBalance); err != nil {
+			common.RespondWithError(s, i, err.Error())
+			return
+		}
 	}
 
 	// Acknowledge the modal with a deferred update to the original message
@@ -285,7 +281,11 @@ func (f *Feature) handleBetModal(s *discordgo.Session, i *discordgo.InteractionC
 	}
 
 	// Process bet and update the original message
-	if err := f.processBetAndUpdateMessage(ctx, s, i, session, betAmount); err != nil {
+	if err := f.processBetAndUpdateMessage(ctx, s, i, session, amount); err != nil {
+		if isBetRejection(err) {
+			common.UpdateMessageWithError(s, i, "Unable to place bet: "+err.Error())
+			return
+		}
 		common.UpdateMessageWithError(s, i, "Unable to place bet. Please try again.")
 	}
 }
@@ -304,7 +304,7 @@ func (f *Feature) handleNewBet(s *discordgo.Session, i *discordgo.InteractionCre
 	channelID := i.ChannelID
 
 	// Create guild-scoped unit of work to get balance
-	uow, err := f.createUnitOfWork(ctx, i)
+	uow, guildID, err := f.createUnitOfWork(ctx, i)
 	if err != nil {
 		log.Errorf("Error creating unit of work: %v", err)
 		common.RespondWithError(s, i, "Unable to process request. Please try again.")
@@ -313,13 +313,9 @@ func (f *Feature) handleNewBet(s *discordgo.Session, i *discordgo.InteractionCre
 	defer uow.Rollback()
 
 	// Get user balance
-	userService := services.NewUserService(
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	userService := uow.Services().UserService()
 
-	user, err := userService.GetOrCreateUser(ctx, discordID, i.Member.User.Username)
+	user, err := userService.GetOrCreateUser(ctx, guildID, discordID, i.Member.User.Username)
 	if err != nil {
 		log.Errorf("Error getting user %d: %v", discordID, err)
 		common.RespondWithError(s, i, "Unable to fetch balance. Please try again.")
@@ -387,7 +383,7 @@ func (f *Feature) handleRepeatBet(s *discordgo.Session, i *discordgo.Interaction
 	channelID := i.ChannelID
 
 	// Create guild-scoped unit of work to get balance (needed for adoption case)
-	uow, err := f.createUnitOfWork(ctx, i)
+	uow, guildID, err := f.createUnitOfWork(ctx, i)
 	if err != nil {
 		log.Errorf("Error creating unit of work: %v", err)
 		common.RespondWithError(s, i, "Unable to process request. Please try again.")
@@ -395,13 +391,9 @@ func (f *Feature) handleRepeatBet(s *discordgo.Session, i *discordgo.Interaction
 	}
 	defer uow.Rollback()
 
-	userService := services.NewUserService(
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	userService := uow.Services().UserService()
 
-	user, err := userService.GetOrCreateUser(ctx, discordID, i.Member.User.Username)
+	user, err := userService.GetOrCreateUser(ctx, guildID, discordID, i.Member.User.Username)
 	if err != nil {
 		log.Errorf("Error getting user %d: %v", discordID, err)
 		common.RespondWithError(s, i, "Unable to fetch balance. Please try again.")
@@ -431,12 +423,11 @@ func (f *Feature) handleRepeatBet(s *discordgo.Session, i *discordgo.Interaction
 	if err := f.processRepeatBet(ctx, s, i, multiplier); err != nil {
 		// Handle specific error types with user-friendly messages
 		switch {
-		case fmt.Sprintf("%v", err)[:4] == "bet ":
-			// Bet validation error - show as ephemeral
-			common.UpdateMessageWithError(s, i, err.Error()[19:]) // Remove "bet validation failed: " prefix
-		case fmt.Sprintf("%v", err)[:5] == "daily":
-			// Daily limit error - show as ephemeral
-			common.UpdateMessageWithError(s, i, err.Error()[23:]) // Remove "daily limit exceeded: " prefix
+		case isBetRejection(err):
+			common.UpdateMessageWithError(s, i, "Unable to place bet: "+err.Error())
+		case errors.Is(err, entities.ErrNonPositiveAmount) || errors.Is(err, entities.ErrInsufficientBalance):
+			message, _ := common.UserErrorMessage(err)
+			common.UpdateMessageWithError(s, i, message)
 		default:
 			log.Errorf("Error processing repeat bet: %v", err)
 			common.UpdateMessageWithError(s, i, "Unable to place bet. Please try again.")
@@ -23,7 +23,7 @@ func buildBetAmountModal(odds float64, balance int64) *discordgo.InteractionResp
 						CustomID:    "bet_amount_input",
 						Label:       label,
 						Style:       discordgo.TextInputShort,
-						Placeholder: "Enter amount to bet",
+						Placeholder: "Enter bits, all, half or 25%",
 						Required:    true,
 						MaxLength:   20,
 					},
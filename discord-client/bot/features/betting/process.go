@@ -2,20 +2,22 @@ package betting
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
 	"gambler/discord-client/bot/common"
-	"gambler/discord-client/domain/services"
+	"gambler/discord-client/domain/entities"
 
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
 )
 
-// processBetAndUpdateMessage processes a bet and updates the message with results
-func (f *Feature) processBetAndUpdateMessage(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, session *BetSession, betAmount int64) error {
+// processBetAndUpdateMessage processes a bet and updates the message with results. Relative amounts like
+// all or half are resolved by the gambling service against the balance read in the bet's transaction.
+func (f *Feature) processBetAndUpdateMessage(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, session *BetSession, amount entities.Amount) error {
 	// Create guild-scoped unit of work for bet placement
-	uow, err := f.createUnitOfWork(ctx, i)
+	uow, _, err := f.createUnitOfWork(ctx, i)
 	if err != nil {
 		log.Errorf("Error creating unit of work for bet: %v", err)
 		return fmt.Errorf("unable to create transaction: %w", err)
@@ -23,15 +25,18 @@ func (f *Feature) processBetAndUpdateMessage(ctx context.Context, s *discordgo.S
 	defer uow.Rollback()
 
 	// Instantiate gambling service with repositories from UnitOfWork
-	gamblingService := services.NewGamblingService(
-		uow.UserRepository(),
-		uow.BetRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	gamblingService := uow.Services().GamblingService()
+
+	guildID, err := common.ParseGuildID(i.GuildID)
+	if err != nil {
+		return fmt.Errorf("invalid guild ID: %w", err)
+	}
 
 	// Place the bet (swap the order - PlaceBet expects amount first, then odds)
-	result, err := gamblingService.PlaceBet(ctx, session.UserID, session.LastOdds, betAmount)
+	result, err := gamblingService.PlaceBetWithAmount(ctx, session.UserID, guildID, session.LastOdds, amount)
+	if isBetRejection(err) {
+		return err
+	}
 	if err != nil {
 		log.Errorf("Error placing bet for user %d: %v", session.UserID, err)
 		return fmt.Errorf("unable to place bet: %w", err)
@@ -54,6 +59,7 @@ func (f *Feature) processBetAndUpdateMessage(ctx context.Context, s *discordgo.S
 	}
 
 	// Update session with new available balance and bet info
+	betAmount := result.BetAmount
 	updateSessionBalance(session.UserID, updatedUser.AvailableBalance, true)
 	updateBetSession(session.UserID, session.LastOdds, betAmount)
 
@@ -121,11 +127,11 @@ func (f *Feature) processRepeatBet(ctx context.Context, s *discordgo.Session, i
 
 	// Validate new amount
 	if err := validateBetAmount(newAmount, session.CurrentBalance); err != nil {
-		return fmt.Errorf("bet validation failed: %w", err)
+		return err
 	}
 
 	// Process bet and update existing message
-	return f.processBetAndUpdateMessage(ctx, s, i, session, newAmount)
+	return f.processBetAndUpdateMessage(ctx, s, i, session, entities.FixedAmount(newAmount))
 }
 
 // showOddsSelectionUpdate updates an already-acknowledged interaction with odds selection
@@ -166,12 +172,19 @@ func (f *Feature) showOddsSelectionUpdate(ctx context.Context, s *discordgo.Sess
 // validateBetAmount validates the bet amount against balance and limits
 func validateBetAmount(amount int64, balance int64) error {
 	if amount <= 0 {
-		return fmt.Errorf("bet amount must be positive")
+		return fmt.Errorf("bet %w", entities.ErrNonPositiveAmount)
 	}
 
 	if amount > balance {
-		return fmt.Errorf("insufficient balance. You have %s bits", common.FormatBalance(balance))
+		return fmt.Errorf("%w. You have %s bits", entities.ErrInsufficientBalance, common.FormatBalance(balance))
 	}
 
 	return nil
 }
+
+// isBetRejection reports whether err is a bet rejection the user should see as-is
+func isBetRejection(err error) bool {
+	return errors.Is(err, entities.ErrBettingCurfewActive) || errors.Is(err, entities.ErrGamblingBreakActive) ||
+		errors.Is(err, entities.ErrBalanceFrozen) || errors.Is(err, entities.ErrBelowBalanceFloor) ||
+		errors.Is(err, entities.ErrAmountNotAffordable) || errors.Is(err, entities.ErrBelowMinBet)
+}
@@ -130,7 +130,7 @@ func (f *Feature) PostDailyAwardsForGuild(ctx context.Context, guildID int64) er
 	defer uow.Rollback()
 
 	// Get guild settings to check for primary channel
-	guildSettingsService := services.NewGuildSettingsService(uow.GuildSettingsRepository())
+	guildSettingsService := uow.Services().GuildSettingsService()
 	settings, err := guildSettingsService.GetOrCreateSettings(ctx, guildID)
 	if err != nil {
 		return fmt.Errorf("failed to get guild settings: %w", err)
@@ -142,13 +142,7 @@ func (f *Feature) PostDailyAwardsForGuild(ctx context.Context, guildID int64) er
 	}
 
 	// Create daily awards service
-	dailyAwardsService := services.NewDailyAwardsService(
-		uow.WordleCompletionRepo(),
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.BetRepository(),
-		uow.GroupWagerRepository(),
-	)
+	dailyAwardsService := uow.Services().DailyAwardsService()
 
 	// Get daily awards summary
 	summary, err := dailyAwardsService.GetDailyAwardsSummary(ctx, guildID)
@@ -0,0 +1,119 @@
+package digest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// BuildWeeklyDigestEmbed creates the weekly economy digest embed
+func BuildWeeklyDigestEmbed(digest *entities.WeeklyDigest) *discordgo.MessageEmbed {
+	fields := []*discordgo.MessageEmbedField{
+		{
+			Name:   "🎲 Bits Wagered",
+			Value:  common.FormatBalance(digest.TotalWagered),
+			Inline: true,
+		},
+		{
+			Name:   "📋 Wagers Resolved",
+			Value:  fmt.Sprintf("%d (%d bets)", digest.WagersResolved, digest.BetsSettled),
+			Inline: true,
+		},
+	}
+
+	if len(digest.BiggestWins) > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "🏆 Biggest Wins",
+			Value: formatMoves(digest.BiggestWins, "+"),
+		})
+	}
+
+	if len(digest.BiggestLosses) > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "💸 Biggest Losses",
+			Value: formatMoves(digest.BiggestLosses, "-"),
+		})
+	}
+
+	if len(digest.LotteryDraws) > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "🎟️ Lottery",
+			Value: formatLotteryDraws(digest),
+		})
+	}
+
+	if digest.NewHighRoller != nil {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name: "👑 New High Roller",
+			Value: fmt.Sprintf("%s for %s bits",
+				common.GetUserMention(digest.NewHighRoller.DiscordID),
+				common.FormatBalance(digest.NewHighRoller.PurchasePrice)),
+		})
+	}
+
+	if len(digest.TopPredictors) > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "🔮 Top Predictors (all time)",
+			Value: formatPredictors(digest.TopPredictors),
+		})
+	}
+
+	return &discordgo.MessageEmbed{
+		Title: "📰 Weekly Digest",
+		Description: fmt.Sprintf("Economy recap for %s – %s",
+			digest.From.Format("Jan 2"),
+			digest.To.Add(-time.Second).Format("Jan 2")),
+		Color:  common.ColorInfo,
+		Fields: fields,
+	}
+}
+
+// formatMoves lists wins or losses one per line with the user, amount and what they came from
+func formatMoves(moves []entities.DigestBalanceMove, sign string) string {
+	lines := make([]string, len(moves))
+	for i, move := range moves {
+		lines[i] = fmt.Sprintf("%d. %s **%s%s** (%s)",
+			i+1,
+			common.GetUserMention(move.DiscordID),
+			sign,
+			common.FormatBalance(move.Amount),
+			move.TransactionType.DisplayName())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatLotteryDraws summarises the week's lottery draws
+func formatLotteryDraws(digest *entities.WeeklyDigest) string {
+	lines := make([]string, 0, len(digest.LotteryDraws)+1)
+	for _, draw := range digest.LotteryDraws {
+		outcome := "no winner, pot rolled over"
+		if draw.WinnerCount > 0 {
+			outcome = fmt.Sprintf("%d winner(s) shared %s bits", draw.WinnerCount, common.FormatBalance(draw.TotalPaidOut))
+		}
+		lines = append(lines, fmt.Sprintf("Draw #%d: number **%d**, %d tickets, %s",
+			draw.DrawID, draw.WinningNumber, draw.TicketsSold, outcome))
+	}
+	if len(digest.LotteryDraws) > 1 {
+		lines = append(lines, fmt.Sprintf("Total paid out: **%s** bits", common.FormatBalance(digest.LotteryPaidOut())))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatPredictors lists the top predictors with their accuracy
+func formatPredictors(predictors []*entities.WagerPredictionStats) string {
+	lines := make([]string, len(predictors))
+	for i, p := range predictors {
+		lines[i] = fmt.Sprintf("%d. %s %.0f%% (%d/%d)",
+			i+1,
+			common.GetUserMention(p.DiscordID),
+			p.AccuracyPercentage,
+			p.CorrectPredictions,
+			p.TotalPredictions)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,32 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Feature posts the weekly economy digest
+type Feature struct {
+	session *discordgo.Session
+}
+
+// NewFeature creates a new digest feature instance
+func NewFeature(session *discordgo.Session) *Feature {
+	return &Feature{
+		session: session,
+	}
+}
+
+// PostWeeklyDigest posts the digest embed to the given channel
+func (f *Feature) PostWeeklyDigest(ctx context.Context, channelID int64, digest *entities.WeeklyDigest) error {
+	embed := BuildWeeklyDigestEmbed(digest)
+	if _, err := f.session.ChannelMessageSendEmbed(common.FormatDiscordID(channelID), embed); err != nil {
+		return fmt.Errorf("failed to send weekly digest: %w", err)
+	}
+	return nil
+}
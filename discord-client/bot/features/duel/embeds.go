@@ -0,0 +1,109 @@
+package duel
+
+import (
+	"fmt"
+	"strings"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	acceptButtonPrefix  = "duel_accept_"
+	declineButtonPrefix = "duel_decline_"
+)
+
+// buildChallengeComponents creates the accept/decline buttons for a pending duel
+func buildChallengeComponents(duelID int64) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "🪙 Accept",
+					Style:    discordgo.SuccessButton,
+					CustomID: fmt.Sprintf("%s%d", acceptButtonPrefix, duelID),
+				},
+				discordgo.Button{
+					Label:    "❌ Decline",
+					Style:    discordgo.DangerButton,
+					CustomID: fmt.Sprintf("%s%d", declineButtonPrefix, duelID),
+				},
+			},
+		},
+	}
+}
+
+// buildChallengeEmbed creates the embed announcing a new duel
+func buildChallengeEmbed(duel *entities.Duel) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title: "🪙 Duel Challenge",
+		Description: fmt.Sprintf("%s challenges %s to a coin flip duel!",
+			common.GetUserMention(duel.ChallengerDiscordID), common.GetUserMention(duel.TargetDiscordID)),
+		Color: common.ColorWarning,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "💰 Stake", Value: common.FormatBalance(duel.Amount), Inline: true},
+			{Name: "🎯 Format", Value: formatSeries(duel.BestOf), Inline: true},
+			{Name: "⏳ Expires", Value: common.FormatDiscordTimestamp(duel.ExpiresAt, "R"), Inline: true},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Duel #%d • Only the challenged user can accept • The challenger can withdraw with Decline", duel.ID),
+		},
+	}
+}
+
+// buildResultEmbed creates the embed shown once a duel is flipped, declined or cancelled
+func buildResultEmbed(duel *entities.Duel) *discordgo.MessageEmbed {
+	challenger := common.GetUserMention(duel.ChallengerDiscordID)
+	target := common.GetUserMention(duel.TargetDiscordID)
+
+	embed := &discordgo.MessageEmbed{
+		Footer: &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("Duel #%d", duel.ID)},
+	}
+
+	switch duel.Status {
+	case entities.DuelStatusCompleted:
+		challengerWins, targetWins := duel.Score()
+		embed.Title = "🪙 Duel Settled"
+		embed.Color = common.ColorSuccess
+		embed.Description = fmt.Sprintf("%s wins **%s bits** from %s!",
+			common.GetUserMention(*duel.WinnerDiscordID), common.FormatBalance(duel.Amount), common.GetUserMention(duel.LoserDiscordID()))
+		embed.Fields = []*discordgo.MessageEmbedField{
+			{Name: "🎯 Format", Value: formatSeries(duel.BestOf), Inline: true},
+			{Name: "📊 Score", Value: fmt.Sprintf("%s %d - %d %s", challenger, challengerWins, targetWins, target), Inline: true},
+			{Name: "🪙 Flips", Value: formatFlips(duel), Inline: false},
+		}
+	case entities.DuelStatusDeclined:
+		embed.Title = "❌ Duel Declined"
+		embed.Color = common.ColorDanger
+		embed.Description = fmt.Sprintf("%s declined the duel from %s.", target, challenger)
+	case entities.DuelStatusCancelled:
+		embed.Title = "❌ Duel Withdrawn"
+		embed.Color = common.ColorDanger
+		embed.Description = fmt.Sprintf("%s withdrew their challenge to %s.", challenger, target)
+	}
+
+	return embed
+}
+
+// formatSeries describes the series length
+func formatSeries(bestOf int) string {
+	if bestOf == 1 {
+		return "Single flip"
+	}
+	return fmt.Sprintf("Best of %d", bestOf)
+}
+
+// formatFlips lists each round's winner. The challenger calls heads.
+func formatFlips(duel *entities.Duel) string {
+	rounds := make([]string, len(duel.Flips))
+	for idx, challengerWon := range duel.Flips {
+		if challengerWon {
+			rounds[idx] = fmt.Sprintf("Round %d: Heads, %s", idx+1, common.GetUserMention(duel.ChallengerDiscordID))
+		} else {
+			rounds[idx] = fmt.Sprintf("Round %d: Tails, %s", idx+1, common.GetUserMention(duel.TargetDiscordID))
+		}
+	}
+	return strings.Join(rounds, "\n")
+}
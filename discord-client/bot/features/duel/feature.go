@@ -0,0 +1,41 @@
+package duel
+
+import (
+	"strings"
+
+	"gambler/discord-client/application"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Feature handles the /duel command and its accept/decline buttons
+type Feature struct {
+	uowFactory application.UnitOfWorkFactory
+}
+
+// New creates a new duel feature
+func New(uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleCommand handles the /duel command
+func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	f.handleChallenge(s, i)
+}
+
+// HandleInteraction handles duel button presses
+func (f *Feature) HandleInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if i.Type != discordgo.InteractionMessageComponent {
+		return
+	}
+
+	customID := i.MessageComponentData().CustomID
+	switch {
+	case strings.HasPrefix(customID, acceptButtonPrefix):
+		f.handleResponse(s, i, strings.TrimPrefix(customID, acceptButtonPrefix), true)
+	case strings.HasPrefix(customID, declineButtonPrefix):
+		f.handleResponse(s, i, strings.TrimPrefix(customID, declineButtonPrefix), false)
+	}
+}
@@ -0,0 +1,167 @@
+package duel
+
+import (
+	"context"
+	"strconv"
+
+	"gambler/discord-client/bot/common"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// handleChallenge handles /duel @user <amount> [best-of-3]
+func (f *Feature) handleChallenge(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	var targetUser *discordgo.User
+	var amount int64
+	bestOf := 1
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch opt.Name {
+		case "user":
+			targetUser = opt.UserValue(s)
+		case "amount":
+			amount = opt.IntValue()
+		case "best-of-3":
+			if opt.BoolValue() {
+				bestOf = 3
+			}
+		}
+	}
+
+	if targetUser == nil {
+		common.RespondWithError(s, i, "Please specify a user to duel")
+		return
+	}
+	if targetUser.Bot {
+		common.RespondWithError(s, i, "Bots don't duel")
+		return
+	}
+
+	challengerID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid user ID")
+		return
+	}
+	targetID, err := strconv.ParseInt(targetUser.ID, 10, 64)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid target user ID")
+		return
+	}
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	ctx := context.Background()
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+	defer uow.Rollback()
+
+	userService := uow.Services().UserService()
+	if _, err := userService.GetOrCreateUser(ctx, guildID, challengerID, i.Member.User.Username); err != nil {
+		common.RespondWithError(s, i, common.DescribeError("Failed to create duel", err))
+		return
+	}
+	if _, err := userService.GetOrCreateUser(ctx, guildID, targetID, targetUser.Username); err != nil {
+		common.RespondWithError(s, i, common.DescribeError("Failed to create duel", err))
+		return
+	}
+
+	duelService := uow.Services().DuelService()
+	duel, err := duelService.Challenge(ctx, challengerID, targetID, guildID, amount, bestOf)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content:    common.GetUserMention(targetID),
+			Embeds:     []*discordgo.MessageEmbed{buildChallengeEmbed(duel)},
+			Components: buildChallengeComponents(duel.ID),
+		},
+	})
+	if err != nil {
+		log.Errorf("Error responding to duel command: %v", err)
+	}
+}
+
+// handleResponse handles the accept and decline buttons on a duel challenge
+func (f *Feature) handleResponse(s *discordgo.Session, i *discordgo.InteractionCreate, rawDuelID string, accept bool) {
+	duelID, err := strconv.ParseInt(rawDuelID, 10, 64)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid duel ID")
+		return
+	}
+
+	userID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid user ID")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	// Defer while processing
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	})
+	if err != nil {
+		log.Errorf("Error deferring interaction: %v", err)
+		return
+	}
+
+	ctx := context.Background()
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.FollowUpWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+	defer uow.Rollback()
+
+	duelService := uow.Services().DuelService()
+
+	respond := duelService.Decline
+	if accept {
+		respond = duelService.Accept
+	}
+	duel, err := respond(ctx, duelID, userID)
+	if err != nil {
+		common.FollowUpWithError(s, i, err.Error())
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.FollowUpWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	components := common.DisableComponents(i.Message.Components)
+	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &[]*discordgo.MessageEmbed{buildResultEmbed(duel)},
+		Components: &components,
+	})
+	if err != nil {
+		log.Errorf("Error editing duel message: %v", err)
+	}
+}
@@ -0,0 +1,9 @@
+package export
+
+// Feature handles the /export admin command
+type Feature struct{}
+
+// New creates a new export feature
+func New() *Feature {
+	return &Feature{}
+}
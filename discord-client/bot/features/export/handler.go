@@ -0,0 +1,123 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxAttachmentBytes is Discord's upload limit for servers without boosts
+const maxAttachmentBytes = 8 << 20
+
+const exportDateLayout = "2006-01-02"
+
+// HandleCommand streams the requested dataset to a temporary file and uploads it. The pipeline
+// defers the response and provides the guild's unit of work.
+func (f *Feature) HandleCommand(c *common.CommandContext) error {
+	s, i := c.Session, c.Interaction
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		return common.NewUserError("You need administrator permissions to use this command", "export requested by non-admin")
+	}
+
+	dataset := entities.ExportDataset("")
+	format := entities.ExportFormatCSV
+	var fromValue, toValue string
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch opt.Name {
+		case "dataset":
+			dataset = entities.ExportDataset(opt.StringValue())
+		case "format":
+			format = entities.ExportFormat(opt.StringValue())
+		case "from":
+			fromValue = opt.StringValue()
+		case "to":
+			toValue = opt.StringValue()
+		}
+	}
+
+	from, to, err := parseExportRange(fromValue, toValue, time.Now().UTC())
+	if err != nil {
+		return common.NewUserError(err.Error(), "invalid export range")
+	}
+	if err := entities.ValidateExportRange(from, to); err != nil {
+		return common.NewUserError(err.Error(), "invalid export range")
+	}
+
+	file, err := os.CreateTemp("", "gamba-export-*")
+	if err != nil {
+		return fmt.Errorf("failed to create export file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	rows, err := c.UoW.Services().ExportService().Export(c.Ctx, c.GuildID, dataset, format, from, to, file)
+	if err != nil {
+		return fmt.Errorf("failed to export %s for guild %d: %w", dataset, c.GuildID, err)
+	}
+
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return fmt.Errorf("failed to size export file: %w", err)
+	}
+	if size > maxAttachmentBytes {
+		c.RespondWithError(fmt.Sprintf("The export is %s MB, over Discord's %d MB upload limit. Try a shorter date range.",
+			strconv.FormatFloat(float64(size)/(1<<20), 'f', 1, 64), maxAttachmentBytes>>20))
+		return nil
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to rewind export file: %w", err)
+	}
+
+	// The range end is exclusive, so show the last included day
+	lastDay := to.AddDate(0, 0, -1)
+	_, err = s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Content: fmt.Sprintf("📦 Exported **%d** %s rows from %s to %s.",
+			rows, dataset, from.Format(exportDateLayout), lastDay.Format(exportDateLayout)),
+		Flags: discordgo.MessageFlagsEphemeral,
+		Files: []*discordgo.File{
+			{
+				Name:        format.FileName(dataset, from, lastDay),
+				ContentType: contentType(format),
+				Reader:      file,
+			},
+		},
+	})
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to upload export")
+	}
+	return nil
+}
+
+// parseExportRange converts inclusive YYYY-MM-DD dates into a half-open UTC range.
+// The end date defaults to today.
+func parseExportRange(fromValue, toValue string, now time.Time) (time.Time, time.Time, error) {
+	from, err := time.Parse(exportDateLayout, fromValue)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start date %q, use YYYY-MM-DD", fromValue)
+	}
+
+	lastDay := now.Truncate(24 * time.Hour)
+	if toValue != "" {
+		lastDay, err = time.Parse(exportDateLayout, toValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid end date %q, use YYYY-MM-DD", toValue)
+		}
+	}
+
+	return from, lastDay.AddDate(0, 0, 1), nil
+}
+
+// contentType returns the MIME type for an export format
+func contentType(format entities.ExportFormat) string {
+	if format == entities.ExportFormatJSON {
+		return "application/json"
+	}
+	return "text/csv"
+}
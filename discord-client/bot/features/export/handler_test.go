@@ -0,0 +1,34 @@
+package export
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExportRange(t *testing.T) {
+	now := time.Date(2024, 3, 10, 18, 45, 0, 0, time.UTC)
+
+	t.Run("end date is inclusive", func(t *testing.T) {
+		from, to, err := parseExportRange("2024-01-01", "2024-01-31", now)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), from)
+		assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), to)
+	})
+
+	t.Run("end date defaults to today", func(t *testing.T) {
+		_, to, err := parseExportRange("2024-03-01", "", now)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), to)
+	})
+
+	t.Run("invalid dates", func(t *testing.T) {
+		_, _, err := parseExportRange("01/02/2024", "", now)
+		assert.ErrorContains(t, err, "invalid start date")
+
+		_, _, err = parseExportRange("2024-01-01", "tomorrow", now)
+		assert.ErrorContains(t, err, "invalid end date")
+	})
+}
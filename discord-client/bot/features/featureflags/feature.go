@@ -0,0 +1,44 @@
+package featureflags
+
+import (
+	"gambler/discord-client/application"
+	"gambler/discord-client/bot/common"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Feature handles the /features command
+type Feature struct {
+	uowFactory application.UnitOfWorkFactory
+}
+
+// New creates a new feature flags feature
+func New(uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleCommand routes features subcommands to appropriate handlers
+func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "❌ You need administrator permissions to use this command")
+		return
+	}
+
+	switch options[0].Name {
+	case "list":
+		f.handleList(s, i)
+	case "enable":
+		f.handleToggle(s, i, options[0].Options, true)
+	case "disable":
+		f.handleToggle(s, i, options[0].Options, false)
+	default:
+		common.RespondWithError(s, i, "Unknown subcommand.")
+	}
+}
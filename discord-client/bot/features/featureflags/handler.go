@@ -0,0 +1,127 @@
+package featureflags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// handleList shows whether each feature is turned on in the guild
+func (f *Feature) handleList(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	f.withFeatureFlagService(s, i, func(ctx context.Context, discordID, guildID int64, featureFlagService interfaces.FeatureFlagService) (string, error) {
+		flags, err := featureFlagService.GetFlags(ctx)
+		if err != nil {
+			return "", err
+		}
+		return formatFeatureList(flags), nil
+	})
+}
+
+// handleToggle turns a feature on or off in the guild
+func (f *Feature) handleToggle(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption, enabled bool) {
+	definition, err := parseFeature(options)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	f.withFeatureFlagService(s, i, func(ctx context.Context, discordID, guildID int64, featureFlagService interfaces.FeatureFlagService) (string, error) {
+		changed, err := featureFlagService.SetEnabled(ctx, guildID, definition.Feature, enabled, discordID)
+		if err != nil {
+			return "", err
+		}
+
+		state := "disabled"
+		if enabled {
+			state = "enabled"
+		}
+		if !changed {
+			return fmt.Sprintf("%s is already %s in this server.", definition.Name, state), nil
+		}
+		return fmt.Sprintf("✅ %s is now %s in this server.", definition.Name, state), nil
+	})
+}
+
+// withFeatureFlagService runs fn in a guild-scoped transaction and responds with its message
+func (f *Feature) withFeatureFlagService(s *discordgo.Session, i *discordgo.InteractionCreate, fn func(ctx context.Context, discordID, guildID int64, featureFlagService interfaces.FeatureFlagService) (string, error)) {
+	ctx := context.Background()
+
+	discordID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing Discord ID %s: %v", i.Member.User.ID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+	defer uow.Rollback()
+
+	message, err := fn(ctx, discordID, guildID, uow.Services().FeatureFlagService())
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	if err := common.RespondWithSuccess(s, i, message, true); err != nil {
+		log.Errorf("Error responding to features command: %v", err)
+	}
+}
+
+// parseFeature reads the required feature option
+func parseFeature(options []*discordgo.ApplicationCommandInteractionDataOption) (entities.FeatureDefinition, error) {
+	for _, opt := range options {
+		if opt.Name != "feature" {
+			continue
+		}
+		feature, err := entities.ParseFeature(opt.StringValue())
+		if err != nil {
+			return entities.FeatureDefinition{}, err
+		}
+		definition, _ := feature.Definition()
+		return definition, nil
+	}
+	return entities.FeatureDefinition{}, errors.New("choose a feature")
+}
+
+// formatFeatureList lists every feature with its state in the guild
+func formatFeatureList(flags entities.FeatureFlags) string {
+	var b strings.Builder
+	b.WriteString("**Server features**\n")
+
+	for _, definition := range entities.FeatureDefinitions {
+		status := "❌"
+		if flags.IsEnabled(definition.Feature) {
+			status = "✅"
+		}
+		b.WriteString(fmt.Sprintf("%s **%s** (`%s`) - %s\n", status, definition.Name, definition.Feature, definition.Description))
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
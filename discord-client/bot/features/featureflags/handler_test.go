@@ -0,0 +1,47 @@
+package featureflags
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFeature(t *testing.T) {
+	t.Parallel()
+
+	t.Run("known feature", func(t *testing.T) {
+		definition, err := parseFeature([]*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: "feature", Type: discordgo.ApplicationCommandOptionString, Value: "duels"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, entities.FeatureDuels, definition.Feature)
+		assert.Equal(t, "Duels", definition.Name)
+	})
+
+	t.Run("unknown feature", func(t *testing.T) {
+		_, err := parseFeature([]*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: "feature", Type: discordgo.ApplicationCommandOptionString, Value: "casino"},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("missing feature", func(t *testing.T) {
+		_, err := parseFeature(nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestFormatFeatureList(t *testing.T) {
+	t.Parallel()
+
+	message := formatFeatureList(entities.NewFeatureFlags([]*entities.GuildFeatureFlag{
+		{Feature: entities.FeatureLottery, Enabled: false},
+	}))
+
+	assert.Contains(t, message, "❌ **Lottery** (`lottery`)")
+	assert.Contains(t, message, "✅ **Duels** (`duels`)")
+}
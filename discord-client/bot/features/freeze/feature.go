@@ -0,0 +1,38 @@
+package freeze
+
+import (
+	"gambler/discord-client/application"
+	"gambler/discord-client/bot/common"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Feature handles the /freeze and /unfreeze moderation commands
+type Feature struct {
+	uowFactory application.UnitOfWorkFactory
+}
+
+// New creates a new freeze feature
+func New(uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleFreezeCommand freezes a user's balance
+func (f *Feature) HandleFreezeCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "❌ You need administrator permissions to use this command")
+		return
+	}
+	f.handleFreeze(s, i)
+}
+
+// HandleUnfreezeCommand lifts a user's balance freeze
+func (f *Feature) HandleUnfreezeCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "❌ You need administrator permissions to use this command")
+		return
+	}
+	f.handleUnfreeze(s, i)
+}
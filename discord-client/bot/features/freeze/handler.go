@@ -0,0 +1,148 @@
+package freeze
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// freezeOptions are the parsed /freeze options
+type freezeOptions struct {
+	targetID int64
+	reason   string
+	duration time.Duration
+}
+
+// handleFreeze blocks the target's balance-affecting actions
+func (f *Feature) handleFreeze(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	opts, err := parseFreezeOptions(i.ApplicationCommandData().Options)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	f.withFreezeService(s, i, func(ctx context.Context, moderatorID, guildID int64, freezeService interfaces.BalanceFreezeService) (string, error) {
+		freeze, err := freezeService.Freeze(ctx, guildID, opts.targetID, moderatorID, opts.reason, opts.duration)
+		if err != nil {
+			return "", err
+		}
+		return formatFrozen(freeze), nil
+	})
+}
+
+// handleUnfreeze lifts the target's active freeze
+func (f *Feature) handleUnfreeze(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	var targetID int64
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "user" {
+			targetID, _ = strconv.ParseInt(opt.UserValue(nil).ID, 10, 64)
+		}
+	}
+	if targetID == 0 {
+		common.RespondWithError(s, i, "Choose a user to unfreeze.")
+		return
+	}
+
+	f.withFreezeService(s, i, func(ctx context.Context, moderatorID, guildID int64, freezeService interfaces.BalanceFreezeService) (string, error) {
+		if _, err := freezeService.Unfreeze(ctx, targetID, moderatorID); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("✅ <@%d>'s balance is no longer frozen.", targetID), nil
+	})
+}
+
+// withFreezeService runs fn in a guild-scoped transaction and responds with its message
+func (f *Feature) withFreezeService(s *discordgo.Session, i *discordgo.InteractionCreate, fn func(ctx context.Context, moderatorID, guildID int64, freezeService interfaces.BalanceFreezeService) (string, error)) {
+	ctx := context.Background()
+
+	moderatorID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing Discord ID %s: %v", i.Member.User.ID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+	defer uow.Rollback()
+
+	message, err := fn(ctx, moderatorID, guildID, uow.Services().BalanceFreezeService())
+	if err != nil {
+		common.RespondWithError(s, i, common.DescribeError("Failed to update balance freeze", err))
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	if err := common.RespondWithSuccess(s, i, message, true); err != nil {
+		log.Errorf("Error responding to freeze command: %v", err)
+	}
+}
+
+// parseFreezeOptions reads the target user, reason and optional duration. No duration freezes until lifted.
+func parseFreezeOptions(options []*discordgo.ApplicationCommandInteractionDataOption) (freezeOptions, error) {
+	var opts freezeOptions
+	for _, opt := range options {
+		switch opt.Name {
+		case "user":
+			targetID, err := strconv.ParseInt(opt.UserValue(nil).ID, 10, 64)
+			if err != nil {
+				return opts, errors.New("invalid user")
+			}
+			opts.targetID = targetID
+		case "reason":
+			opts.reason = opt.StringValue()
+		case "duration":
+			minutes, err := common.ParseDurationMinutes(opt.StringValue())
+			if err != nil {
+				return opts, fmt.Errorf("invalid duration: %w", err)
+			}
+			if minutes <= 0 {
+				return opts, errors.New("duration must be positive; omit it to freeze until lifted")
+			}
+			opts.duration = time.Duration(minutes) * time.Minute
+		}
+	}
+	if opts.targetID == 0 {
+		return opts, errors.New("choose a user to freeze")
+	}
+	return opts, nil
+}
+
+// formatFrozen confirms a freeze to the moderator
+func formatFrozen(freeze *entities.BalanceFreeze) string {
+	message := fmt.Sprintf("🧊 <@%d>'s balance is frozen until a moderator lifts it with `/unfreeze`.", freeze.DiscordID)
+	if freeze.ExpiresAt != nil {
+		message = fmt.Sprintf("🧊 <@%d>'s balance is frozen until %s (%s).", freeze.DiscordID,
+			common.FormatDiscordTimestamp(*freeze.ExpiresAt, "F"),
+			common.FormatDiscordTimestamp(*freeze.ExpiresAt, "R"))
+	}
+	if freeze.Reason != "" {
+		message += fmt.Sprintf("\nReason: %s", freeze.Reason)
+	}
+	return message
+}
@@ -0,0 +1,62 @@
+package freeze
+
+import (
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFreezeOptions(t *testing.T) {
+	t.Parallel()
+
+	user := &discordgo.ApplicationCommandInteractionDataOption{Name: "user", Type: discordgo.ApplicationCommandOptionUser, Value: "111"}
+	reason := &discordgo.ApplicationCommandInteractionDataOption{Name: "reason", Type: discordgo.ApplicationCommandOptionString, Value: "alt account"}
+	duration := func(value string) *discordgo.ApplicationCommandInteractionDataOption {
+		return &discordgo.ApplicationCommandInteractionDataOption{Name: "duration", Type: discordgo.ApplicationCommandOptionString, Value: value}
+	}
+
+	t.Run("without a duration freezes until lifted", func(t *testing.T) {
+		opts, err := parseFreezeOptions([]*discordgo.ApplicationCommandInteractionDataOption{user, reason})
+		require.NoError(t, err)
+		assert.Equal(t, int64(111), opts.targetID)
+		assert.Equal(t, "alt account", opts.reason)
+		assert.Zero(t, opts.duration)
+	})
+
+	t.Run("parses the duration", func(t *testing.T) {
+		opts, err := parseFreezeOptions([]*discordgo.ApplicationCommandInteractionDataOption{user, duration("3d")})
+		require.NoError(t, err)
+		assert.Equal(t, 72*time.Hour, opts.duration)
+	})
+
+	t.Run("rejects bad durations", func(t *testing.T) {
+		_, err := parseFreezeOptions([]*discordgo.ApplicationCommandInteractionDataOption{user, duration("soon")})
+		assert.Error(t, err)
+
+		_, err = parseFreezeOptions([]*discordgo.ApplicationCommandInteractionDataOption{user, duration("0h")})
+		assert.Error(t, err)
+	})
+
+	t.Run("requires a user", func(t *testing.T) {
+		_, err := parseFreezeOptions(nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestFormatFrozen(t *testing.T) {
+	t.Parallel()
+
+	message := formatFrozen(&entities.BalanceFreeze{DiscordID: 111, Reason: "alt account"})
+	assert.Contains(t, message, "until a moderator lifts it")
+	assert.Contains(t, message, "Reason: alt account")
+
+	expiresAt := time.Date(2024, 6, 2, 9, 30, 0, 0, time.UTC)
+	message = formatFrozen(&entities.BalanceFreeze{DiscordID: 111, ExpiresAt: &expiresAt})
+	assert.Contains(t, message, "<t:1717320600:F>")
+	assert.NotContains(t, message, "Reason")
+}
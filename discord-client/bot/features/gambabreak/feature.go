@@ -0,0 +1,24 @@
+package gambabreak
+
+import (
+	"gambler/discord-client/application"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Feature handles the /gamba-break self-exclusion command
+type Feature struct {
+	uowFactory application.UnitOfWorkFactory
+}
+
+// New creates a new gamba break feature
+func New(uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleCommand handles the /gamba-break command
+func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	f.handleGambaBreak(s, i)
+}
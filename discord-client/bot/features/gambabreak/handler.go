@@ -0,0 +1,104 @@
+package gambabreak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gambler/discord-client/bot/common"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+func (f *Feature) handleGambaBreak(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+
+	var durationInput string
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "duration" {
+			durationInput = opt.StringValue()
+		}
+	}
+
+	duration, err := parseBreakDuration(durationInput)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid duration. Use a number followed by h, d or w (e.g. 12h, 3d, 2w).")
+		return
+	}
+
+	discordID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing Discord ID %s: %v", i.Member.User.ID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+	defer uow.Rollback()
+
+	userService := uow.Services().UserService()
+
+	if _, err := userService.GetOrCreateUser(ctx, guildID, discordID, i.Member.User.Username); err != nil {
+		common.RespondWithError(s, i, common.DescribeError("Failed to create user", err))
+		return
+	}
+
+	endsAt, err := userService.StartGamblingBreak(ctx, discordID, duration)
+	if err != nil {
+		log.Errorf("Error starting gambling break for user %d: %v", discordID, err)
+		common.RespondWithError(s, i, common.DescribeError("Failed to start break", err))
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	message := fmt.Sprintf("You're on a gambling break until %s (%s). Betting, lottery tickets and wager proposals are disabled until then.",
+		common.FormatDiscordTimestamp(endsAt, "F"),
+		common.FormatDiscordTimestamp(endsAt, "R"))
+	if err := common.RespondWithSuccess(s, i, message, true); err != nil {
+		log.Errorf("Error responding to gamba-break command: %v", err)
+	}
+}
+
+// parseBreakDuration parses durations like "12h", "3d" or "2w"
+func parseBreakDuration(input string) (time.Duration, error) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	if len(input) < 2 {
+		return 0, fmt.Errorf("invalid duration %q", input)
+	}
+
+	value, err := strconv.Atoi(input[:len(input)-1])
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid duration %q", input)
+	}
+
+	switch input[len(input)-1] {
+	case 'h':
+		return time.Duration(value) * time.Hour, nil
+	case 'd':
+		return time.Duration(value) * 24 * time.Hour, nil
+	case 'w':
+		return time.Duration(value) * 7 * 24 * time.Hour, nil
+	default:
+		return 0, fmt.Errorf("invalid duration unit in %q", input)
+	}
+}
@@ -0,0 +1,44 @@
+package gambabreak
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBreakDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{name: "hours", input: "12h", expected: 12 * time.Hour},
+		{name: "days", input: "3d", expected: 72 * time.Hour},
+		{name: "weeks", input: "2w", expected: 14 * 24 * time.Hour},
+		{name: "uppercase and whitespace", input: " 1D ", expected: 24 * time.Hour},
+		{name: "missing unit", input: "12", wantErr: true},
+		{name: "unknown unit", input: "5m", wantErr: true},
+		{name: "zero", input: "0d", wantErr: true},
+		{name: "negative", input: "-1d", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseBreakDuration(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package groupwagers
+
+import (
+	"sync"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+)
+
+// BetConfirmationTimeout is how long a bet preview waits for the user to confirm before the bet is dropped
+const BetConfirmationTimeout = 60 * time.Second
+
+// pendingBet is a bet the user has previewed but not yet confirmed
+type pendingBet struct {
+	groupWagerID int64
+	optionID     int64
+	userID       int64
+	amount       entities.Amount
+	timer        *time.Timer
+}
+
+// pendingBets tracks bet previews awaiting confirmation, keyed by the modal interaction that opened them.
+// Each entry is either taken by a confirm or cancel click, or expires on its own.
+type pendingBets struct {
+	mu   sync.Mutex
+	bets map[string]*pendingBet
+}
+
+func newPendingBets() *pendingBets {
+	return &pendingBets{bets: make(map[string]*pendingBet)}
+}
+
+// add holds bet under key until it is taken, calling expire if nobody takes it within timeout
+func (p *pendingBets) add(key string, bet *pendingBet, timeout time.Duration, expire func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	bet.timer = time.AfterFunc(timeout, func() {
+		if p.take(key) != nil {
+			expire()
+		}
+	})
+	p.bets[key] = bet
+}
+
+// take removes and returns the bet held under key, or nil if it already expired or was taken
+func (p *pendingBets) take(key string) *pendingBet {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	bet, ok := p.bets[key]
+	if !ok {
+		return nil
+	}
+	delete(p.bets, key)
+	bet.timer.Stop()
+	return bet
+}
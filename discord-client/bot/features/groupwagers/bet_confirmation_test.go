@@ -0,0 +1,45 @@
+package groupwagers
+
+import (
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingBets_TakeBeforeTimeout(t *testing.T) {
+	t.Parallel()
+
+	pending := newPendingBets()
+	expired := make(chan struct{}, 1)
+	pending.add("modal", &pendingBet{groupWagerID: 1, optionID: 2, amount: entities.FixedAmount(500)}, 50*time.Millisecond, func() { expired <- struct{}{} })
+
+	bet := pending.take("modal")
+	require.NotNil(t, bet)
+	assert.Equal(t, entities.FixedAmount(500), bet.amount)
+	assert.Nil(t, pending.take("modal"), "a bet can only be confirmed or cancelled once")
+
+	select {
+	case <-expired:
+		t.Fatal("a taken bet must not expire")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestPendingBets_ExpiresWhenNotTaken(t *testing.T) {
+	t.Parallel()
+
+	pending := newPendingBets()
+	expired := make(chan struct{}, 1)
+	pending.add("modal", &pendingBet{groupWagerID: 1, optionID: 2, amount: entities.FixedAmount(500)}, 10*time.Millisecond, func() { expired <- struct{}{} })
+
+	select {
+	case <-expired:
+	case <-time.After(time.Second):
+		t.Fatal("pending bet never expired")
+	}
+	assert.Nil(t, pending.take("modal"), "an expired bet can no longer be confirmed")
+}
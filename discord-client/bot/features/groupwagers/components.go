@@ -2,6 +2,9 @@ package groupwagers
 
 import (
 	"fmt"
+	"sort"
+
+	"gambler/discord-client/domain/entities"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -33,3 +36,147 @@ func CreateBetButtons(groupWagerID int64, options []string) []discordgo.MessageC
 
 	return rows
 }
+
+// createResolveButton creates the resolve button shown on wagers pending resolution
+func createResolveButton(groupWagerID int64) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Resolve",
+					Style:    discordgo.SuccessButton,
+					CustomID: fmt.Sprintf("group_wager_resolve_%d", groupWagerID),
+					Emoji: &discordgo.ComponentEmoji{
+						Name: "⚖️",
+					},
+				},
+			},
+		},
+	}
+}
+
+// createShareButton creates the button that posts a resolved wager's result as an image
+func createShareButton(groupWagerID int64) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Share result",
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("group_wager_share_%d", groupWagerID),
+					Emoji: &discordgo.ComponentEmoji{
+						Name: "📸",
+					},
+				},
+			},
+		},
+	}
+}
+
+// CreateResolveOptionSelect creates the menu a resolver picks the winning option from
+func CreateResolveOptionSelect(detail *entities.GroupWagerDetail) []discordgo.MessageComponent {
+	options := make([]*entities.GroupWagerOption, len(detail.Options))
+	copy(options, detail.Options)
+	sort.Slice(options, func(i, j int) bool {
+		return options[i].OptionOrder < options[j].OptionOrder
+	})
+
+	bettors := make(map[int64]int)
+	for _, participant := range detail.Participants {
+		bettors[participant.OptionID]++
+	}
+
+	menuOptions := make([]discordgo.SelectMenuOption, 0, len(options))
+	for _, option := range options {
+		menuOptions = append(menuOptions, discordgo.SelectMenuOption{
+			Label:       truncateButtonLabel(option.OptionText, 100),
+			Value:       fmt.Sprintf("%d", option.ID),
+			Description: fmt.Sprintf("%s bits from %d bettors", formatCompactAmount(option.TotalAmount), bettors[option.ID]),
+			Emoji: &discordgo.ComponentEmoji{
+				Name: getNumberEmoji(option.OptionOrder + 1),
+			},
+		})
+	}
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    fmt.Sprintf("group_wager_resolve_select_%d", detail.Wager.ID),
+					Placeholder: "Choose the winning option",
+					Options:     menuOptions,
+				},
+			},
+		},
+	}
+}
+
+// CreateResolveConfirmButtons creates the confirm and cancel buttons for a chosen winning option
+func CreateResolveConfirmButtons(groupWagerID, optionID int64) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Confirm",
+					Style:    discordgo.SuccessButton,
+					CustomID: fmt.Sprintf("group_wager_resolve_confirm_%d_%d", groupWagerID, optionID),
+				},
+				discordgo.Button{
+					Label:    "Cancel",
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("group_wager_resolve_cancel_%d", groupWagerID),
+				},
+			},
+		},
+	}
+}
+
+// CreateBetConfirmButtons creates the confirm and cancel buttons for a previewed bet
+func CreateBetConfirmButtons(key string) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Confirm Bet",
+					Style:    discordgo.SuccessButton,
+					CustomID: "group_wager_bet_confirm_" + key,
+				},
+				discordgo.Button{
+					Label:    "Cancel",
+					Style:    discordgo.SecondaryButton,
+					CustomID: "group_wager_bet_cancel_" + key,
+				},
+			},
+		},
+	}
+}
+
+// CreateAccessSelects creates the menus a creator restricts betting with: one role and up to
+// entities.MaxGroupWagerInvitees invited users. Clearing both menus opens the wager to everyone again.
+func CreateAccessSelects(groupWagerID int64) []discordgo.MessageComponent {
+	minValues := 0
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					MenuType:    discordgo.RoleSelectMenu,
+					CustomID:    fmt.Sprintf("group_wager_access_role_%d", groupWagerID),
+					Placeholder: "Only members of a role can bet",
+					MinValues:   &minValues,
+					MaxValues:   1,
+				},
+			},
+		},
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					MenuType:    discordgo.UserSelectMenu,
+					CustomID:    fmt.Sprintf("group_wager_access_users_%d", groupWagerID),
+					Placeholder: "Invite users to bet",
+					MinValues:   &minValues,
+					MaxValues:   entities.MaxGroupWagerInvitees,
+				},
+			},
+		},
+	}
+}
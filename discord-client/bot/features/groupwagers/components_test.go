@@ -0,0 +1,125 @@
+package groupwagers
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGroupWagerComponents_PendingResolution(t *testing.T) {
+	t.Parallel()
+
+	detail := &entities.GroupWagerDetail{
+		Wager: &entities.GroupWager{ID: 42, State: entities.GroupWagerStatePendingResolution},
+	}
+
+	components := CreateGroupWagerComponents(detail, entities.GroupWagerBetModeButtons)
+
+	require.Len(t, components, 1)
+	row := components[0].(discordgo.ActionsRow)
+	require.Len(t, row.Components, 1)
+	assert.Equal(t, "group_wager_resolve_42", row.Components[0].(discordgo.Button).CustomID)
+}
+
+func TestCreateResolveOptionSelect(t *testing.T) {
+	t.Parallel()
+
+	detail := &entities.GroupWagerDetail{
+		Wager: &entities.GroupWager{ID: 42, State: entities.GroupWagerStatePendingResolution},
+		Options: []*entities.GroupWagerOption{
+			{ID: 8, OptionText: "No", OptionOrder: 1, TotalAmount: 500},
+			{ID: 7, OptionText: "Yes", OptionOrder: 0, TotalAmount: 1500},
+		},
+		Participants: []*entities.GroupWagerParticipant{
+			{DiscordID: 1, OptionID: 7, Amount: 1000},
+			{DiscordID: 2, OptionID: 7, Amount: 500},
+			{DiscordID: 3, OptionID: 8, Amount: 500},
+		},
+	}
+
+	components := CreateResolveOptionSelect(detail)
+
+	require.Len(t, components, 1)
+	menu := components[0].(discordgo.ActionsRow).Components[0].(discordgo.SelectMenu)
+	assert.Equal(t, "group_wager_resolve_select_42", menu.CustomID)
+	require.Len(t, menu.Options, 2)
+	assert.Equal(t, "Yes", menu.Options[0].Label)
+	assert.Equal(t, "7", menu.Options[0].Value)
+	assert.Contains(t, menu.Options[0].Description, "2 bettors")
+	assert.Equal(t, "No", menu.Options[1].Label)
+}
+
+func TestCreateGroupWagerComponents_BetModes(t *testing.T) {
+	t.Parallel()
+
+	newDetail := func() *entities.GroupWagerDetail {
+		votingEndsAt := time.Now().Add(time.Hour)
+		detail := &entities.GroupWagerDetail{
+			Wager: &entities.GroupWager{
+				ID:           42,
+				State:        entities.GroupWagerStateActive,
+				WagerType:    entities.GroupWagerTypePool,
+				VotingEndsAt: &votingEndsAt,
+			},
+		}
+		for i := 0; i < 7; i++ {
+			detail.Options = append(detail.Options, &entities.GroupWagerOption{
+				ID:          int64(100 + i),
+				OptionText:  fmt.Sprintf("Option %d", i+1),
+				OptionOrder: int16(i),
+				TotalAmount: 1500,
+			})
+		}
+		return detail
+	}
+
+	t.Run("buttons", func(t *testing.T) {
+		t.Parallel()
+
+		components := CreateGroupWagerComponents(newDetail(), entities.GroupWagerBetModeButtons)
+
+		// Two rows of option buttons then the actions row
+		require.Len(t, components, 3)
+		first := components[0].(discordgo.ActionsRow)
+		require.Len(t, first.Components, 5)
+		assert.Equal(t, "group_wager_option_42_100", first.Components[0].(discordgo.Button).CustomID)
+		assert.Len(t, components[1].(discordgo.ActionsRow).Components, 2)
+	})
+
+	t.Run("menu", func(t *testing.T) {
+		t.Parallel()
+
+		components := CreateGroupWagerComponents(newDetail(), entities.GroupWagerBetModeMenu)
+
+		// One option menu then the actions row
+		require.Len(t, components, 2)
+		menu := components[0].(discordgo.ActionsRow).Components[0].(discordgo.SelectMenu)
+		assert.Equal(t, "group_wager_option_select_42", menu.CustomID)
+		require.Len(t, menu.Options, 7)
+		assert.Equal(t, "Option 1", menu.Options[0].Label)
+		assert.Equal(t, "100", menu.Options[0].Value)
+		assert.Equal(t, "2K bits bet", menu.Options[0].Description)
+		assert.Equal(t, "group_wager_withdraw_42", components[1].(discordgo.ActionsRow).Components[0].(discordgo.Button).CustomID)
+	})
+}
+
+func TestCreateGroupWagerComponents_Resolved(t *testing.T) {
+	t.Parallel()
+
+	detail := &entities.GroupWagerDetail{
+		Wager: &entities.GroupWager{ID: 42, State: entities.GroupWagerStateResolved},
+	}
+
+	components := CreateGroupWagerComponents(detail, entities.GroupWagerBetModeButtons)
+
+	require.Len(t, components, 1)
+	row := components[0].(discordgo.ActionsRow)
+	require.Len(t, row.Components, 1)
+	assert.Equal(t, "group_wager_share_42", row.Components[0].(discordgo.Button).CustomID)
+}
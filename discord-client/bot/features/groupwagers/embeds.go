@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"gambler/discord-client/bot/common"
 	"gambler/discord-client/domain/entities"
+	"math"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -85,6 +87,33 @@ func CreateGroupWagerEmbed(detail *entities.GroupWagerDetail) *discordgo.Message
 		}
 	}
 
+	// Show who may still join
+	access := detail.Wager.Access()
+	if access.MaxParticipants != nil {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "👥 Participants",
+			Value:  fmt.Sprintf("**%d/%d**", len(detail.Participants), *access.MaxParticipants),
+			Inline: true,
+		})
+	}
+	if access.IsInviteOnly() {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "🔒 Invite Only",
+			Value:  formatWagerInvitees(access),
+			Inline: true,
+		})
+	}
+
+	// Point reaction bettors at the message they react to
+	if detail.Wager.IsReactionWager() && detail.Wager.State == entities.GroupWagerStateActive {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: "👆 Bet by Reacting",
+			Value: fmt.Sprintf("React to [this message](%s) to bet **%s bits**",
+				reactionMessageURL(detail.Wager), common.FormatBalance(detail.Wager.GetReactionStake())),
+			Inline: false,
+		})
+	}
+
 	// Group participants by option
 	participantsByOption := detail.GetParticipantsByOption()
 
@@ -95,9 +124,17 @@ func CreateGroupWagerEmbed(detail *entities.GroupWagerDetail) *discordgo.Message
 		return sortedOptions[i].OptionOrder < sortedOptions[j].OptionOrder
 	})
 
+	// Once resolved, options nobody bet on are collapsed into a single line
+	collapseEmpty := detail.Wager.State == entities.GroupWagerStateResolved
+	var emptyOptions []string
+
 	// Add fields for each option with visual progress bars
 	for _, option := range sortedOptions {
 		participants := participantsByOption[option.ID]
+		if collapseEmpty && len(participants) == 0 {
+			emptyOptions = append(emptyOptions, option.OptionText)
+			continue
+		}
 
 		// Calculate percentage of total pot
 		percentage := float64(0)
@@ -118,6 +155,9 @@ func CreateGroupWagerEmbed(detail *entities.GroupWagerDetail) *discordgo.Message
 			progressBar,
 			formatCompactAmount(option.TotalAmount)+" bits",
 			multiplier)
+		if option.HasCap() {
+			statsLine += fmt.Sprintf(" • cap %s", formatCompactAmount(*option.MaxTotalAmount))
+		}
 
 		// Build participant info
 		var participantInfo string
@@ -158,6 +198,9 @@ func CreateGroupWagerEmbed(detail *entities.GroupWagerDetail) *discordgo.Message
 			Inline: false,
 		})
 	}
+	if field := noBetsField(emptyOptions); field != nil {
+		embed.Fields = append(embed.Fields, field)
+	}
 
 	// Add summary statistics field
 	if len(detail.Participants) > 0 && detail.Wager.TotalPot > 0 {
@@ -186,6 +229,13 @@ func CreateGroupWagerEmbed(detail *entities.GroupWagerDetail) *discordgo.Message
 	case entities.GroupWagerStateCancelled:
 		embed.Color = common.ColorDanger
 		embed.Description += "\n**CANCELLED**"
+		if refunds := entities.NewGroupWagerRefundSummary(detail); refunds.ParticipantCount() > 0 {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:   "↩️ Refunds",
+				Value:  formatRefundSummary(refunds),
+				Inline: false,
+			})
+		}
 	case entities.GroupWagerStatePendingResolution:
 		embed.Color = common.ColorPrimary
 		embed.Description += "\n**⏳ AWAITING RESOLUTION**"
@@ -208,10 +258,104 @@ func CreateGroupWagerEmbed(detail *entities.GroupWagerDetail) *discordgo.Message
 	} else {
 		embed.Footer.Text += fmt.Sprintf(" | %d participants", len(detail.Participants))
 	}
+	embed.Footer.Text += " | " + common.RulesFooterHint
 
 	return embed
 }
 
+// CreateCreatorStatsEmbed summarizes the group wagers a user has created
+func CreateCreatorStatsEmbed(stats *entities.GroupWagerCreatorStats, displayName string) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("📊 Group Wagers by %s", displayName),
+		Color: common.ColorInfo,
+	}
+
+	if stats.TotalCreated == 0 {
+		embed.Description = fmt.Sprintf("<@%d> hasn't created any group wagers yet.", stats.DiscordID)
+		return embed
+	}
+
+	timeToResolve := "-"
+	if stats.TotalResolved > 0 {
+		timeToResolve = common.FormatDuration(stats.AverageTimeToResolve)
+	}
+
+	embed.Fields = []*discordgo.MessageEmbedField{
+		{Name: "Created", Value: fmt.Sprintf("%d", stats.TotalCreated), Inline: true},
+		{Name: "Resolved", Value: fmt.Sprintf("%d", stats.TotalResolved), Inline: true},
+		{Name: "Cancelled", Value: fmt.Sprintf("%d", stats.TotalCancelled), Inline: true},
+		{Name: "Avg. Pot", Value: common.FormatBalance(int64(math.Round(stats.AveragePot))), Inline: true},
+		{Name: "Avg. Participants", Value: fmt.Sprintf("%.1f", stats.AverageParticipants), Inline: true},
+		{Name: "Avg. Time to Resolve", Value: timeToResolve, Inline: true},
+	}
+
+	if len(stats.PopularOptions) > 0 {
+		lines := make([]string, 0, len(stats.PopularOptions))
+		for i, option := range stats.PopularOptions {
+			lines = append(lines, fmt.Sprintf("%d. **%s** · %d bets · %s bits",
+				i+1, truncateButtonLabel(option.OptionText, 80), option.BetCount, common.FormatBalance(option.TotalAmount)))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Most Popular Options",
+			Value: strings.Join(lines, "\n"),
+		})
+	}
+
+	return embed
+}
+
+// refundSummaryMaxLines is the most refunded bets listed on a cancelled wager's embed
+const refundSummaryMaxLines = 10
+
+// noBetsField lists the options nobody bet on in one field, or returns nil if every option had bets
+func noBetsField(optionTexts []string) *discordgo.MessageEmbedField {
+	if len(optionTexts) == 0 {
+		return nil
+	}
+
+	value := strings.Join(optionTexts, " • ")
+	if len(value) > 1024 {
+		value = value[:1021] + "..."
+	}
+	return &discordgo.MessageEmbedField{
+		Name:   "No bets",
+		Value:  value,
+		Inline: false,
+	}
+}
+
+// formatRefundNotice describes the bets returned by a cancellation in one line
+func formatRefundNotice(refunds *entities.GroupWagerRefundSummary) string {
+	if refunds.ParticipantCount() == 0 {
+		return "No bets had been placed."
+	}
+	return fmt.Sprintf("Returned %s bits to %d participants.", common.FormatBalance(refunds.TotalRefunded), refunds.ParticipantCount())
+}
+
+// formatRefundSummary lists the largest refunded bets under the refund notice
+func formatRefundSummary(refunds *entities.GroupWagerRefundSummary) string {
+	lines := []string{formatRefundNotice(refunds)}
+	for _, refund := range refunds.Refunds[:min(len(refunds.Refunds), refundSummaryMaxLines)] {
+		lines = append(lines, fmt.Sprintf("<@%d> · %s", refund.DiscordID, common.FormatBalance(refund.Amount)))
+	}
+	if omitted := refunds.ParticipantCount() - refundSummaryMaxLines; omitted > 0 {
+		lines = append(lines, fmt.Sprintf("…and %d more", omitted))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatWagerInvitees mentions the role and users allowed to bet on an invite-only wager
+func formatWagerInvitees(access entities.GroupWagerAccess) string {
+	mentions := make([]string, 0, len(access.InvitedDiscordIDs)+1)
+	if access.AllowedRoleID != nil {
+		mentions = append(mentions, fmt.Sprintf("<@&%d>", *access.AllowedRoleID))
+	}
+	for _, discordID := range access.InvitedDiscordIDs {
+		mentions = append(mentions, fmt.Sprintf("<@%d>", discordID))
+	}
+	return strings.Join(mentions, " ")
+}
+
 // truncateButtonLabel safely truncates text to fit Discord's button label limit
 func truncateButtonLabel(text string, maxLength int) string {
 	if len(text) <= maxLength {
@@ -232,22 +376,48 @@ func truncateButtonLabel(text string, maxLength int) string {
 	return text[:truncateAt] + "..."
 }
 
-// createGroupWagerComponents creates the button components for a group wager
-func CreateGroupWagerComponents(detail *entities.GroupWagerDetail) []discordgo.MessageComponent {
+// addCurfewField shows bettors on an open wager the guild's daily quiet hours, when new bets are rejected.
+// The times are Discord timestamps so each viewer sees them in their own timezone.
+func addCurfewField(embed *discordgo.MessageEmbed, detail *entities.GroupWagerDetail, settings *entities.GuildSettings, now time.Time) {
+	if !detail.Wager.IsActive() || !detail.Wager.IsVotingPeriodActive() {
+		return
+	}
+	start, end, ok := settings.NextBettingCurfew(now)
+	if !ok {
+		return
+	}
+
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name:   "🌙 Quiet Hours",
+		Value:  fmt.Sprintf("No bets from <t:%d:t> until betting reopens at <t:%d:t>", start.Unix(), end.Unix()),
+		Inline: false,
+	})
+}
+
+// CreateGroupWagerComponents creates the components for a group wager. Active wagers offer their options
+// as buttons or a dropdown menu depending on the guild's bet mode.
+func CreateGroupWagerComponents(detail *entities.GroupWagerDetail, mode entities.GroupWagerBetMode) []discordgo.MessageComponent {
 	// Only show components for active wagers that haven't expired
 	if detail.Wager.IsActive() && detail.Wager.IsVotingPeriodActive() {
-		return createActiveWagerComponents(detail)
+		return createActiveWagerComponents(detail, mode)
+	}
+
+	// Resolvers pick the winner from a button once voting has closed
+	if detail.Wager.IsPendingResolution() {
+		return createResolveButton(detail.Wager.ID)
 	}
 
-	// No components for resolved, cancelled, expired, or pending resolution wagers
+	// Resolved wagers can be shared as an image of the result
+	if detail.Wager.IsResolved() {
+		return createShareButton(detail.Wager.ID)
+	}
+
+	// No components for cancelled or expired wagers
 	return []discordgo.MessageComponent{}
 }
 
-// createActiveWagerComponents creates betting option buttons for active wagers
-func createActiveWagerComponents(detail *entities.GroupWagerDetail) []discordgo.MessageComponent {
-	var rows []discordgo.MessageComponent
-	var currentRow []discordgo.MessageComponent
-
+// createActiveWagerComponents creates the betting controls and actions for active wagers
+func createActiveWagerComponents(detail *entities.GroupWagerDetail, mode entities.GroupWagerBetMode) []discordgo.MessageComponent {
 	// Sort options by order
 	options := make([]*entities.GroupWagerOption, len(detail.Options))
 	copy(options, detail.Options)
@@ -255,15 +425,64 @@ func createActiveWagerComponents(detail *entities.GroupWagerDetail) []discordgo.
 		return options[i].OptionOrder < options[j].OptionOrder
 	})
 
+	var rows []discordgo.MessageComponent
+	if mode == entities.GroupWagerBetModeMenu {
+		rows = createOptionSelectRows(detail, options)
+	} else {
+		rows = createOptionButtonRows(detail, options)
+	}
+
+	var actions []discordgo.MessageComponent
+
+	// Pool bets can be reduced or withdrawn while voting is open
+	if detail.Wager.IsPoolWager() {
+		actions = append(actions, discordgo.Button{
+			Label:    "Reduce/Withdraw",
+			Style:    discordgo.SecondaryButton,
+			CustomID: fmt.Sprintf("group_wager_withdraw_%d", detail.Wager.ID),
+			Emoji: &discordgo.ComponentEmoji{
+				Name: "↩️",
+			},
+		})
+	}
+
+	// Anyone can follow the wager to hear about its progress without betting
+	actions = append(actions, discordgo.Button{
+		Label:    "Follow",
+		Style:    discordgo.SecondaryButton,
+		CustomID: fmt.Sprintf("group_wager_follow_%d", detail.Wager.ID),
+		Emoji: &discordgo.ComponentEmoji{
+			Name: "🔔",
+		},
+	})
+
+	rows = append(rows, discordgo.ActionsRow{
+		Components: actions,
+	})
+
+	return rows
+}
+
+// createOptionButtonRows creates one bet button per option, five to a row
+func createOptionButtonRows(detail *entities.GroupWagerDetail, options []*entities.GroupWagerOption) []discordgo.MessageComponent {
+	var rows []discordgo.MessageComponent
+	var currentRow []discordgo.MessageComponent
+
 	// Create buttons for each option
 	for i, option := range options {
+		var emoji, style string
+		if option.ButtonEmoji != nil {
+			emoji = *option.ButtonEmoji
+		}
+		if option.ButtonStyle != nil {
+			style = string(*option.ButtonStyle)
+		}
+
 		button := discordgo.Button{
 			Label:    truncateButtonLabel(option.OptionText, 80),
-			Style:    discordgo.PrimaryButton,
+			Style:    common.OptionButtonStyle(style, discordgo.PrimaryButton),
 			CustomID: fmt.Sprintf("group_wager_option_%d_%d", detail.Wager.ID, option.ID),
-			Emoji: &discordgo.ComponentEmoji{
-				Name: getNumberEmoji(option.OptionOrder + 1),
-			},
+			Emoji:    common.OptionButtonEmoji(emoji, getNumberEmoji(option.OptionOrder+1)),
 		}
 
 		currentRow = append(currentRow, button)
@@ -280,6 +499,42 @@ func createActiveWagerComponents(detail *entities.GroupWagerDetail) []discordgo.
 	return rows
 }
 
+// createOptionSelectRows creates a single menu listing every option, which keeps large wagers within
+// Discord's component limits
+func createOptionSelectRows(detail *entities.GroupWagerDetail, options []*entities.GroupWagerOption) []discordgo.MessageComponent {
+	menuOptions := make([]discordgo.SelectMenuOption, 0, len(options))
+	for _, option := range options {
+		emoji := ""
+		if option.ButtonEmoji != nil {
+			emoji = *option.ButtonEmoji
+		}
+
+		description := fmt.Sprintf("%s bits bet", formatCompactAmount(option.TotalAmount))
+		if detail.Wager.IsHouseWager() {
+			description = fmt.Sprintf("%.2fx · %s", option.OddsMultiplier, description)
+		}
+
+		menuOptions = append(menuOptions, discordgo.SelectMenuOption{
+			Label:       truncateButtonLabel(option.OptionText, 100),
+			Value:       fmt.Sprintf("%d", option.ID),
+			Description: description,
+			Emoji:       common.OptionButtonEmoji(emoji, getNumberEmoji(option.OptionOrder+1)),
+		})
+	}
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    fmt.Sprintf("group_wager_option_select_%d", detail.Wager.ID),
+					Placeholder: "Choose an option to bet on",
+					Options:     menuOptions,
+				},
+			},
+		},
+	}
+}
+
 // getNumberEmoji returns the emoji for a number (1-10)
 func getNumberEmoji(num int16) string {
 	emojis := []string{"1️⃣", "2️⃣", "3️⃣", "4️⃣", "5️⃣", "6️⃣", "7️⃣", "8️⃣", "9️⃣", "🔟"}
@@ -0,0 +1,147 @@
+package groupwagers
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCreatorStatsEmbed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("summarizes created wagers", func(t *testing.T) {
+		embed := CreateCreatorStatsEmbed(&entities.GroupWagerCreatorStats{
+			DiscordID:            100,
+			TotalCreated:         5,
+			TotalResolved:        4,
+			TotalCancelled:       1,
+			AveragePot:           2499.6,
+			AverageParticipants:  3.25,
+			AverageTimeToResolve: 26*time.Hour + 30*time.Minute,
+			PopularOptions: []*entities.GroupWagerPopularOption{
+				{OptionText: "Yes", BetCount: 12, TotalAmount: 8000},
+				{OptionText: "No", BetCount: 7, TotalAmount: 3500},
+			},
+		}, "Alex")
+
+		assert.Equal(t, "📊 Group Wagers by Alex", embed.Title)
+		require.Len(t, embed.Fields, 7)
+		assert.Equal(t, "2,500", embed.Fields[3].Value)
+		assert.Equal(t, "3.2", embed.Fields[4].Value)
+		assert.Equal(t, "1d 2h 30m", embed.Fields[5].Value)
+		assert.Contains(t, embed.Fields[6].Value, "1. **Yes** · 12 bets · 8,000 bits")
+		assert.Contains(t, embed.Fields[6].Value, "2. **No** · 7 bets · 3,500 bits")
+	})
+
+	t.Run("no resolved wagers", func(t *testing.T) {
+		embed := CreateCreatorStatsEmbed(&entities.GroupWagerCreatorStats{DiscordID: 100, TotalCreated: 1}, "Alex")
+
+		require.Len(t, embed.Fields, 6)
+		assert.Equal(t, "-", embed.Fields[5].Value)
+	})
+
+	t.Run("nothing created", func(t *testing.T) {
+		embed := CreateCreatorStatsEmbed(&entities.GroupWagerCreatorStats{DiscordID: 100}, "Alex")
+
+		assert.Empty(t, embed.Fields)
+		assert.Contains(t, embed.Description, "hasn't created any group wagers")
+	})
+}
+
+func TestCreateGroupWagerEmbed_CollapsesEmptyOptions(t *testing.T) {
+	t.Parallel()
+
+	winningOptionID := int64(1)
+	newDetail := func(state entities.GroupWagerState) *entities.GroupWagerDetail {
+		return &entities.GroupWagerDetail{
+			Wager: &entities.GroupWager{
+				ID:              9,
+				Condition:       "Who wins the final?",
+				State:           state,
+				TotalPot:        3000,
+				WinningOptionID: &winningOptionID,
+			},
+			Options: []*entities.GroupWagerOption{
+				{ID: 1, OptionText: "Red", OptionOrder: 0, TotalAmount: 2000, OddsMultiplier: 1.5},
+				{ID: 2, OptionText: "Blue", OptionOrder: 1, TotalAmount: 1000, OddsMultiplier: 3},
+				{ID: 3, OptionText: "Green", OptionOrder: 2, OddsMultiplier: 1},
+				{ID: 4, OptionText: "Draw", OptionOrder: 3, OddsMultiplier: 1},
+			},
+			Participants: []*entities.GroupWagerParticipant{
+				{DiscordID: 100, OptionID: 1, Amount: 2000},
+				{DiscordID: 200, OptionID: 2, Amount: 1000},
+			},
+		}
+	}
+
+	t.Run("resolved wagers group options without bets", func(t *testing.T) {
+		embed := CreateGroupWagerEmbed(newDetail(entities.GroupWagerStateResolved))
+
+		var names []string
+		var noBets string
+		for _, field := range embed.Fields {
+			names = append(names, field.Name)
+			if field.Name == "No bets" {
+				noBets = field.Value
+			}
+		}
+		assert.NotContains(t, names, "Green")
+		assert.NotContains(t, names, "Draw")
+		assert.Contains(t, names, "Red")
+		assert.Equal(t, "Green • Draw", noBets)
+	})
+
+	t.Run("active wagers show every option", func(t *testing.T) {
+		embed := CreateGroupWagerEmbed(newDetail(entities.GroupWagerStateActive))
+
+		var names []string
+		for _, field := range embed.Fields {
+			names = append(names, field.Name)
+		}
+		assert.Contains(t, names, "Green")
+		assert.Contains(t, names, "Draw")
+		assert.NotContains(t, names, "No bets")
+	})
+}
+
+func TestAddCurfewField(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	votingEndsAt := time.Now().Add(24 * time.Hour)
+	start, end := 23, 7
+	settings := &entities.GuildSettings{CurfewStartHour: &start, CurfewEndHour: &end}
+
+	t.Run("open wager shows quiet hours", func(t *testing.T) {
+		detail := &entities.GroupWagerDetail{
+			Wager: &entities.GroupWager{State: entities.GroupWagerStateActive, VotingEndsAt: &votingEndsAt},
+		}
+		embed := &discordgo.MessageEmbed{}
+
+		addCurfewField(embed, detail, settings, now)
+
+		require.Len(t, embed.Fields, 1)
+		assert.Equal(t, "🌙 Quiet Hours", embed.Fields[0].Name)
+		assert.Contains(t, embed.Fields[0].Value, fmt.Sprintf("<t:%d:t>", time.Date(2024, 3, 15, 23, 0, 0, 0, time.UTC).Unix()))
+		assert.Contains(t, embed.Fields[0].Value, fmt.Sprintf("reopens at <t:%d:t>", time.Date(2024, 3, 16, 7, 0, 0, 0, time.UTC).Unix()))
+	})
+
+	t.Run("no field without a curfew or once resolved", func(t *testing.T) {
+		active := &entities.GroupWagerDetail{
+			Wager: &entities.GroupWager{State: entities.GroupWagerStateActive, VotingEndsAt: &votingEndsAt},
+		}
+		resolved := &entities.GroupWagerDetail{Wager: &entities.GroupWager{State: entities.GroupWagerStateResolved}}
+		embed := &discordgo.MessageEmbed{}
+
+		addCurfewField(embed, active, &entities.GuildSettings{}, now)
+		addCurfewField(embed, resolved, settings, now)
+
+		assert.Empty(t, embed.Fields)
+	})
+}
@@ -3,7 +3,9 @@ package groupwagers
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"gambler/discord-client/application"
 	"gambler/discord-client/bot/common"
@@ -15,18 +17,33 @@ import (
 
 // Feature represents the group wagers feature
 type Feature struct {
-	session    *discordgo.Session
-	uowFactory application.UnitOfWorkFactory
+	session     *discordgo.Session
+	uowFactory  application.UnitOfWorkFactory
+	limiter     *common.RateLimiter
+	pendingBets *pendingBets
+	outbox      *application.DiscordOutboxDispatcher
+
+	resultImages *resultImageCache
 }
 
 // NewFeature creates a new group wagers feature instance
-func NewFeature(session *discordgo.Session, uowFactory application.UnitOfWorkFactory) *Feature {
+func NewFeature(session *discordgo.Session, uowFactory application.UnitOfWorkFactory, limiter *common.RateLimiter) *Feature {
 	return &Feature{
-		session:    session,
-		uowFactory: uowFactory,
+		session:     session,
+		uowFactory:  uowFactory,
+		limiter:     limiter,
+		pendingBets: newPendingBets(),
+
+		resultImages: newResultImageCache(resultImageCacheTTL, resultImageCacheSize),
 	}
 }
 
+// SetOutboxDispatcher sets the dispatcher that sends newly created wagers' posts and pins once they commit.
+// It is set after construction because the dispatcher posts through this feature.
+func (f *Feature) SetOutboxDispatcher(outbox *application.DiscordOutboxDispatcher) {
+	f.outbox = outbox
+}
+
 // HandleCommand handles the /groupwager command and its subcommands
 func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	options := i.ApplicationCommandData().Options
@@ -39,10 +56,26 @@ func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCr
 	switch options[0].Name {
 	case "create":
 		f.handleGroupWagerCreate(s, i)
+	case "quick":
+		f.handleGroupWagerQuick(s, i)
+	case "react":
+		f.handleGroupWagerReact(s, i)
 	case "resolve":
 		f.handleGroupWagerResolve(s, i)
+	case "correct":
+		f.handleGroupWagerCorrect(s, i)
 	case "cancel":
 		f.handleGroupWagerCancel(s, i)
+	case "restore":
+		f.handleGroupWagerRestore(s, i)
+	case "reorder":
+		f.handleGroupWagerReorder(s, i)
+	case "setodds":
+		f.handleGroupWagerSetOdds(s, i)
+	case "link-match":
+		f.handleGroupWagerLinkMatch(s, i)
+	case "stats":
+		f.handleGroupWagerStats(s, i)
 	default:
 		common.RespondWithError(s, i, "Unknown subcommand.")
 	}
@@ -64,12 +97,58 @@ func (f *Feature) HandleInteraction(s *discordgo.Session, i *discordgo.Interacti
 func (f *Feature) handleComponentInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	customID := i.MessageComponentData().CustomID
 
-	// Group wager button interactions use format: group_wager_option_<wager_id>_<option_id>
+	// Bet buttons use format: group_wager_option_<wager_id>_<option_id>
+	// and the option menu uses format: group_wager_option_select_<wager_id>
 	if strings.HasPrefix(customID, "group_wager_option_") {
 		f.handleGroupWagerButtonInteraction(s, i)
 		return
 	}
 
+	// Bet preview buttons use format: group_wager_bet_confirm_<key> and group_wager_bet_cancel_<key>
+	if strings.HasPrefix(customID, "group_wager_bet_confirm_") {
+		f.handleGroupWagerBetConfirm(s, i)
+		return
+	}
+	if strings.HasPrefix(customID, "group_wager_bet_cancel_") {
+		f.handleGroupWagerBetCancel(s, i)
+		return
+	}
+
+	// Withdraw button interactions use format: group_wager_withdraw_<wager_id>
+	if strings.HasPrefix(customID, "group_wager_withdraw_") {
+		f.handleGroupWagerWithdrawButton(s, i)
+		return
+	}
+
+	// Follow button interactions use format: group_wager_follow_<wager_id>
+	if strings.HasPrefix(customID, "group_wager_follow_") {
+		f.handleGroupWagerFollowButton(s, i)
+		return
+	}
+
+	// Share button interactions use format: group_wager_share_<wager_id>
+	if strings.HasPrefix(customID, "group_wager_share_") {
+		f.handleGroupWagerShareButton(s, i)
+		return
+	}
+
+	// Access menus use format: group_wager_access_role_<wager_id> and group_wager_access_users_<wager_id>
+	if strings.HasPrefix(customID, "group_wager_access_") {
+		f.handleGroupWagerAccessSelect(s, i)
+		return
+	}
+
+	// Resolve flow: button, option menu, then confirm or cancel
+	switch {
+	case strings.HasPrefix(customID, "group_wager_resolve_select_"):
+		f.handleGroupWagerResolveSelect(s, i)
+	case strings.HasPrefix(customID, "group_wager_resolve_confirm_"):
+		f.handleGroupWagerResolveConfirm(s, i)
+	case strings.HasPrefix(customID, "group_wager_resolve_cancel_"):
+		f.handleGroupWagerResolveCancel(s, i)
+	case strings.HasPrefix(customID, "group_wager_resolve_"):
+		f.handleGroupWagerResolveButton(s, i)
+	}
 }
 
 // handleModalSubmit handles the group wager modals
@@ -79,14 +158,73 @@ func (f *Feature) handleModalSubmit(s *discordgo.Session, i *discordgo.Interacti
 	case customID == "group_wager_create_modal":
 
 		f.handleGroupWagerCreateModal(s, i)
+	case strings.HasPrefix(customID, "group_wager_react_modal_"):
+		f.handleGroupWagerReactModal(s, i)
 	case strings.HasPrefix(customID, "group_wager_bet_"):
-		f.handleGroupWagerBetModal(s, i)
+		f.limiter.Guard(common.RateLimitBet, f.handleGroupWagerBetModal)(s, i)
+	case strings.HasPrefix(customID, "group_wager_reduce_"):
+		f.limiter.Guard(common.RateLimitBet, f.handleGroupWagerWithdrawModal)(s, i)
 	default:
 		log.Warnf("Unknown group wager modal customID: %s", customID)
 		common.RespondWithError(s, i, "Unknown group wager modal")
 	}
 }
 
+// PostGroupWager implements the application.DiscordPoster interface, rendering the wager from its current state
+func (f *Feature) PostGroupWager(ctx context.Context, guildID, channelID, groupWagerID int64) (*application.PostResult, error) {
+	log.WithFields(log.Fields{
+		"guild":        guildID,
+		"channel":      channelID,
+		"groupWagerID": groupWagerID,
+	}).Info("Posting group wager to Discord")
+
+	if channelID == 0 {
+		return nil, fmt.Errorf("invalid channel ID: %d", channelID)
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	groupWagerDetail, err := uow.Services().GroupWagerService().GetGroupWagerDetail(ctx, groupWagerID)
+	uow.Rollback()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+
+	embed, components := f.renderGroupWager(ctx, guildID, groupWagerDetail)
+
+	channelIDStr := fmt.Sprintf("%d", channelID)
+	message, err := f.session.ChannelMessageSendComplex(channelIDStr, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: components,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send group wager message: %w", err)
+	}
+
+	messageID, err := strconv.ParseInt(message.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse message ID: %w", err)
+	}
+
+	result := &application.PostResult{
+		MessageID: messageID,
+		ChannelID: channelID,
+	}
+
+	// Open a discussion thread on the wager message; the wager still works without one
+	if threadID := common.StartMessageThread(f.session, channelIDStr, message.ID, groupWagerDetail.Wager.Condition); threadID != "" {
+		if parsedThreadID, err := strconv.ParseInt(threadID, 10, 64); err != nil {
+			log.Warnf("Failed to parse thread ID %s: %v", threadID, err)
+		} else {
+			result.ThreadID = parsedThreadID
+		}
+	}
+
+	return result, nil
+}
+
 // UpdateGroupWager implements the application.DiscordPoster interface
 func (f *Feature) UpdateGroupWager(ctx context.Context, messageID, channelID int64, detail interface{}) error {
 	log.WithFields(log.Fields{
@@ -109,8 +247,7 @@ func (f *Feature) UpdateGroupWager(ctx context.Context, messageID, channelID int
 	}
 
 	// Create embed and components
-	embed := CreateGroupWagerEmbed(groupDetail)
-	components := CreateGroupWagerComponents(groupDetail)
+	embed, components := f.renderGroupWager(ctx, groupDetail.Wager.GuildID, groupDetail)
 
 	// Convert IDs to strings for Discord API
 	channelIDStr := fmt.Sprintf("%d", channelID)
@@ -138,3 +275,44 @@ func (f *Feature) UpdateGroupWager(ctx context.Context, messageID, channelID int
 
 	return nil
 }
+
+// ArchiveWagerThread implements the application.DiscordPoster interface
+func (f *Feature) ArchiveWagerThread(ctx context.Context, threadID int64) error {
+	if threadID == 0 {
+		return fmt.Errorf("invalid thread ID: %d", threadID)
+	}
+
+	if err := common.ArchiveThread(f.session, fmt.Sprintf("%d", threadID)); err != nil {
+		return err
+	}
+
+	log.WithField("threadID", threadID).Info("Archived group wager discussion thread")
+	return nil
+}
+
+// renderGroupWager builds the wager message's embed and components with the guild's display settings
+func (f *Feature) renderGroupWager(ctx context.Context, guildID int64, detail *entities.GroupWagerDetail) (*discordgo.MessageEmbed, []discordgo.MessageComponent) {
+	settings := f.displaySettings(ctx, guildID)
+
+	embed := CreateGroupWagerEmbed(detail)
+	addCurfewField(embed, detail, settings, time.Now())
+
+	return embed, CreateGroupWagerComponents(detail, settings.GetGroupWagerBetMode())
+}
+
+// displaySettings returns the guild's settings for rendering wager messages, falling back to the defaults if they can't be read
+func (f *Feature) displaySettings(ctx context.Context, guildID int64) *entities.GuildSettings {
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Warnf("Failed to begin transaction for group wager display settings: %v", err)
+		return &entities.GuildSettings{GuildID: guildID}
+	}
+	defer uow.Rollback()
+
+	settings, err := uow.Services().GuildSettingsService().GetOrCreateSettings(ctx, guildID)
+	if err != nil {
+		log.Warnf("Failed to get group wager display settings for guild %d: %v", guildID, err)
+		return &entities.GuildSettings{GuildID: guildID}
+	}
+	return settings
+}
@@ -0,0 +1,85 @@
+package groupwagers
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// handleGroupWagerFollowButton follows or unfollows the wager for the user who clicked the button
+func (f *Feature) handleGroupWagerFollowButton(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	customID := i.MessageComponentData().CustomID
+
+	// Parse custom ID: group_wager_follow_<wager_id>
+	parts := strings.Split(customID, "_")
+	if len(parts) != 4 {
+		return
+	}
+
+	groupWagerID, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing group wager ID from %s: %v", parts[3], err)
+		return
+	}
+
+	userID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	following, err := uow.Services().GroupWagerFollowService().ToggleFollow(ctx, groupWagerID, userID)
+	if errors.Is(err, entities.ErrGroupWagerNotFollowable) {
+		common.RespondWithError(s, i, "This wager has already ended.")
+		return
+	}
+	if err != nil {
+		log.Errorf("Error toggling follow on group wager %d: %v", groupWagerID, err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	content := "🔕 You unfollowed this wager."
+	if following {
+		content = "🔔 You're following this wager. You'll be notified when the pot hits a milestone, " +
+			"when betting closes and when it's resolved. Click **Follow** again to stop."
+	}
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Errorf("Error responding to follow: %v", err)
+	}
+}
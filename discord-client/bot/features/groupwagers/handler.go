@@ -2,10 +2,11 @@ package groupwagers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"gambler/discord-client/application"
 	"gambler/discord-client/bot/common"
 	"gambler/discord-client/domain/entities"
-	"gambler/discord-client/domain/services"
 	"strconv"
 	"strings"
 
@@ -15,6 +16,17 @@ import (
 
 // handleGroupWagerCreate handles the /groupwager create subcommand
 func (f *Feature) handleGroupWagerCreate(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Pre-fill the voting period with the chosen preset, or the guild's default
+	votingPeriod := ""
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "voting_period" {
+			votingPeriod = opt.StringValue()
+		}
+	}
+	if votingPeriod == "" {
+		votingPeriod = common.FormatDurationMinutes(f.defaultVotingPeriodMinutes(i.GuildID))
+	}
+
 	// Respond with a modal to collect wager details
 	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseModal,
@@ -38,9 +50,9 @@ func (f *Feature) handleGroupWagerCreate(s *discordgo.Session, i *discordgo.Inte
 					Components: []discordgo.MessageComponent{
 						discordgo.TextInput{
 							CustomID:    "options",
-							Label:       "Options (one per line, 2-10 options)",
+							Label:       "Options (one per line, emoji and [color] ok)",
 							Style:       discordgo.TextInputParagraph,
-							Placeholder: "T1\nBLG\nGAM\nFLY\nFURIA\nKOI",
+							Placeholder: "T1 :t1: [red]\nBLG 🐉 [blue]\nGAM\nFLY\nFURIA\nKOI",
 							Required:    true,
 							MaxLength:   1000,
 						},
@@ -52,12 +64,37 @@ func (f *Feature) handleGroupWagerCreate(s *discordgo.Session, i *discordgo.Inte
 							CustomID:    "voting_period",
 							Label:       "Voting Period",
 							Style:       discordgo.TextInputShort,
-							Placeholder: "24, 1:30, :45",
+							Placeholder: "24, 1:30, :45, 6h, 1w",
+							Value:       votingPeriod,
 							Required:    false,
 							MaxLength:   10,
 						},
 					},
 				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "max_per_option",
+							Label:       "Max Total Bet Per Option (optional)",
+							Style:       discordgo.TextInputShort,
+							Placeholder: "50000",
+							Required:    false,
+							MaxLength:   12,
+						},
+					},
+				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "max_participants",
+							Label:       "Max Participants (optional)",
+							Style:       discordgo.TextInputShort,
+							Placeholder: "10",
+							Required:    false,
+							MaxLength:   4,
+						},
+					},
+				},
 			},
 		},
 	})
@@ -66,6 +103,32 @@ func (f *Feature) handleGroupWagerCreate(s *discordgo.Session, i *discordgo.Inte
 	}
 }
 
+// defaultVotingPeriodMinutes returns the guild's default voting period, or 24 hours if it cannot be loaded
+func (f *Feature) defaultVotingPeriodMinutes(guildIDStr string) int {
+	guildID, err := strconv.ParseInt(guildIDStr, 10, 64)
+	if err != nil {
+		return entities.DefaultVotingPeriodMinutes
+	}
+
+	ctx := context.Background()
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		return entities.DefaultVotingPeriodMinutes
+	}
+	defer uow.Rollback()
+
+	settings, err := uow.Services().GuildSettingsService().GetOrCreateSettings(ctx, guildID)
+	if err != nil {
+		log.Errorf("Error getting guild settings: %v", err)
+		return entities.DefaultVotingPeriodMinutes
+	}
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+	}
+	return settings.GetDefaultVotingPeriodMinutes()
+}
+
 // handleGroupWagerCreateModal handles the modal submission for creating a group wager
 func (f *Feature) handleGroupWagerCreateModal(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	ctx := context.Background()
@@ -75,6 +138,8 @@ func (f *Feature) handleGroupWagerCreateModal(s *discordgo.Session, i *discordgo
 	var condition string
 	var optionsText string
 	var votingPeriodText string
+	var maxPerOptionText string
+	var maxParticipantsText string
 
 	for _, comp := range data.Components {
 		row := comp.(*discordgo.ActionsRow)
@@ -87,103 +152,55 @@ func (f *Feature) handleGroupWagerCreateModal(s *discordgo.Session, i *discordgo
 				optionsText = strings.TrimSpace(textInput.Value)
 			case "voting_period":
 				votingPeriodText = strings.TrimSpace(textInput.Value)
+			case "max_per_option":
+				maxPerOptionText = strings.TrimSpace(textInput.Value)
+			case "max_participants":
+				maxParticipantsText = strings.TrimSpace(textInput.Value)
 			}
 		}
 	}
 
-	// Parse options (one per line)
-	optionLines := strings.Split(optionsText, "\n")
-	var options []string
-	optionMap := make(map[string]bool)
-	for _, line := range optionLines {
-		line = strings.TrimSpace(line)
-		if line != "" {
-			// Check for duplicates (case-insensitive)
-			lowerOption := strings.ToLower(line)
-			if optionMap[lowerOption] {
-				common.RespondWithError(s, i, fmt.Sprintf("Duplicate option found: '%s'. Each option must be unique.", line))
-				return
-			}
-			optionMap[lowerOption] = true
-			options = append(options, line)
-		}
-	}
-
-	// Validate options count
-	if len(options) < 2 {
-		common.RespondWithError(s, i, "Please provide at least 2 options.")
+	options, err := parseWagerOptions(s, i.GuildID, optionsText)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
 		return
 	}
-	if len(options) > 10 {
-		common.RespondWithError(s, i, "Maximum 10 options allowed.")
+
+	votingPeriodMinutes, err := parseVotingPeriod(votingPeriodText)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
 		return
 	}
 
-	// Parse and validate voting period
-	votingPeriodMinutes := 1440 // Default value (24 hours)
-	if votingPeriodText != "" {
-		// Check if it's in hours:minutes format
-		if strings.Contains(votingPeriodText, ":") {
-			parts := strings.Split(votingPeriodText, ":")
-			if len(parts) != 2 {
-				common.RespondWithError(s, i, "Invalid time format. Use hours:minutes (e.g., 1:30) or just hours (e.g., 24).")
-				return
-			}
-
-			// Parse hours
-			hoursStr := strings.TrimSpace(parts[0])
-			hours := 0
-			if hoursStr != "" {
-				var err error
-				hours, err = strconv.Atoi(hoursStr)
-				if err != nil {
-					common.RespondWithError(s, i, "Invalid hours value.")
-					return
-				}
-			}
-
-			// Parse minutes
-			minutesStr := strings.TrimSpace(parts[1])
-			minutes, err := strconv.Atoi(minutesStr)
-			if err != nil {
-				common.RespondWithError(s, i, "Invalid minutes value.")
-				return
-			}
-
-			// Validate minutes
-			if minutes < 0 || minutes >= 60 {
-				common.RespondWithError(s, i, "Minutes must be between 0 and 59.")
-				return
-			}
-
-			// Convert to total minutes
-			votingPeriodMinutes = hours*60 + minutes
-
-			// Validate total time
-			if votingPeriodMinutes < 5 {
-				common.RespondWithError(s, i, "Voting period must be at least 5 minutes.")
-				return
-			}
-		} else {
-			// Parse as plain hours
-			hours, err := strconv.Atoi(votingPeriodText)
-			if err != nil {
-				common.RespondWithError(s, i, "Voting period must be a valid number or time format (e.g., 1:30).")
-				return
-			}
-			votingPeriodMinutes = hours * 60
+	// Parse the optional per-option cap, applied to every option
+	var maxTotalAmounts []int64
+	if maxPerOptionText != "" {
+		maxPerOption, err := strconv.ParseInt(strings.ReplaceAll(maxPerOptionText, ",", ""), 10, 64)
+		if err != nil || maxPerOption <= 0 {
+			common.RespondWithError(s, i, "Max total bet per option must be a positive whole number.")
+			return
 		}
+		maxTotalAmounts = make([]int64, len(options))
+		for idx := range maxTotalAmounts {
+			maxTotalAmounts[idx] = maxPerOption
+		}
+	}
 
-		// Final validation
-		if votingPeriodMinutes > 10080 {
-			common.RespondWithError(s, i, "Voting period must not exceed 168 hours (1 week).")
+	// Parse the optional participant limit
+	var maxParticipants *int
+	if maxParticipantsText != "" {
+		limit, err := strconv.Atoi(maxParticipantsText)
+		if err != nil || limit < 1 || limit > entities.MaxGroupWagerParticipantsCap {
+			common.RespondWithError(s, i, fmt.Sprintf("Max participants must be a whole number from 1 to %d.", entities.MaxGroupWagerParticipantsCap))
 			return
 		}
+		maxParticipants = &limit
 	}
 
-	// Defer response while we process
-	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+	// Defer response while we process; the wager itself is posted to the channel through the outbox
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
 	})
 	if err != nil {
 		log.Printf("Error deferring group wager creation: %v", err)
@@ -216,72 +233,147 @@ func (f *Feature) handleGroupWagerCreateModal(s *discordgo.Session, i *discordgo
 	defer uow.Rollback()
 
 	// Instantiate group wager service with repositories from UnitOfWork
-	groupWagerService := services.NewGroupWagerService(
-		uow.GroupWagerRepository(),
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	groupWagerService := uow.Services().GroupWagerService()
+
+	if votingPeriodMinutes == 0 {
+		settings, err := uow.Services().GuildSettingsService().GetOrCreateSettings(ctx, guildID)
+		if err != nil {
+			log.Printf("Error getting guild settings: %v", err)
+			common.FollowUpWithError(s, i, "Unable to process request.")
+			return
+		}
+		votingPeriodMinutes = settings.GetDefaultVotingPeriodMinutes()
+	}
 
 	// Create the group wager (message ID will be updated after posting)
 	// Default to pool wager with no preset odds for existing bot command
-	groupWagerDetail, err := groupWagerService.CreateGroupWager(ctx, &creatorID, condition, options, votingPeriodMinutes, 0, 0, entities.GroupWagerTypePool, nil)
+	groupWagerDetail, err := groupWagerService.CreateGroupWager(ctx, guildID, &creatorID, condition, options, votingPeriodMinutes, 0, 0, entities.GroupWagerTypePool, nil, maxTotalAmounts)
 	if err != nil {
 		log.Printf("Error creating group wager: %v", err)
-		common.FollowUpWithError(s, i, fmt.Sprintf("Failed to create group wager: %v", err))
+		common.FollowUpWithError(s, i, common.DescribeError("Failed to create group wager", err))
 		return
 	}
 
-	// Create the embed
-	embed := CreateGroupWagerEmbed(groupWagerDetail)
-	components := CreateGroupWagerComponents(groupWagerDetail)
-
-	// Send the follow-up message
-	msg, err := s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
-		Embeds:     []*discordgo.MessageEmbed{embed},
-		Components: components,
-	})
-	if err != nil {
-		log.Printf("Error sending group wager message: %v", err)
-		// Rollback is handled by defer
-		return
+	if maxParticipants != nil {
+		access := entities.GroupWagerAccess{MaxParticipants: maxParticipants}
+		if _, err := groupWagerService.SetAccess(ctx, groupWagerDetail.Wager.ID, creatorID, access); err != nil {
+			log.Printf("Error setting group wager participant limit: %v", err)
+			common.FollowUpWithError(s, i, common.DescribeError("Failed to create group wager", err))
+			return
+		}
+		groupWagerDetail.Wager.SetAccess(access)
 	}
 
-	// Update the group wager with message and channel IDs
-	messageID, err := strconv.ParseInt(msg.ID, 10, 64)
-	if err != nil {
-		log.Errorf("failed to parse MessageID: %s", err)
-		return
-	}
-	channelID, err := strconv.ParseInt(msg.ChannelID, 10, 64)
+	f.postCreatedGroupWager(ctx, s, i, uow, groupWagerDetail, guildID)
+}
+
+// postCreatedGroupWager queues a newly created group wager's post and pin in the outbox, commits, and sends them
+// once the wager is saved, then offers the creator the access menu
+func (f *Feature) postCreatedGroupWager(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, uow application.UnitOfWork, groupWagerDetail *entities.GroupWagerDetail, guildID int64) {
+	channelID, err := strconv.ParseInt(i.ChannelID, 10, 64)
 	if err != nil {
 		log.Errorf("failed to parse ChannelID: %s", err)
+		common.FollowUpWithError(s, i, "Unable to process request.")
 		return
 	}
 
-	// Update message IDs in the same transaction
-	if err := groupWagerService.UpdateMessageIDs(ctx, groupWagerDetail.Wager.ID, messageID, channelID); err != nil {
-		log.Errorf("failed to update group wager message IDs: %s", err)
-		// TODO: Consider deleting the Discord message here since DB update failed
-		return
+	// Queue the post and the pin with the wager so neither is sent for a wager that failed to save
+	wagerID := groupWagerDetail.Wager.ID
+	post := &entities.DiscordOutboxMessage{
+		GuildID:      guildID,
+		Action:       entities.DiscordOutboxActionPost,
+		Kind:         entities.DiscordOutboxKindGroupWager,
+		ChannelID:    channelID,
+		GroupWagerID: &wagerID,
+	}
+	pin := &entities.DiscordOutboxMessage{
+		GuildID:      guildID,
+		Action:       entities.DiscordOutboxActionPin,
+		GroupWagerID: &wagerID,
+	}
+	outboxService := uow.Services().DiscordOutboxService()
+	for _, message := range []*entities.DiscordOutboxMessage{post, pin} {
+		if err := outboxService.Enqueue(ctx, message); err != nil {
+			log.Errorf("failed to queue group wager %s: %s", message.Action, err)
+			common.FollowUpWithError(s, i, "Failed to save group wager.")
+			return
+		}
 	}
 
-	// Commit the transaction after all operations succeed
 	if err := uow.Commit(); err != nil {
 		log.Printf("Error committing transaction: %v", err)
 		common.FollowUpWithError(s, i, "Failed to save group wager.")
-		// TODO: Consider deleting the Discord message here since commit failed
 		return
 	}
 
-	// Pin the group wager message
-	common.PinMessage(s, msg.ChannelID, msg.ID)
+	// The pin waits for the post, so send them in order
+	f.outbox.Dispatch(ctx, guildID, post.ID, pin.ID)
+
+	// Let the creator make the wager invite-only before anyone else bets
+	_, err = s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Content:    "Group wager created! Only want certain people betting? Pick a role or invite users before anyone else bets.",
+		Components: CreateAccessSelects(wagerID),
+		Flags:      discordgo.MessageFlagsEphemeral,
+	})
+	if err != nil {
+		log.Errorf("Error sending group wager access menu: %v", err)
+	}
+}
+
+// parseWagerOptions parses the options of a new wager, one per line, each with an optional button emoji and [color]
+func parseWagerOptions(s *discordgo.Session, guildID string, optionsText string) ([]string, error) {
+	var options []string
+	optionMap := make(map[string]bool)
+	for _, line := range strings.Split(optionsText, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		optionLine, err := resolveOptionEmoji(s, guildID, entities.ParseGroupWagerOptionLine(line))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid option: %v", err)
+		}
+
+		// Check for duplicates (case-insensitive)
+		lowerOption := strings.ToLower(optionLine.Text)
+		if optionMap[lowerOption] {
+			return nil, fmt.Errorf("Duplicate option found: '%s'. Each option must be unique.", optionLine.Text)
+		}
+		optionMap[lowerOption] = true
+		options = append(options, optionLine.String())
+	}
+
+	// Validate options count
+	if len(options) < 2 {
+		return nil, fmt.Errorf("Please provide at least 2 options.")
+	}
+	if len(options) > 10 {
+		return nil, fmt.Errorf("Maximum 10 options allowed.")
+	}
+	return options, nil
+}
+
+// parseVotingPeriod parses and validates a new wager's voting period, returning zero to use the guild's default
+func parseVotingPeriod(votingPeriodText string) (int, error) {
+	if votingPeriodText == "" {
+		return 0, nil
+	}
 
+	minutes, err := common.ParseDurationMinutes(votingPeriodText)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid voting period: %v.", err)
+	}
+	if minutes < entities.MinVotingPeriodMinutes || minutes > entities.MaxVotingPeriodMinutes {
+		return 0, fmt.Errorf("Voting period must be between %d minutes and %d hours (1 week).",
+			entities.MinVotingPeriodMinutes, entities.MaxVotingPeriodMinutes/60)
+	}
+	return minutes, nil
 }
 
 // handleGroupWagerResolve handles the /groupwager resolve subcommand
 func (f *Feature) handleGroupWagerResolve(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	ctx := context.Background()
+	ctx := common.WithMemberRoles(context.Background(), i)
 	options := i.ApplicationCommandData().Options[0].Options
 
 	var groupWagerID int64
@@ -331,12 +423,7 @@ func (f *Feature) handleGroupWagerResolve(s *discordgo.Session, i *discordgo.Int
 	defer uow.Rollback()
 
 	// Instantiate group wager service with repositories from UnitOfWork
-	groupWagerService := services.NewGroupWagerService(
-		uow.GroupWagerRepository(),
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	groupWagerService := uow.Services().GroupWagerService()
 
 	// Get wager details to find option ID from text
 	wagerDetail, err := groupWagerService.GetGroupWagerDetail(ctx, groupWagerID)
@@ -366,7 +453,7 @@ func (f *Feature) handleGroupWagerResolve(s *discordgo.Session, i *discordgo.Int
 	result, err := groupWagerService.ResolveGroupWager(ctx, groupWagerID, &resolverID, winningOptionID)
 	if err != nil {
 		log.Printf("Error resolving group wager: %v", err)
-		common.FollowUpWithError(s, i, fmt.Sprintf("Failed to resolve wager: %v", err))
+		common.FollowUpWithError(s, i, common.DescribeError("Failed to resolve wager", err))
 		return
 	}
 
@@ -384,7 +471,20 @@ func (f *Feature) handleGroupWagerResolve(s *discordgo.Session, i *discordgo.Int
 		return
 	}
 
-	// Create success message
+	_, err = s.FollowupMessageCreate(i.Interaction, false, &discordgo.WebhookParams{
+		Content: formatResolutionMessage(result),
+	})
+	if err != nil {
+		log.Printf("Error sending resolve message: %v", err)
+	}
+
+	postSettlementBreakdown(s, i.ChannelID, result)
+
+	refreshResolvedWagerMessage(s, result, updatedDetail)
+}
+
+// formatResolutionMessage builds the public announcement for a resolved group wager
+func formatResolutionMessage(result *entities.GroupWagerResult) string {
 	var winnerList []string
 	for _, winner := range result.Winners {
 		payout := result.PayoutDetails[winner.DiscordID]
@@ -399,42 +499,157 @@ func (f *Feature) handleGroupWagerResolve(s *discordgo.Session, i *discordgo.Int
 		strings.Join(winnerList, "\n"),
 	)
 
-	_, err = s.FollowupMessageCreate(i.Interaction, false, &discordgo.WebhookParams{
-		Content: message,
+	if potTax := entities.TotalLotteryPotTax(result.PotTaxes); potTax > 0 {
+		message += fmt.Sprintf("\n\n🎟️ %s bits (%d%% pot tax) were added to the lottery pot.",
+			common.FormatBalance(potTax), result.PotTaxPercent)
+	}
+
+	return message
+}
+
+// handleGroupWagerCorrect handles the /groupwager correct subcommand
+func (f *Feature) handleGroupWagerCorrect(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := common.WithMemberRoles(context.Background(), i)
+	options := i.ApplicationCommandData().Options[0].Options
+
+	var groupWagerID int64
+	var winningOptionText string
+
+	for _, opt := range options {
+		switch opt.Name {
+		case "id":
+			groupWagerID = opt.IntValue()
+		case "winning_option":
+			winningOptionText = opt.StringValue()
+		}
+	}
+
+	resolverID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing resolver ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
 	})
 	if err != nil {
-		log.Printf("Error sending resolve message: %v", err)
+		log.Printf("Error deferring correct response: %v", err)
+		return
 	}
 
-	// Unpin and update the original wager message to show it's resolved
-	if result.GroupWager.MessageID != 0 && result.GroupWager.ChannelID != 0 {
-		// Unpin the message first
-		messageIDStr := strconv.FormatInt(result.GroupWager.MessageID, 10)
-		channelIDStr := strconv.FormatInt(result.GroupWager.ChannelID, 10)
-		common.UnpinMessage(s, channelIDStr, messageIDStr)
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing guild ID: %v", err)
+		common.FollowUpWithError(s, i, "Unable to process request.")
+		return
+	}
 
-		// Create updated embed and components if we have the updated detail
-		if updatedDetail != nil {
-			embed := CreateGroupWagerEmbed(updatedDetail)
-			components := CreateGroupWagerComponents(updatedDetail) // Will be empty since wager is resolved
-
-			// Update the original message
-			_, err = s.ChannelMessageEditComplex(&discordgo.MessageEdit{
-				Channel:    strconv.FormatInt(result.GroupWager.ChannelID, 10),
-				ID:         strconv.FormatInt(result.GroupWager.MessageID, 10),
-				Embeds:     &[]*discordgo.MessageEmbed{embed},
-				Components: &components,
-			})
-			if err != nil {
-				log.Printf("Error updating resolved group wager message: %v", err)
-			}
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Printf("Error beginning transaction: %v", err)
+		common.FollowUpWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	groupWagerService := uow.Services().GroupWagerService()
+
+	wagerDetail, err := groupWagerService.GetGroupWagerDetail(ctx, groupWagerID)
+	if err != nil {
+		log.Printf("Error getting group wager detail: %v", err)
+		common.FollowUpWithError(s, i, "Failed to get wager details.")
+		return
+	}
+
+	var previousOption, winningOption *entities.GroupWagerOption
+	for _, option := range wagerDetail.Options {
+		if option.OptionText == winningOptionText {
+			winningOption = option
+		}
+		if wagerDetail.Wager.WinningOptionID != nil && option.ID == *wagerDetail.Wager.WinningOptionID {
+			previousOption = option
 		}
 	}
+	if winningOption == nil {
+		common.FollowUpWithError(s, i, fmt.Sprintf("No option found with text: %s", winningOptionText))
+		return
+	}
+
+	result, err := groupWagerService.ReResolve(ctx, groupWagerID, resolverID, winningOption.ID)
+	if err != nil {
+		log.Printf("Error correcting group wager resolution: %v", err)
+		common.FollowUpWithError(s, i, common.DescribeError("Failed to correct resolution", err))
+		return
+	}
+
+	updatedDetail, err := groupWagerService.GetGroupWagerDetail(ctx, groupWagerID)
+	if err != nil {
+		log.Printf("Error getting updated group wager detail: %v", err)
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Printf("Error committing transaction: %v", err)
+		common.FollowUpWithError(s, i, "Failed to save correction.")
+		return
+	}
+
+	_, err = s.FollowupMessageCreate(i.Interaction, false, &discordgo.WebhookParams{
+		Content: formatCorrectionMessage(result, previousOption),
+	})
+	if err != nil {
+		log.Printf("Error sending correct message: %v", err)
+	}
+
+	postSettlementBreakdown(s, i.ChannelID, result)
+
+	refreshResolvedWagerMessage(s, result, updatedDetail)
+}
+
+// formatCorrectionMessage builds the public announcement for a corrected group wager resolution
+func formatCorrectionMessage(result *entities.GroupWagerResult, previousOption *entities.GroupWagerOption) string {
+	message := formatResolutionMessage(result)
+	message = strings.Replace(message, "**Group Wager Resolved!**", "**Group Wager Resolution Corrected!**", 1)
+	if previousOption != nil {
+		message += fmt.Sprintf("\n\n↩️ Payouts for **%s** were reversed.", previousOption.OptionText)
+	}
+	return message
+}
+
+// refreshResolvedWagerMessage unpins the original wager message and updates it to show the resolution
+func refreshResolvedWagerMessage(s *discordgo.Session, result *entities.GroupWagerResult, updatedDetail *entities.GroupWagerDetail) {
+	if result.GroupWager.MessageID == 0 || result.GroupWager.ChannelID == 0 {
+		return
+	}
+
+	// Unpin the message first
+	messageIDStr := strconv.FormatInt(result.GroupWager.MessageID, 10)
+	channelIDStr := strconv.FormatInt(result.GroupWager.ChannelID, 10)
+	common.UnpinMessage(s, channelIDStr, messageIDStr)
+
+	// Update the message if we have the updated detail
+	if updatedDetail == nil {
+		return
+	}
+
+	embed := CreateGroupWagerEmbed(updatedDetail)
+	components := CreateGroupWagerComponents(updatedDetail, entities.DefaultGroupWagerBetMode) // Only the share button once resolved
+
+	_, err := s.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		Channel:    channelIDStr,
+		ID:         messageIDStr,
+		Embeds:     &[]*discordgo.MessageEmbed{embed},
+		Components: &components,
+	})
+	if err != nil {
+		log.Printf("Error updating resolved group wager message: %v", err)
+	}
 }
 
 // handleGroupWagerCancel handles the /groupwager cancel subcommand
 func (f *Feature) handleGroupWagerCancel(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	ctx := context.Background()
+	ctx := common.WithMemberRoles(context.Background(), i)
 	options := i.ApplicationCommandData().Options[0].Options
 
 	var groupWagerID int64
@@ -480,18 +695,13 @@ func (f *Feature) handleGroupWagerCancel(s *discordgo.Session, i *discordgo.Inte
 	defer uow.Rollback()
 
 	// Instantiate group wager service with repositories from UnitOfWork
-	groupWagerService := services.NewGroupWagerService(
-		uow.GroupWagerRepository(),
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	groupWagerService := uow.Services().GroupWagerService()
 
 	// Get the group wager details before cancellation for the message update
 	detail, err := groupWagerService.GetGroupWagerDetail(ctx, groupWagerID)
 	if err != nil {
 		log.Printf("Error getting group wager detail: %v", err)
-		common.FollowUpWithError(s, i, fmt.Sprintf("Failed to find group wager: %v", err))
+		common.FollowUpWithError(s, i, common.DescribeError("Failed to find group wager", err))
 		return
 	}
 
@@ -500,11 +710,11 @@ func (f *Feature) handleGroupWagerCancel(s *discordgo.Session, i *discordgo.Inte
 	messageID := detail.Wager.MessageID
 	channelID := detail.Wager.ChannelID
 
-	// Cancel the wager
-	err = groupWagerService.CancelGroupWager(ctx, groupWagerID, &cancellerID)
+	// Cancel the wager, returning every bet
+	refunds, err := groupWagerService.RefundAllParticipants(ctx, groupWagerID, &cancellerID)
 	if err != nil {
 		log.Printf("Error cancelling group wager: %v", err)
-		common.FollowUpWithError(s, i, fmt.Sprintf("Failed to cancel wager: %v", err))
+		common.FollowUpWithError(s, i, common.DescribeError("Failed to cancel wager", err))
 		return
 	}
 
@@ -517,8 +727,8 @@ func (f *Feature) handleGroupWagerCancel(s *discordgo.Session, i *discordgo.Inte
 
 	// Create success message
 	message := fmt.Sprintf(
-		"**Group Wager Cancelled**\n\nCondition: %s\nAll bets have been refunded.",
-		originalCondition,
+		"**Group Wager Cancelled**\n\nCondition: %s\n%s",
+		originalCondition, formatRefundNotice(refunds),
 	)
 
 	_, err = s.FollowupMessageCreate(i.Interaction, false, &discordgo.WebhookParams{
@@ -539,7 +749,7 @@ func (f *Feature) handleGroupWagerCancel(s *discordgo.Session, i *discordgo.Inte
 
 		// Create updated embed and components
 		embed := CreateGroupWagerEmbed(detail)
-		components := CreateGroupWagerComponents(detail)
+		components := CreateGroupWagerComponents(detail, entities.DefaultGroupWagerBetMode) // Will be empty since wager is cancelled
 		// Update the original message
 		_, err = s.ChannelMessageEditComplex(&discordgo.MessageEdit{
 			Channel:    strconv.FormatInt(channelID, 10),
@@ -553,34 +763,472 @@ func (f *Feature) handleGroupWagerCancel(s *discordgo.Session, i *discordgo.Inte
 	}
 }
 
-// handleGroupWagerButtonInteraction handles button clicks on group wager messages
-func (f *Feature) handleGroupWagerButtonInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	customID := i.MessageComponentData().CustomID
+// handleGroupWagerRestore handles the /groupwager restore subcommand
+func (f *Feature) handleGroupWagerRestore(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := common.WithMemberRoles(context.Background(), i)
+	options := i.ApplicationCommandData().Options[0].Options
 
-	// Parse custom ID: group_wager_option_<wager_id>_<option_id>
-	parts := strings.Split(customID, "_")
-	if len(parts) != 5 || parts[0] != "group" || parts[1] != "wager" || parts[2] != "option" {
-		return
+	var groupWagerID int64
+	for _, opt := range options {
+		if opt.Name == "id" {
+			groupWagerID = opt.IntValue()
+			break
+		}
 	}
 
-	groupWagerID, err := strconv.ParseInt(parts[3], 10, 64)
+	// Get restorer ID
+	restorerID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
 	if err != nil {
-		log.Errorf("Error parsing group wager ID from %s: %v", parts[3], err)
+		log.Printf("Error parsing restorer ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
 		return
 	}
 
-	optionID, err := strconv.ParseInt(parts[4], 10, 64)
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
 	if err != nil {
-		log.Errorf("Error parsing option ID from %s: %v", parts[4], err)
+		log.Printf("Error parsing guild ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
 		return
 	}
 
-	// Show modal for bet amount
+	// Defer response
 	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseModal,
-		Data: &discordgo.InteractionResponseData{
-			CustomID: fmt.Sprintf("group_wager_bet_%d_%d", groupWagerID, optionID),
-			Title:    "Place Your Bet",
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		log.Printf("Error deferring restore response: %v", err)
+		return
+	}
+
+	// Create unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Printf("Error beginning transaction: %v", err)
+		common.FollowUpWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate group wager service with repositories from UnitOfWork
+	groupWagerService := uow.Services().GroupWagerService()
+
+	// Restore the wager
+	detail, err := groupWagerService.RestoreGroupWager(ctx, groupWagerID, restorerID)
+	if err != nil {
+		log.Printf("Error restoring group wager: %v", err)
+		common.FollowUpWithError(s, i, common.DescribeError("Failed to restore wager", err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Printf("Error committing transaction: %v", err)
+		common.FollowUpWithError(s, i, "Failed to save restoration.")
+		return
+	}
+
+	// Create success message
+	message := fmt.Sprintf("**Group Wager Restored**\n\nCondition: %s\nAll bets have been reinstated.", detail.Wager.Condition)
+	if detail.Wager.IsActive() && detail.Wager.VotingEndsAt != nil {
+		message += fmt.Sprintf("\nBetting closes %s.", common.FormatDiscordTimestamp(*detail.Wager.VotingEndsAt, "R"))
+	}
+
+	_, err = s.FollowupMessageCreate(i.Interaction, false, &discordgo.WebhookParams{
+		Content: message,
+	})
+	if err != nil {
+		log.Printf("Error sending restore message: %v", err)
+	}
+
+	// Re-pin and update the original wager message to show it's open again
+	if detail.Wager.MessageID != 0 && detail.Wager.ChannelID != 0 {
+		messageIDStr := strconv.FormatInt(detail.Wager.MessageID, 10)
+		channelIDStr := strconv.FormatInt(detail.Wager.ChannelID, 10)
+		common.PinMessage(s, channelIDStr, messageIDStr)
+
+		// Create updated embed and components
+		embed, components := f.renderGroupWager(ctx, guildID, detail)
+		// Update the original message
+		_, err = s.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			Channel:    channelIDStr,
+			ID:         messageIDStr,
+			Embeds:     &[]*discordgo.MessageEmbed{embed},
+			Components: &components,
+		})
+		if err != nil {
+			log.Printf("Error updating restored group wager message: %v", err)
+		}
+	}
+}
+
+// handleGroupWagerReorder handles the /groupwager reorder subcommand
+func (f *Feature) handleGroupWagerReorder(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	options := i.ApplicationCommandData().Options[0].Options
+
+	var groupWagerID int64
+	var order string
+
+	for _, opt := range options {
+		switch opt.Name {
+		case "id":
+			groupWagerID = opt.IntValue()
+		case "order":
+			order = opt.StringValue()
+		}
+	}
+
+	optionNumbers, err := parseOptionNumbers(order)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	// Get creator ID
+	creatorID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing creator ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing guild ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	// Create unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Printf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate group wager service with repositories from UnitOfWork
+	groupWagerService := uow.Services().GroupWagerService()
+
+	detail, err := groupWagerService.ReorderOptions(ctx, groupWagerID, creatorID, optionNumbers)
+	if err != nil {
+		common.RespondWithError(s, i, common.DescribeError("Failed to reorder options", err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Printf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to save the new order.")
+		return
+	}
+
+	// Reordering numbers the options 0 to n-1, so each one's order is its line
+	lines := make([]string, len(detail.Options))
+	for _, option := range detail.Options {
+		lines[option.OptionOrder] = fmt.Sprintf("%d. %s", option.OptionOrder+1, option.OptionText)
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("**Options Reordered**\n\nWager #%d:\n%s", groupWagerID, strings.Join(lines, "\n")),
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Printf("Error sending reorder message: %v", err)
+	}
+
+	// Show the new order on the wager message
+	if detail.Wager.MessageID != 0 && detail.Wager.ChannelID != 0 {
+		wagerMessage := &discordgo.Message{
+			ID:        strconv.FormatInt(detail.Wager.MessageID, 10),
+			ChannelID: strconv.FormatInt(detail.Wager.ChannelID, 10),
+		}
+		f.updateGroupWagerMessage(s, wagerMessage, groupWagerID, guildID)
+	}
+}
+
+// parseOptionNumbers reads a list of option numbers separated by commas or spaces, e.g. "3, 1, 2"
+func parseOptionNumbers(order string) ([]int, error) {
+	fields := strings.FieldsFunc(order, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("please list the option numbers in their new order, e.g. 3,1,2")
+	}
+
+	numbers := make([]int, 0, len(fields))
+	for _, field := range fields {
+		number, err := strconv.Atoi(field)
+		if err != nil || number < 1 {
+			return nil, fmt.Errorf("%q is not an option number", field)
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
+
+// handleGroupWagerSetOdds handles the /groupwager setodds subcommand
+func (f *Feature) handleGroupWagerSetOdds(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := common.WithMemberRoles(context.Background(), i)
+	options := i.ApplicationCommandData().Options[0].Options
+
+	var groupWagerID int64
+	var optionText string
+	var odds float64
+
+	for _, opt := range options {
+		switch opt.Name {
+		case "id":
+			groupWagerID = opt.IntValue()
+		case "option":
+			optionText = opt.StringValue()
+		case "odds":
+			odds = opt.FloatValue()
+		}
+	}
+
+	// Get updater ID
+	updaterID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing updater ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing guild ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	// Defer response
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		log.Printf("Error deferring set odds response: %v", err)
+		return
+	}
+
+	// Create unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Printf("Error beginning transaction: %v", err)
+		common.FollowUpWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate group wager service with repositories from UnitOfWork
+	groupWagerService := uow.Services().GroupWagerService()
+
+	// Get wager details to find option ID from text
+	wagerDetail, err := groupWagerService.GetGroupWagerDetail(ctx, groupWagerID)
+	if err != nil {
+		log.Printf("Error getting group wager detail: %v", err)
+		common.FollowUpWithError(s, i, "Failed to get wager details.")
+		return
+	}
+
+	// Find the option from the text
+	var selectedOption *entities.GroupWagerOption
+	for _, option := range wagerDetail.Options {
+		if option.OptionText == optionText {
+			selectedOption = option
+			break
+		}
+	}
+
+	if selectedOption == nil {
+		common.FollowUpWithError(s, i, fmt.Sprintf("No option found with text: %s", optionText))
+		return
+	}
+	oldOdds := selectedOption.OddsMultiplier
+
+	// Update the odds - the wager message is refreshed by the odds change event
+	_, err = groupWagerService.UpdateHouseWagerOdds(ctx, groupWagerID, &updaterID, map[int64]float64{selectedOption.ID: odds})
+	if err != nil {
+		log.Printf("Error updating house wager odds: %v", err)
+		common.FollowUpWithError(s, i, common.DescribeError("Failed to update odds", err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Printf("Error committing transaction: %v", err)
+		common.FollowUpWithError(s, i, "Failed to save odds.")
+		return
+	}
+
+	message := fmt.Sprintf(
+		"**Odds Updated**\n\nWager #%d: %s\n%s: %.2fx → %.2fx\nExisting bets keep the odds they were placed at.",
+		groupWagerID,
+		strings.SplitN(wagerDetail.Wager.Condition, "\n", 2)[0],
+		optionText,
+		oldOdds,
+		odds,
+	)
+
+	_, err = s.FollowupMessageCreate(i.Interaction, false, &discordgo.WebhookParams{
+		Content: message,
+	})
+	if err != nil {
+		log.Printf("Error sending set odds message: %v", err)
+	}
+}
+
+// handleGroupWagerLinkMatch handles the /groupwager link-match subcommand
+func (f *Feature) handleGroupWagerLinkMatch(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := common.WithMemberRoles(context.Background(), i)
+	options := i.ApplicationCommandData().Options[0].Options
+
+	var groupWagerID int64
+	var matchID string
+
+	for _, opt := range options {
+		switch opt.Name {
+		case "id":
+			groupWagerID = opt.IntValue()
+		case "match_id":
+			matchID = strings.TrimSpace(opt.StringValue())
+		}
+	}
+
+	// Get linker ID
+	linkerID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing linker ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing guild ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	// Create unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Printf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate group wager service with repositories from UnitOfWork
+	groupWagerService := uow.Services().GroupWagerService()
+
+	ref := entities.ExternalReference{System: entities.SystemEsports, ID: matchID}
+	wager, err := groupWagerService.LinkExternalReference(ctx, groupWagerID, linkerID, ref)
+	if err != nil {
+		log.Printf("Error linking group wager to external match: %v", err)
+		common.RespondWithError(s, i, common.DescribeError("Failed to link wager", err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Printf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to save link.")
+		return
+	}
+
+	message := fmt.Sprintf(
+		"Wager #%d: %s is now linked to match `%s` and will resolve automatically when its result is reported. The reported winner must match an option's text.",
+		groupWagerID,
+		strings.SplitN(wager.Condition, "\n", 2)[0],
+		matchID,
+	)
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Printf("Error responding to link match command: %v", err)
+	}
+}
+
+// handleGroupWagerStats handles the /groupwager stats subcommand
+func (f *Feature) handleGroupWagerStats(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	options := i.ApplicationCommandData().Options[0].Options
+
+	// Default to the command issuer
+	targetIDStr := i.Member.User.ID
+	for _, opt := range options {
+		if opt.Name == "user" {
+			targetIDStr = opt.UserValue(s).ID
+		}
+	}
+
+	creatorID, err := strconv.ParseInt(targetIDStr, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing Discord ID %s: %v", targetIDStr, err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing guild ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	// Create unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Printf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	stats, err := uow.Services().GroupWagerService().GetCreatorStats(ctx, creatorID)
+	if err != nil {
+		log.Printf("Error getting group wager creator stats for %d: %v", creatorID, err)
+		common.RespondWithError(s, i, "Unable to retrieve group wager statistics. Please try again.")
+		return
+	}
+
+	embed := CreateCreatorStatsEmbed(stats, common.GetDisplayNameInt64(s, i.GuildID, creatorID))
+	if err := common.RespondWithEmbed(s, i, embed, nil, false); err != nil {
+		log.Printf("Error responding to group wager stats command: %v", err)
+	}
+}
+
+// handleGroupWagerButtonInteraction handles a bettor choosing an option from a button or the option menu
+func (f *Feature) handleGroupWagerButtonInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	data := i.MessageComponentData()
+	groupWagerID, optionID, err := parseBetOptionSelection(data)
+	if err != nil {
+		log.Errorf("Error parsing bet option from %s %v: %v", data.CustomID, data.Values, err)
+		return
+	}
+
+	// Show modal for bet amount
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: fmt.Sprintf("group_wager_bet_%d_%d", groupWagerID, optionID),
+			Title:    "Place Your Bet",
 			Components: []discordgo.MessageComponent{
 				discordgo.ActionsRow{
 					Components: []discordgo.MessageComponent{
@@ -588,7 +1236,7 @@ func (f *Feature) handleGroupWagerButtonInteraction(s *discordgo.Session, i *dis
 							CustomID:    "amount",
 							Label:       "Bet Amount (in bits)",
 							Style:       discordgo.TextInputShort,
-							Placeholder: "1000",
+							Placeholder: "1000, all, half or 25%",
 							Required:    true,
 							MaxLength:   10,
 						},
@@ -602,14 +1250,320 @@ func (f *Feature) handleGroupWagerButtonInteraction(s *discordgo.Session, i *dis
 	}
 }
 
-// handleGroupWagerBetModal handles the bet amount modal submission
-func (f *Feature) handleGroupWagerBetModal(s *discordgo.Session, i *discordgo.InteractionCreate) {
+// parseBetOptionSelection reads the wager and option a bettor chose. Bet buttons use the custom ID
+// group_wager_option_<wager_id>_<option_id>; the option menu uses group_wager_option_select_<wager_id>
+// with the option ID as the selected value.
+func parseBetOptionSelection(data discordgo.MessageComponentInteractionData) (groupWagerID, optionID int64, err error) {
+	if rest, ok := strings.CutPrefix(data.CustomID, "group_wager_option_select_"); ok {
+		if len(data.Values) != 1 {
+			return 0, 0, fmt.Errorf("expected one selected option, got %d", len(data.Values))
+		}
+		if groupWagerID, err = strconv.ParseInt(rest, 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid group wager ID: %w", err)
+		}
+		if optionID, err = strconv.ParseInt(data.Values[0], 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid option ID: %w", err)
+		}
+		return groupWagerID, optionID, nil
+	}
+
+	parts := strings.Split(data.CustomID, "_")
+	if len(parts) != 5 || parts[0] != "group" || parts[1] != "wager" || parts[2] != "option" {
+		return 0, 0, errors.New("unrecognised bet option custom ID")
+	}
+	if groupWagerID, err = strconv.ParseInt(parts[3], 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid group wager ID: %w", err)
+	}
+	if optionID, err = strconv.ParseInt(parts[4], 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid option ID: %w", err)
+	}
+	return groupWagerID, optionID, nil
+}
+
+// handleGroupWagerBetModal previews the bet from the amount modal and asks the user to confirm it
+func (f *Feature) handleGroupWagerBetModal(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := common.WithMemberRoles(context.Background(), i)
+	data := i.ModalSubmitData()
+
+	// Parse custom ID: group_wager_bet_<wager_id>_<option_id>
+	parts := strings.Split(data.CustomID, "_")
+	if len(parts) != 5 {
+		return
+	}
+
+	groupWagerID, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return
+	}
+
+	optionID, err := strconv.ParseInt(parts[4], 10, 64)
+	if err != nil {
+		return
+	}
+
+	// Get amount from modal
+	var amountStr string
+	for _, comp := range data.Components {
+		row := comp.(*discordgo.ActionsRow)
+		for _, innerComp := range row.Components {
+			textInput := innerComp.(*discordgo.TextInput)
+			if textInput.CustomID == "amount" {
+				amountStr = strings.TrimSpace(textInput.Value)
+			}
+		}
+	}
+
+	amount, err := entities.ParseAmount(amountStr)
+	if err != nil {
+		common.RespondWithError(s, i, fmt.Sprintf("Invalid bet amount: %v.", err))
+		return
+	}
+
+	// Get user ID
+	userID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	// Parse guild ID
+	guildIDInt, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing guild ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	// Create unit of work
+	uow := f.uowFactory.CreateForGuild(guildIDInt)
+	if err := uow.Begin(ctx); err != nil {
+		log.Printf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate services with repositories from UnitOfWork
+	userService := uow.Services().UserService()
+	groupWagerService := uow.Services().GroupWagerService()
+
+	// Ensure user is present in the database.
+	_, err = userService.GetOrCreateUser(ctx, guildIDInt, userID, i.Member.User.Username)
+	if err != nil {
+		common.RespondWithError(s, i, "Unable to get user from DB")
+		return
+	}
+
+	preview, err := groupWagerService.PreviewBet(ctx, groupWagerID, userID, optionID, amount)
+	if err != nil {
+		log.Errorf("Error previewing bet: %v", err)
+		common.RespondWithError(s, i, common.DescribeError("Failed to place bet", err))
+
+		// Check if the error is due to voting period expiration
+		if errors.Is(err, entities.ErrVotingEnded) {
+			// Update the message to reflect the expired state
+			f.updateGroupWagerMessage(s, i.Message, groupWagerID, guildIDInt)
+		}
+		return
+	}
+
+	// Commit the user if they were just created
+	if err := uow.Commit(); err != nil {
+		log.Printf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	// The bet is held until the user confirms it or the preview times out
+	key := i.ID
+	modal := i.Interaction
+	f.pendingBets.add(key, &pendingBet{
+		groupWagerID: groupWagerID,
+		optionID:     optionID,
+		userID:       userID,
+		amount:       amount,
+	}, BetConfirmationTimeout, func() {
+		finishBetConfirmation(s, modal, "⌛ Bet confirmation timed out. No bits were placed.")
+	})
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content:    formatBetPreview(preview),
+			Components: CreateBetConfirmButtons(key),
+			Flags:      discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Errorf("Error showing bet confirmation: %v", err)
+		f.pendingBets.take(key)
+	}
+}
+
+// formatBetPreview describes what the previewed bet pays at the current odds
+func formatBetPreview(preview *entities.GroupWagerBetPreview) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("**Confirm your bet on %s**\n", preview.Wager.Condition))
+	sb.WriteString(fmt.Sprintf("Option: **%s**\n", preview.Option.OptionText))
+	sb.WriteString(fmt.Sprintf("Bet: **%s** bits\n", common.FormatBalance(preview.Amount)))
+	sb.WriteString(fmt.Sprintf("Current multiplier: **%.2fx**\n", preview.Multiplier))
+	sb.WriteString(fmt.Sprintf("Potential payout: **%s** bits\n", common.FormatBalance(preview.PotentialPayout)))
+	sb.WriteString(fmt.Sprintf("Available balance: **%s** bits", common.FormatBalance(preview.AvailableBalance)))
+	if preview.PreviousAmount > 0 {
+		sb.WriteString(fmt.Sprintf("\nThis replaces your current bet of **%s** bits.", common.FormatBalance(preview.PreviousAmount)))
+	}
+	if preview.Wager.IsPoolWager() {
+		sb.WriteString("\n-# Pool odds keep moving until voting ends.")
+	}
+	sb.WriteString(fmt.Sprintf("\n-# Expires in %d seconds.", int(BetConfirmationTimeout.Seconds())))
+	return sb.String()
+}
+
+// finishBetConfirmation replaces a bet preview with its outcome and removes the buttons
+func finishBetConfirmation(s *discordgo.Session, interaction *discordgo.Interaction, content string) {
+	noComponents := []discordgo.MessageComponent{}
+	if _, err := s.InteractionResponseEdit(interaction, &discordgo.WebhookEdit{
+		Content:    &content,
+		Components: &noComponents,
+	}); err != nil {
+		log.Errorf("Error updating bet confirmation: %v", err)
+	}
+}
+
+// handleGroupWagerBetCancel drops a previewed bet without placing it
+func (f *Feature) handleGroupWagerBetCancel(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	f.pendingBets.take(strings.TrimPrefix(i.MessageComponentData().CustomID, "group_wager_bet_cancel_"))
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    "Bet cancelled. No bits were placed.",
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+	if err != nil {
+		log.Errorf("Error cancelling bet confirmation: %v", err)
+	}
+}
+
+// handleGroupWagerBetConfirm places a previewed bet once the user confirms it
+func (f *Feature) handleGroupWagerBetConfirm(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := common.WithMemberRoles(context.Background(), i)
+
+	// Acknowledge the click; the ephemeral preview is edited with the outcome
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	})
+	if err != nil {
+		log.Errorf("Error deferring bet confirmation: %v", err)
+		return
+	}
+
+	bet := f.pendingBets.take(strings.TrimPrefix(i.MessageComponentData().CustomID, "group_wager_bet_confirm_"))
+	if bet == nil {
+		finishBetConfirmation(s, i.Interaction, "⌛ This bet confirmation has expired. Place the bet again to see the latest odds.")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID: %v", err)
+		finishBetConfirmation(s, i.Interaction, "❌ Unable to process request.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		finishBetConfirmation(s, i.Interaction, "❌ Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	groupWagerService := uow.Services().GroupWagerService()
+
+	// The wager state and the user's balance may have changed since the preview, so relative amounts are
+	// resolved again and the bet re-checked as it is placed
+	participant, err := groupWagerService.PlaceBetWithAmount(ctx, bet.groupWagerID, bet.userID, bet.optionID, bet.amount)
+	if err != nil {
+		log.Errorf("Error placing bet: %v", err)
+		finishBetConfirmation(s, i.Interaction, fmt.Sprintf("❌ Failed to place bet: %v", err))
+		return
+	}
+
+	detail, err := groupWagerService.GetGroupWagerDetail(ctx, bet.groupWagerID)
+	if err != nil {
+		log.Errorf("Error getting group wager detail: %v", err)
+		// Continue with the rest of the flow even if we can't get updated details
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Printf("Error committing transaction: %v", err)
+		finishBetConfirmation(s, i.Interaction, "❌ Failed to save bet.")
+		return
+	}
+
+	finishBetConfirmation(s, i.Interaction, fmt.Sprintf("Successfully placed a bet of %s bits!", common.FormatBalance(participant.Amount)))
+
+	// Update the wager message the bet was placed from
+	if detail != nil && detail.Wager.MessageID != 0 {
+		f.updateGroupWagerMessage(s, &discordgo.Message{
+			ID:        strconv.FormatInt(detail.Wager.MessageID, 10),
+			ChannelID: strconv.FormatInt(detail.Wager.ChannelID, 10),
+		}, bet.groupWagerID, guildID)
+	}
+}
+
+// handleGroupWagerWithdrawButton shows the modal for reducing or withdrawing a bet
+func (f *Feature) handleGroupWagerWithdrawButton(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	customID := i.MessageComponentData().CustomID
+
+	// Parse custom ID: group_wager_withdraw_<wager_id>
+	parts := strings.Split(customID, "_")
+	if len(parts) != 4 {
+		return
+	}
+
+	groupWagerID, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing group wager ID from %s: %v", parts[3], err)
+		return
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: fmt.Sprintf("group_wager_reduce_%d", groupWagerID),
+			Title:    "Reduce or Withdraw Your Bet",
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "amount",
+							Label:       "New Bet Amount (0 to withdraw)",
+							Style:       discordgo.TextInputShort,
+							Placeholder: "0",
+							Required:    true,
+							MaxLength:   10,
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		log.Errorf("Error showing withdraw modal: %v", err)
+	}
+}
+
+// handleGroupWagerWithdrawModal handles the reduce/withdraw modal submission
+func (f *Feature) handleGroupWagerWithdrawModal(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	ctx := context.Background()
 	data := i.ModalSubmitData()
 
-	// Parse custom ID: group_wager_bet_<wager_id>_<option_id>
+	// Parse custom ID: group_wager_reduce_<wager_id>
 	parts := strings.Split(data.CustomID, "_")
-	if len(parts) != 5 {
+	if len(parts) != 4 {
 		return
 	}
 
@@ -618,11 +1572,6 @@ func (f *Feature) handleGroupWagerBetModal(s *discordgo.Session, i *discordgo.In
 		return
 	}
 
-	optionID, err := strconv.ParseInt(parts[4], 10, 64)
-	if err != nil {
-		return
-	}
-
 	// Get amount from modal
 	var amountStr string
 	for _, comp := range data.Components {
@@ -635,29 +1584,25 @@ func (f *Feature) handleGroupWagerBetModal(s *discordgo.Session, i *discordgo.In
 		}
 	}
 
-	amount, err := strconv.ParseInt(amountStr, 10, 64)
-	if err != nil || amount <= 0 {
-		common.RespondWithError(s, i, "Please enter a valid positive amount.")
+	newAmount, err := strconv.ParseInt(amountStr, 10, 64)
+	if err != nil || newAmount < 0 {
+		common.RespondWithError(s, i, "Please enter a valid amount, or 0 to withdraw.")
 		return
 	}
 
-	// Get user ID
 	userID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
 	if err != nil {
 		common.RespondWithError(s, i, "Unable to process request.")
 		return
 	}
-	// Parse guild ID
-	guildIDInt, err := strconv.ParseInt(i.GuildID, 10, 64)
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
 	if err != nil {
-		log.Info("Guild ID parsing failed here")
 		log.Printf("Error parsing guild ID: %v", err)
 		common.RespondWithError(s, i, "Unable to process request.")
 		return
 	}
 
-	// Create unit of work
-	uow := f.uowFactory.CreateForGuild(guildIDInt)
+	uow := f.uowFactory.CreateForGuild(guildID)
 	if err := uow.Begin(ctx); err != nil {
 		log.Printf("Error beginning transaction: %v", err)
 		common.RespondWithError(s, i, "Unable to process request.")
@@ -665,62 +1610,40 @@ func (f *Feature) handleGroupWagerBetModal(s *discordgo.Session, i *discordgo.In
 	}
 	defer uow.Rollback()
 
-	// Instantiate services with repositories from UnitOfWork
-	userService := services.NewUserService(
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
-	groupWagerService := services.NewGroupWagerService(
-		uow.GroupWagerRepository(),
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
-
-	// Ensure user is present in the database.
-	_, err = userService.GetOrCreateUser(ctx, userID, i.Member.User.Username)
-	if err != nil {
-		common.RespondWithError(s, i, "Unable to get user from DB")
-		return
-	}
+	groupWagerService := uow.Services().GroupWagerService()
 
-	// Place the bet
-	_, err = groupWagerService.PlaceBet(ctx, groupWagerID, userID, optionID, amount)
-	if err != nil {
-		log.Errorf("Error placing bet: %v", err)
-		common.RespondWithError(s, i, fmt.Sprintf("Failed to place bet: %v", err))
+	if _, err := groupWagerService.WithdrawBet(ctx, groupWagerID, userID, newAmount); err != nil {
+		log.Errorf("Error withdrawing bet: %v", err)
+		common.RespondWithError(s, i, common.DescribeError("Failed to update bet", err))
 
-		// Check if the error is due to voting period expiration
-		if strings.Contains(err.Error(), "voting period has ended") {
-			// Update the message to reflect the expired state
-			f.updateGroupWagerMessage(s, i.Message, groupWagerID, guildIDInt)
-			return
+		if errors.Is(err, entities.ErrVotingEnded) {
+			f.updateGroupWagerMessage(s, i.Message, groupWagerID, guildID)
 		}
 		return
 	}
 
-	// Commit the transaction
 	if err := uow.Commit(); err != nil {
 		log.Printf("Error committing transaction: %v", err)
 		common.RespondWithError(s, i, "Failed to save bet.")
 		return
 	}
 
-	// Respond with success
+	content := fmt.Sprintf("Your bet has been reduced to %s bits.", common.FormatBalance(newAmount))
+	if newAmount == 0 {
+		content = "Your bet has been withdrawn."
+	}
 	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
 		Data: &discordgo.InteractionResponseData{
-			Content: fmt.Sprintf("Successfully placed a bet of %s bits!", common.FormatBalance(amount)),
+			Content: content,
 			Flags:   discordgo.MessageFlagsEphemeral,
 		},
 	})
 	if err != nil {
-		log.Errorf("Error responding to bet: %v", err)
+		log.Errorf("Error responding to withdraw: %v", err)
 	}
 
-	// Update the original message
-	f.updateGroupWagerMessage(s, i.Message, groupWagerID, guildIDInt)
+	f.updateGroupWagerMessage(s, i.Message, groupWagerID, guildID)
 }
 
 // updateGroupWagerMessage updates a group wager message with current state
@@ -738,12 +1661,7 @@ func (f *Feature) updateGroupWagerMessage(s *discordgo.Session, msg *discordgo.M
 	defer uow.Rollback()
 
 	// Instantiate group wager service with repositories from UnitOfWork
-	groupWagerService := services.NewGroupWagerService(
-		uow.GroupWagerRepository(),
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	groupWagerService := uow.Services().GroupWagerService()
 
 	// Get updated wager details
 	detail, err := groupWagerService.GetGroupWagerDetail(ctx, groupWagerID)
@@ -753,8 +1671,7 @@ func (f *Feature) updateGroupWagerMessage(s *discordgo.Session, msg *discordgo.M
 	}
 
 	// Update the message
-	embed := CreateGroupWagerEmbed(detail)
-	components := CreateGroupWagerComponents(detail)
+	embed, components := f.renderGroupWager(ctx, guildID, detail)
 
 	_, err = s.ChannelMessageEditComplex(&discordgo.MessageEdit{
 		Channel:    msg.ChannelID,
@@ -766,3 +1683,380 @@ func (f *Feature) updateGroupWagerMessage(s *discordgo.Session, msg *discordgo.M
 		log.Errorf("Error updating group wager message: %v", err)
 	}
 }
+
+// handleGroupWagerResolveButton shows resolvers a menu of the wager's options to pick the winner from
+func (f *Feature) handleGroupWagerResolveButton(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := common.WithMemberRoles(context.Background(), i)
+
+	// Parse custom ID: group_wager_resolve_<wager_id>
+	groupWagerID, err := strconv.ParseInt(strings.TrimPrefix(i.MessageComponentData().CustomID, "group_wager_resolve_"), 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing group wager ID from %s: %v", i.MessageComponentData().CustomID, err)
+		return
+	}
+
+	resolverID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing resolver ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	groupWagerService := uow.Services().GroupWagerService()
+
+	isResolver, err := groupWagerService.IsResolver(ctx, resolverID)
+	if err != nil {
+		log.Errorf("Error checking resolver permissions: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	if !isResolver {
+		common.RespondWithError(s, i, "Only resolvers can resolve group wagers.")
+		return
+	}
+
+	detail, err := groupWagerService.GetGroupWagerDetail(ctx, groupWagerID)
+	if err != nil {
+		log.Errorf("Error getting group wager detail: %v", err)
+		common.RespondWithError(s, i, "Failed to get wager details.")
+		return
+	}
+	if !detail.Wager.IsPendingResolution() {
+		common.RespondWithError(s, i, "This wager is no longer awaiting resolution.")
+		return
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content:    fmt.Sprintf("Which option won **%s**?", detail.Wager.Condition),
+			Components: CreateResolveOptionSelect(detail),
+			Flags:      discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Errorf("Error showing resolve menu: %v", err)
+	}
+}
+
+// handleGroupWagerResolveSelect asks the resolver to confirm the winning option they picked
+func (f *Feature) handleGroupWagerResolveSelect(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	data := i.MessageComponentData()
+
+	// Parse custom ID: group_wager_resolve_select_<wager_id>
+	groupWagerID, err := strconv.ParseInt(strings.TrimPrefix(data.CustomID, "group_wager_resolve_select_"), 10, 64)
+	if err != nil || len(data.Values) != 1 {
+		log.Errorf("Invalid resolve selection %s: %v", data.CustomID, data.Values)
+		return
+	}
+
+	optionID, err := strconv.ParseInt(data.Values[0], 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing option ID from %s: %v", data.Values[0], err)
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	groupWagerService := uow.Services().GroupWagerService()
+
+	detail, err := groupWagerService.GetGroupWagerDetail(ctx, groupWagerID)
+	if err != nil {
+		log.Errorf("Error getting group wager detail: %v", err)
+		common.RespondWithError(s, i, "Failed to get wager details.")
+		return
+	}
+
+	var winningOption *entities.GroupWagerOption
+	for _, option := range detail.Options {
+		if option.ID == optionID {
+			winningOption = option
+			break
+		}
+	}
+	if winningOption == nil {
+		common.RespondWithError(s, i, "That option no longer exists.")
+		return
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("Resolve **%s** with **%s** as the winner? Payouts can't be undone.",
+				detail.Wager.Condition, winningOption.OptionText),
+			Components: CreateResolveConfirmButtons(groupWagerID, optionID),
+		},
+	})
+	if err != nil {
+		log.Errorf("Error showing resolve confirmation: %v", err)
+	}
+}
+
+// handleGroupWagerResolveCancel dismisses the resolve menu without resolving
+func (f *Feature) handleGroupWagerResolveCancel(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    "Resolution cancelled.",
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+	if err != nil {
+		log.Errorf("Error cancelling resolve menu: %v", err)
+	}
+}
+
+// handleGroupWagerResolveConfirm resolves the wager with the confirmed winning option
+func (f *Feature) handleGroupWagerResolveConfirm(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := common.WithMemberRoles(context.Background(), i)
+
+	// Parse custom ID: group_wager_resolve_confirm_<wager_id>_<option_id>
+	parts := strings.Split(strings.TrimPrefix(i.MessageComponentData().CustomID, "group_wager_resolve_confirm_"), "_")
+	if len(parts) != 2 {
+		return
+	}
+
+	groupWagerID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing group wager ID from %s: %v", parts[0], err)
+		return
+	}
+
+	optionID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing option ID from %s: %v", parts[1], err)
+		return
+	}
+
+	resolverID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing resolver ID: %v", err)
+		return
+	}
+
+	// Acknowledge the click; the ephemeral prompt is edited with the outcome
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	})
+	if err != nil {
+		log.Errorf("Error deferring resolve confirmation: %v", err)
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID: %v", err)
+		common.UpdateMessageWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.UpdateMessageWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	groupWagerService := uow.Services().GroupWagerService()
+
+	// The service re-checks resolver permissions and the wager state
+	result, err := groupWagerService.ResolveGroupWager(ctx, groupWagerID, &resolverID, optionID)
+	if err != nil {
+		log.Errorf("Error resolving group wager %d: %v", groupWagerID, err)
+		common.UpdateMessageWithError(s, i, common.DescribeError("Failed to resolve wager", err))
+		return
+	}
+
+	updatedDetail, err := groupWagerService.GetGroupWagerDetail(ctx, groupWagerID)
+	if err != nil {
+		log.Errorf("Error getting updated group wager detail: %v", err)
+		// Continue with the rest of the flow even if we can't get updated details
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.UpdateMessageWithError(s, i, "Failed to save resolution.")
+		return
+	}
+
+	content := fmt.Sprintf("✅ Resolved with **%s** as the winner.", result.WinningOption.OptionText)
+	noComponents := []discordgo.MessageComponent{}
+	if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content:    &content,
+		Components: &noComponents,
+	}); err != nil {
+		log.Errorf("Error updating resolve confirmation: %v", err)
+	}
+
+	if _, err := s.ChannelMessageSend(i.ChannelID, formatResolutionMessage(result)); err != nil {
+		log.Errorf("Error sending resolve message: %v", err)
+	}
+
+	postSettlementBreakdown(s, i.ChannelID, result)
+
+	refreshResolvedWagerMessage(s, result, updatedDetail)
+}
+
+// handleGroupWagerAccessSelect restricts betting to the role or users the creator picked from the access menu
+func (f *Feature) handleGroupWagerAccessSelect(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	data := i.MessageComponentData()
+
+	// Parse custom ID: group_wager_access_role_<wager_id> or group_wager_access_users_<wager_id>
+	byRole := strings.HasPrefix(data.CustomID, "group_wager_access_role_")
+	idText := strings.TrimPrefix(strings.TrimPrefix(data.CustomID, "group_wager_access_role_"), "group_wager_access_users_")
+	groupWagerID, err := strconv.ParseInt(idText, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing group wager ID from %s: %v", data.CustomID, err)
+		return
+	}
+
+	selected := make([]int64, 0, len(data.Values))
+	for _, value := range data.Values {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			log.Errorf("Error parsing access selection %s: %v", value, err)
+			common.RespondWithError(s, i, "Unable to process request.")
+			return
+		}
+		selected = append(selected, id)
+	}
+
+	creatorID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing creator ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	groupWagerService := uow.Services().GroupWagerService()
+
+	detail, err := groupWagerService.GetGroupWagerDetail(ctx, groupWagerID)
+	if err != nil {
+		log.Errorf("Error getting group wager detail: %v", err)
+		common.RespondWithError(s, i, "Failed to get wager details.")
+		return
+	}
+
+	// Each menu only changes its own half of the access, so picking a role keeps the invited users and vice versa
+	access := detail.Wager.Access()
+	if byRole {
+		access.AllowedRoleID = nil
+		if len(selected) > 0 {
+			access.AllowedRoleID = &selected[0]
+		}
+	} else {
+		access.InvitedDiscordIDs = selected
+	}
+
+	wager, err := groupWagerService.SetAccess(ctx, groupWagerID, creatorID, access)
+	if err != nil {
+		common.RespondWithError(s, i, common.DescribeError("Failed to update who can bet", err))
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to save who can bet.")
+		return
+	}
+
+	content := "Anyone can bet on this wager."
+	if wager.Access().IsInviteOnly() {
+		content = "Only invited users can bet on this wager: " + formatWagerInvitees(wager.Access())
+	}
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:         content,
+			Components:      CreateAccessSelects(groupWagerID),
+			AllowedMentions: &discordgo.MessageAllowedMentions{},
+		},
+	})
+	if err != nil {
+		log.Errorf("Error updating group wager access menu: %v", err)
+	}
+
+	// Show the restriction on the wager message
+	if detail.Wager.MessageID != 0 && detail.Wager.ChannelID != 0 {
+		wagerMessage := &discordgo.Message{
+			ID:        strconv.FormatInt(detail.Wager.MessageID, 10),
+			ChannelID: strconv.FormatInt(detail.Wager.ChannelID, 10),
+		}
+		f.updateGroupWagerMessage(s, wagerMessage, groupWagerID, guildID)
+	}
+}
+
+// resolveOptionEmoji turns an option's :name: emoji shortcode into the guild's custom emoji, since modal text
+// inputs only give us what the user typed
+func resolveOptionEmoji(s *discordgo.Session, guildID string, line entities.GroupWagerOptionLine) (entities.GroupWagerOptionLine, error) {
+	if !entities.IsEmojiShortcode(line.Emoji) {
+		return line, nil
+	}
+
+	var emojis []*discordgo.Emoji
+	if guild, err := s.State.Guild(guildID); err == nil {
+		emojis = guild.Emojis
+	} else {
+		emojis, err = s.GuildEmojis(guildID)
+		if err != nil {
+			log.Printf("Error getting emojis for guild %s: %v", guildID, err)
+			return line, fmt.Errorf("unable to look up server emojis, please try again")
+		}
+	}
+
+	name := strings.Trim(line.Emoji, ":")
+	for _, emoji := range emojis {
+		if emoji.Name == name {
+			line.Emoji = emoji.MessageFormat()
+			return line, nil
+		}
+	}
+
+	return line, fmt.Errorf("unknown emoji %s for option '%s', use a unicode emoji or one of this server's emojis", line.Emoji, line.Text)
+}
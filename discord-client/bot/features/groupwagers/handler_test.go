@@ -0,0 +1,193 @@
+package groupwagers
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBetOptionSelection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		data         discordgo.MessageComponentInteractionData
+		wantWagerID  int64
+		wantOptionID int64
+		wantErr      bool
+	}{
+		{
+			name:         "button",
+			data:         discordgo.MessageComponentInteractionData{CustomID: "group_wager_option_42_7"},
+			wantWagerID:  42,
+			wantOptionID: 7,
+		},
+		{
+			name:         "menu",
+			data:         discordgo.MessageComponentInteractionData{CustomID: "group_wager_option_select_42", Values: []string{"7"}},
+			wantWagerID:  42,
+			wantOptionID: 7,
+		},
+		{
+			name:    "menu without a selection",
+			data:    discordgo.MessageComponentInteractionData{CustomID: "group_wager_option_select_42"},
+			wantErr: true,
+		},
+		{
+			name:    "menu with an invalid option",
+			data:    discordgo.MessageComponentInteractionData{CustomID: "group_wager_option_select_42", Values: []string{"yes"}},
+			wantErr: true,
+		},
+		{
+			name:    "button missing the option",
+			data:    discordgo.MessageComponentInteractionData{CustomID: "group_wager_option_42"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			groupWagerID, optionID, err := parseBetOptionSelection(tt.data)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantWagerID, groupWagerID)
+			assert.Equal(t, tt.wantOptionID, optionID)
+		})
+	}
+}
+
+func TestParseOptionNumbers(t *testing.T) {
+	t.Parallel()
+
+	numbers, err := parseOptionNumbers("3, 1,2")
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 1, 2}, numbers)
+
+	numbers, err = parseOptionNumbers("2 1")
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 1}, numbers)
+
+	_, err = parseOptionNumbers(" , ")
+	assert.Error(t, err)
+
+	_, err = parseOptionNumbers("1,two")
+	assert.ErrorContains(t, err, `"two"`)
+
+	_, err = parseOptionNumbers("0,1")
+	assert.Error(t, err)
+}
+
+func TestParseMessageReference(t *testing.T) {
+	t.Parallel()
+
+	channelID, messageID, err := parseMessageReference("https://discord.com/channels/1/22/333", "1", "9")
+	require.NoError(t, err)
+	assert.Equal(t, "22", channelID)
+	assert.Equal(t, "333", messageID)
+
+	channelID, messageID, err = parseMessageReference(" 333 ", "1", "9")
+	require.NoError(t, err)
+	assert.Equal(t, "9", channelID)
+	assert.Equal(t, "333", messageID)
+
+	_, _, err = parseMessageReference("https://ptb.discord.com/channels/2/22/333", "1", "9")
+	assert.ErrorContains(t, err, "another server")
+
+	_, _, err = parseMessageReference("not a message", "1", "9")
+	assert.Error(t, err)
+}
+
+func TestParseReactModalID(t *testing.T) {
+	t.Parallel()
+
+	channelID, messageID, stake, err := parseReactModalID("group_wager_react_modal_22_333_250")
+	require.NoError(t, err)
+	assert.Equal(t, int64(22), channelID)
+	assert.Equal(t, int64(333), messageID)
+	assert.Equal(t, int64(250), stake)
+
+	_, _, _, err = parseReactModalID("group_wager_react_modal_22_333")
+	assert.Error(t, err)
+}
+
+func TestOptionForReaction(t *testing.T) {
+	t.Parallel()
+
+	thumbsUp := "👍"
+	custom := "<:pog:42>"
+	detail := &entities.GroupWagerDetail{
+		Options: []*entities.GroupWagerOption{
+			{ID: 1, OptionOrder: 0, ButtonEmoji: &thumbsUp},
+			{ID: 2, OptionOrder: 1, ButtonEmoji: &custom},
+			{ID: 3, OptionOrder: 2},
+		},
+	}
+
+	assert.Equal(t, int64(1), optionForReaction(detail, discordgo.Emoji{Name: "👍"}).ID)
+	assert.Equal(t, int64(2), optionForReaction(detail, discordgo.Emoji{Name: "pog", ID: "42"}).ID)
+	assert.Equal(t, int64(3), optionForReaction(detail, discordgo.Emoji{Name: "3️⃣"}).ID)
+	assert.Nil(t, optionForReaction(detail, discordgo.Emoji{Name: "pog"}))
+	assert.Nil(t, optionForReaction(detail, discordgo.Emoji{Name: "🔥"}))
+
+	assert.Equal(t, "pog:42", reactionAPIName(optionReactionEmoji(detail.Options[1])))
+}
+
+func TestParseQuickOptions(t *testing.T) {
+	t.Parallel()
+
+	options, err := parseQuickOptions(nil, "1", "Yes 👍 | No 👎 [red]|Draw")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Yes 👍", "No 👎 [danger]", "Draw"}, options)
+
+	_, err = parseQuickOptions(nil, "1", "Yes|yes")
+	assert.ErrorContains(t, err, "Duplicate")
+
+	_, err = parseQuickOptions(nil, "1", "Yes|")
+	assert.ErrorContains(t, err, "at least 2")
+}
+
+func TestParseQuickOdds(t *testing.T) {
+	t.Parallel()
+
+	odds, err := parseQuickOdds("1.8| 2.1", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1.8, 2.1}, odds)
+
+	_, err = parseQuickOdds("1.8", 2)
+	assert.ErrorContains(t, err, "one odds multiplier per option")
+
+	_, err = parseQuickOdds("1.8|evens", 2)
+	assert.ErrorContains(t, err, `"evens"`)
+
+	_, err = parseQuickOdds("1.8|0.5", 2)
+	assert.Error(t, err)
+}
+
+func TestFormatCorrectionMessage(t *testing.T) {
+	t.Parallel()
+
+	result := &entities.GroupWagerResult{
+		GroupWager:    &entities.GroupWager{Condition: "Will it rain?"},
+		WinningOption: &entities.GroupWagerOption{ID: 2, OptionText: "No"},
+		Winners:       []*entities.GroupWagerParticipant{{DiscordID: 42, OptionID: 2, Amount: 1000}},
+		PayoutDetails: map[int64]int64{42: 2000},
+		TotalPot:      2000,
+	}
+
+	message := formatCorrectionMessage(result, &entities.GroupWagerOption{ID: 1, OptionText: "Yes"})
+	assert.Contains(t, message, "**Group Wager Resolution Corrected!**")
+	assert.NotContains(t, message, "**Group Wager Resolved!**")
+	assert.Contains(t, message, "Winning Option: No")
+	assert.Contains(t, message, "<@42> won")
+	assert.Contains(t, message, "Payouts for **Yes** were reversed.")
+}
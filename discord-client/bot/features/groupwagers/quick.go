@@ -0,0 +1,164 @@
+package groupwagers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// quickOptionSeparator separates the options and odds of /groupwager quick
+const quickOptionSeparator = "|"
+
+// parseQuickOptions parses inline options such as "Yes 👍|No 👎", with the same emoji and [color] syntax as the modal
+func parseQuickOptions(s *discordgo.Session, guildID string, optionsText string) ([]string, error) {
+	return parseWagerOptions(s, guildID, strings.ReplaceAll(optionsText, quickOptionSeparator, "\n"))
+}
+
+// parseQuickOdds parses inline house odds such as "1.8|2.1", one multiplier per option
+func parseQuickOdds(oddsText string, optionCount int) ([]float64, error) {
+	parts := strings.Split(oddsText, quickOptionSeparator)
+	if len(parts) != optionCount {
+		return nil, fmt.Errorf("Give one odds multiplier per option (%d), separated by %s.", optionCount, quickOptionSeparator)
+	}
+
+	odds := make([]float64, len(parts))
+	for idx, part := range parts {
+		multiplier, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || multiplier <= 1 {
+			return nil, fmt.Errorf("Odds %q must be a number greater than 1, such as 1.8.", strings.TrimSpace(part))
+		}
+		odds[idx] = multiplier
+	}
+	return odds, nil
+}
+
+// handleGroupWagerQuick handles the /groupwager quick subcommand, creating a wager from inline arguments
+// without the modal
+func (f *Feature) handleGroupWagerQuick(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := common.WithMemberRoles(context.Background(), i)
+
+	var condition, optionsText, periodText, oddsText string
+	wagerType := entities.GroupWagerTypePool
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		switch opt.Name {
+		case "condition":
+			condition = strings.TrimSpace(opt.StringValue())
+		case "options":
+			optionsText = opt.StringValue()
+		case "period":
+			periodText = strings.TrimSpace(opt.StringValue())
+		case "type":
+			wagerType = entities.GroupWagerType(opt.StringValue())
+		case "odds":
+			oddsText = opt.StringValue()
+		}
+	}
+
+	// Argument mistakes are answered privately before anything is posted
+	options, err := parseQuickOptions(s, i.GuildID, optionsText)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	votingPeriodMinutes, err := parseVotingPeriod(periodText)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	var oddsMultipliers []float64
+	switch wagerType {
+	case entities.GroupWagerTypeHouse:
+		if oddsText == "" {
+			common.RespondWithError(s, i, "House wagers need odds for each option, e.g. odds:1.8|2.1.")
+			return
+		}
+		if oddsMultipliers, err = parseQuickOdds(oddsText, len(options)); err != nil {
+			common.RespondWithError(s, i, err.Error())
+			return
+		}
+	case entities.GroupWagerTypePool:
+		if oddsText != "" {
+			common.RespondWithError(s, i, "Pool wagers calculate their own odds; leave odds out or use type:house.")
+			return
+		}
+	default:
+		common.RespondWithError(s, i, fmt.Sprintf("Unknown wager type %q.", wagerType))
+		return
+	}
+
+	creatorID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing creator ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing guild ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Printf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	groupWagerService := uow.Services().GroupWagerService()
+
+	// House wagers pay out from the house, so only resolvers may offer them
+	if wagerType == entities.GroupWagerTypeHouse {
+		isResolver, err := groupWagerService.IsResolver(ctx, creatorID)
+		if err != nil {
+			log.Printf("Error checking resolver permissions: %v", err)
+			common.RespondWithError(s, i, "Unable to process request.")
+			return
+		}
+		if !isResolver {
+			common.RespondWithError(s, i, "Only resolvers can create house wagers.")
+			return
+		}
+	}
+
+	if votingPeriodMinutes == 0 {
+		settings, err := uow.Services().GuildSettingsService().GetOrCreateSettings(ctx, guildID)
+		if err != nil {
+			log.Printf("Error getting guild settings: %v", err)
+			common.RespondWithError(s, i, "Unable to process request.")
+			return
+		}
+		votingPeriodMinutes = settings.GetDefaultVotingPeriodMinutes()
+	}
+
+	// Defer response while we process; the wager itself is posted to the channel through the outbox
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	})
+	if err != nil {
+		log.Printf("Error deferring quick group wager creation: %v", err)
+		return
+	}
+
+	groupWagerDetail, err := groupWagerService.CreateGroupWager(ctx, guildID, &creatorID, condition, options, votingPeriodMinutes, 0, 0, wagerType, oddsMultipliers, nil)
+	if err != nil {
+		log.Printf("Error creating quick group wager: %v", err)
+		common.FollowUpWithError(s, i, common.DescribeError("Failed to create group wager", err))
+		return
+	}
+
+	f.postCreatedGroupWager(ctx, s, i, uow, groupWagerDetail, guildID)
+}
@@ -0,0 +1,411 @@
+package groupwagers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// messageLinkPattern matches a Discord message link, capturing the guild, channel and message IDs
+var messageLinkPattern = regexp.MustCompile(`^https://(?:\w+\.)?discord(?:app)?\.com/channels/(\d+)/(\d+)/(\d+)$`)
+
+// parseMessageReference reads a message link, or a bare message ID in the current channel, returning the
+// channel and message IDs. Links to other servers are rejected.
+func parseMessageReference(reference, guildID, channelID string) (string, string, error) {
+	reference = strings.TrimSpace(reference)
+	if match := messageLinkPattern.FindStringSubmatch(reference); match != nil {
+		if match[1] != guildID {
+			return "", "", fmt.Errorf("that message is in another server")
+		}
+		return match[2], match[3], nil
+	}
+	if _, err := strconv.ParseUint(reference, 10, 64); err == nil {
+		return channelID, reference, nil
+	}
+	return "", "", fmt.Errorf("please give a message link (Copy Message Link) or message ID")
+}
+
+// optionReactionEmoji returns the emoji users react with to bet on an option: its button emoji,
+// or its number when it has none
+func optionReactionEmoji(option *entities.GroupWagerOption) *discordgo.ComponentEmoji {
+	emoji := ""
+	if option.ButtonEmoji != nil {
+		emoji = *option.ButtonEmoji
+	}
+	return common.OptionButtonEmoji(emoji, getNumberEmoji(option.OptionOrder+1))
+}
+
+// reactionAPIName formats an emoji the way the reactions API expects: name:id for custom emojis
+func reactionAPIName(emoji *discordgo.ComponentEmoji) string {
+	if emoji.ID != "" {
+		return emoji.Name + ":" + emoji.ID
+	}
+	return emoji.Name
+}
+
+// optionForReaction returns the option a reaction bets on, or nil if the emoji isn't one of the wager's
+func optionForReaction(detail *entities.GroupWagerDetail, reaction discordgo.Emoji) *entities.GroupWagerOption {
+	for _, option := range detail.Options {
+		emoji := optionReactionEmoji(option)
+		if emoji.ID != "" {
+			if emoji.ID == reaction.ID {
+				return option
+			}
+		} else if reaction.ID == "" && emoji.Name == reaction.Name {
+			return option
+		}
+	}
+	return nil
+}
+
+// reactionMessageURL links to the message a reaction wager takes bets on
+func reactionMessageURL(wager *entities.GroupWager) string {
+	return fmt.Sprintf("https://discord.com/channels/%d/%d/%d", wager.GuildID, *wager.ReactionChannelID, *wager.ReactionMessageID)
+}
+
+// handleGroupWagerReact handles the /groupwager react subcommand, asking for the wager details in a modal
+func (f *Feature) handleGroupWagerReact(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	var reference string
+	stake := entities.DefaultReactionStake
+	votingPeriod := ""
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		switch opt.Name {
+		case "message":
+			reference = opt.StringValue()
+		case "stake":
+			stake = opt.IntValue()
+		case "voting_period":
+			votingPeriod = opt.StringValue()
+		}
+	}
+
+	if err := entities.ValidateReactionStake(stake); err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	channelID, messageID, err := parseMessageReference(reference, i.GuildID, i.ChannelID)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+	if _, err := s.ChannelMessage(channelID, messageID); err != nil {
+		log.Printf("Error fetching reaction wager message %s/%s: %v", channelID, messageID, err)
+		common.RespondWithError(s, i, "Couldn't find that message. Make sure the bot can see its channel.")
+		return
+	}
+
+	if votingPeriod == "" {
+		votingPeriod = common.FormatDurationMinutes(f.defaultVotingPeriodMinutes(i.GuildID))
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: fmt.Sprintf("group_wager_react_modal_%s_%s_%d", channelID, messageID, stake),
+			Title:    "Create Reaction Wager",
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "condition",
+							Label:       "Wager Condition",
+							Style:       discordgo.TextInputShort,
+							Placeholder: "Will the raid boss drop the mount?",
+							Required:    true,
+							MaxLength:   200,
+						},
+					},
+				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "options",
+							Label:       "Options (one per line, emoji = reaction)",
+							Style:       discordgo.TextInputParagraph,
+							Placeholder: "Yes 👍\nNo 👎",
+							Required:    true,
+							MaxLength:   1000,
+						},
+					},
+				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "voting_period",
+							Label:       "Voting Period",
+							Style:       discordgo.TextInputShort,
+							Placeholder: "24, 1:30, :45, 6h, 1w",
+							Value:       votingPeriod,
+							Required:    false,
+							MaxLength:   10,
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		log.Printf("Error showing reaction wager modal: %v", err)
+	}
+}
+
+// parseReactModalID reads the reaction message and stake from a reaction wager modal's custom ID
+func parseReactModalID(customID string) (channelID, messageID int64, stake int64, err error) {
+	parts := strings.Split(strings.TrimPrefix(customID, "group_wager_react_modal_"), "_")
+	if len(parts) != 3 {
+		return 0, 0, 0, fmt.Errorf("invalid reaction wager modal ID: %s", customID)
+	}
+	if channelID, err = strconv.ParseInt(parts[0], 10, 64); err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid channel ID: %w", err)
+	}
+	if messageID, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid message ID: %w", err)
+	}
+	if stake, err = strconv.ParseInt(parts[2], 10, 64); err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid stake: %w", err)
+	}
+	return channelID, messageID, stake, nil
+}
+
+// handleGroupWagerReactModal creates a reaction wager and seeds the target message with each option's reaction
+func (f *Feature) handleGroupWagerReactModal(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	data := i.ModalSubmitData()
+
+	reactionChannelID, reactionMessageID, stake, err := parseReactModalID(data.CustomID)
+	if err != nil {
+		log.Printf("Error parsing reaction wager modal: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	var condition, optionsText, votingPeriodText string
+	for _, comp := range data.Components {
+		row := comp.(*discordgo.ActionsRow)
+		for _, innerComp := range row.Components {
+			textInput := innerComp.(*discordgo.TextInput)
+			switch textInput.CustomID {
+			case "condition":
+				condition = strings.TrimSpace(textInput.Value)
+			case "options":
+				optionsText = strings.TrimSpace(textInput.Value)
+			case "voting_period":
+				votingPeriodText = strings.TrimSpace(textInput.Value)
+			}
+		}
+	}
+
+	options, err := parseWagerOptions(s, i.GuildID, optionsText)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	votingPeriodMinutes, err := parseVotingPeriod(votingPeriodText)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	// Defer response while we process
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		log.Printf("Error deferring reaction wager creation: %v", err)
+		return
+	}
+
+	creatorID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing creator ID: %v", err)
+		common.FollowUpWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing guild ID: %v", err)
+		common.FollowUpWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Printf("Error beginning transaction: %v", err)
+		common.FollowUpWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	groupWagerService := uow.Services().GroupWagerService()
+
+	if votingPeriodMinutes == 0 {
+		settings, err := uow.Services().GuildSettingsService().GetOrCreateSettings(ctx, guildID)
+		if err != nil {
+			log.Printf("Error getting guild settings: %v", err)
+			common.FollowUpWithError(s, i, "Unable to process request.")
+			return
+		}
+		votingPeriodMinutes = settings.GetDefaultVotingPeriodMinutes()
+	}
+
+	groupWagerDetail, err := groupWagerService.CreateGroupWager(ctx, guildID, &creatorID, condition, options, votingPeriodMinutes, 0, 0, entities.GroupWagerTypePool, nil, nil)
+	if err != nil {
+		log.Printf("Error creating reaction wager: %v", err)
+		common.FollowUpWithError(s, i, common.DescribeError("Failed to create group wager", err))
+		return
+	}
+
+	wager, err := groupWagerService.EnableReactionBetting(ctx, groupWagerDetail.Wager.ID, creatorID, reactionMessageID, reactionChannelID, stake)
+	if err != nil {
+		log.Printf("Error enabling reaction betting: %v", err)
+		common.FollowUpWithError(s, i, common.DescribeError("Failed to create group wager", err))
+		return
+	}
+	groupWagerDetail.Wager = wager
+
+	// The wager message keeps its buttons so bettors can change their amount
+	embed, components := f.renderGroupWager(ctx, guildID, groupWagerDetail)
+
+	msg, err := s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: components,
+	})
+	if err != nil {
+		log.Printf("Error sending reaction wager message: %v", err)
+		return
+	}
+
+	messageID, err := strconv.ParseInt(msg.ID, 10, 64)
+	if err != nil {
+		log.Errorf("failed to parse MessageID: %s", err)
+		return
+	}
+	channelID, err := strconv.ParseInt(msg.ChannelID, 10, 64)
+	if err != nil {
+		log.Errorf("failed to parse ChannelID: %s", err)
+		return
+	}
+	if err := groupWagerService.UpdateMessageIDs(ctx, groupWagerDetail.Wager.ID, messageID, channelID); err != nil {
+		log.Errorf("failed to update group wager message IDs: %s", err)
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Printf("Error committing transaction: %v", err)
+		common.FollowUpWithError(s, i, "Failed to save group wager.")
+		return
+	}
+
+	// Seed the message with each option's reaction so bettors only have to click
+	reactionChannel := strconv.FormatInt(reactionChannelID, 10)
+	reactionMessage := strconv.FormatInt(reactionMessageID, 10)
+	for _, option := range groupWagerDetail.Options {
+		if err := s.MessageReactionAdd(reactionChannel, reactionMessage, reactionAPIName(optionReactionEmoji(option))); err != nil {
+			log.Warnf("Failed to add reaction for group wager %d option %d: %v", groupWagerDetail.Wager.ID, option.ID, err)
+		}
+	}
+}
+
+// HandleReactionAdd places a bet when a user reacts to a reaction wager's message with one of its options
+func (f *Feature) HandleReactionAdd(s *discordgo.Session, r *discordgo.MessageReactionAdd) {
+	if r.GuildID == "" || r.UserID == s.State.User.ID {
+		return
+	}
+	if r.Member != nil && r.Member.User != nil && r.Member.User.Bot {
+		return
+	}
+
+	guildID, err := strconv.ParseInt(r.GuildID, 10, 64)
+	if err != nil {
+		return
+	}
+	messageID, err := strconv.ParseInt(r.MessageID, 10, 64)
+	if err != nil {
+		return
+	}
+	userID, err := strconv.ParseInt(r.UserID, 10, 64)
+	if err != nil {
+		return
+	}
+
+	ctx := common.WithGuildMemberRoles(context.Background(), r.Member)
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		return
+	}
+	defer uow.Rollback()
+
+	groupWagerService := uow.Services().GroupWagerService()
+
+	detail, err := groupWagerService.GetGroupWagerByReactionMessageID(ctx, messageID)
+	if err != nil {
+		log.Errorf("Error getting reaction wager for message %d: %v", messageID, err)
+		return
+	}
+	if detail == nil {
+		return // Most reactions aren't on a wager
+	}
+
+	option := optionForReaction(detail, r.Emoji)
+	if option == nil {
+		return
+	}
+
+	participant, err := groupWagerService.PlaceReactionBet(ctx, detail.Wager.ID, userID, option.ID)
+	if err != nil {
+		// Take the reaction back so it doesn't look like a bet went through
+		if removeErr := s.MessageReactionRemove(r.ChannelID, r.MessageID, r.Emoji.APIName(), r.UserID); removeErr != nil {
+			log.Warnf("Failed to remove rejected reaction bet: %v", removeErr)
+		}
+		f.sendReactionBetDM(r.UserID, fmt.Sprintf("❌ Your reaction on wager #%d didn't place a bet: %s",
+			detail.Wager.ID, common.DescribeError("Failed to place bet", err)))
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing reaction bet: %v", err)
+		return
+	}
+
+	wagerLink := fmt.Sprintf("https://discord.com/channels/%d/%d/%d", guildID, detail.Wager.ChannelID, detail.Wager.MessageID)
+	f.sendReactionBetDM(r.UserID, fmt.Sprintf(
+		"✅ You bet **%s bits** on **%s** in wager #%d: %s\nTo change the amount, use the buttons on the [wager message](%s).",
+		common.FormatBalance(participant.Amount), entities.ParseGroupWagerOptionLine(option.OptionText).Text,
+		detail.Wager.ID, strings.SplitN(detail.Wager.Condition, "\n", 2)[0], wagerLink,
+	))
+
+	if detail.Wager.MessageID != 0 {
+		f.updateGroupWagerMessage(s, &discordgo.Message{
+			ID:        strconv.FormatInt(detail.Wager.MessageID, 10),
+			ChannelID: strconv.FormatInt(detail.Wager.ChannelID, 10),
+		}, detail.Wager.ID, guildID)
+	}
+}
+
+// sendReactionBetDM tells a user what their reaction did, since reactions have no reply of their own
+func (f *Feature) sendReactionBetDM(userID string, message string) {
+	channel, err := f.session.UserChannelCreate(userID)
+	if err == nil {
+		_, err = f.session.ChannelMessageSend(channel.ID, message)
+	}
+	if err != nil {
+		// Users can disable DMs from server members, which isn't worth retrying
+		log.WithFields(log.Fields{
+			"userID": userID,
+			"error":  err,
+		}).Warn("Failed to send reaction bet DM")
+	}
+}
@@ -0,0 +1,198 @@
+package groupwagers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// settlementMaxLines is the most participants listed in the breakdown embed before the rest move to the attachment
+	settlementMaxLines = 25
+	// settlementMaxLength keeps the breakdown inside Discord's 4096 character embed description limit
+	settlementMaxLength = 4000
+	// settlementMoreNoteLength is reserved for the note pointing at the attachment when lines are dropped
+	settlementMoreNoteLength = 64
+)
+
+// settlementBreakdown is the per-participant settlement of a resolved group wager, ready to post
+type settlementBreakdown struct {
+	Embed *discordgo.MessageEmbed
+	File  *discordgo.File // Full breakdown as CSV, attached only when the embed was truncated
+}
+
+// buildSettlementBreakdown lists every participant's bet, option, payout and net change. Large wagers are
+// truncated in the embed and attached in full as a CSV file.
+func buildSettlementBreakdown(result *entities.GroupWagerResult) *settlementBreakdown {
+	settlements := result.Settlements()
+	if len(settlements) == 0 {
+		return nil
+	}
+
+	lines := make([]string, len(settlements))
+	var paidOut, collected, potTax int64
+	for i, settlement := range settlements {
+		lines[i] = formatSettlementLine(settlement)
+		if settlement.Won {
+			paidOut += settlement.Payout
+			potTax += settlement.PotTax
+		} else {
+			collected += settlement.EffectiveLoss
+		}
+	}
+
+	shown, omitted := truncateSettlementLines(lines, settlementMaxLines, settlementMaxLength)
+	description := strings.Join(shown, "\n")
+	if omitted > 0 {
+		description += fmt.Sprintf("\n…and %d more (full breakdown attached)", omitted)
+	}
+
+	footer := fmt.Sprintf("Group Wager ID: %d | %d participants | Paid out %s | Collected %s",
+		result.GroupWager.ID, len(settlements), common.FormatBalance(paidOut), common.FormatBalance(collected))
+	if potTax > 0 {
+		footer += fmt.Sprintf(" | Lottery pot tax %s", common.FormatBalance(potTax))
+	}
+
+	breakdown := &settlementBreakdown{
+		Embed: &discordgo.MessageEmbed{
+			Title:       fmt.Sprintf("Settlement: %s", result.GroupWager.Condition),
+			Description: description,
+			Color:       common.ColorInfo,
+			Footer:      &discordgo.MessageEmbedFooter{Text: footer},
+		},
+	}
+
+	if omitted > 0 {
+		data, err := settlementCSV(settlements)
+		if err != nil {
+			log.Errorf("Error building settlement CSV for group wager %d: %v", result.GroupWager.ID, err)
+			return breakdown
+		}
+		breakdown.File = &discordgo.File{
+			Name:        fmt.Sprintf("group_wager_%d_settlement.csv", result.GroupWager.ID),
+			ContentType: "text/csv",
+			Reader:      bytes.NewReader(data),
+		}
+	}
+
+	return breakdown
+}
+
+// formatSettlementLine formats one participant's settlement for the breakdown embed
+func formatSettlementLine(settlement entities.GroupWagerSettlement) string {
+	option := settlementOptionText(settlement)
+	if settlement.SwitchedFrom != nil {
+		option += fmt.Sprintf(" (switched from %s)", settlement.SwitchedFrom.OptionText)
+	}
+	if settlement.Won {
+		line := fmt.Sprintf("✅ <@%d> · %s · bet %s → paid %s (**+%s**)",
+			settlement.DiscordID, option, common.FormatBalance(settlement.Amount),
+			common.FormatBalance(settlement.Payout), common.FormatBalance(max(settlement.NetChange, 0)))
+		if settlement.PotTax > 0 {
+			line += fmt.Sprintf(" after %s pot tax", common.FormatBalance(settlement.PotTax))
+		}
+		return line
+	}
+
+	line := fmt.Sprintf("❌ <@%d> · %s · bet %s → lost %s",
+		settlement.DiscordID, option, common.FormatBalance(settlement.Amount), common.FormatBalance(settlement.EffectiveLoss))
+	if settlement.IsCapped() {
+		line += " (capped)"
+	}
+	return line
+}
+
+// truncateSettlementLines keeps the leading lines that fit within maxLines and, once joined with newlines,
+// maxLength with room left for the "and N more" note. Returns the kept lines and how many were dropped.
+func truncateSettlementLines(lines []string, maxLines, maxLength int) ([]string, int) {
+	if len(lines) <= maxLines && len(strings.Join(lines, "\n")) <= maxLength {
+		return lines, 0
+	}
+
+	budget := maxLength - settlementMoreNoteLength
+	length := 0
+	for i, line := range lines {
+		next := length + len(line)
+		if i > 0 {
+			next++
+		}
+		if i == maxLines || next > budget {
+			return lines[:i], len(lines) - i
+		}
+		length = next
+	}
+
+	return lines, 0
+}
+
+// settlementCSV renders the full settlement breakdown as CSV
+func settlementCSV(settlements []entities.GroupWagerSettlement) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write([]string{"discord_id", "option", "bet", "payout", "net_change", "effective_loss", "capped", "pot_tax", "switched_from"}); err != nil {
+		return nil, err
+	}
+	for _, settlement := range settlements {
+		switchedFrom := ""
+		if settlement.SwitchedFrom != nil {
+			switchedFrom = settlement.SwitchedFrom.OptionText
+		}
+		record := []string{
+			strconv.FormatInt(settlement.DiscordID, 10),
+			settlementOptionText(settlement),
+			strconv.FormatInt(settlement.Amount, 10),
+			strconv.FormatInt(settlement.Payout, 10),
+			strconv.FormatInt(settlement.NetChange, 10),
+			strconv.FormatInt(settlement.EffectiveLoss, 10),
+			strconv.FormatBool(settlement.IsCapped()),
+			strconv.FormatInt(settlement.PotTax, 10),
+			switchedFrom,
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// settlementOptionText returns the option a settlement was bet on, or a placeholder if it is unknown
+func settlementOptionText(settlement entities.GroupWagerSettlement) string {
+	if settlement.Option == nil {
+		return "unknown option"
+	}
+	return settlement.Option.OptionText
+}
+
+// postSettlementBreakdown sends the settlement breakdown of a resolved group wager to a channel
+func postSettlementBreakdown(s *discordgo.Session, channelID string, result *entities.GroupWagerResult) {
+	breakdown := buildSettlementBreakdown(result)
+	if breakdown == nil {
+		return
+	}
+
+	message := &discordgo.MessageSend{
+		Embeds:          []*discordgo.MessageEmbed{breakdown.Embed},
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	}
+	if breakdown.File != nil {
+		message.Files = []*discordgo.File{breakdown.File}
+	}
+
+	if _, err := s.ChannelMessageSendComplex(channelID, message); err != nil {
+		log.Errorf("Error sending settlement breakdown for group wager %d: %v", result.GroupWager.ID, err)
+	}
+}
@@ -0,0 +1,158 @@
+package groupwagers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func settlementResult(winners, losers int) *entities.GroupWagerResult {
+	yes := &entities.GroupWagerOption{ID: 1, OptionText: "Yes"}
+	no := &entities.GroupWagerOption{ID: 2, OptionText: "No"}
+	result := &entities.GroupWagerResult{
+		GroupWager:    &entities.GroupWager{ID: 42, Condition: "Will it rain?", WagerType: entities.GroupWagerTypePool},
+		WinningOption: yes,
+		Options:       []*entities.GroupWagerOption{yes, no},
+		PayoutDetails: make(map[int64]int64),
+		MaxWinnerBet:  1000,
+	}
+	for i := 0; i < winners; i++ {
+		id := int64(100 + i)
+		result.Winners = append(result.Winners, &entities.GroupWagerParticipant{DiscordID: id, OptionID: 1, Amount: 1000})
+		result.PayoutDetails[id] = 2000
+	}
+	for i := 0; i < losers; i++ {
+		id := int64(1000 + i)
+		result.Losers = append(result.Losers, &entities.GroupWagerParticipant{DiscordID: id, OptionID: 2, Amount: 5000})
+	}
+	return result
+}
+
+func TestTruncateSettlementLines(t *testing.T) {
+	t.Parallel()
+
+	lines := func(n, width int) []string {
+		out := make([]string, n)
+		for i := range out {
+			out[i] = strings.Repeat("x", width)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name        string
+		lines       []string
+		maxLines    int
+		maxLength   int
+		wantShown   int
+		wantOmitted int
+	}{
+		{name: "everything fits", lines: lines(3, 10), maxLines: 5, maxLength: 100, wantShown: 3},
+		{name: "exactly at the limits", lines: lines(5, 10), maxLines: 5, maxLength: 54, wantShown: 5},
+		{name: "too many lines", lines: lines(8, 10), maxLines: 5, maxLength: 1000, wantShown: 5, wantOmitted: 3},
+		{name: "too long leaves room for the note", lines: lines(10, 100), maxLines: 25, maxLength: 500, wantShown: 4, wantOmitted: 6},
+		{name: "no lines", lines: nil, maxLines: 5, maxLength: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			shown, omitted := truncateSettlementLines(tt.lines, tt.maxLines, tt.maxLength)
+
+			assert.Len(t, shown, tt.wantShown)
+			assert.Equal(t, tt.wantOmitted, omitted)
+			assert.Equal(t, len(tt.lines), len(shown)+omitted)
+			if omitted > 0 {
+				assert.LessOrEqual(t, len(strings.Join(shown, "\n"))+settlementMoreNoteLength, tt.maxLength)
+			}
+		})
+	}
+}
+
+func TestBuildSettlementBreakdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("small wager lists everyone without an attachment", func(t *testing.T) {
+		t.Parallel()
+
+		breakdown := buildSettlementBreakdown(settlementResult(1, 1))
+		require.NotNil(t, breakdown)
+
+		assert.Nil(t, breakdown.File)
+		assert.Contains(t, breakdown.Embed.Description, "✅ <@100> · Yes · bet 1,000 → paid 2,000 (**+1,000**)")
+		assert.Contains(t, breakdown.Embed.Description, "❌ <@1000> · No · bet 5,000 → lost 1,000 (capped)")
+		assert.Contains(t, breakdown.Embed.Footer.Text, "2 participants")
+		assert.Contains(t, breakdown.Embed.Footer.Text, "Paid out 2,000")
+		assert.Contains(t, breakdown.Embed.Footer.Text, "Collected 1,000")
+	})
+
+	t.Run("pot tax is shown per winner and in the footer", func(t *testing.T) {
+		t.Parallel()
+
+		result := settlementResult(1, 1)
+		result.PotTaxes = []*entities.LotteryPotTaxShare{{DiscordID: 100, Amount: 150}}
+		result.PotTaxPercent = 5
+
+		breakdown := buildSettlementBreakdown(result)
+		require.NotNil(t, breakdown)
+
+		assert.Contains(t, breakdown.Embed.Description, "paid 2,000 (**+850**) after 150 pot tax")
+		assert.Contains(t, breakdown.Embed.Footer.Text, "Lottery pot tax 150")
+	})
+
+	t.Run("participants who switched options are annotated", func(t *testing.T) {
+		t.Parallel()
+
+		result := settlementResult(2, 1)
+		result.BetChanges = []*entities.GroupWagerBetChange{
+			// Switched from No to Yes and won
+			{DiscordID: 100, PreviousOptionID: 2, PreviousAmount: 500, NewOptionID: 1, NewAmount: 1000},
+			// Only raised the amount on the same option
+			{DiscordID: 101, PreviousOptionID: 1, PreviousAmount: 500, NewOptionID: 1, NewAmount: 1000},
+			// Switched from Yes to No, back to Yes, then to No again; the original pick was Yes
+			{DiscordID: 1000, PreviousOptionID: 1, PreviousAmount: 5000, NewOptionID: 2, NewAmount: 5000},
+			{DiscordID: 1000, PreviousOptionID: 2, PreviousAmount: 5000, NewOptionID: 1, NewAmount: 5000},
+			{DiscordID: 1000, PreviousOptionID: 1, PreviousAmount: 5000, NewOptionID: 2, NewAmount: 5000},
+		}
+
+		breakdown := buildSettlementBreakdown(result)
+		require.NotNil(t, breakdown)
+
+		assert.Contains(t, breakdown.Embed.Description, "✅ <@100> · Yes (switched from No) · bet 1,000")
+		assert.Contains(t, breakdown.Embed.Description, "✅ <@101> · Yes · bet 1,000")
+		assert.Contains(t, breakdown.Embed.Description, "❌ <@1000> · No (switched from Yes) · bet 5,000")
+	})
+
+	t.Run("large wager is truncated and attached in full", func(t *testing.T) {
+		t.Parallel()
+
+		breakdown := buildSettlementBreakdown(settlementResult(20, 30))
+		require.NotNil(t, breakdown)
+
+		assert.Equal(t, settlementMaxLines, strings.Count(breakdown.Embed.Description, "<@"))
+		assert.Contains(t, breakdown.Embed.Description, fmt.Sprintf("…and %d more", 50-settlementMaxLines))
+		assert.LessOrEqual(t, len(breakdown.Embed.Description), 4096)
+
+		require.NotNil(t, breakdown.File)
+		assert.Equal(t, "group_wager_42_settlement.csv", breakdown.File.Name)
+		data, err := io.ReadAll(breakdown.File.Reader)
+		require.NoError(t, err)
+		rows := strings.Split(strings.TrimSpace(string(data)), "\n")
+		assert.Len(t, rows, 51)
+		assert.Equal(t, "discord_id,option,bet,payout,net_change,effective_loss,capped,pot_tax,switched_from", rows[0])
+		assert.Contains(t, rows, "1000,No,5000,0,-1000,1000,true,0,")
+	})
+
+	t.Run("no participants", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, buildSettlementBreakdown(settlementResult(0, 0)))
+	})
+}
@@ -0,0 +1,97 @@
+package groupwagers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gambler/discord-client/bot/common"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// handleGroupWagerShareButton posts the result of a resolved wager to the channel as an image
+func (f *Feature) handleGroupWagerShareButton(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	customID := i.MessageComponentData().CustomID
+
+	// Parse custom ID: group_wager_share_<wager_id>
+	parts := strings.Split(customID, "_")
+	if len(parts) != 4 {
+		return
+	}
+
+	groupWagerID, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing group wager ID from %s: %v", parts[3], err)
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	defer uow.Rollback()
+
+	detail, err := uow.Services().GroupWagerService().GetGroupWagerDetail(ctx, groupWagerID)
+	if err != nil {
+		log.Errorf("Error getting group wager %d: %v", groupWagerID, err)
+		common.RespondWithError(s, i, "Unable to process request.")
+		return
+	}
+	if detail == nil || detail.Wager == nil {
+		common.RespondWithError(s, i, "Group wager not found.")
+		return
+	}
+	if !detail.Wager.IsResolved() {
+		common.RespondWithError(s, i, "Only resolved wagers can be shared.")
+		return
+	}
+
+	now := time.Now()
+	key := resultImageKey(detail.Wager)
+	png, ok := f.resultImages.get(key, now)
+	if !ok {
+		card := buildResultCard(detail, func(discordID int64) string {
+			return common.GetDisplayNameInt64(s, i.GuildID, discordID)
+		})
+		png, err = renderResultCard(card)
+		if err != nil {
+			log.Errorf("Error rendering result of group wager %d: %v", groupWagerID, err)
+			common.RespondWithError(s, i, "Unable to create the result image.")
+			return
+		}
+		f.resultImages.put(key, png, now)
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content:         fmt.Sprintf("📸 <@%s> shared the result of **%s**", i.Member.User.ID, detail.Wager.Condition),
+			AllowedMentions: &discordgo.MessageAllowedMentions{},
+			Files: []*discordgo.File{
+				{
+					Name:        fmt.Sprintf("group_wager_%d_result.png", groupWagerID),
+					ContentType: "image/png",
+					Reader:      bytes.NewReader(png),
+				},
+			},
+		},
+	})
+	if err != nil {
+		log.Errorf("Error responding to share: %v", err)
+	}
+}
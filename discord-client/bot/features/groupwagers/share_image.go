@@ -0,0 +1,291 @@
+package groupwagers
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"sort"
+	"sync"
+	"time"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/fogleman/gg"
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+const (
+	// resultCardMaxWinners is how many of the biggest payouts are listed on a shared result
+	resultCardMaxWinners = 5
+	// resultCardWidth is the width of the rendered result image in pixels
+	resultCardWidth = 600
+	// resultCardPadding is the margin around the result image's content
+	resultCardPadding = 28
+
+	// resultImageCacheTTL is how long a rendered result is reused before it is drawn again with fresh names
+	resultImageCacheTTL = time.Hour
+	// resultImageCacheSize bounds how many rendered results are kept in memory
+	resultImageCacheSize = 64
+)
+
+// resultCardWinner is one of the biggest payouts shown on a shared result
+type resultCardWinner struct {
+	Name   string
+	Bet    int64
+	Payout int64
+}
+
+// resultCard is the summary of a resolved group wager drawn into the shareable image
+type resultCard struct {
+	GroupWagerID  int64
+	Condition     string
+	WinningOption string
+	TotalPot      int64
+	Participants  int
+	WinnerCount   int
+	TopWinners    []resultCardWinner
+	ResolvedAt    *time.Time
+}
+
+// buildResultCard summarizes a resolved wager with its biggest payouts first. nameFor is only called for
+// the winners that are shown.
+func buildResultCard(detail *entities.GroupWagerDetail, nameFor func(discordID int64) string) *resultCard {
+	wager := detail.Wager
+	card := &resultCard{
+		GroupWagerID:  wager.ID,
+		Condition:     wager.Condition,
+		WinningOption: "Unknown option",
+		TotalPot:      wager.TotalPot,
+		Participants:  len(detail.Participants),
+		ResolvedAt:    wager.ResolvedAt,
+	}
+	if wager.WinningOptionID == nil {
+		return card
+	}
+
+	for _, option := range detail.Options {
+		if option.ID == *wager.WinningOptionID {
+			card.WinningOption = option.OptionText
+		}
+	}
+
+	var winners []*entities.GroupWagerParticipant
+	for _, participant := range detail.Participants {
+		if participant.OptionID == *wager.WinningOptionID {
+			winners = append(winners, participant)
+		}
+	}
+	sort.SliceStable(winners, func(i, j int) bool {
+		return participantPayout(winners[i]) > participantPayout(winners[j])
+	})
+
+	card.WinnerCount = len(winners)
+	for _, winner := range winners[:min(len(winners), resultCardMaxWinners)] {
+		card.TopWinners = append(card.TopWinners, resultCardWinner{
+			Name:   nameFor(winner.DiscordID),
+			Bet:    winner.Amount,
+			Payout: participantPayout(winner),
+		})
+	}
+
+	return card
+}
+
+// participantPayout returns what the participant was paid, zero before payouts are recorded
+func participantPayout(participant *entities.GroupWagerParticipant) int64 {
+	if participant.PayoutAmount == nil {
+		return 0
+	}
+	return *participant.PayoutAmount
+}
+
+// resultImageKey identifies a rendering of the wager's result. Corrections change the winning option,
+// so a corrected result is never served from the cache.
+func resultImageKey(wager *entities.GroupWager) string {
+	var winningOptionID int64
+	if wager.WinningOptionID != nil {
+		winningOptionID = *wager.WinningOptionID
+	}
+	return fmt.Sprintf("%d:%d:%d", wager.ID, winningOptionID, wager.TotalPot)
+}
+
+// renderResultCard draws the result summary as a PNG
+func renderResultCard(card *resultCard) ([]byte, error) {
+	titleFace, err := loadResultFont(gobold.TTF, 24)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load title font: %w", err)
+	}
+	headingFace, err := loadResultFont(gobold.TTF, 16)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load heading font: %w", err)
+	}
+	textFace, err := loadResultFont(goregular.TTF, 15)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load text font: %w", err)
+	}
+
+	contentWidth := float64(resultCardWidth - 2*resultCardPadding)
+
+	// Measure the wrapped condition first so the image is as tall as its content
+	measure := gg.NewContext(resultCardWidth, 1)
+	measure.SetFontFace(titleFace)
+	conditionLines := measure.WordWrap(card.Condition, contentWidth)
+	if len(conditionLines) > 4 {
+		conditionLines = append(conditionLines[:3], conditionLines[3]+"…")
+	}
+
+	const lineHeight = 26.0
+	height := resultCardPadding + 20 + len(conditionLines)*int(lineHeight+6) + 110 + len(card.TopWinners)*int(lineHeight) + 60
+	dc := gg.NewContext(resultCardWidth, height)
+
+	// Dark background with a gold accent bar
+	grad := gg.NewLinearGradient(0, 0, 0, float64(height))
+	grad.AddColorStop(0, color.RGBA{23, 26, 41, 255})
+	grad.AddColorStop(1, color.RGBA{10, 13, 23, 255})
+	dc.SetFillStyle(grad)
+	dc.DrawRectangle(0, 0, resultCardWidth, float64(height))
+	dc.Fill()
+	dc.SetRGB(1, 0.84, 0)
+	dc.DrawRectangle(0, 0, 6, float64(height))
+	dc.Fill()
+
+	x := float64(resultCardPadding)
+	y := float64(resultCardPadding) + 12
+
+	dc.SetFontFace(headingFace)
+	dc.SetRGB(1, 0.84, 0)
+	dc.DrawString("WAGER RESOLVED", x, y)
+	y += 34
+
+	dc.SetFontFace(titleFace)
+	dc.SetRGB(1, 1, 1)
+	for _, line := range conditionLines {
+		dc.DrawString(line, x, y)
+		y += lineHeight + 6
+	}
+	y += 8
+
+	dc.SetFontFace(headingFace)
+	dc.SetRGB(0.45, 0.95, 0.5)
+	dc.DrawString("Winner: "+card.WinningOption, x, y)
+	y += lineHeight
+
+	dc.SetFontFace(textFace)
+	dc.SetRGB(0.8, 0.82, 0.9)
+	dc.DrawString(fmt.Sprintf("Pot: %s bits · %d bettors · %d won", common.FormatBalance(card.TotalPot), card.Participants, card.WinnerCount), x, y)
+	y += lineHeight + 14
+
+	dc.SetFontFace(headingFace)
+	dc.SetRGB(1, 1, 1)
+	if len(card.TopWinners) == 0 {
+		dc.DrawString("Nobody picked the winner", x, y)
+		y += lineHeight
+	} else {
+		dc.DrawString("Top winners", x, y)
+		y += lineHeight
+
+		dc.SetFontFace(textFace)
+		for i, winner := range card.TopWinners {
+			dc.SetRGB(0.85, 0.85, 0.9)
+			dc.DrawString(fmt.Sprintf("%d. %s", i+1, truncateResultName(winner.Name)), x, y)
+			dc.SetRGB(0.45, 0.95, 0.5)
+			dc.DrawStringAnchored(fmt.Sprintf("+%s", common.FormatBalance(winner.Payout)), resultCardWidth-resultCardPadding, y, 1, 0)
+			y += lineHeight
+		}
+	}
+
+	footer := fmt.Sprintf("Group Wager #%d", card.GroupWagerID)
+	if card.ResolvedAt != nil {
+		footer += " · " + card.ResolvedAt.UTC().Format("Jan 2, 2006")
+	}
+	dc.SetFontFace(textFace)
+	dc.SetRGB(0.5, 0.52, 0.6)
+	dc.DrawString(footer, x, float64(height-resultCardPadding+8))
+
+	var buf bytes.Buffer
+	if err := dc.EncodePNG(&buf); err != nil {
+		return nil, fmt.Errorf("failed to encode PNG: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// truncateResultName keeps long display names on one line of the result image
+func truncateResultName(name string) string {
+	runes := []rune(name)
+	if len(runes) > 28 {
+		return string(runes[:27]) + "…"
+	}
+	return name
+}
+
+// loadResultFont parses a TrueType font at the given size
+func loadResultFont(fontData []byte, size float64) (font.Face, error) {
+	f, err := truetype.Parse(fontData)
+	if err != nil {
+		return nil, err
+	}
+	return truetype.NewFace(f, &truetype.Options{Size: size, DPI: 72, Hinting: font.HintingFull}), nil
+}
+
+// resultImageCache keeps recently rendered results so repeated shares of a wager don't redraw it
+type resultImageCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	size    int
+	entries map[string]resultImageEntry
+}
+
+// resultImageEntry is a rendered result and when it stops being served
+type resultImageEntry struct {
+	png       []byte
+	expiresAt time.Time
+}
+
+func newResultImageCache(ttl time.Duration, size int) *resultImageCache {
+	return &resultImageCache{
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[string]resultImageEntry),
+	}
+}
+
+// get returns the rendered result under key if it has not expired
+func (c *resultImageCache) get(key string, now time.Time) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !now.Before(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.png, true
+}
+
+// put stores a rendered result, making room by dropping expired entries and then the one expiring soonest
+func (c *resultImageCache) put(key string, png []byte, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		var oldestKey string
+		var oldest time.Time
+		for k, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, k)
+				continue
+			}
+			if oldestKey == "" || entry.expiresAt.Before(oldest) {
+				oldestKey, oldest = k, entry.expiresAt
+			}
+		}
+		if len(c.entries) >= c.size {
+			delete(c.entries, oldestKey)
+		}
+	}
+
+	c.entries[key] = resultImageEntry{png: png, expiresAt: now.Add(c.ttl)}
+}
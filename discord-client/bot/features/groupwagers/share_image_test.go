@@ -0,0 +1,131 @@
+package groupwagers
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newResolvedDetail(winners int) *entities.GroupWagerDetail {
+	winningOptionID := int64(1)
+	resolvedAt := time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+	detail := &entities.GroupWagerDetail{
+		Wager: &entities.GroupWager{
+			ID:              7,
+			Condition:       "Will the raid clear before midnight?",
+			State:           entities.GroupWagerStateResolved,
+			WinningOptionID: &winningOptionID,
+			TotalPot:        12000,
+			ResolvedAt:      &resolvedAt,
+		},
+		Options: []*entities.GroupWagerOption{
+			{ID: 1, OptionText: "Yes"},
+			{ID: 2, OptionText: "No"},
+		},
+	}
+	for n := 0; n < winners; n++ {
+		payout := int64(100 * (n + 1))
+		detail.Participants = append(detail.Participants, &entities.GroupWagerParticipant{
+			DiscordID:    int64(100 + n),
+			OptionID:     1,
+			Amount:       int64(50 * (n + 1)),
+			PayoutAmount: &payout,
+		})
+	}
+	detail.Participants = append(detail.Participants, &entities.GroupWagerParticipant{DiscordID: 999, OptionID: 2, Amount: 5000})
+	return detail
+}
+
+func TestBuildResultCard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("biggest payouts first and capped", func(t *testing.T) {
+		var named []int64
+		card := buildResultCard(newResolvedDetail(7), func(discordID int64) string {
+			named = append(named, discordID)
+			return fmt.Sprintf("user%d", discordID)
+		})
+
+		assert.Equal(t, "Yes", card.WinningOption)
+		assert.Equal(t, int64(12000), card.TotalPot)
+		assert.Equal(t, 8, card.Participants)
+		assert.Equal(t, 7, card.WinnerCount)
+		require.Len(t, card.TopWinners, resultCardMaxWinners)
+		assert.Equal(t, resultCardWinner{Name: "user106", Bet: 350, Payout: 700}, card.TopWinners[0])
+		assert.Equal(t, int64(300), card.TopWinners[4].Payout)
+		assert.Len(t, named, resultCardMaxWinners, "only shown winners should be looked up")
+	})
+
+	t.Run("nobody picked the winner", func(t *testing.T) {
+		card := buildResultCard(newResolvedDetail(0), func(int64) string { return "" })
+
+		assert.Equal(t, 0, card.WinnerCount)
+		assert.Empty(t, card.TopWinners)
+	})
+}
+
+func TestResultImageKey_ChangesWithCorrection(t *testing.T) {
+	t.Parallel()
+
+	detail := newResolvedDetail(1)
+	before := resultImageKey(detail.Wager)
+
+	corrected := int64(2)
+	detail.Wager.WinningOptionID = &corrected
+
+	assert.NotEqual(t, before, resultImageKey(detail.Wager))
+}
+
+func TestRenderResultCard(t *testing.T) {
+	t.Parallel()
+
+	card := buildResultCard(newResolvedDetail(3), func(discordID int64) string {
+		return fmt.Sprintf("user%d", discordID)
+	})
+
+	data, err := renderResultCard(card)
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, resultCardWidth, img.Bounds().Dx())
+}
+
+func TestResultImageCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+
+	t.Run("expires after ttl", func(t *testing.T) {
+		cache := newResultImageCache(time.Hour, 4)
+		cache.put("a", []byte("a"), now)
+
+		got, ok := cache.get("a", now.Add(59*time.Minute))
+		assert.True(t, ok)
+		assert.Equal(t, []byte("a"), got)
+
+		_, ok = cache.get("a", now.Add(time.Hour))
+		assert.False(t, ok)
+	})
+
+	t.Run("evicts the oldest when full", func(t *testing.T) {
+		cache := newResultImageCache(time.Hour, 2)
+		cache.put("a", []byte("a"), now)
+		cache.put("b", []byte("b"), now.Add(time.Minute))
+		cache.put("c", []byte("c"), now.Add(2*time.Minute))
+
+		_, ok := cache.get("a", now.Add(3*time.Minute))
+		assert.False(t, ok)
+		_, ok = cache.get("b", now.Add(3*time.Minute))
+		assert.True(t, ok)
+		_, ok = cache.get("c", now.Add(3*time.Minute))
+		assert.True(t, ok)
+	})
+}
@@ -8,7 +8,6 @@ import (
 
 	"gambler/discord-client/bot/common"
 	"gambler/discord-client/domain/interfaces"
-	"gambler/discord-client/domain/services"
 
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
@@ -62,13 +61,9 @@ func (f *Feature) handleBuy(s *discordgo.Session, i *discordgo.InteractionCreate
 	}
 	defer uow.Rollback()
 
-	userService := services.NewUserService(
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	userService := uow.Services().UserService()
 
-	_, err = userService.GetOrCreateUser(ctx, userID, i.Member.User.Username)
+	_, err = userService.GetOrCreateUser(ctx, guildID, userID, i.Member.User.Username)
 	if err != nil {
 		log.Errorf("Failed to get or create user: %v", err)
 		common.RespondWithError(s, i, "Failed to process command")
@@ -150,7 +145,7 @@ func (f *Feature) handleInfo(s *discordgo.Session, i *discordgo.InteractionCreat
 	if err := uow.Begin(ctx); err == nil {
 		defer uow.Rollback()
 
-		guildSettingsService := services.NewGuildSettingsService(uow.GuildSettingsRepository())
+		guildSettingsService := uow.Services().GuildSettingsService()
 		if roleID, err := guildSettingsService.GetHighRollerRoleID(ctx, guildID); err == nil && roleID != nil {
 			// Fetch the role from Discord
 			if role, err := s.State.Role(i.GuildID, common.FormatDiscordID(*roleID)); err == nil {
@@ -241,19 +236,9 @@ func (f *Feature) processPurchase(ctx context.Context, discordID, guildID, offer
 	defer uow.Rollback()
 
 	// Create services
-	highRollerService := services.NewHighRollerService(
-		uow.HighRollerPurchaseRepository(),
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.GroupWagerRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.GuildSettingsRepository(),
-		uow.EventBus(),
-	)
-
-	guildSettingsService := services.NewGuildSettingsService(
-		uow.GuildSettingsRepository(),
-	)
+	highRollerService := uow.Services().HighRollerService()
+
+	guildSettingsService := uow.Services().GuildSettingsService()
 
 	// Process purchase (validates and updates database)
 	err := highRollerService.PurchaseHighRollerRole(ctx, discordID, guildID, offerAmount)
@@ -295,15 +280,7 @@ func (f *Feature) getCurrentHighRoller(ctx context.Context, guildID int64) (*int
 	defer uow.Rollback()
 
 	// Create service
-	highRollerService := services.NewHighRollerService(
-		uow.HighRollerPurchaseRepository(),
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.GroupWagerRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.GuildSettingsRepository(),
-		uow.EventBus(),
-	)
+	highRollerService := uow.Services().HighRollerService()
 
 	// Get current high roller info
 	info, err := highRollerService.GetCurrentHighRoller(ctx, guildID)
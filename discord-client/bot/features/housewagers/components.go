@@ -7,10 +7,10 @@ import (
 	"strings"
 	"time"
 
+	"gambler/discord-client/application"
 	"gambler/discord-client/application/dto"
 	"gambler/discord-client/bot/common"
 	"gambler/discord-client/domain/entities"
-	"gambler/discord-client/domain/services"
 
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
@@ -38,15 +38,11 @@ func CreateHouseWagerComponents(houseWager dto.HouseWagerPostDTO) []discordgo.Me
 
 	// Create buttons for each betting option
 	for i, option := range houseWager.Options {
-		emoji := getOptionEmoji(i + 1)
-
 		button := discordgo.Button{
 			Label:    fmt.Sprintf("%s (%.2fx)", option.Text, option.Multiplier),
-			Style:    discordgo.SecondaryButton,
+			Style:    common.OptionButtonStyle(option.ButtonStyle, discordgo.SecondaryButton),
 			CustomID: fmt.Sprintf("house_wager_bet_%d_%d", houseWager.WagerID, option.ID),
-			Emoji: &discordgo.ComponentEmoji{
-				Name: emoji,
-			},
+			Emoji:    common.OptionButtonEmoji(option.ButtonEmoji, getOptionEmoji(i+1)),
 		}
 
 		currentRow = append(currentRow, button)
@@ -130,8 +126,14 @@ func (f *Feature) handleHouseWagerBetButton(s *discordgo.Session, i *discordgo.I
 		return
 	}
 
+	// Show the odds in the bettor's preferred format
+	oddsFormat := entities.OddsFormatMultiplier
+	if userID, err := strconv.ParseInt(i.Member.User.ID, 10, 64); err == nil {
+		oddsFormat = viewerOddsFormat(context.Background(), uow, userID)
+	}
+
 	// Create betting modal
-	modal := f.createHouseWagerBetModal(wagerID, optionID, selectedOption.OptionText, selectedOption.OddsMultiplier, wagerDetail.Wager.Condition)
+	modal := f.createHouseWagerBetModal(wagerID, optionID, selectedOption.OptionText, selectedOption.OddsMultiplier, oddsFormat, wagerDetail.Wager.Condition)
 
 	// Respond with modal
 	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
@@ -148,7 +150,7 @@ func (f *Feature) handleHouseWagerBetButton(s *discordgo.Session, i *discordgo.I
 }
 
 // createHouseWagerBetModal creates a modal for betting on a house wager option
-func (f *Feature) createHouseWagerBetModal(wagerID, optionID int64, optionText string, multiplier float64, condition string) *discordgo.InteractionResponseData {
+func (f *Feature) createHouseWagerBetModal(wagerID, optionID int64, optionText string, multiplier float64, oddsFormat entities.OddsFormat, condition string) *discordgo.InteractionResponseData {
 	// Extract the first line of the condition for context (summoner name and game type)
 	var wagerContext string
 	if idx := strings.Index(condition, "\n"); idx > 0 {
@@ -175,9 +177,9 @@ func (f *Feature) createHouseWagerBetModal(wagerID, optionID int64, optionText s
 				Components: []discordgo.MessageComponent{
 					discordgo.TextInput{
 						CustomID:    "amount",
-						Label:       fmt.Sprintf("Bet Amount (%.2fx payout)", multiplier),
+						Label:       betAmountLabel(multiplier, oddsFormat),
 						Style:       discordgo.TextInputShort,
-						Placeholder: "Enter amount in bits (e.g., 1000)",
+						Placeholder: "Bits (e.g., 1000), all, half or 25%",
 						Required:    true,
 						MinLength:   1,
 						MaxLength:   20,
@@ -213,23 +215,20 @@ func (f *Feature) handleHouseWagerBetModal(s *discordgo.Session, i *discordgo.In
 	}
 
 	// Get bet amount from modal
-	var betAmount int64
+	var amountStr string
 	for _, component := range i.ModalSubmitData().Components {
 		if actionRow, ok := component.(*discordgo.ActionsRow); ok {
 			for _, comp := range actionRow.Components {
 				if textInput, ok := comp.(*discordgo.TextInput); ok && textInput.CustomID == "amount" {
-					betAmount, err = strconv.ParseInt(textInput.Value, 10, 64)
-					if err != nil {
-						common.RespondWithError(s, i, "Invalid bet amount. Please enter a valid number.")
-						return
-					}
+					amountStr = textInput.Value
 				}
 			}
 		}
 	}
 
-	if betAmount <= 0 {
-		common.RespondWithError(s, i, "Bet amount must be greater than 0")
+	amount, err := entities.ParseAmount(amountStr)
+	if err != nil {
+		common.RespondWithError(s, i, fmt.Sprintf("Invalid bet amount: %v.", err))
 		return
 	}
 
@@ -287,22 +286,19 @@ func (f *Feature) handleHouseWagerBetModal(s *discordgo.Session, i *discordgo.In
 	}
 
 	// Create group wager service
-	groupWagerService := services.NewGroupWagerService(
-		uow.GroupWagerRepository(),
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	groupWagerService := uow.Services().GroupWagerService()
 
 	// Place the bet
-	_, err = groupWagerService.PlaceBet(context.Background(), wagerID, userID, optionID, betAmount)
+	participant, err := groupWagerService.PlaceBetWithAmount(common.WithMemberRoles(context.Background(), i), wagerID, userID, optionID, amount)
 	if err != nil {
 		uow.Rollback()
 		log.Errorf("Failed to place house wager bet: %v", err)
-		common.RespondWithError(s, i, fmt.Sprintf("Failed to place bet: %v", err))
+		common.RespondWithError(s, i, common.DescribeError("Failed to place bet", err))
 		return
 	}
 
+	oddsFormat := viewerOddsFormat(context.Background(), uow, userID)
+
 	// Commit the transaction
 	if err := uow.Commit(); err != nil {
 		log.Errorf("Failed to commit house wager bet transaction: %v", err)
@@ -310,8 +306,10 @@ func (f *Feature) handleHouseWagerBetModal(s *discordgo.Session, i *discordgo.In
 		return
 	}
 
-	// Calculate potential payout
-	potentialPayout := float64(betAmount) * selectedOption.OddsMultiplier
+	// Calculate potential payout at the odds locked in for this bet
+	betAmount := participant.Amount
+	lockedOdds := participant.GetPayoutMultiplier(selectedOption)
+	potentialPayout := float64(betAmount) * lockedOdds
 
 	// Create Discord message link to original wager
 	wagerLink := common.FormatDiscordMessageLink(guildID, wagerDetail.Wager.ChannelID, wagerDetail.Wager.MessageID)
@@ -321,8 +319,22 @@ func (f *Feature) handleHouseWagerBetModal(s *discordgo.Session, i *discordgo.In
 		Title: "✅ Bet Placed Successfully!",
 		Description: fmt.Sprintf("You bet **%s bits** on **%s**\n[View original wager](%s)",
 			common.FormatBalance(betAmount), selectedOption.OptionText, wagerLink),
-		Color:  common.ColorPrimary,
-		Fields: []*discordgo.MessageEmbedField{},
+		Color: common.ColorPrimary,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Locked Odds",
+				Value:  oddsFormat.Format(lockedOdds),
+				Inline: true,
+			},
+			{
+				Name:   "Potential Payout",
+				Value:  fmt.Sprintf("%s bits", common.FormatBalance(int64(potentialPayout))),
+				Inline: true,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Your bet pays out at these odds even if the wager's odds change",
+		},
 	}
 
 	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
@@ -381,16 +393,23 @@ func (f *Feature) updateHouseWagerMessage(s *discordgo.Session, msg *discordgo.M
 
 	// Convert to HouseWagerPostDTO for embed creation
 	houseWagerDTO := dto.HouseWagerPostDTO{
-		GuildID:      detail.Wager.GuildID,
-		ChannelID:    detail.Wager.ChannelID,
-		WagerID:      detail.Wager.ID,
-		Title:        title,       // Title from first line
-		Description:  description, // Description from remaining lines
-		State:        string(detail.Wager.State),
-		Options:      make([]dto.WagerOptionDTO, len(detail.Options)),
-		VotingEndsAt: detail.Wager.VotingEndsAt,
-		Participants: make([]dto.ParticipantDTO, len(detail.Participants)),
-		TotalPot:     detail.Wager.TotalPot,
+		GuildID:         detail.Wager.GuildID,
+		ChannelID:       detail.Wager.ChannelID,
+		WagerID:         detail.Wager.ID,
+		Title:           title,       // Title from first line
+		Description:     description, // Description from remaining lines
+		State:           string(detail.Wager.State),
+		Options:         make([]dto.WagerOptionDTO, len(detail.Options)),
+		VotingEndsAt:    detail.Wager.VotingEndsAt,
+		Participants:    make([]dto.ParticipantDTO, len(detail.Participants)),
+		PlayerDiscordID: detail.Wager.SubjectDiscordID,
+		TotalPot:        detail.Wager.TotalPot,
+	}
+	if detail.Wager.ThumbnailURL != nil {
+		houseWagerDTO.ThumbnailURL = *detail.Wager.ThumbnailURL
+	}
+	if detail.Wager.ImageURL != nil {
+		houseWagerDTO.ImageURL = *detail.Wager.ImageURL
 	}
 
 	// Convert options
@@ -402,14 +421,27 @@ func (f *Feature) updateHouseWagerMessage(s *discordgo.Session, msg *discordgo.M
 			Multiplier:  opt.OddsMultiplier,
 			TotalAmount: opt.TotalAmount,
 		}
+		if opt.ButtonEmoji != nil {
+			houseWagerDTO.Options[i].ButtonEmoji = *opt.ButtonEmoji
+		}
+		if opt.ButtonStyle != nil {
+			houseWagerDTO.Options[i].ButtonStyle = string(*opt.ButtonStyle)
+		}
+	}
+
+	// Map options by ID for payout multiplier lookup
+	optionsByID := make(map[int64]*entities.GroupWagerOption, len(detail.Options))
+	for _, opt := range detail.Options {
+		optionsByID[opt.ID] = opt
 	}
 
 	// Convert participants
 	for i, participant := range detail.Participants {
 		houseWagerDTO.Participants[i] = dto.ParticipantDTO{
-			DiscordID: participant.DiscordID,
-			OptionID:  participant.OptionID,
-			Amount:    participant.Amount,
+			DiscordID:  participant.DiscordID,
+			OptionID:   participant.OptionID,
+			Amount:     participant.Amount,
+			Multiplier: participant.GetPayoutMultiplier(optionsByID[participant.OptionID]),
 		}
 	}
 
@@ -432,3 +464,22 @@ func (f *Feature) updateHouseWagerMessage(s *discordgo.Session, msg *discordgo.M
 		log.Debugf("Successfully updated house wager message for wager %d", wagerID)
 	}
 }
+
+// betAmountLabel labels the bet modal's amount input with the option's odds
+func betAmountLabel(multiplier float64, oddsFormat entities.OddsFormat) string {
+	if oddsFormat == entities.OddsFormatMultiplier {
+		return fmt.Sprintf("Bet Amount (%s payout)", oddsFormat.Format(multiplier))
+	}
+	return fmt.Sprintf("Bet Amount (odds %s)", oddsFormat.Format(multiplier))
+}
+
+// viewerOddsFormat returns the odds format the user prefers, falling back to multipliers if it can't be loaded
+func viewerOddsFormat(ctx context.Context, uow application.UnitOfWork, discordID int64) entities.OddsFormat {
+	prefsService := uow.Services().UserPreferencesService()
+	oddsFormat, err := prefsService.GetOddsFormat(ctx, discordID)
+	if err != nil {
+		log.Warnf("Failed to get odds format for user %d, using default: %v", discordID, err)
+		return entities.OddsFormatMultiplier
+	}
+	return oddsFormat
+}
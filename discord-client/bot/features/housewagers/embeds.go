@@ -60,8 +60,8 @@ func CreateHouseWagerEmbed(houseWager dto.HouseWagerPostDTO) *discordgo.MessageE
 					// Calculate total payout by looking at winners (participants who bet on winning option)
 					for _, participant := range houseWager.Participants {
 						if participant.OptionID == *houseWager.WinningOptionID {
-							// For house wagers, payout = bet amount * multiplier
-							totalPayout += int64(float64(participant.Amount) * option.Multiplier)
+							// For house wagers, payout = bet amount * odds locked in at bet time
+							totalPayout += int64(float64(participant.Amount) * participant.Multiplier)
 						}
 					}
 					break
@@ -116,6 +116,23 @@ func createBaseHouseWagerEmbed(houseWager dto.HouseWagerPostDTO) *discordgo.Mess
 		},
 	}
 
+	// Queue and champion art for wagers on tracked games
+	if houseWager.ThumbnailURL != "" {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: houseWager.ThumbnailURL}
+	}
+	if houseWager.ImageURL != "" {
+		embed.Image = &discordgo.MessageEmbedImage{URL: houseWager.ImageURL}
+	}
+
+	// Tag the player whose game this is if they linked their Riot account
+	if houseWager.PlayerDiscordID != nil {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Player",
+			Value:  fmt.Sprintf("<@%d>", *houseWager.PlayerDiscordID),
+			Inline: true,
+		})
+	}
+
 	// Add total pot information if there are participants
 	if len(houseWager.Participants) > 0 && houseWager.TotalPot > 0 {
 		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
@@ -158,9 +175,17 @@ func createBaseHouseWagerEmbed(houseWager dto.HouseWagerPostDTO) *discordgo.Mess
 		return sortedOptions[i].Order < sortedOptions[j].Order
 	})
 
+	// Once resolved, options nobody bet on are collapsed into a single line
+	collapseEmpty := houseWager.State == "resolved"
+	var emptyOptions []string
+
 	// Add fields for each option with visual progress bars and participants
 	for _, option := range sortedOptions {
 		participants := participantsByOption[option.ID]
+		if collapseEmpty && len(participants) == 0 {
+			emptyOptions = append(emptyOptions, option.Text)
+			continue
+		}
 
 		// Calculate percentage of total pot
 		percentage := float64(0)
@@ -184,6 +209,9 @@ func createBaseHouseWagerEmbed(houseWager dto.HouseWagerPostDTO) *discordgo.Mess
 				progressBar,
 				formatCompactAmount(option.TotalAmount)+" bits",
 				multiplier)
+			if option.MaxTotalAmount != nil {
+				statsLine += fmt.Sprintf(" • cap %s", formatCompactAmount(*option.MaxTotalAmount))
+			}
 
 			// Sort participants by amount (highest first)
 			sortedParticipants := make([]dto.ParticipantDTO, len(participants))
@@ -195,7 +223,12 @@ func createBaseHouseWagerEmbed(houseWager dto.HouseWagerPostDTO) *discordgo.Mess
 			// Build participant list with amounts
 			participantTags := make([]string, 0, len(sortedParticipants))
 			for _, p := range sortedParticipants {
-				participantTags = append(participantTags, fmt.Sprintf("<@%d> - %s", p.DiscordID, formatCompactAmount(p.Amount)))
+				tag := fmt.Sprintf("<@%d> - %s", p.DiscordID, formatCompactAmount(p.Amount))
+				// Show the locked odds when the option's odds have moved since the bet was placed
+				if p.Multiplier != multiplier {
+					tag += fmt.Sprintf(" @ %.2fx", p.Multiplier)
+				}
+				participantTags = append(participantTags, tag)
 			}
 
 			// Format participant line with clean delineation
@@ -215,6 +248,9 @@ func createBaseHouseWagerEmbed(houseWager dto.HouseWagerPostDTO) *discordgo.Mess
 			fieldValue = fmt.Sprintf("%s **%.2fx odds**",
 				emoji,
 				option.Multiplier)
+			if option.MaxTotalAmount != nil {
+				fieldValue += fmt.Sprintf(" • cap %s bits", formatCompactAmount(*option.MaxTotalAmount))
+			}
 		}
 
 		// Truncate if too long
@@ -228,12 +264,24 @@ func createBaseHouseWagerEmbed(houseWager dto.HouseWagerPostDTO) *discordgo.Mess
 			Inline: false,
 		})
 	}
+	if len(emptyOptions) > 0 {
+		value := strings.Join(emptyOptions, " • ")
+		if len(value) > 1024 {
+			value = value[:1021] + "..."
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "No bets",
+			Value:  value,
+			Inline: false,
+		})
+	}
 
 	// Add participant count to footer
 	participantCount := len(houseWager.Participants)
 	if participantCount > 0 {
 		embed.Footer.Text += fmt.Sprintf(" • %d participants", participantCount)
 	}
+	embed.Footer.Text += " • " + common.RulesFooterHint
 
 	return embed
 }
@@ -281,15 +329,6 @@ func CreateHouseWagerResolvedEmbed(houseWager dto.HouseWagerPostDTO, winningOpti
 	var winnerCount int
 
 	if houseWager.WinningOptionID != nil {
-		// Find winning option details
-		var winningOptionObj *dto.WagerOptionDTO
-		for _, option := range houseWager.Options {
-			if option.ID == *houseWager.WinningOptionID {
-				winningOptionObj = &option
-				break
-			}
-		}
-
 		// Count winners and build winner list
 		for _, participant := range houseWager.Participants {
 			if participant.OptionID == *houseWager.WinningOptionID {
@@ -297,7 +336,7 @@ func CreateHouseWagerResolvedEmbed(houseWager dto.HouseWagerPostDTO, winningOpti
 					winnerInfo.WriteString(" • ")
 				}
 
-				payout := int64(float64(participant.Amount) * winningOptionObj.Multiplier)
+				payout := int64(float64(participant.Amount) * participant.Multiplier)
 				winnerInfo.WriteString(fmt.Sprintf("<@%d> (+%s)", participant.DiscordID, formatCompactAmount(payout)))
 				winnerCount++
 			}
@@ -365,10 +404,21 @@ func CreateHouseWagerCancelledEmbed(houseWager dto.HouseWagerPostDTO) *discordgo
 		embed.Description = strings.Join(filteredLines, "\n")
 	}
 
-	// Add cancellation notice field
+	// Add cancellation notice field with what was returned
+	var refunded, totalRefunded int64
+	for _, participant := range houseWager.Participants {
+		if participant.Amount > 0 {
+			refunded++
+			totalRefunded += participant.Amount
+		}
+	}
+	refundNotice := "No bets had been placed."
+	if refunded > 0 {
+		refundNotice = fmt.Sprintf("Returned %s bits to %d participants.", common.FormatBalance(totalRefunded), refunded)
+	}
 	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
 		Name:   "❌ Wager Cancelled",
-		Value:  "Game ended in less than 10 minutes (forfeit/remake).\nAll bets have been refunded.",
+		Value:  "Game ended in less than 10 minutes (forfeit/remake).\n" + refundNotice,
 		Inline: false,
 	})
 
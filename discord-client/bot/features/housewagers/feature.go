@@ -18,13 +18,15 @@ import (
 type Feature struct {
 	session    *discordgo.Session
 	uowFactory application.UnitOfWorkFactory
+	limiter    *common.RateLimiter
 }
 
 // NewFeature creates a new house wagers feature instance
-func NewFeature(session *discordgo.Session, uowFactory application.UnitOfWorkFactory) *Feature {
+func NewFeature(session *discordgo.Session, uowFactory application.UnitOfWorkFactory, limiter *common.RateLimiter) *Feature {
 	return &Feature{
 		session:    session,
 		uowFactory: uowFactory,
+		limiter:    limiter,
 	}
 }
 
@@ -70,10 +72,21 @@ func (f *Feature) PostHouseWager(ctx context.Context, dto dto.HouseWagerPostDTO)
 		"messageID": messageID,
 	}).Info("Successfully posted house wager to Discord")
 
-	return &application.PostResult{
+	result := &application.PostResult{
 		MessageID: messageID,
 		ChannelID: dto.ChannelID,
-	}, nil
+	}
+
+	// Open a discussion thread on the wager message; the wager still works without one
+	if threadID := common.StartMessageThread(f.session, channelIDStr, message.ID, dto.Title); threadID != "" {
+		if parsedThreadID, err := strconv.ParseInt(threadID, 10, 64); err != nil {
+			log.Warnf("Failed to parse thread ID %s: %v", threadID, err)
+		} else {
+			result.ThreadID = parsedThreadID
+		}
+	}
+
+	return result, nil
 }
 
 // UpdateHouseWager implements the application.DiscordPoster interface
@@ -154,7 +167,7 @@ func (f *Feature) handleModalSubmit(s *discordgo.Session, i *discordgo.Interacti
 	customID := i.ModalSubmitData().CustomID
 
 	if strings.HasPrefix(customID, "house_wager_bet_modal_") {
-		f.handleHouseWagerBetModal(s, i)
+		f.limiter.Guard(common.RateLimitBet, f.handleHouseWagerBetModal)(s, i)
 		return
 	}
 
@@ -0,0 +1,37 @@
+package link
+
+import (
+	"gambler/discord-client/application"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Feature handles the /link command for connecting users to their own game accounts
+type Feature struct {
+	uowFactory application.UnitOfWorkFactory
+}
+
+// New creates a new link feature
+func New(uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleCommand handles the /link command and its subcommands
+func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	data := i.ApplicationCommandData()
+	if len(data.Options) == 0 {
+		return
+	}
+
+	subcommand := data.Options[0]
+	switch subcommand.Name {
+	case "riot":
+		f.handleLinkRiot(s, i, subcommand.Options)
+	case "show":
+		f.handleShow(s, i)
+	case "remove":
+		f.handleRemove(s, i)
+	}
+}
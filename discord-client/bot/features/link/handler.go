@@ -0,0 +1,156 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"gambler/discord-client/application"
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// handleLinkRiot handles the /link riot command
+func (f *Feature) handleLinkRiot(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	var riotID string
+	for _, opt := range options {
+		if opt.Name == "riot_id" {
+			riotID = opt.StringValue()
+		}
+	}
+
+	gameName, tagLine, err := entities.ParseRiotID(riotID)
+	if err != nil {
+		common.RespondWithError(s, i, "Please enter a Riot ID like Faker#KR1")
+		return
+	}
+
+	var link *entities.RiotAccountLink
+	ok := f.withLinkService(s, i, func(ctx context.Context, linkService interfaces.RiotAccountLinkService, discordID int64) error {
+		link, err = linkService.Link(ctx, discordID, gameName, tagLine)
+		return err
+	})
+	if !ok {
+		return
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "🔗 Riot Account Linked",
+		Description: fmt.Sprintf("Your account is now linked to **%s**.", link.RiotID()),
+		Color:       common.ColorPrimary,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Wagers on this account's LoL and TFT games will tag you, and your games show up in /stats",
+		},
+	}
+	if err := common.RespondWithEmbed(s, i, embed, nil, true); err != nil {
+		log.Errorf("Error responding to link command: %v", err)
+	}
+}
+
+// handleShow handles the /link show command
+func (f *Feature) handleShow(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	var link *entities.RiotAccountLink
+	ok := f.withLinkService(s, i, func(ctx context.Context, linkService interfaces.RiotAccountLinkService, discordID int64) error {
+		var err error
+		link, err = linkService.GetLink(ctx, discordID)
+		return err
+	})
+	if !ok {
+		return
+	}
+
+	message := "You haven't linked a Riot account. Use `/link riot` to link one."
+	if link != nil {
+		message = fmt.Sprintf("Your account is linked to **%s** since <t:%d:D>.", link.RiotID(), link.LinkedAt.Unix())
+	}
+	respondEphemeral(s, i, message)
+}
+
+// handleRemove handles the /link remove command
+func (f *Feature) handleRemove(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	var removed bool
+	ok := f.withLinkService(s, i, func(ctx context.Context, linkService interfaces.RiotAccountLinkService, discordID int64) error {
+		var err error
+		removed, err = linkService.Unlink(ctx, discordID)
+		return err
+	})
+	if !ok {
+		return
+	}
+
+	message := "You don't have a linked Riot account."
+	if removed {
+		message = "Your Riot account has been unlinked. Wagers already open still show you as the player."
+	}
+	respondEphemeral(s, i, message)
+}
+
+// respondEphemeral replies to the invoking user only
+func respondEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate, message string) {
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Errorf("Error responding to link command: %v", err)
+	}
+}
+
+// withLinkService runs fn in a transaction for the invoking user, committing on success.
+// It responds with an error and returns false if anything fails.
+func (f *Feature) withLinkService(s *discordgo.Session, i *discordgo.InteractionCreate, fn func(context.Context, interfaces.RiotAccountLinkService, int64) error) bool {
+	ctx := context.Background()
+
+	discordID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing Discord ID %s: %v", i.Member.User.ID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return false
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return false
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return false
+	}
+	defer uow.Rollback()
+
+	if err := fn(ctx, newLinkService(uow), discordID); err != nil {
+		if errors.Is(err, entities.ErrRiotAccountLinkedElsewhere) {
+			common.RespondWithError(s, i, "That Riot account is already linked to another user.")
+			return false
+		}
+		log.Errorf("Error updating riot account link for user %d: %v", discordID, err)
+		common.RespondWithError(s, i, common.DescribeError("Failed to update linked account", err))
+		return false
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return false
+	}
+
+	return true
+}
+
+// newLinkService creates a link service from the unit of work's repositories
+func newLinkService(uow application.UnitOfWork) interfaces.RiotAccountLinkService {
+	return uow.Services().RiotAccountLinkService()
+}
@@ -30,13 +30,21 @@ func CreateLotteryComponents(draw *entities.LotteryDraw) []discordgo.MessageComp
 		discordgo.ActionsRow{
 			Components: []discordgo.MessageComponent{
 				discordgo.Button{
-					Label:    "Buy Tickets",
+					Label:    "Quick Pick",
 					Style:    discordgo.PrimaryButton,
 					CustomID: fmt.Sprintf("lotto_buy_%d", draw.ID),
 					Emoji: &discordgo.ComponentEmoji{
 						Name: "🎟️",
 					},
 				},
+				discordgo.Button{
+					Label:    "Pick Numbers",
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("lotto_pick_%d", draw.ID),
+					Emoji: &discordgo.ComponentEmoji{
+						Name: "🔢",
+					},
+				},
 			},
 		},
 	}
@@ -47,7 +55,7 @@ func CreateBuyTicketsModal(drawID, ticketCost, userBalance int64) *discordgo.Int
 	maxAffordable := userBalance / ticketCost
 	placeholderText := "1"
 	if maxAffordable > 1 {
-		placeholderText = fmt.Sprintf("1 (max: %d)", maxAffordable)
+		placeholderText = fmt.Sprintf("1 (max: %d), all, half or 25%%", maxAffordable)
 	}
 
 	return &discordgo.InteractionResponseData{
@@ -63,7 +71,30 @@ func CreateBuyTicketsModal(drawID, ticketCost, userBalance int64) *discordgo.Int
 						Placeholder: placeholderText,
 						Required:    true,
 						MinLength:   1,
-						MaxLength:   5,
+						MaxLength:   6,
+					},
+				},
+			},
+		},
+	}
+}
+
+// CreatePickNumbersModal creates the modal for buying tickets with numbers the user picks
+func CreatePickNumbersModal(draw *entities.LotteryDraw) *discordgo.InteractionResponseData {
+	return &discordgo.InteractionResponseData{
+		CustomID: fmt.Sprintf("lotto_pick_modal_%d", draw.ID),
+		Title:    "Pick Lottery Numbers",
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					discordgo.TextInput{
+						CustomID:    "numbers",
+						Label:       fmt.Sprintf("Numbers from 0 to %d (%d bits each)", draw.GetMaxNumber(), draw.TicketCost),
+						Style:       discordgo.TextInputParagraph,
+						Placeholder: "One ticket per number, separated by commas: 7, 42, 128",
+						Required:    true,
+						MinLength:   1,
+						MaxLength:   1000,
 					},
 				},
 			},
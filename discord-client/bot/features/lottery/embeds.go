@@ -2,6 +2,7 @@ package lottery
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"gambler/discord-client/bot/common"
@@ -11,6 +12,9 @@ import (
 	"github.com/bwmarrin/discordgo"
 )
 
+// purchaseNumbersLimit is the most tickets a purchase confirmation lists the numbers of
+const purchaseNumbersLimit = 20
+
 // CreateLotteryEmbed creates the main lottery embed for an in-progress draw
 func CreateLotteryEmbed(drawInfo *interfaces.LotteryDrawInfo) *discordgo.MessageEmbed {
 	draw := drawInfo.Draw
@@ -36,7 +40,7 @@ func CreateLotteryEmbed(drawInfo *interfaces.LotteryDrawInfo) *discordgo.Message
 	embed := &discordgo.MessageEmbed{
 		Title:       fmt.Sprintf("Lotto #%d - %s bits", draw.ID, common.FormatBalance(draw.TotalPot)),
 		Color:       common.ColorInfo,
-		Description: fmt.Sprintf("Draws <t:%d:d> <t:%d:t>", draw.DrawTime.Unix(), draw.DrawTime.Unix()),
+		Description: fmt.Sprintf("Draws <t:%d:d> <t:%d:t> (<t:%d:R>)", draw.DrawTime.Unix(), draw.DrawTime.Unix(), draw.DrawTime.Unix()),
 		Fields: []*discordgo.MessageEmbedField{
 			{
 				Name:   "Ticket Cost",
@@ -56,12 +60,21 @@ func CreateLotteryEmbed(drawInfo *interfaces.LotteryDrawInfo) *discordgo.Message
 		},
 	}
 
+	// Pot taxed from resolved group wagers is called out so the jump in the pot is explained
+	if draw.TaxPot > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Wager Pot Tax",
+			Value:  fmt.Sprintf("%s bits from resolved wagers", common.FormatBalance(draw.TaxPot)),
+			Inline: true,
+		})
+	}
+
 	return embed
 }
 
 // CreatePurchaseConfirmationEmbed creates an ephemeral embed for purchase confirmation
 func CreatePurchaseConfirmationEmbed(result *interfaces.LotteryPurchaseResult) *discordgo.MessageEmbed {
-	return &discordgo.MessageEmbed{
+	embed := &discordgo.MessageEmbed{
 		Title:       "Tickets Purchased!",
 		Color:       common.ColorSuccess,
 		Description: fmt.Sprintf("You bought %d ticket(s) for %s", len(result.Tickets), common.FormatBalance(result.TotalCost)),
@@ -73,6 +86,21 @@ func CreatePurchaseConfirmationEmbed(result *interfaces.LotteryPurchaseResult) *
 			},
 		},
 	}
+
+	// Small purchases list their numbers so picks can be checked at a glance
+	if len(result.Tickets) > 0 && len(result.Tickets) <= purchaseNumbersLimit {
+		numbers := make([]string, len(result.Tickets))
+		for idx, ticket := range result.Tickets {
+			numbers[idx] = strconv.FormatInt(ticket.TicketNumber, 10)
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Your Numbers",
+			Value:  strings.Join(numbers, ", "),
+			Inline: true,
+		})
+	}
+
+	return embed
 }
 
 // CreateDrawResultEmbed creates an embed for a completed draw
@@ -105,7 +133,7 @@ func CreateDrawResultEmbed(result *interfaces.LotteryDrawResult, draw *entities.
 	// Build winner section
 	var winnerStr string
 	if result.RolledOver {
-		winnerStr = "No winner"
+		winnerStr = fmt.Sprintf("No winner - %s bits roll over", common.FormatBalance(result.RolloverAmount))
 	} else {
 		winnerMentions := make([]string, 0, len(result.Winners))
 		for _, winner := range result.Winners {
@@ -138,5 +166,141 @@ func CreateDrawResultEmbed(result *interfaces.LotteryDrawResult, draw *entities.
 		},
 	}
 
+	if len(result.ConsolationPayouts) > 0 {
+		total := entities.TotalLotteryConsolation(result.ConsolationPayouts)
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Consolation",
+			Value:  fmt.Sprintf("%s bits above the rollover cap shared among %d ticket holders", common.FormatBalance(total), len(result.ConsolationPayouts)),
+			Inline: false,
+		})
+	}
+
+	return embed
+}
+
+// CreateNumberStatsEmbed creates an ephemeral embed showing historical number statistics
+func CreateNumberStatsEmbed(stats *interfaces.LotteryNumberStats) *discordgo.MessageEmbed {
+	formatFrequencies := func(frequencies []*entities.LotteryNumberFrequency, unit string) string {
+		if len(frequencies) == 0 {
+			return "No history yet"
+		}
+		lines := make([]string, 0, len(frequencies))
+		for _, f := range frequencies {
+			lines = append(lines, fmt.Sprintf("`%s` (%d) - %d %s",
+				entities.FormatBinaryNumber(f.Number, stats.Difficulty), f.Number, f.Count, unit))
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	recentStr := "No completed draws yet"
+	if len(stats.RecentDraws) > 0 {
+		lines := make([]string, 0, len(stats.RecentDraws))
+		for _, draw := range stats.RecentDraws {
+			lines = append(lines, fmt.Sprintf("#%d: `%s` (%d) <t:%d:d>",
+				draw.ID, entities.FormatBinaryNumber(*draw.WinningNumber, draw.Difficulty), *draw.WinningNumber, draw.DrawTime.Unix()))
+		}
+		recentStr = strings.Join(lines, "\n")
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       "Lotto Numbers",
+		Color:       common.ColorInfo,
+		Description: fmt.Sprintf("%d completed draws at the current difficulty (1 in %d)", stats.TotalDraws, int64(1)<<stats.Difficulty),
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "🔥 Hot Numbers",
+				Value:  formatFrequencies(stats.HotNumbers, "wins"),
+				Inline: true,
+			},
+			{
+				Name:   "🧊 Cold Numbers",
+				Value:  formatFrequencies(stats.ColdNumbers, "wins"),
+				Inline: true,
+			},
+			{
+				Name:   "Recent Winning Numbers",
+				Value:  recentStr,
+				Inline: false,
+			},
+			{
+				Name:   "Your Most Played Numbers",
+				Value:  formatFrequencies(stats.UserNumbers, "tickets"),
+				Inline: false,
+			},
+		},
+	}
+}
+
+// CreateDrawHistoryEmbed creates an ephemeral embed listing a page of past draw results
+func CreateDrawHistoryEmbed(history *interfaces.LotteryDrawHistory) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title:  "Lotto History",
+		Color:  common.ColorInfo,
+		Footer: &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("Page %d of %d • %d completed draws", history.Page, history.TotalPages(), history.TotalDraws)},
+	}
+
+	if len(history.Draws) == 0 {
+		embed.Description = "No completed draws on this page"
+		return embed
+	}
+
+	for _, entry := range history.Draws {
+		result := entry.Result
+
+		outcome := fmt.Sprintf("🔁 No winner, %s rolled over", common.FormatBalance(result.TotalPot))
+		if !result.RolledOver() {
+			mentions := make([]string, 0, len(entry.Winners))
+			for _, winner := range entry.Winners {
+				mentions = append(mentions, fmt.Sprintf("<@%d>", winner.DiscordID))
+			}
+			outcome = fmt.Sprintf("🏆 %s won %s", strings.Join(mentions, ", "), common.FormatBalance(result.TotalPaidOut))
+		}
+
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: fmt.Sprintf("Draw #%d • <t:%d:d>", result.DrawID, result.CompletedAt.Unix()),
+			Value: fmt.Sprintf("Winning number `%s` (%d)\nPot: %s • %d tickets sold\n%s",
+				entities.FormatBinaryNumber(result.WinningNumber, result.Difficulty), result.WinningNumber,
+				common.FormatBalance(result.TotalPot), result.TicketsSold, outcome),
+			Inline: false,
+		})
+	}
+
+	return embed
+}
+
+// CreateUserWinsEmbed creates an ephemeral embed listing a user's lottery wins
+func CreateUserWinsEmbed(history *interfaces.LotteryUserWinHistory) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: "Your Lotto Wins",
+		Color: common.ColorInfo,
+	}
+
+	if history.Totals.WinCount == 0 {
+		embed.Description = "You haven't won a draw yet. Keep playing!"
+		return embed
+	}
+
+	embed.Description = fmt.Sprintf("You've won **%d** draws for a total of **%s**",
+		history.Totals.WinCount, common.FormatBalance(history.Totals.TotalWon))
+
+	lines := make([]string, 0, len(history.Wins))
+	for _, win := range history.Wins {
+		line := fmt.Sprintf("#%d <t:%d:d>: `%s` (%d) won %s",
+			win.DrawID, win.CompletedAt.Unix(),
+			entities.FormatBinaryNumber(win.WinningNumber, win.Difficulty), win.WinningNumber,
+			common.FormatBalance(win.WinningAmount))
+		if win.WinnerCount > 1 {
+			line += fmt.Sprintf(" (split %d ways)", win.WinnerCount)
+		}
+		lines = append(lines, line)
+	}
+	embed.Fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Recent Wins",
+			Value:  strings.Join(lines, "\n"),
+			Inline: false,
+		},
+	}
+
 	return embed
 }
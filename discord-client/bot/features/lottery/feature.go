@@ -9,6 +9,7 @@ import (
 	"gambler/discord-client/application"
 	"gambler/discord-client/bot/common"
 	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
 	"gambler/discord-client/domain/interfaces"
 
 	"github.com/bwmarrin/discordgo"
@@ -19,13 +20,39 @@ import (
 type Feature struct {
 	session    *discordgo.Session
 	uowFactory application.UnitOfWorkFactory
+	limiter    *common.RateLimiter
 }
 
 // NewFeature creates a new lottery feature instance
-func NewFeature(session *discordgo.Session, uowFactory application.UnitOfWorkFactory) *Feature {
+func NewFeature(session *discordgo.Session, uowFactory application.UnitOfWorkFactory, limiter *common.RateLimiter) *Feature {
 	return &Feature{
 		session:    session,
 		uowFactory: uowFactory,
+		limiter:    limiter,
+	}
+}
+
+// HandleCommand handles the /lotto command and its subcommands
+func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		common.RespondWithError(s, i, "Please specify a subcommand.")
+		return
+	}
+
+	switch options[0].Name {
+	case "numbers":
+		f.handleNumbers(s, i)
+	case "history":
+		f.handleHistory(s, i)
+	case "mywins":
+		f.handleMyWins(s, i)
+	case "subscribe":
+		f.handleSubscribe(s, i)
+	case "unsubscribe":
+		f.handleUnsubscribe(s, i)
+	default:
+		common.RespondWithError(s, i, "Unknown subcommand.")
 	}
 }
 
@@ -45,8 +72,8 @@ func (f *Feature) HandleInteraction(s *discordgo.Session, i *discordgo.Interacti
 func (f *Feature) handleComponentInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	customID := i.MessageComponentData().CustomID
 
-	// Lottery button interactions use format: lotto_buy_<draw_id>
-	if strings.HasPrefix(customID, "lotto_buy_") {
+	// Lottery button interactions use format: lotto_buy_<draw_id> or lotto_pick_<draw_id>
+	if strings.HasPrefix(customID, "lotto_buy_") || strings.HasPrefix(customID, "lotto_pick_") {
 		f.handleBuyButton(s, i)
 		return
 	}
@@ -59,7 +86,11 @@ func (f *Feature) handleModalSubmit(s *discordgo.Session, i *discordgo.Interacti
 	customID := i.ModalSubmitData().CustomID
 
 	if strings.HasPrefix(customID, "lotto_buy_modal_") {
-		f.handleBuyModalSubmit(s, i)
+		f.limiter.Guard(common.RateLimitLottery, f.handleBuyModalSubmit)(s, i)
+		return
+	}
+	if strings.HasPrefix(customID, "lotto_pick_modal_") {
+		f.limiter.Guard(common.RateLimitLottery, f.handlePickModalSubmit)(s, i)
 		return
 	}
 
@@ -92,6 +123,11 @@ func (f *Feature) PostLotteryResult(ctx context.Context, draw *entities.LotteryD
 		return fmt.Errorf("failed to update lottery message with results: %w", err)
 	}
 
+	// The next draw's message takes over the pin
+	if err := f.session.ChannelMessageUnpin(channelIDStr, messageIDStr); err != nil {
+		log.WithError(err).Warnf("Failed to unpin lottery message for draw %d", draw.ID)
+	}
+
 	log.WithFields(log.Fields{
 		"draw_id":    draw.ID,
 		"channel_id": *draw.ChannelID,
@@ -122,6 +158,11 @@ func (f *Feature) PostNewLotteryDraw(ctx context.Context, drawInfo *interfaces.L
 		return 0, fmt.Errorf("failed to parse message ID: %w", err)
 	}
 
+	// Pin the open draw so the countdown is easy to find; the bot may lack permission, which is not fatal
+	if err := f.session.ChannelMessagePin(channelIDStr, msg.ID); err != nil {
+		log.WithError(err).Warnf("Failed to pin lottery message for draw %d", drawInfo.Draw.ID)
+	}
+
 	log.WithFields(log.Fields{
 		"draw_id":    drawInfo.Draw.ID,
 		"channel_id": channelID,
@@ -155,3 +196,32 @@ func (f *Feature) UpdateLotteryEmbed(ctx context.Context, draw *entities.Lottery
 
 	return nil
 }
+
+// HandleGroupWagerStateChange refreshes the lottery embed when a resolved wager may have added pot tax
+func (f *Feature) HandleGroupWagerStateChange(ctx context.Context, event events.Event) error {
+	e, err := application.AssertEventType[events.GroupWagerStateChangeEvent](event, "GroupWagerStateChangeEvent")
+	if err != nil {
+		return err
+	}
+
+	if e.NewState != string(entities.GroupWagerStateResolved) {
+		return nil
+	}
+
+	uow := f.uowFactory.CreateForGuild(e.GuildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	settings, err := uow.Services().GuildSettingsService().GetOrCreateSettings(ctx, e.GuildID)
+	uow.Rollback()
+	if err != nil {
+		return fmt.Errorf("failed to get guild settings: %w", err)
+	}
+
+	if !settings.HasPotTax() || !settings.IsLottoEnabled() {
+		return nil
+	}
+
+	f.updateLotteryMessage(ctx, f.session, e.GuildID)
+	return nil
+}
@@ -7,18 +7,25 @@ import (
 	"strings"
 
 	"gambler/discord-client/bot/common"
-	"gambler/discord-client/domain/services"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
 
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
 )
 
-// handleBuyButton handles the buy tickets button click
+// DefaultRecentNumbersLimit is the default number of recent winning numbers shown by /lotto numbers
+const DefaultRecentNumbersLimit = 10
+
+// DefaultHistoryLimit is the default number of draws shown by /lotto history and wins shown by /lotto mywins
+const DefaultHistoryLimit = 10
+
+// handleBuyButton handles the quick pick and pick numbers button clicks
 func (f *Feature) handleBuyButton(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	ctx := context.Background()
 	customID := i.MessageComponentData().CustomID
 
-	// Parse draw ID from custom ID: lotto_buy_<draw_id>
+	// Parse draw ID from custom ID: lotto_buy_<draw_id> or lotto_pick_<draw_id>
 	parts := strings.Split(customID, "_")
 	if len(parts) < 3 {
 		common.RespondWithError(s, i, "Invalid button")
@@ -55,12 +62,8 @@ func (f *Feature) handleBuyButton(s *discordgo.Session, i *discordgo.Interaction
 	defer uow.Rollback()
 
 	// Get user balance
-	userService := services.NewUserService(
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
-	user, err := userService.GetOrCreateUser(ctx, discordID, username)
+	userService := uow.Services().UserService()
+	user, err := userService.GetOrCreateUser(ctx, guildID, discordID, username)
 	if err != nil {
 		log.Errorf("Failed to get user: %v", err)
 		common.RespondWithError(s, i, "Failed to get user")
@@ -82,6 +85,9 @@ func (f *Feature) handleBuyButton(s *discordgo.Session, i *discordgo.Interaction
 
 	// Show modal with ticket cost info
 	modal := CreateBuyTicketsModal(drawID, draw.TicketCost, user.Balance)
+	if strings.HasPrefix(customID, "lotto_pick_") {
+		modal = CreatePickNumbersModal(draw)
+	}
 	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseModal,
 		Data: modal,
@@ -122,12 +128,64 @@ func (f *Feature) handleBuyModalSubmit(s *discordgo.Session, i *discordgo.Intera
 		}
 	}
 
-	quantity, err := strconv.Atoi(quantityStr)
-	if err != nil || quantity <= 0 {
-		common.UpdateMessageWithError(s, i, "Please enter a valid positive number")
+	// all, half or a percentage buys as many tickets as fit in that share of the balance
+	amount, err := entities.ParseAmount(quantityStr)
+	if err != nil {
+		common.UpdateMessageWithError(s, i, fmt.Sprintf("Invalid ticket quantity: %v", err))
+		return
+	}
+
+	f.submitPurchase(ctx, s, i, func(lotteryService interfaces.LotteryService, discordID, guildID int64) (*interfaces.LotteryPurchaseResult, error) {
+		return lotteryService.PurchaseTicketsWithAmount(ctx, discordID, guildID, amount)
+	})
+}
+
+// handlePickModalSubmit handles the pick numbers modal submission
+func (f *Feature) handlePickModalSubmit(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+	data := i.ModalSubmitData()
+
+	if err := common.DeferResponse(s, i, true); err != nil {
+		log.Errorf("Failed to defer response: %v", err)
+		return
+	}
+
+	// Validate custom ID format: lotto_pick_modal_<draw_id>
+	parts := strings.Split(data.CustomID, "_")
+	if len(parts) < 4 {
+		common.UpdateMessageWithError(s, i, "Invalid modal")
+		return
+	}
+	if _, err := strconv.ParseInt(parts[3], 10, 64); err != nil {
+		common.UpdateMessageWithError(s, i, "Invalid draw ID")
+		return
+	}
+
+	var numbersStr string
+	for _, comp := range data.Components {
+		row := comp.(*discordgo.ActionsRow)
+		for _, innerComp := range row.Components {
+			textInput := innerComp.(*discordgo.TextInput)
+			if textInput.CustomID == "numbers" {
+				numbersStr = textInput.Value
+			}
+		}
+	}
+
+	numbers, err := entities.ParseLotteryNumbers(numbersStr)
+	if err != nil {
+		common.UpdateMessageWithError(s, i, fmt.Sprintf("Invalid numbers: %v", err))
 		return
 	}
 
+	f.submitPurchase(ctx, s, i, func(lotteryService interfaces.LotteryService, discordID, guildID int64) (*interfaces.LotteryPurchaseResult, error) {
+		return lotteryService.PurchaseSpecificTickets(ctx, discordID, guildID, numbers)
+	})
+}
+
+// submitPurchase buys tickets for the user who submitted a deferred purchase modal, then confirms the
+// purchase and refreshes the lottery message
+func (f *Feature) submitPurchase(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, purchase func(interfaces.LotteryService, int64, int64) (*interfaces.LotteryPurchaseResult, error)) {
 	// Parse Discord IDs
 	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
 	if err != nil {
@@ -153,12 +211,8 @@ func (f *Feature) handleBuyModalSubmit(s *discordgo.Session, i *discordgo.Intera
 	defer uow.Rollback()
 
 	// Ensure user exists
-	userService := services.NewUserService(
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
-	_, err = userService.GetOrCreateUser(ctx, discordID, username)
+	userService := uow.Services().UserService()
+	_, err = userService.GetOrCreateUser(ctx, guildID, discordID, username)
 	if err != nil {
 		log.Errorf("Failed to get/create user: %v", err)
 		common.UpdateMessageWithError(s, i, "Failed to process user")
@@ -166,22 +220,12 @@ func (f *Feature) handleBuyModalSubmit(s *discordgo.Session, i *discordgo.Intera
 	}
 
 	// Purchase tickets via lottery service
-	lotteryService := services.NewLotteryService(
-		uow.LotteryDrawRepository(),
-		uow.LotteryTicketRepository(),
-		uow.LotteryWinnerRepository(),
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.GroupWagerRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.GuildSettingsRepository(),
-		uow.EventBus(),
-	)
-
-	result, err := lotteryService.PurchaseTickets(ctx, discordID, guildID, quantity)
+	lotteryService := uow.Services().LotteryService()
+
+	result, err := purchase(lotteryService, discordID, guildID)
 	if err != nil {
 		log.Errorf("Failed to purchase tickets: %v", err)
-		common.UpdateMessageWithError(s, i, fmt.Sprintf("Failed to purchase tickets: %v", err))
+		common.UpdateMessageWithError(s, i, common.DescribeError("Failed to purchase tickets", err))
 		return
 	}
 
@@ -212,17 +256,7 @@ func (f *Feature) updateLotteryMessage(ctx context.Context, s *discordgo.Session
 	defer uow.Rollback()
 
 	// Get draw info
-	lotteryService := services.NewLotteryService(
-		uow.LotteryDrawRepository(),
-		uow.LotteryTicketRepository(),
-		uow.LotteryWinnerRepository(),
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.GroupWagerRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.GuildSettingsRepository(),
-		uow.EventBus(),
-	)
+	lotteryService := uow.Services().LotteryService()
 
 	drawInfo, err := lotteryService.GetDrawInfo(ctx, guildID)
 	if err != nil {
@@ -251,3 +285,259 @@ func (f *Feature) updateLotteryMessage(ctx context.Context, s *discordgo.Session
 		log.Errorf("Failed to update lottery message: %v", err)
 	}
 }
+
+// handleNumbers handles the /lotto numbers subcommand
+func (f *Feature) handleNumbers(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+
+	recentLimit := DefaultRecentNumbersLimit
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "count" {
+			recentLimit = int(opt.IntValue())
+		}
+	}
+
+	guildID, err := common.ParseGuildID(i.GuildID)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid guild ID")
+		return
+	}
+
+	discordID, err := common.ParseUserID(i.Member.User.ID)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid user ID")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Failed to begin transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to process request")
+		return
+	}
+	defer uow.Rollback()
+
+	lotteryService := uow.Services().LotteryService()
+
+	stats, err := lotteryService.GetNumberStats(ctx, discordID, guildID, recentLimit)
+	if err != nil {
+		log.Errorf("Failed to get lottery number stats: %v", err)
+		common.RespondWithError(s, i, "Failed to get lottery number statistics")
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Failed to commit transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to process request")
+		return
+	}
+
+	embed := CreateNumberStatsEmbed(stats)
+	if err := common.RespondWithEmbed(s, i, embed, nil, true); err != nil {
+		log.Errorf("Failed to send lottery number stats: %v", err)
+	}
+}
+
+// handleHistory handles the /lotto history subcommand
+func (f *Feature) handleHistory(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+
+	pageSize := DefaultHistoryLimit
+	page := 1
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		switch opt.Name {
+		case "count":
+			pageSize = int(opt.IntValue())
+		case "page":
+			page = int(opt.IntValue())
+		}
+	}
+
+	guildID, err := common.ParseGuildID(i.GuildID)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid guild ID")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Failed to begin transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to process request")
+		return
+	}
+	defer uow.Rollback()
+
+	history, err := uow.Services().LotteryService().GetDrawHistory(ctx, guildID, page, pageSize)
+	if err != nil {
+		log.Errorf("Failed to get lottery draw history: %v", err)
+		common.RespondWithError(s, i, "Failed to get lottery history")
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Failed to commit transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to process request")
+		return
+	}
+
+	embed := CreateDrawHistoryEmbed(history)
+	if err := common.RespondWithEmbed(s, i, embed, nil, true); err != nil {
+		log.Errorf("Failed to send lottery draw history: %v", err)
+	}
+}
+
+// handleMyWins handles the /lotto mywins subcommand
+func (f *Feature) handleMyWins(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+
+	limit := DefaultHistoryLimit
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "count" {
+			limit = int(opt.IntValue())
+		}
+	}
+
+	guildID, err := common.ParseGuildID(i.GuildID)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid guild ID")
+		return
+	}
+
+	discordID, err := common.ParseUserID(i.Member.User.ID)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid user ID")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Failed to begin transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to process request")
+		return
+	}
+	defer uow.Rollback()
+
+	history, err := uow.Services().LotteryService().GetUserWinHistory(ctx, discordID, guildID, limit)
+	if err != nil {
+		log.Errorf("Failed to get lottery wins for user %d: %v", discordID, err)
+		common.RespondWithError(s, i, "Failed to get your lottery wins")
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Failed to commit transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to process request")
+		return
+	}
+
+	embed := CreateUserWinsEmbed(history)
+	if err := common.RespondWithEmbed(s, i, embed, nil, true); err != nil {
+		log.Errorf("Failed to send lottery wins: %v", err)
+	}
+}
+
+// handleSubscribe handles the /lotto subscribe subcommand
+func (f *Feature) handleSubscribe(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+
+	var ticketCount int
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "tickets" {
+			ticketCount = int(opt.IntValue())
+		}
+	}
+
+	guildID, err := common.ParseGuildID(i.GuildID)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid guild ID")
+		return
+	}
+
+	discordID, err := common.ParseUserID(i.Member.User.ID)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid user ID")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Failed to begin transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to process request")
+		return
+	}
+	defer uow.Rollback()
+
+	// Ensure user exists
+	userService := uow.Services().UserService()
+	if _, err := userService.GetOrCreateUser(ctx, guildID, discordID, i.Member.User.Username); err != nil {
+		log.Errorf("Failed to get/create user: %v", err)
+		common.RespondWithError(s, i, "Failed to process user")
+		return
+	}
+
+	subscriptionService := uow.Services().LotterySubscriptionService()
+	subscription, err := subscriptionService.Subscribe(ctx, discordID, guildID, ticketCount)
+	if err != nil {
+		log.Errorf("Failed to subscribe to lottery: %v", err)
+		common.RespondWithError(s, i, common.DescribeError("Failed to subscribe", err))
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Failed to commit transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to process request")
+		return
+	}
+
+	message := fmt.Sprintf("You'll automatically buy %d ticket(s) at the start of each new draw, starting with the next one. Draws you can't afford are skipped. Use `/lotto unsubscribe` to stop.", subscription.TicketCount)
+	if err := common.RespondWithSuccess(s, i, message, true); err != nil {
+		log.Errorf("Failed to send subscribe confirmation: %v", err)
+	}
+}
+
+// handleUnsubscribe handles the /lotto unsubscribe subcommand
+func (f *Feature) handleUnsubscribe(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+
+	guildID, err := common.ParseGuildID(i.GuildID)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid guild ID")
+		return
+	}
+
+	discordID, err := common.ParseUserID(i.Member.User.ID)
+	if err != nil {
+		common.RespondWithError(s, i, "Invalid user ID")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Failed to begin transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to process request")
+		return
+	}
+	defer uow.Rollback()
+
+	subscriptionService := uow.Services().LotterySubscriptionService()
+	removed, err := subscriptionService.Unsubscribe(ctx, discordID)
+	if err != nil {
+		log.Errorf("Failed to unsubscribe from lottery: %v", err)
+		common.RespondWithError(s, i, "Failed to unsubscribe")
+		return
+	}
+	if !removed {
+		common.RespondWithError(s, i, "You don't have a lottery subscription.")
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Failed to commit transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to process request")
+		return
+	}
+
+	if err := common.RespondWithSuccess(s, i, "Your lottery subscription has been cancelled.", true); err != nil {
+		log.Errorf("Failed to send unsubscribe confirmation: %v", err)
+	}
+}
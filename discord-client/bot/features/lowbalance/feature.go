@@ -0,0 +1,100 @@
+package lowbalance
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gambler/discord-client/application"
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/events"
+	"gambler/discord-client/domain/services"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// Feature warns users by DM when their balance drops below their guild's reserve floor
+type Feature struct {
+	session    *discordgo.Session
+	uowFactory application.UnitOfWorkFactory
+}
+
+// NewFeature creates a new low balance feature instance
+func NewFeature(session *discordgo.Session, uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		session:    session,
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleBalanceChange publishes a BalanceLowEvent when a BalanceChangeEvent crosses the guild's balance floor
+func (f *Feature) HandleBalanceChange(ctx context.Context, event events.Event) error {
+	e, err := application.AssertEventType[events.BalanceChangeEvent](event, "BalanceChangeEvent")
+	if err != nil {
+		return err
+	}
+
+	// Balance changes outside a guild have no floor
+	if e.GuildID == 0 {
+		return nil
+	}
+
+	uow := f.uowFactory.CreateForGuild(e.GuildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	balanceGuard := services.NewBalanceGuard(uow.GuildSettingsRepository())
+	lowEvent, err := balanceGuard.CheckBalanceChange(ctx, e)
+	if err != nil {
+		return err
+	}
+	if lowEvent == nil {
+		return nil
+	}
+
+	if err := uow.EventBus().Publish(*lowEvent); err != nil {
+		return fmt.Errorf("failed to publish balance low event: %w", err)
+	}
+
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// HandleBalanceLow DMs the user that their balance is now below the guild's reserve floor
+func (f *Feature) HandleBalanceLow(ctx context.Context, event events.Event) error {
+	e, err := application.AssertEventType[events.BalanceLowEvent](event, "BalanceLowEvent")
+	if err != nil {
+		return err
+	}
+
+	userID := strconv.FormatInt(e.UserID, 10)
+	channel, err := f.session.UserChannelCreate(userID)
+	if err != nil {
+		return fmt.Errorf("failed to open DM channel with user %s: %w", userID, err)
+	}
+
+	guildName := "your server"
+	if guild, err := f.session.State.Guild(strconv.FormatInt(e.GuildID, 10)); err == nil {
+		guildName = guild.Name
+	}
+
+	message := fmt.Sprintf("Your balance in **%s** is down to %s bits, below the server's %s bit reserve. "+
+		"You won't be able to place bets or buy lottery tickets until it's back above the reserve.",
+		guildName, common.FormatBalance(e.Balance), common.FormatBalance(e.Floor))
+	if _, err := f.session.ChannelMessageSend(channel.ID, message); err != nil {
+		// Users can disable DMs from server members, which isn't worth retrying
+		log.WithFields(log.Fields{
+			"userID":  e.UserID,
+			"guildID": e.GuildID,
+			"error":   err,
+		}).Warn("Failed to send low balance DM")
+	}
+
+	return nil
+}
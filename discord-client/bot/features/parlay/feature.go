@@ -0,0 +1,37 @@
+package parlay
+
+import (
+	"gambler/discord-client/application"
+	"gambler/discord-client/bot/common"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Feature handles the /parlay command
+type Feature struct {
+	uowFactory application.UnitOfWorkFactory
+}
+
+// New creates a new parlay feature
+func New(uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleCommand routes parlay subcommands to appropriate handlers
+func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	switch options[0].Name {
+	case "place":
+		f.handlePlace(s, i, options[0].Options)
+	case "list":
+		f.handleList(s, i)
+	default:
+		common.RespondWithError(s, i, "Unknown subcommand.")
+	}
+}
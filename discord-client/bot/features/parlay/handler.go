@@ -0,0 +1,218 @@
+package parlay
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/application"
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// recentParlayLimit is how many parlays /parlay list shows
+const recentParlayLimit = 10
+
+// handlePlace places a parlay across the selected house wagers
+func (f *Feature) handlePlace(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	var amount int64
+	var legs string
+	for _, opt := range options {
+		switch opt.Name {
+		case "amount":
+			amount = opt.IntValue()
+		case "legs":
+			legs = opt.StringValue()
+		}
+	}
+
+	selections, err := parseLegs(legs)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	f.withParlayService(s, i, func(ctx context.Context, discordID, guildID int64, parlayService interfaces.ParlayService, oddsFormat entities.OddsFormat) (string, error) {
+		parlay, err := parlayService.PlaceParlay(ctx, discordID, guildID, amount, selections)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("🎟️ Placed parlay #%d: **%s bits** on %d legs at **%s**.\nPays **%s bits** if every leg wins. Cancelled games void their leg.\n%s",
+			parlay.ID,
+			common.FormatBalance(parlay.Amount),
+			len(parlay.Legs),
+			oddsFormat.Format(parlay.CombinedOdds()),
+			common.FormatBalance(parlay.PotentialPayout()),
+			formatLegs(parlay.Legs, oddsFormat)), nil
+	})
+}
+
+// handleList shows the user's recent parlays
+func (f *Feature) handleList(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	f.withParlayService(s, i, func(ctx context.Context, discordID, guildID int64, parlayService interfaces.ParlayService, oddsFormat entities.OddsFormat) (string, error) {
+		parlays, err := parlayService.GetUserParlays(ctx, discordID, recentParlayLimit)
+		if err != nil {
+			return "", err
+		}
+		return formatParlayList(parlays, oddsFormat), nil
+	})
+}
+
+// withParlayService runs fn in a guild-scoped transaction and responds with its message
+func (f *Feature) withParlayService(s *discordgo.Session, i *discordgo.InteractionCreate, fn func(ctx context.Context, discordID, guildID int64, parlayService interfaces.ParlayService, oddsFormat entities.OddsFormat) (string, error)) {
+	ctx := context.Background()
+
+	discordID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing Discord ID %s: %v", i.Member.User.ID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+	defer uow.Rollback()
+
+	userService := uow.Services().UserService()
+	if _, err := userService.GetOrCreateUser(ctx, guildID, discordID, i.Member.User.Username); err != nil {
+		common.RespondWithError(s, i, common.DescribeError("Failed to create user", err))
+		return
+	}
+
+	prefsService := uow.Services().UserPreferencesService()
+	oddsFormat, err := prefsService.GetOddsFormat(ctx, discordID)
+	if err != nil {
+		log.Warnf("Error getting odds format for user %d, using default: %v", discordID, err)
+		oddsFormat = entities.OddsFormatMultiplier
+	}
+
+	message, err := fn(ctx, discordID, guildID, newParlayService(uow), oddsFormat)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	if err := common.RespondWithSuccess(s, i, message, true); err != nil {
+		log.Errorf("Error responding to parlay command: %v", err)
+	}
+}
+
+// newParlayService creates a parlay service from the unit of work's repositories
+func newParlayService(uow application.UnitOfWork) interfaces.ParlayService {
+	return uow.Services().ParlayService()
+}
+
+// parseLegs parses comma separated "wagerID:option" picks, e.g. "12:1, 15:2"
+func parseLegs(input string) ([]entities.ParlayLegSelection, error) {
+	var selections []entities.ParlayLegSelection
+	for _, part := range strings.Split(input, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		wagerPart, optionPart, ok := strings.Cut(part, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid leg %q, use wagerID:option such as 12:1", part)
+		}
+		wagerID, err := strconv.ParseInt(strings.TrimPrefix(strings.TrimSpace(wagerPart), "#"), 10, 64)
+		if err != nil || wagerID <= 0 {
+			return nil, fmt.Errorf("invalid wager ID in leg %q", part)
+		}
+		option, err := strconv.Atoi(strings.TrimSpace(optionPart))
+		if err != nil || option <= 0 {
+			return nil, fmt.Errorf("invalid option number in leg %q", part)
+		}
+
+		selections = append(selections, entities.ParlayLegSelection{GroupWagerID: wagerID, OptionNumber: option})
+	}
+
+	if len(selections) < entities.MinParlayLegs || len(selections) > entities.MaxParlayLegs {
+		return nil, fmt.Errorf("a parlay needs between %d and %d legs", entities.MinParlayLegs, entities.MaxParlayLegs)
+	}
+
+	return selections, nil
+}
+
+// formatLegs lists a parlay's legs with their locked odds and status
+func formatLegs(legs []*entities.ParlayLeg, oddsFormat entities.OddsFormat) string {
+	lines := make([]string, 0, len(legs))
+	for _, leg := range legs {
+		lines = append(lines, fmt.Sprintf("%s Wager #%d • %s", legStatusEmoji(leg.Status), leg.GroupWagerID, oddsFormat.Format(leg.OddsMultiplier)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatParlayList lists parlays with their stake, odds and result
+func formatParlayList(parlays []*entities.Parlay, oddsFormat entities.OddsFormat) string {
+	if len(parlays) == 0 {
+		return "You have no parlays. Use `/parlay place` to combine house wagers into one ticket."
+	}
+
+	blocks := make([]string, 0, len(parlays))
+	for _, parlay := range parlays {
+		var result string
+		switch parlay.Status {
+		case entities.ParlayStatusPending:
+			result = fmt.Sprintf("pending, pays %s", common.FormatBalance(parlay.PotentialPayout()))
+		case entities.ParlayStatusWon:
+			result = fmt.Sprintf("won %s", common.FormatBalance(payoutOf(parlay)))
+		case entities.ParlayStatusLost:
+			result = "lost"
+		case entities.ParlayStatusVoid:
+			result = "void, stake returned"
+		}
+
+		blocks = append(blocks, fmt.Sprintf("**#%d** • %s bits at %s • %s\n%s",
+			parlay.ID,
+			common.FormatBalance(parlay.Amount),
+			oddsFormat.Format(parlay.CombinedOdds()),
+			result,
+			formatLegs(parlay.Legs, oddsFormat)))
+	}
+
+	return strings.Join(blocks, "\n\n")
+}
+
+func payoutOf(parlay *entities.Parlay) int64 {
+	if parlay.PayoutAmount == nil {
+		return 0
+	}
+	return *parlay.PayoutAmount
+}
+
+func legStatusEmoji(status entities.ParlayStatus) string {
+	switch status {
+	case entities.ParlayStatusWon:
+		return "✅"
+	case entities.ParlayStatusLost:
+		return "❌"
+	case entities.ParlayStatusVoid:
+		return "➖"
+	default:
+		return "⏳"
+	}
+}
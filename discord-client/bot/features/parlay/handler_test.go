@@ -0,0 +1,48 @@
+package parlay
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLegs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("parses comma separated picks", func(t *testing.T) {
+		t.Parallel()
+
+		selections, err := parseLegs("12:1, #15:2 ,")
+		require.NoError(t, err)
+		assert.Equal(t, []entities.ParlayLegSelection{
+			{GroupWagerID: 12, OptionNumber: 1},
+			{GroupWagerID: 15, OptionNumber: 2},
+		}, selections)
+	})
+
+	for _, input := range []string{"12", "12:1", "12:0, 15:1", "abc:1, 15:1", "1:1,2:1,3:1,4:1,5:1,6:1"} {
+		t.Run("rejects "+input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseLegs(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFormatLegs(t *testing.T) {
+	t.Parallel()
+
+	legs := []*entities.ParlayLeg{
+		{GroupWagerID: 12, OddsMultiplier: 2.5, Status: entities.ParlayStatusPending},
+		{GroupWagerID: 15, OddsMultiplier: 1.5, Status: entities.ParlayStatusPending},
+	}
+
+	assert.Contains(t, formatLegs(legs, entities.OddsFormatMultiplier), "Wager #12 • 2.50x")
+	american := formatLegs(legs, entities.OddsFormatAmerican)
+	assert.Contains(t, american, "Wager #12 • +150")
+	assert.Contains(t, american, "Wager #15 • -200")
+}
@@ -0,0 +1,24 @@
+package preferences
+
+import (
+	"gambler/discord-client/application"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Feature handles the /preferences command for per-user display settings
+type Feature struct {
+	uowFactory application.UnitOfWorkFactory
+}
+
+// New creates a new preferences feature
+func New(uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleCommand handles the /preferences command
+func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	f.handlePreferences(s, i)
+}
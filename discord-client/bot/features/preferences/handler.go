@@ -0,0 +1,134 @@
+package preferences
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// exampleMultipliers are shown alongside the user's odds format so they can see how it reads
+var exampleMultipliers = []float64{1.5, 2.0, 3.25}
+
+// handlePreferences shows the user's preferences, updating any that were passed as options
+func (f *Feature) handlePreferences(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+
+	var oddsFormat string
+	var showOnGlobal *bool
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch opt.Name {
+		case "odds-format":
+			oddsFormat = opt.StringValue()
+		case "global-leaderboard":
+			value := opt.BoolValue()
+			showOnGlobal = &value
+		}
+	}
+
+	discordID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing Discord ID %s: %v", i.Member.User.ID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+	defer uow.Rollback()
+
+	prefsService := uow.Services().UserPreferencesService()
+
+	var prefs *entities.UserPreferences
+	if oddsFormat != "" {
+		prefs, err = prefsService.SetOddsFormat(ctx, discordID, entities.OddsFormat(oddsFormat))
+	}
+	if err == nil && showOnGlobal != nil {
+		prefs, err = prefsService.SetHideFromGlobalLeaderboard(ctx, discordID, !*showOnGlobal)
+	}
+	if err == nil && prefs == nil {
+		prefs, err = prefsService.GetPreferences(ctx, discordID)
+	}
+	if err != nil {
+		log.Errorf("Error updating preferences for user %d: %v", discordID, err)
+		common.RespondWithError(s, i, common.DescribeError("Failed to update preferences", err))
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: "⚙️ Your Preferences",
+		Color: common.ColorInfo,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Odds Format",
+				Value:  fmt.Sprintf("**%s**\n%s", oddsFormatName(prefs.OddsFormat), formatExamples(prefs.OddsFormat)),
+				Inline: false,
+			},
+			{
+				Name:   "Global Leaderboard",
+				Value:  globalLeaderboardVisibility(prefs.HideFromGlobalLeaderboard),
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Preferences apply in every server and change how odds appear in your private responses",
+		},
+	}
+	if err := common.RespondWithEmbed(s, i, embed, nil, true); err != nil {
+		log.Errorf("Error responding to preferences command: %v", err)
+	}
+}
+
+// oddsFormatName returns the display name of an odds format
+func oddsFormatName(format entities.OddsFormat) string {
+	switch format {
+	case entities.OddsFormatDecimal:
+		return "Decimal"
+	case entities.OddsFormatFractional:
+		return "Fractional"
+	case entities.OddsFormatAmerican:
+		return "American"
+	default:
+		return "Multiplier"
+	}
+}
+
+// formatExamples shows a few multipliers rendered in the given odds format
+func formatExamples(format entities.OddsFormat) string {
+	examples := make([]string, 0, len(exampleMultipliers))
+	for _, m := range exampleMultipliers {
+		examples = append(examples, fmt.Sprintf("%s → %s", entities.OddsFormatMultiplier.Format(m), format.Format(m)))
+	}
+	return strings.Join(examples, " • ")
+}
+
+// globalLeaderboardVisibility describes whether the user appears on the cross-server leaderboard
+func globalLeaderboardVisibility(hidden bool) string {
+	if hidden {
+		return "**Hidden**\nYou don't appear on `/leaderboard global`"
+	}
+	return "**Visible**\nYou appear on `/leaderboard global` when your servers share stats"
+}
@@ -0,0 +1,44 @@
+package resolver
+
+import (
+	"gambler/discord-client/application"
+	"gambler/discord-client/bot/common"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Feature handles the /resolver command
+type Feature struct {
+	uowFactory application.UnitOfWorkFactory
+}
+
+// New creates a new resolver feature
+func New(uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleCommand routes resolver subcommands to appropriate handlers
+func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "❌ You need administrator permissions to use this command")
+		return
+	}
+
+	switch options[0].Name {
+	case "add":
+		f.handleAdd(s, i, options[0].Options)
+	case "remove":
+		f.handleRemove(s, i, options[0].Options)
+	case "list":
+		f.handleList(s, i)
+	default:
+		common.RespondWithError(s, i, "Unknown subcommand.")
+	}
+}
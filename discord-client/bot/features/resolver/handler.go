@@ -0,0 +1,167 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/config"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// handleAdd grants a user or role permission to resolve group wagers
+func (f *Feature) handleAdd(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	resolverType, targetID, err := parseTarget(options)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	f.withResolverService(s, i, func(ctx context.Context, discordID, guildID int64, resolverService interfaces.GuildResolverService) (string, error) {
+		added, err := resolverService.AddResolver(ctx, guildID, resolverType, targetID, discordID)
+		if err != nil {
+			return "", err
+		}
+
+		mention := (&entities.GuildResolver{ResolverType: resolverType, TargetID: targetID}).Mention()
+		if !added {
+			return fmt.Sprintf("%s can already resolve group wagers.", mention), nil
+		}
+		return fmt.Sprintf("✅ %s can now resolve group wagers.", mention), nil
+	})
+}
+
+// handleRemove revokes a user or role's permission to resolve group wagers
+func (f *Feature) handleRemove(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	resolverType, targetID, err := parseTarget(options)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	f.withResolverService(s, i, func(ctx context.Context, discordID, guildID int64, resolverService interfaces.GuildResolverService) (string, error) {
+		removed, err := resolverService.RemoveResolver(ctx, resolverType, targetID)
+		if err != nil {
+			return "", err
+		}
+
+		mention := (&entities.GuildResolver{ResolverType: resolverType, TargetID: targetID}).Mention()
+		if !removed {
+			return fmt.Sprintf("%s is not a resolver in this server.", mention), nil
+		}
+		return fmt.Sprintf("✅ %s can no longer resolve group wagers.", mention), nil
+	})
+}
+
+// handleList shows the guild's resolver grants along with the global resolvers from config
+func (f *Feature) handleList(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	f.withResolverService(s, i, func(ctx context.Context, discordID, guildID int64, resolverService interfaces.GuildResolverService) (string, error) {
+		resolvers, err := resolverService.ListResolvers(ctx)
+		if err != nil {
+			return "", err
+		}
+		return formatResolverList(resolvers, config.Get().ResolverDiscordIDs), nil
+	})
+}
+
+// withResolverService runs fn in a guild-scoped transaction and responds with its message
+func (f *Feature) withResolverService(s *discordgo.Session, i *discordgo.InteractionCreate, fn func(ctx context.Context, discordID, guildID int64, resolverService interfaces.GuildResolverService) (string, error)) {
+	ctx := context.Background()
+
+	discordID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing Discord ID %s: %v", i.Member.User.ID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+	defer uow.Rollback()
+
+	message, err := fn(ctx, discordID, guildID, uow.Services().GuildResolverService())
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	if err := common.RespondWithSuccess(s, i, message, true); err != nil {
+		log.Errorf("Error responding to resolver command: %v", err)
+	}
+}
+
+// parseTarget reads the user or role option, exactly one of which must be given
+func parseTarget(options []*discordgo.ApplicationCommandInteractionDataOption) (entities.GuildResolverType, int64, error) {
+	var resolverType entities.GuildResolverType
+	var rawID string
+	for _, opt := range options {
+		switch opt.Name {
+		case "user":
+			if rawID != "" {
+				return "", 0, errors.New("choose either a user or a role, not both")
+			}
+			resolverType, rawID = entities.GuildResolverTypeUser, opt.UserValue(nil).ID
+		case "role":
+			if rawID != "" {
+				return "", 0, errors.New("choose either a user or a role, not both")
+			}
+			resolverType, rawID = entities.GuildResolverTypeRole, opt.RoleValue(nil, "").ID
+		}
+	}
+	if rawID == "" {
+		return "", 0, errors.New("choose a user or a role")
+	}
+
+	targetID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid %s", resolverType)
+	}
+	return resolverType, targetID, nil
+}
+
+// formatResolverList lists the guild's resolver grants followed by the global resolvers
+func formatResolverList(resolvers []*entities.GuildResolver, globalIDs []int64) string {
+	var b strings.Builder
+	b.WriteString("**Group wager resolvers**\n")
+
+	if len(resolvers) == 0 {
+		b.WriteString("No users or roles have been added. Use `/resolver add` to grant one.\n")
+	}
+	for _, resolver := range resolvers {
+		b.WriteString(fmt.Sprintf("• %s (%s)\n", resolver.Mention(), resolver.ResolverType))
+	}
+
+	if len(globalIDs) > 0 {
+		mentions := make([]string, 0, len(globalIDs))
+		for _, id := range globalIDs {
+			mentions = append(mentions, fmt.Sprintf("<@%d>", id))
+		}
+		b.WriteString(fmt.Sprintf("\nGlobal resolvers: %s", strings.Join(mentions, ", ")))
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -0,0 +1,53 @@
+package resolver
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTarget(t *testing.T) {
+	t.Parallel()
+
+	user := &discordgo.ApplicationCommandInteractionDataOption{Name: "user", Type: discordgo.ApplicationCommandOptionUser, Value: "111"}
+	role := &discordgo.ApplicationCommandInteractionDataOption{Name: "role", Type: discordgo.ApplicationCommandOptionRole, Value: "222"}
+
+	t.Run("user", func(t *testing.T) {
+		resolverType, targetID, err := parseTarget([]*discordgo.ApplicationCommandInteractionDataOption{user})
+		require.NoError(t, err)
+		assert.Equal(t, entities.GuildResolverTypeUser, resolverType)
+		assert.Equal(t, int64(111), targetID)
+	})
+
+	t.Run("role", func(t *testing.T) {
+		resolverType, targetID, err := parseTarget([]*discordgo.ApplicationCommandInteractionDataOption{role})
+		require.NoError(t, err)
+		assert.Equal(t, entities.GuildResolverTypeRole, resolverType)
+		assert.Equal(t, int64(222), targetID)
+	})
+
+	t.Run("requires exactly one target", func(t *testing.T) {
+		_, _, err := parseTarget(nil)
+		assert.Error(t, err)
+
+		_, _, err = parseTarget([]*discordgo.ApplicationCommandInteractionDataOption{user, role})
+		assert.Error(t, err)
+	})
+}
+
+func TestFormatResolverList(t *testing.T) {
+	t.Parallel()
+
+	message := formatResolverList([]*entities.GuildResolver{
+		{ResolverType: entities.GuildResolverTypeUser, TargetID: 111},
+		{ResolverType: entities.GuildResolverTypeRole, TargetID: 222},
+	}, []int64{999})
+
+	assert.Contains(t, message, "<@111> (user)")
+	assert.Contains(t, message, "<@&222> (role)")
+	assert.Contains(t, message, "Global resolvers: <@999>")
+}
@@ -0,0 +1,24 @@
+package rules
+
+import (
+	"gambler/discord-client/application"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Feature handles the /rules command
+type Feature struct {
+	uowFactory application.UnitOfWorkFactory
+}
+
+// New creates a new rules feature
+func New(uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleCommand handles the /rules command
+func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	f.handleRules(s, i)
+}
@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"context"
+	"strconv"
+
+	"gambler/discord-client/bot/common"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// handleRules shows the guild's wager rules and dispute policy
+func (f *Feature) handleRules(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+	defer uow.Rollback()
+
+	guildSettingsService := uow.Services().GuildSettingsService()
+	settings, err := guildSettingsService.GetOrCreateSettings(ctx, guildID)
+	if err != nil {
+		log.Errorf("Failed to get guild settings for %d: %v", guildID, err)
+		common.RespondWithError(s, i, "Unable to load the server rules. Please try again.")
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "📜 Wager Rules & Dispute Policy",
+		Description: settings.GetRulesText(),
+		Color:       common.ColorInfo,
+	}
+	if !settings.HasRulesText() {
+		embed.Footer = &discordgo.MessageEmbedFooter{
+			Text: "Default policy • Admins can customize it with /settings rules",
+		}
+	}
+
+	common.RespondWithEmbed(s, i, embed, nil, false)
+}
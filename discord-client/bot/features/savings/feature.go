@@ -0,0 +1,39 @@
+package savings
+
+import (
+	"gambler/discord-client/application"
+	"gambler/discord-client/bot/common"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Feature handles the /savings command
+type Feature struct {
+	uowFactory application.UnitOfWorkFactory
+}
+
+// New creates a new savings feature
+func New(uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleCommand routes savings subcommands to appropriate handlers
+func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	switch options[0].Name {
+	case "deposit":
+		f.handleDeposit(s, i, options[0].Options)
+	case "withdraw":
+		f.handleWithdraw(s, i, options[0].Options)
+	case "list":
+		f.handleList(s, i)
+	default:
+		common.RespondWithError(s, i, "Unknown subcommand.")
+	}
+}
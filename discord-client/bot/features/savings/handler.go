@@ -0,0 +1,159 @@
+package savings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/application"
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// handleDeposit locks bits for the chosen term
+func (f *Feature) handleDeposit(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	var amount int64
+	var weeks int
+	for _, opt := range options {
+		switch opt.Name {
+		case "amount":
+			amount = opt.IntValue()
+		case "weeks":
+			weeks = int(opt.IntValue())
+		}
+	}
+
+	f.withSavingsService(s, i, func(ctx context.Context, discordID, guildID int64, savingsService interfaces.SavingsService) (string, error) {
+		deposit, err := savingsService.Deposit(ctx, discordID, guildID, amount, weeks)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("🔒 Locked **%s bits** for %d week(s) (deposit #%d).\nIt matures %s and pays a **%s bit** bonus. Withdrawing early forfeits the bonus.",
+			common.FormatBalance(deposit.Amount),
+			deposit.TermWeeks,
+			deposit.ID,
+			common.FormatDiscordTimestamp(deposit.MaturesAt, "R"),
+			common.FormatBalance(deposit.BonusAmount)), nil
+	})
+}
+
+// handleWithdraw releases a deposit, forfeiting the bonus if it has not matured
+func (f *Feature) handleWithdraw(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	var depositID int64
+	for _, opt := range options {
+		if opt.Name == "id" {
+			depositID = opt.IntValue()
+		}
+	}
+
+	f.withSavingsService(s, i, func(ctx context.Context, discordID, guildID int64, savingsService interfaces.SavingsService) (string, error) {
+		deposit, err := savingsService.Withdraw(ctx, discordID, depositID)
+		if errors.Is(err, entities.ErrSavingsDepositNotFound) {
+			return "", fmt.Errorf("you have no savings deposit #%d", depositID)
+		}
+		if err != nil {
+			return "", err
+		}
+
+		if deposit.Status == entities.SavingsDepositStatusMatured {
+			return fmt.Sprintf("🔓 Deposit #%d had matured: **%s bits** released with a **%s bit** bonus.",
+				deposit.ID, common.FormatBalance(deposit.Amount), common.FormatBalance(deposit.BonusAmount)), nil
+		}
+		return fmt.Sprintf("🔓 Withdrew **%s bits** from deposit #%d early. The %s bit bonus was forfeited.",
+			common.FormatBalance(deposit.Amount), deposit.ID, common.FormatBalance(deposit.BonusAmount)), nil
+	})
+}
+
+// handleList shows the user's locked deposits
+func (f *Feature) handleList(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	f.withSavingsService(s, i, func(ctx context.Context, discordID, guildID int64, savingsService interfaces.SavingsService) (string, error) {
+		deposits, err := savingsService.GetLockedDeposits(ctx, discordID)
+		if err != nil {
+			return "", err
+		}
+		return formatDepositList(deposits), nil
+	})
+}
+
+// withSavingsService runs fn in a guild-scoped transaction and responds with its message
+func (f *Feature) withSavingsService(s *discordgo.Session, i *discordgo.InteractionCreate, fn func(ctx context.Context, discordID, guildID int64, savingsService interfaces.SavingsService) (string, error)) {
+	ctx := context.Background()
+
+	discordID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing Discord ID %s: %v", i.Member.User.ID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+	defer uow.Rollback()
+
+	userService := uow.Services().UserService()
+	if _, err := userService.GetOrCreateUser(ctx, guildID, discordID, i.Member.User.Username); err != nil {
+		common.RespondWithError(s, i, common.DescribeError("Failed to create user", err))
+		return
+	}
+
+	message, err := fn(ctx, discordID, guildID, newSavingsService(uow))
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	if err := common.RespondWithSuccess(s, i, message, true); err != nil {
+		log.Errorf("Error responding to savings command: %v", err)
+	}
+}
+
+// newSavingsService creates a savings service from the unit of work's repositories
+func newSavingsService(uow application.UnitOfWork) interfaces.SavingsService {
+	return uow.Services().SavingsService()
+}
+
+// formatDepositList lists locked deposits with their maturity and bonus
+func formatDepositList(deposits []*entities.SavingsDeposit) string {
+	if len(deposits) == 0 {
+		return "You have no locked savings. Use `/savings deposit` to lock bits for a bonus."
+	}
+
+	var total int64
+	lines := make([]string, 0, len(deposits)+1)
+	for _, deposit := range deposits {
+		total += deposit.Amount
+		lines = append(lines, fmt.Sprintf("**#%d** • %s bits for %d week(s) • +%s bonus • matures %s",
+			deposit.ID,
+			common.FormatBalance(deposit.Amount),
+			deposit.TermWeeks,
+			common.FormatBalance(deposit.BonusAmount),
+			common.FormatDiscordTimestamp(deposit.MaturesAt, "R")))
+	}
+	lines = append(lines, fmt.Sprintf("Total locked: **%s bits**", common.FormatBalance(total)))
+
+	return strings.Join(lines, "\n")
+}
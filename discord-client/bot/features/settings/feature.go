@@ -4,8 +4,12 @@ import (
 	"gambler/discord-client/application"
 
 	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
 )
 
+// RulesModalCustomID identifies the modal opened by /settings rules
+const RulesModalCustomID = "settings_rules_modal"
+
 // Feature handles guild settings management
 type Feature struct {
 	session       *discordgo.Session
@@ -46,5 +50,83 @@ func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCr
 		f.handleLottoTicketCost(s, i)
 	case "lotto-difficulty":
 		f.handleLottoDifficulty(s, i)
+	case "lotto-rollover-cap":
+		f.handleLottoRolloverCap(s, i)
+	case "audit-channel":
+		f.handleAuditChannel(s, i)
+	case "digest-channel":
+		f.handleDigestChannel(s, i)
+	case "audit-threshold":
+		f.handleAuditThreshold(s, i)
+	case "curfew":
+		f.handleCurfew(s, i)
+	case "rules":
+		f.handleRules(s, i)
+	case "savings-bonus":
+		f.handleSavingsBonus(s, i)
+	case "stuck-wagers":
+		f.handleStuckWagers(s, i)
+	case "resolver-assignment":
+		f.handleResolverAssignment(s, i)
+	case "snipe-protection":
+		f.handleSnipeProtection(s, i)
+	case "house-option-cap":
+		f.handleHouseOptionCap(s, i)
+	case "starting-balance":
+		f.handleStartingBalance(s, i)
+	case "welcome-new-members":
+		f.handleWelcomeNewMembers(s, i)
+	case "rate-limit":
+		f.handleRateLimit(s, i)
+	case "tft-layout":
+		f.handleTFTLayout(s, i)
+	case "bet-mode":
+		f.handleBetMode(s, i)
+	case "own-game-bets":
+		f.handleOwnGameBets(s, i)
+	case "lol-queues":
+		f.handleLoLQueues(s, i)
+	case "global-leaderboard":
+		f.handleGlobalLeaderboard(s, i)
+	case "voting-period":
+		f.handleVotingPeriod(s, i)
+	case "pot-tax":
+		f.handlePotTax(s, i)
+	case "check":
+		f.handleCheck(s, i)
+	}
+}
+
+// HandleEconomyCommand routes economy limit commands to appropriate handlers
+func (f *Feature) HandleEconomyCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	switch options[0].Name {
+	case "balance-floor":
+		f.handleBalanceFloor(s, i)
+	case "min-bet":
+		f.handleMinBet(s, i)
+	case "season-tokens":
+		f.handleSeasonTokens(s, i)
+	case "new-season":
+		f.handleNewSeason(s, i)
+	}
+}
+
+// HandleInteraction handles settings modal submissions
+func (f *Feature) HandleInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if i.Type != discordgo.InteractionModalSubmit {
+		log.Warnf("Unknown interaction type in settings: %v", i.Type)
+		return
+	}
+
+	switch i.ModalSubmitData().CustomID {
+	case RulesModalCustomID:
+		f.handleRulesModalSubmit(s, i)
+	default:
+		log.Warnf("Unknown settings modal customID: %s", i.ModalSubmitData().CustomID)
 	}
 }
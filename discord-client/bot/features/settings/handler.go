@@ -2,11 +2,14 @@ package settings
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"gambler/discord-client/bot/common"
-	"gambler/discord-client/domain/services"
+	"gambler/discord-client/domain/entities"
 
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
@@ -58,14 +61,12 @@ func (f *Feature) handleHighRollerRole(s *discordgo.Session, i *discordgo.Intera
 	defer uow.Rollback()
 
 	// Instantiate guild settings service with repositories from UnitOfWork
-	guildSettingsService := services.NewGuildSettingsService(
-		uow.GuildSettingsRepository(),
-	)
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
 
 	// Update the high roller role setting
 	if err := guildSettingsService.UpdateHighRollerRole(ctx, guildID, roleID); err != nil {
 		log.Errorf("Failed to update high roller role: %v", err)
-		common.RespondWithError(s, i, "❌ Failed to update settings")
+		common.RespondWithError(s, i, settingsErrorMessage(err))
 		return
 	}
 
@@ -143,14 +144,12 @@ func (f *Feature) handlePrimaryChannel(s *discordgo.Session, i *discordgo.Intera
 	defer uow.Rollback()
 
 	// Instantiate guild settings service with repositories from UnitOfWork
-	guildSettingsService := services.NewGuildSettingsService(
-		uow.GuildSettingsRepository(),
-	)
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
 
 	// Update the primary channel setting
 	if err := guildSettingsService.UpdatePrimaryChannel(ctx, guildID, channelID); err != nil {
 		log.Errorf("Failed to update primary channel: %v", err)
-		common.RespondWithError(s, i, "❌ Failed to update settings")
+		common.RespondWithError(s, i, settingsErrorMessage(err))
 		return
 	}
 
@@ -228,14 +227,12 @@ func (f *Feature) handleLolChannel(s *discordgo.Session, i *discordgo.Interactio
 	defer uow.Rollback()
 
 	// Instantiate guild settings service with repositories from UnitOfWork
-	guildSettingsService := services.NewGuildSettingsService(
-		uow.GuildSettingsRepository(),
-	)
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
 
 	// Update the LOL channel setting
 	if err := guildSettingsService.UpdateLolChannel(ctx, guildID, channelID); err != nil {
 		log.Errorf("Failed to update LOL channel: %v", err)
-		common.RespondWithError(s, i, "❌ Failed to update settings")
+		common.RespondWithError(s, i, settingsErrorMessage(err))
 		return
 	}
 
@@ -313,14 +310,12 @@ func (f *Feature) handleTftChannel(s *discordgo.Session, i *discordgo.Interactio
 	defer uow.Rollback()
 
 	// Instantiate guild settings service with repositories from UnitOfWork
-	guildSettingsService := services.NewGuildSettingsService(
-		uow.GuildSettingsRepository(),
-	)
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
 
 	// Update the TFT channel setting
 	if err := guildSettingsService.UpdateTftChannel(ctx, guildID, channelID); err != nil {
 		log.Errorf("Failed to update TFT channel: %v", err)
-		common.RespondWithError(s, i, "❌ Failed to update settings")
+		common.RespondWithError(s, i, settingsErrorMessage(err))
 		return
 	}
 
@@ -398,14 +393,12 @@ func (f *Feature) handleWordleChannel(s *discordgo.Session, i *discordgo.Interac
 	defer uow.Rollback()
 
 	// Instantiate guild settings service with repositories from UnitOfWork
-	guildSettingsService := services.NewGuildSettingsService(
-		uow.GuildSettingsRepository(),
-	)
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
 
 	// Update the Wordle channel setting
 	if err := guildSettingsService.UpdateWordleChannel(ctx, guildID, channelID); err != nil {
 		log.Errorf("Failed to update Wordle channel: %v", err)
-		common.RespondWithError(s, i, "❌ Failed to update settings")
+		common.RespondWithError(s, i, settingsErrorMessage(err))
 		return
 	}
 
@@ -483,14 +476,12 @@ func (f *Feature) handleLottoChannel(s *discordgo.Session, i *discordgo.Interact
 	defer uow.Rollback()
 
 	// Instantiate guild settings service with repositories from UnitOfWork
-	guildSettingsService := services.NewGuildSettingsService(
-		uow.GuildSettingsRepository(),
-	)
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
 
 	// Update the lotto channel setting
 	if err := guildSettingsService.UpdateLottoChannel(ctx, guildID, channelID); err != nil {
 		log.Errorf("Failed to update lotto channel: %v", err)
-		common.RespondWithError(s, i, "Failed to update settings")
+		common.RespondWithError(s, i, settingsErrorMessage(err))
 		return
 	}
 
@@ -541,17 +532,7 @@ func (f *Feature) postLotteryMessage(ctx context.Context, guildID, channelID int
 	defer uow.Rollback()
 
 	// Get or create the current lottery draw
-	lotteryService := services.NewLotteryService(
-		uow.LotteryDrawRepository(),
-		uow.LotteryTicketRepository(),
-		uow.LotteryWinnerRepository(),
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.GroupWagerRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.GuildSettingsRepository(),
-		uow.EventBus(),
-	)
+	lotteryService := uow.Services().LotteryService()
 
 	// Get draw info (this will create a draw if none exists)
 	drawInfo, err := lotteryService.GetDrawInfo(ctx, guildID)
@@ -620,14 +601,12 @@ func (f *Feature) handleLottoTicketCost(s *discordgo.Session, i *discordgo.Inter
 	defer uow.Rollback()
 
 	// Instantiate guild settings service with repositories from UnitOfWork
-	guildSettingsService := services.NewGuildSettingsService(
-		uow.GuildSettingsRepository(),
-	)
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
 
 	// Update the lotto ticket cost setting
 	if err := guildSettingsService.UpdateLottoTicketCost(ctx, guildID, &cost); err != nil {
 		log.Errorf("Failed to update lotto ticket cost: %v", err)
-		common.RespondWithError(s, i, "Failed to update settings")
+		common.RespondWithError(s, i, settingsErrorMessage(err))
 		return
 	}
 
@@ -691,14 +670,12 @@ func (f *Feature) handleLottoDifficulty(s *discordgo.Session, i *discordgo.Inter
 	defer uow.Rollback()
 
 	// Instantiate guild settings service with repositories from UnitOfWork
-	guildSettingsService := services.NewGuildSettingsService(
-		uow.GuildSettingsRepository(),
-	)
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
 
 	// Update the lotto difficulty setting
 	if err := guildSettingsService.UpdateLottoDifficulty(ctx, guildID, &difficulty); err != nil {
 		log.Errorf("Failed to update lotto difficulty: %v", err)
-		common.RespondWithError(s, i, fmt.Sprintf("Failed to update settings: %v", err))
+		common.RespondWithError(s, i, settingsErrorMessage(err))
 		return
 	}
 
@@ -727,3 +704,2035 @@ func (f *Feature) handleLottoDifficulty(s *discordgo.Session, i *discordgo.Inter
 		log.Errorf("Failed to respond to interaction: %v", err)
 	}
 }
+
+// handleLottoRolloverCap handles the /settings lotto-rollover-cap command
+func (f *Feature) handleLottoRolloverCap(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the amount option (omit to remove the cap)
+	var rolloverCap *int64
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "amount" {
+			value := opt.IntValue()
+			rolloverCap = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the lotto rollover cap setting
+	if err := guildSettingsService.UpdateLottoRolloverCap(ctx, guildID, rolloverCap); err != nil {
+		log.Errorf("Failed to update lotto rollover cap: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "Lottery rollover cap removed. The full pot now rolls over when nobody wins."
+	if rolloverCap != nil {
+		message = fmt.Sprintf("At most %s bits will roll over into the next lottery draw. Anything above that is shared among the draw's ticket holders.", common.FormatBalance(*rolloverCap))
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleAuditChannel handles the /settings audit-channel command
+func (f *Feature) handleAuditChannel(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "❌ You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "❌ Failed to process command")
+		return
+	}
+
+	// Get the channel option (if provided)
+	options := i.ApplicationCommandData().Options[0].Options
+	var channelID *int64
+
+	if len(options) > 0 && options[0].Name == "channel" {
+		// User provided a channel
+		channelIDStr := options[0].ChannelValue(s).ID
+		if channelIDStr != "" {
+			channelIDInt, err := strconv.ParseInt(channelIDStr, 10, 64)
+			if err != nil {
+				log.Errorf("Failed to parse channel ID: %v", err)
+				common.RespondWithError(s, i, "❌ Invalid channel selected")
+				return
+			}
+			channelID = &channelIDInt
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "❌ Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the audit channel setting
+	if err := guildSettingsService.UpdateAuditChannel(ctx, guildID, channelID); err != nil {
+		log.Errorf("Failed to update audit channel: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "❌ Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	var message string
+	if channelID != nil {
+		message = fmt.Sprintf("✅ Audit channel updated to <#%d>", *channelID)
+	} else {
+		message = "✅ Audit log disabled"
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleDigestChannel handles the /settings digest-channel command
+func (f *Feature) handleDigestChannel(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "❌ You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "❌ Failed to process command")
+		return
+	}
+
+	// Get the channel option (if provided)
+	options := i.ApplicationCommandData().Options[0].Options
+	var channelID *int64
+
+	if len(options) > 0 && options[0].Name == "channel" {
+		// User provided a channel
+		channelIDStr := options[0].ChannelValue(s).ID
+		if channelIDStr != "" {
+			channelIDInt, err := strconv.ParseInt(channelIDStr, 10, 64)
+			if err != nil {
+				log.Errorf("Failed to parse channel ID: %v", err)
+				common.RespondWithError(s, i, "❌ Invalid channel selected")
+				return
+			}
+			channelID = &channelIDInt
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "❌ Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the digest channel setting
+	if err := guildSettingsService.UpdateDigestChannel(ctx, guildID, channelID); err != nil {
+		log.Errorf("Failed to update digest channel: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "❌ Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	var message string
+	if channelID != nil {
+		message = fmt.Sprintf("✅ Weekly digest channel updated to <#%d>", *channelID)
+	} else {
+		message = "✅ Weekly digest disabled"
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleAuditThreshold handles the /settings audit-threshold command
+func (f *Feature) handleAuditThreshold(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the threshold option
+	options := i.ApplicationCommandData().Options[0].Options
+	if len(options) == 0 {
+		common.RespondWithError(s, i, "Please provide an audit threshold")
+		return
+	}
+
+	threshold := options[0].IntValue()
+	if threshold <= 0 {
+		common.RespondWithError(s, i, "Audit threshold must be a positive number")
+		return
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the audit threshold setting
+	if err := guildSettingsService.UpdateAuditThreshold(ctx, guildID, &threshold); err != nil {
+		log.Errorf("Failed to update audit threshold: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := fmt.Sprintf("Audit threshold updated to %s bits", common.FormatBalance(threshold))
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleCurfew handles the /settings curfew command
+func (f *Feature) handleCurfew(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the hour options (both omitted disables the curfew)
+	var startHour, endHour *int
+	var timezone *string
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		switch opt.Name {
+		case "start_hour":
+			hour := int(opt.IntValue())
+			startHour = &hour
+		case "end_hour":
+			hour := int(opt.IntValue())
+			endHour = &hour
+		case "timezone":
+			zone := strings.TrimSpace(opt.StringValue())
+			timezone = &zone
+		}
+	}
+
+	if (startHour == nil) != (endHour == nil) {
+		common.RespondWithError(s, i, "Please provide both a start and end hour, or neither to disable the curfew")
+		return
+	}
+	if startHour != nil && *startHour == *endHour {
+		common.RespondWithError(s, i, "Curfew start and end hour must be different")
+		return
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the curfew setting
+	if err := guildSettingsService.UpdateBettingCurfew(ctx, guildID, startHour, endHour, timezone); err != nil {
+		log.Errorf("Failed to update betting curfew: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "Betting curfew disabled"
+	if startHour != nil {
+		zone := "UTC"
+		if timezone != nil {
+			zone = *timezone
+		}
+		message = fmt.Sprintf("Betting curfew set: new bets and wagers are rejected from %02d:00 to %02d:00 %s", *startHour, *endHour, zone)
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleRules handles the /settings rules command by opening a modal prefilled with the current rules
+func (f *Feature) handleRules(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "❌ You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "❌ Failed to process command")
+		return
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "❌ Failed to load settings")
+		return
+	}
+	defer uow.Rollback()
+
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	settings, err := guildSettingsService.GetOrCreateSettings(ctx, guildID)
+	if err != nil {
+		log.Errorf("Failed to get guild settings: %v", err)
+		common.RespondWithError(s, i, "❌ Failed to load settings")
+		return
+	}
+
+	currentRules := ""
+	if settings.HasRulesText() {
+		currentRules = *settings.RulesText
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: RulesModalCustomID,
+			Title:    "Wager Rules & Dispute Policy",
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "rules",
+							Label:       "Rules (markdown, leave empty for default)",
+							Style:       discordgo.TextInputParagraph,
+							Placeholder: "How wagers are resolved and how to dispute a result",
+							Value:       currentRules,
+							Required:    false,
+							MaxLength:   entities.MaxRulesTextLength,
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		log.Errorf("Failed to show rules modal: %v", err)
+	}
+}
+
+// handleRulesModalSubmit saves the rules entered in the /settings rules modal
+func (f *Feature) handleRulesModalSubmit(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Re-check permissions since the modal could be submitted after a role change
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "❌ You need administrator permissions to use this command")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "❌ Failed to process command")
+		return
+	}
+
+	var rules string
+	for _, comp := range i.ModalSubmitData().Components {
+		row, ok := comp.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, innerComp := range row.Components {
+			if textInput, ok := innerComp.(*discordgo.TextInput); ok && textInput.CustomID == "rules" {
+				rules = textInput.Value
+			}
+		}
+	}
+
+	ctx := context.Background()
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "❌ Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	if err := guildSettingsService.UpdateRulesText(ctx, guildID, rules); err != nil {
+		log.Errorf("Failed to update rules text: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "❌ Failed to update settings")
+		return
+	}
+
+	message := "✅ Server rules updated. Members can view them with /rules"
+	if strings.TrimSpace(rules) == "" {
+		message = "✅ Server rules reset to the default policy"
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleSavingsBonus handles the /settings savings-bonus command
+func (f *Feature) handleSavingsBonus(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the percent option (omit to restore the default)
+	var percent *int
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "percent" {
+			value := int(opt.IntValue())
+			percent = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the savings bonus setting
+	if err := guildSettingsService.UpdateSavingsBonusPercent(ctx, guildID, percent); err != nil {
+		log.Errorf("Failed to update savings bonus: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := fmt.Sprintf("Savings bonus reset to the default of %d%% per locked week", entities.DefaultSavingsBonusPercent)
+	if percent != nil {
+		message = fmt.Sprintf("Savings bonus set to %d%% per locked week. Existing deposits keep the rate they were opened with.", *percent)
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleStuckWagers handles the /settings stuck-wagers command
+func (f *Feature) handleStuckWagers(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the optional thresholds (omitting both disables reconciliation)
+	var reminderHours, cancelHours *int
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		value := int(opt.IntValue())
+		switch opt.Name {
+		case "reminder_hours":
+			reminderHours = &value
+		case "cancel_hours":
+			cancelHours = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the stuck wager settings
+	if err := guildSettingsService.UpdateStuckWagerReconciliation(ctx, guildID, reminderHours, cancelHours); err != nil {
+		log.Errorf("Failed to update stuck wager settings: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	var parts []string
+	if reminderHours != nil {
+		parts = append(parts, fmt.Sprintf("resolvers will be pinged after %d hours pending resolution", *reminderHours))
+	}
+	if cancelHours != nil {
+		parts = append(parts, fmt.Sprintf("wagers will be cancelled with full refunds after %d hours", *cancelHours))
+	}
+	message := "Stuck wager reconciliation disabled"
+	if len(parts) > 0 {
+		message = "Stuck wager reconciliation updated: " + strings.Join(parts, ", and ")
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleResolverAssignment handles the /settings resolver-assignment command
+func (f *Feature) handleResolverAssignment(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the enabled option
+	options := i.ApplicationCommandData().Options[0].Options
+	if len(options) == 0 {
+		common.RespondWithError(s, i, "Please choose whether resolvers are assigned automatically")
+		return
+	}
+
+	enabled := options[0].BoolValue()
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsService()
+
+	// Update the resolver assignment setting
+	if err := guildSettingsService.UpdateAutoAssignResolvers(ctx, guildID, enabled); err != nil {
+		log.Errorf("Failed to update resolver assignment setting: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	settings, err := guildSettingsService.GetOrCreateSettings(ctx, guildID)
+	if err != nil {
+		log.Errorf("Failed to get guild settings: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "Resolvers will no longer be assigned wagers automatically."
+	if enabled {
+		message = "Each wager awaiting resolution will now be assigned to the next user added with `/resolver add`, in rotation, who will be pinged with a link to it."
+		if settings.HasStuckWagerReminder() {
+			message += fmt.Sprintf(" If it's still unresolved after %d hours, all resolvers will be pinged.", *settings.StuckWagerReminderHours)
+		} else {
+			message += " Use `/settings stuck-wagers reminder_hours` to ping all resolvers when an assigned wager stays unresolved."
+		}
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleSnipeProtection handles the /settings snipe-protection command
+func (f *Feature) handleSnipeProtection(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the optional window and extension (omitting both disables protection)
+	var windowMinutes, extensionMinutes *int
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		value := int(opt.IntValue())
+		switch opt.Name {
+		case "window_minutes":
+			windowMinutes = &value
+		case "extension_minutes":
+			extensionMinutes = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the sniping protection settings
+	if err := guildSettingsService.UpdateSnipeProtection(ctx, guildID, windowMinutes, extensionMinutes); err != nil {
+		log.Errorf("Failed to update sniping protection: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "Sniping protection disabled"
+	if windowMinutes != nil {
+		message = fmt.Sprintf("Sniping protection enabled: bets in the last %d minutes of voting extend it by %d minutes",
+			*windowMinutes, *extensionMinutes)
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleHouseOptionCap handles the /settings house-option-cap command
+func (f *Feature) handleHouseOptionCap(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the amount option (omit to remove the cap)
+	var optionCap *int64
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "amount" {
+			value := opt.IntValue()
+			optionCap = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the house option cap setting
+	if err := guildSettingsService.UpdateHouseOptionCap(ctx, guildID, optionCap); err != nil {
+		log.Errorf("Failed to update house option cap: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "House wager option cap removed"
+	if optionCap != nil {
+		message = fmt.Sprintf("New LoL/TFT house wagers will accept at most %s bits on each option. Existing wagers keep their caps.", common.FormatBalance(*optionCap))
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleStartingBalance handles the /settings starting-balance command
+func (f *Feature) handleStartingBalance(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the amount option (omit to restore the default)
+	var startingBalance *int64
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "amount" {
+			value := opt.IntValue()
+			startingBalance = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the starting balance setting
+	if err := guildSettingsService.UpdateStartingBalance(ctx, guildID, startingBalance); err != nil {
+		log.Errorf("Failed to update starting balance: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := fmt.Sprintf("New players will start with the default %s bits. Existing balances are unchanged.", common.FormatBalance(entities.DefaultStartingBalance))
+	if startingBalance != nil {
+		message = fmt.Sprintf("New players will start with %s bits. Existing balances are unchanged.", common.FormatBalance(*startingBalance))
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleBalanceFloor handles the /economy balance-floor command
+func (f *Feature) handleBalanceFloor(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the amount option (omit to remove the floor)
+	var floor *int64
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "amount" {
+			value := opt.IntValue()
+			floor = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the balance floor setting
+	if err := guildSettingsService.UpdateMinBalanceFloor(ctx, guildID, floor); err != nil {
+		log.Errorf("Failed to update balance floor: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "Balance floor removed. Players can bet their entire balance."
+	if floor != nil {
+		message = fmt.Sprintf("Players must now keep %s bits in reserve. Bets and lottery tickets that would dip below it are rejected, and players get a DM when their balance drops under it.", common.FormatBalance(*floor))
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleMinBet handles the /economy min-bet command
+func (f *Feature) handleMinBet(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the amount option (omit to remove the minimum)
+	var minBet *int64
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "amount" {
+			value := opt.IntValue()
+			minBet = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the minimum bet, which is validated against the house option cap
+	if err := guildSettingsService.UpdateMinBetAmount(ctx, guildID, minBet); err != nil {
+		log.Errorf("Failed to update minimum bet: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "Minimum bet removed. Players can bet any amount."
+	if minBet != nil {
+		message = fmt.Sprintf("Bets, wager proposals and lottery purchases must now be at least %s bits.", common.FormatBalance(*minBet))
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleSeasonTokens handles the /economy season-tokens command
+func (f *Feature) handleSeasonTokens(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the name and rate options (omit the name to disable season tokens)
+	var name *string
+	var rate *int64
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		switch opt.Name {
+		case "name":
+			value := strings.TrimSpace(opt.StringValue())
+			name = &value
+		case "rate":
+			value := opt.IntValue()
+			rate = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the season token settings
+	if err := guildSettingsService.UpdateSeasonTokens(ctx, guildID, name, rate); err != nil {
+		log.Errorf("Failed to update season tokens: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "Season tokens disabled. Players no longer earn tokens from wins; existing balances are kept."
+	if name != nil {
+		tokenRate := int64(entities.DefaultSeasonTokenRate)
+		if rate != nil {
+			tokenRate = *rate
+		}
+		message = fmt.Sprintf("Players now earn 1 **%s** for every %s bits they win this season.", *name, common.FormatBalance(tokenRate))
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleNewSeason handles the /economy new-season command
+func (f *Feature) handleNewSeason(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to start a new season")
+		return
+	}
+	defer uow.Rollback()
+
+	seasonTokenService := uow.Services().SeasonTokenService()
+
+	season, err := seasonTokenService.StartNewSeason(ctx, guildID)
+	if errors.Is(err, entities.ErrSeasonTokensDisabled) {
+		common.RespondWithError(s, i, "Season tokens are disabled. Set them up with /economy season-tokens first.")
+		return
+	}
+	if err != nil {
+		log.Errorf("Failed to start new season: %v", err)
+		common.RespondWithError(s, i, "Failed to start a new season")
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to start a new season")
+		return
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("Season %d has started. Everyone's season token balance starts again from zero.", season),
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleWelcomeNewMembers handles the /settings welcome-new-members command
+func (f *Feature) handleWelcomeNewMembers(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the enabled option
+	options := i.ApplicationCommandData().Options[0].Options
+	if len(options) == 0 {
+		common.RespondWithError(s, i, "Please choose whether to welcome new members")
+		return
+	}
+
+	enabled := options[0].BoolValue()
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the welcome new members setting
+	if err := guildSettingsService.UpdateWelcomeNewMembers(ctx, guildID, enabled); err != nil {
+		log.Errorf("Failed to update welcome new members: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "Joining members will no longer be welcomed. Accounts are created on their first command."
+	if enabled {
+		message = "Joining members will get an account right away and a welcome message in the primary channel."
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleRateLimit handles the /settings rate-limit command
+func (f *Feature) handleRateLimit(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Omitted options restore their defaults
+	var perMinute, burst *int
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		value := int(opt.IntValue())
+		switch opt.Name {
+		case "per_minute":
+			perMinute = &value
+		case "burst":
+			burst = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the rate limit settings
+	if err := guildSettingsService.UpdateRateLimit(ctx, guildID, perMinute, burst); err != nil {
+		log.Errorf("Failed to update rate limit: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	limit := (&entities.GuildSettings{RateLimitPerMinute: perMinute, RateLimitBurst: burst}).GetRateLimit()
+	message := "Rate limiting is disabled. Players can bet, buy lottery tickets and transfer as fast as they like."
+	if limit.Enabled() {
+		message = fmt.Sprintf("Players can take %d bet, lottery or transfer actions back to back, then %d per minute.", limit.Burst, limit.PerMinute)
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleTFTLayout handles the /settings tft-layout command
+func (f *Feature) handleTFTLayout(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// An omitted layout restores the default
+	var layout *entities.TFTPlacementLayout
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "layout" {
+			value := entities.TFTPlacementLayout(opt.StringValue())
+			layout = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the TFT placement layout
+	if err := guildSettingsService.UpdateTFTPlacementLayout(ctx, guildID, layout); err != nil {
+		log.Errorf("Failed to update TFT placement layout: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	chosen := entities.DefaultTFTPlacementLayout
+	if layout != nil {
+		chosen = *layout
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("TFT wagers will now offer: **%s**. Wagers already open keep their options.", chosen.DisplayName()),
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleBetMode handles the /settings bet-mode command
+func (f *Feature) handleBetMode(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// An omitted mode restores the default
+	var mode *entities.GroupWagerBetMode
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "mode" {
+			value := entities.GroupWagerBetMode(opt.StringValue())
+			mode = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsService()
+
+	// Update the group wager bet mode
+	if err := guildSettingsService.UpdateGroupWagerBetMode(ctx, guildID, mode); err != nil {
+		log.Errorf("Failed to update group wager bet mode: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	chosen := entities.DefaultGroupWagerBetMode
+	if mode != nil {
+		chosen = *mode
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("Bettors will now choose group wager options from **%s**. Open wagers switch the next time their message updates.", chosen.DisplayName()),
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleOwnGameBets handles the /settings own-game-bets command
+func (f *Feature) handleOwnGameBets(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the blocked option
+	options := i.ApplicationCommandData().Options[0].Options
+	if len(options) == 0 {
+		common.RespondWithError(s, i, "Please choose whether to block bets on own games")
+		return
+	}
+
+	blocked := options[0].BoolValue()
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	// Update the own game bets setting
+	if err := guildSettingsService.UpdateBlockOwnGameBets(ctx, guildID, blocked); err != nil {
+		log.Errorf("Failed to update own game bets: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "Players can now bet on LoL and TFT wagers about their own linked games."
+	if blocked {
+		message = "Players can no longer bet on LoL and TFT wagers about their own linked games."
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleLoLQueues handles the /settings lol-queues command
+func (f *Feature) handleLoLQueues(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the non-ranked option
+	options := i.ApplicationCommandData().Options[0].Options
+	if len(options) == 0 {
+		common.RespondWithError(s, i, "Please choose whether non-ranked games create wagers")
+		return
+	}
+
+	enabled := options[0].BoolValue()
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsService()
+
+	// Update the non-ranked queue setting
+	if err := guildSettingsService.UpdateLolNonRankedWagers(ctx, guildID, enabled); err != nil {
+		log.Errorf("Failed to update LoL queue setting: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "LoL house wagers are now only created for ranked Solo/Duo and Flex games."
+	if enabled {
+		message = "LoL house wagers are now also created for ARAM, normal and custom games, with their own odds."
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleGlobalLeaderboard handles the /settings global-leaderboard command
+func (f *Feature) handleGlobalLeaderboard(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the share option
+	options := i.ApplicationCommandData().Options[0].Options
+	if len(options) == 0 {
+		common.RespondWithError(s, i, "Please choose whether to share stats with the global leaderboard")
+		return
+	}
+
+	shared := options[0].BoolValue()
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsService()
+
+	// Update the global leaderboard sharing setting
+	if err := guildSettingsService.UpdateShareGlobalLeaderboard(ctx, guildID, shared); err != nil {
+		log.Errorf("Failed to update global leaderboard sharing: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "This server's predictions are no longer shared, and `/leaderboard global` is unavailable here."
+	if shared {
+		message = "This server's group wager predictions now count toward `/leaderboard global`. " +
+			"Players can hide themselves with `/preferences global-leaderboard`."
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleVotingPeriod handles the /settings voting-period command
+func (f *Feature) handleVotingPeriod(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the period option (omit to restore the default)
+	var minutes *int
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "period" {
+			value, err := common.ParseDurationMinutes(opt.StringValue())
+			if err != nil {
+				common.RespondWithError(s, i, fmt.Sprintf("Invalid voting period: %v", err))
+				return
+			}
+			minutes = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	// Instantiate guild settings service with repositories from UnitOfWork
+	guildSettingsService := uow.Services().GuildSettingsService()
+
+	// Update the default voting period setting
+	if err := guildSettingsService.UpdateDefaultVotingPeriod(ctx, guildID, minutes); err != nil {
+		log.Errorf("Failed to update default voting period: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "New group wagers will default to a 24 hour voting period"
+	if minutes != nil {
+		message = fmt.Sprintf("New group wagers will default to a %s voting period. Creators can still change it.",
+			common.FormatDuration(time.Duration(*minutes)*time.Minute))
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handlePotTax handles the /settings pot-tax subcommand
+func (f *Feature) handlePotTax(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	// Get the percent option (omit to disable)
+	var percent *int
+	for _, opt := range i.ApplicationCommandData().Options[0].Options {
+		if opt.Name == "percent" {
+			value := int(opt.IntValue())
+			percent = &value
+		}
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+	defer uow.Rollback()
+
+	guildSettingsService := uow.Services().GuildSettingsService()
+
+	if err := guildSettingsService.UpdatePotTaxPercent(ctx, guildID, percent); err != nil {
+		log.Errorf("Failed to update pot tax: %v", err)
+		common.RespondWithError(s, i, settingsErrorMessage(err))
+		return
+	}
+
+	settings, err := guildSettingsService.GetOrCreateSettings(ctx, guildID)
+	if err != nil {
+		log.Errorf("Failed to get guild settings: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Commit the transaction
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to update settings")
+		return
+	}
+
+	// Respond with success
+	message := "Pot tax disabled. Resolved group wagers no longer add to the lottery pot"
+	if percent != nil {
+		message = fmt.Sprintf("%d%% of each resolved group wager's pot is now taken from the winnings and added to the lottery pot", *percent)
+		if !settings.IsLottoEnabled() {
+			message += ". No tax is collected until a lottery channel is set with `/settings lotto-channel`"
+		}
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// handleCheck handles the /settings check command
+func (f *Feature) handleCheck(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Check if user has admin permissions
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "You need administrator permissions to use this command")
+		return
+	}
+
+	// Parse guild ID
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID: %v", err)
+		common.RespondWithError(s, i, "Failed to process command")
+		return
+	}
+
+	ctx := context.Background()
+
+	// Create guild-scoped unit of work
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to load settings")
+		return
+	}
+	defer uow.Rollback()
+
+	guildSettingsService := uow.Services().GuildSettingsServiceWithChannels(common.NewChannelLookup(s))
+
+	settings, err := guildSettingsService.GetOrCreateSettings(ctx, guildID)
+	if err != nil {
+		log.Errorf("Failed to get guild settings: %v", err)
+		common.RespondWithError(s, i, "Failed to load settings")
+		return
+	}
+
+	message := "✅ All settings are valid."
+	if err := guildSettingsService.ValidateSettings(ctx, settings); err != nil {
+		var validationErr *entities.SettingsValidationError
+		if !errors.As(err, &validationErr) {
+			log.Errorf("Failed to validate guild settings: %v", err)
+			common.RespondWithError(s, i, "Failed to check settings")
+			return
+		}
+		message = "⚠️ Some settings need attention:\n" + formatViolations(validationErr)
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Failed to load settings")
+		return
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Failed to respond to interaction: %v", err)
+	}
+}
+
+// settingsErrorMessage explains validation failures to the admin and hides anything else
+func settingsErrorMessage(err error) string {
+	var validationErr *entities.SettingsValidationError
+	if errors.As(err, &validationErr) {
+		return "Invalid settings:\n" + formatViolations(validationErr)
+	}
+	return "Failed to update settings"
+}
+
+// formatViolations lists each violation on its own line
+func formatViolations(validationErr *entities.SettingsValidationError) string {
+	lines := make([]string, len(validationErr.Violations))
+	for idx, v := range validationErr.Violations {
+		lines[idx] = fmt.Sprintf("• `%s`: %s", v.Field, v.Message)
+	}
+	return strings.Join(lines, "\n")
+}
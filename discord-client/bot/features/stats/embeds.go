@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"gambler/discord-client/application"
@@ -29,6 +30,9 @@ var ScoreboardPages = []string{PageBits, PageLoL, PageTFT, PageGamble}
 // Constants for leaderboard display
 const MinGameWagersForLeaderboard = 5
 
+// scoreboardBadgeLimit is how many of the top players have their badges listed under the bits scoreboard
+const scoreboardBadgeLimit = 10
+
 // getMedalForRank returns the appropriate medal emoji or rank number
 func getMedalForRank(rank int) string {
 	switch rank {
@@ -130,9 +134,9 @@ func buildGamePage(ctx context.Context, embed *discordgo.MessageEmbed, metricsSe
 
 	switch gameName {
 	case "LoL":
-		entries, totalBitsWagered, err = metricsService.GetLOLLeaderboard(ctx, MinGameWagersForLeaderboard)
+		entries, totalBitsWagered, err = metricsService.GetLOLLeaderboard(ctx, MinGameWagersForLeaderboard, nil)
 	case "TFT":
-		entries, totalBitsWagered, err = metricsService.GetTFTLeaderboard(ctx, MinGameWagersForLeaderboard)
+		entries, totalBitsWagered, err = metricsService.GetTFTLeaderboard(ctx, MinGameWagersForLeaderboard, nil)
 	default:
 		embed.Description = "⚠️ Unknown game type"
 		return nil
@@ -311,3 +315,178 @@ func BuildUserStatsEmbed(userStats *entities.UserStats, targetName string) *disc
 
 	return embed
 }
+
+// formatBiggestTransactions describes a user's biggest win and loss with what they came from
+func formatBiggestTransactions(userStats *entities.UserStats) string {
+	var lines []string
+	if len(userStats.BiggestWins) > 0 {
+		win := userStats.BiggestWins[0]
+		lines = append(lines, fmt.Sprintf("Biggest win: **%s** %s",
+			common.FormatBalanceCompact(win.ChangeAmount), win.ContextDescription()))
+	}
+	if len(userStats.BiggestLosses) > 0 {
+		loss := userStats.BiggestLosses[0]
+		lines = append(lines, fmt.Sprintf("Biggest loss: **%s** %s",
+			common.FormatBalanceCompact(-loss.ChangeAmount), loss.ContextDescription()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatUserBadges names each unlocked achievement next to its badge, in definition order
+func formatUserBadges(achievements []*entities.Achievement) string {
+	unlocked := make(map[entities.AchievementKey]bool, len(achievements))
+	for _, achievement := range achievements {
+		unlocked[achievement.Key] = true
+	}
+
+	var badges []string
+	for _, def := range entities.AchievementDefinitions {
+		if unlocked[def.Key] {
+			badges = append(badges, fmt.Sprintf("%s %s", def.Badge, def.Name))
+		}
+	}
+	return strings.Join(badges, " · ")
+}
+
+// formatScoreboardBadges lists the badges of the top players in rank order, skipping players without any
+func formatScoreboardBadges(entries []*entities.ScoreboardEntry, achievements map[int64][]*entities.Achievement) string {
+	var lines []string
+	for _, entry := range entries[:min(len(entries), scoreboardBadgeLimit)] {
+		if badges := entities.FormatBadges(achievements[entry.DiscordID]); badges != "" {
+			lines = append(lines, fmt.Sprintf("%s <@%d> %s", getMedalForRank(entry.Rank), entry.DiscordID, badges))
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "\n\n🎖️ **Badges**\n" + strings.Join(lines, "\n")
+}
+
+// formatPlayerGames summarises the results of a linked player's own LoL and TFT games
+func formatPlayerGames(games *entities.PlayerGameStats) string {
+	var lines []string
+	if games.LoLGames > 0 {
+		lines = append(lines, fmt.Sprintf("LoL: **%dW %dL** (%.0f%% win rate)",
+			games.LoLWins, games.LoLGames-games.LoLWins, float64(games.LoLWins)/float64(games.LoLGames)*100))
+	}
+	if games.TFTGames > 0 {
+		lines = append(lines, fmt.Sprintf("TFT: **%d games**, top half in %d (%.0f%%)",
+			games.TFTGames, games.TFTTopHalf, float64(games.TFTTopHalf)/float64(games.TFTGames)*100))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// sparklineLevels are the bar heights used by sparkline, lowest first
+var sparklineLevels = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline renders values as a row of bars scaled between their minimum and maximum
+func sparkline(values []int64) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	low, high := values[0], values[0]
+	for _, v := range values {
+		low = min(low, v)
+		high = max(high, v)
+	}
+
+	var sb strings.Builder
+	for _, v := range values {
+		level := 0
+		if high > low {
+			level = int((v - low) * int64(len(sparklineLevels)-1) / (high - low))
+		}
+		sb.WriteRune(sparklineLevels[level])
+	}
+	return sb.String()
+}
+
+// dailyResultRow renders one square per day: green for a net win, red for a net loss,
+// yellow for a break-even day with activity and black for a quiet day
+func dailyResultRow(buckets []*entities.StatsTimeSeriesBucket) string {
+	var sb strings.Builder
+	for _, b := range buckets {
+		switch {
+		case !b.HasActivity():
+			sb.WriteString("⬛")
+		case b.NetProfit > 0:
+			sb.WriteString("🟩")
+		case b.NetProfit < 0:
+			sb.WriteString("🟥")
+		default:
+			sb.WriteString("🟨")
+		}
+	}
+	return sb.String()
+}
+
+// BuildStatsHistoryEmbed creates the embed for /stats history
+func BuildStatsHistoryEmbed(series *entities.UserStatsTimeSeries, targetName string) *discordgo.MessageEmbed {
+	scope := "All games"
+	if series.System != nil {
+		switch *series.System {
+		case entities.SystemLeagueOfLegends:
+			scope = "League of Legends"
+		case entities.SystemTFT:
+			scope = "Teamfight Tactics"
+		}
+	}
+
+	balances := make([]int64, len(series.Buckets))
+	for idx, b := range series.Buckets {
+		balances[idx] = b.ClosingBalance
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📈 Last %d days for %s", len(series.Buckets), targetName),
+		Description: scope,
+		Color:       common.ColorPrimary,
+	}
+
+	if len(series.Buckets) == 0 {
+		return embed
+	}
+
+	first, last := series.Buckets[0], series.Buckets[len(series.Buckets)-1]
+	wins, losses := series.TotalWins(), series.TotalLosses()
+
+	embed.Fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   "Record",
+			Value:  fmt.Sprintf("%d W / %d L (%.0f%%)", wins, losses, winRate(wins, losses)),
+			Inline: true,
+		},
+		{
+			Name:   "Net",
+			Value:  formatProfitLoss(series.NetProfit()),
+			Inline: true,
+		},
+		{
+			Name:   "Daily Results",
+			Value:  dailyResultRow(series.Buckets),
+			Inline: false,
+		},
+		{
+			Name: "Balance",
+			Value: fmt.Sprintf("`%s`\n%s → %s bits",
+				sparkline(balances),
+				common.FormatBalanceCompact(first.OpeningBalance),
+				common.FormatBalanceCompact(last.ClosingBalance)),
+			Inline: false,
+		},
+	}
+	embed.Footer = &discordgo.MessageEmbedFooter{
+		Text: fmt.Sprintf("%s – %s (UTC)", first.Day.Format("Jan 2"), last.Day.Format("Jan 2")),
+	}
+
+	return embed
+}
+
+// winRate returns wins as a percentage of decided results
+func winRate(wins, losses int) float64 {
+	if wins+losses == 0 {
+		return 0
+	}
+	return float64(wins) / float64(wins+losses) * 100
+}
@@ -0,0 +1,37 @@
+package stats
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatScoreboardBadges(t *testing.T) {
+	t.Parallel()
+
+	entries := []*entities.ScoreboardEntry{
+		{Rank: 1, DiscordID: 1},
+		{Rank: 2, DiscordID: 2},
+		{Rank: 3, DiscordID: 3},
+	}
+
+	assert.Empty(t, formatScoreboardBadges(entries, nil))
+
+	achievements := map[int64][]*entities.Achievement{
+		1: {{Key: entities.AchievementWinStreak10}, {Key: entities.AchievementFirstWin}},
+		3: {{Key: entities.AchievementLotteryWinner}},
+	}
+	assert.Equal(t, "\n\n🎖️ **Badges**\n🥇 <@1> 🩸 🔥\n🥉 <@3> 🎰", formatScoreboardBadges(entries, achievements))
+}
+
+func TestFormatUserBadges(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, formatUserBadges(nil))
+	assert.Equal(t, "🩸 First Blood · 🐋 Whale", formatUserBadges([]*entities.Achievement{
+		{Key: entities.AchievementBitsWagered1M},
+		{Key: entities.AchievementFirstWin},
+	}))
+}
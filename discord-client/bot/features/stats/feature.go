@@ -5,10 +5,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"gambler/discord-client/application"
 	"gambler/discord-client/bot/common"
-	"gambler/discord-client/domain/services"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
 
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
@@ -32,11 +34,49 @@ func NewFeature(session *discordgo.Session, uowFactory application.UnitOfWorkFac
 	}
 }
 
+// newReadOnlyMetricsService creates a metrics service on the guild's read-only repositories
+func (f *Feature) newReadOnlyMetricsService(guildID int64) interfaces.UserMetricsService {
+	return f.uowFactory.CreateReadOnlyForGuild(guildID).Services().UserMetricsService()
+}
+
+// scoreboardBadges returns the badge listing appended to the bits scoreboard, empty if it cannot be loaded
+func scoreboardBadges(ctx context.Context, uow application.UnitOfWork, entries []*entities.ScoreboardEntry) string {
+	top := entries[:min(len(entries), scoreboardBadgeLimit)]
+	discordIDs := make([]int64, len(top))
+	for i, entry := range top {
+		discordIDs[i] = entry.DiscordID
+	}
+
+	achievements, err := uow.Services().AchievementService().GetAchievementsForUsers(ctx, discordIDs)
+	if err != nil {
+		log.WithError(err).Warn("Failed to load scoreboard badges")
+		return ""
+	}
+	return formatScoreboardBadges(entries, achievements)
+}
+
+// userBadges returns the user's earned badges for the stats embed, empty if they have none or they cannot be loaded
+func (f *Feature) userBadges(ctx context.Context, guildID, discordID int64) string {
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		return ""
+	}
+	defer uow.Rollback()
+
+	achievements, err := uow.Services().AchievementService().GetAchievements(ctx, discordID)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to load badges for user %d", discordID)
+		return ""
+	}
+	return formatUserBadges(achievements)
+}
+
 // HandleCommand handles the /stats command and its subcommands
 func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	options := i.ApplicationCommandData().Options
 	if len(options) == 0 {
-		common.RespondWithError(s, i, "Please specify a subcommand: scoreboard or balance")
+		common.RespondWithError(s, i, "Please specify a subcommand: scoreboard, balance or history")
 		return
 	}
 
@@ -46,6 +86,8 @@ func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCr
 		f.handleStatsScoreboard(s, i)
 	case "balance":
 		f.handleStatsBalance(s, i, options[0].Options)
+	case "history":
+		f.handleStatsHistory(s, i, options[0].Options)
 	default:
 		common.RespondWithError(s, i, "Unknown subcommand")
 	}
@@ -55,6 +97,12 @@ func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCr
 func (f *Feature) HandleInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	customID := i.MessageComponentData().CustomID
 
+	// Prediction leaderboard pages carry their own filters
+	if strings.HasPrefix(customID, predictionPagePrefix) {
+		f.handlePredictionLeaderboardPage(s, i, customID)
+		return
+	}
+
 	// Handle page navigation buttons
 	if len(customID) > 11 && customID[:11] == "stats_page_" {
 		targetPage := customID[11:]
@@ -92,14 +140,8 @@ func (f *Feature) updateScoreboardPage(s *discordgo.Session, channelID, messageI
 	}
 	defer uow.Rollback()
 
-	// Instantiate user metrics service
-	metricsService := services.NewUserMetricsService(
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.BetRepository(),
-		uow.GroupWagerRepository(),
-		uow.BalanceHistoryRepository(),
-	)
+	// Metrics are read from the replica, away from transactional writes
+	metricsService := f.newReadOnlyMetricsService(guildID)
 
 	// Get scoreboard entries
 	entries, totalBits, err := metricsService.GetScoreboard(ctx, 0)
@@ -111,20 +153,12 @@ func (f *Feature) updateScoreboardPage(s *discordgo.Session, channelID, messageI
 	// Get high roller info before creating embed
 	var highRollerText string
 	if page == PageBits { // Only show on bits page
-		highRollerService := services.NewHighRollerService(
-			uow.HighRollerPurchaseRepository(),
-			uow.UserRepository(),
-			uow.WagerRepository(),
-			uow.GroupWagerRepository(),
-			uow.BalanceHistoryRepository(),
-			uow.GuildSettingsRepository(),
-			uow.EventBus(),
-		)
+		highRollerService := uow.Services().HighRollerService()
 
 		highRollerInfo, err := highRollerService.GetCurrentHighRoller(ctx, guildID)
 		if err == nil && highRollerInfo.CurrentHolder != nil {
 			// Get the role ID for mention
-			guildSettingsService := services.NewGuildSettingsService(uow.GuildSettingsRepository())
+			guildSettingsService := uow.Services().GuildSettingsService()
 			if roleID, err := guildSettingsService.GetHighRollerRoleID(ctx, guildID); err == nil && roleID != nil {
 				highRollerText = fmt.Sprintf("\n<@&%d> - <@%d> - %s - %s",
 					*roleID,
@@ -142,6 +176,9 @@ func (f *Feature) updateScoreboardPage(s *discordgo.Session, channelID, messageI
 	if highRollerText != "" && embed.Description != "" {
 		embed.Description += highRollerText
 	}
+	if page == PageBits && imageData != nil {
+		embed.Description += scoreboardBadges(ctx, uow, entries)
+	}
 
 	// Commit the transaction after building the embed
 	if err := uow.Commit(); err != nil {
@@ -0,0 +1,156 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// GlobalLeaderboardSize is how many players the global leaderboard lists
+const GlobalLeaderboardSize = 10
+
+// handleGlobalLeaderboard displays the cross-guild prediction leaderboard. Only guilds that share their
+// stats can view it, so every guild on the board has agreed to the comparison.
+func (f *Feature) handleGlobalLeaderboard(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	ctx := context.Background()
+
+	by := entities.GlobalLeaderboardSortAccuracy
+	minPredictions := entities.MinGlobalLeaderboardPredictions
+	for _, opt := range options {
+		switch opt.Name {
+		case "sort":
+			by = entities.GlobalLeaderboardSort(opt.StringValue())
+		case "min_wagers":
+			minPredictions = int(opt.IntValue())
+		}
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	viewerID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing Discord ID %s: %v", i.Member.User.ID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	shared, err := f.sharesGlobalLeaderboard(ctx, guildID)
+	if err != nil {
+		log.Errorf("Error checking global leaderboard sharing for guild %d: %v", guildID, err)
+		common.RespondWithError(s, i, "Unable to retrieve the global leaderboard. Please try again.")
+		return
+	}
+	if !shared {
+		common.RespondWithError(s, i, "This server doesn't share its stats with the global leaderboard. "+
+			"An admin can join with `/settings global-leaderboard share:True`.")
+		return
+	}
+
+	// The global leaderboard is a heavy cross-guild read, so it runs on the replica
+	leaderboardService := f.uowFactory.CreateReadOnlyForGuild(guildID).Services().GlobalLeaderboardService()
+	ranked, err := leaderboardService.GetLeaderboard(ctx, guildID, by, minPredictions)
+	if err != nil {
+		log.Errorf("Error getting global leaderboard: %v", err)
+		common.RespondWithError(s, i, "Unable to retrieve the global leaderboard. Please try again.")
+		return
+	}
+
+	embed := BuildGlobalLeaderboardEmbed(ranked, by, minPredictions, viewerID)
+	if err := common.RespondWithEmbed(s, i, embed, nil, false); err != nil {
+		log.Errorf("Error responding with global leaderboard: %v", err)
+	}
+}
+
+// sharesGlobalLeaderboard checks whether the guild has opted in to the global leaderboard
+func (f *Feature) sharesGlobalLeaderboard(ctx context.Context, guildID int64) (bool, error) {
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	settings, err := uow.Services().GuildSettingsService().GetOrCreateSettings(ctx, guildID)
+	if err != nil {
+		return false, err
+	}
+	return settings.ShareGlobalLeaderboard, nil
+}
+
+// BuildGlobalLeaderboardEmbed renders the top of the global leaderboard. Bits aren't comparable between
+// guild economies, so only rates are shown, and players outside the viewing guild stay anonymous.
+// The viewer's own rank is added when they are ranked below the listed players.
+func BuildGlobalLeaderboardEmbed(ranked []*entities.GlobalLeaderboardEntry, by entities.GlobalLeaderboardSort, minPredictions int, viewerID int64) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("🌐 Global Leaderboard — %s", globalLeaderboardSortLabel(by)),
+		Color: common.ColorPrimary,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Minimum %d predictions to qualify • Hide yourself with /preferences global-leaderboard", minPredictions),
+		},
+	}
+
+	if len(ranked) == 0 {
+		embed.Description = "No players qualify yet"
+		return embed
+	}
+
+	var sb strings.Builder
+	for _, entry := range ranked[:min(GlobalLeaderboardSize, len(ranked))] {
+		sb.WriteString(formatGlobalLeaderboardLine(entry))
+		sb.WriteString("\n")
+	}
+	embed.Description = sb.String()
+
+	for _, entry := range ranked[min(GlobalLeaderboardSize, len(ranked)):] {
+		if entry.DiscordID == viewerID {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:  "Your Rank",
+				Value: formatGlobalLeaderboardLine(entry),
+			})
+			break
+		}
+	}
+
+	return embed
+}
+
+// formatGlobalLeaderboardLine renders a single ranked player
+func formatGlobalLeaderboardLine(entry *entities.GlobalLeaderboardEntry) string {
+	name := "*Player in another server*"
+	if entry.InViewerGuild {
+		name = common.GetUserMention(entry.DiscordID)
+	}
+
+	servers := "1 server"
+	if entry.GuildCount != 1 {
+		servers = fmt.Sprintf("%d servers", entry.GuildCount)
+	}
+
+	return fmt.Sprintf("%s %s — **%.1f%%** (%d/%d) • %+.1f%% profit • %s",
+		getMedalForRank(entry.Rank),
+		name,
+		entry.AccuracyPercentage(),
+		entry.CorrectPredictions,
+		entry.TotalPredictions,
+		entry.ProfitPercentage(),
+		servers)
+}
+
+// globalLeaderboardSortLabel returns the display name for a global leaderboard sort
+func globalLeaderboardSortLabel(by entities.GlobalLeaderboardSort) string {
+	if by == entities.GlobalLeaderboardSortProfit {
+		return "Profit %"
+	}
+	return "Accuracy"
+}
@@ -0,0 +1,57 @@
+package stats
+
+import (
+	"strings"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rankedGlobalPlayers(n int) []*entities.GlobalLeaderboardEntry {
+	ranked := make([]*entities.GlobalLeaderboardEntry, n)
+	for i := range ranked {
+		ranked[i] = &entities.GlobalLeaderboardEntry{
+			Rank:               i + 1,
+			DiscordID:          int64(i + 1),
+			GuildCount:         2,
+			InViewerGuild:      i%2 == 0,
+			CorrectPredictions: 8,
+			TotalPredictions:   10,
+			TotalWagered:       4000,
+			NetProfit:          500,
+		}
+	}
+	return ranked
+}
+
+func TestBuildGlobalLeaderboardEmbed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("anonymizes players outside the viewing guild", func(t *testing.T) {
+		embed := BuildGlobalLeaderboardEmbed(rankedGlobalPlayers(5), entities.GlobalLeaderboardSortAccuracy, 10, 99)
+
+		assert.Contains(t, embed.Title, "Accuracy")
+		lines := strings.Split(strings.TrimSpace(embed.Description), "\n")
+		require.Len(t, lines, 5)
+		assert.Equal(t, "🥇 <@1> — **80.0%** (8/10) • +12.5% profit • 2 servers", lines[0])
+		assert.Equal(t, "🥈 *Player in another server* — **80.0%** (8/10) • +12.5% profit • 2 servers", lines[1])
+		assert.Empty(t, embed.Fields)
+	})
+
+	t.Run("shows the viewer's rank below the list", func(t *testing.T) {
+		embed := BuildGlobalLeaderboardEmbed(rankedGlobalPlayers(15), entities.GlobalLeaderboardSortProfit, 10, 13)
+
+		assert.Contains(t, embed.Title, "Profit")
+		assert.Len(t, strings.Split(strings.TrimSpace(embed.Description), "\n"), GlobalLeaderboardSize)
+		require.Len(t, embed.Fields, 1)
+		assert.True(t, strings.HasPrefix(embed.Fields[0].Value, "13. <@13>"))
+	})
+
+	t.Run("explains an empty leaderboard", func(t *testing.T) {
+		embed := BuildGlobalLeaderboardEmbed(nil, entities.GlobalLeaderboardSortAccuracy, 10, 99)
+		assert.Equal(t, "No players qualify yet", embed.Description)
+	})
+}
@@ -7,7 +7,7 @@ import (
 	"strconv"
 
 	"gambler/discord-client/bot/common"
-	"gambler/discord-client/domain/services"
+	"gambler/discord-client/domain/entities"
 
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
@@ -61,14 +61,8 @@ func (f *Feature) handleStatsScoreboard(s *discordgo.Session, i *discordgo.Inter
 	}
 	defer uow.Rollback()
 
-	// Instantiate user metrics service with repositories from UnitOfWork
-	metricsService := services.NewUserMetricsService(
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.BetRepository(),
-		uow.GroupWagerRepository(),
-		uow.BalanceHistoryRepository(),
-	)
+	// Metrics are read from the replica, away from transactional writes
+	metricsService := f.newReadOnlyMetricsService(guildID)
 
 	// Get scoreboard entries
 	entries, totalBits, err := metricsService.GetScoreboard(ctx, 0)
@@ -80,20 +74,12 @@ func (f *Feature) handleStatsScoreboard(s *discordgo.Session, i *discordgo.Inter
 
 	// Get high roller info before creating embed
 	var highRollerText string
-	highRollerService := services.NewHighRollerService(
-		uow.HighRollerPurchaseRepository(),
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.GroupWagerRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.GuildSettingsRepository(),
-		uow.EventBus(),
-	)
+	highRollerService := uow.Services().HighRollerService()
 
 	highRollerInfo, err := highRollerService.GetCurrentHighRoller(ctx, guildID)
 	if err == nil && highRollerInfo.CurrentHolder != nil {
 		// Get the role ID for mention
-		guildSettingsService := services.NewGuildSettingsService(uow.GuildSettingsRepository())
+		guildSettingsService := uow.Services().GuildSettingsService()
 		if roleID, err := guildSettingsService.GetHighRollerRoleID(ctx, guildID); err == nil && roleID != nil {
 			highRollerText = fmt.Sprintf("\n<@&%d> - <@%d> - %s - %s",
 				*roleID,
@@ -110,6 +96,9 @@ func (f *Feature) handleStatsScoreboard(s *discordgo.Session, i *discordgo.Inter
 	if highRollerText != "" && embed.Description != "" {
 		embed.Description += highRollerText
 	}
+	if imageData != nil {
+		embed.Description += scoreboardBadges(ctx, uow, entries)
+	}
 
 	// Commit the transaction after building the embed
 	if err := uow.Commit(); err != nil {
@@ -179,23 +168,8 @@ func (f *Feature) handleStatsBalance(s *discordgo.Session, i *discordgo.Interact
 		targetUser = i.Member.User
 	}
 
-	// Create guild-scoped unit of work
-	uow := f.uowFactory.CreateForGuild(guildID)
-	if err := uow.Begin(ctx); err != nil {
-		log.Errorf("Error beginning transaction: %v", err)
-		common.RespondWithError(s, i, "Unable to process request. Please try again.")
-		return
-	}
-	defer uow.Rollback()
-
-	// Instantiate user metrics service with repositories from UnitOfWork
-	metricsService := services.NewUserMetricsService(
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.BetRepository(),
-		uow.GroupWagerRepository(),
-		uow.BalanceHistoryRepository(),
-	)
+	// Metrics are read from the replica, away from transactional writes
+	metricsService := f.newReadOnlyMetricsService(guildID)
 
 	// Get user stats
 	stats, err := metricsService.GetUserStats(ctx, targetID)
@@ -205,13 +179,6 @@ func (f *Feature) handleStatsBalance(s *discordgo.Session, i *discordgo.Interact
 		return
 	}
 
-	// Commit the transaction
-	if err := uow.Commit(); err != nil {
-		log.Errorf("Error committing transaction: %v", err)
-		common.RespondWithError(s, i, "Unable to process request. Please try again.")
-		return
-	}
-
 	// Get display name
 	displayName := common.GetDisplayNameInt64(s, i.GuildID, targetID)
 
@@ -231,6 +198,30 @@ func (f *Feature) handleStatsBalance(s *discordgo.Session, i *discordgo.Interact
 		},
 	}
 
+	if highlights := formatBiggestTransactions(stats); highlights != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "🏆 Biggest Moments",
+			Value:  highlights,
+			Inline: false,
+		})
+	}
+
+	if stats.PlayerGames != nil {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "🎮 Own Games",
+			Value:  formatPlayerGames(stats.PlayerGames),
+			Inline: false,
+		})
+	}
+
+	if badges := f.userBadges(ctx, guildID, targetID); badges != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "🎖️ Badges",
+			Value:  badges,
+			Inline: false,
+		})
+	}
+
 	// Send response
 	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
@@ -242,3 +233,63 @@ func (f *Feature) handleStatsBalance(s *discordgo.Session, i *discordgo.Interact
 		log.Printf("Error responding to balance stats command: %v", err)
 	}
 }
+
+// statsHistoryDays is how many days /stats history covers
+const statsHistoryDays = 30
+
+// handleStatsHistory handles the /stats history subcommand
+func (f *Feature) handleStatsHistory(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	ctx := context.Background()
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	// Default to the command issuer and all systems
+	targetIDStr := i.Member.User.ID
+	var system *entities.ExternalSystem
+	for _, opt := range options {
+		switch opt.Name {
+		case "user":
+			targetIDStr = opt.UserValue(s).ID
+		case "system":
+			if value := opt.StringValue(); value != "all" {
+				selected := entities.ExternalSystem(value)
+				system = &selected
+			}
+		}
+	}
+
+	targetID, err := strconv.ParseInt(targetIDStr, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing Discord ID %s: %v", targetIDStr, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	// Metrics are read from the replica, away from transactional writes
+	metricsService := f.newReadOnlyMetricsService(guildID)
+
+	series, err := metricsService.GetUserStatsTimeSeries(ctx, targetID, system, statsHistoryDays)
+	if err != nil {
+		log.Errorf("Error getting stats history for %d: %v", targetID, err)
+		common.RespondWithError(s, i, "Unable to retrieve user statistics. Please try again.")
+		return
+	}
+
+	displayName := common.GetDisplayNameInt64(s, i.GuildID, targetID)
+	embed := BuildStatsHistoryEmbed(series, displayName)
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: []*discordgo.MessageEmbed{embed},
+		},
+	})
+	if err != nil {
+		log.Errorf("Error responding to stats history command: %v", err)
+	}
+}
@@ -0,0 +1,252 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// Prediction leaderboard system choices
+const (
+	PredictionSystemAll = "all"
+	PredictionSystemLoL = "lol"
+	PredictionSystemTFT = "tft"
+)
+
+// PredictionLeaderboardPageSize is how many predictors each leaderboard page lists
+const PredictionLeaderboardPageSize = 10
+
+// predictionPagePrefix prefixes prediction leaderboard page buttons: stats_predictions_<system>_<window>_<min>_<page>
+const predictionPagePrefix = "stats_predictions_"
+
+// predictionSystemFilter maps a leaderboard system choice to the external system it filters on (nil for all)
+func predictionSystemFilter(system string) *entities.ExternalSystem {
+	var selected entities.ExternalSystem
+	switch system {
+	case PredictionSystemLoL:
+		selected = entities.SystemLeagueOfLegends
+	case PredictionSystemTFT:
+		selected = entities.SystemTFT
+	default:
+		return nil
+	}
+	return &selected
+}
+
+// predictionSystemLabel returns the display name for a leaderboard system choice
+func predictionSystemLabel(system string) string {
+	switch system {
+	case PredictionSystemLoL:
+		return "LoL"
+	case PredictionSystemTFT:
+		return "TFT"
+	default:
+		return "All Wagers"
+	}
+}
+
+// HandleLeaderboardCommand handles the /leaderboard command and its subcommands
+func (f *Feature) HandleLeaderboardCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		common.RespondWithError(s, i, "Please specify a subcommand: predictions or global")
+		return
+	}
+
+	switch options[0].Name {
+	case "predictions":
+		f.handlePredictionLeaderboard(s, i, options[0].Options)
+	case "global":
+		f.handleGlobalLeaderboard(s, i, options[0].Options)
+	default:
+		common.RespondWithError(s, i, "Unknown subcommand")
+	}
+}
+
+// handlePredictionLeaderboard displays the first page of the prediction accuracy leaderboard
+func (f *Feature) handlePredictionLeaderboard(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	system := PredictionSystemAll
+	window := entities.LeaderboardWindowAllTime
+	minPredictions := MinGameWagersForLeaderboard
+	for _, opt := range options {
+		switch opt.Name {
+		case "system":
+			system = opt.StringValue()
+		case "window":
+			window = entities.LeaderboardWindow(opt.StringValue())
+		case "min_wagers":
+			minPredictions = int(opt.IntValue())
+		}
+	}
+
+	embed, components, err := f.buildPredictionLeaderboardPage(i.GuildID, system, window, minPredictions, 0)
+	if err != nil {
+		log.Errorf("Error building prediction leaderboard: %v", err)
+		common.RespondWithError(s, i, "Unable to retrieve the prediction leaderboard. Please try again.")
+		return
+	}
+
+	if err := common.RespondWithEmbed(s, i, embed, components, false); err != nil {
+		log.Errorf("Error responding with prediction leaderboard: %v", err)
+	}
+}
+
+// handlePredictionLeaderboardPage re-renders the prediction leaderboard at the page a button points to
+func (f *Feature) handlePredictionLeaderboardPage(s *discordgo.Session, i *discordgo.InteractionCreate, customID string) {
+	system, window, minPredictions, page, err := parsePredictionPageID(customID)
+	if err != nil {
+		log.Errorf("Invalid prediction leaderboard button %s: %v", customID, err)
+		return
+	}
+
+	embed, components, err := f.buildPredictionLeaderboardPage(i.GuildID, system, window, minPredictions, page)
+	if err != nil {
+		log.Errorf("Error building prediction leaderboard: %v", err)
+		common.RespondWithError(s, i, "Unable to retrieve the prediction leaderboard. Please try again.")
+		return
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		},
+	})
+	if err != nil {
+		log.Errorf("Error updating prediction leaderboard: %v", err)
+	}
+}
+
+// buildPredictionLeaderboardPage loads fresh prediction stats for the window and renders the requested page
+func (f *Feature) buildPredictionLeaderboardPage(guildIDStr, system string, window entities.LeaderboardWindow, minPredictions, page int) (*discordgo.MessageEmbed, []discordgo.MessageComponent, error) {
+	guildID, err := strconv.ParseInt(guildIDStr, 10, 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid guild ID %s: %w", guildIDStr, err)
+	}
+
+	// Metrics are read from the replica, away from transactional writes
+	metricsService := f.newReadOnlyMetricsService(guildID)
+
+	stats, err := metricsService.GetWagerPredictionStats(context.Background(), predictionSystemFilter(system), window.Since(time.Now().UTC()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ranked := entities.RankWagerPredictionStats(stats, minPredictions)
+	embed, page := BuildPredictionLeaderboardEmbed(ranked, system, window, minPredictions, page)
+	return embed, BuildPredictionLeaderboardNavButtons(ranked, system, window, minPredictions, page), nil
+}
+
+// predictionPageCount returns how many pages the ranked predictors span, always at least one
+func predictionPageCount(total int) int {
+	if total == 0 {
+		return 1
+	}
+	return (total + PredictionLeaderboardPageSize - 1) / PredictionLeaderboardPageSize
+}
+
+// BuildPredictionLeaderboardEmbed renders one page of ranked predictors, clamping the page into range.
+// It returns the embed along with the page actually shown.
+func BuildPredictionLeaderboardEmbed(ranked []*entities.WagerPredictionStats, system string, window entities.LeaderboardWindow, minPredictions, page int) (*discordgo.MessageEmbed, int) {
+	pages := predictionPageCount(len(ranked))
+	page = max(0, min(page, pages-1))
+
+	title := fmt.Sprintf("🔮 Prediction Leaderboard — %s", predictionSystemLabel(system))
+	if window.Days() > 0 {
+		title += fmt.Sprintf(" (%s)", window.Label())
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: title,
+		Color: common.ColorPrimary,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Page %d/%d • Minimum %d predictions to qualify", page+1, pages, minPredictions),
+		},
+	}
+
+	if len(ranked) == 0 {
+		embed.Description = "No predictors qualify yet"
+		return embed, page
+	}
+
+	start := page * PredictionLeaderboardPageSize
+	end := min(start+PredictionLeaderboardPageSize, len(ranked))
+
+	var sb strings.Builder
+	for idx, p := range ranked[start:end] {
+		fmt.Fprintf(&sb, "%s %s — **%.1f%%** (%d/%d) • %s wagered • %s\n",
+			getMedalForRank(start+idx+1),
+			common.GetUserMention(p.DiscordID),
+			p.AccuracyPercentage,
+			p.CorrectPredictions,
+			p.TotalPredictions,
+			common.FormatBalanceCompact(p.TotalAmountWagered),
+			formatProfitLoss(p.NetProfit))
+	}
+	embed.Description = sb.String()
+
+	return embed, page
+}
+
+// BuildPredictionLeaderboardNavButtons creates previous/next buttons that carry the leaderboard filters
+func BuildPredictionLeaderboardNavButtons(ranked []*entities.WagerPredictionStats, system string, window entities.LeaderboardWindow, minPredictions, page int) []discordgo.MessageComponent {
+	pages := predictionPageCount(len(ranked))
+	if pages <= 1 {
+		return nil
+	}
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "◀ Previous",
+					Style:    discordgo.SecondaryButton,
+					CustomID: predictionPageID(system, window, minPredictions, page-1),
+					Disabled: page <= 0,
+				},
+				discordgo.Button{
+					Label:    "Next ▶",
+					Style:    discordgo.SecondaryButton,
+					CustomID: predictionPageID(system, window, minPredictions, page+1),
+					Disabled: page >= pages-1,
+				},
+			},
+		},
+	}
+}
+
+// predictionPageID builds the custom ID of a button pointing at a leaderboard page
+func predictionPageID(system string, window entities.LeaderboardWindow, minPredictions, page int) string {
+	return fmt.Sprintf("%s%s_%s_%d_%d", predictionPagePrefix, system, window, minPredictions, max(page, 0))
+}
+
+// parsePredictionPageID extracts the leaderboard filters and page from a button custom ID.
+// Buttons posted before windows existed carry no window and keep showing all time.
+func parsePredictionPageID(customID string) (system string, window entities.LeaderboardWindow, minPredictions, page int, err error) {
+	parts := strings.Split(strings.TrimPrefix(customID, predictionPagePrefix), "_")
+	switch len(parts) {
+	case 3:
+		parts = []string{parts[0], string(entities.LeaderboardWindowAllTime), parts[1], parts[2]}
+	case 4:
+	default:
+		return "", "", 0, 0, fmt.Errorf("expected 4 parts, got %d", len(parts))
+	}
+
+	if minPredictions, err = strconv.Atoi(parts[2]); err != nil {
+		return "", "", 0, 0, fmt.Errorf("invalid minimum predictions: %w", err)
+	}
+	if page, err = strconv.Atoi(parts[3]); err != nil {
+		return "", "", 0, 0, fmt.Errorf("invalid page: %w", err)
+	}
+
+	return parts[0], entities.LeaderboardWindow(parts[1]), minPredictions, page, nil
+}
@@ -0,0 +1,101 @@
+package stats
+
+import (
+	"strings"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rankedPredictors(n int) []*entities.WagerPredictionStats {
+	ranked := make([]*entities.WagerPredictionStats, n)
+	for i := range ranked {
+		ranked[i] = &entities.WagerPredictionStats{
+			DiscordID:          int64(i + 1),
+			CorrectPredictions: 6,
+			TotalPredictions:   8,
+			AccuracyPercentage: 75,
+			TotalAmountWagered: 12000,
+			NetProfit:          -500,
+		}
+	}
+	return ranked
+}
+
+func TestBuildPredictionLeaderboardEmbed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("renders rank, accuracy, volume and profit", func(t *testing.T) {
+		embed, page := BuildPredictionLeaderboardEmbed(rankedPredictors(12), PredictionSystemLoL, entities.LeaderboardWindowAllTime, 5, 1)
+
+		assert.Equal(t, 1, page)
+		assert.Contains(t, embed.Title, "LoL")
+		assert.Equal(t, "Page 2/2 • Minimum 5 predictions to qualify", embed.Footer.Text)
+
+		lines := strings.Split(strings.TrimSpace(embed.Description), "\n")
+		require.Len(t, lines, 2)
+		assert.Equal(t, "11. <@11> — **75.0%** (6/8) • 12k wagered • *-500*", lines[0])
+	})
+
+	t.Run("clamps pages past the end", func(t *testing.T) {
+		_, page := BuildPredictionLeaderboardEmbed(rankedPredictors(3), PredictionSystemAll, entities.LeaderboardWindowAllTime, 5, 4)
+		assert.Equal(t, 0, page)
+	})
+
+	t.Run("explains an empty leaderboard", func(t *testing.T) {
+		embed, _ := BuildPredictionLeaderboardEmbed(nil, PredictionSystemTFT, entities.LeaderboardWindowAllTime, 10, 0)
+		assert.Equal(t, "No predictors qualify yet", embed.Description)
+		assert.Equal(t, "Page 1/1 • Minimum 10 predictions to qualify", embed.Footer.Text)
+	})
+
+	t.Run("names the time window in the title", func(t *testing.T) {
+		embed, _ := BuildPredictionLeaderboardEmbed(rankedPredictors(3), PredictionSystemTFT, entities.LeaderboardWindow30Days, 5, 0)
+		assert.Equal(t, "🔮 Prediction Leaderboard — TFT (Last 30 Days)", embed.Title)
+	})
+}
+
+func TestBuildPredictionLeaderboardNavButtons(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, BuildPredictionLeaderboardNavButtons(rankedPredictors(10), PredictionSystemAll, entities.LeaderboardWindowAllTime, 5, 0), "a single page needs no buttons")
+
+	components := BuildPredictionLeaderboardNavButtons(rankedPredictors(25), PredictionSystemTFT, entities.LeaderboardWindow7Days, 3, 2)
+	require.Len(t, components, 1)
+	buttons := components[0].(discordgo.ActionsRow).Components
+	require.Len(t, buttons, 2)
+
+	prev, next := buttons[0].(discordgo.Button), buttons[1].(discordgo.Button)
+	assert.False(t, prev.Disabled)
+	assert.True(t, next.Disabled, "the last page cannot go further")
+
+	system, window, minPredictions, page, err := parsePredictionPageID(prev.CustomID)
+	require.NoError(t, err)
+	assert.Equal(t, PredictionSystemTFT, system)
+	assert.Equal(t, entities.LeaderboardWindow7Days, window)
+	assert.Equal(t, 3, minPredictions)
+	assert.Equal(t, 1, page)
+}
+
+func TestParsePredictionPageID_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, id := range []string{"stats_predictions_lol_5", "stats_predictions_lol_x_1", "stats_predictions_lol_5_y", "stats_predictions_lol_7d_x_1"} {
+		_, _, _, _, err := parsePredictionPageID(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestParsePredictionPageID_WithoutWindow(t *testing.T) {
+	t.Parallel()
+
+	system, window, minPredictions, page, err := parsePredictionPageID("stats_predictions_lol_5_2")
+	require.NoError(t, err)
+	assert.Equal(t, PredictionSystemLoL, system)
+	assert.Equal(t, entities.LeaderboardWindowAllTime, window)
+	assert.Equal(t, 5, minPredictions)
+	assert.Equal(t, 2, page)
+}
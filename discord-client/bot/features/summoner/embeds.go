@@ -2,6 +2,7 @@ package summoner
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"gambler/discord-client/bot/common"
@@ -97,3 +98,29 @@ func createNotWatchingEmbed(summonerName, tagLine string) *discordgo.MessageEmbe
 		},
 	}
 }
+
+// createWatchListEmbed lists the summoners watched by a guild
+func createWatchListEmbed(watches []*entities.SummonerWatchDetail) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title:     "👀 Watched Summoners",
+		Color:     common.ColorInfo,
+		Timestamp: time.Now().Format(time.RFC3339),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("%d/%d watches used", len(watches), entities.MaxSummonerWatchesPerGuild),
+		},
+	}
+
+	if len(watches) == 0 {
+		embed.Description = "No summoners are being watched. Add one with `/watch add`."
+		return embed
+	}
+
+	lines := make([]string, 0, len(watches))
+	for _, watch := range watches {
+		lines = append(lines, fmt.Sprintf("• **%s** (since %s)",
+			watch.GetFullName(), common.FormatDiscordTimestamp(watch.WatchedAt, "D")))
+	}
+	embed.Description = strings.Join(lines, "\n")
+
+	return embed
+}
@@ -38,3 +38,21 @@ func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCr
 		}
 	}
 }
+
+// HandleWatchCommand handles the /watch command and its subcommands
+func (f *Feature) HandleWatchCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	data := i.ApplicationCommandData()
+	if len(data.Options) == 0 {
+		return
+	}
+
+	subcommand := data.Options[0]
+	switch subcommand.Name {
+	case "add":
+		f.handleWatchAddCommand(s, i, subcommand.Options)
+	case "remove":
+		f.handleWatchRemoveCommand(s, i, subcommand.Options)
+	case "list":
+		f.handleWatchListCommand(s, i)
+	}
+}
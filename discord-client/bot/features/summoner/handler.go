@@ -2,6 +2,7 @@ package summoner
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -12,14 +13,12 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
 	summoner_pb "gambler/discord-client/proto/services"
-	"gambler/discord-client/domain/services"
 )
 
 // handleWatchCommand handles the /summoner watch command
 func (f *Feature) handleWatchCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	ctx := context.Background()
-
 	// Parse command options
 	options := i.ApplicationCommandData().Options[0].Options // watch subcommand options
 	var gameName, tagLine string
@@ -40,6 +39,13 @@ func (f *Feature) handleWatchCommand(s *discordgo.Session, i *discordgo.Interact
 		return
 	}
 
+	f.addWatch(s, i, gameName, tagLine)
+}
+
+// addWatch validates a summoner with the tracking service and stores the watch for the guild
+func (f *Feature) addWatch(s *discordgo.Session, i *discordgo.InteractionCreate, gameName, tagLine string) {
+	ctx := context.Background()
+
 	// Get guild ID from interaction
 	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
 	if err != nil {
@@ -50,6 +56,20 @@ func (f *Feature) handleWatchCommand(s *discordgo.Session, i *discordgo.Interact
 
 	log.Infof("Processing summoner watch request: %s#%s for guild %d", gameName, tagLine, guildID)
 
+	// Reject duplicates and guilds at their limit before asking the tracking service to start tracking
+	if err := f.checkCanAddWatch(ctx, guildID, gameName, tagLine); err != nil {
+		switch {
+		case errors.Is(err, entities.ErrSummonerAlreadyWatched):
+			common.RespondWithEmbed(s, i, createAlreadyWatchingEmbed(gameName, tagLine), nil, false)
+		case errors.Is(err, entities.ErrSummonerWatchLimitReached):
+			common.RespondWithError(s, i, fmt.Sprintf("This server is already watching the maximum of %d summoners. Remove one with /watch remove first.", entities.MaxSummonerWatchesPerGuild))
+		default:
+			log.Infof("Summoner watch rejected for %s#%s: %v", gameName, tagLine, err)
+			common.RespondWithError(s, i, err.Error())
+		}
+		return
+	}
+
 	// Validate summoner with external service
 	// Note: We now pass separate game_name and tag_line fields
 	validateReq := &summoner_pb.StartTrackingSummonerRequest{
@@ -88,7 +108,7 @@ func (f *Feature) handleWatchCommand(s *discordgo.Session, i *discordgo.Interact
 	defer uow.Rollback()
 
 	// Create summoner watch service
-	summonerWatchService := services.NewSummonerWatchService(uow.SummonerWatchRepository())
+	summonerWatchService := uow.Services().SummonerWatchService()
 
 	// Add the watch - use the validated game name and tag line
 	watchDetail, err := summonerWatchService.AddWatch(ctx, guildID, validateResp.SummonerDetails.GameName, tagLine)
@@ -112,11 +132,20 @@ func (f *Feature) handleWatchCommand(s *discordgo.Session, i *discordgo.Interact
 	common.RespondWithEmbed(s, i, embed, nil, false)
 }
 
+// checkCanAddWatch runs the watch service's duplicate and limit checks in a read-only transaction
+func (f *Feature) checkCanAddWatch(ctx context.Context, guildID int64, gameName, tagLine string) error {
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	summonerWatchService := uow.Services().SummonerWatchService()
+	return summonerWatchService.CheckCanAddWatch(ctx, guildID, gameName, tagLine)
+}
+
 // handleUnwatchCommand handles the /summoner unwatch command
-// We're not making any calls to lol-tracker here, since this is only removing the watch for a single guild.
 func (f *Feature) handleUnwatchCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	ctx := context.Background()
-
 	// Parse command options
 	options := i.ApplicationCommandData().Options[0].Options // unwatch subcommand options
 	var gameName, tagLine string
@@ -137,6 +166,14 @@ func (f *Feature) handleUnwatchCommand(s *discordgo.Session, i *discordgo.Intera
 		return
 	}
 
+	f.removeWatch(s, i, gameName, tagLine)
+}
+
+// removeWatch removes a summoner watch for the guild.
+// We're not making any calls to lol-tracker here, since this is only removing the watch for a single guild.
+func (f *Feature) removeWatch(s *discordgo.Session, i *discordgo.InteractionCreate, gameName, tagLine string) {
+	ctx := context.Background()
+
 	// Get guild ID from interaction
 	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
 	if err != nil {
@@ -157,15 +194,14 @@ func (f *Feature) handleUnwatchCommand(s *discordgo.Session, i *discordgo.Intera
 	defer uow.Rollback()
 
 	// Create summoner watch service
-	summonerWatchService := services.NewSummonerWatchService(uow.SummonerWatchRepository())
+	summonerWatchService := uow.Services().SummonerWatchService()
 
 	// Remove the watch using the parsed name and tag line
 	err = summonerWatchService.RemoveWatch(ctx, guildID, gameName, tagLine)
 	if err != nil {
 		log.Errorf("Failed to remove summoner watch for %s#%s: %v", gameName, tagLine, err)
 
-		// Check if it's a "not found" error
-		if strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, entities.ErrSummonerWatchNotFound) {
 			embed := createNotWatchingEmbed(gameName, tagLine)
 			common.RespondWithEmbed(s, i, embed, nil, false)
 			return
@@ -218,3 +254,68 @@ func (f *Feature) mapValidationError(errorCode *summoner_pb.ValidationError, err
 		return "Unknown validation error occurred"
 	}
 }
+
+// handleWatchAddCommand handles /watch add <riot_id>
+func (f *Feature) handleWatchAddCommand(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	gameName, tagLine, ok := parseRiotIDOption(s, i, options)
+	if !ok {
+		return
+	}
+	f.addWatch(s, i, gameName, tagLine)
+}
+
+// handleWatchRemoveCommand handles /watch remove <riot_id>
+func (f *Feature) handleWatchRemoveCommand(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	gameName, tagLine, ok := parseRiotIDOption(s, i, options)
+	if !ok {
+		return
+	}
+	f.removeWatch(s, i, gameName, tagLine)
+}
+
+// handleWatchListCommand handles /watch list
+func (f *Feature) handleWatchListCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Failed to parse guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Invalid guild ID")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Failed to begin transaction: %v", err)
+		common.RespondWithError(s, i, "Database error occurred. Please try again.")
+		return
+	}
+	defer uow.Rollback()
+
+	summonerWatchService := uow.Services().SummonerWatchService()
+	watches, err := summonerWatchService.ListWatches(ctx, guildID)
+	if err != nil {
+		log.Errorf("Failed to list summoner watches for guild %d: %v", guildID, err)
+		common.RespondWithError(s, i, "Failed to load summoner watches. Please try again.")
+		return
+	}
+
+	common.RespondWithEmbed(s, i, createWatchListEmbed(watches), nil, false)
+}
+
+// parseRiotIDOption reads the riot_id option and responds with an error if it is not in Name#TAG format
+func parseRiotIDOption(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) (string, string, bool) {
+	var riotID string
+	for _, option := range options {
+		if option.Name == "riot_id" {
+			riotID = option.StringValue()
+		}
+	}
+
+	gameName, tagLine, err := entities.ParseRiotID(riotID)
+	if err != nil {
+		common.RespondWithError(s, i, "Please enter a Riot ID like Faker#KR1")
+		return "", "", false
+	}
+	return gameName, tagLine, true
+}
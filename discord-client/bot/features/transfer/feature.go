@@ -2,19 +2,22 @@ package transfer
 
 import (
 	"gambler/discord-client/application"
+	"gambler/discord-client/bot/common"
 	"github.com/bwmarrin/discordgo"
 )
 
 type Feature struct {
 	uowFactory application.UnitOfWorkFactory
+	limiter    *common.RateLimiter
 }
 
-func New(uowFactory application.UnitOfWorkFactory) *Feature {
+func New(uowFactory application.UnitOfWorkFactory, limiter *common.RateLimiter) *Feature {
 	return &Feature{
 		uowFactory: uowFactory,
+		limiter:    limiter,
 	}
 }
 
 func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	f.handleDonate(s, i)
+	f.limiter.Guard(common.RateLimitTransfer, f.handleDonate)(s, i)
 }
@@ -6,7 +6,6 @@ import (
 	"strconv"
 
 	"gambler/discord-client/bot/common"
-	"gambler/discord-client/domain/services"
 
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
@@ -83,21 +82,17 @@ func (f *Feature) handleDonate(s *discordgo.Session, i *discordgo.InteractionCre
 	defer uow.Rollback()
 
 	// Instantiate user service with repositories from UnitOfWork
-	userService := services.NewUserService(
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	userService := uow.Services().UserService()
 
 	// Ensure both users in the DB.
-	_, err = userService.GetOrCreateUser(ctx, fromDiscordID, i.Member.User.Username)
+	_, err = userService.GetOrCreateUser(ctx, guildID, fromDiscordID, i.Member.User.Username)
 	if err != nil {
-		common.RespondWithError(s, i, fmt.Sprintf("Failed to create user: %v", err))
+		common.RespondWithError(s, i, common.DescribeError("Failed to create user", err))
 		return
 	}
-	_, err = userService.GetOrCreateUser(ctx, toDiscordID, recipientUser.Username)
+	_, err = userService.GetOrCreateUser(ctx, guildID, toDiscordID, recipientUser.Username)
 	if err != nil {
-		common.RespondWithError(s, i, fmt.Sprintf("Failed to create user: %v", err))
+		common.RespondWithError(s, i, common.DescribeError("Failed to create user", err))
 		return
 	}
 
@@ -0,0 +1,110 @@
+package wagerfollowers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gambler/discord-client/application"
+	"gambler/discord-client/domain/events"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxMentionsPerMessage keeps thread notifications well under Discord's message length limit
+const maxMentionsPerMessage = 50
+
+// Feature notifies the users following a group wager when the pot reaches a milestone,
+// betting closes and the wager is resolved
+type Feature struct {
+	session    *discordgo.Session
+	uowFactory application.UnitOfWorkFactory
+}
+
+// NewFeature creates a new wager followers feature instance
+func NewFeature(session *discordgo.Session, uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		session:    session,
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleGroupWagerStateChange notifies the wager's followers of pot milestones, betting closing and the
+// resolution. Followers are mentioned in the wager's thread when it has one, otherwise they are sent a DM.
+func (f *Feature) HandleGroupWagerStateChange(ctx context.Context, event events.Event) error {
+	e, err := application.AssertEventType[events.GroupWagerStateChangeEvent](event, "GroupWagerStateChangeEvent")
+	if err != nil {
+		return err
+	}
+
+	if !isFollowerNotification(e) {
+		return nil
+	}
+
+	uow := f.uowFactory.CreateForGuild(e.GuildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	followers, err := uow.Services().GroupWagerFollowService().GetFollowers(ctx, e.GroupWagerID)
+	if err != nil {
+		return fmt.Errorf("failed to get followers of group wager %d: %w", e.GroupWagerID, err)
+	}
+	if len(followers) == 0 {
+		return nil
+	}
+
+	detail, err := uow.Services().GroupWagerService().GetGroupWagerDetail(ctx, e.GroupWagerID)
+	if err != nil {
+		return fmt.Errorf("failed to get group wager %d: %w", e.GroupWagerID, err)
+	}
+
+	message := formatNotification(e, detail)
+
+	// Resolved wagers have their thread archived, so followers are sent a DM instead of reviving it
+	if detail.Wager.HasThread() && !detail.Wager.ShouldArchiveThread() {
+		f.notifyInThread(*detail.Wager.ThreadID, followers, message)
+		return nil
+	}
+
+	for _, discordID := range followers {
+		f.notifyByDM(e.GuildID, discordID, message)
+	}
+
+	return nil
+}
+
+// notifyInThread posts the notification in the wager's thread, mentioning the followers
+func (f *Feature) notifyInThread(threadID int64, followers []int64, message string) {
+	channelID := strconv.FormatInt(threadID, 10)
+	for start := 0; start < len(followers); start += maxMentionsPerMessage {
+		end := min(start+maxMentionsPerMessage, len(followers))
+		content := message + "\n" + formatMentions(followers[start:end])
+		if _, err := f.session.ChannelMessageSend(channelID, content); err != nil {
+			log.WithFields(log.Fields{
+				"threadID": threadID,
+				"error":    err,
+			}).Warn("Failed to notify group wager followers in thread")
+			return
+		}
+	}
+}
+
+// notifyByDM sends the notification to a single follower
+func (f *Feature) notifyByDM(guildID, discordID int64, message string) {
+	userID := strconv.FormatInt(discordID, 10)
+	channel, err := f.session.UserChannelCreate(userID)
+	if err == nil {
+		_, err = f.session.ChannelMessageSend(channel.ID, message)
+	}
+	if err != nil {
+		// Users can disable DMs from server members, which isn't worth retrying
+		log.WithFields(log.Fields{
+			"userID":  discordID,
+			"guildID": guildID,
+			"error":   err,
+		}).Warn("Failed to send group wager follower DM")
+	}
+}
@@ -0,0 +1,58 @@
+package wagerfollowers
+
+import (
+	"fmt"
+	"strings"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+)
+
+// isFollowerNotification reports whether followers hear about the state change: a pot milestone,
+// betting closing or the wager being resolved
+func isFollowerNotification(e events.GroupWagerStateChangeEvent) bool {
+	if e.PotMilestone > 0 {
+		return true
+	}
+	if e.OldState == e.NewState {
+		return false
+	}
+	return e.NewState == string(entities.GroupWagerStatePendingResolution) ||
+		e.NewState == string(entities.GroupWagerStateResolved)
+}
+
+// formatNotification describes the state change to the wager's followers
+func formatNotification(e events.GroupWagerStateChangeEvent, detail *entities.GroupWagerDetail) string {
+	wager := detail.Wager
+	link := common.FormatDiscordMessageLink(wager.GuildID, wager.ChannelID, wager.MessageID)
+
+	switch {
+	case e.NewState == string(entities.GroupWagerStateResolved) && e.OldState != e.NewState:
+		winningOption := "the winning option"
+		if wager.WinningOptionID != nil {
+			for _, option := range detail.Options {
+				if option.ID == *wager.WinningOptionID {
+					winningOption = fmt.Sprintf("**%s**", option.OptionText)
+				}
+			}
+		}
+		return fmt.Sprintf("🏁 **%s** was resolved: %s won the %s bit pot. %s",
+			wager.Condition, winningOption, common.FormatBalance(wager.TotalPot), link)
+	case e.NewState == string(entities.GroupWagerStatePendingResolution) && e.OldState != e.NewState:
+		return fmt.Sprintf("⏳ Betting has closed on **%s** with %s bits in the pot. It's waiting to be resolved. %s",
+			wager.Condition, common.FormatBalance(wager.TotalPot), link)
+	default:
+		return fmt.Sprintf("💰 The pot on **%s** just passed %s bits. %s",
+			wager.Condition, common.FormatBalance(e.PotMilestone), link)
+	}
+}
+
+// formatMentions mentions each follower
+func formatMentions(followers []int64) string {
+	mentions := make([]string, len(followers))
+	for i, discordID := range followers {
+		mentions[i] = common.GetUserMention(discordID)
+	}
+	return strings.Join(mentions, " ")
+}
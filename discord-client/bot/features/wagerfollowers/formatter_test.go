@@ -0,0 +1,88 @@
+package wagerfollowers
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsFollowerNotification(t *testing.T) {
+	active := string(entities.GroupWagerStateActive)
+	pending := string(entities.GroupWagerStatePendingResolution)
+	resolved := string(entities.GroupWagerStateResolved)
+	cancelled := string(entities.GroupWagerStateCancelled)
+
+	tests := []struct {
+		name     string
+		event    events.GroupWagerStateChangeEvent
+		expected bool
+	}{
+		{name: "pot milestone", event: events.GroupWagerStateChangeEvent{OldState: active, NewState: active, PotMilestone: 10000}, expected: true},
+		{name: "voting extended", event: events.GroupWagerStateChangeEvent{OldState: active, NewState: active}, expected: false},
+		{name: "betting closed", event: events.GroupWagerStateChangeEvent{OldState: active, NewState: pending}, expected: true},
+		{name: "resolved", event: events.GroupWagerStateChangeEvent{OldState: pending, NewState: resolved}, expected: true},
+		{name: "cancelled", event: events.GroupWagerStateChangeEvent{OldState: active, NewState: cancelled}, expected: false},
+		{name: "message refresh after resolution", event: events.GroupWagerStateChangeEvent{OldState: resolved, NewState: resolved}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isFollowerNotification(tt.event))
+		})
+	}
+}
+
+func TestFormatNotification(t *testing.T) {
+	winningOptionID := int64(7)
+	detail := &entities.GroupWagerDetail{
+		Wager: &entities.GroupWager{
+			ID:              1,
+			GuildID:         100,
+			ChannelID:       200,
+			MessageID:       300,
+			Condition:       "Will it rain?",
+			TotalPot:        12500,
+			WinningOptionID: &winningOptionID,
+		},
+		Options: []*entities.GroupWagerOption{
+			{ID: 7, OptionText: "Yes"},
+			{ID: 8, OptionText: "No"},
+		},
+	}
+	link := "https://discord.com/channels/100/200/300"
+
+	tests := []struct {
+		name     string
+		event    events.GroupWagerStateChangeEvent
+		expected string
+	}{
+		{
+			name:     "pot milestone",
+			event:    events.GroupWagerStateChangeEvent{OldState: "active", NewState: "active", PotMilestone: 10000},
+			expected: "💰 The pot on **Will it rain?** just passed 10,000 bits. " + link,
+		},
+		{
+			name:     "betting closed",
+			event:    events.GroupWagerStateChangeEvent{OldState: "active", NewState: "pending_resolution"},
+			expected: "⏳ Betting has closed on **Will it rain?** with 12,500 bits in the pot. It's waiting to be resolved. " + link,
+		},
+		{
+			name:     "resolved",
+			event:    events.GroupWagerStateChangeEvent{OldState: "pending_resolution", NewState: "resolved"},
+			expected: "🏁 **Will it rain?** was resolved: **Yes** won the 12,500 bit pot. " + link,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatNotification(tt.event, detail))
+		})
+	}
+}
+
+func TestFormatMentions(t *testing.T) {
+	assert.Equal(t, "<@1> <@2>", formatMentions([]int64{1, 2}))
+}
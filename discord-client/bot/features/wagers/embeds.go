@@ -29,7 +29,7 @@ func BuildWagerProposedEmbed(wager *entities.Wager, proposerName, targetName str
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf("___________________________________________________\nID: %d • Only %s can respond", wager.ID, targetName),
+			Text: fmt.Sprintf("___________________________________________________\nID: %d • Only %s can respond • %s", wager.ID, targetName, common.RulesFooterHint),
 		},
 		Timestamp: wager.CreatedAt.Format(time.RFC3339),
 	}
@@ -109,7 +109,7 @@ func BuildWagerVotingEmbed(wager *entities.Wager, proposerName, targetName strin
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf("Wager ID: %d • Both participants must agree", wager.ID),
+			Text: fmt.Sprintf("Wager ID: %d • Both participants must agree • %s", wager.ID, common.RulesFooterHint),
 		},
 		Timestamp: time.Now().Format(time.RFC3339),
 	}
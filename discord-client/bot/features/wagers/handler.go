@@ -10,7 +10,6 @@ import (
 
 	"gambler/discord-client/bot/common"
 	"gambler/discord-client/domain/entities"
-	"gambler/discord-client/domain/services"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -69,21 +68,17 @@ func (f *Feature) handleWagerPropose(s *discordgo.Session, i *discordgo.Interact
 	defer uow.Rollback()
 
 	// Instantiate user service with repositories from UnitOfWork
-	userService := services.NewUserService(
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	userService := uow.Services().UserService()
 
 	// Get the users to ensure they exist in the DB.
-	_, err = userService.GetOrCreateUser(context.Background(), proposerID, i.Member.User.Username)
+	_, err = userService.GetOrCreateUser(context.Background(), guildID, proposerID, i.Member.User.Username)
 	if err != nil {
-		common.RespondWithError(s, i, fmt.Sprintf("Failed to create wager: %v", err))
+		common.RespondWithError(s, i, common.DescribeError("Failed to create wager", err))
 		return
 	}
-	_, err = userService.GetOrCreateUser(context.Background(), targetID, targetUser.Username)
+	_, err = userService.GetOrCreateUser(context.Background(), guildID, targetID, targetUser.Username)
 	if err != nil {
-		common.RespondWithError(s, i, fmt.Sprintf("Failed to create wager: %v", err))
+		common.RespondWithError(s, i, common.DescribeError("Failed to create wager", err))
 		return
 	}
 
@@ -162,19 +157,13 @@ func (f *Feature) handleWagerConditionModal(s *discordgo.Session, i *discordgo.I
 	defer uow.Rollback()
 
 	// Instantiate wager service with repositories from UnitOfWork
-	wagerService := services.NewWagerService(
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.WagerVoteRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	wagerService := uow.Services().WagerService()
 
 	// Create the wager (we'll get message ID after posting)
 	channelID, _ := strconv.ParseInt(i.ChannelID, 10, 64)
-	wager, err := wagerService.ProposeWager(context.Background(), proposerID, targetID, amount, condition, 0, channelID)
+	wager, err := wagerService.ProposeWager(context.Background(), proposerID, targetID, guildID, amount, condition, 0, channelID)
 	if err != nil {
-		common.UpdateMessageWithError(s, i, fmt.Sprintf("Failed to create wager: %v", err))
+		common.UpdateMessageWithError(s, i, common.DescribeError("Failed to create wager", err))
 		return
 	}
 
@@ -245,18 +234,12 @@ func (f *Feature) handleWagerList(s *discordgo.Session, i *discordgo.Interaction
 	defer uow.Rollback()
 
 	// Instantiate wager service with repositories from UnitOfWork
-	wagerService := services.NewWagerService(
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.WagerVoteRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	wagerService := uow.Services().WagerService()
 
 	// Get active wagers
 	wagers, err := wagerService.GetActiveWagersByUser(context.Background(), userID)
 	if err != nil {
-		common.RespondWithError(s, i, fmt.Sprintf("Failed to get wagers: %v", err))
+		common.RespondWithError(s, i, common.DescribeError("Failed to get wagers", err))
 		return
 	}
 
@@ -317,18 +300,12 @@ func (f *Feature) handleWagerCancel(s *discordgo.Session, i *discordgo.Interacti
 	defer uow.Rollback()
 
 	// Instantiate wager service with repositories from UnitOfWork
-	wagerService := services.NewWagerService(
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.WagerVoteRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	wagerService := uow.Services().WagerService()
 
 	// Get the wager details first to find the message
 	wager, err := wagerService.GetWagerByID(context.Background(), wagerID)
 	if err != nil {
-		common.RespondWithError(s, i, fmt.Sprintf("Failed to get wager: %v", err))
+		common.RespondWithError(s, i, common.DescribeError("Failed to get wager", err))
 		return
 	}
 	if wager == nil {
@@ -339,7 +316,7 @@ func (f *Feature) handleWagerCancel(s *discordgo.Session, i *discordgo.Interacti
 	// Cancel the wager
 	err = wagerService.CancelWager(context.Background(), wagerID, userID)
 	if err != nil {
-		common.RespondWithError(s, i, fmt.Sprintf("Failed to cancel wager: %v", err))
+		common.RespondWithError(s, i, common.DescribeError("Failed to cancel wager", err))
 		return
 	}
 
@@ -447,21 +424,11 @@ func (f *Feature) handleWagerResponse(s *discordgo.Session, i *discordgo.Interac
 	defer uow.Rollback()
 
 	// Instantiate services with repositories from UnitOfWork
-	userService := services.NewUserService(
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
-	wagerService := services.NewWagerService(
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.WagerVoteRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	userService := uow.Services().UserService()
+	wagerService := uow.Services().WagerService()
 
 	// Ensure user exists in the database
-	_, err = userService.GetOrCreateUser(context.Background(), userID, i.Member.User.Username)
+	_, err = userService.GetOrCreateUser(context.Background(), guildID, userID, i.Member.User.Username)
 	if err != nil {
 		common.FollowUpWithError(s, i, "Unable to get user from DB")
 		return
@@ -572,21 +539,11 @@ func (f *Feature) handleWagerVote(s *discordgo.Session, i *discordgo.Interaction
 	defer uow.Rollback()
 
 	// Instantiate services with repositories from UnitOfWork
-	userService := services.NewUserService(
-		uow.UserRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
-	wagerService := services.NewWagerService(
-		uow.UserRepository(),
-		uow.WagerRepository(),
-		uow.WagerVoteRepository(),
-		uow.BalanceHistoryRepository(),
-		uow.EventBus(),
-	)
+	userService := uow.Services().UserService()
+	wagerService := uow.Services().WagerService()
 
 	// Ensure user exists in the database
-	_, err = userService.GetOrCreateUser(context.Background(), voterID, i.Member.User.Username)
+	_, err = userService.GetOrCreateUser(context.Background(), guildID, voterID, i.Member.User.Username)
 	if err != nil {
 		common.FollowUpWithError(s, i, "Unable to get user from DB")
 		return
@@ -0,0 +1,48 @@
+package webhooks
+
+import (
+	"gambler/discord-client/application"
+	"gambler/discord-client/bot/common"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Feature handles the /webhooks command
+type Feature struct {
+	uowFactory application.UnitOfWorkFactory
+}
+
+// New creates a new webhooks feature
+func New(uowFactory application.UnitOfWorkFactory) *Feature {
+	return &Feature{
+		uowFactory: uowFactory,
+	}
+}
+
+// HandleCommand routes webhooks subcommands to appropriate handlers
+func (f *Feature) HandleCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	if !common.IsUserAdmin(s, i.GuildID, i.Member.User.ID) {
+		common.RespondWithError(s, i, "❌ You need administrator permissions to use this command")
+		return
+	}
+
+	switch options[0].Name {
+	case "list":
+		f.handleList(s, i)
+	case "add":
+		f.handleAdd(s, i, options[0].Options)
+	case "remove":
+		f.handleRemove(s, i, options[0].Options)
+	case "test":
+		f.handleTest(s, i, options[0].Options)
+	case "deliveries":
+		f.handleDeliveries(s, i, options[0].Options)
+	default:
+		common.RespondWithError(s, i, "Unknown subcommand.")
+	}
+}
@@ -0,0 +1,250 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// recentDeliveryLimit is how many deliveries /webhooks deliveries shows
+const recentDeliveryLimit = 10
+
+// handleList shows the guild's webhooks and the events they receive
+func (f *Feature) handleList(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	f.withWebhookService(s, i, func(ctx context.Context, discordID, guildID int64, webhookService interfaces.WebhookService) (string, error) {
+		webhooks, err := webhookService.List(ctx)
+		if err != nil {
+			return "", err
+		}
+		return formatWebhookList(webhooks), nil
+	})
+}
+
+// handleAdd registers a webhook and shows its signing secret to the admin who added it
+func (f *Feature) handleAdd(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	url, eventTypes := parseAddOptions(options)
+
+	f.withWebhookService(s, i, func(ctx context.Context, discordID, guildID int64, webhookService interfaces.WebhookService) (string, error) {
+		webhook, err := webhookService.Register(ctx, guildID, url, eventTypes, discordID)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("✅ Webhook **#%d** added for %s.\n"+
+			"Payloads are signed with this secret, it won't be shown again:\n||`%s`||\n"+
+			"Use `/webhooks test` to send a test payload.",
+			webhook.ID, formatEventTypes(webhook.EventTypes), webhook.Secret), nil
+	})
+}
+
+// handleRemove deletes a webhook and its delivery log
+func (f *Feature) handleRemove(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	webhookID, err := parseWebhookID(options)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	f.withWebhookService(s, i, func(ctx context.Context, discordID, guildID int64, webhookService interfaces.WebhookService) (string, error) {
+		removed, err := webhookService.Remove(ctx, webhookID)
+		if err != nil {
+			return "", err
+		}
+		if !removed {
+			return "", entities.ErrWebhookNotFound
+		}
+		return fmt.Sprintf("✅ Webhook **#%d** removed.", webhookID), nil
+	})
+}
+
+// handleTest queues a test payload for a webhook
+func (f *Feature) handleTest(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	webhookID, err := parseWebhookID(options)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	f.withWebhookService(s, i, func(ctx context.Context, discordID, guildID int64, webhookService interfaces.WebhookService) (string, error) {
+		delivery, err := webhookService.SendTest(ctx, guildID, webhookID, discordID)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("📨 Test delivery **#%d** queued for webhook **#%d**. It is sent within a minute, "+
+			"check `/webhooks deliveries` for the result.", delivery.ID, webhookID), nil
+	})
+}
+
+// handleDeliveries shows a webhook's most recent deliveries
+func (f *Feature) handleDeliveries(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	webhookID, err := parseWebhookID(options)
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	f.withWebhookService(s, i, func(ctx context.Context, discordID, guildID int64, webhookService interfaces.WebhookService) (string, error) {
+		deliveries, err := webhookService.GetRecentDeliveries(ctx, webhookID, recentDeliveryLimit)
+		if err != nil {
+			return "", err
+		}
+		return formatDeliveries(webhookID, deliveries), nil
+	})
+}
+
+// withWebhookService runs fn in a guild-scoped transaction and responds with its message
+func (f *Feature) withWebhookService(s *discordgo.Session, i *discordgo.InteractionCreate, fn func(ctx context.Context, discordID, guildID int64, webhookService interfaces.WebhookService) (string, error)) {
+	ctx := context.Background()
+
+	discordID, err := strconv.ParseInt(i.Member.User.ID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing Discord ID %s: %v", i.Member.User.ID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	guildID, err := strconv.ParseInt(i.GuildID, 10, 64)
+	if err != nil {
+		log.Errorf("Error parsing guild ID %s: %v", i.GuildID, err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	uow := f.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		log.Errorf("Error beginning transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+	defer uow.Rollback()
+
+	message, err := fn(ctx, discordID, guildID, uow.Services().WebhookService())
+	if err != nil {
+		common.RespondWithError(s, i, err.Error())
+		return
+	}
+
+	if err := uow.Commit(); err != nil {
+		log.Errorf("Error committing transaction: %v", err)
+		common.RespondWithError(s, i, "Unable to process request. Please try again.")
+		return
+	}
+
+	if err := common.RespondWithSuccess(s, i, message, true); err != nil {
+		log.Errorf("Error responding to webhooks command: %v", err)
+	}
+}
+
+// parseAddOptions reads the URL and the events to subscribe to. Without any event options the
+// webhook receives every event.
+func parseAddOptions(options []*discordgo.ApplicationCommandInteractionDataOption) (string, []entities.WebhookEventType) {
+	var url string
+	var eventTypes []entities.WebhookEventType
+	for _, opt := range options {
+		if opt.Name == "url" {
+			url = strings.TrimSpace(opt.StringValue())
+			continue
+		}
+		if eventType, err := entities.ParseWebhookEventType(opt.Name); err == nil && opt.BoolValue() {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+
+	if len(eventTypes) == 0 {
+		for _, definition := range entities.WebhookEventDefinitions {
+			eventTypes = append(eventTypes, definition.EventType)
+		}
+	}
+
+	return url, eventTypes
+}
+
+// parseWebhookID reads the required webhook ID option
+func parseWebhookID(options []*discordgo.ApplicationCommandInteractionDataOption) (int64, error) {
+	for _, opt := range options {
+		if opt.Name == "id" {
+			return opt.IntValue(), nil
+		}
+	}
+	return 0, errors.New("choose a webhook")
+}
+
+// formatWebhookList lists the guild's webhooks with the events they receive
+func formatWebhookList(webhooks []*entities.GuildWebhook) string {
+	if len(webhooks) == 0 {
+		return "This server has no webhooks. Add one with `/webhooks add`."
+	}
+
+	var b strings.Builder
+	b.WriteString("**Server webhooks**\n")
+	for _, webhook := range webhooks {
+		b.WriteString(fmt.Sprintf("**#%d** `%s`\n└ %s · added by <@%d> %s\n",
+			webhook.ID, webhook.URL, formatEventTypes(webhook.EventTypes), webhook.CreatedBy,
+			common.FormatDiscordTimestamp(webhook.CreatedAt, "R")))
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// formatEventTypes names the events a webhook receives
+func formatEventTypes(eventTypes []entities.WebhookEventType) string {
+	names := make([]string, 0, len(eventTypes))
+	for _, definition := range entities.WebhookEventDefinitions {
+		for _, eventType := range eventTypes {
+			if eventType == definition.EventType {
+				names = append(names, definition.Name)
+			}
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// formatDeliveries lists a webhook's recent deliveries with their outcome
+func formatDeliveries(webhookID int64, deliveries []*entities.WebhookDelivery) string {
+	if len(deliveries) == 0 {
+		return fmt.Sprintf("Webhook **#%d** has no deliveries yet.", webhookID)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("**Recent deliveries for webhook #%d**\n", webhookID))
+	for _, delivery := range deliveries {
+		b.WriteString(fmt.Sprintf("%s **#%d** `%s` · %s", deliveryStatusEmoji(delivery.Status), delivery.ID,
+			delivery.EventType, common.FormatDiscordTimestamp(delivery.CreatedAt, "R")))
+
+		switch delivery.Status {
+		case entities.WebhookDeliveryStatusDelivered:
+			b.WriteString(fmt.Sprintf(" · HTTP %d", *delivery.ResponseStatus))
+		case entities.WebhookDeliveryStatusPending:
+			if delivery.Attempts > 0 {
+				b.WriteString(fmt.Sprintf(" · retry %d/%d %s", delivery.Attempts, entities.WebhookMaxAttempts-1,
+					common.FormatDiscordTimestamp(delivery.NextAttemptAt, "R")))
+			}
+		}
+		if delivery.Status != entities.WebhookDeliveryStatusDelivered && delivery.LastError != nil {
+			b.WriteString(fmt.Sprintf("\n└ %s", *delivery.LastError))
+		}
+		b.WriteString("\n")
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// deliveryStatusEmoji returns the emoji shown for a delivery's status
+func deliveryStatusEmoji(status entities.WebhookDeliveryStatus) string {
+	switch status {
+	case entities.WebhookDeliveryStatusDelivered:
+		return "✅"
+	case entities.WebhookDeliveryStatusFailed:
+		return "❌"
+	default:
+		return "⏳"
+	}
+}
@@ -0,0 +1,66 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAddOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("chosen events", func(t *testing.T) {
+		url, eventTypes := parseAddOptions([]*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: "url", Type: discordgo.ApplicationCommandOptionString, Value: " https://example.com/hook "},
+			{Name: "big_win", Type: discordgo.ApplicationCommandOptionBoolean, Value: true},
+			{Name: "lottery_result", Type: discordgo.ApplicationCommandOptionBoolean, Value: false},
+		})
+
+		assert.Equal(t, "https://example.com/hook", url)
+		assert.Equal(t, []entities.WebhookEventType{entities.WebhookEventBigWin}, eventTypes)
+	})
+
+	t.Run("defaults to every event", func(t *testing.T) {
+		_, eventTypes := parseAddOptions([]*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: "url", Type: discordgo.ApplicationCommandOptionString, Value: "https://example.com/hook"},
+		})
+
+		assert.Len(t, eventTypes, len(entities.WebhookEventDefinitions))
+	})
+}
+
+func TestFormatDeliveries(t *testing.T) {
+	t.Parallel()
+
+	created := time.Unix(1718000000, 0)
+	status := 200
+	failedStatus := 500
+	reason := "endpoint responded with status 500"
+
+	message := formatDeliveries(1, []*entities.WebhookDelivery{
+		{ID: 3, EventType: entities.WebhookEventTest, Status: entities.WebhookDeliveryStatusDelivered, Attempts: 1, ResponseStatus: &status, CreatedAt: created},
+		{ID: 2, EventType: entities.WebhookEventBigWin, Status: entities.WebhookDeliveryStatusPending, Attempts: 2, ResponseStatus: &failedStatus, LastError: &reason, CreatedAt: created, NextAttemptAt: created.Add(time.Minute)},
+		{ID: 1, EventType: entities.WebhookEventBigWin, Status: entities.WebhookDeliveryStatusFailed, Attempts: 6, LastError: &reason, CreatedAt: created},
+	})
+
+	assert.Contains(t, message, "✅ **#3** `test` · <t:1718000000:R> · HTTP 200")
+	assert.Contains(t, message, "⏳ **#2** `big_win` · <t:1718000000:R> · retry 2/5 <t:1718000060:R>\n└ endpoint responded with status 500")
+	assert.Contains(t, message, "❌ **#1** `big_win`")
+	assert.Contains(t, formatDeliveries(1, nil), "no deliveries yet")
+}
+
+func TestFormatWebhookList(t *testing.T) {
+	t.Parallel()
+
+	message := formatWebhookList([]*entities.GuildWebhook{
+		{ID: 7, URL: "https://example.com/hook", EventTypes: []entities.WebhookEventType{entities.WebhookEventWagerResolved, entities.WebhookEventBigWin}, CreatedBy: 456, CreatedAt: time.Unix(1718000000, 0)},
+	})
+
+	assert.Contains(t, message, "**#7** `https://example.com/hook`")
+	assert.Contains(t, message, "Big Wins, Wager Resolutions · added by <@456>")
+	assert.Contains(t, formatWebhookList(nil), "/webhooks add")
+}
@@ -6,7 +6,6 @@ import (
 
 	"gambler/discord-client/application"
 	"gambler/discord-client/application/dto"
-	"gambler/discord-client/domain/services"
 
 	"github.com/bwmarrin/discordgo"
 	log "github.com/sirupsen/logrus"
@@ -66,7 +65,7 @@ func (g *GuildDiscoveryServiceImpl) getPrimaryChannelID(ctx context.Context, gui
 	}
 	defer uow.Rollback()
 
-	guildSettingsService := services.NewGuildSettingsService(uow.GuildSettingsRepository())
+	guildSettingsService := uow.Services().GuildSettingsService()
 	settings, err := guildSettingsService.GetOrCreateSettings(ctx, guildID)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,67 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gambler/discord-client/domain/entities"
+)
+
+// CreateGuildSnapshot stores a copy of a guild's balances, open group wagers and open lottery draw
+func (b *Bot) CreateGuildSnapshot(guildIDStr, reason string) (*entities.GuildSnapshot, error) {
+	guildID, err := strconv.ParseInt(guildIDStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid guild ID: %w", err)
+	}
+
+	ctx := context.Background()
+	uow := b.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	snapshot, err := uow.Services().SnapshotService().CreateSnapshot(ctx, guildID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit snapshot: %w", err)
+	}
+	return snapshot, nil
+}
+
+// RestoreGuildSnapshot rolls a guild's balances and open lottery pot back to a snapshot. Either every
+// balance is restored or none are. A dry run reports the changes without making them.
+func (b *Bot) RestoreGuildSnapshot(guildIDStr, snapshotIDStr string, dryRun bool) (*entities.GuildSnapshotRestoreReport, error) {
+	guildID, err := strconv.ParseInt(guildIDStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid guild ID: %w", err)
+	}
+	snapshotID, err := strconv.ParseInt(snapshotIDStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot ID: %w", err)
+	}
+
+	ctx := context.Background()
+	uow := b.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	report, err := uow.Services().SnapshotService().RestoreSnapshot(ctx, guildID, snapshotID, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	if err := uow.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit snapshot restore: %w", err)
+	}
+	return report, nil
+}
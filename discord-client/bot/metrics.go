@@ -0,0 +1,104 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"gambler/discord-client/application"
+	"gambler/discord-client/domain/entities"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// EconomyGaugesInterval is how often guild economy gauges are recomputed for the metrics endpoint
+const EconomyGaugesInterval = 5 * time.Minute
+
+// economyMetrics describes each gauge exported per guild on the metrics endpoint
+var economyMetrics = []struct {
+	name  string
+	help  string
+	value func(*entities.EconomyGauges) int64
+}{
+	{"gamba_bits_in_circulation", "Total bits held by members of the guild.", func(g *entities.EconomyGauges) int64 { return g.BitsInCirculation }},
+	{"gamba_bits_locked_in_wagers", "Bits locked in open group wagers and 1v1 wagers being voted on.", func(g *entities.EconomyGauges) int64 { return g.BitsLockedInWagers }},
+	{"gamba_lottery_pot_bits", "Bits in the guild's open lottery pot.", func(g *entities.EconomyGauges) int64 { return g.LotteryPot }},
+	{"gamba_active_wagers", "Wagers taking bets, being voted on or awaiting resolution.", func(g *entities.EconomyGauges) int64 { return g.ActiveWagers }},
+}
+
+// economyGaugeCache holds the latest economy snapshot of each guild this shard serves
+type economyGaugeCache struct {
+	mu     sync.RWMutex
+	gauges map[int64]*entities.EconomyGauges
+}
+
+// replace swaps in a fresh set of snapshots, dropping guilds the shard no longer serves
+func (c *economyGaugeCache) replace(gauges map[int64]*entities.EconomyGauges) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gauges = gauges
+}
+
+// snapshot returns the cached gauges ordered by guild ID
+func (c *economyGaugeCache) snapshot() []*entities.EconomyGauges {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	gauges := make([]*entities.EconomyGauges, 0, len(c.gauges))
+	for _, g := range c.gauges {
+		gauges = append(gauges, g)
+	}
+	sort.Slice(gauges, func(i, j int) bool { return gauges[i].GuildID < gauges[j].GuildID })
+	return gauges
+}
+
+// writeEconomyMetrics renders guild economy gauges in the Prometheus text exposition format
+func writeEconomyMetrics(w io.Writer, gauges []*entities.EconomyGauges) error {
+	for _, metric := range economyMetrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n", metric.name, metric.help, metric.name); err != nil {
+			return err
+		}
+		for _, g := range gauges {
+			if _, err := fmt.Fprintf(w, "%s{guild_id=\"%d\"} %d\n", metric.name, g.GuildID, metric.value(g)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// EconomyGaugesJob returns the scheduler job that recomputes the economy gauges of every guild this shard serves
+func (b *Bot) EconomyGaugesJob() application.Job {
+	return application.Job{
+		Name:       "economy-gauges",
+		Interval:   EconomyGaugesInterval,
+		RunOnStart: true,
+		Run:        b.refreshEconomyGauges,
+	}
+}
+
+// refreshEconomyGauges snapshots each guild's economy from the read replica
+func (b *Bot) refreshEconomyGauges(ctx context.Context) error {
+	gauges := make(map[int64]*entities.EconomyGauges)
+	for _, guild := range b.session.State.Guilds {
+		guildID, err := strconv.ParseInt(guild.ID, 10, 64)
+		if err != nil {
+			log.Errorf("Failed to parse guild ID %s: %v", guild.ID, err)
+			continue
+		}
+
+		snapshot, err := b.uowFactory.CreateReadOnlyForGuild(guildID).UserRepository().GetEconomyGauges(ctx)
+		if err != nil {
+			log.Errorf("Error computing economy gauges for guild %d: %v", guildID, err)
+			continue
+		}
+		gauges[guildID] = snapshot
+	}
+
+	b.economyGauges.replace(gauges)
+	return nil
+}
@@ -0,0 +1,42 @@
+package bot
+
+import (
+	"strings"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteEconomyMetrics(t *testing.T) {
+	var cache economyGaugeCache
+	cache.replace(map[int64]*entities.EconomyGauges{
+		200: {GuildID: 200, BitsInCirculation: 5000, BitsLockedInWagers: 1200, LotteryPot: 300, ActiveWagers: 2},
+		100: {GuildID: 100, BitsInCirculation: 900},
+	})
+
+	var sb strings.Builder
+	require.NoError(t, writeEconomyMetrics(&sb, cache.snapshot()))
+	out := sb.String()
+
+	assert.Contains(t, out, "# TYPE gamba_bits_in_circulation gauge\n"+
+		"gamba_bits_in_circulation{guild_id=\"100\"} 900\n"+
+		"gamba_bits_in_circulation{guild_id=\"200\"} 5000\n")
+	assert.Contains(t, out, "gamba_bits_locked_in_wagers{guild_id=\"200\"} 1200\n")
+	assert.Contains(t, out, "gamba_lottery_pot_bits{guild_id=\"200\"} 300\n")
+	assert.Contains(t, out, "gamba_active_wagers{guild_id=\"100\"} 0\n")
+	assert.Equal(t, len(economyMetrics), strings.Count(out, "# HELP "))
+}
+
+func TestWriteEconomyMetrics_NoGuilds(t *testing.T) {
+	var cache economyGaugeCache
+
+	var sb strings.Builder
+	require.NoError(t, writeEconomyMetrics(&sb, cache.snapshot()))
+
+	// Metric families are still described so scrapers see them before the first refresh
+	assert.NotContains(t, sb.String(), "guild_id")
+	assert.Contains(t, sb.String(), "# TYPE gamba_active_wagers gauge")
+}
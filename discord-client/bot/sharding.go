@@ -0,0 +1,116 @@
+package bot
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+)
+
+// ShardHealth reports the gateway status of the shard served by this process
+type ShardHealth struct {
+	ShardID          int       `json:"shard_id"`
+	ShardCount       int       `json:"shard_count"`
+	Connected        bool      `json:"connected"`
+	GuildCount       int       `json:"guild_count"`
+	HeartbeatLatency int64     `json:"heartbeat_latency_ms"`
+	LastConnectedAt  time.Time `json:"last_connected_at"`
+	LastDisconnectAt time.Time `json:"last_disconnect_at"`
+}
+
+// shardState tracks gateway connection changes for health reporting
+type shardState struct {
+	mu               sync.RWMutex
+	connected        bool
+	lastConnectedAt  time.Time
+	lastDisconnectAt time.Time
+}
+
+// configureSharding sets the shard identity on the session before it is opened.
+// A shard count of 0 asks Discord for the recommended number of shards.
+func configureSharding(dg *discordgo.Session, config Config) error {
+	shardCount := config.ShardCount
+	if shardCount == 0 {
+		gateway, err := dg.GatewayBot()
+		if err != nil {
+			return fmt.Errorf("failed to fetch recommended shard count: %w", err)
+		}
+		shardCount = gateway.Shards
+		log.Infof("Using recommended shard count from Discord: %d", shardCount)
+	}
+	if shardCount < 1 {
+		shardCount = 1
+	}
+
+	if config.ShardID < 0 || config.ShardID >= shardCount {
+		return fmt.Errorf("shard ID %d is out of range for shard count %d", config.ShardID, shardCount)
+	}
+
+	dg.ShardID = config.ShardID
+	dg.ShardCount = shardCount
+	return nil
+}
+
+// ShardForGuild returns the shard Discord routes a guild's events to
+func ShardForGuild(guildID int64, shardCount int) int {
+	if shardCount <= 1 {
+		return 0
+	}
+	return int((uint64(guildID) >> 22) % uint64(shardCount))
+}
+
+// OwnsGuild reports whether this process's shard is responsible for the guild
+func (b *Bot) OwnsGuild(guildID int64) bool {
+	return ShardForGuild(guildID, b.session.ShardCount) == b.session.ShardID
+}
+
+// IsPrimaryShard reports whether this process runs shard 0, which owns global work
+// such as command registration and cross-guild workers
+func (b *Bot) IsPrimaryShard() bool {
+	return b.session.ShardID == 0
+}
+
+// handleConnect records that the shard's gateway connection is up
+func (b *Bot) handleConnect(s *discordgo.Session, c *discordgo.Connect) {
+	b.shard.mu.Lock()
+	b.shard.connected = true
+	b.shard.lastConnectedAt = time.Now()
+	b.shard.mu.Unlock()
+
+	log.Infof("Shard %d/%d connected", s.ShardID, s.ShardCount)
+}
+
+// handleDisconnect records that the shard's gateway connection dropped
+func (b *Bot) handleDisconnect(s *discordgo.Session, d *discordgo.Disconnect) {
+	b.shard.mu.Lock()
+	b.shard.connected = false
+	b.shard.lastDisconnectAt = time.Now()
+	b.shard.mu.Unlock()
+
+	log.Warnf("Shard %d/%d disconnected", s.ShardID, s.ShardCount)
+}
+
+// GetShardHealth returns the current health of this process's shard
+func (b *Bot) GetShardHealth() ShardHealth {
+	b.shard.mu.RLock()
+	defer b.shard.mu.RUnlock()
+
+	guildCount := 0
+	if b.session.State != nil {
+		b.session.State.RLock()
+		guildCount = len(b.session.State.Guilds)
+		b.session.State.RUnlock()
+	}
+
+	return ShardHealth{
+		ShardID:          b.session.ShardID,
+		ShardCount:       b.session.ShardCount,
+		Connected:        b.shard.connected,
+		GuildCount:       guildCount,
+		HeartbeatLatency: b.session.HeartbeatLatency().Milliseconds(),
+		LastConnectedAt:  b.shard.lastConnectedAt,
+		LastDisconnectAt: b.shard.lastDisconnectAt,
+	}
+}
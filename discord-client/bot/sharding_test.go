@@ -0,0 +1,40 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShardForGuild(t *testing.T) {
+	// Discord routes guilds using (guild_id >> 22) % shard_count
+	guildID := int64(197038439483310086)
+
+	assert.Equal(t, 0, ShardForGuild(guildID, 1))
+	assert.Equal(t, 0, ShardForGuild(guildID, 0))
+	assert.Equal(t, int((guildID>>22)%4), ShardForGuild(guildID, 4))
+}
+
+func TestBot_OwnsGuild(t *testing.T) {
+	guildID := int64(197038439483310086)
+	shardCount := 4
+	owner := ShardForGuild(guildID, shardCount)
+
+	for shardID := 0; shardID < shardCount; shardID++ {
+		b := &Bot{session: &discordgo.Session{ShardID: shardID, ShardCount: shardCount}}
+		assert.Equal(t, shardID == owner, b.OwnsGuild(guildID))
+	}
+}
+
+func TestConfigureSharding_RejectsOutOfRangeShard(t *testing.T) {
+	dg := &discordgo.Session{}
+
+	err := configureSharding(dg, Config{ShardID: 2, ShardCount: 2})
+	assert.Error(t, err)
+
+	err = configureSharding(dg, Config{ShardID: 1, ShardCount: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, dg.ShardID)
+	assert.Equal(t, 2, dg.ShardCount)
+}
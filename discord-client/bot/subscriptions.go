@@ -1,7 +1,11 @@
 package bot
 
 import (
+	"context"
+
+	"gambler/discord-client/application"
 	"gambler/discord-client/domain"
+	"gambler/discord-client/domain/events"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -12,9 +16,37 @@ func RegisterBotSubscriptions(
 	subscriber domain.EventSubscriber,
 	bot *Bot,
 ) error {
-	// Currently no bot-level subscriptions needed
 	// High roller updates are now manual through purchase commands
-	
+
+	// Balance changes are published by this process, so handle them locally
+	// Since NATS doesn't deliver messages back to the publisher, we need local handling
+	if localRegistry, ok := bot.uowFactory.(application.LocalHandlerRegistry); ok {
+		localRegistry.RegisterLocalHandler(events.EventTypeBalanceChange, bot.audit.HandleBalanceChange)
+		log.Info("Registered local handler for balance change audit log")
+		localRegistry.RegisterLocalHandler(events.EventTypeBalanceChange, bot.lowBalance.HandleBalanceChange)
+		localRegistry.RegisterLocalHandler(events.EventTypeBalanceLow, bot.lowBalance.HandleBalanceLow)
+		log.Info("Registered local handlers for low balance warnings")
+
+		// State changes are broadcast to every replica, so only the one owning the guild notifies followers
+		localRegistry.RegisterLocalHandler(events.EventTypeGroupWagerStateChange, func(ctx context.Context, event events.Event) error {
+			if e, ok := event.(events.GroupWagerStateChangeEvent); ok && !bot.OwnsGuild(e.GuildID) {
+				return nil
+			}
+			return bot.followers.HandleGroupWagerStateChange(ctx, event)
+		})
+		log.Info("Registered local handler for group wager follower notifications")
+
+		localRegistry.RegisterLocalHandler(events.EventTypeGroupWagerStateChange, func(ctx context.Context, event events.Event) error {
+			if e, ok := event.(events.GroupWagerStateChangeEvent); ok && !bot.OwnsGuild(e.GuildID) {
+				return nil
+			}
+			return bot.lottery.HandleGroupWagerStateChange(ctx, event)
+		})
+		log.Info("Registered local handler for lottery pot tax updates")
+	} else {
+		log.Warn("UnitOfWorkFactory does not support local handler registration")
+	}
+
 	log.Info("Bot event subscriptions registered successfully")
 	return nil
 }
@@ -2,90 +2,311 @@ package bot
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
-	"gambler/discord-client/domain/services"
+	"gambler/discord-client/application"
+	"gambler/discord-client/bot/common"
+	"gambler/discord-client/config"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
 
 	log "github.com/sirupsen/logrus"
 )
 
-// StartGroupWagerExpirationWorker starts a background worker to check for expired group wagers
-// Returns a cleanup function to stop the worker gracefully
-func (b *Bot) StartGroupWagerExpirationWorker(ctx context.Context) func() {
-	ticker := time.NewTicker(1 * time.Minute)
-	stopChan := make(chan struct{})
+// GroupWagerExpirationJob returns the scheduler job that moves expired group wagers to pending resolution
+func (b *Bot) GroupWagerExpirationJob() application.Job {
+	return application.Job{
+		Name:       "group-wager-expiration",
+		Interval:   1 * time.Minute,
+		RunOnStart: true,
+		Run:        b.processExpiredWagers,
+	}
+}
+
+// processExpiredWagers transitions expired group wagers in every guild this shard serves
+func (b *Bot) processExpiredWagers(ctx context.Context) error {
+	// First, get all guild IDs that have group wagers
+	// Use a temporary UnitOfWork to query all guilds
+	tempUow := b.uowFactory.CreateForGuild(0)
+	if err := tempUow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction to get guild list: %w", err)
+	}
+
+	// Get all guild IDs with active group wagers
+	guildIDs, err := tempUow.GroupWagerRepository().GetGuildsWithActiveWagers(ctx)
+	tempUow.Rollback()
+
+	if err != nil {
+		return fmt.Errorf("failed to get guilds with active wagers: %w", err)
+	}
+
+	// Process expired wagers for each guild separately
+	for _, guildID := range guildIDs {
+		// Each shard only expires wagers for the guilds it serves
+		if !b.OwnsGuild(guildID) {
+			continue
+		}
 
-	// Run immediately on startup
-	processExpiredWagers := func() {
-		// First, get all guild IDs that have group wagers
-		// Use a temporary UnitOfWork to query all guilds
-		tempUow := b.uowFactory.CreateForGuild(0)
-		if err := tempUow.Begin(context.Background()); err != nil {
-			log.Errorf("Error beginning transaction to get guild list: %v", err)
-			return
+		uow := b.uowFactory.CreateForGuild(guildID)
+		if err := uow.Begin(ctx); err != nil {
+			log.Errorf("Error beginning transaction for guild %d expired group wagers: %v", guildID, err)
+			continue
 		}
 
-		// Get all guild IDs with active group wagers
-		guildIDs, err := tempUow.GroupWagerRepository().GetGuildsWithActiveWagers(context.Background())
-		tempUow.Rollback()
+		// Instantiate service with repositories from UnitOfWork
+		groupWagerService := uow.Services().GroupWagerService()
 
+		assigned, err := groupWagerService.TransitionExpiredWagers(ctx)
 		if err != nil {
-			log.Errorf("Error getting guilds with active wagers: %v", err)
-			return
+			log.Errorf("Error transitioning expired group wagers for guild %d: %v", guildID, err)
+			uow.Rollback()
+			continue
 		}
 
-		// Process expired wagers for each guild separately
-		for _, guildID := range guildIDs {
-			uow := b.uowFactory.CreateForGuild(guildID)
-			if err := uow.Begin(context.Background()); err != nil {
-				log.Errorf("Error beginning transaction for guild %d expired group wagers: %v", guildID, err)
+		var settings *entities.GuildSettings
+		if len(assigned) > 0 {
+			settings, err = uow.GuildSettingsRepository().GetOrCreateGuildSettings(ctx, guildID)
+			if err != nil {
+				log.Errorf("Error getting guild settings for guild %d: %v", guildID, err)
+				uow.Rollback()
 				continue
 			}
+		}
 
-			// Instantiate service with repositories from UnitOfWork
-			groupWagerService := services.NewGroupWagerService(
-				uow.GroupWagerRepository(),
-				uow.UserRepository(),
-				uow.BalanceHistoryRepository(),
-				uow.EventBus(),
-			)
+		if err := uow.Commit(); err != nil {
+			log.Errorf("Error committing expired group wagers transaction for guild %d: %v", guildID, err)
+			continue
+		}
 
-			if err := groupWagerService.TransitionExpiredWagers(context.Background()); err != nil {
-				log.Errorf("Error transitioning expired group wagers for guild %d: %v", guildID, err)
-				uow.Rollback()
-				continue
-			}
+		if len(assigned) > 0 {
+			b.notifyResolverAssignments(guildID, settings, assigned)
+		}
+	}
 
-			if err := uow.Commit(); err != nil {
-				log.Errorf("Error committing expired group wagers transaction for guild %d: %v", guildID, err)
-			}
+	return nil
+}
+
+// notifyResolverAssignments pings the resolver each wager was assigned to in the guild's primary channel,
+// falling back to the channel the wager was created in
+func (b *Bot) notifyResolverAssignments(guildID int64, settings *entities.GuildSettings, assigned []*entities.GroupWager) {
+	for _, wager := range assigned {
+		channelID := wager.ChannelID
+		if settings.HasPrimaryChannel() {
+			channelID = *settings.PrimaryChannelID
+		}
+		if channelID == 0 || wager.AssignedResolverID == nil {
+			continue
+		}
+
+		msg := fmt.Sprintf("🧑‍⚖️ **Wager ready to resolve**\n<@%d>, you've been assigned to resolve **%s**. Voting ended %s.",
+			*wager.AssignedResolverID,
+			wager.Condition,
+			common.FormatDiscordTimestamp(wager.PendingResolutionSince(), "R"))
+		if wager.MessageID != 0 {
+			msg += "\n" + common.FormatDiscordMessageLink(guildID, wager.ChannelID, wager.MessageID)
 		}
+
+		if _, err := b.session.ChannelMessageSend(strconv.FormatInt(channelID, 10), msg); err != nil {
+			log.Errorf("Failed to send resolver assignment for group wager %d: %v", wager.ID, err)
+		}
+	}
+}
+
+// StuckWagerReconcilerJob returns the scheduler job that pings resolvers about group wagers stuck in
+// pending_resolution and auto-cancels them with full refunds once the guild's hard timeout is reached.
+// It runs on start to backfill wagers that got stuck while the bot was down.
+func (b *Bot) StuckWagerReconcilerJob() application.Job {
+	return application.Job{
+		Name:       "stuck-wager-reconciler",
+		Interval:   1 * time.Hour,
+		Jitter:     5 * time.Minute,
+		RunOnStart: true,
+		Run:        b.reconcileStuckWagers,
+	}
+}
+
+// reconcileStuckWagers reconciles stuck wagers in every guild this shard serves
+func (b *Bot) reconcileStuckWagers(ctx context.Context) error {
+	tempUow := b.uowFactory.CreateForGuild(0)
+	if err := tempUow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction to get guild list: %w", err)
+	}
+
+	guildIDs, err := tempUow.GroupWagerRepository().GetGuildsWithWagersPendingResolution(ctx)
+	tempUow.Rollback()
+
+	if err != nil {
+		return fmt.Errorf("failed to get guilds with wagers pending resolution: %w", err)
 	}
 
-	// Start the worker goroutine
-	go func() {
-		log.Info("Group wager expiration worker started")
+	for _, guildID := range guildIDs {
+		if !b.OwnsGuild(guildID) {
+			continue
+		}
+
+		uow := b.uowFactory.CreateForGuild(guildID)
+		if err := uow.Begin(ctx); err != nil {
+			log.Errorf("Error beginning transaction for guild %d stuck wager reconciliation: %v", guildID, err)
+			continue
+		}
 
-		// Run immediately on startup
-		processExpiredWagers()
+		groupWagerService := uow.Services().GroupWagerService()
+
+		result, err := groupWagerService.ReconcileStuckWagers(ctx, guildID, time.Now())
+		if err != nil {
+			log.Errorf("Error reconciling stuck group wagers for guild %d: %v", guildID, err)
+			uow.Rollback()
+			continue
+		}
 
-		for {
-			select {
-			case <-ctx.Done():
-				log.Info("Group wager expiration worker shutting down (context cancelled)...")
-				return
-			case <-stopChan:
-				log.Info("Group wager expiration worker shutting down (stop requested)...")
-				return
-			case <-ticker.C:
-				processExpiredWagers()
+		settings, err := uow.GuildSettingsRepository().GetOrCreateGuildSettings(ctx, guildID)
+		if err != nil {
+			log.Errorf("Error getting guild settings for guild %d: %v", guildID, err)
+			uow.Rollback()
+			continue
+		}
+
+		resolvers, err := uow.GuildResolverRepository().GetAll(ctx)
+		if err != nil {
+			log.Errorf("Error getting resolvers for guild %d: %v", guildID, err)
+			uow.Rollback()
+			continue
+		}
+
+		if err := uow.Commit(); err != nil {
+			log.Errorf("Error committing stuck wager reconciliation for guild %d: %v", guildID, err)
+			continue
+		}
+
+		b.notifyStuckWagerReconciliation(guildID, settings, resolvers, result)
+	}
+
+	return nil
+}
+
+// notifyStuckWagerReconciliation posts resolver reminders and cancellation notices to the guild's primary
+// channel, falling back to the channel each wager was created in
+func (b *Bot) notifyStuckWagerReconciliation(guildID int64, settings *entities.GuildSettings, resolvers []*entities.GuildResolver, result *interfaces.StuckWagerReconciliation) {
+	channelFor := func(wager *entities.GroupWager) int64 {
+		if settings.HasPrimaryChannel() {
+			return *settings.PrimaryChannelID
+		}
+		return wager.ChannelID
+	}
+
+	resolverMentions := make([]string, 0, len(config.Get().ResolverDiscordIDs)+len(resolvers))
+	for _, resolverID := range config.Get().ResolverDiscordIDs {
+		resolverMentions = append(resolverMentions, fmt.Sprintf("<@%d>", resolverID))
+	}
+	for _, resolver := range resolvers {
+		resolverMentions = append(resolverMentions, resolver.Mention())
+	}
+
+	for _, wager := range result.Reminded {
+		channelID := channelFor(wager)
+		if channelID == 0 {
+			continue
+		}
+
+		msg := fmt.Sprintf("⏰ **Wager awaiting resolution**\n%s\n**%s** has been pending resolution since %s.",
+			strings.Join(resolverMentions, " "),
+			wager.Condition,
+			common.FormatDiscordTimestamp(wager.PendingResolutionSince(), "R"))
+		if wager.AssignedResolverID != nil {
+			// The assigned resolver didn't get to it, so every resolver is asked to step in
+			msg = fmt.Sprintf("⏰ **Wager awaiting resolution**\n%s\n**%s** has been pending resolution since %s. <@%d> was assigned but hasn't resolved it yet, so any resolver can step in.",
+				strings.Join(resolverMentions, " "),
+				wager.Condition,
+				common.FormatDiscordTimestamp(wager.PendingResolutionSince(), "R"),
+				*wager.AssignedResolverID)
+		}
+		if wager.MessageID != 0 {
+			msg += "\n" + common.FormatDiscordMessageLink(guildID, wager.ChannelID, wager.MessageID)
+		}
+
+		if _, err := b.session.ChannelMessageSend(strconv.FormatInt(channelID, 10), msg); err != nil {
+			log.Errorf("Failed to send resolution reminder for group wager %d: %v", wager.ID, err)
+		}
+	}
+
+	for _, wager := range result.Cancelled {
+		channelID := channelFor(wager)
+		if channelID == 0 {
+			continue
+		}
+
+		msg := fmt.Sprintf("🚫 **Wager cancelled**\n**%s** was cancelled after waiting %s for resolution. All bets have been refunded.",
+			wager.Condition,
+			common.FormatDuration(time.Since(wager.PendingResolutionSince())))
+
+		if _, err := b.session.ChannelMessageSend(strconv.FormatInt(channelID, 10), msg); err != nil {
+			log.Errorf("Failed to send stuck wager cancellation notice for group wager %d: %v", wager.ID, err)
+		}
+	}
+}
+
+// UserStatsReconcilerJob returns the scheduler job that recomputes cached user stats that drifted from the
+// underlying bets and wagers. The triggers keep user_stats current, so this only repairs missed updates.
+func (b *Bot) UserStatsReconcilerJob() application.Job {
+	return application.Job{
+		Name:     "user-stats-reconciler",
+		Interval: 24 * time.Hour,
+		Jitter:   30 * time.Minute,
+		Run:      b.reconcileUserStats,
+	}
+}
+
+// reconcileUserStats refreshes the drifted user stats rows of every guild this shard serves
+func (b *Bot) reconcileUserStats(ctx context.Context) error {
+	for _, guild := range b.session.State.Guilds {
+		guildID, err := strconv.ParseInt(guild.ID, 10, 64)
+		if err != nil {
+			log.Errorf("Failed to parse guild ID %s: %v", guild.ID, err)
+			continue
+		}
+
+		uow := b.uowFactory.CreateForGuild(guildID)
+		if err := uow.Begin(ctx); err != nil {
+			log.Errorf("Error beginning transaction for guild %d user stats reconciliation: %v", guildID, err)
+			continue
+		}
+
+		drifted, err := uow.UserStatsRepository().GetDrifted(ctx)
+		if err != nil {
+			log.Errorf("Error finding drifted user stats for guild %d: %v", guildID, err)
+			uow.Rollback()
+			continue
+		}
+		if len(drifted) == 0 {
+			uow.Rollback()
+			continue
+		}
+
+		log.WithFields(log.Fields{
+			"guild_id": guildID,
+			"users":    len(drifted),
+		}).Warn("Cached user stats drifted from bets and wagers, refreshing")
+
+		refreshed := true
+		for _, discordID := range drifted {
+			if err := uow.UserStatsRepository().Refresh(ctx, discordID); err != nil {
+				log.Errorf("Error refreshing user stats for user %d in guild %d: %v", discordID, guildID, err)
+				refreshed = false
+				break
 			}
 		}
-	}()
+		if !refreshed {
+			uow.Rollback()
+			continue
+		}
 
-	// Return cleanup function
-	return func() {
-		ticker.Stop()
-		close(stopChan)
+		if err := uow.Commit(); err != nil {
+			log.Errorf("Error committing user stats reconciliation for guild %d: %v", guildID, err)
+		}
 	}
+
+	return nil
 }
@@ -3,6 +3,7 @@ package debug
 import (
 	"context"
 	"fmt"
+	"os"
 	"strconv"
 
 	"gambler/discord-client/domain/entities"
@@ -23,6 +24,18 @@ func (s *Shell) addAdminCommands() {
 			Usage:       "admin-transfer [guild_id] <from_user_id> <to_user_id> <amount>",
 			Category:    "admin",
 		},
+		"bulk-adjust-balances": {
+			Handler:     s.handleBulkAdjustBalances,
+			Description: "Apply balance adjustments from a CSV of discord_id,mode,amount,reason",
+			Usage:       "bulk-adjust-balances [guild_id] <csv_file> [--dry-run]",
+			Category:    "admin",
+		},
+		"reverse-transaction": {
+			Handler:     s.handleReverseTransaction,
+			Description: "Undo a balance history entry with a compensating entry",
+			Usage:       "reverse-transaction [guild_id] <balance_history_id>",
+			Category:    "admin",
+		},
 		"reset-all-2026": {
 			Handler:     s.handleResetAll2026,
 			Description: "Reset all guild balances to 1 bit for 2026",
@@ -98,7 +111,7 @@ func (s *Shell) handleUpdateBalance(shell *Shell, args []string) error {
 		BalanceBefore:   user.Balance,
 		BalanceAfter:    newBalance,
 		ChangeAmount:    newBalance - user.Balance,
-		TransactionType: entities.TransactionTypeTransferIn,
+		TransactionType: entities.AdjustmentTransactionType(newBalance - user.Balance),
 		TransactionMetadata: map[string]any{
 			"admin":  "true",
 			"source": "debug_shell",
@@ -246,6 +259,94 @@ func (s *Shell) handleAdjustBalance(shell *Shell, args []string) error {
 	return nil
 }
 
+// handleReverseTransaction undoes a balance history entry, e.g. a mistaken manual adjustment
+func (s *Shell) handleReverseTransaction(shell *Shell, args []string) error {
+	var guildID, historyID int64
+	var err error
+
+	if s.currentGuild != 0 && len(args) == 1 {
+		// Use default guild: reverse-transaction <balance_history_id>
+		guildID = s.currentGuild
+		historyID, err = strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid balance history ID: %w", err)
+		}
+	} else if len(args) >= 2 {
+		// Full syntax: reverse-transaction <guild_id> <balance_history_id>
+		guildID, err = strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid guild ID: %w", err)
+		}
+		historyID, err = strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid balance history ID: %w", err)
+		}
+	} else {
+		if s.currentGuild == 0 {
+			return fmt.Errorf("usage: reverse-transaction <guild_id> <balance_history_id>\nOr set a guild with 'guild <id>' and use: reverse-transaction <balance_history_id>")
+		}
+		return fmt.Errorf("usage: reverse-transaction <balance_history_id>")
+	}
+
+	ctx := context.Background()
+	uow := s.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	original, err := uow.BalanceHistoryRepository().GetByIDForUpdate(ctx, historyID)
+	if err != nil {
+		return fmt.Errorf("failed to get balance history: %w", err)
+	}
+	if original == nil {
+		return entities.ErrBalanceHistoryNotFound
+	}
+	if err := original.CanReverse(); err != nil {
+		return err
+	}
+
+	// Show the entry being reversed
+	fmt.Printf("\n↩️  Transaction Reversal:\n")
+	fmt.Printf("   Guild:   %d\n", guildID)
+	fmt.Printf("   Entry:   #%d (%s)\n", original.ID, original.TransactionType)
+	fmt.Printf("   User:    %d\n", original.DiscordID)
+	fmt.Printf("   Date:    %s\n", original.CreatedAt.Format("2006-01-02 15:04:05"))
+	fmt.Printf("   Change:  %s bits\n", formatSignedNumber(original.ChangeAmount))
+	fmt.Printf("   Reverse: %s bits\n", formatSignedNumber(-original.ChangeAmount))
+
+	// Confirm action
+	if !s.confirmAction("Reverse this transaction?") {
+		return nil
+	}
+
+	adminService := uow.Services().AdminService()
+
+	reversal, err := adminService.ReverseTransaction(ctx, historyID)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Log admin action
+	s.logAdminAction("reverse_transaction", map[string]interface{}{
+		"guild_id":    guildID,
+		"user_id":     original.DiscordID,
+		"reversed_id": original.ID,
+		"reversal_id": reversal.ID,
+		"change":      reversal.ChangeAmount,
+		"new_balance": reversal.BalanceAfter,
+	})
+
+	s.printSuccess(fmt.Sprintf("Transaction #%d reversed by #%d, user %d now has %s bits",
+		original.ID, reversal.ID, original.DiscordID, formatNumber(reversal.BalanceAfter)))
+	return nil
+}
+
 // handleResetUser resets a user to the starting balance
 func (s *Shell) handleResetUser(shell *Shell, args []string) error {
 	if len(args) < 2 {
@@ -299,7 +400,7 @@ func (s *Shell) handleResetUser(shell *Shell, args []string) error {
 		BalanceBefore:   user.Balance,
 		BalanceAfter:    startingBalance,
 		ChangeAmount:    startingBalance - user.Balance,
-		TransactionType: entities.TransactionTypeTransferIn,
+		TransactionType: entities.AdjustmentTransactionType(startingBalance - user.Balance),
 		TransactionMetadata: map[string]any{
 			"admin":  "true",
 			"source": "debug_shell",
@@ -608,4 +709,111 @@ func (s *Shell) handleResetAll2026(shell *Shell, args []string) error {
 
 	s.printSuccess(fmt.Sprintf("Reset %d users to 1 bit for 2026", resetCount))
 	return nil
-}
\ No newline at end of file
+}
+
+// handleBulkAdjustBalances applies a CSV of balance adjustments to a guild in a single transaction.
+// Rows are discord_id,mode,amount,reason where mode is delta or absolute.
+func (s *Shell) handleBulkAdjustBalances(shell *Shell, args []string) error {
+	dryRun := false
+	var positional []string
+	for _, arg := range args {
+		if arg == "--dry-run" {
+			dryRun = true
+			continue
+		}
+		positional = append(positional, arg)
+	}
+
+	var guildID int64
+	var path string
+	var err error
+	if s.currentGuild != 0 && len(positional) == 1 {
+		// Use default guild: bulk-adjust-balances <csv_file>
+		guildID = s.currentGuild
+		path = positional[0]
+	} else if len(positional) >= 2 {
+		// Full syntax: bulk-adjust-balances <guild_id> <csv_file>
+		guildID, err = strconv.ParseInt(positional[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid guild ID: %w", err)
+		}
+		path = positional[1]
+	} else {
+		return fmt.Errorf("usage: bulk-adjust-balances <guild_id> <csv_file> [--dry-run]\nOr set a guild with 'guild <id>' and use: bulk-adjust-balances <csv_file> [--dry-run]")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open CSV: %w", err)
+	}
+	defer file.Close()
+
+	adjustments, err := entities.ParseBulkBalanceCSV(file)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	uow := s.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	adminService := uow.Services().AdminService()
+
+	// Preview the resulting balances before changing anything
+	preview, err := adminService.BulkAdjustBalances(ctx, guildID, adjustments, true)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(preview.Results))
+	for _, result := range preview.Results {
+		rows = append(rows, []string{
+			strconv.FormatInt(result.DiscordID, 10),
+			formatNumber(result.BalanceBefore),
+			formatSignedNumber(result.ChangeAmount),
+			formatNumber(result.BalanceAfter),
+			result.Reason,
+		})
+	}
+
+	fmt.Printf("\n📋 Bulk Balance Adjustment:\n")
+	fmt.Printf("   Guild:        %d\n", guildID)
+	fmt.Printf("   Rows:         %d (%d change a balance)\n", len(preview.Results), preview.ChangedCount())
+	fmt.Printf("   Total change: %s bits\n\n", formatSignedNumber(preview.TotalChange))
+	fmt.Println(formatTable([]string{"User", "Before", "Change", "After", "Reason"}, rows))
+
+	if dryRun {
+		s.printInfo("Dry run: no balances were changed")
+		return nil
+	}
+
+	// Confirm action
+	if !s.confirmAction(fmt.Sprintf("Apply %d balance adjustments?", preview.ChangedCount())) {
+		return nil
+	}
+
+	report, err := adminService.BulkAdjustBalances(ctx, guildID, adjustments, false)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Log admin action
+	s.logAdminAction("bulk_adjust_balances", map[string]interface{}{
+		"guild_id":     guildID,
+		"file":         path,
+		"rows":         len(report.Results),
+		"changed":      report.ChangedCount(),
+		"total_change": report.TotalChange,
+	})
+
+	s.printSuccess(fmt.Sprintf("Adjusted %d balances by %s bits in total", report.ChangedCount(), formatSignedNumber(report.TotalChange)))
+	return nil
+}
@@ -113,6 +113,23 @@ func (c *DebugClient) GetGuilds() ([]GuildInfo, error) {
 	return debugResp.Data, nil
 }
 
+// GetWorkerStatus fetches the last run of each background job from the bot
+func (c *DebugClient) GetWorkerStatus() ([]WorkerStatus, error) {
+	resp, err := c.client.Get(c.baseURL + "/health/workers")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch worker status: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// 503 still carries the status list, it only signals an unhealthy job
+	var statuses []WorkerStatus
+	if err := json.NewDecoder(resp.Body).Decode(&statuses); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return statuses, nil
+}
+
 // DebugResponse represents the API response
 type DebugResponse struct {
 	Success bool        `json:"success"`
@@ -121,6 +138,20 @@ type DebugResponse struct {
 	Data    interface{} `json:"data,omitempty"`
 }
 
+// WorkerStatus represents the last run of a background job
+type WorkerStatus struct {
+	Name         string        `json:"name"`
+	Interval     time.Duration `json:"interval"`
+	Running      bool          `json:"running"`
+	Runs         int           `json:"runs"`
+	Failures     int           `json:"failures"`
+	LastFinished time.Time     `json:"last_finished"`
+	LastDuration time.Duration `json:"last_duration"`
+	LastError    string        `json:"last_error,omitempty"`
+	NextRun      time.Time     `json:"next_run"`
+	Healthy      bool          `json:"healthy"`
+}
+
 // GuildInfo represents basic guild information
 type GuildInfo struct {
 	ID   string `json:"id"`
@@ -38,6 +38,18 @@ func (s *Shell) initializeCommands() {
 
 	// Add admin commands
 	s.addAdminCommands()
+
+	// Add wager inspection commands
+	s.addWagerCommands()
+
+	// Add read-only state dumps
+	s.addDumpCommands()
+
+	// Add background job commands
+	s.addWorkerCommands()
+
+	// Add guild economy snapshot commands
+	s.addSnapshotCommands()
 }
 
 // handleHelp displays help information
@@ -63,6 +75,13 @@ func (s *Shell) handleHelp(shell *Shell, args []string) error {
 	fmt.Printf("  %-20s %s\n", "replay", "Replay a Discord message")
 	fmt.Printf("  %-20s %s\n", "adjust-balance", "Adjust user balance by amount (+/-)")
 	fmt.Printf("  %-20s %s\n", "admin-transfer", "Transfer bits between users")
+	fmt.Printf("  %-20s %s\n", "reverse-transaction", "Undo a balance history entry")
+	fmt.Printf("  %-20s %s\n", "restore-snapshot", "Roll a guild's economy back to a snapshot")
+	fmt.Printf("  %-20s %s\n", "wager-timeline", "Show the full event history of a group wager")
+	fmt.Printf("  %-20s %s\n", "workers", "Show the last run of each background job")
+	fmt.Printf("  %-20s %s\n", "wager", "Show a group wager with options, participants and payouts")
+	fmt.Printf("  %-20s %s\n", "user", "Show a user's balances, locked amounts and recent history")
+	fmt.Printf("  %-20s %s\n", "guild-info", "Show a guild's settings, active wagers and lottery state")
 	
 	fmt.Println("\n\033[34mOTHER:\033[0m")
 	fmt.Printf("  %-20s %s\n", "guild", "Select guild from menu (auto-selects if only one)")
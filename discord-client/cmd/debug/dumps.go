@@ -0,0 +1,343 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+)
+
+// recentHistoryLimit is how many balance history entries the user dump shows
+const recentHistoryLimit = 10
+
+// addDumpCommands adds read-only state dump commands for production triage
+func (s *Shell) addDumpCommands() {
+	s.commands["wager"] = Command{
+		Handler:     s.handleWagerDump,
+		Description: "Show a group wager with its options, participants and payouts",
+		Usage:       "wager [guild_id] <wager_id>",
+		Category:    "read",
+	}
+	s.commands["user"] = Command{
+		Handler:     s.handleUserDump,
+		Description: "Show a user's balances, locked amounts and recent history",
+		Usage:       "user [guild_id] <discord_id>",
+		Category:    "read",
+	}
+	s.commands["guild-info"] = Command{
+		Handler:     s.handleGuildDump,
+		Description: "Show a guild's settings, active wagers and lottery state",
+		Usage:       "guild-info [guild_id]",
+		Category:    "read",
+	}
+}
+
+// parseGuildScopedID reads "[guild_id] <id>" arguments, falling back to the current guild context
+func (s *Shell) parseGuildScopedID(args []string, command, idName string) (guildID, id int64, err error) {
+	switch {
+	case s.currentGuild != 0 && len(args) == 1:
+		guildID = s.currentGuild
+		id, err = strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid %s: %w", idName, err)
+		}
+	case len(args) >= 2:
+		guildID, err = strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid guild ID: %w", err)
+		}
+		id, err = strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid %s: %w", idName, err)
+		}
+	case s.currentGuild == 0:
+		return 0, 0, fmt.Errorf("usage: %s <guild_id> <%s>\nOr set a guild with 'guild <id>' and use: %s <%s>", command, idName, command, idName)
+	default:
+		return 0, 0, fmt.Errorf("usage: %s <%s>", command, idName)
+	}
+	return guildID, id, nil
+}
+
+// handleWagerDump prints everything stored for a group wager
+func (s *Shell) handleWagerDump(shell *Shell, args []string) error {
+	guildID, wagerID, err := s.parseGuildScopedID(args, "wager", "wager_id")
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	detail, err := s.uowFactory.CreateReadOnlyForGuild(guildID).GroupWagerRepository().GetDetailByID(ctx, wagerID)
+	if err != nil {
+		return fmt.Errorf("failed to get group wager: %w", err)
+	}
+	if detail == nil || detail.Wager == nil {
+		return fmt.Errorf("group wager %d not found in guild %d", wagerID, guildID)
+	}
+
+	wager := detail.Wager
+	fmt.Printf("\n🎲 Group Wager #%d (%s, %s)\n", wager.ID, wager.WagerType, wager.State)
+	fmt.Printf("   %s\n\n", wager.Condition)
+
+	fields := [][]string{
+		{"Creator", formatOptionalID(wager.CreatorDiscordID)},
+		{"Resolver", formatOptionalID(wager.ResolverDiscordID)},
+		{"Subject", formatOptionalID(wager.SubjectDiscordID)},
+		{"Total pot", formatNumber(wager.TotalPot)},
+		{"Min participants", strconv.Itoa(wager.MinParticipants)},
+		{"Voting", fmt.Sprintf("%s → %s", formatOptionalTime(wager.VotingStartsAt), formatOptionalTime(wager.VotingEndsAt))},
+		{"Message", fmt.Sprintf("%d in channel %d", wager.MessageID, wager.ChannelID)},
+		{"Thread", formatOptionalID(wager.ThreadID)},
+		{"Created", wager.CreatedAt.Format(time.DateTime)},
+		{"Resolved", formatOptionalTime(wager.ResolvedAt)},
+		{"Cancelled", formatOptionalTime(wager.CancelledAt)},
+	}
+	if wager.ExternalRef != nil {
+		fields = append(fields, []string{"External ref", fmt.Sprintf("%s:%s", wager.ExternalRef.System, wager.ExternalRef.ID)})
+	}
+	fmt.Println(formatTable([]string{"Field", "Value"}, fields))
+
+	optionText := make(map[int64]string, len(detail.Options))
+	optionRows := make([][]string, 0, len(detail.Options))
+	for _, opt := range detail.Options {
+		optionText[opt.ID] = opt.OptionText
+		winner := ""
+		if wager.WinningOptionID != nil && *wager.WinningOptionID == opt.ID {
+			winner = "🏆"
+		}
+		optionRows = append(optionRows, []string{
+			strconv.FormatInt(opt.ID, 10),
+			truncateString(opt.OptionText, 40),
+			formatNumber(opt.TotalAmount),
+			fmt.Sprintf("%.2fx", opt.OddsMultiplier),
+			formatOptionalNumber(opt.MaxTotalAmount),
+			winner,
+		})
+	}
+	fmt.Println("\nOptions:")
+	fmt.Println(formatTable([]string{"ID", "Option", "Total", "Odds", "Cap", "Winner"}, optionRows))
+
+	if len(detail.Participants) == 0 {
+		s.printInfo("No participants")
+		return nil
+	}
+
+	participantRows := make([][]string, 0, len(detail.Participants))
+	for _, p := range detail.Participants {
+		locked := "-"
+		if p.LockedMultiplier != nil {
+			locked = fmt.Sprintf("%.2fx", *p.LockedMultiplier)
+		}
+		participantRows = append(participantRows, []string{
+			strconv.FormatInt(p.DiscordID, 10),
+			truncateString(optionText[p.OptionID], 30),
+			formatNumber(p.Amount),
+			locked,
+			formatOptionalNumber(p.PayoutAmount),
+			formatOptionalID(p.BalanceHistoryID),
+		})
+	}
+	fmt.Println("\nParticipants:")
+	fmt.Println(formatTable([]string{"User", "Option", "Amount", "Locked odds", "Payout", "History ID"}, participantRows))
+	return nil
+}
+
+// handleUserDump prints a user's balances, what is holding their bits and their latest transactions
+func (s *Shell) handleUserDump(shell *Shell, args []string) error {
+	guildID, discordID, err := s.parseGuildScopedID(args, "user", "discord_id")
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	repos := s.uowFactory.CreateReadOnlyForGuild(guildID)
+
+	user, err := repos.UserRepository().GetByDiscordID(ctx, discordID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return fmt.Errorf("user %d has no account in guild %d", discordID, guildID)
+	}
+
+	locked, err := repos.UserRepository().GetLockedBalanceBreakdown(ctx, discordID)
+	if err != nil {
+		return fmt.Errorf("failed to get locked balance: %w", err)
+	}
+
+	history, err := repos.BalanceHistoryRepository().GetByUser(ctx, discordID, recentHistoryLimit)
+	if err != nil {
+		return fmt.Errorf("failed to get balance history: %w", err)
+	}
+
+	fmt.Printf("\n👤 User %s (%d) in guild %d\n\n", user.Username, user.DiscordID, guildID)
+	fmt.Println(formatTable([]string{"Field", "Value"}, [][]string{
+		{"Balance", formatNumber(user.Balance)},
+		{"Available", formatNumber(user.AvailableBalance)},
+		{"Locked in wagers", formatNumber(locked.InWagers)},
+		{"Locked in group wagers", formatNumber(locked.InGroupWagers)},
+		{"Locked in savings", formatNumber(locked.InSavings)},
+		{"Staked on parlays", formatNumber(locked.InParlays)},
+		{"Staked on duels", formatNumber(locked.InDuels)},
+		{"Open lottery tickets", formatNumber(locked.InLottery)},
+		{"Gambling break ends", formatOptionalTime(user.GamblingBreakEndsAt)},
+		{"Created", user.CreatedAt.Format(time.DateTime)},
+	}))
+
+	if len(history) == 0 {
+		s.printInfo("No balance history")
+		return nil
+	}
+
+	rows := make([][]string, 0, len(history))
+	for _, entry := range history {
+		related := "-"
+		if entry.RelatedType != nil && entry.RelatedID != nil {
+			related = fmt.Sprintf("%s #%d", *entry.RelatedType, *entry.RelatedID)
+		}
+		rows = append(rows, []string{
+			strconv.FormatInt(entry.ID, 10),
+			entry.CreatedAt.Format(time.DateTime),
+			string(entry.TransactionType),
+			formatSignedNumber(entry.ChangeAmount),
+			formatNumber(entry.BalanceAfter),
+			related,
+		})
+	}
+	fmt.Printf("\nLast %d transactions:\n", len(history))
+	fmt.Println(formatTable([]string{"ID", "Time", "Type", "Change", "Balance", "Related"}, rows))
+	return nil
+}
+
+// handleGuildDump prints a guild's settings, open wagers and lottery state
+func (s *Shell) handleGuildDump(shell *Shell, args []string) error {
+	guildID := s.currentGuild
+	if len(args) > 0 {
+		parsed, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid guild ID: %w", err)
+		}
+		guildID = parsed
+	}
+	if guildID == 0 {
+		return fmt.Errorf("no guild context set - use 'guild' command first or provide guild ID")
+	}
+
+	ctx := context.Background()
+
+	// Settings and lottery are read in a transaction that is always rolled back, so defaults
+	// created for an unconfigured guild are never saved
+	uow := s.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	settings, err := uow.GuildSettingsRepository().GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("failed to get guild settings: %w", err)
+	}
+
+	draw, err := uow.LotteryDrawRepository().GetCurrentOpenDraw(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("failed to get lottery draw: %w", err)
+	}
+
+	groupWagers := s.uowFactory.CreateReadOnlyForGuild(guildID).GroupWagerRepository()
+	var openWagers []*entities.GroupWager
+	for _, state := range []entities.GroupWagerState{entities.GroupWagerStateActive, entities.GroupWagerStatePendingResolution} {
+		wagers, err := groupWagers.GetAll(ctx, &state)
+		if err != nil {
+			return fmt.Errorf("failed to get %s group wagers: %w", state, err)
+		}
+		openWagers = append(openWagers, wagers...)
+	}
+
+	fmt.Printf("\n🏠 Guild %d\n\nSettings:\n", guildID)
+	fmt.Println(formatTable([]string{"Setting", "Value"}, settingsRows(settings)))
+
+	if len(openWagers) == 0 {
+		fmt.Println()
+		s.printInfo("No active group wagers")
+	} else {
+		rows := make([][]string, 0, len(openWagers))
+		for _, w := range openWagers {
+			rows = append(rows, []string{
+				strconv.FormatInt(w.ID, 10),
+				string(w.WagerType),
+				string(w.State),
+				formatNumber(w.TotalPot),
+				formatOptionalTime(w.VotingEndsAt),
+				truncateString(w.Condition, 50),
+			})
+		}
+		fmt.Println("\nActive group wagers:")
+		fmt.Println(formatTable([]string{"ID", "Type", "State", "Pot", "Voting ends", "Condition"}, rows))
+	}
+
+	fmt.Println()
+	if draw == nil {
+		s.printInfo("No open lottery draw")
+		return nil
+	}
+	fmt.Println("Lottery:")
+	fmt.Println(formatTable([]string{"Field", "Value"}, [][]string{
+		{"Draw", strconv.FormatInt(draw.ID, 10)},
+		{"Pot", formatNumber(draw.TotalPot)},
+		{"Ticket cost", formatNumber(draw.TicketCost)},
+		{"Difficulty", fmt.Sprintf("%d bits", draw.Difficulty)},
+		{"Draws at", draw.DrawTime.Format(time.DateTime)},
+		{"Message", formatOptionalID(draw.MessageID)},
+	}))
+	return nil
+}
+
+// settingsRows lists every guild setting by its column name, so new settings show up without changes here
+func settingsRows(settings *entities.GuildSettings) [][]string {
+	v := reflect.ValueOf(settings).Elem()
+	t := v.Type()
+
+	rows := make([][]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Tag.Get("db")
+		if name == "" || name == "-" || name == "guild_id" {
+			continue
+		}
+
+		field := v.Field(i)
+		value := "(default)"
+		if field.Kind() != reflect.Pointer {
+			value = fmt.Sprint(field.Interface())
+		} else if !field.IsNil() {
+			value = fmt.Sprint(field.Elem().Interface())
+		}
+		rows = append(rows, []string{name, truncateString(value, 60)})
+	}
+	return rows
+}
+
+// formatOptionalID formats a nullable ID, showing "-" when unset
+func formatOptionalID(id *int64) string {
+	if id == nil {
+		return "-"
+	}
+	return strconv.FormatInt(*id, 10)
+}
+
+// formatOptionalNumber formats a nullable amount with thousands separators, showing "-" when unset
+func formatOptionalNumber(n *int64) string {
+	if n == nil {
+		return "-"
+	}
+	return formatNumber(*n)
+}
+
+// formatOptionalTime formats a nullable timestamp, showing "-" when unset
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.DateTime)
+}
@@ -0,0 +1,261 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gambler/discord-client/domain/entities"
+)
+
+// addSnapshotCommands adds guild economy snapshot commands to the shell
+func (s *Shell) addSnapshotCommands() {
+	s.commands["create-snapshot"] = Command{
+		Handler:     s.handleCreateSnapshot,
+		Description: "Snapshot a guild's balances, open wagers and lottery pot",
+		Usage:       "create-snapshot [guild_id] [reason...]",
+		Category:    "admin",
+	}
+	s.commands["snapshots"] = Command{
+		Handler:     s.handleListSnapshots,
+		Description: "List a guild's recent snapshots",
+		Usage:       "snapshots [guild_id]",
+		Category:    "read",
+	}
+	s.commands["restore-snapshot"] = Command{
+		Handler:     s.handleRestoreSnapshot,
+		Description: "Roll a guild's balances and lottery pot back to a snapshot",
+		Usage:       "restore-snapshot [guild_id] <snapshot_id> [--dry-run]",
+		Category:    "admin",
+	}
+}
+
+// snapshotGuildArg returns the guild ID from the first argument if it is one, otherwise the current guild,
+// along with the remaining arguments
+func (s *Shell) snapshotGuildArg(args []string, usage string) (int64, []string, error) {
+	if len(args) > 0 {
+		if guildID, err := strconv.ParseInt(args[0], 10, 64); err == nil && guildID != 0 {
+			return guildID, args[1:], nil
+		}
+	}
+	if s.currentGuild == 0 {
+		return 0, nil, fmt.Errorf("usage: %s\nOr set a guild with 'guild <id>'", usage)
+	}
+	return s.currentGuild, args, nil
+}
+
+// handleCreateSnapshot stores a snapshot of a guild's economy
+func (s *Shell) handleCreateSnapshot(shell *Shell, args []string) error {
+	guildID, rest, err := s.snapshotGuildArg(args, "create-snapshot <guild_id> [reason...]")
+	if err != nil {
+		return err
+	}
+	reason := strings.Join(rest, " ")
+
+	if s.dryRun {
+		s.printInfo(fmt.Sprintf("Dry-run mode: Would snapshot guild %d", guildID))
+		return nil
+	}
+
+	ctx := context.Background()
+	uow := s.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	snapshot, err := uow.Services().SnapshotService().CreateSnapshot(ctx, guildID, reason)
+	if err != nil {
+		return err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.logAdminAction("create_snapshot", map[string]interface{}{
+		"guild_id":    guildID,
+		"snapshot_id": snapshot.ID,
+		"reason":      reason,
+	})
+
+	s.printSuccess(fmt.Sprintf("Created snapshot #%d: %d balances totalling %s bits, %d open wagers",
+		snapshot.ID, len(snapshot.Data.Balances), formatNumber(snapshot.Data.TotalBalance()), len(snapshot.Data.ActiveWagers)))
+	return nil
+}
+
+// handleListSnapshots lists a guild's most recent snapshots
+func (s *Shell) handleListSnapshots(shell *Shell, args []string) error {
+	guildID, _, err := s.snapshotGuildArg(args, "snapshots <guild_id>")
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	uow := s.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	snapshots, err := uow.Services().SnapshotService().GetRecentSnapshots(ctx, 20)
+	if err != nil {
+		return err
+	}
+
+	if len(snapshots) == 0 {
+		s.printInfo(fmt.Sprintf("Guild %d has no snapshots", guildID))
+		return nil
+	}
+
+	rows := make([][]string, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		lotteryPot := "-"
+		if snapshot.Data.Lottery != nil {
+			lotteryPot = formatNumber(snapshot.Data.Lottery.TotalPot)
+		}
+		rows = append(rows, []string{
+			fmt.Sprintf("#%d", snapshot.ID),
+			snapshot.CreatedAt.Format("2006-01-02 15:04:05"),
+			strconv.Itoa(len(snapshot.Data.Balances)),
+			formatNumber(snapshot.Data.TotalBalance()),
+			strconv.Itoa(len(snapshot.Data.ActiveWagers)),
+			lotteryPot,
+			truncateString(snapshot.Reason, 40),
+		})
+	}
+
+	fmt.Printf("\n📸 Snapshots for guild %d:\n\n", guildID)
+	fmt.Println(formatTable([]string{"ID", "Taken", "Users", "Total Bits", "Open Wagers", "Lottery Pot", "Reason"}, rows))
+	return nil
+}
+
+// handleRestoreSnapshot previews a snapshot restore and applies it once confirmed
+func (s *Shell) handleRestoreSnapshot(shell *Shell, args []string) error {
+	dryRun := false
+	var positional []string
+	for _, arg := range args {
+		if arg == "--dry-run" {
+			dryRun = true
+			continue
+		}
+		positional = append(positional, arg)
+	}
+
+	var guildID, snapshotID int64
+	var err error
+	if s.currentGuild != 0 && len(positional) == 1 {
+		// Use default guild: restore-snapshot <snapshot_id>
+		guildID = s.currentGuild
+		snapshotID, err = strconv.ParseInt(positional[0], 10, 64)
+	} else if len(positional) >= 2 {
+		// Full syntax: restore-snapshot <guild_id> <snapshot_id>
+		guildID, err = strconv.ParseInt(positional[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid guild ID: %w", err)
+		}
+		snapshotID, err = strconv.ParseInt(positional[1], 10, 64)
+	} else {
+		return fmt.Errorf("usage: restore-snapshot <guild_id> <snapshot_id> [--dry-run]\nOr set a guild with 'guild <id>' and use: restore-snapshot <snapshot_id> [--dry-run]")
+	}
+	if err != nil {
+		return fmt.Errorf("invalid snapshot ID: %w", err)
+	}
+
+	ctx := context.Background()
+	uow := s.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	snapshotService := uow.Services().SnapshotService()
+
+	// Preview the restore before changing anything
+	preview, err := snapshotService.RestoreSnapshot(ctx, guildID, snapshotID, true)
+	if err != nil {
+		return err
+	}
+	printSnapshotRestoreReport(preview)
+
+	if dryRun {
+		s.printInfo("Dry run: nothing was restored")
+		return nil
+	}
+
+	// Confirm action
+	if !s.confirmAction(fmt.Sprintf("Restore %d balances in guild %d to snapshot #%d?", preview.ChangedCount(), guildID, snapshotID)) {
+		return nil
+	}
+
+	report, err := snapshotService.RestoreSnapshot(ctx, guildID, snapshotID, false)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := uow.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Log admin action
+	s.logAdminAction("restore_snapshot", map[string]interface{}{
+		"guild_id":     guildID,
+		"snapshot_id":  snapshotID,
+		"backup_id":    report.BackupSnapshotID,
+		"changed":      report.ChangedCount(),
+		"total_change": report.TotalChange,
+	})
+
+	s.printSuccess(fmt.Sprintf("Restored %d balances by %s bits. Undo with: restore-snapshot %d %d",
+		report.ChangedCount(), formatSignedNumber(report.TotalChange), guildID, report.BackupSnapshotID))
+	return nil
+}
+
+// printSnapshotRestoreReport prints the balance, wager and lottery changes of a restore
+func printSnapshotRestoreReport(report *entities.GuildSnapshotRestoreReport) {
+	rows := make([][]string, 0, len(report.Balances))
+	for _, balance := range report.Balances {
+		if balance.ChangeAmount == 0 {
+			continue
+		}
+		rows = append(rows, []string{
+			strconv.FormatInt(balance.DiscordID, 10),
+			formatNumber(balance.BalanceBefore),
+			formatSignedNumber(balance.ChangeAmount),
+			formatNumber(balance.BalanceAfter),
+		})
+	}
+
+	fmt.Printf("\n📸 Snapshot Restore:\n")
+	fmt.Printf("   Guild:        %d\n", report.GuildID)
+	fmt.Printf("   Snapshot:     #%d\n", report.SnapshotID)
+	fmt.Printf("   Balances:     %d (%d change)\n", len(report.Balances), report.ChangedCount())
+	fmt.Printf("   Total change: %s bits\n", formatSignedNumber(report.TotalChange))
+	if report.Lottery != nil {
+		if report.Lottery.Restored {
+			fmt.Printf("   Lottery pot:  %s → %s bits (draw #%d, %d tickets)\n",
+				formatNumber(report.Lottery.PotBefore), formatNumber(report.Lottery.PotAfter),
+				report.Lottery.DrawID, report.Lottery.TicketsNow)
+		} else {
+			fmt.Printf("   Lottery pot:  not restored, draw #%d has completed since the snapshot\n", report.Lottery.DrawID)
+		}
+	}
+	fmt.Println()
+
+	if len(rows) > 0 {
+		fmt.Println(formatTable([]string{"User", "Before", "Change", "After"}, rows))
+	}
+
+	if len(report.MissingUsers) > 0 {
+		fmt.Printf("\033[33m⚠️  %d users in the snapshot no longer have an account and are skipped\033[0m\n", len(report.MissingUsers))
+	}
+	for _, wager := range report.WagerChanges {
+		current := string(wager.CurrentState)
+		if current == "" {
+			current = "deleted"
+		}
+		fmt.Printf("\033[33m⚠️  Group wager #%d was %s and is now %s; review it by hand\033[0m\n", wager.GroupWagerID, wager.SnapshotState, current)
+	}
+}
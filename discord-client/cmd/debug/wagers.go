@@ -0,0 +1,80 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// addWagerCommands adds group wager inspection commands to the shell
+func (s *Shell) addWagerCommands() {
+	s.commands["wager-timeline"] = Command{
+		Handler:     s.handleWagerTimeline,
+		Description: "Show the full event history of a group wager",
+		Usage:       "wager-timeline [guild_id] <wager_id>",
+		Category:    "read",
+	}
+}
+
+// handleWagerTimeline prints every recorded action on a group wager in order
+func (s *Shell) handleWagerTimeline(shell *Shell, args []string) error {
+	guildID, wagerID, err := s.parseGuildScopedID(args, "wager-timeline", "wager_id")
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	uow := s.uowFactory.CreateForGuild(guildID)
+	if err := uow.Begin(ctx); err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer uow.Rollback()
+
+	detail, err := uow.GroupWagerRepository().GetDetailByID(ctx, wagerID)
+	if err != nil {
+		return fmt.Errorf("failed to get group wager: %w", err)
+	}
+	if detail == nil || detail.Wager == nil {
+		return fmt.Errorf("group wager %d not found in guild %d", wagerID, guildID)
+	}
+
+	events, err := uow.GroupWagerRepository().GetEvents(ctx, wagerID)
+	if err != nil {
+		return fmt.Errorf("failed to get wager events: %w", err)
+	}
+
+	wager := detail.Wager
+	fmt.Printf("\n📜 Timeline for Group Wager #%d (%s, %s)\n", wager.ID, wager.WagerType, wager.State)
+	fmt.Printf("   %s\n", wager.Condition)
+	fmt.Printf("   Pot: %s bits across %d participants\n\n", formatNumber(wager.TotalPot), len(detail.Participants))
+
+	if len(events) == 0 {
+		s.printInfo("No events recorded for this wager")
+		return nil
+	}
+
+	rows := make([][]string, 0, len(events))
+	for i, event := range events {
+		actor := "system"
+		if event.ActorDiscordID != nil {
+			actor = strconv.FormatInt(*event.ActorDiscordID, 10)
+		}
+
+		payload, err := json.Marshal(event.Payload)
+		if err != nil {
+			return fmt.Errorf("failed to format payload for event %d: %w", event.ID, err)
+		}
+
+		rows = append(rows, []string{
+			strconv.Itoa(i + 1),
+			event.CreatedAt.Format("2006-01-02 15:04:05"),
+			string(event.EventType),
+			actor,
+			string(payload),
+		})
+	}
+
+	fmt.Println(formatTable([]string{"#", "Time", "Event", "Actor", "Payload"}, rows))
+	return nil
+}
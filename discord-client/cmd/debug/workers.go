@@ -0,0 +1,66 @@
+package debug
+
+import (
+	"fmt"
+	"time"
+)
+
+// addWorkerCommands adds background job inspection commands to the shell
+func (s *Shell) addWorkerCommands() {
+	s.commands["workers"] = Command{
+		Handler:     s.handleWorkers,
+		Description: "Show the last run of each background job",
+		Usage:       "workers",
+		Category:    "read",
+	}
+}
+
+// handleWorkers prints the scheduler status reported by the bot's debug API
+func (s *Shell) handleWorkers(shell *Shell, args []string) error {
+	statuses, err := s.debugClient.GetWorkerStatus()
+	if err != nil {
+		return err
+	}
+
+	if len(statuses) == 0 {
+		s.printInfo("No background jobs are registered")
+		return nil
+	}
+
+	rows := make([][]string, 0, len(statuses))
+	for _, status := range statuses {
+		health := "✅"
+		if !status.Healthy {
+			health = "❌"
+		}
+
+		state := "idle"
+		if status.Running {
+			state = "running"
+		}
+
+		lastRun := "never"
+		if !status.LastFinished.IsZero() {
+			lastRun = fmt.Sprintf("%s ago (%s)", time.Since(status.LastFinished).Round(time.Second), status.LastDuration.Round(time.Millisecond))
+		}
+
+		nextRun := "-"
+		if !status.NextRun.IsZero() {
+			nextRun = status.NextRun.Local().Format("2006-01-02 15:04:05")
+		}
+
+		rows = append(rows, []string{
+			health,
+			status.Name,
+			state,
+			lastRun,
+			nextRun,
+			fmt.Sprintf("%d/%d", status.Failures, status.Runs),
+			status.LastError,
+		})
+	}
+
+	fmt.Println()
+	fmt.Print(formatTable([]string{"", "Job", "State", "Last Run", "Next Run", "Failed/Runs", "Last Error"}, rows))
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"gambler/discord-client/bot"
 	"gambler/discord-client/config"
 	"gambler/discord-client/database"
+	"gambler/discord-client/domain/interfaces"
 	"gambler/discord-client/infrastructure"
 
 	summoner_pb "gambler/discord-client/proto/services"
@@ -66,11 +67,20 @@ func Run(ctx context.Context) error {
 		return err
 	}
 
+	// Optionally share state change events with other replicas through Postgres
+	var eventPublisher interfaces.EventPublisher = natsEventPublisher
+	var postgresEventBus *infrastructure.PostgresEventBus
+	if cfg.EventBusBackend == "postgres" {
+		log.Println("Using Postgres LISTEN/NOTIFY event bus for cross-replica events")
+		postgresEventBus = infrastructure.NewPostgresEventBus(db, natsEventPublisher)
+		eventPublisher = postgresEventBus
+	}
+
 	/// Initialize repositories and services
-	uowFactory := initializeRepositories(db, natsEventPublisher)
+	uowFactory := initializeRepositories(db, eventPublisher)
 
 	// Initialize Discord bot
-	discordBot, err := initializeDiscordBot(cfg, uowFactory, summonerClient, natsEventPublisher)
+	discordBot, err := initializeDiscordBot(cfg, uowFactory, summonerClient, eventPublisher)
 	if err != nil {
 		return err
 	}
@@ -79,15 +89,26 @@ func Run(ctx context.Context) error {
 	lolHandler, tftHandler := initializeApplicationHandlers(uowFactory, discordBot)
 
 	// Initialize application workers
-	dailyAwardsWorker, lotteryDrawWorker := initializeApplicationWorkers(uowFactory, discordBot)
+	dailyAwardsWorker, weeklyDigestWorker, lotteryDrawWorker, oddsRefreshWorker, savingsMaturityWorker, freezeExpiryWorker, rollupWorker, webhookDeliveryWorker, outboxDispatcher := initializeApplicationWorkers(cfg, uowFactory, discordBot)
 
 	// Setup event subscriptions
 	if err := setupEventSubscriptions(natsClient, subjectMapper, uowFactory, discordBot, cfg); err != nil {
 		return err
 	}
 
+	// Resolve wagers linked to external matches from reported results
+	if err := startResultProvider(ctx, cfg, uowFactory, discordBot); err != nil {
+		return err
+	}
+
 	// Start background services
-	messageConsumer, cleanupFuncs := startBackgroundServices(ctx, cfg, lolHandler, tftHandler, dailyAwardsWorker, lotteryDrawWorker, discordBot)
+	messageConsumer, cleanupFuncs := startBackgroundServices(ctx, cfg, lolHandler, tftHandler, dailyAwardsWorker, weeklyDigestWorker, lotteryDrawWorker, oddsRefreshWorker, savingsMaturityWorker, freezeExpiryWorker, rollupWorker, webhookDeliveryWorker, outboxDispatcher, discordBot)
+
+	// Listen for events from other replicas once all handlers are registered
+	if postgresEventBus != nil {
+		cleanupFuncs = append(cleanupFuncs, postgresEventBus.Start(ctx))
+		log.Println("Postgres event listener started")
+	}
 
 	// Wait for shutdown signal
 	log.Printf("Bot is running in %s mode...", cfg.Environment)
@@ -102,11 +123,22 @@ func Run(ctx context.Context) error {
 // creates and returns a database connection
 func initializeDatabase(ctx context.Context, cfg *config.Config) (*database.DB, error) {
 	log.Println("Connecting to database...")
-	db, err := database.NewConnection(ctx, cfg.GetDatabaseURL())
+	db, err := database.NewConnection(ctx, cfg.GetDatabaseURL(), database.WithSlowQueryLog(cfg.GetSlowQueryLog()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 	log.Println("Database connection established successfully")
+
+	// Heavy read paths such as stats and leaderboards use the replica when one is configured
+	if replicaURL := cfg.GetDatabaseReplicaURL(); replicaURL != "" {
+		log.Println("Connecting to database read replica...")
+		if err := db.ConnectReadReplica(ctx, replicaURL); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("failed to connect to database read replica: %w", err)
+		}
+		log.Println("Database read replica connection established successfully")
+	}
+
 	return db, nil
 }
 
@@ -148,7 +180,7 @@ func initializeEventInfrastructure(natsClient *infrastructure.NATSClient) (*infr
 }
 
 // creates the unit of work factory
-func initializeRepositories(db *database.DB, eventPublisher *infrastructure.NATSEventPublisher) *infrastructure.UnitOfWorkFactory {
+func initializeRepositories(db *database.DB, eventPublisher interfaces.EventPublisher) *infrastructure.UnitOfWorkFactory {
 	log.Println("Initializing unit of work factory...")
 	uowFactory := infrastructure.NewUnitOfWorkFactory(db, eventPublisher)
 	log.Println("Unit of work factory initialized successfully")
@@ -156,12 +188,14 @@ func initializeRepositories(db *database.DB, eventPublisher *infrastructure.NATS
 }
 
 // creates and configures the Discord bot
-func initializeDiscordBot(cfg *config.Config, uowFactory application.UnitOfWorkFactory, summonerClient summoner_pb.SummonerTrackingServiceClient, eventPublisher *infrastructure.NATSEventPublisher) (*bot.Bot, error) {
+func initializeDiscordBot(cfg *config.Config, uowFactory application.UnitOfWorkFactory, summonerClient summoner_pb.SummonerTrackingServiceClient, eventPublisher interfaces.EventPublisher) (*bot.Bot, error) {
 	log.Println("Initializing Discord bot...")
 	botConfig := bot.Config{
 		Token:          cfg.DiscordToken,
 		GuildID:        cfg.GuildID,
 		GambaChannelID: cfg.GambaChannelID,
+		ShardID:        cfg.ShardID,
+		ShardCount:     cfg.ShardCount,
 	}
 	discordBot, err := bot.New(botConfig, uowFactory, summonerClient, eventPublisher)
 	if err != nil {
@@ -184,18 +218,67 @@ func initializeApplicationHandlers(uowFactory application.UnitOfWorkFactory, dis
 	return lolHandler, tftHandler
 }
 
+// starts the external match result webhook when a shared secret is configured
+func startResultProvider(ctx context.Context, cfg *config.Config, uowFactory application.UnitOfWorkFactory, discordBot *bot.Bot) error {
+	if cfg.ResultWebhookSecret == "" {
+		return nil
+	}
+
+	log.Println("Initializing external result webhook...")
+	resultProvider := infrastructure.NewWebhookResultProvider(cfg.ResultWebhookSecret)
+	resultHandler := application.NewExternalResultHandler(uowFactory)
+	if err := resultProvider.Start(ctx, resultHandler.HandleResult); err != nil {
+		return fmt.Errorf("failed to start result provider: %w", err)
+	}
+	discordBot.SetResultWebhook(resultProvider)
+	log.Println("External result webhook initialized successfully")
+	return nil
+}
+
 // creates application-level workers
-func initializeApplicationWorkers(uowFactory application.UnitOfWorkFactory, discordBot *bot.Bot) (*application.DailyAwardsWorkerImpl, *application.LotteryDrawWorker) {
+func initializeApplicationWorkers(cfg *config.Config, uowFactory application.UnitOfWorkFactory, discordBot *bot.Bot) (*application.DailyAwardsWorkerImpl, *application.WeeklyDigestWorker, *application.LotteryDrawWorker, *application.HouseWagerOddsRefreshWorker, *application.SavingsMaturityWorker, *application.BalanceFreezeExpiryWorker, *application.BalanceHistoryRollupWorker, *application.WebhookDeliveryWorker, *application.DiscordOutboxDispatcher) {
 	log.Println("Initializing daily awards worker...")
 	guildDiscovery := bot.NewGuildDiscoveryService(discordBot.GetSession(), uowFactory)
 	dailyAwardsWorker := application.NewDailyAwardsWorker(uowFactory, guildDiscovery, discordBot.GetDiscordPoster())
 	log.Println("Daily awards worker initialized successfully")
 
+	log.Println("Initializing weekly digest worker...")
+	weeklyDigestWorker := application.NewWeeklyDigestWorker(uowFactory, guildDiscovery, discordBot.GetWeeklyDigestPoster())
+	log.Println("Weekly digest worker initialized successfully")
+
 	log.Println("Initializing lottery draw worker...")
 	lotteryDrawWorker := application.NewLotteryDrawWorker(uowFactory, discordBot.GetLotteryPoster())
 	log.Println("Lottery draw worker initialized successfully")
 
-	return dailyAwardsWorker, lotteryDrawWorker
+	log.Println("Initializing savings maturity worker...")
+	savingsMaturityWorker := application.NewSavingsMaturityWorker(uowFactory)
+	log.Println("Savings maturity worker initialized successfully")
+
+	log.Println("Initializing balance freeze expiry worker...")
+	freezeExpiryWorker := application.NewBalanceFreezeExpiryWorker(uowFactory)
+	log.Println("Balance freeze expiry worker initialized successfully")
+
+	log.Println("Initializing balance history rollup worker...")
+	rollupWorker := application.NewBalanceHistoryRollupWorker(uowFactory, cfg.BalanceHistoryRetentionMonths)
+	log.Println("Balance history rollup worker initialized successfully")
+
+	log.Println("Initializing webhook delivery worker...")
+	webhookDeliveryWorker := application.NewWebhookDeliveryWorker(uowFactory, infrastructure.NewHTTPWebhookSender())
+	log.Println("Webhook delivery worker initialized successfully")
+
+	log.Println("Initializing discord outbox dispatcher...")
+	outboxDispatcher := application.NewDiscordOutboxDispatcher(uowFactory, discordBot.GetDiscordPoster())
+	log.Println("Discord outbox dispatcher initialized successfully")
+
+	// Odds refresh is only enabled when an odds provider is configured
+	var oddsRefreshWorker *application.HouseWagerOddsRefreshWorker
+	if cfg.OddsProviderURL != "" {
+		log.Println("Initializing house wager odds refresh worker...")
+		oddsRefreshWorker = application.NewHouseWagerOddsRefreshWorker(uowFactory, infrastructure.NewHTTPOddsProvider(cfg.OddsProviderURL))
+		log.Println("House wager odds refresh worker initialized successfully")
+	}
+
+	return dailyAwardsWorker, weeklyDigestWorker, lotteryDrawWorker, oddsRefreshWorker, savingsMaturityWorker, freezeExpiryWorker, rollupWorker, webhookDeliveryWorker, outboxDispatcher
 }
 
 // registers all event subscriptions
@@ -213,6 +296,7 @@ func setupEventSubscriptions(natsClient *infrastructure.NATSClient, subjectMappe
 		uowFactory,
 		discordBot.GetDiscordPoster(),
 		userResolver,
+		cfg.WebhookBigWinThreshold,
 	); err != nil {
 		return fmt.Errorf("failed to register application subscriptions: %w", err)
 	}
@@ -228,7 +312,7 @@ func setupEventSubscriptions(natsClient *infrastructure.NATSClient, subjectMappe
 }
 
 // starts all background services
-func startBackgroundServices(ctx context.Context, cfg *config.Config, lolHandler *application.LoLHandlerImpl, tftHandler *application.TFTHandlerImpl, dailyAwardsWorker *application.DailyAwardsWorkerImpl, lotteryDrawWorker *application.LotteryDrawWorker, discordBot *bot.Bot) (*infrastructure.MessageConsumer, []func()) {
+func startBackgroundServices(ctx context.Context, cfg *config.Config, lolHandler *application.LoLHandlerImpl, tftHandler *application.TFTHandlerImpl, dailyAwardsWorker *application.DailyAwardsWorkerImpl, weeklyDigestWorker *application.WeeklyDigestWorker, lotteryDrawWorker *application.LotteryDrawWorker, oddsRefreshWorker *application.HouseWagerOddsRefreshWorker, savingsMaturityWorker *application.SavingsMaturityWorker, freezeExpiryWorker *application.BalanceFreezeExpiryWorker, rollupWorker *application.BalanceHistoryRollupWorker, webhookDeliveryWorker *application.WebhookDeliveryWorker, outboxDispatcher *application.DiscordOutboxDispatcher, discordBot *bot.Bot) (*infrastructure.MessageConsumer, []func()) {
 	var cleanupFuncs []func()
 
 	log.Printf("Initializing message consumer with NATS servers: %s...", cfg.NATSServers)
@@ -242,24 +326,46 @@ func startBackgroundServices(ctx context.Context, cfg *config.Config, lolHandler
 	}()
 	log.Println("Message consumer started successfully")
 
-	// Start Discord bot workers
-	log.Println("Starting Discord bot workers...")
+	// Register background jobs with a single scheduler
+	log.Println("Registering background jobs...")
+	scheduler := application.NewScheduler()
+	jobs := []application.Job{
+		discordBot.GroupWagerExpirationJob(),
+		discordBot.StuckWagerReconcilerJob(),
+		discordBot.EconomyGaugesJob(),
+		discordBot.UserStatsReconcilerJob(),
+		dailyAwardsWorker.Job(cfg.DailyAwardsHour),
+		weeklyDigestWorker.Job(cfg.WeeklyDigestDay, cfg.WeeklyDigestHour),
+	}
 
-	// Start group wager expiration worker
-	groupWagerCleanup := discordBot.StartGroupWagerExpirationWorker(ctx)
-	cleanupFuncs = append(cleanupFuncs, groupWagerCleanup)
-	log.Println("Group wager expiration worker started")
+	// Lottery draws, savings maturity, freeze expiry, balance history rollups, webhook deliveries, outbox retries and odds refreshes span every guild, so only the primary shard runs them
+	if discordBot.IsPrimaryShard() {
+		jobs = append(jobs,
+			lotteryDrawWorker.Job(),
+			savingsMaturityWorker.Job(application.SavingsMaturityInterval),
+			freezeExpiryWorker.Job(application.BalanceFreezeExpiryInterval),
+			rollupWorker.Job(application.BalanceHistoryRollupInterval),
+			webhookDeliveryWorker.Job(application.WebhookDeliveryInterval),
+			outboxDispatcher.Job(application.DiscordOutboxInterval),
+		)
+
+		// Odds refresh only runs if an odds provider is configured
+		if oddsRefreshWorker != nil {
+			jobs = append(jobs, oddsRefreshWorker.Job(time.Duration(cfg.OddsRefreshIntervalMinutes)*time.Minute))
+		}
+	} else {
+		log.Println("Skipping lottery draw, savings maturity, balance freeze expiry, balance history rollup, webhook delivery, discord outbox and odds refresh jobs on non-primary shard")
+	}
 
-	// Start daily awards worker
-	dailyAwardsCleanup := dailyAwardsWorker.Start(ctx, cfg.DailyAwardsHour)
-	cleanupFuncs = append(cleanupFuncs, dailyAwardsCleanup)
-	discordBot.SetDailyAwardsWorkerCleanup(dailyAwardsCleanup)
-	log.Printf("Daily awards worker started (notification at %02d:00 UTC)", cfg.DailyAwardsHour)
+	for _, job := range jobs {
+		if err := scheduler.Register(job); err != nil {
+			log.Printf("Failed to register background job: %v", err)
+		}
+	}
 
-	// Start lottery draw worker
-	lotteryCleanup := lotteryDrawWorker.Start(ctx)
-	cleanupFuncs = append(cleanupFuncs, lotteryCleanup)
-	log.Println("Lottery draw worker started (draws on Friday 2pm UTC)")
+	cleanupFuncs = append(cleanupFuncs, scheduler.Start(ctx))
+	discordBot.SetScheduler(scheduler)
+	log.Printf("Background job scheduler started with %d jobs", len(jobs))
 
 	return messageConsumer, cleanupFuncs
 }
@@ -276,7 +382,7 @@ func performGracefulShutdown(
 	log.Println("Shutting down services...")
 
 	// Stop all workers
-	log.Println("Stopping Discord bot workers...")
+	log.Println("Stopping background jobs...")
 	for _, cleanup := range cleanupFuncs {
 		cleanup()
 	}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"gambler/discord-client/database"
 )
@@ -17,8 +18,16 @@ type Config struct {
 	GuildID      string // Primary Discord guild ID
 
 	// Database configuration
-	DatabaseURL  string
-	DatabaseName string
+	DatabaseURL        string
+	DatabaseName       string
+	DatabaseReplicaURL string // Optional read replica for stats and leaderboards, shares DatabaseName
+
+	// Slow query log configuration
+	SlowQueryThreshold time.Duration // Queries taking at least this long are logged, zero disables the slow query log
+	SlowQueryExplain   bool          // Capture the query plan of slow queries
+
+	// Balance history retention configuration
+	BalanceHistoryRetentionMonths int // Months raw balance history is kept before it is archived (stats keep using daily rollups), zero disables archiving
 
 	// Bot configuration
 	StartingBalance int64
@@ -27,7 +36,8 @@ type Config struct {
 	GambaChannelID string // Channel ID for high roller change notifications
 
 	// Group Wager configuration
-	ResolverDiscordIDs []int64 // Discord IDs that can resolve group wagers
+	ResolverDiscordIDs          []int64 // Discord IDs that can resolve group wagers
+	ResolutionCorrectionMinutes int     // Minutes after resolution a resolver may correct the winning option, zero disables corrections
 
 	// Summoner Service configuration
 	SummonerServiceAddr string // Address of the summoner tracking service
@@ -35,12 +45,37 @@ type Config struct {
 	// NATS configuration
 	NATSServers string // NATS server addresses (comma-separated)
 
+	// Sharding configuration
+	ShardID    int // Shard served by this process
+	ShardCount int // Total shards, 0 means use Discord's recommended count
+
+	// Event bus configuration
+	EventBusBackend string // "nats" (default) or "postgres" to share state change events across replicas
+
 	// Wordle configuration
 	WordleBotID string // Discord ID of the Wordle bot to monitor
 
 	// Daily Awards configuration
 	DailyAwardsHour int // Hour in UTC when daily awards summary is posted (0-23)
 
+	// Weekly digest configuration
+	WeeklyDigestDay  time.Weekday // Day in UTC the weekly economy digest is posted
+	WeeklyDigestHour int          // Hour in UTC the weekly economy digest is posted (0-23)
+
+	// House wager odds refresh configuration
+	OddsProviderURL            string // Base URL of the external odds service, refresh is disabled when empty
+	OddsRefreshIntervalMinutes int    // Minutes between scheduled odds refreshes
+
+	// House wager odds seeding configuration
+	SeededOddsFloor   float64 // Lowest multiplier offered when odds are seeded from a player's history
+	SeededOddsCeiling float64 // Highest multiplier offered when odds are seeded from a player's history
+
+	// External match result configuration
+	ResultWebhookSecret string // Shared secret external services send to report match results, the webhook is disabled when empty
+
+	// Outbound webhook configuration
+	WebhookBigWinThreshold int64 // Smallest single win sent to webhooks subscribed to big wins
+
 	// Environment
 	Environment string // "development" or "production"
 }
@@ -82,6 +117,22 @@ func (c *Config) GetDatabaseURL() string {
 	return database.ConstructDatabaseURL(c.DatabaseURL, c.DatabaseName)
 }
 
+// GetDatabaseReplicaURL constructs the full read replica URL, or returns "" if no replica is configured
+func (c *Config) GetDatabaseReplicaURL() string {
+	if c.DatabaseReplicaURL == "" {
+		return ""
+	}
+	return database.ConstructDatabaseURL(c.DatabaseReplicaURL, c.DatabaseName)
+}
+
+// GetSlowQueryLog returns the slow query log settings for database connections
+func (c *Config) GetSlowQueryLog() database.SlowQueryLog {
+	return database.SlowQueryLog{
+		Threshold: c.SlowQueryThreshold,
+		Explain:   c.SlowQueryExplain,
+	}
+}
+
 // load loads configuration from environment variables
 func load() (*Config, error) {
 	config := &Config{
@@ -90,8 +141,12 @@ func load() (*Config, error) {
 		GuildID:      os.Getenv("GUILD_ID"),
 
 		// Database
-		DatabaseURL:  os.Getenv("DATABASE_URL"),
-		DatabaseName: os.Getenv("DATABASE_NAME"),
+		DatabaseURL:        os.Getenv("DATABASE_URL"),
+		DatabaseName:       os.Getenv("DATABASE_NAME"),
+		DatabaseReplicaURL: os.Getenv("DATABASE_REPLICA_URL"),
+
+		// Slow query log
+		SlowQueryThreshold: 500 * time.Millisecond,
 
 		// Bot settings with defaults
 		StartingBalance: 1,
@@ -99,18 +154,45 @@ func load() (*Config, error) {
 		// High Roller Role
 		GambaChannelID: os.Getenv("GAMBA_CHANNEL_ID"),
 
+		// Group wager resolution corrections
+		ResolutionCorrectionMinutes: 15,
+
 		// Summoner Service
 		SummonerServiceAddr: getEnvWithDefault("SUMMONER_SERVICE_ADDR", "lol-tracker:9000"),
 
 		// NATS
 		NATSServers: getEnvWithDefault("NATS_SERVERS", "nats://nats:4222"),
 
+		// Sharding
+		ShardCount: 1,
+
+		// Event bus
+		EventBusBackend: getEnvWithDefault("EVENT_BUS_BACKEND", "nats"),
+
 		// Wordle
 		WordleBotID: os.Getenv("WORDLE_BOT_ID"),
 
 		// Daily Awards
 		DailyAwardsHour: 14, // 2pm UTC / 9am CST
 
+		// Weekly digest
+		WeeklyDigestDay:  time.Monday,
+		WeeklyDigestHour: 15, // An hour after the daily awards
+
+		// House wager odds refresh
+		OddsProviderURL:            os.Getenv("ODDS_PROVIDER_URL"),
+		OddsRefreshIntervalMinutes: 30,
+
+		// House wager odds seeding
+		SeededOddsFloor:   1.2,
+		SeededOddsCeiling: 4.0,
+
+		// External match results
+		ResultWebhookSecret: os.Getenv("RESULT_WEBHOOK_SECRET"),
+
+		// Outbound webhooks
+		WebhookBigWinThreshold: 10000,
+
 		// Environment
 		Environment: os.Getenv("ENVIRONMENT"),
 	}
@@ -121,6 +203,68 @@ func load() (*Config, error) {
 			config.StartingBalance = parsedBalance
 		}
 	}
+	if interval := os.Getenv("ODDS_REFRESH_INTERVAL_MINUTES"); interval != "" {
+		if parsedInterval, err := strconv.Atoi(interval); err == nil && parsedInterval > 0 {
+			config.OddsRefreshIntervalMinutes = parsedInterval
+		}
+	}
+	if floor := os.Getenv("SEEDED_ODDS_FLOOR"); floor != "" {
+		if parsedFloor, err := strconv.ParseFloat(floor, 64); err == nil && parsedFloor > 1 {
+			config.SeededOddsFloor = parsedFloor
+		}
+	}
+	if ceiling := os.Getenv("SEEDED_ODDS_CEILING"); ceiling != "" {
+		if parsedCeiling, err := strconv.ParseFloat(ceiling, 64); err == nil && parsedCeiling >= config.SeededOddsFloor {
+			config.SeededOddsCeiling = parsedCeiling
+		}
+	}
+	if minutes := os.Getenv("RESOLUTION_CORRECTION_MINUTES"); minutes != "" {
+		if parsedMinutes, err := strconv.Atoi(minutes); err == nil && parsedMinutes >= 0 {
+			config.ResolutionCorrectionMinutes = parsedMinutes
+		}
+	}
+	if threshold := os.Getenv("WEBHOOK_BIG_WIN_THRESHOLD"); threshold != "" {
+		if parsedThreshold, err := strconv.ParseInt(threshold, 10, 64); err == nil && parsedThreshold > 0 {
+			config.WebhookBigWinThreshold = parsedThreshold
+		}
+	}
+	if threshold := os.Getenv("SLOW_QUERY_THRESHOLD_MS"); threshold != "" {
+		if parsedThreshold, err := strconv.Atoi(threshold); err == nil && parsedThreshold >= 0 {
+			config.SlowQueryThreshold = time.Duration(parsedThreshold) * time.Millisecond
+		}
+	}
+	if months := os.Getenv("BALANCE_HISTORY_RETENTION_MONTHS"); months != "" {
+		if parsedMonths, err := strconv.Atoi(months); err == nil && parsedMonths >= 0 {
+			config.BalanceHistoryRetentionMonths = parsedMonths
+		}
+	}
+	if explain := os.Getenv("SLOW_QUERY_EXPLAIN"); explain != "" {
+		if parsedExplain, err := strconv.ParseBool(explain); err == nil {
+			config.SlowQueryExplain = parsedExplain
+		}
+	}
+	if day := os.Getenv("WEEKLY_DIGEST_DAY"); day != "" {
+		if parsedDay, ok := parseWeekday(day); ok {
+			config.WeeklyDigestDay = parsedDay
+		}
+	}
+	if hour := os.Getenv("WEEKLY_DIGEST_HOUR"); hour != "" {
+		if parsedHour, err := strconv.Atoi(hour); err == nil && parsedHour >= 0 && parsedHour <= 23 {
+			config.WeeklyDigestHour = parsedHour
+		}
+	}
+	if shardID := os.Getenv("SHARD_ID"); shardID != "" {
+		if parsedShardID, err := strconv.Atoi(shardID); err == nil && parsedShardID >= 0 {
+			config.ShardID = parsedShardID
+		}
+	}
+	if shardCount := os.Getenv("SHARD_COUNT"); shardCount != "" {
+		if shardCount == "auto" {
+			config.ShardCount = 0
+		} else if parsedShardCount, err := strconv.Atoi(shardCount); err == nil && parsedShardCount > 0 {
+			config.ShardCount = parsedShardCount
+		}
+	}
 	// Parse resolver Discord IDs
 	if resolverIDs := os.Getenv("RESOLVER_DISCORD_IDS"); resolverIDs != "" {
 		idStrings := strings.Split(resolverIDs, ",")
@@ -157,6 +301,18 @@ func load() (*Config, error) {
 }
 
 // getEnvWithDefault returns the environment variable value or a default if not set
+// parseWeekday parses a day name such as "monday" or "Mon"
+func parseWeekday(value string) (time.Weekday, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if value == name || value == name[:3] {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
 func getEnvWithDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -186,8 +342,9 @@ func ResetConfig() {
 // NewTestConfig creates a minimal config suitable for unit tests
 func NewTestConfig() *Config {
 	return &Config{
-		Environment:        "test",
-		ResolverDiscordIDs: []int64{999999, 999991, 999998}, // Default test resolver IDs
-		StartingBalance:    1,
+		Environment:                 "test",
+		ResolverDiscordIDs:          []int64{999999, 999991, 999998}, // Default test resolver IDs
+		StartingBalance:             1,
+		ResolutionCorrectionMinutes: 15,
 	}
 }
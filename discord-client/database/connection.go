@@ -7,13 +7,68 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-// DB represents a database connection pool
+// DB represents a database connection pool with an optional read replica
 type DB struct {
 	*pgxpool.Pool
+	replica *pgxpool.Pool // Nil unless a read replica is configured
+	options connectionOptions
 }
 
 // NewConnection creates a new database connection pool
-func NewConnection(ctx context.Context, databaseURL string) (*DB, error) {
+func NewConnection(ctx context.Context, databaseURL string, opts ...ConnectionOption) (*DB, error) {
+	var options connectionOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	pool, err := newPool(ctx, databaseURL, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DB{Pool: pool, options: options}, nil
+}
+
+// ConnectReadReplica opens a pool to a read replica for heavy read-only queries.
+// Only repositories created with a read-only constructor use it; transactions stay on the primary.
+func (db *DB) ConnectReadReplica(ctx context.Context, replicaURL string) error {
+	if db.replica != nil {
+		return fmt.Errorf("read replica already connected")
+	}
+
+	pool, err := newPool(ctx, replicaURL, db.options)
+	if err != nil {
+		return fmt.Errorf("read replica: %w", err)
+	}
+
+	db.replica = pool
+	return nil
+}
+
+// HasReadReplica checks if a read replica is connected
+func (db *DB) HasReadReplica() bool {
+	return db.replica != nil
+}
+
+// ReadPool returns the pool read-only queries should use: the replica when connected, otherwise the primary.
+// Replica reads may lag the primary, so nothing read through it should decide a write.
+func (db *DB) ReadPool() *pgxpool.Pool {
+	if db.replica != nil {
+		return db.replica
+	}
+	return db.Pool
+}
+
+// Close closes the database connection pools
+func (db *DB) Close() {
+	if db.replica != nil {
+		db.replica.Close()
+	}
+	db.Pool.Close()
+}
+
+// newPool creates a connection pool with UTC sessions and checks it can reach the database
+func newPool(ctx context.Context, databaseURL string, options connectionOptions) (*pgxpool.Pool, error) {
 	// Parse config to set timezone
 	config, err := pgxpool.ParseConfig(databaseURL)
 	if err != nil {
@@ -23,11 +78,20 @@ func NewConnection(ctx context.Context, databaseURL string) (*DB, error) {
 	// Set timezone to UTC for all connections
 	config.ConnConfig.RuntimeParams["timezone"] = "UTC"
 
+	var tracer *slowQueryTracer
+	if options.slowQueryLog.Threshold > 0 {
+		tracer = newSlowQueryTracer(options.slowQueryLog)
+		config.ConnConfig.Tracer = tracer
+	}
+
 	// Create pool with UTC timezone
 	pool, err := pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create connection pool: %w", err)
 	}
+	if tracer != nil {
+		tracer.pool = pool
+	}
 
 	// Test connection
 	if err := pool.Ping(ctx); err != nil {
@@ -35,10 +99,5 @@ func NewConnection(ctx context.Context, databaseURL string) (*DB, error) {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	return &DB{Pool: pool}, nil
-}
-
-// Close closes the database connection pool
-func (db *DB) Close() {
-	db.Pool.Close()
+	return pool, nil
 }
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_lottery_tickets_guild_user;
+DROP INDEX IF EXISTS idx_lottery_draws_guild_completed;
@@ -0,0 +1,6 @@
+-- Index for number statistics over completed draws
+CREATE INDEX idx_lottery_draws_guild_completed ON lottery_draws(guild_id, difficulty, completed_at DESC)
+    WHERE completed_at IS NOT NULL;
+
+-- Index for a user's ticket history within a guild
+CREATE INDEX idx_lottery_tickets_guild_user ON lottery_tickets(guild_id, discord_id);
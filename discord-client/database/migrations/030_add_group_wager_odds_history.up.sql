@@ -0,0 +1,14 @@
+-- Create group_wager_odds_history table to record every odds change on house wagers
+CREATE TABLE group_wager_odds_history (
+    id BIGSERIAL PRIMARY KEY,
+    group_wager_id BIGINT NOT NULL REFERENCES group_wagers(id) ON DELETE CASCADE,
+    option_id BIGINT NOT NULL REFERENCES group_wager_options(id) ON DELETE CASCADE,
+    old_multiplier DECIMAL(10,2) NOT NULL,
+    new_multiplier DECIMAL(10,2) NOT NULL,
+    source VARCHAR(20) NOT NULL CHECK (source IN ('provider', 'manual')),
+    changed_by_discord_id BIGINT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Index for reading a wager's odds history in order
+CREATE INDEX idx_group_wager_odds_history_wager ON group_wager_odds_history(group_wager_id, created_at);
@@ -0,0 +1,3 @@
+-- Remove balance change audit log settings from guild_settings table
+ALTER TABLE guild_settings DROP COLUMN IF EXISTS audit_threshold;
+ALTER TABLE guild_settings DROP COLUMN IF EXISTS audit_channel_id;
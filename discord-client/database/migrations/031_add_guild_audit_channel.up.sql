@@ -0,0 +1,3 @@
+-- Add balance change audit log settings to guild_settings table
+ALTER TABLE guild_settings ADD COLUMN audit_channel_id BIGINT;
+ALTER TABLE guild_settings ADD COLUMN audit_threshold BIGINT;
@@ -0,0 +1,2 @@
+ALTER TABLE group_wager_participants
+DROP COLUMN IF EXISTS locked_multiplier;
@@ -0,0 +1,13 @@
+-- Lock each house wager bet to the odds offered when it was placed
+ALTER TABLE group_wager_participants
+ADD COLUMN locked_multiplier DECIMAL(10,2);
+
+-- Backfill locked odds for house wager bets placed before odds were locked per participant.
+-- Existing bets are locked at their option's current odds, which is what they would have settled at.
+UPDATE group_wager_participants p
+SET locked_multiplier = o.odds_multiplier
+FROM group_wager_options o, group_wagers w
+WHERE p.option_id = o.id
+  AND p.group_wager_id = w.id
+  AND w.wager_type = 'house'
+  AND p.locked_multiplier IS NULL;
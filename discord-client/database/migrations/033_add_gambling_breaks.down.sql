@@ -0,0 +1 @@
+DROP TABLE IF EXISTS gambling_breaks;
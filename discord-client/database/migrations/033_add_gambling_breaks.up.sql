@@ -0,0 +1,10 @@
+-- Self-exclusion windows requested via /gamba-break
+CREATE TABLE gambling_breaks (
+    discord_id BIGINT NOT NULL,
+    guild_id BIGINT NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (discord_id, guild_id),
+    FOREIGN KEY (discord_id, guild_id) REFERENCES user_guild_accounts(discord_id, guild_id) ON DELETE CASCADE
+);
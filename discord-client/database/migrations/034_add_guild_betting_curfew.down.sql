@@ -0,0 +1,3 @@
+-- Remove betting curfew window from guild_settings table
+ALTER TABLE guild_settings DROP COLUMN IF EXISTS curfew_end_hour;
+ALTER TABLE guild_settings DROP COLUMN IF EXISTS curfew_start_hour;
@@ -0,0 +1,4 @@
+-- Add betting curfew window (UTC hours) to guild_settings
+ALTER TABLE guild_settings
+ADD COLUMN curfew_start_hour SMALLINT CHECK (curfew_start_hour BETWEEN 0 AND 23),
+ADD COLUMN curfew_end_hour SMALLINT CHECK (curfew_end_hour BETWEEN 0 AND 23);
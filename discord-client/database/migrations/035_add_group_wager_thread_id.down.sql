@@ -0,0 +1,2 @@
+-- Remove discussion thread ID from group_wagers table
+ALTER TABLE group_wagers DROP COLUMN IF EXISTS thread_id;
@@ -0,0 +1,3 @@
+-- Add discussion thread ID to group_wagers table
+ALTER TABLE group_wagers
+ADD COLUMN thread_id BIGINT;
@@ -0,0 +1,5 @@
+-- Remove locked balance covering indexes
+DROP INDEX IF EXISTS idx_wagers_proposer_locked;
+DROP INDEX IF EXISTS idx_wagers_target_locked;
+DROP INDEX IF EXISTS idx_group_wager_participants_locked;
+DROP INDEX IF EXISTS idx_lottery_tickets_locked;
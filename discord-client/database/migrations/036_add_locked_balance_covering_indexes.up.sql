@@ -0,0 +1,17 @@
+-- Covering indexes for the locked balance breakdown query
+-- INCLUDE (amount) lets each lock be summed with an index-only scan
+
+-- Wagers are summed separately by proposer and target
+CREATE INDEX IF NOT EXISTS idx_wagers_proposer_locked
+ON wagers(proposer_discord_id, guild_id, state) INCLUDE (amount);
+
+CREATE INDEX IF NOT EXISTS idx_wagers_target_locked
+ON wagers(target_discord_id, guild_id, state) INCLUDE (amount);
+
+-- Group wager participations joined to their wager's guild and state
+CREATE INDEX IF NOT EXISTS idx_group_wager_participants_locked
+ON group_wager_participants(discord_id) INCLUDE (group_wager_id, amount);
+
+-- Lottery tickets joined to their draw to find pending draws
+CREATE INDEX IF NOT EXISTS idx_lottery_tickets_locked
+ON lottery_tickets(discord_id, guild_id) INCLUDE (draw_id, purchase_price);
@@ -0,0 +1,3 @@
+-- Drop experiment tables
+DROP TABLE IF EXISTS experiment_exposures;
+DROP TABLE IF EXISTS experiments;
@@ -0,0 +1,29 @@
+-- Create experiments table for percentage rollouts of engagement features
+CREATE TABLE experiments (
+    key VARCHAR(64) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    unit VARCHAR(10) NOT NULL DEFAULT 'user' CHECK (unit IN ('guild', 'user')),
+    rollout_percent SMALLINT NOT NULL DEFAULT 0 CHECK (rollout_percent BETWEEN 0 AND 100),
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Create experiment_exposures table recording each user's first exposure
+CREATE TABLE experiment_exposures (
+    id BIGSERIAL PRIMARY KEY,
+    experiment_key VARCHAR(64) NOT NULL REFERENCES experiments(key) ON DELETE CASCADE,
+    guild_id BIGINT NOT NULL,
+    discord_id BIGINT NOT NULL,
+    variant VARCHAR(16) NOT NULL CHECK (variant IN ('control', 'treatment')),
+    first_exposed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT unique_experiment_exposure UNIQUE (experiment_key, guild_id, discord_id)
+);
+
+-- Index for the per-variant report
+CREATE INDEX idx_experiment_exposures_key_variant ON experiment_exposures(experiment_key, variant);
+
+-- Seed known experiments at 0% rollout
+INSERT INTO experiments (key, description, unit) VALUES
+    ('quick_bet_buttons', 'Quick-bet buttons on wager messages', 'user'),
+    ('digest_dms', 'Periodic activity digest direct messages', 'user');
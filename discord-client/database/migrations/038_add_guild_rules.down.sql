@@ -0,0 +1,2 @@
+-- Remove rules text from guild_settings table
+ALTER TABLE guild_settings DROP COLUMN IF EXISTS rules_text;
@@ -0,0 +1,3 @@
+-- Add per-guild rules and dispute policy text (markdown) to guild_settings
+ALTER TABLE guild_settings
+ADD COLUMN rules_text TEXT CHECK (char_length(rules_text) <= 4000);
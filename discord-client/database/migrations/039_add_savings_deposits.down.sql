@@ -0,0 +1,12 @@
+-- Remove savings transaction types from balance_history constraint
+ALTER TABLE balance_history
+DROP CONSTRAINT balance_history_transaction_type_check;
+
+ALTER TABLE balance_history
+ADD CONSTRAINT balance_history_transaction_type_check
+CHECK (transaction_type IN ('bet_win', 'bet_loss', 'transfer_in', 'transfer_out', 'initial', 'wager_win', 'wager_loss', 'group_wager_win', 'group_wager_loss', 'wordle_reward', 'high_roller_purchase', 'lotto_ticket', 'lotto_win'));
+
+DROP TABLE IF EXISTS savings_deposits;
+
+ALTER TABLE guild_settings
+DROP COLUMN IF EXISTS savings_bonus_percent;
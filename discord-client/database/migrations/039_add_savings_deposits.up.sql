@@ -0,0 +1,36 @@
+-- Add savings bonus configuration to guild_settings (percent of the deposit earned per locked week)
+ALTER TABLE guild_settings
+ADD COLUMN savings_bonus_percent SMALLINT CHECK (savings_bonus_percent BETWEEN 0 AND 25);
+
+-- Create savings_deposits table for bits locked via /savings
+CREATE TABLE savings_deposits (
+    id BIGSERIAL PRIMARY KEY,
+    guild_id BIGINT NOT NULL,
+    discord_id BIGINT NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    term_weeks SMALLINT NOT NULL CHECK (term_weeks BETWEEN 1 AND 4),
+    bonus_amount BIGINT NOT NULL CHECK (bonus_amount >= 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'locked' CHECK (status IN ('locked', 'matured', 'withdrawn')),
+    locked_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    matures_at TIMESTAMP NOT NULL,
+    closed_at TIMESTAMP,
+    balance_history_id BIGINT,
+    FOREIGN KEY (discord_id, guild_id) REFERENCES user_guild_accounts(discord_id, guild_id) ON DELETE CASCADE
+);
+
+-- Index for summing a user's locked savings when computing available balance
+CREATE INDEX idx_savings_deposits_user_locked ON savings_deposits(discord_id, guild_id)
+    INCLUDE (amount)
+    WHERE status = 'locked';
+
+-- Index for finding deposits ready to mature
+CREATE INDEX idx_savings_deposits_matures_at ON savings_deposits(matures_at)
+    WHERE status = 'locked';
+
+-- Add savings transaction types to balance_history constraint
+ALTER TABLE balance_history
+DROP CONSTRAINT balance_history_transaction_type_check;
+
+ALTER TABLE balance_history
+ADD CONSTRAINT balance_history_transaction_type_check
+CHECK (transaction_type IN ('bet_win', 'bet_loss', 'transfer_in', 'transfer_out', 'initial', 'wager_win', 'wager_loss', 'group_wager_win', 'group_wager_loss', 'wordle_reward', 'high_roller_purchase', 'lotto_ticket', 'lotto_win', 'savings_bonus'));
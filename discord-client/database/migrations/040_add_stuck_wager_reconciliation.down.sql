@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_group_wagers_pending_resolution;
+
+ALTER TABLE group_wagers
+DROP COLUMN IF EXISTS resolution_reminded_at;
+
+ALTER TABLE guild_settings
+DROP COLUMN IF EXISTS stuck_wager_reminder_hours,
+DROP COLUMN IF EXISTS stuck_wager_cancel_hours;
@@ -0,0 +1,12 @@
+-- Add stuck wager reconciliation settings to guild_settings (NULL disables each step)
+ALTER TABLE guild_settings
+ADD COLUMN stuck_wager_reminder_hours INTEGER CHECK (stuck_wager_reminder_hours > 0),
+ADD COLUMN stuck_wager_cancel_hours INTEGER CHECK (stuck_wager_cancel_hours > 0);
+
+-- Track when resolvers were last reminded about a wager awaiting resolution
+ALTER TABLE group_wagers
+ADD COLUMN resolution_reminded_at TIMESTAMP;
+
+-- Index for finding guilds with wagers awaiting resolution
+CREATE INDEX idx_group_wagers_pending_resolution ON group_wagers(guild_id, voting_ends_at)
+    WHERE state = 'pending_resolution';
@@ -0,0 +1,5 @@
+ALTER TABLE guild_settings
+DROP COLUMN IF EXISTS house_option_cap;
+
+ALTER TABLE group_wager_options
+DROP COLUMN IF EXISTS max_total_amount;
@@ -0,0 +1,7 @@
+-- Optional cap on the total amount that can be bet on a single group wager option
+ALTER TABLE group_wager_options
+ADD COLUMN max_total_amount BIGINT CHECK (max_total_amount > 0);
+
+-- Per-option cap applied to system-created house wagers (NULL = uncapped)
+ALTER TABLE guild_settings
+ADD COLUMN house_option_cap BIGINT CHECK (house_option_cap > 0);
@@ -0,0 +1,18 @@
+-- Remove parlay related type from balance_history constraint
+ALTER TABLE balance_history
+DROP CONSTRAINT balance_history_related_type_check;
+
+ALTER TABLE balance_history
+ADD CONSTRAINT balance_history_related_type_check
+CHECK (related_type IN ('bet', 'wager', 'group_wager'));
+
+-- Remove parlay transaction types from balance_history constraint
+ALTER TABLE balance_history
+DROP CONSTRAINT balance_history_transaction_type_check;
+
+ALTER TABLE balance_history
+ADD CONSTRAINT balance_history_transaction_type_check
+CHECK (transaction_type IN ('bet_win', 'bet_loss', 'transfer_in', 'transfer_out', 'initial', 'wager_win', 'wager_loss', 'group_wager_win', 'group_wager_loss', 'wordle_reward', 'high_roller_purchase', 'lotto_ticket', 'lotto_win', 'savings_bonus'));
+
+DROP TABLE IF EXISTS parlay_legs;
+DROP TABLE IF EXISTS parlays;
@@ -0,0 +1,53 @@
+-- Create parlays table for multi-leg tickets across house wagers placed via /parlay
+CREATE TABLE parlays (
+    id BIGSERIAL PRIMARY KEY,
+    guild_id BIGINT NOT NULL,
+    discord_id BIGINT NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'won', 'lost', 'void')),
+    payout_amount BIGINT,
+    balance_history_id BIGINT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    settled_at TIMESTAMP,
+    FOREIGN KEY (discord_id, guild_id) REFERENCES user_guild_accounts(discord_id, guild_id) ON DELETE CASCADE
+);
+
+-- Each leg picks one option of a house wager at the odds offered when the parlay was placed
+CREATE TABLE parlay_legs (
+    id BIGSERIAL PRIMARY KEY,
+    parlay_id BIGINT NOT NULL REFERENCES parlays(id) ON DELETE CASCADE,
+    group_wager_id BIGINT NOT NULL REFERENCES group_wagers(id) ON DELETE CASCADE,
+    option_id BIGINT NOT NULL REFERENCES group_wager_options(id) ON DELETE CASCADE,
+    odds_multiplier DOUBLE PRECISION NOT NULL CHECK (odds_multiplier > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'won', 'lost', 'void')),
+    settled_at TIMESTAMP,
+    UNIQUE(parlay_id, group_wager_id)
+);
+
+-- Index for summing a user's pending parlay stakes when computing available balance
+CREATE INDEX idx_parlays_user_pending ON parlays(discord_id, guild_id)
+    INCLUDE (amount)
+    WHERE status = 'pending';
+
+-- Index for listing a user's recent parlays
+CREATE INDEX idx_parlays_user_created ON parlays(guild_id, discord_id, created_at DESC);
+
+-- Index for finding legs to settle when a wager resolves or is cancelled
+CREATE INDEX idx_parlay_legs_group_wager_pending ON parlay_legs(group_wager_id)
+    WHERE status = 'pending';
+
+-- Add parlay transaction types to balance_history constraint
+ALTER TABLE balance_history
+DROP CONSTRAINT balance_history_transaction_type_check;
+
+ALTER TABLE balance_history
+ADD CONSTRAINT balance_history_transaction_type_check
+CHECK (transaction_type IN ('bet_win', 'bet_loss', 'transfer_in', 'transfer_out', 'initial', 'wager_win', 'wager_loss', 'group_wager_win', 'group_wager_loss', 'wordle_reward', 'high_roller_purchase', 'lotto_ticket', 'lotto_win', 'savings_bonus', 'parlay_win', 'parlay_loss'));
+
+-- Allow balance history to reference parlays
+ALTER TABLE balance_history
+DROP CONSTRAINT balance_history_related_type_check;
+
+ALTER TABLE balance_history
+ADD CONSTRAINT balance_history_related_type_check
+CHECK (related_type IN ('bet', 'wager', 'group_wager', 'parlay'));
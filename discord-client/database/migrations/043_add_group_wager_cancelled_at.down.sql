@@ -0,0 +1,2 @@
+ALTER TABLE group_wagers
+DROP COLUMN IF EXISTS cancelled_at;
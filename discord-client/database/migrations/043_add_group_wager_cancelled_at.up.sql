@@ -0,0 +1,3 @@
+-- Track when a group wager was cancelled so the cancellation can be undone within a grace window
+ALTER TABLE group_wagers
+ADD COLUMN cancelled_at TIMESTAMP;
@@ -0,0 +1,10 @@
+-- Per-guild group wager resolvers, granted to individual users or to everyone with a role
+CREATE TABLE guild_resolvers (
+    id BIGSERIAL PRIMARY KEY,
+    guild_id BIGINT NOT NULL,
+    resolver_type VARCHAR(10) NOT NULL CHECK (resolver_type IN ('user', 'role')),
+    target_id BIGINT NOT NULL,
+    added_by BIGINT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE(guild_id, resolver_type, target_id)
+);
@@ -0,0 +1,17 @@
+-- Remove duel related type from balance_history constraint
+ALTER TABLE balance_history
+DROP CONSTRAINT balance_history_related_type_check;
+
+ALTER TABLE balance_history
+ADD CONSTRAINT balance_history_related_type_check
+CHECK (related_type IN ('bet', 'wager', 'group_wager', 'parlay'));
+
+-- Remove duel transaction types from balance_history constraint
+ALTER TABLE balance_history
+DROP CONSTRAINT balance_history_transaction_type_check;
+
+ALTER TABLE balance_history
+ADD CONSTRAINT balance_history_transaction_type_check
+CHECK (transaction_type IN ('bet_win', 'bet_loss', 'transfer_in', 'transfer_out', 'initial', 'wager_win', 'wager_loss', 'group_wager_win', 'group_wager_loss', 'wordle_reward', 'high_roller_purchase', 'lotto_ticket', 'lotto_win', 'savings_bonus', 'parlay_win', 'parlay_loss'));
+
+DROP TABLE IF EXISTS duels;
@@ -0,0 +1,39 @@
+-- Create duels table for heads-or-tails challenges between two users placed via /duel
+CREATE TABLE duels (
+    id BIGSERIAL PRIMARY KEY,
+    guild_id BIGINT NOT NULL,
+    challenger_discord_id BIGINT NOT NULL,
+    target_discord_id BIGINT NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    best_of SMALLINT NOT NULL DEFAULT 1 CHECK (best_of IN (1, 3)),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'completed', 'declined', 'cancelled')),
+    flips BOOLEAN[] NOT NULL DEFAULT '{}',
+    winner_discord_id BIGINT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP NOT NULL,
+    resolved_at TIMESTAMP,
+    CHECK (challenger_discord_id <> target_discord_id),
+    FOREIGN KEY (challenger_discord_id, guild_id) REFERENCES user_guild_accounts(discord_id, guild_id) ON DELETE CASCADE,
+    FOREIGN KEY (target_discord_id, guild_id) REFERENCES user_guild_accounts(discord_id, guild_id) ON DELETE CASCADE
+);
+
+-- Index for summing a challenger's open duel stakes when computing available balance
+CREATE INDEX idx_duels_challenger_pending ON duels(challenger_discord_id, guild_id)
+    INCLUDE (amount, expires_at)
+    WHERE status = 'pending';
+
+-- Add duel transaction types to balance_history constraint
+ALTER TABLE balance_history
+DROP CONSTRAINT balance_history_transaction_type_check;
+
+ALTER TABLE balance_history
+ADD CONSTRAINT balance_history_transaction_type_check
+CHECK (transaction_type IN ('bet_win', 'bet_loss', 'transfer_in', 'transfer_out', 'initial', 'wager_win', 'wager_loss', 'group_wager_win', 'group_wager_loss', 'wordle_reward', 'high_roller_purchase', 'lotto_ticket', 'lotto_win', 'savings_bonus', 'parlay_win', 'parlay_loss', 'duel_win', 'duel_loss'));
+
+-- Allow balance history to reference duels
+ALTER TABLE balance_history
+DROP CONSTRAINT balance_history_related_type_check;
+
+ALTER TABLE balance_history
+ADD CONSTRAINT balance_history_related_type_check
+CHECK (related_type IN ('bet', 'wager', 'group_wager', 'parlay', 'duel'));
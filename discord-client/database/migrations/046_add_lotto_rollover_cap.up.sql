@@ -0,0 +1,11 @@
+-- Largest pot that can roll over into the next lottery draw (NULL = uncapped)
+ALTER TABLE guild_settings
+ADD COLUMN lotto_rollover_cap BIGINT CHECK (lotto_rollover_cap > 0);
+
+-- Add lottery consolation transaction type to balance_history constraint
+ALTER TABLE balance_history
+DROP CONSTRAINT balance_history_transaction_type_check;
+
+ALTER TABLE balance_history
+ADD CONSTRAINT balance_history_transaction_type_check
+CHECK (transaction_type IN ('bet_win', 'bet_loss', 'transfer_in', 'transfer_out', 'initial', 'wager_win', 'wager_loss', 'group_wager_win', 'group_wager_loss', 'wordle_reward', 'high_roller_purchase', 'lotto_ticket', 'lotto_win', 'savings_bonus', 'parlay_win', 'parlay_loss', 'duel_win', 'duel_loss', 'lotto_consolation'));
@@ -0,0 +1,3 @@
+ALTER TABLE guild_settings
+DROP COLUMN IF EXISTS welcome_new_members,
+DROP COLUMN IF EXISTS starting_balance;
@@ -0,0 +1,4 @@
+-- Bits granted to new users (NULL = default of 1) and whether joining members get an account and a welcome message
+ALTER TABLE guild_settings
+ADD COLUMN starting_balance BIGINT CHECK (starting_balance >= 0),
+ADD COLUMN welcome_new_members BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS lottery_subscriptions;
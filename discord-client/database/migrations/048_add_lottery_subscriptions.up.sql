@@ -0,0 +1,11 @@
+-- Create lottery_subscriptions table for tickets bought automatically at the start of each draw via /lotto subscribe
+CREATE TABLE lottery_subscriptions (
+    id BIGSERIAL PRIMARY KEY,
+    guild_id BIGINT NOT NULL,
+    discord_id BIGINT NOT NULL,
+    ticket_count INTEGER NOT NULL CHECK (ticket_count > 0),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE(guild_id, discord_id),
+    FOREIGN KEY (discord_id, guild_id) REFERENCES user_guild_accounts(discord_id, guild_id) ON DELETE CASCADE
+);
@@ -0,0 +1,7 @@
+-- Create user_preferences table for per-user display settings shared across guilds
+CREATE TABLE user_preferences (
+    discord_id BIGINT PRIMARY KEY,
+    odds_format VARCHAR(20) NOT NULL DEFAULT 'multiplier' CHECK (odds_format IN ('multiplier', 'decimal', 'fractional', 'american')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
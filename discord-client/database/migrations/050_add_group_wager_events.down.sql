@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS group_wager_events_append_only ON group_wager_events;
+DROP FUNCTION IF EXISTS reject_group_wager_event_update();
+DROP TABLE IF EXISTS group_wager_events;
@@ -0,0 +1,26 @@
+-- Create group_wager_events as an append-only log of every state-affecting wager action
+CREATE TABLE group_wager_events (
+    id BIGSERIAL PRIMARY KEY,
+    group_wager_id BIGINT NOT NULL REFERENCES group_wagers(id) ON DELETE CASCADE,
+    event_type VARCHAR(20) NOT NULL CHECK (event_type IN (
+        'created', 'bet_placed', 'bet_changed', 'bet_withdrawn',
+        'odds_updated', 'resolved', 'cancelled', 'restored'
+    )),
+    actor_discord_id BIGINT,
+    payload JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Index for reading a wager's timeline in order
+CREATE INDEX idx_group_wager_events_wager ON group_wager_events(group_wager_id, id);
+
+-- Events are history; reject any attempt to rewrite them
+CREATE OR REPLACE FUNCTION reject_group_wager_event_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'group_wager_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER group_wager_events_append_only BEFORE UPDATE
+    ON group_wager_events FOR EACH ROW EXECUTE FUNCTION reject_group_wager_event_update();
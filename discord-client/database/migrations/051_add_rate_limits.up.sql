@@ -0,0 +1,4 @@
+-- Per-user token bucket for bets, lottery purchases and transfers (NULL = defaults of 10 per minute, burst of 3; 0 per minute disables)
+ALTER TABLE guild_settings
+ADD COLUMN rate_limit_per_minute INTEGER CHECK (rate_limit_per_minute >= 0 AND rate_limit_per_minute <= 120),
+ADD COLUMN rate_limit_burst INTEGER CHECK (rate_limit_burst >= 1 AND rate_limit_burst <= 20);
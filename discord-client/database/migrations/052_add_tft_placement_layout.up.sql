@@ -0,0 +1,3 @@
+-- How regular TFT house wagers split placements into options (NULL = pairs: 1-2/3-4/5-6/7-8)
+ALTER TABLE guild_settings
+ADD COLUMN tft_placement_layout VARCHAR(20) CHECK (tft_placement_layout IN ('pairs', 'exact', 'top_bottom'));
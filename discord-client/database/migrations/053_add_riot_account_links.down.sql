@@ -0,0 +1,7 @@
+ALTER TABLE guild_settings
+DROP COLUMN IF EXISTS block_own_game_bets;
+
+ALTER TABLE group_wagers
+DROP COLUMN IF EXISTS subject_discord_id;
+
+DROP TABLE IF EXISTS riot_account_links;
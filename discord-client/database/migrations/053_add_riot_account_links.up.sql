@@ -0,0 +1,18 @@
+-- Create riot_account_links so users can claim their own Riot account, shared across guilds
+CREATE TABLE riot_account_links (
+    discord_id BIGINT PRIMARY KEY,
+    game_name VARCHAR(255) NOT NULL,
+    tag_line VARCHAR(5) NOT NULL,
+    linked_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- A Riot account can only be claimed by one user; Riot IDs are case-insensitive
+CREATE UNIQUE INDEX idx_riot_account_links_riot_id ON riot_account_links(LOWER(game_name), LOWER(tag_line));
+
+-- The linked user whose game a house wager is on, so they can be tagged and kept off their own games
+ALTER TABLE group_wagers
+ADD COLUMN subject_discord_id BIGINT;
+
+-- Whether players may bet on house wagers about their own games
+ALTER TABLE guild_settings
+ADD COLUMN block_own_game_bets BOOLEAN NOT NULL DEFAULT TRUE;
@@ -0,0 +1,2 @@
+ALTER TABLE guild_settings
+DROP COLUMN IF EXISTS digest_channel_id;
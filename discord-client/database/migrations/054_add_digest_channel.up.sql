@@ -0,0 +1,3 @@
+-- Channel the weekly economy digest is posted to, NULL disables the digest
+ALTER TABLE guild_settings
+ADD COLUMN digest_channel_id BIGINT;
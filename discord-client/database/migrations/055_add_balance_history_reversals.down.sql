@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_balance_history_reversal_of_id;
+
+ALTER TABLE balance_history
+DROP COLUMN IF EXISTS reversed_by_id,
+DROP COLUMN IF EXISTS reversal_of_id;
@@ -0,0 +1,6 @@
+-- Link admin reversals to the entry they undo so an entry can only be reversed once
+ALTER TABLE balance_history
+ADD COLUMN reversal_of_id BIGINT REFERENCES balance_history(id),
+ADD COLUMN reversed_by_id BIGINT REFERENCES balance_history(id);
+
+CREATE UNIQUE INDEX idx_balance_history_reversal_of_id ON balance_history(reversal_of_id) WHERE reversal_of_id IS NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE group_wager_options
+DROP COLUMN IF EXISTS button_style,
+DROP COLUMN IF EXISTS button_emoji;
@@ -0,0 +1,4 @@
+-- Optional emoji and colour for a group wager option's bet button (NULL = numbered emoji, default colour)
+ALTER TABLE group_wager_options
+ADD COLUMN button_emoji VARCHAR(100),
+ADD COLUMN button_style VARCHAR(20) CHECK (button_style IN ('primary', 'secondary', 'success', 'danger'));
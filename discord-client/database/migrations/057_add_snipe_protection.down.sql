@@ -0,0 +1,11 @@
+DELETE FROM group_wager_events WHERE event_type = 'voting_extended';
+
+ALTER TABLE group_wager_events DROP CONSTRAINT group_wager_events_event_type_check;
+ALTER TABLE group_wager_events ADD CONSTRAINT group_wager_events_event_type_check CHECK (event_type IN (
+    'created', 'bet_placed', 'bet_changed', 'bet_withdrawn',
+    'odds_updated', 'resolved', 'cancelled', 'restored'
+));
+
+ALTER TABLE guild_settings
+DROP COLUMN IF EXISTS snipe_extension_minutes,
+DROP COLUMN IF EXISTS snipe_window_minutes;
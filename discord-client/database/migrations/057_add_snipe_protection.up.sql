@@ -0,0 +1,11 @@
+-- Let guilds extend voting when bets land in the final minutes (NULL = disabled)
+ALTER TABLE guild_settings
+ADD COLUMN snipe_window_minutes INTEGER CHECK (snipe_window_minutes > 0),
+ADD COLUMN snipe_extension_minutes INTEGER CHECK (snipe_extension_minutes > 0);
+
+-- Record voting extensions in the wager timeline
+ALTER TABLE group_wager_events DROP CONSTRAINT group_wager_events_event_type_check;
+ALTER TABLE group_wager_events ADD CONSTRAINT group_wager_events_event_type_check CHECK (event_type IN (
+    'created', 'bet_placed', 'bet_changed', 'bet_withdrawn',
+    'odds_updated', 'resolved', 'cancelled', 'restored', 'voting_extended'
+));
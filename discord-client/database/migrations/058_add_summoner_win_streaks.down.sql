@@ -0,0 +1,4 @@
+ALTER TABLE summoners
+DROP COLUMN IF EXISTS last_game_id,
+DROP COLUMN IF EXISTS win_streak_start_game_id,
+DROP COLUMN IF EXISTS win_streak;
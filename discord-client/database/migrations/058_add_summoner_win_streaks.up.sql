@@ -0,0 +1,5 @@
+-- Track each summoner's current win streak so the LoL handler can offer streak wagers
+ALTER TABLE summoners
+ADD COLUMN win_streak INTEGER NOT NULL DEFAULT 0 CHECK (win_streak >= 0),
+ADD COLUMN win_streak_start_game_id VARCHAR(255),
+ADD COLUMN last_game_id VARCHAR(255);
@@ -0,0 +1,3 @@
+-- Reserve balance players cannot bet or buy tickets below (NULL = no floor)
+ALTER TABLE guild_settings
+ADD COLUMN min_balance_floor BIGINT CHECK (min_balance_floor > 0);
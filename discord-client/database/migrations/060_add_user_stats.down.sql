@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS wagers_user_stats ON wagers;
+DROP TRIGGER IF EXISTS bets_user_stats ON bets;
+DROP FUNCTION IF EXISTS refresh_wager_participants_user_stats();
+DROP FUNCTION IF EXISTS apply_bet_to_user_stats();
+DROP FUNCTION IF EXISTS refresh_user_wager_stats(BIGINT, BIGINT);
+DROP FUNCTION IF EXISTS refresh_user_bet_stats(BIGINT, BIGINT);
+DROP TABLE IF EXISTS user_stats;
@@ -0,0 +1,177 @@
+-- Cache each user's bet and wager aggregates per guild so /stats doesn't scan bets and wagers on every call.
+-- Triggers on bets and wagers keep the rows current; a nightly job verifies them against the raw tables.
+CREATE TABLE user_stats (
+    discord_id BIGINT NOT NULL,
+    guild_id BIGINT NOT NULL,
+
+    -- Bets (/gamble)
+    total_bets INTEGER NOT NULL DEFAULT 0,
+    total_bet_wins INTEGER NOT NULL DEFAULT 0,
+    total_bet_losses INTEGER NOT NULL DEFAULT 0,
+    total_bet_wagered BIGINT NOT NULL DEFAULT 0,
+    total_bet_won BIGINT NOT NULL DEFAULT 0,
+    total_bet_lost BIGINT NOT NULL DEFAULT 0,
+    biggest_bet_win BIGINT NOT NULL DEFAULT 0,
+    biggest_bet_loss BIGINT NOT NULL DEFAULT 0,
+
+    -- Wagers (/wager)
+    total_wagers INTEGER NOT NULL DEFAULT 0,
+    total_wagers_proposed INTEGER NOT NULL DEFAULT 0,
+    total_wagers_accepted INTEGER NOT NULL DEFAULT 0,
+    total_wagers_declined INTEGER NOT NULL DEFAULT 0,
+    total_wagers_resolved INTEGER NOT NULL DEFAULT 0,
+    total_wagers_won INTEGER NOT NULL DEFAULT 0,
+    total_wagers_lost INTEGER NOT NULL DEFAULT 0,
+    total_wager_amount BIGINT NOT NULL DEFAULT 0,
+    total_wager_won_amount BIGINT NOT NULL DEFAULT 0,
+    biggest_wager_win BIGINT NOT NULL DEFAULT 0,
+    biggest_wager_loss BIGINT NOT NULL DEFAULT 0,
+
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (discord_id, guild_id)
+);
+
+CREATE INDEX idx_user_stats_guild ON user_stats(guild_id);
+
+-- Recompute a user's bet columns from the bets table
+CREATE OR REPLACE FUNCTION refresh_user_bet_stats(p_discord_id BIGINT, p_guild_id BIGINT)
+RETURNS VOID AS $$
+BEGIN
+    INSERT INTO user_stats (
+        discord_id, guild_id, total_bets, total_bet_wins, total_bet_losses,
+        total_bet_wagered, total_bet_won, total_bet_lost, biggest_bet_win, biggest_bet_loss
+    )
+    SELECT
+        p_discord_id,
+        p_guild_id,
+        COUNT(*),
+        COUNT(CASE WHEN won = true THEN 1 END),
+        COUNT(CASE WHEN won = false THEN 1 END),
+        COALESCE(SUM(amount), 0),
+        COALESCE(SUM(CASE WHEN won = true THEN win_amount ELSE 0 END), 0),
+        COALESCE(SUM(CASE WHEN won = false THEN amount ELSE 0 END), 0),
+        COALESCE(MAX(CASE WHEN won = true THEN win_amount ELSE 0 END), 0),
+        COALESCE(MAX(CASE WHEN won = false THEN amount ELSE 0 END), 0)
+    FROM bets
+    WHERE discord_id = p_discord_id AND guild_id = p_guild_id
+    ON CONFLICT (discord_id, guild_id) DO UPDATE SET
+        total_bets = EXCLUDED.total_bets,
+        total_bet_wins = EXCLUDED.total_bet_wins,
+        total_bet_losses = EXCLUDED.total_bet_losses,
+        total_bet_wagered = EXCLUDED.total_bet_wagered,
+        total_bet_won = EXCLUDED.total_bet_won,
+        total_bet_lost = EXCLUDED.total_bet_lost,
+        biggest_bet_win = EXCLUDED.biggest_bet_win,
+        biggest_bet_loss = EXCLUDED.biggest_bet_loss,
+        updated_at = CURRENT_TIMESTAMP;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Recompute a user's wager columns from the wagers table
+CREATE OR REPLACE FUNCTION refresh_user_wager_stats(p_discord_id BIGINT, p_guild_id BIGINT)
+RETURNS VOID AS $$
+BEGIN
+    INSERT INTO user_stats (
+        discord_id, guild_id, total_wagers, total_wagers_proposed, total_wagers_accepted,
+        total_wagers_declined, total_wagers_resolved, total_wagers_won, total_wagers_lost,
+        total_wager_amount, total_wager_won_amount, biggest_wager_win, biggest_wager_loss
+    )
+    SELECT
+        p_discord_id,
+        p_guild_id,
+        COUNT(*),
+        COUNT(CASE WHEN state = 'proposed' THEN 1 END),
+        COUNT(CASE WHEN state = 'voting' THEN 1 END),
+        COUNT(CASE WHEN state = 'declined' THEN 1 END),
+        COUNT(CASE WHEN state = 'resolved' THEN 1 END),
+        COUNT(CASE WHEN state = 'resolved' AND winner_discord_id = p_discord_id THEN 1 END),
+        COUNT(CASE WHEN state = 'resolved' AND winner_discord_id != p_discord_id THEN 1 END),
+        COALESCE(SUM(amount), 0),
+        COALESCE(SUM(CASE WHEN state = 'resolved' AND winner_discord_id = p_discord_id THEN amount ELSE 0 END), 0),
+        COALESCE(MAX(CASE WHEN state = 'resolved' AND winner_discord_id = p_discord_id THEN amount ELSE 0 END), 0),
+        COALESCE(MAX(CASE WHEN state = 'resolved' AND winner_discord_id != p_discord_id THEN amount ELSE 0 END), 0)
+    FROM wagers
+    WHERE (proposer_discord_id = p_discord_id OR target_discord_id = p_discord_id)
+      AND guild_id = p_guild_id
+    ON CONFLICT (discord_id, guild_id) DO UPDATE SET
+        total_wagers = EXCLUDED.total_wagers,
+        total_wagers_proposed = EXCLUDED.total_wagers_proposed,
+        total_wagers_accepted = EXCLUDED.total_wagers_accepted,
+        total_wagers_declined = EXCLUDED.total_wagers_declined,
+        total_wagers_resolved = EXCLUDED.total_wagers_resolved,
+        total_wagers_won = EXCLUDED.total_wagers_won,
+        total_wagers_lost = EXCLUDED.total_wagers_lost,
+        total_wager_amount = EXCLUDED.total_wager_amount,
+        total_wager_won_amount = EXCLUDED.total_wager_won_amount,
+        biggest_wager_win = EXCLUDED.biggest_wager_win,
+        biggest_wager_loss = EXCLUDED.biggest_wager_loss,
+        updated_at = CURRENT_TIMESTAMP;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Bets are settled when inserted and never change, so each one is added to the running totals
+CREATE OR REPLACE FUNCTION apply_bet_to_user_stats()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO user_stats (
+        discord_id, guild_id, total_bets, total_bet_wins, total_bet_losses,
+        total_bet_wagered, total_bet_won, total_bet_lost, biggest_bet_win, biggest_bet_loss
+    )
+    VALUES (
+        NEW.discord_id,
+        NEW.guild_id,
+        1,
+        CASE WHEN NEW.won THEN 1 ELSE 0 END,
+        CASE WHEN NEW.won THEN 0 ELSE 1 END,
+        NEW.amount,
+        CASE WHEN NEW.won THEN NEW.win_amount ELSE 0 END,
+        CASE WHEN NEW.won THEN 0 ELSE NEW.amount END,
+        CASE WHEN NEW.won THEN NEW.win_amount ELSE 0 END,
+        CASE WHEN NEW.won THEN 0 ELSE NEW.amount END
+    )
+    ON CONFLICT (discord_id, guild_id) DO UPDATE SET
+        total_bets = user_stats.total_bets + EXCLUDED.total_bets,
+        total_bet_wins = user_stats.total_bet_wins + EXCLUDED.total_bet_wins,
+        total_bet_losses = user_stats.total_bet_losses + EXCLUDED.total_bet_losses,
+        total_bet_wagered = user_stats.total_bet_wagered + EXCLUDED.total_bet_wagered,
+        total_bet_won = user_stats.total_bet_won + EXCLUDED.total_bet_won,
+        total_bet_lost = user_stats.total_bet_lost + EXCLUDED.total_bet_lost,
+        biggest_bet_win = GREATEST(user_stats.biggest_bet_win, EXCLUDED.biggest_bet_win),
+        biggest_bet_loss = GREATEST(user_stats.biggest_bet_loss, EXCLUDED.biggest_bet_loss),
+        updated_at = CURRENT_TIMESTAMP;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER bets_user_stats AFTER INSERT
+    ON bets FOR EACH ROW EXECUTE FUNCTION apply_bet_to_user_stats();
+
+-- Wagers move through several states, so both participants' wager columns are recomputed.
+-- A user only has a handful of wagers, which keeps this cheap.
+CREATE OR REPLACE FUNCTION refresh_wager_participants_user_stats()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM refresh_user_wager_stats(OLD.proposer_discord_id, OLD.guild_id);
+        PERFORM refresh_user_wager_stats(OLD.target_discord_id, OLD.guild_id);
+    ELSE
+        PERFORM refresh_user_wager_stats(NEW.proposer_discord_id, NEW.guild_id);
+        PERFORM refresh_user_wager_stats(NEW.target_discord_id, NEW.guild_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER wagers_user_stats AFTER INSERT OR UPDATE OR DELETE
+    ON wagers FOR EACH ROW EXECUTE FUNCTION refresh_wager_participants_user_stats();
+
+-- Backfill from existing history
+SELECT refresh_user_bet_stats(discord_id, guild_id)
+FROM (SELECT DISTINCT discord_id, guild_id FROM bets) AS bettors;
+
+SELECT refresh_user_wager_stats(discord_id, guild_id)
+FROM (
+    SELECT proposer_discord_id AS discord_id, guild_id FROM wagers
+    UNION
+    SELECT target_discord_id AS discord_id, guild_id FROM wagers
+) AS wager_participants;
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS season_token_history;
+DROP TABLE IF EXISTS season_token_balances;
+
+ALTER TABLE guild_settings
+DROP COLUMN IF EXISTS current_season,
+DROP COLUMN IF EXISTS season_token_rate,
+DROP COLUMN IF EXISTS season_token_name;
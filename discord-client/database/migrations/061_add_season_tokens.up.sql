@@ -0,0 +1,34 @@
+-- Seasonal secondary currency earned from wins and spent on special wagers and cosmetics.
+-- Tokens are tracked separately from bits so balance_history only ever holds bit movements.
+ALTER TABLE guild_settings
+ADD COLUMN season_token_name VARCHAR(32),                                  -- NULL = season tokens disabled
+ADD COLUMN season_token_rate BIGINT CHECK (season_token_rate > 0),         -- Bits won per token earned
+ADD COLUMN current_season INTEGER NOT NULL DEFAULT 1 CHECK (current_season > 0);
+
+-- Token balances are kept per season, so starting a new season leaves the old balances behind
+CREATE TABLE season_token_balances (
+    discord_id BIGINT NOT NULL,
+    guild_id BIGINT NOT NULL,
+    season INTEGER NOT NULL,
+    balance BIGINT NOT NULL DEFAULT 0 CHECK (balance >= 0),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (discord_id, guild_id, season),
+    FOREIGN KEY (discord_id, guild_id) REFERENCES user_guild_accounts(discord_id, guild_id) ON DELETE CASCADE
+);
+
+-- Ledger of token credits and debits, the season token counterpart of balance_history
+CREATE TABLE season_token_history (
+    id BIGSERIAL PRIMARY KEY,
+    discord_id BIGINT NOT NULL,
+    guild_id BIGINT NOT NULL,
+    season INTEGER NOT NULL,
+    balance_before BIGINT NOT NULL,
+    balance_after BIGINT NOT NULL,
+    change_amount BIGINT NOT NULL CHECK (change_amount <> 0),
+    transaction_type VARCHAR(50) NOT NULL CHECK (transaction_type IN ('season_token_earned', 'season_token_spent')),
+    transaction_metadata JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (discord_id, guild_id) REFERENCES user_guild_accounts(discord_id, guild_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_season_token_history_user ON season_token_history(guild_id, discord_id, created_at DESC);
@@ -0,0 +1,4 @@
+ALTER TABLE group_wagers
+DROP COLUMN IF EXISTS invited_discord_ids,
+DROP COLUMN IF EXISTS allowed_role_id,
+DROP COLUMN IF EXISTS max_participants;
@@ -0,0 +1,5 @@
+-- Optional participant limits and invite-only access for group wagers
+ALTER TABLE group_wagers
+ADD COLUMN max_participants INTEGER CHECK (max_participants > 0),             -- NULL = no limit on how many users can bet
+ADD COLUMN allowed_role_id BIGINT,                                             -- Members of this role may bet on an invite-only wager
+ADD COLUMN invited_discord_ids BIGINT[] NOT NULL DEFAULT '{}';                 -- Users who may bet on an invite-only wager
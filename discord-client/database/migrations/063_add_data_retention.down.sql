@@ -0,0 +1 @@
+DROP SEQUENCE IF EXISTS anonymized_user_seq;
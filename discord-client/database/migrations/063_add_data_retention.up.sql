@@ -0,0 +1,3 @@
+-- Deleted users' shared history (ledger entries, wagers, lottery tickets) is reassigned to a negative
+-- pseudonymous ID drawn from this sequence, so it can never collide with a Discord snowflake
+CREATE SEQUENCE anonymized_user_seq;
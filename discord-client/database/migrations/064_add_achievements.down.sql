@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS achievement_progress;
+DROP TABLE IF EXISTS achievements;
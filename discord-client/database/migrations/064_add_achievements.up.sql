@@ -0,0 +1,20 @@
+-- Achievements a user has unlocked in a guild. Each achievement can only be unlocked once.
+CREATE TABLE achievements (
+    discord_id BIGINT NOT NULL,
+    guild_id BIGINT NOT NULL,
+    achievement_key VARCHAR(32) NOT NULL,
+    unlocked_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (discord_id, guild_id, achievement_key),
+    FOREIGN KEY (discord_id, guild_id) REFERENCES user_guild_accounts(discord_id, guild_id) ON DELETE CASCADE
+);
+
+-- Progress towards achievements that cannot be derived from user_stats, such as the current win streak
+CREATE TABLE achievement_progress (
+    discord_id BIGINT NOT NULL,
+    guild_id BIGINT NOT NULL,
+    current_win_streak INTEGER NOT NULL DEFAULT 0 CHECK (current_win_streak >= 0),
+    best_win_streak INTEGER NOT NULL DEFAULT 0 CHECK (best_win_streak >= 0),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (discord_id, guild_id),
+    FOREIGN KEY (discord_id, guild_id) REFERENCES user_guild_accounts(discord_id, guild_id) ON DELETE CASCADE
+);
@@ -0,0 +1,3 @@
+-- Voting period pre-filled when creating a group wager, in minutes (NULL = 24 hours)
+ALTER TABLE guild_settings
+ADD COLUMN default_voting_period_minutes INTEGER CHECK (default_voting_period_minutes BETWEEN 5 AND 10080);
@@ -0,0 +1,3 @@
+ALTER TABLE group_wagers
+DROP COLUMN IF EXISTS image_url,
+DROP COLUMN IF EXISTS thumbnail_url;
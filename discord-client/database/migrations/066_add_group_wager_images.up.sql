@@ -0,0 +1,4 @@
+-- Images shown on house wager embeds, such as the queue icon and the champion played
+ALTER TABLE group_wagers
+ADD COLUMN thumbnail_url TEXT,
+ADD COLUMN image_url TEXT;
@@ -0,0 +1,9 @@
+-- Per-guild overrides of each feature's default on/off state
+CREATE TABLE guild_feature_flags (
+    guild_id BIGINT NOT NULL,
+    feature VARCHAR(32) NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    updated_by BIGINT,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (guild_id, feature)
+);
@@ -0,0 +1,8 @@
+-- Users following a group wager to be notified of its progress without betting
+CREATE TABLE group_wager_followers (
+    group_wager_id BIGINT NOT NULL REFERENCES group_wagers(id) ON DELETE CASCADE,
+    guild_id BIGINT NOT NULL,
+    discord_id BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (group_wager_id, discord_id)
+);
@@ -0,0 +1,15 @@
+-- Percent of each resolved group wager's pot added to the current lottery draw (NULL = no pot tax)
+ALTER TABLE guild_settings
+ADD COLUMN pot_tax_percent INTEGER CHECK (pot_tax_percent BETWEEN 1 AND 10);
+
+-- Portion of a draw's pot funded by group wager pot taxes
+ALTER TABLE lottery_draws
+ADD COLUMN tax_pot BIGINT NOT NULL DEFAULT 0;
+
+-- Add pot tax transaction type to balance_history constraint
+ALTER TABLE balance_history
+DROP CONSTRAINT balance_history_transaction_type_check;
+
+ALTER TABLE balance_history
+ADD CONSTRAINT balance_history_transaction_type_check
+CHECK (transaction_type IN ('bet_win', 'bet_loss', 'transfer_in', 'transfer_out', 'initial', 'wager_win', 'wager_loss', 'group_wager_win', 'group_wager_loss', 'wordle_reward', 'high_roller_purchase', 'lotto_ticket', 'lotto_win', 'savings_bonus', 'parlay_win', 'parlay_loss', 'duel_win', 'duel_loss', 'lotto_consolation', 'lotto_pot_tax'));
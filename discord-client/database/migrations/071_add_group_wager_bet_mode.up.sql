@@ -0,0 +1,3 @@
+-- How bettors choose an option on group wager messages (NULL = one button per option)
+ALTER TABLE guild_settings
+ADD COLUMN group_wager_bet_mode VARCHAR(16) CHECK (group_wager_bet_mode IN ('buttons', 'menu'));
@@ -0,0 +1,5 @@
+ALTER TABLE user_preferences
+DROP COLUMN IF EXISTS hide_from_global_leaderboard;
+
+ALTER TABLE guild_settings
+DROP COLUMN IF EXISTS share_global_leaderboard;
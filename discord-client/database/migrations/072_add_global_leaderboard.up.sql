@@ -0,0 +1,8 @@
+-- Guilds opt in to sharing their group wager predictions with the cross-guild leaderboard
+ALTER TABLE guild_settings
+ADD COLUMN share_global_leaderboard BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Users can keep themselves off the cross-guild leaderboard in every guild
+ALTER TABLE user_preferences
+ADD COLUMN hide_from_global_leaderboard BOOLEAN NOT NULL DEFAULT FALSE;
+
@@ -0,0 +1,11 @@
+-- Point-in-time copies of a guild's economy that operators can roll the guild back to after incidents
+CREATE TABLE guild_snapshots (
+    id BIGSERIAL PRIMARY KEY,
+    guild_id BIGINT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    data JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Index for listing a guild's recent snapshots
+CREATE INDEX idx_guild_snapshots_guild ON guild_snapshots(guild_id, created_at DESC);
@@ -0,0 +1,14 @@
+-- Audit trail of participants changing the option or amount of their bet on a group wager
+CREATE TABLE group_wager_bet_changes (
+    id BIGSERIAL PRIMARY KEY,
+    group_wager_id BIGINT NOT NULL REFERENCES group_wagers(id) ON DELETE CASCADE,
+    discord_id BIGINT NOT NULL,
+    previous_option_id BIGINT NOT NULL,
+    previous_amount BIGINT NOT NULL,
+    new_option_id BIGINT NOT NULL,
+    new_amount BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Index for reading a wager's or participant's changes in order
+CREATE INDEX idx_group_wager_bet_changes_wager ON group_wager_bet_changes(group_wager_id, discord_id, id);
@@ -0,0 +1,3 @@
+-- Smallest bet, wager proposal or lottery purchase a guild accepts (NULL = no minimum)
+ALTER TABLE guild_settings
+ADD COLUMN min_bet_amount BIGINT CHECK (min_bet_amount > 0);
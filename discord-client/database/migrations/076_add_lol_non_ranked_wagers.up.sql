@@ -0,0 +1,3 @@
+-- Guilds opt in to house wagers on ARAM, normal and custom LoL games
+ALTER TABLE guild_settings
+ADD COLUMN lol_non_ranked_wagers BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_group_wagers_reaction_message;
+
+ALTER TABLE group_wagers
+DROP COLUMN IF EXISTS reaction_stake,
+DROP COLUMN IF EXISTS reaction_channel_id,
+DROP COLUMN IF EXISTS reaction_message_id;
@@ -0,0 +1,7 @@
+-- Reaction wagers take bets from emoji reactions on an existing Discord message
+ALTER TABLE group_wagers
+ADD COLUMN reaction_message_id BIGINT,
+ADD COLUMN reaction_channel_id BIGINT,
+ADD COLUMN reaction_stake BIGINT CHECK (reaction_stake > 0);
+
+CREATE INDEX idx_group_wagers_reaction_message ON group_wagers(reaction_message_id) WHERE reaction_message_id IS NOT NULL;
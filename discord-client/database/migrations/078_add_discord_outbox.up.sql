@@ -0,0 +1,27 @@
+-- Discord actions written in the same transaction as the change they announce and sent by a dispatcher after commit,
+-- retried with backoff until sent or out of attempts
+CREATE TABLE discord_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    guild_id BIGINT NOT NULL,
+    action VARCHAR(16) NOT NULL CHECK (action IN ('post', 'edit', 'pin')),
+    kind VARCHAR(32) NOT NULL DEFAULT '',
+    channel_id BIGINT NOT NULL,
+    message_id BIGINT,
+    group_wager_id BIGINT REFERENCES group_wagers(id) ON DELETE CASCADE,
+    payload JSONB,
+    dedup_key VARCHAR(100),
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMP
+);
+
+-- Index for the dispatcher finding actions due to be sent
+CREATE INDEX idx_discord_outbox_due ON discord_outbox(next_attempt_at)
+    WHERE status = 'pending';
+
+-- Only one pending action per dedup key, so repeated edits of a message collapse into the latest
+CREATE UNIQUE INDEX idx_discord_outbox_pending_dedup ON discord_outbox(guild_id, dedup_key)
+    WHERE status = 'pending' AND dedup_key IS NOT NULL;
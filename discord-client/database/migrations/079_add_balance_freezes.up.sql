@@ -0,0 +1,22 @@
+-- Moderator freezes set via /freeze that block a user's balance-affecting actions in a guild.
+-- Ended freezes are kept as the freeze log: unfrozen_by_discord_id is NULL when the freeze expired on its own.
+CREATE TABLE balance_freezes (
+    id BIGSERIAL PRIMARY KEY,
+    guild_id BIGINT NOT NULL,
+    discord_id BIGINT NOT NULL,
+    moderator_discord_id BIGINT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    unfrozen_at TIMESTAMP,
+    unfrozen_by_discord_id BIGINT,
+    FOREIGN KEY (discord_id, guild_id) REFERENCES user_guild_accounts(discord_id, guild_id) ON DELETE CASCADE
+);
+
+-- Only one open freeze per user in a guild
+CREATE UNIQUE INDEX idx_balance_freezes_open ON balance_freezes(guild_id, discord_id)
+    WHERE unfrozen_at IS NULL;
+
+-- Index for the expiry worker finding timed freezes that have run out
+CREATE INDEX idx_balance_freezes_expiring ON balance_freezes(expires_at)
+    WHERE unfrozen_at IS NULL AND expires_at IS NOT NULL;
@@ -0,0 +1,14 @@
+-- Watched summoners whose game a house wager is on. Several watched summoners in one game,
+-- such as a Clash team, share a single wager settled by their team's result.
+CREATE TABLE house_wager_players (
+    id BIGSERIAL PRIMARY KEY,
+    group_wager_id BIGINT NOT NULL REFERENCES group_wagers(id) ON DELETE CASCADE,
+    summoner_name VARCHAR(255) NOT NULL,
+    tag_line VARCHAR(255) NOT NULL,
+    won BOOLEAN, -- NULL until the player's game result arrives
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- A summoner joins a wager once, however their name is cased
+CREATE UNIQUE INDEX idx_house_wager_players_summoner
+ON house_wager_players(group_wager_id, LOWER(summoner_name), LOWER(tag_line));
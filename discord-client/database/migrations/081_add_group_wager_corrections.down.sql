@@ -0,0 +1,15 @@
+-- Remove resolution correction transaction types from balance_history constraint
+ALTER TABLE balance_history
+DROP CONSTRAINT balance_history_transaction_type_check;
+
+ALTER TABLE balance_history
+ADD CONSTRAINT balance_history_transaction_type_check
+CHECK (transaction_type IN ('bet_win', 'bet_loss', 'transfer_in', 'transfer_out', 'initial', 'wager_win', 'wager_loss', 'group_wager_win', 'group_wager_loss', 'wordle_reward', 'high_roller_purchase', 'lotto_ticket', 'lotto_win', 'savings_bonus', 'parlay_win', 'parlay_loss', 'duel_win', 'duel_loss', 'lotto_consolation', 'lotto_pot_tax'));
+
+DELETE FROM group_wager_events WHERE event_type = 'resolution_corrected';
+
+ALTER TABLE group_wager_events DROP CONSTRAINT group_wager_events_event_type_check;
+ALTER TABLE group_wager_events ADD CONSTRAINT group_wager_events_event_type_check CHECK (event_type IN (
+    'created', 'bet_placed', 'bet_changed', 'bet_withdrawn',
+    'odds_updated', 'resolved', 'cancelled', 'restored', 'voting_extended'
+));
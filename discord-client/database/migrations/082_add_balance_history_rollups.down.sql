@@ -0,0 +1,25 @@
+-- Restore archived entries so the ledger links can be enforced again
+INSERT INTO balance_history (id, discord_id, guild_id, balance_before, balance_after, change_amount,
+                             transaction_type, transaction_metadata, related_id, related_type,
+                             reversal_of_id, reversed_by_id, created_at)
+SELECT id, discord_id, guild_id, balance_before, balance_after, change_amount,
+       transaction_type, transaction_metadata, related_id, related_type,
+       reversal_of_id, reversed_by_id, created_at
+FROM balance_history_archive;
+
+ALTER TABLE balance_history ADD CONSTRAINT balance_history_reversal_of_id_fkey
+    FOREIGN KEY (reversal_of_id) REFERENCES balance_history(id);
+ALTER TABLE balance_history ADD CONSTRAINT balance_history_reversed_by_id_fkey
+    FOREIGN KEY (reversed_by_id) REFERENCES balance_history(id);
+ALTER TABLE group_wager_participants ADD CONSTRAINT group_wager_participants_balance_history_id_fkey
+    FOREIGN KEY (balance_history_id) REFERENCES balance_history(id);
+ALTER TABLE wagers ADD CONSTRAINT wagers_loser_balance_history_id_fkey
+    FOREIGN KEY (loser_balance_history_id) REFERENCES balance_history(id);
+ALTER TABLE wagers ADD CONSTRAINT wagers_winner_balance_history_id_fkey
+    FOREIGN KEY (winner_balance_history_id) REFERENCES balance_history(id);
+ALTER TABLE bets ADD CONSTRAINT bets_balance_history_id_fkey
+    FOREIGN KEY (balance_history_id) REFERENCES balance_history(id);
+
+DROP TABLE IF EXISTS balance_history_archive;
+DROP TABLE IF EXISTS balance_history_rollup_state;
+DROP TABLE IF EXISTS balance_history_daily_rollups;
@@ -0,0 +1,50 @@
+-- Daily per-user aggregates of balance_history so stats don't scan a guild's whole ledger.
+-- Group wager entries are split by the wager's external system ('' for everything else) so stats
+-- filtered to a system can still be answered from rollups.
+CREATE TABLE balance_history_daily_rollups (
+    guild_id BIGINT NOT NULL,
+    discord_id BIGINT NOT NULL,
+    day DATE NOT NULL,
+    external_system VARCHAR(50) NOT NULL DEFAULT '',
+    entries INTEGER NOT NULL,
+    wins INTEGER NOT NULL,
+    losses INTEGER NOT NULL,
+    net_profit BIGINT NOT NULL,
+    -- First and last entries of the day order opening and closing balances across systems
+    first_entry_at TIMESTAMPTZ NOT NULL,
+    first_entry_id BIGINT NOT NULL,
+    opening_balance BIGINT NOT NULL,
+    last_entry_at TIMESTAMPTZ NOT NULL,
+    last_entry_id BIGINT NOT NULL,
+    closing_balance BIGINT NOT NULL,
+    PRIMARY KEY (guild_id, discord_id, day, external_system)
+);
+
+-- Single row tracking how far the rollup job has got. Days before rolled_up_through are complete
+-- in the rollups table; later days are read from balance_history.
+CREATE TABLE balance_history_rollup_state (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    rolled_up_through DATE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO balance_history_rollup_state (id) VALUES (TRUE);
+
+-- Raw entries moved out of balance_history once they are past the retention period
+CREATE TABLE balance_history_archive (
+    LIKE balance_history,
+    archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_balance_history_archive_discord_guild_created
+    ON balance_history_archive(discord_id, guild_id, created_at DESC);
+
+-- Bets, wagers, group wager payouts and reversals keep pointing at their ledger entry after it is
+-- archived, so those links can no longer be enforced against balance_history alone
+ALTER TABLE bets DROP CONSTRAINT IF EXISTS bets_balance_history_id_fkey;
+ALTER TABLE wagers DROP CONSTRAINT IF EXISTS wagers_winner_balance_history_id_fkey;
+ALTER TABLE wagers DROP CONSTRAINT IF EXISTS wagers_loser_balance_history_id_fkey;
+ALTER TABLE group_wager_participants DROP CONSTRAINT IF EXISTS group_wager_participants_balance_history_id_fkey;
+ALTER TABLE balance_history DROP CONSTRAINT IF EXISTS balance_history_reversal_of_id_fkey;
+ALTER TABLE balance_history DROP CONSTRAINT IF EXISTS balance_history_reversed_by_id_fkey;
@@ -0,0 +1,2 @@
+-- Remove the betting curfew timezone from guild_settings table
+ALTER TABLE guild_settings DROP COLUMN IF EXISTS curfew_timezone;
@@ -0,0 +1,3 @@
+-- Let guilds set their betting curfew (quiet hours) in their own timezone instead of UTC
+ALTER TABLE guild_settings
+ADD COLUMN curfew_timezone TEXT;
@@ -0,0 +1,5 @@
+-- Remove resolver assignment from group_wagers and guild_settings tables
+DROP INDEX IF EXISTS idx_group_wagers_resolver_assigned_at;
+ALTER TABLE group_wagers DROP COLUMN IF EXISTS resolver_assigned_at;
+ALTER TABLE group_wagers DROP COLUMN IF EXISTS assigned_resolver_discord_id;
+ALTER TABLE guild_settings DROP COLUMN IF EXISTS auto_assign_resolvers;
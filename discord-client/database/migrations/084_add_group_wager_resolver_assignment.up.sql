@@ -0,0 +1,12 @@
+-- Let guilds hand each wager awaiting resolution to their resolvers in turn
+ALTER TABLE guild_settings
+ADD COLUMN auto_assign_resolvers BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Track which resolver a wager awaiting resolution was handed to
+ALTER TABLE group_wagers
+ADD COLUMN assigned_resolver_discord_id BIGINT,
+ADD COLUMN resolver_assigned_at TIMESTAMP;
+
+-- Index for finding the guild's most recent assignment to continue the rotation from
+CREATE INDEX idx_group_wagers_resolver_assigned_at ON group_wagers(guild_id, resolver_assigned_at DESC)
+    WHERE resolver_assigned_at IS NOT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE group_wager_participants
+ALTER COLUMN locked_multiplier TYPE DECIMAL(10,2);
@@ -0,0 +1,3 @@
+-- Keep locked odds at full precision, so a topped-up bet's stake-weighted odds settle exactly as previewed
+ALTER TABLE group_wager_participants
+ALTER COLUMN locked_multiplier TYPE DOUBLE PRECISION;
@@ -0,0 +1,143 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	log "github.com/sirupsen/logrus"
+)
+
+// explainTimeout bounds how long capturing a slow query's plan may take
+const explainTimeout = 5 * time.Second
+
+// SlowQueryLog configures logging of queries that exceed a duration threshold
+type SlowQueryLog struct {
+	Threshold time.Duration // Queries taking at least this long are logged, zero disables logging
+	Explain   bool          // Also capture and log the query plan of slow queries
+}
+
+// ConnectionOption configures a connection pool created by NewConnection
+type ConnectionOption func(*connectionOptions)
+
+// connectionOptions holds the options applied to every pool, including a read replica's
+type connectionOptions struct {
+	slowQueryLog SlowQueryLog
+}
+
+// WithSlowQueryLog logs queries slower than the configured threshold, optionally with their plan
+func WithSlowQueryLog(slowQueryLog SlowQueryLog) ConnectionOption {
+	return func(o *connectionOptions) {
+		o.slowQueryLog = slowQueryLog
+	}
+}
+
+// slowQueryStartKey stores the query being traced in its context
+type slowQueryStartKey struct{}
+
+// slowQueryStart is the query captured when it starts
+type slowQueryStart struct {
+	sql     string
+	args    []any
+	started time.Time
+}
+
+// slowQueryTracer logs queries exceeding the threshold, implementing pgx.QueryTracer
+type slowQueryTracer struct {
+	config SlowQueryLog
+	pool   *pgxpool.Pool // Plans are captured on a separate connection, set once the pool is created
+	now    func() time.Time
+}
+
+// newSlowQueryTracer creates a tracer for the configured slow query log
+func newSlowQueryTracer(config SlowQueryLog) *slowQueryTracer {
+	return &slowQueryTracer{config: config, now: time.Now}
+}
+
+// TraceQueryStart records when the query started
+func (t *slowQueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, slowQueryStartKey{}, slowQueryStart{
+		sql:     data.SQL,
+		args:    data.Args,
+		started: t.now(),
+	})
+}
+
+// TraceQueryEnd logs the query if it took at least the threshold
+func (t *slowQueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(slowQueryStartKey{}).(slowQueryStart)
+	if !ok {
+		return
+	}
+
+	duration := t.now().Sub(start.started)
+	if duration < t.config.Threshold {
+		return
+	}
+
+	fields := log.Fields{
+		"sql":         compactSQL(start.sql),
+		"args":        redactArgs(start.args),
+		"duration_ms": duration.Milliseconds(),
+		"rows":        data.CommandTag.RowsAffected(),
+	}
+	if data.Err != nil {
+		fields["error"] = data.Err.Error()
+	}
+	log.WithFields(fields).Warn("Slow database query")
+
+	// Plans are captured in the background so the caller isn't slowed down further
+	if t.config.Explain && t.pool != nil && data.Err == nil && isExplainable(start.sql) {
+		go t.logPlan(start, fields)
+	}
+}
+
+// logPlan runs EXPLAIN for a slow query and logs the plan alongside the query
+func (t *slowQueryTracer) logPlan(start slowQueryStart, fields log.Fields) {
+	ctx, cancel := context.WithTimeout(context.Background(), explainTimeout)
+	defer cancel()
+
+	rows, err := t.pool.Query(ctx, "EXPLAIN "+start.sql, start.args...)
+	if err != nil {
+		log.WithFields(fields).WithError(err).Debug("Failed to capture slow query plan")
+		return
+	}
+	lines, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		log.WithFields(fields).WithError(err).Debug("Failed to capture slow query plan")
+		return
+	}
+
+	log.WithFields(fields).WithField("plan", strings.Join(lines, "\n")).Warn("Slow database query plan")
+}
+
+// isExplainable reports whether EXPLAIN can describe the statement without running it
+func isExplainable(sql string) bool {
+	words := strings.Fields(sql)
+	if len(words) == 0 {
+		return false
+	}
+	switch strings.ToUpper(words[0]) {
+	case "SELECT", "WITH", "INSERT", "UPDATE", "DELETE":
+		return true
+	default:
+		return false
+	}
+}
+
+// redactArgs replaces query arguments with their types so logs never hold user data
+func redactArgs(args []any) []string {
+	redacted := make([]string, len(args))
+	for i, arg := range args {
+		redacted[i] = fmt.Sprintf("$%d=%T", i+1, arg)
+	}
+	return redacted
+}
+
+// compactSQL collapses whitespace so multi-line queries log on one line
+func compactSQL(sql string) string {
+	return strings.Join(strings.Fields(sql), " ")
+}
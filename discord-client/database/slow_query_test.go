@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlowQueryTracer(t *testing.T) {
+	hook := test.NewGlobal()
+	t.Cleanup(func() { log.StandardLogger().ReplaceHooks(make(log.LevelHooks)) })
+
+	trace := func(tracer *slowQueryTracer, duration time.Duration) {
+		started := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		tracer.now = func() time.Time { return started }
+		ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{
+			SQL:  "SELECT *\n\tFROM group_wagers\n\tWHERE guild_id = $1 AND state = $2",
+			Args: []any{int64(42), "active"},
+		})
+		tracer.now = func() time.Time { return started.Add(duration) }
+		tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 3")})
+	}
+
+	t.Run("fast query is not logged", func(t *testing.T) {
+		hook.Reset()
+
+		trace(newSlowQueryTracer(SlowQueryLog{Threshold: time.Second}), 999*time.Millisecond)
+
+		assert.Empty(t, hook.AllEntries())
+	})
+
+	t.Run("slow query is logged with redacted args", func(t *testing.T) {
+		hook.Reset()
+
+		trace(newSlowQueryTracer(SlowQueryLog{Threshold: time.Second}), 1500*time.Millisecond)
+
+		entry := hook.LastEntry()
+		require.NotNil(t, entry)
+		assert.Equal(t, log.WarnLevel, entry.Level)
+		assert.Equal(t, "SELECT * FROM group_wagers WHERE guild_id = $1 AND state = $2", entry.Data["sql"])
+		assert.Equal(t, []string{"$1=int64", "$2=string"}, entry.Data["args"])
+		assert.Equal(t, int64(1500), entry.Data["duration_ms"])
+		assert.Equal(t, int64(3), entry.Data["rows"])
+	})
+}
+
+func TestIsExplainable(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isExplainable("  select 1"))
+	assert.True(t, isExplainable("WITH totals AS (SELECT 1) SELECT * FROM totals"))
+	assert.True(t, isExplainable("UPDATE users SET balance = $1"))
+	assert.True(t, isExplainable("\n\t\tSELECT\n\t\t\t*\n\t\tFROM users"))
+	assert.False(t, isExplainable("EXPLAIN SELECT 1"))
+	assert.False(t, isExplainable("BEGIN"))
+	assert.False(t, isExplainable("LISTEN state_changes"))
+}
@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"strings"
+	"time"
+)
+
+// AchievementKey identifies an achievement
+type AchievementKey string
+
+const (
+	AchievementFirstWin      AchievementKey = "first_win"
+	AchievementWinStreak10   AchievementKey = "win_streak_10"
+	AchievementBitsWagered1M AchievementKey = "bits_wagered_1m"
+	AchievementLotteryWinner AchievementKey = "lottery_winner"
+)
+
+// Achievement thresholds
+const (
+	AchievementWinStreakLength = 10        // Consecutive wins needed for AchievementWinStreak10
+	AchievementWageredAmount   = 1_000_000 // Bits wagered needed for AchievementBitsWagered1M
+)
+
+// AchievementDefinition describes an achievement and the badge shown once it is unlocked
+type AchievementDefinition struct {
+	Key         AchievementKey
+	Name        string
+	Description string
+	Badge       string // Emoji shown on stats and scoreboard embeds
+}
+
+// AchievementDefinitions lists every achievement in display order
+var AchievementDefinitions = []AchievementDefinition{
+	{AchievementFirstWin, "First Blood", "Win your first bet or wager", "🩸"},
+	{AchievementWinStreak10, "On Fire", "Win 10 bets or wagers in a row", "🔥"},
+	{AchievementBitsWagered1M, "Whale", "Wager 1M bits in total", "🐋"},
+	{AchievementLotteryWinner, "Jackpot", "Win the lottery", "🎰"},
+}
+
+// Definition returns the achievement's definition, false if the key is unknown
+func (k AchievementKey) Definition() (AchievementDefinition, bool) {
+	for _, def := range AchievementDefinitions {
+		if def.Key == k {
+			return def, true
+		}
+	}
+	return AchievementDefinition{}, false
+}
+
+// Achievement is an achievement a user has unlocked in a guild
+type Achievement struct {
+	DiscordID  int64
+	GuildID    int64
+	Key        AchievementKey
+	UnlockedAt time.Time
+}
+
+// Badge returns the achievement's badge emoji, empty if the key is unknown
+func (a *Achievement) Badge() string {
+	def, _ := a.Key.Definition()
+	return def.Badge
+}
+
+// AchievementProgress tracks progress towards achievements that cannot be derived from user stats
+type AchievementProgress struct {
+	DiscordID        int64
+	GuildID          int64
+	CurrentWinStreak int
+	BestWinStreak    int
+}
+
+// FormatBadges joins the badges of the given achievements in definition order, so badges read
+// the same on every embed regardless of unlock order
+func FormatBadges(achievements []*Achievement) string {
+	unlocked := make(map[AchievementKey]bool, len(achievements))
+	for _, achievement := range achievements {
+		unlocked[achievement.Key] = true
+	}
+
+	var badges []string
+	for _, def := range AchievementDefinitions {
+		if unlocked[def.Key] {
+			badges = append(badges, def.Badge)
+		}
+	}
+	return strings.Join(badges, " ")
+}
@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatBadges(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, FormatBadges(nil))
+
+	// Badges follow definition order, not unlock order, and unknown keys are skipped
+	achievements := []*Achievement{
+		{Key: AchievementLotteryWinner},
+		{Key: "retired"},
+		{Key: AchievementFirstWin},
+	}
+	assert.Equal(t, "🩸 🎰", FormatBadges(achievements))
+}
+
+func TestAchievementKey_Definition(t *testing.T) {
+	t.Parallel()
+
+	def, ok := AchievementWinStreak10.Definition()
+	assert.True(t, ok)
+	assert.Equal(t, "🔥", def.Badge)
+
+	_, ok = AchievementKey("retired").Definition()
+	assert.False(t, ok)
+}
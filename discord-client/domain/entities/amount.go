@@ -0,0 +1,89 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrAmountNotAffordable is returned when a share of the available balance comes to less than what's needed
+var ErrAmountNotAffordable = errors.New("not enough available balance")
+
+// Amount is an amount typed into a bet or purchase modal: either a fixed value or a percentage of the
+// user's available balance. Relative amounts are resolved inside the service transaction that spends
+// them, so the balance they are taken from can't change underneath them.
+type Amount struct {
+	Value   int64 // Fixed amount, used when Percent is 0
+	Percent int64 // Share of the available balance, 1-100
+}
+
+// FixedAmount returns an Amount of exactly value
+func FixedAmount(value int64) Amount {
+	return Amount{Value: value}
+}
+
+// ParseAmount parses a modal amount: a positive number, "all", "half", or a percentage like "25%"
+func ParseAmount(input string) (Amount, error) {
+	input = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(input), ",", ""))
+
+	switch input {
+	case "all", "all-in", "allin", "max":
+		return Amount{Percent: 100}, nil
+	case "half":
+		return Amount{Percent: 50}, nil
+	}
+
+	if pct, ok := strings.CutSuffix(input, "%"); ok {
+		percent, err := strconv.ParseInt(strings.TrimSpace(pct), 10, 64)
+		if err != nil || percent < 1 || percent > 100 {
+			return Amount{}, fmt.Errorf("percentages must be a whole number from 1%% to 100%%")
+		}
+		return Amount{Percent: percent}, nil
+	}
+
+	value, err := strconv.ParseInt(input, 10, 64)
+	if err != nil || value <= 0 {
+		return Amount{}, fmt.Errorf("enter a positive number, all, half, or a percentage like 25%%")
+	}
+	return Amount{Value: value}, nil
+}
+
+// IsRelative reports whether the amount depends on the user's available balance
+func (a Amount) IsRelative() bool {
+	return a.Percent > 0
+}
+
+// Resolve returns the amount in bits, taking relative amounts from the given available balance
+func (a Amount) Resolve(available int64) (int64, error) {
+	if !a.IsRelative() {
+		return a.Value, nil
+	}
+	if available <= 0 {
+		return 0, fmt.Errorf("%w: you have no bits available", ErrAmountNotAffordable)
+	}
+
+	bits := available * a.Percent / 100
+	if bits <= 0 {
+		return 0, fmt.Errorf("%w: %d%% of your available balance is less than 1 bit", ErrAmountNotAffordable, a.Percent)
+	}
+	return bits, nil
+}
+
+// ResolveUnits returns how many units costing unitCost the amount buys. Fixed amounts are already a
+// count of units, while relative amounts buy as many units as fit in their share of the balance.
+func (a Amount) ResolveUnits(available, unitCost int64) (int64, error) {
+	if !a.IsRelative() {
+		return a.Value, nil
+	}
+
+	bits, err := a.Resolve(available)
+	if err != nil {
+		return 0, err
+	}
+	units := bits / unitCost
+	if units <= 0 {
+		return 0, fmt.Errorf("%w: %d%% of your available balance (%d bits) doesn't cover one at %d bits", ErrAmountNotAffordable, a.Percent, bits, unitCost)
+	}
+	return units, nil
+}
@@ -0,0 +1,76 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Amount
+		wantErr bool
+	}{
+		{input: "1000", want: Amount{Value: 1000}},
+		{input: " 1,500 ", want: Amount{Value: 1500}},
+		{input: "all", want: Amount{Percent: 100}},
+		{input: "ALL", want: Amount{Percent: 100}},
+		{input: "half", want: Amount{Percent: 50}},
+		{input: "25%", want: Amount{Percent: 25}},
+		{input: "100%", want: Amount{Percent: 100}},
+		{input: "0", wantErr: true},
+		{input: "-5", wantErr: true},
+		{input: "0%", wantErr: true},
+		{input: "150%", wantErr: true},
+		{input: "12.5%", wantErr: true},
+		{input: "lots", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseAmount(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAmount_Resolve(t *testing.T) {
+	bits, err := FixedAmount(700).Resolve(500)
+	require.NoError(t, err)
+	assert.Equal(t, int64(700), bits, "fixed amounts are left for the service's balance check")
+
+	bits, err = Amount{Percent: 100}.Resolve(12345)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12345), bits)
+
+	bits, err = Amount{Percent: 25}.Resolve(1001)
+	require.NoError(t, err)
+	assert.Equal(t, int64(250), bits, "relative amounts round down so they never exceed the balance")
+
+	_, err = Amount{Percent: 50}.Resolve(0)
+	assert.ErrorIs(t, err, ErrAmountNotAffordable)
+
+	_, err = Amount{Percent: 1}.Resolve(50)
+	assert.Error(t, err)
+}
+
+func TestAmount_ResolveUnits(t *testing.T) {
+	units, err := FixedAmount(3).ResolveUnits(0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), units)
+
+	units, err = Amount{Percent: 100}.ResolveUnits(1050, 100)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), units)
+
+	_, err = Amount{Percent: 50}.ResolveUnits(150, 100)
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Balance freeze limits
+const (
+	MaxBalanceFreezeReasonLength = 200
+	MaxBalanceFreezeDuration     = 365 * 24 * time.Hour
+)
+
+// ErrBalanceFrozen is returned when a user whose balance a moderator froze attempts a balance-affecting action
+var ErrBalanceFrozen = errors.New("balance is frozen by a moderator")
+
+// ErrBalanceNotFrozen is returned when unfreezing a user who has no open freeze
+var ErrBalanceNotFrozen = errors.New("balance is not frozen")
+
+// BalanceFreeze blocks bets, transfers, lottery purchases and other balance-affecting actions for a user in a guild.
+// A freeze without ExpiresAt lasts until a moderator lifts it. Ended freezes are kept as the freeze log.
+type BalanceFreeze struct {
+	ID                  int64      `db:"id"`
+	GuildID             int64      `db:"guild_id"`
+	DiscordID           int64      `db:"discord_id"`
+	ModeratorDiscordID  int64      `db:"moderator_discord_id"`
+	Reason              string     `db:"reason"`
+	ExpiresAt           *time.Time `db:"expires_at"`
+	CreatedAt           time.Time  `db:"created_at"`
+	UnfrozenAt          *time.Time `db:"unfrozen_at"`
+	UnfrozenByDiscordID *int64     `db:"unfrozen_by_discord_id"` // Nil when the freeze expired on its own
+}
+
+// IsActive checks if the freeze has not been lifted and has not expired
+func (f *BalanceFreeze) IsActive(now time.Time) bool {
+	return f.UnfrozenAt == nil && (f.ExpiresAt == nil || now.Before(*f.ExpiresAt))
+}
+
+// HasExpired checks if a timed freeze has run out without being lifted yet
+func (f *BalanceFreeze) HasExpired(now time.Time) bool {
+	return f.UnfrozenAt == nil && f.ExpiresAt != nil && !now.Before(*f.ExpiresAt)
+}
+
+// Lift ends the freeze. A nil moderator records that the freeze expired on its own.
+func (f *BalanceFreeze) Lift(moderatorDiscordID *int64, now time.Time) {
+	f.UnfrozenAt = &now
+	f.UnfrozenByDiscordID = moderatorDiscordID
+}
+
+// Err returns ErrBalanceFrozen wrapped with when the freeze ends and why it was set
+func (f *BalanceFreeze) Err() error {
+	until := "until a moderator lifts it"
+	if f.ExpiresAt != nil {
+		until = "until " + f.ExpiresAt.UTC().Format("Jan 2, 2006 15:04 UTC")
+	}
+	if f.Reason == "" {
+		return fmt.Errorf("%w %s", ErrBalanceFrozen, until)
+	}
+	return fmt.Errorf("%w %s: %s", ErrBalanceFrozen, until, f.Reason)
+}
@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalanceFreeze_IsActive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Hour)
+	moderatorID := int64(999)
+
+	tests := []struct {
+		name        string
+		freeze      BalanceFreeze
+		wantActive  bool
+		wantExpired bool
+	}{
+		{name: "indefinite", freeze: BalanceFreeze{}, wantActive: true},
+		{name: "before expiry", freeze: BalanceFreeze{ExpiresAt: &later}, wantActive: true},
+		{name: "at expiry", freeze: BalanceFreeze{ExpiresAt: &now}, wantExpired: true},
+		{name: "past expiry", freeze: BalanceFreeze{ExpiresAt: &earlier}, wantExpired: true},
+		{name: "lifted", freeze: BalanceFreeze{ExpiresAt: &later, UnfrozenAt: &earlier, UnfrozenByDiscordID: &moderatorID}},
+		{name: "lifted after expiring", freeze: BalanceFreeze{ExpiresAt: &earlier, UnfrozenAt: &earlier}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.wantActive, tt.freeze.IsActive(now), tt.name)
+		assert.Equal(t, tt.wantExpired, tt.freeze.HasExpired(now), tt.name)
+	}
+}
+
+func TestBalanceFreeze_Err(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2024, 6, 2, 9, 30, 0, 0, time.UTC)
+
+	err := (&BalanceFreeze{ExpiresAt: &expiresAt, Reason: "alt account"}).Err()
+	assert.ErrorIs(t, err, ErrBalanceFrozen)
+	assert.Equal(t, "balance is frozen by a moderator until Jun 2, 2024 09:30 UTC: alt account", err.Error())
+
+	err = (&BalanceFreeze{}).Err()
+	assert.Equal(t, "balance is frozen by a moderator until a moderator lifts it", err.Error())
+}
+
+func TestUser_CheckBalanceFreeze(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	expired := now.Add(-time.Minute)
+
+	assert.NoError(t, (&User{}).CheckBalanceFreeze(now))
+	assert.NoError(t, (&User{BalanceFreeze: &BalanceFreeze{ExpiresAt: &expired}}).CheckBalanceFreeze(now), "expired freezes no longer block")
+	assert.ErrorIs(t, (&User{BalanceFreeze: &BalanceFreeze{}}).CheckBalanceFreeze(now), ErrBalanceFrozen)
+}
@@ -2,6 +2,8 @@ package entities
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -13,6 +15,8 @@ const (
 	RelatedTypeBet        RelatedType = "bet"
 	RelatedTypeWager      RelatedType = "wager"
 	RelatedTypeGroupWager RelatedType = "group_wager"
+	RelatedTypeParlay     RelatedType = "parlay"
+	RelatedTypeDuel       RelatedType = "duel"
 )
 
 // BalanceHistory represents a historical balance change
@@ -28,6 +32,53 @@ type BalanceHistory struct {
 	RelatedID           *int64                 `db:"related_id"`
 	RelatedType         *RelatedType           `db:"related_type"`
 	CreatedAt           time.Time              `db:"created_at"`
+	ReversalOfID        *int64                 `db:"reversal_of_id"` // Set on a compensating entry, the entry it reverses
+	ReversedByID        *int64                 `db:"reversed_by_id"` // Set on a reversed entry, its compensating entry
+}
+
+var (
+	// ErrBalanceHistoryNotFound is returned when a balance history entry does not exist in the guild
+	ErrBalanceHistoryNotFound = errors.New("balance history entry not found")
+	// ErrTransactionAlreadyReversed is returned when reversing an entry that already has a compensating entry
+	ErrTransactionAlreadyReversed = errors.New("transaction has already been reversed")
+	// ErrCannotReverseReversal is returned when reversing a compensating entry, which would reapply the original
+	ErrCannotReverseReversal = errors.New("cannot reverse a reversal")
+)
+
+// IsReversal returns true if this entry compensates another entry
+func (bh *BalanceHistory) IsReversal() bool {
+	return bh.ReversalOfID != nil
+}
+
+// IsReversed returns true if this entry has been undone by a compensating entry
+func (bh *BalanceHistory) IsReversed() bool {
+	return bh.ReversedByID != nil
+}
+
+// CanReverse checks if an admin may undo this entry with a compensating entry
+func (bh *BalanceHistory) CanReverse() error {
+	switch {
+	case bh.IsReversal():
+		return ErrCannotReverseReversal
+	case bh.IsReversed():
+		return ErrTransactionAlreadyReversed
+	case bh.ChangeAmount == 0:
+		return errors.New("transaction did not change the balance")
+	}
+	return nil
+}
+
+// MetadataInt64 reads an integer from the transaction metadata, which decodes JSON numbers as float64
+func (bh *BalanceHistory) MetadataInt64(key string) (int64, bool) {
+	switch value := bh.TransactionMetadata[key].(type) {
+	case int64:
+		return value, true
+	case int:
+		return int64(value), true
+	case float64:
+		return int64(value), true
+	}
+	return 0, false
 }
 
 // IsPositiveChange returns true if the change amount is positive
@@ -67,30 +118,7 @@ func (bh *BalanceHistory) IsSystemTransaction() bool {
 
 // GetTransactionDescription returns a human-readable description of the transaction
 func (bh *BalanceHistory) GetTransactionDescription() string {
-	switch bh.TransactionType {
-	case TransactionTypeBetWin:
-		return "Bet win"
-	case TransactionTypeBetLoss:
-		return "Bet loss"
-	case TransactionTypeWagerWin:
-		return "Wager win"
-	case TransactionTypeWagerLoss:
-		return "Wager loss"
-	case TransactionTypeGroupWagerWin:
-		return "Group wager win"
-	case TransactionTypeGroupWagerLoss:
-		return "Group wager loss"
-	case TransactionTypeTransferIn:
-		return "Transfer received"
-	case TransactionTypeTransferOut:
-		return "Transfer sent"
-	case TransactionTypeInitial:
-		return "Initial balance"
-	case TransactionTypeWordleReward:
-		return "Wordle reward"
-	default:
-		return string(bh.TransactionType)
-	}
+	return bh.TransactionType.DisplayName()
 }
 
 // ValidateTransaction performs basic validation on the transaction
@@ -104,4 +132,28 @@ func (bh *BalanceHistory) ValidateTransaction() error {
 	}
 	
 	return nil
-}
\ No newline at end of file
+}
+
+// BalanceHistoryWithContext is a balance history entry hydrated with the entity that caused it
+type BalanceHistoryWithContext struct {
+	BalanceHistory
+	WagerCondition      *string    // Set for wager wins and losses
+	GroupWagerCondition *string    // Set for group wager wins and losses
+	LotteryDrawTime     *time.Time // Set for lottery wins
+}
+
+// ContextDescription describes where the balance change came from, e.g. "on 'Will T1 win Worlds?'"
+func (h *BalanceHistoryWithContext) ContextDescription() string {
+	switch {
+	case h.GroupWagerCondition != nil:
+		return fmt.Sprintf("on '%s'", *h.GroupWagerCondition)
+	case h.WagerCondition != nil:
+		return fmt.Sprintf("on '%s'", *h.WagerCondition)
+	case h.LotteryDrawTime != nil:
+		return fmt.Sprintf("in the %s lottery", h.LotteryDrawTime.Format("Jan 2"))
+	case h.TransactionType == TransactionTypeBetWin || h.TransactionType == TransactionTypeBetLoss:
+		return "on a /gamble bet"
+	default:
+		return fmt.Sprintf("from a %s", strings.ToLower(h.GetTransactionDescription()))
+	}
+}
@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalanceHistoryWithContext_ContextDescription(t *testing.T) {
+	condition := "Will T1 win Worlds?"
+	drawTime := time.Date(2026, time.January, 2, 14, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		history  BalanceHistoryWithContext
+		expected string
+	}{
+		{
+			name: "group wager",
+			history: BalanceHistoryWithContext{
+				BalanceHistory:      BalanceHistory{TransactionType: TransactionTypeGroupWagerWin},
+				GroupWagerCondition: &condition,
+			},
+			expected: "on 'Will T1 win Worlds?'",
+		},
+		{
+			name: "wager",
+			history: BalanceHistoryWithContext{
+				BalanceHistory: BalanceHistory{TransactionType: TransactionTypeWagerLoss},
+				WagerCondition: &condition,
+			},
+			expected: "on 'Will T1 win Worlds?'",
+		},
+		{
+			name: "lottery",
+			history: BalanceHistoryWithContext{
+				BalanceHistory:  BalanceHistory{TransactionType: TransactionTypeLottoWin},
+				LotteryDrawTime: &drawTime,
+			},
+			expected: "in the Jan 2 lottery",
+		},
+		{
+			name:     "gamble bet",
+			history:  BalanceHistoryWithContext{BalanceHistory: BalanceHistory{TransactionType: TransactionTypeBetWin}},
+			expected: "on a /gamble bet",
+		},
+		{
+			name:     "unhydrated wager",
+			history:  BalanceHistoryWithContext{BalanceHistory: BalanceHistory{TransactionType: TransactionTypeWagerWin}},
+			expected: "from a wager win",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.history.ContextDescription())
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package entities
+
+import "time"
+
+const (
+	// BalanceHistoryRollupSettleDelay is how long after midnight UTC a day is left alone before it is rolled up,
+	// so transactions that started before midnight have committed their entries
+	BalanceHistoryRollupSettleDelay = time.Hour
+
+	// BalanceHistoryRollupMaxDays caps the days rolled up in one transaction, so catching up on a long
+	// history is split into several small passes
+	BalanceHistoryRollupMaxDays = 31
+)
+
+// BalanceHistoryRollupEnd returns the exclusive end of the days that can be rolled up at now: the start
+// of the current UTC day once it has settled, otherwise the start of the previous day
+func BalanceHistoryRollupEnd(now time.Time) time.Time {
+	return startOfUTCDay(now.Add(-BalanceHistoryRollupSettleDelay))
+}
+
+// NextBalanceHistoryRollup returns the days the next rollup pass covers. It starts at rolledUpThrough, or at
+// the day of the earliest entry on the first pass, and stops at end or after BalanceHistoryRollupMaxDays.
+// ok is false when there is nothing left to roll up.
+func NextBalanceHistoryRollup(rolledUpThrough, earliestEntry *time.Time, end time.Time) (from, to time.Time, ok bool) {
+	switch {
+	case rolledUpThrough != nil:
+		from = startOfUTCDay(*rolledUpThrough)
+	case earliestEntry != nil:
+		from = startOfUTCDay(*earliestEntry)
+	default:
+		// No history at all, so everything before end is trivially rolled up
+		from = end
+	}
+	if from.After(end) {
+		from = end
+	}
+
+	to = from.AddDate(0, 0, BalanceHistoryRollupMaxDays)
+	if to.After(end) {
+		to = end
+	}
+	return from, to, from.Before(end) || rolledUpThrough == nil
+}
+
+// BalanceHistoryArchiveCutoff returns the time before which raw entries may be archived: retentionMonths
+// before the start of now's day, but never past the days already rolled up. ok is false when archiving
+// is disabled or nothing has been rolled up yet.
+func BalanceHistoryArchiveCutoff(now time.Time, retentionMonths int, rolledUpThrough *time.Time) (time.Time, bool) {
+	if retentionMonths <= 0 || rolledUpThrough == nil {
+		return time.Time{}, false
+	}
+
+	cutoff := startOfUTCDay(now).AddDate(0, -retentionMonths, 0)
+	if through := startOfUTCDay(*rolledUpThrough); through.Before(cutoff) {
+		cutoff = through
+	}
+	return cutoff, true
+}
+
+// SplitStatsWindow splits the days from from up to to at rolledUpThrough. Days before the returned time
+// are read from rollups and the rest from raw balance history.
+func SplitStatsWindow(from, to time.Time, rolledUpThrough *time.Time) time.Time {
+	if rolledUpThrough == nil {
+		return from
+	}
+
+	split := startOfUTCDay(*rolledUpThrough)
+	if split.Before(from) {
+		return from
+	}
+	if split.After(to) {
+		return to
+	}
+	return split
+}
+
+// startOfUTCDay truncates t to midnight UTC
+func startOfUTCDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
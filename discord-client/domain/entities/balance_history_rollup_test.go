@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextBalanceHistoryRollup(t *testing.T) {
+	t.Parallel()
+
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	t.Run("caps a catch up pass", func(t *testing.T) {
+		t.Parallel()
+
+		earliest := time.Date(2023, 1, 5, 12, 0, 0, 0, time.UTC)
+		from, to, ok := NextBalanceHistoryRollup(nil, &earliest, day(10))
+
+		assert.True(t, ok)
+		assert.Equal(t, time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC), from)
+		assert.Equal(t, from.AddDate(0, 0, BalanceHistoryRollupMaxDays), to)
+	})
+
+	t.Run("caught up", func(t *testing.T) {
+		t.Parallel()
+
+		through := day(10)
+		_, _, ok := NextBalanceHistoryRollup(&through, nil, day(10))
+
+		assert.False(t, ok)
+	})
+
+	t.Run("no history still sets the watermark", func(t *testing.T) {
+		t.Parallel()
+
+		from, to, ok := NextBalanceHistoryRollup(nil, nil, day(10))
+
+		assert.True(t, ok)
+		assert.Equal(t, day(10), from)
+		assert.Equal(t, day(10), to)
+	})
+}
+
+func TestBalanceHistoryRollupEnd(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC), BalanceHistoryRollupEnd(time.Date(2024, 3, 10, 0, 30, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), BalanceHistoryRollupEnd(time.Date(2024, 3, 10, 1, 30, 0, 0, time.UTC)))
+}
+
+func TestSplitStatsWindow(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	at := func(d int) *time.Time {
+		t := time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	assert.Equal(t, from, SplitStatsWindow(from, to, nil), "nothing rolled up reads raw history")
+	assert.Equal(t, *at(20), SplitStatsWindow(from, to, at(20)))
+	assert.Equal(t, from, SplitStatsWindow(from, to, &time.Time{}), "watermark before the window")
+	later := time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, to, SplitStatsWindow(from, to, &later), "window fully rolled up")
+}
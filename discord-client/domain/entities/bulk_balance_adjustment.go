@@ -0,0 +1,151 @@
+package entities
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// MaxBulkBalanceAdjustments caps how many rows a single bulk adjustment applies
+const MaxBulkBalanceAdjustments = 1000
+
+// BalanceAdjustmentMode says whether a bulk adjustment row changes a balance by an amount or sets it
+type BalanceAdjustmentMode string
+
+const (
+	BalanceAdjustmentDelta    BalanceAdjustmentMode = "delta"
+	BalanceAdjustmentAbsolute BalanceAdjustmentMode = "absolute"
+)
+
+// BulkBalanceAdjustment is one row of a bulk balance adjustment
+type BulkBalanceAdjustment struct {
+	Line      int // Line of the CSV the row was read from, for error messages
+	DiscordID int64
+	Mode      BalanceAdjustmentMode
+	Amount    int64
+	Reason    string
+}
+
+// NewBalance returns the balance the row leaves a user with the given balance
+func (a BulkBalanceAdjustment) NewBalance(balance int64) int64 {
+	if a.Mode == BalanceAdjustmentAbsolute {
+		return a.Amount
+	}
+	return balance + a.Amount
+}
+
+// BulkBalanceAdjustmentResult is the outcome of one row of a bulk balance adjustment
+type BulkBalanceAdjustmentResult struct {
+	DiscordID     int64  `json:"discord_id,string"`
+	BalanceBefore int64  `json:"balance_before"`
+	BalanceAfter  int64  `json:"balance_after"`
+	ChangeAmount  int64  `json:"change_amount"`
+	Reason        string `json:"reason"`
+}
+
+// BulkBalanceAdjustmentReport summarizes a bulk balance adjustment, or what it would do in a dry run
+type BulkBalanceAdjustmentReport struct {
+	GuildID     int64                         `json:"guild_id,string"`
+	DryRun      bool                          `json:"dry_run"`
+	Results     []BulkBalanceAdjustmentResult `json:"results"`
+	TotalChange int64                         `json:"total_change"`
+}
+
+// ChangedCount returns how many rows changed a balance
+func (r *BulkBalanceAdjustmentReport) ChangedCount() int {
+	var changed int
+	for _, result := range r.Results {
+		if result.ChangeAmount != 0 {
+			changed++
+		}
+	}
+	return changed
+}
+
+// ParseBulkBalanceCSV reads bulk balance adjustment rows of discord_id,mode,amount,reason where mode is
+// delta or absolute. A header row and blank lines are skipped. Each user may only appear once, so the
+// outcome doesn't depend on row order.
+func ParseBulkBalanceCSV(r io.Reader) ([]BulkBalanceAdjustment, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var adjustments []BulkBalanceAdjustment
+	seen := make(map[int64]int)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		if len(record) > 0 && strings.EqualFold(strings.TrimSpace(record[0]), "discord_id") {
+			continue
+		}
+
+		adjustment, err := parseBulkBalanceRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		adjustment.Line = line
+
+		if previous, ok := seen[adjustment.DiscordID]; ok {
+			return nil, fmt.Errorf("line %d: user %d is already adjusted on line %d", line, adjustment.DiscordID, previous)
+		}
+		seen[adjustment.DiscordID] = line
+
+		adjustments = append(adjustments, adjustment)
+		if len(adjustments) > MaxBulkBalanceAdjustments {
+			return nil, fmt.Errorf("at most %d adjustments can be applied at once", MaxBulkBalanceAdjustments)
+		}
+	}
+
+	if len(adjustments) == 0 {
+		return nil, errors.New("no adjustments found")
+	}
+
+	return adjustments, nil
+}
+
+// parseBulkBalanceRecord parses a single discord_id,mode,amount,reason record
+func parseBulkBalanceRecord(record []string) (BulkBalanceAdjustment, error) {
+	if len(record) != 4 {
+		return BulkBalanceAdjustment{}, fmt.Errorf("expected 4 fields (discord_id,mode,amount,reason), got %d", len(record))
+	}
+
+	discordID, err := strconv.ParseInt(strings.TrimSpace(record[0]), 10, 64)
+	if err != nil || discordID <= 0 {
+		return BulkBalanceAdjustment{}, fmt.Errorf("invalid discord ID %q", record[0])
+	}
+
+	mode := BalanceAdjustmentMode(strings.ToLower(strings.TrimSpace(record[1])))
+	if mode != BalanceAdjustmentDelta && mode != BalanceAdjustmentAbsolute {
+		return BulkBalanceAdjustment{}, fmt.Errorf("invalid mode %q, expected delta or absolute", record[1])
+	}
+
+	amount, err := strconv.ParseInt(strings.TrimSpace(record[2]), 10, 64)
+	if err != nil {
+		return BulkBalanceAdjustment{}, fmt.Errorf("invalid amount %q", record[2])
+	}
+	if mode == BalanceAdjustmentAbsolute && amount < 0 {
+		return BulkBalanceAdjustment{}, errors.New("absolute balance cannot be negative")
+	}
+
+	reason := strings.TrimSpace(record[3])
+	if reason == "" {
+		return BulkBalanceAdjustment{}, errors.New("reason is required")
+	}
+
+	return BulkBalanceAdjustment{
+		DiscordID: discordID,
+		Mode:      mode,
+		Amount:    amount,
+		Reason:    reason,
+	}, nil
+}
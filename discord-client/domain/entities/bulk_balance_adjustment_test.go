@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBulkBalanceCSV(t *testing.T) {
+	t.Parallel()
+
+	t.Run("parses rows after a header", func(t *testing.T) {
+		t.Parallel()
+		input := "discord_id,mode,amount,reason\n" +
+			"111,delta,500,tournament prize\n" +
+			"\n" +
+			"222, absolute ,1000,\"refund, bug #12\"\n" +
+			"333,DELTA,-250,penalty\n"
+
+		adjustments, err := ParseBulkBalanceCSV(strings.NewReader(input))
+
+		require.NoError(t, err)
+		assert.Equal(t, []BulkBalanceAdjustment{
+			{Line: 2, DiscordID: 111, Mode: BalanceAdjustmentDelta, Amount: 500, Reason: "tournament prize"},
+			{Line: 4, DiscordID: 222, Mode: BalanceAdjustmentAbsolute, Amount: 1000, Reason: "refund, bug #12"},
+			{Line: 5, DiscordID: 333, Mode: BalanceAdjustmentDelta, Amount: -250, Reason: "penalty"},
+		}, adjustments)
+	})
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "empty", input: "discord_id,mode,amount,reason\n", wantErr: "no adjustments"},
+		{name: "missing field", input: "111,delta,500\n", wantErr: "line 1: expected 4 fields"},
+		{name: "bad discord ID", input: "abc,delta,500,x\n", wantErr: "invalid discord ID"},
+		{name: "unknown mode", input: "111,set,500,x\n", wantErr: "invalid mode"},
+		{name: "bad amount", input: "111,delta,lots,x\n", wantErr: "invalid amount"},
+		{name: "negative absolute", input: "111,absolute,-5,x\n", wantErr: "cannot be negative"},
+		{name: "missing reason", input: "111,delta,5, \n", wantErr: "reason is required"},
+		{name: "duplicate user", input: "111,delta,5,x\n111,delta,6,y\n", wantErr: "line 2: user 111 is already adjusted on line 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := ParseBulkBalanceCSV(strings.NewReader(tt.input))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestBulkBalanceAdjustment_NewBalance(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, int64(1500), BulkBalanceAdjustment{Mode: BalanceAdjustmentDelta, Amount: 500}.NewBalance(1000))
+	assert.Equal(t, int64(200), BulkBalanceAdjustment{Mode: BalanceAdjustmentAbsolute, Amount: 200}.NewBalance(1000))
+}
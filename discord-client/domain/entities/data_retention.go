@@ -0,0 +1,38 @@
+package entities
+
+// AnonymizedUsername replaces the username of a user whose data was deleted
+const AnonymizedUsername = "Deleted User"
+
+// DataRetentionReport counts the rows a guild purge or user data deletion touched, by table
+type DataRetentionReport struct {
+	Deleted    map[string]int64 `json:"deleted"`
+	Anonymized map[string]int64 `json:"anonymized,omitempty"`
+	// AnonymizedID is the pseudonymous user a deleted user's shared history now belongs to
+	AnonymizedID int64 `json:"anonymized_id,omitempty"`
+}
+
+// NewDataRetentionReport creates an empty report
+func NewDataRetentionReport() *DataRetentionReport {
+	return &DataRetentionReport{
+		Deleted:    make(map[string]int64),
+		Anonymized: make(map[string]int64),
+	}
+}
+
+// TotalDeleted returns the number of rows deleted across all tables
+func (r *DataRetentionReport) TotalDeleted() int64 {
+	return sumCounts(r.Deleted)
+}
+
+// TotalAnonymized returns the number of rows reassigned to the pseudonymous user across all tables
+func (r *DataRetentionReport) TotalAnonymized() int64 {
+	return sumCounts(r.Anonymized)
+}
+
+func sumCounts(counts map[string]int64) int64 {
+	var total int64
+	for _, count := range counts {
+		total += count
+	}
+	return total
+}
@@ -0,0 +1,126 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// DiscordOutboxAction is the Discord side effect an outbox message performs
+type DiscordOutboxAction string
+
+const (
+	DiscordOutboxActionPost DiscordOutboxAction = "post"
+	DiscordOutboxActionEdit DiscordOutboxAction = "edit"
+	DiscordOutboxActionPin  DiscordOutboxAction = "pin"
+)
+
+// DiscordOutboxKind identifies how a post or edit's payload is rendered
+type DiscordOutboxKind string
+
+const (
+	// DiscordOutboxKindHouseWager renders a house wager message from a HouseWagerPostDTO payload
+	DiscordOutboxKindHouseWager DiscordOutboxKind = "house_wager"
+	// DiscordOutboxKindGroupWager posts a group wager's message rendered from the wager itself, so it needs no payload
+	DiscordOutboxKindGroupWager DiscordOutboxKind = "group_wager"
+)
+
+// DiscordOutboxStatus represents the lifecycle state of an outbox message
+type DiscordOutboxStatus string
+
+const (
+	DiscordOutboxStatusPending DiscordOutboxStatus = "pending"
+	DiscordOutboxStatusSent    DiscordOutboxStatus = "sent"
+	DiscordOutboxStatusFailed  DiscordOutboxStatus = "failed"
+)
+
+// Outbox retry policy
+const (
+	DiscordOutboxMaxAttempts      = 8
+	DiscordOutboxBaseRetryBackoff = 5 * time.Second // Doubles after every failed attempt
+)
+
+// DiscordOutboxMessage is a Discord action written in the same transaction as the change it announces,
+// and sent by the outbox dispatcher once that transaction commits
+type DiscordOutboxMessage struct {
+	ID            int64               `db:"id"`
+	GuildID       int64               `db:"guild_id"`
+	Action        DiscordOutboxAction `db:"action"`
+	Kind          DiscordOutboxKind   `db:"kind"`           // Empty for pins
+	ChannelID     int64               `db:"channel_id"`     // 0 for edits and pins of a group wager not posted yet
+	MessageID     *int64              `db:"message_id"`     // Message to edit or pin; nil to use the group wager's message
+	GroupWagerID  *int64              `db:"group_wager_id"` // Wager whose message a post creates, or an edit or pin targets
+	Payload       []byte              `db:"payload"`        // JSON message data for posts and edits
+	DedupKey      *string             `db:"dedup_key"`      // A newer pending message with the same key replaces this one's payload
+	Status        DiscordOutboxStatus `db:"status"`
+	Attempts      int                 `db:"attempts"`
+	LastError     *string             `db:"last_error"`
+	NextAttemptAt time.Time           `db:"next_attempt_at"`
+	CreatedAt     time.Time           `db:"created_at"`
+	SentAt        *time.Time          `db:"sent_at"`
+}
+
+// DiscordOutboxResult is what Discord returned for a sent outbox message
+type DiscordOutboxResult struct {
+	MessageID int64
+	ChannelID int64
+	ThreadID  int64 // Discussion thread opened on a posted message, 0 if none
+}
+
+// Validate checks the message has what its action needs
+func (m *DiscordOutboxMessage) Validate() error {
+	switch m.Action {
+	case DiscordOutboxActionPost:
+		if m.ChannelID == 0 {
+			return fmt.Errorf("outbox post requires a channel")
+		}
+		if m.Kind == DiscordOutboxKindGroupWager {
+			if m.GroupWagerID == nil {
+				return fmt.Errorf("outbox group wager post requires a group wager")
+			}
+			return nil
+		}
+		if len(m.Payload) == 0 {
+			return fmt.Errorf("outbox post requires a payload")
+		}
+		return nil
+	case DiscordOutboxActionEdit:
+		if m.Kind == DiscordOutboxKindGroupWager {
+			return fmt.Errorf("group wager messages are edited by their state change handler, not the outbox")
+		}
+		if len(m.Payload) == 0 {
+			return fmt.Errorf("outbox edit requires a payload")
+		}
+	case DiscordOutboxActionPin:
+	default:
+		return fmt.Errorf("unknown outbox action %q", m.Action)
+	}
+
+	// Edits and pins target a message, or the group wager's message once it has been posted
+	if m.GroupWagerID == nil && (m.MessageID == nil || m.ChannelID == 0) {
+		return fmt.Errorf("outbox %s requires a message or group wager", m.Action)
+	}
+
+	return nil
+}
+
+// RecordSuccess marks the message as sent
+func (m *DiscordOutboxMessage) RecordSuccess(now time.Time) {
+	m.Attempts++
+	m.Status = DiscordOutboxStatusSent
+	m.LastError = nil
+	m.SentAt = &now
+}
+
+// RecordFailure records a failed attempt, scheduling a retry with exponential backoff until the
+// message runs out of attempts, about 10 minutes after the first
+func (m *DiscordOutboxMessage) RecordFailure(reason string, now time.Time) {
+	m.Attempts++
+	m.LastError = &reason
+
+	if m.Attempts >= DiscordOutboxMaxAttempts {
+		m.Status = DiscordOutboxStatusFailed
+		return
+	}
+
+	m.NextAttemptAt = now.Add(DiscordOutboxBaseRetryBackoff << (m.Attempts - 1))
+}
@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscordOutboxMessage_Validate(t *testing.T) {
+	t.Parallel()
+	messageID := int64(42)
+	wagerID := int64(7)
+
+	assert.NoError(t, (&DiscordOutboxMessage{Action: DiscordOutboxActionPost, ChannelID: 1, Payload: []byte(`{}`)}).Validate())
+	assert.NoError(t, (&DiscordOutboxMessage{Action: DiscordOutboxActionEdit, ChannelID: 1, GroupWagerID: &wagerID, Payload: []byte(`{}`)}).Validate())
+	assert.NoError(t, (&DiscordOutboxMessage{Action: DiscordOutboxActionPin, ChannelID: 1, MessageID: &messageID}).Validate())
+
+	assert.Error(t, (&DiscordOutboxMessage{Action: DiscordOutboxActionPost, Payload: []byte(`{}`)}).Validate(), "posts need a channel")
+	assert.Error(t, (&DiscordOutboxMessage{Action: DiscordOutboxActionPost, ChannelID: 1}).Validate(), "posts need a payload")
+	assert.Error(t, (&DiscordOutboxMessage{Action: DiscordOutboxActionEdit, ChannelID: 1, Payload: []byte(`{}`)}).Validate(), "edits need a target")
+	assert.Error(t, (&DiscordOutboxMessage{Action: DiscordOutboxActionPin, ChannelID: 1}).Validate(), "pins need a target")
+	assert.Error(t, (&DiscordOutboxMessage{Action: "delete", ChannelID: 1}).Validate())
+}
+
+func TestDiscordOutboxMessage_RecordFailure(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("backs off exponentially", func(t *testing.T) {
+		message := &DiscordOutboxMessage{Status: DiscordOutboxStatusPending}
+
+		message.RecordFailure("HTTP 503 Service Unavailable", now)
+		assert.Equal(t, DiscordOutboxStatusPending, message.Status)
+		assert.Equal(t, now.Add(5*time.Second), message.NextAttemptAt)
+
+		message.RecordFailure("connection reset", now)
+		assert.Equal(t, now.Add(10*time.Second), message.NextAttemptAt)
+		require.NotNil(t, message.LastError)
+		assert.Equal(t, "connection reset", *message.LastError)
+	})
+
+	t.Run("fails after the last attempt", func(t *testing.T) {
+		message := &DiscordOutboxMessage{Status: DiscordOutboxStatusPending, Attempts: DiscordOutboxMaxAttempts - 1}
+
+		message.RecordFailure("missing access", now)
+		assert.Equal(t, DiscordOutboxStatusFailed, message.Status)
+		assert.Equal(t, DiscordOutboxMaxAttempts, message.Attempts)
+	})
+}
+
+func TestDiscordOutboxMessage_RecordSuccess(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	reason := "timeout"
+	message := &DiscordOutboxMessage{Status: DiscordOutboxStatusPending, Attempts: 2, LastError: &reason}
+
+	message.RecordSuccess(now)
+
+	assert.Equal(t, DiscordOutboxStatusSent, message.Status)
+	assert.Equal(t, 3, message.Attempts)
+	assert.Nil(t, message.LastError)
+	assert.Equal(t, now, *message.SentAt)
+}
@@ -0,0 +1,112 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// DuelStatus represents the state of a duel
+type DuelStatus string
+
+const (
+	DuelStatusPending   DuelStatus = "pending"   // Waiting for the target to accept
+	DuelStatusCompleted DuelStatus = "completed" // Coin flipped and pot transferred
+	DuelStatusDeclined  DuelStatus = "declined"  // Target turned the challenge down
+	DuelStatusCancelled DuelStatus = "cancelled" // Challenger withdrew the challenge
+)
+
+// DuelChallengeTTL is how long a challenge stays open, holding the challenger's stake, before it lapses
+const DuelChallengeTTL = 10 * time.Minute
+
+// ErrDuelNotFound is returned when a duel does not exist in the guild
+var ErrDuelNotFound = errors.New("duel not found")
+
+// Duel is a heads-or-tails challenge between two users. The challenger's stake is held out of their
+// available balance while the challenge is open; on acceptance the coin is flipped (best of 1 or 3)
+// and the loser pays the stake to the winner.
+type Duel struct {
+	ID                  int64      `db:"id"`
+	GuildID             int64      `db:"guild_id"`
+	ChallengerDiscordID int64      `db:"challenger_discord_id"`
+	TargetDiscordID     int64      `db:"target_discord_id"`
+	Amount              int64      `db:"amount"`
+	BestOf              int        `db:"best_of"`
+	Status              DuelStatus `db:"status"`
+	Flips               []bool     `db:"flips"` // One entry per round, true when the challenger won it
+	WinnerDiscordID     *int64     `db:"winner_discord_id"`
+	CreatedAt           time.Time  `db:"created_at"`
+	ExpiresAt           time.Time  `db:"expires_at"`
+	ResolvedAt          *time.Time `db:"resolved_at"`
+}
+
+// IsValidDuelBestOf returns true for the supported series lengths
+func IsValidDuelBestOf(bestOf int) bool {
+	return bestOf == 1 || bestOf == 3
+}
+
+// IsPending returns true if the duel is waiting for the target to respond
+func (d *Duel) IsPending() bool {
+	return d.Status == DuelStatusPending
+}
+
+// IsExpired returns true if the challenge lapsed before the target accepted it
+func (d *Duel) IsExpired(now time.Time) bool {
+	return d.IsPending() && !now.Before(d.ExpiresAt)
+}
+
+// IsParticipant returns true if the user is the challenger or the target
+func (d *Duel) IsParticipant(discordID int64) bool {
+	return discordID == d.ChallengerDiscordID || discordID == d.TargetDiscordID
+}
+
+// WinsNeeded returns how many rounds a side must win to take the duel
+func (d *Duel) WinsNeeded() int {
+	return d.BestOf/2 + 1
+}
+
+// Score returns the number of rounds won by the challenger and the target
+func (d *Duel) Score() (challengerWins, targetWins int) {
+	for _, challengerWon := range d.Flips {
+		if challengerWon {
+			challengerWins++
+		} else {
+			targetWins++
+		}
+	}
+	return challengerWins, targetWins
+}
+
+// Play flips coins until one side has won the majority of rounds and completes the duel.
+// flip returns true when the challenger wins a round.
+func (d *Duel) Play(flip func() bool, now time.Time) {
+	d.Flips = d.Flips[:0]
+	for {
+		challengerWins, targetWins := d.Score()
+		if challengerWins >= d.WinsNeeded() {
+			d.complete(d.ChallengerDiscordID, now)
+			return
+		}
+		if targetWins >= d.WinsNeeded() {
+			d.complete(d.TargetDiscordID, now)
+			return
+		}
+		d.Flips = append(d.Flips, flip())
+	}
+}
+
+func (d *Duel) complete(winnerID int64, now time.Time) {
+	d.Status = DuelStatusCompleted
+	d.WinnerDiscordID = &winnerID
+	d.ResolvedAt = &now
+}
+
+// LoserDiscordID returns the losing user of a completed duel, or 0 if the duel has no winner
+func (d *Duel) LoserDiscordID() int64 {
+	if d.WinnerDiscordID == nil {
+		return 0
+	}
+	if *d.WinnerDiscordID == d.ChallengerDiscordID {
+		return d.TargetDiscordID
+	}
+	return d.ChallengerDiscordID
+}
@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedFlips returns a flip function that plays back the given round results
+func scriptedFlips(results ...bool) func() bool {
+	return func() bool {
+		result := results[0]
+		results = results[1:]
+		return result
+	}
+}
+
+func TestDuel_Play(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tests := []struct {
+		name       string
+		bestOf     int
+		flips      []bool
+		wantWinner int64
+		wantRounds int
+	}{
+		{name: "single flip challenger wins", bestOf: 1, flips: []bool{true}, wantWinner: 1, wantRounds: 1},
+		{name: "single flip target wins", bestOf: 1, flips: []bool{false}, wantWinner: 2, wantRounds: 1},
+		{name: "best of 3 sweep", bestOf: 3, flips: []bool{false, false}, wantWinner: 2, wantRounds: 2},
+		{name: "best of 3 decider", bestOf: 3, flips: []bool{true, false, true}, wantWinner: 1, wantRounds: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			duel := &Duel{ChallengerDiscordID: 1, TargetDiscordID: 2, BestOf: tt.bestOf, Status: DuelStatusPending}
+			duel.Play(scriptedFlips(tt.flips...), now)
+
+			require.NotNil(t, duel.WinnerDiscordID)
+			assert.Equal(t, tt.wantWinner, *duel.WinnerDiscordID)
+			assert.Len(t, duel.Flips, tt.wantRounds)
+			assert.Equal(t, DuelStatusCompleted, duel.Status)
+			assert.Equal(t, 3-tt.wantWinner, duel.LoserDiscordID())
+		})
+	}
+}
+
+func TestDuel_IsExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	duel := &Duel{Status: DuelStatusPending, ExpiresAt: now.Add(time.Minute)}
+	assert.False(t, duel.IsExpired(now))
+	assert.True(t, duel.IsExpired(now.Add(time.Minute)))
+
+	duel.Status = DuelStatusCompleted
+	assert.False(t, duel.IsExpired(now.Add(time.Hour)))
+}
@@ -0,0 +1,89 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxExportRangeDays bounds how much history a single export may cover
+const MaxExportRangeDays = 366
+
+// ExportDataset identifies which economy data an export contains
+type ExportDataset string
+
+const (
+	ExportDatasetBalanceHistory ExportDataset = "balance-history"
+	ExportDatasetWagerOutcomes  ExportDataset = "wager-outcomes"
+	ExportDatasetLotteryResults ExportDataset = "lottery-results"
+)
+
+// IsValid returns true if the dataset is one that can be exported
+func (d ExportDataset) IsValid() bool {
+	switch d {
+	case ExportDatasetBalanceHistory, ExportDatasetWagerOutcomes, ExportDatasetLotteryResults:
+		return true
+	}
+	return false
+}
+
+// ExportFormat identifies the file format of an export
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatJSON ExportFormat = "json"
+)
+
+// IsValid returns true if the format is supported
+func (f ExportFormat) IsValid() bool {
+	return f == ExportFormatCSV || f == ExportFormatJSON
+}
+
+// FileName returns the attachment name for an export of the dataset over the given range
+func (f ExportFormat) FileName(dataset ExportDataset, from, to time.Time) string {
+	return fmt.Sprintf("%s_%s_%s.%s", dataset, from.Format("2006-01-02"), to.Format("2006-01-02"), f)
+}
+
+// ValidateExportRange checks that an export date range is ordered and not too long.
+// The range is half-open: from is inclusive and to is exclusive.
+func ValidateExportRange(from, to time.Time) error {
+	if !to.After(from) {
+		return fmt.Errorf("end date must be after start date")
+	}
+	if to.Sub(from) > MaxExportRangeDays*24*time.Hour {
+		return fmt.Errorf("export range cannot exceed %d days", MaxExportRangeDays)
+	}
+	return nil
+}
+
+// GroupWagerOutcome is one participant's result in a resolved group wager, used for exports
+type GroupWagerOutcome struct {
+	GroupWagerID      int64
+	Condition         string
+	WagerType         GroupWagerType
+	ResolvedAt        time.Time
+	WinningOptionText string
+	DiscordID         int64
+	OptionText        string
+	Amount            int64
+	PayoutAmount      *int64 // nil if the payout was never recorded
+	Won               bool
+}
+
+// LotteryDrawResult summarizes a completed lottery draw, used for exports and the draw history
+type LotteryDrawResult struct {
+	DrawID        int64
+	CompletedAt   time.Time
+	WinningNumber int64
+	Difficulty    int64
+	TicketCost    int64
+	TotalPot      int64
+	TicketsSold   int64
+	WinnerCount   int64
+	TotalPaidOut  int64
+}
+
+// RolledOver reports whether nobody held the winning number, so the pot carried into the next draw
+func (r *LotteryDrawResult) RolledOver() bool {
+	return r.WinnerCount == 0
+}
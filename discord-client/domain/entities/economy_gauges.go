@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+// EconomyGauges is a point-in-time snapshot of a guild's economy, exported for monitoring and alerting
+type EconomyGauges struct {
+	GuildID            int64
+	BitsInCirculation  int64     // Sum of every member's balance
+	BitsLockedInWagers int64     // Bets on open group wagers plus both stakes of 1v1 wagers being voted on
+	LotteryPot         int64     // Pot of draws that have not completed yet
+	ActiveWagers       int64     // Group wagers taking bets or awaiting resolution, plus 1v1 wagers being voted on
+	RecordedAt         time.Time // When the snapshot was taken
+}
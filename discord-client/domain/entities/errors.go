@@ -0,0 +1,23 @@
+package entities
+
+import "errors"
+
+// Errors shared by the domain services. Services wrap them with the details of the failure, e.g.
+// fmt.Errorf("%w: have %d available, need %d", ErrInsufficientBalance, ...), so callers can tell
+// them apart with errors.Is rather than by matching error text.
+var (
+	// ErrUserNotFound is returned when a user has no account in the guild
+	ErrUserNotFound = errors.New("user not found")
+	// ErrInsufficientBalance is returned when a user's available balance can't cover an amount
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	// ErrNonPositiveAmount is returned for bets, transfers and other amounts of zero or less
+	ErrNonPositiveAmount = errors.New("amount must be positive")
+	// ErrWagerNotFound is returned when a two-party wager does not exist
+	ErrWagerNotFound = errors.New("wager not found")
+	// ErrGroupWagerNotFound is returned when a group or house wager does not exist
+	ErrGroupWagerNotFound = errors.New("group wager not found")
+	// ErrVotingEnded is returned when betting on a group wager after its voting period has closed
+	ErrVotingEnded = errors.New("voting period has ended")
+	// ErrNotAuthorized is returned when a user lacks the permission an action requires
+	ErrNotAuthorized = errors.New("user is not authorized")
+)
@@ -0,0 +1,96 @@
+package entities
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+)
+
+// Experiment keys for engagement features rolled out behind an experiment
+const (
+	ExperimentQuickBetButtons = "quick_bet_buttons"
+	ExperimentDigestDMs       = "digest_dms"
+)
+
+// ExperimentUnit determines what an experiment buckets on
+type ExperimentUnit string
+
+const (
+	ExperimentUnitGuild ExperimentUnit = "guild" // Every user in a guild gets the same variant
+	ExperimentUnitUser  ExperimentUnit = "user"  // Each user is bucketed independently
+)
+
+// ExperimentVariant is the arm of an experiment a subject is assigned to
+type ExperimentVariant string
+
+const (
+	ExperimentVariantControl   ExperimentVariant = "control"
+	ExperimentVariantTreatment ExperimentVariant = "treatment"
+)
+
+// Experiment represents a feature rolled out to a percentage of guilds or users
+type Experiment struct {
+	Key            string         `db:"key"`
+	Description    string         `db:"description"`
+	Unit           ExperimentUnit `db:"unit"`
+	RolloutPercent int            `db:"rollout_percent"`
+	Enabled        bool           `db:"enabled"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+}
+
+// Bucket returns the subject's stable bucket in [0, 100).
+// The experiment key is part of the hash so buckets are independent across experiments.
+func (e *Experiment) Bucket(guildID, discordID int64) int {
+	subjectID := discordID
+	if e.Unit == ExperimentUnitGuild {
+		subjectID = guildID
+	}
+
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%s:%d", e.Key, subjectID)
+	return int(h.Sum32() % 100)
+}
+
+// VariantFor returns the variant a subject is assigned to
+func (e *Experiment) VariantFor(guildID, discordID int64) ExperimentVariant {
+	if !e.Enabled || e.Bucket(guildID, discordID) >= e.RolloutPercent {
+		return ExperimentVariantControl
+	}
+	return ExperimentVariantTreatment
+}
+
+// ExperimentExposure records the first time a user saw an experiment variant
+type ExperimentExposure struct {
+	ID             int64             `db:"id"`
+	ExperimentKey  string            `db:"experiment_key"`
+	GuildID        int64             `db:"guild_id"`
+	DiscordID      int64             `db:"discord_id"`
+	Variant        ExperimentVariant `db:"variant"`
+	FirstExposedAt time.Time         `db:"first_exposed_at"`
+}
+
+// ExperimentVariantReport summarizes activity of exposed users in one variant since their first exposure
+type ExperimentVariantReport struct {
+	Variant      ExperimentVariant `json:"variant"`
+	ExposedUsers int64             `json:"exposed_users"`
+	ActiveUsers  int64             `json:"active_users"`
+	Transactions int64             `json:"transactions"`
+	Volume       int64             `json:"volume"`
+}
+
+// ActiveRate returns the fraction of exposed users with any balance activity after exposure
+func (r *ExperimentVariantReport) ActiveRate() float64 {
+	if r.ExposedUsers == 0 {
+		return 0
+	}
+	return float64(r.ActiveUsers) / float64(r.ExposedUsers)
+}
+
+// VolumePerUser returns the average bits moved per exposed user after exposure
+func (r *ExperimentVariantReport) VolumePerUser() float64 {
+	if r.ExposedUsers == 0 {
+		return 0
+	}
+	return float64(r.Volume) / float64(r.ExposedUsers)
+}
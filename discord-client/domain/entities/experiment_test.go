@@ -0,0 +1,88 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExperiment_Bucket_IsStable(t *testing.T) {
+	experiment := &Experiment{Key: ExperimentQuickBetButtons, Unit: ExperimentUnitUser}
+
+	first := experiment.Bucket(1, 123456)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, first, experiment.Bucket(1, 123456))
+	}
+	assert.GreaterOrEqual(t, first, 0)
+	assert.Less(t, first, 100)
+}
+
+func TestExperiment_VariantFor(t *testing.T) {
+	tests := []struct {
+		name           string
+		enabled        bool
+		rolloutPercent int
+		expected       ExperimentVariant
+	}{
+		{"zero percent is always control", true, 0, ExperimentVariantControl},
+		{"full rollout is always treatment", true, 100, ExperimentVariantTreatment},
+		{"disabled is always control", false, 100, ExperimentVariantControl},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			experiment := &Experiment{
+				Key:            ExperimentDigestDMs,
+				Unit:           ExperimentUnitUser,
+				RolloutPercent: tt.rolloutPercent,
+				Enabled:        tt.enabled,
+			}
+			for discordID := int64(1); discordID <= 50; discordID++ {
+				assert.Equal(t, tt.expected, experiment.VariantFor(1, discordID))
+			}
+		})
+	}
+}
+
+func TestExperiment_VariantFor_PartialRolloutSplitsUsers(t *testing.T) {
+	experiment := &Experiment{
+		Key:            ExperimentQuickBetButtons,
+		Unit:           ExperimentUnitUser,
+		RolloutPercent: 50,
+		Enabled:        true,
+	}
+
+	treatment := 0
+	for discordID := int64(1); discordID <= 1000; discordID++ {
+		if experiment.VariantFor(1, discordID) == ExperimentVariantTreatment {
+			treatment++
+		}
+	}
+
+	assert.Greater(t, treatment, 400)
+	assert.Less(t, treatment, 600)
+}
+
+func TestExperiment_VariantFor_GuildUnitAssignsWholeGuild(t *testing.T) {
+	experiment := &Experiment{
+		Key:            ExperimentQuickBetButtons,
+		Unit:           ExperimentUnitGuild,
+		RolloutPercent: 50,
+		Enabled:        true,
+	}
+
+	expected := experiment.VariantFor(42, 1)
+	for discordID := int64(2); discordID <= 50; discordID++ {
+		assert.Equal(t, expected, experiment.VariantFor(42, discordID))
+	}
+}
+
+func TestExperimentVariantReport_Rates(t *testing.T) {
+	report := &ExperimentVariantReport{ExposedUsers: 4, ActiveUsers: 1, Volume: 1000}
+	assert.Equal(t, 0.25, report.ActiveRate())
+	assert.Equal(t, 250.0, report.VolumePerUser())
+
+	empty := &ExperimentVariantReport{}
+	assert.Equal(t, 0.0, empty.ActiveRate())
+	assert.Equal(t, 0.0, empty.VolumePerUser())
+}
@@ -5,6 +5,7 @@ type ExternalSystem string
 const (
 	SystemLeagueOfLegends ExternalSystem = "league_of_legends"
 	SystemTFT             ExternalSystem = "teamfight_tactics"
+	SystemEsports         ExternalSystem = "esports"
 )
 
 type ExternalReference struct {
@@ -14,4 +15,10 @@ type ExternalReference struct {
 
 func (e ExternalReference) IsValid() bool {
 	return e.System != "" && e.ID != ""
-}
\ No newline at end of file
+}
+
+// ExternalResult is the outcome of an external match, reported by a result provider
+type ExternalResult struct {
+	Reference     ExternalReference
+	WinningOption string // Option text of the winning outcome, matched case-insensitively
+}
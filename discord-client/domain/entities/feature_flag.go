@@ -0,0 +1,104 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Feature identifies a bot feature that can be turned on or off per guild
+type Feature string
+
+const (
+	FeatureLottery     Feature = "lottery"
+	FeatureLolWagers   Feature = "lol_wagers"
+	FeatureTftWagers   Feature = "tft_wagers"
+	FeatureDuels       Feature = "duels"
+	FeatureDailyAwards Feature = "daily_awards"
+)
+
+// ErrFeatureDisabled is returned when a guild has turned off the feature being used
+var ErrFeatureDisabled = errors.New("this feature is disabled in this server")
+
+// FeatureDefinition describes a toggleable feature and whether guilds get it without opting in
+type FeatureDefinition struct {
+	Feature        Feature
+	Name           string
+	Description    string
+	DefaultEnabled bool
+}
+
+// FeatureDefinitions lists every toggleable feature in the order /features shows them
+var FeatureDefinitions = []FeatureDefinition{
+	{Feature: FeatureLottery, Name: "Lottery", Description: "/lotto, ticket purchases and subscriptions", DefaultEnabled: true},
+	{Feature: FeatureLolWagers, Name: "LoL Wagers", Description: "House wagers on tracked League of Legends games", DefaultEnabled: true},
+	{Feature: FeatureTftWagers, Name: "TFT Wagers", Description: "House wagers on tracked Teamfight Tactics games", DefaultEnabled: true},
+	{Feature: FeatureDuels, Name: "Duels", Description: "/duel challenges between members", DefaultEnabled: true},
+	{Feature: FeatureDailyAwards, Name: "Daily Awards", Description: "The daily awards summary post", DefaultEnabled: true},
+}
+
+// ParseFeature converts a feature key such as "tft_wagers" into a Feature
+func ParseFeature(key string) (Feature, error) {
+	for _, definition := range FeatureDefinitions {
+		if string(definition.Feature) == key {
+			return definition.Feature, nil
+		}
+	}
+	return "", fmt.Errorf("unknown feature %q", key)
+}
+
+// Definition returns the feature's definition, or false for unknown features
+func (f Feature) Definition() (FeatureDefinition, bool) {
+	for _, definition := range FeatureDefinitions {
+		if definition.Feature == f {
+			return definition, true
+		}
+	}
+	return FeatureDefinition{}, false
+}
+
+// FeatureForExternalSystem returns the feature that gates house wagers on an external system's games
+func FeatureForExternalSystem(system ExternalSystem) (Feature, bool) {
+	switch system {
+	case SystemLeagueOfLegends:
+		return FeatureLolWagers, true
+	case SystemTFT:
+		return FeatureTftWagers, true
+	default:
+		return "", false
+	}
+}
+
+// GuildFeatureFlag is a guild's override of a feature's default state
+type GuildFeatureFlag struct {
+	GuildID   int64     `db:"guild_id"`
+	Feature   Feature   `db:"feature"`
+	Enabled   bool      `db:"enabled"`
+	UpdatedBy *int64    `db:"updated_by"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// FeatureFlags holds a guild's overrides, falling back to each feature's default
+type FeatureFlags map[Feature]bool
+
+// NewFeatureFlags builds a guild's feature flags from its stored overrides
+func NewFeatureFlags(overrides []*GuildFeatureFlag) FeatureFlags {
+	flags := make(FeatureFlags, len(overrides))
+	for _, override := range overrides {
+		flags[override.Feature] = override.Enabled
+	}
+	return flags
+}
+
+// IsEnabled reports whether the feature is on, using its default when the guild hasn't set it.
+// Unknown features are treated as enabled so a typo can never switch something off.
+func (f FeatureFlags) IsEnabled(feature Feature) bool {
+	if enabled, ok := f[feature]; ok {
+		return enabled
+	}
+	definition, ok := feature.Definition()
+	if !ok {
+		return true
+	}
+	return definition.DefaultEnabled
+}
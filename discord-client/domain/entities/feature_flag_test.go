@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureFlags_IsEnabled(t *testing.T) {
+	t.Parallel()
+
+	flags := NewFeatureFlags([]*GuildFeatureFlag{
+		{Feature: FeatureLottery, Enabled: false},
+		{Feature: FeatureDuels, Enabled: true},
+	})
+
+	assert.False(t, flags.IsEnabled(FeatureLottery))
+	assert.True(t, flags.IsEnabled(FeatureDuels))
+	assert.True(t, flags.IsEnabled(FeatureTftWagers), "unset features use their default")
+	assert.True(t, flags.IsEnabled(Feature("unknown")), "unknown features are never switched off")
+}
+
+func TestParseFeature(t *testing.T) {
+	t.Parallel()
+
+	feature, err := ParseFeature("tft_wagers")
+	require.NoError(t, err)
+	assert.Equal(t, FeatureTftWagers, feature)
+
+	_, err = ParseFeature("casino")
+	assert.Error(t, err)
+}
+
+func TestFeatureForExternalSystem(t *testing.T) {
+	t.Parallel()
+
+	feature, ok := FeatureForExternalSystem(SystemLeagueOfLegends)
+	assert.True(t, ok)
+	assert.Equal(t, FeatureLolWagers, feature)
+
+	feature, ok = FeatureForExternalSystem(SystemTFT)
+	assert.True(t, ok)
+	assert.Equal(t, FeatureTftWagers, feature)
+
+	_, ok = FeatureForExternalSystem(ExternalSystem("chess"))
+	assert.False(t, ok)
+}
@@ -0,0 +1,82 @@
+package entities
+
+import "sort"
+
+// GlobalLeaderboardSort selects the stat the cross-guild leaderboard is ranked by
+type GlobalLeaderboardSort string
+
+const (
+	GlobalLeaderboardSortAccuracy GlobalLeaderboardSort = "accuracy" // Share of predictions that picked the winning option
+	GlobalLeaderboardSortProfit   GlobalLeaderboardSort = "profit"   // Net profit as a percent of the amount wagered
+)
+
+// MinGlobalLeaderboardPredictions is the default number of resolved predictions needed to be ranked.
+// It is higher than the guild leaderboards so a lucky handful of bets can't top every server.
+const MinGlobalLeaderboardPredictions = 10
+
+// GlobalLeaderboardEntry is a player's group wager predictions aggregated across every guild that
+// shares its stats. Bits aren't comparable between guild economies, so players are ranked on rates.
+type GlobalLeaderboardEntry struct {
+	Rank               int
+	DiscordID          int64
+	GuildCount         int  // Sharing guilds the player has resolved predictions in
+	InViewerGuild      bool // Whether the player is a member of the guild viewing the leaderboard
+	CorrectPredictions int
+	TotalPredictions   int
+	TotalWagered       int64
+	NetProfit          int64 // Payouts minus amounts wagered
+}
+
+// AccuracyPercentage returns the share of predictions that picked the winning option
+func (e *GlobalLeaderboardEntry) AccuracyPercentage() float64 {
+	if e.TotalPredictions == 0 {
+		return 0
+	}
+	return float64(e.CorrectPredictions) / float64(e.TotalPredictions) * 100
+}
+
+// ProfitPercentage returns net profit as a percent of the amount wagered
+func (e *GlobalLeaderboardEntry) ProfitPercentage() float64 {
+	if e.TotalWagered == 0 {
+		return 0
+	}
+	return float64(e.NetProfit) / float64(e.TotalWagered) * 100
+}
+
+// IsValid checks if the sort is supported
+func (s GlobalLeaderboardSort) IsValid() bool {
+	return s == GlobalLeaderboardSortAccuracy || s == GlobalLeaderboardSortProfit
+}
+
+// RankGlobalLeaderboard orders entries with at least minPredictions predictions by the chosen stat and
+// numbers their ranks. Ties go to more predictions, then the lower Discord ID so the order is stable.
+func RankGlobalLeaderboard(entries []*GlobalLeaderboardEntry, by GlobalLeaderboardSort, minPredictions int) []*GlobalLeaderboardEntry {
+	ranked := make([]*GlobalLeaderboardEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.TotalPredictions >= minPredictions {
+			ranked = append(ranked, entry)
+		}
+	}
+
+	stat := (*GlobalLeaderboardEntry).AccuracyPercentage
+	if by == GlobalLeaderboardSortProfit {
+		stat = (*GlobalLeaderboardEntry).ProfitPercentage
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if stat(a) != stat(b) {
+			return stat(a) > stat(b)
+		}
+		if a.TotalPredictions != b.TotalPredictions {
+			return a.TotalPredictions > b.TotalPredictions
+		}
+		return a.DiscordID < b.DiscordID
+	})
+
+	for i, entry := range ranked {
+		entry.Rank = i + 1
+	}
+
+	return ranked
+}
@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankGlobalLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	newEntries := func() []*GlobalLeaderboardEntry {
+		return []*GlobalLeaderboardEntry{
+			{DiscordID: 1, CorrectPredictions: 6, TotalPredictions: 10, TotalWagered: 10000, NetProfit: 500},
+			{DiscordID: 2, CorrectPredictions: 8, TotalPredictions: 10, TotalWagered: 1000, NetProfit: -100},
+			{DiscordID: 3, CorrectPredictions: 12, TotalPredictions: 20, TotalWagered: 400, NetProfit: 200},
+			{DiscordID: 4, CorrectPredictions: 3, TotalPredictions: 3, TotalWagered: 300, NetProfit: 900},
+		}
+	}
+
+	t.Run("by accuracy", func(t *testing.T) {
+		t.Parallel()
+
+		ranked := RankGlobalLeaderboard(newEntries(), GlobalLeaderboardSortAccuracy, 10)
+
+		require.Len(t, ranked, 3, "players under the minimum are left out")
+		assert.Equal(t, int64(2), ranked[0].DiscordID)
+		// 60% each, more predictions ranks first
+		assert.Equal(t, int64(3), ranked[1].DiscordID)
+		assert.Equal(t, int64(1), ranked[2].DiscordID)
+		assert.Equal(t, 3, ranked[2].Rank)
+	})
+
+	t.Run("by profit percent", func(t *testing.T) {
+		t.Parallel()
+
+		ranked := RankGlobalLeaderboard(newEntries(), GlobalLeaderboardSortProfit, 10)
+
+		require.Len(t, ranked, 3)
+		assert.Equal(t, int64(3), ranked[0].DiscordID)
+		assert.InDelta(t, 50.0, ranked[0].ProfitPercentage(), 0.001)
+		assert.Equal(t, int64(1), ranked[1].DiscordID)
+		assert.Equal(t, int64(2), ranked[2].DiscordID)
+	})
+}
+
+func TestGlobalLeaderboardEntry_Rates(t *testing.T) {
+	t.Parallel()
+
+	empty := &GlobalLeaderboardEntry{}
+	assert.Zero(t, empty.AccuracyPercentage())
+	assert.Zero(t, empty.ProfitPercentage())
+}
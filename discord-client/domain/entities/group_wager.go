@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"sort"
 	"time"
 )
 
@@ -22,6 +23,19 @@ const (
 	GroupWagerTypeHouse GroupWagerType = "house"
 )
 
+// Voting period limits, in minutes
+const (
+	MinVotingPeriodMinutes     = 5
+	MaxVotingPeriodMinutes     = 7 * 24 * 60 // One week
+	DefaultVotingPeriodMinutes = 24 * 60     // Used when neither the creator nor the guild picks one
+)
+
+// Cancellation recovery limits
+const (
+	GroupWagerRestoreWindow = time.Hour       // How long after cancellation a wager can be restored
+	MinRestoredVotingPeriod = 5 * time.Minute // Least betting time a restored wager reopens with
+)
+
 // GroupWager represents a multi-participant wager with multiple outcome options
 type GroupWager struct {
 	ID                  int64              `db:"id"`
@@ -39,20 +53,36 @@ type GroupWager struct {
 	VotingEndsAt        *time.Time         `db:"voting_ends_at"`
 	MessageID           int64              `db:"message_id"`
 	ChannelID           int64              `db:"channel_id"`
+	ThreadID            *int64             `db:"thread_id"`           // Discussion thread attached to the wager message
+	SubjectDiscordID    *int64             `db:"subject_discord_id"`  // Linked player whose game a house wager is on
+	ThumbnailURL        *string            `db:"thumbnail_url"`       // Nullable - small image shown beside the embed, e.g. the queue icon
+	ImageURL            *string            `db:"image_url"`           // Nullable - large image shown under the embed, e.g. the champion played
+	MaxParticipants     *int               `db:"max_participants"`    // Nullable - most users who may bet (NULL = no limit)
+	AllowedRoleID       *int64             `db:"allowed_role_id"`     // Nullable - role whose members may bet on an invite-only wager
+	InvitedDiscordIDs   []int64            `db:"invited_discord_ids"` // Users who may bet on an invite-only wager
+	ReactionMessageID   *int64             `db:"reaction_message_id"` // Nullable - message whose emoji reactions place bets (NULL = buttons only)
+	ReactionChannelID   *int64             `db:"reaction_channel_id"` // Nullable - channel of the reaction message
+	ReactionStake       *int64             `db:"reaction_stake"`      // Nullable - bits a reaction bets when the user has no bet yet
 	CreatedAt           time.Time          `db:"created_at"`
 	ResolvedAt          *time.Time         `db:"resolved_at"`
-	ExternalRef         *ExternalReference `db:"-"` // Handled separately
+	RemindedAt          *time.Time         `db:"resolution_reminded_at"`       // Last resolver reminder, only loaded for pending resolution queries
+	AssignedResolverID  *int64             `db:"assigned_resolver_discord_id"` // Resolver handed the wager, only loaded for pending resolution queries
+	CancelledAt         *time.Time         `db:"cancelled_at"`                 // Set on cancellation and cleared on restore, only loaded for single wager lookups
+	ExternalRef         *ExternalReference `db:"-"`                            // Handled separately
 }
 
 // GroupWagerOption represents a possible outcome for a group wager
 type GroupWagerOption struct {
-	ID             int64     `db:"id"`
-	GroupWagerID   int64     `db:"group_wager_id"`
-	OptionText     string    `db:"option_text"`
-	OptionOrder    int16     `db:"option_order"`
-	TotalAmount    int64     `db:"total_amount"`
-	OddsMultiplier float64   `db:"odds_multiplier"`
-	CreatedAt      time.Time `db:"created_at"`
+	ID             int64                  `db:"id"`
+	GroupWagerID   int64                  `db:"group_wager_id"`
+	OptionText     string                 `db:"option_text"`
+	OptionOrder    int16                  `db:"option_order"`
+	TotalAmount    int64                  `db:"total_amount"`
+	OddsMultiplier float64                `db:"odds_multiplier"`
+	MaxTotalAmount *int64                 `db:"max_total_amount"` // Nullable - cap on the option's total bets
+	ButtonEmoji    *string                `db:"button_emoji"`     // Nullable - unicode emoji or <:name:id> custom emoji shown on the bet button
+	ButtonStyle    *GroupWagerOptionStyle `db:"button_style"`     // Nullable - colour of the bet button
+	CreatedAt      time.Time              `db:"created_at"`
 }
 
 // GroupWagerParticipant represents a user's participation in a group wager
@@ -64,10 +94,43 @@ type GroupWagerParticipant struct {
 	Amount           int64     `db:"amount"`
 	PayoutAmount     *int64    `db:"payout_amount"`
 	BalanceHistoryID *int64    `db:"balance_history_id"`
+	LockedMultiplier *float64  `db:"locked_multiplier"` // House wager odds at bet time, nil for pool wagers
 	CreatedAt        time.Time `db:"created_at"`
 	UpdatedAt        time.Time `db:"updated_at"`
 }
 
+// OddsChangeSource identifies what triggered a house wager odds change
+type OddsChangeSource string
+
+const (
+	OddsChangeSourceProvider OddsChangeSource = "provider"
+	OddsChangeSourceManual   OddsChangeSource = "manual"
+)
+
+// GroupWagerOddsChange records a single change to a house wager option's odds
+type GroupWagerOddsChange struct {
+	ID                 int64            `db:"id"`
+	GroupWagerID       int64            `db:"group_wager_id"`
+	OptionID           int64            `db:"option_id"`
+	OldMultiplier      float64          `db:"old_multiplier"`
+	NewMultiplier      float64          `db:"new_multiplier"`
+	Source             OddsChangeSource `db:"source"`
+	ChangedByDiscordID *int64           `db:"changed_by_discord_id"`
+	CreatedAt          time.Time        `db:"created_at"`
+}
+
+// GroupWagerBetChange records a participant changing the option or amount of their bet
+type GroupWagerBetChange struct {
+	ID               int64     `db:"id"`
+	GroupWagerID     int64     `db:"group_wager_id"`
+	DiscordID        int64     `db:"discord_id"`
+	PreviousOptionID int64     `db:"previous_option_id"`
+	PreviousAmount   int64     `db:"previous_amount"`
+	NewOptionID      int64     `db:"new_option_id"`
+	NewAmount        int64     `db:"new_amount"`
+	CreatedAt        time.Time `db:"created_at"`
+}
+
 // GroupWagerDetail combines a group wager with its options and participants
 type GroupWagerDetail struct {
 	Wager        *GroupWager
@@ -83,6 +146,40 @@ type GroupWagerResult struct {
 	Losers        []*GroupWagerParticipant
 	TotalPot      int64
 	PayoutDetails map[int64]int64 // Discord ID -> payout amount
+	Options       []*GroupWagerOption
+	MaxWinnerBet  int64                  // Largest winning bet, which caps pool wager losses (0 for house wagers)
+	PotTaxes      []*LotteryPotTaxShare  // Winnings withheld for the lottery by the guild's pot tax
+	PotTaxPercent int                    // Pot tax applied, 0 when nothing was taxed
+	BetChanges    []*GroupWagerBetChange // Every bet change on the wager, oldest first
+}
+
+// GroupWagerSettlement is one participant's line in a resolved group wager's settlement breakdown
+type GroupWagerSettlement struct {
+	DiscordID     int64
+	Option        *GroupWagerOption // nil if the option is missing from the result
+	Amount        int64
+	Payout        int64
+	NetChange     int64
+	EffectiveLoss int64 // Bits actually taken from a loser, below Amount when capped by the largest winning bet
+	PotTax        int64 // Winnings withheld for the lottery by the guild's pot tax
+	Won           bool
+	SwitchedFrom  *GroupWagerOption // Option first bet on, if the participant switched away from it
+}
+
+// IsCapped reports whether a losing bet lost less than its stake because of the pool wager exposure cap
+func (s GroupWagerSettlement) IsCapped() bool {
+	return !s.Won && s.EffectiveLoss < s.Amount
+}
+
+// GroupWagerBetPreview shows what a bet would pay at the current odds before the user commits to it
+type GroupWagerBetPreview struct {
+	Wager            *GroupWager
+	Option           *GroupWagerOption
+	Amount           int64
+	PreviousAmount   int64 // The user's existing bet on the wager, which the new bet replaces
+	Multiplier       float64
+	PotentialPayout  int64
+	AvailableBalance int64
 }
 
 // IsActive checks if the group wager is in an active state
@@ -100,6 +197,21 @@ func (gw *GroupWager) IsResolved() bool {
 	return gw.State == GroupWagerStateResolved
 }
 
+// IsCancelled checks if the group wager has been cancelled
+func (gw *GroupWager) IsCancelled() bool {
+	return gw.State == GroupWagerStateCancelled
+}
+
+// HasThread checks if a discussion thread is attached to the wager message
+func (gw *GroupWager) HasThread() bool {
+	return gw.ThreadID != nil && *gw.ThreadID != 0
+}
+
+// ShouldArchiveThread checks if the discussion thread should be archived because the wager is closed
+func (gw *GroupWager) ShouldArchiveThread() bool {
+	return gw.HasThread() && (gw.IsResolved() || gw.IsCancelled())
+}
+
 // IsVotingPeriodActive checks if voting period is currently active
 func (gw *GroupWager) IsVotingPeriodActive() bool {
 	if gw.State != GroupWagerStateActive || gw.VotingEndsAt == nil {
@@ -116,6 +228,32 @@ func (gw *GroupWager) IsVotingPeriodExpired() bool {
 	return time.Now().After(*gw.VotingEndsAt)
 }
 
+// PendingResolutionSince returns when the wager started waiting for resolution.
+// Wagers without a voting period fall back to their creation time.
+func (gw *GroupWager) PendingResolutionSince() time.Time {
+	if gw.VotingEndsAt != nil {
+		return *gw.VotingEndsAt
+	}
+	return gw.CreatedAt
+}
+
+// NeedsResolutionReminder checks if a pending wager has waited at least age since it started
+// waiting for resolution and since the last reminder
+func (gw *GroupWager) NeedsResolutionReminder(now time.Time, age time.Duration) bool {
+	if !gw.IsPendingResolution() || age <= 0 {
+		return false
+	}
+	if now.Sub(gw.PendingResolutionSince()) < age {
+		return false
+	}
+	return gw.RemindedAt == nil || now.Sub(*gw.RemindedAt) >= age
+}
+
+// IsOnPlayersGame checks if the wager is a house wager on the given user's own linked game
+func (gw *GroupWager) IsOnPlayersGame(discordID int64) bool {
+	return gw.SubjectDiscordID != nil && *gw.SubjectDiscordID == discordID
+}
+
 // CanAcceptBets checks if the group wager can still accept bets
 func (gw *GroupWager) CanAcceptBets() bool {
 	return gw.IsActive() && gw.IsVotingPeriodActive()
@@ -187,9 +325,65 @@ func (gw *GroupWager) Resolve(resolverID *int64, winningOptionID int64) {
 func (gw *GroupWager) Cancel() {
 	if gw.State == GroupWagerStateActive || gw.State == GroupWagerStatePendingResolution {
 		gw.State = GroupWagerStateCancelled
+		now := time.Now()
+		gw.CancelledAt = &now
 	}
 }
 
+// ExtendVotingForLateBet pushes the end of voting back by extension when a bet placed at now lands
+// within window of it, giving other participants time to react. It reports whether voting was extended.
+func (gw *GroupWager) ExtendVotingForLateBet(now time.Time, window, extension time.Duration) bool {
+	if window <= 0 || extension <= 0 || gw.VotingEndsAt == nil || !now.Before(*gw.VotingEndsAt) {
+		return false
+	}
+	if gw.VotingEndsAt.Sub(now) > window {
+		return false
+	}
+
+	votingEndsAt := gw.VotingEndsAt.Add(extension)
+	gw.VotingEndsAt = &votingEndsAt
+	return true
+}
+
+// CanBeRestored checks if a cancelled wager is still within the restore window
+func (gw *GroupWager) CanBeRestored(now time.Time) bool {
+	if !gw.IsCancelled() || gw.CancelledAt == nil {
+		return false
+	}
+	return now.Before(gw.CancelledAt.Add(GroupWagerRestoreWindow))
+}
+
+// CanBeCorrected checks if a resolved wager is still within the window in which its winning option may be changed
+func (gw *GroupWager) CanBeCorrected(now time.Time, window time.Duration) bool {
+	if !gw.IsResolved() || gw.ResolvedAt == nil || gw.WinningOptionID == nil || window <= 0 {
+		return false
+	}
+	return now.Before(gw.ResolvedAt.Add(window))
+}
+
+// Restore undoes a cancellation. A wager cancelled while betting was open reopens for the betting
+// time it had left, at least MinRestoredVotingPeriod; one cancelled after voting closed goes back
+// to awaiting resolution.
+func (gw *GroupWager) Restore(now time.Time) {
+	if !gw.CanBeRestored(now) {
+		return
+	}
+
+	switch {
+	case gw.VotingEndsAt == nil:
+		gw.State = GroupWagerStateActive
+	case gw.VotingEndsAt.After(*gw.CancelledAt):
+		remaining := max(gw.VotingEndsAt.Sub(*gw.CancelledAt), MinRestoredVotingPeriod)
+		votingEndsAt := now.Add(remaining)
+		gw.VotingEndsAt = &votingEndsAt
+		gw.State = GroupWagerStateActive
+	default:
+		gw.State = GroupWagerStatePendingResolution
+	}
+
+	gw.CancelledAt = nil
+}
+
 // CalculateMultiplier calculates the potential payout multiplier for an option
 func (o *GroupWagerOption) CalculateMultiplier(totalPot int64) float64 {
 	if o.TotalAmount == 0 {
@@ -198,6 +392,28 @@ func (o *GroupWagerOption) CalculateMultiplier(totalPot int64) float64 {
 	return float64(totalPot) / float64(o.TotalAmount)
 }
 
+// HasCap checks if the option limits the total amount that can be bet on it
+func (o *GroupWagerOption) HasCap() bool {
+	return o.MaxTotalAmount != nil && *o.MaxTotalAmount > 0
+}
+
+// RemainingCapacity returns how many more bits can be bet on a capped option
+func (o *GroupWagerOption) RemainingCapacity() int64 {
+	if !o.HasCap() {
+		return 0
+	}
+	if remaining := *o.MaxTotalAmount - o.TotalAmount; remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// WouldExceedCap checks if adding the given amount would push the option's total past its cap.
+// Reductions are always allowed, even on an option that is already over its cap.
+func (o *GroupWagerOption) WouldExceedCap(additional int64) bool {
+	return o.HasCap() && additional > 0 && o.TotalAmount+additional > *o.MaxTotalAmount
+}
+
 // CalculatePayout calculates the payout for a participant based on their contribution
 func (p *GroupWagerParticipant) CalculatePayout(winningOptionTotal int64, totalPot int64) int64 {
 	if winningOptionTotal == 0 {
@@ -206,6 +422,67 @@ func (p *GroupWagerParticipant) CalculatePayout(winningOptionTotal int64, totalP
 	return (p.Amount * totalPot) / winningOptionTotal
 }
 
+// GetPayoutMultiplier returns the multiplier this participant is settled at, preferring the odds locked at bet time
+func (p *GroupWagerParticipant) GetPayoutMultiplier(option *GroupWagerOption) float64 {
+	if p.LockedMultiplier != nil {
+		return *p.LockedMultiplier
+	}
+	if option == nil {
+		return 0
+	}
+	return option.OddsMultiplier
+}
+
+// LockedMultiplierForChange returns the odds to lock when this house wager bet changes to amount on optionID
+// while the option is offered at currentOdds. Bits already staked on the same option keep the odds they were
+// locked at and only the added bits take the current odds, so the lock is their stake-weighted average.
+// Switching options prices the whole bet at the new option's current odds.
+func (p *GroupWagerParticipant) LockedMultiplierForChange(optionID, amount int64, currentOdds float64) float64 {
+	if p.OptionID != optionID || p.LockedMultiplier == nil || p.Amount <= 0 {
+		return currentOdds
+	}
+	if amount <= p.Amount {
+		return *p.LockedMultiplier
+	}
+	added := amount - p.Amount
+	return (float64(p.Amount)*(*p.LockedMultiplier) + float64(added)*currentOdds) / float64(amount)
+}
+
+// PreviewBet projects the multiplier and payout of betting amount on option, replacing the user's existing
+// bet if they have one. House wagers pay the posted odds; pool wagers are priced on the pot after the bet.
+func (gwd *GroupWagerDetail) PreviewBet(option *GroupWagerOption, existing *GroupWagerParticipant, amount, availableBalance int64) *GroupWagerBetPreview {
+	preview := &GroupWagerBetPreview{
+		Wager:            gwd.Wager,
+		Option:           option,
+		Amount:           amount,
+		AvailableBalance: availableBalance,
+	}
+
+	if gwd.Wager.IsHouseWager() {
+		preview.Multiplier = option.OddsMultiplier
+		if existing != nil {
+			preview.PreviousAmount = existing.Amount
+			preview.Multiplier = existing.LockedMultiplierForChange(option.ID, amount, option.OddsMultiplier)
+		}
+		preview.PotentialPayout = int64(float64(amount) * preview.Multiplier)
+		return preview
+	}
+
+	optionTotal := option.TotalAmount + amount
+	totalPot := gwd.Wager.TotalPot + amount
+	if existing != nil {
+		preview.PreviousAmount = existing.Amount
+		totalPot -= existing.Amount
+		if existing.OptionID == option.ID {
+			optionTotal -= existing.Amount
+		}
+	}
+
+	preview.Multiplier = float64(totalPot) / float64(optionTotal)
+	preview.PotentialPayout = amount * totalPot / optionTotal
+	return preview
+}
+
 // GetParticipantsByOption groups participants by their chosen option
 func (gwd *GroupWagerDetail) GetParticipantsByOption() map[int64][]*GroupWagerParticipant {
 	result := make(map[int64][]*GroupWagerParticipant)
@@ -227,4 +504,83 @@ func (gwd *GroupWagerDetail) HasMultipleOptionsWithParticipants() bool {
 	}
 
 	return optionsWithParticipants >= 2
-}
\ No newline at end of file
+}
+
+// PotTaxFor returns the winnings withheld from a winner by the guild's pot tax
+func (r *GroupWagerResult) PotTaxFor(discordID int64) int64 {
+	for _, share := range r.PotTaxes {
+		if share.DiscordID == discordID {
+			return share.Amount
+		}
+	}
+	return 0
+}
+
+// Settlements returns every participant's settlement, winners first, each side ordered by net change
+// from largest to smallest movement
+func (r *GroupWagerResult) Settlements() []GroupWagerSettlement {
+	optionsByID := make(map[int64]*GroupWagerOption, len(r.Options))
+	for _, option := range r.Options {
+		optionsByID[option.ID] = option
+	}
+	if r.WinningOption != nil {
+		optionsByID[r.WinningOption.ID] = r.WinningOption
+	}
+
+	// A participant's first change records the option they originally bet on
+	originalOptions := make(map[int64]int64)
+	for _, change := range r.BetChanges {
+		if _, seen := originalOptions[change.DiscordID]; !seen {
+			originalOptions[change.DiscordID] = change.PreviousOptionID
+		}
+	}
+	switchedFrom := func(participant *GroupWagerParticipant) *GroupWagerOption {
+		original, ok := originalOptions[participant.DiscordID]
+		if !ok || original == participant.OptionID {
+			return nil
+		}
+		return optionsByID[original]
+	}
+
+	settlements := make([]GroupWagerSettlement, 0, len(r.Winners)+len(r.Losers))
+	for _, winner := range r.Winners {
+		payout := r.PayoutDetails[winner.DiscordID]
+		potTax := r.PotTaxFor(winner.DiscordID)
+		settlements = append(settlements, GroupWagerSettlement{
+			DiscordID:    winner.DiscordID,
+			Option:       optionsByID[winner.OptionID],
+			Amount:       winner.Amount,
+			Payout:       payout,
+			NetChange:    payout - winner.Amount - potTax,
+			PotTax:       potTax,
+			Won:          true,
+			SwitchedFrom: switchedFrom(winner),
+		})
+	}
+	for _, loser := range r.Losers {
+		loss := loser.Amount
+		if r.GroupWager != nil && r.GroupWager.IsPoolWager() && r.MaxWinnerBet > 0 && loss > r.MaxWinnerBet {
+			loss = r.MaxWinnerBet
+		}
+		settlements = append(settlements, GroupWagerSettlement{
+			DiscordID:     loser.DiscordID,
+			Option:        optionsByID[loser.OptionID],
+			Amount:        loser.Amount,
+			NetChange:     -loss,
+			EffectiveLoss: loss,
+			SwitchedFrom:  switchedFrom(loser),
+		})
+	}
+
+	sort.SliceStable(settlements, func(i, j int) bool {
+		if settlements[i].Won != settlements[j].Won {
+			return settlements[i].Won
+		}
+		if settlements[i].Won {
+			return settlements[i].NetChange > settlements[j].NetChange
+		}
+		return settlements[i].NetChange < settlements[j].NetChange
+	})
+
+	return settlements
+}
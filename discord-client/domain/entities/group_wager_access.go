@@ -0,0 +1,83 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Group wager access limits
+const (
+	MaxGroupWagerInvitees        = 25   // Most users a creator can invite, matching Discord's user select limit
+	MaxGroupWagerParticipantsCap = 1000 // Highest participant limit a creator can set
+)
+
+var (
+	// ErrGroupWagerFull is returned when a new bettor joins a wager that has reached its participant limit
+	ErrGroupWagerFull = errors.New("this wager has reached its participant limit")
+	// ErrNotInvitedToGroupWager is returned when someone outside an invite-only wager's role or invite list bets on it
+	ErrNotInvitedToGroupWager = errors.New("this wager is invite-only and you are not invited")
+	// ErrGroupWagerAccessLocked is returned when access is changed after other users have already bet
+	ErrGroupWagerAccessLocked = errors.New("who can bet can only be changed before anyone else has bet")
+)
+
+// GroupWagerAccess restricts who may bet on a group wager and how many users may join.
+// Access is only loaded for single wager lookups.
+type GroupWagerAccess struct {
+	MaxParticipants   *int    // nil = no limit
+	AllowedRoleID     *int64  // Members of this role may bet
+	InvitedDiscordIDs []int64 // These users may bet
+}
+
+// IsInviteOnly checks if only a role or an invite list may bet
+func (a GroupWagerAccess) IsInviteOnly() bool {
+	return a.AllowedRoleID != nil || len(a.InvitedDiscordIDs) > 0
+}
+
+// Validate checks the participant limit and invite list are within bounds
+func (a GroupWagerAccess) Validate() error {
+	if a.MaxParticipants != nil && (*a.MaxParticipants < 1 || *a.MaxParticipants > MaxGroupWagerParticipantsCap) {
+		return fmt.Errorf("max participants must be between 1 and %d", MaxGroupWagerParticipantsCap)
+	}
+	if len(a.InvitedDiscordIDs) > MaxGroupWagerInvitees {
+		return fmt.Errorf("a wager can invite at most %d users", MaxGroupWagerInvitees)
+	}
+	return nil
+}
+
+// Access returns who may bet on the wager
+func (gw *GroupWager) Access() GroupWagerAccess {
+	return GroupWagerAccess{
+		MaxParticipants:   gw.MaxParticipants,
+		AllowedRoleID:     gw.AllowedRoleID,
+		InvitedDiscordIDs: gw.InvitedDiscordIDs,
+	}
+}
+
+// SetAccess sets who may bet on the wager
+func (gw *GroupWager) SetAccess(access GroupWagerAccess) {
+	gw.MaxParticipants = access.MaxParticipants
+	gw.AllowedRoleID = access.AllowedRoleID
+	gw.InvitedDiscordIDs = access.InvitedDiscordIDs
+}
+
+// CheckCanJoin explains why a user who has not bet yet cannot join the wager, or returns nil if they can.
+// roleIDs are the user's roles in the guild. The creator can always bet on their own wager.
+func (gw *GroupWager) CheckCanJoin(discordID int64, roleIDs []int64, participantCount int) error {
+	access := gw.Access()
+	isCreator := gw.CreatorDiscordID != nil && *gw.CreatorDiscordID == discordID
+
+	if access.IsInviteOnly() && !isCreator {
+		invited := slices.Contains(access.InvitedDiscordIDs, discordID) ||
+			(access.AllowedRoleID != nil && slices.Contains(roleIDs, *access.AllowedRoleID))
+		if !invited {
+			return ErrNotInvitedToGroupWager
+		}
+	}
+
+	if access.MaxParticipants != nil && participantCount >= *access.MaxParticipants {
+		return ErrGroupWagerFull
+	}
+
+	return nil
+}
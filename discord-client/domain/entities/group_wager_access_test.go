@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupWager_CheckCanJoin(t *testing.T) {
+	t.Parallel()
+
+	creator := int64(1)
+	role := int64(500)
+	two := 2
+
+	tests := []struct {
+		name         string
+		access       GroupWagerAccess
+		discordID    int64
+		roleIDs      []int64
+		participants int
+		want         error
+	}{
+		{name: "open wager", discordID: 10},
+		{name: "invited user", access: GroupWagerAccess{InvitedDiscordIDs: []int64{10, 11}}, discordID: 10},
+		{name: "member of allowed role", access: GroupWagerAccess{AllowedRoleID: &role}, discordID: 10, roleIDs: []int64{7, role}},
+		{name: "not invited", access: GroupWagerAccess{AllowedRoleID: &role, InvitedDiscordIDs: []int64{11}}, discordID: 10, roleIDs: []int64{7}, want: ErrNotInvitedToGroupWager},
+		{name: "creator is always invited", access: GroupWagerAccess{InvitedDiscordIDs: []int64{11}}, discordID: creator},
+		{name: "room left", access: GroupWagerAccess{MaxParticipants: &two}, discordID: 10, participants: 1},
+		{name: "full", access: GroupWagerAccess{MaxParticipants: &two}, discordID: 10, participants: 2, want: ErrGroupWagerFull},
+		{name: "full applies to the creator", access: GroupWagerAccess{MaxParticipants: &two}, discordID: creator, participants: 2, want: ErrGroupWagerFull},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wager := &GroupWager{CreatorDiscordID: &creator}
+			wager.SetAccess(tt.access)
+
+			err := wager.CheckCanJoin(tt.discordID, tt.roleIDs, tt.participants)
+			if tt.want == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupWagerAccess_Validate(t *testing.T) {
+	t.Parallel()
+
+	zero, cap := 0, MaxGroupWagerParticipantsCap
+	assert.NoError(t, GroupWagerAccess{}.Validate())
+	assert.NoError(t, GroupWagerAccess{MaxParticipants: &cap}.Validate())
+	assert.Error(t, GroupWagerAccess{MaxParticipants: &zero}.Validate())
+	assert.Error(t, GroupWagerAccess{InvitedDiscordIDs: make([]int64, MaxGroupWagerInvitees+1)}.Validate())
+}
@@ -0,0 +1,32 @@
+package entities
+
+// GroupWagerBetMode selects how bettors choose an option on a group wager message
+type GroupWagerBetMode string
+
+const (
+	GroupWagerBetModeButtons GroupWagerBetMode = "buttons" // One button per option
+	GroupWagerBetModeMenu    GroupWagerBetMode = "menu"    // A single dropdown listing every option
+)
+
+// DefaultGroupWagerBetMode is used when a guild has not chosen a bet mode
+const DefaultGroupWagerBetMode = GroupWagerBetModeButtons
+
+// IsValid checks if the bet mode is supported
+func (m GroupWagerBetMode) IsValid() bool {
+	switch m {
+	case GroupWagerBetModeButtons, GroupWagerBetModeMenu:
+		return true
+	default:
+		return false
+	}
+}
+
+// DisplayName returns a user-friendly description of the bet mode
+func (m GroupWagerBetMode) DisplayName() string {
+	switch m {
+	case GroupWagerBetModeMenu:
+		return "a dropdown menu"
+	default:
+		return "one button per option"
+	}
+}
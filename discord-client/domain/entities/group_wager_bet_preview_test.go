@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupWagerDetail_PreviewBet(t *testing.T) {
+	t.Parallel()
+
+	poolDetail := func() (*GroupWagerDetail, *GroupWagerOption, *GroupWagerOption) {
+		yes := &GroupWagerOption{ID: 1, TotalAmount: 3000}
+		no := &GroupWagerOption{ID: 2, TotalAmount: 1000}
+		return &GroupWagerDetail{
+			Wager:   &GroupWager{WagerType: GroupWagerTypePool, TotalPot: 4000},
+			Options: []*GroupWagerOption{yes, no},
+		}, yes, no
+	}
+
+	t.Run("new pool bet is priced on the pot after the bet", func(t *testing.T) {
+		t.Parallel()
+		detail, _, no := poolDetail()
+
+		preview := detail.PreviewBet(no, nil, 1000, 5000)
+		assert.Equal(t, 2.5, preview.Multiplier)
+		assert.Equal(t, int64(2500), preview.PotentialPayout)
+		assert.Equal(t, int64(0), preview.PreviousAmount)
+		assert.Equal(t, int64(5000), preview.AvailableBalance)
+	})
+
+	t.Run("raising a pool bet on the same option counts only the increase", func(t *testing.T) {
+		t.Parallel()
+		detail, _, no := poolDetail()
+
+		preview := detail.PreviewBet(no, &GroupWagerParticipant{OptionID: 2, Amount: 1000}, 2000, 5000)
+		assert.Equal(t, 2.5, preview.Multiplier)
+		assert.Equal(t, int64(5000), preview.PotentialPayout)
+		assert.Equal(t, int64(1000), preview.PreviousAmount)
+	})
+
+	t.Run("switching pool options moves the previous bet", func(t *testing.T) {
+		t.Parallel()
+		detail, yes, _ := poolDetail()
+
+		preview := detail.PreviewBet(yes, &GroupWagerParticipant{OptionID: 2, Amount: 1000}, 1000, 5000)
+		assert.Equal(t, 1.0, preview.Multiplier)
+		assert.Equal(t, int64(1000), preview.PotentialPayout)
+	})
+
+	t.Run("house bet pays the posted odds", func(t *testing.T) {
+		t.Parallel()
+		option := &GroupWagerOption{ID: 1, OddsMultiplier: 1.85}
+		detail := &GroupWagerDetail{Wager: &GroupWager{WagerType: GroupWagerTypeHouse}, Options: []*GroupWagerOption{option}}
+
+		preview := detail.PreviewBet(option, nil, 1000, 5000)
+		assert.Equal(t, 1.85, preview.Multiplier)
+		assert.Equal(t, int64(1850), preview.PotentialPayout)
+	})
+}
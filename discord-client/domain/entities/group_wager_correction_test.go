@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupWager_CanBeCorrected(t *testing.T) {
+	t.Parallel()
+
+	resolvedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	winningOptionID := int64(1)
+	window := 15 * time.Minute
+
+	tests := []struct {
+		name            string
+		state           GroupWagerState
+		resolvedAt      *time.Time
+		winningOptionID *int64
+		now             time.Time
+		window          time.Duration
+		want            bool
+	}{
+		{name: "within window", state: GroupWagerStateResolved, resolvedAt: &resolvedAt, winningOptionID: &winningOptionID, now: resolvedAt.Add(10 * time.Minute), window: window, want: true},
+		{name: "window elapsed", state: GroupWagerStateResolved, resolvedAt: &resolvedAt, winningOptionID: &winningOptionID, now: resolvedAt.Add(window), window: window, want: false},
+		{name: "corrections disabled", state: GroupWagerStateResolved, resolvedAt: &resolvedAt, winningOptionID: &winningOptionID, now: resolvedAt, window: 0, want: false},
+		{name: "no winning option", state: GroupWagerStateResolved, resolvedAt: &resolvedAt, now: resolvedAt, window: window, want: false},
+		{name: "not resolved", state: GroupWagerStateCancelled, resolvedAt: &resolvedAt, winningOptionID: &winningOptionID, now: resolvedAt, window: window, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			wager := &GroupWager{State: tt.state, ResolvedAt: tt.resolvedAt, WinningOptionID: tt.winningOptionID}
+			assert.Equal(t, tt.want, wager.CanBeCorrected(tt.now, tt.window))
+		})
+	}
+}
+
+func TestBalanceHistory_MetadataInt64(t *testing.T) {
+	t.Parallel()
+
+	history := &BalanceHistory{TransactionMetadata: map[string]any{
+		"recorded": int64(7),
+		"decoded":  float64(42),
+		"name":     "draw",
+	}}
+
+	value, ok := history.MetadataInt64("recorded")
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), value)
+
+	value, ok = history.MetadataInt64("decoded")
+	assert.True(t, ok)
+	assert.Equal(t, int64(42), value)
+
+	_, ok = history.MetadataInt64("name")
+	assert.False(t, ok)
+
+	_, ok = history.MetadataInt64("missing")
+	assert.False(t, ok)
+}
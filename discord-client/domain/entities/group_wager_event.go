@@ -0,0 +1,30 @@
+package entities
+
+import "time"
+
+// GroupWagerEventType identifies a state-affecting action on a group wager
+type GroupWagerEventType string
+
+const (
+	GroupWagerEventCreated        GroupWagerEventType = "created"
+	GroupWagerEventBetPlaced      GroupWagerEventType = "bet_placed"
+	GroupWagerEventBetChanged     GroupWagerEventType = "bet_changed"
+	GroupWagerEventBetWithdrawn   GroupWagerEventType = "bet_withdrawn"
+	GroupWagerEventOddsUpdated    GroupWagerEventType = "odds_updated"
+	GroupWagerEventResolved       GroupWagerEventType = "resolved"
+	GroupWagerEventCancelled      GroupWagerEventType = "cancelled"
+	GroupWagerEventRestored       GroupWagerEventType = "restored"
+	GroupWagerEventVotingExtended GroupWagerEventType = "voting_extended"
+	GroupWagerEventCorrected      GroupWagerEventType = "resolution_corrected"
+)
+
+// GroupWagerEvent is an append-only record of an action taken on a group wager.
+// A nil ActorDiscordID means the system performed the action.
+type GroupWagerEvent struct {
+	ID             int64               `db:"id"`
+	GroupWagerID   int64               `db:"group_wager_id"`
+	EventType      GroupWagerEventType `db:"event_type"`
+	ActorDiscordID *int64              `db:"actor_discord_id"`
+	Payload        map[string]any      `db:"payload"`
+	CreatedAt      time.Time           `db:"created_at"`
+}
@@ -0,0 +1,26 @@
+package entities
+
+import "errors"
+
+// ErrGroupWagerNotFollowable is returned when following a wager that is already resolved or cancelled
+var ErrGroupWagerNotFollowable = errors.New("this wager has already ended")
+
+// GroupWagerPotMilestones are the pot sizes followers are notified of, smallest first
+var GroupWagerPotMilestones = []int64{10_000, 50_000, 100_000, 250_000, 500_000, 1_000_000}
+
+// GroupWagerPotMilestone returns the largest milestone the pot passed on its way from oldPot to newPot,
+// or 0 if it didn't reach a new one. A pot that shrinks never passes a milestone.
+func GroupWagerPotMilestone(oldPot, newPot int64) int64 {
+	var reached int64
+	for _, milestone := range GroupWagerPotMilestones {
+		if oldPot < milestone && newPot >= milestone {
+			reached = milestone
+		}
+	}
+	return reached
+}
+
+// CanBeFollowed checks if the wager hasn't ended yet, so followers still have something to hear about
+func (gw *GroupWager) CanBeFollowed() bool {
+	return gw.IsActive() || gw.IsPendingResolution()
+}
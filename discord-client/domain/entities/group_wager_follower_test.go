@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupWagerPotMilestone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		oldPot int64
+		newPot int64
+		want   int64
+	}{
+		{name: "below the first milestone", oldPot: 0, newPot: 9_999, want: 0},
+		{name: "reaches a milestone exactly", oldPot: 9_000, newPot: 10_000, want: 10_000},
+		{name: "already past the milestone", oldPot: 10_000, newPot: 20_000, want: 0},
+		{name: "passes several milestones", oldPot: 5_000, newPot: 120_000, want: 100_000},
+		{name: "pot shrinks", oldPot: 60_000, newPot: 40_000, want: 0},
+		{name: "past the largest milestone", oldPot: 1_000_000, newPot: 5_000_000, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, GroupWagerPotMilestone(tt.oldPot, tt.newPot))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupWagerOption_Cap(t *testing.T) {
+	t.Parallel()
+
+	capAt := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name          string
+		maxTotal      *int64
+		totalAmount   int64
+		additional    int64
+		wantHasCap    bool
+		wantRemaining int64
+		wantExceeds   bool
+	}{
+		{name: "uncapped option", totalAmount: 100000, additional: 100000, wantHasCap: false, wantRemaining: 0, wantExceeds: false},
+		{name: "bet fits under the cap", maxTotal: capAt(5000), totalAmount: 3000, additional: 1000, wantHasCap: true, wantRemaining: 2000, wantExceeds: false},
+		{name: "bet exactly fills the cap", maxTotal: capAt(5000), totalAmount: 3000, additional: 2000, wantHasCap: true, wantRemaining: 2000, wantExceeds: false},
+		{name: "bet exceeds the cap", maxTotal: capAt(5000), totalAmount: 3000, additional: 2001, wantHasCap: true, wantRemaining: 2000, wantExceeds: true},
+		{name: "lowering a bet is always allowed", maxTotal: capAt(5000), totalAmount: 6000, additional: -500, wantHasCap: true, wantRemaining: 0, wantExceeds: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			o := &GroupWagerOption{TotalAmount: tt.totalAmount, MaxTotalAmount: tt.maxTotal}
+
+			assert.Equal(t, tt.wantHasCap, o.HasCap())
+			assert.Equal(t, tt.wantRemaining, o.RemainingCapacity())
+			assert.Equal(t, tt.wantExceeds, o.WouldExceedCap(tt.additional))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrGroupWagerOptionsLocked is returned when options are reordered after someone has bet
+var ErrGroupWagerOptionsLocked = errors.New("options can only be reordered before anyone has bet")
+
+// NewOptionOrders maps each option's ID to its new position, given the options' current numbers (1-based)
+// in the order they should appear. Every option must be listed exactly once.
+func (d *GroupWagerDetail) NewOptionOrders(optionNumbers []int) (map[int64]int16, error) {
+	if len(optionNumbers) != len(d.Options) {
+		return nil, fmt.Errorf("the new order must list all %d options exactly once", len(d.Options))
+	}
+
+	byNumber := make(map[int]*GroupWagerOption, len(d.Options))
+	for _, option := range d.Options {
+		byNumber[int(option.OptionOrder)+1] = option
+	}
+
+	orders := make(map[int64]int16, len(optionNumbers))
+	for position, number := range optionNumbers {
+		option, ok := byNumber[number]
+		if !ok {
+			return nil, fmt.Errorf("wager #%d has no option %d", d.Wager.ID, number)
+		}
+		if _, seen := orders[option.ID]; seen {
+			return nil, fmt.Errorf("option %d is listed more than once", number)
+		}
+		orders[option.ID] = int16(position)
+	}
+
+	return orders, nil
+}
@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWagerDetail_NewOptionOrders(t *testing.T) {
+	t.Parallel()
+
+	detail := &GroupWagerDetail{
+		Wager: &GroupWager{ID: 7},
+		Options: []*GroupWagerOption{
+			{ID: 10, OptionOrder: 0},
+			{ID: 11, OptionOrder: 1},
+			{ID: 12, OptionOrder: 2},
+		},
+	}
+
+	t.Run("moves options to their listed position", func(t *testing.T) {
+		orders, err := detail.NewOptionOrders([]int{3, 1, 2})
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]int16{12: 0, 10: 1, 11: 2}, orders)
+	})
+
+	t.Run("rejects missing options", func(t *testing.T) {
+		_, err := detail.NewOptionOrders([]int{2, 1})
+		assert.ErrorContains(t, err, "all 3 options")
+	})
+
+	t.Run("rejects unknown options", func(t *testing.T) {
+		_, err := detail.NewOptionOrders([]int{1, 2, 4})
+		assert.ErrorContains(t, err, "no option 4")
+	})
+
+	t.Run("rejects duplicates", func(t *testing.T) {
+		_, err := detail.NewOptionOrders([]int{1, 1, 2})
+		assert.ErrorContains(t, err, "more than once")
+	})
+}
@@ -0,0 +1,135 @@
+package entities
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// GroupWagerOptionStyle is the colour of a group wager option's bet button
+type GroupWagerOptionStyle string
+
+const (
+	GroupWagerOptionStylePrimary   GroupWagerOptionStyle = "primary"   // Blurple
+	GroupWagerOptionStyleSecondary GroupWagerOptionStyle = "secondary" // Grey
+	GroupWagerOptionStyleSuccess   GroupWagerOptionStyle = "success"   // Green
+	GroupWagerOptionStyleDanger    GroupWagerOptionStyle = "danger"    // Red
+)
+
+// groupWagerOptionStyleNames maps the colour names accepted in option lines to button styles
+var groupWagerOptionStyleNames = map[string]GroupWagerOptionStyle{
+	"blue":      GroupWagerOptionStylePrimary,
+	"blurple":   GroupWagerOptionStylePrimary,
+	"primary":   GroupWagerOptionStylePrimary,
+	"grey":      GroupWagerOptionStyleSecondary,
+	"gray":      GroupWagerOptionStyleSecondary,
+	"secondary": GroupWagerOptionStyleSecondary,
+	"green":     GroupWagerOptionStyleSuccess,
+	"success":   GroupWagerOptionStyleSuccess,
+	"red":       GroupWagerOptionStyleDanger,
+	"danger":    GroupWagerOptionStyleDanger,
+}
+
+// ParseGroupWagerOptionStyle parses a colour name such as "green" or "danger" into a button style
+func ParseGroupWagerOptionStyle(name string) (GroupWagerOptionStyle, bool) {
+	style, ok := groupWagerOptionStyleNames[strings.ToLower(strings.TrimSpace(name))]
+	return style, ok
+}
+
+var (
+	// customEmojiPattern matches a custom emoji as Discord renders it, e.g. <:t1:123> or <a:spin:456>
+	customEmojiPattern = regexp.MustCompile(`^<a?:\w{2,32}:\d+>$`)
+	// emojiShortcodePattern matches an emoji shortcode typed by a user, e.g. :t1:
+	emojiShortcodePattern = regexp.MustCompile(`^:\w{2,32}:$`)
+)
+
+// IsCustomEmoji checks if an option emoji refers to a guild's custom emoji
+func IsCustomEmoji(emoji string) bool {
+	return customEmojiPattern.MatchString(emoji)
+}
+
+// IsEmojiShortcode checks if an option emoji is a :name: shortcode that still needs resolving to a custom emoji
+func IsEmojiShortcode(emoji string) bool {
+	return emojiShortcodePattern.MatchString(emoji)
+}
+
+// isUnicodeEmoji checks if a token looks like a unicode emoji: symbols only, no letters, digits or ASCII
+func isUnicodeEmoji(token string) bool {
+	first := true
+	for _, r := range token {
+		if r <= unicode.MaxASCII {
+			return false
+		}
+		if first && !unicode.IsSymbol(r) {
+			return false
+		}
+		first = false
+	}
+	return !first
+}
+
+// isOptionEmoji checks if a token can be used as a bet button emoji
+func isOptionEmoji(token string) bool {
+	return IsCustomEmoji(token) || IsEmojiShortcode(token) || isUnicodeEmoji(token)
+}
+
+// GroupWagerOptionLine is an option line split into its text and bet button appearance
+type GroupWagerOptionLine struct {
+	Text  string
+	Emoji string                // Empty for the default numbered emoji
+	Style GroupWagerOptionStyle // Empty for the default colour
+}
+
+// ParseGroupWagerOptionLine splits an option line such as "T1 :t1: [red]" into the option text, an optional
+// trailing emoji and an optional trailing [colour]. A line that would be left without text is kept as plain text.
+func ParseGroupWagerOptionLine(line string) GroupWagerOptionLine {
+	parsed := GroupWagerOptionLine{Text: strings.TrimSpace(line)}
+
+	if rest, token := splitLastToken(parsed.Text); rest != "" && strings.HasPrefix(token, "[") && strings.HasSuffix(token, "]") {
+		if style, ok := ParseGroupWagerOptionStyle(token[1 : len(token)-1]); ok {
+			parsed.Text, parsed.Style = rest, style
+		}
+	}
+
+	if rest, token := splitLastToken(parsed.Text); rest != "" && isOptionEmoji(token) {
+		parsed.Text, parsed.Emoji = rest, token
+	}
+
+	return parsed
+}
+
+// splitLastToken splits the last whitespace separated token off a string
+func splitLastToken(s string) (rest, token string) {
+	i := strings.LastIndexFunc(s, unicode.IsSpace)
+	if i < 0 {
+		return "", s
+	}
+	return strings.TrimSpace(s[:i]), s[i+1:]
+}
+
+// String formats the line back into option line syntax
+func (l GroupWagerOptionLine) String() string {
+	line := l.Text
+	if l.Emoji != "" {
+		line += " " + l.Emoji
+	}
+	if l.Style != "" {
+		line += " [" + string(l.Style) + "]"
+	}
+	return line
+}
+
+// Apply sets the option's text and bet button appearance from the line
+func (l GroupWagerOptionLine) Apply(option *GroupWagerOption) {
+	option.OptionText = l.Text
+	option.ButtonEmoji = nil
+	option.ButtonStyle = nil
+	if l.Emoji != "" {
+		emoji := l.Emoji
+		option.ButtonEmoji = &emoji
+	}
+	if l.Style != "" {
+		style := l.Style
+		option.ButtonStyle = &style
+	}
+}
@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGroupWagerOptionLine(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		line string
+		want GroupWagerOptionLine
+	}{
+		{name: "plain text", line: "T1", want: GroupWagerOptionLine{Text: "T1"}},
+		{name: "unicode emoji", line: "T1 🐯", want: GroupWagerOptionLine{Text: "T1", Emoji: "🐯"}},
+		{name: "shortcode", line: "T1 :t1logo:", want: GroupWagerOptionLine{Text: "T1", Emoji: ":t1logo:"}},
+		{name: "custom emoji", line: "Gen.G <:geng:123456789>", want: GroupWagerOptionLine{Text: "Gen.G", Emoji: "<:geng:123456789>"}},
+		{name: "animated custom emoji", line: "Yes <a:spin:42>", want: GroupWagerOptionLine{Text: "Yes", Emoji: "<a:spin:42>"}},
+		{name: "colour only", line: "Blue side [blue]", want: GroupWagerOptionLine{Text: "Blue side", Style: GroupWagerOptionStylePrimary}},
+		{name: "emoji and colour", line: "  Red side 🔴 [RED]  ", want: GroupWagerOptionLine{Text: "Red side", Emoji: "🔴", Style: GroupWagerOptionStyleDanger}},
+		{name: "unknown colour stays in text", line: "Team [purple]", want: GroupWagerOptionLine{Text: "Team [purple]"}},
+		{name: "emoji alone is the text", line: "🔥", want: GroupWagerOptionLine{Text: "🔥"}},
+		{name: "colour alone is the text", line: "[green]", want: GroupWagerOptionLine{Text: "[green]"}},
+		{name: "words with accents are not emoji", line: "Over é", want: GroupWagerOptionLine{Text: "Over é"}},
+		{name: "keycap digits are text", line: "Top 4", want: GroupWagerOptionLine{Text: "Top 4"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseGroupWagerOptionLine(tt.line))
+		})
+	}
+}
+
+func TestGroupWagerOptionLine_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	line := GroupWagerOptionLine{Text: "Win", Emoji: "🟢", Style: GroupWagerOptionStyleSuccess}
+	assert.Equal(t, "Win 🟢 [success]", line.String())
+	assert.Equal(t, line, ParseGroupWagerOptionLine(line.String()))
+
+	var option GroupWagerOption
+	line.Apply(&option)
+	assert.Equal(t, "Win", option.OptionText)
+	if assert.NotNil(t, option.ButtonEmoji) && assert.NotNil(t, option.ButtonStyle) {
+		assert.Equal(t, "🟢", *option.ButtonEmoji)
+		assert.Equal(t, GroupWagerOptionStyleSuccess, *option.ButtonStyle)
+	}
+
+	GroupWagerOptionLine{Text: "Loss"}.Apply(&option)
+	assert.Nil(t, option.ButtonEmoji)
+	assert.Nil(t, option.ButtonStyle)
+}
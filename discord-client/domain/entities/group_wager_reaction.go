@@ -0,0 +1,41 @@
+package entities
+
+import "fmt"
+
+// Reaction wager stake limits, in bits
+const (
+	DefaultReactionStake int64 = 100
+	MaxReactionStake     int64 = 1_000_000
+)
+
+// ValidateReactionStake checks that a reaction wager's default stake is within limits
+func ValidateReactionStake(stake int64) error {
+	if stake < 1 || stake > MaxReactionStake {
+		return fmt.Errorf("reaction stake must be between 1 and %d bits", MaxReactionStake)
+	}
+	return nil
+}
+
+// IsReactionWager checks if bets can be placed by reacting to a message
+func (gw *GroupWager) IsReactionWager() bool {
+	return gw.ReactionMessageID != nil
+}
+
+// GetReactionStake returns the bits a reaction bets, or DefaultReactionStake if none is set
+func (gw *GroupWager) GetReactionStake() int64 {
+	if gw.ReactionStake == nil {
+		return DefaultReactionStake
+	}
+	return *gw.ReactionStake
+}
+
+// ReactionBetAmount returns what a reaction from the user bets: their existing bet when switching options,
+// so a reaction never changes how much they have riding, otherwise the wager's reaction stake
+func (d *GroupWagerDetail) ReactionBetAmount(discordID int64) int64 {
+	for _, participant := range d.Participants {
+		if participant.DiscordID == discordID {
+			return participant.Amount
+		}
+	}
+	return d.Wager.GetReactionStake()
+}
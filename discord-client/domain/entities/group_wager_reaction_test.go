@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateReactionStake(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, ValidateReactionStake(1))
+	assert.NoError(t, ValidateReactionStake(MaxReactionStake))
+	assert.Error(t, ValidateReactionStake(0))
+	assert.Error(t, ValidateReactionStake(MaxReactionStake+1))
+}
+
+func TestGroupWagerDetail_ReactionBetAmount(t *testing.T) {
+	t.Parallel()
+
+	stake := int64(250)
+	detail := &GroupWagerDetail{
+		Wager:        &GroupWager{ReactionStake: &stake},
+		Participants: []*GroupWagerParticipant{{DiscordID: 100, Amount: 1000}},
+	}
+
+	assert.Equal(t, int64(1000), detail.ReactionBetAmount(100), "switching options keeps the existing bet")
+	assert.Equal(t, int64(250), detail.ReactionBetAmount(200), "new bettors stake the wager's reaction stake")
+
+	detail.Wager.ReactionStake = nil
+	assert.Equal(t, DefaultReactionStake, detail.ReactionBetAmount(200))
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"cmp"
+	"slices"
+)
+
+// GroupWagerRefund is one participant's bet returned when a group wager is cancelled
+type GroupWagerRefund struct {
+	DiscordID int64
+	OptionID  int64
+	Amount    int64
+}
+
+// GroupWagerRefundSummary lists the bets returned by cancelling a group wager. Bets are reserved from
+// the participant's available balance rather than debited, so returning them moves no bits and leaves
+// no ledger entries; cancelling the wager releases every reservation at once.
+type GroupWagerRefundSummary struct {
+	GroupWagerID  int64
+	Refunds       []GroupWagerRefund // Largest bet first
+	TotalRefunded int64
+}
+
+// NewGroupWagerRefundSummary lists the bets returned by cancelling the wager
+func NewGroupWagerRefundSummary(detail *GroupWagerDetail) *GroupWagerRefundSummary {
+	summary := &GroupWagerRefundSummary{GroupWagerID: detail.Wager.ID}
+	for _, participant := range detail.Participants {
+		if participant.Amount <= 0 {
+			continue
+		}
+		summary.Refunds = append(summary.Refunds, GroupWagerRefund{
+			DiscordID: participant.DiscordID,
+			OptionID:  participant.OptionID,
+			Amount:    participant.Amount,
+		})
+		summary.TotalRefunded += participant.Amount
+	}
+
+	// Largest bet first, ties broken by Discord ID so the order is stable
+	slices.SortFunc(summary.Refunds, func(a, b GroupWagerRefund) int {
+		if a.Amount != b.Amount {
+			return cmp.Compare(b.Amount, a.Amount)
+		}
+		return cmp.Compare(a.DiscordID, b.DiscordID)
+	})
+	return summary
+}
+
+// ParticipantCount returns how many participants had a bet returned
+func (s *GroupWagerRefundSummary) ParticipantCount() int {
+	return len(s.Refunds)
+}
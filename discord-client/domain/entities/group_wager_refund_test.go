@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGroupWagerRefundSummary(t *testing.T) {
+	t.Parallel()
+
+	detail := &GroupWagerDetail{
+		Wager: &GroupWager{ID: 7},
+		Participants: []*GroupWagerParticipant{
+			{DiscordID: 3, OptionID: 1, Amount: 500},
+			{DiscordID: 1, OptionID: 2, Amount: 2000},
+			{DiscordID: 4, OptionID: 1, Amount: 0},
+			{DiscordID: 2, OptionID: 2, Amount: 500},
+		},
+	}
+
+	summary := NewGroupWagerRefundSummary(detail)
+
+	assert.Equal(t, int64(7), summary.GroupWagerID)
+	assert.Equal(t, int64(3000), summary.TotalRefunded)
+	assert.Equal(t, 3, summary.ParticipantCount())
+	assert.Equal(t, []GroupWagerRefund{
+		{DiscordID: 1, OptionID: 2, Amount: 2000},
+		{DiscordID: 2, OptionID: 2, Amount: 500},
+		{DiscordID: 3, OptionID: 1, Amount: 500},
+	}, summary.Refunds)
+}
+
+func TestNewGroupWagerRefundSummary_NoParticipants(t *testing.T) {
+	t.Parallel()
+
+	summary := NewGroupWagerRefundSummary(&GroupWagerDetail{Wager: &GroupWager{ID: 7}})
+
+	assert.Zero(t, summary.TotalRefunded)
+	assert.Zero(t, summary.ParticipantCount())
+}
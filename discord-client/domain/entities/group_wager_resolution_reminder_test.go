@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupWager_PendingResolutionSince(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	votingEndsAt := createdAt.Add(24 * time.Hour)
+
+	t.Run("uses voting end when set", func(t *testing.T) {
+		t.Parallel()
+		gw := &GroupWager{CreatedAt: createdAt, VotingEndsAt: &votingEndsAt}
+		assert.Equal(t, votingEndsAt, gw.PendingResolutionSince())
+	})
+
+	t.Run("falls back to creation time", func(t *testing.T) {
+		t.Parallel()
+		gw := &GroupWager{CreatedAt: createdAt}
+		assert.Equal(t, createdAt, gw.PendingResolutionSince())
+	})
+}
+
+func TestGroupWager_NeedsResolutionReminder(t *testing.T) {
+	t.Parallel()
+
+	votingEndsAt := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	at := func(hoursAfter int) time.Time { return votingEndsAt.Add(time.Duration(hoursAfter) * time.Hour) }
+	remindedAt := func(hoursAfter int) *time.Time { t := at(hoursAfter); return &t }
+
+	tests := []struct {
+		name       string
+		state      GroupWagerState
+		remindedAt *time.Time
+		now        time.Time
+		age        time.Duration
+		want       bool
+	}{
+		{name: "not pending resolution", state: GroupWagerStateActive, now: at(48), age: 24 * time.Hour, want: false},
+		{name: "reminders disabled", state: GroupWagerStatePendingResolution, now: at(48), age: 0, want: false},
+		{name: "not stuck long enough", state: GroupWagerStatePendingResolution, now: at(23), age: 24 * time.Hour, want: false},
+		{name: "stuck and never reminded", state: GroupWagerStatePendingResolution, now: at(24), age: 24 * time.Hour, want: true},
+		{name: "reminded recently", state: GroupWagerStatePendingResolution, remindedAt: remindedAt(24), now: at(30), age: 24 * time.Hour, want: false},
+		{name: "reminder is due again", state: GroupWagerStatePendingResolution, remindedAt: remindedAt(24), now: at(48), age: 24 * time.Hour, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gw := &GroupWager{State: tt.state, VotingEndsAt: &votingEndsAt, RemindedAt: tt.remindedAt}
+
+			assert.Equal(t, tt.want, gw.NeedsResolutionReminder(tt.now, tt.age))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWager_CanBeRestored(t *testing.T) {
+	t.Parallel()
+
+	cancelledAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		state       GroupWagerState
+		cancelledAt *time.Time
+		now         time.Time
+		want        bool
+	}{
+		{name: "within window", state: GroupWagerStateCancelled, cancelledAt: &cancelledAt, now: cancelledAt.Add(30 * time.Minute), want: true},
+		{name: "window elapsed", state: GroupWagerStateCancelled, cancelledAt: &cancelledAt, now: cancelledAt.Add(GroupWagerRestoreWindow), want: false},
+		{name: "cancelled before tracking", state: GroupWagerStateCancelled, now: cancelledAt, want: false},
+		{name: "not cancelled", state: GroupWagerStateResolved, cancelledAt: &cancelledAt, now: cancelledAt, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			wager := &GroupWager{State: tt.state, CancelledAt: tt.cancelledAt}
+			assert.Equal(t, tt.want, wager.CanBeRestored(tt.now))
+		})
+	}
+}
+
+func TestGroupWager_Restore(t *testing.T) {
+	t.Parallel()
+
+	cancelledAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	now := cancelledAt.Add(20 * time.Minute)
+	at := func(d time.Duration) *time.Time { t := cancelledAt.Add(d); return &t }
+
+	t.Run("reopens betting for the time that was left", func(t *testing.T) {
+		t.Parallel()
+		wager := &GroupWager{State: GroupWagerStateCancelled, CancelledAt: &cancelledAt, VotingEndsAt: at(30 * time.Minute)}
+
+		wager.Restore(now)
+		assert.Equal(t, GroupWagerStateActive, wager.State)
+		require.NotNil(t, wager.VotingEndsAt)
+		assert.Equal(t, now.Add(30*time.Minute), *wager.VotingEndsAt)
+		assert.Nil(t, wager.CancelledAt)
+	})
+
+	t.Run("reopens for at least the minimum period", func(t *testing.T) {
+		t.Parallel()
+		wager := &GroupWager{State: GroupWagerStateCancelled, CancelledAt: &cancelledAt, VotingEndsAt: at(time.Minute)}
+
+		wager.Restore(now)
+		assert.Equal(t, now.Add(MinRestoredVotingPeriod), *wager.VotingEndsAt)
+	})
+
+	t.Run("returns to pending resolution if voting had closed", func(t *testing.T) {
+		t.Parallel()
+		votingEndsAt := at(-time.Hour)
+		wager := &GroupWager{State: GroupWagerStateCancelled, CancelledAt: &cancelledAt, VotingEndsAt: votingEndsAt}
+
+		wager.Restore(now)
+		assert.Equal(t, GroupWagerStatePendingResolution, wager.State)
+		assert.Equal(t, votingEndsAt, wager.VotingEndsAt)
+	})
+
+	t.Run("ignored outside the window", func(t *testing.T) {
+		t.Parallel()
+		wager := &GroupWager{State: GroupWagerStateCancelled, CancelledAt: &cancelledAt}
+
+		wager.Restore(cancelledAt.Add(2 * GroupWagerRestoreWindow))
+		assert.Equal(t, GroupWagerStateCancelled, wager.State)
+	})
+}
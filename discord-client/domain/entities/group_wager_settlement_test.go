@@ -0,0 +1,96 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWagerResult_Settlements(t *testing.T) {
+	t.Parallel()
+
+	yes := &GroupWagerOption{ID: 1, OptionText: "Yes"}
+	no := &GroupWagerOption{ID: 2, OptionText: "No"}
+
+	t.Run("pool wager caps losses at the largest winning bet", func(t *testing.T) {
+		t.Parallel()
+
+		result := &GroupWagerResult{
+			GroupWager:    &GroupWager{ID: 7, WagerType: GroupWagerTypePool},
+			WinningOption: yes,
+			Options:       []*GroupWagerOption{yes, no},
+			Winners: []*GroupWagerParticipant{
+				{DiscordID: 10, OptionID: 1, Amount: 1000},
+				{DiscordID: 11, OptionID: 1, Amount: 3000},
+			},
+			Losers: []*GroupWagerParticipant{
+				{DiscordID: 20, OptionID: 2, Amount: 500},
+				{DiscordID: 21, OptionID: 2, Amount: 10000},
+			},
+			PayoutDetails: map[int64]int64{10: 1875, 11: 5625, 20: 0, 21: 0},
+			MaxWinnerBet:  3000,
+		}
+
+		settlements := result.Settlements()
+		require.Len(t, settlements, 4)
+
+		// Winners first by net change, then losers by largest loss
+		assert.Equal(t, []int64{11, 10, 21, 20}, []int64{
+			settlements[0].DiscordID, settlements[1].DiscordID, settlements[2].DiscordID, settlements[3].DiscordID,
+		})
+
+		assert.True(t, settlements[0].Won)
+		assert.Equal(t, int64(2625), settlements[0].NetChange)
+		assert.Same(t, yes, settlements[0].Option)
+
+		capped := settlements[2]
+		assert.Same(t, no, capped.Option)
+		assert.Equal(t, int64(3000), capped.EffectiveLoss)
+		assert.Equal(t, int64(-3000), capped.NetChange)
+		assert.True(t, capped.IsCapped())
+
+		assert.Equal(t, int64(500), settlements[3].EffectiveLoss)
+		assert.False(t, settlements[3].IsCapped())
+	})
+
+	t.Run("house wager losers lose their full bet", func(t *testing.T) {
+		t.Parallel()
+
+		result := &GroupWagerResult{
+			GroupWager:    &GroupWager{ID: 8, WagerType: GroupWagerTypeHouse},
+			WinningOption: yes,
+			Winners:       []*GroupWagerParticipant{{DiscordID: 10, OptionID: 1, Amount: 100}},
+			Losers:        []*GroupWagerParticipant{{DiscordID: 20, OptionID: 2, Amount: 10000}},
+			PayoutDetails: map[int64]int64{10: 250},
+		}
+
+		settlements := result.Settlements()
+		require.Len(t, settlements, 2)
+		assert.Equal(t, int64(150), settlements[0].NetChange)
+		assert.Equal(t, int64(10000), settlements[1].EffectiveLoss)
+		assert.False(t, settlements[1].IsCapped())
+		assert.Nil(t, settlements[1].Option, "options missing from the result are left nil")
+	})
+
+	t.Run("pot tax reduces a winner's net change", func(t *testing.T) {
+		t.Parallel()
+
+		result := &GroupWagerResult{
+			GroupWager:    &GroupWager{ID: 9, WagerType: GroupWagerTypePool},
+			WinningOption: yes,
+			Winners:       []*GroupWagerParticipant{{DiscordID: 10, OptionID: 1, Amount: 1000}},
+			Losers:        []*GroupWagerParticipant{{DiscordID: 20, OptionID: 2, Amount: 1000}},
+			PayoutDetails: map[int64]int64{10: 2000},
+			PotTaxes:      []*LotteryPotTaxShare{{DiscordID: 10, Amount: 100}},
+			PotTaxPercent: 5,
+		}
+
+		settlements := result.Settlements()
+		require.Len(t, settlements, 2)
+		assert.Equal(t, int64(2000), settlements[0].Payout)
+		assert.Equal(t, int64(100), settlements[0].PotTax)
+		assert.Equal(t, int64(900), settlements[0].NetChange)
+		assert.Zero(t, settlements[1].PotTax)
+	})
+}
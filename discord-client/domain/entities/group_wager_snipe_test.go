@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupWager_ExtendVotingForLateBet(t *testing.T) {
+	t.Parallel()
+
+	votingEndsAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	window, extension := 2*time.Minute, 5*time.Minute
+
+	tests := []struct {
+		name      string
+		now       time.Time
+		window    time.Duration
+		want      bool
+		wantEndAt time.Time
+	}{
+		{name: "bet inside the window", now: votingEndsAt.Add(-time.Minute), window: window, want: true, wantEndAt: votingEndsAt.Add(extension)},
+		{name: "bet exactly at the window edge", now: votingEndsAt.Add(-window), window: window, want: true, wantEndAt: votingEndsAt.Add(extension)},
+		{name: "bet before the window", now: votingEndsAt.Add(-3 * time.Minute), window: window, want: false, wantEndAt: votingEndsAt},
+		{name: "bet after voting ended", now: votingEndsAt, window: window, want: false, wantEndAt: votingEndsAt},
+		{name: "protection disabled", now: votingEndsAt.Add(-time.Minute), window: 0, want: false, wantEndAt: votingEndsAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			end := votingEndsAt
+			wager := &GroupWager{State: GroupWagerStateActive, VotingEndsAt: &end}
+
+			assert.Equal(t, tt.want, wager.ExtendVotingForLateBet(tt.now, tt.window, extension))
+			assert.Equal(t, tt.wantEndAt, *wager.VotingEndsAt)
+		})
+	}
+
+	t.Run("wager without a voting deadline", func(t *testing.T) {
+		t.Parallel()
+		wager := &GroupWager{State: GroupWagerStateActive}
+		assert.False(t, wager.ExtendVotingForLateBet(votingEndsAt, window, extension))
+		assert.Nil(t, wager.VotingEndsAt)
+	})
+}
+
+func TestGuildSettings_GetSnipeProtection(t *testing.T) {
+	t.Parallel()
+
+	two, five := 2, 5
+
+	window, extension := (&GuildSettings{SnipeWindowMinutes: &two, SnipeExtensionMinutes: &five}).GetSnipeProtection()
+	assert.Equal(t, 2*time.Minute, window)
+	assert.Equal(t, 5*time.Minute, extension)
+
+	window, extension = (&GuildSettings{SnipeWindowMinutes: &two}).GetSnipeProtection()
+	assert.Zero(t, window, "a window without an extension is disabled")
+	assert.Zero(t, extension)
+}
@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupWager_ShouldArchiveThread(t *testing.T) {
+	t.Parallel()
+
+	threadID := func(id int64) *int64 { return &id }
+
+	tests := []struct {
+		name     string
+		state    GroupWagerState
+		threadID *int64
+		want     bool
+	}{
+		{name: "resolved with thread", state: GroupWagerStateResolved, threadID: threadID(42), want: true},
+		{name: "cancelled with thread", state: GroupWagerStateCancelled, threadID: threadID(42), want: true},
+		{name: "active with thread", state: GroupWagerStateActive, threadID: threadID(42), want: false},
+		{name: "pending resolution with thread", state: GroupWagerStatePendingResolution, threadID: threadID(42), want: false},
+		{name: "resolved without thread", state: GroupWagerStateResolved, want: false},
+		{name: "resolved with zero thread ID", state: GroupWagerStateResolved, threadID: threadID(0), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gw := &GroupWager{State: tt.state, ThreadID: tt.threadID}
+
+			assert.Equal(t, tt.want, gw.ShouldArchiveThread())
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// GuildResolverType identifies whether a resolver grant applies to a user or to a role
+type GuildResolverType string
+
+const (
+	GuildResolverTypeUser GuildResolverType = "user"
+	GuildResolverTypeRole GuildResolverType = "role"
+)
+
+// GuildResolver grants a user, or every member of a role, permission to resolve group wagers in a guild
+type GuildResolver struct {
+	ID           int64             `db:"id"`
+	GuildID      int64             `db:"guild_id"`
+	ResolverType GuildResolverType `db:"resolver_type"`
+	TargetID     int64             `db:"target_id"` // Discord user ID or role ID depending on ResolverType
+	AddedBy      *int64            `db:"added_by"`
+	CreatedAt    time.Time         `db:"created_at"`
+}
+
+// Mention returns the Discord mention for the resolver's user or role
+func (r *GuildResolver) Mention() string {
+	if r.ResolverType == GuildResolverTypeRole {
+		return fmt.Sprintf("<@&%d>", r.TargetID)
+	}
+	return fmt.Sprintf("<@%d>", r.TargetID)
+}
+
+// Matches checks if the resolver grant applies to a member with the given user and role IDs
+func (r *GuildResolver) Matches(discordID int64, roleIDs []int64) bool {
+	switch r.ResolverType {
+	case GuildResolverTypeUser:
+		return r.TargetID == discordID
+	case GuildResolverTypeRole:
+		return slices.Contains(roleIDs, r.TargetID)
+	default:
+		return false
+	}
+}
+
+// NextResolverInRotation picks the resolver after lastAssigned in ascending ID order, wrapping around to
+// the lowest ID. Returns false when there are no resolvers to choose from.
+func NextResolverInRotation(resolverIDs []int64, lastAssigned *int64) (int64, bool) {
+	if len(resolverIDs) == 0 {
+		return 0, false
+	}
+
+	ids := slices.Clone(resolverIDs)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	if lastAssigned != nil {
+		for _, id := range ids {
+			if id > *lastAssigned {
+				return id, true
+			}
+		}
+	}
+	return ids[0], true
+}
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextResolverInRotation(t *testing.T) {
+	t.Parallel()
+
+	last := func(id int64) *int64 { return &id }
+
+	tests := []struct {
+		name         string
+		resolverIDs  []int64
+		lastAssigned *int64
+		expectedID   int64
+		expectedOK   bool
+	}{
+		{name: "no resolvers", resolverIDs: nil, expectedOK: false},
+		{name: "first assignment starts at lowest id", resolverIDs: []int64{30, 10, 20}, expectedID: 10, expectedOK: true},
+		{name: "moves to next id", resolverIDs: []int64{30, 10, 20}, lastAssigned: last(10), expectedID: 20, expectedOK: true},
+		{name: "wraps around after highest id", resolverIDs: []int64{30, 10, 20}, lastAssigned: last(30), expectedID: 10, expectedOK: true},
+		{name: "removed resolver continues from its position", resolverIDs: []int64{10, 30}, lastAssigned: last(20), expectedID: 30, expectedOK: true},
+		{name: "duplicates are ignored", resolverIDs: []int64{10, 10, 20}, lastAssigned: last(10), expectedID: 20, expectedOK: true},
+		{name: "single resolver is always picked", resolverIDs: []int64{10}, lastAssigned: last(10), expectedID: 10, expectedOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			id, ok := NextResolverInRotation(tt.resolverIDs, tt.lastAssigned)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedID, id)
+		})
+	}
+}
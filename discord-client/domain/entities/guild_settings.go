@@ -1,6 +1,10 @@
 package entities
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 // Lottery configuration defaults
 const (
@@ -10,6 +14,82 @@ const (
 	MaxLottoDifficulty     = 20
 )
 
+// New member defaults
+const (
+	DefaultStartingBalance = 1         // Bits granted to new users when the guild has not set a starting balance
+	MaxStartingBalance     = 1_000_000 // Keeps a fresh account from outweighing the existing economy
+)
+
+// Audit log configuration defaults
+const (
+	DefaultAuditThreshold = 10000 // Minimum absolute balance change posted to the audit channel
+)
+
+// Savings configuration defaults
+const (
+	DefaultSavingsBonusPercent = 2  // Percent of the deposit earned per locked week
+	MaxSavingsBonusPercent     = 25 // Upper bound so a 4 week term can at most double a deposit
+)
+
+// Rate limit defaults for bets, lottery purchases and transfers
+const (
+	DefaultRateLimitPerMinute = 10  // Tokens refilled per minute for each user and action
+	DefaultRateLimitBurst     = 3   // Actions a user can take back to back before waiting for a refill
+	MaxRateLimitPerMinute     = 120 // Anything faster is effectively no limit
+	MaxRateLimitBurst         = 20
+)
+
+// Stuck wager reconciliation limits
+const (
+	MaxStuckWagerHours = 30 * 24 // Reminder and cancel ages are capped at 30 days
+)
+
+// Sniping protection limits
+const (
+	MaxSnipeProtectionMinutes = 60 // Window and extension are capped at an hour so voting cannot be held open indefinitely
+)
+
+// Pot tax limits
+const (
+	MaxPotTaxPercent = 10 // Winners keep at least 90% of a taxed pot
+)
+
+// Balance floor limits
+const (
+	MaxMinBalanceFloor = 1_000_000 // A floor above this would lock most players out of betting
+)
+
+// Minimum bet limits
+const (
+	MaxMinBetAmount = 1_000_000 // A minimum above this would price most players out of betting
+)
+
+// Season token configuration
+const (
+	DefaultSeasonTokenRate   = 1000 // Bits won per season token earned
+	MaxSeasonTokenNameLength = 32
+)
+
+// Rules configuration
+const (
+	MaxRulesTextLength = 4000 // Fits in a Discord modal text input and embed description
+
+	// DefaultRulesText is shown by /rules when a guild has not configured its own rules
+	DefaultRulesText = "**Resolution**\nWagers are resolved by the server's designated resolvers based on the stated condition. " +
+		"House wagers tied to games are resolved automatically from match results.\n\n" +
+		"**Disputes**\nIf you believe a wager was resolved incorrectly, contact a server admin before the next wager is resolved. " +
+		"Cancelled wagers refund every bet in full."
+)
+
+// ErrBettingCurfewActive is returned when a bet or purchase is attempted during the guild's curfew window
+var ErrBettingCurfewActive = errors.New("betting is closed during curfew hours")
+
+// ErrBelowBalanceFloor is returned when a bet or purchase would leave less than the guild's reserve balance available
+var ErrBelowBalanceFloor = errors.New("this would dip into your reserve balance")
+
+// ErrBelowMinBet is returned when a bet, wager proposal or ticket purchase is smaller than the guild's minimum bet
+var ErrBelowMinBet = errors.New("that's below the server's minimum bet")
+
 // GuildSettings represents per-guild configuration settings
 type GuildSettings struct {
 	GuildID                     int64      `db:"guild_id"`
@@ -22,6 +102,37 @@ type GuildSettings struct {
 	LottoChannelID              *int64     `db:"lotto_channel_id"`                // Nullable - channel for lottery messages
 	LottoTicketCost             *int64     `db:"lotto_ticket_cost"`               // Nullable - ticket cost in bits (default: 1000)
 	LottoDifficulty             *int64     `db:"lotto_difficulty"`                // Nullable - number of bits for ticket numbers (default: 8)
+	AuditChannelID              *int64     `db:"audit_channel_id"`                // Nullable - channel for balance change audit log
+	AuditThreshold              *int64     `db:"audit_threshold"`                 // Nullable - minimum change amount to audit (default: 10000)
+	CurfewStartHour             *int       `db:"curfew_start_hour"`               // Nullable - hour betting closes in the curfew timezone (NULL = no curfew)
+	CurfewEndHour               *int       `db:"curfew_end_hour"`                 // Nullable - hour betting reopens in the curfew timezone
+	CurfewTimezone              *string    `db:"curfew_timezone"`                 // Nullable - IANA timezone of the curfew hours (default: UTC)
+	RulesText                   *string    `db:"rules_text"`                      // Nullable - markdown rules and dispute policy (NULL = default)
+	SavingsBonusPercent         *int       `db:"savings_bonus_percent"`           // Nullable - savings bonus percent per locked week (default: 2)
+	StuckWagerReminderHours     *int       `db:"stuck_wager_reminder_hours"`      // Nullable - hours pending resolution before resolvers are pinged (NULL = disabled)
+	StuckWagerCancelHours       *int       `db:"stuck_wager_cancel_hours"`        // Nullable - hours pending resolution before auto-cancel with refunds (NULL = disabled)
+	HouseOptionCap              *int64     `db:"house_option_cap"`                // Nullable - max total bets per option on LoL/TFT house wagers (NULL = uncapped)
+	LottoRolloverCap            *int64     `db:"lotto_rollover_cap"`              // Nullable - max pot carried into the next draw, excess paid to ticket holders (NULL = uncapped)
+	StartingBalance             *int64     `db:"starting_balance"`                // Nullable - bits granted to new users (default: 1)
+	WelcomeNewMembers           bool       `db:"welcome_new_members"`             // Create accounts for joining members and welcome them in the primary channel
+	RateLimitPerMinute          *int       `db:"rate_limit_per_minute"`           // Nullable - bet, lottery and transfer actions refilled per user per minute (default: 10, 0 = disabled)
+	RateLimitBurst              *int       `db:"rate_limit_burst"`                // Nullable - actions a user can take back to back (default: 3)
+	TftPlacementLayout          *string    `db:"tft_placement_layout"`            // Nullable - option layout for regular TFT house wagers (default: pairs)
+	BlockOwnGameBets            bool       `db:"block_own_game_bets"`             // Stop linked players betting on house wagers about their own games
+	DigestChannelID             *int64     `db:"digest_channel_id"`               // Nullable - channel for the weekly economy digest (NULL = disabled)
+	SnipeWindowMinutes          *int       `db:"snipe_window_minutes"`            // Nullable - bets this close to the end of voting extend it (NULL = disabled)
+	SnipeExtensionMinutes       *int       `db:"snipe_extension_minutes"`         // Nullable - minutes a late bet adds to the voting period
+	MinBalanceFloor             *int64     `db:"min_balance_floor"`               // Nullable - reserve balance bets and tickets cannot dip into (NULL = no floor)
+	SeasonTokenName             *string    `db:"season_token_name"`               // Nullable - name of the guild's seasonal secondary currency (NULL = disabled)
+	SeasonTokenRate             *int64     `db:"season_token_rate"`               // Nullable - bits won per season token earned (default: 1000)
+	CurrentSeason               int        `db:"current_season"`                  // Season that season tokens are currently earned and spent in
+	DefaultVotingPeriodMinutes  *int       `db:"default_voting_period_minutes"`   // Nullable - voting period pre-filled for new group wagers (default: 24 hours)
+	PotTaxPercent               *int       `db:"pot_tax_percent"`                 // Nullable - percent of each resolved group wager's pot added to the lottery (NULL = disabled)
+	GroupWagerBetMode           *string    `db:"group_wager_bet_mode"`            // Nullable - how bettors choose an option on group wagers (default: buttons)
+	ShareGlobalLeaderboard      bool       `db:"share_global_leaderboard"`        // Include this guild's group wager predictions in the cross-guild leaderboard
+	MinBetAmount                *int64     `db:"min_bet_amount"`                  // Nullable - smallest bet, wager proposal or lottery purchase accepted (NULL = no minimum)
+	LolNonRankedWagers          bool       `db:"lol_non_ranked_wagers"`           // Create LoL house wagers for ARAM, normal and custom games, not just ranked
+	AutoAssignResolvers         bool       `db:"auto_assign_resolvers"`           // Hand each wager awaiting resolution to the next resolver in rotation and ping them
 }
 
 // HasPrimaryChannel checks if a primary channel is configured
@@ -128,7 +239,441 @@ func (gs *GuildSettings) SetLottoDifficulty(difficulty *int64) {
 	gs.LottoDifficulty = difficulty
 }
 
+// HasLottoRolloverCap checks if rolled over lottery pots are capped
+func (gs *GuildSettings) HasLottoRolloverCap() bool {
+	return gs.LottoRolloverCap != nil && *gs.LottoRolloverCap > 0
+}
+
+// SetLottoRolloverCap sets the largest pot that can roll over into the next draw (nil removes the cap)
+func (gs *GuildSettings) SetLottoRolloverCap(cap *int64) {
+	gs.LottoRolloverCap = cap
+}
+
+// HasPotTax checks if resolved group wagers are taxed to fund the lottery
+func (gs *GuildSettings) HasPotTax() bool {
+	return gs.PotTaxPercent != nil && *gs.PotTaxPercent > 0
+}
+
+// SetPotTaxPercent sets the percent of each resolved group wager's pot added to the lottery (nil disables it)
+func (gs *GuildSettings) SetPotTaxPercent(percent *int) {
+	gs.PotTaxPercent = percent
+}
+
+// GetStartingBalance returns the balance new users are created with or default if not set
+func (gs *GuildSettings) GetStartingBalance() int64 {
+	if gs.StartingBalance != nil {
+		return *gs.StartingBalance
+	}
+	return DefaultStartingBalance
+}
+
+// SetStartingBalance sets the balance new users are created with
+func (gs *GuildSettings) SetStartingBalance(balance *int64) {
+	gs.StartingBalance = balance
+}
+
 // IsLottoEnabled returns true if lottery is configured (channel is set)
 func (gs *GuildSettings) IsLottoEnabled() bool {
 	return gs.HasLottoChannel()
 }
+
+// HasDigestChannel checks if a weekly digest channel is configured
+func (gs *GuildSettings) HasDigestChannel() bool {
+	return gs.DigestChannelID != nil && *gs.DigestChannelID > 0
+}
+
+// SetDigestChannel sets the weekly digest channel ID
+func (gs *GuildSettings) SetDigestChannel(channelID *int64) {
+	gs.DigestChannelID = channelID
+}
+
+// HasAuditChannel checks if an audit channel is configured
+func (gs *GuildSettings) HasAuditChannel() bool {
+	return gs.AuditChannelID != nil && *gs.AuditChannelID > 0
+}
+
+// GetAuditChannelID returns the audit channel ID or 0 if not set
+func (gs *GuildSettings) GetAuditChannelID() int64 {
+	if gs.AuditChannelID != nil {
+		return *gs.AuditChannelID
+	}
+	return 0
+}
+
+// SetAuditChannel sets the audit channel ID
+func (gs *GuildSettings) SetAuditChannel(channelID *int64) {
+	gs.AuditChannelID = channelID
+}
+
+// GetAuditThreshold returns the audit threshold or default if not set
+func (gs *GuildSettings) GetAuditThreshold() int64 {
+	if gs.AuditThreshold != nil {
+		return *gs.AuditThreshold
+	}
+	return DefaultAuditThreshold
+}
+
+// SetAuditThreshold sets the audit threshold
+func (gs *GuildSettings) SetAuditThreshold(threshold *int64) {
+	gs.AuditThreshold = threshold
+}
+
+// ShouldAuditBalanceChange returns true if a balance change of the given amount should be posted to the audit channel
+func (gs *GuildSettings) ShouldAuditBalanceChange(changeAmount int64) bool {
+	if !gs.HasAuditChannel() {
+		return false
+	}
+	if changeAmount < 0 {
+		changeAmount = -changeAmount
+	}
+	return changeAmount >= gs.GetAuditThreshold()
+}
+
+// HasBettingCurfew checks if a betting curfew window is configured
+func (gs *GuildSettings) HasBettingCurfew() bool {
+	return gs.CurfewStartHour != nil && gs.CurfewEndHour != nil && *gs.CurfewStartHour != *gs.CurfewEndHour
+}
+
+// SetBettingCurfew sets the curfew window in hours of the given IANA timezone (nil hours disable it, nil timezone is UTC)
+func (gs *GuildSettings) SetBettingCurfew(startHour, endHour *int, timezone *string) {
+	gs.CurfewStartHour = startHour
+	gs.CurfewEndHour = endHour
+	gs.CurfewTimezone = timezone
+}
+
+// GetCurfewLocation returns the timezone the curfew hours are in, falling back to UTC if it is unset or unknown
+func (gs *GuildSettings) GetCurfewLocation() *time.Location {
+	if gs.CurfewTimezone == nil || *gs.CurfewTimezone == "" {
+		return time.UTC
+	}
+	loc, err := LoadCurfewLocation(*gs.CurfewTimezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// LoadCurfewLocation loads an IANA timezone name such as "Europe/Berlin" for a curfew
+func LoadCurfewLocation(name string) (*time.Location, error) {
+	// time.LoadLocation treats "" and "Local" as the server's own zone, which guilds can't know
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("unknown timezone %q", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", name)
+	}
+	return loc, nil
+}
+
+// IsInBettingCurfew checks if the given time falls inside the curfew window.
+// The window starts at CurfewStartHour (inclusive) and ends at CurfewEndHour (exclusive) in the
+// curfew timezone, wrapping past midnight when the start hour is after the end hour.
+func (gs *GuildSettings) IsInBettingCurfew(now time.Time) bool {
+	if !gs.HasBettingCurfew() {
+		return false
+	}
+
+	hour := now.In(gs.GetCurfewLocation()).Hour()
+	start, end := *gs.CurfewStartHour, *gs.CurfewEndHour
+	if start < end {
+		return hour >= start && hour < end
+	}
+	return hour >= start || hour < end
+}
+
+// BettingReopensAt returns the next time the curfew window ends after now, in UTC
+func (gs *GuildSettings) BettingReopensAt(now time.Time) time.Time {
+	if !gs.HasBettingCurfew() {
+		return now.UTC()
+	}
+	return nextCurfewHour(now, *gs.CurfewEndHour, gs.GetCurfewLocation()).UTC()
+}
+
+// NextBettingCurfew returns the curfew window that is in progress at now, or the next one to start,
+// in UTC. ok is false when no curfew is configured.
+func (gs *GuildSettings) NextBettingCurfew(now time.Time) (start, end time.Time, ok bool) {
+	if !gs.HasBettingCurfew() {
+		return time.Time{}, time.Time{}, false
+	}
+
+	loc := gs.GetCurfewLocation()
+	end = nextCurfewHour(now, *gs.CurfewEndHour, loc)
+	start = nextCurfewHour(now, *gs.CurfewStartHour, loc)
+	if gs.IsInBettingCurfew(now) {
+		// The window in progress started on the last start hour before it ends
+		start = nextCurfewHour(end.AddDate(0, 0, -1), *gs.CurfewStartHour, loc)
+	}
+	return start.UTC(), end.UTC(), true
+}
+
+// nextCurfewHour returns the first time after now that the clock in loc reads hour:00
+func nextCurfewHour(now time.Time, hour int, loc *time.Location) time.Time {
+	local := now.In(loc)
+	next := time.Date(local.Year(), local.Month(), local.Day(), hour, 0, 0, 0, loc)
+	if !next.After(now) {
+		next = time.Date(local.Year(), local.Month(), local.Day()+1, hour, 0, 0, 0, loc)
+	}
+	return next
+}
+
+// CheckBettingCurfew returns ErrBettingCurfewActive if betting is currently closed
+func (gs *GuildSettings) CheckBettingCurfew(now time.Time) error {
+	if !gs.IsInBettingCurfew(now) {
+		return nil
+	}
+	reopens := gs.BettingReopensAt(now).In(gs.GetCurfewLocation())
+	return fmt.Errorf("%w, betting reopens at %s", ErrBettingCurfewActive, reopens.Format("15:04 MST"))
+}
+
+// HasRulesText checks if the guild has configured its own rules
+func (gs *GuildSettings) HasRulesText() bool {
+	return gs.RulesText != nil && *gs.RulesText != ""
+}
+
+// GetRulesText returns the guild's rules, falling back to the default policy
+func (gs *GuildSettings) GetRulesText() string {
+	if gs.HasRulesText() {
+		return *gs.RulesText
+	}
+	return DefaultRulesText
+}
+
+// SetRulesText sets the guild's rules text (nil to use the default)
+func (gs *GuildSettings) SetRulesText(rules *string) {
+	gs.RulesText = rules
+}
+
+// GetSavingsBonusPercent returns the savings bonus percent per week or default if not set
+func (gs *GuildSettings) GetSavingsBonusPercent() int {
+	if gs.SavingsBonusPercent != nil {
+		return *gs.SavingsBonusPercent
+	}
+	return DefaultSavingsBonusPercent
+}
+
+// SetSavingsBonusPercent sets the savings bonus percent per week (nil to use the default)
+func (gs *GuildSettings) SetSavingsBonusPercent(percent *int) {
+	gs.SavingsBonusPercent = percent
+}
+
+// HasStuckWagerReminder checks if resolvers should be pinged about wagers stuck in pending resolution
+func (gs *GuildSettings) HasStuckWagerReminder() bool {
+	return gs.StuckWagerReminderHours != nil && *gs.StuckWagerReminderHours > 0
+}
+
+// HasStuckWagerAutoCancel checks if wagers stuck in pending resolution are cancelled automatically
+func (gs *GuildSettings) HasStuckWagerAutoCancel() bool {
+	return gs.StuckWagerCancelHours != nil && *gs.StuckWagerCancelHours > 0
+}
+
+// IsStuckWagerReconciliationEnabled checks if any reconciliation step is configured
+func (gs *GuildSettings) IsStuckWagerReconciliationEnabled() bool {
+	return gs.HasStuckWagerReminder() || gs.HasStuckWagerAutoCancel()
+}
+
+// GetStuckWagerReminderAge returns how long a wager waits for resolution before resolvers are pinged
+func (gs *GuildSettings) GetStuckWagerReminderAge() time.Duration {
+	if !gs.HasStuckWagerReminder() {
+		return 0
+	}
+	return time.Duration(*gs.StuckWagerReminderHours) * time.Hour
+}
+
+// GetStuckWagerCancelAge returns how long a wager waits for resolution before it is cancelled
+func (gs *GuildSettings) GetStuckWagerCancelAge() time.Duration {
+	if !gs.HasStuckWagerAutoCancel() {
+		return 0
+	}
+	return time.Duration(*gs.StuckWagerCancelHours) * time.Hour
+}
+
+// SetStuckWagerReconciliation sets the reminder and auto-cancel ages in hours (nil disables each step)
+func (gs *GuildSettings) SetStuckWagerReconciliation(reminderHours, cancelHours *int) {
+	gs.StuckWagerReminderHours = reminderHours
+	gs.StuckWagerCancelHours = cancelHours
+}
+
+// HasSnipeProtection checks if late bets extend the voting period
+func (gs *GuildSettings) HasSnipeProtection() bool {
+	return gs.SnipeWindowMinutes != nil && *gs.SnipeWindowMinutes > 0 &&
+		gs.SnipeExtensionMinutes != nil && *gs.SnipeExtensionMinutes > 0
+}
+
+// GetSnipeProtection returns how close to the end of voting a bet must land to extend it, and by how much
+func (gs *GuildSettings) GetSnipeProtection() (window, extension time.Duration) {
+	if !gs.HasSnipeProtection() {
+		return 0, 0
+	}
+	return time.Duration(*gs.SnipeWindowMinutes) * time.Minute, time.Duration(*gs.SnipeExtensionMinutes) * time.Minute
+}
+
+// SetSnipeProtection sets the late bet window and voting extension in minutes (nil disables protection)
+func (gs *GuildSettings) SetSnipeProtection(windowMinutes, extensionMinutes *int) {
+	gs.SnipeWindowMinutes = windowMinutes
+	gs.SnipeExtensionMinutes = extensionMinutes
+}
+
+// HasHouseOptionCap checks if system-created house wagers cap the total bet on each option
+func (gs *GuildSettings) HasHouseOptionCap() bool {
+	return gs.HouseOptionCap != nil && *gs.HouseOptionCap > 0
+}
+
+// SetHouseOptionCap sets the per-option cap for house wagers (nil removes the cap)
+func (gs *GuildSettings) SetHouseOptionCap(cap *int64) {
+	gs.HouseOptionCap = cap
+}
+
+// RateLimit is a token bucket limit applied to each user's interactions
+type RateLimit struct {
+	PerMinute int // Tokens refilled per minute (0 disables the limit)
+	Burst     int // Bucket capacity
+}
+
+// Enabled checks if the limit restricts anything
+func (rl RateLimit) Enabled() bool {
+	return rl.PerMinute > 0 && rl.Burst > 0
+}
+
+// GetRateLimit returns the guild's interaction rate limit or the defaults if not set
+func (gs *GuildSettings) GetRateLimit() RateLimit {
+	limit := RateLimit{PerMinute: DefaultRateLimitPerMinute, Burst: DefaultRateLimitBurst}
+	if gs.RateLimitPerMinute != nil {
+		limit.PerMinute = *gs.RateLimitPerMinute
+	}
+	if gs.RateLimitBurst != nil {
+		limit.Burst = *gs.RateLimitBurst
+	}
+	return limit
+}
+
+// SetRateLimit sets the refill rate and burst size (nil restores each default)
+func (gs *GuildSettings) SetRateLimit(perMinute, burst *int) {
+	gs.RateLimitPerMinute = perMinute
+	gs.RateLimitBurst = burst
+}
+
+// GetTFTPlacementLayout returns the layout used for regular TFT house wagers or the default if not set
+func (gs *GuildSettings) GetTFTPlacementLayout() TFTPlacementLayout {
+	if gs.TftPlacementLayout != nil {
+		return TFTPlacementLayout(*gs.TftPlacementLayout)
+	}
+	return DefaultTFTPlacementLayout
+}
+
+// SetTFTPlacementLayout sets the layout used for regular TFT house wagers (nil restores the default)
+func (gs *GuildSettings) SetTFTPlacementLayout(layout *TFTPlacementLayout) {
+	if layout == nil {
+		gs.TftPlacementLayout = nil
+		return
+	}
+	value := string(*layout)
+	gs.TftPlacementLayout = &value
+}
+
+// GetGroupWagerBetMode returns how bettors choose an option on group wagers or the default if not set
+func (gs *GuildSettings) GetGroupWagerBetMode() GroupWagerBetMode {
+	if gs.GroupWagerBetMode != nil {
+		return GroupWagerBetMode(*gs.GroupWagerBetMode)
+	}
+	return DefaultGroupWagerBetMode
+}
+
+// SetGroupWagerBetMode sets how bettors choose an option on group wagers (nil restores the default)
+func (gs *GuildSettings) SetGroupWagerBetMode(mode *GroupWagerBetMode) {
+	if mode == nil {
+		gs.GroupWagerBetMode = nil
+		return
+	}
+	value := string(*mode)
+	gs.GroupWagerBetMode = &value
+}
+
+// GetMinBalanceFloor returns the reserve balance players must keep available, or 0 if there is no floor
+func (gs *GuildSettings) GetMinBalanceFloor() int64 {
+	if gs.MinBalanceFloor != nil {
+		return *gs.MinBalanceFloor
+	}
+	return 0
+}
+
+// SetMinBalanceFloor sets the reserve balance players must keep available (nil removes the floor)
+func (gs *GuildSettings) SetMinBalanceFloor(floor *int64) {
+	gs.MinBalanceFloor = floor
+}
+
+// GetMinBetAmount returns the smallest bet the guild accepts, or 0 if there is no minimum
+func (gs *GuildSettings) GetMinBetAmount() int64 {
+	if gs.MinBetAmount != nil {
+		return *gs.MinBetAmount
+	}
+	return 0
+}
+
+// SetMinBetAmount sets the smallest bet the guild accepts (nil removes the minimum)
+func (gs *GuildSettings) SetMinBetAmount(amount *int64) {
+	gs.MinBetAmount = amount
+}
+
+// CheckMinBet returns ErrBelowMinBet if amount is smaller than the guild's minimum bet
+func (gs *GuildSettings) CheckMinBet(amount int64) error {
+	minimum := gs.GetMinBetAmount()
+	if minimum == 0 || amount >= minimum {
+		return nil
+	}
+	return fmt.Errorf("%w of %d bits", ErrBelowMinBet, minimum)
+}
+
+// HasSeasonTokens checks if the guild has a seasonal secondary currency
+func (gs *GuildSettings) HasSeasonTokens() bool {
+	return gs.SeasonTokenName != nil && *gs.SeasonTokenName != ""
+}
+
+// GetSeasonTokenName returns the name of the guild's seasonal currency, or "" if it has none
+func (gs *GuildSettings) GetSeasonTokenName() string {
+	if gs.SeasonTokenName != nil {
+		return *gs.SeasonTokenName
+	}
+	return ""
+}
+
+// GetSeasonTokenRate returns how many bits must be won to earn one season token
+func (gs *GuildSettings) GetSeasonTokenRate() int64 {
+	if gs.SeasonTokenRate != nil && *gs.SeasonTokenRate > 0 {
+		return *gs.SeasonTokenRate
+	}
+	return DefaultSeasonTokenRate
+}
+
+// GetCurrentSeason returns the season season tokens are earned and spent in
+func (gs *GuildSettings) GetCurrentSeason() int {
+	return max(gs.CurrentSeason, 1)
+}
+
+// SeasonTokensForWin returns the season tokens earned by winning bitsWon, or 0 if the guild has no season tokens
+func (gs *GuildSettings) SeasonTokensForWin(bitsWon int64) int64 {
+	if !gs.HasSeasonTokens() || bitsWon <= 0 {
+		return 0
+	}
+	return bitsWon / gs.GetSeasonTokenRate()
+}
+
+// SetSeasonTokens enables the seasonal currency under name, earned at one token per rate bits won
+// (nil name disables it, nil rate restores the default)
+func (gs *GuildSettings) SetSeasonTokens(name *string, rate *int64) {
+	gs.SeasonTokenName = name
+	gs.SeasonTokenRate = rate
+}
+
+// GetDefaultVotingPeriodMinutes returns the voting period pre-filled for new group wagers
+func (gs *GuildSettings) GetDefaultVotingPeriodMinutes() int {
+	if gs.DefaultVotingPeriodMinutes != nil {
+		return *gs.DefaultVotingPeriodMinutes
+	}
+	return DefaultVotingPeriodMinutes
+}
+
+// SetDefaultVotingPeriodMinutes sets the voting period pre-filled for new group wagers (nil restores 24 hours)
+func (gs *GuildSettings) SetDefaultVotingPeriodMinutes(minutes *int) {
+	gs.DefaultVotingPeriodMinutes = minutes
+}
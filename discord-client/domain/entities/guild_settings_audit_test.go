@@ -0,0 +1,104 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuildSettings_GetAuditThreshold(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		threshold *int64
+		want      int64
+	}{
+		{
+			name:      "custom threshold",
+			threshold: func() *int64 { v := int64(50000); return &v }(),
+			want:      50000,
+		},
+		{
+			name:      "nil returns default",
+			threshold: nil,
+			want:      DefaultAuditThreshold,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gs := &GuildSettings{
+				AuditThreshold: tt.threshold,
+			}
+
+			assert.Equal(t, tt.want, gs.GetAuditThreshold())
+		})
+	}
+}
+
+func TestGuildSettings_ShouldAuditBalanceChange(t *testing.T) {
+	t.Parallel()
+
+	channelID := int64(123456789)
+	threshold := int64(5000)
+
+	tests := []struct {
+		name         string
+		channelID    *int64
+		threshold    *int64
+		changeAmount int64
+		want         bool
+	}{
+		{
+			name:         "no audit channel",
+			channelID:    nil,
+			threshold:    &threshold,
+			changeAmount: 100000,
+			want:         false,
+		},
+		{
+			name:         "gain at threshold",
+			channelID:    &channelID,
+			threshold:    &threshold,
+			changeAmount: 5000,
+			want:         true,
+		},
+		{
+			name:         "loss above threshold",
+			channelID:    &channelID,
+			threshold:    &threshold,
+			changeAmount: -7500,
+			want:         true,
+		},
+		{
+			name:         "change below threshold",
+			channelID:    &channelID,
+			threshold:    &threshold,
+			changeAmount: 4999,
+			want:         false,
+		},
+		{
+			name:         "default threshold applies when unset",
+			channelID:    &channelID,
+			threshold:    nil,
+			changeAmount: DefaultAuditThreshold - 1,
+			want:         false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gs := &GuildSettings{
+				AuditChannelID: tt.channelID,
+				AuditThreshold: tt.threshold,
+			}
+
+			assert.Equal(t, tt.want, gs.ShouldAuditBalanceChange(tt.changeAmount))
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuildSettings_IsInBettingCurfew(t *testing.T) {
+	t.Parallel()
+
+	hour := func(h int) *int { return &h }
+	at := func(h, m int) time.Time { return time.Date(2024, 3, 15, h, m, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name      string
+		startHour *int
+		endHour   *int
+		now       time.Time
+		want      bool
+	}{
+		{name: "no curfew configured", now: at(3, 0), want: false},
+		{name: "same start and end disables curfew", startHour: hour(5), endHour: hour(5), now: at(5, 30), want: false},
+		{name: "inside same-day window", startHour: hour(9), endHour: hour(17), now: at(12, 0), want: true},
+		{name: "start hour is inclusive", startHour: hour(9), endHour: hour(17), now: at(9, 0), want: true},
+		{name: "end hour is exclusive", startHour: hour(9), endHour: hour(17), now: at(17, 0), want: false},
+		{name: "inside overnight window before midnight", startHour: hour(23), endHour: hour(7), now: at(23, 45), want: true},
+		{name: "inside overnight window after midnight", startHour: hour(23), endHour: hour(7), now: at(2, 0), want: true},
+		{name: "outside overnight window", startHour: hour(23), endHour: hour(7), now: at(12, 0), want: false},
+		{name: "non-UTC time is converted", startHour: hour(23), endHour: hour(7), now: time.Date(2024, 3, 15, 20, 0, 0, 0, time.FixedZone("EST", -5*60*60)), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gs := &GuildSettings{CurfewStartHour: tt.startHour, CurfewEndHour: tt.endHour}
+
+			assert.Equal(t, tt.want, gs.IsInBettingCurfew(tt.now))
+		})
+	}
+}
+
+func TestGuildSettings_BettingReopensAt(t *testing.T) {
+	t.Parallel()
+
+	start, end := 23, 7
+	gs := &GuildSettings{CurfewStartHour: &start, CurfewEndHour: &end}
+
+	// Before midnight, betting reopens the next morning
+	assert.Equal(t, time.Date(2024, 3, 16, 7, 0, 0, 0, time.UTC), gs.BettingReopensAt(time.Date(2024, 3, 15, 23, 30, 0, 0, time.UTC)))
+	// After midnight, betting reopens the same morning
+	assert.Equal(t, time.Date(2024, 3, 15, 7, 0, 0, 0, time.UTC), gs.BettingReopensAt(time.Date(2024, 3, 15, 2, 0, 0, 0, time.UTC)))
+}
+
+func TestGuildSettings_CheckBettingCurfew(t *testing.T) {
+	t.Parallel()
+
+	start, end := 9, 17
+	gs := &GuildSettings{CurfewStartHour: &start, CurfewEndHour: &end}
+
+	err := gs.CheckBettingCurfew(time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC))
+	assert.ErrorIs(t, err, ErrBettingCurfewActive)
+	assert.Contains(t, err.Error(), "betting reopens at 17:00 UTC")
+
+	assert.NoError(t, gs.CheckBettingCurfew(time.Date(2024, 3, 15, 18, 0, 0, 0, time.UTC)))
+}
+
+func TestGuildSettings_BettingCurfewTimezone(t *testing.T) {
+	t.Parallel()
+
+	start, end, zone := 23, 7, "Europe/Berlin"
+	gs := &GuildSettings{CurfewStartHour: &start, CurfewEndHour: &end, CurfewTimezone: &zone}
+
+	// 22:30 UTC is 23:30 in Berlin in winter, inside the curfew
+	now := time.Date(2024, 1, 15, 22, 30, 0, 0, time.UTC)
+	assert.True(t, gs.IsInBettingCurfew(now))
+	assert.Equal(t, time.Date(2024, 1, 16, 6, 0, 0, 0, time.UTC), gs.BettingReopensAt(now))
+
+	err := gs.CheckBettingCurfew(now)
+	assert.ErrorIs(t, err, ErrBettingCurfewActive)
+	assert.Contains(t, err.Error(), "betting reopens at 07:00 CET")
+
+	// 06:30 UTC is 07:30 in Berlin, after the curfew
+	assert.False(t, gs.IsInBettingCurfew(time.Date(2024, 1, 16, 6, 30, 0, 0, time.UTC)))
+
+	// An unknown timezone falls back to UTC
+	unknown := "Mars/Olympus_Mons"
+	gs.CurfewTimezone = &unknown
+	assert.Equal(t, time.UTC, gs.GetCurfewLocation())
+	assert.False(t, gs.IsInBettingCurfew(now))
+}
+
+func TestGuildSettings_NextBettingCurfew(t *testing.T) {
+	t.Parallel()
+
+	start, end := 23, 7
+	gs := &GuildSettings{CurfewStartHour: &start, CurfewEndHour: &end}
+
+	// Outside the window, the next one starts tonight
+	from, to, ok := gs.NextBettingCurfew(time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2024, 3, 15, 23, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2024, 3, 16, 7, 0, 0, 0, time.UTC), to)
+
+	// Inside the window, the one in progress is returned
+	from, to, ok = gs.NextBettingCurfew(time.Date(2024, 3, 16, 2, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2024, 3, 15, 23, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2024, 3, 16, 7, 0, 0, 0, time.UTC), to)
+
+	_, _, ok = (&GuildSettings{}).NextBettingCurfew(time.Now())
+	assert.False(t, ok)
+}
@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuildSettings_GetRulesText(t *testing.T) {
+	t.Parallel()
+
+	custom := "Disputes go to #mod-mail within 24 hours."
+	empty := ""
+
+	tests := []struct {
+		name       string
+		rules      *string
+		want       string
+		wantCustom bool
+	}{
+		{
+			name:       "custom rules",
+			rules:      &custom,
+			want:       custom,
+			wantCustom: true,
+		},
+		{
+			name:  "nil returns default",
+			rules: nil,
+			want:  DefaultRulesText,
+		},
+		{
+			name:  "empty returns default",
+			rules: &empty,
+			want:  DefaultRulesText,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			settings := &GuildSettings{RulesText: tt.rules}
+			assert.Equal(t, tt.want, settings.GetRulesText())
+			assert.Equal(t, tt.wantCustom, settings.HasRulesText())
+		})
+	}
+}
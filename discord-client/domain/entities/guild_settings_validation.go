@@ -0,0 +1,165 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// SettingsViolation describes a single guild setting that failed validation
+type SettingsViolation struct {
+	Field   string // Column of the offending setting, e.g. "lotto_ticket_cost"
+	Message string // User-facing explanation
+}
+
+// SettingsValidationError lists every violation found when validating guild settings
+type SettingsValidationError struct {
+	Violations []SettingsViolation
+}
+
+// NewSettingsValidationError creates a validation error for a single setting
+func NewSettingsValidationError(field, message string) *SettingsValidationError {
+	return &SettingsValidationError{Violations: []SettingsViolation{{Field: field, Message: message}}}
+}
+
+// Error implements the error interface
+func (e *SettingsValidationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = v.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// ChannelSetting is a configured channel and the setting it is stored in
+type ChannelSetting struct {
+	Field     string
+	ChannelID int64
+}
+
+// ConfiguredChannels returns every channel the guild has configured, in a stable order
+func (gs *GuildSettings) ConfiguredChannels() []ChannelSetting {
+	candidates := []struct {
+		field string
+		id    *int64
+	}{
+		{"primary_channel_id", gs.PrimaryChannelID},
+		{"lol_channel_id", gs.LolChannelID},
+		{"tft_channel_id", gs.TftChannelID},
+		{"wordle_channel_id", gs.WordleChannelID},
+		{"lotto_channel_id", gs.LottoChannelID},
+		{"audit_channel_id", gs.AuditChannelID},
+		{"digest_channel_id", gs.DigestChannelID},
+	}
+
+	var channels []ChannelSetting
+	for _, c := range candidates {
+		if c.id != nil {
+			channels = append(channels, ChannelSetting{Field: c.field, ChannelID: *c.id})
+		}
+	}
+	return channels
+}
+
+// Validate checks the settings as a whole, including constraints that span several fields.
+// Returns a *SettingsValidationError listing every violation, or nil if the settings are valid.
+func (gs *GuildSettings) Validate() error {
+	var violations []SettingsViolation
+	add := func(field, message string) {
+		violations = append(violations, SettingsViolation{Field: field, Message: message})
+	}
+
+	if difficulty := gs.GetLottoDifficulty(); difficulty < MinLottoDifficulty || difficulty > MaxLottoDifficulty {
+		add("lotto_difficulty", fmt.Sprintf("difficulty must be between %d and %d", MinLottoDifficulty, MaxLottoDifficulty))
+	}
+	if gs.LottoTicketCost != nil && *gs.LottoTicketCost <= 0 {
+		add("lotto_ticket_cost", "ticket cost must be positive")
+	}
+	if gs.LottoRolloverCap != nil && *gs.LottoRolloverCap <= 0 {
+		add("lotto_rollover_cap", "lottery rollover cap must be positive")
+	}
+	if gs.PotTaxPercent != nil && (*gs.PotTaxPercent < 1 || *gs.PotTaxPercent > MaxPotTaxPercent) {
+		add("pot_tax_percent", fmt.Sprintf("pot tax must be between 1 and %d percent", MaxPotTaxPercent))
+	}
+	if gs.StartingBalance != nil && (*gs.StartingBalance < 0 || *gs.StartingBalance > MaxStartingBalance) {
+		add("starting_balance", fmt.Sprintf("starting balance must be between 0 and %d", MaxStartingBalance))
+	}
+
+	// New members should be able to afford a ticket when the guild chose their starting balance
+	if gs.IsLottoEnabled() && gs.StartingBalance != nil && *gs.StartingBalance > 0 &&
+		gs.GetLottoTicketCost() > *gs.StartingBalance {
+		add("lotto_ticket_cost", "ticket cost cannot exceed the starting balance of new members")
+	}
+
+	if gs.AuditThreshold != nil && *gs.AuditThreshold <= 0 {
+		add("audit_threshold", "audit threshold must be positive")
+	}
+
+	if (gs.CurfewStartHour == nil) != (gs.CurfewEndHour == nil) {
+		add("curfew_start_hour", "curfew requires both a start and end hour")
+	} else if gs.CurfewStartHour != nil {
+		start, end := *gs.CurfewStartHour, *gs.CurfewEndHour
+		if start < 0 || start > 23 || end < 0 || end > 23 {
+			add("curfew_start_hour", "curfew hours must be between 0 and 23")
+		} else if start == end {
+			add("curfew_start_hour", "curfew start and end hour must differ")
+		}
+	}
+	if gs.CurfewTimezone != nil {
+		if _, err := LoadCurfewLocation(*gs.CurfewTimezone); err != nil {
+			add("curfew_timezone", "curfew timezone must be an IANA name such as Europe/Berlin")
+		}
+	}
+
+	if gs.RulesText != nil && utf8.RuneCountInString(*gs.RulesText) > MaxRulesTextLength {
+		add("rules_text", fmt.Sprintf("rules cannot be longer than %d characters", MaxRulesTextLength))
+	}
+
+	if percent := gs.GetSavingsBonusPercent(); percent < 0 || percent > MaxSavingsBonusPercent {
+		add("savings_bonus_percent", fmt.Sprintf("savings bonus must be between 0 and %d percent", MaxSavingsBonusPercent))
+	}
+
+	for _, hours := range []*int{gs.StuckWagerReminderHours, gs.StuckWagerCancelHours} {
+		if hours != nil && (*hours < 1 || *hours > MaxStuckWagerHours) {
+			add("stuck_wager_hours", fmt.Sprintf("stuck wager hours must be between 1 and %d", MaxStuckWagerHours))
+			break
+		}
+	}
+	if gs.HasStuckWagerReminder() && gs.HasStuckWagerAutoCancel() &&
+		*gs.StuckWagerCancelHours <= *gs.StuckWagerReminderHours {
+		add("stuck_wager_cancel_hours", "auto-cancel must come after the resolver reminder")
+	}
+
+	if gs.HouseOptionCap != nil && *gs.HouseOptionCap <= 0 {
+		add("house_option_cap", "house option cap must be positive")
+	}
+
+	if gs.MinBetAmount != nil && (*gs.MinBetAmount < 1 || *gs.MinBetAmount > MaxMinBetAmount) {
+		add("min_bet_amount", fmt.Sprintf("minimum bet must be between 1 and %d", MaxMinBetAmount))
+	}
+	// A minimum above the option cap would leave house wager options no room for a single bet
+	if gs.MinBetAmount != nil && gs.HouseOptionCap != nil && *gs.MinBetAmount > *gs.HouseOptionCap {
+		add("min_bet_amount", "minimum bet cannot exceed the house option cap")
+	}
+
+	limit := gs.GetRateLimit()
+	if limit.PerMinute < 0 || limit.PerMinute > MaxRateLimitPerMinute {
+		add("rate_limit_per_minute", fmt.Sprintf("rate limit must be between 0 and %d actions per minute", MaxRateLimitPerMinute))
+	}
+	if limit.Burst < 1 || limit.Burst > MaxRateLimitBurst {
+		add("rate_limit_burst", fmt.Sprintf("burst must be between 1 and %d actions", MaxRateLimitBurst))
+	}
+
+	if !gs.GetTFTPlacementLayout().IsValid() {
+		add("tft_placement_layout", fmt.Sprintf("unknown TFT placement layout %q", gs.GetTFTPlacementLayout()))
+	}
+
+	if !gs.GetGroupWagerBetMode().IsValid() {
+		add("group_wager_bet_mode", fmt.Sprintf("unknown group wager bet mode %q", gs.GetGroupWagerBetMode()))
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return &SettingsValidationError{Violations: violations}
+}
@@ -0,0 +1,97 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuildSettings_Validate(t *testing.T) {
+	t.Parallel()
+
+	int64Ptr := func(v int64) *int64 { return &v }
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name       string
+		settings   GuildSettings
+		wantFields []string
+	}{
+		{name: "defaults are valid", settings: GuildSettings{GuildID: 1}},
+		{
+			name:     "configured settings are valid",
+			settings: GuildSettings{LottoChannelID: int64Ptr(5), LottoTicketCost: int64Ptr(500), StartingBalance: int64Ptr(1000), CurfewStartHour: intPtr(22), CurfewEndHour: intPtr(6)},
+		},
+		{
+			name:       "ticket cost above custom starting balance",
+			settings:   GuildSettings{LottoChannelID: int64Ptr(5), LottoTicketCost: int64Ptr(2000), StartingBalance: int64Ptr(1000)},
+			wantFields: []string{"lotto_ticket_cost"},
+		},
+		{
+			name:     "ticket cost above starting balance ignored while lottery is disabled",
+			settings: GuildSettings{LottoTicketCost: int64Ptr(2000), StartingBalance: int64Ptr(1000)},
+		},
+		{
+			name:     "ticket cost above default starting balance is allowed",
+			settings: GuildSettings{LottoChannelID: int64Ptr(5)},
+		},
+		{
+			name:       "difficulty out of range",
+			settings:   GuildSettings{LottoDifficulty: int64Ptr(MaxLottoDifficulty + 1)},
+			wantFields: []string{"lotto_difficulty"},
+		},
+		{
+			name:       "half configured curfew",
+			settings:   GuildSettings{CurfewStartHour: intPtr(3)},
+			wantFields: []string{"curfew_start_hour"},
+		},
+		{
+			name:       "cancel before reminder",
+			settings:   GuildSettings{StuckWagerReminderHours: intPtr(48), StuckWagerCancelHours: intPtr(24)},
+			wantFields: []string{"stuck_wager_cancel_hours"},
+		},
+		{
+			name:       "minimum bet above house option cap",
+			settings:   GuildSettings{MinBetAmount: int64Ptr(5000), HouseOptionCap: int64Ptr(1000)},
+			wantFields: []string{"min_bet_amount"},
+		},
+		{
+			name:       "every violation is reported",
+			settings:   GuildSettings{AuditThreshold: int64Ptr(0), HouseOptionCap: int64Ptr(-1), RateLimitBurst: intPtr(0)},
+			wantFields: []string{"audit_threshold", "house_option_cap", "rate_limit_burst"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.settings.Validate()
+			if len(tt.wantFields) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErr *SettingsValidationError
+			require.ErrorAs(t, err, &validationErr)
+			fields := make([]string, len(validationErr.Violations))
+			for i, v := range validationErr.Violations {
+				fields[i] = v.Field
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestGuildSettings_ConfiguredChannels(t *testing.T) {
+	t.Parallel()
+
+	primary, audit := int64(10), int64(20)
+	gs := &GuildSettings{PrimaryChannelID: &primary, AuditChannelID: &audit}
+
+	assert.Equal(t, []ChannelSetting{
+		{Field: "primary_channel_id", ChannelID: 10},
+		{Field: "audit_channel_id", ChannelID: 20},
+	}, gs.ConfiguredChannels())
+}
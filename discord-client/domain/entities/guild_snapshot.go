@@ -0,0 +1,105 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrGuildSnapshotNotFound is returned when a snapshot does not exist in the guild
+var ErrGuildSnapshotNotFound = errors.New("guild snapshot not found")
+
+// GuildSnapshot is a point-in-time copy of a guild's economy, taken so the guild can be rolled back after an incident
+type GuildSnapshot struct {
+	ID        int64             `json:"id,string"`
+	GuildID   int64             `json:"guild_id,string"`
+	Reason    string            `json:"reason"`
+	Data      GuildSnapshotData `json:"data"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// GuildSnapshotData is the economy state stored in a snapshot
+type GuildSnapshotData struct {
+	Balances     []GuildSnapshotBalance    `json:"balances"`
+	ActiveWagers []GuildSnapshotGroupWager `json:"active_wagers"`
+	Lottery      *GuildSnapshotLotteryDraw `json:"lottery,omitempty"` // Nil if the guild had no open draw
+}
+
+// GuildSnapshotBalance is a user's balance when the snapshot was taken
+type GuildSnapshotBalance struct {
+	DiscordID int64 `json:"discord_id,string"`
+	Balance   int64 `json:"balance"`
+}
+
+// GuildSnapshotGroupWager is a group wager that was open when the snapshot was taken
+type GuildSnapshotGroupWager struct {
+	GroupWagerID int64           `json:"group_wager_id,string"`
+	State        GroupWagerState `json:"state"`
+	TotalPot     int64           `json:"total_pot"`
+}
+
+// GuildSnapshotLotteryDraw is the guild's open lottery draw when the snapshot was taken
+type GuildSnapshotLotteryDraw struct {
+	DrawID      int64  `json:"draw_id,string"`
+	TotalPot    int64  `json:"total_pot"`
+	TaxPot      *int64 `json:"tax_pot,omitempty"` // Part of the pot from group wager pot taxes; nil in snapshots taken before it was recorded
+	TicketsSold int64  `json:"tickets_sold"`
+}
+
+// TotalBalance returns the sum of every balance in the snapshot
+func (d GuildSnapshotData) TotalBalance() int64 {
+	var total int64
+	for _, balance := range d.Balances {
+		total += balance.Balance
+	}
+	return total
+}
+
+// GuildSnapshotBalanceChange is how restoring a snapshot changes one user's balance
+type GuildSnapshotBalanceChange struct {
+	DiscordID     int64 `json:"discord_id,string"`
+	BalanceBefore int64 `json:"balance_before"`
+	BalanceAfter  int64 `json:"balance_after"`
+	ChangeAmount  int64 `json:"change_amount"`
+}
+
+// GuildSnapshotWagerChange is a group wager that was open in the snapshot and has changed state since.
+// Restoring a snapshot does not reopen wagers, so these need reviewing by hand.
+type GuildSnapshotWagerChange struct {
+	GroupWagerID  int64           `json:"group_wager_id,string"`
+	SnapshotState GroupWagerState `json:"snapshot_state"`
+	CurrentState  GroupWagerState `json:"current_state"` // Empty if the wager no longer exists
+}
+
+// GuildSnapshotLotteryChange is how restoring a snapshot changes the open lottery draw
+type GuildSnapshotLotteryChange struct {
+	DrawID            int64 `json:"draw_id,string"`
+	Restored          bool  `json:"restored"` // False if the draw has been completed since the snapshot
+	PotBefore         int64 `json:"pot_before"`
+	PotAfter          int64 `json:"pot_after"`
+	TicketsAtSnapshot int64 `json:"tickets_at_snapshot"`
+	TicketsNow        int64 `json:"tickets_now"`
+}
+
+// GuildSnapshotRestoreReport summarizes restoring a snapshot, or what restoring it would do in a dry run
+type GuildSnapshotRestoreReport struct {
+	SnapshotID       int64                        `json:"snapshot_id,string"`
+	GuildID          int64                        `json:"guild_id,string"`
+	DryRun           bool                         `json:"dry_run"`
+	BackupSnapshotID int64                        `json:"backup_snapshot_id,string"` // Snapshot of the guild taken just before restoring, zero in a dry run
+	Balances         []GuildSnapshotBalanceChange `json:"balances"`
+	TotalChange      int64                        `json:"total_change"`
+	MissingUsers     []int64                      `json:"missing_users"` // Users in the snapshot who no longer have an account
+	WagerChanges     []GuildSnapshotWagerChange   `json:"wager_changes"`
+	Lottery          *GuildSnapshotLotteryChange  `json:"lottery,omitempty"`
+}
+
+// ChangedCount returns how many balances the restore changes
+func (r *GuildSnapshotRestoreReport) ChangedCount() int {
+	var changed int
+	for _, balance := range r.Balances {
+		if balance.ChangeAmount != 0 {
+			changed++
+		}
+	}
+	return changed
+}
@@ -0,0 +1,61 @@
+package entities
+
+import "time"
+
+// HouseWagerPlayer is a watched summoner whose game a house wager is on
+type HouseWagerPlayer struct {
+	ID           int64     `db:"id"`
+	GroupWagerID int64     `db:"group_wager_id"`
+	SummonerName string    `db:"summoner_name"`
+	TagLine      string    `db:"tag_line"`
+	Won          *bool     `db:"won"` // Nil until the player's game result arrives
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+// HouseWagerTeamOutcome is the result a house wager's players share
+type HouseWagerTeamOutcome int
+
+const (
+	HouseWagerTeamPending HouseWagerTeamOutcome = iota // Some players' results have not arrived
+	HouseWagerTeamWon
+	HouseWagerTeamLost
+	HouseWagerTeamSplit // The players were on opposing teams, so there is no shared result
+)
+
+// SharedTeamOutcome derives the team result of a wager's players once every result has arrived
+func SharedTeamOutcome(players []*HouseWagerPlayer) HouseWagerTeamOutcome {
+	if len(players) == 0 {
+		return HouseWagerTeamPending
+	}
+
+	wins := 0
+	for _, player := range players {
+		if player.Won == nil {
+			return HouseWagerTeamPending
+		}
+		if *player.Won {
+			wins++
+		}
+	}
+
+	switch wins {
+	case len(players):
+		return HouseWagerTeamWon
+	case 0:
+		return HouseWagerTeamLost
+	default:
+		return HouseWagerTeamSplit
+	}
+}
+
+// ReportedTeamOutcome derives the team result from the players whose results have arrived, for settling a
+// wager when a teammate's result never comes
+func ReportedTeamOutcome(players []*HouseWagerPlayer) HouseWagerTeamOutcome {
+	reported := make([]*HouseWagerPlayer, 0, len(players))
+	for _, player := range players {
+		if player.Won != nil {
+			reported = append(reported, player)
+		}
+	}
+	return SharedTeamOutcome(reported)
+}
@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharedTeamOutcome(t *testing.T) {
+	t.Parallel()
+
+	won, lost := true, false
+	player := func(result *bool) *HouseWagerPlayer {
+		return &HouseWagerPlayer{Won: result}
+	}
+
+	tests := []struct {
+		name    string
+		players []*HouseWagerPlayer
+		want    HouseWagerTeamOutcome
+	}{
+		{name: "no players", want: HouseWagerTeamPending},
+		{name: "solo win", players: []*HouseWagerPlayer{player(&won)}, want: HouseWagerTeamWon},
+		{name: "waiting on a teammate", players: []*HouseWagerPlayer{player(&won), player(nil)}, want: HouseWagerTeamPending},
+		{name: "team won", players: []*HouseWagerPlayer{player(&won), player(&won), player(&won)}, want: HouseWagerTeamWon},
+		{name: "team lost", players: []*HouseWagerPlayer{player(&lost), player(&lost)}, want: HouseWagerTeamLost},
+		{name: "opposing teams", players: []*HouseWagerPlayer{player(&won), player(&lost)}, want: HouseWagerTeamSplit},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, SharedTeamOutcome(tt.players), tt.name)
+	}
+}
+
+func TestReportedTeamOutcome(t *testing.T) {
+	t.Parallel()
+
+	won, lost := true, false
+	player := func(result *bool) *HouseWagerPlayer {
+		return &HouseWagerPlayer{Won: result}
+	}
+
+	tests := []struct {
+		name    string
+		players []*HouseWagerPlayer
+		want    HouseWagerTeamOutcome
+	}{
+		{name: "nothing reported", players: []*HouseWagerPlayer{player(nil), player(nil)}, want: HouseWagerTeamPending},
+		{name: "teammate missing after a win", players: []*HouseWagerPlayer{player(&won), player(nil)}, want: HouseWagerTeamWon},
+		{name: "teammate missing after a loss", players: []*HouseWagerPlayer{player(nil), player(&lost), player(&lost)}, want: HouseWagerTeamLost},
+		{name: "reported players on opposing teams", players: []*HouseWagerPlayer{player(&won), player(nil), player(&lost)}, want: HouseWagerTeamSplit},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ReportedTeamOutcome(tt.players), tt.name)
+	}
+}
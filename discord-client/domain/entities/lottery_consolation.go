@@ -0,0 +1,48 @@
+package entities
+
+// LotteryConsolationPayout is a ticket holder's share of the pot above a guild's rollover cap
+type LotteryConsolationPayout struct {
+	DiscordID   int64
+	TicketCount int64
+	Amount      int64
+}
+
+// SplitLotteryConsolation divides excess among a draw's participants in proportion to their ticket counts.
+// Shares are rounded down, so the returned total may fall short of excess by less than one bit per participant.
+// Participants whose share rounds to zero are omitted.
+func SplitLotteryConsolation(excess int64, participants []*LotteryParticipantInfo) []*LotteryConsolationPayout {
+	if excess <= 0 {
+		return nil
+	}
+
+	var totalTickets int64
+	for _, participant := range participants {
+		totalTickets += participant.TicketCount
+	}
+	if totalTickets == 0 {
+		return nil
+	}
+
+	payouts := make([]*LotteryConsolationPayout, 0, len(participants))
+	for _, participant := range participants {
+		amount := excess * participant.TicketCount / totalTickets
+		if amount <= 0 {
+			continue
+		}
+		payouts = append(payouts, &LotteryConsolationPayout{
+			DiscordID:   participant.DiscordID,
+			TicketCount: participant.TicketCount,
+			Amount:      amount,
+		})
+	}
+	return payouts
+}
+
+// TotalLotteryConsolation returns the sum of the payouts
+func TotalLotteryConsolation(payouts []*LotteryConsolationPayout) int64 {
+	var total int64
+	for _, payout := range payouts {
+		total += payout.Amount
+	}
+	return total
+}
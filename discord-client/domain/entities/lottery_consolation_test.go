@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitLotteryConsolation(t *testing.T) {
+	t.Parallel()
+
+	participants := []*LotteryParticipantInfo{
+		{DiscordID: 1, TicketCount: 6},
+		{DiscordID: 2, TicketCount: 3},
+		{DiscordID: 3, TicketCount: 1},
+	}
+
+	t.Run("splits by ticket count", func(t *testing.T) {
+		t.Parallel()
+
+		payouts := SplitLotteryConsolation(1000, participants)
+		assert.Equal(t, []*LotteryConsolationPayout{
+			{DiscordID: 1, TicketCount: 6, Amount: 600},
+			{DiscordID: 2, TicketCount: 3, Amount: 300},
+			{DiscordID: 3, TicketCount: 1, Amount: 100},
+		}, payouts)
+	})
+
+	t.Run("rounds shares down", func(t *testing.T) {
+		t.Parallel()
+
+		payouts := SplitLotteryConsolation(7, participants)
+		assert.Equal(t, int64(6), TotalLotteryConsolation(payouts)) // 4 + 2 + 0
+		assert.Len(t, payouts, 2)
+	})
+
+	t.Run("nothing to split", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, SplitLotteryConsolation(0, participants))
+		assert.Nil(t, SplitLotteryConsolation(1000, nil))
+	})
+}
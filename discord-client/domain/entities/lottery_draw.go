@@ -2,11 +2,23 @@ package entities
 
 import (
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
 )
 
+var (
+	// ErrLotteryDrawAlreadyCompleted is returned when conducting a draw that has already been drawn
+	ErrLotteryDrawAlreadyCompleted = errors.New("draw already completed")
+
+	// ErrLotteryDrawInProgress is returned when another transaction is already conducting the draw
+	ErrLotteryDrawInProgress = errors.New("draw is already being conducted")
+
+	// ErrLotteryNumberOutOfRange is returned when a picked number is outside the draw's difficulty range
+	ErrLotteryNumberOutOfRange = errors.New("number is outside the draw's range")
+)
+
 // LotteryDraw represents a single lottery draw event
 type LotteryDraw struct {
 	ID            int64      `db:"id"`
@@ -16,6 +28,7 @@ type LotteryDraw struct {
 	WinningNumber *int64     `db:"winning_number"`  // NULL until draw completes
 	DrawTime      time.Time  `db:"draw_time"`       // When the draw will occur
 	TotalPot      int64      `db:"total_pot"`       // Total pot amount
+	TaxPot        int64      `db:"tax_pot"`         // Portion of the pot added by group wager pot taxes
 	CompletedAt   *time.Time `db:"completed_at"`    // NULL until draw completes
 	MessageID     *int64     `db:"message_id"`      // Discord message ID for the lottery embed
 	ChannelID     *int64     `db:"channel_id"`      // Discord channel ID
@@ -37,6 +50,14 @@ func (d *LotteryDraw) GetMaxNumber() int64 {
 	return (1 << d.Difficulty) - 1
 }
 
+// ValidateTicketNumber checks a picked number is within [0, 2^difficulty - 1]
+func (d *LotteryDraw) ValidateTicketNumber(number int64) error {
+	if number < 0 || number > d.GetMaxNumber() {
+		return fmt.Errorf("%w: %d is not between 0 and %d", ErrLotteryNumberOutOfRange, number, d.GetMaxNumber())
+	}
+	return nil
+}
+
 // GetTotalNumbers returns the total count of possible numbers (2^difficulty)
 func (d *LotteryDraw) GetTotalNumbers() int64 {
 	return 1 << d.Difficulty
@@ -76,3 +97,10 @@ func FormatBinaryNumber(number, difficulty int64) string {
 	format := fmt.Sprintf("%%0%db", difficulty)
 	return fmt.Sprintf(format, number)
 }
+
+// LotteryNumberFrequency represents how often a number has appeared in a guild's draw history
+type LotteryNumberFrequency struct {
+	Number     int64     `db:"number"`
+	Count      int64     `db:"count"`
+	LastSeenAt time.Time `db:"last_seen_at"`
+}
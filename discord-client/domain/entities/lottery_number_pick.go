@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrNoLotteryNumbers is returned when a number pick contains no numbers
+	ErrNoLotteryNumbers = errors.New("pick at least one number")
+
+	// ErrLotteryNumberAlreadyOwned is returned when picking a number the user already holds a ticket for
+	ErrLotteryNumberAlreadyOwned = errors.New("you already have a ticket for")
+)
+
+// ParseLotteryNumbers parses numbers typed by a user, separated by commas or spaces, in the order
+// given. Repeating a number is rejected since each ticket must have a unique number.
+func ParseLotteryNumbers(text string) ([]int64, error) {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n' || r == '\t'
+	})
+	if len(fields) == 0 {
+		return nil, ErrNoLotteryNumbers
+	}
+
+	numbers := make([]int64, 0, len(fields))
+	seen := make(map[int64]bool, len(fields))
+	for _, field := range fields {
+		number, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a whole number", field)
+		}
+		if seen[number] {
+			return nil, fmt.Errorf("%d is picked more than once", number)
+		}
+		seen[number] = true
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
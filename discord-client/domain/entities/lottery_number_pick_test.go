@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLotteryNumbers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    []int64
+		wantErr string
+	}{
+		{name: "comma separated", input: "7, 42,128", want: []int64{7, 42, 128}},
+		{name: "spaces and newlines", input: " 3 9\n27 ", want: []int64{3, 9, 27}},
+		{name: "single number", input: "0", want: []int64{0}},
+		{name: "empty", input: " , ", wantErr: "pick at least one number"},
+		{name: "not a number", input: "7, lucky", wantErr: `"lucky" is not a whole number`},
+		{name: "repeated number", input: "7, 8, 7", wantErr: "7 is picked more than once"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseLotteryNumbers(tt.input)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLotteryDraw_ValidateTicketNumber(t *testing.T) {
+	t.Parallel()
+
+	draw := &LotteryDraw{Difficulty: 8}
+
+	assert.NoError(t, draw.ValidateTicketNumber(0))
+	assert.NoError(t, draw.ValidateTicketNumber(255))
+	assert.ErrorIs(t, draw.ValidateTicketNumber(256), ErrLotteryNumberOutOfRange)
+	assert.ErrorIs(t, draw.ValidateTicketNumber(-1), ErrLotteryNumberOutOfRange)
+}
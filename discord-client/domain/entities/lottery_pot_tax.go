@@ -0,0 +1,54 @@
+package entities
+
+// LotteryPotTaxShare is the part of a group wager winner's payout withheld by the guild's pot tax
+type LotteryPotTaxShare struct {
+	DiscordID int64
+	Amount    int64
+}
+
+// SplitLotteryPotTax withholds percent of a resolved group wager's pot from its winners, in proportion to
+// their net winnings. The tax is capped at the winners' combined net winnings so nobody loses bits by
+// winning, and shares are rounded down. Winners must have their payout set; those whose share rounds to
+// zero are omitted.
+func SplitLotteryPotTax(totalPot int64, percent int, winners []*GroupWagerParticipant) []*LotteryPotTaxShare {
+	if totalPot <= 0 || percent <= 0 {
+		return nil
+	}
+
+	var totalWinnings int64
+	for _, winner := range winners {
+		totalWinnings += netWinnings(winner)
+	}
+	if totalWinnings == 0 {
+		return nil
+	}
+
+	tax := min(totalPot*int64(percent)/100, totalWinnings)
+
+	shares := make([]*LotteryPotTaxShare, 0, len(winners))
+	for _, winner := range winners {
+		amount := tax * netWinnings(winner) / totalWinnings
+		if amount <= 0 {
+			continue
+		}
+		shares = append(shares, &LotteryPotTaxShare{DiscordID: winner.DiscordID, Amount: amount})
+	}
+	return shares
+}
+
+// TotalLotteryPotTax returns the sum of the shares
+func TotalLotteryPotTax(shares []*LotteryPotTaxShare) int64 {
+	var total int64
+	for _, share := range shares {
+		total += share.Amount
+	}
+	return total
+}
+
+// netWinnings returns what a winner gained beyond their bet
+func netWinnings(winner *GroupWagerParticipant) int64 {
+	if winner.PayoutAmount == nil || *winner.PayoutAmount <= winner.Amount {
+		return 0
+	}
+	return *winner.PayoutAmount - winner.Amount
+}
@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitLotteryPotTax(t *testing.T) {
+	t.Parallel()
+
+	winner := func(discordID, amount, payout int64) *GroupWagerParticipant {
+		return &GroupWagerParticipant{DiscordID: discordID, Amount: amount, PayoutAmount: &payout}
+	}
+
+	t.Run("splits by net winnings", func(t *testing.T) {
+		t.Parallel()
+
+		shares := SplitLotteryPotTax(10000, 5, []*GroupWagerParticipant{
+			winner(1, 3000, 7500),
+			winner(2, 1000, 2500),
+		})
+		assert.Equal(t, []*LotteryPotTaxShare{
+			{DiscordID: 1, Amount: 375},
+			{DiscordID: 2, Amount: 125},
+		}, shares)
+		assert.Equal(t, int64(500), TotalLotteryPotTax(shares))
+	})
+
+	t.Run("capped at net winnings", func(t *testing.T) {
+		t.Parallel()
+
+		// Nearly everyone backed the winner, so the pot barely moved
+		shares := SplitLotteryPotTax(10000, 10, []*GroupWagerParticipant{winner(1, 9900, 10000)})
+		assert.Equal(t, []*LotteryPotTaxShare{{DiscordID: 1, Amount: 100}}, shares)
+	})
+
+	t.Run("break-even winners are not taxed", func(t *testing.T) {
+		t.Parallel()
+
+		shares := SplitLotteryPotTax(5000, 5, []*GroupWagerParticipant{winner(1, 5000, 5000)})
+		assert.Empty(t, shares)
+	})
+
+	t.Run("no tax", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, SplitLotteryPotTax(10000, 0, []*GroupWagerParticipant{winner(1, 1000, 10000)}))
+		assert.Empty(t, SplitLotteryPotTax(10000, 5, nil))
+	})
+}
@@ -0,0 +1,16 @@
+package entities
+
+import "time"
+
+// MaxLotterySubscriptionTickets is the most tickets a subscription can buy per draw
+const MaxLotterySubscriptionTickets = 100
+
+// LotterySubscription buys a fixed number of tickets for a user at the start of each new draw
+type LotterySubscription struct {
+	ID          int64     `db:"id"`
+	GuildID     int64     `db:"guild_id"`
+	DiscordID   int64     `db:"discord_id"`
+	TicketCount int       `db:"ticket_count"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
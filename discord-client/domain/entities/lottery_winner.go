@@ -12,3 +12,20 @@ type LotteryWinner struct {
 	BalanceHistoryID int64     `db:"balance_history_id"`
 	CreatedAt        time.Time `db:"created_at"`
 }
+
+// LotteryUserWin is one of a user's lottery wins along with the draw it came from
+type LotteryUserWin struct {
+	DrawID        int64
+	WinningNumber int64
+	Difficulty    int64
+	TotalPot      int64
+	WinningAmount int64
+	WinnerCount   int64 // Winners who split the pot, including this user
+	CompletedAt   time.Time
+}
+
+// LotteryUserWinTotals summarizes all of a user's lottery wins in a guild
+type LotteryUserWinTotals struct {
+	WinCount int64
+	TotalWon int64
+}
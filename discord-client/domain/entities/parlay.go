@@ -0,0 +1,128 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// ParlayStatus represents the settlement state of a parlay or one of its legs
+type ParlayStatus string
+
+const (
+	ParlayStatusPending ParlayStatus = "pending"
+	ParlayStatusWon     ParlayStatus = "won"
+	ParlayStatusLost    ParlayStatus = "lost"
+	ParlayStatusVoid    ParlayStatus = "void" // Leg's wager was cancelled, or every leg of the parlay was
+)
+
+// Parlay leg limits
+const (
+	MinParlayLegs = 2
+	MaxParlayLegs = 5
+)
+
+// ErrParlayNotFound is returned when a parlay does not exist or belongs to another user
+var ErrParlayNotFound = errors.New("parlay not found")
+
+// Parlay is a single ticket combining picks on several house wagers. The stake is held out of the
+// user's available balance until every leg settles; it pays the stake times the product of the
+// winning legs' odds and loses the whole stake if any leg loses.
+type Parlay struct {
+	ID               int64        `db:"id"`
+	GuildID          int64        `db:"guild_id"`
+	DiscordID        int64        `db:"discord_id"`
+	Amount           int64        `db:"amount"`
+	Status           ParlayStatus `db:"status"`
+	PayoutAmount     *int64       `db:"payout_amount"`      // Set on settlement: stake times odds, 0 when lost, the stake when void
+	BalanceHistoryID *int64       `db:"balance_history_id"` // Win or loss transaction, NULL for void parlays
+	CreatedAt        time.Time    `db:"created_at"`
+	SettledAt        *time.Time   `db:"settled_at"`
+	Legs             []*ParlayLeg `db:"-"`
+}
+
+// ParlayLeg is one pick of a parlay, settled when its house wager is resolved or cancelled
+type ParlayLeg struct {
+	ID             int64        `db:"id"`
+	ParlayID       int64        `db:"parlay_id"`
+	GroupWagerID   int64        `db:"group_wager_id"`
+	OptionID       int64        `db:"option_id"`
+	OddsMultiplier float64      `db:"odds_multiplier"` // Locked in when the parlay was placed
+	Status         ParlayStatus `db:"status"`
+	SettledAt      *time.Time   `db:"settled_at"`
+}
+
+// ParlayLegSelection is a user's pick for one leg of a new parlay
+type ParlayLegSelection struct {
+	GroupWagerID int64
+	OptionNumber int // 1-based, as numbered on the wager embed
+}
+
+// IsPending returns true if the parlay has not been settled
+func (p *Parlay) IsPending() bool {
+	return p.Status == ParlayStatusPending
+}
+
+// CombinedOdds returns the product of the odds of every leg that has not been voided
+func (p *Parlay) CombinedOdds() float64 {
+	odds := 1.0
+	for _, leg := range p.Legs {
+		if leg.Status != ParlayStatusVoid {
+			odds *= leg.OddsMultiplier
+		}
+	}
+	return odds
+}
+
+// PotentialPayout returns what the parlay pays if every remaining leg wins
+func (p *Parlay) PotentialPayout() int64 {
+	return int64(float64(p.Amount) * p.CombinedOdds())
+}
+
+// Outcome determines the parlay's status from its legs: any lost leg loses the parlay, any pending
+// leg keeps it pending, all voided legs void it, and otherwise it has won
+func (p *Parlay) Outcome() ParlayStatus {
+	pending, voided := false, 0
+	for _, leg := range p.Legs {
+		switch leg.Status {
+		case ParlayStatusLost:
+			return ParlayStatusLost
+		case ParlayStatusPending:
+			pending = true
+		case ParlayStatusVoid:
+			voided++
+		}
+	}
+
+	switch {
+	case pending:
+		return ParlayStatusPending
+	case voided == len(p.Legs):
+		return ParlayStatusVoid
+	default:
+		return ParlayStatusWon
+	}
+}
+
+// SettleFromWager settles a pending leg from its wager's final state.
+// Returns false if the leg was already settled or the wager has not finished.
+func (l *ParlayLeg) SettleFromWager(wager *GroupWager, now time.Time) bool {
+	if l.Status != ParlayStatusPending || wager == nil || wager.ID != l.GroupWagerID {
+		return false
+	}
+
+	switch {
+	case wager.IsCancelled():
+		l.Status = ParlayStatusVoid
+	case wager.IsResolved() && wager.WinningOptionID != nil:
+		if *wager.WinningOptionID == l.OptionID {
+			l.Status = ParlayStatusWon
+		} else {
+			l.Status = ParlayStatusLost
+		}
+	default:
+		return false
+	}
+
+	l.SettledAt = &now
+	return true
+}
@@ -0,0 +1,80 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParlay_Outcome(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		legs []ParlayStatus
+		want ParlayStatus
+	}{
+		{name: "all won", legs: []ParlayStatus{ParlayStatusWon, ParlayStatusWon}, want: ParlayStatusWon},
+		{name: "lost leg loses while others pending", legs: []ParlayStatus{ParlayStatusLost, ParlayStatusPending}, want: ParlayStatusLost},
+		{name: "pending leg keeps parlay open", legs: []ParlayStatus{ParlayStatusWon, ParlayStatusPending}, want: ParlayStatusPending},
+		{name: "voided leg is skipped", legs: []ParlayStatus{ParlayStatusWon, ParlayStatusVoid}, want: ParlayStatusWon},
+		{name: "all voided", legs: []ParlayStatus{ParlayStatusVoid, ParlayStatusVoid}, want: ParlayStatusVoid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			parlay := &Parlay{}
+			for _, status := range tt.legs {
+				parlay.Legs = append(parlay.Legs, &ParlayLeg{Status: status})
+			}
+			assert.Equal(t, tt.want, parlay.Outcome())
+		})
+	}
+}
+
+func TestParlay_PotentialPayoutSkipsVoidedLegs(t *testing.T) {
+	t.Parallel()
+
+	parlay := &Parlay{
+		Amount: 1000,
+		Legs: []*ParlayLeg{
+			{OddsMultiplier: 2.0, Status: ParlayStatusWon},
+			{OddsMultiplier: 1.5, Status: ParlayStatusPending},
+			{OddsMultiplier: 3.0, Status: ParlayStatusVoid},
+		},
+	}
+
+	assert.InDelta(t, 3.0, parlay.CombinedOdds(), 1e-9)
+	assert.Equal(t, int64(3000), parlay.PotentialPayout())
+}
+
+func TestParlayLeg_SettleFromWager(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	winningOptionID := int64(10)
+
+	tests := []struct {
+		name    string
+		wager   *GroupWager
+		settled bool
+		want    ParlayStatus
+	}{
+		{name: "picked the winner", wager: &GroupWager{ID: 1, State: GroupWagerStateResolved, WinningOptionID: &winningOptionID}, settled: true, want: ParlayStatusWon},
+		{name: "picked a loser", wager: &GroupWager{ID: 1, State: GroupWagerStateResolved, WinningOptionID: func() *int64 { v := int64(11); return &v }()}, settled: true, want: ParlayStatusLost},
+		{name: "cancelled game voids the leg", wager: &GroupWager{ID: 1, State: GroupWagerStateCancelled}, settled: true, want: ParlayStatusVoid},
+		{name: "active wager leaves the leg pending", wager: &GroupWager{ID: 1, State: GroupWagerStateActive}, settled: false, want: ParlayStatusPending},
+		{name: "other wager is ignored", wager: &GroupWager{ID: 2, State: GroupWagerStateCancelled}, settled: false, want: ParlayStatusPending},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			leg := &ParlayLeg{GroupWagerID: 1, OptionID: winningOptionID, Status: ParlayStatusPending}
+			assert.Equal(t, tt.settled, leg.SettleFromWager(tt.wager, now))
+			assert.Equal(t, tt.want, leg.Status)
+		})
+	}
+}
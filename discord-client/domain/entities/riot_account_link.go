@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxRiotTagLineLength is the longest tag line Riot allows after the '#'
+const MaxRiotTagLineLength = 5
+
+var (
+	// ErrRiotAccountLinkedElsewhere is returned when another user has already linked the Riot account
+	ErrRiotAccountLinkedElsewhere = errors.New("riot account is already linked to another user")
+	// ErrBetOnOwnGame is returned when a linked player bets on their own game and the guild blocks it
+	ErrBetOnOwnGame = errors.New("you can't bet on wagers about your own games in this server")
+)
+
+// RiotAccountLink associates a Discord user with their own Riot account, shared across guilds
+type RiotAccountLink struct {
+	DiscordID int64     `db:"discord_id"`
+	GameName  string    `db:"game_name"`
+	TagLine   string    `db:"tag_line"`
+	LinkedAt  time.Time `db:"linked_at"`
+}
+
+// RiotID returns the linked account in "GameName#TAG" format
+func (l *RiotAccountLink) RiotID() string {
+	return fmt.Sprintf("%s#%s", l.GameName, l.TagLine)
+}
+
+// Matches checks if the link is for the given Riot ID, ignoring case like Riot does
+func (l *RiotAccountLink) Matches(gameName, tagLine string) bool {
+	return strings.EqualFold(l.GameName, gameName) && strings.EqualFold(l.TagLine, tagLine)
+}
+
+// PlayerGameResult is the outcome of a resolved house wager on a linked player's own game
+type PlayerGameResult struct {
+	System        ExternalSystem
+	WinningOption string // Text of the winning option, e.g. "Win" or a TFT placement label
+	OptionCount   int    // Number of options the wager offered, used to tell Double Up apart
+}
+
+// PlayerGameStats summarises a linked player's own games from the house wagers on them
+type PlayerGameStats struct {
+	LoLGames   int
+	LoLWins    int
+	TFTGames   int
+	TFTTopHalf int // Top 4 in regular games, top 2 teams in Double Up
+}
+
+// NewPlayerGameStats tallies a player's game results. TFT results whose option label is
+// not a known placement are skipped.
+func NewPlayerGameStats(results []*PlayerGameResult) *PlayerGameStats {
+	stats := &PlayerGameStats{}
+	for _, result := range results {
+		switch result.System {
+		case SystemLeagueOfLegends:
+			stats.LoLGames++
+			if result.WinningOption == "Win" {
+				stats.LoLWins++
+			}
+		case SystemTFT:
+			placement, ok := ParseTFTPlacementOption(result.WinningOption)
+			if !ok {
+				continue
+			}
+			stats.TFTGames++
+			topHalf := int32(4)
+			if placement.Min == placement.Max && result.OptionCount == len(TFTDoubleUpPlacements) {
+				topHalf = 2
+			}
+			if placement.Max <= topHalf {
+				stats.TFTTopHalf++
+			}
+		}
+	}
+	return stats
+}
+
+// HasGames checks if the player has any tracked games
+func (s *PlayerGameStats) HasGames() bool {
+	return s.LoLGames > 0 || s.TFTGames > 0
+}
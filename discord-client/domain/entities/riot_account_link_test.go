@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRiotAccountLink_Matches(t *testing.T) {
+	link := &RiotAccountLink{GameName: "Faker", TagLine: "KR1"}
+
+	assert.Equal(t, "Faker#KR1", link.RiotID())
+	assert.True(t, link.Matches("faker", "kr1"))
+	assert.False(t, link.Matches("Faker", "NA1"))
+}
+
+func TestNewPlayerGameStats(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []*PlayerGameResult
+		want    PlayerGameStats
+	}{
+		{
+			name: "lol wins and losses",
+			results: []*PlayerGameResult{
+				{System: SystemLeagueOfLegends, WinningOption: "Win", OptionCount: 2},
+				{System: SystemLeagueOfLegends, WinningOption: "Win", OptionCount: 2},
+				{System: SystemLeagueOfLegends, WinningOption: "Loss", OptionCount: 2},
+			},
+			want: PlayerGameStats{LoLGames: 3, LoLWins: 2},
+		},
+		{
+			name: "tft top half across layouts",
+			results: []*PlayerGameResult{
+				{System: SystemTFT, WinningOption: "3-4", OptionCount: 4},
+				{System: SystemTFT, WinningOption: "5-6", OptionCount: 4},
+				{System: SystemTFT, WinningOption: "Top 4", OptionCount: 2},
+				{System: SystemTFT, WinningOption: "4", OptionCount: 8},
+				{System: SystemTFT, WinningOption: "7", OptionCount: 8},
+			},
+			want: PlayerGameStats{TFTGames: 5, TFTTopHalf: 3},
+		},
+		{
+			name: "double up top half is the top two teams",
+			results: []*PlayerGameResult{
+				{System: SystemTFT, WinningOption: "2", OptionCount: 4},
+				{System: SystemTFT, WinningOption: "3", OptionCount: 4},
+			},
+			want: PlayerGameStats{TFTGames: 2, TFTTopHalf: 1},
+		},
+		{
+			name: "unknown tft labels are skipped",
+			results: []*PlayerGameResult{
+				{System: SystemTFT, WinningOption: "Yes", OptionCount: 2},
+			},
+			want: PlayerGameStats{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := NewPlayerGameStats(tt.results)
+			assert.Equal(t, tt.want, *stats)
+			assert.Equal(t, tt.want != PlayerGameStats{}, stats.HasGames())
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// SavingsDepositStatus represents the lifecycle state of a savings deposit
+type SavingsDepositStatus string
+
+const (
+	SavingsDepositStatusLocked    SavingsDepositStatus = "locked"
+	SavingsDepositStatusMatured   SavingsDepositStatus = "matured"
+	SavingsDepositStatusWithdrawn SavingsDepositStatus = "withdrawn"
+)
+
+// Savings term limits
+const (
+	MinSavingsTermWeeks = 1
+	MaxSavingsTermWeeks = 4
+	MinSavingsDeposit   = 100
+)
+
+// ErrSavingsDepositNotFound is returned when a deposit does not exist or belongs to another user
+var ErrSavingsDepositNotFound = errors.New("savings deposit not found")
+
+// SavingsDeposit represents bits a user has locked for a fixed term in exchange for a bonus at maturity.
+// Locked deposits stay in the user's balance but are excluded from their available balance.
+type SavingsDeposit struct {
+	ID               int64                `db:"id"`
+	GuildID          int64                `db:"guild_id"`
+	DiscordID        int64                `db:"discord_id"`
+	Amount           int64                `db:"amount"`
+	TermWeeks        int                  `db:"term_weeks"`
+	BonusAmount      int64                `db:"bonus_amount"` // Fixed when the deposit is created
+	Status           SavingsDepositStatus `db:"status"`
+	LockedAt         time.Time            `db:"locked_at"`
+	MaturesAt        time.Time            `db:"matures_at"`
+	ClosedAt         *time.Time           `db:"closed_at"`
+	BalanceHistoryID *int64               `db:"balance_history_id"` // Bonus credit, set when the deposit matures
+}
+
+// NewSavingsDeposit creates a locked deposit, fixing its bonus at the given weekly rate
+func NewSavingsDeposit(guildID, discordID, amount int64, termWeeks, bonusPercentPerWeek int, now time.Time) (*SavingsDeposit, error) {
+	if amount < MinSavingsDeposit {
+		return nil, errors.New("deposit is below the minimum savings amount")
+	}
+	if termWeeks < MinSavingsTermWeeks || termWeeks > MaxSavingsTermWeeks {
+		return nil, errors.New("savings term must be between 1 and 4 weeks")
+	}
+
+	return &SavingsDeposit{
+		GuildID:     guildID,
+		DiscordID:   discordID,
+		Amount:      amount,
+		TermWeeks:   termWeeks,
+		BonusAmount: CalculateSavingsBonus(amount, termWeeks, bonusPercentPerWeek),
+		Status:      SavingsDepositStatusLocked,
+		LockedAt:    now,
+		MaturesAt:   now.AddDate(0, 0, 7*termWeeks),
+	}, nil
+}
+
+// CalculateSavingsBonus returns the bonus earned by locking amount for termWeeks at the given weekly percent
+func CalculateSavingsBonus(amount int64, termWeeks, bonusPercentPerWeek int) int64 {
+	if bonusPercentPerWeek <= 0 {
+		return 0
+	}
+	return amount * int64(bonusPercentPerWeek) * int64(termWeeks) / 100
+}
+
+// IsLocked returns true if the deposit is still held
+func (d *SavingsDeposit) IsLocked() bool {
+	return d.Status == SavingsDepositStatusLocked
+}
+
+// HasMatured returns true if a locked deposit has reached its maturity time
+func (d *SavingsDeposit) HasMatured(now time.Time) bool {
+	return d.IsLocked() && !now.Before(d.MaturesAt)
+}
+
+// TotalAtMaturity returns the principal plus the bonus paid at maturity
+func (d *SavingsDeposit) TotalAtMaturity() int64 {
+	return d.Amount + d.BonusAmount
+}
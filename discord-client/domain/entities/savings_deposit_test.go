@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateSavingsBonus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		amount    int64
+		termWeeks int
+		percent   int
+		want      int64
+	}{
+		{name: "one week at default rate", amount: 10000, termWeeks: 1, percent: 2, want: 200},
+		{name: "four weeks at default rate", amount: 10000, termWeeks: 4, percent: 2, want: 800},
+		{name: "rounds down", amount: 149, termWeeks: 1, percent: 2, want: 2},
+		{name: "zero rate", amount: 10000, termWeeks: 4, percent: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, CalculateSavingsBonus(tt.amount, tt.termWeeks, tt.percent))
+		})
+	}
+}
+
+func TestNewSavingsDeposit(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	deposit, err := NewSavingsDeposit(1, 2, 5000, 2, 3, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(300), deposit.BonusAmount)
+	assert.Equal(t, now.AddDate(0, 0, 14), deposit.MaturesAt)
+	assert.True(t, deposit.IsLocked())
+	assert.False(t, deposit.HasMatured(now.AddDate(0, 0, 13)))
+	assert.True(t, deposit.HasMatured(now.AddDate(0, 0, 14)))
+	assert.Equal(t, int64(5300), deposit.TotalAtMaturity())
+
+	_, err = NewSavingsDeposit(1, 2, 5000, 0, 3, now)
+	assert.Error(t, err)
+
+	_, err = NewSavingsDeposit(1, 2, MinSavingsDeposit-1, 1, 3, now)
+	assert.Error(t, err)
+}
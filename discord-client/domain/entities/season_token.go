@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrSeasonTokensDisabled is returned when season tokens are moved in a guild without a seasonal currency
+	ErrSeasonTokensDisabled = errors.New("this server has no season tokens")
+	// ErrInsufficientSeasonTokens is returned when a debit is larger than the user's season token balance
+	ErrInsufficientSeasonTokens = errors.New("insufficient season tokens")
+)
+
+// SeasonTokenBalance is a user's balance of the guild's seasonal secondary currency for one season
+type SeasonTokenBalance struct {
+	DiscordID int64  `db:"discord_id"`
+	GuildID   int64  `db:"guild_id"`
+	Season    int    `db:"season"`
+	Balance   int64  `db:"balance"`
+	TokenName string `db:"-"` // The guild's name for its season tokens
+}
+
+// SeasonTokenHistory records a single credit or debit of season tokens
+type SeasonTokenHistory struct {
+	ID                  int64                  `db:"id"`
+	DiscordID           int64                  `db:"discord_id"`
+	GuildID             int64                  `db:"guild_id"`
+	Season              int                    `db:"season"`
+	BalanceBefore       int64                  `db:"balance_before"`
+	BalanceAfter        int64                  `db:"balance_after"`
+	ChangeAmount        int64                  `db:"change_amount"`
+	TransactionType     TransactionType        `db:"transaction_type"`
+	TransactionMetadata map[string]interface{} `db:"transaction_metadata"`
+	CreatedAt           time.Time              `db:"created_at"`
+}
@@ -1,5 +1,11 @@
 package entities
 
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
 // BetStats represents aggregated betting statistics
 type BetStats struct {
 	TotalBets    int
@@ -27,6 +33,16 @@ type WagerStats struct {
 	BiggestLoss    int64
 }
 
+// CachedUserStats holds a user's bet and wager aggregates for a guild, kept current incrementally
+// so stats don't have to scan the bets and wagers tables
+type CachedUserStats struct {
+	DiscordID int64
+	GuildID   int64
+	Bets      BetStats
+	Wagers    WagerStats
+	UpdatedAt time.Time
+}
+
 // UserStats represents combined statistics for a user
 type UserStats struct {
 	User             *User
@@ -34,6 +50,9 @@ type UserStats struct {
 	WagerStats       *WagerStatsDetail
 	GroupWagerStats  *GroupWagerStats
 	ReservedInWagers int64 // Amount currently locked in active wagers
+	BiggestWins      []*BalanceHistoryWithContext
+	BiggestLosses    []*BalanceHistoryWithContext
+	PlayerGames      *PlayerGameStats // The user's own linked games, nil if none were wagered on
 }
 
 // BetStatsDetail contains detailed betting statistics
@@ -92,6 +111,25 @@ type GroupWagerStats struct {
 	TotalWonAmount   int64
 }
 
+// GroupWagerCreatorStats summarizes the group wagers a user has created
+type GroupWagerCreatorStats struct {
+	DiscordID            int64
+	TotalCreated         int
+	TotalResolved        int
+	TotalCancelled       int
+	AveragePot           float64       // Across wagers that weren't cancelled
+	AverageParticipants  float64       // Across wagers that weren't cancelled
+	AverageTimeToResolve time.Duration // From creation to resolution, zero when none have resolved
+	PopularOptions       []*GroupWagerPopularOption
+}
+
+// GroupWagerPopularOption is an option text that drew bets across a creator's wagers
+type GroupWagerPopularOption struct {
+	OptionText  string
+	BetCount    int
+	TotalAmount int64
+}
+
 // ScoreboardEntry represents a user's entry in the scoreboard
 type ScoreboardEntry struct {
 	Rank             int
@@ -127,6 +165,7 @@ type WagerPredictionStats struct {
 	TotalPredictions   int     `json:"total_predictions"`
 	AccuracyPercentage float64 `json:"accuracy_percentage"`
 	TotalAmountWagered int64   `json:"total_amount_wagered"`
+	NetProfit          int64   `json:"net_profit"` // Payouts minus amounts wagered on resolved wagers
 }
 
 // CalculateAccuracy computes the accuracy percentage
@@ -143,6 +182,72 @@ func (s *WagerPredictionStats) HasData() bool {
 	return s.TotalPredictions > 0
 }
 
+// RankWagerPredictionStats orders predictors with at least minPredictions predictions by accuracy.
+// Ties go to more correct predictions, then the lower Discord ID so the order is stable.
+func RankWagerPredictionStats(stats map[int64]*WagerPredictionStats, minPredictions int) []*WagerPredictionStats {
+	ranked := make([]*WagerPredictionStats, 0, len(stats))
+	for _, s := range stats {
+		if s.TotalPredictions >= minPredictions {
+			ranked = append(ranked, s)
+		}
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.AccuracyPercentage != b.AccuracyPercentage {
+			return a.AccuracyPercentage > b.AccuracyPercentage
+		}
+		if a.CorrectPredictions != b.CorrectPredictions {
+			return a.CorrectPredictions > b.CorrectPredictions
+		}
+		return a.DiscordID < b.DiscordID
+	})
+
+	return ranked
+}
+
+// LeaderboardWindow limits a prediction leaderboard to wagers created within a recent period
+type LeaderboardWindow string
+
+const (
+	LeaderboardWindowAllTime LeaderboardWindow = "all"
+	LeaderboardWindow7Days   LeaderboardWindow = "7d"
+	LeaderboardWindow30Days  LeaderboardWindow = "30d"
+	LeaderboardWindow90Days  LeaderboardWindow = "90d"
+)
+
+// Days returns how many days the window covers, or 0 for all time
+func (w LeaderboardWindow) Days() int {
+	switch w {
+	case LeaderboardWindow7Days:
+		return 7
+	case LeaderboardWindow30Days:
+		return 30
+	case LeaderboardWindow90Days:
+		return 90
+	default:
+		return 0
+	}
+}
+
+// Since returns the earliest wager creation time the window counts, or nil for all time
+func (w LeaderboardWindow) Since(now time.Time) *time.Time {
+	days := w.Days()
+	if days == 0 {
+		return nil
+	}
+	since := now.AddDate(0, 0, -days)
+	return &since
+}
+
+// Label returns the display name of the window
+func (w LeaderboardWindow) Label() string {
+	if days := w.Days(); days > 0 {
+		return fmt.Sprintf("Last %d Days", days)
+	}
+	return "All Time"
+}
+
 // LOLPredictionStats represents LOL-specific prediction statistics
 type LOLPredictionStats struct {
 	DiscordID          int64   `json:"discord_id"`
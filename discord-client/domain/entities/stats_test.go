@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankWagerPredictionStats(t *testing.T) {
+	t.Parallel()
+
+	stats := map[int64]*WagerPredictionStats{
+		1: {DiscordID: 1, CorrectPredictions: 2, TotalPredictions: 2},  // 100%, too few predictions
+		2: {DiscordID: 2, CorrectPredictions: 3, TotalPredictions: 6},  // 50%
+		3: {DiscordID: 3, CorrectPredictions: 6, TotalPredictions: 8},  // 75%
+		4: {DiscordID: 4, CorrectPredictions: 6, TotalPredictions: 8},  // 75%, same as 3
+		5: {DiscordID: 5, CorrectPredictions: 5, TotalPredictions: 10}, // 50%, more correct than 2
+	}
+	for _, s := range stats {
+		s.CalculateAccuracy()
+	}
+
+	ranked := RankWagerPredictionStats(stats, 3)
+	ids := make([]int64, len(ranked))
+	for i, s := range ranked {
+		ids[i] = s.DiscordID
+	}
+	assert.Equal(t, []int64{3, 4, 5, 2}, ids)
+
+	assert.Len(t, RankWagerPredictionStats(stats, 0), 5, "no minimum keeps everyone")
+	assert.Empty(t, RankWagerPredictionStats(stats, 11))
+}
+
+func TestLeaderboardWindow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		window    LeaderboardWindow
+		wantSince *time.Time
+		wantLabel string
+	}{
+		{window: LeaderboardWindowAllTime, wantLabel: "All Time"},
+		{window: LeaderboardWindow7Days, wantSince: func() *time.Time { t := time.Date(2024, 6, 23, 12, 0, 0, 0, time.UTC); return &t }(), wantLabel: "Last 7 Days"},
+		{window: LeaderboardWindow30Days, wantSince: func() *time.Time { t := time.Date(2024, 5, 31, 12, 0, 0, 0, time.UTC); return &t }(), wantLabel: "Last 30 Days"},
+		{window: LeaderboardWindow90Days, wantSince: func() *time.Time { t := time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC); return &t }(), wantLabel: "Last 90 Days"},
+		{window: "season", wantLabel: "All Time"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.wantSince, tt.window.Since(now), tt.window)
+		assert.Equal(t, tt.wantLabel, tt.window.Label(), tt.window)
+	}
+}
@@ -0,0 +1,87 @@
+package entities
+
+import "time"
+
+// StatsTimeSeriesBucket is one day of a user's balance history
+type StatsTimeSeriesBucket struct {
+	Day            time.Time // Start of the day (UTC)
+	Wins           int
+	Losses         int
+	NetProfit      int64 // Sum of the day's win and loss changes
+	OpeningBalance int64 // Balance before the day's first entry
+	ClosingBalance int64 // Balance after the day's last entry
+}
+
+// HasActivity returns true if the bucket recorded any wins or losses
+func (b *StatsTimeSeriesBucket) HasActivity() bool {
+	return b.Wins > 0 || b.Losses > 0
+}
+
+// UserStatsTimeSeries is a user's win/loss record and balance bucketed by day
+type UserStatsTimeSeries struct {
+	DiscordID int64
+	System    *ExternalSystem // nil for all wins and losses; the balance line always covers everything
+	Buckets   []*StatsTimeSeriesBucket
+}
+
+// TotalWins returns the number of wins across all buckets
+func (ts *UserStatsTimeSeries) TotalWins() int {
+	total := 0
+	for _, b := range ts.Buckets {
+		total += b.Wins
+	}
+	return total
+}
+
+// TotalLosses returns the number of losses across all buckets
+func (ts *UserStatsTimeSeries) TotalLosses() int {
+	total := 0
+	for _, b := range ts.Buckets {
+		total += b.Losses
+	}
+	return total
+}
+
+// NetProfit returns the summed net profit across all buckets
+func (ts *UserStatsTimeSeries) NetProfit() int64 {
+	var total int64
+	for _, b := range ts.Buckets {
+		total += b.NetProfit
+	}
+	return total
+}
+
+// FillStatsTimeSeries returns one bucket per day from the start of from's day for the given number of days.
+// active holds the repository's buckets for days with balance history, oldest first; days without history
+// carry the previous closing balance forward, and days before the first entry use its opening balance.
+// currentBalance is used when there is no history in the window at all.
+func FillStatsTimeSeries(from time.Time, days int, active []*StatsTimeSeriesBucket, currentBalance int64) []*StatsTimeSeriesBucket {
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+
+	byDay := make(map[time.Time]*StatsTimeSeriesBucket, len(active))
+	for _, b := range active {
+		byDay[b.Day.UTC()] = b
+	}
+
+	balance := currentBalance
+	if len(active) > 0 {
+		balance = active[0].OpeningBalance
+	}
+
+	buckets := make([]*StatsTimeSeriesBucket, 0, days)
+	for d := 0; d < days; d++ {
+		day := start.AddDate(0, 0, d)
+		if b, ok := byDay[day]; ok {
+			buckets = append(buckets, b)
+			balance = b.ClosingBalance
+			continue
+		}
+		buckets = append(buckets, &StatsTimeSeriesBucket{
+			Day:            day,
+			OpeningBalance: balance,
+			ClosingBalance: balance,
+		})
+	}
+
+	return buckets
+}
@@ -0,0 +1,45 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFillStatsTimeSeries(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, 3, 1, 15, 30, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	t.Run("carries balances across quiet days", func(t *testing.T) {
+		t.Parallel()
+
+		active := []*StatsTimeSeriesBucket{
+			{Day: day(2), Wins: 1, NetProfit: 200, OpeningBalance: 1000, ClosingBalance: 1200},
+			{Day: day(4), Losses: 1, NetProfit: -100, OpeningBalance: 1200, ClosingBalance: 1100},
+		}
+
+		buckets := FillStatsTimeSeries(from, 5, active, 9999)
+
+		assert.Len(t, buckets, 5)
+		assert.Equal(t, day(1), buckets[0].Day)
+		assert.Equal(t, int64(1000), buckets[0].ClosingBalance)
+		assert.False(t, buckets[0].HasActivity())
+		assert.True(t, buckets[1].HasActivity())
+		assert.Equal(t, int64(1200), buckets[2].ClosingBalance)
+		assert.Equal(t, int64(1100), buckets[4].ClosingBalance)
+	})
+
+	t.Run("no history uses current balance", func(t *testing.T) {
+		t.Parallel()
+
+		buckets := FillStatsTimeSeries(from, 3, nil, 750)
+
+		assert.Len(t, buckets, 3)
+		for _, b := range buckets {
+			assert.Equal(t, int64(750), b.ClosingBalance)
+		}
+	})
+}
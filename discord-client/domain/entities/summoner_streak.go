@@ -0,0 +1,45 @@
+package entities
+
+import "fmt"
+
+// MinWinStreakForWager is the streak length at which a streak wager is offered
+const MinWinStreakForWager = 3
+
+// SummonerStreak tracks a summoner's current run of consecutive wins
+type SummonerStreak struct {
+	Length      int
+	StartGameID string // Game that started the streak, identifying it across games
+	LastGameID  string // Last game recorded, so a repeated result is not counted twice
+}
+
+// RecordGame extends the streak on a win and breaks it on a loss.
+// Returns false without changes if the game was already recorded.
+func (s *SummonerStreak) RecordGame(gameID string, won bool) bool {
+	if gameID == s.LastGameID {
+		return false
+	}
+	s.LastGameID = gameID
+
+	if !won {
+		s.Length = 0
+		s.StartGameID = ""
+		return true
+	}
+
+	if s.Length == 0 {
+		s.StartGameID = gameID
+	}
+	s.Length++
+	return true
+}
+
+// HasWager reports whether the streak is long enough to have a streak wager
+func (s *SummonerStreak) HasWager() bool {
+	return s.Length >= MinWinStreakForWager
+}
+
+// WagerGameID returns the external ID of the wager on extending the streak at its current length.
+// Keying on the streak's first game and its length offers each streak length at most once.
+func (s *SummonerStreak) WagerGameID() string {
+	return fmt.Sprintf("%s:streak:%d", s.StartGameID, s.Length)
+}
@@ -0,0 +1,30 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummonerStreak_RecordGame(t *testing.T) {
+	t.Parallel()
+
+	var streak SummonerStreak
+	for _, gameID := range []string{"g1", "g2", "g3"} {
+		assert.True(t, streak.RecordGame(gameID, true))
+	}
+	assert.Equal(t, 3, streak.Length)
+	assert.True(t, streak.HasWager())
+	assert.Equal(t, "g1:streak:3", streak.WagerGameID())
+
+	assert.False(t, streak.RecordGame("g3", true), "a repeated game is ignored")
+	assert.Equal(t, 3, streak.Length)
+
+	assert.True(t, streak.RecordGame("g4", false))
+	assert.Equal(t, 0, streak.Length)
+	assert.False(t, streak.HasWager())
+
+	streak.RecordGame("g5", true)
+	assert.Equal(t, "g5", streak.StartGameID, "a new streak starts from its first win")
+	assert.Equal(t, 1, streak.Length)
+}
@@ -1,9 +1,37 @@
 package entities
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 )
 
+// MaxSummonerWatchesPerGuild limits how many summoners a single guild can track
+const MaxSummonerWatchesPerGuild = 25
+
+var (
+	ErrSummonerAlreadyWatched    = errors.New("summoner is already being watched")
+	ErrSummonerWatchNotFound     = errors.New("summoner watch not found")
+	ErrSummonerWatchLimitReached = fmt.Errorf("guilds can watch at most %d summoners", MaxSummonerWatchesPerGuild)
+)
+
+// ParseRiotID splits a Riot ID in "GameName#TAG" format into its game name and tag line
+func ParseRiotID(riotID string) (gameName, tagLine string, err error) {
+	separator := strings.LastIndex(riotID, "#")
+	if separator < 0 {
+		return "", "", fmt.Errorf("riot ID must be in the format Name#TAG")
+	}
+
+	gameName = strings.TrimSpace(riotID[:separator])
+	tagLine = strings.TrimSpace(riotID[separator+1:])
+	if gameName == "" || tagLine == "" {
+		return "", "", fmt.Errorf("riot ID must be in the format Name#TAG")
+	}
+
+	return gameName, tagLine, nil
+}
+
 // SummonerWatchDetail represents a combined view of summoner and watch information
 // Used for API responses that need both summoner details and watch metadata
 type SummonerWatchDetail struct {
@@ -26,4 +54,4 @@ func (swd *SummonerWatchDetail) GetFullName() string {
 // IsValid checks if the detail has valid summoner information
 func (swd *SummonerWatchDetail) IsValid() bool {
 	return swd.SummonerName != "" && swd.TagLine != "" && swd.GuildID > 0
-}
\ No newline at end of file
+}
@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRiotID(t *testing.T) {
+	tests := []struct {
+		riotID       string
+		expectedName string
+		expectedTag  string
+		expectErr    bool
+	}{
+		{riotID: "Faker#KR1", expectedName: "Faker", expectedTag: "KR1"},
+		{riotID: "Hide on bush#KR1", expectedName: "Hide on bush", expectedTag: "KR1"},
+		{riotID: " Doublelift # NA1 ", expectedName: "Doublelift", expectedTag: "NA1"},
+		{riotID: "Faker", expectErr: true},
+		{riotID: "#KR1", expectErr: true},
+		{riotID: "Faker#", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.riotID, func(t *testing.T) {
+			name, tag, err := ParseRiotID(tt.riotID)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedName, name)
+			assert.Equal(t, tt.expectedTag, tag)
+		})
+	}
+}
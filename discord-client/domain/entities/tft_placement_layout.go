@@ -0,0 +1,108 @@
+package entities
+
+import "fmt"
+
+// TFTPlacementLayout selects how the placements of a regular 8 player TFT game are split into house wager options
+type TFTPlacementLayout string
+
+const (
+	TFTPlacementLayoutPairs     TFTPlacementLayout = "pairs"      // 1-2, 3-4, 5-6, 7-8 at 4x
+	TFTPlacementLayoutExact     TFTPlacementLayout = "exact"      // Each placement 1 through 8 at 8x
+	TFTPlacementLayoutTopBottom TFTPlacementLayout = "top_bottom" // Top 4 or Bottom 4 at 2x
+)
+
+// DefaultTFTPlacementLayout is used when a guild has not chosen a layout
+const DefaultTFTPlacementLayout = TFTPlacementLayoutPairs
+
+// TFTPlacementRange is a single wager option covering placements Min through Max inclusive
+type TFTPlacementRange struct {
+	Label string
+	Min   int32
+	Max   int32
+	Odds  float64
+}
+
+// Contains checks if the placement falls in the range
+func (r TFTPlacementRange) Contains(placement int32) bool {
+	return placement >= r.Min && placement <= r.Max
+}
+
+// tftPlacementLayouts lists the options of each layout in display order
+var tftPlacementLayouts = map[TFTPlacementLayout][]TFTPlacementRange{
+	TFTPlacementLayoutPairs: {
+		{Label: "1-2", Min: 1, Max: 2, Odds: 4.0},
+		{Label: "3-4", Min: 3, Max: 4, Odds: 4.0},
+		{Label: "5-6", Min: 5, Max: 6, Odds: 4.0},
+		{Label: "7-8", Min: 7, Max: 8, Odds: 4.0},
+	},
+	TFTPlacementLayoutExact: exactTFTPlacements(8, 8.0),
+	TFTPlacementLayoutTopBottom: {
+		{Label: "Top 4", Min: 1, Max: 4, Odds: 2.0},
+		{Label: "Bottom 4", Min: 5, Max: 8, Odds: 2.0},
+	},
+}
+
+// TFTDoubleUpPlacements are the options for Double Up games, where 4 teams place 1 through 4
+var TFTDoubleUpPlacements = exactTFTPlacements(4, 4.0)
+
+// exactTFTPlacements returns one option per placement from 1 to count
+func exactTFTPlacements(count int32, odds float64) []TFTPlacementRange {
+	ranges := make([]TFTPlacementRange, count)
+	for i := range ranges {
+		placement := int32(i) + 1
+		ranges[i] = TFTPlacementRange{Label: fmt.Sprintf("%d", placement), Min: placement, Max: placement, Odds: odds}
+	}
+	return ranges
+}
+
+// TFTPlacementLayouts returns every supported layout in a stable order
+func TFTPlacementLayouts() []TFTPlacementLayout {
+	return []TFTPlacementLayout{TFTPlacementLayoutPairs, TFTPlacementLayoutExact, TFTPlacementLayoutTopBottom}
+}
+
+// IsValid checks if the layout is supported
+func (l TFTPlacementLayout) IsValid() bool {
+	_, ok := tftPlacementLayouts[l]
+	return ok
+}
+
+// Ranges returns the wager options of the layout, falling back to the default for unknown layouts
+func (l TFTPlacementLayout) Ranges() []TFTPlacementRange {
+	ranges, ok := tftPlacementLayouts[l]
+	if !ok {
+		ranges = tftPlacementLayouts[DefaultTFTPlacementLayout]
+	}
+	return append([]TFTPlacementRange(nil), ranges...)
+}
+
+// DisplayName returns a user-friendly description of the layout
+func (l TFTPlacementLayout) DisplayName() string {
+	switch l {
+	case TFTPlacementLayoutPairs:
+		return "Pairs (1-2 / 3-4 / 5-6 / 7-8 at 4x)"
+	case TFTPlacementLayoutExact:
+		return "Exact placement (1 through 8 at 8x)"
+	case TFTPlacementLayoutTopBottom:
+		return "Top 4 / Bottom 4 at 2x"
+	default:
+		return string(l)
+	}
+}
+
+// ParseTFTPlacementOption finds the placements covered by a TFT wager option from its label.
+// Labels from every layout and queue preset are recognised so wagers still resolve after a guild switches layouts.
+func ParseTFTPlacementOption(label string) (TFTPlacementRange, bool) {
+	for _, layout := range TFTPlacementLayouts() {
+		for _, r := range tftPlacementLayouts[layout] {
+			if r.Label == label {
+				return r, true
+			}
+		}
+	}
+	for _, r := range TFTTurboPlacements {
+		if r.Label == label {
+			return r, true
+		}
+	}
+	return TFTPlacementRange{}, false
+}
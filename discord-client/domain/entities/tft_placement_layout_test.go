@@ -0,0 +1,92 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTFTPlacementLayout_RangesCoverEveryPlacementOnce(t *testing.T) {
+	t.Parallel()
+
+	for _, layout := range TFTPlacementLayouts() {
+		t.Run(string(layout), func(t *testing.T) {
+			t.Parallel()
+
+			ranges := layout.Ranges()
+			require.NotEmpty(t, ranges)
+			for placement := int32(1); placement <= 8; placement++ {
+				matches := 0
+				for _, r := range ranges {
+					if r.Contains(placement) {
+						matches++
+					}
+				}
+				assert.Equal(t, 1, matches, "placement %d", placement)
+			}
+		})
+	}
+}
+
+func TestTFTPlacementLayout_Ranges(t *testing.T) {
+	t.Parallel()
+
+	exact := TFTPlacementLayoutExact.Ranges()
+	require.Len(t, exact, 8)
+	assert.Equal(t, "8", exact[7].Label)
+	assert.Equal(t, 8.0, exact[7].Odds)
+
+	topBottom := TFTPlacementLayoutTopBottom.Ranges()
+	require.Len(t, topBottom, 2)
+	assert.Equal(t, TFTPlacementRange{Label: "Bottom 4", Min: 5, Max: 8, Odds: 2.0}, topBottom[1])
+
+	// Unknown layouts fall back to the default
+	assert.Equal(t, TFTPlacementLayoutPairs.Ranges(), TFTPlacementLayout("thirds").Ranges())
+	assert.False(t, TFTPlacementLayout("thirds").IsValid())
+}
+
+func TestParseTFTPlacementOption(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		label  string
+		wantOK bool
+		min    int32
+		max    int32
+	}{
+		{label: "3-4", wantOK: true, min: 3, max: 4},
+		{label: "6", wantOK: true, min: 6, max: 6},
+		{label: "Top 4", wantOK: true, min: 1, max: 4},
+		{label: "Bottom 4", wantOK: true, min: 5, max: 8},
+		{label: "2-4", wantOK: true, min: 2, max: 4},
+		{label: "1-4", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			t.Parallel()
+
+			r, ok := ParseTFTPlacementOption(tt.label)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.min, r.Min)
+				assert.Equal(t, tt.max, r.Max)
+			}
+		})
+	}
+}
+
+func TestGuildSettings_GetTFTPlacementLayout(t *testing.T) {
+	t.Parallel()
+
+	settings := &GuildSettings{}
+	assert.Equal(t, DefaultTFTPlacementLayout, settings.GetTFTPlacementLayout())
+
+	exact := TFTPlacementLayoutExact
+	settings.SetTFTPlacementLayout(&exact)
+	assert.Equal(t, TFTPlacementLayoutExact, settings.GetTFTPlacementLayout())
+
+	settings.SetTFTPlacementLayout(nil)
+	assert.Nil(t, settings.TftPlacementLayout)
+}
@@ -0,0 +1,62 @@
+package entities
+
+// TFTOddsPreset selects how a TFT queue's placements are offered as house wager options
+type TFTOddsPreset string
+
+const (
+	TFTOddsPresetStandard TFTOddsPreset = "standard"  // The guild's placement layout for 8 player games
+	TFTOddsPresetDoubleUp TFTOddsPreset = "double_up" // Team placements 1 through 4
+	TFTOddsPresetTurbo    TFTOddsPreset = "turbo"     // 1st / 2-4 / 5-8, for fast games where only the top spot is usually contested
+)
+
+// TFTTurboPlacements are the options of the turbo preset
+var TFTTurboPlacements = []TFTPlacementRange{
+	{Label: "1", Min: 1, Max: 1, Odds: 8.0},
+	{Label: "2-4", Min: 2, Max: 4, Odds: 2.67},
+	{Label: "5-8", Min: 5, Max: 8, Odds: 2.0},
+}
+
+// TFTQueue describes a TFT queue that house wagers are created for
+type TFTQueue struct {
+	Type                string // Queue type reported by the tracker
+	Name                string // Shown in the wager condition
+	Preset              TFTOddsPreset
+	VotingPeriodMinutes int
+}
+
+// tftQueues lists the queues house wagers are created for. Games in other queues are ignored,
+// so adding a TFT mode only takes an entry here.
+var tftQueues = []TFTQueue{
+	{Type: "TFT_RANKED", Name: "Ranked TFT", Preset: TFTOddsPresetStandard, VotingPeriodMinutes: 5},
+	{Type: "TFT_RANKED_DOUBLE_UP", Name: "Ranked Double Up", Preset: TFTOddsPresetDoubleUp, VotingPeriodMinutes: 5},
+	{Type: "TFT_HYPER_ROLL", Name: "Hyper Roll", Preset: TFTOddsPresetTurbo, VotingPeriodMinutes: 3},
+	{Type: "TFT_CHONCCS_TREASURE", Name: "Choncc's Treasure", Preset: TFTOddsPresetStandard, VotingPeriodMinutes: 5},
+	{Type: "TFT_SET_REVIVAL", Name: "TFT Set Revival", Preset: TFTOddsPresetStandard, VotingPeriodMinutes: 5},
+}
+
+// TFTQueues returns every supported queue in display order
+func TFTQueues() []TFTQueue {
+	return append([]TFTQueue(nil), tftQueues...)
+}
+
+// LookupTFTQueue finds a supported queue by its queue type
+func LookupTFTQueue(queueType string) (TFTQueue, bool) {
+	for _, queue := range tftQueues {
+		if queue.Type == queueType {
+			return queue, true
+		}
+	}
+	return TFTQueue{}, false
+}
+
+// Placements returns the wager options for a game in the queue. Only the standard preset uses the guild's layout.
+func (q TFTQueue) Placements(guildLayout TFTPlacementLayout) []TFTPlacementRange {
+	switch q.Preset {
+	case TFTOddsPresetDoubleUp:
+		return append([]TFTPlacementRange(nil), TFTDoubleUpPlacements...)
+	case TFTOddsPresetTurbo:
+		return append([]TFTPlacementRange(nil), TFTTurboPlacements...)
+	default:
+		return guildLayout.Ranges()
+	}
+}
@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTFTQueues_PlacementsCoverEveryPlacementOnce(t *testing.T) {
+	t.Parallel()
+
+	for _, queue := range TFTQueues() {
+		t.Run(queue.Type, func(t *testing.T) {
+			t.Parallel()
+
+			assert.NotEmpty(t, queue.Name)
+			assert.Positive(t, queue.VotingPeriodMinutes)
+
+			ranges := queue.Placements(DefaultTFTPlacementLayout)
+			require.NotEmpty(t, ranges)
+			players := int32(8)
+			if queue.Preset == TFTOddsPresetDoubleUp {
+				players = 4
+			}
+			for placement := int32(1); placement <= players; placement++ {
+				matches := 0
+				for _, r := range ranges {
+					if r.Contains(placement) {
+						matches++
+					}
+				}
+				assert.Equal(t, 1, matches, "placement %d", placement)
+			}
+
+			// Every option must resolve by its label
+			for _, r := range ranges {
+				parsed, ok := ParseTFTPlacementOption(r.Label)
+				require.True(t, ok, r.Label)
+				assert.Equal(t, r.Min, parsed.Min, r.Label)
+				assert.Equal(t, r.Max, parsed.Max, r.Label)
+			}
+		})
+	}
+}
+
+func TestLookupTFTQueue(t *testing.T) {
+	t.Parallel()
+
+	hyperRoll, ok := LookupTFTQueue("TFT_HYPER_ROLL")
+	require.True(t, ok)
+	assert.Equal(t, TFTOddsPresetTurbo, hyperRoll.Preset)
+	assert.Equal(t, TFTTurboPlacements, hyperRoll.Placements(TFTPlacementLayoutExact))
+
+	ranked, ok := LookupTFTQueue("TFT_RANKED")
+	require.True(t, ok)
+	assert.Equal(t, TFTPlacementLayoutExact.Ranges(), ranked.Placements(TFTPlacementLayoutExact))
+
+	_, ok = LookupTFTQueue("TFT_TUTORIAL")
+	assert.False(t, ok)
+}
@@ -0,0 +1,284 @@
+package entities
+
+import (
+	"fmt"
+)
+
+// TransactionSign describes which direction a transaction type is allowed to move a balance
+type TransactionSign string
+
+const (
+	// TransactionSignCredit types add bits; a zero change is allowed for break-even payouts
+	TransactionSignCredit TransactionSign = "credit"
+	// TransactionSignDebit types remove bits; a zero change is allowed for fully capped losses
+	TransactionSignDebit TransactionSign = "debit"
+)
+
+// TransactionCategory groups transaction types by the feature that produces them
+type TransactionCategory string
+
+const (
+	TransactionCategoryGambling TransactionCategory = "gambling"
+	TransactionCategoryTransfer TransactionCategory = "transfer"
+	TransactionCategoryLottery  TransactionCategory = "lottery"
+	TransactionCategorySavings  TransactionCategory = "savings"
+	TransactionCategorySystem   TransactionCategory = "system"
+)
+
+// TransactionTypeInfo is the registry metadata for a transaction type
+type TransactionTypeInfo struct {
+	Type        TransactionType
+	DisplayName string
+	Category    TransactionCategory
+	Sign        TransactionSign
+	RelatedType RelatedType // Entity referenced by related_id, empty when none is recorded
+	Flow        string      // Where the bits come from and go to, used for generated documentation
+}
+
+// transactionTypeRegistry lists every transaction type the system may record, in documentation order.
+// New balance-changing features must register their type here (and in the balance_history check constraint).
+var transactionTypeRegistry = []TransactionTypeInfo{
+	{
+		Type:        TransactionTypeBetWin,
+		DisplayName: "Bet win",
+		Category:    TransactionCategoryGambling,
+		Sign:        TransactionSignCredit,
+		RelatedType: RelatedTypeBet,
+		Flow:        "House pays out a winning /gamble bet",
+	},
+	{
+		Type:        TransactionTypeBetLoss,
+		DisplayName: "Bet loss",
+		Category:    TransactionCategoryGambling,
+		Sign:        TransactionSignDebit,
+		RelatedType: RelatedTypeBet,
+		Flow:        "A losing /gamble bet is taken by the house",
+	},
+	{
+		Type:        TransactionTypeWagerWin,
+		DisplayName: "Wager win",
+		Category:    TransactionCategoryGambling,
+		Sign:        TransactionSignCredit,
+		RelatedType: RelatedTypeWager,
+		Flow:        "Winner of a 1v1 wager receives the loser's stake",
+	},
+	{
+		Type:        TransactionTypeWagerLoss,
+		DisplayName: "Wager loss",
+		Category:    TransactionCategoryGambling,
+		Sign:        TransactionSignDebit,
+		RelatedType: RelatedTypeWager,
+		Flow:        "Loser of a 1v1 wager pays their stake to the winner",
+	},
+	{
+		Type:        TransactionTypeGroupWagerWin,
+		DisplayName: "Group wager win",
+		Category:    TransactionCategoryGambling,
+		Sign:        TransactionSignCredit,
+		RelatedType: RelatedTypeGroupWager,
+		Flow:        "Winning group wager participant receives their net payout from the pot or the house",
+	},
+	{
+		Type:        TransactionTypeGroupWagerLoss,
+		DisplayName: "Group wager loss",
+		Category:    TransactionCategoryGambling,
+		Sign:        TransactionSignDebit,
+		RelatedType: RelatedTypeGroupWager,
+		Flow:        "Losing group wager participant pays into the pot (capped by the largest winning bet) or to the house",
+	},
+	{
+		Type:        TransactionTypeGroupWagerCorrectionIn,
+		DisplayName: "Group wager correction",
+		Category:    TransactionCategoryGambling,
+		Sign:        TransactionSignCredit,
+		RelatedType: RelatedTypeGroupWager,
+		Flow:        "A resolver corrected the winning option: a loss or pot tax from the wrong resolution is returned",
+	},
+	{
+		Type:        TransactionTypeGroupWagerCorrectionOut,
+		DisplayName: "Group wager correction",
+		Category:    TransactionCategoryGambling,
+		Sign:        TransactionSignDebit,
+		RelatedType: RelatedTypeGroupWager,
+		Flow:        "A resolver corrected the winning option: a payout from the wrong resolution is taken back",
+	},
+	{
+		Type:        TransactionTypeParlayWin,
+		DisplayName: "Parlay win",
+		Category:    TransactionCategoryGambling,
+		Sign:        TransactionSignCredit,
+		RelatedType: RelatedTypeParlay,
+		Flow:        "House pays the net payout of a parlay whose legs all won (voided legs count as even odds)",
+	},
+	{
+		Type:        TransactionTypeParlayLoss,
+		DisplayName: "Parlay loss",
+		Category:    TransactionCategoryGambling,
+		Sign:        TransactionSignDebit,
+		RelatedType: RelatedTypeParlay,
+		Flow:        "A parlay with a losing leg forfeits its stake to the house",
+	},
+	{
+		Type:        TransactionTypeDuelWin,
+		DisplayName: "Duel win",
+		Category:    TransactionCategoryGambling,
+		Sign:        TransactionSignCredit,
+		RelatedType: RelatedTypeDuel,
+		Flow:        "Winner of a /duel coin flip receives the loser's stake",
+	},
+	{
+		Type:        TransactionTypeDuelLoss,
+		DisplayName: "Duel loss",
+		Category:    TransactionCategoryGambling,
+		Sign:        TransactionSignDebit,
+		RelatedType: RelatedTypeDuel,
+		Flow:        "Loser of a /duel coin flip pays their stake to the winner",
+	},
+	{
+		Type:        TransactionTypeTransferIn,
+		DisplayName: "Transfer received",
+		Category:    TransactionCategoryTransfer,
+		Sign:        TransactionSignCredit,
+		Flow:        "Bits received from another user's /donate or an admin adjustment",
+	},
+	{
+		Type:        TransactionTypeTransferOut,
+		DisplayName: "Transfer sent",
+		Category:    TransactionCategoryTransfer,
+		Sign:        TransactionSignDebit,
+		Flow:        "Bits sent to another user with /donate or removed by an admin adjustment",
+	},
+	{
+		Type:        TransactionTypeLottoTicket,
+		DisplayName: "Lottery ticket",
+		Category:    TransactionCategoryLottery,
+		Sign:        TransactionSignDebit,
+		Flow:        "Ticket cost is paid into the lottery pot",
+	},
+	{
+		Type:        TransactionTypeLottoWin,
+		DisplayName: "Lottery win",
+		Category:    TransactionCategoryLottery,
+		Sign:        TransactionSignCredit,
+		Flow:        "Lottery pot is split between the draw's winners",
+	},
+	{
+		Type:        TransactionTypeLottoConsolation,
+		DisplayName: "Lottery consolation",
+		Category:    TransactionCategoryLottery,
+		Sign:        TransactionSignCredit,
+		Flow:        "Pot above the guild's rollover cap is shared among a winnerless draw's ticket holders by ticket count",
+	},
+	{
+		Type:        TransactionTypeLottoPotTax,
+		DisplayName: "Lottery pot tax",
+		Category:    TransactionCategoryLottery,
+		Sign:        TransactionSignDebit,
+		RelatedType: RelatedTypeGroupWager,
+		Flow:        "The guild's pot tax on a resolved group wager is withheld from its winners and added to the current lottery draw",
+	},
+	{
+		Type:        TransactionTypeSavingsBonus,
+		DisplayName: "Savings bonus",
+		Category:    TransactionCategorySavings,
+		Sign:        TransactionSignCredit,
+		Flow:        "Bonus minted when a savings deposit matures; the locked principal never leaves the balance",
+	},
+	{
+		Type:        TransactionTypeInitial,
+		DisplayName: "Initial balance",
+		Category:    TransactionCategorySystem,
+		Sign:        TransactionSignCredit,
+		Flow:        "Starting balance minted when a user is created",
+	},
+	{
+		Type:        TransactionTypeWordleReward,
+		DisplayName: "Wordle reward",
+		Category:    TransactionCategorySystem,
+		Sign:        TransactionSignCredit,
+		Flow:        "Reward minted for completing the daily Wordle",
+	},
+	{
+		Type:        TransactionTypeHighRollerPurchase,
+		DisplayName: "High roller purchase",
+		Category:    TransactionCategorySystem,
+		Sign:        TransactionSignDebit,
+		Flow:        "Bits burned to buy the high roller role",
+	},
+}
+
+// transactionTypesByName indexes the registry for lookups
+var transactionTypesByName = func() map[TransactionType]TransactionTypeInfo {
+	index := make(map[TransactionType]TransactionTypeInfo, len(transactionTypeRegistry))
+	for _, info := range transactionTypeRegistry {
+		index[info.Type] = info
+	}
+	return index
+}()
+
+// RegisteredTransactionTypes returns metadata for every registered transaction type in documentation order
+func RegisteredTransactionTypes() []TransactionTypeInfo {
+	types := make([]TransactionTypeInfo, len(transactionTypeRegistry))
+	copy(types, transactionTypeRegistry)
+	return types
+}
+
+// Info returns the registry metadata for the transaction type
+func (tt TransactionType) Info() (TransactionTypeInfo, bool) {
+	info, ok := transactionTypesByName[tt]
+	return info, ok
+}
+
+// IsRegistered returns true if the transaction type is in the registry
+func (tt TransactionType) IsRegistered() bool {
+	_, ok := transactionTypesByName[tt]
+	return ok
+}
+
+// DisplayName returns the human-readable name of the transaction type, or the raw value if unregistered
+func (tt TransactionType) DisplayName() string {
+	if info, ok := tt.Info(); ok {
+		return info.DisplayName
+	}
+	return string(tt)
+}
+
+// ValidateBalanceChange checks that a change amount and related entity are consistent with the transaction type
+func (tt TransactionType) ValidateBalanceChange(changeAmount int64, relatedType *RelatedType) error {
+	info, ok := tt.Info()
+	if !ok {
+		return fmt.Errorf("unknown transaction type %q", tt)
+	}
+
+	switch {
+	case info.Sign == TransactionSignCredit && changeAmount < 0:
+		return fmt.Errorf("%s must not decrease a balance (change %d)", tt, changeAmount)
+	case info.Sign == TransactionSignDebit && changeAmount > 0:
+		return fmt.Errorf("%s must not increase a balance (change %d)", tt, changeAmount)
+	}
+
+	if relatedType != nil && *relatedType != info.RelatedType {
+		if info.RelatedType == "" {
+			return fmt.Errorf("%s does not reference a related entity (got %s)", tt, *relatedType)
+		}
+		return fmt.Errorf("%s must reference a %s (got %s)", tt, info.RelatedType, *relatedType)
+	}
+
+	return nil
+}
+
+// CorrectionTransactionType returns the group wager correction type matching the direction of a compensating change
+func CorrectionTransactionType(changeAmount int64) TransactionType {
+	if changeAmount < 0 {
+		return TransactionTypeGroupWagerCorrectionOut
+	}
+	return TransactionTypeGroupWagerCorrectionIn
+}
+
+// AdjustmentTransactionType returns the transfer type matching the direction of a manual balance adjustment
+func AdjustmentTransactionType(changeAmount int64) TransactionType {
+	if changeAmount < 0 {
+		return TransactionTypeTransferOut
+	}
+	return TransactionTypeTransferIn
+}
@@ -0,0 +1,99 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisteredTransactionTypes_Complete(t *testing.T) {
+	t.Parallel()
+
+	seen := make(map[TransactionType]bool)
+	for _, info := range RegisteredTransactionTypes() {
+		assert.False(t, seen[info.Type], "%s registered twice", info.Type)
+		seen[info.Type] = true
+
+		assert.NotEmpty(t, info.DisplayName, "%s has no display name", info.Type)
+		assert.NotEmpty(t, info.Category, "%s has no category", info.Type)
+		assert.Contains(t, []TransactionSign{TransactionSignCredit, TransactionSignDebit}, info.Sign, "%s has no sign", info.Type)
+		assert.NotEmpty(t, info.Flow, "%s has no money flow description", info.Type)
+	}
+
+	for _, tt := range []TransactionType{
+		TransactionTypeBetWin, TransactionTypeBetLoss,
+		TransactionTypeWagerWin, TransactionTypeWagerLoss,
+		TransactionTypeGroupWagerWin, TransactionTypeGroupWagerLoss,
+		TransactionTypeGroupWagerCorrectionIn, TransactionTypeGroupWagerCorrectionOut,
+		TransactionTypeDuelWin, TransactionTypeDuelLoss,
+		TransactionTypeTransferIn, TransactionTypeTransferOut,
+		TransactionTypeLottoTicket, TransactionTypeLottoWin, TransactionTypeLottoConsolation, TransactionTypeLottoPotTax,
+		TransactionTypeSavingsBonus,
+		TransactionTypeInitial, TransactionTypeWordleReward, TransactionTypeHighRollerPurchase,
+	} {
+		assert.True(t, tt.IsRegistered(), "%s is not registered", tt)
+	}
+}
+
+func TestTransactionType_ValidateBalanceChange(t *testing.T) {
+	t.Parallel()
+
+	wager := RelatedTypeWager
+	groupWager := RelatedTypeGroupWager
+
+	tests := []struct {
+		name         string
+		tt           TransactionType
+		changeAmount int64
+		relatedType  *RelatedType
+		errContains  string
+	}{
+		{name: "credit with positive change", tt: TransactionTypeLottoWin, changeAmount: 500},
+		{name: "debit with negative change", tt: TransactionTypeLottoTicket, changeAmount: -100},
+		{name: "break-even payout allowed", tt: TransactionTypeGroupWagerWin, changeAmount: 0, relatedType: &groupWager},
+		{name: "matching related type", tt: TransactionTypeWagerLoss, changeAmount: -100, relatedType: &wager},
+		{name: "unknown type", tt: TransactionType("made_up"), changeAmount: 100, errContains: "unknown transaction type"},
+		{name: "credit with negative change", tt: TransactionTypeTransferIn, changeAmount: -100, errContains: "must not decrease"},
+		{name: "debit with positive change", tt: TransactionTypeHighRollerPurchase, changeAmount: 100, errContains: "must not increase"},
+		{name: "wrong related type", tt: TransactionTypeWagerWin, changeAmount: 100, relatedType: &groupWager, errContains: "must reference a wager"},
+		{name: "unexpected related type", tt: TransactionTypeWordleReward, changeAmount: 100, relatedType: &wager, errContains: "does not reference"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.tt.ValidateBalanceChange(tt.changeAmount, tt.relatedType)
+
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
+func TestTransactionType_DisplayName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Transfer received", TransactionTypeTransferIn.DisplayName())
+	assert.Equal(t, "Lottery win", TransactionTypeLottoWin.DisplayName())
+	assert.Equal(t, "made_up", TransactionType("made_up").DisplayName())
+}
+
+func TestAdjustmentTransactionType(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, TransactionTypeTransferIn, AdjustmentTransactionType(500))
+	assert.Equal(t, TransactionTypeTransferOut, AdjustmentTransactionType(-500))
+}
+
+func TestCorrectionTransactionType(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, TransactionTypeGroupWagerCorrectionIn, CorrectionTransactionType(500))
+	assert.Equal(t, TransactionTypeGroupWagerCorrectionOut, CorrectionTransactionType(-500))
+}
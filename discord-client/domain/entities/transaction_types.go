@@ -12,33 +12,54 @@ const (
 	TransactionTypeWagerLoss      TransactionType = "wager_loss"
 	TransactionTypeGroupWagerWin  TransactionType = "group_wager_win"
 	TransactionTypeGroupWagerLoss TransactionType = "group_wager_loss"
+	TransactionTypeParlayWin      TransactionType = "parlay_win"
+	TransactionTypeParlayLoss     TransactionType = "parlay_loss"
+	TransactionTypeDuelWin        TransactionType = "duel_win"
+	TransactionTypeDuelLoss       TransactionType = "duel_loss"
+
+	// Group wager resolution corrections, reversing the payouts of a wrong winning option
+	TransactionTypeGroupWagerCorrectionIn  TransactionType = "group_wager_correction_in"
+	TransactionTypeGroupWagerCorrectionOut TransactionType = "group_wager_correction_out"
 
 	// Transfer transactions
 	TransactionTypeTransferIn  TransactionType = "transfer_in"
 	TransactionTypeTransferOut TransactionType = "transfer_out"
 
 	// Lottery transactions
-	TransactionTypeLottoTicket TransactionType = "lotto_ticket"
-	TransactionTypeLottoWin    TransactionType = "lotto_win"
+	TransactionTypeLottoTicket      TransactionType = "lotto_ticket"
+	TransactionTypeLottoWin         TransactionType = "lotto_win"
+	TransactionTypeLottoConsolation TransactionType = "lotto_consolation" // Share of a capped rollover paid to ticket holders
+	TransactionTypeLottoPotTax      TransactionType = "lotto_pot_tax"     // Share of a group wager pot added to the lottery pot
+
+	// Savings transactions
+	TransactionTypeSavingsBonus TransactionType = "savings_bonus"
 
 	// System transactions
 	TransactionTypeInitial            TransactionType = "initial"
 	TransactionTypeWordleReward       TransactionType = "wordle_reward"
 	TransactionTypeHighRollerPurchase TransactionType = "high_roller_purchase"
+
+	// Season token transactions, recorded in season token history rather than balance history
+	TransactionTypeSeasonTokenEarned TransactionType = "season_token_earned"
+	TransactionTypeSeasonTokenSpent  TransactionType = "season_token_spent"
 )
 
 // IsWinType returns true if the transaction type represents a win
 func (tt TransactionType) IsWinType() bool {
 	return tt == TransactionTypeBetWin ||
 		tt == TransactionTypeWagerWin ||
-		tt == TransactionTypeGroupWagerWin
+		tt == TransactionTypeGroupWagerWin ||
+		tt == TransactionTypeParlayWin ||
+		tt == TransactionTypeDuelWin
 }
 
 // IsLossType returns true if the transaction type represents a loss
 func (tt TransactionType) IsLossType() bool {
 	return tt == TransactionTypeBetLoss ||
 		tt == TransactionTypeWagerLoss ||
-		tt == TransactionTypeGroupWagerLoss
+		tt == TransactionTypeGroupWagerLoss ||
+		tt == TransactionTypeParlayLoss ||
+		tt == TransactionTypeDuelLoss
 }
 
 // IsTransferType returns true if the transaction type represents a transfer
@@ -56,10 +77,17 @@ func (tt TransactionType) IsGamblingRelated() bool {
 func (tt TransactionType) IsSystemGenerated() bool {
 	return tt == TransactionTypeInitial ||
 		tt == TransactionTypeWordleReward ||
-		tt == TransactionTypeHighRollerPurchase
+		tt == TransactionTypeHighRollerPurchase ||
+		tt == TransactionTypeSavingsBonus
 }
 
 // String returns the string representation of the transaction type
 func (tt TransactionType) String() string {
 	return string(tt)
-}
\ No newline at end of file
+}
+
+// IsSeasonTokenType returns true if the transaction type moves season tokens rather than bits
+func (tt TransactionType) IsSeasonTokenType() bool {
+	return tt == TransactionTypeSeasonTokenEarned ||
+		tt == TransactionTypeSeasonTokenSpent
+}
@@ -2,17 +2,29 @@ package entities
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
+// Gambling break (self-exclusion) limits
+const (
+	MinGamblingBreakDuration = time.Hour
+	MaxGamblingBreakDuration = 365 * 24 * time.Hour
+)
+
+// ErrGamblingBreakActive is returned when a user on a gambling break attempts to bet
+var ErrGamblingBreakActive = errors.New("gambling break is active")
+
 // User represents a Discord user with guild-specific balance information
 type User struct {
-	DiscordID        int64     `db:"discord_id"`
-	Username         string    `db:"username"`
-	Balance          int64     `db:"-"` // Populated from user_guild_accounts
-	AvailableBalance int64     `db:"-"` // Calculated field: balance minus pending wagers
-	CreatedAt        time.Time `db:"created_at"`
-	UpdatedAt        time.Time `db:"updated_at"`
+	DiscordID           int64          `db:"discord_id"`
+	Username            string         `db:"username"`
+	Balance             int64          `db:"-"` // Populated from user_guild_accounts
+	AvailableBalance    int64          `db:"-"` // Calculated field: balance minus pending wagers
+	GamblingBreakEndsAt *time.Time     `db:"-"` // Populated from gambling_breaks while a break is active
+	BalanceFreeze       *BalanceFreeze `db:"-"` // Populated from balance_freezes while a freeze is active
+	CreatedAt           time.Time      `db:"created_at"`
+	UpdatedAt           time.Time      `db:"updated_at"`
 }
 
 // CanAfford checks if the user has sufficient available balance for an amount
@@ -59,4 +71,46 @@ func (u *User) CalculateNewBalance(changeAmount int64) int64 {
 // CalculateNewAvailableBalance calculates what the available balance would be after a change
 func (u *User) CalculateNewAvailableBalance(changeAmount int64) int64 {
 	return u.AvailableBalance + changeAmount
-}
\ No newline at end of file
+}
+
+// IsOnGamblingBreak checks if the user has an unexpired self-exclusion window
+func (u *User) IsOnGamblingBreak(now time.Time) bool {
+	return u.GamblingBreakEndsAt != nil && now.Before(*u.GamblingBreakEndsAt)
+}
+
+// CheckGamblingBreak returns ErrGamblingBreakActive if the user is on a gambling break
+func (u *User) CheckGamblingBreak(now time.Time) error {
+	if !u.IsOnGamblingBreak(now) {
+		return nil
+	}
+	return fmt.Errorf("%w until %s", ErrGamblingBreakActive, u.GamblingBreakEndsAt.UTC().Format("Jan 2, 2006 15:04 UTC"))
+}
+
+// IsBalanceFrozen checks if a moderator has frozen the user's balance
+func (u *User) IsBalanceFrozen(now time.Time) bool {
+	return u.BalanceFreeze != nil && u.BalanceFreeze.IsActive(now)
+}
+
+// CheckBalanceFreeze returns ErrBalanceFrozen if a moderator has frozen the user's balance
+func (u *User) CheckBalanceFreeze(now time.Time) error {
+	if !u.IsBalanceFrozen(now) {
+		return nil
+	}
+	return u.BalanceFreeze.Err()
+}
+
+// LockedBalanceBreakdown itemizes the parts of a user's balance tied up in pending activity
+type LockedBalanceBreakdown struct {
+	InWagers      int64 // Accepted 1v1 wagers awaiting a vote
+	InGroupWagers int64 // Bets on active or pending-resolution group wagers
+	InLottery     int64 // Tickets for draws that have not completed yet
+	InSavings     int64 // Savings deposits that have not matured or been withdrawn
+	InParlays     int64 // Stakes on parlays that have not settled
+	InDuels       int64 // Stakes on open duel challenges the user issued
+}
+
+// Total returns the amount held back from the user's balance.
+// Lottery tickets are excluded because they are paid for at purchase.
+func (b *LockedBalanceBreakdown) Total() int64 {
+	return b.InWagers + b.InGroupWagers + b.InSavings + b.InParlays + b.InDuels
+}
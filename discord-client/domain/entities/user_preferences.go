@@ -0,0 +1,93 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// OddsFormat is how odds are shown to a user
+type OddsFormat string
+
+const (
+	OddsFormatMultiplier OddsFormat = "multiplier" // 2.50x, the total paid out per bit staked
+	OddsFormatDecimal    OddsFormat = "decimal"    // 2.50, European
+	OddsFormatFractional OddsFormat = "fractional" // 3/2, UK, profit over stake
+	OddsFormatAmerican   OddsFormat = "american"   // +150 / -200, moneyline
+)
+
+// maxFractionalDenominator bounds the denominator used when approximating fractional odds
+const maxFractionalDenominator = 20
+
+// OddsFormats lists the supported odds formats in display order
+var OddsFormats = []OddsFormat{OddsFormatMultiplier, OddsFormatDecimal, OddsFormatFractional, OddsFormatAmerican}
+
+// IsValid returns true if the format is supported
+func (f OddsFormat) IsValid() bool {
+	for _, format := range OddsFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// Format renders a payout multiplier in this format.
+// Fractional and American odds need a profit, so multipliers of 1x or less are shown as "n/a".
+func (f OddsFormat) Format(multiplier float64) string {
+	switch f {
+	case OddsFormatDecimal:
+		return fmt.Sprintf("%.2f", multiplier)
+	case OddsFormatFractional:
+		if multiplier <= 1 {
+			return "n/a"
+		}
+		numerator, denominator := approximateFraction(multiplier - 1)
+		return fmt.Sprintf("%d/%d", numerator, denominator)
+	case OddsFormatAmerican:
+		if multiplier <= 1 {
+			return "n/a"
+		}
+		profit := multiplier - 1
+		if profit >= 1 {
+			return fmt.Sprintf("+%d", int64(math.Round(profit*100)))
+		}
+		return fmt.Sprintf("-%d", int64(math.Round(100/profit)))
+	default:
+		return fmt.Sprintf("%.2fx", multiplier)
+	}
+}
+
+// approximateFraction returns the fraction closest to value with a denominator of at most
+// maxFractionalDenominator, preferring the smallest denominator on ties
+func approximateFraction(value float64) (int64, int64) {
+	bestNumerator, bestDenominator := int64(math.Round(value)), int64(1)
+	bestError := math.Abs(value - float64(bestNumerator))
+	for denominator := int64(2); denominator <= maxFractionalDenominator; denominator++ {
+		numerator := int64(math.Round(value * float64(denominator)))
+		if e := math.Abs(value - float64(numerator)/float64(denominator)); e < bestError-1e-9 {
+			bestNumerator, bestDenominator, bestError = numerator, denominator, e
+		}
+	}
+	if bestNumerator == 0 {
+		return 1, maxFractionalDenominator
+	}
+	return bestNumerator, bestDenominator
+}
+
+// UserPreferences holds a user's display preferences, shared across guilds
+type UserPreferences struct {
+	DiscordID                 int64      `db:"discord_id"`
+	OddsFormat                OddsFormat `db:"odds_format"`
+	HideFromGlobalLeaderboard bool       `db:"hide_from_global_leaderboard"` // Keep the user off the cross-guild leaderboard
+	CreatedAt                 time.Time  `db:"created_at"`
+	UpdatedAt                 time.Time  `db:"updated_at"`
+}
+
+// DefaultUserPreferences returns the preferences used for users who haven't set any
+func DefaultUserPreferences(discordID int64) *UserPreferences {
+	return &UserPreferences{
+		DiscordID:  discordID,
+		OddsFormat: OddsFormatMultiplier,
+	}
+}
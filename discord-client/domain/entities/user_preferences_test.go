@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOddsFormat_Format(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     OddsFormat
+		multiplier float64
+		want       string
+	}{
+		{"multiplier", OddsFormatMultiplier, 2.5, "2.50x"},
+		{"unknown falls back to multiplier", OddsFormat("bogus"), 1.75, "1.75x"},
+		{"decimal", OddsFormatDecimal, 2.5, "2.50"},
+		{"fractional odds against", OddsFormatFractional, 2.5, "3/2"},
+		{"fractional odds on", OddsFormatFractional, 1.5, "1/2"},
+		{"fractional evens", OddsFormatFractional, 2.0, "1/1"},
+		{"fractional approximated", OddsFormatFractional, 1.91, "10/11"},
+		{"fractional tiny profit", OddsFormatFractional, 1.001, "1/20"},
+		{"fractional no profit", OddsFormatFractional, 1.0, "n/a"},
+		{"american underdog", OddsFormatAmerican, 2.5, "+150"},
+		{"american evens", OddsFormatAmerican, 2.0, "+100"},
+		{"american favourite", OddsFormatAmerican, 1.5, "-200"},
+		{"american no profit", OddsFormatAmerican, 0, "n/a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.format.Format(tt.multiplier))
+		})
+	}
+}
+
+func TestOddsFormat_IsValid(t *testing.T) {
+	for _, format := range OddsFormats {
+		assert.True(t, format.IsValid())
+	}
+	assert.False(t, OddsFormat("bogus").IsValid())
+}
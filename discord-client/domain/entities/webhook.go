@@ -0,0 +1,237 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WebhookEventType identifies an event guild webhooks can subscribe to
+type WebhookEventType string
+
+const (
+	WebhookEventBigWin        WebhookEventType = "big_win"
+	WebhookEventLotteryResult WebhookEventType = "lottery_result"
+	WebhookEventWagerResolved WebhookEventType = "wager_resolved"
+
+	// WebhookEventTest is sent by /webhooks test and can't be subscribed to
+	WebhookEventTest WebhookEventType = "test"
+)
+
+// WebhookDeliveryStatus represents the lifecycle state of a webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// Webhook limits and retry policy
+const (
+	MaxWebhooksPerGuild     = 5
+	WebhookMaxAttempts      = 6
+	WebhookBaseRetryBackoff = 30 * time.Second // Doubles after every failed attempt
+)
+
+// ErrWebhookNotFound is returned when a webhook does not exist in the guild
+var ErrWebhookNotFound = errors.New("webhook not found")
+
+// ErrWebhookAddressNotPublic is returned when a webhook URL points at a loopback, private or link-local address
+var ErrWebhookAddressNotPublic = errors.New("webhook URL must point to a public address")
+
+// WebhookEventDefinition describes an event webhooks can subscribe to
+type WebhookEventDefinition struct {
+	EventType   WebhookEventType
+	Name        string
+	Description string
+}
+
+// WebhookEventDefinitions lists every subscribable event in the order /webhooks shows them
+var WebhookEventDefinitions = []WebhookEventDefinition{
+	{EventType: WebhookEventBigWin, Name: "Big Wins", Description: "A member wins at least the big win threshold in one payout"},
+	{EventType: WebhookEventLotteryResult, Name: "Lottery Results", Description: "A lottery draw is conducted"},
+	{EventType: WebhookEventWagerResolved, Name: "Wager Resolutions", Description: "A group or house wager is resolved"},
+}
+
+// ParseWebhookEventType converts an event key such as "big_win" into a subscribable WebhookEventType
+func ParseWebhookEventType(key string) (WebhookEventType, error) {
+	for _, definition := range WebhookEventDefinitions {
+		if string(definition.EventType) == key {
+			return definition.EventType, nil
+		}
+	}
+	return "", fmt.Errorf("unknown webhook event %q", key)
+}
+
+// ValidateWebhookURL checks that a webhook URL is an absolute HTTPS URL whose host isn't a non-public address.
+// Host names are checked once resolved, when the webhook is registered and again when each delivery connects.
+func ValidateWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" {
+		return errors.New("webhook URL must be an absolute URL")
+	}
+	if parsed.Scheme != "https" {
+		return errors.New("webhook URL must use https")
+	}
+	if strings.EqualFold(parsed.Hostname(), "localhost") {
+		return ErrWebhookAddressNotPublic
+	}
+	if ip := net.ParseIP(parsed.Hostname()); ip != nil && !IsPublicWebhookIP(ip) {
+		return ErrWebhookAddressNotPublic
+	}
+	return nil
+}
+
+// IsPublicWebhookIP reports whether webhooks may be sent to the address. Loopback, private, link-local,
+// multicast and unspecified addresses are refused so a webhook can't reach the bot's own network.
+func IsPublicWebhookIP(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified()
+}
+
+// GuildWebhook is an endpoint a guild admin registered to receive event payloads
+type GuildWebhook struct {
+	ID         int64              `db:"id"`
+	GuildID    int64              `db:"guild_id"`
+	URL        string             `db:"url"`
+	Secret     string             `db:"secret"` // Key the payload signature is computed with
+	EventTypes []WebhookEventType `db:"event_types"`
+	CreatedBy  int64              `db:"created_by"`
+	CreatedAt  time.Time          `db:"created_at"`
+}
+
+// Subscribes reports whether the webhook receives the event type
+func (w *GuildWebhook) Subscribes(eventType WebhookEventType) bool {
+	for _, subscribed := range w.EventTypes {
+		if subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is a payload queued for, or sent to, a webhook
+type WebhookDelivery struct {
+	ID             int64                 `db:"id"`
+	WebhookID      int64                 `db:"webhook_id"`
+	GuildID        int64                 `db:"guild_id"`
+	EventType      WebhookEventType      `db:"event_type"`
+	Payload        []byte                `db:"payload"` // JSON event data, wrapped in a WebhookEnvelope when sent
+	Status         WebhookDeliveryStatus `db:"status"`
+	Attempts       int                   `db:"attempts"`
+	ResponseStatus *int                  `db:"response_status"`
+	LastError      *string               `db:"last_error"`
+	NextAttemptAt  time.Time             `db:"next_attempt_at"`
+	CreatedAt      time.Time             `db:"created_at"`
+	DeliveredAt    *time.Time            `db:"delivered_at"`
+}
+
+// RecordSuccess marks the delivery as delivered
+func (d *WebhookDelivery) RecordSuccess(responseStatus int, now time.Time) {
+	d.Attempts++
+	d.Status = WebhookDeliveryStatusDelivered
+	d.ResponseStatus = &responseStatus
+	d.LastError = nil
+	d.DeliveredAt = &now
+}
+
+// RecordFailure records a failed attempt, scheduling a retry with exponential backoff until the
+// delivery runs out of attempts, about 15 minutes after the first. responseStatus is nil when no response was received.
+func (d *WebhookDelivery) RecordFailure(responseStatus *int, reason string, now time.Time) {
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	d.LastError = &reason
+
+	if d.Attempts >= WebhookMaxAttempts {
+		d.Status = WebhookDeliveryStatusFailed
+		return
+	}
+
+	d.NextAttemptAt = now.Add(WebhookBaseRetryBackoff << (d.Attempts - 1))
+}
+
+// WebhookEnvelope is the JSON body posted to a webhook
+type WebhookEnvelope struct {
+	DeliveryID int64            `json:"delivery_id"`
+	Event      WebhookEventType `json:"event"`
+	GuildID    string           `json:"guild_id"`
+	CreatedAt  time.Time        `json:"created_at"`
+	Data       any              `json:"data"`
+}
+
+// WebhookBigWinData is the data of a big_win event
+type WebhookBigWinData struct {
+	DiscordID       string          `json:"discord_id"`
+	Amount          int64           `json:"amount"`
+	NewBalance      int64           `json:"new_balance"`
+	TransactionType TransactionType `json:"transaction_type"`
+}
+
+// WebhookLotteryResultData is the data of a lottery_result event
+type WebhookLotteryResultData struct {
+	DrawID         int64    `json:"draw_id"`
+	WinningNumber  int64    `json:"winning_number"`
+	PotAmount      int64    `json:"pot_amount"`
+	WinnerIDs      []string `json:"winner_ids"`
+	PrizePerWinner int64    `json:"prize_per_winner"`
+	RolledOver     bool     `json:"rolled_over"`
+}
+
+// WebhookWagerResolvedData is the data of a wager_resolved event
+type WebhookWagerResolvedData struct {
+	GroupWagerID     int64    `json:"group_wager_id"`
+	Condition        string   `json:"condition"`
+	WinningOption    string   `json:"winning_option"`
+	TotalPot         int64    `json:"total_pot"`
+	ParticipantCount int      `json:"participant_count"`
+	WinnerIDs        []string `json:"winner_ids"`
+}
+
+// WebhookTestData is the data of a test event
+type WebhookTestData struct {
+	Message     string `json:"message"`
+	RequestedBy string `json:"requested_by"`
+}
+
+// FormatWebhookID formats a Discord ID for a webhook payload. IDs are sent as strings
+// because they exceed the integer precision of JSON parsers such as JavaScript's.
+func FormatWebhookID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// NewWebhookWagerResolvedData builds the wager_resolved data of a resolved group wager
+func NewWebhookWagerResolvedData(detail *GroupWagerDetail) WebhookWagerResolvedData {
+	data := WebhookWagerResolvedData{
+		GroupWagerID:     detail.Wager.ID,
+		Condition:        detail.Wager.Condition,
+		TotalPot:         detail.Wager.TotalPot,
+		ParticipantCount: len(detail.Participants),
+		WinnerIDs:        []string{},
+	}
+
+	if detail.Wager.WinningOptionID == nil {
+		return data
+	}
+	for _, option := range detail.Options {
+		if option.ID == *detail.Wager.WinningOptionID {
+			data.WinningOption = option.OptionText
+		}
+	}
+	for _, participant := range detail.Participants {
+		if participant.OptionID == *detail.Wager.WinningOptionID {
+			data.WinnerIDs = append(data.WinnerIDs, FormatWebhookID(participant.DiscordID))
+		}
+	}
+
+	return data
+}
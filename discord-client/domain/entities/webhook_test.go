@@ -0,0 +1,97 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDelivery_RecordFailure(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("backs off exponentially", func(t *testing.T) {
+		delivery := &WebhookDelivery{Status: WebhookDeliveryStatusPending}
+		status := 500
+
+		delivery.RecordFailure(&status, "endpoint responded with status 500", now)
+		assert.Equal(t, WebhookDeliveryStatusPending, delivery.Status)
+		assert.Equal(t, now.Add(30*time.Second), delivery.NextAttemptAt)
+
+		delivery.RecordFailure(nil, "connection refused", now)
+		assert.Equal(t, now.Add(time.Minute), delivery.NextAttemptAt)
+		assert.Nil(t, delivery.ResponseStatus)
+		require.NotNil(t, delivery.LastError)
+		assert.Equal(t, "connection refused", *delivery.LastError)
+	})
+
+	t.Run("fails after the last attempt", func(t *testing.T) {
+		delivery := &WebhookDelivery{Status: WebhookDeliveryStatusPending, Attempts: WebhookMaxAttempts - 1}
+
+		delivery.RecordFailure(nil, "timeout", now)
+		assert.Equal(t, WebhookDeliveryStatusFailed, delivery.Status)
+		assert.Equal(t, WebhookMaxAttempts, delivery.Attempts)
+	})
+}
+
+func TestWebhookDelivery_RecordSuccess(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	reason := "timeout"
+	delivery := &WebhookDelivery{Status: WebhookDeliveryStatusPending, Attempts: 2, LastError: &reason}
+
+	delivery.RecordSuccess(204, now)
+
+	assert.Equal(t, WebhookDeliveryStatusDelivered, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Equal(t, 204, *delivery.ResponseStatus)
+	assert.Nil(t, delivery.LastError)
+	assert.Equal(t, now, *delivery.DeliveredAt)
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, ValidateWebhookURL("https://example.com/hooks/gamba"))
+	assert.ErrorContains(t, ValidateWebhookURL("http://example.com/hooks"), "https")
+	assert.ErrorContains(t, ValidateWebhookURL("example.com/hooks"), "absolute")
+	assert.Error(t, ValidateWebhookURL(""))
+
+	for _, rawURL := range []string{
+		"https://localhost/hooks",
+		"https://127.0.0.1/hooks",
+		"https://10.0.0.5:8443/hooks",
+		"https://192.168.1.1/hooks",
+		"https://169.254.169.254/latest/meta-data",
+		"https://[::1]/hooks",
+		"https://0.0.0.0/hooks",
+	} {
+		assert.ErrorIs(t, ValidateWebhookURL(rawURL), ErrWebhookAddressNotPublic, rawURL)
+	}
+	assert.NoError(t, ValidateWebhookURL("https://93.184.216.34/hooks"))
+}
+
+func TestNewWebhookWagerResolvedData(t *testing.T) {
+	t.Parallel()
+	winningOptionID := int64(2)
+
+	data := NewWebhookWagerResolvedData(&GroupWagerDetail{
+		Wager: &GroupWager{ID: 10, Condition: "Who wins?", TotalPot: 3000, WinningOptionID: &winningOptionID},
+		Options: []*GroupWagerOption{
+			{ID: 1, OptionText: "Red"},
+			{ID: 2, OptionText: "Blue"},
+		},
+		Participants: []*GroupWagerParticipant{
+			{DiscordID: 100, OptionID: 1},
+			{DiscordID: 200, OptionID: 2},
+			{DiscordID: 300, OptionID: 2},
+		},
+	})
+
+	assert.Equal(t, int64(10), data.GroupWagerID)
+	assert.Equal(t, "Blue", data.WinningOption)
+	assert.Equal(t, 3, data.ParticipantCount)
+	assert.Equal(t, []string{"200", "300"}, data.WinnerIDs)
+}
@@ -0,0 +1,147 @@
+package entities
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// WeeklyDigestTopMoves is how many of the biggest wins and losses a digest lists
+	WeeklyDigestTopMoves = 3
+	// WeeklyDigestTopPredictors is how many predictors a digest lists
+	WeeklyDigestTopPredictors = 3
+	// WeeklyDigestMinPredictions is the fewest resolved predictions a user needs to rank as a top predictor
+	WeeklyDigestMinPredictions = 5
+)
+
+// DigestBalanceMove is a single gambling win or loss listed in a weekly digest
+type DigestBalanceMove struct {
+	DiscordID       int64
+	Amount          int64 // Size of the change in bits, always positive
+	TransactionType TransactionType
+	CreatedAt       time.Time
+}
+
+// WeeklyDigest summarises a guild's economy over one week.
+// Build it with NewWeeklyDigest and feed it the week's data through the Add methods.
+type WeeklyDigest struct {
+	GuildID        int64
+	From           time.Time
+	To             time.Time
+	TotalWagered   int64 // Stakes placed on group wagers resolved during the week
+	BetsSettled    int   // Group wager bets settled during the week
+	WagersResolved int   // Group wagers resolved during the week
+	BiggestWins    []DigestBalanceMove
+	BiggestLosses  []DigestBalanceMove
+	LotteryDraws   []*LotteryDrawResult
+	NewHighRoller  *HighRollerPurchase // Latest high roller purchase in the week, nil if the role did not change hands
+	TopPredictors  []*WagerPredictionStats
+
+	resolvedWagers map[int64]struct{}
+}
+
+// NewWeeklyDigest creates an empty digest for the week [from, to)
+func NewWeeklyDigest(guildID int64, from, to time.Time) *WeeklyDigest {
+	return &WeeklyDigest{
+		GuildID:        guildID,
+		From:           from,
+		To:             to,
+		resolvedWagers: make(map[int64]struct{}),
+	}
+}
+
+// AddBalanceChange considers a balance history entry for the biggest wins and losses.
+// Only gambling wins and losses count; transfers, lottery and system entries are ignored.
+func (d *WeeklyDigest) AddBalanceChange(h *BalanceHistory) {
+	if h.ChangeAmount == 0 {
+		return
+	}
+
+	move := DigestBalanceMove{
+		DiscordID:       h.DiscordID,
+		Amount:          h.ChangeAmount,
+		TransactionType: h.TransactionType,
+		CreatedAt:       h.CreatedAt,
+	}
+	if move.Amount < 0 {
+		move.Amount = -move.Amount
+	}
+
+	switch {
+	case h.TransactionType.IsWinType():
+		d.BiggestWins = insertTopMove(d.BiggestWins, move)
+	case h.TransactionType.IsLossType():
+		d.BiggestLosses = insertTopMove(d.BiggestLosses, move)
+	}
+}
+
+// insertTopMove adds a move to a list kept in descending order of amount, trimmed to WeeklyDigestTopMoves.
+// Ties keep the earlier move first.
+func insertTopMove(moves []DigestBalanceMove, move DigestBalanceMove) []DigestBalanceMove {
+	i := sort.Search(len(moves), func(i int) bool { return moves[i].Amount < move.Amount })
+	if i >= WeeklyDigestTopMoves {
+		return moves
+	}
+
+	moves = append(moves, DigestBalanceMove{})
+	copy(moves[i+1:], moves[i:])
+	moves[i] = move
+
+	if len(moves) > WeeklyDigestTopMoves {
+		moves = moves[:WeeklyDigestTopMoves]
+	}
+	return moves
+}
+
+// AddWagerOutcome adds a settled group wager bet to the week's betting volume
+func (d *WeeklyDigest) AddWagerOutcome(o *GroupWagerOutcome) {
+	d.TotalWagered += o.Amount
+	d.BetsSettled++
+
+	if _, seen := d.resolvedWagers[o.GroupWagerID]; !seen {
+		d.resolvedWagers[o.GroupWagerID] = struct{}{}
+		d.WagersResolved++
+	}
+}
+
+// AddLotteryResult adds a completed lottery draw
+func (d *WeeklyDigest) AddLotteryResult(r *LotteryDrawResult) {
+	d.LotteryDraws = append(d.LotteryDraws, r)
+}
+
+// LotteryPaidOut returns the bits paid to lottery winners across the week's draws
+func (d *WeeklyDigest) LotteryPaidOut() int64 {
+	var total int64
+	for _, draw := range d.LotteryDraws {
+		total += draw.TotalPaidOut
+	}
+	return total
+}
+
+// SetHighRollerPurchase records the guild's latest high roller purchase if it happened during the week
+func (d *WeeklyDigest) SetHighRollerPurchase(purchase *HighRollerPurchase) {
+	if purchase == nil || purchase.PurchasedAt.Before(d.From) || !purchase.PurchasedAt.Before(d.To) {
+		return
+	}
+	d.NewHighRoller = purchase
+}
+
+// RankTopPredictors picks the most accurate predictors with at least WeeklyDigestMinPredictions predictions.
+// Ties go to more correct predictions, then the lower Discord ID so the order is stable.
+func (d *WeeklyDigest) RankTopPredictors(stats map[int64]*WagerPredictionStats) {
+	candidates := RankWagerPredictionStats(stats, WeeklyDigestMinPredictions)
+	if len(candidates) > WeeklyDigestTopPredictors {
+		candidates = candidates[:WeeklyDigestTopPredictors]
+	}
+	d.TopPredictors = candidates
+}
+
+// HasActivity checks if anything happened in the guild's economy during the week.
+// Top predictors are all-time, so they alone do not make a week worth posting.
+func (d *WeeklyDigest) HasActivity() bool {
+	return d.BetsSettled > 0 ||
+		len(d.BiggestWins) > 0 ||
+		len(d.BiggestLosses) > 0 ||
+		len(d.LotteryDraws) > 0 ||
+		d.NewHighRoller != nil
+}
@@ -0,0 +1,135 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeeklyDigest_AddBalanceChange(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	digest := NewWeeklyDigest(1, from, from.AddDate(0, 0, 7))
+
+	changes := []*BalanceHistory{
+		{DiscordID: 1, ChangeAmount: 500, TransactionType: TransactionTypeGroupWagerWin},
+		{DiscordID: 2, ChangeAmount: 5000, TransactionType: TransactionTypeBetWin},
+		{DiscordID: 3, ChangeAmount: 2000, TransactionType: TransactionTypeDuelWin},
+		{DiscordID: 4, ChangeAmount: 2000, TransactionType: TransactionTypeParlayWin},
+		{DiscordID: 5, ChangeAmount: 100, TransactionType: TransactionTypeWagerWin},
+		{DiscordID: 6, ChangeAmount: -3000, TransactionType: TransactionTypeGroupWagerLoss},
+		{DiscordID: 7, ChangeAmount: -700, TransactionType: TransactionTypeBetLoss},
+		{DiscordID: 8, ChangeAmount: 0, TransactionType: TransactionTypeGroupWagerLoss},
+		{DiscordID: 9, ChangeAmount: 90000, TransactionType: TransactionTypeTransferIn},
+		{DiscordID: 10, ChangeAmount: 80000, TransactionType: TransactionTypeLottoWin},
+	}
+	for _, change := range changes {
+		digest.AddBalanceChange(change)
+	}
+
+	require.Len(t, digest.BiggestWins, WeeklyDigestTopMoves)
+	assert.Equal(t, []int64{2, 3, 4}, moveIDs(digest.BiggestWins), "ties keep the earlier win first")
+	assert.Equal(t, int64(5000), digest.BiggestWins[0].Amount)
+
+	require.Len(t, digest.BiggestLosses, 2, "zero changes are skipped")
+	assert.Equal(t, []int64{6, 7}, moveIDs(digest.BiggestLosses))
+	assert.Equal(t, int64(3000), digest.BiggestLosses[0].Amount, "losses are reported as positive amounts")
+}
+
+func moveIDs(moves []DigestBalanceMove) []int64 {
+	ids := make([]int64, len(moves))
+	for i, move := range moves {
+		ids[i] = move.DiscordID
+	}
+	return ids
+}
+
+func TestWeeklyDigest_AddWagerOutcome(t *testing.T) {
+	t.Parallel()
+
+	digest := NewWeeklyDigest(1, time.Time{}, time.Now())
+	digest.AddWagerOutcome(&GroupWagerOutcome{GroupWagerID: 10, DiscordID: 1, Amount: 1000})
+	digest.AddWagerOutcome(&GroupWagerOutcome{GroupWagerID: 10, DiscordID: 2, Amount: 250})
+	digest.AddWagerOutcome(&GroupWagerOutcome{GroupWagerID: 11, DiscordID: 1, Amount: 50})
+
+	assert.Equal(t, int64(1300), digest.TotalWagered)
+	assert.Equal(t, 3, digest.BetsSettled)
+	assert.Equal(t, 2, digest.WagersResolved)
+	assert.True(t, digest.HasActivity())
+}
+
+func TestWeeklyDigest_LotteryPaidOut(t *testing.T) {
+	t.Parallel()
+
+	digest := NewWeeklyDigest(1, time.Time{}, time.Now())
+	assert.Zero(t, digest.LotteryPaidOut())
+
+	digest.AddLotteryResult(&LotteryDrawResult{DrawID: 1, TotalPot: 8000, TotalPaidOut: 8000, WinnerCount: 2})
+	digest.AddLotteryResult(&LotteryDrawResult{DrawID: 2, TotalPot: 3000})
+	assert.Equal(t, int64(8000), digest.LotteryPaidOut())
+	assert.True(t, digest.HasActivity())
+}
+
+func TestWeeklyDigest_SetHighRollerPurchase(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	tests := []struct {
+		name        string
+		purchasedAt time.Time
+		want        bool
+	}{
+		{name: "start of week", purchasedAt: from, want: true},
+		{name: "mid week", purchasedAt: from.Add(72 * time.Hour), want: true},
+		{name: "before week", purchasedAt: from.Add(-time.Second)},
+		{name: "end of week is excluded", purchasedAt: to},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			digest := NewWeeklyDigest(1, from, to)
+			digest.SetHighRollerPurchase(&HighRollerPurchase{DiscordID: 5, PurchasedAt: tt.purchasedAt})
+			assert.Equal(t, tt.want, digest.NewHighRoller != nil)
+			assert.Equal(t, tt.want, digest.HasActivity())
+		})
+	}
+
+	digest := NewWeeklyDigest(1, from, to)
+	digest.SetHighRollerPurchase(nil)
+	assert.Nil(t, digest.NewHighRoller)
+}
+
+func TestWeeklyDigest_RankTopPredictors(t *testing.T) {
+	t.Parallel()
+
+	stats := map[int64]*WagerPredictionStats{
+		1: {DiscordID: 1, CorrectPredictions: 4, TotalPredictions: 4},   // Too few predictions
+		2: {DiscordID: 2, CorrectPredictions: 6, TotalPredictions: 10},  // 60%
+		3: {DiscordID: 3, CorrectPredictions: 12, TotalPredictions: 20}, // 60%, more correct than 2
+		4: {DiscordID: 4, CorrectPredictions: 9, TotalPredictions: 10},  // 90%
+		5: {DiscordID: 5, CorrectPredictions: 3, TotalPredictions: 5},   // 60%, same as 6
+		6: {DiscordID: 6, CorrectPredictions: 3, TotalPredictions: 5},   // 60%
+	}
+	for _, s := range stats {
+		s.CalculateAccuracy()
+	}
+
+	digest := NewWeeklyDigest(1, time.Time{}, time.Now())
+	digest.RankTopPredictors(stats)
+
+	require.Len(t, digest.TopPredictors, WeeklyDigestTopPredictors)
+	assert.Equal(t, int64(4), digest.TopPredictors[0].DiscordID)
+	assert.Equal(t, int64(3), digest.TopPredictors[1].DiscordID)
+	assert.Equal(t, int64(2), digest.TopPredictors[2].DiscordID)
+	assert.False(t, digest.HasActivity(), "all-time predictors alone are not weekly activity")
+
+	stats[2].TotalPredictions, stats[3].TotalPredictions, stats[4].TotalPredictions = 1, 1, 1
+	digest.RankTopPredictors(stats)
+	require.Len(t, digest.TopPredictors, 2)
+	assert.Equal(t, []int64{5, 6}, []int64{digest.TopPredictors[0].DiscordID, digest.TopPredictors[1].DiscordID}, "equal records fall back to Discord ID")
+}
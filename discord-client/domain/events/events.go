@@ -7,10 +7,12 @@ type EventType string
 
 const (
 	EventTypeBalanceChange         EventType = "balance_change"
+	EventTypeBalanceLow            EventType = "balance_low"
 	EventTypeUserCreated           EventType = "user_created"
 	EventTypeBetPlaced             EventType = "bet_placed"
 	EventTypeWagerResolved         EventType = "wager_resolved"
 	EventTypeGroupWagerStateChange EventType = "group_wager_state_change"
+	EventTypeGroupWagerOddsChange  EventType = "group_wager_odds_change"
 	EventTypeDiscordMessage        EventType = "discord_message"
 )
 
@@ -33,6 +35,18 @@ func (e BalanceChangeEvent) Type() EventType {
 	return EventTypeBalanceChange
 }
 
+// BalanceLowEvent represents a balance change that took a user below the guild's reserve balance
+type BalanceLowEvent struct {
+	UserID  int64
+	GuildID int64
+	Balance int64
+	Floor   int64
+}
+
+func (e BalanceLowEvent) Type() EventType {
+	return EventTypeBalanceLow
+}
+
 // UserCreatedEvent represents a new user creation
 type UserCreatedEvent struct {
 	UserID         int64
@@ -78,12 +92,25 @@ type GroupWagerStateChangeEvent struct {
 	NewState     string
 	MessageID    int64
 	ChannelID    int64
+	PotMilestone int64 // Pot milestone a bet just passed, 0 for other changes
 }
 
 func (e GroupWagerStateChangeEvent) Type() EventType {
 	return EventTypeGroupWagerStateChange
 }
 
+// GroupWagerOddsChangeEvent represents an odds update on an active house wager
+type GroupWagerOddsChangeEvent struct {
+	GroupWagerID int64
+	GuildID      int64
+	MessageID    int64
+	ChannelID    int64
+}
+
+func (e GroupWagerOddsChangeEvent) Type() EventType {
+	return EventTypeGroupWagerOddsChange
+}
+
 // DiscordMessageEvent represents a Discord message received by the bot
 type DiscordMessageEvent struct {
 	MessageID string
@@ -29,6 +29,16 @@ type UserRepository interface {
 	// This method fetches user balances, wager stats, bet stats, volume, donations,
 	// and the total server bits in one database query to avoid N+1 query problems
 	GetScoreboardData(ctx context.Context) ([]*entities.ScoreboardEntry, int64, error)
+
+	// SetGamblingBreak starts or extends a user's gambling break, returning the effective end time
+	SetGamblingBreak(ctx context.Context, discordID int64, endsAt time.Time) (time.Time, error)
+
+	// GetLockedBalanceBreakdown returns the amounts a user has tied up in pending wagers, group wagers, lottery draws and savings
+	GetLockedBalanceBreakdown(ctx context.Context, discordID int64) (*entities.LockedBalanceBreakdown, error)
+
+	// GetEconomyGauges returns the guild's bits in circulation, bits locked in wagers, open lottery pot
+	// and active wager count in one database query
+	GetEconomyGauges(ctx context.Context) (*entities.EconomyGauges, error)
 }
 
 // BalanceHistoryRepository defines the interface for balance history tracking
@@ -36,17 +46,77 @@ type BalanceHistoryRepository interface {
 	// Record creates a new balance history entry
 	Record(ctx context.Context, history *entities.BalanceHistory) error
 
+	// GetByIDForUpdate returns a guild balance history entry and locks it until the transaction ends.
+	// Returns nil if the entry does not exist in the guild.
+	GetByIDForUpdate(ctx context.Context, id int64) (*entities.BalanceHistory, error)
+
+	// MarkReversed links an entry to the compensating entry that reverses it.
+	// Returns ErrTransactionAlreadyReversed if the entry was reversed in the meantime.
+	MarkReversed(ctx context.Context, id, reversalID int64) error
+
+	// GetByRelated returns the guild balance history entries recorded for a related entity, oldest first
+	GetByRelated(ctx context.Context, relatedType entities.RelatedType, relatedID int64) ([]*entities.BalanceHistory, error)
+
 	// GetByUser returns balance history for a specific user
 	GetByUser(ctx context.Context, discordID int64, limit int) ([]*entities.BalanceHistory, error)
 
 	// GetByDateRange returns balance history within a date range
 	GetByDateRange(ctx context.Context, discordID int64, from, to time.Time) ([]*entities.BalanceHistory, error)
 
+	// StreamByDateRange calls fn for every guild balance history entry within a date range, oldest first.
+	// Iteration stops at the first error returned by fn.
+	StreamByDateRange(ctx context.Context, from, to time.Time, fn func(*entities.BalanceHistory) error) error
+
 	// GetTotalVolumeByUser returns the total volume (sum of absolute balance changes) for a user
 	GetTotalVolumeByUser(ctx context.Context, discordID int64) (int64, error)
 
 	// GetTotalDonationsByUser returns the total amount donated (transfer_out) by a user
 	GetTotalDonationsByUser(ctx context.Context, discordID int64) (int64, error)
+
+	// GetBiggestWins returns a user's largest winning entries with the wager or lottery they came from
+	GetBiggestWins(ctx context.Context, discordID int64, limit int) ([]*entities.BalanceHistoryWithContext, error)
+
+	// GetBiggestLosses returns a user's largest losing entries with the wager they came from
+	GetBiggestLosses(ctx context.Context, discordID int64, limit int) ([]*entities.BalanceHistoryWithContext, error)
+
+	// GetDailyStats returns a user's wins, losses and balances aggregated by UTC day for days with entries,
+	// oldest first. A non-nil system limits wins and losses to group wagers for that system
+	GetDailyStats(ctx context.Context, discordID int64, from, to time.Time, system *entities.ExternalSystem) ([]*entities.StatsTimeSeriesBucket, error)
+}
+
+// BalanceHistoryRollupRepository defines the interface for the daily balance history rollups and the
+// archive of raw entries past the retention period. Rolling up and archiving span every guild; stats
+// reads are scoped to the repository's guild.
+type BalanceHistoryRollupRepository interface {
+	// GetRolledUpThrough returns the exclusive end of the rolled up days, nil before the first rollup
+	GetRolledUpThrough(ctx context.Context) (*time.Time, error)
+
+	// GetEarliestEntryTime returns when the oldest raw balance history entry was recorded, nil if there are none
+	GetEarliestEntryTime(ctx context.Context) (*time.Time, error)
+
+	// RollupDays aggregates the raw entries of every guild from from up to to by user, UTC day and external
+	// system, then advances the rolled up watermark to to. It returns the number of rollup rows written.
+	RollupDays(ctx context.Context, from, to time.Time) (int64, error)
+
+	// GetDailyStats returns a user's rolled up wins, losses and balances by UTC day for days with entries,
+	// oldest first, matching BalanceHistoryRepository.GetDailyStats
+	GetDailyStats(ctx context.Context, discordID int64, from, to time.Time, system *entities.ExternalSystem) ([]*entities.StatsTimeSeriesBucket, error)
+
+	// ArchiveEntriesBefore moves up to limit of the oldest raw entries recorded before before into the
+	// archive and returns how many were moved
+	ArchiveEntriesBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+// UserStatsRepository defines the interface for the cached per-user bet and wager aggregates
+type UserStatsRepository interface {
+	// GetByDiscordID returns the user's cached stats, zeroed if they have never bet or wagered
+	GetByDiscordID(ctx context.Context, discordID int64) (*entities.CachedUserStats, error)
+
+	// GetDrifted returns the users whose cached stats no longer match the bets and wagers tables
+	GetDrifted(ctx context.Context) ([]int64, error)
+
+	// Refresh recomputes a user's cached stats from the bets and wagers tables
+	Refresh(ctx context.Context, discordID int64) error
 }
 
 // BetRepository defines the interface for bet data access
@@ -65,6 +135,10 @@ type BetRepository interface {
 
 	// GetByUserSince returns all bets for a user since a specific time
 	GetByUserSince(ctx context.Context, discordID int64, since time.Time) ([]*entities.Bet, error)
+
+	// GetLeaderboardData returns unranked bet aggregates for every guild member with at least minBets bets,
+	// ordered by net profit then total bets (both descending)
+	GetLeaderboardData(ctx context.Context, minBets int) ([]*entities.GamblingLeaderboardEntry, error)
 }
 
 // WagerRepository defines the interface for wager data access
@@ -131,17 +205,32 @@ type GroupWagerRepository interface {
 	GetByID(ctx context.Context, id int64) (*entities.GroupWager, error)
 	GetByMessageID(ctx context.Context, messageID int64) (*entities.GroupWager, error)
 	GetByExternalReference(ctx context.Context, ref entities.ExternalReference) (*entities.GroupWager, error)
+	// GetGuildsWithOpenExternalReference returns the guilds with an unresolved wager linked to the external reference
+	GetGuildsWithOpenExternalReference(ctx context.Context, ref entities.ExternalReference) ([]int64, error)
 	Update(ctx context.Context, wager *entities.GroupWager) error
+	// UpdateAccess sets the participant limit, allowed role and invite list, which Update leaves unchanged
+	UpdateAccess(ctx context.Context, groupWagerID int64, access entities.GroupWagerAccess) error
+	// UpdateReactionBetting sets the message whose reactions place bets and the stake they bet
+	UpdateReactionBetting(ctx context.Context, groupWagerID int64, messageID, channelID int64, stake int64) error
+	// UpdateMessageIDs sets the wager's message and channel, leaving every other column unchanged
+	UpdateMessageIDs(ctx context.Context, groupWagerID int64, messageID, channelID int64) error
+	// UpdateThreadID sets the discussion thread on the wager's message, leaving every other column unchanged
+	UpdateThreadID(ctx context.Context, groupWagerID int64, threadID int64) error
 	GetActiveByUser(ctx context.Context, discordID int64) ([]*entities.GroupWager, error)
 	GetAll(ctx context.Context, state *entities.GroupWagerState) ([]*entities.GroupWager, error)
 
 	// Detail operations (returns full wager with options and participants)
 	GetDetailByID(ctx context.Context, id int64) (*entities.GroupWagerDetail, error)
+	// GetDetailByIDForUpdate is GetDetailByID that also locks the wager and its options until the transaction
+	// ends, so concurrent bets cannot overwrite each other's option totals and pot
+	GetDetailByIDForUpdate(ctx context.Context, id int64) (*entities.GroupWagerDetail, error)
 	GetDetailByMessageID(ctx context.Context, messageID int64) (*entities.GroupWagerDetail, error)
+	GetDetailByReactionMessageID(ctx context.Context, messageID int64) (*entities.GroupWagerDetail, error)
 
 	// Participant operations
 	SaveParticipant(ctx context.Context, participant *entities.GroupWagerParticipant) error
 	GetParticipant(ctx context.Context, groupWagerID int64, discordID int64) (*entities.GroupWagerParticipant, error)
+	DeleteParticipant(ctx context.Context, groupWagerID int64, discordID int64) error
 	GetActiveParticipationsByUser(ctx context.Context, discordID int64) ([]*entities.GroupWagerParticipant, error)
 	UpdateParticipantPayouts(ctx context.Context, participants []*entities.GroupWagerParticipant) error
 
@@ -149,17 +238,57 @@ type GroupWagerRepository interface {
 	UpdateOptionTotal(ctx context.Context, optionID int64, totalAmount int64) error
 	UpdateOptionOdds(ctx context.Context, optionID int64, oddsMultiplier float64) error
 	UpdateAllOptionOdds(ctx context.Context, groupWagerID int64, oddsMultipliers map[int64]float64) error
+	UpdateOptionOrders(ctx context.Context, groupWagerID int64, orders map[int64]int16) error
+
+	// Odds history operations
+	CreateOddsHistory(ctx context.Context, changes []*entities.GroupWagerOddsChange) error
+	GetOddsHistory(ctx context.Context, groupWagerID int64) ([]*entities.GroupWagerOddsChange, error)
+
+	// Event log operations
+	RecordEvent(ctx context.Context, event *entities.GroupWagerEvent) error
+	GetEvents(ctx context.Context, groupWagerID int64) ([]*entities.GroupWagerEvent, error)
+
+	// Bet change operations
+	RecordBetChange(ctx context.Context, change *entities.GroupWagerBetChange) error
+	// GetBetChanges returns every bet change on a wager, oldest first
+	GetBetChanges(ctx context.Context, groupWagerID int64) ([]*entities.GroupWagerBetChange, error)
+	// GetParticipantBetChanges returns a participant's bet changes on a wager, oldest first
+	GetParticipantBetChanges(ctx context.Context, groupWagerID int64, discordID int64) ([]*entities.GroupWagerBetChange, error)
+
+	// House wager player operations
+	// AddHousePlayer adds a watched summoner to a house wager. Returns false if they were already on it.
+	AddHousePlayer(ctx context.Context, groupWagerID int64, summonerName, tagLine string) (bool, error)
+	// RecordHousePlayerResult records a player's game result on a house wager
+	RecordHousePlayerResult(ctx context.Context, groupWagerID int64, summonerName, tagLine string, won bool) error
+	// GetHousePlayers returns a house wager's players in the order they joined
+	GetHousePlayers(ctx context.Context, groupWagerID int64) ([]*entities.HouseWagerPlayer, error)
 
 	// Stats operations
 	GetStats(ctx context.Context, discordID int64) (*entities.GroupWagerStats, error)
+	GetSubjectGameResults(ctx context.Context, discordID int64) ([]*entities.PlayerGameResult, error)
+	GetSummonerGameResults(ctx context.Context, summonerName string, limit int) ([]*entities.PlayerGameResult, error)
+	GetCreatorStats(ctx context.Context, creatorID int64) (*entities.GroupWagerCreatorStats, error)
+	GetCreatorPopularOptions(ctx context.Context, creatorID int64, limit int) ([]*entities.GroupWagerPopularOption, error)
 
 	// Analytics operations
-	GetGroupWagerPredictions(ctx context.Context, externalSystem *entities.ExternalSystem) ([]*entities.GroupWagerPrediction, error)
+	GetGroupWagerPredictions(ctx context.Context, externalSystem *entities.ExternalSystem, since *time.Time) ([]*entities.GroupWagerPrediction, error)
+	StreamOutcomesByDateRange(ctx context.Context, from, to time.Time, fn func(*entities.GroupWagerOutcome) error) error
 
 	// Expiration operations
 	GetExpiredActiveWagers(ctx context.Context) ([]*entities.GroupWager, error)
 	GetWagersPendingResolution(ctx context.Context) ([]*entities.GroupWager, error)
 	GetGuildsWithActiveWagers(ctx context.Context) ([]int64, error)
+
+	// Reconciliation operations
+	GetGuildsWithWagersPendingResolution(ctx context.Context) ([]int64, error)
+	MarkResolutionReminded(ctx context.Context, groupWagerID int64, remindedAt time.Time) error
+
+	// Resolver assignment operations
+	AssignResolver(ctx context.Context, groupWagerID int64, resolverID int64, assignedAt time.Time) error
+	GetLastAssignedResolver(ctx context.Context) (*int64, error)
+
+	// Parlay operations
+	HasSettledParlayLegs(ctx context.Context, groupWagerID int64) (bool, error)
 }
 
 // GuildSettingsRepository defines the interface for guild settings data access
@@ -203,6 +332,10 @@ type SummonerWatchRepository interface {
 
 	// GetWatch retrieves a specific summoner watch for a guild
 	GetWatch(ctx context.Context, guildID int64, summonerName, tagLine string) (*entities.SummonerWatchDetail, error)
+
+	// RecordGameResult updates the summoner's win streak with a finished game.
+	// Returns the streak before and after the game, or nils if the summoner is unknown or the game was already recorded.
+	RecordGameResult(ctx context.Context, summonerName, tagLine, gameID string, won bool) (*entities.SummonerStreak, *entities.SummonerStreak, error)
 }
 
 
@@ -223,14 +356,45 @@ type LotteryDrawRepository interface {
 	// IncrementPot atomically increments the pot amount with row locking
 	IncrementPot(ctx context.Context, drawID, amount int64) error
 
+	// AddPotTax adds a group wager's pot tax to an open draw's pot.
+	// Returns false without adding it if the draw has already been completed.
+	AddPotTax(ctx context.Context, drawID, amount int64) (bool, error)
+
+	// RemovePotTax takes a refunded pot tax back out of an open draw's pot.
+	// Returns false without removing it if the draw has already been completed.
+	RemovePotTax(ctx context.Context, drawID, amount int64) (bool, error)
+
 	// GetCurrentOpenDraw returns the current open draw for a guild if one exists
 	GetCurrentOpenDraw(ctx context.Context, guildID int64) (*entities.LotteryDraw, error)
 
 	// GetByIDForUpdate retrieves a draw by ID with row lock for update
 	GetByIDForUpdate(ctx context.Context, id int64) (*entities.LotteryDraw, error)
 
+	// TryLockDraw takes a transaction-scoped advisory lock on the draw without waiting.
+	// Returns false if another transaction, possibly on another instance, already holds it.
+	TryLockDraw(ctx context.Context, drawID int64) (bool, error)
+
+	// GetOpenDrawsWithoutMessage returns open draws across all guilds, drawing after afterTime, that have not been
+	// posted to Discord yet, e.g. because the bot stopped between conducting a draw and posting its successor
+	GetOpenDrawsWithoutMessage(ctx context.Context, afterTime time.Time) ([]*entities.LotteryDraw, error)
+
 	// GetNextPendingDrawTime returns the earliest draw_time of pending draws
 	GetNextPendingDrawTime(ctx context.Context) (*time.Time, error)
+
+	// GetRecentCompletedDraws returns the most recently completed draws for a guild
+	GetRecentCompletedDraws(ctx context.Context, guildID int64, limit int) ([]*entities.LotteryDraw, error)
+
+	// GetWinningNumberFrequency returns how often each number has won for a guild at the given difficulty
+	GetWinningNumberFrequency(ctx context.Context, guildID, difficulty int64) ([]*entities.LotteryNumberFrequency, error)
+
+	// StreamCompletedDrawResults calls fn for every draw completed within a date range, oldest first
+	StreamCompletedDrawResults(ctx context.Context, guildID int64, from, to time.Time, fn func(*entities.LotteryDrawResult) error) error
+
+	// GetCompletedDrawResults returns a page of a guild's completed draws, newest first
+	GetCompletedDrawResults(ctx context.Context, guildID int64, limit, offset int) ([]*entities.LotteryDrawResult, error)
+
+	// CountCompletedDraws returns how many draws a guild has completed
+	CountCompletedDraws(ctx context.Context, guildID int64) (int64, error)
 }
 
 // LotteryTicketRepository defines the interface for lottery ticket data access
@@ -252,6 +416,9 @@ type LotteryTicketRepository interface {
 
 	// GetUsedNumbersByUser returns ticket numbers already used by a specific user in a draw
 	GetUsedNumbersByUser(ctx context.Context, drawID, discordID int64) ([]int64, error)
+
+	// GetUserNumberFrequency returns the numbers a user has held most often across draws at the given difficulty
+	GetUserNumberFrequency(ctx context.Context, discordID, difficulty int64, limit int) ([]*entities.LotteryNumberFrequency, error)
 }
 
 // LotteryWinnerRepository defines the interface for lottery winner data access
@@ -264,9 +431,293 @@ type LotteryWinnerRepository interface {
 
 	// GetByUserID returns all wins for a specific user
 	GetByUserID(ctx context.Context, discordID int64) ([]*entities.LotteryWinner, error)
+
+	// GetByDrawIDs returns the winners of several draws, grouped by draw
+	GetByDrawIDs(ctx context.Context, drawIDs []int64) ([]*entities.LotteryWinner, error)
+
+	// GetUserWins returns a page of a user's wins in the guild, newest first
+	GetUserWins(ctx context.Context, discordID int64, limit, offset int) ([]*entities.LotteryUserWin, error)
+
+	// GetUserWinTotals returns the number and total amount of a user's wins in the guild
+	GetUserWinTotals(ctx context.Context, discordID int64) (*entities.LotteryUserWinTotals, error)
+}
+
+// ExperimentRepository defines the interface for experiment rollout and exposure data access
+type ExperimentRepository interface {
+	// GetByKey retrieves an experiment by its key, returning nil if it does not exist
+	GetByKey(ctx context.Context, key string) (*entities.Experiment, error)
+
+	// UpdateRollout sets the rollout percentage and enabled flag of an experiment
+	UpdateRollout(ctx context.Context, key string, rolloutPercent int, enabled bool) error
+
+	// RecordExposure records a user's first exposure to an experiment; later exposures are ignored
+	RecordExposure(ctx context.Context, exposure *entities.ExperimentExposure) error
+
+	// GetReport compares balance activity after first exposure between the variants of an experiment
+	GetReport(ctx context.Context, key string) ([]*entities.ExperimentVariantReport, error)
+}
+
+// SavingsDepositRepository defines the interface for savings deposit data access
+type SavingsDepositRepository interface {
+	// Create creates a new savings deposit
+	Create(ctx context.Context, deposit *entities.SavingsDeposit) error
+
+	// GetByIDForUpdate retrieves a deposit by ID with row lock for update, returning nil if it does not exist
+	GetByIDForUpdate(ctx context.Context, id int64) (*entities.SavingsDeposit, error)
+
+	// GetLockedByUser returns a user's locked deposits ordered by maturity
+	GetLockedByUser(ctx context.Context, discordID int64) ([]*entities.SavingsDeposit, error)
+
+	// Update updates the status, close time and bonus transaction of a deposit
+	Update(ctx context.Context, deposit *entities.SavingsDeposit) error
+
+	// GetMaturedDeposits returns locked deposits across all guilds that matured at or before the given time
+	GetMaturedDeposits(ctx context.Context, asOf time.Time) ([]*entities.SavingsDeposit, error)
+}
+
+// BalanceFreezeRepository defines the interface for moderator balance freeze data access
+type BalanceFreezeRepository interface {
+	// Create records a new freeze
+	Create(ctx context.Context, freeze *entities.BalanceFreeze) error
+
+	// GetByIDForUpdate retrieves a freeze by ID with row lock for update, returning nil if it does not exist
+	GetByIDForUpdate(ctx context.Context, id int64) (*entities.BalanceFreeze, error)
+
+	// GetOpenByUserForUpdate retrieves the user's freeze that has not been lifted with row lock, returning nil if there is none
+	GetOpenByUserForUpdate(ctx context.Context, discordID int64) (*entities.BalanceFreeze, error)
+
+	// Update records that a freeze was lifted
+	Update(ctx context.Context, freeze *entities.BalanceFreeze) error
+
+	// GetExpiredFreezes returns freezes across all guilds that expired at or before the given time and have not been lifted
+	GetExpiredFreezes(ctx context.Context, asOf time.Time) ([]*entities.BalanceFreeze, error)
+}
+
+// ParlayRepository defines the interface for parlay data access
+type ParlayRepository interface {
+	// Create creates a parlay and its legs
+	Create(ctx context.Context, parlay *entities.Parlay) error
+
+	// GetByIDForUpdate retrieves a parlay with its legs and a row lock for update, returning nil if it does not exist
+	GetByIDForUpdate(ctx context.Context, id int64) (*entities.Parlay, error)
+
+	// GetByUser returns a user's most recent parlays with their legs, newest first
+	GetByUser(ctx context.Context, discordID int64, limit int) ([]*entities.Parlay, error)
+
+	// GetPendingByGroupWagerForUpdate returns pending parlays with a leg on the wager, locked for update
+	GetPendingByGroupWagerForUpdate(ctx context.Context, groupWagerID int64) ([]*entities.Parlay, error)
+
+	// Update updates the settlement of a parlay and its legs
+	Update(ctx context.Context, parlay *entities.Parlay) error
+}
+
+// GuildResolverRepository defines the interface for per-guild group wager resolver data access
+type GuildResolverRepository interface {
+	// GetAll returns the current guild's resolver grants, users before roles
+	GetAll(ctx context.Context) ([]*entities.GuildResolver, error)
+
+	// Add grants resolver permission in the current guild. Returns false if the grant already existed.
+	Add(ctx context.Context, resolver *entities.GuildResolver) (bool, error)
+
+	// Remove revokes a resolver grant in the current guild. Returns false if there was no such grant.
+	Remove(ctx context.Context, resolverType entities.GuildResolverType, targetID int64) (bool, error)
+}
+
+// GuildFeatureFlagRepository defines the interface for per-guild feature flag data access
+type GuildFeatureFlagRepository interface {
+	// GetAll returns the features the current guild has explicitly turned on or off
+	GetAll(ctx context.Context) ([]*entities.GuildFeatureFlag, error)
+
+	// Set stores whether a feature is enabled in the current guild, replacing any earlier override
+	Set(ctx context.Context, flag *entities.GuildFeatureFlag) error
+}
+
+// GuildWebhookRepository defines the interface for outbound webhook data access
+type GuildWebhookRepository interface {
+	// Create registers a webhook in the current guild
+	Create(ctx context.Context, webhook *entities.GuildWebhook) error
+
+	// GetByID returns a webhook in the current guild, returning nil if it does not exist
+	GetByID(ctx context.Context, id int64) (*entities.GuildWebhook, error)
+
+	// GetAll returns the current guild's webhooks, oldest first
+	GetAll(ctx context.Context) ([]*entities.GuildWebhook, error)
+
+	// GetSubscribed returns the current guild's webhooks subscribed to the event type
+	GetSubscribed(ctx context.Context, eventType entities.WebhookEventType) ([]*entities.GuildWebhook, error)
+
+	// Delete removes a webhook and its deliveries from the current guild. Returns false if there was no such webhook.
+	Delete(ctx context.Context, id int64) (bool, error)
+}
+
+// WebhookDeliveryRepository defines the interface for webhook delivery data access
+type WebhookDeliveryRepository interface {
+	// Create queues a delivery in the current guild
+	Create(ctx context.Context, delivery *entities.WebhookDelivery) error
+
+	// GetByIDForUpdate retrieves a delivery in the current guild with row lock for update, returning nil if it does not exist
+	GetByIDForUpdate(ctx context.Context, id int64) (*entities.WebhookDelivery, error)
+
+	// GetRecentByWebhook returns a webhook's most recent deliveries, newest first
+	GetRecentByWebhook(ctx context.Context, webhookID int64, limit int) ([]*entities.WebhookDelivery, error)
+
+	// Update updates the status, attempts and outcome of a delivery
+	Update(ctx context.Context, delivery *entities.WebhookDelivery) error
+
+	// GetDueDeliveries returns pending deliveries across all guilds due at or before the given time, oldest first
+	GetDueDeliveries(ctx context.Context, asOf time.Time, limit int) ([]*entities.WebhookDelivery, error)
+}
+
+// DiscordOutboxRepository defines the interface for Discord outbox data access
+type DiscordOutboxRepository interface {
+	// Create queues a message in the current guild. A pending message with the same dedup key is
+	// updated in place instead, taking the new payload, and its ID is set on the message.
+	Create(ctx context.Context, message *entities.DiscordOutboxMessage) error
+
+	// GetByIDForUpdate retrieves a message in the current guild with row lock for update, returning nil if it does not exist
+	GetByIDForUpdate(ctx context.Context, id int64) (*entities.DiscordOutboxMessage, error)
+
+	// Update updates the status, attempts and outcome of a message
+	Update(ctx context.Context, message *entities.DiscordOutboxMessage) error
+
+	// GetDueMessages returns pending messages across all guilds due at or before the given time, oldest first
+	GetDueMessages(ctx context.Context, asOf time.Time, limit int) ([]*entities.DiscordOutboxMessage, error)
+}
+
+// DuelRepository defines the interface for duel data access
+type DuelRepository interface {
+	// Create creates a new duel challenge
+	Create(ctx context.Context, duel *entities.Duel) error
+
+	// GetByIDForUpdate retrieves a duel with a row lock for update, returning nil if it does not exist
+	GetByIDForUpdate(ctx context.Context, id int64) (*entities.Duel, error)
+
+	// Update updates the status and outcome of a duel
+	Update(ctx context.Context, duel *entities.Duel) error
+}
+
+// LotterySubscriptionRepository defines the interface for lottery auto-buy subscription data access
+type LotterySubscriptionRepository interface {
+	// Upsert creates the user's subscription in the current guild or replaces its ticket count
+	Upsert(ctx context.Context, subscription *entities.LotterySubscription) error
+
+	// GetByUser returns the user's subscription in the current guild, or nil if they have none
+	GetByUser(ctx context.Context, discordID int64) (*entities.LotterySubscription, error)
+
+	// GetAll returns every subscription in the current guild, oldest first
+	GetAll(ctx context.Context) ([]*entities.LotterySubscription, error)
+
+	// Delete removes the user's subscription in the current guild. Returns false if they had none.
+	Delete(ctx context.Context, discordID int64) (bool, error)
+}
+
+// GroupWagerFollowerRepository defines the interface for the users following a group wager
+type GroupWagerFollowerRepository interface {
+	// Follow adds the user as a follower of the wager. Returns false if they were already following it.
+	Follow(ctx context.Context, groupWagerID, discordID int64) (bool, error)
+
+	// Unfollow removes the user as a follower of the wager. Returns false if they weren't following it.
+	Unfollow(ctx context.Context, groupWagerID, discordID int64) (bool, error)
+
+	// GetFollowers returns the Discord IDs following the wager, in the order they followed it
+	GetFollowers(ctx context.Context, groupWagerID int64) ([]int64, error)
+}
+
+// GuildSnapshotRepository defines the interface for guild economy snapshot data access
+type GuildSnapshotRepository interface {
+	// Create stores a snapshot of the current guild
+	Create(ctx context.Context, snapshot *entities.GuildSnapshot) error
+
+	// GetByID retrieves a snapshot in the current guild, returning nil if it does not exist
+	GetByID(ctx context.Context, id int64) (*entities.GuildSnapshot, error)
+
+	// GetRecent returns the guild's most recent snapshots, newest first
+	GetRecent(ctx context.Context, limit int) ([]*entities.GuildSnapshot, error)
+}
+
+// GlobalLeaderboardRepository defines the interface for the cross-guild prediction leaderboard.
+// Queries span every guild that shares its stats rather than a single guild.
+type GlobalLeaderboardRepository interface {
+	// GetPredictionEntries returns unranked prediction totals for every player with at least minPredictions
+	// resolved predictions in sharing guilds, excluding players who hid themselves
+	GetPredictionEntries(ctx context.Context, viewerGuildID int64, minPredictions int) ([]*entities.GlobalLeaderboardEntry, error)
+}
+
+// UserPreferencesRepository defines the interface for per-user display preference data access.
+// Preferences are shared across guilds.
+type UserPreferencesRepository interface {
+	// GetByDiscordID returns a user's preferences, or nil if they have never set any
+	GetByDiscordID(ctx context.Context, discordID int64) (*entities.UserPreferences, error)
+
+	// Upsert creates or replaces a user's preferences
+	Upsert(ctx context.Context, prefs *entities.UserPreferences) error
+}
+
+// RiotAccountLinkRepository defines the interface for links between Discord users and their Riot accounts.
+// Links are shared across guilds.
+type RiotAccountLinkRepository interface {
+	// GetByDiscordID returns the user's link, or nil if they have not linked an account
+	GetByDiscordID(ctx context.Context, discordID int64) (*entities.RiotAccountLink, error)
+
+	// GetByRiotID returns the link for a Riot ID ignoring case, or nil if nobody has claimed it
+	GetByRiotID(ctx context.Context, gameName, tagLine string) (*entities.RiotAccountLink, error)
+
+	// Upsert creates or replaces the user's link
+	Upsert(ctx context.Context, link *entities.RiotAccountLink) error
+
+	// Delete removes the user's link. Returns false if they had none.
+	Delete(ctx context.Context, discordID int64) (bool, error)
+}
+
+// SeasonTokenRepository defines the interface for the guild's seasonal secondary currency.
+// Token balances are separate from bits and are kept per season.
+type SeasonTokenRepository interface {
+	// GetBalance returns the user's token balance for a season, zero if they have never earned any
+	GetBalance(ctx context.Context, discordID int64, season int) (int64, error)
+
+	// Credit adds tokens to the user's season balance and returns the new balance
+	Credit(ctx context.Context, discordID int64, season int, amount int64) (int64, error)
+
+	// Debit removes tokens from the user's season balance and returns the new balance.
+	// Returns false without changing anything if the balance is smaller than amount.
+	Debit(ctx context.Context, discordID int64, season int, amount int64) (int64, bool, error)
+
+	// RecordHistory records a token credit or debit in the season token ledger
+	RecordHistory(ctx context.Context, history *entities.SeasonTokenHistory) error
+
+	// GetHistory returns the user's most recent token movements for a season, newest first
+	GetHistory(ctx context.Context, discordID int64, season int, limit int) ([]*entities.SeasonTokenHistory, error)
+}
+
+// AchievementRepository defines the interface for unlocked achievements and progress towards them
+type AchievementRepository interface {
+	// Unlock records the achievement as unlocked. Returns false if the user had already unlocked it.
+	Unlock(ctx context.Context, discordID int64, key entities.AchievementKey) (bool, error)
+
+	// GetByDiscordID returns the user's unlocked achievements, oldest first
+	GetByDiscordID(ctx context.Context, discordID int64) ([]*entities.Achievement, error)
+
+	// GetByDiscordIDs returns the unlocked achievements of several users, keyed by Discord ID.
+	// Users without achievements are left out.
+	GetByDiscordIDs(ctx context.Context, discordIDs []int64) (map[int64][]*entities.Achievement, error)
+
+	// RecordResult extends the user's win streak on a win or resets it on a loss, and returns the updated progress
+	RecordResult(ctx context.Context, discordID int64, won bool) (*entities.AchievementProgress, error)
+}
+
+// DataRetentionRepository deletes and anonymizes stored data. Unlike the other repositories it is
+// not guild scoped, because a user's data spans every guild they have played in.
+type DataRetentionRepository interface {
+	// PurgeGuild deletes every row belonging to the guild
+	PurgeGuild(ctx context.Context, guildID int64) (*entities.DataRetentionReport, error)
+
+	// AnonymizeUser reassigns the user's shared history, such as ledger entries, wagers and lottery
+	// tickets, to a new pseudonymous user and deletes everything else stored about them.
+	// Returns nil if the user does not exist.
+	AnonymizeUser(ctx context.Context, discordID int64) (*entities.DataRetentionReport, error)
 }
 
 // EventPublisher defines the interface for publishing events
 type EventPublisher interface {
 	Publish(event events.Event) error
-}
\ No newline at end of file
+}
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"gambler/discord-client/domain/entities"
@@ -9,26 +10,37 @@ import (
 
 // UserService defines the interface for user operations
 type UserService interface {
-	// GetOrCreateUser retrieves an existing user or creates a new one with initial balance
-	GetOrCreateUser(ctx context.Context, discordID int64, username string) (*entities.User, error)
+	// GetOrCreateUser retrieves an existing user or creates a new one with the guild's starting balance
+	GetOrCreateUser(ctx context.Context, guildID, discordID int64, username string) (*entities.User, error)
 
 	// GetCurrentHighRoller returns the user with the highest balance
 	GetCurrentHighRoller(ctx context.Context) (*entities.User, error)
 
 	// TransferBetweenUsers transfers amount from sender to recipient
 	TransferBetweenUsers(ctx context.Context, fromDiscordID, toDiscordID int64, amount int64, fromUsername, toUsername string) error
+
+	// StartGamblingBreak blocks the user from betting for the given duration.
+	// Returns when the break ends; an existing longer break is kept.
+	StartGamblingBreak(ctx context.Context, discordID int64, duration time.Duration) (time.Time, error)
+
+	// GetLockedBalanceBreakdown returns how much of the user's balance is tied up and where
+	GetLockedBalanceBreakdown(ctx context.Context, discordID int64) (*entities.LockedBalanceBreakdown, error)
 }
 
 // GamblingService defines the interface for gambling operations
 type GamblingService interface {
 	// PlaceBet places a bet for a user with the given win probability and amount
-	PlaceBet(ctx context.Context, discordID int64, winProbability float64, betAmount int64) (*entities.BetResult, error)
+	PlaceBet(ctx context.Context, discordID int64, guildID int64, winProbability float64, betAmount int64) (*entities.BetResult, error)
+
+	// PlaceBetWithAmount places a bet of a typed amount, resolving all, half or a percentage against the
+	// user's available balance in the same transaction as the bet
+	PlaceBetWithAmount(ctx context.Context, discordID int64, guildID int64, winProbability float64, amount entities.Amount) (*entities.BetResult, error)
 }
 
 // WagerService defines the interface for wager operations
 type WagerService interface {
 	// ProposeWager creates a new wager proposal
-	ProposeWager(ctx context.Context, proposerID, targetID int64, amount int64, condition string, messageID, channelID int64) (*entities.Wager, error)
+	ProposeWager(ctx context.Context, proposerID, targetID, guildID int64, amount int64, condition string, messageID, channelID int64) (*entities.Wager, error)
 
 	// RespondToWager handles accepting or declining a wager
 	RespondToWager(ctx context.Context, wagerID int64, responderID int64, accept bool) (*entities.Wager, error)
@@ -58,35 +70,119 @@ type WagerService interface {
 
 // GroupWagerService defines the interface for group wager operations
 type GroupWagerService interface {
-	// CreateGroupWager creates a new group wager with options
-	CreateGroupWager(ctx context.Context, creatorID *int64, condition string, options []string, votingPeriodMinutes int, messageID, channelID int64, wagerType entities.GroupWagerType, oddsMultipliers []float64) (*entities.GroupWagerDetail, error)
+	// CreateGroupWager creates a new group wager with options. maxTotalAmounts optionally caps the total bet on each option (0 = uncapped)
+	CreateGroupWager(ctx context.Context, guildID int64, creatorID *int64, condition string, options []string, votingPeriodMinutes int, messageID, channelID int64, wagerType entities.GroupWagerType, oddsMultipliers []float64, maxTotalAmounts []int64) (*entities.GroupWagerDetail, error)
 
 	// PlaceBet allows a user to place or update their bet on a group wager option
 	PlaceBet(ctx context.Context, groupWagerID int64, userID int64, optionID int64, amount int64) (*entities.GroupWagerParticipant, error)
 
+	// PlaceBetWithAmount places a bet of a typed amount, resolving all, half or a percentage against the
+	// user's available balance in the same transaction as the bet
+	PlaceBetWithAmount(ctx context.Context, groupWagerID int64, userID int64, optionID int64, amount entities.Amount) (*entities.GroupWagerParticipant, error)
+
+	// PlaceReactionBet bets on an option of a reaction wager. New bettors stake the wager's reaction stake,
+	// while existing bettors move their whole bet to the option
+	PlaceReactionBet(ctx context.Context, groupWagerID int64, userID int64, optionID int64) (*entities.GroupWagerParticipant, error)
+
+	// PreviewBet shows what a bet would pay at the current odds, without placing it, so the user can confirm
+	PreviewBet(ctx context.Context, groupWagerID int64, userID int64, optionID int64, amount entities.Amount) (*entities.GroupWagerBetPreview, error)
+
+	// WithdrawBet reduces a user's bet on a pool wager while voting is open.
+	// A newAmount of 0 removes the participation entirely and returns a nil participant.
+	WithdrawBet(ctx context.Context, groupWagerID int64, userID int64, newAmount int64) (*entities.GroupWagerParticipant, error)
+
 	// ResolveGroupWager resolves a group wager with the winning option
 	ResolveGroupWager(ctx context.Context, groupWagerID int64, resolverID *int64, winningOptionID int64) (*entities.GroupWagerResult, error)
 
+	// ReResolve corrects the winning option of a group wager resolved within the configured correction window.
+	// Prior payouts and pot tax are reversed with compensating balance history before the wager is settled
+	// again. Wagers with parlay legs settled on the original outcome can't be corrected.
+	ReResolve(ctx context.Context, groupWagerID int64, resolverID int64, newWinningOptionID int64) (*entities.GroupWagerResult, error)
+
 	// GetGroupWagerDetail retrieves full details of a group wager
 	GetGroupWagerDetail(ctx context.Context, groupWagerID int64) (*entities.GroupWagerDetail, error)
 
 	// GetGroupWagerByMessageID retrieves a group wager by message ID
 	GetGroupWagerByMessageID(ctx context.Context, messageID int64) (*entities.GroupWagerDetail, error)
 
+	// GetGroupWagerByReactionMessageID retrieves the group wager taking bets from reactions on a message, or nil if none does
+	GetGroupWagerByReactionMessageID(ctx context.Context, messageID int64) (*entities.GroupWagerDetail, error)
+
+	// EnableReactionBetting lets users bet on the creator's wager by reacting to a message with the options' emojis
+	EnableReactionBetting(ctx context.Context, groupWagerID int64, creatorID int64, messageID, channelID int64, stake int64) (*entities.GroupWager, error)
+
 	// GetActiveGroupWagersByUser returns active group wagers where user is participating
 	GetActiveGroupWagersByUser(ctx context.Context, discordID int64) ([]*entities.GroupWager, error)
 
-	// IsResolver checks if a user can resolve group wagers
-	IsResolver(discordID int64) bool
+	// IsResolver checks if a user can resolve group wagers in the current guild, either as a globally
+	// configured resolver or through the guild's user and role grants. Role grants are matched against
+	// the member roles attached with utils.WithMemberRoles.
+	IsResolver(ctx context.Context, discordID int64) (bool, error)
 
 	// UpdateMessageIDs updates the message and channel IDs for a group wager
 	UpdateMessageIDs(ctx context.Context, groupWagerID int64, messageID int64, channelID int64) error
 
-	// TransitionExpiredWagers finds and transitions expired active wagers to pending_resolution
-	TransitionExpiredWagers(ctx context.Context) error
+	// UpdateThreadID records the discussion thread attached to a group wager message
+	UpdateThreadID(ctx context.Context, groupWagerID int64, threadID int64) error
+
+	// LinkExternalReference links an open group wager to an external match so a result provider can resolve it
+	LinkExternalReference(ctx context.Context, groupWagerID int64, linkerID int64, ref entities.ExternalReference) (*entities.GroupWager, error)
+
+	// SetAccess sets the participant limit and invite-only restrictions of a group wager. Only the creator
+	// can change them, and only before anyone else has bet
+	SetAccess(ctx context.Context, groupWagerID int64, creatorID int64, access entities.GroupWagerAccess) (*entities.GroupWager, error)
+
+	// ReorderOptions lets the creator rearrange an active wager's options before anyone has bet.
+	// optionNumbers lists the options' current numbers (1-based) in their new order.
+	ReorderOptions(ctx context.Context, groupWagerID int64, creatorID int64, optionNumbers []int) (*entities.GroupWagerDetail, error)
+
+	// TransitionExpiredWagers finds and transitions expired active wagers to pending_resolution, returning the
+	// wagers that were assigned a resolver
+	TransitionExpiredWagers(ctx context.Context) ([]*entities.GroupWager, error)
 
 	// CancelGroupWager cancels an active group wager
 	CancelGroupWager(ctx context.Context, groupWagerID int64, cancellerID *int64) error
+
+	// RefundAllParticipants cancels an active or pending group wager, returning every participant's bet.
+	// A nil cancellerID is a system cancellation, such as a remade game. Returns the bets returned.
+	RefundAllParticipants(ctx context.Context, groupWagerID int64, cancellerID *int64) (*entities.GroupWagerRefundSummary, error)
+
+	// RestoreGroupWager undoes a cancellation made within entities.GroupWagerRestoreWindow, as long as
+	// no payouts were made and every participant can still cover their bet
+	RestoreGroupWager(ctx context.Context, groupWagerID int64, restorerID int64) (*entities.GroupWagerDetail, error)
+
+	// ReconcileStuckWagers applies the guild's stuck wager settings to wagers awaiting resolution:
+	// wagers past the cancel age are cancelled with full refunds, and wagers past the reminder age
+	// are marked reminded and returned so resolvers can be pinged
+	ReconcileStuckWagers(ctx context.Context, guildID int64, now time.Time) (*StuckWagerReconciliation, error)
+
+	// UpdateHouseWagerOdds changes house wager odds by option ID, recording each change to odds history
+	UpdateHouseWagerOdds(ctx context.Context, groupWagerID int64, updaterID *int64, oddsMultipliers map[int64]float64) (*entities.GroupWagerDetail, error)
+
+	// GetOddsHistory returns the recorded odds changes for a group wager
+	GetOddsHistory(ctx context.Context, groupWagerID int64) ([]*entities.GroupWagerOddsChange, error)
+
+	// GetCreatorStats summarizes the group wagers a user has created, including their most popular options
+	GetCreatorStats(ctx context.Context, creatorID int64) (*entities.GroupWagerCreatorStats, error)
+}
+
+// OddsEngine prices house wagers from the results of past house wagers on the same player
+type OddsEngine interface {
+	// LoLWinLossOdds returns the Win and Loss multipliers for a summoner's next game,
+	// falling back to even odds when the summoner has too little history
+	LoLWinLossOdds(ctx context.Context, summonerName string) ([]float64, error)
+}
+
+// StuckWagerReconciliation reports what the reconciler did with wagers stuck awaiting resolution
+type StuckWagerReconciliation struct {
+	Reminded  []*entities.GroupWager // Resolvers should be pinged about these wagers
+	Cancelled []*entities.GroupWager // Cancelled with full refunds after the hard timeout
+}
+
+// ChannelLookup checks guild channels against Discord
+type ChannelLookup interface {
+	// ChannelExists reports whether the channel exists and belongs to the guild
+	ChannelExists(ctx context.Context, guildID, channelID int64) (bool, error)
 }
 
 // GuildSettingsService defines the interface for guild settings operations
@@ -94,6 +190,13 @@ type GuildSettingsService interface {
 	// GetOrCreateSettings retrieves guild settings or creates default ones if not found
 	GetOrCreateSettings(ctx context.Context, guildID int64) (*entities.GuildSettings, error)
 
+	// ValidateSettings checks settings as a whole, including cross-field constraints and that channels exist.
+	// Returns a *entities.SettingsValidationError describing every violation.
+	ValidateSettings(ctx context.Context, settings *entities.GuildSettings) error
+
+	// PreviewSettings applies change to the guild's settings and validates the result without saving it
+	PreviewSettings(ctx context.Context, guildID int64, change func(*entities.GuildSettings)) (*entities.GuildSettings, error)
+
 	// UpdatePrimaryChannel updates the primary channel for a guild
 	UpdatePrimaryChannel(ctx context.Context, guildID int64, channelID *int64) error
 
@@ -120,6 +223,80 @@ type GuildSettingsService interface {
 
 	// UpdateLottoDifficulty updates the lottery difficulty for a guild
 	UpdateLottoDifficulty(ctx context.Context, guildID int64, difficulty *int64) error
+
+	// UpdateLottoRolloverCap sets the largest pot that can roll over into the next draw (nil removes the cap)
+	UpdateLottoRolloverCap(ctx context.Context, guildID int64, cap *int64) error
+
+	// UpdatePotTaxPercent sets the percent of each resolved group wager's pot added to the lottery (nil disables it)
+	UpdatePotTaxPercent(ctx context.Context, guildID int64, percent *int) error
+
+	// UpdateAuditChannel updates the balance change audit channel for a guild
+	UpdateAuditChannel(ctx context.Context, guildID int64, channelID *int64) error
+
+	// UpdateDigestChannel updates the weekly economy digest channel for a guild
+	UpdateDigestChannel(ctx context.Context, guildID int64, channelID *int64) error
+
+	// UpdateAuditThreshold updates the minimum balance change posted to the audit channel for a guild
+	UpdateAuditThreshold(ctx context.Context, guildID int64, threshold *int64) error
+
+	// UpdateBettingCurfew sets the hours, in an IANA timezone (nil for UTC), during which new bets and wagers are rejected (both hours nil to disable)
+	UpdateBettingCurfew(ctx context.Context, guildID int64, startHour, endHour *int, timezone *string) error
+
+	// UpdateRulesText sets the guild's rules and dispute policy (empty to restore the default)
+	UpdateRulesText(ctx context.Context, guildID int64, rules string) error
+
+	// UpdateStuckWagerReconciliation sets the hours a wager may await resolution before resolvers are pinged
+	// and before it is cancelled with refunds (nil disables each step)
+	UpdateStuckWagerReconciliation(ctx context.Context, guildID int64, reminderHours, cancelHours *int) error
+
+	// UpdateSnipeProtection sets how close to the end of voting a bet must land to extend voting,
+	// and by how many minutes (nil for both disables sniping protection)
+	UpdateSnipeProtection(ctx context.Context, guildID int64, windowMinutes, extensionMinutes *int) error
+
+	// UpdateSavingsBonusPercent sets the savings bonus percent earned per locked week (nil to restore the default)
+	UpdateSavingsBonusPercent(ctx context.Context, guildID int64, percent *int) error
+
+	// UpdateHouseOptionCap sets the max total bet per option on LoL/TFT house wagers (nil removes the cap)
+	UpdateHouseOptionCap(ctx context.Context, guildID int64, cap *int64) error
+
+	// UpdateStartingBalance sets the balance new users are created with (nil restores the default)
+	UpdateStartingBalance(ctx context.Context, guildID int64, balance *int64) error
+	// UpdateMinBalanceFloor sets the reserve balance users cannot bet below (nil removes the floor)
+	UpdateMinBalanceFloor(ctx context.Context, guildID int64, floor *int64) error
+
+	// UpdateMinBetAmount sets the smallest bet, wager proposal or lottery purchase accepted (nil removes the minimum)
+	UpdateMinBetAmount(ctx context.Context, guildID int64, amount *int64) error
+
+	// UpdateWelcomeNewMembers enables or disables creating accounts for and welcoming members as they join
+	UpdateWelcomeNewMembers(ctx context.Context, guildID int64, enabled bool) error
+
+	// UpdateRateLimit sets how fast each user may bet, buy lottery tickets and transfer (nil restores each default, 0 per minute disables)
+	UpdateRateLimit(ctx context.Context, guildID int64, perMinute, burst *int) error
+
+	// UpdateTFTPlacementLayout sets how regular TFT house wagers split placements into options (nil restores the default)
+	UpdateTFTPlacementLayout(ctx context.Context, guildID int64, layout *entities.TFTPlacementLayout) error
+
+	// UpdateGroupWagerBetMode sets how bettors choose an option on group wagers (nil restores the default)
+	UpdateGroupWagerBetMode(ctx context.Context, guildID int64, mode *entities.GroupWagerBetMode) error
+
+	// UpdateBlockOwnGameBets enables or disables stopping linked players from betting on their own games
+	UpdateBlockOwnGameBets(ctx context.Context, guildID int64, blocked bool) error
+
+	// UpdateShareGlobalLeaderboard enables or disables sharing the guild's predictions with the cross-guild leaderboard
+	UpdateShareGlobalLeaderboard(ctx context.Context, guildID int64, shared bool) error
+
+	// UpdateLolNonRankedWagers enables or disables house wagers on ARAM, normal and custom LoL games
+	UpdateLolNonRankedWagers(ctx context.Context, guildID int64, enabled bool) error
+
+	// UpdateAutoAssignResolvers enables or disables assigning each wager awaiting resolution to the next resolver in rotation
+	UpdateAutoAssignResolvers(ctx context.Context, guildID int64, enabled bool) error
+
+	// UpdateSeasonTokens enables the seasonal currency under name, earned at one token per rate bits won
+	// (nil name disables it, nil rate restores the default)
+	UpdateSeasonTokens(ctx context.Context, guildID int64, name *string, rate *int64) error
+
+	// UpdateDefaultVotingPeriod sets the voting period pre-filled for new group wagers, in minutes (nil restores 24 hours)
+	UpdateDefaultVotingPeriod(ctx context.Context, guildID int64, minutes *int) error
 }
 
 // HighRollerService defines the interface for high roller operations
@@ -144,6 +321,9 @@ type SummonerWatchService interface {
 	// AddWatch creates a new summoner watch for a guild
 	AddWatch(ctx context.Context, guildID int64, summonerName, tagLine string) (*entities.SummonerWatchDetail, error)
 
+	// CheckCanAddWatch validates a new watch without creating it, rejecting duplicates and guilds at their watch limit
+	CheckCanAddWatch(ctx context.Context, guildID int64, summonerName, tagLine string) error
+
 	// RemoveWatch removes a summoner watch for a guild
 	RemoveWatch(ctx context.Context, guildID int64, summonerName, tagLine string) error
 
@@ -156,6 +336,17 @@ type LotteryService interface {
 	// PurchaseTickets buys lottery tickets for a user
 	PurchaseTickets(ctx context.Context, discordID, guildID int64, quantity int) (*LotteryPurchaseResult, error)
 
+	// PurchaseTicketsWithAmount buys a number of tickets, or with all, half or a percentage as many tickets as
+	// fit in that share of the user's available balance
+	PurchaseTicketsWithAmount(ctx context.Context, discordID, guildID int64, amount entities.Amount) (*LotteryPurchaseResult, error)
+
+	// PurchaseSpecificTickets buys one ticket for each number the user picked, rejecting numbers outside
+	// the draw's range or already on one of the user's tickets
+	PurchaseSpecificTickets(ctx context.Context, discordID, guildID int64, numbers []int64) (*LotteryPurchaseResult, error)
+
+	// AutoPurchaseTickets buys lottery tickets on behalf of a user's subscription
+	AutoPurchaseTickets(ctx context.Context, discordID, guildID int64, quantity int) (*LotteryPurchaseResult, error)
+
 	// GetOrCreateCurrentDraw gets the current open draw or creates one
 	GetOrCreateCurrentDraw(ctx context.Context, guildID int64) (*entities.LotteryDraw, error)
 
@@ -173,6 +364,16 @@ type LotteryService interface {
 
 	// CalculateNextDrawTime calculates the next Friday 2pm UTC draw time
 	CalculateNextDrawTime() time.Time
+
+	// GetNumberStats returns hot/cold winning numbers, recent results and the user's most played numbers
+	GetNumberStats(ctx context.Context, discordID, guildID int64, recentLimit int) (*LotteryNumberStats, error)
+
+	// GetDrawHistory returns a page of the guild's completed draws, newest first, with their winners.
+	// Pages start at 1.
+	GetDrawHistory(ctx context.Context, guildID int64, page, pageSize int) (*LotteryDrawHistory, error)
+
+	// GetUserWinHistory returns the user's most recent lottery wins in the guild and their all-time totals
+	GetUserWinHistory(ctx context.Context, discordID, guildID int64, limit int) (*LotteryUserWinHistory, error)
 }
 
 // LotteryPurchaseResult represents the result of a ticket purchase
@@ -198,6 +399,107 @@ type LotteryDrawResult struct {
 	PotAmount     int64
 	RolledOver    bool
 	NextDraw      *entities.LotteryDraw
+
+	// Set on rollover: the amount carried into the next draw, and the consolation paid out of
+	// any pot above the guild's rollover cap
+	RolloverAmount     int64
+	ConsolationPayouts []*entities.LotteryConsolationPayout
+}
+
+// LotteryNumberStats contains historical number statistics for a guild's lottery
+type LotteryNumberStats struct {
+	Difficulty  int64
+	TotalDraws  int64
+	HotNumbers  []*entities.LotteryNumberFrequency
+	ColdNumbers []*entities.LotteryNumberFrequency
+	RecentDraws []*entities.LotteryDraw
+	UserNumbers []*entities.LotteryNumberFrequency
+}
+
+// LotteryDrawHistory is a page of a guild's completed lottery draws
+type LotteryDrawHistory struct {
+	Draws      []*LotteryDrawHistoryEntry
+	Page       int
+	PageSize   int
+	TotalDraws int64
+}
+
+// TotalPages returns how many pages the guild's draw history spans
+func (h *LotteryDrawHistory) TotalPages() int {
+	if h.PageSize <= 0 || h.TotalDraws == 0 {
+		return 1
+	}
+	return int((h.TotalDraws + int64(h.PageSize) - 1) / int64(h.PageSize))
+}
+
+// LotteryDrawHistoryEntry is a completed draw with the users who won it
+type LotteryDrawHistoryEntry struct {
+	Result  *entities.LotteryDrawResult
+	Winners []*entities.LotteryWinner
+}
+
+// LotteryUserWinHistory contains a user's recent lottery wins and their totals across all draws
+type LotteryUserWinHistory struct {
+	Wins   []*entities.LotteryUserWin
+	Totals *entities.LotteryUserWinTotals
+}
+
+// LotterySubscriptionService manages tickets bought automatically at the start of each draw
+type LotterySubscriptionService interface {
+	// Subscribe sets the number of tickets bought for the user at the start of each new draw
+	Subscribe(ctx context.Context, discordID, guildID int64, ticketCount int) (*entities.LotterySubscription, error)
+
+	// Unsubscribe cancels the user's subscription. Returns false if they had none.
+	Unsubscribe(ctx context.Context, discordID int64) (bool, error)
+
+	// GetSubscription returns the user's subscription, or nil if they have none
+	GetSubscription(ctx context.Context, discordID int64) (*entities.LotterySubscription, error)
+
+	// ProcessSubscriptions buys each subscriber's tickets for the guild's current draw.
+	// Subscribers who can't buy right now (insufficient available balance, a gambling break, a balance freeze or curfew) are skipped.
+	ProcessSubscriptions(ctx context.Context, guildID int64) (*LotterySubscriptionRunResult, error)
+}
+
+// LotterySubscriptionRunResult summarizes the tickets bought by ProcessSubscriptions
+type LotterySubscriptionRunResult struct {
+	Purchased     []*entities.LotterySubscription
+	Skipped       []*entities.LotterySubscription
+	TicketsBought int
+	TotalCost     int64
+}
+
+// GlobalLeaderboardService ranks players across every guild that shares its stats
+type GlobalLeaderboardService interface {
+	// GetLeaderboard returns the players with at least minPredictions resolved predictions ranked by the chosen stat
+	GetLeaderboard(ctx context.Context, viewerGuildID int64, by entities.GlobalLeaderboardSort, minPredictions int) ([]*entities.GlobalLeaderboardEntry, error)
+}
+
+// UserPreferencesService manages per-user display preferences
+type UserPreferencesService interface {
+	// GetPreferences returns a user's preferences, falling back to the defaults if they have never set any
+	GetPreferences(ctx context.Context, discordID int64) (*entities.UserPreferences, error)
+
+	// GetOddsFormat returns the odds format a user wants odds shown in
+	GetOddsFormat(ctx context.Context, discordID int64) (entities.OddsFormat, error)
+
+	// SetOddsFormat sets the odds format a user wants odds shown in
+	SetOddsFormat(ctx context.Context, discordID int64, format entities.OddsFormat) (*entities.UserPreferences, error)
+
+	// SetHideFromGlobalLeaderboard sets whether the user is kept off the cross-guild leaderboard
+	SetHideFromGlobalLeaderboard(ctx context.Context, discordID int64, hidden bool) (*entities.UserPreferences, error)
+}
+
+// RiotAccountLinkService manages the links between users and their own Riot accounts
+type RiotAccountLinkService interface {
+	// GetLink returns the user's linked Riot account, or nil if they have not linked one
+	GetLink(ctx context.Context, discordID int64) (*entities.RiotAccountLink, error)
+
+	// Link links the user to a Riot account, replacing any account they linked before.
+	// Returns ErrRiotAccountLinkedElsewhere if another user has claimed the account.
+	Link(ctx context.Context, discordID int64, gameName, tagLine string) (*entities.RiotAccountLink, error)
+
+	// Unlink removes the user's linked Riot account. Returns false if they had none.
+	Unlink(ctx context.Context, discordID int64) (bool, error)
 }
 
 // UserMetricsService consolidates user statistics and analytics operations
@@ -210,6 +512,10 @@ type UserMetricsService interface {
 	// GetUserStats returns detailed statistics for a specific user
 	GetUserStats(ctx context.Context, discordID int64) (*entities.UserStats, error)
 
+	// GetUserStatsTimeSeries returns a user's wins, losses and balance for each of the last days UTC days
+	// Pass a system to count only wins and losses on that system's house wagers (nil for all)
+	GetUserStatsTimeSeries(ctx context.Context, discordID int64, system *entities.ExternalSystem, days int) (*entities.UserStatsTimeSeries, error)
+
 	// Prediction analytics methods
 
 	// GetLOLPredictionStats calculates LOL-specific prediction stats for all users in a guild
@@ -217,18 +523,275 @@ type UserMetricsService interface {
 	GetLOLPredictionStats(ctx context.Context) (map[int64]*entities.LOLPredictionStats, error)
 
 	// GetWagerPredictionStats calculates generic prediction stats for all users in a guild
-	// Can optionally filter by external system (pass nil for all wagers)
-	GetWagerPredictionStats(ctx context.Context, externalSystem *entities.ExternalSystem) (map[int64]*entities.WagerPredictionStats, error)
+	// Can optionally filter by external system (pass nil for all wagers) and to wagers created since a time (pass nil for all time)
+	GetWagerPredictionStats(ctx context.Context, externalSystem *entities.ExternalSystem, since *time.Time) (map[int64]*entities.WagerPredictionStats, error)
 
 	// GetLOLLeaderboard returns LoL prediction leaderboard entries
-	// Filters users with minimum wager count and calculates profit/loss
-	GetLOLLeaderboard(ctx context.Context, minWagers int) ([]*entities.LOLLeaderboardEntry, int64, error)
+	// Filters users with minimum wager count and calculates profit/loss, counting wagers created since a time (nil for all time)
+	GetLOLLeaderboard(ctx context.Context, minWagers int, since *time.Time) ([]*entities.LOLLeaderboardEntry, int64, error)
 
 	// GetTFTLeaderboard returns TFT prediction leaderboard entries
-	// Filters users with minimum wager count and calculates profit/loss
-	GetTFTLeaderboard(ctx context.Context, minWagers int) ([]*entities.LOLLeaderboardEntry, int64, error)
+	// Filters users with minimum wager count and calculates profit/loss, counting wagers created since a time (nil for all time)
+	GetTFTLeaderboard(ctx context.Context, minWagers int, since *time.Time) ([]*entities.LOLLeaderboardEntry, int64, error)
 
 	// GetGamblingLeaderboard returns gambling leaderboard entries
 	// Filters users with minimum bet count and calculates net profit/loss
 	GetGamblingLeaderboard(ctx context.Context, minBets int) ([]*entities.GamblingLeaderboardEntry, int64, error)
-}
\ No newline at end of file
+}
+
+// ExperimentService manages percentage rollouts of engagement features
+type ExperimentService interface {
+	// GetVariant returns the variant a user is assigned to and records their exposure.
+	// Unknown experiments return the control variant without recording anything.
+	GetVariant(ctx context.Context, key string, guildID, discordID int64) (entities.ExperimentVariant, error)
+
+	// IsInTreatment reports whether a user should see the experimental feature
+	IsInTreatment(ctx context.Context, key string, guildID, discordID int64) (bool, error)
+
+	// UpdateRollout sets the percentage of guilds or users that receive the treatment
+	UpdateRollout(ctx context.Context, key string, rolloutPercent int, enabled bool) error
+
+	// GetReport compares activity metrics between the variants of an experiment
+	GetReport(ctx context.Context, key string) ([]*entities.ExperimentVariantReport, error)
+}
+
+// BalanceFreezeService manages moderator freezes that block a user's balance-affecting actions
+type BalanceFreezeService interface {
+	// Freeze blocks the user's bets, transfers, lottery purchases and other balance-affecting actions for duration,
+	// or until a moderator lifts it when duration is 0. An open freeze is replaced.
+	Freeze(ctx context.Context, guildID, discordID, moderatorID int64, reason string, duration time.Duration) (*entities.BalanceFreeze, error)
+
+	// Unfreeze lifts the user's active freeze, returning ErrBalanceNotFrozen if there is none
+	Unfreeze(ctx context.Context, discordID, moderatorID int64) (*entities.BalanceFreeze, error)
+
+	// ExpireFreeze lifts a freeze that has run out, recording it as expired. Returns nil if the freeze
+	// is still active or was already lifted.
+	ExpireFreeze(ctx context.Context, freezeID int64) (*entities.BalanceFreeze, error)
+}
+
+// SavingsService manages bits locked for a fixed term in exchange for a bonus at maturity
+type SavingsService interface {
+	// Deposit locks part of a user's available balance for the given number of weeks
+	Deposit(ctx context.Context, discordID, guildID int64, amount int64, termWeeks int) (*entities.SavingsDeposit, error)
+
+	// Withdraw releases a deposit. Deposits withdrawn before maturity forfeit their bonus,
+	// deposits that already matured are paid out as if the scheduler had processed them.
+	Withdraw(ctx context.Context, discordID int64, depositID int64) (*entities.SavingsDeposit, error)
+
+	// GetLockedDeposits returns the user's locked deposits ordered by maturity
+	GetLockedDeposits(ctx context.Context, discordID int64) ([]*entities.SavingsDeposit, error)
+
+	// MatureDeposit releases a matured deposit and credits its bonus. Deposits that are no longer locked are skipped.
+	MatureDeposit(ctx context.Context, depositID int64) (*entities.SavingsDeposit, error)
+}
+
+// BalanceHistoryRollupService maintains the daily balance history rollups across every guild and
+// archives raw entries once they are past the retention period
+type BalanceHistoryRollupService interface {
+	// RollUp aggregates the next batch of settled days and returns how many days it covered, 0 once it has caught up
+	RollUp(ctx context.Context, now time.Time) (int, error)
+
+	// ArchiveExpired moves up to limit raw entries older than retentionMonths into the archive and returns
+	// how many were moved. Only rolled up days are archived, and a retention of 0 disables archiving.
+	ArchiveExpired(ctx context.Context, now time.Time, retentionMonths, limit int) (int64, error)
+}
+
+// DataRetentionService removes stored data when the bot leaves a guild or a user asks for their data
+// to be deleted
+type DataRetentionService interface {
+	// PurgeGuild deletes all of a guild's data
+	PurgeGuild(ctx context.Context, guildID int64) (*entities.DataRetentionReport, error)
+
+	// DeleteUserData anonymizes the user's history shared with other users, such as ledger entries,
+	// wagers and lottery tickets, and deletes the rest of their data in every guild
+	DeleteUserData(ctx context.Context, discordID int64) (*entities.DataRetentionReport, error)
+}
+
+// SeasonTokenService manages the guild's seasonal secondary currency. Tokens are earned from wins,
+// spent on special wagers and cosmetics, and kept apart from bits in their own ledger.
+type SeasonTokenService interface {
+	// GetBalance returns the user's token balance for the guild's current season
+	GetBalance(ctx context.Context, guildID, discordID int64) (*entities.SeasonTokenBalance, error)
+
+	// Credit adds tokens to the user's current season balance and records the movement
+	Credit(ctx context.Context, guildID, discordID int64, amount int64, metadata map[string]interface{}) (*entities.SeasonTokenHistory, error)
+
+	// Debit spends tokens from the user's current season balance and records the movement.
+	// Returns entities.ErrInsufficientSeasonTokens if the balance is too small.
+	Debit(ctx context.Context, guildID, discordID int64, amount int64, metadata map[string]interface{}) (*entities.SeasonTokenHistory, error)
+
+	// EarnFromWin credits the tokens earned by a win of bitsWon. Returns nil when the guild has no
+	// season tokens or the win is too small to earn any.
+	EarnFromWin(ctx context.Context, guildID, discordID int64, bitsWon int64, source entities.TransactionType) (*entities.SeasonTokenHistory, error)
+
+	// GetHistory returns the user's most recent token movements in the current season, newest first
+	GetHistory(ctx context.Context, guildID, discordID int64, limit int) ([]*entities.SeasonTokenHistory, error)
+
+	// StartNewSeason closes the current season; balances start again from zero. Returns the new season number.
+	StartNewSeason(ctx context.Context, guildID int64) (int, error)
+}
+
+// AchievementService unlocks achievements as users play and lists the badges they have earned
+type AchievementService interface {
+	// RecordBalanceChange advances the user's achievement progress from a balance change and unlocks
+	// any achievements it completes. Returns the newly unlocked achievements.
+	RecordBalanceChange(ctx context.Context, discordID int64, transactionType entities.TransactionType) ([]*entities.Achievement, error)
+
+	// GetAchievements returns the user's unlocked achievements, oldest first
+	GetAchievements(ctx context.Context, discordID int64) ([]*entities.Achievement, error)
+
+	// GetAchievementsForUsers returns the unlocked achievements of several users, keyed by Discord ID
+	GetAchievementsForUsers(ctx context.Context, discordIDs []int64) (map[int64][]*entities.Achievement, error)
+}
+
+// ExportService generates downloadable exports of guild economy data
+type ExportService interface {
+	// Export streams the dataset for the guild within [from, to) to w in the given format.
+	// Returns the number of data rows written.
+	Export(ctx context.Context, guildID int64, dataset entities.ExportDataset, format entities.ExportFormat, from, to time.Time, w io.Writer) (int, error)
+}
+
+// WeeklyDigestService compiles the weekly economy digest of a guild
+type WeeklyDigestService interface {
+	// BuildDigest summarises the guild's betting volume, biggest wins and losses, lottery draws and
+	// high roller changes within [from, to), along with the guild's all-time top predictors
+	BuildDigest(ctx context.Context, guildID int64, from, to time.Time) (*entities.WeeklyDigest, error)
+}
+
+// AdminService provides guild administration operations used by operators
+type AdminService interface {
+	// ReverseTransaction undoes a balance history entry by applying a compensating entry of the opposite
+	// amount and linking the two. Returns the compensating entry.
+	ReverseTransaction(ctx context.Context, balanceHistoryID int64) (*entities.BalanceHistory, error)
+
+	// BulkAdjustBalances applies every adjustment to the guild's balances, recording each change with its
+	// reason. It stops at the first adjustment that can't be applied, so the caller should roll back on error
+	// to keep the batch atomic. A dry run reports the resulting balances without changing anything.
+	BulkAdjustBalances(ctx context.Context, guildID int64, adjustments []entities.BulkBalanceAdjustment, dryRun bool) (*entities.BulkBalanceAdjustmentReport, error)
+}
+
+// SnapshotService takes point-in-time copies of a guild's economy so operators can roll the guild back after incidents
+type SnapshotService interface {
+	// CreateSnapshot stores the guild's balances, open group wagers and open lottery draw
+	CreateSnapshot(ctx context.Context, guildID int64, reason string) (*entities.GuildSnapshot, error)
+
+	// GetRecentSnapshots returns the guild's most recent snapshots, newest first
+	GetRecentSnapshots(ctx context.Context, limit int) ([]*entities.GuildSnapshot, error)
+
+	// RestoreSnapshot sets every balance in the snapshot back to its snapshotted amount and restores the pot of
+	// the snapshot's lottery draw if it is still open. Group wagers are not reopened or reverted; the report lists
+	// those that changed state since the snapshot for review. The guild is snapshotted first so the restore can
+	// itself be undone. The caller should roll back on error to keep the restore atomic. A dry run reports the
+	// changes without making them.
+	RestoreSnapshot(ctx context.Context, guildID, snapshotID int64, dryRun bool) (*entities.GuildSnapshotRestoreReport, error)
+}
+
+// ParlayService manages multi-leg tickets combining picks on several house wagers
+type ParlayService interface {
+	// PlaceParlay reserves the stake from the user's available balance and creates a parlay,
+	// locking in each selected option's current odds
+	PlaceParlay(ctx context.Context, discordID, guildID int64, amount int64, selections []entities.ParlayLegSelection) (*entities.Parlay, error)
+
+	// SettleParlaysForWager settles the legs on a resolved or cancelled group wager and pays out or
+	// collects every parlay whose legs have all settled. Returns the parlays that were fully settled.
+	SettleParlaysForWager(ctx context.Context, groupWagerID int64) ([]*entities.Parlay, error)
+
+	// GetUserParlays returns the user's most recent parlays, newest first
+	GetUserParlays(ctx context.Context, discordID int64, limit int) ([]*entities.Parlay, error)
+}
+
+// GuildResolverService manages the users and roles allowed to resolve group wagers in a guild
+type GuildResolverService interface {
+	// AddResolver grants a user or role resolver permission. Returns false if the grant already existed.
+	AddResolver(ctx context.Context, guildID int64, resolverType entities.GuildResolverType, targetID, addedBy int64) (bool, error)
+
+	// RemoveResolver revokes a user or role grant. Returns false if there was no such grant.
+	RemoveResolver(ctx context.Context, resolverType entities.GuildResolverType, targetID int64) (bool, error)
+
+	// ListResolvers returns the guild's resolver grants, users before roles
+	ListResolvers(ctx context.Context) ([]*entities.GuildResolver, error)
+}
+
+// FeatureFlagService manages which features are turned on in a guild
+type FeatureFlagService interface {
+	// GetFlags returns the guild's feature flags, with defaults for features it hasn't set
+	GetFlags(ctx context.Context) (entities.FeatureFlags, error)
+
+	// IsEnabled reports whether a feature is turned on in the guild
+	IsEnabled(ctx context.Context, feature entities.Feature) (bool, error)
+
+	// SetEnabled turns a feature on or off in the guild. Returns false if it was already in that state.
+	SetEnabled(ctx context.Context, guildID int64, feature entities.Feature, enabled bool, updatedBy int64) (bool, error)
+}
+
+// WebhookSender posts a delivery's signed payload to its webhook
+type WebhookSender interface {
+	// Send posts the delivery and returns the response status code, or 0 with an error when no response was received
+	Send(ctx context.Context, webhook *entities.GuildWebhook, delivery *entities.WebhookDelivery) (int, error)
+}
+
+// WebhookService manages a guild's outbound webhooks and the deliveries queued for them
+type WebhookService interface {
+	// Register adds a webhook receiving the given events, generating the secret its payloads are signed with
+	Register(ctx context.Context, guildID int64, url string, eventTypes []entities.WebhookEventType, createdBy int64) (*entities.GuildWebhook, error)
+
+	// Remove deletes a webhook and its deliveries. Returns false if there was no such webhook.
+	Remove(ctx context.Context, webhookID int64) (bool, error)
+
+	// List returns the guild's webhooks, oldest first
+	List(ctx context.Context) ([]*entities.GuildWebhook, error)
+
+	// Enqueue queues the event data for every guild webhook subscribed to the event type and returns how many were queued
+	Enqueue(ctx context.Context, guildID int64, eventType entities.WebhookEventType, data any) (int, error)
+
+	// SendTest queues a test payload for a single webhook
+	SendTest(ctx context.Context, guildID, webhookID, requestedBy int64) (*entities.WebhookDelivery, error)
+
+	// GetRecentDeliveries returns a webhook's most recent deliveries, newest first
+	GetRecentDeliveries(ctx context.Context, webhookID int64, limit int) ([]*entities.WebhookDelivery, error)
+
+	// Deliver sends a pending delivery and records the outcome, scheduling a retry with backoff on failure.
+	// Returns nil if the delivery no longer exists or is no longer pending.
+	Deliver(ctx context.Context, deliveryID int64, sender WebhookSender) (*entities.WebhookDelivery, error)
+}
+
+// DiscordOutboxExecutor performs an outbox message's action against Discord
+type DiscordOutboxExecutor interface {
+	// Execute posts, edits or pins the message. Edits and pins of a group wager's message are given its message ID.
+	Execute(ctx context.Context, message *entities.DiscordOutboxMessage) (*entities.DiscordOutboxResult, error)
+}
+
+// DiscordOutboxService queues Discord side effects within a transaction and sends them once it commits
+type DiscordOutboxService interface {
+	// Enqueue queues a message that is due immediately. A pending message with the same dedup key takes its payload instead.
+	Enqueue(ctx context.Context, message *entities.DiscordOutboxMessage) error
+
+	// Dispatch sends a pending message and records the outcome, scheduling a retry with backoff on failure.
+	// A post for a group wager records the posted message on the wager.
+	// Returns nil if the message no longer exists or is no longer pending.
+	Dispatch(ctx context.Context, messageID int64, executor DiscordOutboxExecutor) (*entities.DiscordOutboxMessage, error)
+}
+
+// GroupWagerFollowService manages the users following a group wager to hear about its progress
+type GroupWagerFollowService interface {
+	// ToggleFollow follows the wager, or unfollows it if the user already follows it.
+	// Returns whether the user now follows the wager.
+	ToggleFollow(ctx context.Context, groupWagerID, discordID int64) (bool, error)
+
+	// GetFollowers returns the Discord IDs following the wager
+	GetFollowers(ctx context.Context, groupWagerID int64) ([]int64, error)
+}
+
+// DuelService manages heads-or-tails duels between two users
+type DuelService interface {
+	// Challenge creates a duel, holding the challenger's stake until the target responds or the challenge lapses.
+	// bestOf must be 1 or 3.
+	Challenge(ctx context.Context, challengerID, targetID, guildID int64, amount int64, bestOf int) (*entities.Duel, error)
+
+	// Accept flips the coin for a pending duel and transfers the stake from the loser to the winner.
+	// Only the target can accept.
+	Accept(ctx context.Context, duelID, responderID int64) (*entities.Duel, error)
+
+	// Decline closes a pending duel without a flip. The target declines it, the challenger cancels it.
+	Decline(ctx context.Context, duelID, responderID int64) (*entities.Duel, error)
+}
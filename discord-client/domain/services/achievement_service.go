@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// achievementService implements business logic for achievements and badges
+type achievementService struct {
+	achievementRepo interfaces.AchievementRepository
+	userStatsRepo   interfaces.UserStatsRepository
+}
+
+// NewAchievementService creates a new achievement service
+func NewAchievementService(
+	achievementRepo interfaces.AchievementRepository,
+	userStatsRepo interfaces.UserStatsRepository,
+) interfaces.AchievementService {
+	return &achievementService{
+		achievementRepo: achievementRepo,
+		userStatsRepo:   userStatsRepo,
+	}
+}
+
+// RecordBalanceChange advances the user's achievement progress from a balance change and unlocks
+// any achievements it completes. Returns the newly unlocked achievements.
+func (s *achievementService) RecordBalanceChange(ctx context.Context, discordID int64, transactionType entities.TransactionType) ([]*entities.Achievement, error) {
+	var earned []entities.AchievementKey
+
+	switch {
+	case transactionType == entities.TransactionTypeLottoWin:
+		earned = append(earned, entities.AchievementLotteryWinner)
+
+	case transactionType.IsGamblingRelated():
+		won := transactionType.IsWinType()
+		progress, err := s.achievementRepo.RecordResult(ctx, discordID, won)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record result: %w", err)
+		}
+		if won {
+			earned = append(earned, entities.AchievementFirstWin)
+		}
+		if progress.CurrentWinStreak >= entities.AchievementWinStreakLength {
+			earned = append(earned, entities.AchievementWinStreak10)
+		}
+
+		// Bits wagered come from the cached stats of bets and head-to-head wagers
+		stats, err := s.userStatsRepo.GetByDiscordID(ctx, discordID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user stats: %w", err)
+		}
+		if stats.Bets.TotalWagered+stats.Wagers.TotalAmount >= entities.AchievementWageredAmount {
+			earned = append(earned, entities.AchievementBitsWagered1M)
+		}
+
+	default:
+		return nil, nil
+	}
+
+	var unlocked []*entities.Achievement
+	for _, key := range earned {
+		isNew, err := s.achievementRepo.Unlock(ctx, discordID, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unlock achievement: %w", err)
+		}
+		if isNew {
+			unlocked = append(unlocked, &entities.Achievement{DiscordID: discordID, Key: key})
+		}
+	}
+
+	return unlocked, nil
+}
+
+// GetAchievements returns the user's unlocked achievements, oldest first
+func (s *achievementService) GetAchievements(ctx context.Context, discordID int64) ([]*entities.Achievement, error) {
+	achievements, err := s.achievementRepo.GetByDiscordID(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get achievements: %w", err)
+	}
+	return achievements, nil
+}
+
+// GetAchievementsForUsers returns the unlocked achievements of several users, keyed by Discord ID
+func (s *achievementService) GetAchievementsForUsers(ctx context.Context, discordIDs []int64) (map[int64][]*entities.Achievement, error) {
+	achievements, err := s.achievementRepo.GetByDiscordIDs(ctx, discordIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get achievements: %w", err)
+	}
+	return achievements, nil
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAchievementService_RecordBalanceChange(t *testing.T) {
+	t.Parallel()
+
+	const discordID = int64(123)
+
+	t.Run("first win unlocks first win", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		achievementRepo := new(testhelpers.MockAchievementRepository)
+		statsRepo := new(testhelpers.MockUserStatsRepository)
+		achievementRepo.On("RecordResult", ctx, discordID, true).
+			Return(&entities.AchievementProgress{CurrentWinStreak: 1, BestWinStreak: 1}, nil)
+		statsRepo.On("GetByDiscordID", ctx, discordID).
+			Return(&entities.CachedUserStats{Bets: entities.BetStats{TotalWagered: 500}}, nil)
+		achievementRepo.On("Unlock", ctx, discordID, entities.AchievementFirstWin).Return(true, nil)
+
+		unlocked, err := NewAchievementService(achievementRepo, statsRepo).
+			RecordBalanceChange(ctx, discordID, entities.TransactionTypeBetWin)
+
+		require.NoError(t, err)
+		require.Len(t, unlocked, 1)
+		assert.Equal(t, entities.AchievementFirstWin, unlocked[0].Key)
+		achievementRepo.AssertExpectations(t)
+	})
+
+	t.Run("tenth win in a row and 1M wagered unlock together", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		achievementRepo := new(testhelpers.MockAchievementRepository)
+		statsRepo := new(testhelpers.MockUserStatsRepository)
+		achievementRepo.On("RecordResult", ctx, discordID, true).
+			Return(&entities.AchievementProgress{CurrentWinStreak: 10, BestWinStreak: 10}, nil)
+		statsRepo.On("GetByDiscordID", ctx, discordID).Return(&entities.CachedUserStats{
+			Bets:   entities.BetStats{TotalWagered: 600_000},
+			Wagers: entities.WagerStats{TotalAmount: 400_000},
+		}, nil)
+		achievementRepo.On("Unlock", ctx, discordID, entities.AchievementFirstWin).Return(false, nil)
+		achievementRepo.On("Unlock", ctx, discordID, entities.AchievementWinStreak10).Return(true, nil)
+		achievementRepo.On("Unlock", ctx, discordID, entities.AchievementBitsWagered1M).Return(true, nil)
+
+		unlocked, err := NewAchievementService(achievementRepo, statsRepo).
+			RecordBalanceChange(ctx, discordID, entities.TransactionTypeGroupWagerWin)
+
+		require.NoError(t, err)
+		require.Len(t, unlocked, 2)
+		assert.Equal(t, entities.AchievementWinStreak10, unlocked[0].Key)
+		assert.Equal(t, entities.AchievementBitsWagered1M, unlocked[1].Key)
+		achievementRepo.AssertExpectations(t)
+	})
+
+	t.Run("loss resets the streak without unlocking", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		achievementRepo := new(testhelpers.MockAchievementRepository)
+		statsRepo := new(testhelpers.MockUserStatsRepository)
+		achievementRepo.On("RecordResult", ctx, discordID, false).
+			Return(&entities.AchievementProgress{BestWinStreak: 4}, nil)
+		statsRepo.On("GetByDiscordID", ctx, discordID).Return(&entities.CachedUserStats{}, nil)
+
+		unlocked, err := NewAchievementService(achievementRepo, statsRepo).
+			RecordBalanceChange(ctx, discordID, entities.TransactionTypeWagerLoss)
+
+		require.NoError(t, err)
+		assert.Empty(t, unlocked)
+		achievementRepo.AssertNotCalled(t, "Unlock", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("lottery win unlocks lottery winner", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		achievementRepo := new(testhelpers.MockAchievementRepository)
+		statsRepo := new(testhelpers.MockUserStatsRepository)
+		achievementRepo.On("Unlock", ctx, discordID, entities.AchievementLotteryWinner).Return(true, nil)
+
+		unlocked, err := NewAchievementService(achievementRepo, statsRepo).
+			RecordBalanceChange(ctx, discordID, entities.TransactionTypeLottoWin)
+
+		require.NoError(t, err)
+		require.Len(t, unlocked, 1)
+		achievementRepo.AssertNotCalled(t, "RecordResult", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("other transactions are ignored", func(t *testing.T) {
+		t.Parallel()
+
+		achievementRepo := new(testhelpers.MockAchievementRepository)
+		statsRepo := new(testhelpers.MockUserStatsRepository)
+
+		unlocked, err := NewAchievementService(achievementRepo, statsRepo).
+			RecordBalanceChange(context.Background(), discordID, entities.TransactionTypeTransferIn)
+
+		require.NoError(t, err)
+		assert.Empty(t, unlocked)
+		achievementRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+	"gambler/discord-client/domain/utils"
+)
+
+// adminService implements operator tooling for correcting guild economy data
+type adminService struct {
+	userRepo           interfaces.UserRepository
+	balanceHistoryRepo interfaces.BalanceHistoryRepository
+	eventPublisher     interfaces.EventPublisher
+}
+
+// NewAdminService creates a new admin service
+func NewAdminService(
+	userRepo interfaces.UserRepository,
+	balanceHistoryRepo interfaces.BalanceHistoryRepository,
+	eventPublisher interfaces.EventPublisher,
+) interfaces.AdminService {
+	return &adminService{
+		userRepo:           userRepo,
+		balanceHistoryRepo: balanceHistoryRepo,
+		eventPublisher:     eventPublisher,
+	}
+}
+
+// ReverseTransaction undoes a balance history entry with a linked compensating entry
+func (s *adminService) ReverseTransaction(ctx context.Context, balanceHistoryID int64) (*entities.BalanceHistory, error) {
+	original, err := s.balanceHistoryRepo.GetByIDForUpdate(ctx, balanceHistoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance history: %w", err)
+	}
+	if original == nil {
+		return nil, entities.ErrBalanceHistoryNotFound
+	}
+	if err := original.CanReverse(); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetByDiscordID(ctx, original.DiscordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, entities.ErrUserNotFound
+	}
+
+	change := -original.ChangeAmount
+	// Bits reserved in open wagers cannot be clawed back
+	if change < 0 && user.AvailableBalance < -change {
+		return nil, fmt.Errorf("%w to reverse: have %d available, need %d", entities.ErrInsufficientBalance, user.AvailableBalance, -change)
+	}
+
+	newBalance := user.Balance + change
+	if err := s.userRepo.UpdateBalance(ctx, user.DiscordID, newBalance); err != nil {
+		return nil, fmt.Errorf("failed to update balance: %w", err)
+	}
+
+	reversal := &entities.BalanceHistory{
+		DiscordID:       original.DiscordID,
+		GuildID:         original.GuildID,
+		BalanceBefore:   user.Balance,
+		BalanceAfter:    newBalance,
+		ChangeAmount:    change,
+		TransactionType: entities.AdjustmentTransactionType(change),
+		TransactionMetadata: map[string]interface{}{
+			"admin":       "true",
+			"reason":      "reversal",
+			"reversal_of": original.ID,
+		},
+		ReversalOfID: &original.ID,
+	}
+	if err := utils.RecordBalanceChange(ctx, s.balanceHistoryRepo, s.eventPublisher, reversal); err != nil {
+		return nil, fmt.Errorf("failed to record reversal: %w", err)
+	}
+
+	if err := s.balanceHistoryRepo.MarkReversed(ctx, original.ID, reversal.ID); err != nil {
+		return nil, fmt.Errorf("failed to link reversal: %w", err)
+	}
+
+	return reversal, nil
+}
+
+// BulkAdjustBalances applies the adjustments to the guild's balances, or reports what they would do in a dry run
+func (s *adminService) BulkAdjustBalances(ctx context.Context, guildID int64, adjustments []entities.BulkBalanceAdjustment, dryRun bool) (*entities.BulkBalanceAdjustmentReport, error) {
+	report := &entities.BulkBalanceAdjustmentReport{
+		GuildID: guildID,
+		DryRun:  dryRun,
+		Results: make([]entities.BulkBalanceAdjustmentResult, 0, len(adjustments)),
+	}
+
+	for _, adjustment := range adjustments {
+		user, err := s.userRepo.GetByDiscordID(ctx, adjustment.DiscordID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user %d: %w", adjustment.DiscordID, err)
+		}
+		if user == nil {
+			return nil, fmt.Errorf("line %d: user %d not found", adjustment.Line, adjustment.DiscordID)
+		}
+
+		newBalance := adjustment.NewBalance(user.Balance)
+		change := newBalance - user.Balance
+		if newBalance < 0 {
+			return nil, fmt.Errorf("line %d: user %d would have a negative balance of %d", adjustment.Line, user.DiscordID, newBalance)
+		}
+		// Bits reserved in open wagers cannot be taken away
+		if change < 0 && user.AvailableBalance < -change {
+			return nil, fmt.Errorf("line %d: user %d has %d available, need %d", adjustment.Line, user.DiscordID, user.AvailableBalance, -change)
+		}
+
+		report.Results = append(report.Results, entities.BulkBalanceAdjustmentResult{
+			DiscordID:     user.DiscordID,
+			BalanceBefore: user.Balance,
+			BalanceAfter:  newBalance,
+			ChangeAmount:  change,
+			Reason:        adjustment.Reason,
+		})
+		report.TotalChange += change
+
+		if dryRun || change == 0 {
+			continue
+		}
+
+		if err := s.userRepo.UpdateBalance(ctx, user.DiscordID, newBalance); err != nil {
+			return nil, fmt.Errorf("failed to update balance for user %d: %w", user.DiscordID, err)
+		}
+
+		history := &entities.BalanceHistory{
+			DiscordID:       user.DiscordID,
+			GuildID:         guildID,
+			BalanceBefore:   user.Balance,
+			BalanceAfter:    newBalance,
+			ChangeAmount:    change,
+			TransactionType: entities.AdjustmentTransactionType(change),
+			TransactionMetadata: map[string]interface{}{
+				"admin":  "true",
+				"source": "bulk_adjustment",
+				"reason": adjustment.Reason,
+			},
+		}
+		if err := utils.RecordBalanceChange(ctx, s.balanceHistoryRepo, s.eventPublisher, history); err != nil {
+			return nil, fmt.Errorf("failed to record adjustment for user %d: %w", user.DiscordID, err)
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminService_ReverseTransaction(t *testing.T) {
+	ctx := context.Background()
+	guildID := int64(123456789)
+	discordID := int64(111)
+
+	newService := func() (*testhelpers.MockUserRepository, *testhelpers.MockBalanceHistoryRepository, *testhelpers.MockEventPublisher, *adminService) {
+		userRepo := new(testhelpers.MockUserRepository)
+		balanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+		eventPublisher := new(testhelpers.MockEventPublisher)
+		return userRepo, balanceHistoryRepo, eventPublisher, NewAdminService(userRepo, balanceHistoryRepo, eventPublisher).(*adminService)
+	}
+
+	t.Run("reverses a credit", func(t *testing.T) {
+		userRepo, balanceHistoryRepo, eventPublisher, service := newService()
+
+		balanceHistoryRepo.On("GetByIDForUpdate", ctx, int64(42)).Return(&entities.BalanceHistory{
+			ID: 42, DiscordID: discordID, GuildID: guildID, ChangeAmount: 5000, TransactionType: entities.TransactionTypeTransferIn,
+		}, nil)
+		userRepo.On("GetByDiscordID", ctx, discordID).Return(&entities.User{DiscordID: discordID, Balance: 8000, AvailableBalance: 8000}, nil)
+		userRepo.On("UpdateBalance", ctx, discordID, int64(3000)).Return(nil)
+		balanceHistoryRepo.On("Record", ctx, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+			return h.ChangeAmount == -5000 &&
+				h.BalanceBefore == 8000 && h.BalanceAfter == 3000 &&
+				h.TransactionType == entities.TransactionTypeTransferOut &&
+				h.ReversalOfID != nil && *h.ReversalOfID == 42
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*entities.BalanceHistory).ID = 43
+		}).Return(nil)
+		eventPublisher.On("Publish", mock.Anything).Return(nil)
+		balanceHistoryRepo.On("MarkReversed", ctx, int64(42), int64(43)).Return(nil)
+
+		reversal, err := service.ReverseTransaction(ctx, 42)
+		require.NoError(t, err)
+		assert.Equal(t, int64(43), reversal.ID)
+		assert.True(t, reversal.IsReversal())
+
+		userRepo.AssertExpectations(t)
+		balanceHistoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("reverses a debit", func(t *testing.T) {
+		userRepo, balanceHistoryRepo, eventPublisher, service := newService()
+
+		balanceHistoryRepo.On("GetByIDForUpdate", ctx, int64(42)).Return(&entities.BalanceHistory{
+			ID: 42, DiscordID: discordID, GuildID: guildID, ChangeAmount: -700, TransactionType: entities.TransactionTypeBetLoss,
+		}, nil)
+		userRepo.On("GetByDiscordID", ctx, discordID).Return(&entities.User{DiscordID: discordID, Balance: 100}, nil)
+		userRepo.On("UpdateBalance", ctx, discordID, int64(800)).Return(nil)
+		balanceHistoryRepo.On("Record", ctx, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+			return h.ChangeAmount == 700 && h.TransactionType == entities.TransactionTypeTransferIn
+		})).Return(nil)
+		eventPublisher.On("Publish", mock.Anything).Return(nil)
+		balanceHistoryRepo.On("MarkReversed", ctx, int64(42), mock.Anything).Return(nil)
+
+		_, err := service.ReverseTransaction(ctx, 42)
+		require.NoError(t, err)
+		userRepo.AssertExpectations(t)
+	})
+
+	t.Run("insufficient available balance", func(t *testing.T) {
+		userRepo, balanceHistoryRepo, _, service := newService()
+
+		balanceHistoryRepo.On("GetByIDForUpdate", ctx, int64(42)).Return(&entities.BalanceHistory{
+			ID: 42, DiscordID: discordID, GuildID: guildID, ChangeAmount: 5000,
+		}, nil)
+		userRepo.On("GetByDiscordID", ctx, discordID).Return(&entities.User{DiscordID: discordID, Balance: 6000, AvailableBalance: 1000}, nil)
+
+		_, err := service.ReverseTransaction(ctx, 42)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "insufficient balance")
+		userRepo.AssertNotCalled(t, "UpdateBalance", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("entry not found", func(t *testing.T) {
+		_, balanceHistoryRepo, _, service := newService()
+		balanceHistoryRepo.On("GetByIDForUpdate", ctx, int64(42)).Return(nil, nil)
+
+		_, err := service.ReverseTransaction(ctx, 42)
+		assert.ErrorIs(t, err, entities.ErrBalanceHistoryNotFound)
+	})
+
+	t.Run("already reversed", func(t *testing.T) {
+		_, balanceHistoryRepo, _, service := newService()
+		reversedBy := int64(50)
+		balanceHistoryRepo.On("GetByIDForUpdate", ctx, int64(42)).Return(&entities.BalanceHistory{
+			ID: 42, DiscordID: discordID, ChangeAmount: 5000, ReversedByID: &reversedBy,
+		}, nil)
+
+		_, err := service.ReverseTransaction(ctx, 42)
+		assert.ErrorIs(t, err, entities.ErrTransactionAlreadyReversed)
+	})
+
+	t.Run("reversal entries cannot be reversed", func(t *testing.T) {
+		_, balanceHistoryRepo, _, service := newService()
+		reversalOf := int64(41)
+		balanceHistoryRepo.On("GetByIDForUpdate", ctx, int64(42)).Return(&entities.BalanceHistory{
+			ID: 42, DiscordID: discordID, ChangeAmount: -5000, ReversalOfID: &reversalOf,
+		}, nil)
+
+		_, err := service.ReverseTransaction(ctx, 42)
+		assert.ErrorIs(t, err, entities.ErrCannotReverseReversal)
+	})
+}
+
+func TestAdminService_BulkAdjustBalances(t *testing.T) {
+	ctx := context.Background()
+	guildID := int64(123456789)
+
+	newService := func() (*testhelpers.MockUserRepository, *testhelpers.MockBalanceHistoryRepository, *testhelpers.MockEventPublisher, *adminService) {
+		userRepo := new(testhelpers.MockUserRepository)
+		balanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+		eventPublisher := new(testhelpers.MockEventPublisher)
+		return userRepo, balanceHistoryRepo, eventPublisher, NewAdminService(userRepo, balanceHistoryRepo, eventPublisher).(*adminService)
+	}
+
+	adjustments := []entities.BulkBalanceAdjustment{
+		{Line: 1, DiscordID: 111, Mode: entities.BalanceAdjustmentDelta, Amount: 500, Reason: "prize"},
+		{Line: 2, DiscordID: 222, Mode: entities.BalanceAdjustmentAbsolute, Amount: 1000, Reason: "reset"},
+		{Line: 3, DiscordID: 333, Mode: entities.BalanceAdjustmentAbsolute, Amount: 700, Reason: "no-op"},
+	}
+	stubUsers := func(userRepo *testhelpers.MockUserRepository) {
+		userRepo.On("GetByDiscordID", ctx, int64(111)).Return(&entities.User{DiscordID: 111, Balance: 2000, AvailableBalance: 2000}, nil)
+		userRepo.On("GetByDiscordID", ctx, int64(222)).Return(&entities.User{DiscordID: 222, Balance: 4000, AvailableBalance: 4000}, nil)
+		userRepo.On("GetByDiscordID", ctx, int64(333)).Return(&entities.User{DiscordID: 333, Balance: 700, AvailableBalance: 700}, nil)
+	}
+
+	t.Run("applies each adjustment", func(t *testing.T) {
+		userRepo, balanceHistoryRepo, eventPublisher, service := newService()
+		stubUsers(userRepo)
+		userRepo.On("UpdateBalance", ctx, int64(111), int64(2500)).Return(nil)
+		userRepo.On("UpdateBalance", ctx, int64(222), int64(1000)).Return(nil)
+		balanceHistoryRepo.On("Record", ctx, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+			return h.DiscordID == 111 && h.ChangeAmount == 500 &&
+				h.TransactionType == entities.TransactionTypeTransferIn &&
+				h.TransactionMetadata["reason"] == "prize"
+		})).Return(nil)
+		balanceHistoryRepo.On("Record", ctx, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+			return h.DiscordID == 222 && h.ChangeAmount == -3000 && h.TransactionType == entities.TransactionTypeTransferOut
+		})).Return(nil)
+		eventPublisher.On("Publish", mock.Anything).Return(nil)
+
+		report, err := service.BulkAdjustBalances(ctx, guildID, adjustments, false)
+		require.NoError(t, err)
+		assert.False(t, report.DryRun)
+		assert.Equal(t, int64(-2500), report.TotalChange)
+		assert.Equal(t, 2, report.ChangedCount())
+		require.Len(t, report.Results, 3)
+		assert.Equal(t, int64(2500), report.Results[0].BalanceAfter)
+
+		userRepo.AssertExpectations(t)
+		balanceHistoryRepo.AssertExpectations(t)
+		userRepo.AssertNotCalled(t, "UpdateBalance", ctx, int64(333), mock.Anything)
+	})
+
+	t.Run("dry run changes nothing", func(t *testing.T) {
+		userRepo, balanceHistoryRepo, _, service := newService()
+		stubUsers(userRepo)
+
+		report, err := service.BulkAdjustBalances(ctx, guildID, adjustments, true)
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, int64(1000), report.Results[1].BalanceAfter)
+		userRepo.AssertNotCalled(t, "UpdateBalance")
+		balanceHistoryRepo.AssertNotCalled(t, "Record")
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		userRepo, _, _, service := newService()
+		userRepo.On("GetByDiscordID", ctx, int64(111)).Return(nil, nil)
+
+		_, err := service.BulkAdjustBalances(ctx, guildID, adjustments[:1], true)
+		assert.ErrorContains(t, err, "line 1: user 111 not found")
+	})
+
+	t.Run("negative balance", func(t *testing.T) {
+		userRepo, _, _, service := newService()
+		userRepo.On("GetByDiscordID", ctx, int64(111)).Return(&entities.User{DiscordID: 111, Balance: 100, AvailableBalance: 100}, nil)
+
+		_, err := service.BulkAdjustBalances(ctx, guildID, []entities.BulkBalanceAdjustment{
+			{Line: 4, DiscordID: 111, Mode: entities.BalanceAdjustmentDelta, Amount: -500, Reason: "penalty"},
+		}, true)
+		assert.ErrorContains(t, err, "negative balance")
+	})
+
+	t.Run("reserved bits cannot be taken", func(t *testing.T) {
+		userRepo, _, _, service := newService()
+		userRepo.On("GetByDiscordID", ctx, int64(111)).Return(&entities.User{DiscordID: 111, Balance: 1000, AvailableBalance: 200}, nil)
+
+		_, err := service.BulkAdjustBalances(ctx, guildID, []entities.BulkBalanceAdjustment{
+			{Line: 1, DiscordID: 111, Mode: entities.BalanceAdjustmentAbsolute, Amount: 500, Reason: "penalty"},
+		}, false)
+		assert.ErrorContains(t, err, "has 200 available, need 500")
+		userRepo.AssertNotCalled(t, "UpdateBalance")
+	})
+}
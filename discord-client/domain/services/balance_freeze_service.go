@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// balanceFreezeService implements moderator balance freezes
+type balanceFreezeService struct {
+	freezeRepo interfaces.BalanceFreezeRepository
+	userRepo   interfaces.UserRepository
+	now        func() time.Time
+}
+
+// NewBalanceFreezeService creates a new balance freeze service
+func NewBalanceFreezeService(
+	freezeRepo interfaces.BalanceFreezeRepository,
+	userRepo interfaces.UserRepository,
+) interfaces.BalanceFreezeService {
+	return &balanceFreezeService{
+		freezeRepo: freezeRepo,
+		userRepo:   userRepo,
+		now:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Freeze blocks the user's balance-affecting actions for duration, or until lifted when duration is 0.
+// An open freeze is lifted by the moderator and replaced so the log keeps both.
+func (s *balanceFreezeService) Freeze(ctx context.Context, guildID, discordID, moderatorID int64, reason string, duration time.Duration) (*entities.BalanceFreeze, error) {
+	reason = strings.TrimSpace(reason)
+	if len(reason) > entities.MaxBalanceFreezeReasonLength {
+		return nil, fmt.Errorf("reason cannot be longer than %d characters", entities.MaxBalanceFreezeReasonLength)
+	}
+	if duration < 0 || duration > entities.MaxBalanceFreezeDuration {
+		return nil, fmt.Errorf("freeze cannot be longer than %d days", int(entities.MaxBalanceFreezeDuration.Hours()/24))
+	}
+
+	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, entities.ErrUserNotFound
+	}
+
+	now := s.now()
+	if err := s.liftOpenFreeze(ctx, discordID, &moderatorID, now); err != nil {
+		return nil, err
+	}
+
+	freeze := &entities.BalanceFreeze{
+		GuildID:            guildID,
+		DiscordID:          discordID,
+		ModeratorDiscordID: moderatorID,
+		Reason:             reason,
+		CreatedAt:          now,
+	}
+	if duration > 0 {
+		expiresAt := now.Add(duration)
+		freeze.ExpiresAt = &expiresAt
+	}
+
+	if err := s.freezeRepo.Create(ctx, freeze); err != nil {
+		return nil, fmt.Errorf("failed to create balance freeze: %w", err)
+	}
+
+	return freeze, nil
+}
+
+// Unfreeze lifts the user's active freeze on behalf of a moderator
+func (s *balanceFreezeService) Unfreeze(ctx context.Context, discordID, moderatorID int64) (*entities.BalanceFreeze, error) {
+	freeze, err := s.freezeRepo.GetOpenByUserForUpdate(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance freeze: %w", err)
+	}
+
+	now := s.now()
+	if freeze == nil || !freeze.IsActive(now) {
+		return nil, entities.ErrBalanceNotFrozen
+	}
+
+	freeze.Lift(&moderatorID, now)
+	if err := s.freezeRepo.Update(ctx, freeze); err != nil {
+		return nil, fmt.Errorf("failed to lift balance freeze: %w", err)
+	}
+
+	return freeze, nil
+}
+
+// ExpireFreeze lifts a freeze that has run out. Freezes that are still active or already lifted are skipped.
+func (s *balanceFreezeService) ExpireFreeze(ctx context.Context, freezeID int64) (*entities.BalanceFreeze, error) {
+	// Lock the row so a moderator unfreezing at the same time can't lift it twice
+	freeze, err := s.freezeRepo.GetByIDForUpdate(ctx, freezeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance freeze: %w", err)
+	}
+	if freeze == nil || !freeze.HasExpired(s.now()) {
+		return nil, nil
+	}
+
+	freeze.Lift(nil, *freeze.ExpiresAt)
+	if err := s.freezeRepo.Update(ctx, freeze); err != nil {
+		return nil, fmt.Errorf("failed to expire balance freeze: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"guild":       freeze.GuildID,
+		"user":        freeze.DiscordID,
+		"moderator":   freeze.ModeratorDiscordID,
+		"freezeID":    freeze.ID,
+		"frozenSince": freeze.CreatedAt,
+	}).Info("Balance freeze expired")
+
+	return freeze, nil
+}
+
+// liftOpenFreeze ends the user's open freeze, if any, recording an expired one as expired
+func (s *balanceFreezeService) liftOpenFreeze(ctx context.Context, discordID int64, moderatorID *int64, now time.Time) error {
+	open, err := s.freezeRepo.GetOpenByUserForUpdate(ctx, discordID)
+	if err != nil {
+		return fmt.Errorf("failed to get balance freeze: %w", err)
+	}
+	if open == nil {
+		return nil
+	}
+
+	if open.HasExpired(now) {
+		open.Lift(nil, *open.ExpiresAt)
+	} else {
+		open.Lift(moderatorID, now)
+	}
+	if err := s.freezeRepo.Update(ctx, open); err != nil {
+		return fmt.Errorf("failed to lift balance freeze: %w", err)
+	}
+
+	return nil
+}
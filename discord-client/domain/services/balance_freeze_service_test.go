@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBalanceFreezeService(now time.Time) (*balanceFreezeService, *testhelpers.MockBalanceFreezeRepository, *testhelpers.MockUserRepository) {
+	freezeRepo := new(testhelpers.MockBalanceFreezeRepository)
+	userRepo := new(testhelpers.MockUserRepository)
+	service := &balanceFreezeService{
+		freezeRepo: freezeRepo,
+		userRepo:   userRepo,
+		now:        func() time.Time { return now },
+	}
+	return service, freezeRepo, userRepo
+}
+
+func TestBalanceFreezeService_Freeze(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	guildID, userID, moderatorID := int64(123), int64(456), int64(789)
+
+	t.Run("freezes for a duration", func(t *testing.T) {
+		service, freezeRepo, userRepo := newTestBalanceFreezeService(now)
+		userRepo.On("GetByDiscordID", ctx, userID).Return(&entities.User{DiscordID: userID}, nil)
+		freezeRepo.On("GetOpenByUserForUpdate", ctx, userID).Return(nil, nil)
+		freezeRepo.On("Create", ctx, mock.AnythingOfType("*entities.BalanceFreeze")).Return(nil)
+
+		freeze, err := service.Freeze(ctx, guildID, userID, moderatorID, "  alt account ", 48*time.Hour)
+
+		require.NoError(t, err)
+		assert.Equal(t, "alt account", freeze.Reason)
+		assert.Equal(t, moderatorID, freeze.ModeratorDiscordID)
+		require.NotNil(t, freeze.ExpiresAt)
+		assert.Equal(t, now.Add(48*time.Hour), *freeze.ExpiresAt)
+		freezeRepo.AssertExpectations(t)
+	})
+
+	t.Run("freezes until lifted without a duration", func(t *testing.T) {
+		service, freezeRepo, userRepo := newTestBalanceFreezeService(now)
+		userRepo.On("GetByDiscordID", ctx, userID).Return(&entities.User{DiscordID: userID}, nil)
+		freezeRepo.On("GetOpenByUserForUpdate", ctx, userID).Return(nil, nil)
+		freezeRepo.On("Create", ctx, mock.AnythingOfType("*entities.BalanceFreeze")).Return(nil)
+
+		freeze, err := service.Freeze(ctx, guildID, userID, moderatorID, "", 0)
+
+		require.NoError(t, err)
+		assert.Nil(t, freeze.ExpiresAt)
+	})
+
+	t.Run("replaces an open freeze, keeping it in the log", func(t *testing.T) {
+		service, freezeRepo, userRepo := newTestBalanceFreezeService(now)
+		existing := &entities.BalanceFreeze{ID: 1, GuildID: guildID, DiscordID: userID}
+		userRepo.On("GetByDiscordID", ctx, userID).Return(&entities.User{DiscordID: userID}, nil)
+		freezeRepo.On("GetOpenByUserForUpdate", ctx, userID).Return(existing, nil)
+		freezeRepo.On("Update", ctx, existing).Return(nil)
+		freezeRepo.On("Create", ctx, mock.AnythingOfType("*entities.BalanceFreeze")).Return(nil)
+
+		_, err := service.Freeze(ctx, guildID, userID, moderatorID, "", time.Hour)
+
+		require.NoError(t, err)
+		assert.Equal(t, now, *existing.UnfrozenAt)
+		assert.Equal(t, moderatorID, *existing.UnfrozenByDiscordID)
+	})
+
+	t.Run("rejects unknown users", func(t *testing.T) {
+		service, freezeRepo, userRepo := newTestBalanceFreezeService(now)
+		userRepo.On("GetByDiscordID", ctx, userID).Return(nil, nil)
+
+		_, err := service.Freeze(ctx, guildID, userID, moderatorID, "", 0)
+
+		assert.ErrorIs(t, err, entities.ErrUserNotFound)
+		freezeRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects freezes longer than the maximum", func(t *testing.T) {
+		service, _, _ := newTestBalanceFreezeService(now)
+
+		_, err := service.Freeze(ctx, guildID, userID, moderatorID, "", entities.MaxBalanceFreezeDuration+time.Hour)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestBalanceFreezeService_Unfreeze(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	userID, moderatorID := int64(456), int64(789)
+
+	t.Run("lifts an active freeze", func(t *testing.T) {
+		service, freezeRepo, _ := newTestBalanceFreezeService(now)
+		freeze := &entities.BalanceFreeze{ID: 1, DiscordID: userID}
+		freezeRepo.On("GetOpenByUserForUpdate", ctx, userID).Return(freeze, nil)
+		freezeRepo.On("Update", ctx, freeze).Return(nil)
+
+		lifted, err := service.Unfreeze(ctx, userID, moderatorID)
+
+		require.NoError(t, err)
+		assert.Equal(t, now, *lifted.UnfrozenAt)
+		assert.Equal(t, moderatorID, *lifted.UnfrozenByDiscordID)
+	})
+
+	t.Run("reports users who are not frozen", func(t *testing.T) {
+		service, freezeRepo, _ := newTestBalanceFreezeService(now)
+		expired := now.Add(-time.Minute)
+		freezeRepo.On("GetOpenByUserForUpdate", ctx, userID).Return(&entities.BalanceFreeze{ID: 1, ExpiresAt: &expired}, nil)
+
+		_, err := service.Unfreeze(ctx, userID, moderatorID)
+
+		assert.ErrorIs(t, err, entities.ErrBalanceNotFrozen)
+		freezeRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestBalanceFreezeService_ExpireFreeze(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("records an expired freeze as expired at its expiry", func(t *testing.T) {
+		service, freezeRepo, _ := newTestBalanceFreezeService(now)
+		expiresAt := now.Add(-time.Minute)
+		freeze := &entities.BalanceFreeze{ID: 1, ExpiresAt: &expiresAt}
+		freezeRepo.On("GetByIDForUpdate", ctx, int64(1)).Return(freeze, nil)
+		freezeRepo.On("Update", ctx, freeze).Return(nil)
+
+		expired, err := service.ExpireFreeze(ctx, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, expiresAt, *expired.UnfrozenAt)
+		assert.Nil(t, expired.UnfrozenByDiscordID)
+	})
+
+	t.Run("skips freezes lifted in the meantime", func(t *testing.T) {
+		service, freezeRepo, _ := newTestBalanceFreezeService(now)
+		expiresAt := now.Add(-time.Minute)
+		liftedAt := now.Add(-30 * time.Second)
+		freezeRepo.On("GetByIDForUpdate", ctx, int64(1)).Return(&entities.BalanceFreeze{ID: 1, ExpiresAt: &expiresAt, UnfrozenAt: &liftedAt}, nil)
+
+		expired, err := service.ExpireFreeze(ctx, 1)
+
+		require.NoError(t, err)
+		assert.Nil(t, expired)
+		freezeRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+	"gambler/discord-client/domain/interfaces"
+	"gambler/discord-client/domain/utils"
+)
+
+// BalanceGuard applies the guild's minimum balance floor, minimum bet and betting curfew, shared by every service that lets users spend bits on bets
+type BalanceGuard struct {
+	guildSettingsRepo interfaces.GuildSettingsRepository
+}
+
+// NewBalanceGuard creates a new balance guard
+func NewBalanceGuard(guildSettingsRepo interfaces.GuildSettingsRepository) *BalanceGuard {
+	return &BalanceGuard{guildSettingsRepo: guildSettingsRepo}
+}
+
+// CheckSpend returns ErrBelowBalanceFloor if spending amount would leave less than the guild's floor available
+func (g *BalanceGuard) CheckSpend(ctx context.Context, guildID, available, amount int64) error {
+	settings, err := g.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("failed to get guild settings: %w", err)
+	}
+
+	floor := settings.GetMinBalanceFloor()
+	if floor == 0 || available-amount >= floor {
+		return nil
+	}
+
+	spendable := max(available-floor, 0)
+	return fmt.Errorf("%w: the server keeps %s bits in reserve, so you can spend at most %s",
+		entities.ErrBelowBalanceFloor, utils.FormatShortNotation(floor), utils.FormatShortNotation(spendable))
+}
+
+// CheckMinBet returns ErrBelowMinBet if amount is smaller than the guild's minimum bet
+func (g *BalanceGuard) CheckMinBet(ctx context.Context, guildID, amount int64) error {
+	settings, err := g.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	return settings.CheckMinBet(amount)
+}
+
+// CheckBettingCurfew returns ErrBettingCurfewActive if the guild's curfew is in effect at now
+func (g *BalanceGuard) CheckBettingCurfew(ctx context.Context, guildID int64, now time.Time) error {
+	settings, err := g.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	return settings.CheckBettingCurfew(now)
+}
+
+// CheckBalanceChange returns a BalanceLowEvent if the change took the user's balance from at or above
+// the guild's floor to below it, so each drop is announced once rather than on every change below the floor
+func (g *BalanceGuard) CheckBalanceChange(ctx context.Context, change events.BalanceChangeEvent) (*events.BalanceLowEvent, error) {
+	if change.NewBalance >= change.OldBalance {
+		return nil, nil
+	}
+
+	settings, err := g.guildSettingsRepo.GetOrCreateGuildSettings(ctx, change.GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+
+	floor := settings.GetMinBalanceFloor()
+	if floor == 0 || change.OldBalance < floor || change.NewBalance >= floor {
+		return nil, nil
+	}
+
+	return &events.BalanceLowEvent{
+		UserID:  change.UserID,
+		GuildID: change.GuildID,
+		Balance: change.NewBalance,
+		Floor:   floor,
+	}, nil
+}
+
+// CheckNotFrozen returns ErrBalanceFrozen if a moderator has frozen the balance of the user taking an action or of
+// anyone else whose balance the action moves. Every service that moves bits in or out of a user's balance calls it
+// before doing so. The reason is only shown to the frozen user themselves.
+func CheckNotFrozen(now time.Time, actor *entities.User, counterparties ...*entities.User) error {
+	if err := actor.CheckBalanceFreeze(now); err != nil {
+		return err
+	}
+	for _, counterparty := range counterparties {
+		if counterparty.IsBalanceFrozen(now) {
+			return fmt.Errorf("the other player's %w", entities.ErrBalanceFrozen)
+		}
+	}
+	return nil
+}
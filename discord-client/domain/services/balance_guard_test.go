@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceGuard_CheckSpend(t *testing.T) {
+	t.Parallel()
+
+	floor := int64(1000)
+
+	tests := []struct {
+		name      string
+		floor     *int64
+		available int64
+		amount    int64
+		wantErr   bool
+	}{
+		{name: "no floor allows spending everything", available: 5000, amount: 5000},
+		{name: "spend leaving the floor allowed", floor: &floor, available: 5000, amount: 4000},
+		{name: "spend dipping below the floor rejected", floor: &floor, available: 5000, amount: 4001, wantErr: true},
+		{name: "already below the floor rejected", floor: &floor, available: 500, amount: 100, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			mockRepo.On("GetOrCreateGuildSettings", ctx, int64(123456789)).
+				Return(&entities.GuildSettings{GuildID: 123456789, MinBalanceFloor: tt.floor}, nil)
+
+			guard := NewBalanceGuard(mockRepo)
+			err := guard.CheckSpend(ctx, 123456789, tt.available, tt.amount)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, entities.ErrBelowBalanceFloor)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBalanceGuard_CheckBalanceChange(t *testing.T) {
+	t.Parallel()
+
+	floor := int64(1000)
+
+	tests := []struct {
+		name       string
+		floor      *int64
+		oldBalance int64
+		newBalance int64
+		wantEvent  bool
+	}{
+		{name: "crossing below the floor", floor: &floor, oldBalance: 1500, newBalance: 900, wantEvent: true},
+		{name: "dropping from exactly the floor", floor: &floor, oldBalance: 1000, newBalance: 999, wantEvent: true},
+		{name: "already below the floor", floor: &floor, oldBalance: 900, newBalance: 800},
+		{name: "staying above the floor", floor: &floor, oldBalance: 2000, newBalance: 1000},
+		{name: "no floor configured", oldBalance: 1500, newBalance: 900},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			mockRepo.On("GetOrCreateGuildSettings", ctx, int64(123456789)).
+				Return(&entities.GuildSettings{GuildID: 123456789, MinBalanceFloor: tt.floor}, nil)
+
+			guard := NewBalanceGuard(mockRepo)
+			event, err := guard.CheckBalanceChange(ctx, events.BalanceChangeEvent{
+				UserID:     42,
+				GuildID:    123456789,
+				OldBalance: tt.oldBalance,
+				NewBalance: tt.newBalance,
+			})
+			require.NoError(t, err)
+
+			if !tt.wantEvent {
+				assert.Nil(t, event)
+				return
+			}
+			require.NotNil(t, event)
+			assert.Equal(t, int64(42), event.UserID)
+			assert.Equal(t, tt.newBalance, event.Balance)
+			assert.Equal(t, floor, event.Floor)
+		})
+	}
+}
+
+func TestBalanceGuard_CheckBalanceChange_IgnoresIncreases(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := new(testhelpers.MockGuildSettingsRepository)
+	guard := NewBalanceGuard(mockRepo)
+
+	event, err := guard.CheckBalanceChange(context.Background(), events.BalanceChangeEvent{
+		UserID:     42,
+		GuildID:    123456789,
+		OldBalance: 500,
+		NewBalance: 1500,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, event)
+	mockRepo.AssertNotCalled(t, "GetOrCreateGuildSettings")
+}
+
+func TestCheckNotFrozen(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	free := &entities.User{DiscordID: 1}
+	frozen := &entities.User{DiscordID: 2, BalanceFreeze: &entities.BalanceFreeze{Reason: "chargeback"}}
+
+	assert.NoError(t, CheckNotFrozen(now, free, free))
+
+	err := CheckNotFrozen(now, frozen, free)
+	assert.ErrorIs(t, err, entities.ErrBalanceFrozen)
+	assert.Contains(t, err.Error(), "chargeback", "the frozen user is told why")
+
+	err = CheckNotFrozen(now, free, frozen)
+	assert.ErrorIs(t, err, entities.ErrBalanceFrozen)
+	assert.Equal(t, "the other player's balance is frozen by a moderator", err.Error(), "the other player's reason stays private")
+}
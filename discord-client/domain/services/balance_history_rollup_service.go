@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// balanceHistoryRollupService implements the BalanceHistoryRollupService interface
+type balanceHistoryRollupService struct {
+	rollupRepo interfaces.BalanceHistoryRollupRepository
+}
+
+// NewBalanceHistoryRollupService creates a new balance history rollup service
+func NewBalanceHistoryRollupService(rollupRepo interfaces.BalanceHistoryRollupRepository) interfaces.BalanceHistoryRollupService {
+	return &balanceHistoryRollupService{
+		rollupRepo: rollupRepo,
+	}
+}
+
+// RollUp aggregates the days after the last rollup up to the settled end of now, at most
+// BalanceHistoryRollupMaxDays at a time
+func (s *balanceHistoryRollupService) RollUp(ctx context.Context, now time.Time) (int, error) {
+	rolledUpThrough, err := s.rollupRepo.GetRolledUpThrough(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rollup state: %w", err)
+	}
+
+	// The first pass starts from the oldest entry rather than the beginning of time
+	var earliest *time.Time
+	if rolledUpThrough == nil {
+		earliest, err = s.rollupRepo.GetEarliestEntryTime(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get earliest balance history entry: %w", err)
+		}
+	}
+
+	from, to, ok := entities.NextBalanceHistoryRollup(rolledUpThrough, earliest, entities.BalanceHistoryRollupEnd(now))
+	if !ok {
+		return 0, nil
+	}
+
+	if _, err := s.rollupRepo.RollupDays(ctx, from, to); err != nil {
+		return 0, fmt.Errorf("failed to roll up balance history: %w", err)
+	}
+
+	return int(to.Sub(from).Hours() / 24), nil
+}
+
+// ArchiveExpired moves the oldest raw entries past the retention period into the archive
+func (s *balanceHistoryRollupService) ArchiveExpired(ctx context.Context, now time.Time, retentionMonths, limit int) (int64, error) {
+	if retentionMonths <= 0 {
+		return 0, nil
+	}
+
+	rolledUpThrough, err := s.rollupRepo.GetRolledUpThrough(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rollup state: %w", err)
+	}
+
+	// Raw entries must stay until their day is in the rollups, or they would drop out of stats
+	cutoff, ok := entities.BalanceHistoryArchiveCutoff(now, retentionMonths, rolledUpThrough)
+	if !ok {
+		return 0, nil
+	}
+
+	archived, err := s.rollupRepo.ArchiveEntriesBefore(ctx, cutoff, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to archive balance history: %w", err)
+	}
+
+	return archived, nil
+}
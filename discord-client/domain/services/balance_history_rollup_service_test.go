@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceHistoryRollupService_RollUp(t *testing.T) {
+	ctx := context.Background()
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+	now := time.Date(2024, 3, 10, 6, 0, 0, 0, time.UTC)
+
+	t.Run("continues from the watermark up to today", func(t *testing.T) {
+		rollupRepo := new(testhelpers.MockBalanceHistoryRollupRepository)
+		service := NewBalanceHistoryRollupService(rollupRepo)
+
+		through := day(7)
+		rollupRepo.On("GetRolledUpThrough", ctx).Return(&through, nil)
+		rollupRepo.On("RollupDays", ctx, day(7), day(10)).Return(int64(12), nil)
+
+		days, err := service.RollUp(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, days)
+		rollupRepo.AssertExpectations(t)
+	})
+
+	t.Run("first pass starts at the earliest entry", func(t *testing.T) {
+		rollupRepo := new(testhelpers.MockBalanceHistoryRollupRepository)
+		service := NewBalanceHistoryRollupService(rollupRepo)
+
+		earliest := time.Date(2024, 3, 2, 18, 45, 0, 0, time.UTC)
+		rollupRepo.On("GetRolledUpThrough", ctx).Return(nil, nil)
+		rollupRepo.On("GetEarliestEntryTime", ctx).Return(&earliest, nil)
+		rollupRepo.On("RollupDays", ctx, day(2), day(10)).Return(int64(40), nil)
+
+		days, err := service.RollUp(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 8, days)
+		rollupRepo.AssertExpectations(t)
+	})
+
+	t.Run("waits for the previous day to settle after midnight", func(t *testing.T) {
+		rollupRepo := new(testhelpers.MockBalanceHistoryRollupRepository)
+		service := NewBalanceHistoryRollupService(rollupRepo)
+
+		through := day(9)
+		rollupRepo.On("GetRolledUpThrough", ctx).Return(&through, nil)
+
+		days, err := service.RollUp(ctx, time.Date(2024, 3, 10, 0, 30, 0, 0, time.UTC))
+
+		require.NoError(t, err)
+		assert.Zero(t, days)
+		rollupRepo.AssertNotCalled(t, "RollupDays", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestBalanceHistoryRollupService_ArchiveExpired(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 9, 10, 6, 0, 0, 0, time.UTC)
+
+	t.Run("archives entries older than the retention period", func(t *testing.T) {
+		rollupRepo := new(testhelpers.MockBalanceHistoryRollupRepository)
+		service := NewBalanceHistoryRollupService(rollupRepo)
+
+		through := time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC)
+		rollupRepo.On("GetRolledUpThrough", ctx).Return(&through, nil)
+		rollupRepo.On("ArchiveEntriesBefore", ctx, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), 100).Return(int64(100), nil)
+
+		archived, err := service.ArchiveExpired(ctx, now, 6, 100)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), archived)
+		rollupRepo.AssertExpectations(t)
+	})
+
+	t.Run("keeps days that are not rolled up", func(t *testing.T) {
+		rollupRepo := new(testhelpers.MockBalanceHistoryRollupRepository)
+		service := NewBalanceHistoryRollupService(rollupRepo)
+
+		through := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+		rollupRepo.On("GetRolledUpThrough", ctx).Return(&through, nil)
+		rollupRepo.On("ArchiveEntriesBefore", ctx, through, 100).Return(int64(3), nil)
+
+		archived, err := service.ArchiveExpired(ctx, now, 6, 100)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), archived)
+		rollupRepo.AssertExpectations(t)
+	})
+
+	t.Run("nothing is archived before the first rollup", func(t *testing.T) {
+		rollupRepo := new(testhelpers.MockBalanceHistoryRollupRepository)
+		service := NewBalanceHistoryRollupService(rollupRepo)
+
+		rollupRepo.On("GetRolledUpThrough", ctx).Return(nil, nil)
+
+		archived, err := service.ArchiveExpired(ctx, now, 6, 100)
+
+		require.NoError(t, err)
+		assert.Zero(t, archived)
+		rollupRepo.AssertNotCalled(t, "ArchiveEntriesBefore", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("disabled retention", func(t *testing.T) {
+		rollupRepo := new(testhelpers.MockBalanceHistoryRollupRepository)
+		service := NewBalanceHistoryRollupService(rollupRepo)
+
+		archived, err := service.ArchiveExpired(ctx, now, 0, 100)
+
+		require.NoError(t, err)
+		assert.Zero(t, archived)
+		rollupRepo.AssertNotCalled(t, "GetRolledUpThrough", mock.Anything)
+	})
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"gambler/discord-client/domain/entities"
@@ -49,11 +50,11 @@ func (s *BalanceService) ValidateTransferParameters(params TransferParameters) e
 	}
 	
 	if params.Amount <= 0 {
-		return errors.New("transfer amount must be positive")
+		return fmt.Errorf("transfer %w", entities.ErrNonPositiveAmount)
 	}
 	
 	if params.FromAvailableBalance < params.Amount {
-		return errors.New("insufficient available balance for transfer")
+		return fmt.Errorf("%w for transfer", entities.ErrInsufficientBalance)
 	}
 	
 	return nil
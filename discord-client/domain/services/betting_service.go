@@ -2,6 +2,7 @@ package services
 
 import (
 	"errors"
+	"fmt"
 	"math/rand"
 
 	"gambler/discord-client/domain/entities"
@@ -39,11 +40,11 @@ func (s *BettingService) ValidateBetParameters(params BetParameters) error {
 	}
 	
 	if params.Amount <= 0 {
-		return errors.New("bet amount must be positive")
+		return fmt.Errorf("bet %w", entities.ErrNonPositiveAmount)
 	}
 	
 	if params.AvailableBalance < params.Amount {
-		return errors.New("insufficient available balance")
+		return entities.ErrInsufficientBalance
 	}
 	
 	return nil
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// dataRetentionService implements business logic for deleting stored guild and user data
+type dataRetentionService struct {
+	dataRetentionRepo interfaces.DataRetentionRepository
+}
+
+// NewDataRetentionService creates a new data retention service
+func NewDataRetentionService(dataRetentionRepo interfaces.DataRetentionRepository) interfaces.DataRetentionService {
+	return &dataRetentionService{dataRetentionRepo: dataRetentionRepo}
+}
+
+// PurgeGuild deletes all of a guild's data
+func (s *dataRetentionService) PurgeGuild(ctx context.Context, guildID int64) (*entities.DataRetentionReport, error) {
+	// Guild 0 holds data from before multi-guild support and is never a guild the bot leaves
+	if guildID <= 0 {
+		return nil, fmt.Errorf("invalid guild ID %d", guildID)
+	}
+
+	report, err := s.dataRetentionRepo.PurgeGuild(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge guild %d: %w", guildID, err)
+	}
+
+	return report, nil
+}
+
+// DeleteUserData anonymizes the user's history shared with other users and deletes the rest of
+// their data in every guild
+func (s *dataRetentionService) DeleteUserData(ctx context.Context, discordID int64) (*entities.DataRetentionReport, error) {
+	// Anonymized users have negative IDs, and deleting them again would only churn pseudonyms
+	if discordID <= 0 {
+		return nil, fmt.Errorf("invalid Discord ID %d", discordID)
+	}
+
+	report, err := s.dataRetentionRepo.AnonymizeUser(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete data for user %d: %w", discordID, err)
+	}
+	if report == nil {
+		return nil, fmt.Errorf("%w: %d", entities.ErrUserNotFound, discordID)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataRetentionService_PurgeGuild(t *testing.T) {
+	t.Parallel()
+
+	t.Run("purges the guild", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		repo := new(testhelpers.MockDataRetentionRepository)
+		report := entities.NewDataRetentionReport()
+		report.Deleted["user_guild_accounts"] = 3
+		repo.On("PurgeGuild", ctx, int64(42)).Return(report, nil)
+
+		result, err := NewDataRetentionService(repo).PurgeGuild(ctx, 42)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), result.TotalDeleted())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects the legacy guild", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(testhelpers.MockDataRetentionRepository)
+
+		_, err := NewDataRetentionService(repo).PurgeGuild(context.Background(), 0)
+
+		assert.Error(t, err)
+		repo.AssertNotCalled(t, "PurgeGuild", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDataRetentionService_DeleteUserData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("anonymizes the user", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		repo := new(testhelpers.MockDataRetentionRepository)
+		report := entities.NewDataRetentionReport()
+		report.AnonymizedID = -1
+		report.Anonymized["balance_history"] = 10
+		repo.On("AnonymizeUser", ctx, int64(123)).Return(report, nil)
+
+		result, err := NewDataRetentionService(repo).DeleteUserData(ctx, 123)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(-1), result.AnonymizedID)
+		assert.Equal(t, int64(10), result.TotalAnonymized())
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		repo := new(testhelpers.MockDataRetentionRepository)
+		repo.On("AnonymizeUser", ctx, int64(123)).Return(nil, nil)
+
+		_, err := NewDataRetentionService(repo).DeleteUserData(ctx, 123)
+
+		assert.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("anonymized users cannot be deleted again", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(testhelpers.MockDataRetentionRepository)
+
+		_, err := NewDataRetentionService(repo).DeleteUserData(context.Background(), -5)
+
+		assert.Error(t, err)
+		repo.AssertNotCalled(t, "AnonymizeUser", mock.Anything, mock.Anything)
+	})
+}
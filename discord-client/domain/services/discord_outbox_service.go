@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// maxDiscordOutboxErrorLength caps the send error kept on an outbox message
+const maxDiscordOutboxErrorLength = 500
+
+// errOutboxWagerNotPosted is recorded against an edit or pin whose group wager message hasn't been posted yet,
+// so it is retried once the post has gone out
+var errOutboxWagerNotPosted = errors.New("group wager message has not been posted yet")
+
+// discordOutboxService implements the transactional outbox for Discord side effects
+type discordOutboxService struct {
+	outboxRepo     interfaces.DiscordOutboxRepository
+	groupWagerRepo interfaces.GroupWagerRepository
+	now            func() time.Time
+}
+
+// NewDiscordOutboxService creates a new Discord outbox service
+func NewDiscordOutboxService(
+	outboxRepo interfaces.DiscordOutboxRepository,
+	groupWagerRepo interfaces.GroupWagerRepository,
+) interfaces.DiscordOutboxService {
+	return &discordOutboxService{
+		outboxRepo:     outboxRepo,
+		groupWagerRepo: groupWagerRepo,
+		now:            func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Enqueue queues a message that is due immediately. A pending message with the same dedup key takes its payload instead.
+func (s *discordOutboxService) Enqueue(ctx context.Context, message *entities.DiscordOutboxMessage) error {
+	if err := message.Validate(); err != nil {
+		return err
+	}
+
+	message.Status = entities.DiscordOutboxStatusPending
+	message.NextAttemptAt = s.now()
+	if err := s.outboxRepo.Create(ctx, message); err != nil {
+		return fmt.Errorf("failed to queue discord %s: %w", message.Action, err)
+	}
+
+	return nil
+}
+
+// Dispatch sends a pending message and records the outcome, scheduling a retry with backoff on failure.
+// The message stays locked while it is sent so two dispatchers can't send it twice. Sending is at least
+// once: a post that succeeds in a transaction that then fails to commit is posted again on retry.
+func (s *discordOutboxService) Dispatch(ctx context.Context, messageID int64, executor interfaces.DiscordOutboxExecutor) (*entities.DiscordOutboxMessage, error) {
+	message, err := s.outboxRepo.GetByIDForUpdate(ctx, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get discord outbox message: %w", err)
+	}
+	if message == nil || message.Status != entities.DiscordOutboxStatusPending {
+		return nil, nil
+	}
+
+	var wager *entities.GroupWager
+	if message.GroupWagerID != nil {
+		wager, err = s.groupWagerRepo.GetByID(ctx, *message.GroupWagerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get group wager: %w", err)
+		}
+	}
+
+	sendErr := s.execute(ctx, message, wager, executor)
+	if sendErr != nil {
+		message.RecordFailure(truncateOutboxError(sendErr.Error()), s.now())
+	} else {
+		message.RecordSuccess(s.now())
+	}
+
+	if err := s.outboxRepo.Update(ctx, message); err != nil {
+		return nil, fmt.Errorf("failed to record discord outbox attempt: %w", err)
+	}
+
+	return message, nil
+}
+
+// execute performs the message's action, pointing edits and pins of a group wager at its message and
+// recording a posted group wager message on the wager
+func (s *discordOutboxService) execute(ctx context.Context, message *entities.DiscordOutboxMessage, wager *entities.GroupWager, executor interfaces.DiscordOutboxExecutor) error {
+	if message.Action != entities.DiscordOutboxActionPost && message.MessageID == nil && wager != nil {
+		if wager.MessageID == 0 {
+			return errOutboxWagerNotPosted
+		}
+		wagerMessageID := wager.MessageID
+		message.MessageID = &wagerMessageID
+		message.ChannelID = wager.ChannelID
+	}
+
+	result, err := executor.Execute(ctx, message)
+	if err != nil {
+		return err
+	}
+
+	// Only the Discord IDs are written, as the wager was read without a lock and may have changed since
+	if message.Action == entities.DiscordOutboxActionPost && wager != nil && result != nil {
+		if err := s.groupWagerRepo.UpdateMessageIDs(ctx, wager.ID, result.MessageID, result.ChannelID); err != nil {
+			return fmt.Errorf("failed to record posted message on group wager %d: %w", wager.ID, err)
+		}
+		if result.ThreadID != 0 {
+			if err := s.groupWagerRepo.UpdateThreadID(ctx, wager.ID, result.ThreadID); err != nil {
+				return fmt.Errorf("failed to record thread on group wager %d: %w", wager.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// truncateOutboxError shortens a send error to the length kept on the outbox message
+func truncateOutboxError(message string) string {
+	if len(message) <= maxDiscordOutboxErrorLength {
+		return message
+	}
+	return message[:maxDiscordOutboxErrorLength]
+}
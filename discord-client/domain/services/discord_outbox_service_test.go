@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubDiscordOutboxExecutor returns a fixed outcome for every message it is asked to send
+type stubDiscordOutboxExecutor struct {
+	result *entities.DiscordOutboxResult
+	err    error
+	sent   []*entities.DiscordOutboxMessage
+}
+
+func (s *stubDiscordOutboxExecutor) Execute(ctx context.Context, message *entities.DiscordOutboxMessage) (*entities.DiscordOutboxResult, error) {
+	s.sent = append(s.sent, message)
+	return s.result, s.err
+}
+
+func newTestDiscordOutboxService(now time.Time) (*discordOutboxService, *testhelpers.MockDiscordOutboxRepository, *testhelpers.MockGroupWagerRepository) {
+	outboxRepo := new(testhelpers.MockDiscordOutboxRepository)
+	groupWagerRepo := new(testhelpers.MockGroupWagerRepository)
+	service := &discordOutboxService{
+		outboxRepo:     outboxRepo,
+		groupWagerRepo: groupWagerRepo,
+		now:            func() time.Time { return now },
+	}
+	return service, outboxRepo, groupWagerRepo
+}
+
+func TestDiscordOutboxService_Enqueue(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("queues a message due now", func(t *testing.T) {
+		service, outboxRepo, _ := newTestDiscordOutboxService(now)
+		outboxRepo.On("Create", ctx, mock.AnythingOfType("*entities.DiscordOutboxMessage")).Return(nil)
+
+		message := &entities.DiscordOutboxMessage{GuildID: 123, Action: entities.DiscordOutboxActionPost, ChannelID: 456, Payload: []byte(`{}`)}
+		require.NoError(t, service.Enqueue(ctx, message))
+
+		assert.Equal(t, entities.DiscordOutboxStatusPending, message.Status)
+		assert.Equal(t, now, message.NextAttemptAt)
+		outboxRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects an incomplete message", func(t *testing.T) {
+		service, outboxRepo, _ := newTestDiscordOutboxService(now)
+
+		err := service.Enqueue(ctx, &entities.DiscordOutboxMessage{GuildID: 123, Action: entities.DiscordOutboxActionPin, ChannelID: 456})
+
+		assert.Error(t, err)
+		outboxRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDiscordOutboxService_Dispatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	wagerID := int64(7)
+
+	pendingPost := func() *entities.DiscordOutboxMessage {
+		return &entities.DiscordOutboxMessage{
+			ID: 10, GuildID: 123, Action: entities.DiscordOutboxActionPost, Kind: entities.DiscordOutboxKindHouseWager,
+			ChannelID: 456, GroupWagerID: &wagerID, Payload: []byte(`{}`), Status: entities.DiscordOutboxStatusPending,
+		}
+	}
+
+	t.Run("records a post on its group wager", func(t *testing.T) {
+		service, outboxRepo, groupWagerRepo := newTestDiscordOutboxService(now)
+		wager := &entities.GroupWager{ID: wagerID}
+		outboxRepo.On("GetByIDForUpdate", ctx, int64(10)).Return(pendingPost(), nil)
+		groupWagerRepo.On("GetByID", ctx, wagerID).Return(wager, nil)
+		groupWagerRepo.On("UpdateMessageIDs", ctx, wagerID, int64(999), int64(456)).Return(nil)
+		groupWagerRepo.On("UpdateThreadID", ctx, wagerID, int64(1000)).Return(nil)
+		outboxRepo.On("Update", ctx, mock.AnythingOfType("*entities.DiscordOutboxMessage")).Return(nil)
+
+		executor := &stubDiscordOutboxExecutor{result: &entities.DiscordOutboxResult{MessageID: 999, ChannelID: 456, ThreadID: 1000}}
+		message, err := service.Dispatch(ctx, 10, executor)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.DiscordOutboxStatusSent, message.Status)
+		assert.Equal(t, now, *message.SentAt)
+		// The rest of the wager may have changed while Discord was called, so it isn't written back
+		groupWagerRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		groupWagerRepo.AssertExpectations(t)
+	})
+
+	t.Run("points an edit at its group wager's message", func(t *testing.T) {
+		service, outboxRepo, groupWagerRepo := newTestDiscordOutboxService(now)
+		edit := pendingPost()
+		edit.Action = entities.DiscordOutboxActionEdit
+		outboxRepo.On("GetByIDForUpdate", ctx, int64(10)).Return(edit, nil)
+		groupWagerRepo.On("GetByID", ctx, wagerID).Return(&entities.GroupWager{ID: wagerID, MessageID: 999, ChannelID: 789}, nil)
+		outboxRepo.On("Update", ctx, edit).Return(nil)
+
+		executor := &stubDiscordOutboxExecutor{}
+		_, err := service.Dispatch(ctx, 10, executor)
+
+		require.NoError(t, err)
+		require.Len(t, executor.sent, 1)
+		assert.Equal(t, int64(999), *executor.sent[0].MessageID)
+		assert.Equal(t, int64(789), executor.sent[0].ChannelID)
+		assert.Equal(t, entities.DiscordOutboxStatusSent, edit.Status)
+		groupWagerRepo.AssertNotCalled(t, "UpdateMessageIDs", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("retries an edit until its group wager is posted", func(t *testing.T) {
+		service, outboxRepo, groupWagerRepo := newTestDiscordOutboxService(now)
+		edit := pendingPost()
+		edit.Action = entities.DiscordOutboxActionEdit
+		outboxRepo.On("GetByIDForUpdate", ctx, int64(10)).Return(edit, nil)
+		groupWagerRepo.On("GetByID", ctx, wagerID).Return(&entities.GroupWager{ID: wagerID}, nil)
+		outboxRepo.On("Update", ctx, edit).Return(nil)
+
+		executor := &stubDiscordOutboxExecutor{}
+		_, err := service.Dispatch(ctx, 10, executor)
+
+		require.NoError(t, err)
+		assert.Empty(t, executor.sent)
+		assert.Equal(t, entities.DiscordOutboxStatusPending, edit.Status)
+		assert.Equal(t, now.Add(entities.DiscordOutboxBaseRetryBackoff), edit.NextAttemptAt)
+	})
+
+	t.Run("schedules a retry when Discord fails", func(t *testing.T) {
+		service, outboxRepo, groupWagerRepo := newTestDiscordOutboxService(now)
+		outboxRepo.On("GetByIDForUpdate", ctx, int64(10)).Return(pendingPost(), nil)
+		groupWagerRepo.On("GetByID", ctx, wagerID).Return(&entities.GroupWager{ID: wagerID}, nil)
+		outboxRepo.On("Update", ctx, mock.AnythingOfType("*entities.DiscordOutboxMessage")).Return(nil)
+
+		message, err := service.Dispatch(ctx, 10, &stubDiscordOutboxExecutor{err: errors.New("HTTP 503 Service Unavailable")})
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.DiscordOutboxStatusPending, message.Status)
+		assert.Equal(t, "HTTP 503 Service Unavailable", *message.LastError)
+		groupWagerRepo.AssertNotCalled(t, "UpdateMessageIDs", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("skips messages that were already sent", func(t *testing.T) {
+		service, outboxRepo, _ := newTestDiscordOutboxService(now)
+		sent := pendingPost()
+		sent.Status = entities.DiscordOutboxStatusSent
+		outboxRepo.On("GetByIDForUpdate", ctx, int64(10)).Return(sent, nil)
+
+		executor := &stubDiscordOutboxExecutor{}
+		message, err := service.Dispatch(ctx, 10, executor)
+
+		require.NoError(t, err)
+		assert.Nil(t, message)
+		assert.Empty(t, executor.sent)
+	})
+}
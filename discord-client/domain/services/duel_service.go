@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+	"gambler/discord-client/domain/utils"
+)
+
+// duelService implements business logic for duels
+type duelService struct {
+	duelRepo           interfaces.DuelRepository
+	userRepo           interfaces.UserRepository
+	balanceHistoryRepo interfaces.BalanceHistoryRepository
+	eventPublisher     interfaces.EventPublisher
+	flip               func() bool // Returns true when the challenger wins a round
+}
+
+// NewDuelService creates a new duel service
+func NewDuelService(
+	duelRepo interfaces.DuelRepository,
+	userRepo interfaces.UserRepository,
+	balanceHistoryRepo interfaces.BalanceHistoryRepository,
+	eventPublisher interfaces.EventPublisher,
+) interfaces.DuelService {
+	return &duelService{
+		duelRepo:           duelRepo,
+		userRepo:           userRepo,
+		balanceHistoryRepo: balanceHistoryRepo,
+		eventPublisher:     eventPublisher,
+		flip:               func() bool { return rand.Intn(2) == 0 },
+	}
+}
+
+// Challenge creates a duel after checking both users can cover the stake
+func (s *duelService) Challenge(ctx context.Context, challengerID, targetID, guildID int64, amount int64, bestOf int) (*entities.Duel, error) {
+	if challengerID == targetID {
+		return nil, errors.New("you cannot duel yourself")
+	}
+	if amount <= 0 {
+		return nil, fmt.Errorf("duel %w", entities.ErrNonPositiveAmount)
+	}
+	if !entities.IsValidDuelBestOf(bestOf) {
+		return nil, errors.New("duels are best of 1 or best of 3")
+	}
+
+	now := time.Now()
+	challenger, err := s.userRepo.GetByDiscordID(ctx, challengerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenger: %w", err)
+	}
+	if challenger == nil {
+		return nil, errors.New("challenger not found")
+	}
+	if err := challenger.CheckGamblingBreak(now); err != nil {
+		return nil, err
+	}
+	if challenger.AvailableBalance < amount {
+		return nil, fmt.Errorf("%w: have %s available, need %s", entities.ErrInsufficientBalance, utils.FormatShortNotation(challenger.AvailableBalance), utils.FormatShortNotation(amount))
+	}
+
+	target, err := s.userRepo.GetByDiscordID(ctx, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target: %w", err)
+	}
+	if target == nil {
+		return nil, fmt.Errorf("target %w", entities.ErrUserNotFound)
+	}
+	if target.IsOnGamblingBreak(now) {
+		return nil, errors.New("target user is on a gambling break")
+	}
+	if err := CheckNotFrozen(now, challenger, target); err != nil {
+		return nil, err
+	}
+	if target.AvailableBalance < amount {
+		return nil, fmt.Errorf("target user has %w: they have %s available, need %s", entities.ErrInsufficientBalance, utils.FormatShortNotation(target.AvailableBalance), utils.FormatShortNotation(amount))
+	}
+
+	duel := &entities.Duel{
+		GuildID:             guildID,
+		ChallengerDiscordID: challengerID,
+		TargetDiscordID:     targetID,
+		Amount:              amount,
+		BestOf:              bestOf,
+		Status:              entities.DuelStatusPending,
+		CreatedAt:           now.UTC(),
+		ExpiresAt:           now.UTC().Add(entities.DuelChallengeTTL),
+	}
+	if err := s.duelRepo.Create(ctx, duel); err != nil {
+		return nil, fmt.Errorf("failed to create duel: %w", err)
+	}
+
+	return duel, nil
+}
+
+// Accept flips the coin and settles the duel within the caller's transaction
+func (s *duelService) Accept(ctx context.Context, duelID, responderID int64) (*entities.Duel, error) {
+	duel, err := s.getPendingDuel(ctx, duelID)
+	if err != nil {
+		return nil, err
+	}
+	if duel.TargetDiscordID != responderID {
+		return nil, errors.New("only the challenged user can accept this duel")
+	}
+
+	now := time.Now()
+	if duel.IsExpired(now) {
+		return nil, errors.New("this duel challenge has expired")
+	}
+
+	// The challenger's stake is already held, so their available balance includes it
+	challenger, err := s.userRepo.GetByDiscordID(ctx, duel.ChallengerDiscordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenger: %w", err)
+	}
+	if challenger == nil {
+		return nil, errors.New("challenger not found")
+	}
+	if challenger.Balance < duel.Amount {
+		return nil, errors.New("challenger no longer has sufficient balance")
+	}
+	if challenger.IsOnGamblingBreak(now) {
+		return nil, errors.New("challenger is on a gambling break")
+	}
+
+	target, err := s.userRepo.GetByDiscordID(ctx, duel.TargetDiscordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target: %w", err)
+	}
+	if target == nil {
+		return nil, fmt.Errorf("target %w", entities.ErrUserNotFound)
+	}
+	if err := target.CheckGamblingBreak(now); err != nil {
+		return nil, err
+	}
+	if err := CheckNotFrozen(now, target, challenger); err != nil {
+		return nil, err
+	}
+	if target.AvailableBalance < duel.Amount {
+		return nil, fmt.Errorf("%w: have %s available, need %s", entities.ErrInsufficientBalance, utils.FormatShortNotation(target.AvailableBalance), utils.FormatShortNotation(duel.Amount))
+	}
+
+	duel.Play(s.flip, now.UTC())
+
+	winner, loser := challenger, target
+	if *duel.WinnerDiscordID == target.DiscordID {
+		winner, loser = target, challenger
+	}
+	if err := s.recordResult(ctx, duel, winner, entities.TransactionTypeDuelWin, duel.Amount); err != nil {
+		return nil, err
+	}
+	if err := s.recordResult(ctx, duel, loser, entities.TransactionTypeDuelLoss, -duel.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := s.duelRepo.Update(ctx, duel); err != nil {
+		return nil, fmt.Errorf("failed to update duel: %w", err)
+	}
+
+	return duel, nil
+}
+
+// Decline closes a pending duel, releasing the challenger's stake
+func (s *duelService) Decline(ctx context.Context, duelID, responderID int64) (*entities.Duel, error) {
+	duel, err := s.getPendingDuel(ctx, duelID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch responderID {
+	case duel.TargetDiscordID:
+		duel.Status = entities.DuelStatusDeclined
+	case duel.ChallengerDiscordID:
+		duel.Status = entities.DuelStatusCancelled
+	default:
+		return nil, errors.New("only the duelists can respond to this duel")
+	}
+
+	now := time.Now().UTC()
+	duel.ResolvedAt = &now
+	if err := s.duelRepo.Update(ctx, duel); err != nil {
+		return nil, fmt.Errorf("failed to update duel: %w", err)
+	}
+
+	return duel, nil
+}
+
+// getPendingDuel locks a duel and checks that it is still waiting for a response
+func (s *duelService) getPendingDuel(ctx context.Context, duelID int64) (*entities.Duel, error) {
+	duel, err := s.duelRepo.GetByIDForUpdate(ctx, duelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get duel: %w", err)
+	}
+	if duel == nil {
+		return nil, entities.ErrDuelNotFound
+	}
+	if !duel.IsPending() {
+		return nil, fmt.Errorf("this duel is already %s", duel.Status)
+	}
+	return duel, nil
+}
+
+// recordResult applies one side's share of the duel outcome to their balance
+func (s *duelService) recordResult(ctx context.Context, duel *entities.Duel, user *entities.User, transactionType entities.TransactionType, change int64) error {
+	newBalance := user.Balance + change
+	if err := s.userRepo.UpdateBalance(ctx, user.DiscordID, newBalance); err != nil {
+		return fmt.Errorf("failed to update balance: %w", err)
+	}
+
+	challengerWins, targetWins := duel.Score()
+	relatedType := entities.RelatedTypeDuel
+	history := &entities.BalanceHistory{
+		DiscordID:       user.DiscordID,
+		GuildID:         duel.GuildID,
+		BalanceBefore:   user.Balance,
+		BalanceAfter:    newBalance,
+		ChangeAmount:    change,
+		TransactionType: transactionType,
+		TransactionMetadata: map[string]interface{}{
+			"duel_id":         duel.ID,
+			"amount":          duel.Amount,
+			"best_of":         duel.BestOf,
+			"challenger_id":   duel.ChallengerDiscordID,
+			"target_id":       duel.TargetDiscordID,
+			"challenger_wins": challengerWins,
+			"target_wins":     targetWins,
+		},
+		RelatedID:   &duel.ID,
+		RelatedType: &relatedType,
+	}
+	if err := utils.RecordBalanceChange(ctx, s.balanceHistoryRepo, s.eventPublisher, history); err != nil {
+		return fmt.Errorf("failed to record duel result: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// duelServiceMocks groups the mocks needed by the duel service
+type duelServiceMocks struct {
+	duelRepo           *testhelpers.MockDuelRepository
+	userRepo           *testhelpers.MockUserRepository
+	balanceHistoryRepo *testhelpers.MockBalanceHistoryRepository
+	eventPublisher     *testhelpers.MockEventPublisher
+}
+
+func newDuelServiceWithMocks(flips ...bool) (*duelServiceMocks, *duelService) {
+	mocks := &duelServiceMocks{
+		duelRepo:           new(testhelpers.MockDuelRepository),
+		userRepo:           new(testhelpers.MockUserRepository),
+		balanceHistoryRepo: new(testhelpers.MockBalanceHistoryRepository),
+		eventPublisher:     new(testhelpers.MockEventPublisher),
+	}
+	service := NewDuelService(
+		mocks.duelRepo,
+		mocks.userRepo,
+		mocks.balanceHistoryRepo,
+		mocks.eventPublisher,
+	).(*duelService)
+	service.flip = func() bool {
+		result := flips[0]
+		flips = flips[1:]
+		return result
+	}
+	return mocks, service
+}
+
+func createTestDuel(id int64, bestOf int) *entities.Duel {
+	return &entities.Duel{
+		ID:                  id,
+		GuildID:             123456789,
+		ChallengerDiscordID: 111,
+		TargetDiscordID:     222,
+		Amount:              1000,
+		BestOf:              bestOf,
+		Status:              entities.DuelStatusPending,
+		ExpiresAt:           time.Now().Add(entities.DuelChallengeTTL),
+	}
+}
+
+func TestDuelService_Challenge(t *testing.T) {
+	t.Parallel()
+
+	t.Run("creates a pending duel", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newDuelServiceWithMocks()
+		ctx := context.Background()
+
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(111)).Return(createTestUser(111, 5000), nil)
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(222)).Return(createTestUser(222, 5000), nil)
+		mocks.duelRepo.On("Create", ctx, mock.MatchedBy(func(d *entities.Duel) bool {
+			return d.ChallengerDiscordID == 111 && d.TargetDiscordID == 222 && d.BestOf == 3 &&
+				d.IsPending() && d.ExpiresAt.After(d.CreatedAt)
+		})).Return(nil)
+
+		duel, err := service.Challenge(ctx, 111, 222, 123456789, 1000, 3)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000), duel.Amount)
+		mocks.duelRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects targets who cannot cover the stake", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newDuelServiceWithMocks()
+		ctx := context.Background()
+
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(111)).Return(createTestUser(111, 5000), nil)
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(222)).Return(createTestUser(222, 500), nil)
+
+		_, err := service.Challenge(ctx, 111, 222, 123456789, 1000, 1)
+		assert.ErrorIs(t, err, entities.ErrInsufficientBalance)
+		assert.ErrorContains(t, err, "target user has insufficient balance")
+		mocks.duelRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	for name, args := range map[string][3]int64{
+		"rejects self duels":     {111, 1000, 1},
+		"rejects zero amounts":   {222, 0, 1},
+		"rejects invalid series": {222, 1000, 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, service := newDuelServiceWithMocks()
+			_, err := service.Challenge(context.Background(), 111, args[0], 123456789, args[1], int(args[2]))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestDuelService_Accept(t *testing.T) {
+	t.Parallel()
+
+	t.Run("best of 3 transfers the stake to the winner", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newDuelServiceWithMocks(false, true, false)
+		ctx := context.Background()
+
+		mocks.duelRepo.On("GetByIDForUpdate", ctx, int64(7)).Return(createTestDuel(7, 3), nil)
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(111)).Return(createTestUser(111, 5000), nil)
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(222)).Return(createTestUser(222, 3000), nil)
+		mocks.userRepo.On("UpdateBalance", ctx, int64(222), int64(4000)).Return(nil)
+		mocks.userRepo.On("UpdateBalance", ctx, int64(111), int64(4000)).Return(nil)
+		mocks.balanceHistoryRepo.On("Record", ctx, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+			return h.DiscordID == 222 && h.TransactionType == entities.TransactionTypeDuelWin && h.ChangeAmount == 1000
+		})).Return(nil)
+		mocks.balanceHistoryRepo.On("Record", ctx, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+			return h.DiscordID == 111 && h.TransactionType == entities.TransactionTypeDuelLoss && h.ChangeAmount == -1000
+		})).Return(nil)
+		mocks.eventPublisher.On("Publish", mock.Anything).Return(nil)
+		mocks.duelRepo.On("Update", ctx, mock.MatchedBy(func(d *entities.Duel) bool {
+			return d.Status == entities.DuelStatusCompleted && len(d.Flips) == 3
+		})).Return(nil)
+
+		duel, err := service.Accept(ctx, 7, 222)
+		require.NoError(t, err)
+		require.NotNil(t, duel.WinnerDiscordID)
+		assert.Equal(t, int64(222), *duel.WinnerDiscordID)
+
+		mocks.userRepo.AssertExpectations(t)
+		mocks.balanceHistoryRepo.AssertExpectations(t)
+		mocks.duelRepo.AssertExpectations(t)
+	})
+
+	t.Run("only the target can accept", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newDuelServiceWithMocks()
+		ctx := context.Background()
+
+		mocks.duelRepo.On("GetByIDForUpdate", ctx, int64(7)).Return(createTestDuel(7, 1), nil)
+
+		_, err := service.Accept(ctx, 7, 111)
+		assert.ErrorContains(t, err, "only the challenged user")
+	})
+
+	t.Run("rejects expired challenges", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newDuelServiceWithMocks()
+		ctx := context.Background()
+
+		duel := createTestDuel(7, 1)
+		duel.ExpiresAt = time.Now().Add(-time.Second)
+		mocks.duelRepo.On("GetByIDForUpdate", ctx, int64(7)).Return(duel, nil)
+
+		_, err := service.Accept(ctx, 7, 222)
+		assert.ErrorContains(t, err, "expired")
+		mocks.duelRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects duels that were already settled", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newDuelServiceWithMocks()
+		ctx := context.Background()
+
+		duel := createTestDuel(7, 1)
+		duel.Status = entities.DuelStatusCompleted
+		mocks.duelRepo.On("GetByIDForUpdate", ctx, int64(7)).Return(duel, nil)
+
+		_, err := service.Accept(ctx, 7, 222)
+		assert.ErrorContains(t, err, "already completed")
+	})
+}
+
+func TestDuelService_Decline(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		responderID int64
+		want        entities.DuelStatus
+	}{
+		{responderID: 222, want: entities.DuelStatusDeclined},
+		{responderID: 111, want: entities.DuelStatusCancelled},
+	} {
+		t.Run(string(tt.want), func(t *testing.T) {
+			t.Parallel()
+
+			mocks, service := newDuelServiceWithMocks()
+			ctx := context.Background()
+
+			mocks.duelRepo.On("GetByIDForUpdate", ctx, int64(7)).Return(createTestDuel(7, 1), nil)
+			mocks.duelRepo.On("Update", ctx, mock.Anything).Return(nil)
+
+			duel, err := service.Decline(ctx, 7, tt.responderID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, duel.Status)
+		})
+	}
+
+	t.Run("bystanders cannot respond", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newDuelServiceWithMocks()
+		ctx := context.Background()
+
+		mocks.duelRepo.On("GetByIDForUpdate", ctx, int64(7)).Return(createTestDuel(7, 1), nil)
+
+		_, err := service.Decline(ctx, 7, 333)
+		assert.Error(t, err)
+	})
+}
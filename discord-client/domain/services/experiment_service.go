@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+type experimentService struct {
+	experimentRepo interfaces.ExperimentRepository
+}
+
+// NewExperimentService creates a new experiment service
+func NewExperimentService(experimentRepo interfaces.ExperimentRepository) interfaces.ExperimentService {
+	return &experimentService{
+		experimentRepo: experimentRepo,
+	}
+}
+
+// GetVariant returns the variant a user is assigned to and records their exposure
+func (s *experimentService) GetVariant(ctx context.Context, key string, guildID, discordID int64) (entities.ExperimentVariant, error) {
+	experiment, err := s.experimentRepo.GetByKey(ctx, key)
+	if err != nil {
+		return entities.ExperimentVariantControl, fmt.Errorf("failed to get experiment: %w", err)
+	}
+	if experiment == nil {
+		return entities.ExperimentVariantControl, nil
+	}
+
+	variant := experiment.VariantFor(guildID, discordID)
+
+	// Only the first exposure is kept, so the report measures activity from that point on
+	exposure := &entities.ExperimentExposure{
+		ExperimentKey: key,
+		GuildID:       guildID,
+		DiscordID:     discordID,
+		Variant:       variant,
+	}
+	if err := s.experimentRepo.RecordExposure(ctx, exposure); err != nil {
+		return variant, fmt.Errorf("failed to record exposure: %w", err)
+	}
+
+	return variant, nil
+}
+
+// IsInTreatment reports whether a user should see the experimental feature
+func (s *experimentService) IsInTreatment(ctx context.Context, key string, guildID, discordID int64) (bool, error) {
+	variant, err := s.GetVariant(ctx, key, guildID, discordID)
+	if err != nil {
+		return false, err
+	}
+	return variant == entities.ExperimentVariantTreatment, nil
+}
+
+// UpdateRollout sets the percentage of guilds or users that receive the treatment
+func (s *experimentService) UpdateRollout(ctx context.Context, key string, rolloutPercent int, enabled bool) error {
+	if rolloutPercent < 0 || rolloutPercent > 100 {
+		return fmt.Errorf("rollout percent must be between 0 and 100")
+	}
+
+	if err := s.experimentRepo.UpdateRollout(ctx, key, rolloutPercent, enabled); err != nil {
+		return fmt.Errorf("failed to update rollout: %w", err)
+	}
+
+	return nil
+}
+
+// GetReport compares activity metrics between the variants of an experiment
+func (s *experimentService) GetReport(ctx context.Context, key string) ([]*entities.ExperimentVariantReport, error) {
+	experiment, err := s.experimentRepo.GetByKey(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experiment: %w", err)
+	}
+	if experiment == nil {
+		return nil, fmt.Errorf("experiment %s not found", key)
+	}
+
+	reports, err := s.experimentRepo.GetReport(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experiment report: %w", err)
+	}
+
+	return reports, nil
+}
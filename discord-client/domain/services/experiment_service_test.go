@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExperimentService_GetVariant_RecordsExposure(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(testhelpers.MockExperimentRepository)
+	service := NewExperimentService(mockRepo)
+
+	experiment := &entities.Experiment{
+		Key:            entities.ExperimentQuickBetButtons,
+		Unit:           entities.ExperimentUnitUser,
+		RolloutPercent: 100,
+		Enabled:        true,
+	}
+	mockRepo.On("GetByKey", ctx, entities.ExperimentQuickBetButtons).Return(experiment, nil)
+	mockRepo.On("RecordExposure", ctx, mock.MatchedBy(func(e *entities.ExperimentExposure) bool {
+		return e.ExperimentKey == entities.ExperimentQuickBetButtons &&
+			e.GuildID == 1 &&
+			e.DiscordID == 123456 &&
+			e.Variant == entities.ExperimentVariantTreatment
+	})).Return(nil)
+
+	variant, err := service.GetVariant(ctx, entities.ExperimentQuickBetButtons, 1, 123456)
+
+	require.NoError(t, err)
+	assert.Equal(t, entities.ExperimentVariantTreatment, variant)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExperimentService_GetVariant_UnknownExperimentIsControl(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(testhelpers.MockExperimentRepository)
+	service := NewExperimentService(mockRepo)
+
+	mockRepo.On("GetByKey", ctx, "missing").Return(nil, nil)
+
+	inTreatment, err := service.IsInTreatment(ctx, "missing", 1, 123456)
+
+	require.NoError(t, err)
+	assert.False(t, inTreatment)
+	mockRepo.AssertNotCalled(t, "RecordExposure", mock.Anything, mock.Anything)
+}
+
+func TestExperimentService_UpdateRollout_ValidatesPercent(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(testhelpers.MockExperimentRepository)
+	service := NewExperimentService(mockRepo)
+
+	err := service.UpdateRollout(ctx, entities.ExperimentDigestDMs, 101, true)
+	assert.Error(t, err)
+
+	err = service.UpdateRollout(ctx, entities.ExperimentDigestDMs, -1, true)
+	assert.Error(t, err)
+
+	mockRepo.On("UpdateRollout", ctx, entities.ExperimentDigestDMs, 25, true).Return(nil)
+	err = service.UpdateRollout(ctx, entities.ExperimentDigestDMs, 25, true)
+	assert.NoError(t, err)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExperimentService_GetReport(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(testhelpers.MockExperimentRepository)
+	service := NewExperimentService(mockRepo)
+
+	t.Run("unknown experiment", func(t *testing.T) {
+		mockRepo.On("GetByKey", ctx, "missing").Return(nil, nil).Once()
+
+		_, err := service.GetReport(ctx, "missing")
+		assert.Error(t, err)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo.On("GetByKey", ctx, "broken").Return(nil, errors.New("db down")).Once()
+
+		_, err := service.GetReport(ctx, "broken")
+		assert.Error(t, err)
+	})
+
+	t.Run("returns variant reports", func(t *testing.T) {
+		reports := []*entities.ExperimentVariantReport{
+			{Variant: entities.ExperimentVariantControl, ExposedUsers: 10, ActiveUsers: 4},
+			{Variant: entities.ExperimentVariantTreatment, ExposedUsers: 10, ActiveUsers: 6},
+		}
+		mockRepo.On("GetByKey", ctx, entities.ExperimentDigestDMs).Return(&entities.Experiment{Key: entities.ExperimentDigestDMs}, nil).Once()
+		mockRepo.On("GetReport", ctx, entities.ExperimentDigestDMs).Return(reports, nil).Once()
+
+		result, err := service.GetReport(ctx, entities.ExperimentDigestDMs)
+		require.NoError(t, err)
+		assert.Equal(t, reports, result)
+	})
+}
@@ -0,0 +1,239 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// exportService implements economy data exports
+type exportService struct {
+	balanceHistoryRepo interfaces.BalanceHistoryRepository
+	groupWagerRepo     interfaces.GroupWagerRepository
+	lotteryDrawRepo    interfaces.LotteryDrawRepository
+}
+
+// NewExportService creates a new export service
+func NewExportService(
+	balanceHistoryRepo interfaces.BalanceHistoryRepository,
+	groupWagerRepo interfaces.GroupWagerRepository,
+	lotteryDrawRepo interfaces.LotteryDrawRepository,
+) interfaces.ExportService {
+	return &exportService{
+		balanceHistoryRepo: balanceHistoryRepo,
+		groupWagerRepo:     groupWagerRepo,
+		lotteryDrawRepo:    lotteryDrawRepo,
+	}
+}
+
+var (
+	balanceHistoryExportColumns = []string{
+		"id", "created_at", "discord_id", "transaction_type", "description",
+		"change_amount", "balance_before", "balance_after", "related_type", "related_id", "metadata",
+	}
+	wagerOutcomeExportColumns = []string{
+		"group_wager_id", "resolved_at", "wager_type", "condition", "winning_option",
+		"discord_id", "option", "amount", "payout_amount", "won",
+	}
+	lotteryResultExportColumns = []string{
+		"draw_id", "completed_at", "winning_number", "difficulty", "ticket_cost",
+		"total_pot", "tickets_sold", "winner_count", "total_paid_out",
+	}
+)
+
+// Export streams the dataset for a guild within [from, to) to w in the given format and returns the number of rows written
+func (s *exportService) Export(ctx context.Context, guildID int64, dataset entities.ExportDataset, format entities.ExportFormat, from, to time.Time, w io.Writer) (int, error) {
+	if !dataset.IsValid() {
+		return 0, fmt.Errorf("unknown export dataset %q", dataset)
+	}
+	if !format.IsValid() {
+		return 0, fmt.Errorf("unknown export format %q", format)
+	}
+	if err := entities.ValidateExportRange(from, to); err != nil {
+		return 0, err
+	}
+
+	var out exportWriter
+	switch dataset {
+	case entities.ExportDatasetBalanceHistory:
+		out = newExportWriter(format, w, balanceHistoryExportColumns)
+	case entities.ExportDatasetWagerOutcomes:
+		out = newExportWriter(format, w, wagerOutcomeExportColumns)
+	case entities.ExportDatasetLotteryResults:
+		out = newExportWriter(format, w, lotteryResultExportColumns)
+	}
+
+	if err := out.Begin(); err != nil {
+		return 0, fmt.Errorf("failed to write export header: %w", err)
+	}
+
+	rows := 0
+	var err error
+	switch dataset {
+	case entities.ExportDatasetBalanceHistory:
+		err = s.balanceHistoryRepo.StreamByDateRange(ctx, from, to, func(h *entities.BalanceHistory) error {
+			rows++
+			var relatedType any
+			if h.RelatedType != nil {
+				relatedType = string(*h.RelatedType)
+			}
+			return out.WriteRow([]any{
+				h.ID, h.CreatedAt, h.DiscordID, string(h.TransactionType), h.TransactionType.DisplayName(),
+				h.ChangeAmount, h.BalanceBefore, h.BalanceAfter, relatedType, h.RelatedID, h.TransactionMetadata,
+			})
+		})
+	case entities.ExportDatasetWagerOutcomes:
+		err = s.groupWagerRepo.StreamOutcomesByDateRange(ctx, from, to, func(o *entities.GroupWagerOutcome) error {
+			rows++
+			return out.WriteRow([]any{
+				o.GroupWagerID, o.ResolvedAt, string(o.WagerType), o.Condition, o.WinningOptionText,
+				o.DiscordID, o.OptionText, o.Amount, o.PayoutAmount, o.Won,
+			})
+		})
+	case entities.ExportDatasetLotteryResults:
+		err = s.lotteryDrawRepo.StreamCompletedDrawResults(ctx, guildID, from, to, func(r *entities.LotteryDrawResult) error {
+			rows++
+			return out.WriteRow([]any{
+				r.DrawID, r.CompletedAt, r.WinningNumber, r.Difficulty, r.TicketCost,
+				r.TotalPot, r.TicketsSold, r.WinnerCount, r.TotalPaidOut,
+			})
+		})
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to export %s: %w", dataset, err)
+	}
+
+	if err := out.End(); err != nil {
+		return 0, fmt.Errorf("failed to finish export: %w", err)
+	}
+
+	return rows, nil
+}
+
+// exportWriter writes export rows one at a time in a specific file format
+type exportWriter interface {
+	Begin() error
+	WriteRow(values []any) error
+	End() error
+}
+
+func newExportWriter(format entities.ExportFormat, w io.Writer, columns []string) exportWriter {
+	if format == entities.ExportFormatJSON {
+		return &jsonExportWriter{w: bufio.NewWriter(w), columns: columns}
+	}
+	return &csvExportWriter{w: csv.NewWriter(w), columns: columns}
+}
+
+// csvExportWriter writes a header row followed by one record per row
+type csvExportWriter struct {
+	w       *csv.Writer
+	columns []string
+}
+
+func (c *csvExportWriter) Begin() error {
+	return c.w.Write(c.columns)
+}
+
+func (c *csvExportWriter) WriteRow(values []any) error {
+	record := make([]string, len(values))
+	for i, value := range values {
+		field, err := csvExportField(value)
+		if err != nil {
+			return err
+		}
+		record[i] = field
+	}
+	return c.w.Write(record)
+}
+
+func (c *csvExportWriter) End() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// csvExportField formats a single value for a CSV cell. Nil values become empty cells.
+func csvExportField(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case *int64:
+		if v == nil {
+			return "", nil
+		}
+		return strconv.FormatInt(*v, 10), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case time.Time:
+		return v.UTC().Format(time.RFC3339), nil
+	case map[string]any:
+		if len(v) == 0 {
+			return "", nil
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(encoded), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// jsonExportWriter writes a JSON array of objects whose keys follow the column order.
+// bufio.Writer errors are sticky, so write failures surface when End flushes.
+type jsonExportWriter struct {
+	w       *bufio.Writer
+	columns []string
+	rows    int
+}
+
+func (j *jsonExportWriter) Begin() error {
+	j.w.WriteString("[")
+	return nil
+}
+
+func (j *jsonExportWriter) WriteRow(values []any) error {
+	if j.rows > 0 {
+		j.w.WriteString(",")
+	}
+	j.rows++
+
+	j.w.WriteString("\n  {")
+	for i, value := range values {
+		if t, ok := value.(time.Time); ok {
+			value = t.UTC().Format(time.RFC3339)
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", j.columns[i], err)
+		}
+		if i > 0 {
+			j.w.WriteString(", ")
+		}
+		j.w.WriteString(strconv.Quote(j.columns[i]))
+		j.w.WriteString(": ")
+		j.w.Write(encoded)
+	}
+	j.w.WriteString("}")
+	return nil
+}
+
+func (j *jsonExportWriter) End() error {
+	if j.rows > 0 {
+		j.w.WriteString("\n")
+	}
+	j.w.WriteString("]\n")
+	return j.w.Flush()
+}
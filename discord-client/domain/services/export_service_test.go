@@ -0,0 +1,158 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportService_Export(t *testing.T) {
+	ctx := context.Background()
+	guildID := int64(123456789)
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2024, 1, 15, 12, 30, 0, 0, time.UTC)
+	relatedID := int64(42)
+	relatedType := entities.RelatedTypeGroupWager
+	payout := int64(1500)
+
+	histories := []*entities.BalanceHistory{
+		{
+			ID:                  1,
+			DiscordID:           111,
+			GuildID:             guildID,
+			BalanceBefore:       1000,
+			BalanceAfter:        2500,
+			ChangeAmount:        1500,
+			TransactionType:     entities.TransactionTypeGroupWagerWin,
+			TransactionMetadata: map[string]any{"note": "pot, \"split\""},
+			RelatedID:           &relatedID,
+			RelatedType:         &relatedType,
+			CreatedAt:           createdAt,
+		},
+		{
+			ID:              2,
+			DiscordID:       222,
+			GuildID:         guildID,
+			BalanceBefore:   500,
+			BalanceAfter:    400,
+			ChangeAmount:    -100,
+			TransactionType: entities.TransactionTypeLottoTicket,
+			CreatedAt:       createdAt.Add(time.Hour),
+		},
+	}
+
+	t.Run("balance history as CSV", func(t *testing.T) {
+		balanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+		balanceHistoryRepo.On("StreamByDateRange", ctx, from, to).Return(histories, nil)
+		service := NewExportService(balanceHistoryRepo, new(testhelpers.MockGroupWagerRepository), new(testhelpers.MockLotteryDrawRepository))
+
+		var buf bytes.Buffer
+		rows, err := service.Export(ctx, guildID, entities.ExportDatasetBalanceHistory, entities.ExportFormatCSV, from, to, &buf)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, rows)
+		assert.Equal(t,
+			"id,created_at,discord_id,transaction_type,description,change_amount,balance_before,balance_after,related_type,related_id,metadata\n"+
+				"1,2024-01-15T12:30:00Z,111,group_wager_win,Group wager win,1500,1000,2500,group_wager,42,\"{\"\"note\"\":\"\"pot, \\\"\"split\\\"\"\"\"}\"\n"+
+				"2,2024-01-15T13:30:00Z,222,lotto_ticket,Lottery ticket,-100,500,400,,,\n",
+			buf.String())
+		balanceHistoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("wager outcomes as JSON", func(t *testing.T) {
+		groupWagerRepo := new(testhelpers.MockGroupWagerRepository)
+		groupWagerRepo.On("StreamOutcomesByDateRange", ctx, from, to).Return([]*entities.GroupWagerOutcome{
+			{
+				GroupWagerID:      7,
+				Condition:         "Who wins?",
+				WagerType:         entities.GroupWagerTypePool,
+				ResolvedAt:        createdAt,
+				WinningOptionText: "Blue",
+				DiscordID:         111,
+				OptionText:        "Blue",
+				Amount:            1000,
+				PayoutAmount:      &payout,
+				Won:               true,
+			},
+			{
+				GroupWagerID:      7,
+				Condition:         "Who wins?",
+				WagerType:         entities.GroupWagerTypePool,
+				ResolvedAt:        createdAt,
+				WinningOptionText: "Blue",
+				DiscordID:         222,
+				OptionText:        "Red",
+				Amount:            500,
+			},
+		}, nil)
+		service := NewExportService(new(testhelpers.MockBalanceHistoryRepository), groupWagerRepo, new(testhelpers.MockLotteryDrawRepository))
+
+		var buf bytes.Buffer
+		rows, err := service.Export(ctx, guildID, entities.ExportDatasetWagerOutcomes, entities.ExportFormatJSON, from, to, &buf)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, rows)
+		assert.Contains(t, buf.String(), `{"group_wager_id": 7, "resolved_at": "2024-01-15T12:30:00Z", "wager_type": "pool"`)
+
+		var decoded []map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		require.Len(t, decoded, 2)
+		assert.Equal(t, float64(1500), decoded[0]["payout_amount"])
+		assert.Equal(t, true, decoded[0]["won"])
+		assert.Nil(t, decoded[1]["payout_amount"])
+		assert.Equal(t, "Red", decoded[1]["option"])
+	})
+
+	t.Run("lottery results with no draws", func(t *testing.T) {
+		lotteryDrawRepo := new(testhelpers.MockLotteryDrawRepository)
+		lotteryDrawRepo.On("StreamCompletedDrawResults", ctx, guildID, from, to).Return(nil, nil)
+		service := NewExportService(new(testhelpers.MockBalanceHistoryRepository), new(testhelpers.MockGroupWagerRepository), lotteryDrawRepo)
+
+		var csvBuf, jsonBuf bytes.Buffer
+		rows, err := service.Export(ctx, guildID, entities.ExportDatasetLotteryResults, entities.ExportFormatCSV, from, to, &csvBuf)
+		require.NoError(t, err)
+		assert.Equal(t, 0, rows)
+		assert.Equal(t, "draw_id,completed_at,winning_number,difficulty,ticket_cost,total_pot,tickets_sold,winner_count,total_paid_out\n", csvBuf.String())
+
+		rows, err = service.Export(ctx, guildID, entities.ExportDatasetLotteryResults, entities.ExportFormatJSON, from, to, &jsonBuf)
+		require.NoError(t, err)
+		assert.Equal(t, 0, rows)
+		assert.Equal(t, "[]\n", jsonBuf.String())
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		balanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+		balanceHistoryRepo.On("StreamByDateRange", ctx, from, to).Return(nil, errors.New("connection reset"))
+		service := NewExportService(balanceHistoryRepo, new(testhelpers.MockGroupWagerRepository), new(testhelpers.MockLotteryDrawRepository))
+
+		_, err := service.Export(ctx, guildID, entities.ExportDatasetBalanceHistory, entities.ExportFormatCSV, from, to, &bytes.Buffer{})
+
+		assert.ErrorContains(t, err, "connection reset")
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		service := NewExportService(new(testhelpers.MockBalanceHistoryRepository), new(testhelpers.MockGroupWagerRepository), new(testhelpers.MockLotteryDrawRepository))
+
+		_, err := service.Export(ctx, guildID, "bets", entities.ExportFormatCSV, from, to, &bytes.Buffer{})
+		assert.ErrorContains(t, err, "unknown export dataset")
+
+		_, err = service.Export(ctx, guildID, entities.ExportDatasetBalanceHistory, "xml", from, to, &bytes.Buffer{})
+		assert.ErrorContains(t, err, "unknown export format")
+
+		_, err = service.Export(ctx, guildID, entities.ExportDatasetBalanceHistory, entities.ExportFormatCSV, to, from, &bytes.Buffer{})
+		assert.ErrorContains(t, err, "end date must be after start date")
+
+		_, err = service.Export(ctx, guildID, entities.ExportDatasetBalanceHistory, entities.ExportFormatCSV, from, from.AddDate(2, 0, 0), &bytes.Buffer{})
+		assert.ErrorContains(t, err, "cannot exceed")
+	})
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// featureFlagService implements business logic for per-guild feature flags
+type featureFlagService struct {
+	featureFlagRepo interfaces.GuildFeatureFlagRepository
+}
+
+// NewFeatureFlagService creates a new feature flag service
+func NewFeatureFlagService(featureFlagRepo interfaces.GuildFeatureFlagRepository) interfaces.FeatureFlagService {
+	return &featureFlagService{
+		featureFlagRepo: featureFlagRepo,
+	}
+}
+
+// GetFlags returns the guild's feature flags, with defaults for features it hasn't set
+func (s *featureFlagService) GetFlags(ctx context.Context) (entities.FeatureFlags, error) {
+	overrides, err := s.featureFlagRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feature flags: %w", err)
+	}
+	return entities.NewFeatureFlags(overrides), nil
+}
+
+// IsEnabled reports whether a feature is turned on in the guild
+func (s *featureFlagService) IsEnabled(ctx context.Context, feature entities.Feature) (bool, error) {
+	flags, err := s.GetFlags(ctx)
+	if err != nil {
+		return false, err
+	}
+	return flags.IsEnabled(feature), nil
+}
+
+// SetEnabled turns a feature on or off in the guild. Returns false if it was already in that state.
+func (s *featureFlagService) SetEnabled(ctx context.Context, guildID int64, feature entities.Feature, enabled bool, updatedBy int64) (bool, error) {
+	if _, ok := feature.Definition(); !ok {
+		return false, fmt.Errorf("unknown feature %q", feature)
+	}
+
+	flags, err := s.GetFlags(ctx)
+	if err != nil {
+		return false, err
+	}
+	if flags.IsEnabled(feature) == enabled {
+		return false, nil
+	}
+
+	err = s.featureFlagRepo.Set(ctx, &entities.GuildFeatureFlag{
+		GuildID:   guildID,
+		Feature:   feature,
+		Enabled:   enabled,
+		UpdatedBy: &updatedBy,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to set feature flag: %w", err)
+	}
+	return true, nil
+}
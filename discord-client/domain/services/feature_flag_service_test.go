@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureFlagService_IsEnabled(t *testing.T) {
+	t.Parallel()
+
+	repo := new(testhelpers.MockGuildFeatureFlagRepository)
+	service := NewFeatureFlagService(repo)
+
+	repo.On("GetAll", mock.Anything).Return([]*entities.GuildFeatureFlag{
+		{GuildID: 123, Feature: entities.FeatureDuels, Enabled: false},
+	}, nil)
+
+	enabled, err := service.IsEnabled(context.Background(), entities.FeatureDuels)
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	// Features the guild hasn't touched use their default
+	enabled, err = service.IsEnabled(context.Background(), entities.FeatureLottery)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+}
+
+func TestFeatureFlagService_SetEnabled(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disables a feature", func(t *testing.T) {
+		repo := new(testhelpers.MockGuildFeatureFlagRepository)
+		service := NewFeatureFlagService(repo)
+
+		repo.On("GetAll", mock.Anything).Return([]*entities.GuildFeatureFlag{}, nil)
+		repo.On("Set", mock.Anything, mock.MatchedBy(func(f *entities.GuildFeatureFlag) bool {
+			return f.GuildID == 123 && f.Feature == entities.FeatureTftWagers && !f.Enabled &&
+				f.UpdatedBy != nil && *f.UpdatedBy == 42
+		})).Return(nil)
+
+		changed, err := service.SetEnabled(context.Background(), 123, entities.FeatureTftWagers, false, 42)
+
+		require.NoError(t, err)
+		assert.True(t, changed)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("reports unchanged state", func(t *testing.T) {
+		repo := new(testhelpers.MockGuildFeatureFlagRepository)
+		service := NewFeatureFlagService(repo)
+
+		repo.On("GetAll", mock.Anything).Return([]*entities.GuildFeatureFlag{}, nil)
+
+		changed, err := service.SetEnabled(context.Background(), 123, entities.FeatureLottery, true, 42)
+
+		require.NoError(t, err)
+		assert.False(t, changed)
+		repo.AssertNotCalled(t, "Set", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects unknown feature", func(t *testing.T) {
+		repo := new(testhelpers.MockGuildFeatureFlagRepository)
+		service := NewFeatureFlagService(repo)
+
+		_, err := service.SetEnabled(context.Background(), 123, entities.Feature("casino"), false, 42)
+
+		assert.Error(t, err)
+		repo.AssertNotCalled(t, "GetAll", mock.Anything)
+	})
+
+	t.Run("wraps repository errors", func(t *testing.T) {
+		repo := new(testhelpers.MockGuildFeatureFlagRepository)
+		service := NewFeatureFlagService(repo)
+
+		repo.On("GetAll", mock.Anything).Return([]*entities.GuildFeatureFlag{}, nil)
+		repo.On("Set", mock.Anything, mock.Anything).Return(errors.New("db down"))
+
+		_, err := service.SetEnabled(context.Background(), 123, entities.FeatureDuels, false, 42)
+
+		assert.ErrorContains(t, err, "failed to set feature flag")
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"time"
 
 	"gambler/discord-client/domain/entities"
 	"gambler/discord-client/domain/interfaces"
@@ -14,26 +15,52 @@ type gamblingService struct {
 	userRepo           interfaces.UserRepository
 	betRepo            interfaces.BetRepository
 	balanceHistoryRepo interfaces.BalanceHistoryRepository
+	guildSettingsRepo  interfaces.GuildSettingsRepository
 	eventPublisher     interfaces.EventPublisher
+	balanceGuard       *BalanceGuard
 }
 
 // NewGamblingService creates a new gambling service
-func NewGamblingService(userRepo interfaces.UserRepository, betRepo interfaces.BetRepository, balanceHistoryRepo interfaces.BalanceHistoryRepository, eventPublisher interfaces.EventPublisher) interfaces.GamblingService {
+func NewGamblingService(userRepo interfaces.UserRepository, betRepo interfaces.BetRepository, balanceHistoryRepo interfaces.BalanceHistoryRepository, guildSettingsRepo interfaces.GuildSettingsRepository, eventPublisher interfaces.EventPublisher) interfaces.GamblingService {
 	return &gamblingService{
 		userRepo:           userRepo,
 		betRepo:            betRepo,
 		balanceHistoryRepo: balanceHistoryRepo,
+		guildSettingsRepo:  guildSettingsRepo,
 		eventPublisher:     eventPublisher,
+		balanceGuard:       NewBalanceGuard(guildSettingsRepo),
 	}
 }
 
-func (s *gamblingService) PlaceBet(ctx context.Context, discordID int64, winProbability float64, betAmount int64) (*entities.BetResult, error) {
+// PlaceBetWithAmount places a bet of a typed amount, resolving all, half or a percentage against the
+// user's available balance in the same transaction as the bet
+func (s *gamblingService) PlaceBetWithAmount(ctx context.Context, discordID int64, guildID int64, winProbability float64, amount entities.Amount) (*entities.BetResult, error) {
+	betAmount, err := utils.ResolveAmount(ctx, s.userRepo, discordID, amount)
+	if err != nil {
+		return nil, err
+	}
+	return s.PlaceBet(ctx, discordID, guildID, winProbability, betAmount)
+}
+
+func (s *gamblingService) PlaceBet(ctx context.Context, discordID int64, guildID int64, winProbability float64, betAmount int64) (*entities.BetResult, error) {
 	// Validate inputs
 	if winProbability <= 0 || winProbability >= 1 {
 		return nil, fmt.Errorf("win probability must be between 0 and 1 (exclusive)")
 	}
 	if betAmount <= 0 {
-		return nil, fmt.Errorf("bet amount must be positive")
+		return nil, fmt.Errorf("bet %w", entities.ErrNonPositiveAmount)
+	}
+
+	// Betting is disabled during the guild's curfew window
+	guildSettings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	if err := guildSettings.CheckBettingCurfew(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := guildSettings.CheckMinBet(betAmount); err != nil {
+		return nil, err
 	}
 
 	// Get current user state (for calculating new balance)
@@ -42,7 +69,16 @@ func (s *gamblingService) PlaceBet(ctx context.Context, discordID int64, winProb
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 	if user == nil {
-		return nil, fmt.Errorf("user not found")
+		return nil, entities.ErrUserNotFound
+	}
+	if err := user.CheckGamblingBreak(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := CheckNotFrozen(time.Now(), user); err != nil {
+		return nil, err
+	}
+	if err := s.balanceGuard.CheckSpend(ctx, guildID, user.AvailableBalance, betAmount); err != nil {
+		return nil, err
 	}
 
 	// Calculate potential win amount (no house edge)
@@ -73,7 +109,7 @@ func (s *gamblingService) PlaceBet(ctx context.Context, discordID int64, winProb
 
 		// Check if sufficient balance before updating
 		if user.AvailableBalance < betAmount {
-			return nil, fmt.Errorf("insufficient balance: have %s available, need %s", utils.FormatShortNotation(user.AvailableBalance), utils.FormatShortNotation(betAmount))
+			return nil, fmt.Errorf("%w: have %s available, need %s", entities.ErrInsufficientBalance, utils.FormatShortNotation(user.AvailableBalance), utils.FormatShortNotation(betAmount))
 		}
 
 		// Update balance with bet deduction
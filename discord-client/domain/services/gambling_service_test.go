@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"gambler/discord-client/config"
 	"gambler/discord-client/domain/entities"
@@ -13,6 +14,8 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+const testGamblingGuildID = 987654
+
 func TestGamblingService_PlaceBet_Win(t *testing.T) {
 	// Set up test config
 	config.SetTestConfig(config.NewTestConfig())
@@ -23,9 +26,11 @@ func TestGamblingService_PlaceBet_Win(t *testing.T) {
 	mockUserRepo := new(testhelpers.MockUserRepository)
 	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
 	mockBetRepo := new(testhelpers.MockBetRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(testGamblingGuildID)).Return(&entities.GuildSettings{GuildID: testGamblingGuildID}, nil).Maybe()
 	mockEventPublisher := new(testhelpers.MockEventPublisher)
 
-	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockEventPublisher)
+	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
 
 	existingUser := &entities.User{
 		DiscordID:        123456,
@@ -62,7 +67,7 @@ func TestGamblingService_PlaceBet_Win(t *testing.T) {
 	mockEventPublisher.On("Publish", mock.AnythingOfType("events.BalanceChangeEvent")).Return(nil)
 
 	// Force a win by setting a high probability
-	result, err := service.PlaceBet(ctx, 123456, 0.99, 1000)
+	result, err := service.PlaceBet(ctx, 123456, testGamblingGuildID, 0.99, 1000)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -87,9 +92,11 @@ func TestGamblingService_PlaceBet_Loss(t *testing.T) {
 	mockUserRepo := new(testhelpers.MockUserRepository)
 	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
 	mockBetRepo := new(testhelpers.MockBetRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(testGamblingGuildID)).Return(&entities.GuildSettings{GuildID: testGamblingGuildID}, nil).Maybe()
 	mockEventPublisher := new(testhelpers.MockEventPublisher)
 
-	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockEventPublisher)
+	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
 
 	existingUser := &entities.User{
 		DiscordID:        123456,
@@ -124,7 +131,7 @@ func TestGamblingService_PlaceBet_Loss(t *testing.T) {
 	mockEventPublisher.On("Publish", mock.AnythingOfType("events.BalanceChangeEvent")).Return(nil)
 
 	// Force a loss by setting a very low probability
-	result, err := service.PlaceBet(ctx, 123456, 0.01, 1000)
+	result, err := service.PlaceBet(ctx, 123456, testGamblingGuildID, 0.01, 1000)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -146,23 +153,25 @@ func TestGamblingService_PlaceBet_InvalidProbability(t *testing.T) {
 	mockUserRepo := new(testhelpers.MockUserRepository)
 	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
 	mockBetRepo := new(testhelpers.MockBetRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(testGamblingGuildID)).Return(&entities.GuildSettings{GuildID: testGamblingGuildID}, nil).Maybe()
 	mockEventPublisher := new(testhelpers.MockEventPublisher)
-	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockEventPublisher)
+	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
 
 	// Test probability too low
-	result, err := service.PlaceBet(ctx, 123456, 0.0, 1000)
+	result, err := service.PlaceBet(ctx, 123456, testGamblingGuildID, 0.0, 1000)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "win probability must be between 0 and 1 (exclusive)")
 
 	// Test probability too high
-	result, err = service.PlaceBet(ctx, 123456, 1.0, 1000)
+	result, err = service.PlaceBet(ctx, 123456, testGamblingGuildID, 1.0, 1000)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "win probability must be between 0 and 1 (exclusive)")
 
 	// Test negative probability
-	result, err = service.PlaceBet(ctx, 123456, -0.1, 1000)
+	result, err = service.PlaceBet(ctx, 123456, testGamblingGuildID, -0.1, 1000)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "win probability must be between 0 and 1 (exclusive)")
@@ -178,17 +187,19 @@ func TestGamblingService_PlaceBet_InvalidAmount(t *testing.T) {
 	mockUserRepo := new(testhelpers.MockUserRepository)
 	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
 	mockBetRepo := new(testhelpers.MockBetRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(testGamblingGuildID)).Return(&entities.GuildSettings{GuildID: testGamblingGuildID}, nil).Maybe()
 	mockEventPublisher := new(testhelpers.MockEventPublisher)
-	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockEventPublisher)
+	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
 
 	// Test negative amount
-	result, err := service.PlaceBet(ctx, 123456, 0.5, -100)
+	result, err := service.PlaceBet(ctx, 123456, testGamblingGuildID, 0.5, -100)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "bet amount must be positive")
 
 	// Test zero amount
-	result, err = service.PlaceBet(ctx, 123456, 0.5, 0)
+	result, err = service.PlaceBet(ctx, 123456, testGamblingGuildID, 0.5, 0)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "bet amount must be positive")
@@ -206,9 +217,11 @@ func TestGamblingService_PlaceBet_InsufficientBalance(t *testing.T) {
 	mockUserRepo := new(testhelpers.MockUserRepository)
 	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
 	mockBetRepo := new(testhelpers.MockBetRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(testGamblingGuildID)).Return(&entities.GuildSettings{GuildID: testGamblingGuildID}, nil).Maybe()
 	mockEventPublisher := new(testhelpers.MockEventPublisher)
 
-	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockEventPublisher)
+	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
 
 	existingUser := &entities.User{
 		DiscordID:        123456,
@@ -221,10 +234,11 @@ func TestGamblingService_PlaceBet_InsufficientBalance(t *testing.T) {
 	// No UpdateBalance call expected - service layer will catch insufficient balance before calling repository
 
 	// Force a loss to trigger deduction
-	result, err := service.PlaceBet(ctx, 123456, 0.01, 1000)
+	result, err := service.PlaceBet(ctx, 123456, testGamblingGuildID, 0.01, 1000)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
+	assert.ErrorIs(t, err, entities.ErrInsufficientBalance)
 	assert.Contains(t, err.Error(), "insufficient balance: have 500 available, need 1.0k")
 
 	mockUserRepo.AssertExpectations(t)
@@ -242,13 +256,15 @@ func TestGamblingService_PlaceBet_UserNotFound(t *testing.T) {
 	mockUserRepo := new(testhelpers.MockUserRepository)
 	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
 	mockBetRepo := new(testhelpers.MockBetRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(testGamblingGuildID)).Return(&entities.GuildSettings{GuildID: testGamblingGuildID}, nil).Maybe()
 	mockEventPublisher := new(testhelpers.MockEventPublisher)
 
-	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockEventPublisher)
+	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
 
 	mockUserRepo.On("GetByDiscordID", ctx, int64(123456)).Return(nil, nil) // User not found
 
-	result, err := service.PlaceBet(ctx, 123456, 0.5, 1000)
+	result, err := service.PlaceBet(ctx, 123456, testGamblingGuildID, 0.5, 1000)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -269,9 +285,11 @@ func TestGamblingService_PlaceBet_TransactionRollback(t *testing.T) {
 	mockUserRepo := new(testhelpers.MockUserRepository)
 	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
 	mockBetRepo := new(testhelpers.MockBetRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(testGamblingGuildID)).Return(&entities.GuildSettings{GuildID: testGamblingGuildID}, nil).Maybe()
 	mockEventPublisher := new(testhelpers.MockEventPublisher)
 
-	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockEventPublisher)
+	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
 
 	existingUser := &entities.User{
 		DiscordID:        123456,
@@ -296,7 +314,7 @@ func TestGamblingService_PlaceBet_TransactionRollback(t *testing.T) {
 	mockEventPublisher.On("Publish", mock.AnythingOfType("events.BalanceChangeEvent")).Return(nil)
 
 	// Force a win
-	result, err := service.PlaceBet(ctx, 123456, 0.99, 1000)
+	result, err := service.PlaceBet(ctx, 123456, testGamblingGuildID, 0.99, 1000)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -307,3 +325,106 @@ func TestGamblingService_PlaceBet_TransactionRollback(t *testing.T) {
 	mockBetRepo.AssertExpectations(t)
 	mockEventPublisher.AssertExpectations(t)
 }
+
+func TestGamblingService_PlaceBet_GamblingBreak(t *testing.T) {
+	// Set up test config
+	config.SetTestConfig(config.NewTestConfig())
+
+	ctx := context.Background()
+
+	// Setup mocks
+	mockUserRepo := new(testhelpers.MockUserRepository)
+	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+	mockBetRepo := new(testhelpers.MockBetRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(testGamblingGuildID)).Return(&entities.GuildSettings{GuildID: testGamblingGuildID}, nil).Maybe()
+	mockEventPublisher := new(testhelpers.MockEventPublisher)
+
+	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
+
+	breakEndsAt := time.Now().Add(24 * time.Hour)
+	existingUser := &entities.User{
+		DiscordID:           123456,
+		Username:            "testuser",
+		Balance:             10000,
+		AvailableBalance:    10000,
+		GamblingBreakEndsAt: &breakEndsAt,
+	}
+
+	mockUserRepo.On("GetByDiscordID", ctx, int64(123456)).Return(existingUser, nil)
+
+	result, err := service.PlaceBet(ctx, 123456, testGamblingGuildID, 0.5, 1000)
+
+	assert.ErrorIs(t, err, entities.ErrGamblingBreakActive)
+	assert.Nil(t, result)
+
+	mockUserRepo.AssertExpectations(t)
+	mockUserRepo.AssertNotCalled(t, "UpdateBalance")
+	mockBalanceHistoryRepo.AssertNotCalled(t, "Record")
+	mockBetRepo.AssertNotCalled(t, "Create")
+}
+
+func TestGamblingService_PlaceBet_BelowMinBet(t *testing.T) {
+	// Set up test config
+	config.SetTestConfig(config.NewTestConfig())
+
+	ctx := context.Background()
+
+	// Setup mocks
+	mockUserRepo := new(testhelpers.MockUserRepository)
+	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+	mockBetRepo := new(testhelpers.MockBetRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	mockEventPublisher := new(testhelpers.MockEventPublisher)
+
+	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
+
+	minBet := int64(500)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(testGamblingGuildID)).Return(&entities.GuildSettings{
+		GuildID:      testGamblingGuildID,
+		MinBetAmount: &minBet,
+	}, nil)
+
+	result, err := service.PlaceBet(ctx, 123456, testGamblingGuildID, 0.5, 100)
+
+	assert.ErrorIs(t, err, entities.ErrBelowMinBet)
+	assert.Nil(t, result)
+
+	mockGuildSettingsRepo.AssertExpectations(t)
+	mockUserRepo.AssertNotCalled(t, "GetByDiscordID")
+	mockBetRepo.AssertNotCalled(t, "Create")
+}
+
+func TestGamblingService_PlaceBet_BettingCurfew(t *testing.T) {
+	// Set up test config
+	config.SetTestConfig(config.NewTestConfig())
+
+	ctx := context.Background()
+
+	// Setup mocks
+	mockUserRepo := new(testhelpers.MockUserRepository)
+	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+	mockBetRepo := new(testhelpers.MockBetRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	mockEventPublisher := new(testhelpers.MockEventPublisher)
+
+	service := NewGamblingService(mockUserRepo, mockBetRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
+
+	// Curfew window covering the current hour
+	startHour := time.Now().UTC().Hour()
+	endHour := (startHour + 1) % 24
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(testGamblingGuildID)).Return(&entities.GuildSettings{
+		GuildID:         testGamblingGuildID,
+		CurfewStartHour: &startHour,
+		CurfewEndHour:   &endHour,
+	}, nil)
+
+	result, err := service.PlaceBet(ctx, 123456, testGamblingGuildID, 0.5, 1000)
+
+	assert.ErrorIs(t, err, entities.ErrBettingCurfewActive)
+	assert.Nil(t, result)
+
+	mockGuildSettingsRepo.AssertExpectations(t)
+	mockUserRepo.AssertNotCalled(t, "GetByDiscordID")
+	mockBetRepo.AssertNotCalled(t, "Create")
+}
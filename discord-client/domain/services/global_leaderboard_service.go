@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// globalLeaderboardService implements the cross-guild prediction leaderboard
+type globalLeaderboardService struct {
+	leaderboardRepo interfaces.GlobalLeaderboardRepository
+}
+
+// NewGlobalLeaderboardService creates a new global leaderboard service
+func NewGlobalLeaderboardService(leaderboardRepo interfaces.GlobalLeaderboardRepository) interfaces.GlobalLeaderboardService {
+	return &globalLeaderboardService{
+		leaderboardRepo: leaderboardRepo,
+	}
+}
+
+// GetLeaderboard returns the players with at least minPredictions resolved predictions ranked by the chosen stat
+func (s *globalLeaderboardService) GetLeaderboard(ctx context.Context, viewerGuildID int64, by entities.GlobalLeaderboardSort, minPredictions int) ([]*entities.GlobalLeaderboardEntry, error) {
+	if !by.IsValid() {
+		return nil, fmt.Errorf("unknown leaderboard sort %q", by)
+	}
+	if minPredictions < 1 {
+		minPredictions = 1
+	}
+
+	entries, err := s.leaderboardRepo.GetPredictionEntries(ctx, viewerGuildID, minPredictions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get global leaderboard: %w", err)
+	}
+
+	return entities.RankGlobalLeaderboard(entries, by, minPredictions), nil
+}
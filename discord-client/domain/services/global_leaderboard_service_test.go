@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalLeaderboardService_GetLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ranks entries", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		repo := new(testhelpers.MockGlobalLeaderboardRepository)
+		repo.On("GetPredictionEntries", ctx, int64(555), 10).Return([]*entities.GlobalLeaderboardEntry{
+			{DiscordID: 1, CorrectPredictions: 5, TotalPredictions: 10},
+			{DiscordID: 2, CorrectPredictions: 9, TotalPredictions: 10},
+		}, nil)
+
+		service := NewGlobalLeaderboardService(repo)
+		ranked, err := service.GetLeaderboard(ctx, 555, entities.GlobalLeaderboardSortAccuracy, 10)
+
+		require.NoError(t, err)
+		require.Len(t, ranked, 2)
+		assert.Equal(t, int64(2), ranked[0].DiscordID)
+		assert.Equal(t, 1, ranked[0].Rank)
+	})
+
+	t.Run("rejects unknown sort", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(testhelpers.MockGlobalLeaderboardRepository)
+
+		service := NewGlobalLeaderboardService(repo)
+		_, err := service.GetLeaderboard(context.Background(), 555, "volume", 10)
+
+		assert.ErrorContains(t, err, "unknown leaderboard sort")
+		repo.AssertNotCalled(t, "GetPredictionEntries")
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		repo := new(testhelpers.MockGlobalLeaderboardRepository)
+		repo.On("GetPredictionEntries", ctx, int64(555), 10).Return(nil, errors.New("db down"))
+
+		service := NewGlobalLeaderboardService(repo)
+		_, err := service.GetLeaderboard(ctx, 555, entities.GlobalLeaderboardSortProfit, 10)
+
+		assert.Error(t, err)
+	})
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"gambler/discord-client/domain/entities"
@@ -91,11 +92,11 @@ func (s *GroupWagerService) CanUserPlaceBet(wager *entities.GroupWager, user *en
 	}
 	
 	if amount <= 0 {
-		return errors.New("bet amount must be positive")
+		return fmt.Errorf("bet %w", entities.ErrNonPositiveAmount)
 	}
 	
 	if !user.CanAfford(amount) {
-		return errors.New("insufficient available balance")
+		return entities.ErrInsufficientBalance
 	}
 	
 	// Validate option exists (would need options list to validate)
@@ -187,9 +188,9 @@ func (s *GroupWagerService) CalculateHouseWagerPayouts(
 	result.Winners = winners
 	result.Losers = losers
 	
-	// Calculate payouts using fixed odds
+	// Calculate payouts using the odds each bet was locked in at
 	for _, winner := range winners {
-		payout := int64(float64(winner.Amount) * winningOption.OddsMultiplier)
+		payout := int64(float64(winner.Amount) * winner.GetPayoutMultiplier(winningOption))
 		result.PayoutDetails[winner.DiscordID] = payout
 	}
 	
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// groupWagerFollowService implements business logic for following group wagers
+type groupWagerFollowService struct {
+	groupWagerRepo interfaces.GroupWagerRepository
+	followerRepo   interfaces.GroupWagerFollowerRepository
+}
+
+// NewGroupWagerFollowService creates a new group wager follow service
+func NewGroupWagerFollowService(
+	groupWagerRepo interfaces.GroupWagerRepository,
+	followerRepo interfaces.GroupWagerFollowerRepository,
+) interfaces.GroupWagerFollowService {
+	return &groupWagerFollowService{
+		groupWagerRepo: groupWagerRepo,
+		followerRepo:   followerRepo,
+	}
+}
+
+// ToggleFollow follows the wager, or unfollows it if the user already follows it.
+// Returns whether the user now follows the wager.
+func (s *groupWagerFollowService) ToggleFollow(ctx context.Context, groupWagerID, discordID int64) (bool, error) {
+	unfollowed, err := s.followerRepo.Unfollow(ctx, groupWagerID, discordID)
+	if err != nil {
+		return false, err
+	}
+	if unfollowed {
+		return false, nil
+	}
+
+	groupWager, err := s.groupWagerRepo.GetByID(ctx, groupWagerID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get group wager: %w", err)
+	}
+	if groupWager == nil {
+		return false, entities.ErrGroupWagerNotFound
+	}
+	if !groupWager.CanBeFollowed() {
+		return false, entities.ErrGroupWagerNotFollowable
+	}
+
+	if _, err := s.followerRepo.Follow(ctx, groupWagerID, discordID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetFollowers returns the Discord IDs following the wager
+func (s *groupWagerFollowService) GetFollowers(ctx context.Context, groupWagerID int64) ([]int64, error) {
+	return s.followerRepo.GetFollowers(ctx, groupWagerID)
+}
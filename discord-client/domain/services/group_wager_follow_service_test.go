@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWagerFollowService_ToggleFollow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	newService := func() (*groupWagerFollowService, *testhelpers.MockGroupWagerRepository, *testhelpers.MockGroupWagerFollowerRepository) {
+		groupWagerRepo := new(testhelpers.MockGroupWagerRepository)
+		followerRepo := new(testhelpers.MockGroupWagerFollowerRepository)
+		return &groupWagerFollowService{groupWagerRepo: groupWagerRepo, followerRepo: followerRepo}, groupWagerRepo, followerRepo
+	}
+
+	t.Run("follows an active wager", func(t *testing.T) {
+		service, groupWagerRepo, followerRepo := newService()
+		followerRepo.On("Unfollow", ctx, int64(1), int64(99)).Return(false, nil)
+		groupWagerRepo.On("GetByID", ctx, int64(1)).Return(&entities.GroupWager{ID: 1, State: entities.GroupWagerStateActive}, nil)
+		followerRepo.On("Follow", ctx, int64(1), int64(99)).Return(true, nil)
+
+		following, err := service.ToggleFollow(ctx, 1, 99)
+
+		require.NoError(t, err)
+		assert.True(t, following)
+		followerRepo.AssertExpectations(t)
+	})
+
+	t.Run("unfollows a followed wager", func(t *testing.T) {
+		service, groupWagerRepo, followerRepo := newService()
+		followerRepo.On("Unfollow", ctx, int64(1), int64(99)).Return(true, nil)
+
+		following, err := service.ToggleFollow(ctx, 1, 99)
+
+		require.NoError(t, err)
+		assert.False(t, following)
+		groupWagerRepo.AssertNotCalled(t, "GetByID")
+		followerRepo.AssertNotCalled(t, "Follow")
+	})
+
+	t.Run("rejects a resolved wager", func(t *testing.T) {
+		service, groupWagerRepo, followerRepo := newService()
+		followerRepo.On("Unfollow", ctx, int64(1), int64(99)).Return(false, nil)
+		groupWagerRepo.On("GetByID", ctx, int64(1)).Return(&entities.GroupWager{ID: 1, State: entities.GroupWagerStateResolved}, nil)
+
+		_, err := service.ToggleFollow(ctx, 1, 99)
+
+		assert.ErrorIs(t, err, entities.ErrGroupWagerNotFollowable)
+		followerRepo.AssertNotCalled(t, "Follow")
+	})
+
+	t.Run("unknown wager", func(t *testing.T) {
+		service, groupWagerRepo, followerRepo := newService()
+		followerRepo.On("Unfollow", ctx, int64(1), int64(99)).Return(false, nil)
+		groupWagerRepo.On("GetByID", ctx, int64(1)).Return(nil, nil)
+
+		_, err := service.ToggleFollow(ctx, 1, 99)
+
+		assert.ErrorContains(t, err, "not found")
+	})
+}
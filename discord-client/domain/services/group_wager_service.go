@@ -8,6 +8,7 @@ import (
 	"gambler/discord-client/domain/events"
 	"gambler/discord-client/domain/interfaces"
 	"gambler/discord-client/domain/utils"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,7 +20,11 @@ type groupWagerService struct {
 	groupWagerRepo     interfaces.GroupWagerRepository
 	userRepo           interfaces.UserRepository
 	balanceHistoryRepo interfaces.BalanceHistoryRepository
+	guildSettingsRepo  interfaces.GuildSettingsRepository
+	guildResolverRepo  interfaces.GuildResolverRepository
+	lotteryDrawRepo    interfaces.LotteryDrawRepository
 	eventPublisher     interfaces.EventPublisher
+	balanceGuard       *BalanceGuard
 }
 
 // NewGroupWagerService creates a new group wager service
@@ -27,6 +32,9 @@ func NewGroupWagerService(
 	groupWagerRepo interfaces.GroupWagerRepository,
 	userRepo interfaces.UserRepository,
 	balanceHistoryRepo interfaces.BalanceHistoryRepository,
+	guildSettingsRepo interfaces.GuildSettingsRepository,
+	guildResolverRepo interfaces.GuildResolverRepository,
+	lotteryDrawRepo interfaces.LotteryDrawRepository,
 	eventPublisher interfaces.EventPublisher,
 ) interfaces.GroupWagerService {
 	return &groupWagerService{
@@ -34,12 +42,16 @@ func NewGroupWagerService(
 		groupWagerRepo:     groupWagerRepo,
 		userRepo:           userRepo,
 		balanceHistoryRepo: balanceHistoryRepo,
+		guildSettingsRepo:  guildSettingsRepo,
+		guildResolverRepo:  guildResolverRepo,
+		lotteryDrawRepo:    lotteryDrawRepo,
 		eventPublisher:     eventPublisher,
+		balanceGuard:       NewBalanceGuard(guildSettingsRepo),
 	}
 }
 
 // CreateGroupWager creates a new group wager with options
-func (s *groupWagerService) CreateGroupWager(ctx context.Context, creatorID *int64, condition string, options []string, votingPeriodMinutes int, messageID, channelID int64, wagerType entities.GroupWagerType, oddsMultipliers []float64) (*entities.GroupWagerDetail, error) {
+func (s *groupWagerService) CreateGroupWager(ctx context.Context, guildID int64, creatorID *int64, condition string, options []string, votingPeriodMinutes int, messageID, channelID int64, wagerType entities.GroupWagerType, oddsMultipliers []float64, maxTotalAmounts []int64) (*entities.GroupWagerDetail, error) {
 	// Validate inputs
 	if condition == "" {
 		return nil, fmt.Errorf("condition cannot be empty")
@@ -47,8 +59,9 @@ func (s *groupWagerService) CreateGroupWager(ctx context.Context, creatorID *int
 	if len(options) < 2 {
 		return nil, fmt.Errorf("must provide at least 2 options")
 	}
-	if votingPeriodMinutes < 5 || votingPeriodMinutes > 10080 {
-		return nil, fmt.Errorf("voting period must be between 5 minutes and 168 hours (10080 minutes)")
+	if votingPeriodMinutes < entities.MinVotingPeriodMinutes || votingPeriodMinutes > entities.MaxVotingPeriodMinutes {
+		return nil, fmt.Errorf("voting period must be between %d minutes and %d hours (%d minutes)",
+			entities.MinVotingPeriodMinutes, entities.MaxVotingPeriodMinutes/60, entities.MaxVotingPeriodMinutes)
 	}
 
 	// Validate wager type
@@ -73,12 +86,27 @@ func (s *groupWagerService) CreateGroupWager(ctx context.Context, creatorID *int
 		}
 	}
 
-	// Check for duplicate options (case-insensitive)
+	// Validate per-option caps (0 leaves an option uncapped)
+	if len(maxTotalAmounts) > 0 {
+		if len(maxTotalAmounts) != len(options) {
+			return nil, fmt.Errorf("must provide a cap for each option")
+		}
+		for i, maxTotal := range maxTotalAmounts {
+			if maxTotal < 0 {
+				return nil, fmt.Errorf("cap for option %d cannot be negative", i+1)
+			}
+		}
+	}
+
+	// Split each option's bet button emoji and colour from its text, then check for duplicate
+	// options (case-insensitive)
+	optionLines := make([]entities.GroupWagerOptionLine, len(options))
 	optionMap := make(map[string]bool)
-	for _, option := range options {
-		lowerOption := strings.ToLower(strings.TrimSpace(option))
+	for i, option := range options {
+		optionLines[i] = entities.ParseGroupWagerOptionLine(option)
+		lowerOption := strings.ToLower(optionLines[i].Text)
 		if optionMap[lowerOption] {
-			return nil, fmt.Errorf("duplicate option found: '%s'. Each option must be unique", option)
+			return nil, fmt.Errorf("duplicate option found: '%s'. Each option must be unique", optionLines[i].Text)
 		}
 		optionMap[lowerOption] = true
 	}
@@ -92,6 +120,14 @@ func (s *groupWagerService) CreateGroupWager(ctx context.Context, creatorID *int
 		if creator == nil {
 			return nil, fmt.Errorf("creator %d not found", *creatorID)
 		}
+		if err := creator.CheckGamblingBreak(time.Now()); err != nil {
+			return nil, err
+		}
+
+		// Players can't open new wagers during the guild's curfew window; system wagers still follow live games
+		if err := s.balanceGuard.CheckBettingCurfew(ctx, guildID, time.Now()); err != nil {
+			return nil, err
+		}
 	}
 
 	// Calculate voting period times
@@ -121,7 +157,7 @@ func (s *groupWagerService) CreateGroupWager(ctx context.Context, creatorID *int
 
 	// Create options
 	var wagerOptions []*entities.GroupWagerOption
-	for i, optionText := range options {
+	for i, optionLine := range optionLines {
 		var odds float64
 		if wagerType == entities.GroupWagerTypeHouse {
 			odds = oddsMultipliers[i]
@@ -131,11 +167,15 @@ func (s *groupWagerService) CreateGroupWager(ctx context.Context, creatorID *int
 		}
 
 		option := &entities.GroupWagerOption{
-			OptionText:     optionText,
 			OptionOrder:    int16(i),
 			TotalAmount:    0,
 			OddsMultiplier: odds,
 		}
+		optionLine.Apply(option)
+		if len(maxTotalAmounts) > 0 && maxTotalAmounts[i] > 0 {
+			maxTotal := maxTotalAmounts[i]
+			option.MaxTotalAmount = &maxTotal
+		}
 		wagerOptions = append(wagerOptions, option)
 	}
 
@@ -144,6 +184,22 @@ func (s *groupWagerService) CreateGroupWager(ctx context.Context, creatorID *int
 		return nil, fmt.Errorf("failed to create group wager with options: %w", err)
 	}
 
+	optionPayloads := make([]map[string]any, 0, len(wagerOptions))
+	for _, option := range wagerOptions {
+		optionPayloads = append(optionPayloads, map[string]any{
+			"option_id": option.ID,
+			"text":      option.OptionText,
+			"odds":      option.OddsMultiplier,
+		})
+	}
+	if err := s.recordEvent(ctx, groupWager.ID, entities.GroupWagerEventCreated, creatorID, map[string]any{
+		"wager_type": groupWager.WagerType,
+		"condition":  groupWager.Condition,
+		"options":    optionPayloads,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &entities.GroupWagerDetail{
 		Wager:        groupWager,
 		Options:      wagerOptions,
@@ -151,38 +207,172 @@ func (s *groupWagerService) CreateGroupWager(ctx context.Context, creatorID *int
 	}, nil
 }
 
+// checkAcceptingBets explains why a group wager is not taking bets, or returns nil if it is
+func checkAcceptingBets(groupWager *entities.GroupWager) error {
+	if groupWager.CanAcceptBets() {
+		return nil
+	}
+	if groupWager.IsActive() && groupWager.IsVotingPeriodExpired() {
+		return fmt.Errorf("%w, bets can no longer be placed or changed", entities.ErrVotingEnded)
+	}
+	// Provide user-friendly error messages for specific states
+	switch groupWager.State {
+	case entities.GroupWagerStateResolved:
+		return fmt.Errorf("cannot place bets on resolved wager")
+	case entities.GroupWagerStateCancelled:
+		return fmt.Errorf("cannot place bets on cancelled wager")
+	default:
+		return fmt.Errorf("group wager is not accepting bets (state: %s)", groupWager.State)
+	}
+}
+
+// PlaceBetWithAmount places a bet of a typed amount, resolving all, half or a percentage against the
+// user's current bet plus their available balance in the same transaction as the bet
+func (s *groupWagerService) PlaceBetWithAmount(ctx context.Context, groupWagerID int64, userID int64, optionID int64, amount entities.Amount) (*entities.GroupWagerParticipant, error) {
+	if !amount.IsRelative() {
+		return s.PlaceBet(ctx, groupWagerID, userID, optionID, amount.Value)
+	}
+
+	user, err := s.userRepo.GetByDiscordID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: %d", entities.ErrUserNotFound, userID)
+	}
+	existingParticipant, err := s.groupWagerRepo.GetParticipant(ctx, groupWagerID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing participation: %w", err)
+	}
+
+	betAmount, err := resolveBetAmount(amount, user, existingParticipant)
+	if err != nil {
+		return nil, err
+	}
+	return s.PlaceBet(ctx, groupWagerID, userID, optionID, betAmount)
+}
+
+// resolveBetAmount resolves a typed bet amount. A bet replaces the user's total on the wager, so relative
+// amounts are taken from their current bet plus their available balance, which excludes that bet.
+func resolveBetAmount(amount entities.Amount, user *entities.User, existing *entities.GroupWagerParticipant) (int64, error) {
+	stakeable := user.AvailableBalance
+	if existing != nil {
+		stakeable += existing.Amount
+	}
+	return amount.Resolve(stakeable)
+}
+
+// PlaceReactionBet bets on an option of a reaction wager. New bettors stake the wager's reaction stake,
+// while existing bettors move their whole bet to the option.
+func (s *groupWagerService) PlaceReactionBet(ctx context.Context, groupWagerID int64, userID int64, optionID int64) (*entities.GroupWagerParticipant, error) {
+	detail, err := s.groupWagerRepo.GetDetailByID(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+	if detail == nil || detail.Wager == nil {
+		return nil, entities.ErrGroupWagerNotFound
+	}
+	if !detail.Wager.IsReactionWager() {
+		return nil, fmt.Errorf("group wager %d does not take bets from reactions", groupWagerID)
+	}
+
+	return s.PlaceBet(ctx, groupWagerID, userID, optionID, detail.ReactionBetAmount(userID))
+}
+
+// PreviewBet shows the option, multiplier and potential payout of a bet at the current odds without placing it.
+// Relative amounts are previewed against the user's current bet plus their available balance.
+func (s *groupWagerService) PreviewBet(ctx context.Context, groupWagerID int64, userID int64, optionID int64, amount entities.Amount) (*entities.GroupWagerBetPreview, error) {
+	if !amount.IsRelative() && amount.Value <= 0 {
+		return nil, fmt.Errorf("bet %w", entities.ErrNonPositiveAmount)
+	}
+
+	detail, err := s.groupWagerRepo.GetDetailByID(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+	if detail == nil || detail.Wager == nil {
+		return nil, entities.ErrGroupWagerNotFound
+	}
+	if err := checkAcceptingBets(detail.Wager); err != nil {
+		return nil, err
+	}
+
+	var selectedOption *entities.GroupWagerOption
+	for _, opt := range detail.Options {
+		if opt.ID == optionID {
+			selectedOption = opt
+			break
+		}
+	}
+	if selectedOption == nil {
+		return nil, fmt.Errorf("invalid option ID")
+	}
+
+	user, err := s.userRepo.GetByDiscordID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: %d", entities.ErrUserNotFound, userID)
+	}
+
+	existingParticipant, err := s.groupWagerRepo.GetParticipant(ctx, groupWagerID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing participation: %w", err)
+	}
+
+	betAmount, err := resolveBetAmount(amount, user, existingParticipant)
+	if err != nil {
+		return nil, err
+	}
+	if existingParticipant == nil {
+		if err := detail.Wager.CheckCanJoin(userID, utils.MemberRolesFromContext(ctx), len(detail.Participants)); err != nil {
+			return nil, err
+		}
+	}
+
+	return detail.PreviewBet(selectedOption, existingParticipant, betAmount, user.AvailableBalance), nil
+}
+
 // PlaceBet allows a user to place or update their bet on a group wager option
 func (s *groupWagerService) PlaceBet(ctx context.Context, groupWagerID int64, userID int64, optionID int64, amount int64) (*entities.GroupWagerParticipant, error) {
 	// Validate amount
 	if amount <= 0 {
-		return nil, fmt.Errorf("bet amount must be positive")
+		return nil, fmt.Errorf("bet %w", entities.ErrNonPositiveAmount)
 	}
 
-	// Get full detail including options and wager
-	detail, err := s.groupWagerRepo.GetDetailByID(ctx, groupWagerID)
+	// Lock the wager and its options, since the option totals and pot are read here and written back below
+	detail, err := s.groupWagerRepo.GetDetailByIDForUpdate(ctx, groupWagerID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
 	}
 	if detail == nil || detail.Wager == nil {
-		return nil, fmt.Errorf("group wager not found")
+		return nil, entities.ErrGroupWagerNotFound
 	}
 
 	groupWager := detail.Wager
 
 	// Check if betting is allowed
-	if !groupWager.CanAcceptBets() {
-		if groupWager.IsActive() && groupWager.IsVotingPeriodExpired() {
-			return nil, fmt.Errorf("voting period has ended, bets can no longer be placed or changed")
-		}
-		// Provide user-friendly error messages for specific states
-		switch groupWager.State {
-		case entities.GroupWagerStateResolved:
-			return nil, fmt.Errorf("cannot place bets on resolved wager")
-		case entities.GroupWagerStateCancelled:
-			return nil, fmt.Errorf("cannot place bets on cancelled wager")
-		default:
-			return nil, fmt.Errorf("group wager is not accepting bets (state: %s)", groupWager.State)
-		}
+	if err := checkAcceptingBets(groupWager); err != nil {
+		return nil, err
+	}
+
+	// Betting is disabled during the guild's curfew window
+	guildSettings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, groupWager.GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	if err := guildSettings.CheckBettingCurfew(time.Now()); err != nil {
+		return nil, err
+	}
+	// Changing a bet checks the new total, so a bet can't be lowered below the minimum either
+	if err := guildSettings.CheckMinBet(amount); err != nil {
+		return nil, err
+	}
+
+	// Players who linked their Riot account can be kept off wagers on their own games
+	if guildSettings.BlockOwnGameBets && groupWager.IsOnPlayersGame(userID) {
+		return nil, entities.ErrBetOnOwnGame
 	}
 
 	options := detail.Options
@@ -204,7 +394,13 @@ func (s *groupWagerService) PlaceBet(ctx context.Context, groupWagerID int64, us
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 	if user == nil {
-		return nil, fmt.Errorf("user %d not found", userID)
+		return nil, fmt.Errorf("%w: %d", entities.ErrUserNotFound, userID)
+	}
+	if err := user.CheckGamblingBreak(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := CheckNotFrozen(time.Now(), user); err != nil {
+		return nil, err
 	}
 
 	// Check for existing participation
@@ -213,6 +409,13 @@ func (s *groupWagerService) PlaceBet(ctx context.Context, groupWagerID int64, us
 		return nil, fmt.Errorf("failed to check existing participation: %w", err)
 	}
 
+	// Invite-only wagers and participant limits only keep out new bettors; existing ones can still change their bet
+	if existingParticipant == nil {
+		if err := groupWager.CheckCanJoin(userID, utils.MemberRolesFromContext(ctx), len(detail.Participants)); err != nil {
+			return nil, err
+		}
+	}
+
 	var previousAmount int64 = 0
 	var previousOptionID int64 = 0
 	if existingParticipant != nil {
@@ -223,7 +426,34 @@ func (s *groupWagerService) PlaceBet(ctx context.Context, groupWagerID int64, us
 	// Calculate the net change in balance needed
 	netChange := amount - previousAmount
 	if user.AvailableBalance < netChange {
-		return nil, fmt.Errorf("insufficient balance: have %s available, need %s more", utils.FormatShortNotation(user.AvailableBalance), utils.FormatShortNotation(netChange))
+		return nil, fmt.Errorf("%w: have %s available, need %s more", entities.ErrInsufficientBalance, utils.FormatShortNotation(user.AvailableBalance), utils.FormatShortNotation(netChange))
+	}
+	if netChange > 0 {
+		if err := s.balanceGuard.CheckSpend(ctx, groupWager.GuildID, user.AvailableBalance, netChange); err != nil {
+			return nil, err
+		}
+	}
+
+	// Reject bets that would push the option past its cap. A bet already on this option
+	// only counts the increase, since its previous amount is already in the total.
+	additional := amount
+	if previousOptionID == optionID {
+		additional = amount - previousAmount
+	}
+	if selectedOption.WouldExceedCap(additional) {
+		return nil, fmt.Errorf("'%s' is capped at %s bits and only has room for %s more",
+			selectedOption.OptionText, utils.FormatShortNotation(*selectedOption.MaxTotalAmount), utils.FormatShortNotation(selectedOption.RemainingCapacity()))
+	}
+
+	// House wager bets are locked to the odds on offer when they are placed, so later
+	// odds changes never affect them. Topping up only prices the added bits at the current odds.
+	var lockedMultiplier *float64
+	if groupWager.IsHouseWager() {
+		odds := selectedOption.OddsMultiplier
+		if existingParticipant != nil {
+			odds = existingParticipant.LockedMultiplierForChange(optionID, amount, selectedOption.OddsMultiplier)
+		}
+		lockedMultiplier = &odds
 	}
 
 	// Create or update participant
@@ -232,6 +462,7 @@ func (s *groupWagerService) PlaceBet(ctx context.Context, groupWagerID int64, us
 		// Update existing
 		existingParticipant.OptionID = optionID
 		existingParticipant.Amount = amount
+		existingParticipant.LockedMultiplier = lockedMultiplier
 		if err := s.groupWagerRepo.SaveParticipant(ctx, existingParticipant); err != nil {
 			return nil, fmt.Errorf("failed to update participant: %w", err)
 		}
@@ -239,10 +470,11 @@ func (s *groupWagerService) PlaceBet(ctx context.Context, groupWagerID int64, us
 	} else {
 		// Create new
 		participant = &entities.GroupWagerParticipant{
-			GroupWagerID: groupWagerID,
-			DiscordID:    userID,
-			OptionID:     optionID,
-			Amount:       amount,
+			GroupWagerID:     groupWagerID,
+			DiscordID:        userID,
+			OptionID:         optionID,
+			Amount:           amount,
+			LockedMultiplier: lockedMultiplier,
 		}
 		if err := s.groupWagerRepo.SaveParticipant(ctx, participant); err != nil {
 			return nil, fmt.Errorf("failed to create participant: %w", err)
@@ -270,11 +502,17 @@ func (s *groupWagerService) PlaceBet(ctx context.Context, groupWagerID int64, us
 		return nil, fmt.Errorf("failed to update option total: %w", err)
 	}
 
+	// A bet in the final minutes of voting pushes the end back so others have time to react
+	window, extension := guildSettings.GetSnipeProtection()
+	votingExtended := groupWager.ExtendVotingForLateBet(time.Now(), window, extension)
+
 	// Update group wager total pot
+	previousPot := groupWager.TotalPot
 	groupWager.TotalPot += netChange
 	if err := s.groupWagerRepo.Update(ctx, groupWager); err != nil {
 		return nil, fmt.Errorf("failed to update group wager pot: %w", err)
 	}
+	potMilestone := entities.GroupWagerPotMilestone(previousPot, groupWager.TotalPot)
 
 	// For pool wagers, recalculate and update odds for all options
 	if groupWager.IsPoolWager() && groupWager.TotalPot > 0 {
@@ -288,6 +526,175 @@ func (s *groupWagerService) PlaceBet(ctx context.Context, groupWagerID int64, us
 		}
 	}
 
+	eventType := entities.GroupWagerEventBetPlaced
+	payload := map[string]any{
+		"option_id": optionID,
+		"amount":    amount,
+		"total_pot": groupWager.TotalPot,
+	}
+	if existingParticipant != nil {
+		eventType = entities.GroupWagerEventBetChanged
+		payload["previous_option_id"] = previousOptionID
+		payload["previous_amount"] = previousAmount
+	}
+	if lockedMultiplier != nil {
+		payload["locked_multiplier"] = *lockedMultiplier
+	}
+	if err := s.recordEvent(ctx, groupWagerID, eventType, &userID, payload); err != nil {
+		return nil, err
+	}
+
+	// Keep the previous choice so the settlement can show who switched sides
+	if existingParticipant != nil && (previousOptionID != optionID || previousAmount != amount) {
+		if err := s.groupWagerRepo.RecordBetChange(ctx, &entities.GroupWagerBetChange{
+			GroupWagerID:     groupWagerID,
+			DiscordID:        userID,
+			PreviousOptionID: previousOptionID,
+			PreviousAmount:   previousAmount,
+			NewOptionID:      optionID,
+			NewAmount:        amount,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if votingExtended {
+		if err := s.recordEvent(ctx, groupWagerID, entities.GroupWagerEventVotingExtended, &userID, map[string]any{
+			"voting_ends_at":    groupWager.VotingEndsAt,
+			"extension_minutes": int(extension.Minutes()),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Refresh the wager message so participants see the new deadline, and let followers know the pot
+	// reached a milestone
+	if votingExtended || potMilestone > 0 {
+		if err := s.eventPublisher.Publish(events.GroupWagerStateChangeEvent{
+			GroupWagerID: groupWager.ID,
+			GuildID:      groupWager.GuildID,
+			OldState:     string(groupWager.State),
+			NewState:     string(groupWager.State),
+			MessageID:    groupWager.MessageID,
+			ChannelID:    groupWager.ChannelID,
+			PotMilestone: potMilestone,
+		}); err != nil {
+			log.WithError(err).Error("Failed to publish group wager state change event")
+		}
+	}
+
+	return participant, nil
+}
+
+// WithdrawBet reduces or removes a user's bet on a pool wager while voting is still open
+func (s *groupWagerService) WithdrawBet(ctx context.Context, groupWagerID int64, userID int64, newAmount int64) (*entities.GroupWagerParticipant, error) {
+	if newAmount < 0 {
+		return nil, fmt.Errorf("bet amount cannot be negative")
+	}
+
+	detail, err := s.groupWagerRepo.GetDetailByIDForUpdate(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+	if detail == nil || detail.Wager == nil {
+		return nil, entities.ErrGroupWagerNotFound
+	}
+
+	groupWager := detail.Wager
+
+	// House wager bets are priced at locked odds, so only pool bets can be cashed out
+	if !groupWager.IsPoolWager() {
+		return nil, fmt.Errorf("only pool wager bets can be reduced or withdrawn")
+	}
+	if !groupWager.CanAcceptBets() {
+		return nil, fmt.Errorf("%w, bets can no longer be reduced or withdrawn", entities.ErrVotingEnded)
+	}
+
+	participant, err := s.groupWagerRepo.GetParticipant(ctx, groupWagerID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant == nil {
+		return nil, fmt.Errorf("you have no bet on this wager")
+	}
+	if newAmount >= participant.Amount {
+		return nil, fmt.Errorf("new amount must be less than your current bet of %s", utils.FormatShortNotation(participant.Amount))
+	}
+
+	// A reduced bet must still meet the minimum; withdrawing entirely is always allowed
+	if newAmount > 0 {
+		guildSettings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, groupWager.GuildID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get guild settings: %w", err)
+		}
+		if err := guildSettings.CheckMinBet(newAmount); err != nil {
+			return nil, err
+		}
+	}
+
+	reduction := participant.Amount - newAmount
+
+	if newAmount == 0 {
+		if err := s.groupWagerRepo.DeleteParticipant(ctx, groupWagerID, userID); err != nil {
+			return nil, fmt.Errorf("failed to remove participant: %w", err)
+		}
+	} else {
+		participant.Amount = newAmount
+		if err := s.groupWagerRepo.SaveParticipant(ctx, participant); err != nil {
+			return nil, fmt.Errorf("failed to update participant: %w", err)
+		}
+	}
+
+	// Update option total
+	for _, opt := range detail.Options {
+		if opt.ID == participant.OptionID {
+			opt.TotalAmount -= reduction
+			if err := s.groupWagerRepo.UpdateOptionTotal(ctx, opt.ID, opt.TotalAmount); err != nil {
+				return nil, fmt.Errorf("failed to update option total: %w", err)
+			}
+			break
+		}
+	}
+
+	// Update group wager total pot
+	groupWager.TotalPot -= reduction
+	if err := s.groupWagerRepo.Update(ctx, groupWager); err != nil {
+		return nil, fmt.Errorf("failed to update group wager pot: %w", err)
+	}
+
+	// Recalculate odds for all options, resetting them if the pot is now empty
+	oddsUpdates := make(map[int64]float64)
+	for _, opt := range detail.Options {
+		oddsUpdates[opt.ID] = opt.CalculateMultiplier(groupWager.TotalPot)
+	}
+	if err := s.groupWagerRepo.UpdateAllOptionOdds(ctx, groupWagerID, oddsUpdates); err != nil {
+		return nil, fmt.Errorf("failed to update option odds: %w", err)
+	}
+
+	if err := s.recordEvent(ctx, groupWagerID, entities.GroupWagerEventBetWithdrawn, &userID, map[string]any{
+		"option_id":       participant.OptionID,
+		"amount":          newAmount,
+		"previous_amount": newAmount + reduction,
+		"total_pot":       groupWager.TotalPot,
+	}); err != nil {
+		return nil, err
+	}
+
+	// Keep the bet's history alongside changes made through PlaceBet
+	if err := s.groupWagerRepo.RecordBetChange(ctx, &entities.GroupWagerBetChange{
+		GroupWagerID:     groupWagerID,
+		DiscordID:        userID,
+		PreviousOptionID: participant.OptionID,
+		PreviousAmount:   newAmount + reduction,
+		NewOptionID:      participant.OptionID,
+		NewAmount:        newAmount,
+	}); err != nil {
+		return nil, err
+	}
+
+	if newAmount == 0 {
+		return nil, nil
+	}
 	return participant, nil
 }
 
@@ -302,6 +709,90 @@ func calculateMaxWinnerBet(winners []*entities.GroupWagerParticipant) int64 {
 	return maxBet
 }
 
+// calculatePayouts splits the participants into the winning option's winners and its losers, and sets
+// each participant's payout
+func calculatePayouts(groupWager *entities.GroupWager, winningOption *entities.GroupWagerOption, participants []*entities.GroupWagerParticipant) (winners, losers []*entities.GroupWagerParticipant, payoutDetails map[int64]int64, maxWinnerBet int64) {
+	winningOptionTotal := winningOption.TotalAmount
+
+	payoutDetails = make(map[int64]int64)
+
+	// Separate winners and losers
+	for _, participant := range participants {
+		if participant.OptionID == winningOption.ID {
+			winners = append(winners, participant)
+		} else {
+			losers = append(losers, participant)
+		}
+	}
+
+	// Calculate max winner bet once for pool wagers
+	if groupWager.IsPoolWager() {
+		maxWinnerBet = calculateMaxWinnerBet(winners)
+	}
+
+	// Calculate payouts based on wager type
+	if groupWager.IsPoolWager() {
+
+		// Calculate effective prize pool with capped losses
+		effectivePrizePool := int64(0)
+
+		// Add capped losses from losers
+		for _, loser := range losers {
+			effectiveLoss := calculateEffectiveLoss(loser.Amount, maxWinnerBet)
+			effectivePrizePool += effectiveLoss
+		}
+
+		// Add winner contributions to prize pool
+		for _, winner := range winners {
+			effectivePrizePool += winner.Amount
+		}
+
+		// Calculate proportional payouts for winners
+		for _, winner := range winners {
+			var payout int64
+			if winningOptionTotal > 0 {
+				payout = (winner.Amount * effectivePrizePool) / winningOptionTotal
+			}
+			winner.PayoutAmount = &payout
+			payoutDetails[winner.DiscordID] = payout
+		}
+
+		// Set loser payouts to 0
+		for _, loser := range losers {
+			zero := int64(0)
+			loser.PayoutAmount = &zero
+			payoutDetails[loser.DiscordID] = 0
+		}
+	} else {
+		// House wager: settle each bet at the odds locked in when it was placed
+		for _, winner := range winners {
+			payout := int64(float64(winner.Amount) * winner.GetPayoutMultiplier(winningOption))
+			winner.PayoutAmount = &payout
+			payoutDetails[winner.DiscordID] = payout
+		}
+
+		for _, loser := range losers {
+			zero := int64(0)
+			loser.PayoutAmount = &zero
+			payoutDetails[loser.DiscordID] = 0
+		}
+	}
+
+	return winners, losers, payoutDetails, maxWinnerBet
+}
+
+// settlementBalanceChange returns the balance change settling a participant whose payout has been calculated.
+// Winners gain their payout beyond their bet; pool wager losses are capped at the biggest winning bet.
+func settlementBalanceChange(groupWager *entities.GroupWager, participant *entities.GroupWagerParticipant, winningOptionID, maxWinnerBet int64) int64 {
+	if participant.OptionID == winningOptionID {
+		return *participant.PayoutAmount - participant.Amount
+	}
+	if groupWager.IsPoolWager() {
+		return -calculateEffectiveLoss(participant.Amount, maxWinnerBet)
+	}
+	return -participant.Amount
+}
+
 // calculateEffectiveLoss calculates the actual loss amount considering exposure cap
 func calculateEffectiveLoss(betAmount, maxWinnerBet int64) int64 {
 	if maxWinnerBet > 0 && betAmount > maxWinnerBet {
@@ -345,6 +836,11 @@ func (s *groupWagerService) processParticipantBalanceChange(
 		metadata["payout_amount"] = *participant.PayoutAmount
 	}
 
+	// Add locked odds for house wager bets
+	if participant.LockedMultiplier != nil {
+		metadata["odds_multiplier"] = *participant.LockedMultiplier
+	}
+
 	// Add capped loss info for losers if applicable
 	if transactionType == entities.TransactionTypeGroupWagerLoss &&
 		groupWager.IsPoolWager() && maxWinnerBet > 0 && participant.Amount > maxWinnerBet {
@@ -374,9 +870,15 @@ func (s *groupWagerService) processParticipantBalanceChange(
 // ResolveGroupWager resolves a group wager with the winning option
 func (s *groupWagerService) ResolveGroupWager(ctx context.Context, groupWagerID int64, resolverID *int64, winningOptionID int64) (*entities.GroupWagerResult, error) {
 	// Check if user is a resolver (skip check for system resolution when resolverID is nil)
-	if resolverID != nil && !s.IsResolver(*resolverID) {
-		return nil, fmt.Errorf("user is not authorized to resolve group wagers")
-	}
+	if resolverID != nil {
+		isResolver, err := s.IsResolver(ctx, *resolverID)
+		if err != nil {
+			return nil, err
+		}
+		if !isResolver {
+			return nil, fmt.Errorf("%w to resolve group wagers", entities.ErrNotAuthorized)
+		}
+	}
 
 	// Get full detail to get participants and options (this includes the wager with external reference)
 	detail, err := s.groupWagerRepo.GetDetailByID(ctx, groupWagerID)
@@ -384,7 +886,7 @@ func (s *groupWagerService) ResolveGroupWager(ctx context.Context, groupWagerID
 		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
 	}
 	if detail == nil || detail.Wager == nil {
-		return nil, fmt.Errorf("group wager not found")
+		return nil, entities.ErrGroupWagerNotFound
 	}
 
 	groupWager := detail.Wager
@@ -394,6 +896,47 @@ func (s *groupWagerService) ResolveGroupWager(ctx context.Context, groupWagerID
 		return nil, fmt.Errorf("group wager cannot be resolved (current state: %s)", groupWager.State)
 	}
 
+	oldState := groupWager.State
+	result, err := s.settleGroupWager(ctx, detail, resolverID, winningOptionID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.recordEvent(ctx, groupWagerID, entities.GroupWagerEventResolved, resolverID, map[string]any{
+		"winning_option_id": winningOptionID,
+		"previous_state":    oldState,
+		"total_pot":         result.TotalPot,
+		"pot_tax":           entities.TotalLotteryPotTax(result.PotTaxes),
+		"winners":           len(result.Winners),
+		"losers":            len(result.Losers),
+	}); err != nil {
+		return nil, err
+	}
+
+	result.BetChanges, err = s.groupWagerRepo.GetBetChanges(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bet changes: %w", err)
+	}
+
+	// Publish state change event
+	if err := s.eventPublisher.Publish(events.GroupWagerStateChangeEvent{
+		GroupWagerID: groupWager.ID,
+		GuildID:      groupWager.GuildID,
+		OldState:     string(oldState),
+		NewState:     string(groupWager.State),
+		MessageID:    groupWager.MessageID,
+		ChannelID:    groupWager.ChannelID,
+	}); err != nil {
+		log.WithError(err).Error("Failed to publish group wager state change event")
+	}
+
+	return result, nil
+}
+
+// settleGroupWager pays out a group wager's participants for the winning option and marks it resolved at resolvedAt
+func (s *groupWagerService) settleGroupWager(ctx context.Context, detail *entities.GroupWagerDetail, resolverID *int64, winningOptionID int64, resolvedAt time.Time) (*entities.GroupWagerResult, error) {
+	groupWager := detail.Wager
+	groupWagerID := groupWager.ID
 	participants := detail.Participants
 
 	// Check minimum participants and multiple options
@@ -418,85 +961,14 @@ func (s *groupWagerService) ResolveGroupWager(ctx context.Context, groupWagerID
 		return nil, fmt.Errorf("no option found with ID: %d", winningOptionID)
 	}
 
-	// Calculate payouts
 	totalPot := groupWager.TotalPot
-	winningOptionTotal := winningOption.TotalAmount
-
-	var winners []*entities.GroupWagerParticipant
-	var losers []*entities.GroupWagerParticipant
-	payoutDetails := make(map[int64]int64)
-
-	// Separate winners and losers
-	for _, participant := range participants {
-		if participant.OptionID == winningOption.ID {
-			winners = append(winners, participant)
-		} else {
-			losers = append(losers, participant)
-		}
-	}
-
-	// Calculate max winner bet once for pool wagers
-	var maxWinnerBet int64
-	if groupWager.IsPoolWager() {
-		maxWinnerBet = calculateMaxWinnerBet(winners)
-	}
-
-	// Calculate payouts based on wager type
-	if groupWager.IsPoolWager() {
-
-		// Calculate effective prize pool with capped losses
-		effectivePrizePool := int64(0)
-
-		// Add capped losses from losers
-		for _, loser := range losers {
-			effectiveLoss := calculateEffectiveLoss(loser.Amount, maxWinnerBet)
-			effectivePrizePool += effectiveLoss
-		}
-
-		// Add winner contributions to prize pool
-		for _, winner := range winners {
-			effectivePrizePool += winner.Amount
-		}
-
-		// Calculate proportional payouts for winners
-		for _, winner := range winners {
-			var payout int64
-			if winningOptionTotal > 0 {
-				payout = (winner.Amount * effectivePrizePool) / winningOptionTotal
-			}
-			winner.PayoutAmount = &payout
-			payoutDetails[winner.DiscordID] = payout
-		}
-
-		// Set loser payouts to 0
-		for _, loser := range losers {
-			zero := int64(0)
-			loser.PayoutAmount = &zero
-			payoutDetails[loser.DiscordID] = 0
-		}
-	} else {
-		// House wager: use existing logic with odds multipliers
-		for _, winner := range winners {
-			payout := int64(float64(winner.Amount) * winningOption.OddsMultiplier)
-			winner.PayoutAmount = &payout
-			payoutDetails[winner.DiscordID] = payout
-		}
-
-		for _, loser := range losers {
-			zero := int64(0)
-			loser.PayoutAmount = &zero
-			payoutDetails[loser.DiscordID] = 0
-		}
-	}
+	winners, losers, payoutDetails, maxWinnerBet := calculatePayouts(groupWager, winningOption, participants)
 
 	// Process payouts
 	for i, winner := range winners {
-		// Calculate balance change: net win (payout - original bet)
-		balanceChange := *winner.PayoutAmount - winner.Amount
-
 		// Process balance update and history
 		history, err := s.processParticipantBalanceChange(
-			ctx, winner, balanceChange, entities.TransactionTypeGroupWagerWin,
+			ctx, winner, settlementBalanceChange(groupWager, winner, winningOptionID, maxWinnerBet), entities.TransactionTypeGroupWagerWin,
 			groupWagerID, groupWager, maxWinnerBet,
 		)
 		if err != nil {
@@ -509,19 +981,9 @@ func (s *groupWagerService) ResolveGroupWager(ctx context.Context, groupWagerID
 
 	// Process losers
 	for i, loser := range losers {
-		// Calculate balance change
-		var balanceChange int64
-		if groupWager.IsPoolWager() {
-			// Pool wager with exposure cap
-			balanceChange = -calculateEffectiveLoss(loser.Amount, maxWinnerBet)
-		} else {
-			// House wager: lose full bet amount
-			balanceChange = -loser.Amount
-		}
-
 		// Process balance update and history
 		history, err := s.processParticipantBalanceChange(
-			ctx, loser, balanceChange, entities.TransactionTypeGroupWagerLoss,
+			ctx, loser, settlementBalanceChange(groupWager, loser, winningOptionID, maxWinnerBet), entities.TransactionTypeGroupWagerLoss,
 			groupWagerID, groupWager, maxWinnerBet,
 		)
 		if err != nil {
@@ -532,6 +994,12 @@ func (s *groupWagerService) ResolveGroupWager(ctx context.Context, groupWagerID
 		losers[i].BalanceHistoryID = &history.ID
 	}
 
+	// Withhold the guild's pot tax from the winners for the lottery
+	potTaxes, potTaxPercent, err := s.collectPotTax(ctx, groupWager, totalPot, winners)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update participant records with payouts and balance history IDs
 	allParticipants := append(winners, losers...)
 	if err := s.groupWagerRepo.UpdateParticipantPayouts(ctx, allParticipants); err != nil {
@@ -539,29 +1007,15 @@ func (s *groupWagerService) ResolveGroupWager(ctx context.Context, groupWagerID
 	}
 
 	// Update group wager as resolved
-	now := time.Now()
-	oldState := groupWager.State
 	groupWager.State = entities.GroupWagerStateResolved
 	groupWager.ResolverDiscordID = resolverID
 	groupWager.WinningOptionID = &winningOptionID
-	groupWager.ResolvedAt = &now
+	groupWager.ResolvedAt = &resolvedAt
 
 	if err := s.groupWagerRepo.Update(ctx, groupWager); err != nil {
 		return nil, fmt.Errorf("failed to update resolved group wager: %w", err)
 	}
 
-	// Publish state change event
-	if err := s.eventPublisher.Publish(events.GroupWagerStateChangeEvent{
-		GroupWagerID: groupWager.ID,
-		GuildID:      groupWager.GuildID,
-		OldState:     string(oldState),
-		NewState:     string(groupWager.State),
-		MessageID:    groupWager.MessageID,
-		ChannelID:    groupWager.ChannelID,
-	}); err != nil {
-		log.WithError(err).Error("Failed to publish group wager state change event")
-	}
-
 	return &entities.GroupWagerResult{
 		GroupWager:    groupWager,
 		WinningOption: winningOption,
@@ -569,149 +1023,756 @@ func (s *groupWagerService) ResolveGroupWager(ctx context.Context, groupWagerID
 		Losers:        losers,
 		TotalPot:      totalPot,
 		PayoutDetails: payoutDetails,
+		Options:       options,
+		MaxWinnerBet:  maxWinnerBet,
+		PotTaxes:      potTaxes,
+		PotTaxPercent: potTaxPercent,
 	}, nil
 }
 
-// GetGroupWagerDetail retrieves full details of a group wager
-func (s *groupWagerService) GetGroupWagerDetail(ctx context.Context, groupWagerID int64) (*entities.GroupWagerDetail, error) {
+// ReResolve corrects the winning option of a recently resolved group wager. The original payouts and pot tax
+// are reversed with compensating entries before the wager is settled again for the new winning option.
+func (s *groupWagerService) ReResolve(ctx context.Context, groupWagerID int64, resolverID int64, newWinningOptionID int64) (*entities.GroupWagerResult, error) {
+	isResolver, err := s.IsResolver(ctx, resolverID)
+	if err != nil {
+		return nil, err
+	}
+	if !isResolver {
+		return nil, fmt.Errorf("%w to correct group wager resolutions", entities.ErrNotAuthorized)
+	}
+
 	detail, err := s.groupWagerRepo.GetDetailByID(ctx, groupWagerID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
 	}
-	if detail == nil {
-		return nil, fmt.Errorf("group wager not found")
+	if detail == nil || detail.Wager == nil {
+		return nil, entities.ErrGroupWagerNotFound
 	}
 
-	return detail, nil
-}
+	groupWager := detail.Wager
+	if !groupWager.IsResolved() {
+		return nil, fmt.Errorf("can only correct resolved group wagers (current state: %s)", groupWager.State)
+	}
+	window := time.Duration(s.config.ResolutionCorrectionMinutes) * time.Minute
+	if !groupWager.CanBeCorrected(time.Now(), window) {
+		return nil, fmt.Errorf("group wager resolutions can only be corrected within %d minutes of being resolved", s.config.ResolutionCorrectionMinutes)
+	}
+	previousWinningOptionID := *groupWager.WinningOptionID
+	if newWinningOptionID == previousWinningOptionID {
+		return nil, fmt.Errorf("that option has already won this group wager")
+	}
+	if !slices.ContainsFunc(detail.Options, func(option *entities.GroupWagerOption) bool { return option.ID == newWinningOptionID }) {
+		return nil, fmt.Errorf("no option found with ID: %d", newWinningOptionID)
+	}
 
-// GetGroupWagerByMessageID retrieves a group wager by message ID
-func (s *groupWagerService) GetGroupWagerByMessageID(ctx context.Context, messageID int64) (*entities.GroupWagerDetail, error) {
-	detail, err := s.groupWagerRepo.GetDetailByMessageID(ctx, messageID)
+	// Parlay legs were settled on the original outcome and may already have paid out
+	parlaysSettled, err := s.groupWagerRepo.HasSettledParlayLegs(ctx, groupWagerID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+		return nil, err
+	}
+	if parlaysSettled {
+		return nil, fmt.Errorf("cannot correct resolution: parlays have already been settled on its outcome")
 	}
 
-	return detail, nil
-}
+	if err := s.checkCorrectionCovered(ctx, detail, newWinningOptionID); err != nil {
+		return nil, err
+	}
 
-// GetActiveGroupWagersByUser returns active group wagers where user is participating
-func (s *groupWagerService) GetActiveGroupWagersByUser(ctx context.Context, discordID int64) ([]*entities.GroupWager, error) {
-	wagers, err := s.groupWagerRepo.GetActiveByUser(ctx, discordID)
+	// Refund the pot tax first so the winners it was withheld from can cover their payout reversal
+	refundedTax, err := s.refundPotTax(ctx, groupWager, resolverID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get active group wagers: %w", err)
+		return nil, err
 	}
 
-	return wagers, nil
+	reversed := 0
+	for _, participant := range detail.Participants {
+		if participant.BalanceHistoryID == nil {
+			continue
+		}
+		if err := s.reverseResolutionEntry(ctx, groupWager, *participant.BalanceHistoryID, resolverID); err != nil {
+			return nil, err
+		}
+		participant.PayoutAmount = nil
+		participant.BalanceHistoryID = nil
+		reversed++
+	}
+
+	// Corrections are measured from the original resolution, so they can't extend the window
+	result, err := s.settleGroupWager(ctx, detail, &resolverID, newWinningOptionID, *groupWager.ResolvedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.recordEvent(ctx, groupWagerID, entities.GroupWagerEventCorrected, &resolverID, map[string]any{
+		"previous_winning_option_id": previousWinningOptionID,
+		"winning_option_id":          newWinningOptionID,
+		"reversed_payouts":           reversed,
+		"refunded_pot_tax":           refundedTax,
+		"pot_tax":                    entities.TotalLotteryPotTax(result.PotTaxes),
+	}); err != nil {
+		return nil, err
+	}
+
+	result.BetChanges, err = s.groupWagerRepo.GetBetChanges(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bet changes: %w", err)
+	}
+
+	// The state is unchanged, but the event refreshes the wager message with the corrected outcome
+	if err := s.eventPublisher.Publish(events.GroupWagerStateChangeEvent{
+		GroupWagerID: groupWager.ID,
+		GuildID:      groupWager.GuildID,
+		OldState:     string(entities.GroupWagerStateResolved),
+		NewState:     string(groupWager.State),
+		MessageID:    groupWager.MessageID,
+		ChannelID:    groupWager.ChannelID,
+	}); err != nil {
+		log.WithError(err).Error("Failed to publish group wager state change event")
+	}
+
+	return result, nil
 }
 
-// IsResolver checks if a user can resolve group wagers
-func (s *groupWagerService) IsResolver(discordID int64) bool {
-	for _, resolverID := range s.config.ResolverDiscordIDs {
-		if discordID == resolverID {
-			return true
+// checkCorrectionCovered refuses a correction that would take more from any user than they have available.
+// Each user's net correction is what reversing the original resolution and settling the new winning option
+// change their balance by together, so it is checked before anything is written. The new pot tax is left
+// out, as it never takes more than a winner gains.
+func (s *groupWagerService) checkCorrectionCovered(ctx context.Context, detail *entities.GroupWagerDetail, newWinningOptionID int64) error {
+	corrections := make(map[int64]int64)
+
+	for _, participant := range detail.Participants {
+		if participant.BalanceHistoryID == nil {
+			continue
+		}
+		original, err := s.balanceHistoryRepo.GetByIDForUpdate(ctx, *participant.BalanceHistoryID)
+		if err != nil {
+			return fmt.Errorf("failed to get balance history: %w", err)
+		}
+		if original == nil {
+			return entities.ErrBalanceHistoryNotFound
+		}
+		corrections[original.DiscordID] -= original.ChangeAmount
+	}
+
+	histories, err := s.balanceHistoryRepo.GetByRelated(ctx, entities.RelatedTypeGroupWager, detail.Wager.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get group wager balance history: %w", err)
+	}
+	for _, history := range histories {
+		if history.TransactionType == entities.TransactionTypeLottoPotTax && !history.IsReversed() {
+			corrections[history.DiscordID] -= history.ChangeAmount
+		}
+	}
+
+	// Settle copies of the participants so their recorded payouts are left alone
+	var winningOption *entities.GroupWagerOption
+	for _, option := range detail.Options {
+		if option.ID == newWinningOptionID {
+			winningOption = option
+		}
+	}
+	participants := make([]*entities.GroupWagerParticipant, 0, len(detail.Participants))
+	for _, participant := range detail.Participants {
+		settled := *participant
+		participants = append(participants, &settled)
+	}
+	_, _, _, maxWinnerBet := calculatePayouts(detail.Wager, winningOption, participants)
+	for _, participant := range participants {
+		corrections[participant.DiscordID] += settlementBalanceChange(detail.Wager, participant, newWinningOptionID, maxWinnerBet)
+	}
+
+	for _, participant := range detail.Participants {
+		correction := corrections[participant.DiscordID]
+		if correction >= 0 {
+			continue
+		}
+		user, err := s.userRepo.GetByDiscordID(ctx, participant.DiscordID)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if user == nil {
+			return entities.ErrUserNotFound
+		}
+		// Bits reserved in open wagers, savings and parlays cannot be clawed back
+		if user.AvailableBalance < -correction {
+			return fmt.Errorf("cannot correct resolution: <@%d> no longer has %s bits available to cover the correction", user.DiscordID, utils.FormatShortNotation(-correction))
 		}
 	}
-	return false
+
+	return nil
 }
 
-// UpdateMessageIDs updates the message and channel IDs for a group wager
-func (s *groupWagerService) UpdateMessageIDs(ctx context.Context, groupWagerID int64, messageID int64, channelID int64) error {
-	// Get the group wager detail
-	detail, err := s.groupWagerRepo.GetDetailByID(ctx, groupWagerID)
+// reverseResolutionEntry undoes a balance change made by a wrong resolution with a compensating entry linked to it
+func (s *groupWagerService) reverseResolutionEntry(ctx context.Context, groupWager *entities.GroupWager, balanceHistoryID int64, resolverID int64) error {
+	original, err := s.balanceHistoryRepo.GetByIDForUpdate(ctx, balanceHistoryID)
 	if err != nil {
-		return fmt.Errorf("failed to get group wager detail: %w", err)
+		return fmt.Errorf("failed to get balance history: %w", err)
 	}
-	if detail == nil || detail.Wager == nil {
-		return fmt.Errorf("group wager not found")
+	if original == nil {
+		return entities.ErrBalanceHistoryNotFound
+	}
+	// Break-even payouts and fully capped losses left nothing to undo
+	if original.ChangeAmount == 0 {
+		return nil
+	}
+	if err := original.CanReverse(); err != nil {
+		return err
 	}
 
-	groupWager := detail.Wager
+	user, err := s.userRepo.GetByDiscordID(ctx, original.DiscordID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return entities.ErrUserNotFound
+	}
 
-	// Update message and channel IDs
-	groupWager.MessageID = messageID
-	groupWager.ChannelID = channelID
+	change := -original.ChangeAmount
+	newBalance := user.Balance + change
+	if err := s.userRepo.UpdateBalance(ctx, user.DiscordID, newBalance); err != nil {
+		return fmt.Errorf("failed to update balance: %w", err)
+	}
 
-	// Save the update
-	if err := s.groupWagerRepo.Update(ctx, groupWager); err != nil {
-		return fmt.Errorf("failed to update group wager: %w", err)
+	reversal := &entities.BalanceHistory{
+		DiscordID:       original.DiscordID,
+		BalanceBefore:   user.Balance,
+		BalanceAfter:    newBalance,
+		ChangeAmount:    change,
+		TransactionType: entities.CorrectionTransactionType(change),
+		TransactionMetadata: map[string]any{
+			"group_wager_id": groupWager.ID,
+			"condition":      groupWager.Condition,
+			"reversal_of":    original.ID,
+			"reversed_type":  string(original.TransactionType),
+			"corrected_by":   resolverID,
+		},
+		RelatedID:    &groupWager.ID,
+		RelatedType:  relatedTypePtr(entities.RelatedTypeGroupWager),
+		ReversalOfID: &original.ID,
+	}
+	if err := utils.RecordBalanceChange(ctx, s.balanceHistoryRepo, s.eventPublisher, reversal); err != nil {
+		return fmt.Errorf("failed to record correction: %w", err)
+	}
+
+	if err := s.balanceHistoryRepo.MarkReversed(ctx, original.ID, reversal.ID); err != nil {
+		return fmt.Errorf("failed to link correction: %w", err)
 	}
 
 	return nil
 }
 
-// TransitionExpiredWagers finds and transitions active wagers to pending_resolution once their betting window is exhausted
-func (s *groupWagerService) TransitionExpiredWagers(ctx context.Context) error {
-	// Find expired active wagers
-	expiredWagers, err := s.groupWagerRepo.GetExpiredActiveWagers(ctx)
+// refundPotTax returns the pot tax withheld by a wrong resolution to its winners and takes it back out of the
+// lottery draw it was added to. Tax already paid out by a completed draw can't be recovered, so the
+// correction is refused.
+func (s *groupWagerService) refundPotTax(ctx context.Context, groupWager *entities.GroupWager, resolverID int64) (int64, error) {
+	histories, err := s.balanceHistoryRepo.GetByRelated(ctx, entities.RelatedTypeGroupWager, groupWager.ID)
 	if err != nil {
-		return fmt.Errorf("failed to get expired active wagers: %w", err)
+		return 0, fmt.Errorf("failed to get group wager balance history: %w", err)
 	}
 
-	// Transition each wager
-	for _, wager := range expiredWagers {
-		oldState := wager.State
-		wager.State = entities.GroupWagerStatePendingResolution
+	refunded := int64(0)
+	for _, history := range histories {
+		if history.TransactionType != entities.TransactionTypeLottoPotTax || history.IsReversed() {
+			continue
+		}
 
-		// Update the wager
-		if err := s.groupWagerRepo.Update(ctx, wager); err != nil {
-			return fmt.Errorf("failed to update wager %d to pending_resolution: %w", wager.ID, err)
+		drawID, ok := history.MetadataInt64("lottery_draw_id")
+		if !ok {
+			return 0, fmt.Errorf("pot tax entry %d has no lottery draw", history.ID)
+		}
+		removed, err := s.lotteryDrawRepo.RemovePotTax(ctx, drawID, -history.ChangeAmount)
+		if err != nil {
+			return 0, err
+		}
+		if !removed {
+			return 0, fmt.Errorf("cannot correct resolution: its pot tax has already been paid out by the lottery")
 		}
 
-		// Publish state change event
-		if err := s.eventPublisher.Publish(events.GroupWagerStateChangeEvent{
-			GroupWagerID: wager.ID,
-			GuildID:      wager.GuildID,
-			OldState:     string(oldState),
-			NewState:     string(wager.State),
-			MessageID:    wager.MessageID,
-			ChannelID:    wager.ChannelID,
-		}); err != nil {
-			log.WithError(err).Error("Failed to publish group wager state change event")
+		if err := s.reverseResolutionEntry(ctx, groupWager, history.ID, resolverID); err != nil {
+			return 0, err
 		}
+		refunded += -history.ChangeAmount
 	}
 
-	return nil
+	return refunded, nil
 }
 
-// CancelGroupWager cancels an active group wager
-func (s *groupWagerService) CancelGroupWager(ctx context.Context, groupWagerID int64, cancellerID *int64) error {
-	// Get the group wager detail
-	detail, err := s.groupWagerRepo.GetDetailByID(ctx, groupWagerID)
+// collectPotTax withholds the guild's pot tax from a resolved wager's winners and adds it to the current
+// lottery draw. Nothing is taxed when the guild has no pot tax or no draw is selling tickets.
+func (s *groupWagerService) collectPotTax(ctx context.Context, groupWager *entities.GroupWager, totalPot int64, winners []*entities.GroupWagerParticipant) ([]*entities.LotteryPotTaxShare, int, error) {
+	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, groupWager.GuildID)
 	if err != nil {
-		return fmt.Errorf("failed to get group wager detail: %w", err)
+		return nil, 0, fmt.Errorf("failed to get guild settings: %w", err)
 	}
-	if detail == nil || detail.Wager == nil {
-		return fmt.Errorf("group wager not found")
+	if !settings.HasPotTax() || !settings.IsLottoEnabled() {
+		return nil, 0, nil
 	}
 
-	groupWager := detail.Wager
-
-	// Check if canceller is authorized (creator or resolver)
-	// Allow system cancellation when cancellerID is nil
-	if cancellerID != nil {
-		isCreator := groupWager.CreatorDiscordID != nil && *cancellerID == *groupWager.CreatorDiscordID
-		isResolver := s.IsResolver(*cancellerID)
-		if !isCreator && !isResolver {
-			return fmt.Errorf("only the creator or a resolver can cancel a group wager")
-		}
+	shares := entities.SplitLotteryPotTax(totalPot, *settings.PotTaxPercent, winners)
+	totalTax := entities.TotalLotteryPotTax(shares)
+	if totalTax == 0 {
+		return nil, 0, nil
 	}
 
-	// Check if wager can be cancelled (only active or pending_resolution states)
-	if groupWager.State != entities.GroupWagerStateActive && groupWager.State != entities.GroupWagerStatePendingResolution {
-		return fmt.Errorf("can only cancel active or pending resolution group wagers")
+	draw, err := s.lotteryDrawRepo.GetCurrentOpenDraw(ctx, groupWager.GuildID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get current lottery draw: %w", err)
+	}
+	if draw == nil || !draw.CanPurchaseTickets() {
+		return nil, 0, nil
 	}
 
-	// Update state to cancelled
-	oldState := groupWager.State
-	groupWager.State = entities.GroupWagerStateCancelled
+	// The draw may have been conducted since it was read, in which case the winners keep the tax
+	added, err := s.lotteryDrawRepo.AddPotTax(ctx, draw.ID, totalTax)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !added {
+		return nil, 0, nil
+	}
 
-	// Save the update
-	if err := s.groupWagerRepo.Update(ctx, groupWager); err != nil {
-		return fmt.Errorf("failed to update group wager: %w", err)
+	for _, share := range shares {
+		if err := s.recordPotTax(ctx, groupWager, draw, share, *settings.PotTaxPercent); err != nil {
+			return nil, 0, err
+		}
 	}
 
-	// Publish state change event
+	log.WithFields(log.Fields{
+		"groupWagerID": groupWager.ID,
+		"drawID":       draw.ID,
+		"potTax":       totalTax,
+		"winners":      len(shares),
+	}).Info("Added group wager pot tax to the lottery")
+
+	return shares, *settings.PotTaxPercent, nil
+}
+
+// recordPotTax deducts a winner's pot tax share and records it in their balance history
+func (s *groupWagerService) recordPotTax(ctx context.Context, groupWager *entities.GroupWager, draw *entities.LotteryDraw, share *entities.LotteryPotTaxShare, percent int) error {
+	user, err := s.userRepo.GetByDiscordID(ctx, share.DiscordID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	newBalance := user.Balance - share.Amount
+	if err := s.userRepo.UpdateBalance(ctx, share.DiscordID, newBalance); err != nil {
+		return fmt.Errorf("failed to update balance: %w", err)
+	}
+
+	history := &entities.BalanceHistory{
+		DiscordID:       share.DiscordID,
+		BalanceBefore:   user.Balance,
+		BalanceAfter:    newBalance,
+		ChangeAmount:    -share.Amount,
+		TransactionType: entities.TransactionTypeLottoPotTax,
+		TransactionMetadata: map[string]any{
+			"group_wager_id":  groupWager.ID,
+			"lottery_draw_id": draw.ID,
+			"pot_tax_percent": percent,
+		},
+		RelatedID:   &groupWager.ID,
+		RelatedType: relatedTypePtr(entities.RelatedTypeGroupWager),
+	}
+
+	if err := utils.RecordBalanceChange(ctx, s.balanceHistoryRepo, s.eventPublisher, history); err != nil {
+		return fmt.Errorf("failed to record pot tax: %w", err)
+	}
+
+	return nil
+}
+
+// GetGroupWagerDetail retrieves full details of a group wager
+func (s *groupWagerService) GetGroupWagerDetail(ctx context.Context, groupWagerID int64) (*entities.GroupWagerDetail, error) {
+	detail, err := s.groupWagerRepo.GetDetailByID(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+	if detail == nil {
+		return nil, entities.ErrGroupWagerNotFound
+	}
+
+	return detail, nil
+}
+
+// GetGroupWagerByMessageID retrieves a group wager by message ID
+func (s *groupWagerService) GetGroupWagerByMessageID(ctx context.Context, messageID int64) (*entities.GroupWagerDetail, error) {
+	detail, err := s.groupWagerRepo.GetDetailByMessageID(ctx, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+
+	return detail, nil
+}
+
+// GetGroupWagerByReactionMessageID retrieves the group wager taking bets from reactions on a message
+func (s *groupWagerService) GetGroupWagerByReactionMessageID(ctx context.Context, messageID int64) (*entities.GroupWagerDetail, error) {
+	detail, err := s.groupWagerRepo.GetDetailByReactionMessageID(ctx, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+
+	return detail, nil
+}
+
+// EnableReactionBetting lets users bet on the creator's wager by reacting to a message with the options' emojis
+func (s *groupWagerService) EnableReactionBetting(ctx context.Context, groupWagerID int64, creatorID int64, messageID, channelID int64, stake int64) (*entities.GroupWager, error) {
+	if err := entities.ValidateReactionStake(stake); err != nil {
+		return nil, err
+	}
+
+	detail, err := s.groupWagerRepo.GetDetailByIDForUpdate(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+	if detail == nil || detail.Wager == nil {
+		return nil, entities.ErrGroupWagerNotFound
+	}
+
+	groupWager := detail.Wager
+	if groupWager.CreatorDiscordID == nil || *groupWager.CreatorDiscordID != creatorID {
+		return nil, fmt.Errorf("only the creator can enable reaction betting on a group wager")
+	}
+	if !groupWager.IsActive() {
+		return nil, fmt.Errorf("only active group wagers can be changed (current state: %s)", groupWager.State)
+	}
+
+	if err := s.groupWagerRepo.UpdateReactionBetting(ctx, groupWagerID, messageID, channelID, stake); err != nil {
+		return nil, fmt.Errorf("failed to update reaction betting: %w", err)
+	}
+	groupWager.ReactionMessageID = &messageID
+	groupWager.ReactionChannelID = &channelID
+	groupWager.ReactionStake = &stake
+
+	return groupWager, nil
+}
+
+// GetActiveGroupWagersByUser returns active group wagers where user is participating
+func (s *groupWagerService) GetActiveGroupWagersByUser(ctx context.Context, discordID int64) ([]*entities.GroupWager, error) {
+	wagers, err := s.groupWagerRepo.GetActiveByUser(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active group wagers: %w", err)
+	}
+
+	return wagers, nil
+}
+
+// IsResolver checks if a user can resolve group wagers in the current guild. Globally configured
+// resolvers can resolve everywhere; otherwise the guild's resolver grants are checked against the
+// user and the member roles attached to ctx.
+func (s *groupWagerService) IsResolver(ctx context.Context, discordID int64) (bool, error) {
+	if slices.Contains(s.config.ResolverDiscordIDs, discordID) {
+		return true, nil
+	}
+
+	resolvers, err := s.guildResolverRepo.GetAll(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get guild resolvers: %w", err)
+	}
+
+	roleIDs := utils.MemberRolesFromContext(ctx)
+	for _, resolver := range resolvers {
+		if resolver.Matches(discordID, roleIDs) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// UpdateMessageIDs updates the message and channel IDs for a group wager
+func (s *groupWagerService) UpdateMessageIDs(ctx context.Context, groupWagerID int64, messageID int64, channelID int64) error {
+	if err := s.groupWagerRepo.UpdateMessageIDs(ctx, groupWagerID, messageID, channelID); err != nil {
+		return fmt.Errorf("failed to update group wager: %w", err)
+	}
+	return nil
+}
+
+// UpdateThreadID records the discussion thread attached to a group wager message
+func (s *groupWagerService) UpdateThreadID(ctx context.Context, groupWagerID int64, threadID int64) error {
+	if err := s.groupWagerRepo.UpdateThreadID(ctx, groupWagerID, threadID); err != nil {
+		return fmt.Errorf("failed to update group wager: %w", err)
+	}
+	return nil
+}
+
+// LinkExternalReference links an open group wager to an external match so a result provider can resolve it
+func (s *groupWagerService) LinkExternalReference(ctx context.Context, groupWagerID int64, linkerID int64, ref entities.ExternalReference) (*entities.GroupWager, error) {
+	isResolver, err := s.IsResolver(ctx, linkerID)
+	if err != nil {
+		return nil, err
+	}
+	if !isResolver {
+		return nil, fmt.Errorf("only resolvers can link group wagers to external matches")
+	}
+
+	if !ref.IsValid() {
+		return nil, fmt.Errorf("external match reference is missing a system or ID")
+	}
+
+	detail, err := s.groupWagerRepo.GetDetailByID(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+	if detail == nil || detail.Wager == nil {
+		return nil, entities.ErrGroupWagerNotFound
+	}
+
+	groupWager := detail.Wager
+	if !groupWager.IsActive() && !groupWager.IsPendingResolution() {
+		return nil, fmt.Errorf("only unresolved group wagers can be linked (current state: %s)", groupWager.State)
+	}
+
+	// Game tracked wagers are already resolved by game events
+	if groupWager.ExternalRef != nil && groupWager.ExternalRef.System != ref.System {
+		return nil, fmt.Errorf("group wager is already resolved from %s games", groupWager.ExternalRef.System)
+	}
+
+	existing, err := s.groupWagerRepo.GetByExternalReference(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check external reference: %w", err)
+	}
+	if existing != nil && existing.ID != groupWager.ID {
+		return nil, fmt.Errorf("match %s is already linked to group wager #%d", ref.ID, existing.ID)
+	}
+
+	groupWager.SetExternalReference(ref.System, ref.ID)
+	if err := s.groupWagerRepo.Update(ctx, groupWager); err != nil {
+		return nil, fmt.Errorf("failed to update group wager: %w", err)
+	}
+
+	return groupWager, nil
+}
+
+// SetAccess limits how many users may bet on a group wager and whether only a role or invited users may bet
+func (s *groupWagerService) SetAccess(ctx context.Context, groupWagerID int64, creatorID int64, access entities.GroupWagerAccess) (*entities.GroupWager, error) {
+	if err := access.Validate(); err != nil {
+		return nil, err
+	}
+
+	detail, err := s.groupWagerRepo.GetDetailByIDForUpdate(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+	if detail == nil || detail.Wager == nil {
+		return nil, entities.ErrGroupWagerNotFound
+	}
+
+	groupWager := detail.Wager
+	if groupWager.CreatorDiscordID == nil || *groupWager.CreatorDiscordID != creatorID {
+		return nil, fmt.Errorf("only the creator can change who can bet on a group wager")
+	}
+	if !groupWager.IsActive() {
+		return nil, fmt.Errorf("only active group wagers can be changed (current state: %s)", groupWager.State)
+	}
+
+	// Restricting access after others have joined would leave bettors on a wager they could no longer join
+	for _, participant := range detail.Participants {
+		if participant.DiscordID != creatorID {
+			return nil, entities.ErrGroupWagerAccessLocked
+		}
+	}
+
+	if err := s.groupWagerRepo.UpdateAccess(ctx, groupWagerID, access); err != nil {
+		return nil, fmt.Errorf("failed to update group wager access: %w", err)
+	}
+	groupWager.SetAccess(access)
+
+	return groupWager, nil
+}
+
+// ReorderOptions lets the creator rearrange an active wager's options before anyone has bet
+func (s *groupWagerService) ReorderOptions(ctx context.Context, groupWagerID int64, creatorID int64, optionNumbers []int) (*entities.GroupWagerDetail, error) {
+	detail, err := s.groupWagerRepo.GetDetailByIDForUpdate(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+	if detail == nil || detail.Wager == nil {
+		return nil, entities.ErrGroupWagerNotFound
+	}
+
+	groupWager := detail.Wager
+	if groupWager.CreatorDiscordID == nil || *groupWager.CreatorDiscordID != creatorID {
+		return nil, fmt.Errorf("only the creator can reorder a group wager's options")
+	}
+	if !groupWager.IsActive() {
+		return nil, fmt.Errorf("only active group wagers can be changed (current state: %s)", groupWager.State)
+	}
+
+	// Moving options under existing bets would change what the numbered buttons bettors saw refer to
+	if len(detail.Participants) > 0 {
+		return nil, entities.ErrGroupWagerOptionsLocked
+	}
+
+	orders, err := detail.NewOptionOrders(optionNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.groupWagerRepo.UpdateOptionOrders(ctx, groupWagerID, orders); err != nil {
+		return nil, fmt.Errorf("failed to update option order: %w", err)
+	}
+	for _, option := range detail.Options {
+		option.OptionOrder = orders[option.ID]
+	}
+
+	return detail, nil
+}
+
+// TransitionExpiredWagers finds and transitions active wagers to pending_resolution once their betting window is exhausted.
+// When the guild assigns resolvers automatically, each transitioned pool wager is handed to the next resolver in
+// rotation and the assigned wagers are returned.
+func (s *groupWagerService) TransitionExpiredWagers(ctx context.Context) ([]*entities.GroupWager, error) {
+	// Find expired active wagers
+	expiredWagers, err := s.groupWagerRepo.GetExpiredActiveWagers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired active wagers: %w", err)
+	}
+
+	// Transition each wager
+	for _, wager := range expiredWagers {
+		oldState := wager.State
+		wager.State = entities.GroupWagerStatePendingResolution
+
+		// Update the wager
+		if err := s.groupWagerRepo.Update(ctx, wager); err != nil {
+			return nil, fmt.Errorf("failed to update wager %d to pending_resolution: %w", wager.ID, err)
+		}
+
+		// Publish state change event
+		if err := s.eventPublisher.Publish(events.GroupWagerStateChangeEvent{
+			GroupWagerID: wager.ID,
+			GuildID:      wager.GuildID,
+			OldState:     string(oldState),
+			NewState:     string(wager.State),
+			MessageID:    wager.MessageID,
+			ChannelID:    wager.ChannelID,
+		}); err != nil {
+			log.WithError(err).Error("Failed to publish group wager state change event")
+		}
+	}
+
+	return s.assignResolvers(ctx, expiredWagers, time.Now())
+}
+
+// assignResolvers hands each pool wager that just reached pending_resolution to the next resolver in the guild's
+// rotation. House wagers are resolved from game results, so they are never assigned.
+func (s *groupWagerService) assignResolvers(ctx context.Context, wagers []*entities.GroupWager, now time.Time) ([]*entities.GroupWager, error) {
+	var poolWagers []*entities.GroupWager
+	for _, wager := range wagers {
+		if wager.IsPoolWager() {
+			poolWagers = append(poolWagers, wager)
+		}
+	}
+	if len(poolWagers) == 0 {
+		return nil, nil
+	}
+
+	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, poolWagers[0].GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	if !settings.AutoAssignResolvers {
+		return nil, nil
+	}
+
+	// Only the guild's own user grants take turns. Bot-wide resolvers may not be members of the guild,
+	// and role grants can't be expanded to members here.
+	var resolverIDs []int64
+	guildResolvers, err := s.guildResolverRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild resolvers: %w", err)
+	}
+	for _, resolver := range guildResolvers {
+		if resolver.ResolverType == entities.GuildResolverTypeUser {
+			resolverIDs = append(resolverIDs, resolver.TargetID)
+		}
+	}
+	if len(resolverIDs) == 0 {
+		return nil, nil
+	}
+
+	lastAssigned, err := s.groupWagerRepo.GetLastAssignedResolver(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last assigned resolver: %w", err)
+	}
+
+	var assigned []*entities.GroupWager
+	for _, wager := range poolWagers {
+		resolverID, ok := entities.NextResolverInRotation(resolverIDs, lastAssigned)
+		if !ok {
+			break
+		}
+		if err := s.groupWagerRepo.AssignResolver(ctx, wager.ID, resolverID, now); err != nil {
+			return nil, fmt.Errorf("failed to assign resolver to wager %d: %w", wager.ID, err)
+		}
+		wager.AssignedResolverID = &resolverID
+		lastAssigned = &resolverID
+		assigned = append(assigned, wager)
+	}
+
+	return assigned, nil
+}
+
+// CancelGroupWager cancels an active group wager
+func (s *groupWagerService) CancelGroupWager(ctx context.Context, groupWagerID int64, cancellerID *int64) error {
+	_, err := s.RefundAllParticipants(ctx, groupWagerID, cancellerID)
+	return err
+}
+
+// RefundAllParticipants cancels an active or pending group wager, returning every participant's bet
+func (s *groupWagerService) RefundAllParticipants(ctx context.Context, groupWagerID int64, cancellerID *int64) (*entities.GroupWagerRefundSummary, error) {
+	// Get the group wager detail
+	detail, err := s.groupWagerRepo.GetDetailByID(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+	if detail == nil || detail.Wager == nil {
+		return nil, entities.ErrGroupWagerNotFound
+	}
+
+	groupWager := detail.Wager
+
+	// Check if canceller is authorized (creator or resolver)
+	// Allow system cancellation when cancellerID is nil
+	if cancellerID != nil {
+		isCreator := groupWager.CreatorDiscordID != nil && *cancellerID == *groupWager.CreatorDiscordID
+		if !isCreator {
+			isResolver, err := s.IsResolver(ctx, *cancellerID)
+			if err != nil {
+				return nil, err
+			}
+			if !isResolver {
+				return nil, fmt.Errorf("only the creator or a resolver can cancel a group wager")
+			}
+		}
+	}
+
+	// Check if wager can be cancelled (only active or pending_resolution states)
+	if groupWager.State != entities.GroupWagerStateActive && groupWager.State != entities.GroupWagerStatePendingResolution {
+		return nil, fmt.Errorf("can only cancel active or pending resolution group wagers")
+	}
+
+	// Bets are reserved rather than debited, so cancelling releases them without moving any bits
+	summary := entities.NewGroupWagerRefundSummary(detail)
+
+	// Update state to cancelled
+	oldState := groupWager.State
+	groupWager.Cancel()
+
+	// Save the update
+	if err := s.groupWagerRepo.Update(ctx, groupWager); err != nil {
+		return nil, fmt.Errorf("failed to update group wager: %w", err)
+	}
+
+	if err := s.recordEvent(ctx, groupWagerID, entities.GroupWagerEventCancelled, cancellerID, map[string]any{
+		"previous_state":        oldState,
+		"total_pot":             groupWager.TotalPot,
+		"refunded_participants": summary.ParticipantCount(),
+		"total_refunded":        summary.TotalRefunded,
+	}); err != nil {
+		return nil, err
+	}
+
+	// Publish state change event
 	if err := s.eventPublisher.Publish(events.GroupWagerStateChangeEvent{
 		GroupWagerID: groupWager.ID,
 		GuildID:      groupWager.GuildID,
@@ -723,5 +1784,292 @@ func (s *groupWagerService) CancelGroupWager(ctx context.Context, groupWagerID i
 		log.WithError(err).Error("Failed to publish group wager state change event")
 	}
 
+	return summary, nil
+}
+
+// RestoreGroupWager undoes a cancellation made within the restore window
+func (s *groupWagerService) RestoreGroupWager(ctx context.Context, groupWagerID int64, restorerID int64) (*entities.GroupWagerDetail, error) {
+	detail, err := s.groupWagerRepo.GetDetailByID(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+	if detail == nil || detail.Wager == nil {
+		return nil, entities.ErrGroupWagerNotFound
+	}
+
+	groupWager := detail.Wager
+
+	// Same authorization as cancelling
+	isCreator := groupWager.CreatorDiscordID != nil && restorerID == *groupWager.CreatorDiscordID
+	if !isCreator {
+		isResolver, err := s.IsResolver(ctx, restorerID)
+		if err != nil {
+			return nil, err
+		}
+		if !isResolver {
+			return nil, fmt.Errorf("only the creator or a resolver can restore a group wager")
+		}
+	}
+
+	if !groupWager.IsCancelled() {
+		return nil, fmt.Errorf("can only restore cancelled group wagers")
+	}
+	now := time.Now()
+	if !groupWager.CanBeRestored(now) {
+		return nil, fmt.Errorf("group wagers can only be restored within %d minutes of being cancelled", int(entities.GroupWagerRestoreWindow.Minutes()))
+	}
+
+	// Cancelling never pays out, so any settled participant means the bets were already refunded or paid elsewhere
+	for _, participant := range detail.Participants {
+		if participant.PayoutAmount != nil || participant.BalanceHistoryID != nil {
+			return nil, fmt.Errorf("cannot restore group wager: payouts have already been made")
+		}
+	}
+
+	// Cancelling voided the wager's parlay legs, and those parlays may have been paid out since
+	hasSettledLegs, err := s.groupWagerRepo.HasSettledParlayLegs(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check parlay legs: %w", err)
+	}
+	if hasSettledLegs {
+		return nil, fmt.Errorf("cannot restore group wager: parlays have already been settled on its cancellation")
+	}
+
+	// Cancelling released the bets, so every participant must still be able to cover theirs
+	for _, participant := range detail.Participants {
+		user, err := s.userRepo.GetByDiscordID(ctx, participant.DiscordID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+		if user == nil || user.AvailableBalance < participant.Amount {
+			return nil, fmt.Errorf("cannot restore group wager: <@%d> no longer has %s bits available for their bet", participant.DiscordID, utils.FormatShortNotation(participant.Amount))
+		}
+	}
+
+	oldState := groupWager.State
+	groupWager.Restore(now)
+
+	if err := s.groupWagerRepo.Update(ctx, groupWager); err != nil {
+		return nil, fmt.Errorf("failed to update group wager: %w", err)
+	}
+
+	if err := s.recordEvent(ctx, groupWagerID, entities.GroupWagerEventRestored, &restorerID, map[string]any{
+		"restored_state": groupWager.State,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.eventPublisher.Publish(events.GroupWagerStateChangeEvent{
+		GroupWagerID: groupWager.ID,
+		GuildID:      groupWager.GuildID,
+		OldState:     string(oldState),
+		NewState:     string(groupWager.State),
+		MessageID:    groupWager.MessageID,
+		ChannelID:    groupWager.ChannelID,
+	}); err != nil {
+		log.WithError(err).Error("Failed to publish group wager state change event")
+	}
+
+	return detail, nil
+}
+
+// ReconcileStuckWagers cancels or flags wagers that have been awaiting resolution for too long
+func (s *groupWagerService) ReconcileStuckWagers(ctx context.Context, guildID int64, now time.Time) (*interfaces.StuckWagerReconciliation, error) {
+	result := &interfaces.StuckWagerReconciliation{}
+
+	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	if !settings.IsStuckWagerReconciliationEnabled() {
+		return result, nil
+	}
+
+	pendingWagers, err := s.groupWagerRepo.GetWagersPendingResolution(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wagers pending resolution: %w", err)
+	}
+
+	for _, wager := range pendingWagers {
+		waited := now.Sub(wager.PendingResolutionSince())
+
+		if settings.HasStuckWagerAutoCancel() && waited >= settings.GetStuckWagerCancelAge() {
+			// Bets are only locked, so cancelling releases every participant's full amount
+			if err := s.CancelGroupWager(ctx, wager.ID, nil); err != nil {
+				return nil, fmt.Errorf("failed to cancel stuck wager %d: %w", wager.ID, err)
+			}
+			wager.State = entities.GroupWagerStateCancelled
+			result.Cancelled = append(result.Cancelled, wager)
+			continue
+		}
+
+		if wager.NeedsResolutionReminder(now, settings.GetStuckWagerReminderAge()) {
+			if err := s.groupWagerRepo.MarkResolutionReminded(ctx, wager.ID, now); err != nil {
+				return nil, fmt.Errorf("failed to mark wager %d reminded: %w", wager.ID, err)
+			}
+			wager.RemindedAt = &now
+			result.Reminded = append(result.Reminded, wager)
+		}
+	}
+
+	return result, nil
+}
+
+// UpdateHouseWagerOdds changes the odds on an active house wager's options and records each change to odds history.
+// Bets already placed keep the odds they were locked in at. A nil updaterID indicates a scheduled provider refresh.
+func (s *groupWagerService) UpdateHouseWagerOdds(ctx context.Context, groupWagerID int64, updaterID *int64, oddsMultipliers map[int64]float64) (*entities.GroupWagerDetail, error) {
+	if updaterID != nil {
+		isResolver, err := s.IsResolver(ctx, *updaterID)
+		if err != nil {
+			return nil, err
+		}
+		if !isResolver {
+			return nil, fmt.Errorf("%w to update wager odds", entities.ErrNotAuthorized)
+		}
+	}
+	if len(oddsMultipliers) == 0 {
+		return nil, fmt.Errorf("no odds provided")
+	}
+
+	detail, err := s.groupWagerRepo.GetDetailByID(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager detail: %w", err)
+	}
+	if detail == nil || detail.Wager == nil {
+		return nil, entities.ErrGroupWagerNotFound
+	}
+
+	groupWager := detail.Wager
+	if !groupWager.IsHouseWager() {
+		return nil, fmt.Errorf("odds can only be set on house wagers")
+	}
+	if !groupWager.CanAcceptBets() {
+		return nil, fmt.Errorf("odds can only be changed while betting is open")
+	}
+
+	source := entities.OddsChangeSourceProvider
+	if updaterID != nil {
+		source = entities.OddsChangeSourceManual
+	}
+
+	optionIDs := make(map[int64]bool, len(detail.Options))
+	for _, option := range detail.Options {
+		optionIDs[option.ID] = true
+	}
+	for optionID := range oddsMultipliers {
+		if !optionIDs[optionID] {
+			return nil, fmt.Errorf("no option found with ID: %d", optionID)
+		}
+	}
+
+	// Only record options whose odds actually change
+	var changes []*entities.GroupWagerOddsChange
+	oddsUpdates := make(map[int64]float64)
+	for _, option := range detail.Options {
+		newOdds, ok := oddsMultipliers[option.ID]
+		if !ok {
+			continue
+		}
+		if newOdds <= 0 {
+			return nil, fmt.Errorf("odds multiplier for %s must be positive", option.OptionText)
+		}
+		if newOdds == option.OddsMultiplier {
+			continue
+		}
+
+		changes = append(changes, &entities.GroupWagerOddsChange{
+			GroupWagerID:       groupWagerID,
+			OptionID:           option.ID,
+			OldMultiplier:      option.OddsMultiplier,
+			NewMultiplier:      newOdds,
+			Source:             source,
+			ChangedByDiscordID: updaterID,
+		})
+		oddsUpdates[option.ID] = newOdds
+		option.OddsMultiplier = newOdds
+	}
+
+	if len(changes) == 0 {
+		return detail, nil
+	}
+
+	if err := s.groupWagerRepo.UpdateAllOptionOdds(ctx, groupWagerID, oddsUpdates); err != nil {
+		return nil, fmt.Errorf("failed to update option odds: %w", err)
+	}
+
+	if err := s.groupWagerRepo.CreateOddsHistory(ctx, changes); err != nil {
+		return nil, fmt.Errorf("failed to record odds history: %w", err)
+	}
+
+	changePayloads := make([]map[string]any, 0, len(changes))
+	for _, change := range changes {
+		changePayloads = append(changePayloads, map[string]any{
+			"option_id": change.OptionID,
+			"old_odds":  change.OldMultiplier,
+			"new_odds":  change.NewMultiplier,
+		})
+	}
+	if err := s.recordEvent(ctx, groupWagerID, entities.GroupWagerEventOddsUpdated, updaterID, map[string]any{
+		"source":  source,
+		"changes": changePayloads,
+	}); err != nil {
+		return nil, err
+	}
+
+	// Publish odds change event so the wager message is refreshed
+	if err := s.eventPublisher.Publish(events.GroupWagerOddsChangeEvent{
+		GroupWagerID: groupWager.ID,
+		GuildID:      groupWager.GuildID,
+		MessageID:    groupWager.MessageID,
+		ChannelID:    groupWager.ChannelID,
+	}); err != nil {
+		log.WithError(err).Error("Failed to publish group wager odds change event")
+	}
+
+	return detail, nil
+}
+
+// GetOddsHistory returns the recorded odds changes for a group wager
+func (s *groupWagerService) GetOddsHistory(ctx context.Context, groupWagerID int64) ([]*entities.GroupWagerOddsChange, error) {
+	history, err := s.groupWagerRepo.GetOddsHistory(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get odds history: %w", err)
+	}
+
+	return history, nil
+}
+
+// creatorStatsPopularOptions is how many popular options creator stats include
+const creatorStatsPopularOptions = 3
+
+// GetCreatorStats summarizes the group wagers a user has created, including their most popular options
+func (s *groupWagerService) GetCreatorStats(ctx context.Context, creatorID int64) (*entities.GroupWagerCreatorStats, error) {
+	stats, err := s.groupWagerRepo.GetCreatorStats(ctx, creatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get creator stats: %w", err)
+	}
+
+	if stats.TotalCreated == 0 {
+		return stats, nil
+	}
+
+	stats.PopularOptions, err = s.groupWagerRepo.GetCreatorPopularOptions(ctx, creatorID, creatorStatsPopularOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get popular options: %w", err)
+	}
+
+	return stats, nil
+}
+
+// recordEvent appends an action to the wager's event log. A nil actorID means the system acted.
+func (s *groupWagerService) recordEvent(ctx context.Context, groupWagerID int64, eventType entities.GroupWagerEventType, actorID *int64, payload map[string]any) error {
+	if err := s.groupWagerRepo.RecordEvent(ctx, &entities.GroupWagerEvent{
+		GroupWagerID:   groupWagerID,
+		EventType:      eventType,
+		ActorDiscordID: actorID,
+		Payload:        payload,
+	}); err != nil {
+		return fmt.Errorf("failed to record %s event: %w", eventType, err)
+	}
 	return nil
 }
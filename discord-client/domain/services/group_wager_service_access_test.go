@@ -0,0 +1,154 @@
+package services
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWagerService_PlaceBet_Access(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	expectDetail := func(scenario *GroupWagerScenario, userID int64) {
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+			Wager:        scenario.Wager,
+			Options:      scenario.Options,
+			Participants: scenario.Participants,
+		})
+		user, _ := scenario.GetUser(userID)
+		fixture.Helper.ExpectUserLookup(userID, user)
+		fixture.Helper.ExpectParticipantLookup(TestWagerID, userID, findParticipantInScenario(scenario.Participants, userID))
+	}
+
+	t.Run("uninvited user cannot join an invite-only wager", func(t *testing.T) {
+		fixture.Reset()
+
+		role := int64(777)
+		scenario := NewGroupWagerScenario().
+			WithHouseWager(TestResolverID, "Test condition").
+			WithOptions("Win", "Loss").
+			WithOdds(2.0, 2.0).
+			Build()
+		scenario.Wager.SetAccess(entities.GroupWagerAccess{AllowedRoleID: &role, InvitedDiscordIDs: []int64{TestUser3ID}})
+		expectDetail(scenario, TestUser2ID)
+
+		ctx := utils.WithMemberRoles(fixture.Ctx, []int64{888})
+		participant, err := fixture.Service.PlaceBet(ctx, TestWagerID, TestUser2ID, TestOption1ID, 1000)
+
+		assert.ErrorIs(t, err, entities.ErrNotInvitedToGroupWager)
+		assert.Nil(t, participant)
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "SaveParticipant", mock.Anything, mock.Anything)
+	})
+
+	t.Run("new bettor cannot join a full wager", func(t *testing.T) {
+		fixture.Reset()
+
+		limit := 1
+		scenario := NewGroupWagerScenario().
+			WithHouseWager(TestResolverID, "Test condition").
+			WithOptions("Win", "Loss").
+			WithOdds(2.0, 2.0).
+			WithParticipant(TestUser1ID, 0, 1000).
+			Build()
+		scenario.Wager.SetAccess(entities.GroupWagerAccess{MaxParticipants: &limit})
+		expectDetail(scenario, TestUser2ID)
+
+		participant, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser2ID, TestOption1ID, 1000)
+
+		assert.ErrorIs(t, err, entities.ErrGroupWagerFull)
+		assert.Nil(t, participant)
+	})
+
+	t.Run("existing bettor can change their bet on a full wager", func(t *testing.T) {
+		fixture.Reset()
+
+		limit := 1
+		scenario := NewGroupWagerScenario().
+			WithHouseWager(TestResolverID, "Test condition").
+			WithOptions("Win", "Loss").
+			WithOdds(2.0, 2.0).
+			WithParticipant(TestUser1ID, 0, 1000).
+			Build()
+		scenario.Wager.SetAccess(entities.GroupWagerAccess{MaxParticipants: &limit})
+		expectDetail(scenario, TestUser1ID)
+
+		fixture.Mocks.GroupWagerRepo.On("SaveParticipant", fixture.Ctx, mock.MatchedBy(func(p *entities.GroupWagerParticipant) bool {
+			return p.DiscordID == TestUser1ID && p.Amount == 2000
+		})).Return(nil)
+		fixture.Helper.ExpectOptionTotalUpdate(TestOption1ID, 2000)
+		fixture.Mocks.GroupWagerRepo.On("Update", fixture.Ctx, mock.Anything).Return(nil)
+
+		participant, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 2000)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2000), participant.Amount)
+	})
+}
+
+func TestGroupWagerService_SetAccess(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+	limit := 5
+
+	t.Run("creator sets the limit and invite list", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().
+			WithOptions("Win", "Loss").
+			WithParticipant(TestUser1ID, 0, 1000).
+			Build()
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+			Wager: scenario.Wager, Options: scenario.Options, Participants: scenario.Participants,
+		})
+		access := entities.GroupWagerAccess{MaxParticipants: &limit, InvitedDiscordIDs: []int64{TestUser2ID}}
+		fixture.Mocks.GroupWagerRepo.On("UpdateAccess", fixture.Ctx, TestWagerID, access).Return(nil)
+
+		wager, err := fixture.Service.SetAccess(fixture.Ctx, TestWagerID, TestUser1ID, access)
+
+		require.NoError(t, err)
+		assert.Equal(t, &limit, wager.MaxParticipants)
+		assert.True(t, wager.Access().IsInviteOnly())
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("only the creator can change access", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().Build()
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{Wager: scenario.Wager})
+
+		_, err := fixture.Service.SetAccess(fixture.Ctx, TestWagerID, TestUser2ID, entities.GroupWagerAccess{MaxParticipants: &limit})
+
+		fixture.Assertions.AssertValidationError(err, "only the creator")
+	})
+
+	t.Run("access is locked once others have bet", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().
+			WithOptions("Win", "Loss").
+			WithParticipant(TestUser2ID, 0, 1000).
+			Build()
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+			Wager: scenario.Wager, Options: scenario.Options, Participants: scenario.Participants,
+		})
+
+		_, err := fixture.Service.SetAccess(fixture.Ctx, TestWagerID, TestUser1ID, entities.GroupWagerAccess{MaxParticipants: &limit})
+
+		assert.ErrorIs(t, err, entities.ErrGroupWagerAccessLocked)
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "UpdateAccess", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid limits are rejected", func(t *testing.T) {
+		fixture.Reset()
+
+		zero := 0
+		_, err := fixture.Service.SetAccess(fixture.Ctx, TestWagerID, TestUser1ID, entities.GroupWagerAccess{MaxParticipants: &zero})
+
+		fixture.Assertions.AssertValidationError(err, "max participants")
+	})
+}
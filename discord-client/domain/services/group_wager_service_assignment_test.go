@@ -0,0 +1,117 @@
+package services
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWagerService_TransitionExpiredWagers_AssignsResolvers(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	guildID := TestGuildID
+	resolverID := func(id int64) *int64 { return &id }
+	expired := func(id int64, wagerType entities.GroupWagerType) *entities.GroupWager {
+		return &entities.GroupWager{
+			ID:        id,
+			GuildID:   guildID,
+			State:     entities.GroupWagerStateActive,
+			WagerType: wagerType,
+			MessageID: 789,
+			ChannelID: 456,
+		}
+	}
+	expectTransition := func(wagers ...*entities.GroupWager) {
+		fixture.Mocks.GroupWagerRepo.On("GetExpiredActiveWagers", fixture.Ctx).Return(wagers, nil)
+		fixture.Mocks.GroupWagerRepo.On("Update", fixture.Ctx, mock.MatchedBy(func(w *entities.GroupWager) bool {
+			return w.State == entities.GroupWagerStatePendingResolution
+		})).Return(nil)
+		fixture.Helper.ExpectEventPublish(events.EventTypeGroupWagerStateChange)
+	}
+
+	t.Run("disabled assignment only transitions", func(t *testing.T) {
+		fixture.Reset()
+		expectTransition(expired(1, entities.GroupWagerTypePool))
+
+		assigned, err := fixture.Service.TransitionExpiredWagers(fixture.Ctx)
+
+		require.NoError(t, err)
+		assert.Empty(t, assigned)
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "AssignResolver", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		fixture.AssertAllMocks()
+	})
+
+	expectGuildResolvers := func(resolvers ...*entities.GuildResolver) {
+		fixture.Mocks.GuildResolverRepo.ExpectedCalls = nil
+		fixture.Mocks.GuildResolverRepo.On("GetAll", fixture.Ctx).Return(resolvers, nil)
+	}
+	userGrant := func(id int64) *entities.GuildResolver {
+		return &entities.GuildResolver{GuildID: guildID, ResolverType: entities.GuildResolverTypeUser, TargetID: id}
+	}
+
+	t.Run("assigns pool wagers to the next resolvers in rotation", func(t *testing.T) {
+		fixture.Reset()
+		fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{GuildID: guildID, AutoAssignResolvers: true})
+		expectGuildResolvers(userGrant(3000), userGrant(1000), userGrant(2000))
+
+		first := expired(1, entities.GroupWagerTypePool)
+		house := expired(2, entities.GroupWagerTypeHouse)
+		second := expired(3, entities.GroupWagerTypePool)
+		expectTransition(first, house, second)
+
+		fixture.Mocks.GroupWagerRepo.On("GetLastAssignedResolver", fixture.Ctx).Return(resolverID(2000), nil)
+		fixture.Mocks.GroupWagerRepo.On("AssignResolver", fixture.Ctx, int64(1), int64(3000), mock.AnythingOfType("time.Time")).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("AssignResolver", fixture.Ctx, int64(3), int64(1000), mock.AnythingOfType("time.Time")).Return(nil)
+
+		assigned, err := fixture.Service.TransitionExpiredWagers(fixture.Ctx)
+
+		require.NoError(t, err)
+		require.Len(t, assigned, 2)
+		assert.Equal(t, int64(3000), *assigned[0].AssignedResolverID)
+		assert.Equal(t, int64(1000), *assigned[1].AssignedResolverID)
+		assert.Nil(t, house.AssignedResolverID)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("only the guild's user grants take turns", func(t *testing.T) {
+		fixture.Reset()
+		fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{GuildID: guildID, AutoAssignResolvers: true})
+		// Bot-wide resolvers and role grants are left out even when they come next
+		expectGuildResolvers(
+			&entities.GuildResolver{GuildID: guildID, ResolverType: entities.GuildResolverTypeRole, TargetID: 5000},
+			userGrant(1000),
+			userGrant(TestResolverID),
+		)
+
+		expectTransition(expired(1, entities.GroupWagerTypePool))
+
+		fixture.Mocks.GroupWagerRepo.On("GetLastAssignedResolver", fixture.Ctx).Return(resolverID(1000), nil)
+		fixture.Mocks.GroupWagerRepo.On("AssignResolver", fixture.Ctx, int64(1), TestResolverID, mock.AnythingOfType("time.Time")).Return(nil)
+
+		assigned, err := fixture.Service.TransitionExpiredWagers(fixture.Ctx)
+
+		require.NoError(t, err)
+		require.Len(t, assigned, 1)
+		assert.Equal(t, TestResolverID, *assigned[0].AssignedResolverID)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("no guild user grants leaves wagers unassigned", func(t *testing.T) {
+		fixture.Reset()
+		fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{GuildID: guildID, AutoAssignResolvers: true})
+
+		expectTransition(expired(1, entities.GroupWagerTypePool))
+
+		assigned, err := fixture.Service.TransitionExpiredWagers(fixture.Ctx)
+
+		require.NoError(t, err)
+		assert.Empty(t, assigned)
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "AssignResolver", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		fixture.AssertAllMocks()
+	})
+}
@@ -6,7 +6,9 @@ import (
 	"gambler/discord-client/domain/entities"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // Helper function to create a wager detail from a wager
@@ -51,6 +53,29 @@ func TestGroupWagerService_CancelGroupWager(t *testing.T) {
 				helper.ExpectEventPublish(events.EventTypeGroupWagerStateChange)
 			},
 		},
+		{
+			name:         "cancellation is recorded in event log",
+			groupWagerID: 1,
+			cancellerID:  &creatorID,
+			setupMocks: func(mocks *TestMocks, helper *MockHelper) {
+				wager := &entities.GroupWager{
+					ID:               1,
+					CreatorDiscordID: &creatorID,
+					State:            entities.GroupWagerStatePendingResolution,
+					TotalPot:         5000,
+				}
+				helper.ExpectWagerDetailLookup(1, createWagerDetail(wager))
+				mocks.GroupWagerRepo.On("Update", helper.ctx, mock.Anything).Return(nil)
+				mocks.GroupWagerRepo.On("RecordEvent", helper.ctx, mock.MatchedBy(func(e *entities.GroupWagerEvent) bool {
+					return e.GroupWagerID == 1 &&
+						e.EventType == entities.GroupWagerEventCancelled &&
+						e.ActorDiscordID != nil && *e.ActorDiscordID == creatorID &&
+						e.Payload["previous_state"] == entities.GroupWagerStatePendingResolution &&
+						e.Payload["total_pot"] == int64(5000)
+				})).Return(nil)
+				helper.ExpectEventPublish(events.EventTypeGroupWagerStateChange)
+			},
+		},
 		{
 			name:         "successful cancellation by resolver",
 			groupWagerID: 1,
@@ -293,3 +318,60 @@ func TestGroupWagerService_CancelGroupWager_SystemUser(t *testing.T) {
 		fixture.AssertAllMocks()
 	})
 }
+
+func TestGroupWagerService_RefundAllParticipants(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+	creatorID := int64(123)
+
+	t.Run("returns the refunded bets", func(t *testing.T) {
+		fixture.Reset()
+
+		wager := &entities.GroupWager{
+			ID:               1,
+			CreatorDiscordID: &creatorID,
+			State:            entities.GroupWagerStateActive,
+			TotalPot:         3500,
+		}
+		detail := createWagerDetail(wager)
+		detail.Participants = []*entities.GroupWagerParticipant{
+			{DiscordID: 200, OptionID: 1, Amount: 1000},
+			{DiscordID: 300, OptionID: 2, Amount: 2500},
+		}
+		fixture.Helper.ExpectWagerDetailLookup(1, detail)
+		fixture.Mocks.GroupWagerRepo.On("Update", fixture.Ctx, mock.MatchedBy(func(w *entities.GroupWager) bool {
+			return w.State == entities.GroupWagerStateCancelled
+		})).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("RecordEvent", fixture.Ctx, mock.MatchedBy(func(e *entities.GroupWagerEvent) bool {
+			return e.EventType == entities.GroupWagerEventCancelled &&
+				e.Payload["refunded_participants"] == 2 &&
+				e.Payload["total_refunded"] == int64(3500)
+		})).Return(nil)
+		fixture.Helper.ExpectEventPublish(events.EventTypeGroupWagerStateChange)
+
+		summary, err := fixture.Service.RefundAllParticipants(fixture.Ctx, 1, nil)
+
+		fixture.Assertions.AssertNoError(err)
+		require.NotNil(t, summary)
+		assert.Equal(t, int64(3500), summary.TotalRefunded)
+		require.Len(t, summary.Refunds, 2)
+		assert.Equal(t, int64(300), summary.Refunds[0].DiscordID)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("resolved wager is not refunded", func(t *testing.T) {
+		fixture.Reset()
+
+		wager := &entities.GroupWager{
+			ID:               2,
+			CreatorDiscordID: &creatorID,
+			State:            entities.GroupWagerStateResolved,
+		}
+		fixture.Helper.ExpectWagerDetailLookup(2, createWagerDetail(wager))
+
+		summary, err := fixture.Service.RefundAllParticipants(fixture.Ctx, 2, &creatorID)
+
+		fixture.Assertions.AssertValidationError(err, "can only cancel active or pending resolution group wagers")
+		assert.Nil(t, summary)
+		fixture.AssertAllMocks()
+	})
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"testing"
+	"time"
 
 	"gambler/discord-client/domain/entities"
 
@@ -128,6 +129,15 @@ func TestGroupWagerService_CreateGroupWager_TableDriven(t *testing.T) {
 			wagerType:     entities.GroupWagerTypePool,
 			expectedError: "duplicate option found",
 		},
+		{
+			name:          "duplicate options with different buttons",
+			creatorID:     &testResolverID,
+			condition:     "Test",
+			options:       []string{"Yes 🔥", "No", "yes [red]"}, // duplicate once the button appearance is split off
+			votingMinutes: 60,
+			wagerType:     entities.GroupWagerTypePool,
+			expectedError: "duplicate option found: 'yes'",
+		},
 	}
 
 	for _, tt := range validationTests {
@@ -138,6 +148,7 @@ func TestGroupWagerService_CreateGroupWager_TableDriven(t *testing.T) {
 			// Execute - validation should fail before any repository calls
 			result, err := fixture.Service.CreateGroupWager(
 				fixture.Ctx,
+				TestGuildID,
 				tt.creatorID,
 				tt.condition,
 				tt.options,
@@ -146,6 +157,7 @@ func TestGroupWagerService_CreateGroupWager_TableDriven(t *testing.T) {
 				TestChannelID,
 				tt.wagerType,
 				tt.oddsMultipliers,
+				nil,
 			)
 
 			// Assert
@@ -285,6 +297,7 @@ func TestGroupWagerService_CreateGroupWager_Success(t *testing.T) {
 			// Execute
 			result, err := fixture.Service.CreateGroupWager(
 				fixture.Ctx,
+				TestGuildID,
 				&testResolverID,
 				tt.condition,
 				tt.options,
@@ -293,6 +306,7 @@ func TestGroupWagerService_CreateGroupWager_Success(t *testing.T) {
 				TestChannelID,
 				tt.wagerType,
 				tt.oddsMultipliers,
+				nil,
 			)
 
 			// Assert
@@ -315,6 +329,7 @@ func TestGroupWagerService_CreateGroupWager_UserNotFound(t *testing.T) {
 	// Execute
 	result, err := fixture.Service.CreateGroupWager(
 		fixture.Ctx,
+		TestGuildID,
 		&testResolverID,
 		"Test condition",
 		[]string{"Yes", "No"},
@@ -323,6 +338,7 @@ func TestGroupWagerService_CreateGroupWager_UserNotFound(t *testing.T) {
 		TestChannelID,
 		entities.GroupWagerTypePool,
 		nil,
+		nil,
 	)
 
 	// Assert
@@ -334,6 +350,42 @@ func TestGroupWagerService_CreateGroupWager_UserNotFound(t *testing.T) {
 	fixture.AssertAllMocks()
 }
 
+func TestGroupWagerService_CreateGroupWager_BettingCurfew(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+	creatorID := int64(TestResolverID)
+
+	// Curfew window covering the current hour
+	startHour := time.Now().UTC().Hour()
+	endHour := (startHour + 1) % 24
+	fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{
+		GuildID:         TestGuildID,
+		CurfewStartHour: &startHour,
+		CurfewEndHour:   &endHour,
+	})
+	fixture.Helper.ExpectUserLookup(TestResolverID, &entities.User{DiscordID: TestResolverID, Balance: TestInitialBalance, AvailableBalance: TestInitialBalance})
+
+	result, err := fixture.Service.CreateGroupWager(
+		fixture.Ctx,
+		TestGuildID,
+		&creatorID,
+		"Test condition",
+		[]string{"Yes", "No"},
+		60,
+		TestMessageID,
+		TestChannelID,
+		entities.GroupWagerTypePool,
+		nil,
+		nil,
+	)
+
+	assert.ErrorIs(t, err, entities.ErrBettingCurfewActive)
+	assert.Contains(t, err.Error(), "betting reopens at")
+	assert.Nil(t, result)
+	fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "CreateWithOptions", mock.Anything, mock.Anything, mock.Anything)
+
+	fixture.AssertAllMocks()
+}
+
 func TestGroupWagerService_CreateGroupWager_SystemUser(t *testing.T) {
 	fixture := NewGroupWagerTestFixture(t)
 
@@ -365,6 +417,7 @@ func TestGroupWagerService_CreateGroupWager_SystemUser(t *testing.T) {
 	// Execute
 	result, err := fixture.Service.CreateGroupWager(
 		fixture.Ctx,
+		TestGuildID,
 		nil, // System user - no specific creator
 		"Test condition",
 		[]string{"Yes", "No"},
@@ -373,6 +426,7 @@ func TestGroupWagerService_CreateGroupWager_SystemUser(t *testing.T) {
 		TestChannelID,
 		entities.GroupWagerTypeHouse,
 		[]float64{1.5, 2.0},
+		nil,
 	)
 
 	// Assert
@@ -385,3 +439,72 @@ func TestGroupWagerService_CreateGroupWager_SystemUser(t *testing.T) {
 
 	fixture.AssertAllMocks()
 }
+
+func TestGroupWagerService_CreateGroupWager_OptionButtons(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	fixture.Mocks.GroupWagerRepo.On("CreateWithOptions", fixture.Ctx, mock.Anything,
+		mock.MatchedBy(func(opts []*entities.GroupWagerOption) bool {
+			return len(opts) == 3 &&
+				opts[0].OptionText == "T1" && *opts[0].ButtonEmoji == "<:t1:123>" && *opts[0].ButtonStyle == entities.GroupWagerOptionStyleDanger &&
+				opts[1].OptionText == "BLG" && *opts[1].ButtonEmoji == "🐉" && opts[1].ButtonStyle == nil &&
+				opts[2].OptionText == "GAM" && opts[2].ButtonEmoji == nil && opts[2].ButtonStyle == nil
+		}),
+	).Return(nil)
+
+	result, err := fixture.Service.CreateGroupWager(
+		fixture.Ctx,
+		TestGuildID,
+		nil,
+		"Who wins?",
+		[]string{"T1 <:t1:123> [red]", "BLG 🐉", "GAM"},
+		60,
+		TestMessageID,
+		TestChannelID,
+		entities.GroupWagerTypeHouse,
+		[]float64{1.5, 2.0, 3.0},
+		nil,
+	)
+
+	fixture.Assertions.AssertNoError(err)
+	require.Len(fixture.T, result.Options, 3)
+	assert.Equal(fixture.T, "T1", result.Options[0].OptionText)
+
+	fixture.AssertAllMocks()
+}
+
+func TestGroupWagerService_CreateGroupWager_CreatorOnGamblingBreak(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+	testResolverID := int64(TestResolverID)
+
+	// Setup - creator is on a gambling break
+	breakEndsAt := time.Now().Add(time.Hour)
+	fixture.Helper.ExpectUserLookup(TestResolverID, &entities.User{
+		DiscordID:           TestResolverID,
+		Username:            "resolver",
+		Balance:             TestInitialBalance,
+		AvailableBalance:    TestInitialBalance,
+		GamblingBreakEndsAt: &breakEndsAt,
+	})
+
+	// Execute
+	result, err := fixture.Service.CreateGroupWager(
+		fixture.Ctx,
+		TestGuildID,
+		&testResolverID,
+		"Test condition",
+		[]string{"Yes", "No"},
+		60,
+		TestMessageID,
+		TestChannelID,
+		entities.GroupWagerTypePool,
+		nil,
+		nil,
+	)
+
+	// Assert
+	assert.ErrorIs(t, err, entities.ErrGamblingBreakActive)
+	assert.Nil(t, result)
+
+	fixture.AssertAllMocks()
+}
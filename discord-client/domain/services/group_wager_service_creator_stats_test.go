@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWagerService_GetCreatorStats(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("includes popular options", func(t *testing.T) {
+		service, _, mockGroupWagerRepo, _, _ := createTestGroupWagerService()
+
+		mockGroupWagerRepo.On("GetCreatorStats", ctx, int64(100)).Return(&entities.GroupWagerCreatorStats{
+			DiscordID:            100,
+			TotalCreated:         4,
+			TotalResolved:        3,
+			AveragePot:           1500,
+			AverageParticipants:  4.5,
+			AverageTimeToResolve: 2 * time.Hour,
+		}, nil)
+		mockGroupWagerRepo.On("GetCreatorPopularOptions", ctx, int64(100), creatorStatsPopularOptions).Return([]*entities.GroupWagerPopularOption{
+			{OptionText: "Yes", BetCount: 9, TotalAmount: 4000},
+		}, nil)
+
+		stats, err := service.GetCreatorStats(ctx, 100)
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, stats.TotalCreated)
+		require.Len(t, stats.PopularOptions, 1)
+		assert.Equal(t, "Yes", stats.PopularOptions[0].OptionText)
+		mockGroupWagerRepo.AssertExpectations(t)
+	})
+
+	t.Run("skips options for users who created nothing", func(t *testing.T) {
+		service, _, mockGroupWagerRepo, _, _ := createTestGroupWagerService()
+
+		mockGroupWagerRepo.On("GetCreatorStats", ctx, int64(100)).Return(&entities.GroupWagerCreatorStats{DiscordID: 100}, nil)
+
+		stats, err := service.GetCreatorStats(ctx, 100)
+
+		require.NoError(t, err)
+		assert.Zero(t, stats.TotalCreated)
+		assert.Empty(t, stats.PopularOptions)
+		mockGroupWagerRepo.AssertNotCalled(t, "GetCreatorPopularOptions")
+	})
+
+	t.Run("wraps repository errors", func(t *testing.T) {
+		service, _, mockGroupWagerRepo, _, _ := createTestGroupWagerService()
+
+		mockGroupWagerRepo.On("GetCreatorStats", ctx, int64(100)).Return(nil, errors.New("db down"))
+
+		_, err := service.GetCreatorStats(ctx, 100)
+
+		assert.ErrorContains(t, err, "failed to get creator stats")
+	})
+}
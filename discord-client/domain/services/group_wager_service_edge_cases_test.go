@@ -27,7 +27,7 @@ func TestGroupWagerService_EdgeCases(t *testing.T) {
 			{
 				name: "place bet on non-existent wager",
 				operation: func() error {
-					fixture.Helper.ExpectWagerDetailNotFound(TestWagerID)
+					fixture.Helper.ExpectWagerDetailNotFoundForUpdate(TestWagerID)
 					_, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 1000)
 					return err
 				},
@@ -66,7 +66,7 @@ func TestGroupWagerService_EdgeCases(t *testing.T) {
 		fixture.Reset()
 
 		// Test handling of database connection errors
-		fixture.Mocks.GroupWagerRepo.On("GetDetailByID", fixture.Ctx, int64(TestWagerID)).Return(nil, errors.New("connection failed"))
+		fixture.Mocks.GroupWagerRepo.On("GetDetailByIDForUpdate", fixture.Ctx, int64(TestWagerID)).Return(nil, errors.New("connection failed"))
 
 		_, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 1000)
 
@@ -84,7 +84,7 @@ func TestGroupWagerService_EdgeCases(t *testing.T) {
 			WithOptions("Yes", "No").
 			Build()
 
-		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
 			Wager:        scenario.Wager,
 			Options:      scenario.Options,
 			Participants: scenario.Participants,
@@ -93,7 +93,7 @@ func TestGroupWagerService_EdgeCases(t *testing.T) {
 
 		_, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 1000)
 
-		fixture.Assertions.AssertValidationError(err, "user 100 not found")
+		assert.ErrorIs(t, err, entities.ErrUserNotFound)
 		fixture.AssertAllMocks()
 	})
 
@@ -105,7 +105,7 @@ func TestGroupWagerService_EdgeCases(t *testing.T) {
 			WithOptions("Option A", "Option B").
 			WithUser(TestUser1ID, "user1", TestInitialBalance).
 			Build()
-		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
 			Wager:        scenario.Wager,
 			Options:      scenario.Options,
 			Participants: scenario.Participants,
@@ -152,7 +152,7 @@ func TestGroupWagerService_EdgeCases(t *testing.T) {
 			WithUser(TestUser1ID, "poor_user", 500). // Only 500 balance
 			Build()
 
-		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
 			Wager:        scenario.Wager,
 			Options:      scenario.Options,
 			Participants: scenario.Participants,
@@ -190,7 +190,7 @@ func TestGroupWagerService_EdgeCases(t *testing.T) {
 					Options:      []*entities.GroupWagerOption{},
 					Participants: []*entities.GroupWagerParticipant{},
 				}
-				fixture.Helper.ExpectWagerDetailLookup(TestWagerID, detail)
+				fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, detail)
 
 				_, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 1000)
 
@@ -210,7 +210,7 @@ func TestGroupWagerService_EdgeCases(t *testing.T) {
 			WithUser(TestUser1ID, "user1", TestInitialBalance).
 			Build()
 
-		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
 			Wager:        scenario.Wager,
 			Options:      scenario.Options,
 			Participants: scenario.Participants,
@@ -239,7 +239,7 @@ func TestGroupWagerService_EdgeCases(t *testing.T) {
 			WithUser(TestUser1ID, "user1", TestInitialBalance).
 			Build()
 
-		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
 			Wager:        scenario.Wager,
 			Options:      scenario.Options,
 			Participants: scenario.Participants,
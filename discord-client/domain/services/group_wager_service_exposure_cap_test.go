@@ -158,6 +158,9 @@ func TestGroupWagerService_ResolveGroupWager_ExposureCap(t *testing.T) {
 				mocks.GroupWagerRepo,
 				mocks.UserRepo,
 				mocks.BalanceHistoryRepo,
+				mocks.GuildSettingsRepo,
+				mocks.GuildResolverRepo,
+				mocks.LotteryDrawRepo,
 				mocks.EventPublisher,
 			)
 			service.(*groupWagerService).config.ResolverDiscordIDs = []int64{TestResolverID}
@@ -252,6 +255,9 @@ func TestGroupWagerService_ResolveGroupWager_ExposureCap_EdgeCases(t *testing.T)
 			mocks.GroupWagerRepo,
 			mocks.UserRepo,
 			mocks.BalanceHistoryRepo,
+			mocks.GuildSettingsRepo,
+			mocks.GuildResolverRepo,
+			mocks.LotteryDrawRepo,
 			mocks.EventPublisher,
 		)
 		service.(*groupWagerService).config.ResolverDiscordIDs = []int64{TestResolverID}
@@ -312,6 +318,9 @@ func TestGroupWagerService_ResolveGroupWager_ExposureCap_EdgeCases(t *testing.T)
 			mocks.GroupWagerRepo,
 			mocks.UserRepo,
 			mocks.BalanceHistoryRepo,
+			mocks.GuildSettingsRepo,
+			mocks.GuildResolverRepo,
+			mocks.LotteryDrawRepo,
 			mocks.EventPublisher,
 		)
 		service.(*groupWagerService).config.ResolverDiscordIDs = []int64{TestResolverID}
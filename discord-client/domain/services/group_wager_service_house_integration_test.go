@@ -29,6 +29,9 @@ func TestHouseWager_Integration(t *testing.T) {
 	userRepo := repository.NewUserRepository(testDB.DB)
 	groupWagerRepo := repository.NewGroupWagerRepository(testDB.DB)
 	balanceHistoryRepo := repository.NewBalanceHistoryRepository(testDB.DB)
+	guildSettingsRepo := repository.NewGuildSettingsRepository(testDB.DB)
+	guildResolverRepo := repository.NewGuildResolverRepositoryScoped(testDB.DB.Pool, 0)
+	lotteryDrawRepo := repository.NewLotteryDrawRepositoryScoped(testDB.DB.Pool, 0)
 	eventPublisher := &testhelpers.MockEventPublisher{}
 	eventPublisher.On("Publish", mock.Anything).Return(nil)
 
@@ -45,6 +48,9 @@ func TestHouseWager_Integration(t *testing.T) {
 		groupWagerRepo,
 		userRepo,
 		balanceHistoryRepo,
+		guildSettingsRepo,
+		guildResolverRepo,
+		lotteryDrawRepo,
 		eventPublisher,
 	)
 
@@ -62,6 +68,7 @@ func TestHouseWager_Integration(t *testing.T) {
 		// Create house wager with fixed odds
 		wagerDetail, err := groupWagerService.CreateGroupWager(
 			ctx,
+			int64(0),
 			&creator.DiscordID,
 			"Who will win the championship?",
 			[]string{"Team Alpha", "Team Beta", "Team Gamma"},
@@ -70,6 +77,7 @@ func TestHouseWager_Integration(t *testing.T) {
 			789012,
 			entities.GroupWagerTypeHouse,
 			[]float64{1.5, 2.5, 4.0}, // Fixed odds for each team
+			nil,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, wagerDetail)
@@ -167,6 +175,7 @@ func TestHouseWager_Integration(t *testing.T) {
 		testCreatorID := int64(999999)
 		wagerDetail, err := groupWagerService.CreateGroupWager(
 			ctx,
+			int64(0),
 			&testCreatorID,
 			"Coin flip",
 			[]string{"Heads", "Tails"},
@@ -175,6 +184,7 @@ func TestHouseWager_Integration(t *testing.T) {
 			890123,
 			entities.GroupWagerTypeHouse,
 			[]float64{2.0, 2.0}, // Even odds
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -248,6 +258,7 @@ func TestHouseWager_Integration(t *testing.T) {
 		testCreatorID2 := int64(999999)
 		wagerDetail, err := groupWagerService.CreateGroupWager(
 			ctx,
+			int64(0),
 			&testCreatorID2,
 			"Pick the winner",
 			[]string{"Option A", "Option B", "Option C"},
@@ -256,6 +267,7 @@ func TestHouseWager_Integration(t *testing.T) {
 			901234,
 			entities.GroupWagerTypeHouse,
 			[]float64{3.0, 2.0, 1.5},
+			nil,
 		)
 		require.NoError(t, err)
 
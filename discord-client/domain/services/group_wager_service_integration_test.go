@@ -3,6 +3,7 @@ package services_test
 import (
 	"context"
 	"gambler/discord-client/domain/testhelpers"
+	"sync"
 	"testing"
 	"time"
 
@@ -30,6 +31,9 @@ func TestGroupWagerResolution_Integration(t *testing.T) {
 	userRepo := repository.NewUserRepository(testDB.DB)
 	groupWagerRepo := repository.NewGroupWagerRepository(testDB.DB)
 	balanceHistoryRepo := repository.NewBalanceHistoryRepository(testDB.DB)
+	guildSettingsRepo := repository.NewGuildSettingsRepository(testDB.DB)
+	guildResolverRepo := repository.NewGuildResolverRepositoryScoped(testDB.DB.Pool, 0)
+	lotteryDrawRepo := repository.NewLotteryDrawRepositoryScoped(testDB.DB.Pool, 0)
 	eventPublisher := &testhelpers.MockEventPublisher{}
 	// Allow any publish calls
 	eventPublisher.On("Publish", mock.Anything).Return(nil)
@@ -44,6 +48,9 @@ func TestGroupWagerResolution_Integration(t *testing.T) {
 		groupWagerRepo,
 		userRepo,
 		balanceHistoryRepo,
+		guildSettingsRepo,
+		guildResolverRepo,
+		lotteryDrawRepo,
 		eventPublisher,
 	)
 
@@ -353,6 +360,9 @@ func TestGroupWagerResolution_EdgeCases(t *testing.T) {
 	userRepo := repository.NewUserRepository(testDB.DB)
 	groupWagerRepo := repository.NewGroupWagerRepository(testDB.DB)
 	balanceHistoryRepo := repository.NewBalanceHistoryRepository(testDB.DB)
+	guildSettingsRepo := repository.NewGuildSettingsRepository(testDB.DB)
+	guildResolverRepo := repository.NewGuildResolverRepositoryScoped(testDB.DB.Pool, 0)
+	lotteryDrawRepo := repository.NewLotteryDrawRepositoryScoped(testDB.DB.Pool, 0)
 	eventPublisher := &testhelpers.MockEventPublisher{}
 	// Allow any publish calls
 	eventPublisher.On("Publish", mock.Anything).Return(nil)
@@ -367,6 +377,9 @@ func TestGroupWagerResolution_EdgeCases(t *testing.T) {
 		groupWagerRepo,
 		userRepo,
 		balanceHistoryRepo,
+		guildSettingsRepo,
+		guildResolverRepo,
+		lotteryDrawRepo,
 		eventPublisher,
 	)
 
@@ -502,3 +515,111 @@ func TestGroupWagerResolution_EdgeCases(t *testing.T) {
 		assert.Equal(t, int64(1666), result.PayoutDetails[user1.DiscordID]+result.PayoutDetails[user2.DiscordID]) // Rounding causes 1 bit loss
 	})
 }
+
+func TestGroupWagerPlaceBet_Concurrent_Integration(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	testDB := testutil.SetupTestDatabase(t)
+	ctx := context.Background()
+	guildID := int64(400001)
+
+	userRepo := repository.NewUserRepositoryScoped(testDB.DB.Pool, guildID)
+	groupWagerRepo := repository.NewGroupWagerRepositoryScoped(testDB.DB.Pool, guildID)
+
+	const bettors = 20
+	const betAmount = int64(1000)
+
+	// Create the guild settings up front so the bets don't race to create them
+	_, err := repository.NewGuildSettingsRepository(testDB.DB).GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+
+	creator, err := userRepo.Create(ctx, 999999, "creator", 100000)
+	require.NoError(t, err)
+	for i := 0; i < bettors; i++ {
+		_, err := userRepo.Create(ctx, int64(500000+i), "bettor", 100000)
+		require.NoError(t, err)
+	}
+
+	votingEndsAt := time.Now().Add(24 * time.Hour)
+	groupWager := &entities.GroupWager{
+		CreatorDiscordID:    &creator.DiscordID,
+		Condition:           "Concurrent bets",
+		State:               entities.GroupWagerStateActive,
+		WagerType:           entities.GroupWagerTypePool,
+		VotingPeriodMinutes: 1440,
+		VotingStartsAt:      &time.Time{},
+		VotingEndsAt:        &votingEndsAt,
+	}
+	require.NoError(t, groupWagerRepo.CreateWithOptions(ctx, groupWager, []*entities.GroupWagerOption{
+		{OptionText: "Yes", OptionOrder: 0},
+		{OptionText: "No", OptionOrder: 1},
+	}))
+
+	detail, err := groupWagerRepo.GetDetailByID(ctx, groupWager.ID)
+	require.NoError(t, err)
+	require.Len(t, detail.Options, 2)
+
+	// Each bet runs in its own transaction, the way the bot places bets, and all start at once
+	start := make(chan struct{})
+	errs := make(chan error, bettors)
+	var wg sync.WaitGroup
+	for i := 0; i < bettors; i++ {
+		wg.Add(1)
+		go func(discordID, optionID int64) {
+			defer wg.Done()
+			<-start
+			errs <- placeBetInTransaction(ctx, testDB, guildID, groupWager.ID, discordID, optionID, betAmount)
+		}(int64(500000+i), detail.Options[i%2].ID)
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	// No bet may be lost: the pot and option totals must match the bets that were placed
+	final, err := groupWagerRepo.GetDetailByID(ctx, groupWager.ID)
+	require.NoError(t, err)
+	require.Len(t, final.Participants, bettors)
+
+	assert.Equal(t, bettors*betAmount, final.Wager.TotalPot)
+	var optionTotal int64
+	for _, option := range final.Options {
+		assert.Equal(t, bettors/2*betAmount, option.TotalAmount, "option %s", option.OptionText)
+		optionTotal += option.TotalAmount
+	}
+	assert.Equal(t, final.Wager.TotalPot, optionTotal)
+}
+
+// placeBetInTransaction places a bet in its own committed transaction
+func placeBetInTransaction(ctx context.Context, testDB *testutil.TestDatabase, guildID, groupWagerID, discordID, optionID, amount int64) error {
+	tx, err := testDB.DB.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	eventPublisher := &testhelpers.MockEventPublisher{}
+	eventPublisher.On("Publish", mock.Anything).Return(nil)
+
+	groupWagerService := services.NewGroupWagerService(
+		repository.NewGroupWagerRepositoryScoped(tx, guildID),
+		repository.NewUserRepositoryScoped(tx, guildID),
+		repository.NewBalanceHistoryRepositoryScoped(tx, guildID),
+		repository.NewGuildSettingsRepositoryWithTx(tx),
+		repository.NewGuildResolverRepositoryScoped(tx, guildID),
+		repository.NewLotteryDrawRepositoryScoped(tx, guildID),
+		eventPublisher,
+	)
+
+	if _, err := groupWagerService.PlaceBet(ctx, groupWagerID, discordID, optionID, amount); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGroupWagerService_LinkExternalReference(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+	resolverID := TestResolverID
+	ref := entities.ExternalReference{System: entities.SystemEsports, ID: "worlds-final"}
+
+	openWager := func() *entities.GroupWager {
+		return &entities.GroupWager{ID: 1, State: entities.GroupWagerStateActive}
+	}
+
+	t.Run("resolver links an open wager", func(t *testing.T) {
+		fixture.Reset()
+		fixture.SetResolvers(resolverID)
+
+		fixture.Helper.ExpectWagerDetailLookup(1, createWagerDetail(openWager()))
+		fixture.Mocks.GroupWagerRepo.On("GetByExternalReference", fixture.Ctx, ref).Return(nil, nil)
+		fixture.Mocks.GroupWagerRepo.On("Update", fixture.Ctx, mock.MatchedBy(func(w *entities.GroupWager) bool {
+			return w.ExternalRef != nil && *w.ExternalRef == ref
+		})).Return(nil)
+
+		wager, err := fixture.Service.LinkExternalReference(fixture.Ctx, 1, resolverID, ref)
+		fixture.Assertions.AssertNoError(err)
+		fixture.Equal(ref, *wager.ExternalRef)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("non-resolver rejected", func(t *testing.T) {
+		fixture.Reset()
+		fixture.SetResolvers(resolverID)
+
+		_, err := fixture.Service.LinkExternalReference(fixture.Ctx, 1, 123, ref)
+		fixture.Assertions.AssertValidationError(err, "only resolvers")
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("resolved wager rejected", func(t *testing.T) {
+		fixture.Reset()
+		fixture.SetResolvers(resolverID)
+
+		wager := openWager()
+		wager.State = entities.GroupWagerStateResolved
+		fixture.Helper.ExpectWagerDetailLookup(1, createWagerDetail(wager))
+
+		_, err := fixture.Service.LinkExternalReference(fixture.Ctx, 1, resolverID, ref)
+		fixture.Assertions.AssertValidationError(err, "only unresolved group wagers")
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("game tracked wager rejected", func(t *testing.T) {
+		fixture.Reset()
+		fixture.SetResolvers(resolverID)
+
+		wager := openWager()
+		wager.SetExternalReference(entities.SystemLeagueOfLegends, "NA1_123")
+		fixture.Helper.ExpectWagerDetailLookup(1, createWagerDetail(wager))
+
+		_, err := fixture.Service.LinkExternalReference(fixture.Ctx, 1, resolverID, ref)
+		fixture.Assertions.AssertValidationError(err, "already resolved from league_of_legends games")
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("match linked to another wager", func(t *testing.T) {
+		fixture.Reset()
+		fixture.SetResolvers(resolverID)
+
+		fixture.Helper.ExpectWagerDetailLookup(1, createWagerDetail(openWager()))
+		fixture.Mocks.GroupWagerRepo.On("GetByExternalReference", fixture.Ctx, ref).Return(&entities.GroupWager{ID: 7}, nil)
+
+		_, err := fixture.Service.LinkExternalReference(fixture.Ctx, 1, resolverID, ref)
+		fixture.Assertions.AssertValidationError(err, "already linked to group wager #7")
+		fixture.AssertAllMocks()
+	})
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWagerService_UpdateHouseWagerOdds(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	t.Run("manual update records history and publishes event", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().
+			WithHouseWager(TestResolverID, "Tournament winner").
+			WithOptions("Team A", "Team B").
+			WithOdds(2.5, 1.8).
+			Build()
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+			Wager:        scenario.Wager,
+			Options:      scenario.Options,
+			Participants: scenario.Participants,
+		})
+
+		fixture.Mocks.GroupWagerRepo.On("UpdateAllOptionOdds", fixture.Ctx, int64(TestWagerID), map[int64]float64{
+			TestOption1ID: 3.0,
+		}).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("CreateOddsHistory", fixture.Ctx, mock.MatchedBy(func(changes []*entities.GroupWagerOddsChange) bool {
+			return len(changes) == 1 &&
+				changes[0].OptionID == TestOption1ID &&
+				changes[0].OldMultiplier == 2.5 &&
+				changes[0].NewMultiplier == 3.0 &&
+				changes[0].Source == entities.OddsChangeSourceManual &&
+				changes[0].ChangedByDiscordID != nil && *changes[0].ChangedByDiscordID == TestResolverID
+		})).Return(nil)
+		fixture.Helper.ExpectEventPublish(events.EventTypeGroupWagerOddsChange)
+
+		// Option 2 odds are unchanged and should not be recorded
+		resolverID := int64(TestResolverID)
+		detail, err := fixture.Service.UpdateHouseWagerOdds(fixture.Ctx, TestWagerID, &resolverID, map[int64]float64{
+			TestOption1ID: 3.0,
+			TestOption2ID: 1.8,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, detail)
+		assert.Equal(t, 3.0, detail.Options[0].OddsMultiplier)
+		assert.Equal(t, 1.8, detail.Options[1].OddsMultiplier)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("provider update with unchanged odds is a no-op", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().
+			WithHouseWager(TestResolverID, "Tournament winner").
+			WithOptions("Team A", "Team B").
+			WithOdds(2.5, 1.8).
+			Build()
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+			Wager:        scenario.Wager,
+			Options:      scenario.Options,
+			Participants: scenario.Participants,
+		})
+
+		_, err := fixture.Service.UpdateHouseWagerOdds(fixture.Ctx, TestWagerID, nil, map[int64]float64{
+			TestOption1ID: 2.5,
+		})
+
+		require.NoError(t, err)
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "UpdateAllOptionOdds", mock.Anything, mock.Anything, mock.Anything)
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "CreateOddsHistory", mock.Anything, mock.Anything)
+		fixture.Mocks.EventPublisher.AssertNotCalled(t, "Publish", mock.Anything)
+	})
+
+	t.Run("non-resolver cannot update odds", func(t *testing.T) {
+		fixture.Reset()
+
+		userID := int64(TestUser1ID)
+		_, err := fixture.Service.UpdateHouseWagerOdds(fixture.Ctx, TestWagerID, &userID, map[int64]float64{
+			TestOption1ID: 3.0,
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not authorized")
+	})
+
+	t.Run("pool wager odds cannot be set", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().
+			WithPoolWager(TestResolverID, "Pool wager").
+			WithOptions("Yes", "No").
+			Build()
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+			Wager:        scenario.Wager,
+			Options:      scenario.Options,
+			Participants: scenario.Participants,
+		})
+
+		resolverID := int64(TestResolverID)
+		_, err := fixture.Service.UpdateHouseWagerOdds(fixture.Ctx, TestWagerID, &resolverID, map[int64]float64{
+			TestOption1ID: 3.0,
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "only be set on house wagers")
+	})
+
+	t.Run("unknown option is rejected", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().
+			WithHouseWager(TestResolverID, "Tournament winner").
+			WithOptions("Team A", "Team B").
+			WithOdds(2.5, 1.8).
+			Build()
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+			Wager:        scenario.Wager,
+			Options:      scenario.Options,
+			Participants: scenario.Participants,
+		})
+
+		_, err := fixture.Service.UpdateHouseWagerOdds(fixture.Ctx, TestWagerID, nil, map[int64]float64{
+			999: 3.0,
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no option found")
+	})
+}
+
+func TestGroupWagerService_ResolveGroupWager_LockedOdds(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	// User1 bet at 3.0 before the odds were cut to 2.0, user2 bet after
+	scenario := NewGroupWagerScenario().
+		WithHouseWager(TestResolverID, "Tournament winner").
+		WithOptions("Team A", "Team B").
+		WithOdds(2.0, 1.8).
+		WithUser(TestUser1ID, "user1", 10000).
+		WithUser(TestUser2ID, "user2", 10000).
+		WithUser(TestUser3ID, "user3", 10000).
+		WithParticipant(TestUser1ID, 0, 1000).
+		WithParticipant(TestUser2ID, 0, 1000).
+		WithParticipant(TestUser3ID, 1, 500).
+		Build()
+	lockedOdds := 3.0
+	currentOdds := 2.0
+	scenario.Participants[0].LockedMultiplier = &lockedOdds
+	scenario.Participants[1].LockedMultiplier = &currentOdds
+
+	fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+		Wager:        scenario.Wager,
+		Options:      scenario.Options,
+		Participants: scenario.Participants,
+	})
+	for _, user := range scenario.Users {
+		fixture.Helper.ExpectUserLookup(user.DiscordID, user)
+	}
+
+	// Winners are paid at their locked odds, losers forfeit their bet
+	fixture.Helper.ExpectBalanceUpdate(TestUser1ID, 12000)
+	fixture.Helper.ExpectBalanceHistoryRecordSimple(TestUser1ID, 12000, entities.TransactionTypeGroupWagerWin)
+	fixture.Helper.ExpectBalanceUpdate(TestUser2ID, 11000)
+	fixture.Helper.ExpectBalanceHistoryRecordSimple(TestUser2ID, 11000, entities.TransactionTypeGroupWagerWin)
+	fixture.Helper.ExpectBalanceUpdate(TestUser3ID, 9500)
+	fixture.Helper.ExpectBalanceHistoryRecordSimple(TestUser3ID, 9500, entities.TransactionTypeGroupWagerLoss)
+	fixture.Helper.ExpectEventPublish(events.EventTypeBalanceChange)
+	fixture.Helper.ExpectEventPublish(events.EventTypeGroupWagerStateChange)
+
+	fixture.Mocks.GroupWagerRepo.On("UpdateParticipantPayouts", fixture.Ctx, mock.Anything).Return(nil)
+	fixture.Mocks.GroupWagerRepo.On("Update", fixture.Ctx, mock.Anything).Return(nil)
+
+	resolverID := int64(TestResolverID)
+	result, err := fixture.Service.ResolveGroupWager(fixture.Ctx, TestWagerID, &resolverID, TestOption1ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3000), result.PayoutDetails[TestUser1ID])
+	assert.Equal(t, int64(2000), result.PayoutDetails[TestUser2ID])
+	assert.Equal(t, int64(0), result.PayoutDetails[TestUser3ID])
+	fixture.AssertAllMocks()
+}
+
+func TestGroupWagerDomainService_CalculateHouseWagerPayouts_LockedOdds(t *testing.T) {
+	service := NewGroupWagerDomainService()
+
+	wager := &entities.GroupWager{ID: TestWagerID, WagerType: entities.GroupWagerTypeHouse, TotalPot: 2500}
+	winningOption := &entities.GroupWagerOption{ID: TestOption1ID, OddsMultiplier: 1.5}
+	lockedOdds := 2.5
+
+	participants := []*entities.GroupWagerParticipant{
+		{DiscordID: TestUser1ID, OptionID: TestOption1ID, Amount: 1000, LockedMultiplier: &lockedOdds},
+		{DiscordID: TestUser2ID, OptionID: TestOption1ID, Amount: 1000}, // No locked odds, falls back to option odds
+		{DiscordID: TestUser3ID, OptionID: TestOption2ID, Amount: 500},
+	}
+
+	result := service.CalculateHouseWagerPayouts(wager, winningOption, participants)
+
+	assert.Len(t, result.Winners, 2)
+	assert.Len(t, result.Losers, 1)
+	assert.Equal(t, int64(2500), result.PayoutDetails[TestUser1ID])
+	assert.Equal(t, int64(1500), result.PayoutDetails[TestUser2ID])
+}
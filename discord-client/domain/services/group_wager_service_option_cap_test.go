@@ -0,0 +1,116 @@
+package services
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWagerService_CreateGroupWager_OptionCaps(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	t.Run("caps are stored on options and zero leaves an option uncapped", func(t *testing.T) {
+		fixture.Reset()
+
+		fixture.Mocks.GroupWagerRepo.On("CreateWithOptions", fixture.Ctx, mock.Anything,
+			mock.MatchedBy(func(opts []*entities.GroupWagerOption) bool {
+				return len(opts) == 2 &&
+					opts[0].MaxTotalAmount != nil && *opts[0].MaxTotalAmount == 50000 &&
+					opts[1].MaxTotalAmount == nil
+			}),
+		).Return(nil)
+
+		result, err := fixture.Service.CreateGroupWager(fixture.Ctx, TestGuildID, nil, "Test condition", []string{"Win", "Loss"}, 60,
+			TestMessageID, TestChannelID, entities.GroupWagerTypeHouse, []float64{2.0, 2.0}, []int64{50000, 0})
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("caps must match the options", func(t *testing.T) {
+		fixture.Reset()
+
+		_, err := fixture.Service.CreateGroupWager(fixture.Ctx, TestGuildID, nil, "Test condition", []string{"Win", "Loss"}, 60,
+			TestMessageID, TestChannelID, entities.GroupWagerTypeHouse, []float64{2.0, 2.0}, []int64{50000})
+
+		fixture.Assertions.AssertValidationError(err, "must provide a cap for each option")
+	})
+
+	t.Run("negative caps are rejected", func(t *testing.T) {
+		fixture.Reset()
+
+		_, err := fixture.Service.CreateGroupWager(fixture.Ctx, TestGuildID, nil, "Test condition", []string{"Win", "Loss"}, 60,
+			TestMessageID, TestChannelID, entities.GroupWagerTypeHouse, []float64{2.0, 2.0}, []int64{50000, -1})
+
+		fixture.Assertions.AssertValidationError(err, "cannot be negative")
+	})
+}
+
+func TestGroupWagerService_PlaceBet_OptionCap(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	expectDetail := func(scenario *GroupWagerScenario) {
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+			Wager:        scenario.Wager,
+			Options:      scenario.Options,
+			Participants: scenario.Participants,
+		})
+		user, _ := scenario.GetUser(TestUser1ID)
+		fixture.Helper.ExpectUserLookup(TestUser1ID, user)
+		fixture.Helper.ExpectParticipantLookup(TestWagerID, TestUser1ID, findParticipantInScenario(scenario.Participants, TestUser1ID))
+	}
+
+	t.Run("bet that pushes an option past its cap is rejected", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().
+			WithHouseWager(TestResolverID, "Test condition").
+			WithOptions("Win", "Loss").
+			WithOdds(2.0, 2.0).
+			WithUser(TestUser1ID, "user1", TestInitialBalance).
+			WithUser(TestUser2ID, "user2", TestInitialBalance).
+			WithParticipant(TestUser2ID, 0, 4000).
+			WithOptionCap(0, 5000).
+			Build()
+		expectDetail(scenario)
+
+		participant, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 2000)
+
+		require.Error(t, err)
+		assert.Nil(t, participant)
+		assert.Contains(t, err.Error(), "capped at")
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "SaveParticipant", mock.Anything, mock.Anything)
+	})
+
+	t.Run("raising a bet on the same option only counts the increase", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().
+			WithHouseWager(TestResolverID, "Test condition").
+			WithOptions("Win", "Loss").
+			WithOdds(2.0, 2.0).
+			WithUser(TestUser1ID, "user1", TestInitialBalance).
+			WithParticipant(TestUser1ID, 0, 4000).
+			WithOptionCap(0, 5000).
+			Build()
+		expectDetail(scenario)
+
+		fixture.Mocks.GroupWagerRepo.On("SaveParticipant", fixture.Ctx, mock.MatchedBy(func(p *entities.GroupWagerParticipant) bool {
+			return p.DiscordID == TestUser1ID && p.Amount == 5000
+		})).Return(nil)
+		fixture.Helper.ExpectOptionTotalUpdate(TestOption1ID, 5000)
+		fixture.Mocks.GroupWagerRepo.On("Update", fixture.Ctx, mock.Anything).Return(nil)
+
+		participant, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 5000)
+
+		require.NoError(t, err)
+		require.NotNil(t, participant)
+		assert.Equal(t, int64(5000), participant.Amount)
+		fixture.AssertAllMocks()
+	})
+}
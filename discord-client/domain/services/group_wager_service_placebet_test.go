@@ -2,6 +2,7 @@ package services
 
 import (
 	"testing"
+	"time"
 
 	"gambler/discord-client/domain/entities"
 
@@ -115,6 +116,9 @@ func TestGroupWagerService_PlaceBet_TableDriven(t *testing.T) {
 				require.NotNil(t, participant)
 				assert.Equal(t, int64(2000), participant.Amount)
 				assert.Equal(t, int64(TestOption2ID), participant.OptionID)
+				// Switching options locks the whole bet at the new option's current odds
+				require.NotNil(t, participant.LockedMultiplier)
+				assert.Equal(t, 1.8, *participant.LockedMultiplier)
 			},
 		},
 		{
@@ -154,8 +158,8 @@ func TestGroupWagerService_PlaceBet_TableDriven(t *testing.T) {
 				Options:      fullScenario.Options,
 				Participants: fullScenario.Participants,
 			}
-			fixture.Helper.ExpectWagerDetailLookup(TestWagerID, detail)
-			fixture.Helper.ExpectWagerDetailLookup(TestWagerID, detail)
+			fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, detail)
+			fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, detail)
 
 			// Setup user mock if user exists in scenario
 			if user, exists := fullScenario.GetUser(TestUser1ID); exists {
@@ -178,6 +182,17 @@ func TestGroupWagerService_PlaceBet_TableDriven(t *testing.T) {
 							p.Amount == tc.betAmount
 					})).Return(nil)
 
+					// The previous choice is kept in the bet change trail
+					previousOptionID, previousAmount := existingParticipant.OptionID, existingParticipant.Amount
+					fixture.Mocks.GroupWagerRepo.On("RecordBetChange", fixture.Ctx, mock.MatchedBy(func(c *entities.GroupWagerBetChange) bool {
+						return c.GroupWagerID == TestWagerID &&
+							c.DiscordID == TestUser1ID &&
+							c.PreviousOptionID == previousOptionID &&
+							c.PreviousAmount == previousAmount &&
+							c.NewOptionID == fullScenario.Options[tc.betOption].ID &&
+							c.NewAmount == tc.betAmount
+					})).Return(nil)
+
 					// Expect option total updates for both old and new options
 					if existingParticipant.OptionID != fullScenario.Options[tc.betOption].ID {
 						// Different option - update old option to 0, new option to bet amount
@@ -234,7 +249,7 @@ func TestGroupWagerService_PlaceBet_CompleteFlow(t *testing.T) {
 			Build()
 
 		// Setup mocks for successful bet
-		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
 			Wager:        scenario.Wager,
 			Options:      scenario.Options,
 			Participants: scenario.Participants,
@@ -282,7 +297,7 @@ func TestGroupWagerService_PlaceBet_CompleteFlow(t *testing.T) {
 			Build()
 
 		// Setup mocks for successful bet
-		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
 			Wager:        scenario.Wager,
 			Options:      scenario.Options,
 			Participants: scenario.Participants,
@@ -309,6 +324,50 @@ func TestGroupWagerService_PlaceBet_CompleteFlow(t *testing.T) {
 		require.NotNil(t, participant)
 		assert.Equal(t, int64(TestOption1ID), participant.OptionID)
 		assert.Equal(t, int64(1000), participant.Amount)
+		require.NotNil(t, participant.LockedMultiplier, "House wager bets should lock in current odds")
+		assert.Equal(t, 2.5, *participant.LockedMultiplier)
+
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("house wager - topping up keeps the locked odds on the bits already staked", func(t *testing.T) {
+		fixture.Reset()
+
+		// The bet was locked at 1.5x before the odds rose to 3.0x
+		scenario := NewGroupWagerScenario().
+			WithHouseWager(TestResolverID, "Test house wager").
+			WithOptions("Team A", "Team B").
+			WithOdds(3.0, 1.8).
+			WithUser(TestUser1ID, "user1", TestInitialBalance).
+			WithParticipant(TestUser1ID, 0, 10000).
+			Build()
+		lockedOdds := 1.5
+		existing := findParticipantInScenario(scenario.Participants, TestUser1ID)
+		existing.LockedMultiplier = &lockedOdds
+
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+			Wager:        scenario.Wager,
+			Options:      scenario.Options,
+			Participants: scenario.Participants,
+		})
+		user1, _ := scenario.GetUser(TestUser1ID)
+		fixture.Helper.ExpectUserLookup(TestUser1ID, user1)
+		fixture.Helper.ExpectParticipantLookup(TestWagerID, TestUser1ID, existing)
+		fixture.Mocks.GroupWagerRepo.On("SaveParticipant", fixture.Ctx, mock.AnythingOfType("*entities.GroupWagerParticipant")).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("RecordBetChange", fixture.Ctx, mock.AnythingOfType("*entities.GroupWagerBetChange")).Return(nil)
+		fixture.Helper.ExpectOptionTotalUpdate(TestOption1ID, 10001)
+		fixture.Mocks.GroupWagerRepo.On("Update", fixture.Ctx, mock.MatchedBy(func(gw *entities.GroupWager) bool {
+			return gw.ID == TestWagerID
+		})).Return(nil)
+
+		participant, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 10001)
+
+		require.NoError(t, err)
+		require.NotNil(t, participant.LockedMultiplier)
+		// 10,000 bits pay at 1.5x and only the added bit pays at 3.0x
+		payout := int64(float64(participant.Amount) * participant.GetPayoutMultiplier(scenario.Options[0]))
+		assert.InDelta(t, 15003, payout, 1)
+		assert.Less(t, *participant.LockedMultiplier, 1.51)
 
 		fixture.AssertAllMocks()
 	})
@@ -328,7 +387,7 @@ func TestGroupWagerService_PlaceBet_EdgeCases(t *testing.T) {
 			Build()
 
 		// Setup mocks
-		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
 			Wager:        scenario.Wager,
 			Options:      scenario.Options,
 			Participants: scenario.Participants,
@@ -375,3 +434,238 @@ func TestGroupWagerService_PlaceBet_EdgeCases(t *testing.T) {
 		fixture.AssertAllMocks()
 	})
 }
+
+func TestGroupWagerService_PlaceBet_GamblingBreak(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	scenario := NewGroupWagerScenario().
+		WithHouseWager(TestResolverID, "Test wager").
+		WithOptions("Yes", "No").
+		WithOdds(2.0, 2.0).
+		WithUser(TestUser1ID, "user1", TestInitialBalance).
+		Build()
+
+	user, _ := scenario.GetUser(TestUser1ID)
+	breakEndsAt := time.Now().Add(24 * time.Hour)
+	user.GamblingBreakEndsAt = &breakEndsAt
+
+	fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+		Wager:        scenario.Wager,
+		Options:      scenario.Options,
+		Participants: scenario.Participants,
+	})
+	fixture.Helper.ExpectUserLookup(TestUser1ID, user)
+
+	participant, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 1000)
+
+	assert.ErrorIs(t, err, entities.ErrGamblingBreakActive)
+	assert.Nil(t, participant)
+	fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "SaveParticipant", mock.Anything, mock.Anything)
+
+	fixture.AssertAllMocks()
+}
+
+func TestGroupWagerService_PlaceBet_BettingCurfew(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	scenario := NewGroupWagerScenario().
+		WithPoolWager(TestResolverID, "Test wager").
+		WithOptions("Yes", "No").
+		WithUser(TestUser1ID, "user1", TestInitialBalance).
+		Build()
+
+	// Curfew window covering the current hour
+	startHour := time.Now().UTC().Hour()
+	endHour := (startHour + 1) % 24
+	fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{
+		CurfewStartHour: &startHour,
+		CurfewEndHour:   &endHour,
+	})
+	fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+		Wager:        scenario.Wager,
+		Options:      scenario.Options,
+		Participants: scenario.Participants,
+	})
+
+	participant, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 1000)
+
+	assert.ErrorIs(t, err, entities.ErrBettingCurfewActive)
+	assert.Contains(t, err.Error(), "betting reopens at")
+	assert.Nil(t, participant)
+	fixture.Mocks.UserRepo.AssertNotCalled(t, "GetByDiscordID", mock.Anything, mock.Anything)
+
+	fixture.AssertAllMocks()
+}
+
+func TestGroupWagerService_PlaceBet_BelowMinBet(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	scenario := NewGroupWagerScenario().
+		WithPoolWager(TestResolverID, "Test wager").
+		WithOptions("Yes", "No").
+		WithUser(TestUser1ID, "user1", TestInitialBalance).
+		Build()
+
+	minBet := int64(5000)
+	fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{MinBetAmount: &minBet})
+	fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+		Wager:        scenario.Wager,
+		Options:      scenario.Options,
+		Participants: scenario.Participants,
+	})
+
+	participant, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 1000)
+
+	assert.ErrorIs(t, err, entities.ErrBelowMinBet)
+	assert.Nil(t, participant)
+	fixture.Mocks.UserRepo.AssertNotCalled(t, "GetByDiscordID", mock.Anything, mock.Anything)
+
+	fixture.AssertAllMocks()
+}
+
+func TestGroupWagerService_PlaceBet_OwnGameBlocked(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	scenario := NewGroupWagerScenario().
+		WithHouseWager(TestResolverID, "Player - **Ranked Solo**").
+		WithOptions("Win", "Loss").
+		WithOdds(2.0, 2.0).
+		WithUser(TestUser1ID, "user1", TestInitialBalance).
+		Build()
+
+	// The bettor is the linked player whose game the wager is on
+	subjectID := int64(TestUser1ID)
+	scenario.Wager.SubjectDiscordID = &subjectID
+
+	fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{BlockOwnGameBets: true})
+	fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+		Wager:        scenario.Wager,
+		Options:      scenario.Options,
+		Participants: scenario.Participants,
+	})
+
+	participant, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 1000)
+
+	assert.ErrorIs(t, err, entities.ErrBetOnOwnGame)
+	assert.Nil(t, participant)
+	fixture.Mocks.UserRepo.AssertNotCalled(t, "GetByDiscordID", mock.Anything, mock.Anything)
+
+	fixture.AssertAllMocks()
+}
+
+func TestGroupWagerService_PlaceBet_SnipingProtection(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+	window, extension := 2, 5
+
+	placeBetEndingIn := func(remaining time.Duration) *entities.GroupWager {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().
+			WithPoolWager(TestResolverID, "Test wager").
+			WithOptions("Yes", "No").
+			WithUser(TestUser1ID, "user1", TestInitialBalance).
+			Build()
+		votingEndsAt := time.Now().Add(remaining)
+		scenario.Wager.VotingEndsAt = &votingEndsAt
+
+		fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{
+			SnipeWindowMinutes:    &window,
+			SnipeExtensionMinutes: &extension,
+		})
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+			Wager:        scenario.Wager,
+			Options:      scenario.Options,
+			Participants: scenario.Participants,
+		})
+		user1, _ := scenario.GetUser(TestUser1ID)
+		fixture.Helper.ExpectUserLookup(TestUser1ID, user1)
+		fixture.Helper.ExpectParticipantLookup(TestWagerID, TestUser1ID, nil)
+		fixture.Helper.ExpectNewParticipant(TestWagerID, TestUser1ID, TestOption1ID, int64(1000))
+		fixture.Helper.ExpectOptionTotalUpdate(TestOption1ID, 1000)
+		fixture.Mocks.GroupWagerRepo.On("Update", fixture.Ctx, mock.Anything).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("UpdateAllOptionOdds", fixture.Ctx, int64(TestWagerID), mock.Anything).Return(nil)
+
+		return scenario.Wager
+	}
+
+	t.Run("bet in the final minutes extends voting", func(t *testing.T) {
+		wager := placeBetEndingIn(time.Minute)
+		originalEnd := *wager.VotingEndsAt
+
+		fixture.Mocks.GroupWagerRepo.On("RecordEvent", fixture.Ctx, mock.MatchedBy(func(e *entities.GroupWagerEvent) bool {
+			return e.EventType == entities.GroupWagerEventVotingExtended && e.Payload["extension_minutes"] == extension
+		})).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("RecordEvent", fixture.Ctx, mock.Anything).Return(nil)
+		fixture.Mocks.EventPublisher.On("Publish", mock.AnythingOfType("events.GroupWagerStateChangeEvent")).Return(nil)
+
+		_, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 1000)
+
+		require.NoError(t, err)
+		assert.Equal(t, originalEnd.Add(5*time.Minute), *wager.VotingEndsAt)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("bet before the window leaves voting alone", func(t *testing.T) {
+		wager := placeBetEndingIn(10 * time.Minute)
+		originalEnd := *wager.VotingEndsAt
+
+		_, err := fixture.Service.PlaceBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, 1000)
+
+		require.NoError(t, err)
+		assert.Equal(t, originalEnd, *wager.VotingEndsAt)
+		fixture.Mocks.EventPublisher.AssertNotCalled(t, "Publish", mock.Anything)
+		fixture.AssertAllMocks()
+	})
+}
+
+func TestGroupWagerService_PlaceBetWithAmount(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	// The user has 500 bet on the first option and 300 more available
+	setupExistingBet := func() {
+		fixture.Reset()
+		scenario := NewGroupWagerScenario().
+			WithPoolWager(TestResolverID, "Test condition").
+			WithOptions("Option 1", "Option 2").
+			WithParticipant(TestUser1ID, 0, 500).
+			Build()
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+			Wager:        scenario.Wager,
+			Options:      scenario.Options,
+			Participants: scenario.Participants,
+		})
+		fixture.Helper.ExpectUserLookup(TestUser1ID, &entities.User{DiscordID: TestUser1ID, Balance: 800, AvailableBalance: 300})
+		fixture.Helper.ExpectParticipantLookup(TestWagerID, TestUser1ID, findParticipantInScenario(scenario.Participants, TestUser1ID))
+		fixture.Mocks.GroupWagerRepo.On("RecordBetChange", fixture.Ctx, mock.Anything).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("Update", fixture.Ctx, mock.Anything).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("UpdateAllOptionOdds", fixture.Ctx, int64(TestWagerID), mock.Anything).Return(nil)
+	}
+	expectBet := func(amount int64) {
+		fixture.Mocks.GroupWagerRepo.On("SaveParticipant", fixture.Ctx, mock.MatchedBy(func(p *entities.GroupWagerParticipant) bool {
+			return p.DiscordID == TestUser1ID && p.Amount == amount
+		})).Return(nil)
+		fixture.Helper.ExpectOptionTotalUpdate(TestOption1ID, amount)
+	}
+
+	t.Run("all bets the current bet plus the available balance", func(t *testing.T) {
+		setupExistingBet()
+		expectBet(800)
+
+		participant, err := fixture.Service.PlaceBetWithAmount(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, entities.Amount{Percent: 100})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(800), participant.Amount)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("half is taken from the current bet plus the available balance", func(t *testing.T) {
+		setupExistingBet()
+		expectBet(400)
+
+		participant, err := fixture.Service.PlaceBetWithAmount(fixture.Ctx, TestWagerID, TestUser1ID, TestOption1ID, entities.Amount{Percent: 50})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(400), participant.Amount)
+		fixture.AssertAllMocks()
+	})
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGroupWagerService_PreviewBet(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	poolDetail := func(state entities.GroupWagerState) *entities.GroupWagerDetail {
+		votingEndsAt := time.Now().Add(time.Hour)
+		return &entities.GroupWagerDetail{
+			Wager: &entities.GroupWager{ID: TestWagerID, State: state, WagerType: entities.GroupWagerTypePool, TotalPot: 4000, VotingEndsAt: &votingEndsAt},
+			Options: []*entities.GroupWagerOption{
+				{ID: TestOption1ID, OptionText: "Yes", TotalAmount: 3000},
+				{ID: TestOption2ID, OptionText: "No", TotalAmount: 1000},
+			},
+		}
+	}
+
+	t.Run("previews the payout without placing the bet", func(t *testing.T) {
+		fixture.Reset()
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, poolDetail(entities.GroupWagerStateActive))
+		fixture.Helper.ExpectUserLookup(TestUser1ID, &entities.User{DiscordID: TestUser1ID, AvailableBalance: 7000})
+		fixture.Mocks.GroupWagerRepo.On("GetParticipant", mock.Anything, int64(TestWagerID), int64(TestUser1ID)).Return(nil, nil)
+
+		preview, err := fixture.Service.PreviewBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption2ID, entities.FixedAmount(1000))
+		fixture.Assertions.AssertNoError(err)
+		fixture.Equal("No", preview.Option.OptionText)
+		fixture.Equal(2.5, preview.Multiplier)
+		fixture.Equal(int64(2500), preview.PotentialPayout)
+		fixture.Equal(int64(7000), preview.AvailableBalance)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("closed wager rejected", func(t *testing.T) {
+		fixture.Reset()
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, poolDetail(entities.GroupWagerStateResolved))
+
+		_, err := fixture.Service.PreviewBet(fixture.Ctx, TestWagerID, TestUser1ID, TestOption2ID, entities.FixedAmount(1000))
+		fixture.Assertions.AssertValidationError(err, "resolved wager")
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("unknown option rejected", func(t *testing.T) {
+		fixture.Reset()
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, poolDetail(entities.GroupWagerStateActive))
+
+		_, err := fixture.Service.PreviewBet(fixture.Ctx, TestWagerID, TestUser1ID, 999, entities.FixedAmount(1000))
+		fixture.Assertions.AssertValidationError(err, "invalid option")
+		fixture.AssertAllMocks()
+	})
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWagerService_EnableReactionBetting(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+	messageID, channelID := int64(555), int64(777)
+
+	t.Run("creator enables reaction betting", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().WithOptions("Yes", "No").Build()
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+			Wager: scenario.Wager, Options: scenario.Options,
+		})
+		fixture.Mocks.GroupWagerRepo.On("UpdateReactionBetting", fixture.Ctx, TestWagerID, messageID, channelID, int64(250)).Return(nil)
+
+		wager, err := fixture.Service.EnableReactionBetting(fixture.Ctx, TestWagerID, TestUser1ID, messageID, channelID, 250)
+
+		require.NoError(t, err)
+		assert.True(t, wager.IsReactionWager())
+		assert.Equal(t, int64(250), wager.GetReactionStake())
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("only the creator can enable reaction betting", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().Build()
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{Wager: scenario.Wager})
+
+		_, err := fixture.Service.EnableReactionBetting(fixture.Ctx, TestWagerID, TestUser2ID, messageID, channelID, 250)
+
+		fixture.Assertions.AssertValidationError(err, "only the creator")
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "UpdateReactionBetting", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("stake must be positive", func(t *testing.T) {
+		fixture.Reset()
+
+		_, err := fixture.Service.EnableReactionBetting(fixture.Ctx, TestWagerID, TestUser1ID, messageID, channelID, 0)
+
+		assert.ErrorContains(t, err, "reaction stake")
+	})
+}
+
+func TestGroupWagerService_PlaceReactionBet_RequiresReactionWager(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	scenario := NewGroupWagerScenario().WithOptions("Yes", "No").Build()
+	fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+		Wager: scenario.Wager, Options: scenario.Options,
+	})
+
+	_, err := fixture.Service.PlaceReactionBet(fixture.Ctx, TestWagerID, TestUser2ID, TestOption1ID)
+
+	assert.ErrorContains(t, err, "does not take bets from reactions")
+}
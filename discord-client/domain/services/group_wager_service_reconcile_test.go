@@ -0,0 +1,98 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWagerService_ReconcileStuckWagers(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	guildID := TestGuildID
+	now := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+	hours := func(h int) *int { return &h }
+	pendingFor := func(id int64, h int) *entities.GroupWager {
+		votingEndsAt := now.Add(-time.Duration(h) * time.Hour)
+		return &entities.GroupWager{
+			ID:           id,
+			GuildID:      guildID,
+			State:        entities.GroupWagerStatePendingResolution,
+			VotingEndsAt: &votingEndsAt,
+			MessageID:    789,
+			ChannelID:    456,
+		}
+	}
+
+	t.Run("disabled settings skip the scan", func(t *testing.T) {
+		fixture.Reset()
+		fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{GuildID: guildID})
+
+		result, err := fixture.Service.ReconcileStuckWagers(fixture.Ctx, guildID, now)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Reminded)
+		assert.Empty(t, result.Cancelled)
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "GetWagersPendingResolution", mock.Anything)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("reminds stuck wagers and cancels expired ones", func(t *testing.T) {
+		fixture.Reset()
+		fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{
+			GuildID:                 guildID,
+			StuckWagerReminderHours: hours(24),
+			StuckWagerCancelHours:   hours(72),
+		})
+
+		fresh := pendingFor(1, 2)
+		stuck := pendingFor(2, 30)
+		expired := pendingFor(3, 80)
+		fixture.Mocks.GroupWagerRepo.On("GetWagersPendingResolution", fixture.Ctx).
+			Return([]*entities.GroupWager{fresh, stuck, expired}, nil)
+
+		fixture.Mocks.GroupWagerRepo.On("MarkResolutionReminded", fixture.Ctx, int64(2), now).Return(nil)
+
+		fixture.Helper.ExpectWagerDetailLookup(3, createWagerDetail(pendingFor(3, 80)))
+		fixture.Mocks.GroupWagerRepo.On("Update", fixture.Ctx, mock.MatchedBy(func(w *entities.GroupWager) bool {
+			return w.ID == 3 && w.State == entities.GroupWagerStateCancelled
+		})).Return(nil)
+		fixture.Helper.ExpectEventPublish(events.EventTypeGroupWagerStateChange)
+
+		result, err := fixture.Service.ReconcileStuckWagers(fixture.Ctx, guildID, now)
+
+		require.NoError(t, err)
+		require.Len(t, result.Reminded, 1)
+		assert.Equal(t, int64(2), result.Reminded[0].ID)
+		require.Len(t, result.Cancelled, 1)
+		assert.Equal(t, int64(3), result.Cancelled[0].ID)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("already reminded wagers are not pinged again until the age elapses", func(t *testing.T) {
+		fixture.Reset()
+		fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{
+			GuildID:                 guildID,
+			StuckWagerReminderHours: hours(24),
+		})
+
+		stuck := pendingFor(1, 30)
+		remindedAt := now.Add(-6 * time.Hour)
+		stuck.RemindedAt = &remindedAt
+		fixture.Mocks.GroupWagerRepo.On("GetWagersPendingResolution", fixture.Ctx).
+			Return([]*entities.GroupWager{stuck}, nil)
+
+		result, err := fixture.Service.ReconcileStuckWagers(fixture.Ctx, guildID, now)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Reminded)
+		assert.Empty(t, result.Cancelled)
+		fixture.AssertAllMocks()
+	})
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWagerService_ReorderOptions(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+
+	newOptions := func() []interface{} {
+		return []interface{}{
+			&entities.GroupWagerOption{ID: 1, GroupWagerID: TestWagerID, OptionText: "Red", OptionOrder: 0},
+			&entities.GroupWagerOption{ID: 2, GroupWagerID: TestWagerID, OptionText: "Blue", OptionOrder: 1},
+			&entities.GroupWagerOption{ID: 3, GroupWagerID: TestWagerID, OptionText: "Draw", OptionOrder: 2},
+		}
+	}
+
+	t.Run("creator reorders options before anyone bets", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().WithOptions(newOptions()...).Build()
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+			Wager: scenario.Wager, Options: scenario.Options,
+		})
+		fixture.Mocks.GroupWagerRepo.On("UpdateOptionOrders", fixture.Ctx, TestWagerID, map[int64]int16{3: 0, 1: 1, 2: 2}).Return(nil)
+
+		detail, err := fixture.Service.ReorderOptions(fixture.Ctx, TestWagerID, TestUser1ID, []int{3, 1, 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, int16(0), detail.Options[2].OptionOrder)
+		assert.Equal(t, int16(1), detail.Options[0].OptionOrder)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("only the creator can reorder", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().WithOptions(newOptions()...).Build()
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+			Wager: scenario.Wager, Options: scenario.Options,
+		})
+
+		_, err := fixture.Service.ReorderOptions(fixture.Ctx, TestWagerID, TestUser2ID, []int{3, 1, 2})
+
+		fixture.Assertions.AssertValidationError(err, "only the creator")
+	})
+
+	t.Run("options are locked once anyone has bet", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().
+			WithOptions(newOptions()...).
+			WithParticipant(TestUser1ID, 0, 1000).
+			Build()
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+			Wager: scenario.Wager, Options: scenario.Options, Participants: scenario.Participants,
+		})
+
+		_, err := fixture.Service.ReorderOptions(fixture.Ctx, TestWagerID, TestUser1ID, []int{3, 1, 2})
+
+		assert.ErrorIs(t, err, entities.ErrGroupWagerOptionsLocked)
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "UpdateOptionOrders", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("incomplete orders are rejected", func(t *testing.T) {
+		fixture.Reset()
+
+		scenario := NewGroupWagerScenario().WithOptions(newOptions()...).Build()
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+			Wager: scenario.Wager, Options: scenario.Options,
+		})
+
+		_, err := fixture.Service.ReorderOptions(fixture.Ctx, TestWagerID, TestUser1ID, []int{2, 1})
+
+		assert.ErrorContains(t, err, "all 3 options")
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "UpdateOptionOrders", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGroupWagerService_ReResolve(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+	resolverID := TestResolverID
+	userA, userB := TestUser1ID, TestUser2ID
+
+	// A and B each bet 1000 on opposite options of a pool wager that was resolved for A's option
+	resolvedDetail := func(resolvedAgo time.Duration) *entities.GroupWagerDetail {
+		resolvedAt := time.Now().Add(-resolvedAgo)
+		winningOptionID := int64(1)
+		payoutA, payoutB := int64(2000), int64(0)
+		historyA, historyB := int64(11), int64(12)
+		return &entities.GroupWagerDetail{
+			Wager: &entities.GroupWager{
+				ID:              TestWagerID,
+				GuildID:         TestGuildID,
+				State:           entities.GroupWagerStateResolved,
+				WagerType:       entities.GroupWagerTypePool,
+				TotalPot:        2000,
+				WinningOptionID: &winningOptionID,
+				ResolvedAt:      &resolvedAt,
+				MessageID:       789,
+				ChannelID:       456,
+			},
+			Options: []*entities.GroupWagerOption{
+				{ID: 1, GroupWagerID: TestWagerID, OptionText: "Yes", TotalAmount: 1000},
+				{ID: 2, GroupWagerID: TestWagerID, OptionText: "No", TotalAmount: 1000},
+			},
+			Participants: []*entities.GroupWagerParticipant{
+				{DiscordID: userA, GroupWagerID: TestWagerID, OptionID: 1, Amount: 1000, PayoutAmount: &payoutA, BalanceHistoryID: &historyA},
+				{DiscordID: userB, GroupWagerID: TestWagerID, OptionID: 2, Amount: 1000, PayoutAmount: &payoutB, BalanceHistoryID: &historyB},
+			},
+		}
+	}
+	winEntry := &entities.BalanceHistory{ID: 11, DiscordID: userA, ChangeAmount: 1000, TransactionType: entities.TransactionTypeGroupWagerWin}
+	lossEntry := &entities.BalanceHistory{ID: 12, DiscordID: userB, ChangeAmount: -1000, TransactionType: entities.TransactionTypeGroupWagerLoss}
+	potTaxEntry := &entities.BalanceHistory{
+		ID:                  13,
+		DiscordID:           userA,
+		ChangeAmount:        -100,
+		TransactionType:     entities.TransactionTypeLottoPotTax,
+		TransactionMetadata: map[string]any{"lottery_draw_id": float64(5)}, // JSON numbers decode as float64
+	}
+
+	t.Run("reverses payouts and pot tax then pays the corrected winners", func(t *testing.T) {
+		fixture.Reset()
+
+		detail := resolvedDetail(5 * time.Minute)
+		originalResolvedAt := *detail.Wager.ResolvedAt
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, detail)
+		fixture.Mocks.BalanceHistoryRepo.On("GetByRelated", mock.Anything, entities.RelatedTypeGroupWager, TestWagerID).
+			Return([]*entities.BalanceHistory{winEntry, lossEntry, potTaxEntry}, nil)
+		fixture.Mocks.LotteryDrawRepo.On("RemovePotTax", mock.Anything, int64(5), int64(100)).Return(true, nil)
+		for _, entry := range []*entities.BalanceHistory{winEntry, lossEntry, potTaxEntry} {
+			fixture.Mocks.BalanceHistoryRepo.On("GetByIDForUpdate", mock.Anything, entry.ID).Return(entry, nil)
+			fixture.Mocks.BalanceHistoryRepo.On("MarkReversed", mock.Anything, entry.ID, mock.Anything).Return(nil)
+		}
+		fixture.Helper.ExpectUserLookup(userA, &entities.User{DiscordID: userA, Balance: 2000, AvailableBalance: 2000})
+		fixture.Helper.ExpectUserLookup(userB, &entities.User{DiscordID: userB, Balance: 0, AvailableBalance: 0})
+
+		// Pot tax refund, then the win and loss reversals
+		fixture.Helper.ExpectBalanceUpdate(userA, 2100)
+		fixture.Helper.ExpectBalanceHistoryRecordSimple(userA, 2100, entities.TransactionTypeGroupWagerCorrectionIn)
+		fixture.Helper.ExpectBalanceUpdate(userA, 1000)
+		fixture.Helper.ExpectBalanceHistoryRecordSimple(userA, 1000, entities.TransactionTypeGroupWagerCorrectionOut)
+		fixture.Helper.ExpectBalanceUpdate(userB, 1000)
+		fixture.Helper.ExpectBalanceHistoryRecordSimple(userB, 1000, entities.TransactionTypeGroupWagerCorrectionIn)
+
+		// Settling the corrected outcome from the looked up balances
+		fixture.Helper.ExpectBalanceHistoryRecordSimple(userB, 1000, entities.TransactionTypeGroupWagerWin)
+		fixture.Helper.ExpectBalanceHistoryRecordSimple(userA, 1000, entities.TransactionTypeGroupWagerLoss)
+		fixture.Helper.ExpectEventPublish(events.EventTypeBalanceChange)
+
+		fixture.Mocks.GroupWagerRepo.On("UpdateParticipantPayouts", mock.Anything, mock.Anything).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("Update", mock.Anything, mock.MatchedBy(func(w *entities.GroupWager) bool {
+			return w.State == entities.GroupWagerStateResolved && *w.WinningOptionID == 2 && w.ResolvedAt.Equal(originalResolvedAt)
+		})).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("RecordEvent", mock.Anything, mock.MatchedBy(func(e *entities.GroupWagerEvent) bool {
+			return e.EventType == entities.GroupWagerEventCorrected &&
+				e.Payload["previous_winning_option_id"] == int64(1) &&
+				e.Payload["refunded_pot_tax"] == int64(100)
+		})).Return(nil)
+		fixture.Helper.ExpectEventPublish(events.EventTypeGroupWagerStateChange)
+
+		result, err := fixture.Service.ReResolve(fixture.Ctx, TestWagerID, resolverID, 2)
+		fixture.Assertions.AssertNoError(err)
+		fixture.Equal(int64(2), result.WinningOption.ID)
+		assert.Len(t, result.Winners, 1)
+		fixture.Equal(userB, result.Winners[0].DiscordID)
+		fixture.Equal(int64(2000), result.PayoutDetails[userB])
+		fixture.AssertAllMocks()
+		fixture.Mocks.LotteryDrawRepo.AssertExpectations(t)
+	})
+
+	t.Run("pot tax was already drawn", func(t *testing.T) {
+		fixture.Reset()
+
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, resolvedDetail(5*time.Minute))
+		fixture.Mocks.BalanceHistoryRepo.On("GetByRelated", mock.Anything, entities.RelatedTypeGroupWager, TestWagerID).
+			Return([]*entities.BalanceHistory{winEntry, lossEntry, potTaxEntry}, nil)
+		fixture.Mocks.BalanceHistoryRepo.On("GetByIDForUpdate", mock.Anything, winEntry.ID).Return(winEntry, nil)
+		fixture.Mocks.BalanceHistoryRepo.On("GetByIDForUpdate", mock.Anything, lossEntry.ID).Return(lossEntry, nil)
+		fixture.Helper.ExpectUserLookup(userA, &entities.User{DiscordID: userA, Balance: 2000, AvailableBalance: 2000})
+		fixture.Mocks.LotteryDrawRepo.On("RemovePotTax", mock.Anything, int64(5), int64(100)).Return(false, nil)
+
+		_, err := fixture.Service.ReResolve(fixture.Ctx, TestWagerID, resolverID, 2)
+		fixture.Assertions.AssertValidationError(err, "already been paid out by the lottery")
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("parlays were settled on the original outcome", func(t *testing.T) {
+		fixture.Reset()
+		fixture.Mocks.GroupWagerRepo.ExpectedCalls = nil
+
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, resolvedDetail(5*time.Minute))
+		fixture.Mocks.GroupWagerRepo.On("HasSettledParlayLegs", mock.Anything, TestWagerID).Return(true, nil)
+
+		_, err := fixture.Service.ReResolve(fixture.Ctx, TestWagerID, resolverID, 2)
+		fixture.Assertions.AssertValidationError(err, "parlays have already been settled")
+		fixture.Mocks.BalanceHistoryRepo.AssertNotCalled(t, "GetByRelated", mock.Anything, mock.Anything, mock.Anything)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("former winner can't cover their payout reversal and new loss together", func(t *testing.T) {
+		fixture.Reset()
+
+		// 1500 covers returning the 1000 payout net of the refunded tax, but not the 1000 loss on top
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, resolvedDetail(5*time.Minute))
+		fixture.Mocks.BalanceHistoryRepo.On("GetByRelated", mock.Anything, entities.RelatedTypeGroupWager, TestWagerID).
+			Return([]*entities.BalanceHistory{winEntry, lossEntry, potTaxEntry}, nil)
+		fixture.Mocks.BalanceHistoryRepo.On("GetByIDForUpdate", mock.Anything, winEntry.ID).Return(winEntry, nil)
+		fixture.Mocks.BalanceHistoryRepo.On("GetByIDForUpdate", mock.Anything, lossEntry.ID).Return(lossEntry, nil)
+		fixture.Helper.ExpectUserLookup(userA, &entities.User{DiscordID: userA, Balance: 2000, AvailableBalance: 1500})
+
+		_, err := fixture.Service.ReResolve(fixture.Ctx, TestWagerID, resolverID, 2)
+		fixture.Assertions.AssertValidationError(err, "no longer has 1.9k bits available")
+		fixture.Mocks.LotteryDrawRepo.AssertNotCalled(t, "RemovePotTax", mock.Anything, mock.Anything, mock.Anything)
+		fixture.Mocks.UserRepo.AssertNotCalled(t, "UpdateBalance", mock.Anything, mock.Anything, mock.Anything)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("window has elapsed", func(t *testing.T) {
+		fixture.Reset()
+
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, resolvedDetail(time.Hour))
+
+		_, err := fixture.Service.ReResolve(fixture.Ctx, TestWagerID, resolverID, 2)
+		fixture.Assertions.AssertValidationError(err, "can only be corrected within 15 minutes")
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("option already won", func(t *testing.T) {
+		fixture.Reset()
+
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, resolvedDetail(time.Minute))
+
+		_, err := fixture.Service.ReResolve(fixture.Ctx, TestWagerID, resolverID, 1)
+		fixture.Assertions.AssertValidationError(err, "already won")
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("wager is not resolved", func(t *testing.T) {
+		fixture.Reset()
+
+		detail := resolvedDetail(time.Minute)
+		detail.Wager.State = entities.GroupWagerStateCancelled
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, detail)
+
+		_, err := fixture.Service.ReResolve(fixture.Ctx, TestWagerID, resolverID, 2)
+		fixture.Assertions.AssertValidationError(err, "can only correct resolved")
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("unauthorized user", func(t *testing.T) {
+		fixture.Reset()
+
+		_, err := fixture.Service.ReResolve(fixture.Ctx, TestWagerID, 456, 2)
+		assert.ErrorIs(t, err, entities.ErrNotAuthorized)
+		fixture.AssertAllMocks()
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"gambler/discord-client/config"
 	"gambler/discord-client/domain/entities"
@@ -115,6 +116,9 @@ func TestGroupWagerService_ResolveGroupWager_BothTypes(t *testing.T) {
 				mocks.GroupWagerRepo,
 				mocks.UserRepo,
 				mocks.BalanceHistoryRepo,
+				mocks.GuildSettingsRepo,
+				mocks.GuildResolverRepo,
+				mocks.LotteryDrawRepo,
 				mocks.EventPublisher,
 			)
 			service.(*groupWagerService).config.ResolverDiscordIDs = []int64{TestResolverID}
@@ -228,6 +232,9 @@ func TestGroupWagerService_ResolveGroupWager_ValidationErrors(t *testing.T) {
 				mocks.GroupWagerRepo,
 				mocks.UserRepo,
 				mocks.BalanceHistoryRepo,
+				mocks.GuildSettingsRepo,
+				mocks.GuildResolverRepo,
+				mocks.LotteryDrawRepo,
 				mocks.EventPublisher,
 			)
 			service.(*groupWagerService).config.ResolverDiscordIDs = []int64{TestResolverID}
@@ -444,7 +451,7 @@ func TestGroupWagerService_HouseWager_SpecificScenarios(t *testing.T) {
 			Build()
 
 		// Setup mocks for bet
-		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
 			Wager:        scenario.Wager,
 			Options:      scenario.Options,
 			Participants: scenario.Participants,
@@ -622,6 +629,119 @@ func TestGroupWagerService_PoolWager_NoWinners(t *testing.T) {
 	})
 }
 
+func TestGroupWagerService_ResolveGroupWager_PotTax(t *testing.T) {
+	config.SetTestConfig(config.NewTestConfig())
+
+	fixture := NewGroupWagerTestFixture(t)
+
+	t.Run("winner's pot tax is added to the open lottery draw", func(t *testing.T) {
+		fixture.Reset()
+		fixture.SetResolvers(TestResolverID)
+
+		percent := 10
+		lottoChannelID := int64(777)
+		fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{
+			PotTaxPercent:  &percent,
+			LottoChannelID: &lottoChannelID,
+		})
+
+		scenario := NewGroupWagerScenario().
+			WithPoolWager(TestResolverID, "Pool wager with pot tax").
+			WithOptions("Yes", "No").
+			WithUser(TestUser1ID, "user1", TestInitialBalance).
+			WithUser(TestUser2ID, "user2", TestInitialBalance).
+			WithParticipant(TestUser1ID, 0, 1000).
+			WithParticipant(TestUser2ID, 1, 1000).
+			Build()
+		winningOptionID := scenario.Options[0].ID
+
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+			Wager:        scenario.Wager,
+			Options:      scenario.Options,
+			Participants: scenario.Participants,
+		})
+
+		// The winner is looked up again after the payout to deduct the tax
+		fixture.Mocks.UserRepo.On("GetByDiscordID", mock.Anything, TestUser1ID).
+			Return(&entities.User{DiscordID: TestUser1ID, Balance: TestInitialBalance}, nil).Once()
+		fixture.Mocks.UserRepo.On("GetByDiscordID", mock.Anything, TestUser1ID).
+			Return(&entities.User{DiscordID: TestUser1ID, Balance: TestInitialBalance + 1000}, nil).Once()
+		fixture.Helper.ExpectUserLookup(TestUser2ID, &entities.User{DiscordID: TestUser2ID, Balance: TestInitialBalance})
+
+		// Winner takes the 2000 pot for a net 1000, loser pays 1000
+		fixture.Helper.ExpectBalanceUpdate(TestUser1ID, TestInitialBalance+1000)
+		fixture.Helper.ExpectBalanceHistoryRecordSimple(TestUser1ID, TestInitialBalance+1000, entities.TransactionTypeGroupWagerWin)
+		fixture.Helper.ExpectBalanceUpdate(TestUser2ID, TestInitialBalance-1000)
+		fixture.Helper.ExpectBalanceHistoryRecordSimple(TestUser2ID, TestInitialBalance-1000, entities.TransactionTypeGroupWagerLoss)
+
+		// 10% of the 2000 pot goes to the lottery
+		draw := &entities.LotteryDraw{ID: 42, GuildID: scenario.Wager.GuildID, DrawTime: time.Now().Add(time.Hour)}
+		fixture.Mocks.LotteryDrawRepo.On("GetCurrentOpenDraw", mock.Anything, scenario.Wager.GuildID).Return(draw, nil)
+		fixture.Mocks.LotteryDrawRepo.On("AddPotTax", mock.Anything, int64(42), int64(200)).Return(true, nil)
+		fixture.Helper.ExpectBalanceUpdate(TestUser1ID, TestInitialBalance+800)
+		fixture.Helper.ExpectBalanceHistoryRecordSimple(TestUser1ID, TestInitialBalance+800, entities.TransactionTypeLottoPotTax)
+
+		fixture.Mocks.EventPublisher.On("Publish", mock.AnythingOfType("events.BalanceChangeEvent")).Return(nil).Times(3)
+		fixture.Mocks.GroupWagerRepo.On("UpdateParticipantPayouts", fixture.Ctx, mock.Anything).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("Update", fixture.Ctx, mock.Anything).Return(nil)
+		fixture.Mocks.EventPublisher.On("Publish", mock.AnythingOfType("events.GroupWagerStateChangeEvent")).Return(nil)
+
+		resolverID := int64(TestResolverID)
+		result, err := fixture.Service.ResolveGroupWager(fixture.Ctx, TestWagerID, &resolverID, winningOptionID)
+
+		fixture.Assertions.AssertNoError(err)
+		assert.Equal(t, 10, result.PotTaxPercent)
+		assert.Equal(t, int64(200), result.PotTaxFor(TestUser1ID))
+		assert.Equal(t, int64(2000), *result.Winners[0].PayoutAmount, "payouts stay gross of the tax")
+
+		fixture.AssertAllMocks()
+		fixture.Mocks.LotteryDrawRepo.AssertExpectations(t)
+	})
+
+	t.Run("no tax without a lottery channel", func(t *testing.T) {
+		fixture.Reset()
+		fixture.SetResolvers(TestResolverID)
+
+		percent := 10
+		fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{PotTaxPercent: &percent})
+
+		scenario := NewGroupWagerScenario().
+			WithPoolWager(TestResolverID, "Pool wager without lottery").
+			WithOptions("Yes", "No").
+			WithUser(TestUser1ID, "user1", TestInitialBalance).
+			WithUser(TestUser2ID, "user2", TestInitialBalance).
+			WithParticipant(TestUser1ID, 0, 1000).
+			WithParticipant(TestUser2ID, 1, 1000).
+			Build()
+		winningOptionID := scenario.Options[0].ID
+
+		fixture.Helper.ExpectWagerDetailLookup(TestWagerID, &entities.GroupWagerDetail{
+			Wager:        scenario.Wager,
+			Options:      scenario.Options,
+			Participants: scenario.Participants,
+		})
+		for _, user := range scenario.Users {
+			fixture.Helper.ExpectUserLookup(user.DiscordID, user)
+		}
+		fixture.Helper.ExpectBalanceUpdate(TestUser1ID, TestInitialBalance+1000)
+		fixture.Helper.ExpectBalanceHistoryRecordSimple(TestUser1ID, TestInitialBalance+1000, entities.TransactionTypeGroupWagerWin)
+		fixture.Helper.ExpectBalanceUpdate(TestUser2ID, TestInitialBalance-1000)
+		fixture.Helper.ExpectBalanceHistoryRecordSimple(TestUser2ID, TestInitialBalance-1000, entities.TransactionTypeGroupWagerLoss)
+		fixture.Mocks.EventPublisher.On("Publish", mock.AnythingOfType("events.BalanceChangeEvent")).Return(nil).Twice()
+		fixture.Mocks.GroupWagerRepo.On("UpdateParticipantPayouts", fixture.Ctx, mock.Anything).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("Update", fixture.Ctx, mock.Anything).Return(nil)
+		fixture.Mocks.EventPublisher.On("Publish", mock.AnythingOfType("events.GroupWagerStateChangeEvent")).Return(nil)
+
+		resolverID := int64(TestResolverID)
+		result, err := fixture.Service.ResolveGroupWager(fixture.Ctx, TestWagerID, &resolverID, winningOptionID)
+
+		fixture.Assertions.AssertNoError(err)
+		assert.Empty(t, result.PotTaxes)
+		fixture.Mocks.LotteryDrawRepo.AssertNotCalled(t, "GetCurrentOpenDraw", mock.Anything, mock.Anything)
+		fixture.AssertAllMocks()
+	})
+}
+
 func TestGroupWagerService_ResolveGroupWager_BalanceUpdateFailure(t *testing.T) {
 	config.SetTestConfig(config.NewTestConfig())
 
@@ -633,6 +753,9 @@ func TestGroupWagerService_ResolveGroupWager_BalanceUpdateFailure(t *testing.T)
 		mocks.GroupWagerRepo,
 		mocks.UserRepo,
 		mocks.BalanceHistoryRepo,
+		mocks.GuildSettingsRepo,
+		mocks.GuildResolverRepo,
+		mocks.LotteryDrawRepo,
 		mocks.EventPublisher,
 	)
 	service.(*groupWagerService).config.ResolverDiscordIDs = []int64{TestResolverID}
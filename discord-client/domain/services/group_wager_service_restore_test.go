@@ -0,0 +1,126 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/events"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGroupWagerService_RestoreGroupWager(t *testing.T) {
+	fixture := NewGroupWagerTestFixture(t)
+	creatorID := int64(123)
+	resolverID := TestResolverID
+
+	cancelledWager := func(cancelledAgo time.Duration) *entities.GroupWager {
+		cancelledAt := time.Now().Add(-cancelledAgo)
+		votingEndsAt := cancelledAt.Add(30 * time.Minute)
+		return &entities.GroupWager{
+			ID:               1,
+			CreatorDiscordID: &creatorID,
+			State:            entities.GroupWagerStateCancelled,
+			VotingEndsAt:     &votingEndsAt,
+			CancelledAt:      &cancelledAt,
+			MessageID:        789,
+			ChannelID:        456,
+		}
+	}
+
+	t.Run("resolver restores a recent cancellation", func(t *testing.T) {
+		fixture.Reset()
+		fixture.SetResolvers(resolverID)
+
+		detail := createWagerDetail(cancelledWager(10 * time.Minute))
+		detail.Participants = []*entities.GroupWagerParticipant{{DiscordID: 111, Amount: 500}}
+		fixture.Helper.ExpectWagerDetailLookup(1, detail)
+		fixture.Helper.ExpectUserLookup(111, &entities.User{DiscordID: 111, Balance: 1000, AvailableBalance: 1000})
+		fixture.Mocks.GroupWagerRepo.On("Update", fixture.Ctx, mock.MatchedBy(func(w *entities.GroupWager) bool {
+			return w.State == entities.GroupWagerStateActive && w.CancelledAt == nil && w.VotingEndsAt.After(time.Now().Add(29*time.Minute))
+		})).Return(nil)
+		fixture.Helper.ExpectEventPublish(events.EventTypeGroupWagerStateChange)
+
+		restored, err := fixture.Service.RestoreGroupWager(fixture.Ctx, 1, resolverID)
+		fixture.Assertions.AssertNoError(err)
+		fixture.Equal(entities.GroupWagerStateActive, restored.Wager.State)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("window has elapsed", func(t *testing.T) {
+		fixture.Reset()
+		fixture.SetResolvers(resolverID)
+
+		fixture.Helper.ExpectWagerDetailLookup(1, createWagerDetail(cancelledWager(2*entities.GroupWagerRestoreWindow)))
+
+		_, err := fixture.Service.RestoreGroupWager(fixture.Ctx, 1, resolverID)
+		fixture.Assertions.AssertValidationError(err, "can only be restored within")
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("payouts were made", func(t *testing.T) {
+		fixture.Reset()
+		fixture.SetResolvers(resolverID)
+
+		payout := int64(1000)
+		detail := createWagerDetail(cancelledWager(time.Minute))
+		detail.Participants = []*entities.GroupWagerParticipant{{DiscordID: 111, Amount: 500, PayoutAmount: &payout}}
+		fixture.Helper.ExpectWagerDetailLookup(1, detail)
+
+		_, err := fixture.Service.RestoreGroupWager(fixture.Ctx, 1, resolverID)
+		fixture.Assertions.AssertValidationError(err, "payouts have already been made")
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("parlays were settled on the cancellation", func(t *testing.T) {
+		fixture.Reset()
+		fixture.SetResolvers(resolverID)
+		fixture.Mocks.GroupWagerRepo.ExpectedCalls = nil
+
+		fixture.Helper.ExpectWagerDetailLookup(1, createWagerDetail(cancelledWager(time.Minute)))
+		fixture.Mocks.GroupWagerRepo.On("HasSettledParlayLegs", mock.Anything, int64(1)).Return(true, nil)
+
+		_, err := fixture.Service.RestoreGroupWager(fixture.Ctx, 1, resolverID)
+		fixture.Assertions.AssertValidationError(err, "parlays have already been settled")
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("participant spent the released bet", func(t *testing.T) {
+		fixture.Reset()
+		fixture.SetResolvers(resolverID)
+
+		detail := createWagerDetail(cancelledWager(time.Minute))
+		detail.Participants = []*entities.GroupWagerParticipant{{DiscordID: 111, Amount: 500}}
+		fixture.Helper.ExpectWagerDetailLookup(1, detail)
+		fixture.Helper.ExpectUserLookup(111, &entities.User{DiscordID: 111, Balance: 300, AvailableBalance: 300})
+
+		_, err := fixture.Service.RestoreGroupWager(fixture.Ctx, 1, resolverID)
+		fixture.Assertions.AssertValidationError(err, "no longer has")
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("unauthorized user", func(t *testing.T) {
+		fixture.Reset()
+		fixture.SetResolvers()
+
+		fixture.Helper.ExpectWagerDetailLookup(1, createWagerDetail(cancelledWager(time.Minute)))
+
+		_, err := fixture.Service.RestoreGroupWager(fixture.Ctx, 1, 456)
+		fixture.Assertions.AssertValidationError(err, "only the creator or a resolver can restore")
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("wager is not cancelled", func(t *testing.T) {
+		fixture.Reset()
+
+		wager := cancelledWager(time.Minute)
+		wager.State = entities.GroupWagerStateActive
+		fixture.Helper.ExpectWagerDetailLookup(1, createWagerDetail(wager))
+
+		_, err := fixture.Service.RestoreGroupWager(fixture.Ctx, 1, creatorID)
+		fixture.Assertions.AssertValidationError(err, "can only restore cancelled")
+		fixture.AssertAllMocks()
+	})
+}
@@ -1,13 +1,19 @@
 package services
 
 import (
+	"context"
+	"errors"
+	"gambler/discord-client/domain/entities"
 	"gambler/discord-client/domain/interfaces"
 	"gambler/discord-client/domain/testhelpers"
+	"gambler/discord-client/domain/utils"
 	"testing"
 
 	"gambler/discord-client/config"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // Test utilities
@@ -18,36 +24,48 @@ func createTestGroupWagerService() (interfaces.GroupWagerService, *testhelpers.M
 	mockUserRepo := new(testhelpers.MockUserRepository)
 	mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", mock.Anything, mock.Anything).Return(&entities.GuildSettings{}, nil).Maybe()
+	mockGuildResolverRepo := new(testhelpers.MockGuildResolverRepository)
+	mockGuildResolverRepo.On("GetAll", mock.Anything).Return([]*entities.GuildResolver{}, nil).Maybe()
+	mockLotteryDrawRepo := new(testhelpers.MockLotteryDrawRepository)
 	mockEventPublisher := new(testhelpers.MockEventPublisher)
 
-	service := NewGroupWagerService(mockGroupWagerRepo, mockUserRepo, mockBalanceHistoryRepo, mockEventPublisher)
+	service := NewGroupWagerService(mockGroupWagerRepo, mockUserRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockGuildResolverRepo, mockLotteryDrawRepo, mockEventPublisher)
 	return service, mockUserRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, mockEventPublisher
 }
 
 // Tests
 
 func TestGroupWagerService_IsResolver(t *testing.T) {
+	isResolver := func(t *testing.T, service interfaces.GroupWagerService, ctx context.Context, discordID int64) bool {
+		ok, err := service.IsResolver(ctx, discordID)
+		require.NoError(t, err)
+		return ok
+	}
 
 	t.Run("user is resolver", func(t *testing.T) {
 		// Setup
 		service, _, _, _, _ := createTestGroupWagerService()
+		ctx := context.Background()
 
 		// Test config sets 999999 as the default resolver ID
-		assert.True(t, service.IsResolver(999999))
+		assert.True(t, isResolver(t, service, ctx, 999999))
 		// Non-resolvers
-		assert.False(t, service.IsResolver(111111))
-		assert.False(t, service.IsResolver(222222))
+		assert.False(t, isResolver(t, service, ctx, 111111))
+		assert.False(t, isResolver(t, service, ctx, 222222))
 	})
 
 	t.Run("user is not resolver", func(t *testing.T) {
 		// Setup
 		service, _, _, _, _ := createTestGroupWagerService()
+		ctx := context.Background()
 
 		// Test config sets 999999 as the default resolver ID
 		// These should not be resolvers
-		assert.False(t, service.IsResolver(444444))
-		assert.False(t, service.IsResolver(555555))
-		assert.False(t, service.IsResolver(0))
+		assert.False(t, isResolver(t, service, ctx, 444444))
+		assert.False(t, isResolver(t, service, ctx, 555555))
+		assert.False(t, isResolver(t, service, ctx, 0))
 	})
 
 	t.Run("test resolver constant", func(t *testing.T) {
@@ -56,6 +74,32 @@ func TestGroupWagerService_IsResolver(t *testing.T) {
 
 		// Test config sets 999999 as the default resolver ID
 		// TestResolverID constant should be a resolver
-		assert.True(t, service.IsResolver(TestResolverID))
+		assert.True(t, isResolver(t, service, context.Background(), TestResolverID))
+	})
+
+	t.Run("guild user and role grants", func(t *testing.T) {
+		mocks := NewTestMocks()
+		mocks.GuildResolverRepo.ExpectedCalls = nil
+		mocks.GuildResolverRepo.On("GetAll", mock.Anything).Return([]*entities.GuildResolver{
+			{ResolverType: entities.GuildResolverTypeUser, TargetID: 111111},
+			{ResolverType: entities.GuildResolverTypeRole, TargetID: 777},
+		}, nil)
+		service := NewGroupWagerService(mocks.GroupWagerRepo, mocks.UserRepo, mocks.BalanceHistoryRepo, mocks.GuildSettingsRepo, mocks.GuildResolverRepo, mocks.LotteryDrawRepo, mocks.EventPublisher)
+		ctx := context.Background()
+
+		assert.True(t, isResolver(t, service, ctx, 111111))
+		assert.False(t, isResolver(t, service, ctx, 222222))
+		assert.True(t, isResolver(t, service, utils.WithMemberRoles(ctx, []int64{555, 777}), 222222))
+		assert.False(t, isResolver(t, service, utils.WithMemberRoles(ctx, []int64{555}), 222222))
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mocks := NewTestMocks()
+		mocks.GuildResolverRepo.ExpectedCalls = nil
+		mocks.GuildResolverRepo.On("GetAll", mock.Anything).Return(nil, errors.New("connection lost"))
+		service := NewGroupWagerService(mocks.GroupWagerRepo, mocks.UserRepo, mocks.BalanceHistoryRepo, mocks.GuildSettingsRepo, mocks.GuildResolverRepo, mocks.LotteryDrawRepo, mocks.EventPublisher)
+
+		_, err := service.IsResolver(context.Background(), 222222)
+		assert.Error(t, err)
 	})
 }
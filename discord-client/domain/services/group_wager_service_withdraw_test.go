@@ -0,0 +1,238 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWagerService_WithdrawBet(t *testing.T) {
+	// Pool with user1 on option 1 for 3000 and user2 on option 2 for 2000
+	newScenario := func() *GroupWagerScenario {
+		return NewGroupWagerScenario().
+			WithPoolWager(TestResolverID, "Test wager").
+			WithOptions("Yes", "No").
+			WithParticipant(TestUser1ID, 0, 3000).
+			WithParticipant(TestUser2ID, 1, 2000)
+	}
+	expectDetail := func(fixture *GroupWagerTestFixture, scenario *GroupWagerScenario) {
+		fixture.Helper.ExpectWagerDetailLookupForUpdate(TestWagerID, &entities.GroupWagerDetail{
+			Wager:        scenario.Wager,
+			Options:      scenario.Options,
+			Participants: scenario.Participants,
+		})
+	}
+
+	t.Run("reduces bet and recalculates pot and odds", func(t *testing.T) {
+		fixture := NewGroupWagerTestFixture(t)
+		scenario := newScenario()
+		expectDetail(fixture, scenario)
+		fixture.Helper.ExpectParticipantLookup(TestWagerID, TestUser1ID, findParticipantInScenario(scenario.Participants, TestUser1ID))
+
+		fixture.Mocks.GroupWagerRepo.On("SaveParticipant", mock.Anything, mock.MatchedBy(func(p *entities.GroupWagerParticipant) bool {
+			return p.DiscordID == TestUser1ID && p.Amount == 1000
+		})).Return(nil)
+		fixture.Helper.ExpectOptionTotalUpdate(TestOption1ID, 1000)
+		fixture.Mocks.GroupWagerRepo.On("Update", mock.Anything, mock.MatchedBy(func(gw *entities.GroupWager) bool {
+			return gw.TotalPot == 3000
+		})).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("UpdateAllOptionOdds", mock.Anything, TestWagerID, map[int64]float64{
+			TestOption1ID: 3.0,
+			TestOption2ID: 1.5,
+		}).Return(nil)
+
+		participant, err := fixture.Service.WithdrawBet(fixture.Ctx, TestWagerID, TestUser1ID, 1000)
+
+		require.NoError(t, err)
+		require.NotNil(t, participant)
+		assert.Equal(t, int64(1000), participant.Amount)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("zero removes participation", func(t *testing.T) {
+		fixture := NewGroupWagerTestFixture(t)
+		scenario := newScenario()
+		expectDetail(fixture, scenario)
+		fixture.Helper.ExpectParticipantLookup(TestWagerID, TestUser1ID, findParticipantInScenario(scenario.Participants, TestUser1ID))
+
+		fixture.Mocks.GroupWagerRepo.On("DeleteParticipant", mock.Anything, TestWagerID, TestUser1ID).Return(nil)
+		fixture.Helper.ExpectOptionTotalUpdate(TestOption1ID, 0)
+		fixture.Mocks.GroupWagerRepo.On("Update", mock.Anything, mock.MatchedBy(func(gw *entities.GroupWager) bool {
+			return gw.TotalPot == 2000
+		})).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("UpdateAllOptionOdds", mock.Anything, TestWagerID, map[int64]float64{
+			TestOption1ID: 0,
+			TestOption2ID: 1.0,
+		}).Return(nil)
+
+		participant, err := fixture.Service.WithdrawBet(fixture.Ctx, TestWagerID, TestUser1ID, 0)
+
+		require.NoError(t, err)
+		assert.Nil(t, participant)
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "SaveParticipant", mock.Anything, mock.Anything)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("records withdrawal in event log", func(t *testing.T) {
+		fixture := NewGroupWagerTestFixture(t)
+		scenario := newScenario()
+		expectDetail(fixture, scenario)
+		fixture.Helper.ExpectParticipantLookup(TestWagerID, TestUser1ID, findParticipantInScenario(scenario.Participants, TestUser1ID))
+
+		fixture.Mocks.GroupWagerRepo.On("SaveParticipant", mock.Anything, mock.Anything).Return(nil)
+		fixture.Helper.ExpectOptionTotalUpdate(TestOption1ID, 1000)
+		fixture.Mocks.GroupWagerRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("UpdateAllOptionOdds", mock.Anything, TestWagerID, mock.Anything).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("RecordEvent", mock.Anything, mock.MatchedBy(func(e *entities.GroupWagerEvent) bool {
+			return e.GroupWagerID == TestWagerID &&
+				e.EventType == entities.GroupWagerEventBetWithdrawn &&
+				e.ActorDiscordID != nil && *e.ActorDiscordID == TestUser1ID &&
+				e.Payload["amount"] == int64(1000) &&
+				e.Payload["previous_amount"] == int64(3000) &&
+				e.Payload["total_pot"] == int64(3000)
+		})).Return(nil)
+
+		_, err := fixture.Service.WithdrawBet(fixture.Ctx, TestWagerID, TestUser1ID, 1000)
+
+		require.NoError(t, err)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("records reductions and withdrawals as bet changes", func(t *testing.T) {
+		for _, newAmount := range []int64{1000, 0} {
+			fixture := NewGroupWagerTestFixture(t)
+			scenario := newScenario()
+			expectDetail(fixture, scenario)
+			fixture.Helper.ExpectParticipantLookup(TestWagerID, TestUser1ID, findParticipantInScenario(scenario.Participants, TestUser1ID))
+
+			fixture.Mocks.GroupWagerRepo.On("SaveParticipant", mock.Anything, mock.Anything).Return(nil).Maybe()
+			fixture.Mocks.GroupWagerRepo.On("DeleteParticipant", mock.Anything, TestWagerID, TestUser1ID).Return(nil).Maybe()
+			fixture.Helper.ExpectOptionTotalUpdate(TestOption1ID, newAmount)
+			fixture.Mocks.GroupWagerRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+			fixture.Mocks.GroupWagerRepo.On("UpdateAllOptionOdds", mock.Anything, TestWagerID, mock.Anything).Return(nil)
+			fixture.Mocks.GroupWagerRepo.On("RecordBetChange", mock.Anything, &entities.GroupWagerBetChange{
+				GroupWagerID:     TestWagerID,
+				DiscordID:        TestUser1ID,
+				PreviousOptionID: TestOption1ID,
+				PreviousAmount:   3000,
+				NewOptionID:      TestOption1ID,
+				NewAmount:        newAmount,
+			}).Return(nil)
+
+			_, err := fixture.Service.WithdrawBet(fixture.Ctx, TestWagerID, TestUser1ID, newAmount)
+
+			require.NoError(t, err)
+			fixture.AssertAllMocks()
+		}
+	})
+
+	t.Run("event log failure aborts withdrawal", func(t *testing.T) {
+		fixture := NewGroupWagerTestFixture(t)
+		scenario := newScenario()
+		expectDetail(fixture, scenario)
+		fixture.Helper.ExpectParticipantLookup(TestWagerID, TestUser1ID, findParticipantInScenario(scenario.Participants, TestUser1ID))
+
+		fixture.Mocks.GroupWagerRepo.On("SaveParticipant", mock.Anything, mock.Anything).Return(nil)
+		fixture.Helper.ExpectOptionTotalUpdate(TestOption1ID, 1000)
+		fixture.Mocks.GroupWagerRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("UpdateAllOptionOdds", mock.Anything, TestWagerID, mock.Anything).Return(nil)
+		fixture.Mocks.GroupWagerRepo.On("RecordEvent", mock.Anything, mock.Anything).Return(assert.AnError)
+
+		participant, err := fixture.Service.WithdrawBet(fixture.Ctx, TestWagerID, TestUser1ID, 1000)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to record bet_withdrawn event")
+		assert.Nil(t, participant)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("rejects a reduction below the minimum bet", func(t *testing.T) {
+		fixture := NewGroupWagerTestFixture(t)
+		scenario := newScenario()
+		expectDetail(fixture, scenario)
+		fixture.Helper.ExpectParticipantLookup(TestWagerID, TestUser1ID, findParticipantInScenario(scenario.Participants, TestUser1ID))
+		minBet := int64(500)
+		fixture.Helper.ExpectGuildSettings(&entities.GuildSettings{MinBetAmount: &minBet})
+
+		participant, err := fixture.Service.WithdrawBet(fixture.Ctx, TestWagerID, TestUser1ID, 100)
+
+		require.ErrorIs(t, err, entities.ErrBelowMinBet)
+		assert.Nil(t, participant)
+		fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "SaveParticipant", mock.Anything, mock.Anything)
+		fixture.AssertAllMocks()
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		testCases := []struct {
+			name        string
+			modify      func(*GroupWagerScenario)
+			userID      int64
+			newAmount   int64
+			errContains string
+		}{
+			{
+				name:        "negative amount",
+				userID:      TestUser1ID,
+				newAmount:   -1,
+				errContains: "cannot be negative",
+			},
+			{
+				name:        "house wager",
+				modify:      func(s *GroupWagerScenario) { s.Wager.WagerType = entities.GroupWagerTypeHouse },
+				userID:      TestUser1ID,
+				newAmount:   1000,
+				errContains: "only pool wager bets",
+			},
+			{
+				name: "voting period ended",
+				modify: func(s *GroupWagerScenario) {
+					ended := time.Now().Add(-time.Minute)
+					s.Wager.VotingEndsAt = &ended
+				},
+				userID:      TestUser1ID,
+				newAmount:   1000,
+				errContains: "voting period has ended",
+			},
+			{
+				name:        "no existing bet",
+				userID:      TestUser3ID,
+				newAmount:   0,
+				errContains: "you have no bet on this wager",
+			},
+			{
+				name:        "amount not lower than current bet",
+				userID:      TestUser1ID,
+				newAmount:   3000,
+				errContains: "must be less than your current bet",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				fixture := NewGroupWagerTestFixture(t)
+				scenario := newScenario()
+				if tc.modify != nil {
+					tc.modify(scenario)
+				}
+				if tc.newAmount >= 0 {
+					expectDetail(fixture, scenario)
+				}
+				fixture.Mocks.GroupWagerRepo.On("GetParticipant", mock.Anything, TestWagerID, tc.userID).
+					Return(findParticipantInScenario(scenario.Participants, tc.userID), nil).Maybe()
+
+				participant, err := fixture.Service.WithdrawBet(fixture.Ctx, TestWagerID, tc.userID, tc.newAmount)
+
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errContains)
+				assert.Nil(t, participant)
+				fixture.Mocks.GroupWagerRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				fixture.AssertAllMocks()
+			})
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// guildResolverService implements business logic for per-guild resolver grants
+type guildResolverService struct {
+	guildResolverRepo interfaces.GuildResolverRepository
+}
+
+// NewGuildResolverService creates a new guild resolver service
+func NewGuildResolverService(guildResolverRepo interfaces.GuildResolverRepository) interfaces.GuildResolverService {
+	return &guildResolverService{
+		guildResolverRepo: guildResolverRepo,
+	}
+}
+
+// AddResolver grants a user or role resolver permission in the guild
+func (s *guildResolverService) AddResolver(ctx context.Context, guildID int64, resolverType entities.GuildResolverType, targetID, addedBy int64) (bool, error) {
+	if err := validateResolverTarget(resolverType, targetID); err != nil {
+		return false, err
+	}
+
+	resolver := &entities.GuildResolver{
+		GuildID:      guildID,
+		ResolverType: resolverType,
+		TargetID:     targetID,
+		AddedBy:      &addedBy,
+	}
+	added, err := s.guildResolverRepo.Add(ctx, resolver)
+	if err != nil {
+		return false, fmt.Errorf("failed to add resolver: %w", err)
+	}
+	return added, nil
+}
+
+// RemoveResolver revokes a user or role grant in the guild
+func (s *guildResolverService) RemoveResolver(ctx context.Context, resolverType entities.GuildResolverType, targetID int64) (bool, error) {
+	if err := validateResolverTarget(resolverType, targetID); err != nil {
+		return false, err
+	}
+
+	removed, err := s.guildResolverRepo.Remove(ctx, resolverType, targetID)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove resolver: %w", err)
+	}
+	return removed, nil
+}
+
+// ListResolvers returns the guild's resolver grants
+func (s *guildResolverService) ListResolvers(ctx context.Context) ([]*entities.GuildResolver, error) {
+	resolvers, err := s.guildResolverRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resolvers: %w", err)
+	}
+	return resolvers, nil
+}
+
+// validateResolverTarget checks that a grant names a known target type and a valid Discord ID
+func validateResolverTarget(resolverType entities.GuildResolverType, targetID int64) error {
+	if resolverType != entities.GuildResolverTypeUser && resolverType != entities.GuildResolverTypeRole {
+		return fmt.Errorf("unknown resolver type %q", resolverType)
+	}
+	if targetID <= 0 {
+		return fmt.Errorf("invalid %s ID", resolverType)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuildResolverService_AddResolver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("adds a role grant", func(t *testing.T) {
+		repo := new(testhelpers.MockGuildResolverRepository)
+		service := NewGuildResolverService(repo)
+
+		repo.On("Add", mock.Anything, mock.MatchedBy(func(r *entities.GuildResolver) bool {
+			return r.GuildID == 123 && r.ResolverType == entities.GuildResolverTypeRole &&
+				r.TargetID == 777 && r.AddedBy != nil && *r.AddedBy == 42
+		})).Return(true, nil)
+
+		added, err := service.AddResolver(context.Background(), 123, entities.GuildResolverTypeRole, 777, 42)
+
+		require.NoError(t, err)
+		assert.True(t, added)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("reports existing grant", func(t *testing.T) {
+		repo := new(testhelpers.MockGuildResolverRepository)
+		service := NewGuildResolverService(repo)
+
+		repo.On("Add", mock.Anything, mock.Anything).Return(false, nil)
+
+		added, err := service.AddResolver(context.Background(), 123, entities.GuildResolverTypeUser, 555, 42)
+
+		require.NoError(t, err)
+		assert.False(t, added)
+	})
+
+	t.Run("rejects invalid target", func(t *testing.T) {
+		repo := new(testhelpers.MockGuildResolverRepository)
+		service := NewGuildResolverService(repo)
+
+		_, err := service.AddResolver(context.Background(), 123, entities.GuildResolverTypeUser, 0, 42)
+		assert.Error(t, err)
+
+		_, err = service.AddResolver(context.Background(), 123, entities.GuildResolverType("channel"), 555, 42)
+		assert.Error(t, err)
+
+		repo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGuildResolverService_RemoveResolver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("removes a user grant", func(t *testing.T) {
+		repo := new(testhelpers.MockGuildResolverRepository)
+		service := NewGuildResolverService(repo)
+
+		repo.On("Remove", mock.Anything, entities.GuildResolverTypeUser, int64(555)).Return(true, nil)
+
+		removed, err := service.RemoveResolver(context.Background(), entities.GuildResolverTypeUser, 555)
+
+		require.NoError(t, err)
+		assert.True(t, removed)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("wraps repository errors", func(t *testing.T) {
+		repo := new(testhelpers.MockGuildResolverRepository)
+		service := NewGuildResolverService(repo)
+
+		repo.On("Remove", mock.Anything, entities.GuildResolverTypeRole, int64(777)).Return(false, errors.New("connection lost"))
+
+		_, err := service.RemoveResolver(context.Background(), entities.GuildResolverTypeRole, 777)
+
+		assert.ErrorContains(t, err, "failed to remove resolver")
+	})
+}
@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
+	"unicode/utf8"
 
 	"gambler/discord-client/domain/entities"
 	"gambler/discord-client/domain/interfaces"
@@ -11,12 +13,21 @@ import (
 // guildSettingsService implements the GuildSettingsService interface
 type guildSettingsService struct {
 	guildSettingsRepo interfaces.GuildSettingsRepository
+	channelLookup     interfaces.ChannelLookup
 }
 
-// NewGuildSettingsService creates a new guild settings service
+// NewGuildSettingsService creates a new guild settings service.
+// Channels are not checked against Discord; use NewGuildSettingsServiceWithChannels for that.
 func NewGuildSettingsService(guildSettingsRepo interfaces.GuildSettingsRepository) interfaces.GuildSettingsService {
+	return NewGuildSettingsServiceWithChannels(guildSettingsRepo, nil)
+}
+
+// NewGuildSettingsServiceWithChannels creates a guild settings service that also
+// rejects channels which do not exist in the guild
+func NewGuildSettingsServiceWithChannels(guildSettingsRepo interfaces.GuildSettingsRepository, channelLookup interfaces.ChannelLookup) interfaces.GuildSettingsService {
 	return &guildSettingsService{
 		guildSettingsRepo: guildSettingsRepo,
+		channelLookup:     channelLookup,
 	}
 }
 
@@ -32,19 +43,33 @@ func (s *guildSettingsService) GetOrCreateSettings(ctx context.Context, guildID
 	return settings, nil
 }
 
-// UpdatePrimaryChannel updates the primary channel for a guild
-func (s *guildSettingsService) UpdatePrimaryChannel(ctx context.Context, guildID int64, channelID *int64) error {
+// ValidateSettings checks the settings as a whole, including that configured channels still exist
+func (s *guildSettingsService) ValidateSettings(ctx context.Context, settings *entities.GuildSettings) error {
+	return s.validate(ctx, nil, settings)
+}
 
-	// Get existing settings
+// PreviewSettings applies change to the guild's settings and validates the result without saving it.
+// The changed settings are returned alongside any *entities.SettingsValidationError.
+func (s *guildSettingsService) PreviewSettings(ctx context.Context, guildID int64, change func(*entities.GuildSettings)) (*entities.GuildSettings, error) {
 	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
 	if err != nil {
-		return fmt.Errorf("failed to get guild settings: %w", err)
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
 	}
 
-	// Update primary channel (can be nil to disable)
-	settings.PrimaryChannelID = channelID
+	// Setters replace pointers rather than writing through them, so a shallow copy keeps the old values
+	before := *settings
+	change(settings)
+
+	return settings, s.validate(ctx, &before, settings)
+}
+
+// update applies change to the guild's settings and saves them if the result is valid
+func (s *guildSettingsService) update(ctx context.Context, guildID int64, change func(*entities.GuildSettings)) error {
+	settings, err := s.PreviewSettings(ctx, guildID, change)
+	if err != nil {
+		return err
+	}
 
-	// Save updated settings
 	if err := s.guildSettingsRepo.UpdateGuildSettings(ctx, settings); err != nil {
 		return fmt.Errorf("failed to update guild settings: %w", err)
 	}
@@ -52,150 +77,431 @@ func (s *guildSettingsService) UpdatePrimaryChannel(ctx context.Context, guildID
 	return nil
 }
 
-// UpdateLolChannel updates the LOL channel for a guild
-func (s *guildSettingsService) UpdateLolChannel(ctx context.Context, guildID int64, channelID *int64) error {
-
-	// Get existing settings
-	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
-	if err != nil {
-		return fmt.Errorf("failed to get guild settings: %w", err)
+// validate checks settings against the entity rules and the guild's channels.
+// When before is set, only problems introduced by the change are reported so a guild
+// with an outdated setting (e.g. a deleted channel) can still fix other settings.
+func (s *guildSettingsService) validate(ctx context.Context, before, after *entities.GuildSettings) error {
+	violations := violationsOf(after.Validate())
+	if before != nil {
+		existing := make(map[entities.SettingsViolation]bool)
+		for _, v := range violationsOf(before.Validate()) {
+			existing[v] = true
+		}
+		introduced := violations[:0]
+		for _, v := range violations {
+			if !existing[v] {
+				introduced = append(introduced, v)
+			}
+		}
+		violations = introduced
 	}
 
-	// Update LOL channel (can be nil to disable)
-	settings.LolChannelID = channelID
+	if s.channelLookup != nil {
+		unchanged := make(map[entities.ChannelSetting]bool)
+		if before != nil {
+			for _, channel := range before.ConfiguredChannels() {
+				unchanged[channel] = true
+			}
+		}
 
-	// Save updated settings
-	if err := s.guildSettingsRepo.UpdateGuildSettings(ctx, settings); err != nil {
-		return fmt.Errorf("failed to update guild settings: %w", err)
+		for _, channel := range after.ConfiguredChannels() {
+			if unchanged[channel] {
+				continue
+			}
+			exists, err := s.channelLookup.ChannelExists(ctx, after.GuildID, channel.ChannelID)
+			if err != nil {
+				return fmt.Errorf("failed to look up channel %d: %w", channel.ChannelID, err)
+			}
+			if !exists {
+				violations = append(violations, entities.SettingsViolation{
+					Field:   channel.Field,
+					Message: fmt.Sprintf("channel <#%d> does not exist in this server", channel.ChannelID),
+				})
+			}
+		}
 	}
 
+	if len(violations) == 0 {
+		return nil
+	}
+	return &entities.SettingsValidationError{Violations: violations}
+}
+
+// violationsOf unwraps the violations from an entity validation error
+func violationsOf(err error) []entities.SettingsViolation {
+	if validationErr, ok := err.(*entities.SettingsValidationError); ok {
+		return validationErr.Violations
+	}
 	return nil
 }
 
+// UpdatePrimaryChannel updates the primary channel for a guild
+func (s *guildSettingsService) UpdatePrimaryChannel(ctx context.Context, guildID int64, channelID *int64) error {
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		// Update primary channel (can be nil to disable)
+		settings.SetPrimaryChannel(channelID)
+	})
+}
+
+// UpdateLolChannel updates the LOL channel for a guild
+func (s *guildSettingsService) UpdateLolChannel(ctx context.Context, guildID int64, channelID *int64) error {
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		// Update LOL channel (can be nil to disable)
+		settings.SetLolChannel(channelID)
+	})
+}
+
 // UpdateTftChannel updates the TFT channel for a guild
 func (s *guildSettingsService) UpdateTftChannel(ctx context.Context, guildID int64, channelID *int64) error {
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		// Update TFT channel (can be nil to disable)
+		settings.SetTftChannel(channelID)
+	})
+}
+
+// UpdateHighRollerRole updates the high roller role for a guild
+func (s *guildSettingsService) UpdateHighRollerRole(ctx context.Context, guildID int64, roleID *int64) error {
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		// Update high roller role (can be nil to disable)
+		settings.SetHighRollerRole(roleID)
+	})
+}
 
-	// Get existing settings
+// UpdateWordleChannel updates the Wordle channel for a guild
+func (s *guildSettingsService) UpdateWordleChannel(ctx context.Context, guildID int64, channelID *int64) error {
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		// Update Wordle channel (can be nil to disable)
+		settings.SetWordleChannel(channelID)
+	})
+}
+
+// GetHighRollerRoleID returns the high roller role ID for a guild
+func (s *guildSettingsService) GetHighRollerRoleID(ctx context.Context, guildID int64) (*int64, error) {
 	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
 	if err != nil {
-		return fmt.Errorf("failed to get guild settings: %w", err)
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
 	}
 
-	// Update TFT channel (can be nil to disable)
-	settings.TftChannelID = channelID
+	return settings.HighRollerRoleID, nil
+}
 
-	// Save updated settings
-	if err := s.guildSettingsRepo.UpdateGuildSettings(ctx, settings); err != nil {
-		return fmt.Errorf("failed to update guild settings: %w", err)
+// UpdateLottoChannel updates the lottery channel for a guild
+func (s *guildSettingsService) UpdateLottoChannel(ctx context.Context, guildID int64, channelID *int64) error {
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetLottoChannel(channelID)
+	})
+}
+
+// UpdateLottoTicketCost updates the lottery ticket cost for a guild
+func (s *guildSettingsService) UpdateLottoTicketCost(ctx context.Context, guildID int64, cost *int64) error {
+	if cost != nil && *cost <= 0 {
+		return entities.NewSettingsValidationError("lotto_ticket_cost", "ticket cost must be positive")
 	}
 
-	return nil
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetLottoTicketCost(cost)
+	})
 }
 
-// UpdateHighRollerRole updates the high roller role for a guild
-func (s *guildSettingsService) UpdateHighRollerRole(ctx context.Context, guildID int64, roleID *int64) error {
+// UpdateLottoDifficulty updates the lottery difficulty for a guild
+func (s *guildSettingsService) UpdateLottoDifficulty(ctx context.Context, guildID int64, difficulty *int64) error {
+	if difficulty != nil {
+		if *difficulty < entities.MinLottoDifficulty || *difficulty > entities.MaxLottoDifficulty {
+			return entities.NewSettingsValidationError("lotto_difficulty",
+				fmt.Sprintf("difficulty must be between %d and %d", entities.MinLottoDifficulty, entities.MaxLottoDifficulty))
+		}
+	}
 
-	// Get existing settings
-	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
-	if err != nil {
-		return fmt.Errorf("failed to get guild settings: %w", err)
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetLottoDifficulty(difficulty)
+	})
+}
+
+// UpdateAuditChannel updates the balance change audit channel for a guild
+func (s *guildSettingsService) UpdateAuditChannel(ctx context.Context, guildID int64, channelID *int64) error {
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		// Update audit channel (can be nil to disable)
+		settings.SetAuditChannel(channelID)
+	})
+}
+
+// UpdateDigestChannel updates the weekly economy digest channel for a guild
+func (s *guildSettingsService) UpdateDigestChannel(ctx context.Context, guildID int64, channelID *int64) error {
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		// Update digest channel (can be nil to disable)
+		settings.SetDigestChannel(channelID)
+	})
+}
+
+// UpdateAuditThreshold updates the minimum balance change posted to the audit channel for a guild
+func (s *guildSettingsService) UpdateAuditThreshold(ctx context.Context, guildID int64, threshold *int64) error {
+	if threshold != nil && *threshold <= 0 {
+		return entities.NewSettingsValidationError("audit_threshold", "audit threshold must be positive")
 	}
 
-	// Update high roller role (can be nil to disable)
-	settings.HighRollerRoleID = roleID
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetAuditThreshold(threshold)
+	})
+}
 
-	// Save updated settings
-	if err := s.guildSettingsRepo.UpdateGuildSettings(ctx, settings); err != nil {
-		return fmt.Errorf("failed to update guild settings: %w", err)
+// UpdateBettingCurfew updates the betting curfew window for a guild
+func (s *guildSettingsService) UpdateBettingCurfew(ctx context.Context, guildID int64, startHour, endHour *int, timezone *string) error {
+	if (startHour == nil) != (endHour == nil) {
+		return entities.NewSettingsValidationError("curfew_start_hour", "curfew requires both a start and end hour")
+	}
+	if startHour != nil {
+		if *startHour < 0 || *startHour > 23 || *endHour < 0 || *endHour > 23 {
+			return entities.NewSettingsValidationError("curfew_start_hour", "curfew hours must be between 0 and 23")
+		}
+		if *startHour == *endHour {
+			return entities.NewSettingsValidationError("curfew_start_hour", "curfew start and end hour must differ")
+		}
+	} else {
+		// Disabling the curfew forgets its timezone too
+		timezone = nil
+	}
+	if timezone != nil {
+		if _, err := entities.LoadCurfewLocation(*timezone); err != nil {
+			return entities.NewSettingsValidationError("curfew_timezone", "curfew timezone must be an IANA name such as Europe/Berlin")
+		}
 	}
 
-	return nil
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetBettingCurfew(startHour, endHour, timezone)
+	})
 }
 
-// UpdateWordleChannel updates the Wordle channel for a guild
-func (s *guildSettingsService) UpdateWordleChannel(ctx context.Context, guildID int64, channelID *int64) error {
+// UpdateRulesText updates the rules and dispute policy shown by /rules for a guild
+func (s *guildSettingsService) UpdateRulesText(ctx context.Context, guildID int64, rules string) error {
+	rules = strings.TrimSpace(rules)
+	if utf8.RuneCountInString(rules) > entities.MaxRulesTextLength {
+		return entities.NewSettingsValidationError("rules_text",
+			fmt.Sprintf("rules cannot be longer than %d characters", entities.MaxRulesTextLength))
+	}
 
-	// Get existing settings
-	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
-	if err != nil {
-		return fmt.Errorf("failed to get guild settings: %w", err)
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		if rules == "" {
+			settings.SetRulesText(nil)
+		} else {
+			settings.SetRulesText(&rules)
+		}
+	})
+}
+
+// UpdateSavingsBonusPercent updates the savings bonus percent earned per locked week for a guild
+func (s *guildSettingsService) UpdateSavingsBonusPercent(ctx context.Context, guildID int64, percent *int) error {
+	if percent != nil && (*percent < 0 || *percent > entities.MaxSavingsBonusPercent) {
+		return entities.NewSettingsValidationError("savings_bonus_percent",
+			fmt.Sprintf("savings bonus must be between 0 and %d percent", entities.MaxSavingsBonusPercent))
 	}
 
-	// Update Wordle channel (can be nil to disable)
-	settings.WordleChannelID = channelID
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetSavingsBonusPercent(percent)
+	})
+}
 
-	// Save updated settings
-	if err := s.guildSettingsRepo.UpdateGuildSettings(ctx, settings); err != nil {
-		return fmt.Errorf("failed to update guild settings: %w", err)
+// UpdateStuckWagerReconciliation updates when wagers awaiting resolution trigger resolver reminders and auto-cancellation
+func (s *guildSettingsService) UpdateStuckWagerReconciliation(ctx context.Context, guildID int64, reminderHours, cancelHours *int) error {
+	for _, hours := range []*int{reminderHours, cancelHours} {
+		if hours != nil && (*hours <= 0 || *hours > entities.MaxStuckWagerHours) {
+			return entities.NewSettingsValidationError("stuck_wager_hours",
+				fmt.Sprintf("stuck wager hours must be between 1 and %d", entities.MaxStuckWagerHours))
+		}
+	}
+	if reminderHours != nil && cancelHours != nil && *cancelHours <= *reminderHours {
+		return entities.NewSettingsValidationError("stuck_wager_cancel_hours", "auto-cancel must come after the resolver reminder")
 	}
 
-	return nil
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetStuckWagerReconciliation(reminderHours, cancelHours)
+	})
 }
 
-// GetHighRollerRoleID returns the high roller role ID for a guild
-func (s *guildSettingsService) GetHighRollerRoleID(ctx context.Context, guildID int64) (*int64, error) {
-	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+// UpdateSnipeProtection updates how late bets extend the voting period for a guild
+func (s *guildSettingsService) UpdateSnipeProtection(ctx context.Context, guildID int64, windowMinutes, extensionMinutes *int) error {
+	if (windowMinutes == nil) != (extensionMinutes == nil) {
+		return entities.NewSettingsValidationError("snipe_window_minutes", "sniping protection requires both a window and an extension")
+	}
+	for _, minutes := range []*int{windowMinutes, extensionMinutes} {
+		if minutes != nil && (*minutes <= 0 || *minutes > entities.MaxSnipeProtectionMinutes) {
+			return entities.NewSettingsValidationError("snipe_window_minutes",
+				fmt.Sprintf("sniping protection minutes must be between 1 and %d", entities.MaxSnipeProtectionMinutes))
+		}
 	}
 
-	return settings.HighRollerRoleID, nil
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetSnipeProtection(windowMinutes, extensionMinutes)
+	})
 }
 
-// UpdateLottoChannel updates the lottery channel for a guild
-func (s *guildSettingsService) UpdateLottoChannel(ctx context.Context, guildID int64, channelID *int64) error {
-	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
-	if err != nil {
-		return fmt.Errorf("failed to get guild settings: %w", err)
+// UpdateHouseOptionCap updates the per-option bet cap applied to new LoL/TFT house wagers for a guild
+func (s *guildSettingsService) UpdateHouseOptionCap(ctx context.Context, guildID int64, cap *int64) error {
+	if cap != nil && *cap <= 0 {
+		return entities.NewSettingsValidationError("house_option_cap", "house option cap must be positive")
 	}
 
-	settings.SetLottoChannel(channelID)
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetHouseOptionCap(cap)
+	})
+}
 
-	if err := s.guildSettingsRepo.UpdateGuildSettings(ctx, settings); err != nil {
-		return fmt.Errorf("failed to update guild settings: %w", err)
+// UpdateLottoRolloverCap updates the largest lottery pot that can roll over into the next draw for a guild
+func (s *guildSettingsService) UpdateLottoRolloverCap(ctx context.Context, guildID int64, cap *int64) error {
+	if cap != nil && *cap <= 0 {
+		return entities.NewSettingsValidationError("lotto_rollover_cap", "lottery rollover cap must be positive")
 	}
 
-	return nil
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetLottoRolloverCap(cap)
+	})
 }
 
-// UpdateLottoTicketCost updates the lottery ticket cost for a guild
-func (s *guildSettingsService) UpdateLottoTicketCost(ctx context.Context, guildID int64, cost *int64) error {
-	if cost != nil && *cost <= 0 {
-		return fmt.Errorf("ticket cost must be positive")
+// UpdatePotTaxPercent updates the percent of each resolved group wager's pot added to the lottery for a guild
+func (s *guildSettingsService) UpdatePotTaxPercent(ctx context.Context, guildID int64, percent *int) error {
+	if percent != nil && (*percent < 1 || *percent > entities.MaxPotTaxPercent) {
+		return entities.NewSettingsValidationError("pot_tax_percent",
+			fmt.Sprintf("pot tax must be between 1 and %d percent", entities.MaxPotTaxPercent))
 	}
 
-	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
-	if err != nil {
-		return fmt.Errorf("failed to get guild settings: %w", err)
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetPotTaxPercent(percent)
+	})
+}
+
+// UpdateStartingBalance updates the balance new users are created with for a guild
+func (s *guildSettingsService) UpdateStartingBalance(ctx context.Context, guildID int64, balance *int64) error {
+	if balance != nil && (*balance < 0 || *balance > entities.MaxStartingBalance) {
+		return entities.NewSettingsValidationError("starting_balance",
+			fmt.Sprintf("starting balance must be between 0 and %d", entities.MaxStartingBalance))
+	}
+
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetStartingBalance(balance)
+	})
+}
+
+// UpdateMinBalanceFloor updates the reserve balance users cannot bet below for a guild
+func (s *guildSettingsService) UpdateMinBalanceFloor(ctx context.Context, guildID int64, floor *int64) error {
+	if floor != nil && (*floor < 1 || *floor > entities.MaxMinBalanceFloor) {
+		return entities.NewSettingsValidationError("min_balance_floor",
+			fmt.Sprintf("balance floor must be between 1 and %d", entities.MaxMinBalanceFloor))
 	}
 
-	settings.SetLottoTicketCost(cost)
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetMinBalanceFloor(floor)
+	})
+}
 
-	if err := s.guildSettingsRepo.UpdateGuildSettings(ctx, settings); err != nil {
-		return fmt.Errorf("failed to update guild settings: %w", err)
+// UpdateMinBetAmount updates the smallest bet a guild accepts. The minimum cannot exceed the house option cap.
+func (s *guildSettingsService) UpdateMinBetAmount(ctx context.Context, guildID int64, amount *int64) error {
+	if amount != nil && (*amount < 1 || *amount > entities.MaxMinBetAmount) {
+		return entities.NewSettingsValidationError("min_bet_amount",
+			fmt.Sprintf("minimum bet must be between 1 and %d", entities.MaxMinBetAmount))
 	}
 
-	return nil
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetMinBetAmount(amount)
+	})
 }
 
-// UpdateLottoDifficulty updates the lottery difficulty for a guild
-func (s *guildSettingsService) UpdateLottoDifficulty(ctx context.Context, guildID int64, difficulty *int64) error {
-	if difficulty != nil {
-		if *difficulty < entities.MinLottoDifficulty || *difficulty > entities.MaxLottoDifficulty {
-			return fmt.Errorf("difficulty must be between %d and %d", entities.MinLottoDifficulty, entities.MaxLottoDifficulty)
-		}
+// UpdateWelcomeNewMembers updates whether joining members get an account and a welcome message for a guild
+func (s *guildSettingsService) UpdateWelcomeNewMembers(ctx context.Context, guildID int64, enabled bool) error {
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.WelcomeNewMembers = enabled
+	})
+}
+
+// UpdateRateLimit updates the per-user rate limit on bets, lottery purchases and transfers for a guild
+func (s *guildSettingsService) UpdateRateLimit(ctx context.Context, guildID int64, perMinute, burst *int) error {
+	if perMinute != nil && (*perMinute < 0 || *perMinute > entities.MaxRateLimitPerMinute) {
+		return entities.NewSettingsValidationError("rate_limit_per_minute",
+			fmt.Sprintf("rate limit must be between 0 and %d actions per minute", entities.MaxRateLimitPerMinute))
+	}
+	if burst != nil && (*burst < 1 || *burst > entities.MaxRateLimitBurst) {
+		return entities.NewSettingsValidationError("rate_limit_burst",
+			fmt.Sprintf("burst must be between 1 and %d actions", entities.MaxRateLimitBurst))
 	}
 
-	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
-	if err != nil {
-		return fmt.Errorf("failed to get guild settings: %w", err)
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetRateLimit(perMinute, burst)
+	})
+}
+
+// UpdateTFTPlacementLayout updates how regular TFT house wagers split placements into options for a guild
+func (s *guildSettingsService) UpdateTFTPlacementLayout(ctx context.Context, guildID int64, layout *entities.TFTPlacementLayout) error {
+	if layout != nil && !layout.IsValid() {
+		return entities.NewSettingsValidationError("tft_placement_layout",
+			fmt.Sprintf("unknown TFT placement layout %q", *layout))
+	}
+
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetTFTPlacementLayout(layout)
+	})
+}
+
+// UpdateGroupWagerBetMode updates how bettors choose an option on group wagers for a guild
+func (s *guildSettingsService) UpdateGroupWagerBetMode(ctx context.Context, guildID int64, mode *entities.GroupWagerBetMode) error {
+	if mode != nil && !mode.IsValid() {
+		return entities.NewSettingsValidationError("group_wager_bet_mode",
+			fmt.Sprintf("unknown group wager bet mode %q", *mode))
 	}
 
-	settings.SetLottoDifficulty(difficulty)
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetGroupWagerBetMode(mode)
+	})
+}
 
-	if err := s.guildSettingsRepo.UpdateGuildSettings(ctx, settings); err != nil {
-		return fmt.Errorf("failed to update guild settings: %w", err)
+// UpdateBlockOwnGameBets updates whether linked players may bet on house wagers about their own games
+func (s *guildSettingsService) UpdateBlockOwnGameBets(ctx context.Context, guildID int64, blocked bool) error {
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.BlockOwnGameBets = blocked
+	})
+}
+
+// UpdateShareGlobalLeaderboard updates whether a guild's predictions count toward the cross-guild leaderboard
+func (s *guildSettingsService) UpdateShareGlobalLeaderboard(ctx context.Context, guildID int64, shared bool) error {
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.ShareGlobalLeaderboard = shared
+	})
+}
+
+// UpdateLolNonRankedWagers updates whether ARAM, normal and custom LoL games create house wagers
+func (s *guildSettingsService) UpdateLolNonRankedWagers(ctx context.Context, guildID int64, enabled bool) error {
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.LolNonRankedWagers = enabled
+	})
+}
+
+// UpdateAutoAssignResolvers updates whether wagers awaiting resolution are handed to resolvers in rotation
+func (s *guildSettingsService) UpdateAutoAssignResolvers(ctx context.Context, guildID int64, enabled bool) error {
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.AutoAssignResolvers = enabled
+	})
+}
+
+// UpdateSeasonTokens updates the name and earn rate of the seasonal currency for a guild
+func (s *guildSettingsService) UpdateSeasonTokens(ctx context.Context, guildID int64, name *string, rate *int64) error {
+	if name != nil && (*name == "" || len(*name) > entities.MaxSeasonTokenNameLength) {
+		return entities.NewSettingsValidationError("season_token_name",
+			fmt.Sprintf("season token name must be between 1 and %d characters", entities.MaxSeasonTokenNameLength))
+	}
+	if rate != nil && *rate < 1 {
+		return entities.NewSettingsValidationError("season_token_rate", "season token rate must be at least 1 bit per token")
 	}
 
-	return nil
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetSeasonTokens(name, rate)
+	})
+}
+
+// UpdateDefaultVotingPeriod updates the voting period pre-filled for new group wagers for a guild
+func (s *guildSettingsService) UpdateDefaultVotingPeriod(ctx context.Context, guildID int64, minutes *int) error {
+	if minutes != nil && (*minutes < entities.MinVotingPeriodMinutes || *minutes > entities.MaxVotingPeriodMinutes) {
+		return entities.NewSettingsValidationError("default_voting_period_minutes",
+			fmt.Sprintf("voting period must be between %d minutes and %d hours", entities.MinVotingPeriodMinutes, entities.MaxVotingPeriodMinutes/60))
+	}
+
+	return s.update(ctx, guildID, func(settings *entities.GuildSettings) {
+		settings.SetDefaultVotingPeriodMinutes(minutes)
+	})
 }
@@ -3,12 +3,14 @@ package services
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"gambler/discord-client/domain/entities"
 	"gambler/discord-client/domain/testhelpers"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestGuildSettingsService_GetHighRollerRoleID(t *testing.T) {
@@ -427,4 +429,1271 @@ func TestGuildSettingsService_UpdateLottoDifficulty(t *testing.T) {
 			mockRepo.AssertExpectations(t)
 		})
 	}
-}
\ No newline at end of file
+}
+func TestGuildSettingsService_UpdateAuditThreshold(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		guildID     int64
+		threshold   *int64
+		setupMock   func(*testhelpers.MockGuildSettingsRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:      "successful threshold update",
+			guildID:   123456789,
+			threshold: func() *int64 { v := int64(25000); return &v }(),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.AuditThreshold != nil && *s.AuditThreshold == 25000
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "zero threshold rejected",
+			guildID:   123456789,
+			threshold: func() *int64 { v := int64(0); return &v }(),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				// No mock setup needed - validation fails before repository call
+			},
+			wantErr:     true,
+			errContains: "audit threshold must be positive",
+		},
+		{
+			name:      "update settings error",
+			guildID:   123456789,
+			threshold: func() *int64 { v := int64(25000); return &v }(),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), settings).Return(errors.New("database error"))
+			},
+			wantErr:     true,
+			errContains: "failed to update guild settings",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateAuditThreshold(ctx, tt.guildID, tt.threshold)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGuildSettingsService_UpdateBettingCurfew(t *testing.T) {
+	t.Parallel()
+
+	hour := func(h int) *int { return &h }
+	zone := func(name string) *string { return &name }
+
+	tests := []struct {
+		name        string
+		startHour   *int
+		endHour     *int
+		timezone    *string
+		setupMock   func(*testhelpers.MockGuildSettingsRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:      "set overnight curfew",
+			startHour: hour(23),
+			endHour:   hour(7),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.CurfewStartHour != nil && *s.CurfewStartHour == 23 &&
+						s.CurfewEndHour != nil && *s.CurfewEndHour == 7
+				})).Return(nil)
+			},
+		},
+		{
+			name:      "set curfew in a timezone",
+			startHour: hour(23),
+			endHour:   hour(7),
+			timezone:  zone("Europe/Berlin"),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.CurfewTimezone != nil && *s.CurfewTimezone == "Europe/Berlin"
+				})).Return(nil)
+			},
+		},
+		{
+			name:     "clear curfew",
+			timezone: zone("Europe/Berlin"),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789, CurfewStartHour: hour(1), CurfewEndHour: hour(6), CurfewTimezone: zone("Europe/Berlin")}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.CurfewStartHour == nil && s.CurfewEndHour == nil && s.CurfewTimezone == nil
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "unknown timezone rejected",
+			startHour:   hour(23),
+			endHour:     hour(7),
+			timezone:    zone("Mars/Olympus_Mons"),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "IANA name",
+		},
+		{
+			name:        "only start hour rejected",
+			startHour:   hour(23),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "both a start and end hour",
+		},
+		{
+			name:        "hour out of range rejected",
+			startHour:   hour(24),
+			endHour:     hour(7),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "between 0 and 23",
+		},
+		{
+			name:        "same start and end rejected",
+			startHour:   hour(7),
+			endHour:     hour(7),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "must differ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateBettingCurfew(ctx, 123456789, tt.startHour, tt.endHour, tt.timezone)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGuildSettingsService_UpdateRulesText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		rules       string
+		setupMock   func(*testhelpers.MockGuildSettingsRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:  "set rules trims whitespace",
+			rules: "  Resolvers have the final say.  \n",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.RulesText != nil && *s.RulesText == "Resolvers have the final say."
+				})).Return(nil)
+			},
+		},
+		{
+			name:  "empty rules reset to default",
+			rules: "   ",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				existing := "Old rules"
+				settings := &entities.GuildSettings{GuildID: 123456789, RulesText: &existing}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.RulesText == nil
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "too long rejected",
+			rules:       strings.Repeat("a", entities.MaxRulesTextLength+1),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "cannot be longer than",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateRulesText(ctx, 123456789, tt.rules)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGuildSettingsService_UpdateSavingsBonusPercent(t *testing.T) {
+	t.Parallel()
+
+	percent := func(p int) *int { return &p }
+
+	t.Run("set bonus", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		mockRepo := new(testhelpers.MockGuildSettingsRepository)
+		mockRepo.On("GetOrCreateGuildSettings", ctx, int64(123456789)).Return(&entities.GuildSettings{GuildID: 123456789}, nil)
+		mockRepo.On("UpdateGuildSettings", ctx, mock.MatchedBy(func(s *entities.GuildSettings) bool {
+			return s.GetSavingsBonusPercent() == 5
+		})).Return(nil)
+
+		err := NewGuildSettingsService(mockRepo).UpdateSavingsBonusPercent(ctx, 123456789, percent(5))
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("out of range rejected", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(testhelpers.MockGuildSettingsRepository)
+		err := NewGuildSettingsService(mockRepo).UpdateSavingsBonusPercent(context.Background(), 123456789, percent(26))
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "GetOrCreateGuildSettings", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGuildSettingsService_UpdateStuckWagerReconciliation(t *testing.T) {
+	t.Parallel()
+
+	hours := func(h int) *int { return &h }
+
+	tests := []struct {
+		name          string
+		reminderHours *int
+		cancelHours   *int
+		setupMock     func(*testhelpers.MockGuildSettingsRepository)
+		wantErr       bool
+		errContains   string
+	}{
+		{
+			name:          "set reminder and auto-cancel",
+			reminderHours: hours(24),
+			cancelHours:   hours(72),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.StuckWagerReminderHours != nil && *s.StuckWagerReminderHours == 24 &&
+						s.StuckWagerCancelHours != nil && *s.StuckWagerCancelHours == 72
+				})).Return(nil)
+			},
+		},
+		{
+			name: "disable reconciliation",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789, StuckWagerReminderHours: hours(24), StuckWagerCancelHours: hours(72)}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.StuckWagerReminderHours == nil && s.StuckWagerCancelHours == nil
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "hours out of range rejected",
+			cancelHours: hours(entities.MaxStuckWagerHours + 1),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "between 1 and",
+		},
+		{
+			name:          "cancel before reminder rejected",
+			reminderHours: hours(48),
+			cancelHours:   hours(24),
+			setupMock:     func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:       true,
+			errContains:   "must come after",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateStuckWagerReconciliation(ctx, 123456789, tt.reminderHours, tt.cancelHours)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGuildSettingsService_UpdateSnipeProtection(t *testing.T) {
+	t.Parallel()
+
+	minutes := func(m int) *int { return &m }
+
+	tests := []struct {
+		name             string
+		windowMinutes    *int
+		extensionMinutes *int
+		setupMock        func(*testhelpers.MockGuildSettingsRepository)
+		wantErr          bool
+		errContains      string
+	}{
+		{
+			name:             "enable protection",
+			windowMinutes:    minutes(2),
+			extensionMinutes: minutes(5),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.SnipeWindowMinutes != nil && *s.SnipeWindowMinutes == 2 &&
+						s.SnipeExtensionMinutes != nil && *s.SnipeExtensionMinutes == 5
+				})).Return(nil)
+			},
+		},
+		{
+			name: "disable protection",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789, SnipeWindowMinutes: minutes(2), SnipeExtensionMinutes: minutes(5)}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.SnipeWindowMinutes == nil && s.SnipeExtensionMinutes == nil
+				})).Return(nil)
+			},
+		},
+		{
+			name:          "window without extension rejected",
+			windowMinutes: minutes(2),
+			setupMock:     func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:       true,
+			errContains:   "requires both",
+		},
+		{
+			name:             "minutes out of range rejected",
+			windowMinutes:    minutes(2),
+			extensionMinutes: minutes(entities.MaxSnipeProtectionMinutes + 1),
+			setupMock:        func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:          true,
+			errContains:      "between 1 and",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateSnipeProtection(ctx, 123456789, tt.windowMinutes, tt.extensionMinutes)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGuildSettingsService_UpdateHouseOptionCap(t *testing.T) {
+	t.Parallel()
+
+	amount := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name        string
+		cap         *int64
+		setupMock   func(*testhelpers.MockGuildSettingsRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "set cap",
+			cap:  amount(50000),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.HouseOptionCap != nil && *s.HouseOptionCap == 50000
+				})).Return(nil)
+			},
+		},
+		{
+			name: "remove cap",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789, HouseOptionCap: amount(50000)}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.HouseOptionCap == nil
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "non-positive cap rejected",
+			cap:         amount(0),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateHouseOptionCap(ctx, 123456789, tt.cap)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGuildSettingsService_UpdateLottoRolloverCap(t *testing.T) {
+	t.Parallel()
+
+	amount := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name        string
+		cap         *int64
+		setupMock   func(*testhelpers.MockGuildSettingsRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "set cap",
+			cap:  amount(100000),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.LottoRolloverCap != nil && *s.LottoRolloverCap == 100000
+				})).Return(nil)
+			},
+		},
+		{
+			name: "remove cap",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789, LottoRolloverCap: amount(100000)}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.LottoRolloverCap == nil
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "non-positive cap rejected",
+			cap:         amount(-5),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateLottoRolloverCap(ctx, 123456789, tt.cap)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGuildSettingsService_UpdateStartingBalance(t *testing.T) {
+	t.Parallel()
+
+	amount := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name        string
+		balance     *int64
+		setupMock   func(*testhelpers.MockGuildSettingsRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "set starting balance",
+			balance: amount(10000),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.GetStartingBalance() == 10000
+				})).Return(nil)
+			},
+		},
+		{
+			name: "restore default",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789, StartingBalance: amount(10000)}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.StartingBalance == nil && s.GetStartingBalance() == entities.DefaultStartingBalance
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "above maximum rejected",
+			balance:     amount(entities.MaxStartingBalance + 1),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "must be between",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateStartingBalance(ctx, 123456789, tt.balance)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGuildSettingsService_UpdateMinBetAmount(t *testing.T) {
+	t.Parallel()
+
+	amount := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name        string
+		minBet      *int64
+		setupMock   func(*testhelpers.MockGuildSettingsRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:   "set minimum bet",
+			minBet: amount(500),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.GetMinBetAmount() == 500
+				})).Return(nil)
+			},
+		},
+		{
+			name: "remove minimum",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789, MinBetAmount: amount(500)}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.MinBetAmount == nil && s.GetMinBetAmount() == 0
+				})).Return(nil)
+			},
+		},
+		{
+			name:   "above house option cap rejected",
+			minBet: amount(5000),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789, HouseOptionCap: amount(1000)}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+			},
+			wantErr:     true,
+			errContains: "house option cap",
+		},
+		{
+			name:        "zero rejected",
+			minBet:      amount(0),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "must be between",
+		},
+		{
+			name:        "above maximum rejected",
+			minBet:      amount(entities.MaxMinBetAmount + 1),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "must be between",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateMinBetAmount(ctx, 123456789, tt.minBet)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGuildSettingsService_UpdateHouseOptionCap_BelowMinBet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	minBet := int64(5000)
+	cap := int64(1000)
+	mockRepo := new(testhelpers.MockGuildSettingsRepository)
+	mockRepo.On("GetOrCreateGuildSettings", ctx, int64(123456789)).Return(&entities.GuildSettings{GuildID: 123456789, MinBetAmount: &minBet}, nil)
+	service := NewGuildSettingsService(mockRepo)
+
+	err := service.UpdateHouseOptionCap(ctx, 123456789, &cap)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "house option cap")
+	mockRepo.AssertNotCalled(t, "UpdateGuildSettings", mock.Anything, mock.Anything)
+}
+
+func TestGuildSettingsService_UpdateMinBalanceFloor(t *testing.T) {
+	t.Parallel()
+
+	amount := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name        string
+		floor       *int64
+		setupMock   func(*testhelpers.MockGuildSettingsRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:  "set balance floor",
+			floor: amount(100),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.GetMinBalanceFloor() == 100
+				})).Return(nil)
+			},
+		},
+		{
+			name: "remove floor",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789, MinBalanceFloor: amount(100)}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.MinBalanceFloor == nil && s.GetMinBalanceFloor() == 0
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "zero rejected",
+			floor:       amount(0),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "must be between",
+		},
+		{
+			name:        "above maximum rejected",
+			floor:       amount(entities.MaxMinBalanceFloor + 1),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "must be between",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateMinBalanceFloor(ctx, 123456789, tt.floor)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGuildSettingsService_UpdateRateLimit(t *testing.T) {
+	t.Parallel()
+
+	value := func(v int) *int { return &v }
+
+	tests := []struct {
+		name        string
+		perMinute   *int
+		burst       *int
+		setupMock   func(*testhelpers.MockGuildSettingsRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:      "set rate limit",
+			perMinute: value(30),
+			burst:     value(5),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.GetRateLimit() == entities.RateLimit{PerMinute: 30, Burst: 5}
+				})).Return(nil)
+			},
+		},
+		{
+			name:      "zero per minute disables",
+			perMinute: value(0),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return !s.GetRateLimit().Enabled()
+				})).Return(nil)
+			},
+		},
+		{
+			name: "restore defaults",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789, RateLimitPerMinute: value(30), RateLimitBurst: value(5)}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.RateLimitPerMinute == nil && s.RateLimitBurst == nil
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "per minute above maximum rejected",
+			perMinute:   value(entities.MaxRateLimitPerMinute + 1),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "actions per minute",
+		},
+		{
+			name:        "zero burst rejected",
+			burst:       value(0),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "burst must be between",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateRateLimit(ctx, 123456789, tt.perMinute, tt.burst)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// fakeChannelLookup treats only the listed channel IDs as existing
+type fakeChannelLookup struct {
+	existing map[int64]bool
+	checked  []int64
+}
+
+func (f *fakeChannelLookup) ChannelExists(ctx context.Context, guildID, channelID int64) (bool, error) {
+	f.checked = append(f.checked, channelID)
+	return f.existing[channelID], nil
+}
+
+func TestGuildSettingsService_Validation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	guildID := int64(123456789)
+	int64Ptr := func(v int64) *int64 { return &v }
+
+	t.Run("cross-field violation blocks the update", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(testhelpers.MockGuildSettingsRepository)
+		settings := &entities.GuildSettings{GuildID: guildID, LottoChannelID: int64Ptr(5), LottoTicketCost: int64Ptr(100), StartingBalance: int64Ptr(500)}
+		mockRepo.On("GetOrCreateGuildSettings", ctx, guildID).Return(settings, nil)
+
+		service := NewGuildSettingsService(mockRepo)
+		err := service.UpdateLottoTicketCost(ctx, guildID, int64Ptr(1000))
+
+		var validationErr *entities.SettingsValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "lotto_ticket_cost", validationErr.Violations[0].Field)
+		mockRepo.AssertNotCalled(t, "UpdateGuildSettings", mock.Anything, mock.Anything)
+	})
+
+	t.Run("existing violations do not block unrelated updates", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(testhelpers.MockGuildSettingsRepository)
+		settings := &entities.GuildSettings{GuildID: guildID, LottoChannelID: int64Ptr(5), LottoTicketCost: int64Ptr(1000), StartingBalance: int64Ptr(500)}
+		mockRepo.On("GetOrCreateGuildSettings", ctx, guildID).Return(settings, nil)
+		mockRepo.On("UpdateGuildSettings", ctx, mock.Anything).Return(nil)
+
+		service := NewGuildSettingsService(mockRepo)
+		assert.NoError(t, service.UpdateWelcomeNewMembers(ctx, guildID, true))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("missing channel is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(testhelpers.MockGuildSettingsRepository)
+		mockRepo.On("GetOrCreateGuildSettings", ctx, guildID).Return(&entities.GuildSettings{GuildID: guildID}, nil)
+
+		service := NewGuildSettingsServiceWithChannels(mockRepo, &fakeChannelLookup{})
+		err := service.UpdateLolChannel(ctx, guildID, int64Ptr(42))
+
+		var validationErr *entities.SettingsValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "lol_channel_id", validationErr.Violations[0].Field)
+		mockRepo.AssertNotCalled(t, "UpdateGuildSettings", mock.Anything, mock.Anything)
+	})
+
+	t.Run("only changed channels are looked up", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(testhelpers.MockGuildSettingsRepository)
+		settings := &entities.GuildSettings{GuildID: guildID, PrimaryChannelID: int64Ptr(1)}
+		mockRepo.On("GetOrCreateGuildSettings", ctx, guildID).Return(settings, nil)
+		mockRepo.On("UpdateGuildSettings", ctx, mock.Anything).Return(nil)
+
+		lookup := &fakeChannelLookup{existing: map[int64]bool{2: true}}
+		service := NewGuildSettingsServiceWithChannels(mockRepo, lookup)
+		assert.NoError(t, service.UpdateTftChannel(ctx, guildID, int64Ptr(2)))
+		assert.Equal(t, []int64{2}, lookup.checked)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("preview does not save", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := new(testhelpers.MockGuildSettingsRepository)
+		mockRepo.On("GetOrCreateGuildSettings", ctx, guildID).Return(&entities.GuildSettings{GuildID: guildID}, nil)
+
+		service := NewGuildSettingsService(mockRepo)
+		preview, err := service.PreviewSettings(ctx, guildID, func(settings *entities.GuildSettings) {
+			settings.SetLottoDifficulty(int64Ptr(12))
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(12), preview.GetLottoDifficulty())
+		mockRepo.AssertNotCalled(t, "UpdateGuildSettings", mock.Anything, mock.Anything)
+	})
+
+	t.Run("validate reports deleted channels", func(t *testing.T) {
+		t.Parallel()
+
+		service := NewGuildSettingsServiceWithChannels(new(testhelpers.MockGuildSettingsRepository), &fakeChannelLookup{})
+		err := service.ValidateSettings(ctx, &entities.GuildSettings{GuildID: guildID, AuditChannelID: int64Ptr(7)})
+
+		var validationErr *entities.SettingsValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "audit_channel_id", validationErr.Violations[0].Field)
+	})
+}
+
+func TestGuildSettingsService_UpdateTFTPlacementLayout(t *testing.T) {
+	t.Parallel()
+
+	layout := func(l entities.TFTPlacementLayout) *entities.TFTPlacementLayout { return &l }
+
+	tests := []struct {
+		name        string
+		layout      *entities.TFTPlacementLayout
+		setupMock   func(*testhelpers.MockGuildSettingsRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:   "set exact placements",
+			layout: layout(entities.TFTPlacementLayoutExact),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.GetTFTPlacementLayout() == entities.TFTPlacementLayoutExact
+				})).Return(nil)
+			},
+		},
+		{
+			name: "restore default",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				current := string(entities.TFTPlacementLayoutTopBottom)
+				settings := &entities.GuildSettings{GuildID: 123456789, TftPlacementLayout: &current}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.TftPlacementLayout == nil
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "unknown layout rejected",
+			layout:      layout("thirds"),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "unknown TFT placement layout",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateTFTPlacementLayout(ctx, 123456789, tt.layout)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGuildSettingsService_UpdateGroupWagerBetMode(t *testing.T) {
+	t.Parallel()
+
+	mode := func(m entities.GroupWagerBetMode) *entities.GroupWagerBetMode { return &m }
+
+	tests := []struct {
+		name        string
+		mode        *entities.GroupWagerBetMode
+		setupMock   func(*testhelpers.MockGuildSettingsRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "use a dropdown menu",
+			mode: mode(entities.GroupWagerBetModeMenu),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.GetGroupWagerBetMode() == entities.GroupWagerBetModeMenu
+				})).Return(nil)
+			},
+		},
+		{
+			name: "restore default",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				current := string(entities.GroupWagerBetModeMenu)
+				settings := &entities.GuildSettings{GuildID: 123456789, GroupWagerBetMode: &current}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.GroupWagerBetMode == nil
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "unknown mode rejected",
+			mode:        mode("reactions"),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "unknown group wager bet mode",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateGroupWagerBetMode(ctx, 123456789, tt.mode)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGuildSettingsService_UpdateDefaultVotingPeriod(t *testing.T) {
+	t.Parallel()
+
+	minutes := func(v int) *int { return &v }
+
+	tests := []struct {
+		name        string
+		minutes     *int
+		setupMock   func(*testhelpers.MockGuildSettingsRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "set default voting period",
+			minutes: minutes(360),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.GetDefaultVotingPeriodMinutes() == 360
+				})).Return(nil)
+			},
+		},
+		{
+			name: "restore the default",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789, DefaultVotingPeriodMinutes: minutes(60)}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.DefaultVotingPeriodMinutes == nil && s.GetDefaultVotingPeriodMinutes() == entities.DefaultVotingPeriodMinutes
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "below minimum rejected",
+			minutes:     minutes(entities.MinVotingPeriodMinutes - 1),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "must be between",
+		},
+		{
+			name:        "above maximum rejected",
+			minutes:     minutes(entities.MaxVotingPeriodMinutes + 1),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "must be between",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdateDefaultVotingPeriod(ctx, 123456789, tt.minutes)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGuildSettingsService_UpdatePotTaxPercent(t *testing.T) {
+	t.Parallel()
+
+	percent := func(v int) *int { return &v }
+
+	tests := []struct {
+		name        string
+		percent     *int
+		setupMock   func(*testhelpers.MockGuildSettingsRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "set pot tax",
+			percent: percent(5),
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return s.HasPotTax() && *s.PotTaxPercent == 5
+				})).Return(nil)
+			},
+		},
+		{
+			name: "disable pot tax",
+			setupMock: func(mockRepo *testhelpers.MockGuildSettingsRepository) {
+				settings := &entities.GuildSettings{GuildID: 123456789, PotTaxPercent: percent(5)}
+				mockRepo.On("GetOrCreateGuildSettings", context.Background(), int64(123456789)).Return(settings, nil)
+				mockRepo.On("UpdateGuildSettings", context.Background(), mock.MatchedBy(func(s *entities.GuildSettings) bool {
+					return !s.HasPotTax()
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "above maximum rejected",
+			percent:     percent(entities.MaxPotTaxPercent + 1),
+			setupMock:   func(mockRepo *testhelpers.MockGuildSettingsRepository) {},
+			wantErr:     true,
+			errContains: "must be between",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockRepo := new(testhelpers.MockGuildSettingsRepository)
+			tt.setupMock(mockRepo)
+
+			service := NewGuildSettingsService(mockRepo)
+
+			err := service.UpdatePotTaxPercent(ctx, 123456789, tt.percent)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
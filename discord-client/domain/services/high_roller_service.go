@@ -87,7 +87,7 @@ func (s *highRollerService) GetCurrentHighRoller(ctx context.Context, guildID in
 func (s *highRollerService) PurchaseHighRollerRole(ctx context.Context, discordID, guildID, offerAmount int64) error {
 	// Validate offer amount
 	if offerAmount <= 0 {
-		return errors.New("offer amount must be positive")
+		return fmt.Errorf("offer %w", entities.ErrNonPositiveAmount)
 	}
 
 	// Get current high roller info
@@ -111,16 +111,13 @@ func (s *highRollerService) PurchaseHighRollerRole(ctx context.Context, discordI
 	if err != nil {
 		return fmt.Errorf("failed to get user: %w", err)
 	}
-
-	// Calculate available balance (current balance minus pending wagers)
-	availableBalance, err := s.calculateAvailableBalance(ctx, user)
-	if err != nil {
-		return fmt.Errorf("failed to calculate available balance: %w", err)
+	if err := CheckNotFrozen(time.Now(), user); err != nil {
+		return err
 	}
 
-	// Check if user has sufficient balance
-	if availableBalance < offerAmount {
-		return fmt.Errorf("insufficient balance: available %d bits, need %d bits", availableBalance, offerAmount)
+	// Bits locked in wagers, savings, parlays and duels can't be spent
+	if user.AvailableBalance < offerAmount {
+		return fmt.Errorf("%w: available %d bits, need %d bits", entities.ErrInsufficientBalance, user.AvailableBalance, offerAmount)
 	}
 
 	// Initialize tracking start time if not set (first purchase in this guild)
@@ -166,36 +163,3 @@ func (s *highRollerService) PurchaseHighRollerRole(ctx context.Context, discordI
 
 	return nil
 }
-
-// calculateAvailableBalance calculates user's available balance considering pending wagers
-func (s *highRollerService) calculateAvailableBalance(ctx context.Context, user *entities.User) (int64, error) {
-	// Get user's active wagers
-	activeWagers, err := s.wagerRepo.GetActiveByUser(ctx, user.DiscordID)
-	if err != nil {
-		return 0, fmt.Errorf("failed to get active wagers: %w", err)
-	}
-
-	// Calculate total amount locked in wagers
-	var lockedAmount int64
-	for _, wager := range activeWagers {
-		// Only count wagers that are not yet resolved
-		if wager.State == entities.WagerStateProposed || wager.State == entities.WagerStateVoting {
-			if wager.ProposerDiscordID == user.DiscordID || wager.TargetDiscordID == user.DiscordID {
-				lockedAmount += wager.Amount
-			}
-		}
-	}
-
-	// Get active group wager participations
-	participations, err := s.groupWagerRepo.GetActiveParticipationsByUser(ctx, user.DiscordID)
-	if err != nil {
-		return 0, fmt.Errorf("failed to get group wager participations: %w", err)
-	}
-
-	// Add group wager amounts
-	for _, participation := range participations {
-		lockedAmount += participation.Amount
-	}
-
-	return user.Balance - lockedAmount, nil
-}
@@ -243,6 +243,30 @@ func TestHighRollerService_PurchaseHighRollerRole(t *testing.T) {
 			wantErr:     true,
 			errContains: "you already hold the high roller role",
 		},
+		{
+			name: "buyer's balance is frozen",
+			setup: func() (*testhelpers.MockHighRollerPurchaseRepository, *testhelpers.MockUserRepository, *testhelpers.MockWagerRepository, *testhelpers.MockGroupWagerRepository, *testhelpers.MockBalanceHistoryRepository, *testhelpers.MockGuildSettingsRepository, *testhelpers.MockEventPublisher) {
+				mockRepo := new(testhelpers.MockHighRollerPurchaseRepository)
+				mockUserRepo := new(testhelpers.MockUserRepository)
+
+				buyer := &entities.User{
+					DiscordID:     123,
+					Username:      "buyer",
+					Balance:       100000,
+					BalanceFreeze: &entities.BalanceFreeze{Reason: "chargeback"},
+				}
+
+				mockRepo.On("GetLatestPurchase", mock.Anything, int64(456)).Return(nil, nil)
+				mockUserRepo.On("GetByDiscordID", mock.Anything, int64(123)).Return(buyer, nil)
+
+				return mockRepo, mockUserRepo, new(testhelpers.MockWagerRepository), new(testhelpers.MockGroupWagerRepository), new(testhelpers.MockBalanceHistoryRepository), new(testhelpers.MockGuildSettingsRepository), new(testhelpers.MockEventPublisher)
+			},
+			discordID:   123,
+			guildID:     456,
+			offerAmount: 50000,
+			wantErr:     true,
+			errContains: "balance is frozen",
+		},
 		{
 			name: "offer too low",
 			setup: func() (*testhelpers.MockHighRollerPurchaseRepository, *testhelpers.MockUserRepository, *testhelpers.MockWagerRepository, *testhelpers.MockGroupWagerRepository, *testhelpers.MockBalanceHistoryRepository, *testhelpers.MockGuildSettingsRepository, *testhelpers.MockEventPublisher) {
@@ -292,9 +316,10 @@ func TestHighRollerService_PurchaseHighRollerRole(t *testing.T) {
 					Username:  "currentHolder",
 				}
 				buyer := &entities.User{
-					DiscordID: 123,
-					Username:  "buyer",
-					Balance:   40000,  // Not enough for 60000 offer
+					DiscordID:        123,
+					Username:         "buyer",
+					Balance:          40000, // Not enough for 60000 offer
+					AvailableBalance: 40000,
 				}
 				guildSettings := &entities.GuildSettings{
 					GuildID: 456,
@@ -304,8 +329,6 @@ func TestHighRollerService_PurchaseHighRollerRole(t *testing.T) {
 				mockUserRepo.On("GetByDiscordID", mock.Anything, int64(789)).Return(currentHolder, nil)
 				mockGuildSettingsRepo.On("GetOrCreateGuildSettings", mock.Anything, int64(456)).Return(guildSettings, nil)
 				mockUserRepo.On("GetByDiscordID", mock.Anything, int64(123)).Return(buyer, nil)
-				mockWagerRepo.On("GetActiveByUser", mock.Anything, int64(123)).Return([]*entities.Wager{}, nil)
-				mockGroupWagerRepo.On("GetActiveParticipationsByUser", mock.Anything, int64(123)).Return([]*entities.GroupWagerParticipant{}, nil)
 
 				return mockRepo, mockUserRepo, mockWagerRepo, mockGroupWagerRepo, new(testhelpers.MockBalanceHistoryRepository), mockGuildSettingsRepo, new(testhelpers.MockEventPublisher)
 			},
@@ -327,9 +350,10 @@ func TestHighRollerService_PurchaseHighRollerRole(t *testing.T) {
 				mockEventPublisher := new(testhelpers.MockEventPublisher)
 
 				buyer := &entities.User{
-					DiscordID: 123,
-					Username:  "buyer",
-					Balance:   100000,
+					DiscordID:        123,
+					Username:         "buyer",
+					Balance:          100000,
+					AvailableBalance: 100000,
 				}
 				guildSettings := &entities.GuildSettings{
 					GuildID: 456,
@@ -339,8 +363,6 @@ func TestHighRollerService_PurchaseHighRollerRole(t *testing.T) {
 				mockRepo.On("GetLatestPurchase", mock.Anything, int64(456)).Return(nil, nil)
 				mockGuildSettingsRepo.On("GetOrCreateGuildSettings", mock.Anything, int64(456)).Return(guildSettings, nil)
 				mockUserRepo.On("GetByDiscordID", mock.Anything, int64(123)).Return(buyer, nil)
-				mockWagerRepo.On("GetActiveByUser", mock.Anything, int64(123)).Return([]*entities.Wager{}, nil)
-				mockGroupWagerRepo.On("GetActiveParticipationsByUser", mock.Anything, int64(123)).Return([]*entities.GroupWagerParticipant{}, nil)
 				// Expect UpdateGuildSettings to be called to set tracking start time
 				mockGuildSettingsRepo.On("UpdateGuildSettings", mock.Anything, mock.AnythingOfType("*entities.GuildSettings")).Return(nil)
 				mockUserRepo.On("UpdateBalance", mock.Anything, int64(123), int64(50000)).Return(nil)
@@ -385,9 +407,10 @@ func TestHighRollerService_PurchaseHighRollerRole(t *testing.T) {
 					Username:  "currentHolder",
 				}
 				buyer := &entities.User{
-					DiscordID: 123,
-					Username:  "buyer",
-					Balance:   100000,
+					DiscordID:        123,
+					Username:         "buyer",
+					Balance:          100000,
+					AvailableBalance: 100000,
 				}
 				guildSettings := &entities.GuildSettings{
 					GuildID: 456,
@@ -397,8 +420,6 @@ func TestHighRollerService_PurchaseHighRollerRole(t *testing.T) {
 				mockUserRepo.On("GetByDiscordID", mock.Anything, int64(789)).Return(currentHolder, nil)
 				mockGuildSettingsRepo.On("GetOrCreateGuildSettings", mock.Anything, int64(456)).Return(guildSettings, nil).Twice()
 				mockUserRepo.On("GetByDiscordID", mock.Anything, int64(123)).Return(buyer, nil)
-				mockWagerRepo.On("GetActiveByUser", mock.Anything, int64(123)).Return([]*entities.Wager{}, nil)
-				mockGroupWagerRepo.On("GetActiveParticipationsByUser", mock.Anything, int64(123)).Return([]*entities.GroupWagerParticipant{}, nil)
 				// Expect UpdateGuildSettings to be called to set tracking start time
 				mockGuildSettingsRepo.On("UpdateGuildSettings", mock.Anything, mock.AnythingOfType("*entities.GuildSettings")).Return(nil)
 				mockUserRepo.On("UpdateBalance", mock.Anything, int64(123), int64(50000)).Return(nil)
@@ -463,199 +484,70 @@ func TestHighRollerService_PurchaseHighRollerRole(t *testing.T) {
 	}
 }
 
-func TestHighRollerService_calculateAvailableBalance(t *testing.T) {
+func TestHighRollerService_PurchaseHighRollerRole_AvailableBalance(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name    string
-		setup   func() (*testhelpers.MockWagerRepository, *testhelpers.MockGroupWagerRepository)
-		user    *entities.User
-		want    int64
-		wantErr bool
+		name             string
+		availableBalance int64
+		wantErr          bool
 	}{
 		{
-			name: "no active wagers or participations",
-			setup: func() (*testhelpers.MockWagerRepository, *testhelpers.MockGroupWagerRepository) {
-				mockWagerRepo := new(testhelpers.MockWagerRepository)
-				mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
-				
-				mockWagerRepo.On("GetActiveByUser", mock.Anything, int64(123)).Return([]*entities.Wager{}, nil)
-				mockGroupWagerRepo.On("GetActiveParticipationsByUser", mock.Anything, int64(123)).Return([]*entities.GroupWagerParticipant{}, nil)
-				
-				return mockWagerRepo, mockGroupWagerRepo
-			},
-			user: &entities.User{
-				DiscordID: 123,
-				Balance:   100000,
-			},
-			want:    100000,
-			wantErr: false,
-		},
-		{
-			name: "with active wagers",
-			setup: func() (*testhelpers.MockWagerRepository, *testhelpers.MockGroupWagerRepository) {
-				mockWagerRepo := new(testhelpers.MockWagerRepository)
-				mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
-				
-				activeWagers := []*entities.Wager{
-					{
-						ProposerDiscordID: 123,
-						Amount:           10000,
-						State:            entities.WagerStateProposed,
-					},
-					{
-						TargetDiscordID: 123,
-						Amount:          15000,
-						State:           entities.WagerStateVoting,
-					},
-					{
-						ProposerDiscordID: 123,
-						Amount:           5000,
-						State:            entities.WagerStateResolved, // Should not be counted
-					},
-				}
-				
-				mockWagerRepo.On("GetActiveByUser", mock.Anything, int64(123)).Return(activeWagers, nil)
-				mockGroupWagerRepo.On("GetActiveParticipationsByUser", mock.Anything, int64(123)).Return([]*entities.GroupWagerParticipant{}, nil)
-				
-				return mockWagerRepo, mockGroupWagerRepo
-			},
-			user: &entities.User{
-				DiscordID: 123,
-				Balance:   100000,
-			},
-			want:    75000, // 100000 - 10000 - 15000
-			wantErr: false,
-		},
-		{
-			name: "with group wager participations",
-			setup: func() (*testhelpers.MockWagerRepository, *testhelpers.MockGroupWagerRepository) {
-				mockWagerRepo := new(testhelpers.MockWagerRepository)
-				mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
-				
-				participations := []*entities.GroupWagerParticipant{
-					{
-						DiscordID: 123,
-						Amount:    20000,
-					},
-					{
-						DiscordID: 123,
-						Amount:    5000,
-					},
-				}
-				
-				mockWagerRepo.On("GetActiveByUser", mock.Anything, int64(123)).Return([]*entities.Wager{}, nil)
-				mockGroupWagerRepo.On("GetActiveParticipationsByUser", mock.Anything, int64(123)).Return(participations, nil)
-				
-				return mockWagerRepo, mockGroupWagerRepo
-			},
-			user: &entities.User{
-				DiscordID: 123,
-				Balance:   100000,
-			},
-			want:    75000, // 100000 - 20000 - 5000
-			wantErr: false,
-		},
-		{
-			name: "with both wagers and participations",
-			setup: func() (*testhelpers.MockWagerRepository, *testhelpers.MockGroupWagerRepository) {
-				mockWagerRepo := new(testhelpers.MockWagerRepository)
-				mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
-				
-				activeWagers := []*entities.Wager{
-					{
-						ProposerDiscordID: 123,
-						Amount:           10000,
-						State:            entities.WagerStateProposed,
-					},
-				}
-				participations := []*entities.GroupWagerParticipant{
-					{
-						DiscordID: 123,
-						Amount:    15000,
-					},
-				}
-				
-				mockWagerRepo.On("GetActiveByUser", mock.Anything, int64(123)).Return(activeWagers, nil)
-				mockGroupWagerRepo.On("GetActiveParticipationsByUser", mock.Anything, int64(123)).Return(participations, nil)
-				
-				return mockWagerRepo, mockGroupWagerRepo
-			},
-			user: &entities.User{
-				DiscordID: 123,
-				Balance:   100000,
-			},
-			want:    75000, // 100000 - 10000 - 15000
-			wantErr: false,
+			name:             "bits locked in savings, parlays and duels can't be spent",
+			availableBalance: 30000,
+			wantErr:          true,
 		},
 		{
-			name: "wager repository error",
-			setup: func() (*testhelpers.MockWagerRepository, *testhelpers.MockGroupWagerRepository) {
-				mockWagerRepo := new(testhelpers.MockWagerRepository)
-				mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
-				
-				mockWagerRepo.On("GetActiveByUser", mock.Anything, int64(123)).Return(nil, errors.New("database error"))
-				
-				return mockWagerRepo, mockGroupWagerRepo
-			},
-			user: &entities.User{
-				DiscordID: 123,
-				Balance:   100000,
-			},
-			wantErr: true,
-		},
-		{
-			name: "group wager repository error",
-			setup: func() (*testhelpers.MockWagerRepository, *testhelpers.MockGroupWagerRepository) {
-				mockWagerRepo := new(testhelpers.MockWagerRepository)
-				mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
-				
-				mockWagerRepo.On("GetActiveByUser", mock.Anything, int64(123)).Return([]*entities.Wager{}, nil)
-				mockGroupWagerRepo.On("GetActiveParticipationsByUser", mock.Anything, int64(123)).Return(nil, errors.New("database error"))
-				
-				return mockWagerRepo, mockGroupWagerRepo
-			},
-			user: &entities.User{
-				DiscordID: 123,
-				Balance:   100000,
-			},
-			wantErr: true,
+			name:             "available balance covers the offer",
+			availableBalance: 50000,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			
-			mockWagerRepo, mockGroupWagerRepo := tt.setup()
+
 			mockRepo := new(testhelpers.MockHighRollerPurchaseRepository)
 			mockUserRepo := new(testhelpers.MockUserRepository)
+			mockWagerRepo := new(testhelpers.MockWagerRepository)
+			mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 			mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
 			mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
 			mockEventPublisher := new(testhelpers.MockEventPublisher)
 
-			service := &highRollerService{
-				highRollerRepo:     mockRepo,
-				userRepo:           mockUserRepo,
-				wagerRepo:          mockWagerRepo,
-				groupWagerRepo:     mockGroupWagerRepo,
-				balanceHistoryRepo: mockBalanceHistoryRepo,
-				guildSettingsRepo:  mockGuildSettingsRepo,
-				eventPublisher:     mockEventPublisher,
+			// The buyer's balance would cover the offer, but only the available balance counts
+			buyer := &entities.User{DiscordID: 123, Username: "buyer", Balance: 100000, AvailableBalance: tt.availableBalance}
+			mockRepo.On("GetLatestPurchase", mock.Anything, int64(456)).Return(nil, nil)
+			mockGuildSettingsRepo.On("GetOrCreateGuildSettings", mock.Anything, int64(456)).Return(&entities.GuildSettings{GuildID: 456}, nil)
+			mockUserRepo.On("GetByDiscordID", mock.Anything, int64(123)).Return(buyer, nil)
+			if !tt.wantErr {
+				mockGuildSettingsRepo.On("UpdateGuildSettings", mock.Anything, mock.AnythingOfType("*entities.GuildSettings")).Return(nil)
+				mockUserRepo.On("UpdateBalance", mock.Anything, int64(123), int64(50000)).Return(nil)
+				mockBalanceHistoryRepo.On("Record", mock.Anything, mock.Anything).Return(nil)
+				mockEventPublisher.On("Publish", mock.AnythingOfType("events.BalanceChangeEvent")).Return(nil)
+				mockRepo.On("CreatePurchase", mock.Anything, mock.Anything).Return(nil)
 			}
 
-			ctx := context.Background()
-			result, err := service.calculateAvailableBalance(ctx, tt.user)
+			service := NewHighRollerService(
+				mockRepo,
+				mockUserRepo,
+				mockWagerRepo,
+				mockGroupWagerRepo,
+				mockBalanceHistoryRepo,
+				mockGuildSettingsRepo,
+				mockEventPublisher,
+			)
+
+			err := service.PurchaseHighRollerRole(context.Background(), 123, 456, 50000)
 
 			if tt.wantErr {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, entities.ErrInsufficientBalance)
+				mockUserRepo.AssertNotCalled(t, "UpdateBalance", mock.Anything, mock.Anything, mock.Anything)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tt.want, result)
 			}
-
-			mockWagerRepo.AssertExpectations(t)
-			mockGroupWagerRepo.AssertExpectations(t)
+			mockRepo.AssertExpectations(t)
+			mockUserRepo.AssertExpectations(t)
 		})
 	}
-}
\ No newline at end of file
+}
@@ -6,6 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"gambler/discord-client/domain/entities"
@@ -19,6 +22,9 @@ const (
 	// denseThreshold is the number pool size below which we always enumerate available numbers.
 	// 65536 (2^16) ensures enumeration is fast and memory-bounded even at 100% usage.
 	denseThreshold = 1 << 16
+
+	// numberStatsLimit is how many hot, cold and user numbers are reported by GetNumberStats
+	numberStatsLimit = 5
 )
 
 // lotteryService implements business logic for lottery operations
@@ -32,6 +38,7 @@ type lotteryService struct {
 	balanceHistoryRepo interfaces.BalanceHistoryRepository
 	guildSettingsRepo  interfaces.GuildSettingsRepository
 	eventPublisher     interfaces.EventPublisher
+	balanceGuard       *BalanceGuard
 }
 
 // NewLotteryService creates a new lottery service
@@ -56,6 +63,7 @@ func NewLotteryService(
 		balanceHistoryRepo: balanceHistoryRepo,
 		guildSettingsRepo:  guildSettingsRepo,
 		eventPublisher:     eventPublisher,
+		balanceGuard:       NewBalanceGuard(guildSettingsRepo),
 	}
 }
 
@@ -95,42 +103,149 @@ func (s *lotteryService) GetOrCreateCurrentDraw(ctx context.Context, guildID int
 
 // PurchaseTickets buys lottery tickets for a user
 func (s *lotteryService) PurchaseTickets(ctx context.Context, discordID, guildID int64, quantity int) (*interfaces.LotteryPurchaseResult, error) {
-	if quantity <= 0 {
-		return nil, errors.New("quantity must be positive")
+	return s.purchaseTickets(ctx, discordID, guildID, entities.FixedAmount(int64(quantity)), false)
+}
+
+// PurchaseTicketsWithAmount buys a typed amount of lottery tickets, where all, half or a percentage buys as
+// many tickets as fit in that share of the user's available balance
+func (s *lotteryService) PurchaseTicketsWithAmount(ctx context.Context, discordID, guildID int64, amount entities.Amount) (*interfaces.LotteryPurchaseResult, error) {
+	return s.purchaseTickets(ctx, discordID, guildID, amount, false)
+}
+
+// AutoPurchaseTickets buys lottery tickets on behalf of a user's subscription
+func (s *lotteryService) AutoPurchaseTickets(ctx context.Context, discordID, guildID int64, quantity int) (*interfaces.LotteryPurchaseResult, error) {
+	return s.purchaseTickets(ctx, discordID, guildID, entities.FixedAmount(int64(quantity)), true)
+}
+
+// PurchaseSpecificTickets buys one ticket for each number the user picked
+func (s *lotteryService) PurchaseSpecificTickets(ctx context.Context, discordID, guildID int64, numbers []int64) (*interfaces.LotteryPurchaseResult, error) {
+	if len(numbers) == 0 {
+		return nil, entities.ErrNoLotteryNumbers
+	}
+
+	draw, user, availableBalance, err := s.openPurchase(ctx, discordID, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, number := range numbers {
+		if err := draw.ValidateTicketNumber(number); err != nil {
+			return nil, err
+		}
+	}
+
+	totalCost := draw.TicketCost * int64(len(numbers))
+	if availableBalance < totalCost {
+		return nil, fmt.Errorf("%w: have %d available, need %d", entities.ErrInsufficientBalance, availableBalance, totalCost)
+	}
+	// The minimum applies to the whole purchase, so a guild minimum above the ticket cost means buying several tickets
+	if err := s.balanceGuard.CheckMinBet(ctx, guildID, totalCost); err != nil {
+		return nil, err
+	}
+	if err := s.balanceGuard.CheckSpend(ctx, guildID, availableBalance, totalCost); err != nil {
+		return nil, err
+	}
+
+	// Each of the user's tickets in a draw must have a different number
+	usedNumbers, err := s.lotteryTicketRepo.GetUsedNumbersByUser(ctx, draw.ID, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get used numbers: %w", err)
+	}
+	usedSet := make(map[int64]bool, len(usedNumbers))
+	for _, num := range usedNumbers {
+		usedSet[num] = true
+	}
+	var owned []string
+	for _, number := range numbers {
+		if usedSet[number] {
+			owned = append(owned, strconv.FormatInt(number, 10))
+		}
+	}
+	if len(owned) > 0 {
+		return nil, fmt.Errorf("%w %s", entities.ErrLotteryNumberAlreadyOwned, strings.Join(owned, ", "))
+	}
+
+	return s.buyTicketNumbers(ctx, user, draw, guildID, numbers, map[string]interface{}{"picked_numbers": true})
+}
+
+// openPurchase checks the user may buy tickets for the guild's current draw and returns the draw,
+// the user and their available balance
+func (s *lotteryService) openPurchase(ctx context.Context, discordID, guildID int64) (*entities.LotteryDraw, *entities.User, int64, error) {
+	// Ticket purchases are disabled during the guild's curfew window
+	guildSettings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	if err := guildSettings.CheckBettingCurfew(time.Now()); err != nil {
+		return nil, nil, 0, err
 	}
 
 	// Get current draw
 	draw, err := s.GetOrCreateCurrentDraw(ctx, guildID)
 	if err != nil {
-		return nil, err
+		return nil, nil, 0, err
 	}
 
 	// Check if tickets can still be purchased
 	if !draw.CanPurchaseTickets() {
-		return nil, errors.New("tickets can no longer be purchased for this draw")
+		return nil, nil, 0, errors.New("tickets can no longer be purchased for this draw")
 	}
 
-	// Calculate total cost using draw's captured ticket cost
-	ticketCost := draw.TicketCost
-	totalCost := ticketCost * int64(quantity)
-
 	// Get user
 	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user: %w", err)
+		return nil, nil, 0, fmt.Errorf("failed to get user: %w", err)
 	}
 	if user == nil {
-		return nil, errors.New("user not found")
+		return nil, nil, 0, entities.ErrUserNotFound
+	}
+	if err := user.CheckGamblingBreak(time.Now()); err != nil {
+		return nil, nil, 0, err
+	}
+	if err := CheckNotFrozen(time.Now(), user); err != nil {
+		return nil, nil, 0, err
 	}
 
 	// Calculate available balance
 	availableBalance, err := s.calculateAvailableBalance(ctx, user)
 	if err != nil {
-		return nil, fmt.Errorf("failed to calculate available balance: %w", err)
+		return nil, nil, 0, fmt.Errorf("failed to calculate available balance: %w", err)
+	}
+
+	return draw, user, availableBalance, nil
+}
+
+// purchaseTickets buys lottery tickets for a user, marking the balance history when bought by a subscription
+func (s *lotteryService) purchaseTickets(ctx context.Context, discordID, guildID int64, amount entities.Amount, autoPurchase bool) (*interfaces.LotteryPurchaseResult, error) {
+	if !amount.IsRelative() && amount.Value <= 0 {
+		return nil, errors.New("quantity must be positive")
+	}
+
+	draw, user, availableBalance, err := s.openPurchase(ctx, discordID, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the draw's captured ticket cost
+	ticketCost := draw.TicketCost
+
+	// Relative amounts are resolved against the balance read in this transaction, buying as many
+	// tickets as fit in their share
+	units, err := amount.ResolveUnits(availableBalance, ticketCost)
+	if err != nil {
+		return nil, err
 	}
+	totalCost := ticketCost * units
 
 	if availableBalance < totalCost {
-		return nil, fmt.Errorf("insufficient balance: have %d available, need %d", availableBalance, totalCost)
+		return nil, fmt.Errorf("%w: have %d available, need %d", entities.ErrInsufficientBalance, availableBalance, totalCost)
+	}
+	// The minimum applies to the whole purchase, so a guild minimum above the ticket cost means buying several tickets
+	if err := s.balanceGuard.CheckMinBet(ctx, guildID, totalCost); err != nil {
+		return nil, err
+	}
+	if err := s.balanceGuard.CheckSpend(ctx, guildID, availableBalance, totalCost); err != nil {
+		return nil, err
 	}
 
 	// Get numbers this user already has for this draw (to avoid duplicates)
@@ -139,9 +254,14 @@ func (s *lotteryService) PurchaseTickets(ctx context.Context, discordID, guildID
 		return nil, fmt.Errorf("failed to get used numbers: %w", err)
 	}
 
-	// Check if user can get the requested quantity of unique numbers
+	// Check if user can get the requested quantity of unique numbers. Relative amounts stop at the
+	// numbers left rather than failing, since they only asked for as many tickets as they can get.
 	totalNumbers := draw.GetTotalNumbers()
 	userAvailableNumbers := totalNumbers - int64(len(usedNumbers))
+	if amount.IsRelative() && units > userAvailableNumbers && userAvailableNumbers > 0 {
+		units = userAvailableNumbers
+	}
+	quantity := int(units)
 	if int64(quantity) > userAvailableNumbers {
 		return nil, fmt.Errorf("only %d more unique numbers available", userAvailableNumbers)
 	}
@@ -158,6 +278,21 @@ func (s *lotteryService) PurchaseTickets(ctx context.Context, discordID, guildID
 		return nil, fmt.Errorf("failed to generate ticket numbers: %w", err)
 	}
 
+	var metadata map[string]interface{}
+	if autoPurchase {
+		metadata = map[string]interface{}{"auto_purchase": true}
+	}
+	return s.buyTicketNumbers(ctx, user, draw, guildID, ticketNumbers, metadata)
+}
+
+// buyTicketNumbers charges the user for a ticket per number and adds the cost to the pot. Extra
+// metadata is recorded on the balance history entry.
+func (s *lotteryService) buyTicketNumbers(ctx context.Context, user *entities.User, draw *entities.LotteryDraw, guildID int64, ticketNumbers []int64, metadata map[string]interface{}) (*interfaces.LotteryPurchaseResult, error) {
+	discordID := user.DiscordID
+	ticketCost := draw.TicketCost
+	quantity := len(ticketNumbers)
+	totalCost := ticketCost * int64(quantity)
+
 	// Update user balance
 	newBalance := user.Balance - totalCost
 	if err := s.userRepo.UpdateBalance(ctx, user.DiscordID, newBalance); err != nil {
@@ -179,6 +314,9 @@ func (s *lotteryService) PurchaseTickets(ctx context.Context, discordID, guildID
 			"ticket_numbers": ticketNumbers,
 		},
 	}
+	for key, value := range metadata {
+		history.TransactionMetadata[key] = value
+	}
 	if err := utils.RecordBalanceChange(ctx, s.balanceHistoryRepo, s.eventPublisher, history); err != nil {
 		return nil, fmt.Errorf("failed to record balance change: %w", err)
 	}
@@ -208,7 +346,7 @@ func (s *lotteryService) PurchaseTickets(ctx context.Context, discordID, guildID
 	}
 
 	// Refresh draw to get updated pot
-	draw, err = s.lotteryDrawRepo.GetByID(ctx, draw.ID)
+	draw, err := s.lotteryDrawRepo.GetByID(ctx, draw.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to refresh draw: %w", err)
 	}
@@ -268,10 +406,20 @@ func (s *lotteryService) GetDrawInfo(ctx context.Context, guildID int64) (*inter
 func (s *lotteryService) ConductDraw(ctx context.Context, draw *entities.LotteryDraw) (*interfaces.LotteryDrawResult, error) {
 	// Check if already completed (idempotency guard)
 	if draw.IsCompleted() {
-		return nil, errors.New("draw already completed")
+		return nil, entities.ErrLotteryDrawAlreadyCompleted
+	}
+
+	// Only one transaction across all instances may conduct a draw; the others back off
+	// instead of queueing behind the row lock and re-reading the draw afterwards
+	acquired, err := s.lotteryDrawRepo.TryLockDraw(ctx, draw.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		return nil, entities.ErrLotteryDrawInProgress
 	}
 
-	// Lock the draw for update
+	// Lock the draw for update and re-check completion, so a retry after a committed draw is a no-op
 	lockedDraw, err := s.lotteryDrawRepo.GetByIDForUpdate(ctx, draw.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to lock draw: %w", err)
@@ -280,7 +428,7 @@ func (s *lotteryService) ConductDraw(ctx context.Context, draw *entities.Lottery
 		return nil, errors.New("draw not found")
 	}
 	if lockedDraw.IsCompleted() {
-		return nil, errors.New("draw already completed")
+		return nil, entities.ErrLotteryDrawAlreadyCompleted
 	}
 
 	// Generate winning number using draw's stored difficulty
@@ -371,11 +519,26 @@ func (s *lotteryService) ConductDraw(ctx context.Context, draw *entities.Lottery
 		lockedDraw.Complete(winningNumber)
 	}
 
-	// Always create next draw
 	guildSettings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, draw.GuildID)
 	if err != nil {
 		log.WithError(err).WithField("guildID", draw.GuildID).Error("failed to get guild settings for next draw")
-	} else {
+	}
+
+	// A rolled over pot above the guild's cap pays the excess back to this draw's ticket holders
+	if result.RolledOver {
+		result.RolloverAmount = lockedDraw.TotalPot
+		if guildSettings != nil && guildSettings.HasLottoRolloverCap() && lockedDraw.TotalPot > *guildSettings.LottoRolloverCap {
+			payouts, err := s.payConsolation(ctx, lockedDraw, winningNumber, lockedDraw.TotalPot-*guildSettings.LottoRolloverCap)
+			if err != nil {
+				return nil, err
+			}
+			result.ConsolationPayouts = payouts
+			result.RolloverAmount -= entities.TotalLotteryConsolation(payouts)
+		}
+	}
+
+	// Always create next draw
+	if guildSettings != nil {
 		nextDrawTime := s.CalculateNextDrawTime()
 		nextDraw, err := s.lotteryDrawRepo.GetOrCreateCurrentDraw(
 			ctx,
@@ -390,15 +553,15 @@ func (s *lotteryService) ConductDraw(ctx context.Context, draw *entities.Lottery
 			}).Error("failed to create next draw")
 		} else if nextDraw != nil {
 			// Transfer pot to next draw only on rollover
-			if result.RolledOver {
-				if err := s.lotteryDrawRepo.IncrementPot(ctx, nextDraw.ID, lockedDraw.TotalPot); err != nil {
+			if result.RolloverAmount > 0 {
+				if err := s.lotteryDrawRepo.IncrementPot(ctx, nextDraw.ID, result.RolloverAmount); err != nil {
 					log.WithError(err).WithFields(log.Fields{
 						"guildID":    draw.GuildID,
 						"nextDrawID": nextDraw.ID,
-						"potAmount":  lockedDraw.TotalPot,
+						"potAmount":  result.RolloverAmount,
 					}).Error("failed to increment pot for rollover, pot is lost")
 				} else {
-					nextDraw.TotalPot += lockedDraw.TotalPot
+					nextDraw.TotalPot += result.RolloverAmount
 				}
 			}
 			result.NextDraw = nextDraw
@@ -413,6 +576,58 @@ func (s *lotteryService) ConductDraw(ctx context.Context, draw *entities.Lottery
 	return result, nil
 }
 
+// payConsolation credits each ticket holder of a winnerless draw with their share of the pot above the rollover cap
+func (s *lotteryService) payConsolation(ctx context.Context, draw *entities.LotteryDraw, winningNumber, excess int64) ([]*entities.LotteryConsolationPayout, error) {
+	participants, err := s.lotteryTicketRepo.GetParticipantSummary(ctx, draw.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draw participants: %w", err)
+	}
+
+	payouts := entities.SplitLotteryConsolation(excess, participants)
+	for _, payout := range payouts {
+		user, err := s.userRepo.GetByDiscordID(ctx, payout.DiscordID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ticket holder: %w", err)
+		}
+		if user == nil {
+			return nil, fmt.Errorf("ticket holder %d not found", payout.DiscordID)
+		}
+
+		newBalance := user.Balance + payout.Amount
+		if err := s.userRepo.UpdateBalance(ctx, user.DiscordID, newBalance); err != nil {
+			return nil, fmt.Errorf("failed to update ticket holder balance: %w", err)
+		}
+
+		history := &entities.BalanceHistory{
+			DiscordID:       payout.DiscordID,
+			GuildID:         draw.GuildID,
+			BalanceBefore:   user.Balance,
+			BalanceAfter:    newBalance,
+			ChangeAmount:    payout.Amount,
+			TransactionType: entities.TransactionTypeLottoConsolation,
+			TransactionMetadata: map[string]interface{}{
+				"draw_id":        draw.ID,
+				"winning_number": winningNumber,
+				"pot_amount":     draw.TotalPot,
+				"excess_amount":  excess,
+				"ticket_count":   payout.TicketCount,
+			},
+		}
+		if err := utils.RecordBalanceChange(ctx, s.balanceHistoryRepo, s.eventPublisher, history); err != nil {
+			return nil, fmt.Errorf("failed to record consolation balance change: %w", err)
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"drawID":     draw.ID,
+		"guildID":    draw.GuildID,
+		"excess":     excess,
+		"recipients": len(payouts),
+	}).Info("Paid lottery rollover excess to ticket holders")
+
+	return payouts, nil
+}
+
 // SetDrawMessage updates draw with Discord message/channel IDs
 func (s *lotteryService) SetDrawMessage(ctx context.Context, drawID, channelID, messageID int64) error {
 	draw, err := s.lotteryDrawRepo.GetByID(ctx, drawID)
@@ -431,34 +646,76 @@ func (s *lotteryService) SetDrawMessage(ctx context.Context, drawID, channelID,
 	return nil
 }
 
-// calculateAvailableBalance calculates user's available balance considering pending wagers
-func (s *lotteryService) calculateAvailableBalance(ctx context.Context, user *entities.User) (int64, error) {
-	// Get user's active wagers
-	activeWagers, err := s.wagerRepo.GetActiveByUser(ctx, user.DiscordID)
+// GetNumberStats returns hot/cold winning numbers, recent results and the user's most played numbers.
+// Frequencies only consider draws at the guild's current difficulty since numbers are not comparable across difficulties.
+func (s *lotteryService) GetNumberStats(ctx context.Context, discordID, guildID int64, recentLimit int) (*interfaces.LotteryNumberStats, error) {
+	if recentLimit <= 0 {
+		return nil, errors.New("recent draw limit must be positive")
+	}
+
+	guildSettings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	difficulty := guildSettings.GetLottoDifficulty()
+
+	frequencies, err := s.lotteryDrawRepo.GetWinningNumberFrequency(ctx, guildID, difficulty)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get active wagers: %w", err)
+		return nil, fmt.Errorf("failed to get winning number frequency: %w", err)
 	}
 
-	var lockedAmount int64
-	for _, wager := range activeWagers {
-		if wager.State == entities.WagerStateProposed || wager.State == entities.WagerStateVoting {
-			if wager.ProposerDiscordID == user.DiscordID || wager.TargetDiscordID == user.DiscordID {
-				lockedAmount += wager.Amount
-			}
-		}
+	recentDraws, err := s.lotteryDrawRepo.GetRecentCompletedDraws(ctx, guildID, recentLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent draws: %w", err)
 	}
 
-	// Get active group wager participations
-	participations, err := s.groupWagerRepo.GetActiveParticipationsByUser(ctx, user.DiscordID)
+	userNumbers, err := s.lotteryTicketRepo.GetUserNumberFrequency(ctx, discordID, difficulty, numberStatsLimit)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get group wager participations: %w", err)
+		return nil, fmt.Errorf("failed to get user number frequency: %w", err)
+	}
+
+	var totalDraws int64
+	for _, f := range frequencies {
+		totalDraws += f.Count
+	}
+
+	// Frequencies arrive most-frequent first, so hot numbers are the head of the list
+	hot := frequencies
+	if len(hot) > numberStatsLimit {
+		hot = hot[:numberStatsLimit]
 	}
 
-	for _, participation := range participations {
-		lockedAmount += participation.Amount
+	// Cold numbers are the least frequent, preferring the ones that have gone longest without being drawn
+	cold := make([]*entities.LotteryNumberFrequency, len(frequencies))
+	copy(cold, frequencies)
+	sort.SliceStable(cold, func(i, j int) bool {
+		if cold[i].Count != cold[j].Count {
+			return cold[i].Count < cold[j].Count
+		}
+		return cold[i].LastSeenAt.Before(cold[j].LastSeenAt)
+	})
+	if len(cold) > numberStatsLimit {
+		cold = cold[:numberStatsLimit]
+	}
+
+	return &interfaces.LotteryNumberStats{
+		Difficulty:  difficulty,
+		TotalDraws:  totalDraws,
+		HotNumbers:  hot,
+		ColdNumbers: cold,
+		RecentDraws: recentDraws,
+		UserNumbers: userNumbers,
+	}, nil
+}
+
+// calculateAvailableBalance calculates user's available balance considering pending wagers
+func (s *lotteryService) calculateAvailableBalance(ctx context.Context, user *entities.User) (int64, error) {
+	locked, err := s.userRepo.GetLockedBalanceBreakdown(ctx, user.DiscordID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get locked balance: %w", err)
 	}
 
-	return user.Balance - lockedAmount, nil
+	return user.Balance - locked.Total(), nil
 }
 
 // generateUniqueNumbers selects N unique ticket numbers not in usedSet.
@@ -534,3 +791,76 @@ func (s *lotteryService) generateWithRetry(totalNumbers int64, usedSet map[int64
 
 	return result, nil
 }
+
+// GetDrawHistory returns a page of the guild's completed draws, newest first, with their winners
+func (s *lotteryService) GetDrawHistory(ctx context.Context, guildID int64, page, pageSize int) (*interfaces.LotteryDrawHistory, error) {
+	if page < 1 {
+		return nil, errors.New("page must be at least 1")
+	}
+	if pageSize <= 0 {
+		return nil, errors.New("page size must be positive")
+	}
+
+	total, err := s.lotteryDrawRepo.CountCompletedDraws(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count completed draws: %w", err)
+	}
+
+	results, err := s.lotteryDrawRepo.GetCompletedDrawResults(ctx, guildID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get completed draws: %w", err)
+	}
+
+	drawIDs := make([]int64, 0, len(results))
+	for _, result := range results {
+		if result.WinnerCount > 0 {
+			drawIDs = append(drawIDs, result.DrawID)
+		}
+	}
+	winners, err := s.lotteryWinnerRepo.GetByDrawIDs(ctx, drawIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draw winners: %w", err)
+	}
+
+	winnersByDraw := make(map[int64][]*entities.LotteryWinner, len(drawIDs))
+	for _, winner := range winners {
+		winnersByDraw[winner.DrawID] = append(winnersByDraw[winner.DrawID], winner)
+	}
+
+	history := &interfaces.LotteryDrawHistory{
+		Draws:      make([]*interfaces.LotteryDrawHistoryEntry, 0, len(results)),
+		Page:       page,
+		PageSize:   pageSize,
+		TotalDraws: total,
+	}
+	for _, result := range results {
+		history.Draws = append(history.Draws, &interfaces.LotteryDrawHistoryEntry{
+			Result:  result,
+			Winners: winnersByDraw[result.DrawID],
+		})
+	}
+
+	return history, nil
+}
+
+// GetUserWinHistory returns the user's most recent lottery wins in the guild and their all-time totals
+func (s *lotteryService) GetUserWinHistory(ctx context.Context, discordID, guildID int64, limit int) (*interfaces.LotteryUserWinHistory, error) {
+	if limit <= 0 {
+		return nil, errors.New("win limit must be positive")
+	}
+
+	totals, err := s.lotteryWinnerRepo.GetUserWinTotals(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get win totals: %w", err)
+	}
+
+	wins, err := s.lotteryWinnerRepo.GetUserWins(ctx, discordID, limit, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wins: %w", err)
+	}
+
+	return &interfaces.LotteryUserWinHistory{
+		Wins:   wins,
+		Totals: totals,
+	}, nil
+}
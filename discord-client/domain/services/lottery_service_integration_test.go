@@ -2,6 +2,8 @@ package services_test
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"gambler/discord-client/domain/testhelpers"
 	"gambler/discord-client/repository"
 	"gambler/discord-client/repository/testutil"
+	"gambler/discord-client/repository/testutil/factories"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -35,6 +38,7 @@ type lotteryTestContext struct {
 	groupWagerRepo     interfaces.GroupWagerRepository
 	eventPublisher     *testhelpers.MockEventPublisher
 	lotteryService     interfaces.LotteryService
+	factory            *factories.Factory
 }
 
 // setupLotteryIntegrationTest creates all repositories and the lottery service for integration tests
@@ -79,23 +83,10 @@ func setupLotteryIntegrationTest(t *testing.T, guildID int64) *lotteryTestContex
 		groupWagerRepo:     groupWagerRepo,
 		eventPublisher:     eventPublisher,
 		lotteryService:     lotteryService,
+		factory:            factories.New(t, testDB.DB, guildID),
 	}
 }
 
-// createGuildSettingsWithLottery creates guild settings with lottery configuration
-func createGuildSettingsWithLottery(ctx context.Context, t *testing.T, repo *repository.GuildSettingsRepository, guildID int64, difficulty, ticketCost int64) *entities.GuildSettings {
-	settings, err := repo.GetOrCreateGuildSettings(ctx, guildID)
-	require.NoError(t, err)
-
-	settings.LottoDifficulty = &difficulty
-	settings.LottoTicketCost = &ticketCost
-
-	err = repo.UpdateGuildSettings(ctx, settings)
-	require.NoError(t, err)
-
-	return settings
-}
-
 func TestLotteryPurchaseTickets_Integration(t *testing.T) {
 	t.Parallel()
 	if testing.Short() {
@@ -117,7 +108,7 @@ func TestLotteryPurchaseTickets_Integration(t *testing.T) {
 		// Setup: Create guild settings with lottery enabled
 		ticketCost := int64(1000)
 		difficulty := int64(8) // 256 possible numbers
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, difficulty, ticketCost)
+		tc.factory.GuildSettings().WithLottery(difficulty, ticketCost).Create(ctx)
 
 		// Create user with initial balance
 		user, err := tc.userRepo.Create(ctx, 111111, "buyer1", 100000)
@@ -166,7 +157,7 @@ func TestLotteryPurchaseTickets_Integration(t *testing.T) {
 		// Setup
 		ticketCost := int64(500)
 		quantity := 5
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, 8, ticketCost)
+		tc.factory.GuildSettings().WithLottery(8, ticketCost).Create(ctx)
 
 		user, err := tc.userRepo.Create(ctx, 222222, "buyer2", 100000)
 		require.NoError(t, err)
@@ -205,7 +196,7 @@ func TestLotteryPurchaseTickets_Integration(t *testing.T) {
 
 		// Setup
 		ticketCost := int64(1000)
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, 8, ticketCost)
+		tc.factory.GuildSettings().WithLottery(8, ticketCost).Create(ctx)
 
 		user1, err := tc.userRepo.Create(ctx, 333333, "buyer3", 100000)
 		require.NoError(t, err)
@@ -255,7 +246,7 @@ func TestLotteryPurchaseTickets_Integration(t *testing.T) {
 
 		// Setup with high ticket cost
 		ticketCost := int64(10000)
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, 8, ticketCost)
+		tc.factory.GuildSettings().WithLottery(8, ticketCost).Create(ctx)
 
 		// Create user with low balance
 		user, err := tc.userRepo.Create(ctx, 555555, "pooruser", 5000)
@@ -281,7 +272,7 @@ func TestLotteryPurchaseTickets_Integration(t *testing.T) {
 
 		// Setup
 		ticketCost := int64(1000)
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, 8, ticketCost)
+		tc.factory.GuildSettings().WithLottery(8, ticketCost).Create(ctx)
 
 		// Create user with 10000 balance
 		user, err := tc.userRepo.Create(ctx, 666666, "wageruser", 10000)
@@ -342,7 +333,7 @@ func TestLotteryDrawConduct_Integration(t *testing.T) {
 		// This makes it guaranteed that one of the 4 tickets will win
 		ticketCost := int64(1000)
 		difficulty := int64(2) // Only 4 possible numbers: 0, 1, 2, 3
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, difficulty, ticketCost)
+		tc.factory.GuildSettings().WithLottery(difficulty, ticketCost).Create(ctx)
 
 		// Create user and buy all 4 possible tickets to guarantee a winner
 		user, err := tc.userRepo.Create(ctx, 111111, "winner", 100000)
@@ -408,7 +399,7 @@ func TestLotteryDrawConduct_Integration(t *testing.T) {
 		// Use difficulty of 2 (4 numbers) and have 2 users each buy 2 tickets
 		ticketCost := int64(1000)
 		difficulty := int64(2)
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, difficulty, ticketCost)
+		tc.factory.GuildSettings().WithLottery(difficulty, ticketCost).Create(ctx)
 
 		user1, err := tc.userRepo.Create(ctx, 111111, "player1", 100000)
 		require.NoError(t, err)
@@ -470,7 +461,7 @@ func TestLotteryDrawConduct_Integration(t *testing.T) {
 		// Setup
 		ticketCost := int64(1000)
 		difficulty := int64(8)
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, difficulty, ticketCost)
+		tc.factory.GuildSettings().WithLottery(difficulty, ticketCost).Create(ctx)
 
 		// Create a draw with a pot but no tickets (simulating a scenario where tickets exist but none match)
 		// We'll manually create a draw with some pot
@@ -517,7 +508,7 @@ func TestLotteryDrawConduct_Integration(t *testing.T) {
 		// Setup
 		ticketCost := int64(1000)
 		difficulty := int64(2)
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, difficulty, ticketCost)
+		tc.factory.GuildSettings().WithLottery(difficulty, ticketCost).Create(ctx)
 
 		user, err := tc.userRepo.Create(ctx, 111111, "player", 100000)
 		require.NoError(t, err)
@@ -540,6 +531,99 @@ func TestLotteryDrawConduct_Integration(t *testing.T) {
 		assert.Nil(t, secondResult)
 		assert.Contains(t, err.Error(), "already completed")
 	})
+
+	t.Run("concurrent_draws_pay_once", func(t *testing.T) {
+		t.Parallel()
+
+		guildID := int64(200005)
+		tc := setupLotteryIntegrationTest(t, guildID)
+
+		ticketCost := int64(1000)
+		difficulty := int64(2)
+		tc.factory.GuildSettings().WithLottery(difficulty, ticketCost).Create(ctx)
+
+		user, err := tc.userRepo.Create(ctx, 111111, "player", 100000)
+		require.NoError(t, err)
+
+		// Cover every number so the draw always has a winner
+		result, err := tc.lotteryService.PurchaseTickets(ctx, user.DiscordID, guildID, 4)
+		require.NoError(t, err)
+		draw, err := tc.lotteryDrawRepo.GetByID(ctx, result.Draw.ID)
+		require.NoError(t, err)
+		balanceBeforeDraw := int64(100000) - draw.TotalPot
+
+		// Several instances pick up the same pending draw at once, each in its own transaction
+		const instances = 5
+		var wg sync.WaitGroup
+		errs := make([]error, instances)
+		start := make(chan struct{})
+		for n := 0; n < instances; n++ {
+			wg.Add(1)
+			go func(n int) {
+				defer wg.Done()
+				<-start
+				errs[n] = conductDrawInTransaction(ctx, tc.testDB, guildID, draw)
+			}(n)
+		}
+		close(start)
+		wg.Wait()
+
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+				continue
+			}
+			assert.True(t,
+				errors.Is(err, entities.ErrLotteryDrawInProgress) || errors.Is(err, entities.ErrLotteryDrawAlreadyCompleted),
+				"unexpected error: %v", err)
+		}
+		assert.Equal(t, 1, succeeded)
+
+		// A retry with the stale draw after the winner committed is a no-op
+		err = conductDrawInTransaction(ctx, tc.testDB, guildID, draw)
+		assert.ErrorIs(t, err, entities.ErrLotteryDrawAlreadyCompleted)
+
+		// The pot was paid exactly once
+		updatedUser, err := tc.userRepo.GetByDiscordID(ctx, user.DiscordID)
+		require.NoError(t, err)
+		assert.Equal(t, balanceBeforeDraw+draw.TotalPot, updatedUser.Balance)
+
+		winners, err := tc.lotteryWinnerRepo.GetByDrawID(ctx, draw.ID)
+		require.NoError(t, err)
+		assert.Len(t, winners, 1)
+	})
+}
+
+// conductDrawInTransaction conducts a draw the way the draw worker does: in its own transaction,
+// committed only if the draw succeeds
+func conductDrawInTransaction(ctx context.Context, testDB *testutil.TestDatabase, guildID int64, draw *entities.LotteryDraw) error {
+	tx, err := testDB.DB.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	eventPublisher := &testhelpers.MockEventPublisher{}
+	eventPublisher.On("Publish", mock.Anything).Return(nil)
+
+	lotteryService := services.NewLotteryService(
+		repository.NewLotteryDrawRepositoryScoped(tx, guildID),
+		repository.NewLotteryTicketRepositoryScoped(tx, guildID),
+		repository.NewLotteryWinnerRepositoryScoped(tx, guildID),
+		repository.NewUserRepositoryScoped(tx, guildID),
+		repository.NewWagerRepositoryScoped(tx, guildID),
+		repository.NewGroupWagerRepositoryScoped(tx, guildID),
+		repository.NewBalanceHistoryRepositoryScoped(tx, guildID),
+		repository.NewGuildSettingsRepositoryWithTx(tx),
+		eventPublisher,
+	)
+
+	if _, err := lotteryService.ConductDraw(ctx, draw); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
 func TestLotteryDrawInfo_Integration(t *testing.T) {
@@ -561,7 +645,7 @@ func TestLotteryDrawInfo_Integration(t *testing.T) {
 
 		// Setup
 		ticketCost := int64(1000)
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, 8, ticketCost)
+		tc.factory.GuildSettings().WithLottery(8, ticketCost).Create(ctx)
 
 		user1, err := tc.userRepo.Create(ctx, 111111, "player1", 100000)
 		require.NoError(t, err)
@@ -592,7 +676,7 @@ func TestLotteryDrawInfo_Integration(t *testing.T) {
 
 		// Setup
 		ticketCost := int64(500)
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, 8, ticketCost)
+		tc.factory.GuildSettings().WithLottery(8, ticketCost).Create(ctx)
 
 		user1, err := tc.userRepo.Create(ctx, 111111, "player1", 100000)
 		require.NoError(t, err)
@@ -633,7 +717,7 @@ func TestLotteryDrawInfo_Integration(t *testing.T) {
 		tc := setupLotteryIntegrationTest(t, guildID)
 
 		// Setup guild settings but don't create any draw
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, 8, 1000)
+		tc.factory.GuildSettings().WithLottery(8, 1000).Create(ctx)
 
 		// Verify no draws exist
 		existingDraw, err := tc.lotteryDrawRepo.GetCurrentOpenDraw(ctx, guildID)
@@ -679,7 +763,7 @@ func TestLotteryEdgeCases_Integration(t *testing.T) {
 		// Setup with small number range
 		ticketCost := int64(100)
 		difficulty := int64(4) // 16 possible numbers
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, difficulty, ticketCost)
+		tc.factory.GuildSettings().WithLottery(difficulty, ticketCost).Create(ctx)
 
 		// Create 4 users
 		users := make([]*entities.User, 4)
@@ -716,7 +800,7 @@ func TestLotteryEdgeCases_Integration(t *testing.T) {
 		// Very low difficulty - only 4 possible numbers
 		ticketCost := int64(100)
 		difficulty := int64(2) // Only 4 numbers: 0, 1, 2, 3
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, difficulty, ticketCost)
+		tc.factory.GuildSettings().WithLottery(difficulty, ticketCost).Create(ctx)
 
 		user, err := tc.userRepo.Create(ctx, 111111, "buyer", 100000)
 		require.NoError(t, err)
@@ -740,7 +824,7 @@ func TestLotteryEdgeCases_Integration(t *testing.T) {
 
 		// Setup
 		ticketCost := int64(1000)
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, 8, ticketCost)
+		tc.factory.GuildSettings().WithLottery(8, ticketCost).Create(ctx)
 
 		user, err := tc.userRepo.Create(ctx, 111111, "buyer", 100000)
 		require.NoError(t, err)
@@ -781,7 +865,7 @@ func TestLotteryEdgeCases_Integration(t *testing.T) {
 		tc := setupLotteryIntegrationTest(t, guildID)
 
 		// Setup
-		createGuildSettingsWithLottery(ctx, t, tc.guildSettingsRepo, guildID, 8, 1000)
+		tc.factory.GuildSettings().WithLottery(8, 1000).Create(ctx)
 
 		// Get or create draw
 		draw, err := tc.lotteryService.GetOrCreateCurrentDraw(ctx, guildID)
@@ -265,6 +265,63 @@ func TestLotteryService_PurchaseTickets_Validation(t *testing.T) {
 			wantErr:     true,
 			errContains: "user not found",
 		},
+		{
+			name:      "betting curfew active",
+			discordID: 123456,
+			guildID:   123456789,
+			quantity:  1,
+			setupMocks: func(drawRepo *testhelpers.MockLotteryDrawRepository, ticketRepo *testhelpers.MockLotteryTicketRepository, userRepo *testhelpers.MockUserRepository, wagerRepo *testhelpers.MockWagerRepository, groupWagerRepo *testhelpers.MockGroupWagerRepository, balanceHistoryRepo *testhelpers.MockBalanceHistoryRepository, settingsRepo *testhelpers.MockGuildSettingsRepository, eventPublisher *testhelpers.MockEventPublisher) {
+				settings := createTestGuildSettings(123456789)
+				startHour := time.Now().UTC().Hour()
+				endHour := (startHour + 1) % 24
+				settings.CurfewStartHour = &startHour
+				settings.CurfewEndHour = &endHour
+				settingsRepo.On("GetOrCreateGuildSettings", mock.Anything, int64(123456789)).Return(settings, nil)
+			},
+			wantErr:     true,
+			errContains: "betting is closed during curfew hours",
+		},
+		{
+			name:      "user on gambling break",
+			discordID: 123456,
+			guildID:   123456789,
+			quantity:  1,
+			setupMocks: func(drawRepo *testhelpers.MockLotteryDrawRepository, ticketRepo *testhelpers.MockLotteryTicketRepository, userRepo *testhelpers.MockUserRepository, wagerRepo *testhelpers.MockWagerRepository, groupWagerRepo *testhelpers.MockGroupWagerRepository, balanceHistoryRepo *testhelpers.MockBalanceHistoryRepository, settingsRepo *testhelpers.MockGuildSettingsRepository, eventPublisher *testhelpers.MockEventPublisher) {
+				settings := createTestGuildSettings(123456789)
+				settingsRepo.On("GetOrCreateGuildSettings", mock.Anything, int64(123456789)).Return(settings, nil)
+
+				draw := createTestDraw(1, 123456789)
+				drawRepo.On("GetOrCreateCurrentDraw", mock.Anything, int64(123456789), mock.AnythingOfType("time.Time"), int64(8), int64(1000)).Return(draw, nil)
+
+				user := createTestUser(123456, 100000)
+				breakEndsAt := time.Now().Add(24 * time.Hour)
+				user.GamblingBreakEndsAt = &breakEndsAt
+				userRepo.On("GetByDiscordID", mock.Anything, int64(123456)).Return(user, nil)
+			},
+			wantErr:     true,
+			errContains: "gambling break is active",
+		},
+		{
+			name:      "purchase below minimum bet",
+			discordID: 123456,
+			guildID:   123456789,
+			quantity:  2,
+			setupMocks: func(drawRepo *testhelpers.MockLotteryDrawRepository, ticketRepo *testhelpers.MockLotteryTicketRepository, userRepo *testhelpers.MockUserRepository, wagerRepo *testhelpers.MockWagerRepository, groupWagerRepo *testhelpers.MockGroupWagerRepository, balanceHistoryRepo *testhelpers.MockBalanceHistoryRepository, settingsRepo *testhelpers.MockGuildSettingsRepository, eventPublisher *testhelpers.MockEventPublisher) {
+				settings := createTestGuildSettings(123456789)
+				minBet := int64(5000)
+				settings.MinBetAmount = &minBet // Two 1000 bit tickets fall short
+				settingsRepo.On("GetOrCreateGuildSettings", mock.Anything, int64(123456789)).Return(settings, nil)
+
+				draw := createTestDraw(1, 123456789)
+				drawRepo.On("GetOrCreateCurrentDraw", mock.Anything, int64(123456789), mock.AnythingOfType("time.Time"), int64(8), int64(1000)).Return(draw, nil)
+
+				user := createTestUser(123456, 100000)
+				userRepo.On("GetByDiscordID", mock.Anything, int64(123456)).Return(user, nil)
+				userRepo.On("GetLockedBalanceBreakdown", mock.Anything, int64(123456)).Return(&entities.LockedBalanceBreakdown{}, nil).Maybe()
+			},
+			wantErr:     true,
+			errContains: "below the server's minimum bet of 5000 bits",
+		},
 		{
 			name:      "insufficient balance",
 			discordID: 123456,
@@ -280,9 +337,8 @@ func TestLotteryService_PurchaseTickets_Validation(t *testing.T) {
 				user := createTestUser(123456, 2000) // Only 2000, needs 5000
 				userRepo.On("GetByDiscordID", mock.Anything, int64(123456)).Return(user, nil)
 
-				// No active wagers or group wager participations
-				wagerRepo.On("GetActiveByUser", mock.Anything, int64(123456)).Return([]*entities.Wager{}, nil)
-				groupWagerRepo.On("GetActiveParticipationsByUser", mock.Anything, int64(123456)).Return([]*entities.GroupWagerParticipant{}, nil)
+				// Nothing locked in wagers or group wagers
+				userRepo.On("GetLockedBalanceBreakdown", mock.Anything, int64(123456)).Return(&entities.LockedBalanceBreakdown{}, nil)
 			},
 			wantErr:     true,
 			errContains: "insufficient balance",
@@ -304,8 +360,7 @@ func TestLotteryService_PurchaseTickets_Validation(t *testing.T) {
 				user := createTestUser(123456, 1000000) // Plenty of balance
 				userRepo.On("GetByDiscordID", mock.Anything, int64(123456)).Return(user, nil)
 
-				wagerRepo.On("GetActiveByUser", mock.Anything, int64(123456)).Return([]*entities.Wager{}, nil)
-				groupWagerRepo.On("GetActiveParticipationsByUser", mock.Anything, int64(123456)).Return([]*entities.GroupWagerParticipant{}, nil)
+				userRepo.On("GetLockedBalanceBreakdown", mock.Anything, int64(123456)).Return(&entities.LockedBalanceBreakdown{}, nil)
 
 				// User already has 10 numbers out of 16 possible
 				usedNumbers := make([]int64, 10)
@@ -365,9 +420,8 @@ func TestLotteryService_PurchaseTickets_Success(t *testing.T) {
 	user := createTestUser(discordID, 10000)
 	userRepo.On("GetByDiscordID", mock.Anything, discordID).Return(user, nil)
 
-	// No active wagers
-	wagerRepo.On("GetActiveByUser", mock.Anything, discordID).Return([]*entities.Wager{}, nil)
-	groupWagerRepo.On("GetActiveParticipationsByUser", mock.Anything, discordID).Return([]*entities.GroupWagerParticipant{}, nil)
+	// Nothing locked in wagers
+	userRepo.On("GetLockedBalanceBreakdown", mock.Anything, discordID).Return(&entities.LockedBalanceBreakdown{}, nil)
 
 	// No used numbers for this user
 	ticketRepo.On("GetUsedNumbersByUser", mock.Anything, int64(1), discordID).Return([]int64{}, nil)
@@ -461,25 +515,13 @@ func TestLotteryService_PurchaseTickets_WithLockedBalance(t *testing.T) {
 	user := createTestUser(discordID, 10000)
 	userRepo.On("GetByDiscordID", mock.Anything, discordID).Return(user, nil)
 
-	// User has 6000 locked in active wager (proposer for 3000, target for 3000)
-	activeWagers := []*entities.Wager{
-		{
-			ID:                 1,
-			ProposerDiscordID:  discordID,
-			TargetDiscordID:    999999,
-			Amount:             3000,
-			State:              entities.WagerStateProposed,
-		},
-		{
-			ID:                 2,
-			ProposerDiscordID:  888888,
-			TargetDiscordID:    discordID,
-			Amount:             3000,
-			State:              entities.WagerStateVoting,
-		},
-	}
-	wagerRepo.On("GetActiveByUser", mock.Anything, discordID).Return(activeWagers, nil)
-	groupWagerRepo.On("GetActiveParticipationsByUser", mock.Anything, discordID).Return([]*entities.GroupWagerParticipant{}, nil)
+	// User has 6000 locked in wagers, group wagers and parlays; lottery tickets are already paid for
+	userRepo.On("GetLockedBalanceBreakdown", mock.Anything, discordID).Return(&entities.LockedBalanceBreakdown{
+		InWagers:      3000,
+		InGroupWagers: 1500,
+		InParlays:     1500,
+		InLottery:     2000,
+	}, nil)
 
 	service := NewLotteryService(
 		drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
@@ -497,6 +539,162 @@ func TestLotteryService_PurchaseTickets_WithLockedBalance(t *testing.T) {
 	assert.Contains(t, err.Error(), "need 5000")
 }
 
+func TestLotteryService_PurchaseTicketsWithAmount_All(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo, balanceHistoryRepo, settingsRepo, eventPublisher := setupLotteryServiceMocks()
+
+	guildID := int64(123456789)
+	discordID := int64(123456)
+	ticketCost := int64(1000)
+
+	settingsRepo.On("GetOrCreateGuildSettings", mock.Anything, guildID).Return(createTestGuildSettings(guildID), nil)
+	draw := createTestDraw(1, guildID)
+	drawRepo.On("GetOrCreateCurrentDraw", mock.Anything, guildID, mock.AnythingOfType("time.Time"), int64(8), ticketCost).Return(draw, nil)
+
+	// 10500 balance with 6000 locked leaves 4500 available, which covers 4 tickets
+	user := createTestUser(discordID, 10500)
+	userRepo.On("GetByDiscordID", mock.Anything, discordID).Return(user, nil)
+	userRepo.On("GetLockedBalanceBreakdown", mock.Anything, discordID).Return(&entities.LockedBalanceBreakdown{
+		InWagers:      3000,
+		InGroupWagers: 3000,
+	}, nil)
+	ticketRepo.On("GetUsedNumbersByUser", mock.Anything, draw.ID, discordID).Return([]int64{}, nil)
+	userRepo.On("UpdateBalance", mock.Anything, discordID, int64(6500)).Return(nil)
+	balanceHistoryRepo.On("Record", mock.Anything, mock.Anything).Return(nil)
+	eventPublisher.On("Publish", mock.AnythingOfType("events.BalanceChangeEvent")).Return(nil)
+	ticketRepo.On("CreateBatch", mock.Anything, mock.Anything).Return(nil)
+	drawRepo.On("IncrementPot", mock.Anything, draw.ID, int64(4000)).Return(nil)
+	drawRepo.On("GetByID", mock.Anything, draw.ID).Return(draw, nil)
+
+	service := NewLotteryService(
+		drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
+		balanceHistoryRepo, settingsRepo, eventPublisher,
+	)
+
+	result, err := service.PurchaseTicketsWithAmount(ctx, discordID, guildID, entities.Amount{Percent: 100})
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Tickets, 4)
+	assert.Equal(t, int64(4000), result.TotalCost)
+	userRepo.AssertExpectations(t)
+	drawRepo.AssertExpectations(t)
+}
+
+func TestLotteryService_PurchaseTicketsWithAmount_ShareBelowTicketCost(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo, balanceHistoryRepo, settingsRepo, eventPublisher := setupLotteryServiceMocks()
+
+	guildID := int64(123456789)
+	discordID := int64(123456)
+
+	settingsRepo.On("GetOrCreateGuildSettings", mock.Anything, guildID).Return(createTestGuildSettings(guildID), nil)
+	drawRepo.On("GetOrCreateCurrentDraw", mock.Anything, guildID, mock.AnythingOfType("time.Time"), int64(8), int64(1000)).Return(createTestDraw(1, guildID), nil)
+	userRepo.On("GetByDiscordID", mock.Anything, discordID).Return(createTestUser(discordID, 1500), nil)
+	userRepo.On("GetLockedBalanceBreakdown", mock.Anything, discordID).Return(&entities.LockedBalanceBreakdown{}, nil)
+
+	service := NewLotteryService(
+		drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
+		balanceHistoryRepo, settingsRepo, eventPublisher,
+	)
+
+	// Half of 1500 is 750, less than one 1000 bit ticket
+	result, err := service.PurchaseTicketsWithAmount(ctx, discordID, guildID, entities.Amount{Percent: 50})
+
+	assert.ErrorIs(t, err, entities.ErrAmountNotAffordable)
+	assert.Nil(t, result)
+	userRepo.AssertNotCalled(t, "UpdateBalance", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestLotteryService_PurchaseSpecificTickets(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo, balanceHistoryRepo, settingsRepo, eventPublisher := setupLotteryServiceMocks()
+
+	guildID := int64(123456789)
+	discordID := int64(123456)
+
+	settingsRepo.On("GetOrCreateGuildSettings", mock.Anything, guildID).Return(createTestGuildSettings(guildID), nil)
+	draw := createTestDraw(1, guildID)
+	drawRepo.On("GetOrCreateCurrentDraw", mock.Anything, guildID, mock.AnythingOfType("time.Time"), int64(8), int64(1000)).Return(draw, nil)
+	userRepo.On("GetByDiscordID", mock.Anything, discordID).Return(createTestUser(discordID, 10000), nil)
+	userRepo.On("GetLockedBalanceBreakdown", mock.Anything, discordID).Return(&entities.LockedBalanceBreakdown{}, nil)
+	ticketRepo.On("GetUsedNumbersByUser", mock.Anything, draw.ID, discordID).Return([]int64{5}, nil)
+	userRepo.On("UpdateBalance", mock.Anything, discordID, int64(7000)).Return(nil)
+	balanceHistoryRepo.On("Record", mock.Anything, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+		return h.ChangeAmount == -3000 && h.TransactionMetadata["picked_numbers"] == true
+	})).Return(nil)
+	eventPublisher.On("Publish", mock.AnythingOfType("events.BalanceChangeEvent")).Return(nil)
+	ticketRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(tickets []*entities.LotteryTicket) bool {
+		return len(tickets) == 3 &&
+			tickets[0].TicketNumber == 7 && tickets[1].TicketNumber == 42 && tickets[2].TicketNumber == 255
+	})).Return(nil)
+	drawRepo.On("IncrementPot", mock.Anything, draw.ID, int64(3000)).Return(nil)
+	drawRepo.On("GetByID", mock.Anything, draw.ID).Return(draw, nil)
+
+	service := NewLotteryService(
+		drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
+		balanceHistoryRepo, settingsRepo, eventPublisher,
+	)
+
+	result, err := service.PurchaseSpecificTickets(ctx, discordID, guildID, []int64{7, 42, 255})
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Tickets, 3)
+	assert.Equal(t, int64(3000), result.TotalCost)
+	assert.Equal(t, int64(7000), result.NewBalance)
+	ticketRepo.AssertExpectations(t)
+	balanceHistoryRepo.AssertExpectations(t)
+}
+
+func TestLotteryService_PurchaseSpecificTickets_Rejected(t *testing.T) {
+	t.Parallel()
+
+	guildID := int64(123456789)
+	discordID := int64(123456)
+
+	tests := []struct {
+		name    string
+		numbers []int64
+		wantErr error
+	}{
+		{name: "no numbers", numbers: nil, wantErr: entities.ErrNoLotteryNumbers},
+		{name: "outside difficulty range", numbers: []int64{7, 256}, wantErr: entities.ErrLotteryNumberOutOfRange},
+		{name: "already owned", numbers: []int64{7, 5}, wantErr: entities.ErrLotteryNumberAlreadyOwned},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo, balanceHistoryRepo, settingsRepo, eventPublisher := setupLotteryServiceMocks()
+
+			settingsRepo.On("GetOrCreateGuildSettings", mock.Anything, guildID).Return(createTestGuildSettings(guildID), nil)
+			draw := createTestDraw(1, guildID)
+			drawRepo.On("GetOrCreateCurrentDraw", mock.Anything, guildID, mock.AnythingOfType("time.Time"), int64(8), int64(1000)).Return(draw, nil)
+			userRepo.On("GetByDiscordID", mock.Anything, discordID).Return(createTestUser(discordID, 10000), nil)
+			userRepo.On("GetLockedBalanceBreakdown", mock.Anything, discordID).Return(&entities.LockedBalanceBreakdown{}, nil)
+			ticketRepo.On("GetUsedNumbersByUser", mock.Anything, draw.ID, discordID).Return([]int64{5}, nil)
+
+			service := NewLotteryService(
+				drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
+				balanceHistoryRepo, settingsRepo, eventPublisher,
+			)
+
+			result, err := service.PurchaseSpecificTickets(ctx, discordID, guildID, tt.numbers)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, result)
+			userRepo.AssertNotCalled(t, "UpdateBalance", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestLotteryService_GetUserTickets(t *testing.T) {
 	t.Parallel()
 
@@ -694,6 +892,57 @@ func TestLotteryService_ConductDraw_AlreadyCompleted(t *testing.T) {
 	assert.Contains(t, err.Error(), "draw already completed")
 }
 
+func TestLotteryService_ConductDraw_LockHeldElsewhere(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo, balanceHistoryRepo, settingsRepo, eventPublisher := setupLotteryServiceMocks()
+
+	draw := createTestDraw(1, 123456789)
+	drawRepo.On("TryLockDraw", mock.Anything, draw.ID).Return(false, nil)
+
+	service := NewLotteryService(
+		drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
+		balanceHistoryRepo, settingsRepo, eventPublisher,
+	)
+
+	result, err := service.ConductDraw(ctx, draw)
+
+	assert.ErrorIs(t, err, entities.ErrLotteryDrawInProgress)
+	assert.Nil(t, result)
+	drawRepo.AssertExpectations(t)
+	drawRepo.AssertNotCalled(t, "GetByIDForUpdate", mock.Anything, mock.Anything)
+	userRepo.AssertNotCalled(t, "UpdateBalance", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestLotteryService_ConductDraw_CompletedByEarlierAttempt(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo, balanceHistoryRepo, settingsRepo, eventPublisher := setupLotteryServiceMocks()
+
+	// The caller's copy is stale; the locked row shows the draw was already completed
+	draw := createTestDraw(1, 123456789)
+	now := time.Now()
+	completedDraw := createTestDraw(1, 123456789, func(d *entities.LotteryDraw) {
+		d.CompletedAt = &now
+	})
+	drawRepo.On("TryLockDraw", mock.Anything, draw.ID).Return(true, nil)
+	drawRepo.On("GetByIDForUpdate", mock.Anything, draw.ID).Return(completedDraw, nil)
+
+	service := NewLotteryService(
+		drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
+		balanceHistoryRepo, settingsRepo, eventPublisher,
+	)
+
+	result, err := service.ConductDraw(ctx, draw)
+
+	assert.ErrorIs(t, err, entities.ErrLotteryDrawAlreadyCompleted)
+	assert.Nil(t, result)
+	drawRepo.AssertExpectations(t)
+	ticketRepo.AssertNotCalled(t, "GetWinningTickets", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestLotteryService_ConductDraw_WithWinner(t *testing.T) {
 	t.Parallel()
 
@@ -709,6 +958,7 @@ func TestLotteryService_ConductDraw_WithWinner(t *testing.T) {
 	})
 
 	// Lock the draw for update
+	drawRepo.On("TryLockDraw", mock.Anything, draw.ID).Return(true, nil)
 	drawRepo.On("GetByIDForUpdate", mock.Anything, draw.ID).Return(draw, nil)
 
 	// Mock winning tickets - one winner
@@ -799,6 +1049,7 @@ func TestLotteryService_ConductDraw_MultipleWinners(t *testing.T) {
 	})
 
 	// Lock the draw for update
+	drawRepo.On("TryLockDraw", mock.Anything, draw.ID).Return(true, nil)
 	drawRepo.On("GetByIDForUpdate", mock.Anything, draw.ID).Return(draw, nil)
 
 	// Mock winning tickets - two winners with same number (edge case)
@@ -881,6 +1132,7 @@ func TestLotteryService_ConductDraw_NoWinner_Rollover(t *testing.T) {
 	})
 
 	// Lock the draw for update
+	drawRepo.On("TryLockDraw", mock.Anything, draw.ID).Return(true, nil)
 	drawRepo.On("GetByIDForUpdate", mock.Anything, draw.ID).Return(draw, nil)
 
 	// No winning tickets
@@ -921,6 +1173,74 @@ func TestLotteryService_ConductDraw_NoWinner_Rollover(t *testing.T) {
 	settingsRepo.AssertExpectations(t)
 }
 
+func TestLotteryService_ConductDraw_Rollover_AboveCap(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo, balanceHistoryRepo, settingsRepo, eventPublisher := setupLotteryServiceMocks()
+
+	guildID := int64(123456789)
+	potAmount := int64(10000)
+	rolloverCap := int64(7000)
+
+	draw := createTestDraw(1, guildID, func(d *entities.LotteryDraw) {
+		d.TotalPot = potAmount
+	})
+
+	drawRepo.On("TryLockDraw", mock.Anything, draw.ID).Return(true, nil)
+	drawRepo.On("GetByIDForUpdate", mock.Anything, draw.ID).Return(draw, nil)
+	ticketRepo.On("GetWinningTickets", mock.Anything, draw.ID, mock.AnythingOfType("int64")).Return([]*entities.LotteryTicket{}, nil)
+	drawRepo.On("Update", mock.Anything, mock.MatchedBy(func(d *entities.LotteryDraw) bool {
+		return d.ID == draw.ID && d.CompletedAt != nil
+	})).Return(nil)
+
+	settings := createTestGuildSettings(guildID)
+	settings.SetLottoRolloverCap(&rolloverCap)
+	settingsRepo.On("GetOrCreateGuildSettings", mock.Anything, guildID).Return(settings, nil)
+
+	// The 3000 excess is split 2:1 by ticket count
+	ticketRepo.On("GetParticipantSummary", mock.Anything, draw.ID).Return([]*entities.LotteryParticipantInfo{
+		{DiscordID: 111, TicketCount: 2},
+		{DiscordID: 222, TicketCount: 1},
+	}, nil)
+
+	holder1 := createTestUser(111, 500)
+	holder2 := createTestUser(222, 500)
+	userRepo.On("GetByDiscordID", mock.Anything, int64(111)).Return(holder1, nil)
+	userRepo.On("GetByDiscordID", mock.Anything, int64(222)).Return(holder2, nil)
+	userRepo.On("UpdateBalance", mock.Anything, int64(111), int64(2500)).Return(nil)
+	userRepo.On("UpdateBalance", mock.Anything, int64(222), int64(1500)).Return(nil)
+
+	balanceHistoryRepo.On("Record", mock.Anything, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+		return h.TransactionType == entities.TransactionTypeLottoConsolation
+	})).Return(nil).Times(2)
+	eventPublisher.On("Publish", mock.AnythingOfType("events.BalanceChangeEvent")).Return(nil).Times(2)
+
+	// Only the capped amount carries into the next draw
+	nextDraw := createTestDraw(2, guildID)
+	drawRepo.On("GetOrCreateCurrentDraw", mock.Anything, guildID, mock.AnythingOfType("time.Time"), int64(8), int64(1000)).Return(nextDraw, nil)
+	drawRepo.On("IncrementPot", mock.Anything, nextDraw.ID, rolloverCap).Return(nil)
+
+	service := NewLotteryService(
+		drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
+		balanceHistoryRepo, settingsRepo, eventPublisher,
+	)
+
+	result, err := service.ConductDraw(ctx, draw)
+
+	assert.NoError(t, err)
+	assert.True(t, result.RolledOver)
+	assert.Equal(t, rolloverCap, result.RolloverAmount)
+	assert.Len(t, result.ConsolationPayouts, 2)
+	assert.Equal(t, int64(3000), entities.TotalLotteryConsolation(result.ConsolationPayouts))
+
+	drawRepo.AssertExpectations(t)
+	ticketRepo.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+	balanceHistoryRepo.AssertExpectations(t)
+	eventPublisher.AssertExpectations(t)
+}
+
 func TestLotteryService_SetDrawMessage(t *testing.T) {
 	t.Parallel()
 
@@ -1015,3 +1335,160 @@ func TestLotteryService_SetDrawMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestLotteryService_GetNumberStats(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	guildID := int64(123456789)
+	discordID := int64(111)
+	now := time.Now()
+
+	drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo, balanceHistoryRepo, settingsRepo, eventPublisher := setupLotteryServiceMocks()
+
+	settingsRepo.On("GetOrCreateGuildSettings", mock.Anything, guildID).Return(createTestGuildSettings(guildID), nil)
+
+	frequencies := []*entities.LotteryNumberFrequency{
+		{Number: 42, Count: 3, LastSeenAt: now},
+		{Number: 7, Count: 2, LastSeenAt: now.Add(-24 * time.Hour)},
+		{Number: 100, Count: 1, LastSeenAt: now.Add(-1 * time.Hour)},
+		{Number: 200, Count: 1, LastSeenAt: now.Add(-48 * time.Hour)},
+	}
+	drawRepo.On("GetWinningNumberFrequency", mock.Anything, guildID, int64(8)).Return(frequencies, nil)
+
+	winningNumber := int64(42)
+	recentDraws := []*entities.LotteryDraw{
+		createTestDraw(10, guildID, func(d *entities.LotteryDraw) { d.Complete(winningNumber) }),
+	}
+	drawRepo.On("GetRecentCompletedDraws", mock.Anything, guildID, 10).Return(recentDraws, nil)
+
+	userNumbers := []*entities.LotteryNumberFrequency{
+		{Number: 13, Count: 4, LastSeenAt: now},
+	}
+	ticketRepo.On("GetUserNumberFrequency", mock.Anything, discordID, int64(8), numberStatsLimit).Return(userNumbers, nil)
+
+	service := NewLotteryService(
+		drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
+		balanceHistoryRepo, settingsRepo, eventPublisher,
+	)
+
+	stats, err := service.GetNumberStats(ctx, discordID, guildID, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), stats.Difficulty)
+	assert.Equal(t, int64(7), stats.TotalDraws)
+	assert.Equal(t, int64(42), stats.HotNumbers[0].Number)
+	// Ties on count are broken by the number that has gone longest without being drawn
+	assert.Equal(t, int64(200), stats.ColdNumbers[0].Number)
+	assert.Equal(t, int64(100), stats.ColdNumbers[1].Number)
+	assert.Len(t, stats.RecentDraws, 1)
+	assert.Equal(t, userNumbers, stats.UserNumbers)
+
+	drawRepo.AssertExpectations(t)
+	ticketRepo.AssertExpectations(t)
+	settingsRepo.AssertExpectations(t)
+}
+
+func TestLotteryService_GetNumberStats_InvalidLimit(t *testing.T) {
+	t.Parallel()
+
+	drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo, balanceHistoryRepo, settingsRepo, eventPublisher := setupLotteryServiceMocks()
+
+	service := NewLotteryService(
+		drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
+		balanceHistoryRepo, settingsRepo, eventPublisher,
+	)
+
+	_, err := service.GetNumberStats(context.Background(), 111, 123456789, 0)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "must be positive")
+}
+
+func TestLotteryService_GetDrawHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	guildID := int64(123456789)
+	now := time.Now()
+
+	drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo, balanceHistoryRepo, settingsRepo, eventPublisher := setupLotteryServiceMocks()
+
+	results := []*entities.LotteryDrawResult{
+		{DrawID: 12, CompletedAt: now, WinningNumber: 42, Difficulty: 8, TotalPot: 5000, TicketsSold: 40, WinnerCount: 2, TotalPaidOut: 5000},
+		{DrawID: 11, CompletedAt: now.Add(-7 * 24 * time.Hour), WinningNumber: 7, Difficulty: 8, TotalPot: 3000, TicketsSold: 30},
+	}
+	winners := []*entities.LotteryWinner{
+		{DrawID: 12, DiscordID: 111, WinningAmount: 2500},
+		{DrawID: 12, DiscordID: 222, WinningAmount: 2500},
+	}
+	drawRepo.On("CountCompletedDraws", mock.Anything, guildID).Return(int64(12), nil)
+	drawRepo.On("GetCompletedDrawResults", mock.Anything, guildID, 5, 5).Return(results, nil)
+	// Only draws that had winners are looked up
+	winnerRepo.On("GetByDrawIDs", mock.Anything, []int64{12}).Return(winners, nil)
+
+	service := NewLotteryService(
+		drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
+		balanceHistoryRepo, settingsRepo, eventPublisher,
+	)
+
+	history, err := service.GetDrawHistory(ctx, guildID, 2, 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, history.Page)
+	assert.Equal(t, 3, history.TotalPages())
+	assert.Len(t, history.Draws, 2)
+	assert.Equal(t, winners, history.Draws[0].Winners)
+	assert.Empty(t, history.Draws[1].Winners)
+	assert.True(t, history.Draws[1].Result.RolledOver())
+
+	drawRepo.AssertExpectations(t)
+	winnerRepo.AssertExpectations(t)
+}
+
+func TestLotteryService_GetDrawHistory_InvalidPage(t *testing.T) {
+	t.Parallel()
+
+	drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo, balanceHistoryRepo, settingsRepo, eventPublisher := setupLotteryServiceMocks()
+
+	service := NewLotteryService(
+		drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
+		balanceHistoryRepo, settingsRepo, eventPublisher,
+	)
+
+	_, err := service.GetDrawHistory(context.Background(), 123456789, 0, 10)
+	assert.Error(t, err)
+
+	_, err = service.GetDrawHistory(context.Background(), 123456789, 1, 0)
+	assert.Error(t, err)
+}
+
+func TestLotteryService_GetUserWinHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	guildID := int64(123456789)
+	discordID := int64(111)
+
+	drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo, balanceHistoryRepo, settingsRepo, eventPublisher := setupLotteryServiceMocks()
+
+	wins := []*entities.LotteryUserWin{
+		{DrawID: 12, WinningNumber: 42, Difficulty: 8, TotalPot: 5000, WinningAmount: 2500, WinnerCount: 2, CompletedAt: time.Now()},
+	}
+	totals := &entities.LotteryUserWinTotals{WinCount: 3, TotalWon: 9000}
+	winnerRepo.On("GetUserWinTotals", mock.Anything, discordID).Return(totals, nil)
+	winnerRepo.On("GetUserWins", mock.Anything, discordID, 10, 0).Return(wins, nil)
+
+	service := NewLotteryService(
+		drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
+		balanceHistoryRepo, settingsRepo, eventPublisher,
+	)
+
+	history, err := service.GetUserWinHistory(ctx, discordID, guildID, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, wins, history.Wins)
+	assert.Equal(t, totals, history.Totals)
+
+	winnerRepo.AssertExpectations(t)
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// lotterySubscriptionService implements business logic for lottery auto-buy subscriptions
+type lotterySubscriptionService struct {
+	subscriptionRepo interfaces.LotterySubscriptionRepository
+	lotteryService   interfaces.LotteryService
+}
+
+// NewLotterySubscriptionService creates a new lottery subscription service
+func NewLotterySubscriptionService(
+	subscriptionRepo interfaces.LotterySubscriptionRepository,
+	lotteryService interfaces.LotteryService,
+) interfaces.LotterySubscriptionService {
+	return &lotterySubscriptionService{
+		subscriptionRepo: subscriptionRepo,
+		lotteryService:   lotteryService,
+	}
+}
+
+// Subscribe sets the number of tickets bought for the user at the start of each new draw
+func (s *lotterySubscriptionService) Subscribe(ctx context.Context, discordID, guildID int64, ticketCount int) (*entities.LotterySubscription, error) {
+	if ticketCount <= 0 || ticketCount > entities.MaxLotterySubscriptionTickets {
+		return nil, fmt.Errorf("subscriptions must buy between 1 and %d tickets", entities.MaxLotterySubscriptionTickets)
+	}
+
+	subscription := &entities.LotterySubscription{
+		GuildID:     guildID,
+		DiscordID:   discordID,
+		TicketCount: ticketCount,
+	}
+	if err := s.subscriptionRepo.Upsert(ctx, subscription); err != nil {
+		return nil, fmt.Errorf("failed to save subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// Unsubscribe cancels the user's subscription. Returns false if they had none.
+func (s *lotterySubscriptionService) Unsubscribe(ctx context.Context, discordID int64) (bool, error) {
+	removed, err := s.subscriptionRepo.Delete(ctx, discordID)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove subscription: %w", err)
+	}
+	return removed, nil
+}
+
+// GetSubscription returns the user's subscription, or nil if they have none
+func (s *lotterySubscriptionService) GetSubscription(ctx context.Context, discordID int64) (*entities.LotterySubscription, error) {
+	subscription, err := s.subscriptionRepo.GetByUser(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	return subscription, nil
+}
+
+// ProcessSubscriptions buys each subscriber's tickets for the guild's current draw.
+// Subscribers who can't buy right now (insufficient available balance, the reserve floor, the minimum bet, a gambling break, a balance freeze or curfew) are skipped.
+func (s *lotterySubscriptionService) ProcessSubscriptions(ctx context.Context, guildID int64) (*interfaces.LotterySubscriptionRunResult, error) {
+	subscriptions, err := s.subscriptionRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscriptions: %w", err)
+	}
+
+	result := &interfaces.LotterySubscriptionRunResult{}
+	for _, subscription := range subscriptions {
+		purchase, err := s.lotteryService.AutoPurchaseTickets(ctx, subscription.DiscordID, guildID, subscription.TicketCount)
+		if err != nil {
+			if errors.Is(err, entities.ErrInsufficientBalance) ||
+				errors.Is(err, entities.ErrBelowBalanceFloor) ||
+				errors.Is(err, entities.ErrBelowMinBet) ||
+				errors.Is(err, entities.ErrGamblingBreakActive) ||
+				errors.Is(err, entities.ErrBalanceFrozen) ||
+				errors.Is(err, entities.ErrBettingCurfewActive) {
+				log.WithFields(log.Fields{
+					"guild_id":     guildID,
+					"discord_id":   subscription.DiscordID,
+					"ticket_count": subscription.TicketCount,
+				}).WithError(err).Info("Skipping lottery subscription")
+				result.Skipped = append(result.Skipped, subscription)
+				continue
+			}
+			return nil, fmt.Errorf("failed to buy subscription tickets for user %d: %w", subscription.DiscordID, err)
+		}
+
+		result.Purchased = append(result.Purchased, subscription)
+		result.TicketsBought += len(purchase.Tickets)
+		result.TotalCost += purchase.TotalCost
+	}
+
+	return result, nil
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLotterySubscriptionService_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		ticketCount int
+		wantErr     bool
+	}{
+		{name: "valid count", ticketCount: 5},
+		{name: "max count", ticketCount: entities.MaxLotterySubscriptionTickets},
+		{name: "zero count", ticketCount: 0, wantErr: true},
+		{name: "above max", ticketCount: entities.MaxLotterySubscriptionTickets + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			subscriptionRepo := new(testhelpers.MockLotterySubscriptionRepository)
+			if !tt.wantErr {
+				subscriptionRepo.On("Upsert", ctx, mock.MatchedBy(func(sub *entities.LotterySubscription) bool {
+					return sub.GuildID == 999 && sub.DiscordID == 123 && sub.TicketCount == tt.ticketCount
+				})).Return(nil)
+			}
+
+			service := NewLotterySubscriptionService(subscriptionRepo, nil)
+			subscription, err := service.Subscribe(ctx, 123, 999, tt.ticketCount)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, subscription)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ticketCount, subscription.TicketCount)
+			}
+			subscriptionRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLotterySubscriptionService_ProcessSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo, balanceHistoryRepo, settingsRepo, eventPublisher := setupLotteryServiceMocks()
+	subscriptionRepo := new(testhelpers.MockLotterySubscriptionRepository)
+
+	guildID := int64(123456789)
+	richID := int64(111)
+	poorID := int64(222)
+	ticketCost := int64(1000)
+
+	subscriptionRepo.On("GetAll", ctx).Return([]*entities.LotterySubscription{
+		{ID: 1, GuildID: guildID, DiscordID: richID, TicketCount: 2},
+		{ID: 2, GuildID: guildID, DiscordID: poorID, TicketCount: 3},
+	}, nil)
+
+	settings := createTestGuildSettings(guildID)
+	settingsRepo.On("GetOrCreateGuildSettings", mock.Anything, guildID).Return(settings, nil)
+
+	draw := createTestDraw(1, guildID)
+	drawRepo.On("GetOrCreateCurrentDraw", mock.Anything, guildID, mock.AnythingOfType("time.Time"), int64(8), ticketCost).Return(draw, nil)
+
+	// The first subscriber can afford their tickets
+	richUser := createTestUser(richID, 10000)
+	userRepo.On("GetByDiscordID", mock.Anything, richID).Return(richUser, nil)
+	userRepo.On("GetLockedBalanceBreakdown", mock.Anything, richID).Return(&entities.LockedBalanceBreakdown{}, nil)
+	ticketRepo.On("GetUsedNumbersByUser", mock.Anything, draw.ID, richID).Return([]int64{}, nil)
+	userRepo.On("UpdateBalance", mock.Anything, richID, int64(8000)).Return(nil)
+	balanceHistoryRepo.On("Record", mock.Anything, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+		return h.DiscordID == richID &&
+			h.TransactionType == entities.TransactionTypeLottoTicket &&
+			h.TransactionMetadata["auto_purchase"] == true
+	})).Return(nil)
+	eventPublisher.On("Publish", mock.AnythingOfType("events.BalanceChangeEvent")).Return(nil)
+	ticketRepo.On("CreateBatch", mock.Anything, mock.AnythingOfType("[]*entities.LotteryTicket")).Return(nil)
+	drawRepo.On("IncrementPot", mock.Anything, draw.ID, 2*ticketCost).Return(nil)
+	drawRepo.On("GetByID", mock.Anything, draw.ID).Return(draw, nil)
+
+	// The second subscriber has most of their balance locked and is skipped
+	poorUser := createTestUser(poorID, 5000)
+	userRepo.On("GetByDiscordID", mock.Anything, poorID).Return(poorUser, nil)
+	userRepo.On("GetLockedBalanceBreakdown", mock.Anything, poorID).Return(&entities.LockedBalanceBreakdown{InWagers: 4000}, nil)
+
+	lotteryService := NewLotteryService(
+		drawRepo, ticketRepo, winnerRepo, userRepo, wagerRepo, groupWagerRepo,
+		balanceHistoryRepo, settingsRepo, eventPublisher,
+	)
+	service := NewLotterySubscriptionService(subscriptionRepo, lotteryService)
+
+	result, err := service.ProcessSubscriptions(ctx, guildID)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Purchased, 1)
+	assert.Equal(t, richID, result.Purchased[0].DiscordID)
+	assert.Len(t, result.Skipped, 1)
+	assert.Equal(t, poorID, result.Skipped[0].DiscordID)
+	assert.Equal(t, 2, result.TicketsBought)
+	assert.Equal(t, 2*ticketCost, result.TotalCost)
+
+	userRepo.AssertNotCalled(t, "UpdateBalance", mock.Anything, poorID, mock.Anything)
+	subscriptionRepo.AssertExpectations(t)
+	balanceHistoryRepo.AssertExpectations(t)
+	drawRepo.AssertExpectations(t)
+}
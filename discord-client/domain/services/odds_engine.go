@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"gambler/discord-client/config"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// minSeededOdds is the lowest multiplier the engine will ever offer, so a winning bet always pays something
+const minSeededOdds = 1.01
+
+// OddsEngineConfig tunes how the odds engine prices a summoner's games
+type OddsEngineConfig struct {
+	SampleSize int       // Most recent resolved games the win rate is taken from
+	MinGames   int       // With fewer games than this the fallback odds are offered
+	PriorGames float64   // Games at a 50% win rate blended in, so a short hot or cold run can't swing the odds too far
+	HouseEdge  float64   // Share of the fair payout the house keeps
+	Floor      float64   // Lowest multiplier offered on either option
+	Ceiling    float64   // Highest multiplier offered on either option
+	Fallback   []float64 // Win and Loss odds offered without enough history
+}
+
+// DefaultOddsEngineConfig returns the odds engine's default tuning
+func DefaultOddsEngineConfig() OddsEngineConfig {
+	return OddsEngineConfig{
+		SampleSize: 20,
+		MinGames:   5,
+		PriorGames: 4,
+		HouseEdge:  0.05,
+		Floor:      1.2,
+		Ceiling:    4.0,
+		Fallback:   []float64{2.0, 2.0},
+	}
+}
+
+// WinLossOdds prices the Win and Loss options of a game from the summoner's wins out of their recent games.
+// The observed win rate is pulled toward 50% by the prior, turned into fair odds less the house edge and
+// clamped between the floor and ceiling.
+func (c OddsEngineConfig) WinLossOdds(wins, games int) []float64 {
+	if games < c.MinGames || games <= 0 {
+		return append([]float64(nil), c.Fallback...)
+	}
+
+	winRate := (float64(wins) + c.PriorGames/2) / (float64(games) + c.PriorGames)
+	return []float64{c.price(winRate), c.price(1 - winRate)}
+}
+
+// price turns an outcome's probability into a multiplier within the configured bounds
+func (c OddsEngineConfig) price(probability float64) float64 {
+	floor := math.Max(c.Floor, minSeededOdds)
+	ceiling := math.Max(c.Ceiling, floor)
+
+	if probability <= 0 {
+		return ceiling
+	}
+
+	odds := (1 / probability) * (1 - c.HouseEdge)
+	odds = math.Min(math.Max(odds, floor), ceiling)
+	return math.Round(odds*100) / 100
+}
+
+// oddsEngine prices house wagers from the results of past house wagers on the same player
+type oddsEngine struct {
+	groupWagerRepo interfaces.GroupWagerRepository
+	config         OddsEngineConfig
+}
+
+// NewOddsEngine creates a new odds engine, bounded by the configured seeded odds floor and ceiling
+func NewOddsEngine(groupWagerRepo interfaces.GroupWagerRepository) interfaces.OddsEngine {
+	engineConfig := DefaultOddsEngineConfig()
+	if cfg := config.Get(); cfg != nil {
+		if cfg.SeededOddsFloor > 0 {
+			engineConfig.Floor = cfg.SeededOddsFloor
+		}
+		if cfg.SeededOddsCeiling > 0 {
+			engineConfig.Ceiling = cfg.SeededOddsCeiling
+		}
+	}
+
+	return &oddsEngine{
+		groupWagerRepo: groupWagerRepo,
+		config:         engineConfig,
+	}
+}
+
+// LoLWinLossOdds returns the Win and Loss multipliers for a summoner's next game
+func (e *oddsEngine) LoLWinLossOdds(ctx context.Context, summonerName string) ([]float64, error) {
+	results, err := e.groupWagerRepo.GetSummonerGameResults(ctx, summonerName, e.config.SampleSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get summoner game results: %w", err)
+	}
+
+	stats := entities.NewPlayerGameStats(results)
+	return e.config.WinLossOdds(stats.LoLWins, stats.LoLGames), nil
+}
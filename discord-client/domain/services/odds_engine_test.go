@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOddsEngineConfig_WinLossOdds(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultOddsEngineConfig()
+
+	t.Run("no history uses fallback odds", func(t *testing.T) {
+		assert.Equal(t, []float64{2.0, 2.0}, cfg.WinLossOdds(0, 0))
+	})
+
+	t.Run("too few games uses fallback odds", func(t *testing.T) {
+		assert.Equal(t, []float64{2.0, 2.0}, cfg.WinLossOdds(4, 4))
+	})
+
+	t.Run("even record prices both sides the same", func(t *testing.T) {
+		odds := cfg.WinLossOdds(10, 20)
+		assert.Equal(t, odds[0], odds[1])
+		assert.Equal(t, 1.9, odds[0])
+	})
+
+	t.Run("100% win rate is clamped to the bounds", func(t *testing.T) {
+		odds := cfg.WinLossOdds(20, 20)
+		assert.Equal(t, cfg.Floor, odds[0], "the favourite's win pays at least the floor")
+		assert.Equal(t, cfg.Ceiling, odds[1], "the long shot pays at most the ceiling")
+	})
+
+	t.Run("0% win rate is clamped to the bounds", func(t *testing.T) {
+		odds := cfg.WinLossOdds(0, 20)
+		assert.Equal(t, cfg.Ceiling, odds[0])
+		assert.Equal(t, cfg.Floor, odds[1])
+	})
+
+	t.Run("winning record pays less on a win", func(t *testing.T) {
+		odds := cfg.WinLossOdds(12, 20)
+		assert.Less(t, odds[0], odds[1])
+		assert.Equal(t, 1.63, odds[0])
+		assert.Equal(t, 2.28, odds[1])
+	})
+
+	t.Run("guard rails hold with misconfigured bounds", func(t *testing.T) {
+		broken := cfg
+		broken.Floor = 0.5
+		broken.Ceiling = 0
+
+		for _, multiplier := range broken.WinLossOdds(20, 20) {
+			assert.Equal(t, minSeededOdds, multiplier)
+		}
+	})
+
+	t.Run("fallback is copied", func(t *testing.T) {
+		odds := cfg.WinLossOdds(0, 0)
+		odds[0] = 10
+		assert.Equal(t, 2.0, cfg.Fallback[0])
+	})
+}
+
+func TestOddsEngine_LoLWinLossOdds(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("prices from recent games", func(t *testing.T) {
+		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
+		engine := &oddsEngine{groupWagerRepo: mockGroupWagerRepo, config: DefaultOddsEngineConfig()}
+
+		results := make([]*entities.PlayerGameResult, 0, 20)
+		for i := 0; i < 20; i++ {
+			option := "Loss"
+			if i < 12 {
+				option = "Win"
+			}
+			results = append(results, &entities.PlayerGameResult{System: entities.SystemLeagueOfLegends, WinningOption: option, OptionCount: 2})
+		}
+		mockGroupWagerRepo.On("GetSummonerGameResults", ctx, "Faker", 20).Return(results, nil)
+
+		odds, err := engine.LoLWinLossOdds(ctx, "Faker")
+
+		require.NoError(t, err)
+		assert.Equal(t, []float64{1.63, 2.28}, odds)
+	})
+
+	t.Run("no history", func(t *testing.T) {
+		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
+		engine := &oddsEngine{groupWagerRepo: mockGroupWagerRepo, config: DefaultOddsEngineConfig()}
+
+		mockGroupWagerRepo.On("GetSummonerGameResults", ctx, "Newbie", 20).Return(nil, nil)
+
+		odds, err := engine.LoLWinLossOdds(ctx, "Newbie")
+
+		require.NoError(t, err)
+		assert.Equal(t, []float64{2.0, 2.0}, odds)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
+		engine := &oddsEngine{groupWagerRepo: mockGroupWagerRepo, config: DefaultOddsEngineConfig()}
+
+		mockGroupWagerRepo.On("GetSummonerGameResults", ctx, "Faker", 20).Return(nil, errors.New("db down"))
+
+		_, err := engine.LoLWinLossOdds(ctx, "Faker")
+
+		assert.ErrorContains(t, err, "failed to get summoner game results")
+	})
+}
@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+	"gambler/discord-client/domain/utils"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// parlayService implements business logic for parlays
+type parlayService struct {
+	parlayRepo         interfaces.ParlayRepository
+	groupWagerRepo     interfaces.GroupWagerRepository
+	userRepo           interfaces.UserRepository
+	balanceHistoryRepo interfaces.BalanceHistoryRepository
+	guildSettingsRepo  interfaces.GuildSettingsRepository
+	eventPublisher     interfaces.EventPublisher
+}
+
+// NewParlayService creates a new parlay service
+func NewParlayService(
+	parlayRepo interfaces.ParlayRepository,
+	groupWagerRepo interfaces.GroupWagerRepository,
+	userRepo interfaces.UserRepository,
+	balanceHistoryRepo interfaces.BalanceHistoryRepository,
+	guildSettingsRepo interfaces.GuildSettingsRepository,
+	eventPublisher interfaces.EventPublisher,
+) interfaces.ParlayService {
+	return &parlayService{
+		parlayRepo:         parlayRepo,
+		groupWagerRepo:     groupWagerRepo,
+		userRepo:           userRepo,
+		balanceHistoryRepo: balanceHistoryRepo,
+		guildSettingsRepo:  guildSettingsRepo,
+		eventPublisher:     eventPublisher,
+	}
+}
+
+// PlaceParlay reserves the stake from the user's available balance and creates a parlay
+func (s *parlayService) PlaceParlay(ctx context.Context, discordID, guildID int64, amount int64, selections []entities.ParlayLegSelection) (*entities.Parlay, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("parlay %w", entities.ErrNonPositiveAmount)
+	}
+	if len(selections) < entities.MinParlayLegs || len(selections) > entities.MaxParlayLegs {
+		return nil, fmt.Errorf("a parlay needs between %d and %d legs", entities.MinParlayLegs, entities.MaxParlayLegs)
+	}
+
+	// Betting is disabled during the guild's curfew window
+	guildSettings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	if err := guildSettings.CheckBettingCurfew(time.Now()); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: %d", entities.ErrUserNotFound, discordID)
+	}
+	if err := user.CheckGamblingBreak(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := CheckNotFrozen(time.Now(), user); err != nil {
+		return nil, err
+	}
+	if user.AvailableBalance < amount {
+		return nil, fmt.Errorf("%w: have %s available, need %s", entities.ErrInsufficientBalance, utils.FormatShortNotation(user.AvailableBalance), utils.FormatShortNotation(amount))
+	}
+
+	parlay := &entities.Parlay{
+		GuildID:   guildID,
+		DiscordID: discordID,
+		Amount:    amount,
+		Status:    entities.ParlayStatusPending,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	seen := make(map[int64]bool, len(selections))
+	for _, selection := range selections {
+		if seen[selection.GroupWagerID] {
+			return nil, fmt.Errorf("wager #%d appears more than once in the parlay", selection.GroupWagerID)
+		}
+		seen[selection.GroupWagerID] = true
+
+		leg, err := s.buildLeg(ctx, selection)
+		if err != nil {
+			return nil, err
+		}
+		parlay.Legs = append(parlay.Legs, leg)
+	}
+
+	if err := s.parlayRepo.Create(ctx, parlay); err != nil {
+		return nil, fmt.Errorf("failed to create parlay: %w", err)
+	}
+
+	return parlay, nil
+}
+
+// buildLeg validates a selection against its house wager and locks in the option's current odds
+func (s *parlayService) buildLeg(ctx context.Context, selection entities.ParlayLegSelection) (*entities.ParlayLeg, error) {
+	detail, err := s.groupWagerRepo.GetDetailByID(ctx, selection.GroupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager %d: %w", selection.GroupWagerID, err)
+	}
+	if detail == nil || detail.Wager == nil {
+		return nil, fmt.Errorf("wager #%d not found", selection.GroupWagerID)
+	}
+	if !detail.Wager.IsHouseWager() {
+		return nil, fmt.Errorf("wager #%d is not a house wager, only house wagers can be parlayed", selection.GroupWagerID)
+	}
+	if !detail.Wager.CanAcceptBets() {
+		return nil, fmt.Errorf("wager #%d is no longer accepting bets", selection.GroupWagerID)
+	}
+
+	for _, option := range detail.Options {
+		if int(option.OptionOrder)+1 == selection.OptionNumber {
+			return &entities.ParlayLeg{
+				GroupWagerID:   selection.GroupWagerID,
+				OptionID:       option.ID,
+				OddsMultiplier: option.OddsMultiplier,
+				Status:         entities.ParlayStatusPending,
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("wager #%d has no option %d", selection.GroupWagerID, selection.OptionNumber)
+}
+
+// SettleParlaysForWager settles the legs on a finished group wager and the parlays they complete
+func (s *parlayService) SettleParlaysForWager(ctx context.Context, groupWagerID int64) ([]*entities.Parlay, error) {
+	wager, err := s.groupWagerRepo.GetByID(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager: %w", err)
+	}
+	if wager == nil {
+		return nil, fmt.Errorf("group wager %d not found", groupWagerID)
+	}
+	if !wager.IsResolved() && !wager.IsCancelled() {
+		return nil, nil
+	}
+
+	// Locking the pending parlays makes settlement safe to run more than once for the same wager
+	parlays, err := s.parlayRepo.GetPendingByGroupWagerForUpdate(ctx, groupWagerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending parlays: %w", err)
+	}
+
+	now := time.Now().UTC()
+	var settled []*entities.Parlay
+	for _, parlay := range parlays {
+		for _, leg := range parlay.Legs {
+			leg.SettleFromWager(wager, now)
+		}
+
+		outcome := parlay.Outcome()
+		if outcome != entities.ParlayStatusPending {
+			if err := s.settle(ctx, parlay, outcome, now); err != nil {
+				return nil, err
+			}
+			settled = append(settled, parlay)
+		}
+
+		if err := s.parlayRepo.Update(ctx, parlay); err != nil {
+			return nil, fmt.Errorf("failed to update parlay: %w", err)
+		}
+	}
+
+	if len(settled) > 0 {
+		log.WithFields(log.Fields{
+			"groupWagerID": groupWagerID,
+			"settled":      len(settled),
+			"pending":      len(parlays) - len(settled),
+		}).Info("Settled parlays")
+	}
+
+	return settled, nil
+}
+
+// settle applies a parlay's final outcome to the user's balance. The stake was only reserved, so a
+// win credits the net profit, a loss debits the stake and a void parlay leaves the balance untouched.
+func (s *parlayService) settle(ctx context.Context, parlay *entities.Parlay, outcome entities.ParlayStatus, now time.Time) error {
+	var payout int64
+	switch outcome {
+	case entities.ParlayStatusWon:
+		payout = parlay.PotentialPayout()
+	case entities.ParlayStatusVoid:
+		payout = parlay.Amount
+	}
+
+	parlay.Status = outcome
+	parlay.PayoutAmount = &payout
+	parlay.SettledAt = &now
+
+	change := payout - parlay.Amount
+	if change == 0 {
+		return nil
+	}
+
+	user, err := s.userRepo.GetByDiscordID(ctx, parlay.DiscordID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return fmt.Errorf("user %d not found", parlay.DiscordID)
+	}
+
+	newBalance := user.Balance + change
+	if err := s.userRepo.UpdateBalance(ctx, user.DiscordID, newBalance); err != nil {
+		return fmt.Errorf("failed to update balance: %w", err)
+	}
+
+	// Legs voided at even odds can leave a winning parlay paying less than its stake
+	transactionType := entities.TransactionTypeParlayWin
+	if change < 0 {
+		transactionType = entities.TransactionTypeParlayLoss
+	}
+
+	relatedType := entities.RelatedTypeParlay
+	history := &entities.BalanceHistory{
+		DiscordID:       parlay.DiscordID,
+		GuildID:         parlay.GuildID,
+		BalanceBefore:   user.Balance,
+		BalanceAfter:    newBalance,
+		ChangeAmount:    change,
+		TransactionType: transactionType,
+		TransactionMetadata: map[string]interface{}{
+			"parlay_id":     parlay.ID,
+			"amount":        parlay.Amount,
+			"payout":        payout,
+			"combined_odds": parlay.CombinedOdds(),
+			"legs":          len(parlay.Legs),
+		},
+		RelatedID:   &parlay.ID,
+		RelatedType: &relatedType,
+	}
+	if err := utils.RecordBalanceChange(ctx, s.balanceHistoryRepo, s.eventPublisher, history); err != nil {
+		return fmt.Errorf("failed to record parlay settlement: %w", err)
+	}
+	parlay.BalanceHistoryID = &history.ID
+
+	return nil
+}
+
+// GetUserParlays returns the user's most recent parlays
+func (s *parlayService) GetUserParlays(ctx context.Context, discordID int64, limit int) ([]*entities.Parlay, error) {
+	parlays, err := s.parlayRepo.GetByUser(ctx, discordID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parlays: %w", err)
+	}
+	return parlays, nil
+}
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// parlayServiceMocks groups the mocks needed by the parlay service
+type parlayServiceMocks struct {
+	parlayRepo         *testhelpers.MockParlayRepository
+	groupWagerRepo     *testhelpers.MockGroupWagerRepository
+	userRepo           *testhelpers.MockUserRepository
+	balanceHistoryRepo *testhelpers.MockBalanceHistoryRepository
+	guildSettingsRepo  *testhelpers.MockGuildSettingsRepository
+	eventPublisher     *testhelpers.MockEventPublisher
+}
+
+func newParlayServiceWithMocks() (*parlayServiceMocks, *parlayService) {
+	mocks := &parlayServiceMocks{
+		parlayRepo:         new(testhelpers.MockParlayRepository),
+		groupWagerRepo:     new(testhelpers.MockGroupWagerRepository),
+		userRepo:           new(testhelpers.MockUserRepository),
+		balanceHistoryRepo: new(testhelpers.MockBalanceHistoryRepository),
+		guildSettingsRepo:  new(testhelpers.MockGuildSettingsRepository),
+		eventPublisher:     new(testhelpers.MockEventPublisher),
+	}
+	service := NewParlayService(
+		mocks.parlayRepo,
+		mocks.groupWagerRepo,
+		mocks.userRepo,
+		mocks.balanceHistoryRepo,
+		mocks.guildSettingsRepo,
+		mocks.eventPublisher,
+	).(*parlayService)
+	return mocks, service
+}
+
+// createTestHouseWagerDetail creates an active house wager with two options at the given odds
+func createTestHouseWagerDetail(id int64, odds1, odds2 float64) *entities.GroupWagerDetail {
+	votingEndsAt := time.Now().Add(time.Hour)
+	return &entities.GroupWagerDetail{
+		Wager: &entities.GroupWager{
+			ID:           id,
+			GuildID:      123456789,
+			State:        entities.GroupWagerStateActive,
+			WagerType:    entities.GroupWagerTypeHouse,
+			VotingEndsAt: &votingEndsAt,
+		},
+		Options: []*entities.GroupWagerOption{
+			{ID: id * 10, GroupWagerID: id, OptionOrder: 0, OddsMultiplier: odds1},
+			{ID: id*10 + 1, GroupWagerID: id, OptionOrder: 1, OddsMultiplier: odds2},
+		},
+	}
+}
+
+func createTestParlay(id, discordID int64, legs ...*entities.ParlayLeg) *entities.Parlay {
+	return &entities.Parlay{
+		ID:        id,
+		GuildID:   123456789,
+		DiscordID: discordID,
+		Amount:    1000,
+		Status:    entities.ParlayStatusPending,
+		Legs:      legs,
+	}
+}
+
+func TestParlayService_PlaceParlay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("locks in each option's odds", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newParlayServiceWithMocks()
+		ctx := context.Background()
+
+		mocks.guildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(123456789)).Return(&entities.GuildSettings{GuildID: 123456789}, nil)
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(111)).Return(createTestUser(111, 5000), nil)
+		mocks.groupWagerRepo.On("GetDetailByID", ctx, int64(1)).Return(createTestHouseWagerDetail(1, 2.0, 1.8), nil)
+		mocks.groupWagerRepo.On("GetDetailByID", ctx, int64(2)).Return(createTestHouseWagerDetail(2, 1.5, 2.5), nil)
+		mocks.parlayRepo.On("Create", ctx, mock.MatchedBy(func(p *entities.Parlay) bool {
+			return p.Amount == 1000 && len(p.Legs) == 2 && p.Legs[0].OptionID == 10 && p.Legs[1].OptionID == 21
+		})).Return(nil)
+
+		parlay, err := service.PlaceParlay(ctx, 111, 123456789, 1000, []entities.ParlayLegSelection{
+			{GroupWagerID: 1, OptionNumber: 1},
+			{GroupWagerID: 2, OptionNumber: 2},
+		})
+		require.NoError(t, err)
+		assert.InDelta(t, 5.0, parlay.CombinedOdds(), 1e-9)
+		assert.Equal(t, int64(5000), parlay.PotentialPayout())
+
+		mocks.parlayRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects the same wager twice", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newParlayServiceWithMocks()
+		ctx := context.Background()
+
+		mocks.guildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(123456789)).Return(&entities.GuildSettings{GuildID: 123456789}, nil)
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(111)).Return(createTestUser(111, 5000), nil)
+		mocks.groupWagerRepo.On("GetDetailByID", ctx, int64(1)).Return(createTestHouseWagerDetail(1, 2.0, 1.8), nil)
+
+		_, err := service.PlaceParlay(ctx, 111, 123456789, 1000, []entities.ParlayLegSelection{
+			{GroupWagerID: 1, OptionNumber: 1},
+			{GroupWagerID: 1, OptionNumber: 2},
+		})
+		assert.ErrorContains(t, err, "more than once")
+		mocks.parlayRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects pool wagers", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newParlayServiceWithMocks()
+		ctx := context.Background()
+
+		pool := createTestHouseWagerDetail(2, 0, 0)
+		pool.Wager.WagerType = entities.GroupWagerTypePool
+		mocks.guildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(123456789)).Return(&entities.GuildSettings{GuildID: 123456789}, nil)
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(111)).Return(createTestUser(111, 5000), nil)
+		mocks.groupWagerRepo.On("GetDetailByID", ctx, int64(1)).Return(createTestHouseWagerDetail(1, 2.0, 1.8), nil)
+		mocks.groupWagerRepo.On("GetDetailByID", ctx, int64(2)).Return(pool, nil)
+
+		_, err := service.PlaceParlay(ctx, 111, 123456789, 1000, []entities.ParlayLegSelection{
+			{GroupWagerID: 1, OptionNumber: 1},
+			{GroupWagerID: 2, OptionNumber: 1},
+		})
+		assert.ErrorContains(t, err, "not a house wager")
+	})
+
+	t.Run("requires available balance", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newParlayServiceWithMocks()
+		ctx := context.Background()
+
+		mocks.guildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(123456789)).Return(&entities.GuildSettings{GuildID: 123456789}, nil)
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(111)).Return(createTestUser(111, 500), nil)
+
+		_, err := service.PlaceParlay(ctx, 111, 123456789, 1000, []entities.ParlayLegSelection{
+			{GroupWagerID: 1, OptionNumber: 1},
+			{GroupWagerID: 2, OptionNumber: 1},
+		})
+		assert.ErrorContains(t, err, "insufficient balance")
+	})
+
+	t.Run("requires at least two legs", func(t *testing.T) {
+		t.Parallel()
+
+		_, service := newParlayServiceWithMocks()
+
+		_, err := service.PlaceParlay(context.Background(), 111, 123456789, 1000, []entities.ParlayLegSelection{
+			{GroupWagerID: 1, OptionNumber: 1},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestParlayService_SettleParlaysForWager(t *testing.T) {
+	t.Parallel()
+
+	t.Run("final winning leg pays the net payout", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newParlayServiceWithMocks()
+		ctx := context.Background()
+
+		winningOptionID := int64(10)
+		mocks.groupWagerRepo.On("GetByID", ctx, int64(1)).
+			Return(&entities.GroupWager{ID: 1, State: entities.GroupWagerStateResolved, WinningOptionID: &winningOptionID}, nil)
+		parlay := createTestParlay(5, 111,
+			&entities.ParlayLeg{GroupWagerID: 1, OptionID: 10, OddsMultiplier: 2.0, Status: entities.ParlayStatusPending},
+			&entities.ParlayLeg{GroupWagerID: 2, OptionID: 20, OddsMultiplier: 1.5, Status: entities.ParlayStatusWon},
+		)
+		mocks.parlayRepo.On("GetPendingByGroupWagerForUpdate", ctx, int64(1)).Return([]*entities.Parlay{parlay}, nil)
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(111)).Return(createTestUser(111, 10000), nil)
+		mocks.userRepo.On("UpdateBalance", ctx, int64(111), int64(12000)).Return(nil)
+		mocks.balanceHistoryRepo.On("Record", ctx, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+			return h.TransactionType == entities.TransactionTypeParlayWin && h.ChangeAmount == 2000
+		})).Return(nil)
+		mocks.eventPublisher.On("Publish", mock.Anything).Return(nil)
+		mocks.parlayRepo.On("Update", ctx, mock.MatchedBy(func(p *entities.Parlay) bool {
+			return p.Status == entities.ParlayStatusWon && *p.PayoutAmount == 3000 && p.BalanceHistoryID != nil
+		})).Return(nil)
+
+		settled, err := service.SettleParlaysForWager(ctx, 1)
+		require.NoError(t, err)
+		assert.Len(t, settled, 1)
+
+		mocks.userRepo.AssertExpectations(t)
+		mocks.balanceHistoryRepo.AssertExpectations(t)
+		mocks.parlayRepo.AssertExpectations(t)
+	})
+
+	t.Run("losing leg collects the stake", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newParlayServiceWithMocks()
+		ctx := context.Background()
+
+		winningOptionID := int64(11)
+		mocks.groupWagerRepo.On("GetByID", ctx, int64(1)).
+			Return(&entities.GroupWager{ID: 1, State: entities.GroupWagerStateResolved, WinningOptionID: &winningOptionID}, nil)
+		parlay := createTestParlay(5, 111,
+			&entities.ParlayLeg{GroupWagerID: 1, OptionID: 10, OddsMultiplier: 2.0, Status: entities.ParlayStatusPending},
+			&entities.ParlayLeg{GroupWagerID: 2, OptionID: 20, OddsMultiplier: 1.5, Status: entities.ParlayStatusPending},
+		)
+		mocks.parlayRepo.On("GetPendingByGroupWagerForUpdate", ctx, int64(1)).Return([]*entities.Parlay{parlay}, nil)
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(111)).Return(createTestUser(111, 10000), nil)
+		mocks.userRepo.On("UpdateBalance", ctx, int64(111), int64(9000)).Return(nil)
+		mocks.balanceHistoryRepo.On("Record", ctx, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+			return h.TransactionType == entities.TransactionTypeParlayLoss && h.ChangeAmount == -1000
+		})).Return(nil)
+		mocks.eventPublisher.On("Publish", mock.Anything).Return(nil)
+		mocks.parlayRepo.On("Update", ctx, mock.MatchedBy(func(p *entities.Parlay) bool {
+			return p.Status == entities.ParlayStatusLost && *p.PayoutAmount == 0
+		})).Return(nil)
+
+		settled, err := service.SettleParlaysForWager(ctx, 1)
+		require.NoError(t, err)
+		assert.Len(t, settled, 1)
+
+		mocks.balanceHistoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("cancelled game voids the leg and keeps the parlay open", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newParlayServiceWithMocks()
+		ctx := context.Background()
+
+		mocks.groupWagerRepo.On("GetByID", ctx, int64(1)).
+			Return(&entities.GroupWager{ID: 1, State: entities.GroupWagerStateCancelled}, nil)
+		parlay := createTestParlay(5, 111,
+			&entities.ParlayLeg{GroupWagerID: 1, OptionID: 10, OddsMultiplier: 2.0, Status: entities.ParlayStatusPending},
+			&entities.ParlayLeg{GroupWagerID: 2, OptionID: 20, OddsMultiplier: 1.5, Status: entities.ParlayStatusPending},
+		)
+		mocks.parlayRepo.On("GetPendingByGroupWagerForUpdate", ctx, int64(1)).Return([]*entities.Parlay{parlay}, nil)
+		mocks.parlayRepo.On("Update", ctx, mock.MatchedBy(func(p *entities.Parlay) bool {
+			return p.IsPending() && p.Legs[0].Status == entities.ParlayStatusVoid
+		})).Return(nil)
+
+		settled, err := service.SettleParlaysForWager(ctx, 1)
+		require.NoError(t, err)
+		assert.Empty(t, settled)
+		assert.Equal(t, int64(1500), parlay.PotentialPayout())
+
+		mocks.userRepo.AssertNotCalled(t, "UpdateBalance", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("all legs voided returns the stake without a transaction", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newParlayServiceWithMocks()
+		ctx := context.Background()
+
+		mocks.groupWagerRepo.On("GetByID", ctx, int64(1)).
+			Return(&entities.GroupWager{ID: 1, State: entities.GroupWagerStateCancelled}, nil)
+		parlay := createTestParlay(5, 111,
+			&entities.ParlayLeg{GroupWagerID: 1, OptionID: 10, OddsMultiplier: 2.0, Status: entities.ParlayStatusPending},
+			&entities.ParlayLeg{GroupWagerID: 2, OptionID: 20, OddsMultiplier: 1.5, Status: entities.ParlayStatusVoid},
+		)
+		mocks.parlayRepo.On("GetPendingByGroupWagerForUpdate", ctx, int64(1)).Return([]*entities.Parlay{parlay}, nil)
+		mocks.parlayRepo.On("Update", ctx, mock.MatchedBy(func(p *entities.Parlay) bool {
+			return p.Status == entities.ParlayStatusVoid && *p.PayoutAmount == 1000 && p.BalanceHistoryID == nil
+		})).Return(nil)
+
+		settled, err := service.SettleParlaysForWager(ctx, 1)
+		require.NoError(t, err)
+		assert.Len(t, settled, 1)
+
+		mocks.balanceHistoryRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+	})
+
+	t.Run("active wager settles nothing", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newParlayServiceWithMocks()
+		ctx := context.Background()
+
+		mocks.groupWagerRepo.On("GetByID", ctx, int64(1)).
+			Return(&entities.GroupWager{ID: 1, State: entities.GroupWagerStateActive}, nil)
+
+		settled, err := service.SettleParlaysForWager(ctx, 1)
+		require.NoError(t, err)
+		assert.Empty(t, settled)
+		mocks.parlayRepo.AssertNotCalled(t, "GetPendingByGroupWagerForUpdate", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// riotAccountLinkService implements business logic for linking users to their Riot accounts
+type riotAccountLinkService struct {
+	linkRepo interfaces.RiotAccountLinkRepository
+}
+
+// NewRiotAccountLinkService creates a new Riot account link service
+func NewRiotAccountLinkService(linkRepo interfaces.RiotAccountLinkRepository) interfaces.RiotAccountLinkService {
+	return &riotAccountLinkService{
+		linkRepo: linkRepo,
+	}
+}
+
+// GetLink returns the user's linked Riot account, or nil if they have not linked one
+func (s *riotAccountLinkService) GetLink(ctx context.Context, discordID int64) (*entities.RiotAccountLink, error) {
+	link, err := s.linkRepo.GetByDiscordID(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get riot account link: %w", err)
+	}
+	return link, nil
+}
+
+// Link links the user to a Riot account, replacing any account they linked before
+func (s *riotAccountLinkService) Link(ctx context.Context, discordID int64, gameName, tagLine string) (*entities.RiotAccountLink, error) {
+	if len(tagLine) > entities.MaxRiotTagLineLength {
+		return nil, fmt.Errorf("tag line can be at most %d characters", entities.MaxRiotTagLineLength)
+	}
+
+	existing, err := s.linkRepo.GetByRiotID(ctx, gameName, tagLine)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check riot account link: %w", err)
+	}
+	if existing != nil && existing.DiscordID != discordID {
+		return nil, entities.ErrRiotAccountLinkedElsewhere
+	}
+
+	link := &entities.RiotAccountLink{
+		DiscordID: discordID,
+		GameName:  gameName,
+		TagLine:   tagLine,
+	}
+	if err := s.linkRepo.Upsert(ctx, link); err != nil {
+		return nil, fmt.Errorf("failed to save riot account link: %w", err)
+	}
+
+	return link, nil
+}
+
+// Unlink removes the user's linked Riot account. Returns false if they had none.
+func (s *riotAccountLinkService) Unlink(ctx context.Context, discordID int64) (bool, error) {
+	removed, err := s.linkRepo.Delete(ctx, discordID)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove riot account link: %w", err)
+	}
+	return removed, nil
+}
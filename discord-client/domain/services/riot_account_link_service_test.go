@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRiotAccountLinkService_Link(t *testing.T) {
+	t.Parallel()
+
+	t.Run("links an unclaimed account", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		linkRepo := new(testhelpers.MockRiotAccountLinkRepository)
+		linkRepo.On("GetByRiotID", ctx, "Faker", "KR1").Return(nil, nil)
+		linkRepo.On("Upsert", ctx, mock.MatchedBy(func(link *entities.RiotAccountLink) bool {
+			return link.DiscordID == 123 && link.GameName == "Faker" && link.TagLine == "KR1"
+		})).Return(nil)
+
+		link, err := NewRiotAccountLinkService(linkRepo).Link(ctx, 123, "Faker", "KR1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Faker#KR1", link.RiotID())
+		linkRepo.AssertExpectations(t)
+	})
+
+	t.Run("relinking your own account", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		linkRepo := new(testhelpers.MockRiotAccountLinkRepository)
+		linkRepo.On("GetByRiotID", ctx, "faker", "kr1").Return(&entities.RiotAccountLink{DiscordID: 123, GameName: "Faker", TagLine: "KR1"}, nil)
+		linkRepo.On("Upsert", ctx, mock.Anything).Return(nil)
+
+		_, err := NewRiotAccountLinkService(linkRepo).Link(ctx, 123, "faker", "kr1")
+
+		assert.NoError(t, err)
+		linkRepo.AssertExpectations(t)
+	})
+
+	t.Run("account claimed by another user", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		linkRepo := new(testhelpers.MockRiotAccountLinkRepository)
+		linkRepo.On("GetByRiotID", ctx, "Faker", "KR1").Return(&entities.RiotAccountLink{DiscordID: 456, GameName: "Faker", TagLine: "KR1"}, nil)
+
+		_, err := NewRiotAccountLinkService(linkRepo).Link(ctx, 123, "Faker", "KR1")
+
+		assert.ErrorIs(t, err, entities.ErrRiotAccountLinkedElsewhere)
+		linkRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+	})
+
+	t.Run("tag line too long", func(t *testing.T) {
+		t.Parallel()
+
+		linkRepo := new(testhelpers.MockRiotAccountLinkRepository)
+
+		_, err := NewRiotAccountLinkService(linkRepo).Link(context.Background(), 123, "Faker", "KOREA1")
+
+		assert.Error(t, err)
+		linkRepo.AssertNotCalled(t, "GetByRiotID", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestRiotAccountLinkService_Unlink(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	linkRepo := new(testhelpers.MockRiotAccountLinkRepository)
+	linkRepo.On("Delete", ctx, int64(123)).Return(true, nil)
+	linkRepo.On("Delete", ctx, int64(456)).Return(false, nil)
+
+	service := NewRiotAccountLinkService(linkRepo)
+
+	removed, err := service.Unlink(ctx, 123)
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	removed, err = service.Unlink(ctx, 456)
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+	"gambler/discord-client/domain/utils"
+)
+
+// savingsService implements business logic for savings deposits
+type savingsService struct {
+	savingsRepo        interfaces.SavingsDepositRepository
+	userRepo           interfaces.UserRepository
+	balanceHistoryRepo interfaces.BalanceHistoryRepository
+	guildSettingsRepo  interfaces.GuildSettingsRepository
+	eventPublisher     interfaces.EventPublisher
+}
+
+// NewSavingsService creates a new savings service
+func NewSavingsService(
+	savingsRepo interfaces.SavingsDepositRepository,
+	userRepo interfaces.UserRepository,
+	balanceHistoryRepo interfaces.BalanceHistoryRepository,
+	guildSettingsRepo interfaces.GuildSettingsRepository,
+	eventPublisher interfaces.EventPublisher,
+) interfaces.SavingsService {
+	return &savingsService{
+		savingsRepo:        savingsRepo,
+		userRepo:           userRepo,
+		balanceHistoryRepo: balanceHistoryRepo,
+		guildSettingsRepo:  guildSettingsRepo,
+		eventPublisher:     eventPublisher,
+	}
+}
+
+// Deposit locks part of a user's available balance for the given number of weeks
+func (s *savingsService) Deposit(ctx context.Context, discordID, guildID int64, amount int64, termWeeks int) (*entities.SavingsDeposit, error) {
+	if amount < entities.MinSavingsDeposit {
+		return nil, fmt.Errorf("minimum savings deposit is %d bits", entities.MinSavingsDeposit)
+	}
+	if termWeeks < entities.MinSavingsTermWeeks || termWeeks > entities.MaxSavingsTermWeeks {
+		return nil, fmt.Errorf("savings term must be between %d and %d weeks", entities.MinSavingsTermWeeks, entities.MaxSavingsTermWeeks)
+	}
+
+	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, entities.ErrUserNotFound
+	}
+	if err := CheckNotFrozen(time.Now(), user); err != nil {
+		return nil, err
+	}
+
+	if user.AvailableBalance < amount {
+		return nil, fmt.Errorf("%w: have %d available, need %d", entities.ErrInsufficientBalance, user.AvailableBalance, amount)
+	}
+
+	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+
+	deposit, err := entities.NewSavingsDeposit(guildID, discordID, amount, termWeeks, settings.GetSavingsBonusPercent(), time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.savingsRepo.Create(ctx, deposit); err != nil {
+		return nil, fmt.Errorf("failed to create savings deposit: %w", err)
+	}
+
+	return deposit, nil
+}
+
+// Withdraw releases a deposit, forfeiting the bonus if it has not matured yet
+func (s *savingsService) Withdraw(ctx context.Context, discordID int64, depositID int64) (*entities.SavingsDeposit, error) {
+	deposit, err := s.savingsRepo.GetByIDForUpdate(ctx, depositID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get savings deposit: %w", err)
+	}
+	if deposit == nil || deposit.DiscordID != discordID {
+		return nil, entities.ErrSavingsDepositNotFound
+	}
+	if !deposit.IsLocked() {
+		return nil, fmt.Errorf("savings deposit %d has already been released", depositID)
+	}
+
+	now := time.Now().UTC()
+	if deposit.HasMatured(now) {
+		if err := s.payOut(ctx, deposit, now); err != nil {
+			return nil, err
+		}
+		return deposit, nil
+	}
+
+	deposit.Status = entities.SavingsDepositStatusWithdrawn
+	deposit.ClosedAt = &now
+	if err := s.savingsRepo.Update(ctx, deposit); err != nil {
+		return nil, fmt.Errorf("failed to update savings deposit: %w", err)
+	}
+
+	return deposit, nil
+}
+
+// GetLockedDeposits returns the user's locked deposits ordered by maturity
+func (s *savingsService) GetLockedDeposits(ctx context.Context, discordID int64) ([]*entities.SavingsDeposit, error) {
+	deposits, err := s.savingsRepo.GetLockedByUser(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get savings deposits: %w", err)
+	}
+	return deposits, nil
+}
+
+// MatureDeposit releases a matured deposit and credits its bonus
+func (s *savingsService) MatureDeposit(ctx context.Context, depositID int64) (*entities.SavingsDeposit, error) {
+	// Lock the row so a concurrent withdrawal cannot release the deposit twice
+	deposit, err := s.savingsRepo.GetByIDForUpdate(ctx, depositID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get savings deposit: %w", err)
+	}
+	if deposit == nil {
+		return nil, entities.ErrSavingsDepositNotFound
+	}
+
+	now := time.Now().UTC()
+	if !deposit.HasMatured(now) {
+		return deposit, nil
+	}
+
+	if err := s.payOut(ctx, deposit, now); err != nil {
+		return nil, err
+	}
+
+	return deposit, nil
+}
+
+// payOut marks a locked deposit as matured and credits its bonus with a dedicated transaction
+func (s *savingsService) payOut(ctx context.Context, deposit *entities.SavingsDeposit, now time.Time) error {
+	if deposit.BonusAmount > 0 {
+		user, err := s.userRepo.GetByDiscordID(ctx, deposit.DiscordID)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if user == nil {
+			return entities.ErrUserNotFound
+		}
+
+		newBalance := user.Balance + deposit.BonusAmount
+		if err := s.userRepo.UpdateBalance(ctx, user.DiscordID, newBalance); err != nil {
+			return fmt.Errorf("failed to update balance: %w", err)
+		}
+
+		history := &entities.BalanceHistory{
+			DiscordID:       deposit.DiscordID,
+			GuildID:         deposit.GuildID,
+			BalanceBefore:   user.Balance,
+			BalanceAfter:    newBalance,
+			ChangeAmount:    deposit.BonusAmount,
+			TransactionType: entities.TransactionTypeSavingsBonus,
+			TransactionMetadata: map[string]interface{}{
+				"deposit_id": deposit.ID,
+				"amount":     deposit.Amount,
+				"term_weeks": deposit.TermWeeks,
+			},
+		}
+		if err := utils.RecordBalanceChange(ctx, s.balanceHistoryRepo, s.eventPublisher, history); err != nil {
+			return fmt.Errorf("failed to record savings bonus: %w", err)
+		}
+		deposit.BalanceHistoryID = &history.ID
+	}
+
+	deposit.Status = entities.SavingsDepositStatusMatured
+	deposit.ClosedAt = &now
+	if err := s.savingsRepo.Update(ctx, deposit); err != nil {
+		return fmt.Errorf("failed to update savings deposit: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// savingsServiceMocks groups the mocks needed by the savings service
+type savingsServiceMocks struct {
+	savingsRepo        *testhelpers.MockSavingsDepositRepository
+	userRepo           *testhelpers.MockUserRepository
+	balanceHistoryRepo *testhelpers.MockBalanceHistoryRepository
+	guildSettingsRepo  *testhelpers.MockGuildSettingsRepository
+	eventPublisher     *testhelpers.MockEventPublisher
+}
+
+func newSavingsServiceWithMocks() (*savingsServiceMocks, *savingsService) {
+	mocks := &savingsServiceMocks{
+		savingsRepo:        new(testhelpers.MockSavingsDepositRepository),
+		userRepo:           new(testhelpers.MockUserRepository),
+		balanceHistoryRepo: new(testhelpers.MockBalanceHistoryRepository),
+		guildSettingsRepo:  new(testhelpers.MockGuildSettingsRepository),
+		eventPublisher:     new(testhelpers.MockEventPublisher),
+	}
+	service := NewSavingsService(
+		mocks.savingsRepo,
+		mocks.userRepo,
+		mocks.balanceHistoryRepo,
+		mocks.guildSettingsRepo,
+		mocks.eventPublisher,
+	).(*savingsService)
+	return mocks, service
+}
+
+func createTestSavingsDeposit(id, discordID int64, maturesAt time.Time) *entities.SavingsDeposit {
+	return &entities.SavingsDeposit{
+		ID:          id,
+		GuildID:     123456789,
+		DiscordID:   discordID,
+		Amount:      10000,
+		TermWeeks:   2,
+		BonusAmount: 400,
+		Status:      entities.SavingsDepositStatusLocked,
+		LockedAt:    maturesAt.AddDate(0, 0, -14),
+		MaturesAt:   maturesAt,
+	}
+}
+
+func TestSavingsService_Deposit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("locks bits at the guild bonus rate", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newSavingsServiceWithMocks()
+		ctx := context.Background()
+		percent := 5
+
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(111)).Return(createTestUser(111, 20000), nil)
+		mocks.guildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(123456789)).
+			Return(&entities.GuildSettings{GuildID: 123456789, SavingsBonusPercent: &percent}, nil)
+		mocks.savingsRepo.On("Create", ctx, mock.MatchedBy(func(d *entities.SavingsDeposit) bool {
+			return d.Amount == 10000 && d.TermWeeks == 3 && d.BonusAmount == 1500 && d.IsLocked()
+		})).Return(nil)
+
+		deposit, err := service.Deposit(ctx, 111, 123456789, 10000, 3)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1500), deposit.BonusAmount)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, 21), deposit.MaturesAt, time.Minute)
+
+		mocks.savingsRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects deposits above available balance", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newSavingsServiceWithMocks()
+		ctx := context.Background()
+
+		user := createTestUser(111, 20000)
+		user.AvailableBalance = 5000
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(111)).Return(user, nil)
+
+		_, err := service.Deposit(ctx, 111, 123456789, 10000, 1)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "insufficient balance")
+
+		mocks.savingsRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects terms outside 1 to 4 weeks", func(t *testing.T) {
+		t.Parallel()
+
+		_, service := newSavingsServiceWithMocks()
+
+		_, err := service.Deposit(context.Background(), 111, 123456789, 10000, 5)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "between 1 and 4 weeks")
+	})
+}
+
+func TestSavingsService_Withdraw(t *testing.T) {
+	t.Parallel()
+
+	t.Run("early withdrawal forfeits the bonus", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newSavingsServiceWithMocks()
+		ctx := context.Background()
+
+		deposit := createTestSavingsDeposit(7, 111, time.Now().Add(48*time.Hour))
+		mocks.savingsRepo.On("GetByIDForUpdate", ctx, int64(7)).Return(deposit, nil)
+		mocks.savingsRepo.On("Update", ctx, mock.MatchedBy(func(d *entities.SavingsDeposit) bool {
+			return d.Status == entities.SavingsDepositStatusWithdrawn && d.ClosedAt != nil && d.BalanceHistoryID == nil
+		})).Return(nil)
+
+		result, err := service.Withdraw(ctx, 111, 7)
+		require.NoError(t, err)
+		assert.Equal(t, entities.SavingsDepositStatusWithdrawn, result.Status)
+
+		mocks.userRepo.AssertNotCalled(t, "UpdateBalance", mock.Anything, mock.Anything, mock.Anything)
+		mocks.balanceHistoryRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+		mocks.savingsRepo.AssertExpectations(t)
+	})
+
+	t.Run("matured deposit pays the bonus", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newSavingsServiceWithMocks()
+		ctx := context.Background()
+
+		deposit := createTestSavingsDeposit(7, 111, time.Now().Add(-time.Hour))
+		mocks.savingsRepo.On("GetByIDForUpdate", ctx, int64(7)).Return(deposit, nil)
+		mocks.userRepo.On("GetByDiscordID", ctx, int64(111)).Return(createTestUser(111, 20000), nil)
+		mocks.userRepo.On("UpdateBalance", ctx, int64(111), int64(20400)).Return(nil)
+		mocks.balanceHistoryRepo.On("Record", ctx, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+			return h.TransactionType == entities.TransactionTypeSavingsBonus && h.ChangeAmount == 400
+		})).Return(nil)
+		mocks.eventPublisher.On("Publish", mock.Anything).Return(nil)
+		mocks.savingsRepo.On("Update", ctx, mock.MatchedBy(func(d *entities.SavingsDeposit) bool {
+			return d.Status == entities.SavingsDepositStatusMatured && d.BalanceHistoryID != nil
+		})).Return(nil)
+
+		result, err := service.Withdraw(ctx, 111, 7)
+		require.NoError(t, err)
+		assert.Equal(t, entities.SavingsDepositStatusMatured, result.Status)
+
+		mocks.userRepo.AssertExpectations(t)
+		mocks.balanceHistoryRepo.AssertExpectations(t)
+		mocks.savingsRepo.AssertExpectations(t)
+	})
+
+	t.Run("another user's deposit is not found", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newSavingsServiceWithMocks()
+		ctx := context.Background()
+
+		deposit := createTestSavingsDeposit(7, 222, time.Now().Add(48*time.Hour))
+		mocks.savingsRepo.On("GetByIDForUpdate", ctx, int64(7)).Return(deposit, nil)
+
+		_, err := service.Withdraw(ctx, 111, 7)
+		assert.ErrorIs(t, err, entities.ErrSavingsDepositNotFound)
+	})
+}
+
+func TestSavingsService_MatureDeposit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("skips deposits that are no longer locked", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newSavingsServiceWithMocks()
+		ctx := context.Background()
+
+		deposit := createTestSavingsDeposit(7, 111, time.Now().Add(-time.Hour))
+		deposit.Status = entities.SavingsDepositStatusWithdrawn
+		mocks.savingsRepo.On("GetByIDForUpdate", ctx, int64(7)).Return(deposit, nil)
+
+		result, err := service.MatureDeposit(ctx, 7)
+		require.NoError(t, err)
+		assert.Equal(t, entities.SavingsDepositStatusWithdrawn, result.Status)
+
+		mocks.savingsRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("zero bonus releases without a transaction", func(t *testing.T) {
+		t.Parallel()
+
+		mocks, service := newSavingsServiceWithMocks()
+		ctx := context.Background()
+
+		deposit := createTestSavingsDeposit(7, 111, time.Now().Add(-time.Hour))
+		deposit.BonusAmount = 0
+		mocks.savingsRepo.On("GetByIDForUpdate", ctx, int64(7)).Return(deposit, nil)
+		mocks.savingsRepo.On("Update", ctx, mock.MatchedBy(func(d *entities.SavingsDeposit) bool {
+			return d.Status == entities.SavingsDepositStatusMatured && d.BalanceHistoryID == nil
+		})).Return(nil)
+
+		_, err := service.MatureDeposit(ctx, 7)
+		require.NoError(t, err)
+
+		mocks.balanceHistoryRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+		mocks.savingsRepo.AssertExpectations(t)
+	})
+}
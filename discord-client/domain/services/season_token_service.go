@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// seasonTokenService implements business logic for the guild's seasonal secondary currency
+type seasonTokenService struct {
+	seasonTokenRepo   interfaces.SeasonTokenRepository
+	guildSettingsRepo interfaces.GuildSettingsRepository
+}
+
+// NewSeasonTokenService creates a new season token service
+func NewSeasonTokenService(
+	seasonTokenRepo interfaces.SeasonTokenRepository,
+	guildSettingsRepo interfaces.GuildSettingsRepository,
+) interfaces.SeasonTokenService {
+	return &seasonTokenService{
+		seasonTokenRepo:   seasonTokenRepo,
+		guildSettingsRepo: guildSettingsRepo,
+	}
+}
+
+// GetBalance returns the user's token balance for the guild's current season
+func (s *seasonTokenService) GetBalance(ctx context.Context, guildID, discordID int64) (*entities.SeasonTokenBalance, error) {
+	settings, err := s.enabledSettings(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	season := settings.GetCurrentSeason()
+	balance, err := s.seasonTokenRepo.GetBalance(ctx, discordID, season)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season token balance: %w", err)
+	}
+
+	return &entities.SeasonTokenBalance{
+		DiscordID: discordID,
+		GuildID:   guildID,
+		Season:    season,
+		Balance:   balance,
+		TokenName: settings.GetSeasonTokenName(),
+	}, nil
+}
+
+// Credit adds tokens to the user's current season balance and records the movement
+func (s *seasonTokenService) Credit(ctx context.Context, guildID, discordID int64, amount int64, metadata map[string]interface{}) (*entities.SeasonTokenHistory, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("season token %w", entities.ErrNonPositiveAmount)
+	}
+
+	settings, err := s.enabledSettings(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	season := settings.GetCurrentSeason()
+	newBalance, err := s.seasonTokenRepo.Credit(ctx, discordID, season, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to credit season tokens: %w", err)
+	}
+
+	return s.record(ctx, discordID, season, newBalance-amount, newBalance, entities.TransactionTypeSeasonTokenEarned, metadata)
+}
+
+// Debit spends tokens from the user's current season balance and records the movement
+func (s *seasonTokenService) Debit(ctx context.Context, guildID, discordID int64, amount int64, metadata map[string]interface{}) (*entities.SeasonTokenHistory, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("season token %w", entities.ErrNonPositiveAmount)
+	}
+
+	settings, err := s.enabledSettings(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	season := settings.GetCurrentSeason()
+	newBalance, ok, err := s.seasonTokenRepo.Debit(ctx, discordID, season, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to debit season tokens: %w", err)
+	}
+	if !ok {
+		return nil, entities.ErrInsufficientSeasonTokens
+	}
+
+	return s.record(ctx, discordID, season, newBalance+amount, newBalance, entities.TransactionTypeSeasonTokenSpent, metadata)
+}
+
+// EarnFromWin credits the tokens earned by a win of bitsWon
+func (s *seasonTokenService) EarnFromWin(ctx context.Context, guildID, discordID int64, bitsWon int64, source entities.TransactionType) (*entities.SeasonTokenHistory, error) {
+	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+
+	tokens := settings.SeasonTokensForWin(bitsWon)
+	if tokens == 0 {
+		return nil, nil
+	}
+
+	return s.Credit(ctx, guildID, discordID, tokens, map[string]interface{}{
+		"source":   string(source),
+		"bits_won": bitsWon,
+	})
+}
+
+// GetHistory returns the user's most recent token movements in the current season, newest first
+func (s *seasonTokenService) GetHistory(ctx context.Context, guildID, discordID int64, limit int) ([]*entities.SeasonTokenHistory, error) {
+	settings, err := s.enabledSettings(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.seasonTokenRepo.GetHistory(ctx, discordID, settings.GetCurrentSeason(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season token history: %w", err)
+	}
+
+	return history, nil
+}
+
+// StartNewSeason closes the current season. Old balances stay in the table under their season.
+func (s *seasonTokenService) StartNewSeason(ctx context.Context, guildID int64) (int, error) {
+	settings, err := s.enabledSettings(ctx, guildID)
+	if err != nil {
+		return 0, err
+	}
+
+	settings.CurrentSeason = settings.GetCurrentSeason() + 1
+	if err := s.guildSettingsRepo.UpdateGuildSettings(ctx, settings); err != nil {
+		return 0, fmt.Errorf("failed to update guild settings: %w", err)
+	}
+
+	return settings.CurrentSeason, nil
+}
+
+// enabledSettings returns the guild's settings, or ErrSeasonTokensDisabled if it has no seasonal currency
+func (s *seasonTokenService) enabledSettings(ctx context.Context, guildID int64) (*entities.GuildSettings, error) {
+	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	if !settings.HasSeasonTokens() {
+		return nil, entities.ErrSeasonTokensDisabled
+	}
+
+	return settings, nil
+}
+
+// record writes a token movement to the season token ledger
+func (s *seasonTokenService) record(ctx context.Context, discordID int64, season int, before, after int64, transactionType entities.TransactionType, metadata map[string]interface{}) (*entities.SeasonTokenHistory, error) {
+	history := &entities.SeasonTokenHistory{
+		DiscordID:           discordID,
+		Season:              season,
+		BalanceBefore:       before,
+		BalanceAfter:        after,
+		ChangeAmount:        after - before,
+		TransactionType:     transactionType,
+		TransactionMetadata: metadata,
+	}
+	if err := s.seasonTokenRepo.RecordHistory(ctx, history); err != nil {
+		return nil, fmt.Errorf("failed to record season token history: %w", err)
+	}
+
+	return history, nil
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func seasonTokenSettings(guildID int64, season int) *entities.GuildSettings {
+	name := "Crowns"
+	rate := int64(500)
+	return &entities.GuildSettings{
+		GuildID:         guildID,
+		SeasonTokenName: &name,
+		SeasonTokenRate: &rate,
+		CurrentSeason:   season,
+	}
+}
+
+func TestSeasonTokenService_EarnFromWin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("credits tokens at the guild rate", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		tokenRepo := new(testhelpers.MockSeasonTokenRepository)
+		settingsRepo := new(testhelpers.MockGuildSettingsRepository)
+		settingsRepo.On("GetOrCreateGuildSettings", ctx, int64(1)).Return(seasonTokenSettings(1, 3), nil)
+		tokenRepo.On("Credit", ctx, int64(123), 3, int64(4)).Return(int64(10), nil)
+		tokenRepo.On("RecordHistory", ctx, mock.MatchedBy(func(h *entities.SeasonTokenHistory) bool {
+			return h.TransactionType == entities.TransactionTypeSeasonTokenEarned &&
+				h.BalanceBefore == 6 && h.BalanceAfter == 10 && h.ChangeAmount == 4 && h.Season == 3 &&
+				h.TransactionMetadata["source"] == string(entities.TransactionTypeGroupWagerWin)
+		})).Return(nil)
+
+		service := NewSeasonTokenService(tokenRepo, settingsRepo)
+		history, err := service.EarnFromWin(ctx, 1, 123, 2400, entities.TransactionTypeGroupWagerWin)
+
+		require.NoError(t, err)
+		require.NotNil(t, history)
+		assert.Equal(t, int64(4), history.ChangeAmount)
+		tokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("small wins earn nothing", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		tokenRepo := new(testhelpers.MockSeasonTokenRepository)
+		settingsRepo := new(testhelpers.MockGuildSettingsRepository)
+		settingsRepo.On("GetOrCreateGuildSettings", ctx, int64(1)).Return(seasonTokenSettings(1, 1), nil)
+
+		service := NewSeasonTokenService(tokenRepo, settingsRepo)
+		history, err := service.EarnFromWin(ctx, 1, 123, 499, entities.TransactionTypeBetWin)
+
+		assert.NoError(t, err)
+		assert.Nil(t, history)
+		tokenRepo.AssertNotCalled(t, "Credit", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("guild without season tokens", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		tokenRepo := new(testhelpers.MockSeasonTokenRepository)
+		settingsRepo := new(testhelpers.MockGuildSettingsRepository)
+		settingsRepo.On("GetOrCreateGuildSettings", ctx, int64(1)).Return(&entities.GuildSettings{GuildID: 1}, nil)
+
+		service := NewSeasonTokenService(tokenRepo, settingsRepo)
+		history, err := service.EarnFromWin(ctx, 1, 123, 1_000_000, entities.TransactionTypeBetWin)
+
+		assert.NoError(t, err)
+		assert.Nil(t, history)
+	})
+}
+
+func TestSeasonTokenService_Debit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("records the spend", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		tokenRepo := new(testhelpers.MockSeasonTokenRepository)
+		settingsRepo := new(testhelpers.MockGuildSettingsRepository)
+		settingsRepo.On("GetOrCreateGuildSettings", ctx, int64(1)).Return(seasonTokenSettings(1, 2), nil)
+		tokenRepo.On("Debit", ctx, int64(123), 2, int64(5)).Return(int64(3), true, nil)
+		tokenRepo.On("RecordHistory", ctx, mock.AnythingOfType("*entities.SeasonTokenHistory")).Return(nil)
+
+		service := NewSeasonTokenService(tokenRepo, settingsRepo)
+		history, err := service.Debit(ctx, 1, 123, 5, map[string]interface{}{"item": "badge"})
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.TransactionTypeSeasonTokenSpent, history.TransactionType)
+		assert.Equal(t, int64(8), history.BalanceBefore)
+		assert.Equal(t, int64(3), history.BalanceAfter)
+		assert.Equal(t, int64(-5), history.ChangeAmount)
+	})
+
+	t.Run("insufficient tokens", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		tokenRepo := new(testhelpers.MockSeasonTokenRepository)
+		settingsRepo := new(testhelpers.MockGuildSettingsRepository)
+		settingsRepo.On("GetOrCreateGuildSettings", ctx, int64(1)).Return(seasonTokenSettings(1, 2), nil)
+		tokenRepo.On("Debit", ctx, int64(123), 2, int64(5)).Return(int64(0), false, nil)
+
+		service := NewSeasonTokenService(tokenRepo, settingsRepo)
+		_, err := service.Debit(ctx, 1, 123, 5, nil)
+
+		assert.ErrorIs(t, err, entities.ErrInsufficientSeasonTokens)
+		tokenRepo.AssertNotCalled(t, "RecordHistory", mock.Anything, mock.Anything)
+	})
+
+	t.Run("guild without season tokens", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		tokenRepo := new(testhelpers.MockSeasonTokenRepository)
+		settingsRepo := new(testhelpers.MockGuildSettingsRepository)
+		settingsRepo.On("GetOrCreateGuildSettings", ctx, int64(1)).Return(&entities.GuildSettings{GuildID: 1}, nil)
+
+		service := NewSeasonTokenService(tokenRepo, settingsRepo)
+		_, err := service.Debit(ctx, 1, 123, 5, nil)
+
+		assert.ErrorIs(t, err, entities.ErrSeasonTokensDisabled)
+	})
+}
+
+func TestSeasonTokenService_StartNewSeason(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tokenRepo := new(testhelpers.MockSeasonTokenRepository)
+	settingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	settingsRepo.On("GetOrCreateGuildSettings", ctx, int64(1)).Return(seasonTokenSettings(1, 0), nil)
+	settingsRepo.On("UpdateGuildSettings", ctx, mock.MatchedBy(func(s *entities.GuildSettings) bool {
+		return s.CurrentSeason == 2
+	})).Return(nil)
+
+	service := NewSeasonTokenService(tokenRepo, settingsRepo)
+	season, err := service.StartNewSeason(ctx, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, season)
+	settingsRepo.AssertExpectations(t)
+}
@@ -0,0 +1,267 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+	"gambler/discord-client/domain/utils"
+)
+
+// snapshotService implements guild economy snapshots and restores
+type snapshotService struct {
+	snapshotRepo       interfaces.GuildSnapshotRepository
+	userRepo           interfaces.UserRepository
+	groupWagerRepo     interfaces.GroupWagerRepository
+	lotteryDrawRepo    interfaces.LotteryDrawRepository
+	lotteryTicketRepo  interfaces.LotteryTicketRepository
+	balanceHistoryRepo interfaces.BalanceHistoryRepository
+	eventPublisher     interfaces.EventPublisher
+}
+
+// NewSnapshotService creates a new snapshot service
+func NewSnapshotService(
+	snapshotRepo interfaces.GuildSnapshotRepository,
+	userRepo interfaces.UserRepository,
+	groupWagerRepo interfaces.GroupWagerRepository,
+	lotteryDrawRepo interfaces.LotteryDrawRepository,
+	lotteryTicketRepo interfaces.LotteryTicketRepository,
+	balanceHistoryRepo interfaces.BalanceHistoryRepository,
+	eventPublisher interfaces.EventPublisher,
+) interfaces.SnapshotService {
+	return &snapshotService{
+		snapshotRepo:       snapshotRepo,
+		userRepo:           userRepo,
+		groupWagerRepo:     groupWagerRepo,
+		lotteryDrawRepo:    lotteryDrawRepo,
+		lotteryTicketRepo:  lotteryTicketRepo,
+		balanceHistoryRepo: balanceHistoryRepo,
+		eventPublisher:     eventPublisher,
+	}
+}
+
+// CreateSnapshot stores the guild's balances, open group wagers and open lottery draw
+func (s *snapshotService) CreateSnapshot(ctx context.Context, guildID int64, reason string) (*entities.GuildSnapshot, error) {
+	users, err := s.userRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	data := entities.GuildSnapshotData{
+		Balances:     make([]entities.GuildSnapshotBalance, 0, len(users)),
+		ActiveWagers: []entities.GuildSnapshotGroupWager{},
+	}
+	for _, user := range users {
+		data.Balances = append(data.Balances, entities.GuildSnapshotBalance{
+			DiscordID: user.DiscordID,
+			Balance:   user.Balance,
+		})
+	}
+
+	for _, state := range []entities.GroupWagerState{entities.GroupWagerStateActive, entities.GroupWagerStatePendingResolution} {
+		wagers, err := s.groupWagerRepo.GetAll(ctx, &state)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s group wagers: %w", state, err)
+		}
+		for _, wager := range wagers {
+			data.ActiveWagers = append(data.ActiveWagers, entities.GuildSnapshotGroupWager{
+				GroupWagerID: wager.ID,
+				State:        wager.State,
+				TotalPot:     wager.TotalPot,
+			})
+		}
+	}
+
+	draw, err := s.lotteryDrawRepo.GetCurrentOpenDraw(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open lottery draw: %w", err)
+	}
+	if draw != nil {
+		tickets, err := s.lotteryTicketRepo.CountTicketsForDraw(ctx, draw.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count lottery tickets: %w", err)
+		}
+		taxPot := draw.TaxPot
+		data.Lottery = &entities.GuildSnapshotLotteryDraw{
+			DrawID:      draw.ID,
+			TotalPot:    draw.TotalPot,
+			TaxPot:      &taxPot,
+			TicketsSold: tickets,
+		}
+	}
+
+	snapshot := &entities.GuildSnapshot{
+		GuildID: guildID,
+		Reason:  reason,
+		Data:    data,
+	}
+	if err := s.snapshotRepo.Create(ctx, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to save snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// GetRecentSnapshots returns the guild's most recent snapshots, newest first
+func (s *snapshotService) GetRecentSnapshots(ctx context.Context, limit int) ([]*entities.GuildSnapshot, error) {
+	snapshots, err := s.snapshotRepo.GetRecent(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshots: %w", err)
+	}
+	return snapshots, nil
+}
+
+// RestoreSnapshot rolls the guild's balances and open lottery pot back to a snapshot, or reports what it would do in a dry run
+func (s *snapshotService) RestoreSnapshot(ctx context.Context, guildID, snapshotID int64, dryRun bool) (*entities.GuildSnapshotRestoreReport, error) {
+	snapshot, err := s.snapshotRepo.GetByID(ctx, snapshotID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot: %w", err)
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("%w: %d", entities.ErrGuildSnapshotNotFound, snapshotID)
+	}
+
+	report := &entities.GuildSnapshotRestoreReport{
+		SnapshotID:   snapshot.ID,
+		GuildID:      guildID,
+		DryRun:       dryRun,
+		Balances:     make([]entities.GuildSnapshotBalanceChange, 0, len(snapshot.Data.Balances)),
+		MissingUsers: []int64{},
+		WagerChanges: []entities.GuildSnapshotWagerChange{},
+	}
+
+	// Keep a copy of the state being overwritten so the restore itself can be undone
+	if !dryRun {
+		backup, err := s.CreateSnapshot(ctx, guildID, fmt.Sprintf("Automatic backup before restoring snapshot %d", snapshot.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to back up guild before restore: %w", err)
+		}
+		report.BackupSnapshotID = backup.ID
+	}
+
+	for _, balance := range snapshot.Data.Balances {
+		user, err := s.userRepo.GetByDiscordID(ctx, balance.DiscordID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user %d: %w", balance.DiscordID, err)
+		}
+		if user == nil {
+			report.MissingUsers = append(report.MissingUsers, balance.DiscordID)
+			continue
+		}
+
+		change := balance.Balance - user.Balance
+		// Bits reserved in wagers that are still open cannot be taken away
+		if pending := user.GetPendingAmount(); balance.Balance < pending {
+			return nil, fmt.Errorf("user %d has %d bits in open wagers, more than their snapshot balance of %d",
+				user.DiscordID, pending, balance.Balance)
+		}
+
+		report.Balances = append(report.Balances, entities.GuildSnapshotBalanceChange{
+			DiscordID:     user.DiscordID,
+			BalanceBefore: user.Balance,
+			BalanceAfter:  balance.Balance,
+			ChangeAmount:  change,
+		})
+		report.TotalChange += change
+
+		if dryRun || change == 0 {
+			continue
+		}
+
+		if err := s.userRepo.UpdateBalance(ctx, user.DiscordID, balance.Balance); err != nil {
+			return nil, fmt.Errorf("failed to update balance for user %d: %w", user.DiscordID, err)
+		}
+
+		history := &entities.BalanceHistory{
+			DiscordID:       user.DiscordID,
+			GuildID:         guildID,
+			BalanceBefore:   user.Balance,
+			BalanceAfter:    balance.Balance,
+			ChangeAmount:    change,
+			TransactionType: entities.AdjustmentTransactionType(change),
+			TransactionMetadata: map[string]interface{}{
+				"admin":       "true",
+				"source":      "snapshot_restore",
+				"snapshot_id": snapshot.ID,
+			},
+		}
+		if err := utils.RecordBalanceChange(ctx, s.balanceHistoryRepo, s.eventPublisher, history); err != nil {
+			return nil, fmt.Errorf("failed to record restore for user %d: %w", user.DiscordID, err)
+		}
+	}
+
+	for _, snapshotWager := range snapshot.Data.ActiveWagers {
+		wager, err := s.groupWagerRepo.GetByID(ctx, snapshotWager.GroupWagerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get group wager %d: %w", snapshotWager.GroupWagerID, err)
+		}
+		change := entities.GuildSnapshotWagerChange{
+			GroupWagerID:  snapshotWager.GroupWagerID,
+			SnapshotState: snapshotWager.State,
+		}
+		if wager != nil {
+			if wager.State == snapshotWager.State {
+				continue
+			}
+			change.CurrentState = wager.State
+		}
+		report.WagerChanges = append(report.WagerChanges, change)
+	}
+
+	if snapshot.Data.Lottery != nil {
+		lottery, err := s.restoreLotteryPot(ctx, snapshot.Data.Lottery, dryRun)
+		if err != nil {
+			return nil, err
+		}
+		report.Lottery = lottery
+	}
+
+	return report, nil
+}
+
+// restoreLotteryPot sets the pot of the snapshot's lottery draw back to its snapshotted amount if the draw is still open.
+// Balances go back to the snapshot too, so the restore is refused if tickets have been bought or refunded since.
+func (s *snapshotService) restoreLotteryPot(ctx context.Context, snapshotDraw *entities.GuildSnapshotLotteryDraw, dryRun bool) (*entities.GuildSnapshotLotteryChange, error) {
+	draw, err := s.lotteryDrawRepo.GetByIDForUpdate(ctx, snapshotDraw.DrawID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lottery draw %d: %w", snapshotDraw.DrawID, err)
+	}
+
+	change := &entities.GuildSnapshotLotteryChange{
+		DrawID:            snapshotDraw.DrawID,
+		PotAfter:          snapshotDraw.TotalPot,
+		TicketsAtSnapshot: snapshotDraw.TicketsSold,
+	}
+	if draw == nil || draw.IsCompleted() {
+		return change, nil
+	}
+
+	tickets, err := s.lotteryTicketRepo.CountTicketsForDraw(ctx, draw.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count lottery tickets: %w", err)
+	}
+	if tickets != snapshotDraw.TicketsSold {
+		return nil, fmt.Errorf("cannot restore snapshot: lottery draw %d has %d tickets, %d when the snapshot was taken",
+			draw.ID, tickets, snapshotDraw.TicketsSold)
+	}
+	change.Restored = true
+	change.PotBefore = draw.TotalPot
+	change.TicketsNow = tickets
+
+	taxPot := draw.TaxPot
+	if snapshotDraw.TaxPot != nil {
+		taxPot = *snapshotDraw.TaxPot
+	}
+	if dryRun || (draw.TotalPot == snapshotDraw.TotalPot && draw.TaxPot == taxPot) {
+		return change, nil
+	}
+
+	draw.TotalPot = snapshotDraw.TotalPot
+	draw.TaxPot = taxPot
+	if err := s.lotteryDrawRepo.Update(ctx, draw); err != nil {
+		return nil, fmt.Errorf("failed to restore lottery pot: %w", err)
+	}
+
+	return change, nil
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type snapshotServiceMocks struct {
+	snapshots      *testhelpers.MockGuildSnapshotRepository
+	users          *testhelpers.MockUserRepository
+	groupWagers    *testhelpers.MockGroupWagerRepository
+	lotteryDraws   *testhelpers.MockLotteryDrawRepository
+	lotteryTickets *testhelpers.MockLotteryTicketRepository
+	balanceHistory *testhelpers.MockBalanceHistoryRepository
+	events         *testhelpers.MockEventPublisher
+}
+
+func newSnapshotServiceForTest() (*snapshotServiceMocks, *snapshotService) {
+	m := &snapshotServiceMocks{
+		snapshots:      new(testhelpers.MockGuildSnapshotRepository),
+		users:          new(testhelpers.MockUserRepository),
+		groupWagers:    new(testhelpers.MockGroupWagerRepository),
+		lotteryDraws:   new(testhelpers.MockLotteryDrawRepository),
+		lotteryTickets: new(testhelpers.MockLotteryTicketRepository),
+		balanceHistory: new(testhelpers.MockBalanceHistoryRepository),
+		events:         new(testhelpers.MockEventPublisher),
+	}
+	service := NewSnapshotService(m.snapshots, m.users, m.groupWagers, m.lotteryDraws, m.lotteryTickets, m.balanceHistory, m.events)
+	return m, service.(*snapshotService)
+}
+
+func TestSnapshotService_CreateSnapshot(t *testing.T) {
+	ctx := context.Background()
+	guildID := int64(123456789)
+	m, service := newSnapshotServiceForTest()
+
+	active := entities.GroupWagerStateActive
+	pending := entities.GroupWagerStatePendingResolution
+	m.users.On("GetAll", ctx).Return([]*entities.User{
+		{DiscordID: 111, Balance: 5000},
+		{DiscordID: 222, Balance: 300},
+	}, nil)
+	m.groupWagers.On("GetAll", ctx, &active).Return([]*entities.GroupWager{
+		{ID: 7, State: entities.GroupWagerStateActive, TotalPot: 1200},
+	}, nil)
+	m.groupWagers.On("GetAll", ctx, &pending).Return([]*entities.GroupWager{}, nil)
+	m.lotteryDraws.On("GetCurrentOpenDraw", ctx, guildID).Return(&entities.LotteryDraw{ID: 9, TotalPot: 800, TaxPot: 100}, nil)
+	m.lotteryTickets.On("CountTicketsForDraw", ctx, int64(9)).Return(int64(8), nil)
+	m.snapshots.On("Create", ctx, mock.AnythingOfType("*entities.GuildSnapshot")).Run(func(args mock.Arguments) {
+		args.Get(1).(*entities.GuildSnapshot).ID = 1
+	}).Return(nil)
+
+	snapshot, err := service.CreateSnapshot(ctx, guildID, "before season reset")
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1), snapshot.ID)
+	assert.Equal(t, "before season reset", snapshot.Reason)
+	assert.Equal(t, int64(5300), snapshot.Data.TotalBalance())
+	assert.Equal(t, []entities.GuildSnapshotGroupWager{{GroupWagerID: 7, State: entities.GroupWagerStateActive, TotalPot: 1200}}, snapshot.Data.ActiveWagers)
+	taxPot := int64(100)
+	assert.Equal(t, &entities.GuildSnapshotLotteryDraw{DrawID: 9, TotalPot: 800, TaxPot: &taxPot, TicketsSold: 8}, snapshot.Data.Lottery)
+	m.snapshots.AssertExpectations(t)
+}
+
+func TestSnapshotService_RestoreSnapshot(t *testing.T) {
+	ctx := context.Background()
+	guildID := int64(123456789)
+	snapshotTaxPot := int64(100)
+
+	snapshot := &entities.GuildSnapshot{
+		ID:      1,
+		GuildID: guildID,
+		Data: entities.GuildSnapshotData{
+			Balances: []entities.GuildSnapshotBalance{
+				{DiscordID: 111, Balance: 5000},
+				{DiscordID: 222, Balance: 300},
+				{DiscordID: 333, Balance: 100},
+			},
+			ActiveWagers: []entities.GuildSnapshotGroupWager{
+				{GroupWagerID: 7, State: entities.GroupWagerStateActive},
+				{GroupWagerID: 8, State: entities.GroupWagerStateActive},
+			},
+			Lottery: &entities.GuildSnapshotLotteryDraw{DrawID: 9, TotalPot: 800, TaxPot: &snapshotTaxPot, TicketsSold: 8},
+		},
+		CreatedAt: time.Now().Add(-time.Hour),
+	}
+
+	expectLookups := func(m *snapshotServiceMocks) {
+		m.snapshots.On("GetByID", ctx, int64(1)).Return(snapshot, nil)
+		m.users.On("GetByDiscordID", ctx, int64(111)).Return(&entities.User{DiscordID: 111, Balance: 9000, AvailableBalance: 9000}, nil)
+		m.users.On("GetByDiscordID", ctx, int64(222)).Return(&entities.User{DiscordID: 222, Balance: 300, AvailableBalance: 300}, nil)
+		m.users.On("GetByDiscordID", ctx, int64(333)).Return(nil, nil)
+		m.groupWagers.On("GetByID", ctx, int64(7)).Return(&entities.GroupWager{ID: 7, State: entities.GroupWagerStateActive}, nil)
+		m.groupWagers.On("GetByID", ctx, int64(8)).Return(&entities.GroupWager{ID: 8, State: entities.GroupWagerStateResolved}, nil)
+		// The pot has grown from pot taxes since the snapshot, with no tickets bought
+		m.lotteryDraws.On("GetByIDForUpdate", ctx, int64(9)).Return(&entities.LotteryDraw{ID: 9, TotalPot: 1500, TaxPot: 800}, nil)
+		m.lotteryTickets.On("CountTicketsForDraw", ctx, int64(9)).Return(int64(8), nil)
+	}
+
+	t.Run("dry run reports without changing anything", func(t *testing.T) {
+		m, service := newSnapshotServiceForTest()
+		expectLookups(m)
+
+		report, err := service.RestoreSnapshot(ctx, guildID, 1, true)
+		require.NoError(t, err)
+
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 1, report.ChangedCount())
+		assert.Equal(t, int64(-4000), report.TotalChange)
+		assert.Equal(t, []int64{333}, report.MissingUsers)
+		assert.Equal(t, []entities.GuildSnapshotWagerChange{
+			{GroupWagerID: 8, SnapshotState: entities.GroupWagerStateActive, CurrentState: entities.GroupWagerStateResolved},
+		}, report.WagerChanges)
+		require.NotNil(t, report.Lottery)
+		assert.True(t, report.Lottery.Restored)
+		assert.Equal(t, int64(1500), report.Lottery.PotBefore)
+		assert.Equal(t, int64(800), report.Lottery.PotAfter)
+		m.users.AssertNotCalled(t, "UpdateBalance", mock.Anything, mock.Anything, mock.Anything)
+		m.lotteryDraws.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("restores balances and the lottery pot", func(t *testing.T) {
+		m, service := newSnapshotServiceForTest()
+		expectLookups(m)
+		// Backup of the current state
+		m.users.On("GetAll", ctx).Return([]*entities.User{{DiscordID: 111, Balance: 9000}, {DiscordID: 222, Balance: 300}}, nil)
+		m.groupWagers.On("GetAll", ctx, mock.Anything).Return([]*entities.GroupWager{}, nil)
+		m.lotteryDraws.On("GetCurrentOpenDraw", ctx, guildID).Return(&entities.LotteryDraw{ID: 9, TotalPot: 1500}, nil)
+		m.snapshots.On("Create", ctx, mock.MatchedBy(func(backup *entities.GuildSnapshot) bool {
+			return backup.Data.TotalBalance() == 9300 && backup.Data.Lottery.TotalPot == 1500
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*entities.GuildSnapshot).ID = 2
+		}).Return(nil)
+		m.users.On("UpdateBalance", ctx, int64(111), int64(5000)).Return(nil)
+		m.balanceHistory.On("Record", ctx, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+			return h.DiscordID == 111 && h.ChangeAmount == -4000 &&
+				h.TransactionType == entities.TransactionTypeTransferOut &&
+				h.TransactionMetadata["source"] == "snapshot_restore"
+		})).Return(nil)
+		m.events.On("Publish", mock.Anything).Return(nil)
+		m.lotteryDraws.On("Update", ctx, mock.MatchedBy(func(d *entities.LotteryDraw) bool {
+			return d.ID == 9 && d.TotalPot == 800 && d.TaxPot == 100
+		})).Return(nil)
+
+		report, err := service.RestoreSnapshot(ctx, guildID, 1, false)
+		require.NoError(t, err)
+
+		assert.False(t, report.DryRun)
+		assert.Equal(t, int64(2), report.BackupSnapshotID)
+		m.snapshots.AssertExpectations(t)
+		m.users.AssertExpectations(t)
+		m.balanceHistory.AssertExpectations(t)
+		m.lotteryDraws.AssertExpectations(t)
+	})
+
+	t.Run("refuses to take bits reserved in open wagers", func(t *testing.T) {
+		m, service := newSnapshotServiceForTest()
+		m.snapshots.On("GetByID", ctx, int64(1)).Return(snapshot, nil)
+		m.users.On("GetByDiscordID", ctx, int64(111)).Return(&entities.User{DiscordID: 111, Balance: 9000, AvailableBalance: 2000}, nil)
+
+		_, err := service.RestoreSnapshot(ctx, guildID, 1, true)
+		assert.ErrorContains(t, err, "7000 bits in open wagers")
+	})
+
+	t.Run("refuses once lottery tickets have been bought since the snapshot", func(t *testing.T) {
+		m, service := newSnapshotServiceForTest()
+		m.snapshots.On("GetByID", ctx, int64(1)).Return(snapshot, nil)
+		m.users.On("GetByDiscordID", ctx, mock.Anything).Return(nil, nil)
+		m.groupWagers.On("GetByID", ctx, mock.Anything).Return(nil, nil)
+		m.lotteryDraws.On("GetByIDForUpdate", ctx, int64(9)).Return(&entities.LotteryDraw{ID: 9, TotalPot: 1500}, nil)
+		m.lotteryTickets.On("CountTicketsForDraw", ctx, int64(9)).Return(int64(15), nil)
+
+		_, err := service.RestoreSnapshot(ctx, guildID, 1, true)
+		assert.ErrorContains(t, err, "lottery draw 9 has 15 tickets, 8 when the snapshot was taken")
+		m.lotteryDraws.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("snapshot not found", func(t *testing.T) {
+		m, service := newSnapshotServiceForTest()
+		m.snapshots.On("GetByID", ctx, int64(2)).Return(nil, nil)
+
+		_, err := service.RestoreSnapshot(ctx, guildID, 2, true)
+		assert.True(t, errors.Is(err, entities.ErrGuildSnapshotNotFound))
+	})
+}
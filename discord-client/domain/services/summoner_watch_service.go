@@ -22,12 +22,7 @@ func NewSummonerWatchService(summonerWatchRepo interfaces.SummonerWatchRepositor
 
 // AddWatch creates a new summoner watch for a guild
 func (s *summonerWatchService) AddWatch(ctx context.Context, guildID int64, summonerName, tagLine string) (*entities.SummonerWatchDetail, error) {
-	// Validate inputs
-	if err := s.validateSummonerName(summonerName); err != nil {
-		return nil, err
-	}
-
-	if err := s.validateTagLine(tagLine); err != nil {
+	if err := s.CheckCanAddWatch(ctx, guildID, summonerName, tagLine); err != nil {
 		return nil, err
 	}
 
@@ -44,6 +39,39 @@ func (s *summonerWatchService) AddWatch(ctx context.Context, guildID int64, summ
 	return watch, nil
 }
 
+// CheckCanAddWatch validates a new watch without creating it, rejecting duplicates and guilds at their watch limit
+func (s *summonerWatchService) CheckCanAddWatch(ctx context.Context, guildID int64, summonerName, tagLine string) error {
+	// Validate inputs
+	if err := s.validateSummonerName(summonerName); err != nil {
+		return err
+	}
+
+	if err := s.validateTagLine(tagLine); err != nil {
+		return err
+	}
+
+	normalizedSummonerName := strings.ToLower(strings.TrimSpace(summonerName))
+	normalizedTagLine := strings.ToLower(strings.TrimSpace(tagLine))
+
+	existing, err := s.summonerWatchRepo.GetWatch(ctx, guildID, normalizedSummonerName, normalizedTagLine)
+	if err != nil {
+		return fmt.Errorf("failed to check existing watch: %w", err)
+	}
+	if existing != nil {
+		return entities.ErrSummonerAlreadyWatched
+	}
+
+	watches, err := s.summonerWatchRepo.GetWatchesByGuild(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("failed to get guild watches: %w", err)
+	}
+	if len(watches) >= entities.MaxSummonerWatchesPerGuild {
+		return entities.ErrSummonerWatchLimitReached
+	}
+
+	return nil
+}
+
 // RemoveWatch removes a summoner watch for a guild
 func (s *summonerWatchService) RemoveWatch(ctx context.Context, guildID int64, summonerName, tagLine string) error {
 
@@ -61,9 +89,12 @@ func (s *summonerWatchService) RemoveWatch(ctx context.Context, guildID int64, s
 	normalizedTagLine := strings.ToLower(strings.TrimSpace(tagLine))
 
 	// Check if watch exists before attempting to delete
-	_, err := s.summonerWatchRepo.GetWatch(ctx, guildID, normalizedSummonerName, normalizedTagLine)
+	watch, err := s.summonerWatchRepo.GetWatch(ctx, guildID, normalizedSummonerName, normalizedTagLine)
 	if err != nil {
-		return fmt.Errorf("summoner watch not found")
+		return fmt.Errorf("failed to get summoner watch: %w", err)
+	}
+	if watch == nil {
+		return entities.ErrSummonerWatchNotFound
 	}
 
 	// Delete the watch
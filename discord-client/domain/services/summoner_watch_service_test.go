@@ -29,6 +29,8 @@ func TestSummonerWatchService_AddWatch_Success(t *testing.T) {
 	}
 
 	// Mock expectations
+	mockRepo.On("GetWatch", ctx, int64(12345), "testsummoner", "gamba").Return(nil, nil)
+	mockRepo.On("GetWatchesByGuild", ctx, int64(12345)).Return([]*entities.SummonerWatchDetail{}, nil)
 	mockRepo.On("CreateWatch", ctx, int64(12345), "testsummoner", "gamba").Return(expectedWatch, nil)
 
 	// Execute
@@ -142,6 +144,8 @@ func TestSummonerWatchService_AddWatch_LowercaseTagLine(t *testing.T) {
 	}
 
 	// Mock expectations - should call with lowercase tagLine as is
+	mockRepo.On("GetWatch", ctx, int64(12345), "testsummoner", "na1").Return(nil, nil)
+	mockRepo.On("GetWatchesByGuild", ctx, int64(12345)).Return([]*entities.SummonerWatchDetail{}, nil)
 	mockRepo.On("CreateWatch", ctx, int64(12345), "testsummoner", "na1").Return(expectedWatch, nil)
 
 	// Execute with lowercase tagLine
@@ -159,6 +163,8 @@ func TestSummonerWatchService_AddWatch_RepositoryError(t *testing.T) {
 	service := NewSummonerWatchService(mockRepo)
 
 	repoErr := errors.New("repository error")
+	mockRepo.On("GetWatch", ctx, int64(12345), "testsummoner", "gamba").Return(nil, nil)
+	mockRepo.On("GetWatchesByGuild", ctx, int64(12345)).Return([]*entities.SummonerWatchDetail{}, nil)
 	mockRepo.On("CreateWatch", ctx, int64(12345), "testsummoner", "gamba").Return(nil, repoErr)
 
 	// Execute
@@ -171,6 +177,45 @@ func TestSummonerWatchService_AddWatch_RepositoryError(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestSummonerWatchService_AddWatch_AlreadyWatched(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(testhelpers.MockSummonerWatchRepository)
+	service := NewSummonerWatchService(mockRepo)
+
+	existingWatch := &entities.SummonerWatchDetail{
+		GuildID:      12345,
+		SummonerName: "testsummoner",
+		TagLine:      "gamba",
+	}
+	mockRepo.On("GetWatch", ctx, int64(12345), "testsummoner", "gamba").Return(existingWatch, nil)
+
+	// Execute
+	result, err := service.AddWatch(ctx, 12345, "TestSummoner", "GAMBA")
+
+	// Assert
+	assert.ErrorIs(t, err, entities.ErrSummonerAlreadyWatched)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "CreateWatch")
+}
+
+func TestSummonerWatchService_AddWatch_LimitReached(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(testhelpers.MockSummonerWatchRepository)
+	service := NewSummonerWatchService(mockRepo)
+
+	watches := make([]*entities.SummonerWatchDetail, entities.MaxSummonerWatchesPerGuild)
+	mockRepo.On("GetWatch", ctx, int64(12345), "testsummoner", "gamba").Return(nil, nil)
+	mockRepo.On("GetWatchesByGuild", ctx, int64(12345)).Return(watches, nil)
+
+	// Execute
+	result, err := service.AddWatch(ctx, 12345, "TestSummoner", "gamba")
+
+	// Assert
+	assert.ErrorIs(t, err, entities.ErrSummonerWatchLimitReached)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "CreateWatch")
+}
+
 func TestSummonerWatchService_RemoveWatch_Success(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(testhelpers.MockSummonerWatchRepository)
@@ -194,20 +239,37 @@ func TestSummonerWatchService_RemoveWatch_Success(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
-func TestSummonerWatchService_RemoveWatch_NotFound(t *testing.T) {
+func TestSummonerWatchService_RemoveWatch_LookupError(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(testhelpers.MockSummonerWatchRepository)
 	service := NewSummonerWatchService(mockRepo)
 
-	// Mock expectations - watch doesn't exist
-	mockRepo.On("GetWatch", ctx, int64(12345), "testsummoner", "na1").Return(nil, errors.New("not found"))
+	// Mock expectations - the lookup fails
+	mockRepo.On("GetWatch", ctx, int64(12345), "testsummoner", "na1").Return(nil, errors.New("connection reset"))
 
 	// Execute
 	err := service.RemoveWatch(ctx, 12345, "TestSummoner", "NA1")
 
 	// Assert
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "summoner watch not found")
+	assert.Contains(t, err.Error(), "failed to get summoner watch")
+	assert.NotErrorIs(t, err, entities.ErrSummonerWatchNotFound)
+	mockRepo.AssertNotCalled(t, "DeleteWatch")
+}
+
+func TestSummonerWatchService_RemoveWatch_MissingWatch(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(testhelpers.MockSummonerWatchRepository)
+	service := NewSummonerWatchService(mockRepo)
+
+	// The repository returns nil without an error when no watch exists
+	mockRepo.On("GetWatch", ctx, int64(12345), "testsummoner", "na1").Return(nil, nil)
+
+	// Execute
+	err := service.RemoveWatch(ctx, 12345, "TestSummoner", "NA1")
+
+	// Assert
+	assert.ErrorIs(t, err, entities.ErrSummonerWatchNotFound)
 	mockRepo.AssertNotCalled(t, "DeleteWatch")
 }
 
@@ -67,9 +67,9 @@ func (a *AssertionHelper) AssertHouseWagerPayouts(result *entities.GroupWagerRes
 	// For house wagers, verify fixed odds payouts
 	for _, winner := range result.Winners {
 		require.NotNil(a.t, winner.PayoutAmount)
-		expectedPayout := int64(float64(winner.Amount) * winningOption.OddsMultiplier)
+		expectedPayout := int64(float64(winner.Amount) * winner.GetPayoutMultiplier(winningOption))
 		assert.Equal(a.t, expectedPayout, *winner.PayoutAmount,
-			"House wager payout should be bet amount * odds multiplier")
+			"House wager payout should be bet amount * locked odds multiplier")
 	}
 
 	// Losers should have 0 payout
@@ -67,6 +67,9 @@ func TestServiceMethod_Example(t *testing.T) {
 					mocks.GroupWagerRepo,
 					mocks.UserRepo,
 					mocks.BalanceHistoryRepo,
+					mocks.GuildSettingsRepo,
+					mocks.GuildResolverRepo,
+					mocks.LotteryDrawRepo,
 					mocks.EventPublisher,
 				)
 
@@ -105,6 +108,9 @@ func TestServiceMethod_Example(t *testing.T) {
 			mocks.GroupWagerRepo,
 			mocks.UserRepo,
 			mocks.BalanceHistoryRepo,
+			mocks.GuildSettingsRepo,
+			mocks.GuildResolverRepo,
+			mocks.LotteryDrawRepo,
 			mocks.EventPublisher,
 		)
 
@@ -165,6 +171,9 @@ func TestServiceMethod_Example(t *testing.T) {
 					mocks.GroupWagerRepo,
 					mocks.UserRepo,
 					mocks.BalanceHistoryRepo,
+					mocks.GuildSettingsRepo,
+					mocks.GuildResolverRepo,
+					mocks.LotteryDrawRepo,
 					mocks.EventPublisher,
 				)
 
@@ -243,6 +252,9 @@ func TestServiceMethod_ErrorScenarios(t *testing.T) {
 				mocks.GroupWagerRepo,
 				mocks.UserRepo,
 				mocks.BalanceHistoryRepo,
+				mocks.GuildSettingsRepo,
+				mocks.GuildResolverRepo,
+				mocks.LotteryDrawRepo,
 				mocks.EventPublisher,
 			)
 
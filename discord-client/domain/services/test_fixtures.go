@@ -31,6 +31,9 @@ func NewGroupWagerTestFixture(t *testing.T) *GroupWagerTestFixture {
 		mocks.GroupWagerRepo,
 		mocks.UserRepo,
 		mocks.BalanceHistoryRepo,
+		mocks.GuildSettingsRepo,
+		mocks.GuildResolverRepo,
+		mocks.LotteryDrawRepo,
 		mocks.EventPublisher,
 	)
 
@@ -71,6 +74,9 @@ func (f *GroupWagerTestFixture) Reset() {
 		f.Mocks.GroupWagerRepo,
 		f.Mocks.UserRepo,
 		f.Mocks.BalanceHistoryRepo,
+		f.Mocks.GuildSettingsRepo,
+		f.Mocks.GuildResolverRepo,
+		f.Mocks.LotteryDrawRepo,
 		f.Mocks.EventPublisher,
 	)
 }
@@ -39,12 +39,14 @@ type TestMocks struct {
 	WagerRepo          *testhelpers.MockWagerRepository
 	WagerVoteRepo      *testhelpers.MockWagerVoteRepository
 	GuildSettingsRepo  *testhelpers.MockGuildSettingsRepository
+	GuildResolverRepo  *testhelpers.MockGuildResolverRepository
 	SummonerWatchRepo  *testhelpers.MockSummonerWatchRepository
+	LotteryDrawRepo    *testhelpers.MockLotteryDrawRepository
 }
 
 // NewTestMocks creates a new set of mocks
 func NewTestMocks() *TestMocks {
-	return &TestMocks{
+	mocks := &TestMocks{
 		GroupWagerRepo:     &testhelpers.MockGroupWagerRepository{},
 		UserRepo:           &testhelpers.MockUserRepository{},
 		BalanceHistoryRepo: &testhelpers.MockBalanceHistoryRepository{},
@@ -53,8 +55,21 @@ func NewTestMocks() *TestMocks {
 		WagerRepo:          &testhelpers.MockWagerRepository{},
 		WagerVoteRepo:      &testhelpers.MockWagerVoteRepository{},
 		GuildSettingsRepo:  &testhelpers.MockGuildSettingsRepository{},
+		GuildResolverRepo:  &testhelpers.MockGuildResolverRepository{},
 		SummonerWatchRepo:  &testhelpers.MockSummonerWatchRepository{},
+		LotteryDrawRepo:    &testhelpers.MockLotteryDrawRepository{},
 	}
+
+	// Guild settings default to no betting curfew; use ExpectGuildSettings to override
+	mocks.GuildSettingsRepo.On("GetOrCreateGuildSettings", mock.Anything, mock.Anything).Return(&entities.GuildSettings{}, nil).Maybe()
+
+	// Only the statically configured resolvers are resolvers unless a test grants more
+	mocks.GuildResolverRepo.On("GetAll", mock.Anything).Return([]*entities.GuildResolver{}, nil).Maybe()
+
+	// Wagers have no settled parlay legs unless a test places them
+	mocks.GroupWagerRepo.On("HasSettledParlayLegs", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+
+	return mocks
 }
 
 // AssertAllExpectations verifies all mock expectations were met
@@ -84,6 +99,12 @@ func NewMockHelper(mocks *TestMocks) *MockHelper {
 	}
 }
 
+// ExpectGuildSettings replaces the default guild settings returned for any guild
+func (h *MockHelper) ExpectGuildSettings(settings *entities.GuildSettings) {
+	h.mocks.GuildSettingsRepo.ExpectedCalls = nil
+	h.mocks.GuildSettingsRepo.On("GetOrCreateGuildSettings", mock.Anything, mock.Anything).Return(settings, nil)
+}
+
 // ExpectUserLookup sets up user repository mock expectations
 func (h *MockHelper) ExpectUserLookup(discordID int64, user *entities.User) {
 	h.mocks.UserRepo.On("GetByDiscordID", mock.Anything, discordID).Return(user, nil)
@@ -99,6 +120,11 @@ func (h *MockHelper) ExpectWagerDetailLookup(wagerID int64, detail *entities.Gro
 	h.mocks.GroupWagerRepo.On("GetDetailByID", mock.Anything, wagerID).Return(detail, nil)
 }
 
+// ExpectWagerDetailLookupForUpdate sets up locking wager detail repository mock expectations
+func (h *MockHelper) ExpectWagerDetailLookupForUpdate(wagerID int64, detail *entities.GroupWagerDetail) {
+	h.mocks.GroupWagerRepo.On("GetDetailByIDForUpdate", mock.Anything, wagerID).Return(detail, nil)
+}
+
 // ExpectWagerNotFound sets up wager repository mock to return not found
 func (h *MockHelper) ExpectWagerNotFound(wagerID int64) {
 	h.mocks.GroupWagerRepo.On("GetByID", mock.Anything, wagerID).Return(nil, nil)
@@ -109,6 +135,11 @@ func (h *MockHelper) ExpectWagerDetailNotFound(wagerID int64) {
 	h.mocks.GroupWagerRepo.On("GetDetailByID", mock.Anything, wagerID).Return(nil, nil)
 }
 
+// ExpectWagerDetailNotFoundForUpdate sets up locking wager detail repository mock to return not found
+func (h *MockHelper) ExpectWagerDetailNotFoundForUpdate(wagerID int64) {
+	h.mocks.GroupWagerRepo.On("GetDetailByIDForUpdate", mock.Anything, wagerID).Return(nil, nil)
+}
+
 // ExpectEventPublish sets up event publisher mock expectations
 func (h *MockHelper) ExpectEventPublish(eventType events.EventType) {
 	h.mocks.EventPublisher.On("Publish", mock.MatchedBy(func(e events.Event) bool {
@@ -296,6 +327,14 @@ func (s *GroupWagerScenario) WithOdds(odds ...float64) *GroupWagerScenario {
 	return s
 }
 
+// WithOptionCap caps the total amount that can be bet on an option
+func (s *GroupWagerScenario) WithOptionCap(optionIndex int, maxTotal int64) *GroupWagerScenario {
+	if optionIndex < len(s.Options) {
+		s.Options[optionIndex].MaxTotalAmount = &maxTotal
+	}
+	return s
+}
+
 // WithParticipant adds a participant to the scenario
 func (s *GroupWagerScenario) WithParticipant(userID int64, optionIndex int, amount int64) *GroupWagerScenario {
 	if optionIndex >= len(s.Options) {
@@ -6,6 +6,7 @@ import (
 	"gambler/discord-client/domain/entities"
 	"gambler/discord-client/domain/interfaces"
 	"sort"
+	"time"
 )
 
 // calculateWinRate calculates win percentage from wins and total attempts
@@ -19,26 +20,29 @@ func calculateWinRate(wins, total int) float64 {
 // userMetricsService implements the UserMetricsService interface
 type userMetricsService struct {
 	userRepo            interfaces.UserRepository
-	wagerRepo           interfaces.WagerRepository
+	userStatsRepo       interfaces.UserStatsRepository
 	betRepo             interfaces.BetRepository
 	groupWagerRepo      interfaces.GroupWagerRepository
 	balanceHistoryRepo  interfaces.BalanceHistoryRepository
+	rollupRepo          interfaces.BalanceHistoryRollupRepository
 }
 
 // NewUserMetricsService creates a new user metrics service
 func NewUserMetricsService(
 	userRepo interfaces.UserRepository,
-	wagerRepo interfaces.WagerRepository,
+	userStatsRepo interfaces.UserStatsRepository,
 	betRepo interfaces.BetRepository,
 	groupWagerRepo interfaces.GroupWagerRepository,
 	balanceHistoryRepo interfaces.BalanceHistoryRepository,
+	rollupRepo interfaces.BalanceHistoryRollupRepository,
 ) interfaces.UserMetricsService {
 	return &userMetricsService{
 		userRepo:           userRepo,
-		wagerRepo:          wagerRepo,
+		userStatsRepo:      userStatsRepo,
 		betRepo:            betRepo,
 		groupWagerRepo:     groupWagerRepo,
 		balanceHistoryRepo: balanceHistoryRepo,
+		rollupRepo:         rollupRepo,
 	}
 }
 
@@ -58,6 +62,9 @@ func (s *userMetricsService) GetScoreboard(ctx context.Context, limit int) ([]*e
 	return entries, totalBits, nil
 }
 
+// biggestTransactionsLimit is how many of a user's biggest wins and losses are included in their stats
+const biggestTransactionsLimit = 3
+
 // GetUserStats returns detailed statistics for a specific user
 func (s *userMetricsService) GetUserStats(ctx context.Context, discordID int64) (*entities.UserStats, error) {
 	// Get user
@@ -66,23 +73,19 @@ func (s *userMetricsService) GetUserStats(ctx context.Context, discordID int64)
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 	if user == nil {
-		return nil, fmt.Errorf("user not found")
+		return nil, entities.ErrUserNotFound
 	}
 
 	// Calculate reserved amount
 	reservedInWagers := user.Balance - user.AvailableBalance
 
-	// Get bet stats
-	betStats, err := s.betRepo.GetStats(ctx, discordID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get bet stats: %w", err)
-	}
-
-	// Get wager stats
-	wagerStats, err := s.wagerRepo.GetStats(ctx, discordID)
+	// Get bet and wager stats from the incrementally maintained cache
+	cachedStats, err := s.userStatsRepo.GetByDiscordID(ctx, discordID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get wager stats: %w", err)
+		return nil, fmt.Errorf("failed to get user stats: %w", err)
 	}
+	betStats := cachedStats.Bets
+	wagerStats := cachedStats.Wagers
 
 	// Get group wager stats
 	groupWagerStats, err := s.groupWagerRepo.GetStats(ctx, discordID)
@@ -119,22 +122,93 @@ func (s *userMetricsService) GetUserStats(ctx context.Context, discordID int64)
 	}
 	wagerDetail.WinPercentage = calculateWinRate(wagerStats.TotalWon, wagerStats.TotalResolved)
 
+	// Get biggest wins and losses with the wager or lottery they came from
+	biggestWins, err := s.balanceHistoryRepo.GetBiggestWins(ctx, discordID, biggestTransactionsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get biggest wins: %w", err)
+	}
+
+	biggestLosses, err := s.balanceHistoryRepo.GetBiggestLosses(ctx, discordID, biggestTransactionsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get biggest losses: %w", err)
+	}
+
+	// Get the user's own games from the house wagers on their linked Riot account
+	gameResults, err := s.groupWagerRepo.GetSubjectGameResults(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player game results: %w", err)
+	}
+
 	stats := &entities.UserStats{
 		User:             user,
 		BetStats:         betDetail,
 		WagerStats:       wagerDetail,
 		GroupWagerStats:  groupWagerStats,
 		ReservedInWagers: reservedInWagers,
+		BiggestWins:      biggestWins,
+		BiggestLosses:    biggestLosses,
+	}
+
+	if playerGames := entities.NewPlayerGameStats(gameResults); playerGames.HasGames() {
+		stats.PlayerGames = playerGames
 	}
 
 	return stats, nil
 }
 
+// GetUserStatsTimeSeries returns a user's wins, losses and balance bucketed by UTC day for the last days days
+func (s *userMetricsService) GetUserStatsTimeSeries(ctx context.Context, discordID int64, system *entities.ExternalSystem, days int) (*entities.UserStatsTimeSeries, error) {
+	if days <= 0 {
+		return nil, fmt.Errorf("days must be positive")
+	}
+
+	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, entities.ErrUserNotFound
+	}
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	from := today.AddDate(0, 0, -(days - 1))
+	to := today.AddDate(0, 0, 1)
+
+	// Complete days come from the rollups and only the days since the last rollup from raw history
+	rolledUpThrough, err := s.rollupRepo.GetRolledUpThrough(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rollup state: %w", err)
+	}
+	split := entities.SplitStatsWindow(from, to, rolledUpThrough)
+
+	var active []*entities.StatsTimeSeriesBucket
+	if split.After(from) {
+		active, err = s.rollupRepo.GetDailyStats(ctx, discordID, from, split, system)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rolled up daily stats: %w", err)
+		}
+	}
+	if split.Before(to) {
+		recent, err := s.balanceHistoryRepo.GetDailyStats(ctx, discordID, split, to, system)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get daily stats: %w", err)
+		}
+		active = append(active, recent...)
+	}
+
+	return &entities.UserStatsTimeSeries{
+		DiscordID: discordID,
+		System:    system,
+		Buckets:   entities.FillStatsTimeSeries(from, days, active, user.Balance),
+	}, nil
+}
+
 // GetLOLPredictionStats calculates LOL-specific prediction stats for all users in a guild
 func (s *userMetricsService) GetLOLPredictionStats(ctx context.Context) (map[int64]*entities.LOLPredictionStats, error) {
 	// Get all LOL wager predictions
 	lolSystem := entities.SystemLeagueOfLegends
-	predictions, err := s.groupWagerRepo.GetGroupWagerPredictions(ctx, &lolSystem)
+	predictions, err := s.groupWagerRepo.GetGroupWagerPredictions(ctx, &lolSystem, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get LOL wager predictions: %w", err)
 	}
@@ -173,9 +247,9 @@ func (s *userMetricsService) GetLOLPredictionStats(ctx context.Context) (map[int
 }
 
 // GetWagerPredictionStats calculates generic prediction stats for all users in a guild
-func (s *userMetricsService) GetWagerPredictionStats(ctx context.Context, externalSystem *entities.ExternalSystem) (map[int64]*entities.WagerPredictionStats, error) {
-	// Get wager predictions, optionally filtered by external system
-	predictions, err := s.groupWagerRepo.GetGroupWagerPredictions(ctx, externalSystem)
+func (s *userMetricsService) GetWagerPredictionStats(ctx context.Context, externalSystem *entities.ExternalSystem, since *time.Time) (map[int64]*entities.WagerPredictionStats, error) {
+	// Get wager predictions, optionally filtered by external system and creation time
+	predictions, err := s.groupWagerRepo.GetGroupWagerPredictions(ctx, externalSystem, since)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get wager predictions: %w", err)
 	}
@@ -198,6 +272,11 @@ func (s *userMetricsService) GetWagerPredictionStats(ctx context.Context, extern
 		if pred.WasCorrect {
 			stats.CorrectPredictions++
 		}
+
+		// Only resolved wagers have a payout to count toward profit
+		if pred.PayoutAmount != nil {
+			stats.NetProfit += *pred.PayoutAmount - pred.Amount
+		}
 	}
 
 	// Calculate accuracy percentages
@@ -209,9 +288,9 @@ func (s *userMetricsService) GetWagerPredictionStats(ctx context.Context, extern
 }
 
 // getGameLeaderboard is a generic method to get prediction leaderboard entries for a specific game system
-func (s *userMetricsService) getGameLeaderboard(ctx context.Context, minWagers int, system entities.ExternalSystem) ([]*entities.LOLLeaderboardEntry, int64, error) {
-	// Get all wager predictions for the specified system
-	predictions, err := s.groupWagerRepo.GetGroupWagerPredictions(ctx, &system)
+func (s *userMetricsService) getGameLeaderboard(ctx context.Context, minWagers int, system entities.ExternalSystem, since *time.Time) ([]*entities.LOLLeaderboardEntry, int64, error) {
+	// Get all wager predictions for the specified system within the window
+	predictions, err := s.groupWagerRepo.GetGroupWagerPredictions(ctx, &system, since)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get %s wager predictions: %w", system, err)
 	}
@@ -280,67 +359,31 @@ func (s *userMetricsService) getGameLeaderboard(ctx context.Context, minWagers i
 }
 
 // GetLOLLeaderboard returns LoL prediction leaderboard entries
-func (s *userMetricsService) GetLOLLeaderboard(ctx context.Context, minWagers int) ([]*entities.LOLLeaderboardEntry, int64, error) {
-	return s.getGameLeaderboard(ctx, minWagers, entities.SystemLeagueOfLegends)
+func (s *userMetricsService) GetLOLLeaderboard(ctx context.Context, minWagers int, since *time.Time) ([]*entities.LOLLeaderboardEntry, int64, error) {
+	return s.getGameLeaderboard(ctx, minWagers, entities.SystemLeagueOfLegends, since)
 }
 
 // GetTFTLeaderboard returns TFT prediction leaderboard entries
-func (s *userMetricsService) GetTFTLeaderboard(ctx context.Context, minWagers int) ([]*entities.LOLLeaderboardEntry, int64, error) {
-	return s.getGameLeaderboard(ctx, minWagers, entities.SystemTFT)
+func (s *userMetricsService) GetTFTLeaderboard(ctx context.Context, minWagers int, since *time.Time) ([]*entities.LOLLeaderboardEntry, int64, error) {
+	return s.getGameLeaderboard(ctx, minWagers, entities.SystemTFT, since)
 }
 
 // GetGamblingLeaderboard returns gambling leaderboard entries sorted by net profit
 func (s *userMetricsService) GetGamblingLeaderboard(ctx context.Context, minBets int) ([]*entities.GamblingLeaderboardEntry, int64, error) {
-	// Get all users
-	users, err := s.userRepo.GetAll(ctx)
+	// Aggregates, the minimum bet filter and ordering (net profit, then total bets) are computed by the repository
+	entries, err := s.betRepo.GetLeaderboardData(ctx, minBets)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get all users: %w", err)
+		return nil, 0, fmt.Errorf("failed to get gambling leaderboard data: %w", err)
+	}
+	if entries == nil {
+		entries = make([]*entities.GamblingLeaderboardEntry, 0)
 	}
 
-	// Build leaderboard entries
-	entries := make([]*entities.GamblingLeaderboardEntry, 0)
 	var totalBitsWagered int64
-
-	for _, user := range users {
-		// Get bet stats for this user
-		betStats, err := s.betRepo.GetStats(ctx, user.DiscordID)
-		if err != nil {
-			return nil, 0, fmt.Errorf("failed to get bet stats for user %d: %w", user.DiscordID, err)
-		}
-
-		// Only include users with bets
-		if betStats.TotalBets == 0 {
-			continue
-		}
-
-		entry := &entities.GamblingLeaderboardEntry{
-			DiscordID:    user.DiscordID,
-			TotalBets:    betStats.TotalBets,
-			TotalWins:    betStats.TotalWins,
-			TotalWagered: betStats.TotalWagered,
-			NetProfit:    betStats.TotalWon - betStats.TotalLost,
-		}
-
+	for i, entry := range entries {
 		entry.CalculateWinPercentage()
-
-		// Only include users who meet minimum bet requirement
-		if entry.QualifiesForLeaderboard(minBets) {
-			entries = append(entries, entry)
-			totalBitsWagered += betStats.TotalWagered
-		}
-	}
-
-	// Sort by net profit (descending), then by total bets (descending)
-	sort.Slice(entries, func(i, j int) bool {
-		if entries[i].NetProfit == entries[j].NetProfit {
-			return entries[i].TotalBets > entries[j].TotalBets
-		}
-		return entries[i].NetProfit > entries[j].NetProfit
-	})
-
-	// Assign ranks
-	for i := range entries {
-		entries[i].Rank = i + 1
+		entry.Rank = i + 1
+		totalBitsWagered += entry.TotalWagered
 	}
 
 	return entries, totalBitsWagered, nil
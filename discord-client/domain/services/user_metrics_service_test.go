@@ -5,10 +5,13 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,11 +25,11 @@ func TestUserMetricsService_GetLOLPredictionStats(t *testing.T) {
 
 	t.Run("calculates accuracy for LOL predictions correctly", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		// Mock data: 3 users with different prediction patterns
 		predictions := []*entities.GroupWagerPrediction{
@@ -46,7 +49,7 @@ func TestUserMetricsService_GetLOLPredictionStats(t *testing.T) {
 		}
 
 		lolSystem := entities.SystemLeagueOfLegends
-		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &lolSystem).Return(predictions, nil)
+		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &lolSystem, (*time.Time)(nil)).Return(predictions, nil)
 
 		// Execute
 		stats, err := service.GetLOLPredictionStats(ctx)
@@ -84,11 +87,11 @@ func TestUserMetricsService_GetLOLPredictionStats(t *testing.T) {
 
 	t.Run("filters out non-Win/Loss options", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		// Include some non-Win/Loss options that should be filtered
 		predictions := []*entities.GroupWagerPrediction{
@@ -99,7 +102,7 @@ func TestUserMetricsService_GetLOLPredictionStats(t *testing.T) {
 		}
 
 		lolSystem := entities.SystemLeagueOfLegends
-		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &lolSystem).Return(predictions, nil)
+		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &lolSystem, (*time.Time)(nil)).Return(predictions, nil)
 
 		// Execute
 		stats, err := service.GetLOLPredictionStats(ctx)
@@ -119,15 +122,15 @@ func TestUserMetricsService_GetLOLPredictionStats(t *testing.T) {
 
 	t.Run("handles repository error", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		expectedErr := fmt.Errorf("database error")
 		lolSystem := entities.SystemLeagueOfLegends
-		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &lolSystem).Return(nil, expectedErr)
+		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &lolSystem, (*time.Time)(nil)).Return(nil, expectedErr)
 
 		// Execute
 		stats, err := service.GetLOLPredictionStats(ctx)
@@ -147,22 +150,24 @@ func TestUserMetricsService_GetWagerPredictionStats(t *testing.T) {
 
 	t.Run("calculates stats for all wagers when no filter", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
+		payout := int64(2500)
+		zero := int64(0)
 		predictions := []*entities.GroupWagerPrediction{
-			{DiscordID: 100, GroupWagerID: 1, OptionID: 1, OptionText: "Option A", WinningOptionID: 1, Amount: 1000, WasCorrect: true},
-			{DiscordID: 100, GroupWagerID: 2, OptionID: 3, OptionText: "Option B", WinningOptionID: 4, Amount: 500, WasCorrect: false},
+			{DiscordID: 100, GroupWagerID: 1, OptionID: 1, OptionText: "Option A", WinningOptionID: 1, Amount: 1000, WasCorrect: true, PayoutAmount: &payout},
+			{DiscordID: 100, GroupWagerID: 2, OptionID: 3, OptionText: "Option B", WinningOptionID: 4, Amount: 500, WasCorrect: false, PayoutAmount: &zero},
 			{DiscordID: 200, GroupWagerID: 1, OptionID: 2, OptionText: "Option B", WinningOptionID: 1, Amount: 2000, WasCorrect: false},
 		}
 
-		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, (*entities.ExternalSystem)(nil)).Return(predictions, nil)
+		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, (*entities.ExternalSystem)(nil), (*time.Time)(nil)).Return(predictions, nil)
 
 		// Execute
-		stats, err := service.GetWagerPredictionStats(ctx, nil)
+		stats, err := service.GetWagerPredictionStats(ctx, nil, nil)
 
 		// Assert
 		require.NoError(t, err)
@@ -172,27 +177,31 @@ func TestUserMetricsService_GetWagerPredictionStats(t *testing.T) {
 		assert.Equal(t, 2, user1Stats.TotalPredictions)
 		assert.Equal(t, 1, user1Stats.CorrectPredictions)
 		assert.Equal(t, float64(50), user1Stats.AccuracyPercentage)
+		assert.Equal(t, int64(1000), user1Stats.NetProfit)
+
+		// Unresolved predictions have no payout and do not count toward profit
+		assert.Equal(t, int64(0), stats[200].NetProfit)
 
 		mockGroupWagerRepo.AssertExpectations(t)
 	})
 
 	t.Run("filters by external system when specified", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		tftSystem := entities.SystemTFT
 		predictions := []*entities.GroupWagerPrediction{
 			{DiscordID: 100, GroupWagerID: 1, OptionID: 1, OptionText: "Top 4", WinningOptionID: 1, Amount: 1000, WasCorrect: true, ExternalSystem: &tftSystem},
 		}
 
-		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem).Return(predictions, nil)
+		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem, (*time.Time)(nil)).Return(predictions, nil)
 
 		// Execute
-		stats, err := service.GetWagerPredictionStats(ctx, &tftSystem)
+		stats, err := service.GetWagerPredictionStats(ctx, &tftSystem, nil)
 
 		// Assert
 		require.NoError(t, err)
@@ -200,6 +209,28 @@ func TestUserMetricsService_GetWagerPredictionStats(t *testing.T) {
 
 		mockGroupWagerRepo.AssertExpectations(t)
 	})
+
+	t.Run("limits to the time window when specified", func(t *testing.T) {
+		mockUserRepo := new(testhelpers.MockUserRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
+		mockBetRepo := new(testhelpers.MockBetRepository)
+		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
+		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
+
+		lolSystem := entities.SystemLeagueOfLegends
+		since := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &lolSystem, &since).Return([]*entities.GroupWagerPrediction{}, nil)
+
+		// Execute
+		stats, err := service.GetWagerPredictionStats(ctx, &lolSystem, &since)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, stats)
+
+		mockGroupWagerRepo.AssertExpectations(t)
+	})
 }
 
 func TestUserMetricsService_GetScoreboard(t *testing.T) {
@@ -207,11 +238,11 @@ func TestUserMetricsService_GetScoreboard(t *testing.T) {
 
 	t.Run("returns scoreboard with correct statistics", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		// Mock scoreboard data from the optimized query
 		scoreboardEntries := []*entities.ScoreboardEntry{
@@ -271,17 +302,17 @@ func TestUserMetricsService_GetScoreboard(t *testing.T) {
 		assert.Equal(t, int64(1000), entries[1].TotalDonations)
 
 		mockUserRepo.AssertExpectations(t)
-		mockWagerRepo.AssertExpectations(t)
+		mockUserStatsRepo.AssertExpectations(t)
 		mockBetRepo.AssertExpectations(t)
 	})
 
 	t.Run("applies limit correctly", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		// Mock scoreboard entries (5 users)
 		scoreboardEntries := make([]*entities.ScoreboardEntry, 5)
@@ -322,11 +353,11 @@ func TestUserMetricsService_GetUserStats(t *testing.T) {
 
 	t.Run("returns complete user stats", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		// Mock user
 		user := &entities.User{
@@ -338,7 +369,7 @@ func TestUserMetricsService_GetUserStats(t *testing.T) {
 		mockUserRepo.On("GetByDiscordID", ctx, int64(100)).Return(user, nil)
 
 		// Mock bet stats
-		betStats := &entities.BetStats{
+		betStats := entities.BetStats{
 			TotalBets:   20,
 			TotalWins:   15,
 			TotalLosses: 5,
@@ -348,10 +379,9 @@ func TestUserMetricsService_GetUserStats(t *testing.T) {
 			BiggestWin:   3000,
 			BiggestLoss:  500,
 		}
-		mockBetRepo.On("GetStats", ctx, int64(100)).Return(betStats, nil)
 
 		// Mock wager stats
-		wagerStats := &entities.WagerStats{
+		wagerStats := entities.WagerStats{
 			TotalWagers:    30,
 			TotalProposed:  15,
 			TotalAccepted:  10,
@@ -364,7 +394,11 @@ func TestUserMetricsService_GetUserStats(t *testing.T) {
 			BiggestWin:     2000,
 			BiggestLoss:    1000,
 		}
-		mockWagerRepo.On("GetStats", ctx, int64(100)).Return(wagerStats, nil)
+		mockUserStatsRepo.On("GetByDiscordID", ctx, int64(100)).Return(&entities.CachedUserStats{
+			DiscordID: 100,
+			Bets:      betStats,
+			Wagers:    wagerStats,
+		}, nil)
 
 		// Mock group wager stats
 		groupWagerStats := &entities.GroupWagerStats{
@@ -375,6 +409,24 @@ func TestUserMetricsService_GetUserStats(t *testing.T) {
 		}
 		mockGroupWagerRepo.On("GetStats", ctx, int64(100)).Return(groupWagerStats, nil)
 
+		// Mock biggest wins and losses
+		condition := "Will T1 win Worlds?"
+		biggestWins := []*entities.BalanceHistoryWithContext{
+			{
+				BalanceHistory:      entities.BalanceHistory{ChangeAmount: 250000, TransactionType: entities.TransactionTypeGroupWagerWin},
+				GroupWagerCondition: &condition,
+			},
+		}
+		mockBalanceHistoryRepo.On("GetBiggestWins", ctx, int64(100), 3).Return(biggestWins, nil)
+		mockBalanceHistoryRepo.On("GetBiggestLosses", ctx, int64(100), 3).Return([]*entities.BalanceHistoryWithContext{}, nil)
+
+		// Mock the user's own linked games
+		mockGroupWagerRepo.On("GetSubjectGameResults", ctx, int64(100)).Return([]*entities.PlayerGameResult{
+			{System: entities.SystemLeagueOfLegends, WinningOption: "Win", OptionCount: 2},
+			{System: entities.SystemLeagueOfLegends, WinningOption: "Loss", OptionCount: 2},
+			{System: entities.SystemTFT, WinningOption: "3-4", OptionCount: 4},
+		}, nil)
+
 		// Execute
 		stats, err := service.GetUserStats(ctx, 100)
 
@@ -397,19 +449,28 @@ func TestUserMetricsService_GetUserStats(t *testing.T) {
 		// Check group wager stats
 		assert.Equal(t, groupWagerStats, stats.GroupWagerStats)
 
+		// Check biggest wins and losses
+		assert.Equal(t, biggestWins, stats.BiggestWins)
+		assert.Empty(t, stats.BiggestLosses)
+
+		// Check the user's own games
+		require.NotNil(t, stats.PlayerGames)
+		assert.Equal(t, &entities.PlayerGameStats{LoLGames: 2, LoLWins: 1, TFTGames: 1, TFTTopHalf: 1}, stats.PlayerGames)
+
 		mockUserRepo.AssertExpectations(t)
-		mockWagerRepo.AssertExpectations(t)
+		mockUserStatsRepo.AssertExpectations(t)
 		mockBetRepo.AssertExpectations(t)
 		mockGroupWagerRepo.AssertExpectations(t)
+		mockBalanceHistoryRepo.AssertExpectations(t)
 	})
 
 	t.Run("returns error when user not found", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		mockUserRepo.On("GetByDiscordID", ctx, int64(999)).Return(nil, nil)
 
@@ -430,11 +491,11 @@ func TestUserMetricsService_GetTFTLeaderboard(t *testing.T) {
 
 	t.Run("calculates TFT leaderboard with placement options and 4:1 odds", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		// Mock TFT predictions with placement options and 4:1 odds (4x payout)
 		predictions := []*entities.GroupWagerPrediction{
@@ -458,10 +519,10 @@ func TestUserMetricsService_GetTFTLeaderboard(t *testing.T) {
 		}
 
 		tftSystem := entities.SystemTFT
-		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem).Return(predictions, nil)
+		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem, (*time.Time)(nil)).Return(predictions, nil)
 
 		// Execute
-		entries, totalBits, err := service.GetTFTLeaderboard(ctx, 1)
+		entries, totalBits, err := service.GetTFTLeaderboard(ctx, 1, nil)
 
 		// Assert
 		require.NoError(t, err)
@@ -498,11 +559,11 @@ func TestUserMetricsService_GetTFTLeaderboard(t *testing.T) {
 
 	t.Run("handles null payout amounts gracefully", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		// Include predictions with various payout states
 		predictions := []*entities.GroupWagerPrediction{
@@ -513,10 +574,10 @@ func TestUserMetricsService_GetTFTLeaderboard(t *testing.T) {
 		}
 
 		tftSystem := entities.SystemTFT
-		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem).Return(predictions, nil)
+		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem, (*time.Time)(nil)).Return(predictions, nil)
 
 		// Execute
-		entries, totalBits, err := service.GetTFTLeaderboard(ctx, 1)
+		entries, totalBits, err := service.GetTFTLeaderboard(ctx, 1, nil)
 
 		// Assert
 		require.NoError(t, err)
@@ -537,11 +598,11 @@ func TestUserMetricsService_GetTFTLeaderboard(t *testing.T) {
 
 	t.Run("applies minimum wager requirement correctly", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		predictions := []*entities.GroupWagerPrediction{
 			// User with 3 predictions - should qualify for minWagers=3
@@ -555,10 +616,10 @@ func TestUserMetricsService_GetTFTLeaderboard(t *testing.T) {
 		}
 
 		tftSystem := entities.SystemTFT
-		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem).Return(predictions, nil)
+		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem, (*time.Time)(nil)).Return(predictions, nil)
 
 		// Execute with minimum 3 wagers
-		entries, totalBits, err := service.GetTFTLeaderboard(ctx, 3)
+		entries, totalBits, err := service.GetTFTLeaderboard(ctx, 3, nil)
 
 		// Assert
 		require.NoError(t, err)
@@ -575,11 +636,11 @@ func TestUserMetricsService_GetTFTLeaderboard(t *testing.T) {
 
 	t.Run("handles tiebreaker logic - same profit/loss sorted by total predictions", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		predictions := []*entities.GroupWagerPrediction{
 			// User 1: 1 correct prediction = +2000 profit/loss
@@ -593,10 +654,10 @@ func TestUserMetricsService_GetTFTLeaderboard(t *testing.T) {
 		}
 
 		tftSystem := entities.SystemTFT
-		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem).Return(predictions, nil)
+		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem, (*time.Time)(nil)).Return(predictions, nil)
 
 		// Execute
-		entries, _, err := service.GetTFTLeaderboard(ctx, 1)
+		entries, _, err := service.GetTFTLeaderboard(ctx, 1, nil)
 
 		// Assert
 		require.NoError(t, err)
@@ -618,20 +679,20 @@ func TestUserMetricsService_GetTFTLeaderboard(t *testing.T) {
 
 	t.Run("handles empty predictions", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		// No predictions
 		var predictions []*entities.GroupWagerPrediction
 
 		tftSystem := entities.SystemTFT
-		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem).Return(predictions, nil)
+		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem, (*time.Time)(nil)).Return(predictions, nil)
 
 		// Execute
-		entries, totalBits, err := service.GetTFTLeaderboard(ctx, 1)
+		entries, totalBits, err := service.GetTFTLeaderboard(ctx, 1, nil)
 
 		// Assert
 		require.NoError(t, err)
@@ -643,18 +704,18 @@ func TestUserMetricsService_GetTFTLeaderboard(t *testing.T) {
 
 	t.Run("handles repository error", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		expectedErr := fmt.Errorf("database connection failed")
 		tftSystem := entities.SystemTFT
-		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem).Return(nil, expectedErr)
+		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem, (*time.Time)(nil)).Return(nil, expectedErr)
 
 		// Execute
-		entries, totalBits, err := service.GetTFTLeaderboard(ctx, 1)
+		entries, totalBits, err := service.GetTFTLeaderboard(ctx, 1, nil)
 
 		// Assert
 		require.Error(t, err)
@@ -668,11 +729,11 @@ func TestUserMetricsService_GetTFTLeaderboard(t *testing.T) {
 
 	t.Run("verifies SystemTFT is passed correctly to repository", func(t *testing.T) {
 		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+		mockUserStatsRepo := new(testhelpers.MockUserStatsRepository)
 		mockBetRepo := new(testhelpers.MockBetRepository)
 		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
 		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+		service := NewUserMetricsService(mockUserRepo, mockUserStatsRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
 
 		predictions := []*entities.GroupWagerPrediction{
 			{DiscordID: 100, GroupWagerID: 1, OptionID: 1, OptionText: "1-2", WinningOptionID: 1, Amount: 1000, WasCorrect: true, PayoutAmount: ptr(4000)},
@@ -680,10 +741,10 @@ func TestUserMetricsService_GetTFTLeaderboard(t *testing.T) {
 
 		tftSystem := entities.SystemTFT
 		// Verify that SystemTFT constant is passed exactly
-		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem).Return(predictions, nil)
+		mockGroupWagerRepo.On("GetGroupWagerPredictions", ctx, &tftSystem, (*time.Time)(nil)).Return(predictions, nil)
 
 		// Execute
-		_, _, err := service.GetTFTLeaderboard(ctx, 1)
+		_, _, err := service.GetTFTLeaderboard(ctx, 1, nil)
 
 		// Assert
 		require.NoError(t, err)
@@ -694,54 +755,27 @@ func TestUserMetricsService_GetTFTLeaderboard(t *testing.T) {
 func TestUserMetricsService_GetGamblingLeaderboard(t *testing.T) {
 	ctx := context.Background()
 
-	t.Run("calculates gambling leaderboard with correct net profit", func(t *testing.T) {
-		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
+	newService := func() (*testhelpers.MockBetRepository, interfaces.UserMetricsService) {
 		mockBetRepo := new(testhelpers.MockBetRepository)
-		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
-		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
-
-		// Mock users
-		users := []*entities.User{
-			{DiscordID: 100, Username: "user1", Balance: 5000},
-			{DiscordID: 200, Username: "user2", Balance: 3000},
-			{DiscordID: 300, Username: "user3", Balance: 2000},
-		}
-		mockUserRepo.On("GetAll", ctx).Return(users, nil)
-
-		// Mock bet stats for each user
-		mockBetRepo.On("GetStats", ctx, int64(100)).Return(&entities.BetStats{
-			TotalBets:    20,
-			TotalWins:    15,
-			TotalLosses:  5,
-			TotalWagered: 10000,
-			TotalWon:     12000,
-			TotalLost:    2000,
-			BiggestWin:   3000,
-			BiggestLoss:  500,
-		}, nil)
-
-		mockBetRepo.On("GetStats", ctx, int64(200)).Return(&entities.BetStats{
-			TotalBets:    10,
-			TotalWins:    4,
-			TotalLosses:  6,
-			TotalWagered: 5000,
-			TotalWon:     5500,
-			TotalLost:    3000,
-			BiggestWin:   1000,
-			BiggestLoss:  800,
-		}, nil)
-
-		mockBetRepo.On("GetStats", ctx, int64(300)).Return(&entities.BetStats{
-			TotalBets:    8,
-			TotalWins:    3,
-			TotalLosses:  5,
-			TotalWagered: 4000,
-			TotalWon:     4200,
-			TotalLost:    2500,
-			BiggestWin:   800,
-			BiggestLoss:  600,
+		service := NewUserMetricsService(
+			new(testhelpers.MockUserRepository),
+			new(testhelpers.MockUserStatsRepository),
+			mockBetRepo,
+			new(testhelpers.MockGroupWagerRepository),
+			new(testhelpers.MockBalanceHistoryRepository),
+			new(testhelpers.MockBalanceHistoryRollupRepository),
+		)
+		return mockBetRepo, service
+	}
+
+	t.Run("ranks repository aggregates and calculates win percentage", func(t *testing.T) {
+		mockBetRepo, service := newService()
+
+		// Repository returns entries already filtered and ordered by net profit
+		mockBetRepo.On("GetLeaderboardData", ctx, 5).Return([]*entities.GamblingLeaderboardEntry{
+			{DiscordID: 100, TotalBets: 20, TotalWins: 15, TotalWagered: 10000, NetProfit: 10000},
+			{DiscordID: 200, TotalBets: 10, TotalWins: 4, TotalWagered: 5000, NetProfit: 2500},
+			{DiscordID: 300, TotalBets: 8, TotalWins: 3, TotalWagered: 4000, NetProfit: 1700},
 		}, nil)
 
 		// Execute
@@ -752,122 +786,59 @@ func TestUserMetricsService_GetGamblingLeaderboard(t *testing.T) {
 		require.Len(t, entries, 3)
 		assert.Equal(t, int64(19000), totalBitsWagered)
 
-		// Check ranking by net profit
-		// User 1: 12000 - 2000 = 10000 profit
-		// User 2: 5500 - 3000 = 2500 profit
-		// User 3: 4200 - 2500 = 1700 profit
 		assert.Equal(t, 1, entries[0].Rank)
 		assert.Equal(t, int64(100), entries[0].DiscordID)
-		assert.Equal(t, 20, entries[0].TotalBets)
-		assert.Equal(t, 15, entries[0].TotalWins)
 		assert.Equal(t, float64(75), entries[0].WinPercentage)
 		assert.Equal(t, int64(10000), entries[0].NetProfit)
 
 		assert.Equal(t, 2, entries[1].Rank)
 		assert.Equal(t, int64(200), entries[1].DiscordID)
-		assert.Equal(t, 10, entries[1].TotalBets)
-		assert.Equal(t, 4, entries[1].TotalWins)
 		assert.Equal(t, float64(40), entries[1].WinPercentage)
-		assert.Equal(t, int64(2500), entries[1].NetProfit)
 
 		assert.Equal(t, 3, entries[2].Rank)
 		assert.Equal(t, int64(300), entries[2].DiscordID)
-		assert.Equal(t, 8, entries[2].TotalBets)
-		assert.Equal(t, 3, entries[2].TotalWins)
 		assert.InDelta(t, 37.5, entries[2].WinPercentage, 0.01)
-		assert.Equal(t, int64(1700), entries[2].NetProfit)
 
-		mockUserRepo.AssertExpectations(t)
 		mockBetRepo.AssertExpectations(t)
+		mockBetRepo.AssertNotCalled(t, "GetStats")
 	})
 
-	t.Run("filters by minimum bets requirement", func(t *testing.T) {
-		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
-		mockBetRepo := new(testhelpers.MockBetRepository)
-		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
-		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
-
-		users := []*entities.User{
-			{DiscordID: 100, Username: "user1", Balance: 5000},
-			{DiscordID: 200, Username: "user2", Balance: 3000},
-			{DiscordID: 300, Username: "user3", Balance: 2000},
-		}
-		mockUserRepo.On("GetAll", ctx).Return(users, nil)
-
-		// User 1: 20 bets (qualifies for minBets=5)
-		mockBetRepo.On("GetStats", ctx, int64(100)).Return(&entities.BetStats{
-			TotalBets:    20,
-			TotalWins:    15,
-			TotalWagered: 10000,
-			TotalWon:     12000,
-			TotalLost:    2000,
-		}, nil)
-
-		// User 2: 2 bets (doesn't qualify for minBets=5)
-		mockBetRepo.On("GetStats", ctx, int64(200)).Return(&entities.BetStats{
-			TotalBets:    2,
-			TotalWins:    1,
-			TotalWagered: 1000,
-			TotalWon:     1500,
-			TotalLost:    500,
-		}, nil)
+	t.Run("passes minimum bets to the repository", func(t *testing.T) {
+		mockBetRepo, service := newService()
 
-		// User 3: 0 bets (doesn't qualify - no bets at all)
-		mockBetRepo.On("GetStats", ctx, int64(300)).Return(&entities.BetStats{
-			TotalBets:    0,
-			TotalWins:    0,
-			TotalWagered: 0,
-			TotalWon:     0,
-			TotalLost:    0,
+		mockBetRepo.On("GetLeaderboardData", ctx, 50).Return([]*entities.GamblingLeaderboardEntry{
+			{DiscordID: 100, TotalBets: 60, TotalWins: 30, TotalWagered: 10000, NetProfit: -500},
 		}, nil)
 
-		// Execute with minBets=5
-		entries, totalBitsWagered, err := service.GetGamblingLeaderboard(ctx, 5)
+		entries, totalBitsWagered, err := service.GetGamblingLeaderboard(ctx, 50)
 
-		// Assert
 		require.NoError(t, err)
-		require.Len(t, entries, 1) // Only user 1 qualifies
+		require.Len(t, entries, 1)
 		assert.Equal(t, int64(10000), totalBitsWagered)
 		assert.Equal(t, int64(100), entries[0].DiscordID)
-
-		mockUserRepo.AssertExpectations(t)
 		mockBetRepo.AssertExpectations(t)
 	})
 
-	t.Run("handles empty user list", func(t *testing.T) {
-		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
-		mockBetRepo := new(testhelpers.MockBetRepository)
-		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
-		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+	t.Run("handles no qualifying users", func(t *testing.T) {
+		mockBetRepo, service := newService()
 
-		var users []*entities.User
-		mockUserRepo.On("GetAll", ctx).Return(users, nil)
+		mockBetRepo.On("GetLeaderboardData", ctx, 5).Return(nil, nil)
 
 		// Execute
 		entries, totalBitsWagered, err := service.GetGamblingLeaderboard(ctx, 5)
 
 		// Assert
 		require.NoError(t, err)
+		assert.NotNil(t, entries)
 		assert.Len(t, entries, 0)
 		assert.Equal(t, int64(0), totalBitsWagered)
-
-		mockUserRepo.AssertExpectations(t)
 	})
 
-	t.Run("handles repository error from GetAll", func(t *testing.T) {
-		mockUserRepo := new(testhelpers.MockUserRepository)
-		mockWagerRepo := new(testhelpers.MockWagerRepository)
-		mockBetRepo := new(testhelpers.MockBetRepository)
-		mockGroupWagerRepo := new(testhelpers.MockGroupWagerRepository)
-		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
-		service := NewUserMetricsService(mockUserRepo, mockWagerRepo, mockBetRepo, mockGroupWagerRepo, mockBalanceHistoryRepo)
+	t.Run("handles repository error", func(t *testing.T) {
+		mockBetRepo, service := newService()
 
 		expectedErr := fmt.Errorf("database connection failed")
-		mockUserRepo.On("GetAll", ctx).Return(nil, expectedErr)
+		mockBetRepo.On("GetLeaderboardData", ctx, 5).Return(nil, expectedErr)
 
 		// Execute
 		entries, totalBitsWagered, err := service.GetGamblingLeaderboard(ctx, 5)
@@ -876,9 +847,83 @@ func TestUserMetricsService_GetGamblingLeaderboard(t *testing.T) {
 		require.Error(t, err)
 		assert.Nil(t, entries)
 		assert.Equal(t, int64(0), totalBitsWagered)
-		assert.Contains(t, err.Error(), "failed to get all users")
+		assert.Contains(t, err.Error(), "failed to get gambling leaderboard data")
 		assert.Contains(t, err.Error(), "database connection failed")
+	})
+}
 
-		mockUserRepo.AssertExpectations(t)
+func TestUserMetricsService_GetUserStatsTimeSeries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fills every day in the window", func(t *testing.T) {
+		mockUserRepo := new(testhelpers.MockUserRepository)
+		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+		mockRollupRepo := new(testhelpers.MockBalanceHistoryRollupRepository)
+		service := NewUserMetricsService(mockUserRepo, new(testhelpers.MockUserStatsRepository), new(testhelpers.MockBetRepository), new(testhelpers.MockGroupWagerRepository), mockBalanceHistoryRepo, mockRollupRepo)
+
+		mockUserRepo.On("GetByDiscordID", ctx, int64(100)).Return(&entities.User{DiscordID: 100, Balance: 1500}, nil)
+		mockRollupRepo.On("GetRolledUpThrough", ctx).Return(nil, nil)
+
+		now := time.Now().UTC()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		lolSystem := entities.SystemLeagueOfLegends
+		active := []*entities.StatsTimeSeriesBucket{
+			{Day: today.AddDate(0, 0, -1), Wins: 2, Losses: 1, NetProfit: 500, OpeningBalance: 1000, ClosingBalance: 1500},
+		}
+		mockBalanceHistoryRepo.On("GetDailyStats", ctx, int64(100), today.AddDate(0, 0, -29), today.AddDate(0, 0, 1), &lolSystem).Return(active, nil)
+
+		series, err := service.GetUserStatsTimeSeries(ctx, 100, &lolSystem, 30)
+
+		require.NoError(t, err)
+		require.Len(t, series.Buckets, 30)
+		assert.Equal(t, int64(1000), series.Buckets[0].ClosingBalance)
+		assert.Equal(t, 2, series.TotalWins())
+		assert.Equal(t, 1, series.TotalLosses())
+		assert.Equal(t, int64(1500), series.Buckets[29].ClosingBalance)
+		mockBalanceHistoryRepo.AssertExpectations(t)
 	})
-}
\ No newline at end of file
+
+	t.Run("reads rolled up days from rollups and the rest from raw history", func(t *testing.T) {
+		mockUserRepo := new(testhelpers.MockUserRepository)
+		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+		mockRollupRepo := new(testhelpers.MockBalanceHistoryRollupRepository)
+		service := NewUserMetricsService(mockUserRepo, new(testhelpers.MockUserStatsRepository), new(testhelpers.MockBetRepository), new(testhelpers.MockGroupWagerRepository), mockBalanceHistoryRepo, mockRollupRepo)
+
+		mockUserRepo.On("GetByDiscordID", ctx, int64(100)).Return(&entities.User{DiscordID: 100, Balance: 900}, nil)
+
+		now := time.Now().UTC()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		rolledUpThrough := today.AddDate(0, 0, -1)
+		mockRollupRepo.On("GetRolledUpThrough", ctx).Return(&rolledUpThrough, nil)
+		mockRollupRepo.On("GetDailyStats", ctx, int64(100), today.AddDate(0, 0, -6), rolledUpThrough, (*entities.ExternalSystem)(nil)).Return([]*entities.StatsTimeSeriesBucket{
+			{Day: today.AddDate(0, 0, -3), Wins: 1, NetProfit: 200, OpeningBalance: 1000, ClosingBalance: 1200},
+		}, nil)
+		mockBalanceHistoryRepo.On("GetDailyStats", ctx, int64(100), rolledUpThrough, today.AddDate(0, 0, 1), (*entities.ExternalSystem)(nil)).Return([]*entities.StatsTimeSeriesBucket{
+			{Day: today, Losses: 1, NetProfit: -300, OpeningBalance: 1200, ClosingBalance: 900},
+		}, nil)
+
+		series, err := service.GetUserStatsTimeSeries(ctx, 100, nil, 7)
+
+		require.NoError(t, err)
+		require.Len(t, series.Buckets, 7)
+		assert.Equal(t, int64(1000), series.Buckets[0].ClosingBalance)
+		assert.Equal(t, int64(1200), series.Buckets[5].ClosingBalance)
+		assert.Equal(t, int64(900), series.Buckets[6].ClosingBalance)
+		assert.Equal(t, int64(-100), series.NetProfit())
+		mockRollupRepo.AssertExpectations(t)
+		mockBalanceHistoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		mockUserRepo := new(testhelpers.MockUserRepository)
+		mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+		service := NewUserMetricsService(mockUserRepo, new(testhelpers.MockUserStatsRepository), new(testhelpers.MockBetRepository), new(testhelpers.MockGroupWagerRepository), mockBalanceHistoryRepo, new(testhelpers.MockBalanceHistoryRollupRepository))
+
+		mockUserRepo.On("GetByDiscordID", ctx, int64(100)).Return(nil, nil)
+
+		_, err := service.GetUserStatsTimeSeries(ctx, 100, nil, 30)
+
+		assert.Error(t, err)
+		mockBalanceHistoryRepo.AssertNotCalled(t, "GetDailyStats", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
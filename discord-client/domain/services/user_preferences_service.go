@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// userPreferencesService implements business logic for per-user display preferences
+type userPreferencesService struct {
+	prefsRepo interfaces.UserPreferencesRepository
+}
+
+// NewUserPreferencesService creates a new user preferences service
+func NewUserPreferencesService(prefsRepo interfaces.UserPreferencesRepository) interfaces.UserPreferencesService {
+	return &userPreferencesService{
+		prefsRepo: prefsRepo,
+	}
+}
+
+// GetPreferences returns a user's preferences, falling back to the defaults if they have never set any
+func (s *userPreferencesService) GetPreferences(ctx context.Context, discordID int64) (*entities.UserPreferences, error) {
+	prefs, err := s.prefsRepo.GetByDiscordID(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user preferences: %w", err)
+	}
+	if prefs == nil {
+		return entities.DefaultUserPreferences(discordID), nil
+	}
+	return prefs, nil
+}
+
+// GetOddsFormat returns the odds format a user wants odds shown in
+func (s *userPreferencesService) GetOddsFormat(ctx context.Context, discordID int64) (entities.OddsFormat, error) {
+	prefs, err := s.GetPreferences(ctx, discordID)
+	if err != nil {
+		return "", err
+	}
+	return prefs.OddsFormat, nil
+}
+
+// SetOddsFormat sets the odds format a user wants odds shown in
+func (s *userPreferencesService) SetOddsFormat(ctx context.Context, discordID int64, format entities.OddsFormat) (*entities.UserPreferences, error) {
+	if !format.IsValid() {
+		return nil, fmt.Errorf("unknown odds format %q", format)
+	}
+
+	prefs, err := s.GetPreferences(ctx, discordID)
+	if err != nil {
+		return nil, err
+	}
+
+	prefs.OddsFormat = format
+	if err := s.prefsRepo.Upsert(ctx, prefs); err != nil {
+		return nil, fmt.Errorf("failed to save user preferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
+// SetHideFromGlobalLeaderboard sets whether the user is kept off the cross-guild leaderboard
+func (s *userPreferencesService) SetHideFromGlobalLeaderboard(ctx context.Context, discordID int64, hidden bool) (*entities.UserPreferences, error) {
+	prefs, err := s.GetPreferences(ctx, discordID)
+	if err != nil {
+		return nil, err
+	}
+
+	prefs.HideFromGlobalLeaderboard = hidden
+	if err := s.prefsRepo.Upsert(ctx, prefs); err != nil {
+		return nil, fmt.Errorf("failed to save user preferences: %w", err)
+	}
+
+	return prefs, nil
+}
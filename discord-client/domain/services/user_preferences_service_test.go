@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUserPreferencesService_GetPreferences(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults when unset", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		prefsRepo := new(testhelpers.MockUserPreferencesRepository)
+		prefsRepo.On("GetByDiscordID", ctx, int64(123)).Return(nil, nil)
+
+		service := NewUserPreferencesService(prefsRepo)
+		format, err := service.GetOddsFormat(ctx, 123)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.OddsFormatMultiplier, format)
+	})
+
+	t.Run("stored preference", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		prefsRepo := new(testhelpers.MockUserPreferencesRepository)
+		prefsRepo.On("GetByDiscordID", ctx, int64(123)).Return(&entities.UserPreferences{
+			DiscordID:  123,
+			OddsFormat: entities.OddsFormatAmerican,
+		}, nil)
+
+		service := NewUserPreferencesService(prefsRepo)
+		format, err := service.GetOddsFormat(ctx, 123)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.OddsFormatAmerican, format)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		prefsRepo := new(testhelpers.MockUserPreferencesRepository)
+		prefsRepo.On("GetByDiscordID", ctx, int64(123)).Return(nil, errors.New("db down"))
+
+		service := NewUserPreferencesService(prefsRepo)
+		_, err := service.GetPreferences(ctx, 123)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestUserPreferencesService_SetOddsFormat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("saves valid format", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		prefsRepo := new(testhelpers.MockUserPreferencesRepository)
+		prefsRepo.On("GetByDiscordID", ctx, int64(123)).Return(nil, nil)
+		prefsRepo.On("Upsert", ctx, mock.MatchedBy(func(p *entities.UserPreferences) bool {
+			return p.DiscordID == 123 && p.OddsFormat == entities.OddsFormatFractional
+		})).Return(nil)
+
+		service := NewUserPreferencesService(prefsRepo)
+		prefs, err := service.SetOddsFormat(ctx, 123, entities.OddsFormatFractional)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.OddsFormatFractional, prefs.OddsFormat)
+		prefsRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects unknown format", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		prefsRepo := new(testhelpers.MockUserPreferencesRepository)
+
+		service := NewUserPreferencesService(prefsRepo)
+		_, err := service.SetOddsFormat(ctx, 123, entities.OddsFormat("roman"))
+
+		assert.Error(t, err)
+		prefsRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserPreferencesService_SetHideFromGlobalLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	prefsRepo := new(testhelpers.MockUserPreferencesRepository)
+	prefsRepo.On("GetByDiscordID", ctx, int64(123)).Return(&entities.UserPreferences{
+		DiscordID:  123,
+		OddsFormat: entities.OddsFormatDecimal,
+	}, nil)
+	prefsRepo.On("Upsert", ctx, mock.MatchedBy(func(p *entities.UserPreferences) bool {
+		return p.HideFromGlobalLeaderboard && p.OddsFormat == entities.OddsFormatDecimal
+	})).Return(nil)
+
+	service := NewUserPreferencesService(prefsRepo)
+	prefs, err := service.SetHideFromGlobalLeaderboard(ctx, 123, true)
+
+	assert.NoError(t, err)
+	assert.True(t, prefs.HideFromGlobalLeaderboard)
+	prefsRepo.AssertExpectations(t)
+}
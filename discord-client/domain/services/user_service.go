@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"gambler/discord-client/domain/entities"
 	"gambler/discord-client/domain/interfaces"
@@ -13,20 +14,22 @@ import (
 type userService struct {
 	userRepo           interfaces.UserRepository
 	balanceHistoryRepo interfaces.BalanceHistoryRepository
+	guildSettingsRepo  interfaces.GuildSettingsRepository
 	eventPublisher     interfaces.EventPublisher
 }
 
 // NewUserService creates a new user service
-func NewUserService(userRepo interfaces.UserRepository, balanceHistoryRepo interfaces.BalanceHistoryRepository, eventPublisher interfaces.EventPublisher) interfaces.UserService {
+func NewUserService(userRepo interfaces.UserRepository, balanceHistoryRepo interfaces.BalanceHistoryRepository, guildSettingsRepo interfaces.GuildSettingsRepository, eventPublisher interfaces.EventPublisher) interfaces.UserService {
 	return &userService{
 		userRepo:           userRepo,
 		balanceHistoryRepo: balanceHistoryRepo,
+		guildSettingsRepo:  guildSettingsRepo,
 		eventPublisher:     eventPublisher,
 	}
 }
 
-// GetOrCreateUser retrieves an existing user or creates a new one with initial balance
-func (s *userService) GetOrCreateUser(ctx context.Context, discordID int64, username string) (*entities.User, error) {
+// GetOrCreateUser retrieves an existing user or creates a new one with the guild's starting balance
+func (s *userService) GetOrCreateUser(ctx context.Context, guildID, discordID int64, username string) (*entities.User, error) {
 	// First try to get existing user
 	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
 	if err != nil {
@@ -38,9 +41,15 @@ func (s *userService) GetOrCreateUser(ctx context.Context, discordID int64, user
 		return user, nil
 	}
 
-	// User doesn't exist, create new one with initial balance
+	settings, err := s.guildSettingsRepo.GetOrCreateGuildSettings(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	startingBalance := settings.GetStartingBalance()
+
+	// User doesn't exist, create new one with the starting balance
 	// Database unique constraint on discord_id prevents duplicate users
-	user, err = s.userRepo.Create(ctx, discordID, username, utils.InitialBalance)
+	user, err = s.userRepo.Create(ctx, discordID, username, startingBalance)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
@@ -50,8 +59,8 @@ func (s *userService) GetOrCreateUser(ctx context.Context, discordID int64, user
 		DiscordID:       discordID,
 		GuildID:         0, // Will be set by repository from UoW's guild scope
 		BalanceBefore:   0,
-		BalanceAfter:    utils.InitialBalance,
-		ChangeAmount:    utils.InitialBalance,
+		BalanceAfter:    startingBalance,
+		ChangeAmount:    startingBalance,
 		TransactionType: entities.TransactionTypeInitial,
 		TransactionMetadata: map[string]any{
 			"username": username,
@@ -91,7 +100,7 @@ func (s *userService) GetCurrentHighRoller(ctx context.Context) (*entities.User,
 func (s *userService) TransferBetweenUsers(ctx context.Context, fromDiscordID, toDiscordID int64, amount int64, fromUsername, toUsername string) error {
 	// Validate inputs
 	if amount <= 0 {
-		return fmt.Errorf("transfer amount must be positive")
+		return fmt.Errorf("transfer %w", entities.ErrNonPositiveAmount)
 	}
 	if fromDiscordID == toDiscordID {
 		return fmt.Errorf("cannot transfer to yourself")
@@ -103,12 +112,12 @@ func (s *userService) TransferBetweenUsers(ctx context.Context, fromDiscordID, t
 		return fmt.Errorf("failed to get sender user: %w", err)
 	}
 	if fromUser == nil {
-		return fmt.Errorf("sender user not found")
+		return fmt.Errorf("sender %w", entities.ErrUserNotFound)
 	}
 
 	// Check if sender has sufficient available balance
 	if fromUser.AvailableBalance < amount {
-		return fmt.Errorf("insufficient balance: have %s available, need %s", utils.FormatShortNotation(fromUser.AvailableBalance), utils.FormatShortNotation(amount))
+		return fmt.Errorf("%w: have %s available, need %s", entities.ErrInsufficientBalance, utils.FormatShortNotation(fromUser.AvailableBalance), utils.FormatShortNotation(amount))
 	}
 
 	// Get recipient user
@@ -117,7 +126,10 @@ func (s *userService) TransferBetweenUsers(ctx context.Context, fromDiscordID, t
 		return fmt.Errorf("failed to get recipient user: %w", err)
 	}
 	if toUser == nil {
-		return fmt.Errorf("recipient user not found")
+		return fmt.Errorf("recipient %w", entities.ErrUserNotFound)
+	}
+	if err := CheckNotFrozen(time.Now(), fromUser, toUser); err != nil {
+		return err
 	}
 
 	// Calculate new balances
@@ -174,3 +186,37 @@ func (s *userService) TransferBetweenUsers(ctx context.Context, fromDiscordID, t
 
 	return nil
 }
+
+// StartGamblingBreak puts the user on a self-exclusion break for the given duration
+func (s *userService) StartGamblingBreak(ctx context.Context, discordID int64, duration time.Duration) (time.Time, error) {
+	if duration < entities.MinGamblingBreakDuration {
+		return time.Time{}, fmt.Errorf("break must be at least %s", entities.MinGamblingBreakDuration)
+	}
+	if duration > entities.MaxGamblingBreakDuration {
+		return time.Time{}, fmt.Errorf("break cannot be longer than %d days", int(entities.MaxGamblingBreakDuration.Hours()/24))
+	}
+
+	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return time.Time{}, entities.ErrUserNotFound
+	}
+
+	endsAt, err := s.userRepo.SetGamblingBreak(ctx, discordID, time.Now().Add(duration))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to start gambling break: %w", err)
+	}
+
+	return endsAt, nil
+}
+
+// GetLockedBalanceBreakdown returns how much of the user's balance is tied up and where
+func (s *userService) GetLockedBalanceBreakdown(ctx context.Context, discordID int64) (*entities.LockedBalanceBreakdown, error) {
+	breakdown, err := s.userRepo.GetLockedBalanceBreakdown(ctx, discordID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get locked balance breakdown: %w", err)
+	}
+	return breakdown, nil
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"gambler/discord-client/domain/entities"
 
@@ -18,9 +19,10 @@ func TestUserService_GetOrCreateUser_ExistingUser(t *testing.T) {
 	// Setup mocks
 	mockUserRepo := new(testhelpers.MockUserRepository)
 	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
 	mockEventPublisher := new(testhelpers.MockEventPublisher)
 
-	service := NewUserService(mockUserRepo, mockBalanceHistoryRepo, mockEventPublisher)
+	service := NewUserService(mockUserRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
 
 	existingUser := &entities.User{
 		DiscordID: 123456,
@@ -31,7 +33,7 @@ func TestUserService_GetOrCreateUser_ExistingUser(t *testing.T) {
 	// Mock expectations
 	mockUserRepo.On("GetByDiscordID", ctx, int64(123456)).Return(existingUser, nil)
 
-	user, err := service.GetOrCreateUser(ctx, 123456, "testuser")
+	user, err := service.GetOrCreateUser(ctx, 987654, 123456, "testuser")
 
 	assert.NoError(t, err)
 	assert.Equal(t, existingUser, user)
@@ -46,9 +48,10 @@ func TestUserService_GetOrCreateUser_NewUser(t *testing.T) {
 	// Setup mocks
 	mockUserRepo := new(testhelpers.MockUserRepository)
 	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
 	mockEventPublisher := new(testhelpers.MockEventPublisher)
 
-	service := NewUserService(mockUserRepo, mockBalanceHistoryRepo, mockEventPublisher)
+	service := NewUserService(mockUserRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
 
 	newUser := &entities.User{
 		DiscordID: 123456,
@@ -59,6 +62,7 @@ func TestUserService_GetOrCreateUser_NewUser(t *testing.T) {
 	// Mock expectations
 	// User doesn't exist on first check
 	mockUserRepo.On("GetByDiscordID", ctx, int64(123456)).Return(nil, nil)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(987654)).Return(&entities.GuildSettings{GuildID: 987654}, nil)
 	// Create call returns new user
 	mockUserRepo.On("Create", ctx, int64(123456), "newuser", InitialBalance).Return(newUser, nil)
 
@@ -75,7 +79,7 @@ func TestUserService_GetOrCreateUser_NewUser(t *testing.T) {
 	mockEventPublisher.On("Publish", mock.AnythingOfType("events.BalanceChangeEvent")).Return(nil)
 	mockEventPublisher.On("Publish", mock.AnythingOfType("events.UserCreatedEvent")).Return(nil)
 
-	user, err := service.GetOrCreateUser(ctx, 123456, "newuser")
+	user, err := service.GetOrCreateUser(ctx, 987654, 123456, "newuser")
 
 	assert.NoError(t, err)
 	assert.Equal(t, newUser, user)
@@ -85,23 +89,60 @@ func TestUserService_GetOrCreateUser_NewUser(t *testing.T) {
 	mockEventPublisher.AssertExpectations(t)
 }
 
+func TestUserService_GetOrCreateUser_GuildStartingBalance(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup mocks
+	mockUserRepo := new(testhelpers.MockUserRepository)
+	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	mockEventPublisher := new(testhelpers.MockEventPublisher)
+
+	service := NewUserService(mockUserRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
+
+	startingBalance := int64(25000)
+	newUser := &entities.User{
+		DiscordID: 123456,
+		Username:  "newuser",
+		Balance:   startingBalance,
+	}
+
+	mockUserRepo.On("GetByDiscordID", ctx, int64(123456)).Return(nil, nil)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(987654)).Return(&entities.GuildSettings{GuildID: 987654, StartingBalance: &startingBalance}, nil)
+	mockUserRepo.On("Create", ctx, int64(123456), "newuser", startingBalance).Return(newUser, nil)
+	mockBalanceHistoryRepo.On("Record", ctx, mock.MatchedBy(func(h *entities.BalanceHistory) bool {
+		return h.BalanceAfter == startingBalance && h.ChangeAmount == startingBalance
+	})).Return(nil)
+	mockEventPublisher.On("Publish", mock.Anything).Return(nil)
+
+	user, err := service.GetOrCreateUser(ctx, 987654, 123456, "newuser")
+
+	assert.NoError(t, err)
+	assert.Equal(t, newUser, user)
+
+	mockUserRepo.AssertExpectations(t)
+	mockBalanceHistoryRepo.AssertExpectations(t)
+}
+
 func TestUserService_GetOrCreateUser_CreateError(t *testing.T) {
 	ctx := context.Background()
 
 	// Setup mocks
 	mockUserRepo := new(testhelpers.MockUserRepository)
 	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
 	mockEventPublisher := new(testhelpers.MockEventPublisher)
 
-	service := NewUserService(mockUserRepo, mockBalanceHistoryRepo, mockEventPublisher)
+	service := NewUserService(mockUserRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
 
 	// Mock expectations
 	// User doesn't exist
 	mockUserRepo.On("GetByDiscordID", ctx, int64(123456)).Return(nil, nil)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(987654)).Return(&entities.GuildSettings{GuildID: 987654}, nil)
 	// Create fails
 	mockUserRepo.On("Create", ctx, int64(123456), "failuser", InitialBalance).Return(nil, errors.New("database error"))
 
-	user, err := service.GetOrCreateUser(ctx, 123456, "failuser")
+	user, err := service.GetOrCreateUser(ctx, 987654, 123456, "failuser")
 
 	assert.Error(t, err)
 	assert.Nil(t, user)
@@ -117,9 +158,10 @@ func TestUserService_GetOrCreateUser_BalanceHistoryError(t *testing.T) {
 	// Setup mocks
 	mockUserRepo := new(testhelpers.MockUserRepository)
 	mockBalanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+	mockGuildSettingsRepo := new(testhelpers.MockGuildSettingsRepository)
 	mockEventPublisher := new(testhelpers.MockEventPublisher)
 
-	service := NewUserService(mockUserRepo, mockBalanceHistoryRepo, mockEventPublisher)
+	service := NewUserService(mockUserRepo, mockBalanceHistoryRepo, mockGuildSettingsRepo, mockEventPublisher)
 
 	newUser := &entities.User{
 		DiscordID: 123456,
@@ -129,6 +171,7 @@ func TestUserService_GetOrCreateUser_BalanceHistoryError(t *testing.T) {
 
 	// Mock expectations
 	mockUserRepo.On("GetByDiscordID", ctx, int64(123456)).Return(nil, nil)
+	mockGuildSettingsRepo.On("GetOrCreateGuildSettings", ctx, int64(987654)).Return(&entities.GuildSettings{GuildID: 987654}, nil)
 	mockUserRepo.On("Create", ctx, int64(123456), "newuser", InitialBalance).Return(newUser, nil)
 
 	// Balance history recording fails
@@ -138,7 +181,7 @@ func TestUserService_GetOrCreateUser_BalanceHistoryError(t *testing.T) {
 	// No event publisher mocks needed for this test case
 
 	// Should fail due to history error
-	user, err := service.GetOrCreateUser(ctx, 123456, "newuser")
+	user, err := service.GetOrCreateUser(ctx, 987654, 123456, "newuser")
 
 	assert.Error(t, err)
 	assert.Nil(t, user)
@@ -147,3 +190,73 @@ func TestUserService_GetOrCreateUser_BalanceHistoryError(t *testing.T) {
 	mockUserRepo.AssertExpectations(t)
 	mockBalanceHistoryRepo.AssertExpectations(t)
 }
+
+func TestUserService_StartGamblingBreak(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		duration    time.Duration
+		setupMocks  func(*testhelpers.MockUserRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:        "duration too short",
+			duration:    30 * time.Minute,
+			setupMocks:  func(userRepo *testhelpers.MockUserRepository) {},
+			wantErr:     true,
+			errContains: "at least",
+		},
+		{
+			name:        "duration too long",
+			duration:    entities.MaxGamblingBreakDuration + time.Hour,
+			setupMocks:  func(userRepo *testhelpers.MockUserRepository) {},
+			wantErr:     true,
+			errContains: "cannot be longer than 365 days",
+		},
+		{
+			name:     "user not found",
+			duration: 24 * time.Hour,
+			setupMocks: func(userRepo *testhelpers.MockUserRepository) {
+				userRepo.On("GetByDiscordID", mock.Anything, int64(123456)).Return(nil, nil)
+			},
+			wantErr:     true,
+			errContains: "user not found",
+		},
+		{
+			name:     "starts break",
+			duration: 24 * time.Hour,
+			setupMocks: func(userRepo *testhelpers.MockUserRepository) {
+				userRepo.On("GetByDiscordID", mock.Anything, int64(123456)).Return(&entities.User{DiscordID: 123456}, nil)
+				userRepo.On("SetGamblingBreak", mock.Anything, int64(123456), mock.MatchedBy(func(endsAt time.Time) bool {
+					return endsAt.After(time.Now().Add(23*time.Hour)) && endsAt.Before(time.Now().Add(25*time.Hour))
+				})).Return(time.Now().Add(24*time.Hour), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mockUserRepo := new(testhelpers.MockUserRepository)
+			tt.setupMocks(mockUserRepo)
+
+			service := NewUserService(mockUserRepo, new(testhelpers.MockBalanceHistoryRepository), new(testhelpers.MockGuildSettingsRepository), new(testhelpers.MockEventPublisher))
+
+			endsAt, err := service.StartGamblingBreak(ctx, 123456, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				mockUserRepo.AssertNotCalled(t, "SetGamblingBreak", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, endsAt.After(time.Now()))
+			}
+			mockUserRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"gambler/discord-client/domain/entities"
@@ -31,15 +32,15 @@ func (s *WagerResolutionService) CanWagerBeProposed(proposer *entities.User, tar
 	}
 	
 	if amount <= 0 {
-		return errors.New("wager amount must be positive")
+		return fmt.Errorf("wager %w", entities.ErrNonPositiveAmount)
 	}
 	
 	if !proposer.CanAfford(amount) {
-		return errors.New("proposer has insufficient available balance")
+		return fmt.Errorf("proposer has %w", entities.ErrInsufficientBalance)
 	}
 	
 	if !target.CanAfford(amount) {
-		return errors.New("target has insufficient available balance")
+		return fmt.Errorf("target has %w", entities.ErrInsufficientBalance)
 	}
 	
 	return nil
@@ -17,31 +17,40 @@ type wagerService struct {
 	wagerVoteRepo      interfaces.WagerVoteRepository
 	balanceHistoryRepo interfaces.BalanceHistoryRepository
 	eventPublisher     interfaces.EventPublisher
+	balanceGuard       *BalanceGuard
 }
 
 // NewWagerService creates a new wager service
-func NewWagerService(userRepo interfaces.UserRepository, wagerRepo interfaces.WagerRepository, wagerVoteRepo interfaces.WagerVoteRepository, balanceHistoryRepo interfaces.BalanceHistoryRepository, eventPublisher interfaces.EventPublisher) interfaces.WagerService {
+func NewWagerService(userRepo interfaces.UserRepository, wagerRepo interfaces.WagerRepository, wagerVoteRepo interfaces.WagerVoteRepository, balanceHistoryRepo interfaces.BalanceHistoryRepository, guildSettingsRepo interfaces.GuildSettingsRepository, eventPublisher interfaces.EventPublisher) interfaces.WagerService {
 	return &wagerService{
 		userRepo:           userRepo,
 		wagerRepo:          wagerRepo,
 		wagerVoteRepo:      wagerVoteRepo,
 		balanceHistoryRepo: balanceHistoryRepo,
 		eventPublisher:     eventPublisher,
+		balanceGuard:       NewBalanceGuard(guildSettingsRepo),
 	}
 }
 
 // ProposeWager creates a new wager proposal
-func (s *wagerService) ProposeWager(ctx context.Context, proposerID, targetID int64, amount int64, condition string, messageID, channelID int64) (*entities.Wager, error) {
+func (s *wagerService) ProposeWager(ctx context.Context, proposerID, targetID, guildID int64, amount int64, condition string, messageID, channelID int64) (*entities.Wager, error) {
 	// Validate inputs
 	if proposerID == targetID {
 		return nil, fmt.Errorf("cannot create a wager with yourself")
 	}
 	if amount <= 0 {
-		return nil, fmt.Errorf("wager amount must be positive")
+		return nil, fmt.Errorf("wager %w", entities.ErrNonPositiveAmount)
 	}
 	if condition == "" {
 		return nil, fmt.Errorf("wager condition cannot be empty")
 	}
+	// New wagers can't be proposed during the guild's curfew window
+	if err := s.balanceGuard.CheckBettingCurfew(ctx, guildID, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.balanceGuard.CheckMinBet(ctx, guildID, amount); err != nil {
+		return nil, err
+	}
 
 	// Check if both users exist and have sufficient available balance
 	proposer, err := s.userRepo.GetByDiscordID(ctx, proposerID)
@@ -51,8 +60,11 @@ func (s *wagerService) ProposeWager(ctx context.Context, proposerID, targetID in
 	if proposer == nil {
 		return nil, fmt.Errorf("proposer not found")
 	}
+	if err := proposer.CheckGamblingBreak(time.Now()); err != nil {
+		return nil, err
+	}
 	if proposer.AvailableBalance < amount {
-		return nil, fmt.Errorf("insufficient balance: have %s available, need %s", utils.FormatShortNotation(proposer.AvailableBalance), utils.FormatShortNotation(amount))
+		return nil, fmt.Errorf("%w: have %s available, need %s%s", entities.ErrInsufficientBalance, utils.FormatShortNotation(proposer.AvailableBalance), utils.FormatShortNotation(amount), s.describeLockedBalance(ctx, proposerID))
 	}
 
 	target, err := s.userRepo.GetByDiscordID(ctx, targetID)
@@ -60,10 +72,16 @@ func (s *wagerService) ProposeWager(ctx context.Context, proposerID, targetID in
 		return nil, fmt.Errorf("failed to get target: %w", err)
 	}
 	if target == nil {
-		return nil, fmt.Errorf("target user not found")
+		return nil, fmt.Errorf("target %w", entities.ErrUserNotFound)
+	}
+	if target.IsOnGamblingBreak(time.Now()) {
+		return nil, fmt.Errorf("target user is on a gambling break")
+	}
+	if err := CheckNotFrozen(time.Now(), proposer, target); err != nil {
+		return nil, err
 	}
 	if target.AvailableBalance < amount {
-		return nil, fmt.Errorf("target user has insufficient balance: they have %s available, need %s", utils.FormatShortNotation(target.AvailableBalance), utils.FormatShortNotation(amount))
+		return nil, fmt.Errorf("target user has %w: they have %s available, need %s", entities.ErrInsufficientBalance, utils.FormatShortNotation(target.AvailableBalance), utils.FormatShortNotation(amount))
 	}
 
 	// Create the wager
@@ -93,7 +111,7 @@ func (s *wagerService) RespondToWager(ctx context.Context, wagerID int64, respon
 		return nil, fmt.Errorf("failed to get wager: %w", err)
 	}
 	if wager == nil {
-		return nil, fmt.Errorf("wager not found")
+		return nil, entities.ErrWagerNotFound
 	}
 
 	// Validate the responder
@@ -107,6 +125,11 @@ func (s *wagerService) RespondToWager(ctx context.Context, wagerID int64, respon
 	// Update wager state based on response
 	now := time.Now()
 	if accept {
+		// Accepting puts bits on the line, so it waits for the curfew to end like any other bet
+		if err := s.balanceGuard.CheckBettingCurfew(ctx, wager.GuildID, now); err != nil {
+			return nil, err
+		}
+
 		// Double-check both users still have sufficient balance
 		proposer, err := s.userRepo.GetByDiscordID(ctx, wager.ProposerDiscordID)
 		if err != nil {
@@ -115,6 +138,9 @@ func (s *wagerService) RespondToWager(ctx context.Context, wagerID int64, respon
 		if proposer.AvailableBalance < wager.Amount {
 			return nil, fmt.Errorf("proposer no longer has sufficient balance")
 		}
+		if proposer.IsOnGamblingBreak(now) {
+			return nil, fmt.Errorf("proposer is on a gambling break")
+		}
 
 		target, err := s.userRepo.GetByDiscordID(ctx, wager.TargetDiscordID)
 		if err != nil {
@@ -123,6 +149,12 @@ func (s *wagerService) RespondToWager(ctx context.Context, wagerID int64, respon
 		if target.AvailableBalance < wager.Amount {
 			return nil, fmt.Errorf("you no longer have sufficient balance")
 		}
+		if err := target.CheckGamblingBreak(now); err != nil {
+			return nil, err
+		}
+		if err := CheckNotFrozen(now, target, proposer); err != nil {
+			return nil, err
+		}
 
 		wager.State = entities.WagerStateVoting
 		wager.AcceptedAt = &now
@@ -147,7 +179,7 @@ func (s *wagerService) CastVote(ctx context.Context, wagerID int64, voterID int6
 		return nil, nil, fmt.Errorf("failed to get wager: %w", err)
 	}
 	if wager == nil {
-		return nil, nil, fmt.Errorf("wager not found")
+		return nil, nil, entities.ErrWagerNotFound
 	}
 
 	// Validate the vote
@@ -329,7 +361,7 @@ func (s *wagerService) CancelWager(ctx context.Context, wagerID int64, canceller
 	}
 	log.Infof("Retrieved wager ID: %d", wager.ID)
 	if wager == nil {
-		return fmt.Errorf("wager not found")
+		return entities.ErrWagerNotFound
 	}
 
 	if wager.State == entities.WagerStateResolved || wager.State == entities.WagerStateDeclined {
@@ -357,7 +389,7 @@ func (s *wagerService) UpdateMessageIDs(ctx context.Context, wagerID int64, mess
 		return fmt.Errorf("failed to get wager: %w", err)
 	}
 	if wager == nil {
-		return fmt.Errorf("wager not found")
+		return entities.ErrWagerNotFound
 	}
 
 	wager.MessageID = &messageID
@@ -374,3 +406,19 @@ func (s *wagerService) UpdateMessageIDs(ctx context.Context, wagerID int64, mess
 func relatedTypePtr(rt entities.RelatedType) *entities.RelatedType {
 	return &rt
 }
+
+// describeLockedBalance explains where a user's unavailable balance is tied up, for insufficient balance errors
+func (s *wagerService) describeLockedBalance(ctx context.Context, discordID int64) string {
+	locked, err := s.userRepo.GetLockedBalanceBreakdown(ctx, discordID)
+	if err != nil {
+		log.Warnf("Failed to get locked balance breakdown for user %d: %v", discordID, err)
+		return ""
+	}
+	if locked.Total() == 0 {
+		return ""
+	}
+	if locked.InSavings > 0 {
+		return fmt.Sprintf(" (%s locked in wagers, %s in group wagers, %s in savings)", utils.FormatShortNotation(locked.InWagers), utils.FormatShortNotation(locked.InGroupWagers), utils.FormatShortNotation(locked.InSavings))
+	}
+	return fmt.Sprintf(" (%s locked in wagers, %s in group wagers)", utils.FormatShortNotation(locked.InWagers), utils.FormatShortNotation(locked.InGroupWagers))
+}
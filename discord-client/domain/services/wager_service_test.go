@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newWagerTestSettingsRepo returns guild settings with the given minimum bet for wager proposals
+func newWagerTestSettingsRepo(minBet *int64) *testhelpers.MockGuildSettingsRepository {
+	settingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	settingsRepo.On("GetOrCreateGuildSettings", mock.Anything, int64(123456789)).
+		Return(&entities.GuildSettings{GuildID: 123456789, MinBetAmount: minBet}, nil)
+	return settingsRepo
+}
+
+func TestWagerService_GamblingBreak(t *testing.T) {
+	t.Parallel()
+
+	breakEndsAt := time.Now().Add(24 * time.Hour)
+	onBreak := func(discordID int64) *entities.User {
+		return &entities.User{DiscordID: discordID, Balance: 10000, AvailableBalance: 10000, GamblingBreakEndsAt: &breakEndsAt}
+	}
+	available := func(discordID int64) *entities.User {
+		return &entities.User{DiscordID: discordID, Balance: 10000, AvailableBalance: 10000}
+	}
+
+	t.Run("proposer on break cannot propose", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		userRepo := new(testhelpers.MockUserRepository)
+		wagerRepo := new(testhelpers.MockWagerRepository)
+		service := NewWagerService(userRepo, wagerRepo, nil, new(testhelpers.MockBalanceHistoryRepository), newWagerTestSettingsRepo(nil), new(testhelpers.MockEventPublisher))
+
+		userRepo.On("GetByDiscordID", mock.Anything, int64(111)).Return(onBreak(111), nil)
+
+		wager, err := service.ProposeWager(ctx, 111, 222, 123456789, 1000, "condition", 1, 2)
+
+		assert.ErrorIs(t, err, entities.ErrGamblingBreakActive)
+		assert.Nil(t, wager)
+		wagerRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("cannot propose to target on break", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		userRepo := new(testhelpers.MockUserRepository)
+		wagerRepo := new(testhelpers.MockWagerRepository)
+		service := NewWagerService(userRepo, wagerRepo, nil, new(testhelpers.MockBalanceHistoryRepository), newWagerTestSettingsRepo(nil), new(testhelpers.MockEventPublisher))
+
+		userRepo.On("GetByDiscordID", mock.Anything, int64(111)).Return(available(111), nil)
+		userRepo.On("GetByDiscordID", mock.Anything, int64(222)).Return(onBreak(222), nil)
+
+		wager, err := service.ProposeWager(ctx, 111, 222, 123456789, 1000, "condition", 1, 2)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "target user is on a gambling break")
+		assert.Nil(t, wager)
+		wagerRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("target on break cannot accept", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		userRepo := new(testhelpers.MockUserRepository)
+		wagerRepo := new(testhelpers.MockWagerRepository)
+		service := NewWagerService(userRepo, wagerRepo, nil, new(testhelpers.MockBalanceHistoryRepository), newWagerTestSettingsRepo(nil), new(testhelpers.MockEventPublisher))
+
+		wagerRepo.On("GetByID", mock.Anything, int64(1)).Return(&entities.Wager{
+			ID:                1,
+			GuildID:           123456789,
+			ProposerDiscordID: 111,
+			TargetDiscordID:   222,
+			Amount:            1000,
+			State:             entities.WagerStateProposed,
+		}, nil)
+		userRepo.On("GetByDiscordID", mock.Anything, int64(111)).Return(available(111), nil)
+		userRepo.On("GetByDiscordID", mock.Anything, int64(222)).Return(onBreak(222), nil)
+
+		wager, err := service.RespondToWager(ctx, 1, 222, true)
+
+		assert.ErrorIs(t, err, entities.ErrGamblingBreakActive)
+		assert.Nil(t, wager)
+		wagerRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestWagerService_ProposeWager_InsufficientBalanceExplainsLocks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userRepo := new(testhelpers.MockUserRepository)
+	wagerRepo := new(testhelpers.MockWagerRepository)
+	service := NewWagerService(userRepo, wagerRepo, nil, new(testhelpers.MockBalanceHistoryRepository), newWagerTestSettingsRepo(nil), new(testhelpers.MockEventPublisher))
+
+	userRepo.On("GetByDiscordID", mock.Anything, int64(111)).Return(&entities.User{DiscordID: 111, Balance: 10000, AvailableBalance: 2000}, nil)
+	userRepo.On("GetLockedBalanceBreakdown", mock.Anything, int64(111)).Return(&entities.LockedBalanceBreakdown{
+		InWagers:      5000,
+		InGroupWagers: 3000,
+	}, nil)
+
+	wager, err := service.ProposeWager(ctx, 111, 222, 123456789, 4000, "condition", 1, 2)
+
+	assert.Error(t, err)
+	assert.Nil(t, wager)
+	assert.Contains(t, err.Error(), "insufficient balance")
+	assert.Contains(t, err.Error(), "5.0k locked in wagers, 3.0k in group wagers")
+	wagerRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestWagerService_ProposeWager_BelowMinBet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userRepo := new(testhelpers.MockUserRepository)
+	wagerRepo := new(testhelpers.MockWagerRepository)
+	minBet := int64(5000)
+	service := NewWagerService(userRepo, wagerRepo, nil, new(testhelpers.MockBalanceHistoryRepository), newWagerTestSettingsRepo(&minBet), new(testhelpers.MockEventPublisher))
+
+	wager, err := service.ProposeWager(ctx, 111, 222, 123456789, 1000, "condition", 1, 2)
+
+	assert.ErrorIs(t, err, entities.ErrBelowMinBet)
+	assert.Contains(t, err.Error(), "5000 bits")
+	assert.Nil(t, wager)
+	userRepo.AssertNotCalled(t, "GetByDiscordID", mock.Anything, mock.Anything)
+	wagerRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestWagerService_ProposeWager_BettingCurfew(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userRepo := new(testhelpers.MockUserRepository)
+	wagerRepo := new(testhelpers.MockWagerRepository)
+
+	// Curfew window covering the current hour
+	startHour := time.Now().UTC().Hour()
+	endHour := (startHour + 1) % 24
+	settingsRepo := new(testhelpers.MockGuildSettingsRepository)
+	settingsRepo.On("GetOrCreateGuildSettings", mock.Anything, int64(123456789)).
+		Return(&entities.GuildSettings{GuildID: 123456789, CurfewStartHour: &startHour, CurfewEndHour: &endHour}, nil)
+	service := NewWagerService(userRepo, wagerRepo, nil, new(testhelpers.MockBalanceHistoryRepository), settingsRepo, new(testhelpers.MockEventPublisher))
+
+	wager, err := service.ProposeWager(ctx, 111, 222, 123456789, 1000, "condition", 1, 2)
+
+	assert.ErrorIs(t, err, entities.ErrBettingCurfewActive)
+	assert.Nil(t, wager)
+	userRepo.AssertNotCalled(t, "GetByDiscordID", mock.Anything, mock.Anything)
+	wagerRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
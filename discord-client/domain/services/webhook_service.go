@@ -0,0 +1,262 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// maxWebhookErrorLength caps the delivery error kept for /webhooks deliveries
+const maxWebhookErrorLength = 500
+
+// webhookService implements business logic for outbound guild webhooks
+type webhookService struct {
+	webhookRepo  interfaces.GuildWebhookRepository
+	deliveryRepo interfaces.WebhookDeliveryRepository
+	now          func() time.Time
+	lookupIP     func(ctx context.Context, host string) ([]net.IP, error)
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(
+	webhookRepo interfaces.GuildWebhookRepository,
+	deliveryRepo interfaces.WebhookDeliveryRepository,
+) interfaces.WebhookService {
+	return &webhookService{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		now:          func() time.Time { return time.Now().UTC() },
+		lookupIP: func(ctx context.Context, host string) ([]net.IP, error) {
+			return net.DefaultResolver.LookupIP(ctx, "ip", host)
+		},
+	}
+}
+
+// Register adds a webhook receiving the given events, generating the secret its payloads are signed with
+func (s *webhookService) Register(ctx context.Context, guildID int64, url string, eventTypes []entities.WebhookEventType, createdBy int64) (*entities.GuildWebhook, error) {
+	if err := entities.ValidateWebhookURL(url); err != nil {
+		return nil, err
+	}
+	if err := s.checkWebhookHost(ctx, url); err != nil {
+		return nil, err
+	}
+	if len(eventTypes) == 0 {
+		return nil, errors.New("a webhook must subscribe to at least one event")
+	}
+	for _, eventType := range eventTypes {
+		if _, err := entities.ParseWebhookEventType(string(eventType)); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := s.webhookRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+	if len(existing) >= entities.MaxWebhooksPerGuild {
+		return nil, fmt.Errorf("this server already has the maximum of %d webhooks", entities.MaxWebhooksPerGuild)
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := &entities.GuildWebhook{
+		GuildID:    guildID,
+		URL:        url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		CreatedBy:  createdBy,
+	}
+	if err := s.webhookRepo.Create(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// checkWebhookHost resolves the webhook URL's host and refuses it if any of its addresses isn't public
+func (s *webhookService) checkWebhookHost(ctx context.Context, rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.New("webhook URL must be an absolute URL")
+	}
+
+	host := parsed.Hostname()
+	ips, err := s.lookupIP(ctx, host)
+	if err != nil || len(ips) == 0 {
+		return fmt.Errorf("webhook host %s could not be resolved", host)
+	}
+	for _, ip := range ips {
+		if !entities.IsPublicWebhookIP(ip) {
+			return entities.ErrWebhookAddressNotPublic
+		}
+	}
+
+	return nil
+}
+
+// Remove deletes a webhook and its deliveries. Returns false if there was no such webhook.
+func (s *webhookService) Remove(ctx context.Context, webhookID int64) (bool, error) {
+	removed, err := s.webhookRepo.Delete(ctx, webhookID)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove webhook: %w", err)
+	}
+	return removed, nil
+}
+
+// List returns the guild's webhooks, oldest first
+func (s *webhookService) List(ctx context.Context) ([]*entities.GuildWebhook, error) {
+	webhooks, err := s.webhookRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+	return webhooks, nil
+}
+
+// Enqueue queues the event data for every guild webhook subscribed to the event type and returns how many were queued
+func (s *webhookService) Enqueue(ctx context.Context, guildID int64, eventType entities.WebhookEventType, data any) (int, error) {
+	webhooks, err := s.webhookRepo.GetSubscribed(ctx, eventType)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get subscribed webhooks: %w", err)
+	}
+	if len(webhooks) == 0 {
+		return 0, nil
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode %s payload: %w", eventType, err)
+	}
+
+	for _, webhook := range webhooks {
+		if _, err := s.queue(ctx, guildID, webhook.ID, eventType, payload); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(webhooks), nil
+}
+
+// SendTest queues a test payload for a single webhook
+func (s *webhookService) SendTest(ctx context.Context, guildID, webhookID, requestedBy int64) (*entities.WebhookDelivery, error) {
+	webhook, err := s.webhookRepo.GetByID(ctx, webhookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	if webhook == nil {
+		return nil, entities.ErrWebhookNotFound
+	}
+
+	payload, err := json.Marshal(entities.WebhookTestData{
+		Message:     "Test delivery from Gamba",
+		RequestedBy: entities.FormatWebhookID(requestedBy),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode test payload: %w", err)
+	}
+
+	return s.queue(ctx, guildID, webhook.ID, entities.WebhookEventTest, payload)
+}
+
+// GetRecentDeliveries returns a webhook's most recent deliveries, newest first
+func (s *webhookService) GetRecentDeliveries(ctx context.Context, webhookID int64, limit int) ([]*entities.WebhookDelivery, error) {
+	webhook, err := s.webhookRepo.GetByID(ctx, webhookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	if webhook == nil {
+		return nil, entities.ErrWebhookNotFound
+	}
+
+	deliveries, err := s.deliveryRepo.GetRecentByWebhook(ctx, webhookID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// Deliver sends a pending delivery and records the outcome, scheduling a retry with backoff on failure.
+// The delivery stays locked while it is sent so it can't be sent twice at once.
+func (s *webhookService) Deliver(ctx context.Context, deliveryID int64, sender interfaces.WebhookSender) (*entities.WebhookDelivery, error) {
+	delivery, err := s.deliveryRepo.GetByIDForUpdate(ctx, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+	if delivery == nil || delivery.Status != entities.WebhookDeliveryStatusPending {
+		return nil, nil
+	}
+
+	webhook, err := s.webhookRepo.GetByID(ctx, delivery.WebhookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	if webhook == nil {
+		// Removed webhooks take their deliveries with them
+		return nil, nil
+	}
+
+	statusCode, sendErr := sender.Send(ctx, webhook, delivery)
+	now := s.now()
+	switch {
+	case sendErr != nil:
+		var responseStatus *int
+		if statusCode != 0 {
+			responseStatus = &statusCode
+		}
+		delivery.RecordFailure(responseStatus, truncateWebhookError(sendErr.Error()), now)
+	case statusCode < 200 || statusCode > 299:
+		delivery.RecordFailure(&statusCode, fmt.Sprintf("endpoint responded with status %d", statusCode), now)
+	default:
+		delivery.RecordSuccess(statusCode, now)
+	}
+
+	if err := s.deliveryRepo.Update(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("failed to record webhook delivery attempt: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// queue creates a pending delivery that is due immediately
+func (s *webhookService) queue(ctx context.Context, guildID, webhookID int64, eventType entities.WebhookEventType, payload []byte) (*entities.WebhookDelivery, error) {
+	delivery := &entities.WebhookDelivery{
+		WebhookID:     webhookID,
+		GuildID:       guildID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        entities.WebhookDeliveryStatusPending,
+		NextAttemptAt: s.now(),
+	}
+	if err := s.deliveryRepo.Create(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("failed to queue webhook delivery: %w", err)
+	}
+	return delivery, nil
+}
+
+// generateWebhookSecret returns a random hex encoded signing secret
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// truncateWebhookError shortens a delivery error to the length kept in the delivery log
+func truncateWebhookError(message string) string {
+	if len(message) <= maxWebhookErrorLength {
+		return message
+	}
+	return message[:maxWebhookErrorLength]
+}
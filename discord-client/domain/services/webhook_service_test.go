@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubWebhookSender returns a fixed outcome for every delivery it is asked to send
+type stubWebhookSender struct {
+	statusCode int
+	err        error
+	sent       []*entities.WebhookDelivery
+}
+
+func (s *stubWebhookSender) Send(ctx context.Context, webhook *entities.GuildWebhook, delivery *entities.WebhookDelivery) (int, error) {
+	s.sent = append(s.sent, delivery)
+	return s.statusCode, s.err
+}
+
+func newTestWebhookService(now time.Time) (*webhookService, *testhelpers.MockGuildWebhookRepository, *testhelpers.MockWebhookDeliveryRepository) {
+	webhookRepo := new(testhelpers.MockGuildWebhookRepository)
+	deliveryRepo := new(testhelpers.MockWebhookDeliveryRepository)
+	service := &webhookService{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		now:          func() time.Time { return now },
+		lookupIP: func(ctx context.Context, host string) ([]net.IP, error) {
+			return []net.IP{net.ParseIP("93.184.216.34")}, nil
+		},
+	}
+	return service, webhookRepo, deliveryRepo
+}
+
+func TestWebhookService_Register(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("generates a secret", func(t *testing.T) {
+		service, webhookRepo, _ := newTestWebhookService(now)
+		webhookRepo.On("GetAll", ctx).Return(nil, nil)
+		webhookRepo.On("Create", ctx, mock.AnythingOfType("*entities.GuildWebhook")).Return(nil)
+
+		webhook, err := service.Register(ctx, 123, "https://example.com/hook", []entities.WebhookEventType{entities.WebhookEventBigWin}, 456)
+
+		require.NoError(t, err)
+		assert.Len(t, webhook.Secret, 64)
+		assert.Equal(t, int64(123), webhook.GuildID)
+		assert.Equal(t, int64(456), webhook.CreatedBy)
+	})
+
+	t.Run("rejects insecure URLs", func(t *testing.T) {
+		service, webhookRepo, _ := newTestWebhookService(now)
+
+		_, err := service.Register(ctx, 123, "http://example.com/hook", []entities.WebhookEventType{entities.WebhookEventBigWin}, 456)
+
+		assert.ErrorContains(t, err, "https")
+		webhookRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("rejects hosts resolving to private addresses", func(t *testing.T) {
+		service, webhookRepo, _ := newTestWebhookService(now)
+		service.lookupIP = func(ctx context.Context, host string) ([]net.IP, error) {
+			return []net.IP{net.ParseIP("93.184.216.34"), net.ParseIP("10.0.0.5")}, nil
+		}
+
+		_, err := service.Register(ctx, 123, "https://internal.example.com/hook", []entities.WebhookEventType{entities.WebhookEventBigWin}, 456)
+
+		assert.ErrorIs(t, err, entities.ErrWebhookAddressNotPublic)
+		webhookRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("rejects hosts that don't resolve", func(t *testing.T) {
+		service, webhookRepo, _ := newTestWebhookService(now)
+		service.lookupIP = func(ctx context.Context, host string) ([]net.IP, error) {
+			return nil, errors.New("no such host")
+		}
+
+		_, err := service.Register(ctx, 123, "https://missing.example.com/hook", []entities.WebhookEventType{entities.WebhookEventBigWin}, 456)
+
+		assert.ErrorContains(t, err, "could not be resolved")
+		webhookRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("rejects the test event", func(t *testing.T) {
+		service, _, _ := newTestWebhookService(now)
+
+		_, err := service.Register(ctx, 123, "https://example.com/hook", []entities.WebhookEventType{entities.WebhookEventTest}, 456)
+
+		assert.ErrorContains(t, err, "unknown webhook event")
+	})
+
+	t.Run("limits webhooks per guild", func(t *testing.T) {
+		service, webhookRepo, _ := newTestWebhookService(now)
+		existing := make([]*entities.GuildWebhook, entities.MaxWebhooksPerGuild)
+		webhookRepo.On("GetAll", ctx).Return(existing, nil)
+
+		_, err := service.Register(ctx, 123, "https://example.com/hook", []entities.WebhookEventType{entities.WebhookEventBigWin}, 456)
+
+		assert.ErrorContains(t, err, "maximum")
+		webhookRepo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestWebhookService_Enqueue(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("queues for each subscribed webhook", func(t *testing.T) {
+		service, webhookRepo, deliveryRepo := newTestWebhookService(now)
+		webhookRepo.On("GetSubscribed", ctx, entities.WebhookEventBigWin).Return([]*entities.GuildWebhook{{ID: 1}, {ID: 2}}, nil)
+		deliveryRepo.On("Create", ctx, mock.MatchedBy(func(d *entities.WebhookDelivery) bool {
+			return d.GuildID == 123 &&
+				d.EventType == entities.WebhookEventBigWin &&
+				d.Status == entities.WebhookDeliveryStatusPending &&
+				d.NextAttemptAt.Equal(now) &&
+				string(d.Payload) == `{"discord_id":"99","amount":20000,"new_balance":50000,"transaction_type":"bet_win"}`
+		})).Return(nil).Twice()
+
+		queued, err := service.Enqueue(ctx, 123, entities.WebhookEventBigWin, entities.WebhookBigWinData{
+			DiscordID:       "99",
+			Amount:          20000,
+			NewBalance:      50000,
+			TransactionType: entities.TransactionTypeBetWin,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, queued)
+		deliveryRepo.AssertExpectations(t)
+	})
+
+	t.Run("nothing subscribed", func(t *testing.T) {
+		service, webhookRepo, deliveryRepo := newTestWebhookService(now)
+		webhookRepo.On("GetSubscribed", ctx, entities.WebhookEventLotteryResult).Return(nil, nil)
+
+		queued, err := service.Enqueue(ctx, 123, entities.WebhookEventLotteryResult, entities.WebhookLotteryResultData{})
+
+		require.NoError(t, err)
+		assert.Zero(t, queued)
+		deliveryRepo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestWebhookService_Deliver(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	webhook := &entities.GuildWebhook{ID: 1, GuildID: 123, URL: "https://example.com/hook", Secret: "secret"}
+
+	pending := func() *entities.WebhookDelivery {
+		return &entities.WebhookDelivery{ID: 10, WebhookID: 1, GuildID: 123, Status: entities.WebhookDeliveryStatusPending}
+	}
+
+	t.Run("records a successful delivery", func(t *testing.T) {
+		service, webhookRepo, deliveryRepo := newTestWebhookService(now)
+		deliveryRepo.On("GetByIDForUpdate", ctx, int64(10)).Return(pending(), nil)
+		webhookRepo.On("GetByID", ctx, int64(1)).Return(webhook, nil)
+		deliveryRepo.On("Update", ctx, mock.AnythingOfType("*entities.WebhookDelivery")).Return(nil)
+
+		delivery, err := service.Deliver(ctx, 10, &stubWebhookSender{statusCode: 200})
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.WebhookDeliveryStatusDelivered, delivery.Status)
+		assert.Equal(t, now, *delivery.DeliveredAt)
+	})
+
+	t.Run("schedules a retry on an error status", func(t *testing.T) {
+		service, webhookRepo, deliveryRepo := newTestWebhookService(now)
+		deliveryRepo.On("GetByIDForUpdate", ctx, int64(10)).Return(pending(), nil)
+		webhookRepo.On("GetByID", ctx, int64(1)).Return(webhook, nil)
+		deliveryRepo.On("Update", ctx, mock.AnythingOfType("*entities.WebhookDelivery")).Return(nil)
+
+		delivery, err := service.Deliver(ctx, 10, &stubWebhookSender{statusCode: 503})
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.WebhookDeliveryStatusPending, delivery.Status)
+		assert.Equal(t, 503, *delivery.ResponseStatus)
+		assert.Equal(t, "endpoint responded with status 503", *delivery.LastError)
+		assert.Equal(t, now.Add(entities.WebhookBaseRetryBackoff), delivery.NextAttemptAt)
+	})
+
+	t.Run("schedules a retry when the endpoint is unreachable", func(t *testing.T) {
+		service, webhookRepo, deliveryRepo := newTestWebhookService(now)
+		deliveryRepo.On("GetByIDForUpdate", ctx, int64(10)).Return(pending(), nil)
+		webhookRepo.On("GetByID", ctx, int64(1)).Return(webhook, nil)
+		deliveryRepo.On("Update", ctx, mock.AnythingOfType("*entities.WebhookDelivery")).Return(nil)
+
+		delivery, err := service.Deliver(ctx, 10, &stubWebhookSender{err: errors.New("connection refused")})
+
+		require.NoError(t, err)
+		assert.Nil(t, delivery.ResponseStatus)
+		assert.Equal(t, "connection refused", *delivery.LastError)
+		assert.Equal(t, 1, delivery.Attempts)
+	})
+
+	t.Run("skips deliveries that are no longer pending", func(t *testing.T) {
+		service, _, deliveryRepo := newTestWebhookService(now)
+		delivered := pending()
+		delivered.Status = entities.WebhookDeliveryStatusDelivered
+		deliveryRepo.On("GetByIDForUpdate", ctx, int64(10)).Return(delivered, nil)
+		sender := &stubWebhookSender{statusCode: 200}
+
+		delivery, err := service.Deliver(ctx, 10, sender)
+
+		require.NoError(t, err)
+		assert.Nil(t, delivery)
+		assert.Empty(t, sender.sent)
+		deliveryRepo.AssertNotCalled(t, "Update")
+	})
+}
+
+func TestWebhookService_SendTest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("queues a test payload", func(t *testing.T) {
+		service, webhookRepo, deliveryRepo := newTestWebhookService(now)
+		webhookRepo.On("GetByID", ctx, int64(1)).Return(&entities.GuildWebhook{ID: 1, GuildID: 123}, nil)
+		deliveryRepo.On("Create", ctx, mock.AnythingOfType("*entities.WebhookDelivery")).Return(nil)
+
+		delivery, err := service.SendTest(ctx, 123, 1, 456)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.WebhookEventTest, delivery.EventType)
+		assert.Contains(t, string(delivery.Payload), `"requested_by":"456"`)
+	})
+
+	t.Run("unknown webhook", func(t *testing.T) {
+		service, webhookRepo, _ := newTestWebhookService(now)
+		webhookRepo.On("GetByID", ctx, int64(1)).Return(nil, nil)
+
+		_, err := service.SendTest(ctx, 123, 1, 456)
+
+		assert.ErrorIs(t, err, entities.ErrWebhookNotFound)
+	})
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// weeklyDigestService implements the weekly economy digest
+type weeklyDigestService struct {
+	balanceHistoryRepo interfaces.BalanceHistoryRepository
+	groupWagerRepo     interfaces.GroupWagerRepository
+	lotteryDrawRepo    interfaces.LotteryDrawRepository
+	highRollerRepo     interfaces.HighRollerPurchaseRepository
+	metricsService     interfaces.UserMetricsService
+}
+
+// NewWeeklyDigestService creates a new weekly digest service
+func NewWeeklyDigestService(
+	balanceHistoryRepo interfaces.BalanceHistoryRepository,
+	groupWagerRepo interfaces.GroupWagerRepository,
+	lotteryDrawRepo interfaces.LotteryDrawRepository,
+	highRollerRepo interfaces.HighRollerPurchaseRepository,
+	metricsService interfaces.UserMetricsService,
+) interfaces.WeeklyDigestService {
+	return &weeklyDigestService{
+		balanceHistoryRepo: balanceHistoryRepo,
+		groupWagerRepo:     groupWagerRepo,
+		lotteryDrawRepo:    lotteryDrawRepo,
+		highRollerRepo:     highRollerRepo,
+		metricsService:     metricsService,
+	}
+}
+
+// BuildDigest streams the week's data into a digest for the guild
+func (s *weeklyDigestService) BuildDigest(ctx context.Context, guildID int64, from, to time.Time) (*entities.WeeklyDigest, error) {
+	if !from.Before(to) {
+		return nil, fmt.Errorf("digest range start %s must be before end %s", from, to)
+	}
+
+	digest := entities.NewWeeklyDigest(guildID, from, to)
+
+	err := s.groupWagerRepo.StreamOutcomesByDateRange(ctx, from, to, func(o *entities.GroupWagerOutcome) error {
+		digest.AddWagerOutcome(o)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wager outcomes: %w", err)
+	}
+
+	err = s.balanceHistoryRepo.StreamByDateRange(ctx, from, to, func(h *entities.BalanceHistory) error {
+		digest.AddBalanceChange(h)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance history: %w", err)
+	}
+
+	err = s.lotteryDrawRepo.StreamCompletedDrawResults(ctx, guildID, from, to, func(r *entities.LotteryDrawResult) error {
+		digest.AddLotteryResult(r)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lottery results: %w", err)
+	}
+
+	purchase, err := s.highRollerRepo.GetLatestPurchase(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest high roller purchase: %w", err)
+	}
+	digest.SetHighRollerPurchase(purchase)
+
+	predictionStats, err := s.metricsService.GetWagerPredictionStats(ctx, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prediction stats: %w", err)
+	}
+	digest.RankTopPredictors(predictionStats)
+
+	return digest, nil
+}
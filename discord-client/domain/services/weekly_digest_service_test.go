@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeeklyDigestService_BuildDigest(t *testing.T) {
+	ctx := context.Background()
+	guildID := int64(123456789)
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	newService := func(groupWagerRepo *testhelpers.MockGroupWagerRepository, balanceHistoryRepo *testhelpers.MockBalanceHistoryRepository, lotteryDrawRepo *testhelpers.MockLotteryDrawRepository, highRollerRepo *testhelpers.MockHighRollerPurchaseRepository) *weeklyDigestService {
+		metricsService := NewUserMetricsService(nil, nil, nil, groupWagerRepo, balanceHistoryRepo, nil)
+		return NewWeeklyDigestService(balanceHistoryRepo, groupWagerRepo, lotteryDrawRepo, highRollerRepo, metricsService).(*weeklyDigestService)
+	}
+
+	t.Run("aggregates the week", func(t *testing.T) {
+		groupWagerRepo := new(testhelpers.MockGroupWagerRepository)
+		balanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+		lotteryDrawRepo := new(testhelpers.MockLotteryDrawRepository)
+		highRollerRepo := new(testhelpers.MockHighRollerPurchaseRepository)
+
+		groupWagerRepo.On("StreamOutcomesByDateRange", ctx, from, to).Return([]*entities.GroupWagerOutcome{
+			{GroupWagerID: 1, DiscordID: 111, Amount: 1000, Won: true},
+			{GroupWagerID: 1, DiscordID: 222, Amount: 4000},
+			{GroupWagerID: 2, DiscordID: 111, Amount: 500},
+		}, nil)
+		balanceHistoryRepo.On("StreamByDateRange", ctx, from, to).Return([]*entities.BalanceHistory{
+			{DiscordID: 111, ChangeAmount: 3000, TransactionType: entities.TransactionTypeGroupWagerWin},
+			{DiscordID: 222, ChangeAmount: -4000, TransactionType: entities.TransactionTypeGroupWagerLoss},
+			{DiscordID: 333, ChangeAmount: 50000, TransactionType: entities.TransactionTypeTransferIn},
+		}, nil)
+		lotteryDrawRepo.On("StreamCompletedDrawResults", ctx, guildID, from, to).Return([]*entities.LotteryDrawResult{
+			{DrawID: 7, TotalPot: 6000, TotalPaidOut: 6000, WinnerCount: 1},
+		}, nil)
+		highRollerRepo.On("GetLatestPurchase", ctx, guildID).Return(&entities.HighRollerPurchase{
+			DiscordID: 333, PurchasePrice: 25000, PurchasedAt: from.Add(24 * time.Hour),
+		}, nil)
+
+		predictions := make([]*entities.GroupWagerPrediction, 0, entities.WeeklyDigestMinPredictions+1)
+		for i := 0; i < entities.WeeklyDigestMinPredictions; i++ {
+			predictions = append(predictions, &entities.GroupWagerPrediction{DiscordID: 111, Amount: 100, WasCorrect: i > 0})
+		}
+		predictions = append(predictions, &entities.GroupWagerPrediction{DiscordID: 222, Amount: 100, WasCorrect: true})
+		groupWagerRepo.On("GetGroupWagerPredictions", ctx, mock.Anything, mock.Anything).Return(predictions, nil)
+
+		digest, err := newService(groupWagerRepo, balanceHistoryRepo, lotteryDrawRepo, highRollerRepo).BuildDigest(ctx, guildID, from, to)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(5500), digest.TotalWagered)
+		assert.Equal(t, 3, digest.BetsSettled)
+		assert.Equal(t, 2, digest.WagersResolved)
+
+		require.Len(t, digest.BiggestWins, 1)
+		assert.Equal(t, int64(111), digest.BiggestWins[0].DiscordID)
+		require.Len(t, digest.BiggestLosses, 1)
+		assert.Equal(t, int64(4000), digest.BiggestLosses[0].Amount)
+
+		assert.Equal(t, int64(6000), digest.LotteryPaidOut())
+		require.NotNil(t, digest.NewHighRoller)
+		assert.Equal(t, int64(333), digest.NewHighRoller.DiscordID)
+
+		require.Len(t, digest.TopPredictors, 1, "users below the minimum predictions are not ranked")
+		assert.Equal(t, int64(111), digest.TopPredictors[0].DiscordID)
+		assert.InDelta(t, 80.0, digest.TopPredictors[0].AccuracyPercentage, 0.001)
+	})
+
+	t.Run("quiet week", func(t *testing.T) {
+		groupWagerRepo := new(testhelpers.MockGroupWagerRepository)
+		balanceHistoryRepo := new(testhelpers.MockBalanceHistoryRepository)
+		lotteryDrawRepo := new(testhelpers.MockLotteryDrawRepository)
+		highRollerRepo := new(testhelpers.MockHighRollerPurchaseRepository)
+
+		groupWagerRepo.On("StreamOutcomesByDateRange", ctx, from, to).Return(nil, nil)
+		balanceHistoryRepo.On("StreamByDateRange", ctx, from, to).Return(nil, nil)
+		lotteryDrawRepo.On("StreamCompletedDrawResults", ctx, guildID, from, to).Return(nil, nil)
+		highRollerRepo.On("GetLatestPurchase", ctx, guildID).Return(&entities.HighRollerPurchase{
+			DiscordID: 333, PurchasedAt: from.Add(-48 * time.Hour),
+		}, nil)
+		groupWagerRepo.On("GetGroupWagerPredictions", ctx, mock.Anything, mock.Anything).Return([]*entities.GroupWagerPrediction{}, nil)
+
+		digest, err := newService(groupWagerRepo, balanceHistoryRepo, lotteryDrawRepo, highRollerRepo).BuildDigest(ctx, guildID, from, to)
+		require.NoError(t, err)
+		assert.Nil(t, digest.NewHighRoller, "a purchase before the week is not news")
+		assert.False(t, digest.HasActivity())
+	})
+
+	t.Run("repository errors are returned", func(t *testing.T) {
+		groupWagerRepo := new(testhelpers.MockGroupWagerRepository)
+		groupWagerRepo.On("StreamOutcomesByDateRange", ctx, from, to).Return(nil, errors.New("db down"))
+
+		_, err := newService(groupWagerRepo, nil, nil, nil).BuildDigest(ctx, guildID, from, to)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get wager outcomes")
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		_, err := newService(nil, nil, nil, nil).BuildDigest(ctx, guildID, to, from)
+		require.Error(t, err)
+	})
+}
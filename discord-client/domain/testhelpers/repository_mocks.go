@@ -60,6 +60,27 @@ func (m *MockUserRepository) GetScoreboardData(ctx context.Context) ([]*entities
 	return args.Get(0).([]*entities.ScoreboardEntry), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockUserRepository) SetGamblingBreak(ctx context.Context, discordID int64, endsAt time.Time) (time.Time, error) {
+	args := m.Called(ctx, discordID, endsAt)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockUserRepository) GetLockedBalanceBreakdown(ctx context.Context, discordID int64) (*entities.LockedBalanceBreakdown, error) {
+	args := m.Called(ctx, discordID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.LockedBalanceBreakdown), args.Error(1)
+}
+
+func (m *MockUserRepository) GetEconomyGauges(ctx context.Context) (*entities.EconomyGauges, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.EconomyGauges), args.Error(1)
+}
+
 // MockBalanceHistoryRepository is a mock implementation of BalanceHistoryRepository
 type MockBalanceHistoryRepository struct {
 	mock.Mock
@@ -86,6 +107,40 @@ func (m *MockBalanceHistoryRepository) GetByDateRange(ctx context.Context, disco
 	return args.Get(0).([]*entities.BalanceHistory), args.Error(1)
 }
 
+// StreamByDateRange feeds the entries returned by the expectation to fn
+func (m *MockBalanceHistoryRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.BalanceHistory, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.BalanceHistory), args.Error(1)
+}
+
+func (m *MockBalanceHistoryRepository) MarkReversed(ctx context.Context, id, reversalID int64) error {
+	args := m.Called(ctx, id, reversalID)
+	return args.Error(0)
+}
+
+func (m *MockBalanceHistoryRepository) GetByRelated(ctx context.Context, relatedType entities.RelatedType, relatedID int64) ([]*entities.BalanceHistory, error) {
+	args := m.Called(ctx, relatedType, relatedID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.BalanceHistory), args.Error(1)
+}
+
+func (m *MockBalanceHistoryRepository) StreamByDateRange(ctx context.Context, from, to time.Time, fn func(*entities.BalanceHistory) error) error {
+	args := m.Called(ctx, from, to)
+	if histories, ok := args.Get(0).([]*entities.BalanceHistory); ok {
+		for _, history := range histories {
+			if err := fn(history); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
 func (m *MockBalanceHistoryRepository) GetTotalVolumeByUser(ctx context.Context, discordID int64) (int64, error) {
 	args := m.Called(ctx, discordID)
 	return args.Get(0).(int64), args.Error(1)
@@ -96,6 +151,69 @@ func (m *MockBalanceHistoryRepository) GetTotalDonationsByUser(ctx context.Conte
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockBalanceHistoryRepository) GetBiggestWins(ctx context.Context, discordID int64, limit int) ([]*entities.BalanceHistoryWithContext, error) {
+	args := m.Called(ctx, discordID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.BalanceHistoryWithContext), args.Error(1)
+}
+
+func (m *MockBalanceHistoryRepository) GetBiggestLosses(ctx context.Context, discordID int64, limit int) ([]*entities.BalanceHistoryWithContext, error) {
+	args := m.Called(ctx, discordID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.BalanceHistoryWithContext), args.Error(1)
+}
+
+func (m *MockBalanceHistoryRepository) GetDailyStats(ctx context.Context, discordID int64, from, to time.Time, system *entities.ExternalSystem) ([]*entities.StatsTimeSeriesBucket, error) {
+	args := m.Called(ctx, discordID, from, to, system)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.StatsTimeSeriesBucket), args.Error(1)
+}
+
+// MockBalanceHistoryRollupRepository is a mock implementation of BalanceHistoryRollupRepository
+type MockBalanceHistoryRollupRepository struct {
+	mock.Mock
+}
+
+func (m *MockBalanceHistoryRollupRepository) GetRolledUpThrough(ctx context.Context) (*time.Time, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
+func (m *MockBalanceHistoryRollupRepository) GetEarliestEntryTime(ctx context.Context) (*time.Time, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
+func (m *MockBalanceHistoryRollupRepository) RollupDays(ctx context.Context, from, to time.Time) (int64, error) {
+	args := m.Called(ctx, from, to)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockBalanceHistoryRollupRepository) GetDailyStats(ctx context.Context, discordID int64, from, to time.Time, system *entities.ExternalSystem) ([]*entities.StatsTimeSeriesBucket, error) {
+	args := m.Called(ctx, discordID, from, to, system)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.StatsTimeSeriesBucket), args.Error(1)
+}
+
+func (m *MockBalanceHistoryRollupRepository) ArchiveEntriesBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	args := m.Called(ctx, before, limit)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 // MockBetRepository is a mock implementation of BetRepository
 type MockBetRepository struct {
 	mock.Mock
@@ -138,6 +256,14 @@ func (m *MockBetRepository) GetByUserSince(ctx context.Context, discordID int64,
 	return args.Get(0).([]*entities.Bet), args.Error(1)
 }
 
+func (m *MockBetRepository) GetLeaderboardData(ctx context.Context, minBets int) ([]*entities.GamblingLeaderboardEntry, error) {
+	args := m.Called(ctx, minBets)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.GamblingLeaderboardEntry), args.Error(1)
+}
+
 // MockGroupWagerRepository is a mock implementation of GroupWagerRepository
 type MockGroupWagerRepository struct {
 	mock.Mock
@@ -172,6 +298,14 @@ func (m *MockGroupWagerRepository) GetByExternalReference(ctx context.Context, r
 	return args.Get(0).(*entities.GroupWager), args.Error(1)
 }
 
+func (m *MockGroupWagerRepository) GetGuildsWithOpenExternalReference(ctx context.Context, ref entities.ExternalReference) ([]int64, error) {
+	args := m.Called(ctx, ref)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int64), args.Error(1)
+}
+
 func (m *MockGroupWagerRepository) Update(ctx context.Context, wager *entities.GroupWager) error {
 	args := m.Called(ctx, wager)
 	return args.Error(0)
@@ -206,6 +340,37 @@ func (m *MockGroupWagerRepository) GetDetailByID(ctx context.Context, id int64)
 	return args.Get(0).(*entities.GroupWagerDetail), args.Error(1)
 }
 
+func (m *MockGroupWagerRepository) GetDetailByIDForUpdate(ctx context.Context, id int64) (*entities.GroupWagerDetail, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.GroupWagerDetail), args.Error(1)
+}
+
+func (m *MockGroupWagerRepository) GetDetailByReactionMessageID(ctx context.Context, messageID int64) (*entities.GroupWagerDetail, error) {
+	args := m.Called(ctx, messageID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.GroupWagerDetail), args.Error(1)
+}
+
+func (m *MockGroupWagerRepository) UpdateReactionBetting(ctx context.Context, groupWagerID int64, messageID, channelID int64, stake int64) error {
+	args := m.Called(ctx, groupWagerID, messageID, channelID, stake)
+	return args.Error(0)
+}
+
+func (m *MockGroupWagerRepository) UpdateMessageIDs(ctx context.Context, groupWagerID int64, messageID, channelID int64) error {
+	args := m.Called(ctx, groupWagerID, messageID, channelID)
+	return args.Error(0)
+}
+
+func (m *MockGroupWagerRepository) UpdateThreadID(ctx context.Context, groupWagerID int64, threadID int64) error {
+	args := m.Called(ctx, groupWagerID, threadID)
+	return args.Error(0)
+}
+
 func (m *MockGroupWagerRepository) GetDetailByMessageID(ctx context.Context, messageID int64) (*entities.GroupWagerDetail, error) {
 	args := m.Called(ctx, messageID)
 	if args.Get(0) == nil {
@@ -219,6 +384,11 @@ func (m *MockGroupWagerRepository) SaveParticipant(ctx context.Context, particip
 	return args.Error(0)
 }
 
+func (m *MockGroupWagerRepository) DeleteParticipant(ctx context.Context, groupWagerID int64, discordID int64) error {
+	args := m.Called(ctx, groupWagerID, discordID)
+	return args.Error(0)
+}
+
 func (m *MockGroupWagerRepository) GetParticipant(ctx context.Context, groupWagerID int64, discordID int64) (*entities.GroupWagerParticipant, error) {
 	args := m.Called(ctx, groupWagerID, discordID)
 	if args.Get(0) == nil {
@@ -255,11 +425,128 @@ func (m *MockGroupWagerRepository) UpdateAllOptionOdds(ctx context.Context, grou
 	return args.Error(0)
 }
 
+func (m *MockGroupWagerRepository) CreateOddsHistory(ctx context.Context, changes []*entities.GroupWagerOddsChange) error {
+	args := m.Called(ctx, changes)
+	return args.Error(0)
+}
+
+func (m *MockGroupWagerRepository) GetOddsHistory(ctx context.Context, groupWagerID int64) ([]*entities.GroupWagerOddsChange, error) {
+	args := m.Called(ctx, groupWagerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.GroupWagerOddsChange), args.Error(1)
+}
+
+// RecordEvent only goes through the mock when a test expects it. The event log is
+// an audit side effect, so tests that don't assert on it needn't stub it.
+func (m *MockGroupWagerRepository) RecordEvent(ctx context.Context, event *entities.GroupWagerEvent) error {
+	for _, call := range m.ExpectedCalls {
+		if call.Method == "RecordEvent" {
+			args := m.Called(ctx, event)
+			return args.Error(0)
+		}
+	}
+	return nil
+}
+
+// RecordBetChange only goes through the mock when a test expects it. Like the event log,
+// the bet change trail is an audit side effect.
+func (m *MockGroupWagerRepository) RecordBetChange(ctx context.Context, change *entities.GroupWagerBetChange) error {
+	for _, call := range m.ExpectedCalls {
+		if call.Method == "RecordBetChange" {
+			args := m.Called(ctx, change)
+			return args.Error(0)
+		}
+	}
+	return nil
+}
+
+// GetBetChanges returns no changes unless a test expects it, since it only annotates results
+func (m *MockGroupWagerRepository) GetBetChanges(ctx context.Context, groupWagerID int64) ([]*entities.GroupWagerBetChange, error) {
+	for _, call := range m.ExpectedCalls {
+		if call.Method == "GetBetChanges" {
+			args := m.Called(ctx, groupWagerID)
+			if args.Get(0) == nil {
+				return nil, args.Error(1)
+			}
+			return args.Get(0).([]*entities.GroupWagerBetChange), args.Error(1)
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockGroupWagerRepository) GetParticipantBetChanges(ctx context.Context, groupWagerID int64, discordID int64) ([]*entities.GroupWagerBetChange, error) {
+	args := m.Called(ctx, groupWagerID, discordID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.GroupWagerBetChange), args.Error(1)
+}
+
+func (m *MockGroupWagerRepository) AddHousePlayer(ctx context.Context, groupWagerID int64, summonerName, tagLine string) (bool, error) {
+	args := m.Called(ctx, groupWagerID, summonerName, tagLine)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGroupWagerRepository) RecordHousePlayerResult(ctx context.Context, groupWagerID int64, summonerName, tagLine string, won bool) error {
+	args := m.Called(ctx, groupWagerID, summonerName, tagLine, won)
+	return args.Error(0)
+}
+
+func (m *MockGroupWagerRepository) GetHousePlayers(ctx context.Context, groupWagerID int64) ([]*entities.HouseWagerPlayer, error) {
+	args := m.Called(ctx, groupWagerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.HouseWagerPlayer), args.Error(1)
+}
+
+func (m *MockGroupWagerRepository) GetEvents(ctx context.Context, groupWagerID int64) ([]*entities.GroupWagerEvent, error) {
+	args := m.Called(ctx, groupWagerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.GroupWagerEvent), args.Error(1)
+}
+
 func (m *MockGroupWagerRepository) GetStats(ctx context.Context, discordID int64) (*entities.GroupWagerStats, error) {
 	args := m.Called(ctx, discordID)
 	return args.Get(0).(*entities.GroupWagerStats), args.Error(1)
 }
 
+func (m *MockGroupWagerRepository) GetSubjectGameResults(ctx context.Context, discordID int64) ([]*entities.PlayerGameResult, error) {
+	args := m.Called(ctx, discordID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PlayerGameResult), args.Error(1)
+}
+
+func (m *MockGroupWagerRepository) GetSummonerGameResults(ctx context.Context, summonerName string, limit int) ([]*entities.PlayerGameResult, error) {
+	args := m.Called(ctx, summonerName, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PlayerGameResult), args.Error(1)
+}
+
+func (m *MockGroupWagerRepository) GetCreatorStats(ctx context.Context, creatorID int64) (*entities.GroupWagerCreatorStats, error) {
+	args := m.Called(ctx, creatorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.GroupWagerCreatorStats), args.Error(1)
+}
+
+func (m *MockGroupWagerRepository) GetCreatorPopularOptions(ctx context.Context, creatorID int64, limit int) ([]*entities.GroupWagerPopularOption, error) {
+	args := m.Called(ctx, creatorID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.GroupWagerPopularOption), args.Error(1)
+}
+
 func (m *MockGroupWagerRepository) GetExpiredActiveWagers(ctx context.Context) ([]*entities.GroupWager, error) {
 	args := m.Called(ctx)
 	return args.Get(0).([]*entities.GroupWager), args.Error(1)
@@ -275,14 +562,65 @@ func (m *MockGroupWagerRepository) GetGuildsWithActiveWagers(ctx context.Context
 	return args.Get(0).([]int64), args.Error(1)
 }
 
-func (m *MockGroupWagerRepository) GetGroupWagerPredictions(ctx context.Context, externalSystem *entities.ExternalSystem) ([]*entities.GroupWagerPrediction, error) {
-	args := m.Called(ctx, externalSystem)
+func (m *MockGroupWagerRepository) GetGuildsWithWagersPendingResolution(ctx context.Context) ([]int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]int64), args.Error(1)
+}
+
+func (m *MockGroupWagerRepository) UpdateOptionOrders(ctx context.Context, groupWagerID int64, orders map[int64]int16) error {
+	args := m.Called(ctx, groupWagerID, orders)
+	return args.Error(0)
+}
+
+func (m *MockGroupWagerRepository) UpdateAccess(ctx context.Context, groupWagerID int64, access entities.GroupWagerAccess) error {
+	args := m.Called(ctx, groupWagerID, access)
+	return args.Error(0)
+}
+
+func (m *MockGroupWagerRepository) MarkResolutionReminded(ctx context.Context, groupWagerID int64, remindedAt time.Time) error {
+	args := m.Called(ctx, groupWagerID, remindedAt)
+	return args.Error(0)
+}
+
+func (m *MockGroupWagerRepository) AssignResolver(ctx context.Context, groupWagerID int64, resolverID int64, assignedAt time.Time) error {
+	args := m.Called(ctx, groupWagerID, resolverID, assignedAt)
+	return args.Error(0)
+}
+
+func (m *MockGroupWagerRepository) HasSettledParlayLegs(ctx context.Context, groupWagerID int64) (bool, error) {
+	args := m.Called(ctx, groupWagerID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGroupWagerRepository) GetLastAssignedResolver(ctx context.Context) (*int64, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*int64), args.Error(1)
+}
+
+func (m *MockGroupWagerRepository) GetGroupWagerPredictions(ctx context.Context, externalSystem *entities.ExternalSystem, since *time.Time) ([]*entities.GroupWagerPrediction, error) {
+	args := m.Called(ctx, externalSystem, since)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*entities.GroupWagerPrediction), args.Error(1)
 }
 
+// StreamOutcomesByDateRange feeds the outcomes returned by the expectation to fn
+func (m *MockGroupWagerRepository) StreamOutcomesByDateRange(ctx context.Context, from, to time.Time, fn func(*entities.GroupWagerOutcome) error) error {
+	args := m.Called(ctx, from, to)
+	if outcomes, ok := args.Get(0).([]*entities.GroupWagerOutcome); ok {
+		for _, outcome := range outcomes {
+			if err := fn(outcome); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
 // MockWagerRepository is a mock implementation of WagerRepository for testing
 type MockWagerRepository struct {
 	mock.Mock
@@ -446,6 +784,18 @@ func (m *MockSummonerWatchRepository) GetWatch(ctx context.Context, guildID int6
 	return args.Get(0).(*entities.SummonerWatchDetail), args.Error(1)
 }
 
+func (m *MockSummonerWatchRepository) RecordGameResult(ctx context.Context, summonerName, tagLine, gameID string, won bool) (*entities.SummonerStreak, *entities.SummonerStreak, error) {
+	args := m.Called(ctx, summonerName, tagLine, gameID, won)
+	var previous, current *entities.SummonerStreak
+	if args.Get(0) != nil {
+		previous = args.Get(0).(*entities.SummonerStreak)
+	}
+	if args.Get(1) != nil {
+		current = args.Get(1).(*entities.SummonerStreak)
+	}
+	return previous, current, args.Error(2)
+}
+
 // MockWordleCompletionRepository is a mock implementation of WordleCompletionRepository
 type MockWordleCompletionRepository struct {
 	mock.Mock
@@ -545,11 +895,29 @@ func (m *MockLotteryDrawRepository) GetPendingDrawsForTime(ctx context.Context,
 	return args.Get(0).([]*entities.LotteryDraw), args.Error(1)
 }
 
+func (m *MockLotteryDrawRepository) GetOpenDrawsWithoutMessage(ctx context.Context, afterTime time.Time) ([]*entities.LotteryDraw, error) {
+	args := m.Called(ctx, afterTime)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.LotteryDraw), args.Error(1)
+}
+
 func (m *MockLotteryDrawRepository) IncrementPot(ctx context.Context, drawID, amount int64) error {
 	args := m.Called(ctx, drawID, amount)
 	return args.Error(0)
 }
 
+func (m *MockLotteryDrawRepository) AddPotTax(ctx context.Context, drawID, amount int64) (bool, error) {
+	args := m.Called(ctx, drawID, amount)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockLotteryDrawRepository) RemovePotTax(ctx context.Context, drawID, amount int64) (bool, error) {
+	args := m.Called(ctx, drawID, amount)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockLotteryDrawRepository) GetCurrentOpenDraw(ctx context.Context, guildID int64) (*entities.LotteryDraw, error) {
 	args := m.Called(ctx, guildID)
 	if args.Get(0) == nil {
@@ -566,6 +934,11 @@ func (m *MockLotteryDrawRepository) GetByIDForUpdate(ctx context.Context, id int
 	return args.Get(0).(*entities.LotteryDraw), args.Error(1)
 }
 
+func (m *MockLotteryDrawRepository) TryLockDraw(ctx context.Context, drawID int64) (bool, error) {
+	args := m.Called(ctx, drawID)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockLotteryDrawRepository) GetNextPendingDrawTime(ctx context.Context) (*time.Time, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
@@ -574,6 +947,48 @@ func (m *MockLotteryDrawRepository) GetNextPendingDrawTime(ctx context.Context)
 	return args.Get(0).(*time.Time), args.Error(1)
 }
 
+func (m *MockLotteryDrawRepository) GetRecentCompletedDraws(ctx context.Context, guildID int64, limit int) ([]*entities.LotteryDraw, error) {
+	args := m.Called(ctx, guildID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.LotteryDraw), args.Error(1)
+}
+
+func (m *MockLotteryDrawRepository) GetWinningNumberFrequency(ctx context.Context, guildID, difficulty int64) ([]*entities.LotteryNumberFrequency, error) {
+	args := m.Called(ctx, guildID, difficulty)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.LotteryNumberFrequency), args.Error(1)
+}
+
+// StreamCompletedDrawResults feeds the results returned by the expectation to fn
+func (m *MockLotteryDrawRepository) StreamCompletedDrawResults(ctx context.Context, guildID int64, from, to time.Time, fn func(*entities.LotteryDrawResult) error) error {
+	args := m.Called(ctx, guildID, from, to)
+	if results, ok := args.Get(0).([]*entities.LotteryDrawResult); ok {
+		for _, result := range results {
+			if err := fn(result); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *MockLotteryDrawRepository) GetCompletedDrawResults(ctx context.Context, guildID int64, limit, offset int) ([]*entities.LotteryDrawResult, error) {
+	args := m.Called(ctx, guildID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.LotteryDrawResult), args.Error(1)
+}
+
+func (m *MockLotteryDrawRepository) CountCompletedDraws(ctx context.Context, guildID int64) (int64, error) {
+	args := m.Called(ctx, guildID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 // MockLotteryTicketRepository is a mock implementation of LotteryTicketRepository
 type MockLotteryTicketRepository struct {
 	mock.Mock
@@ -621,6 +1036,14 @@ func (m *MockLotteryTicketRepository) GetUsedNumbersByUser(ctx context.Context,
 	return args.Get(0).([]int64), args.Error(1)
 }
 
+func (m *MockLotteryTicketRepository) GetUserNumberFrequency(ctx context.Context, discordID, difficulty int64, limit int) ([]*entities.LotteryNumberFrequency, error) {
+	args := m.Called(ctx, discordID, difficulty, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.LotteryNumberFrequency), args.Error(1)
+}
+
 // MockLotteryWinnerRepository is a mock implementation of LotteryWinnerRepository
 type MockLotteryWinnerRepository struct {
 	mock.Mock
@@ -646,3 +1069,604 @@ func (m *MockLotteryWinnerRepository) GetByUserID(ctx context.Context, discordID
 	}
 	return args.Get(0).([]*entities.LotteryWinner), args.Error(1)
 }
+
+func (m *MockLotteryWinnerRepository) GetByDrawIDs(ctx context.Context, drawIDs []int64) ([]*entities.LotteryWinner, error) {
+	args := m.Called(ctx, drawIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.LotteryWinner), args.Error(1)
+}
+
+func (m *MockLotteryWinnerRepository) GetUserWins(ctx context.Context, discordID int64, limit, offset int) ([]*entities.LotteryUserWin, error) {
+	args := m.Called(ctx, discordID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.LotteryUserWin), args.Error(1)
+}
+
+func (m *MockLotteryWinnerRepository) GetUserWinTotals(ctx context.Context, discordID int64) (*entities.LotteryUserWinTotals, error) {
+	args := m.Called(ctx, discordID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.LotteryUserWinTotals), args.Error(1)
+}
+
+// MockExperimentRepository is a mock implementation of ExperimentRepository
+type MockExperimentRepository struct {
+	mock.Mock
+}
+
+func (m *MockExperimentRepository) GetByKey(ctx context.Context, key string) (*entities.Experiment, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Experiment), args.Error(1)
+}
+
+func (m *MockExperimentRepository) UpdateRollout(ctx context.Context, key string, rolloutPercent int, enabled bool) error {
+	args := m.Called(ctx, key, rolloutPercent, enabled)
+	return args.Error(0)
+}
+
+func (m *MockExperimentRepository) RecordExposure(ctx context.Context, exposure *entities.ExperimentExposure) error {
+	args := m.Called(ctx, exposure)
+	return args.Error(0)
+}
+
+func (m *MockExperimentRepository) GetReport(ctx context.Context, key string) ([]*entities.ExperimentVariantReport, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ExperimentVariantReport), args.Error(1)
+}
+
+// MockSavingsDepositRepository is a mock implementation of SavingsDepositRepository
+type MockSavingsDepositRepository struct {
+	mock.Mock
+}
+
+func (m *MockSavingsDepositRepository) Create(ctx context.Context, deposit *entities.SavingsDeposit) error {
+	args := m.Called(ctx, deposit)
+	return args.Error(0)
+}
+
+func (m *MockSavingsDepositRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.SavingsDeposit, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.SavingsDeposit), args.Error(1)
+}
+
+func (m *MockSavingsDepositRepository) GetLockedByUser(ctx context.Context, discordID int64) ([]*entities.SavingsDeposit, error) {
+	args := m.Called(ctx, discordID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.SavingsDeposit), args.Error(1)
+}
+
+func (m *MockSavingsDepositRepository) Update(ctx context.Context, deposit *entities.SavingsDeposit) error {
+	args := m.Called(ctx, deposit)
+	return args.Error(0)
+}
+
+func (m *MockSavingsDepositRepository) GetMaturedDeposits(ctx context.Context, asOf time.Time) ([]*entities.SavingsDeposit, error) {
+	args := m.Called(ctx, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.SavingsDeposit), args.Error(1)
+}
+
+// MockBalanceFreezeRepository is a mock implementation of BalanceFreezeRepository
+type MockBalanceFreezeRepository struct {
+	mock.Mock
+}
+
+func (m *MockBalanceFreezeRepository) Create(ctx context.Context, freeze *entities.BalanceFreeze) error {
+	args := m.Called(ctx, freeze)
+	return args.Error(0)
+}
+
+func (m *MockBalanceFreezeRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.BalanceFreeze, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.BalanceFreeze), args.Error(1)
+}
+
+func (m *MockBalanceFreezeRepository) GetOpenByUserForUpdate(ctx context.Context, discordID int64) (*entities.BalanceFreeze, error) {
+	args := m.Called(ctx, discordID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.BalanceFreeze), args.Error(1)
+}
+
+func (m *MockBalanceFreezeRepository) Update(ctx context.Context, freeze *entities.BalanceFreeze) error {
+	args := m.Called(ctx, freeze)
+	return args.Error(0)
+}
+
+func (m *MockBalanceFreezeRepository) GetExpiredFreezes(ctx context.Context, asOf time.Time) ([]*entities.BalanceFreeze, error) {
+	args := m.Called(ctx, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.BalanceFreeze), args.Error(1)
+}
+
+// MockParlayRepository is a mock implementation of ParlayRepository
+type MockParlayRepository struct {
+	mock.Mock
+}
+
+func (m *MockParlayRepository) Create(ctx context.Context, parlay *entities.Parlay) error {
+	args := m.Called(ctx, parlay)
+	return args.Error(0)
+}
+
+func (m *MockParlayRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.Parlay, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Parlay), args.Error(1)
+}
+
+func (m *MockParlayRepository) GetByUser(ctx context.Context, discordID int64, limit int) ([]*entities.Parlay, error) {
+	args := m.Called(ctx, discordID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Parlay), args.Error(1)
+}
+
+func (m *MockParlayRepository) GetPendingByGroupWagerForUpdate(ctx context.Context, groupWagerID int64) ([]*entities.Parlay, error) {
+	args := m.Called(ctx, groupWagerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Parlay), args.Error(1)
+}
+
+func (m *MockParlayRepository) Update(ctx context.Context, parlay *entities.Parlay) error {
+	args := m.Called(ctx, parlay)
+	return args.Error(0)
+}
+
+// MockGuildResolverRepository is a mock implementation of GuildResolverRepository
+type MockGuildResolverRepository struct {
+	mock.Mock
+}
+
+func (m *MockGuildResolverRepository) GetAll(ctx context.Context) ([]*entities.GuildResolver, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.GuildResolver), args.Error(1)
+}
+
+func (m *MockGuildResolverRepository) Add(ctx context.Context, resolver *entities.GuildResolver) (bool, error) {
+	args := m.Called(ctx, resolver)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGuildResolverRepository) Remove(ctx context.Context, resolverType entities.GuildResolverType, targetID int64) (bool, error) {
+	args := m.Called(ctx, resolverType, targetID)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockGuildFeatureFlagRepository is a mock implementation of GuildFeatureFlagRepository
+type MockGuildFeatureFlagRepository struct {
+	mock.Mock
+}
+
+func (m *MockGuildFeatureFlagRepository) GetAll(ctx context.Context) ([]*entities.GuildFeatureFlag, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.GuildFeatureFlag), args.Error(1)
+}
+
+func (m *MockGuildFeatureFlagRepository) Set(ctx context.Context, flag *entities.GuildFeatureFlag) error {
+	args := m.Called(ctx, flag)
+	return args.Error(0)
+}
+
+// MockGuildWebhookRepository is a mock implementation of GuildWebhookRepository
+type MockGuildWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *MockGuildWebhookRepository) Create(ctx context.Context, webhook *entities.GuildWebhook) error {
+	args := m.Called(ctx, webhook)
+	return args.Error(0)
+}
+
+func (m *MockGuildWebhookRepository) GetByID(ctx context.Context, id int64) (*entities.GuildWebhook, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.GuildWebhook), args.Error(1)
+}
+
+func (m *MockGuildWebhookRepository) GetAll(ctx context.Context) ([]*entities.GuildWebhook, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.GuildWebhook), args.Error(1)
+}
+
+func (m *MockGuildWebhookRepository) GetSubscribed(ctx context.Context, eventType entities.WebhookEventType) ([]*entities.GuildWebhook, error) {
+	args := m.Called(ctx, eventType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.GuildWebhook), args.Error(1)
+}
+
+func (m *MockGuildWebhookRepository) Delete(ctx context.Context, id int64) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockWebhookDeliveryRepository is a mock implementation of WebhookDeliveryRepository
+type MockWebhookDeliveryRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookDeliveryRepository) Create(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDeliveryRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.WebhookDelivery, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookDeliveryRepository) GetRecentByWebhook(ctx context.Context, webhookID int64, limit int) ([]*entities.WebhookDelivery, error) {
+	args := m.Called(ctx, webhookID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookDeliveryRepository) Update(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDeliveryRepository) GetDueDeliveries(ctx context.Context, asOf time.Time, limit int) ([]*entities.WebhookDelivery, error) {
+	args := m.Called(ctx, asOf, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.WebhookDelivery), args.Error(1)
+}
+
+// MockDiscordOutboxRepository is a mock implementation of DiscordOutboxRepository
+type MockDiscordOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockDiscordOutboxRepository) Create(ctx context.Context, message *entities.DiscordOutboxMessage) error {
+	args := m.Called(ctx, message)
+	return args.Error(0)
+}
+
+func (m *MockDiscordOutboxRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.DiscordOutboxMessage, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.DiscordOutboxMessage), args.Error(1)
+}
+
+func (m *MockDiscordOutboxRepository) Update(ctx context.Context, message *entities.DiscordOutboxMessage) error {
+	args := m.Called(ctx, message)
+	return args.Error(0)
+}
+
+func (m *MockDiscordOutboxRepository) GetDueMessages(ctx context.Context, asOf time.Time, limit int) ([]*entities.DiscordOutboxMessage, error) {
+	args := m.Called(ctx, asOf, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.DiscordOutboxMessage), args.Error(1)
+}
+
+// MockGroupWagerFollowerRepository is a mock implementation of GroupWagerFollowerRepository
+type MockGroupWagerFollowerRepository struct {
+	mock.Mock
+}
+
+func (m *MockGroupWagerFollowerRepository) Follow(ctx context.Context, groupWagerID, discordID int64) (bool, error) {
+	args := m.Called(ctx, groupWagerID, discordID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGroupWagerFollowerRepository) Unfollow(ctx context.Context, groupWagerID, discordID int64) (bool, error) {
+	args := m.Called(ctx, groupWagerID, discordID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGroupWagerFollowerRepository) GetFollowers(ctx context.Context, groupWagerID int64) ([]int64, error) {
+	args := m.Called(ctx, groupWagerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int64), args.Error(1)
+}
+
+// MockGuildSnapshotRepository is a mock implementation of GuildSnapshotRepository
+type MockGuildSnapshotRepository struct {
+	mock.Mock
+}
+
+func (m *MockGuildSnapshotRepository) Create(ctx context.Context, snapshot *entities.GuildSnapshot) error {
+	args := m.Called(ctx, snapshot)
+	return args.Error(0)
+}
+
+func (m *MockGuildSnapshotRepository) GetByID(ctx context.Context, id int64) (*entities.GuildSnapshot, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.GuildSnapshot), args.Error(1)
+}
+
+func (m *MockGuildSnapshotRepository) GetRecent(ctx context.Context, limit int) ([]*entities.GuildSnapshot, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.GuildSnapshot), args.Error(1)
+}
+
+// MockDuelRepository is a mock implementation of DuelRepository
+type MockDuelRepository struct {
+	mock.Mock
+}
+
+func (m *MockDuelRepository) Create(ctx context.Context, duel *entities.Duel) error {
+	args := m.Called(ctx, duel)
+	return args.Error(0)
+}
+
+func (m *MockDuelRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.Duel, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Duel), args.Error(1)
+}
+
+func (m *MockDuelRepository) Update(ctx context.Context, duel *entities.Duel) error {
+	args := m.Called(ctx, duel)
+	return args.Error(0)
+}
+
+// MockLotterySubscriptionRepository is a mock implementation of LotterySubscriptionRepository
+type MockLotterySubscriptionRepository struct {
+	mock.Mock
+}
+
+func (m *MockLotterySubscriptionRepository) Upsert(ctx context.Context, subscription *entities.LotterySubscription) error {
+	args := m.Called(ctx, subscription)
+	return args.Error(0)
+}
+
+func (m *MockLotterySubscriptionRepository) GetByUser(ctx context.Context, discordID int64) (*entities.LotterySubscription, error) {
+	args := m.Called(ctx, discordID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.LotterySubscription), args.Error(1)
+}
+
+func (m *MockLotterySubscriptionRepository) GetAll(ctx context.Context) ([]*entities.LotterySubscription, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.LotterySubscription), args.Error(1)
+}
+
+func (m *MockLotterySubscriptionRepository) Delete(ctx context.Context, discordID int64) (bool, error) {
+	args := m.Called(ctx, discordID)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockGlobalLeaderboardRepository is a mock implementation of GlobalLeaderboardRepository
+type MockGlobalLeaderboardRepository struct {
+	mock.Mock
+}
+
+func (m *MockGlobalLeaderboardRepository) GetPredictionEntries(ctx context.Context, viewerGuildID int64, minPredictions int) ([]*entities.GlobalLeaderboardEntry, error) {
+	args := m.Called(ctx, viewerGuildID, minPredictions)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.GlobalLeaderboardEntry), args.Error(1)
+}
+
+// MockUserPreferencesRepository is a mock implementation of UserPreferencesRepository
+type MockUserPreferencesRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserPreferencesRepository) GetByDiscordID(ctx context.Context, discordID int64) (*entities.UserPreferences, error) {
+	args := m.Called(ctx, discordID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserPreferences), args.Error(1)
+}
+
+func (m *MockUserPreferencesRepository) Upsert(ctx context.Context, prefs *entities.UserPreferences) error {
+	args := m.Called(ctx, prefs)
+	return args.Error(0)
+}
+
+// MockRiotAccountLinkRepository is a mock implementation of RiotAccountLinkRepository
+type MockRiotAccountLinkRepository struct {
+	mock.Mock
+}
+
+func (m *MockRiotAccountLinkRepository) GetByDiscordID(ctx context.Context, discordID int64) (*entities.RiotAccountLink, error) {
+	args := m.Called(ctx, discordID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.RiotAccountLink), args.Error(1)
+}
+
+func (m *MockRiotAccountLinkRepository) GetByRiotID(ctx context.Context, gameName, tagLine string) (*entities.RiotAccountLink, error) {
+	args := m.Called(ctx, gameName, tagLine)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.RiotAccountLink), args.Error(1)
+}
+
+func (m *MockRiotAccountLinkRepository) Upsert(ctx context.Context, link *entities.RiotAccountLink) error {
+	args := m.Called(ctx, link)
+	return args.Error(0)
+}
+
+func (m *MockRiotAccountLinkRepository) Delete(ctx context.Context, discordID int64) (bool, error) {
+	args := m.Called(ctx, discordID)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockUserStatsRepository is a mock implementation of UserStatsRepository
+type MockUserStatsRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserStatsRepository) GetByDiscordID(ctx context.Context, discordID int64) (*entities.CachedUserStats, error) {
+	args := m.Called(ctx, discordID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.CachedUserStats), args.Error(1)
+}
+
+func (m *MockUserStatsRepository) GetDrifted(ctx context.Context) ([]int64, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int64), args.Error(1)
+}
+
+func (m *MockUserStatsRepository) Refresh(ctx context.Context, discordID int64) error {
+	args := m.Called(ctx, discordID)
+	return args.Error(0)
+}
+
+// MockSeasonTokenRepository is a mock implementation of SeasonTokenRepository
+type MockSeasonTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockSeasonTokenRepository) GetBalance(ctx context.Context, discordID int64, season int) (int64, error) {
+	args := m.Called(ctx, discordID, season)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockSeasonTokenRepository) Credit(ctx context.Context, discordID int64, season int, amount int64) (int64, error) {
+	args := m.Called(ctx, discordID, season, amount)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockSeasonTokenRepository) Debit(ctx context.Context, discordID int64, season int, amount int64) (int64, bool, error) {
+	args := m.Called(ctx, discordID, season, amount)
+	return args.Get(0).(int64), args.Bool(1), args.Error(2)
+}
+
+func (m *MockSeasonTokenRepository) RecordHistory(ctx context.Context, history *entities.SeasonTokenHistory) error {
+	args := m.Called(ctx, history)
+	return args.Error(0)
+}
+
+func (m *MockSeasonTokenRepository) GetHistory(ctx context.Context, discordID int64, season int, limit int) ([]*entities.SeasonTokenHistory, error) {
+	args := m.Called(ctx, discordID, season, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.SeasonTokenHistory), args.Error(1)
+}
+
+// MockDataRetentionRepository is a mock implementation of DataRetentionRepository
+type MockDataRetentionRepository struct {
+	mock.Mock
+}
+
+func (m *MockDataRetentionRepository) PurgeGuild(ctx context.Context, guildID int64) (*entities.DataRetentionReport, error) {
+	args := m.Called(ctx, guildID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.DataRetentionReport), args.Error(1)
+}
+
+func (m *MockDataRetentionRepository) AnonymizeUser(ctx context.Context, discordID int64) (*entities.DataRetentionReport, error) {
+	args := m.Called(ctx, discordID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.DataRetentionReport), args.Error(1)
+}
+
+// MockAchievementRepository is a mock implementation of AchievementRepository
+type MockAchievementRepository struct {
+	mock.Mock
+}
+
+func (m *MockAchievementRepository) Unlock(ctx context.Context, discordID int64, key entities.AchievementKey) (bool, error) {
+	args := m.Called(ctx, discordID, key)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAchievementRepository) GetByDiscordID(ctx context.Context, discordID int64) ([]*entities.Achievement, error) {
+	args := m.Called(ctx, discordID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Achievement), args.Error(1)
+}
+
+func (m *MockAchievementRepository) GetByDiscordIDs(ctx context.Context, discordIDs []int64) (map[int64][]*entities.Achievement, error) {
+	args := m.Called(ctx, discordIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int64][]*entities.Achievement), args.Error(1)
+}
+
+func (m *MockAchievementRepository) RecordResult(ctx context.Context, discordID int64, won bool) (*entities.AchievementProgress, error) {
+	args := m.Called(ctx, discordID, won)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AchievementProgress), args.Error(1)
+}
@@ -61,3 +61,20 @@ func RecordBalanceChange(ctx context.Context, balanceHistoryRepo interfaces.Bala
 
 	return nil
 }
+
+// ResolveAmount resolves a typed amount against the user's available balance as read in the caller's
+// transaction. Fixed amounts are returned as-is and left to the caller's own balance checks.
+func ResolveAmount(ctx context.Context, userRepo interfaces.UserRepository, discordID int64, amount entities.Amount) (int64, error) {
+	if !amount.IsRelative() {
+		return amount.Value, nil
+	}
+
+	user, err := userRepo.GetByDiscordID(ctx, discordID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return 0, fmt.Errorf("user not found")
+	}
+	return amount.Resolve(user.AvailableBalance)
+}
@@ -117,3 +117,21 @@ func TestRecordBalanceChangeRepositoryError(t *testing.T) {
 	// Verify mock expectations
 	mockBalanceHistoryRepo.AssertExpectations(t)
 }
+
+// TestResolveAmount tests that relative amounts are taken from the user's available balance
+func TestResolveAmount(t *testing.T) {
+	ctx := context.Background()
+
+	mockUserRepo := new(testhelpers.MockUserRepository)
+	mockUserRepo.On("GetByDiscordID", ctx, int64(42)).Return(&entities.User{DiscordID: 42, Balance: 10000, AvailableBalance: 8000}, nil)
+
+	bits, err := ResolveAmount(ctx, mockUserRepo, 42, entities.Amount{Percent: 50})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4000), bits)
+
+	// Fixed amounts never need the user lookup
+	bits, err = ResolveAmount(ctx, mockUserRepo, 42, entities.FixedAmount(123))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123), bits)
+	mockUserRepo.AssertNumberOfCalls(t, "GetByDiscordID", 1)
+}
@@ -0,0 +1,16 @@
+package utils
+
+import "context"
+
+type memberRolesKey struct{}
+
+// WithMemberRoles attaches the acting Discord member's role IDs to the context for permission checks
+func WithMemberRoles(ctx context.Context, roleIDs []int64) context.Context {
+	return context.WithValue(ctx, memberRolesKey{}, roleIDs)
+}
+
+// MemberRolesFromContext returns the acting member's role IDs, or nil if none were attached
+func MemberRolesFromContext(ctx context.Context) []int64 {
+	roleIDs, _ := ctx.Value(memberRolesKey{}).([]int64)
+	return roleIDs
+}
@@ -0,0 +1,30 @@
+package infrastructure
+
+import (
+	"context"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// cacheInvalidatingDataRetentionRepository drops a purged guild's cached settings, resolver grants and
+// feature flags once the purge commits, so a guild that adds the bot back starts over from the defaults
+type cacheInvalidatingDataRetentionRepository struct {
+	interfaces.DataRetentionRepository
+	settings     *cachedGuildSettingsRepository
+	resolvers    *cachedGuildResolverRepository
+	featureFlags *cachedGuildFeatureFlagRepository
+}
+
+// PurgeGuild deletes every row belonging to the guild and invalidates its cached copies
+func (r *cacheInvalidatingDataRetentionRepository) PurgeGuild(ctx context.Context, guildID int64) (*entities.DataRetentionReport, error) {
+	report, err := r.DataRetentionRepository.PurgeGuild(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.settings.cache.wrote(guildID)
+	r.resolvers.cache.wrote(guildID)
+	r.featureFlags.cache.wrote(guildID)
+	return report, nil
+}
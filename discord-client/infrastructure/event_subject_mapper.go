@@ -19,8 +19,12 @@ func (m *EventSubjectMapper) MapEventToSubject(event events.Event) string {
 	switch event.Type() {
 	case events.EventTypeGroupWagerStateChange:
 		return "wagers.group.state_changed"
+	case events.EventTypeGroupWagerOddsChange:
+		return "wagers.group.odds_changed"
 	case events.EventTypeBalanceChange:
 		return "users.balance_changed"
+	case events.EventTypeBalanceLow:
+		return "users.balance_low"
 	case events.EventTypeUserCreated:
 		return "users.created"
 	case events.EventTypeBetPlaced:
@@ -40,8 +44,12 @@ func (m *EventSubjectMapper) MapSubjectToEventType(subject string) events.EventT
 	switch subject {
 	case "wagers.group.state_changed":
 		return events.EventTypeGroupWagerStateChange
+	case "wagers.group.odds_changed":
+		return events.EventTypeGroupWagerOddsChange
 	case "users.balance_changed":
 		return events.EventTypeBalanceChange
+	case "users.balance_low":
+		return events.EventTypeBalanceLow
 	case "users.created":
 		return events.EventTypeUserCreated
 	case "betting.placed":
@@ -59,7 +67,9 @@ func (m *EventSubjectMapper) MapSubjectToEventType(subject string) events.EventT
 func (m *EventSubjectMapper) GetAllSubjects() []string {
 	return []string{
 		"wagers.group.state_changed",
+		"wagers.group.odds_changed",
 		"users.balance_changed",
+		"users.balance_low",
 		"users.created",
 		"betting.placed",
 		"wagers.individual.resolved",
@@ -0,0 +1,157 @@
+package infrastructure
+
+import (
+	"sync"
+	"time"
+)
+
+// guildCache is a process-wide read-through cache of per-guild values shared by all units of work.
+// Entries are invalidated when this process writes a guild's value and expire after the TTL
+// so that changes made by other replicas are eventually picked up.
+type guildCache[T any] struct {
+	ttl   time.Duration
+	now   func() time.Time
+	clone func(T) T // Copies values on the way in and out so callers cannot mutate cached state
+
+	mu          sync.Mutex
+	entries     map[int64]guildCacheEntry[T]
+	generations map[int64]uint64 // Bumped on every invalidation to discard fills that raced with an update
+}
+
+type guildCacheEntry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
+// newGuildCache creates a new guild cache. A non-positive TTL disables caching.
+func newGuildCache[T any](ttl time.Duration, clone func(T) T) *guildCache[T] {
+	return &guildCache[T]{
+		ttl:         ttl,
+		now:         time.Now,
+		clone:       clone,
+		entries:     make(map[int64]guildCacheEntry[T]),
+		generations: make(map[int64]uint64),
+	}
+}
+
+// get returns a copy of the cached value for a guild if present and not expired
+func (c *guildCache[T]) get(guildID int64) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero T
+	entry, ok := c.entries[guildID]
+	if !ok {
+		return zero, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, guildID)
+		return zero, false
+	}
+
+	return c.clone(entry.value), true
+}
+
+// generation returns the invalidation generation to pass to store for a read that is about to start
+func (c *guildCache[T]) generation(guildID int64) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generations[guildID]
+}
+
+// store caches a value read from the database unless the guild was invalidated since the read started
+func (c *guildCache[T]) store(guildID int64, generation uint64, value T) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations[guildID] != generation {
+		return
+	}
+	c.entries[guildID] = guildCacheEntry[T]{
+		value:     c.clone(value),
+		expiresAt: c.now().Add(c.ttl),
+	}
+}
+
+// Invalidate drops the cached value for a guild
+func (c *guildCache[T]) Invalidate(guildID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, guildID)
+	c.generations[guildID]++
+}
+
+// guildCacheTx tracks a unit of work's use of a guildCache. Values loaded on a miss are only cached
+// once the transaction commits, since they may have been written by this transaction, and reads for
+// guilds the transaction wrote bypass the cache until it ends.
+type guildCacheTx[T any] struct {
+	cache   *guildCache[T]
+	pending map[int64]pendingGuildValue[T] // Loaded in this transaction, cached on commit
+	written map[int64]bool                 // Written in this transaction, read from the transaction until it ends
+}
+
+type pendingGuildValue[T any] struct {
+	value      T
+	generation uint64
+}
+
+func newGuildCacheTx[T any](cache *guildCache[T]) *guildCacheTx[T] {
+	return &guildCacheTx[T]{
+		cache:   cache,
+		pending: make(map[int64]pendingGuildValue[T]),
+		written: make(map[int64]bool),
+	}
+}
+
+// read returns the guild's value from this transaction's loads or the shared cache, calling load on a miss
+func (t *guildCacheTx[T]) read(guildID int64, load func() (T, error)) (T, error) {
+	// Uncommitted changes are only visible inside this transaction
+	if t.written[guildID] {
+		return load()
+	}
+
+	if loaded, ok := t.pending[guildID]; ok {
+		return t.cache.clone(loaded.value), nil
+	}
+
+	if value, ok := t.cache.get(guildID); ok {
+		return value, nil
+	}
+
+	generation := t.cache.generation(guildID)
+	value, err := load()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	t.pending[guildID] = pendingGuildValue[T]{value: t.cache.clone(value), generation: generation}
+	return value, nil
+}
+
+// wrote records that the transaction changed the guild's value and invalidates the cached copy
+func (t *guildCacheTx[T]) wrote(guildID int64) {
+	delete(t.pending, guildID)
+	t.written[guildID] = true
+	t.cache.Invalidate(guildID)
+}
+
+// ended caches values loaded by a committed transaction and invalidates guilds it wrote again,
+// discarding pre-commit values other units of work cached while the write was in flight
+func (t *guildCacheTx[T]) ended(committed bool) {
+	if committed {
+		for guildID, loaded := range t.pending {
+			t.cache.store(guildID, loaded.generation, loaded.value)
+		}
+	}
+	for guildID := range t.written {
+		t.cache.Invalidate(guildID)
+	}
+
+	t.pending = make(map[int64]pendingGuildValue[T])
+	t.written = make(map[int64]bool)
+}
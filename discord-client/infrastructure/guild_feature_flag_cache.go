@@ -0,0 +1,67 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// DefaultGuildFeatureFlagCacheTTL bounds how long another replica's feature toggle can go unnoticed
+const DefaultGuildFeatureFlagCacheTTL = 30 * time.Second
+
+// GuildFeatureFlagCache is a process-wide read-through cache of each guild's feature flags.
+// Flags are checked before every gated command and interaction, while they rarely change.
+type GuildFeatureFlagCache struct {
+	*guildCache[[]*entities.GuildFeatureFlag]
+}
+
+// NewGuildFeatureFlagCache creates a new guild feature flag cache. A non-positive TTL disables caching.
+func NewGuildFeatureFlagCache(ttl time.Duration) *GuildFeatureFlagCache {
+	return &GuildFeatureFlagCache{newGuildCache(ttl, func(flags []*entities.GuildFeatureFlag) []*entities.GuildFeatureFlag {
+		copied := make([]*entities.GuildFeatureFlag, len(flags))
+		for i, flag := range flags {
+			flagCopy := *flag
+			copied[i] = &flagCopy
+		}
+		return copied
+	})}
+}
+
+// cachedGuildFeatureFlagRepository serves a guild's feature flags from the shared cache within a unit of work
+type cachedGuildFeatureFlagRepository struct {
+	repo    interfaces.GuildFeatureFlagRepository
+	guildID int64
+	cache   *guildCacheTx[[]*entities.GuildFeatureFlag]
+}
+
+func newCachedGuildFeatureFlagRepository(repo interfaces.GuildFeatureFlagRepository, guildID int64, cache *GuildFeatureFlagCache) *cachedGuildFeatureFlagRepository {
+	return &cachedGuildFeatureFlagRepository{
+		repo:    repo,
+		guildID: guildID,
+		cache:   newGuildCacheTx(cache.guildCache),
+	}
+}
+
+// GetAll returns cached feature flags, loading them from the transaction on a miss
+func (r *cachedGuildFeatureFlagRepository) GetAll(ctx context.Context) ([]*entities.GuildFeatureFlag, error) {
+	return r.cache.read(r.guildID, func() ([]*entities.GuildFeatureFlag, error) {
+		return r.repo.GetAll(ctx)
+	})
+}
+
+// Set stores a feature flag and invalidates the cached flags
+func (r *cachedGuildFeatureFlagRepository) Set(ctx context.Context, flag *entities.GuildFeatureFlag) error {
+	if err := r.repo.Set(ctx, flag); err != nil {
+		return err
+	}
+
+	r.cache.wrote(r.guildID)
+	return nil
+}
+
+// transactionEnded caches flags loaded by a committed transaction and invalidates them if it wrote any
+func (r *cachedGuildFeatureFlagRepository) transactionEnded(committed bool) {
+	r.cache.ended(committed)
+}
@@ -0,0 +1,79 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// DefaultGuildResolverCacheTTL bounds how long another replica's resolver change can go unnoticed
+const DefaultGuildResolverCacheTTL = 30 * time.Second
+
+// GuildResolverCache is a process-wide read-through cache of each guild's resolver grants.
+// Resolver checks run on every resolve, cancel and odds change, while grants rarely change.
+type GuildResolverCache struct {
+	*guildCache[[]*entities.GuildResolver]
+}
+
+// NewGuildResolverCache creates a new guild resolver cache. A non-positive TTL disables caching.
+func NewGuildResolverCache(ttl time.Duration) *GuildResolverCache {
+	return &GuildResolverCache{newGuildCache(ttl, func(resolvers []*entities.GuildResolver) []*entities.GuildResolver {
+		copied := make([]*entities.GuildResolver, len(resolvers))
+		for i, resolver := range resolvers {
+			resolverCopy := *resolver
+			copied[i] = &resolverCopy
+		}
+		return copied
+	})}
+}
+
+// cachedGuildResolverRepository serves a guild's resolver grants from the shared cache within a unit of work
+type cachedGuildResolverRepository struct {
+	repo    interfaces.GuildResolverRepository
+	guildID int64
+	cache   *guildCacheTx[[]*entities.GuildResolver]
+}
+
+func newCachedGuildResolverRepository(repo interfaces.GuildResolverRepository, guildID int64, cache *GuildResolverCache) *cachedGuildResolverRepository {
+	return &cachedGuildResolverRepository{
+		repo:    repo,
+		guildID: guildID,
+		cache:   newGuildCacheTx(cache.guildCache),
+	}
+}
+
+// GetAll returns cached resolver grants, loading them from the transaction on a miss
+func (r *cachedGuildResolverRepository) GetAll(ctx context.Context) ([]*entities.GuildResolver, error) {
+	return r.cache.read(r.guildID, func() ([]*entities.GuildResolver, error) {
+		return r.repo.GetAll(ctx)
+	})
+}
+
+// Add grants resolver permission and invalidates the cached grants
+func (r *cachedGuildResolverRepository) Add(ctx context.Context, resolver *entities.GuildResolver) (bool, error) {
+	added, err := r.repo.Add(ctx, resolver)
+	if err != nil {
+		return false, err
+	}
+
+	r.cache.wrote(r.guildID)
+	return added, nil
+}
+
+// Remove revokes a resolver grant and invalidates the cached grants
+func (r *cachedGuildResolverRepository) Remove(ctx context.Context, resolverType entities.GuildResolverType, targetID int64) (bool, error) {
+	removed, err := r.repo.Remove(ctx, resolverType, targetID)
+	if err != nil {
+		return false, err
+	}
+
+	r.cache.wrote(r.guildID)
+	return removed, nil
+}
+
+// transactionEnded caches grants loaded by a committed transaction and invalidates them if it wrote any
+func (r *cachedGuildResolverRepository) transactionEnded(committed bool) {
+	r.cache.ended(committed)
+}
@@ -0,0 +1,70 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/testhelpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuildResolverCache_ReadThroughAndInvalidate(t *testing.T) {
+	ctx := context.Background()
+	cache := NewGuildResolverCache(time.Minute)
+	grants := []*entities.GuildResolver{{GuildID: 1, ResolverType: entities.GuildResolverTypeRole, TargetID: 777}}
+
+	repo := new(testhelpers.MockGuildResolverRepository)
+	repo.On("GetAll", mock.Anything).Return(grants, nil).Once()
+
+	// A committed read fills the cache for later units of work
+	first := newCachedGuildResolverRepository(repo, 1, cache)
+	resolvers, err := first.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, resolvers, 1)
+	resolvers[0].TargetID = 0 // Callers must not be able to corrupt the cached grants
+	first.transactionEnded(true)
+
+	second := newCachedGuildResolverRepository(repo, 1, cache)
+	resolvers, err = second.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(777), resolvers[0].TargetID)
+	second.transactionEnded(true)
+	repo.AssertNumberOfCalls(t, "GetAll", 1)
+
+	// Adding a grant invalidates the cache so the next unit of work reloads
+	repo.On("Add", mock.Anything, mock.Anything).Return(true, nil)
+	writer := newCachedGuildResolverRepository(repo, 1, cache)
+	_, err = writer.Add(ctx, &entities.GuildResolver{GuildID: 1, ResolverType: entities.GuildResolverTypeUser, TargetID: 555})
+	require.NoError(t, err)
+	writer.transactionEnded(true)
+
+	repo.On("GetAll", mock.Anything).Return(append(grants, &entities.GuildResolver{GuildID: 1, ResolverType: entities.GuildResolverTypeUser, TargetID: 555}), nil).Once()
+	third := newCachedGuildResolverRepository(repo, 1, cache)
+	resolvers, err = third.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, resolvers, 2)
+	repo.AssertNumberOfCalls(t, "GetAll", 2)
+}
+
+func TestGuildResolverCache_RolledBackReadsAreNotCached(t *testing.T) {
+	ctx := context.Background()
+	cache := NewGuildResolverCache(time.Minute)
+
+	repo := new(testhelpers.MockGuildResolverRepository)
+	repo.On("GetAll", mock.Anything).Return([]*entities.GuildResolver{}, nil)
+
+	rolledBack := newCachedGuildResolverRepository(repo, 1, cache)
+	_, err := rolledBack.GetAll(ctx)
+	require.NoError(t, err)
+	rolledBack.transactionEnded(false)
+
+	next := newCachedGuildResolverRepository(repo, 1, cache)
+	_, err = next.GetAll(ctx)
+	require.NoError(t, err)
+	repo.AssertNumberOfCalls(t, "GetAll", 2)
+}
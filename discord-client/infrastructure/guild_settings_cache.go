@@ -0,0 +1,69 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// DefaultGuildSettingsCacheTTL bounds how long another replica's settings change can go unnoticed
+const DefaultGuildSettingsCacheTTL = 30 * time.Second
+
+// GuildSettingsCache is a process-wide read-through cache of guild settings shared by all units of work
+type GuildSettingsCache struct {
+	*guildCache[entities.GuildSettings]
+}
+
+// NewGuildSettingsCache creates a new guild settings cache. A non-positive TTL disables caching.
+func NewGuildSettingsCache(ttl time.Duration) *GuildSettingsCache {
+	// Settings setters replace pointer fields rather than writing through them, so a shallow copy
+	// keeps callers from mutating the cached value
+	return &GuildSettingsCache{newGuildCache(ttl, func(settings entities.GuildSettings) entities.GuildSettings {
+		return settings
+	})}
+}
+
+// cachedGuildSettingsRepository serves guild settings reads from the shared cache within a unit of work
+type cachedGuildSettingsRepository struct {
+	repo  interfaces.GuildSettingsRepository
+	cache *guildCacheTx[entities.GuildSettings]
+}
+
+func newCachedGuildSettingsRepository(repo interfaces.GuildSettingsRepository, cache *GuildSettingsCache) *cachedGuildSettingsRepository {
+	return &cachedGuildSettingsRepository{
+		repo:  repo,
+		cache: newGuildCacheTx(cache.guildCache),
+	}
+}
+
+// GetOrCreateGuildSettings returns cached settings, loading them from the transaction on a miss
+func (r *cachedGuildSettingsRepository) GetOrCreateGuildSettings(ctx context.Context, guildID int64) (*entities.GuildSettings, error) {
+	settings, err := r.cache.read(guildID, func() (entities.GuildSettings, error) {
+		settings, err := r.repo.GetOrCreateGuildSettings(ctx, guildID)
+		if err != nil {
+			return entities.GuildSettings{}, err
+		}
+		return *settings, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// UpdateGuildSettings updates the settings and invalidates the cached copy
+func (r *cachedGuildSettingsRepository) UpdateGuildSettings(ctx context.Context, settings *entities.GuildSettings) error {
+	if err := r.repo.UpdateGuildSettings(ctx, settings); err != nil {
+		return err
+	}
+
+	r.cache.wrote(settings.GuildID)
+	return nil
+}
+
+// transactionEnded caches settings loaded by a committed transaction and invalidates guilds it updated
+func (r *cachedGuildSettingsRepository) transactionEnded(committed bool) {
+	r.cache.ended(committed)
+}
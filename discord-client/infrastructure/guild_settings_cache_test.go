@@ -0,0 +1,305 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSettingsStore holds committed guild settings and counts database reads
+type fakeSettingsStore struct {
+	mu        sync.Mutex
+	committed map[int64]entities.GuildSettings
+	reads     atomic.Int64
+}
+
+func newFakeSettingsStore() *fakeSettingsStore {
+	return &fakeSettingsStore{committed: make(map[int64]entities.GuildSettings)}
+}
+
+func (s *fakeSettingsStore) auditThreshold(guildID int64) *int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.committed[guildID].AuditThreshold
+}
+
+// fakeSettingsTx is a read-committed transaction over the store that buffers writes until commit
+type fakeSettingsTx struct {
+	store  *fakeSettingsStore
+	writes map[int64]entities.GuildSettings
+}
+
+func (s *fakeSettingsStore) begin() *fakeSettingsTx {
+	return &fakeSettingsTx{store: s, writes: make(map[int64]entities.GuildSettings)}
+}
+
+func (tx *fakeSettingsTx) GetOrCreateGuildSettings(ctx context.Context, guildID int64) (*entities.GuildSettings, error) {
+	tx.store.reads.Add(1)
+	if settings, ok := tx.writes[guildID]; ok {
+		return &settings, nil
+	}
+
+	tx.store.mu.Lock()
+	defer tx.store.mu.Unlock()
+	settings, ok := tx.store.committed[guildID]
+	if !ok {
+		// Defaults inserted by this transaction are invisible to others until commit
+		settings = entities.GuildSettings{GuildID: guildID}
+		tx.writes[guildID] = settings
+	}
+	return &settings, nil
+}
+
+func (tx *fakeSettingsTx) UpdateGuildSettings(ctx context.Context, settings *entities.GuildSettings) error {
+	tx.writes[settings.GuildID] = *settings
+	return nil
+}
+
+func (tx *fakeSettingsTx) commit() {
+	tx.store.mu.Lock()
+	defer tx.store.mu.Unlock()
+	for guildID, settings := range tx.writes {
+		tx.store.committed[guildID] = settings
+	}
+}
+
+// fakeSettingsUnitOfWork mirrors how unitOfWork wires the cached repository around a transaction
+type fakeSettingsUnitOfWork struct {
+	tx   *fakeSettingsTx
+	repo *cachedGuildSettingsRepository
+}
+
+func newFakeSettingsUnitOfWork(store *fakeSettingsStore, cache *GuildSettingsCache) *fakeSettingsUnitOfWork {
+	tx := store.begin()
+	return &fakeSettingsUnitOfWork{tx: tx, repo: newCachedGuildSettingsRepository(tx, cache)}
+}
+
+func (u *fakeSettingsUnitOfWork) commit() {
+	u.tx.commit()
+	u.repo.transactionEnded(true)
+}
+
+func (u *fakeSettingsUnitOfWork) rollback() {
+	u.repo.transactionEnded(false)
+}
+
+func TestGuildSettingsCache_ReadThrough(t *testing.T) {
+	ctx := context.Background()
+	guildID := int64(123456789)
+	store := newFakeSettingsStore()
+	cache := NewGuildSettingsCache(time.Minute)
+
+	// First read misses and is cached on commit
+	uow := newFakeSettingsUnitOfWork(store, cache)
+	settings, err := uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	assert.Equal(t, guildID, settings.GuildID)
+	uow.commit()
+	assert.Equal(t, int64(1), store.reads.Load())
+
+	// Later reads are served from the cache
+	uow = newFakeSettingsUnitOfWork(store, cache)
+	_, err = uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	_, err = uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	uow.commit()
+	assert.Equal(t, int64(1), store.reads.Load())
+}
+
+func TestGuildSettingsCache_CallersCannotMutateCachedValue(t *testing.T) {
+	ctx := context.Background()
+	guildID := int64(123456789)
+	store := newFakeSettingsStore()
+	cache := NewGuildSettingsCache(time.Minute)
+
+	uow := newFakeSettingsUnitOfWork(store, cache)
+	settings, err := uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	uow.commit()
+
+	// Modify without saving, as a service would before validation fails
+	threshold := int64(500)
+	settings.AuditThreshold = &threshold
+
+	uow = newFakeSettingsUnitOfWork(store, cache)
+	cached, err := uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	assert.Nil(t, cached.AuditThreshold)
+	cached.SetAuditThreshold(&threshold)
+	uow.rollback()
+
+	uow = newFakeSettingsUnitOfWork(store, cache)
+	cached, err = uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	assert.Nil(t, cached.AuditThreshold)
+}
+
+func TestGuildSettingsCache_UpdateInvalidates(t *testing.T) {
+	ctx := context.Background()
+	guildID := int64(123456789)
+	store := newFakeSettingsStore()
+	cache := NewGuildSettingsCache(time.Minute)
+
+	uow := newFakeSettingsUnitOfWork(store, cache)
+	_, err := uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	uow.commit()
+
+	uow = newFakeSettingsUnitOfWork(store, cache)
+	settings, err := uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	threshold := int64(25000)
+	settings.AuditThreshold = &threshold
+	require.NoError(t, uow.repo.UpdateGuildSettings(ctx, settings))
+
+	// The updating transaction reads its own write
+	reread, err := uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	assert.Equal(t, threshold, *reread.AuditThreshold)
+
+	// Other transactions keep seeing the committed value until commit
+	other := newFakeSettingsUnitOfWork(store, cache)
+	before, err := other.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	assert.Nil(t, before.AuditThreshold)
+
+	uow.commit()
+	// A fill that started before the commit must not repopulate the cache with the old value
+	other.commit()
+
+	uow = newFakeSettingsUnitOfWork(store, cache)
+	after, err := uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	require.NotNil(t, after.AuditThreshold)
+	assert.Equal(t, threshold, *after.AuditThreshold)
+}
+
+func TestGuildSettingsCache_RolledBackDefaultsAreNotCached(t *testing.T) {
+	ctx := context.Background()
+	guildID := int64(123456789)
+	store := newFakeSettingsStore()
+	cache := NewGuildSettingsCache(time.Minute)
+
+	uow := newFakeSettingsUnitOfWork(store, cache)
+	_, err := uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	uow.rollback()
+
+	_, ok := cache.get(guildID)
+	assert.False(t, ok)
+}
+
+func TestGuildSettingsCache_Expiry(t *testing.T) {
+	ctx := context.Background()
+	guildID := int64(123456789)
+	store := newFakeSettingsStore()
+	cache := NewGuildSettingsCache(time.Minute)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	uow := newFakeSettingsUnitOfWork(store, cache)
+	_, err := uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	uow.commit()
+
+	// Another replica changes the settings behind this process's back
+	threshold := int64(7500)
+	store.committed[guildID] = entities.GuildSettings{GuildID: guildID, AuditThreshold: &threshold}
+
+	uow = newFakeSettingsUnitOfWork(store, cache)
+	stale, err := uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	assert.Nil(t, stale.AuditThreshold)
+	uow.commit()
+
+	now = now.Add(time.Minute)
+	uow = newFakeSettingsUnitOfWork(store, cache)
+	fresh, err := uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+	require.NoError(t, err)
+	require.NotNil(t, fresh.AuditThreshold)
+	assert.Equal(t, threshold, *fresh.AuditThreshold)
+}
+
+func TestGuildSettingsCache_Disabled(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeSettingsStore()
+	cache := NewGuildSettingsCache(0)
+
+	for range 3 {
+		uow := newFakeSettingsUnitOfWork(store, cache)
+		_, err := uow.repo.GetOrCreateGuildSettings(ctx, 1)
+		require.NoError(t, err)
+		uow.commit()
+	}
+	assert.Equal(t, int64(3), store.reads.Load())
+}
+
+func TestGuildSettingsCache_ConcurrentUpdates(t *testing.T) {
+	ctx := context.Background()
+	guildIDs := []int64{1, 2, 3}
+	store := newFakeSettingsStore()
+	cache := NewGuildSettingsCache(time.Minute)
+
+	// Values written by transactions that rolled back are negative and must never be observed
+	var nextValue atomic.Int64
+	var wg sync.WaitGroup
+	errs := make(chan string, 1000)
+
+	for worker := range 12 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 200 {
+				guildID := guildIDs[(worker+i)%len(guildIDs)]
+				uow := newFakeSettingsUnitOfWork(store, cache)
+
+				settings, err := uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+				if err != nil {
+					errs <- err.Error()
+					return
+				}
+				if settings.AuditThreshold != nil && *settings.AuditThreshold < 0 {
+					errs <- "observed a rolled back value"
+				}
+
+				switch worker % 3 {
+				case 0:
+					value := nextValue.Add(1)
+					settings.AuditThreshold = &value
+					_ = uow.repo.UpdateGuildSettings(ctx, settings)
+					uow.commit()
+				case 1:
+					value := -nextValue.Add(1)
+					settings.AuditThreshold = &value
+					_ = uow.repo.UpdateGuildSettings(ctx, settings)
+					uow.rollback()
+				default:
+					uow.commit()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for msg := range errs {
+		t.Error(msg)
+	}
+
+	// Once writers are quiet every guild's cached value matches the committed one
+	for _, guildID := range guildIDs {
+		uow := newFakeSettingsUnitOfWork(store, cache)
+		settings, err := uow.repo.GetOrCreateGuildSettings(ctx, guildID)
+		require.NoError(t, err)
+		uow.commit()
+		assert.Equal(t, store.auditThreshold(guildID), settings.AuditThreshold, "guild %d", guildID)
+	}
+}
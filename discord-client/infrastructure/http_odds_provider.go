@@ -0,0 +1,73 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+)
+
+// HTTPOddsProvider fetches house wager odds from an external odds service over HTTP.
+// The service is queried with the wager's external reference and responds with a JSON
+// object mapping option text to odds multiplier, e.g. {"Team A": 1.85, "Team B": 2.10}.
+type HTTPOddsProvider struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewHTTPOddsProvider creates a new HTTP odds provider
+func NewHTTPOddsProvider(baseURL string) *HTTPOddsProvider {
+	return &HTTPOddsProvider{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// GetOdds returns the latest odds for the wager's options, keyed by option ID
+func (p *HTTPOddsProvider) GetOdds(ctx context.Context, detail *entities.GroupWagerDetail) (map[int64]float64, error) {
+	if detail == nil || detail.Wager == nil || detail.Wager.ExternalRef == nil {
+		return nil, nil
+	}
+
+	query := url.Values{}
+	query.Set("system", string(detail.Wager.ExternalRef.System))
+	query.Set("id", detail.Wager.ExternalRef.ID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"/odds?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create odds request: %w", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch odds: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// The provider has no odds for this event
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("odds provider returned status %d", resp.StatusCode)
+	}
+
+	var oddsByText map[string]float64
+	if err := json.NewDecoder(resp.Body).Decode(&oddsByText); err != nil {
+		return nil, fmt.Errorf("failed to decode odds response: %w", err)
+	}
+
+	odds := make(map[int64]float64)
+	for _, option := range detail.Options {
+		if multiplier, ok := oddsByText[option.OptionText]; ok {
+			odds[option.ID] = multiplier
+		}
+	}
+
+	return odds, nil
+}
@@ -0,0 +1,126 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+)
+
+// Headers sent with every webhook delivery
+const (
+	WebhookEventHeader     = "X-Gamba-Event"
+	WebhookDeliveryHeader  = "X-Gamba-Delivery"
+	WebhookTimestampHeader = "X-Gamba-Timestamp"
+	WebhookSignatureHeader = "X-Gamba-Signature"
+)
+
+// HTTPWebhookSender posts webhook deliveries as signed JSON. Receivers verify a payload by computing
+// the hex encoded HMAC-SHA256 of "<timestamp>.<body>" with the webhook's secret and comparing it to
+// the signature header, e.g.
+//
+//	X-Gamba-Timestamp: 1718000000
+//	X-Gamba-Signature: sha256=5d41402abc4b2a76b9719d911017c592...
+type HTTPWebhookSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewHTTPWebhookSender creates a new HTTP webhook sender that only connects to public addresses
+func NewHTTPWebhookSender() *HTTPWebhookSender {
+	return newHTTPWebhookSender(refuseNonPublicAddress)
+}
+
+// newHTTPWebhookSender creates a sender whose connections are checked by control once the host has been
+// resolved, so a host name can't be pointed at an internal address after the webhook was registered.
+// Redirects are not followed and the response is the delivery's result.
+func newHTTPWebhookSender(control func(network, address string, conn syscall.RawConn) error) *HTTPWebhookSender {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: control,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil // A proxy would connect on our behalf, bypassing the address check
+	transport.DialContext = dialer.DialContext
+
+	return &HTTPWebhookSender{
+		client: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: transport,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+}
+
+// refuseNonPublicAddress is a dialer control that refuses connections to loopback, private and link-local addresses
+func refuseNonPublicAddress(network, address string, conn syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid webhook address %s: %w", address, err)
+	}
+	if ip := net.ParseIP(host); ip == nil || !entities.IsPublicWebhookIP(ip) {
+		return fmt.Errorf("refusing to connect to %s: %w", host, entities.ErrWebhookAddressNotPublic)
+	}
+	return nil
+}
+
+// Send posts the delivery and returns the response status code, or 0 with an error when no response was received
+func (s *HTTPWebhookSender) Send(ctx context.Context, webhook *entities.GuildWebhook, delivery *entities.WebhookDelivery) (int, error) {
+	body, err := json.Marshal(entities.WebhookEnvelope{
+		DeliveryID: delivery.ID,
+		Event:      delivery.EventType,
+		GuildID:    entities.FormatWebhookID(delivery.GuildID),
+		CreatedAt:  delivery.CreatedAt,
+		Data:       json.RawMessage(delivery.Payload),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode webhook body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Gamba-Webhooks/1.0")
+	req.Header.Set(WebhookEventHeader, string(delivery.EventType))
+	req.Header.Set(WebhookDeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload(webhook.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drain a little of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	return resp.StatusCode, nil
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 signature of a webhook body sent at timestamp
+func SignWebhookPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,136 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPWebhookSender_Send(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1718000000, 0)
+	delivery := &entities.WebhookDelivery{
+		ID:        42,
+		GuildID:   123,
+		EventType: entities.WebhookEventBigWin,
+		Payload:   []byte(`{"discord_id":"99","amount":20000}`),
+		CreatedAt: now,
+	}
+
+	t.Run("posts a signed envelope", func(t *testing.T) {
+		t.Parallel()
+
+		var headers http.Header
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			headers = r.Header.Clone()
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		sender := newHTTPWebhookSender(nil)
+		sender.now = func() time.Time { return now }
+
+		status, err := sender.Send(context.Background(), &entities.GuildWebhook{URL: server.URL, Secret: "secret"}, delivery)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, status)
+		assert.Equal(t, "big_win", headers.Get(WebhookEventHeader))
+		assert.Equal(t, "42", headers.Get(WebhookDeliveryHeader))
+		assert.Equal(t, "1718000000", headers.Get(WebhookTimestampHeader))
+		assert.Equal(t, "sha256="+SignWebhookPayload("secret", "1718000000", body), headers.Get(WebhookSignatureHeader))
+
+		var envelope struct {
+			DeliveryID int64           `json:"delivery_id"`
+			Event      string          `json:"event"`
+			GuildID    string          `json:"guild_id"`
+			Data       json.RawMessage `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(body, &envelope))
+		assert.Equal(t, int64(42), envelope.DeliveryID)
+		assert.Equal(t, "123", envelope.GuildID)
+		assert.JSONEq(t, `{"discord_id":"99","amount":20000}`, string(envelope.Data))
+	})
+
+	t.Run("returns error statuses", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		status, err := newHTTPWebhookSender(nil).Send(context.Background(), &entities.GuildWebhook{URL: server.URL, Secret: "secret"}, delivery)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadGateway, status)
+	})
+
+	t.Run("unreachable endpoint", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		status, err := newHTTPWebhookSender(nil).Send(context.Background(), &entities.GuildWebhook{URL: server.URL, Secret: "secret"}, delivery)
+
+		assert.Error(t, err)
+		assert.Zero(t, status)
+	})
+
+	t.Run("does not follow redirects", func(t *testing.T) {
+		t.Parallel()
+
+		var redirected bool
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			redirected = true
+		}))
+		defer target.Close()
+		server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+		defer server.Close()
+
+		status, err := newHTTPWebhookSender(nil).Send(context.Background(), &entities.GuildWebhook{URL: server.URL, Secret: "secret"}, delivery)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusTemporaryRedirect, status)
+		assert.False(t, redirected)
+	})
+
+	t.Run("refuses non-public addresses", func(t *testing.T) {
+		t.Parallel()
+
+		var reached bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			reached = true
+		}))
+		defer server.Close()
+
+		status, err := NewHTTPWebhookSender().Send(context.Background(), &entities.GuildWebhook{URL: server.URL, Secret: "secret"}, delivery)
+
+		assert.ErrorIs(t, err, entities.ErrWebhookAddressNotPublic)
+		assert.Zero(t, status)
+		assert.False(t, reached)
+	})
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	t.Parallel()
+
+	signature := SignWebhookPayload("secret", "1718000000", []byte(`{}`))
+
+	assert.Len(t, signature, 64)
+	assert.Equal(t, signature, SignWebhookPayload("secret", "1718000000", []byte(`{}`)))
+	assert.NotEqual(t, signature, SignWebhookPayload("other", "1718000000", []byte(`{}`)))
+	assert.NotEqual(t, signature, SignWebhookPayload("secret", "1718000001", []byte(`{}`)))
+}
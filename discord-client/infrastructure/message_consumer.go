@@ -2,11 +2,13 @@ package infrastructure
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
 
 	"gambler/discord-client/application"
+	"gambler/discord-client/application/contracts"
 	"gambler/discord-client/application/dto"
 	events "gambler/discord-client/proto/events"
 	log "github.com/sirupsen/logrus"
@@ -18,8 +20,8 @@ type MessageConsumer struct {
 	natsClient *NATSClient
 
 	// Handler for LoL events
-	lolHandler application.LoLEventHandler
-	lolAdapter *ProtobufToLoLAdapter
+	lolHandler    application.LoLEventHandler
+	lolTranslator *contracts.LoLEventTranslator
 
 	// Handler for TFT events
 	tftHandler application.TFTEventHandler
@@ -40,13 +42,13 @@ func NewMessageConsumer(natsServers string, lolHandler application.LoLEventHandl
 	natsClient := NewNATSClient(natsServers)
 
 	mc := &MessageConsumer{
-		natsClient: natsClient,
-		lolHandler: lolHandler,
-		lolAdapter: NewProtobufToLoLAdapter(),
-		tftHandler: tftHandler,
-		tftAdapter: NewProtobufToTFTAdapter(),
-		ctx:        ctx,
-		cancel:     cancel,
+		natsClient:    natsClient,
+		lolHandler:    lolHandler,
+		lolTranslator: contracts.NewLoLEventTranslator(),
+		tftHandler:    tftHandler,
+		tftAdapter:    NewProtobufToTFTAdapter(),
+		ctx:           ctx,
+		cancel:        cancel,
 	}
 
 	return mc
@@ -117,27 +119,14 @@ func (mc *MessageConsumer) subscribe(subject string) error {
 
 // handleLoLGameStateChange processes LoL game state change events
 func (mc *MessageConsumer) handleLoLGameStateChange(ctx context.Context, data []byte) error {
-	// Deserialize the protobuf message
-	event := &events.LoLGameStateChanged{}
-	if err := proto.Unmarshal(data, event); err != nil {
+	// Decode whichever contract version the tracker sent into a domain DTO
+	domainEvent, err := mc.lolTranslator.Translate(data)
+	if errors.Is(err, contracts.ErrMalformedPayload) {
 		return fmt.Errorf("failed to unmarshal LoLGameStateChanged: %w", err)
 	}
-
-	log.WithFields(log.Fields{
-		"summoner":       fmt.Sprintf("%s#%s", event.GameName, event.TagLine),
-		"previousStatus": event.PreviousStatus,
-		"currentStatus":  event.CurrentStatus,
-		"gameId":         event.GameId,
-	}).Debug("Processing LoL game state change")
-
-	// Convert protobuf to domain DTO
-	domainEvent, err := mc.lolAdapter.ConvertGameStateChanged(event)
 	if err != nil {
 		// Log and ignore non-relevant transitions
-		log.WithFields(log.Fields{
-			"previousStatus": event.PreviousStatus,
-			"currentStatus":  event.CurrentStatus,
-		}).Debug("Ignoring non-relevant state transition")
+		log.WithError(err).Debug("Ignoring non-relevant state transition")
 		return nil
 	}
 
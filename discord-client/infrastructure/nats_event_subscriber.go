@@ -119,8 +119,12 @@ func (s *NATSEventSubscriber) deserializeEvent(eventType events.EventType, paylo
 	switch eventType {
 	case events.EventTypeGroupWagerStateChange:
 		event = &events.GroupWagerStateChangeEvent{}
+	case events.EventTypeGroupWagerOddsChange:
+		event = &events.GroupWagerOddsChangeEvent{}
 	case events.EventTypeBalanceChange:
 		event = &events.BalanceChangeEvent{}
+	case events.EventTypeBalanceLow:
+		event = &events.BalanceLowEvent{}
 	case events.EventTypeUserCreated:
 		event = &events.UserCreatedEvent{}
 	case events.EventTypeBetPlaced:
@@ -145,8 +149,12 @@ func (s *NATSEventSubscriber) mapEventTypeToSubject(eventType events.EventType)
 	switch eventType {
 	case events.EventTypeGroupWagerStateChange:
 		event = events.GroupWagerStateChangeEvent{}
+	case events.EventTypeGroupWagerOddsChange:
+		event = events.GroupWagerOddsChangeEvent{}
 	case events.EventTypeBalanceChange:
 		event = events.BalanceChangeEvent{}
+	case events.EventTypeBalanceLow:
+		event = events.BalanceLowEvent{}
 	case events.EventTypeUserCreated:
 		event = events.UserCreatedEvent{}
 	case events.EventTypeBetPlaced:
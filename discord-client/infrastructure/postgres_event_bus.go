@@ -0,0 +1,218 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"gambler/discord-client/application"
+	"gambler/discord-client/database"
+	"gambler/discord-client/domain/events"
+	"gambler/discord-client/domain/interfaces"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// PostgresEventChannel is the LISTEN/NOTIFY channel shared by all bot replicas
+	PostgresEventChannel = "gamba_events"
+
+	// maxNotifyPayloadBytes keeps payloads under Postgres' 8000 byte NOTIFY limit
+	maxNotifyPayloadBytes = 7900
+
+	postgresListenRetryDelay = 5 * time.Second
+)
+
+// broadcastEventTypes are the events that other replicas need to react to.
+// Events like Discord messages and balance changes are only handled by the instance that produced them,
+// otherwise every replica would award Wordle bits or post audit entries again.
+var broadcastEventTypes = map[events.EventType]bool{
+	events.EventTypeGroupWagerStateChange: true,
+	events.EventTypeGroupWagerOddsChange:  true,
+}
+
+// postgresEventEnvelope is the NOTIFY payload exchanged between replicas
+type postgresEventEnvelope struct {
+	SourceInstance string           `json:"source_instance"`
+	EventType      events.EventType `json:"event_type"`
+	Payload        json.RawMessage  `json:"payload"`
+}
+
+// PostgresEventBus implements the EventPublisher interface on top of Postgres LISTEN/NOTIFY
+// so that multiple bot replicas receive state change events published by any of them.
+// Events are still forwarded to the wrapped publisher for local handlers and external consumers.
+type PostgresEventBus struct {
+	db         *database.DB
+	next       interfaces.EventPublisher
+	instanceID string
+
+	mu       sync.RWMutex
+	handlers map[events.EventType][]func(context.Context, events.Event) error
+}
+
+// NewPostgresEventBus creates a new Postgres backed event bus wrapping the given publisher
+func NewPostgresEventBus(db *database.DB, next interfaces.EventPublisher) *PostgresEventBus {
+	return &PostgresEventBus{
+		db:         db,
+		next:       next,
+		instanceID: uuid.New().String(),
+		handlers:   make(map[events.EventType][]func(context.Context, events.Event) error),
+	}
+}
+
+// Publish forwards the event to the wrapped publisher and notifies other replicas
+func (b *PostgresEventBus) Publish(event events.Event) error {
+	if err := b.next.Publish(event); err != nil {
+		return err
+	}
+
+	if !broadcastEventTypes[event.Type()] {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event payload: %w", err)
+	}
+
+	envelope, err := json.Marshal(postgresEventEnvelope{
+		SourceInstance: b.instanceID,
+		EventType:      event.Type(),
+		Payload:        payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal event envelope: %w", err)
+	}
+
+	if len(envelope) > maxNotifyPayloadBytes {
+		log.WithFields(log.Fields{
+			"eventType": event.Type(),
+			"size":      len(envelope),
+		}).Warn("Event too large for NOTIFY, other replicas will not receive it")
+		return nil
+	}
+
+	if _, err := b.db.Exec(context.Background(), "SELECT pg_notify($1, $2)", PostgresEventChannel, string(envelope)); err != nil {
+		return fmt.Errorf("failed to notify replicas: %w", err)
+	}
+
+	return nil
+}
+
+// RegisterLocalHandler registers a handler for events published by this process and by other replicas
+func (b *PostgresEventBus) RegisterLocalHandler(eventType events.EventType, handler func(context.Context, events.Event) error) {
+	if registry, ok := b.next.(application.LocalHandlerRegistry); ok {
+		registry.RegisterLocalHandler(eventType, handler)
+	}
+
+	b.mu.Lock()
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+	b.mu.Unlock()
+}
+
+// Start listens for events from other replicas until the context is cancelled.
+// Returns a cleanup function that stops the listener.
+func (b *PostgresEventBus) Start(ctx context.Context) func() {
+	listenCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		log.WithField("instanceID", b.instanceID).Info("Postgres event listener started")
+
+		for {
+			if err := b.listen(listenCtx); err != nil && listenCtx.Err() == nil {
+				log.Errorf("Postgres event listener error, reconnecting: %v", err)
+			}
+
+			select {
+			case <-listenCtx.Done():
+				log.Info("Postgres event listener stopped")
+				return
+			case <-time.After(postgresListenRetryDelay):
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// listen holds a dedicated connection and dispatches notifications until an error occurs
+func (b *PostgresEventBus) listen(ctx context.Context) error {
+	conn, err := b.db.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+PostgresEventChannel); err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", PostgresEventChannel, err)
+	}
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		b.handleNotification(ctx, notification.Payload)
+	}
+}
+
+// handleNotification decodes a notification and invokes the handlers registered for its event type
+func (b *PostgresEventBus) handleNotification(ctx context.Context, payload string) {
+	var envelope postgresEventEnvelope
+	if err := json.Unmarshal([]byte(payload), &envelope); err != nil {
+		log.Errorf("Failed to unmarshal event notification: %v", err)
+		return
+	}
+
+	// Local handlers already ran synchronously when this instance published the event
+	if envelope.SourceInstance == b.instanceID {
+		return
+	}
+
+	event, err := decodeBroadcastEvent(envelope.EventType, envelope.Payload)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"eventType": envelope.EventType,
+			"error":     err,
+		}).Error("Failed to decode event notification")
+		return
+	}
+
+	b.mu.RLock()
+	handlers := b.handlers[envelope.EventType]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			log.WithFields(log.Fields{
+				"eventType":      envelope.EventType,
+				"sourceInstance": envelope.SourceInstance,
+				"error":          err,
+			}).Error("Replica event handler failed")
+		}
+	}
+}
+
+// decodeBroadcastEvent decodes the payload into the value type that local handlers expect
+func decodeBroadcastEvent(eventType events.EventType, payload []byte) (events.Event, error) {
+	switch eventType {
+	case events.EventTypeGroupWagerStateChange:
+		var event events.GroupWagerStateChangeEvent
+		err := json.Unmarshal(payload, &event)
+		return event, err
+	case events.EventTypeGroupWagerOddsChange:
+		var event events.GroupWagerOddsChangeEvent
+		err := json.Unmarshal(payload, &event)
+		return event, err
+	default:
+		return nil, fmt.Errorf("event type %s is not broadcast", eventType)
+	}
+}
@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"gambler/discord-client/application"
 	"gambler/discord-client/database"
 	"gambler/discord-client/domain/events"
-	"gambler/discord-client/repository"
 	"gambler/discord-client/domain/interfaces"
+	"gambler/discord-client/repository"
 
 	"github.com/jackc/pgx/v5"
 	log "github.com/sirupsen/logrus"
@@ -15,25 +16,48 @@ import (
 
 // unitOfWork implements the UnitOfWork interface with integrated event publishing
 type unitOfWork struct {
-	db                     *database.DB
-	tx                     pgx.Tx
-	ctx                    context.Context
-	guildID                int64
-	eventPublisher         interfaces.EventPublisher
-	pendingEvents          []events.Event
-	userRepo               interfaces.UserRepository
-	balanceHistoryRepo     interfaces.BalanceHistoryRepository
-	betRepo                interfaces.BetRepository
-	wagerRepo              interfaces.WagerRepository
-	wagerVoteRepo          interfaces.WagerVoteRepository
-	groupWagerRepo         interfaces.GroupWagerRepository
-	guildSettingsRepo      interfaces.GuildSettingsRepository
-	summonerWatchRepo      interfaces.SummonerWatchRepository
-	wordleCompletionRepo   interfaces.WordleCompletionRepository
-	highRollerPurchaseRepo interfaces.HighRollerPurchaseRepository
-	lotteryDrawRepo        interfaces.LotteryDrawRepository
-	lotteryTicketRepo      interfaces.LotteryTicketRepository
-	lotteryWinnerRepo      interfaces.LotteryWinnerRepository
+	db                      *database.DB
+	tx                      pgx.Tx
+	ctx                     context.Context
+	guildID                 int64
+	eventPublisher          interfaces.EventPublisher
+	settingsCache           *GuildSettingsCache
+	resolverCache           *GuildResolverCache
+	featureFlagCache        *GuildFeatureFlagCache
+	pendingEvents           []events.Event
+	userRepo                interfaces.UserRepository
+	balanceHistoryRepo      interfaces.BalanceHistoryRepository
+	rollupRepo              interfaces.BalanceHistoryRollupRepository
+	betRepo                 interfaces.BetRepository
+	wagerRepo               interfaces.WagerRepository
+	wagerVoteRepo           interfaces.WagerVoteRepository
+	groupWagerRepo          interfaces.GroupWagerRepository
+	guildSettingsRepo       *cachedGuildSettingsRepository
+	summonerWatchRepo       interfaces.SummonerWatchRepository
+	wordleCompletionRepo    interfaces.WordleCompletionRepository
+	highRollerPurchaseRepo  interfaces.HighRollerPurchaseRepository
+	lotteryDrawRepo         interfaces.LotteryDrawRepository
+	lotteryTicketRepo       interfaces.LotteryTicketRepository
+	lotteryWinnerRepo       interfaces.LotteryWinnerRepository
+	experimentRepo          interfaces.ExperimentRepository
+	savingsDepositRepo      interfaces.SavingsDepositRepository
+	balanceFreezeRepo       interfaces.BalanceFreezeRepository
+	parlayRepo              interfaces.ParlayRepository
+	guildResolverRepo       *cachedGuildResolverRepository
+	duelRepo                interfaces.DuelRepository
+	lotterySubscriptionRepo interfaces.LotterySubscriptionRepository
+	userPreferencesRepo     interfaces.UserPreferencesRepository
+	riotAccountLinkRepo     interfaces.RiotAccountLinkRepository
+	userStatsRepo           interfaces.UserStatsRepository
+	seasonTokenRepo         interfaces.SeasonTokenRepository
+	dataRetentionRepo       interfaces.DataRetentionRepository
+	achievementRepo         interfaces.AchievementRepository
+	featureFlagRepo         *cachedGuildFeatureFlagRepository
+	guildWebhookRepo        interfaces.GuildWebhookRepository
+	webhookDeliveryRepo     interfaces.WebhookDeliveryRepository
+	discordOutboxRepo       interfaces.DiscordOutboxRepository
+	groupWagerFollowerRepo  interfaces.GroupWagerFollowerRepository
+	guildSnapshotRepo       interfaces.GuildSnapshotRepository
 }
 
 // transactionalEventBus wraps the unit of work to buffer events
@@ -70,17 +94,42 @@ func (u *unitOfWork) Begin(ctx context.Context) error {
 	// Create guild-scoped repositories with the transaction
 	u.userRepo = repository.NewUserRepositoryScoped(tx, u.guildID)
 	u.balanceHistoryRepo = repository.NewBalanceHistoryRepositoryScoped(tx, u.guildID)
+	u.rollupRepo = repository.NewBalanceHistoryRollupRepositoryScoped(tx, u.guildID)
 	u.betRepo = repository.NewBetRepositoryScoped(tx, u.guildID)
 	u.wagerRepo = repository.NewWagerRepositoryScoped(tx, u.guildID)
 	u.wagerVoteRepo = repository.NewWagerVoteRepositoryScoped(tx, u.guildID)
 	u.groupWagerRepo = repository.NewGroupWagerRepositoryScoped(tx, u.guildID)
-	u.guildSettingsRepo = repository.NewGuildSettingsRepositoryWithTx(tx) // Guild settings don't need scoping
+	u.guildSettingsRepo = newCachedGuildSettingsRepository(repository.NewGuildSettingsRepositoryWithTx(tx), u.settingsCache) // Guild settings don't need scoping
 	u.summonerWatchRepo = repository.NewSummonerWatchRepositoryScoped(tx, u.guildID)
 	u.wordleCompletionRepo = repository.NewWordleCompletionRepositoryScoped(tx, u.guildID)
 	u.highRollerPurchaseRepo = repository.NewHighRollerPurchaseRepositoryScoped(tx, u.guildID)
 	u.lotteryDrawRepo = repository.NewLotteryDrawRepositoryScoped(tx, u.guildID)
 	u.lotteryTicketRepo = repository.NewLotteryTicketRepositoryScoped(tx, u.guildID)
 	u.lotteryWinnerRepo = repository.NewLotteryWinnerRepositoryScoped(tx, u.guildID)
+	u.experimentRepo = repository.NewExperimentRepositoryWithTx(tx) // Experiments are global
+	u.savingsDepositRepo = repository.NewSavingsDepositRepositoryScoped(tx, u.guildID)
+	u.balanceFreezeRepo = repository.NewBalanceFreezeRepositoryScoped(tx, u.guildID)
+	u.parlayRepo = repository.NewParlayRepositoryScoped(tx, u.guildID)
+	u.guildResolverRepo = newCachedGuildResolverRepository(repository.NewGuildResolverRepositoryScoped(tx, u.guildID), u.guildID, u.resolverCache)
+	u.duelRepo = repository.NewDuelRepositoryScoped(tx, u.guildID)
+	u.lotterySubscriptionRepo = repository.NewLotterySubscriptionRepositoryScoped(tx, u.guildID)
+	u.userPreferencesRepo = repository.NewUserPreferencesRepositoryWithTx(tx) // Preferences are global
+	u.riotAccountLinkRepo = repository.NewRiotAccountLinkRepositoryWithTx(tx) // Riot account links are global
+	u.userStatsRepo = repository.NewUserStatsRepositoryScoped(tx, u.guildID)
+	u.seasonTokenRepo = repository.NewSeasonTokenRepositoryScoped(tx, u.guildID)
+	u.featureFlagRepo = newCachedGuildFeatureFlagRepository(repository.NewGuildFeatureFlagRepositoryScoped(tx, u.guildID), u.guildID, u.featureFlagCache)
+	u.dataRetentionRepo = &cacheInvalidatingDataRetentionRepository{
+		DataRetentionRepository: repository.NewDataRetentionRepositoryWithTx(tx), // Deletes span every guild
+		settings:                u.guildSettingsRepo,
+		resolvers:               u.guildResolverRepo,
+		featureFlags:            u.featureFlagRepo,
+	}
+	u.achievementRepo = repository.NewAchievementRepositoryScoped(tx, u.guildID)
+	u.guildWebhookRepo = repository.NewGuildWebhookRepositoryScoped(tx, u.guildID)
+	u.webhookDeliveryRepo = repository.NewWebhookDeliveryRepositoryScoped(tx, u.guildID)
+	u.discordOutboxRepo = repository.NewDiscordOutboxRepositoryScoped(tx, u.guildID)
+	u.groupWagerFollowerRepo = repository.NewGroupWagerFollowerRepositoryScoped(tx, u.guildID)
+	u.guildSnapshotRepo = repository.NewGuildSnapshotRepositoryScoped(tx, u.guildID)
 
 	return nil
 }
@@ -98,6 +147,9 @@ func (u *unitOfWork) Commit() error {
 	}
 
 	u.tx = nil
+	u.guildSettingsRepo.transactionEnded(true)
+	u.guildResolverRepo.transactionEnded(true)
+	u.featureFlagRepo.transactionEnded(true)
 
 	// Then flush pending events after successful commit
 	if u.eventPublisher != nil && len(u.pendingEvents) > 0 {
@@ -152,6 +204,9 @@ func (u *unitOfWork) Rollback() error {
 	}
 
 	u.tx = nil
+	u.guildSettingsRepo.transactionEnded(false)
+	u.guildResolverRepo.transactionEnded(false)
+	u.featureFlagRepo.transactionEnded(false)
 	return nil
 }
 
@@ -170,6 +225,13 @@ func (u *unitOfWork) BalanceHistoryRepository() interfaces.BalanceHistoryReposit
 	return u.balanceHistoryRepo
 }
 
+func (u *unitOfWork) BalanceHistoryRollupRepository() interfaces.BalanceHistoryRollupRepository {
+	if u.rollupRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.rollupRepo
+}
+
 func (u *unitOfWork) BetRepository() interfaces.BetRepository {
 	if u.betRepo == nil {
 		panic("unit of work not started - call Begin() first")
@@ -247,6 +309,144 @@ func (u *unitOfWork) LotteryWinnerRepository() interfaces.LotteryWinnerRepositor
 	return u.lotteryWinnerRepo
 }
 
+func (u *unitOfWork) ExperimentRepository() interfaces.ExperimentRepository {
+	if u.experimentRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.experimentRepo
+}
+
+func (u *unitOfWork) SavingsDepositRepository() interfaces.SavingsDepositRepository {
+	if u.savingsDepositRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.savingsDepositRepo
+}
+
+func (u *unitOfWork) ParlayRepository() interfaces.ParlayRepository {
+	if u.parlayRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.parlayRepo
+}
+
+func (u *unitOfWork) GuildResolverRepository() interfaces.GuildResolverRepository {
+	if u.guildResolverRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.guildResolverRepo
+}
+
+func (u *unitOfWork) GuildFeatureFlagRepository() interfaces.GuildFeatureFlagRepository {
+	if u.featureFlagRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.featureFlagRepo
+}
+
+func (u *unitOfWork) GuildWebhookRepository() interfaces.GuildWebhookRepository {
+	if u.guildWebhookRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.guildWebhookRepo
+}
+
+func (u *unitOfWork) WebhookDeliveryRepository() interfaces.WebhookDeliveryRepository {
+	if u.webhookDeliveryRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.webhookDeliveryRepo
+}
+
+func (u *unitOfWork) BalanceFreezeRepository() interfaces.BalanceFreezeRepository {
+	if u.balanceFreezeRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.balanceFreezeRepo
+}
+
+func (u *unitOfWork) DiscordOutboxRepository() interfaces.DiscordOutboxRepository {
+	if u.discordOutboxRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.discordOutboxRepo
+}
+
+func (u *unitOfWork) GroupWagerFollowerRepository() interfaces.GroupWagerFollowerRepository {
+	if u.groupWagerFollowerRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.groupWagerFollowerRepo
+}
+
+func (u *unitOfWork) GuildSnapshotRepository() interfaces.GuildSnapshotRepository {
+	if u.guildSnapshotRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.guildSnapshotRepo
+}
+
+func (u *unitOfWork) DuelRepository() interfaces.DuelRepository {
+	if u.duelRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.duelRepo
+}
+
+func (u *unitOfWork) LotterySubscriptionRepository() interfaces.LotterySubscriptionRepository {
+	if u.lotterySubscriptionRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.lotterySubscriptionRepo
+}
+
+func (u *unitOfWork) UserPreferencesRepository() interfaces.UserPreferencesRepository {
+	if u.userPreferencesRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.userPreferencesRepo
+}
+
+func (u *unitOfWork) RiotAccountLinkRepository() interfaces.RiotAccountLinkRepository {
+	if u.riotAccountLinkRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.riotAccountLinkRepo
+}
+
+func (u *unitOfWork) UserStatsRepository() interfaces.UserStatsRepository {
+	if u.userStatsRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.userStatsRepo
+}
+
+func (u *unitOfWork) SeasonTokenRepository() interfaces.SeasonTokenRepository {
+	if u.seasonTokenRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.seasonTokenRepo
+}
+
+func (u *unitOfWork) DataRetentionRepository() interfaces.DataRetentionRepository {
+	if u.dataRetentionRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.dataRetentionRepo
+}
+
+func (u *unitOfWork) AchievementRepository() interfaces.AchievementRepository {
+	if u.achievementRepo == nil {
+		panic("unit of work not started - call Begin() first")
+	}
+	return u.achievementRepo
+}
+
+// Services returns a factory for domain services wired to this unit of work's repositories
+func (u *unitOfWork) Services() application.ServiceFactory {
+	return application.NewServiceFactory(u)
+}
+
 // EventBus returns the transactional event publisher
 func (u *unitOfWork) EventBus() interfaces.EventPublisher {
 	return &transactionalEventBus{uow: u}
@@ -7,37 +7,104 @@ import (
 	"gambler/discord-client/database"
 	"gambler/discord-client/domain/events"
 	"gambler/discord-client/domain/interfaces"
+	"gambler/discord-client/repository"
 )
 
 // UnitOfWorkFactory implements the application.UnitOfWorkFactory interface
 // It creates UnitOfWork instances that handle both database transactions and event publishing
 type UnitOfWorkFactory struct {
-	db             *database.DB
-	eventPublisher interfaces.EventPublisher
+	db               *database.DB
+	eventPublisher   interfaces.EventPublisher
+	settingsCache    *GuildSettingsCache
+	resolverCache    *GuildResolverCache
+	featureFlagCache *GuildFeatureFlagCache
 }
 
 // NewUnitOfWorkFactory creates a new UnitOfWorkFactory
 func NewUnitOfWorkFactory(db *database.DB, eventPublisher interfaces.EventPublisher) *UnitOfWorkFactory {
 	return &UnitOfWorkFactory{
-		db:             db,
-		eventPublisher: eventPublisher,
+		db:               db,
+		eventPublisher:   eventPublisher,
+		settingsCache:    NewGuildSettingsCache(DefaultGuildSettingsCacheTTL),
+		resolverCache:    NewGuildResolverCache(DefaultGuildResolverCacheTTL),
+		featureFlagCache: NewGuildFeatureFlagCache(DefaultGuildFeatureFlagCacheTTL),
 	}
 }
 
 // RegisterLocalHandler registers a handler that will be invoked locally for events
 // This ensures events published within the same process are handled immediately
 func (f *UnitOfWorkFactory) RegisterLocalHandler(eventType events.EventType, handler func(context.Context, events.Event) error) {
-	// Register directly with the NATSEventPublisher
-	if natsPublisher, ok := f.eventPublisher.(*NATSEventPublisher); ok {
-		natsPublisher.RegisterLocalHandler(eventType, handler)
+	// Register directly with the event publisher if it supports local handlers
+	if registry, ok := f.eventPublisher.(application.LocalHandlerRegistry); ok {
+		registry.RegisterLocalHandler(eventType, handler)
 	}
 }
 
 // CreateForGuild creates a new UnitOfWork with integrated event publishing
 func (f *UnitOfWorkFactory) CreateForGuild(guildID int64) application.UnitOfWork {
 	return &unitOfWork{
-		db:             f.db,
-		guildID:        guildID,
-		eventPublisher: f.eventPublisher,
+		db:               f.db,
+		guildID:          guildID,
+		eventPublisher:   f.eventPublisher,
+		settingsCache:    f.settingsCache,
+		resolverCache:    f.resolverCache,
+		featureFlagCache: f.featureFlagCache,
 	}
 }
+
+// CreateReadOnlyForGuild creates replica-backed repositories for a guild. Without a configured
+// replica they read from the primary pool, still outside any transaction.
+func (f *UnitOfWorkFactory) CreateReadOnlyForGuild(guildID int64) application.ReadOnlyRepositories {
+	return &readOnlyRepositories{
+		userRepo:           repository.NewUserRepositoryReadOnly(f.db, guildID),
+		wagerRepo:          repository.NewWagerRepositoryReadOnly(f.db, guildID),
+		betRepo:            repository.NewBetRepositoryReadOnly(f.db, guildID),
+		groupWagerRepo:     repository.NewGroupWagerRepositoryReadOnly(f.db, guildID),
+		balanceHistoryRepo: repository.NewBalanceHistoryRepositoryReadOnly(f.db, guildID),
+		rollupRepo:         repository.NewBalanceHistoryRollupRepositoryReadOnly(f.db, guildID),
+		userStatsRepo:      repository.NewUserStatsRepositoryReadOnly(f.db, guildID),
+		globalLeaderboard:  repository.NewGlobalLeaderboardRepositoryReadOnly(f.db),
+	}
+}
+
+// readOnlyRepositories implements application.ReadOnlyRepositories
+type readOnlyRepositories struct {
+	userRepo           interfaces.UserRepository
+	wagerRepo          interfaces.WagerRepository
+	betRepo            interfaces.BetRepository
+	groupWagerRepo     interfaces.GroupWagerRepository
+	balanceHistoryRepo interfaces.BalanceHistoryRepository
+	rollupRepo         interfaces.BalanceHistoryRollupRepository
+	userStatsRepo      interfaces.UserStatsRepository
+	globalLeaderboard  interfaces.GlobalLeaderboardRepository
+}
+
+func (r *readOnlyRepositories) UserRepository() interfaces.UserRepository { return r.userRepo }
+
+func (r *readOnlyRepositories) WagerRepository() interfaces.WagerRepository { return r.wagerRepo }
+
+func (r *readOnlyRepositories) BetRepository() interfaces.BetRepository { return r.betRepo }
+
+func (r *readOnlyRepositories) GroupWagerRepository() interfaces.GroupWagerRepository {
+	return r.groupWagerRepo
+}
+
+func (r *readOnlyRepositories) BalanceHistoryRepository() interfaces.BalanceHistoryRepository {
+	return r.balanceHistoryRepo
+}
+
+func (r *readOnlyRepositories) BalanceHistoryRollupRepository() interfaces.BalanceHistoryRollupRepository {
+	return r.rollupRepo
+}
+
+func (r *readOnlyRepositories) UserStatsRepository() interfaces.UserStatsRepository {
+	return r.userStatsRepo
+}
+
+func (r *readOnlyRepositories) GlobalLeaderboardRepository() interfaces.GlobalLeaderboardRepository {
+	return r.globalLeaderboard
+}
+
+func (r *readOnlyRepositories) Services() application.ReadOnlyServiceFactory {
+	return application.NewReadOnlyServiceFactory(r)
+}
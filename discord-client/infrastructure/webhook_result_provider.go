@@ -0,0 +1,103 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+
+	"gambler/discord-client/application"
+	"gambler/discord-client/domain/entities"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// webhookResultRequest is the JSON body external services post to report a match result
+type webhookResultRequest struct {
+	System        string `json:"system"`         // Defaults to esports
+	MatchID       string `json:"match_id"`       // External match ID the wager was linked to
+	WinningOption string `json:"winning_option"` // Text of the winning option
+}
+
+// WebhookResultProvider is a push based ResultProvider that accepts match results over HTTP.
+// Requests must carry the shared secret as a bearer token, e.g.
+//
+//	POST /webhooks/results
+//	Authorization: Bearer <secret>
+//	{"match_id": "worlds-2024-final", "winning_option": "T1"}
+type WebhookResultProvider struct {
+	secret string
+
+	mu      sync.RWMutex
+	handler application.ResultHandler
+}
+
+// NewWebhookResultProvider creates a new webhook result provider
+func NewWebhookResultProvider(secret string) *WebhookResultProvider {
+	return &WebhookResultProvider{secret: secret}
+}
+
+// Start keeps the handler that results received by the webhook are delivered to
+func (p *WebhookResultProvider) Start(ctx context.Context, handler application.ResultHandler) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handler = handler
+	return nil
+}
+
+// ServeHTTP accepts a match result and settles the linked wagers before responding
+func (p *WebhookResultProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if p.secret == "" || subtle.ConstantTimeCompare([]byte(token), []byte(p.secret)) != 1 {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	p.mu.RLock()
+	handler := p.handler
+	p.mu.RUnlock()
+	if handler == nil {
+		http.Error(w, "Result provider not started", http.StatusServiceUnavailable)
+		return
+	}
+
+	var req webhookResultRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	system := entities.SystemEsports
+	if req.System != "" {
+		system = entities.ExternalSystem(req.System)
+	}
+	result := entities.ExternalResult{
+		Reference:     entities.ExternalReference{System: system, ID: req.MatchID},
+		WinningOption: req.WinningOption,
+	}
+
+	if err := handler(r.Context(), result); err != nil {
+		log.WithFields(log.Fields{
+			"system":  system,
+			"matchID": req.MatchID,
+			"error":   err,
+		}).Warn("Failed to handle external match result")
+
+		if errors.Is(err, application.ErrNoLinkedWager) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
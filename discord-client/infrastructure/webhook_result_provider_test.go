@@ -0,0 +1,91 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gambler/discord-client/application"
+	"gambler/discord-client/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookResultProvider_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	post := func(provider *WebhookResultProvider, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/webhooks/results", strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		provider.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("delivers result to handler", func(t *testing.T) {
+		t.Parallel()
+
+		var received entities.ExternalResult
+		provider := NewWebhookResultProvider("secret")
+		require.NoError(t, provider.Start(context.Background(), func(ctx context.Context, result entities.ExternalResult) error {
+			received = result
+			return nil
+		}))
+
+		rec := post(provider, "secret", `{"match_id": "worlds-final", "winning_option": "T1"}`)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, entities.ExternalResult{
+			Reference:     entities.ExternalReference{System: entities.SystemEsports, ID: "worlds-final"},
+			WinningOption: "T1",
+		}, received)
+	})
+
+	t.Run("rejects wrong secret", func(t *testing.T) {
+		t.Parallel()
+
+		provider := NewWebhookResultProvider("secret")
+		require.NoError(t, provider.Start(context.Background(), func(ctx context.Context, result entities.ExternalResult) error {
+			t.Error("handler should not be called")
+			return nil
+		}))
+
+		assert.Equal(t, http.StatusUnauthorized, post(provider, "guess", `{}`).Code)
+		assert.Equal(t, http.StatusUnauthorized, post(provider, "", `{}`).Code)
+	})
+
+	t.Run("unlinked match is not found", func(t *testing.T) {
+		t.Parallel()
+
+		provider := NewWebhookResultProvider("secret")
+		require.NoError(t, provider.Start(context.Background(), func(ctx context.Context, result entities.ExternalResult) error {
+			return application.ErrNoLinkedWager
+		}))
+
+		assert.Equal(t, http.StatusNotFound, post(provider, "secret", `{"match_id": "unknown", "winning_option": "T1"}`).Code)
+	})
+
+	t.Run("resolution failure is unprocessable", func(t *testing.T) {
+		t.Parallel()
+
+		provider := NewWebhookResultProvider("secret")
+		require.NoError(t, provider.Start(context.Background(), func(ctx context.Context, result entities.ExternalResult) error {
+			return errors.New("wager #3 has no option matching \"T1\"")
+		}))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, post(provider, "secret", `{"match_id": "worlds-final", "winning_option": "T1"}`).Code)
+	})
+
+	t.Run("not started", func(t *testing.T) {
+		t.Parallel()
+
+		provider := NewWebhookResultProvider("secret")
+		assert.Equal(t, http.StatusServiceUnavailable, post(provider, "secret", `{}`).Code)
+	})
+}
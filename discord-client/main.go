@@ -127,7 +127,7 @@ func handleBalanceAdjustment() error {
 		BalanceBefore:   initialBalance,
 		BalanceAfter:    balance,
 		ChangeAmount:    balance - initialBalance,
-		TransactionType: entities.TransactionTypeTransferIn,
+		TransactionType: entities.AdjustmentTransactionType(balance - initialBalance),
 		TransactionMetadata: map[string]any{
 			"admin": "true",
 		},
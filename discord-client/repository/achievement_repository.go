@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// AchievementRepository implements the AchievementRepository interface over the achievements
+// and achievement_progress tables
+type AchievementRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewAchievementRepositoryScoped creates a new achievement repository with a transaction and guild scope
+func NewAchievementRepositoryScoped(tx Queryable, guildID int64) interfaces.AchievementRepository {
+	return &AchievementRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// Unlock records the achievement as unlocked. Returns false if the user had already unlocked it.
+func (r *AchievementRepository) Unlock(ctx context.Context, discordID int64, key entities.AchievementKey) (bool, error) {
+	query := `
+		INSERT INTO achievements (discord_id, guild_id, achievement_key)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (discord_id, guild_id, achievement_key) DO NOTHING`
+
+	result, err := r.q.Exec(ctx, query, discordID, r.guildID, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to unlock achievement %s for user %d: %w", key, discordID, err)
+	}
+
+	return result.RowsAffected() == 1, nil
+}
+
+// GetByDiscordID returns the user's unlocked achievements, oldest first
+func (r *AchievementRepository) GetByDiscordID(ctx context.Context, discordID int64) ([]*entities.Achievement, error) {
+	query := `
+		SELECT discord_id, guild_id, achievement_key, unlocked_at
+		FROM achievements
+		WHERE discord_id = $1 AND guild_id = $2
+		ORDER BY unlocked_at, achievement_key`
+
+	rows, err := r.q.Query(ctx, query, discordID, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get achievements for user %d: %w", discordID, err)
+	}
+
+	return scanAchievements(rows)
+}
+
+// GetByDiscordIDs returns the unlocked achievements of several users, keyed by Discord ID.
+// Users without achievements are left out.
+func (r *AchievementRepository) GetByDiscordIDs(ctx context.Context, discordIDs []int64) (map[int64][]*entities.Achievement, error) {
+	byUser := make(map[int64][]*entities.Achievement)
+	if len(discordIDs) == 0 {
+		return byUser, nil
+	}
+
+	query := `
+		SELECT discord_id, guild_id, achievement_key, unlocked_at
+		FROM achievements
+		WHERE discord_id = ANY($1) AND guild_id = $2
+		ORDER BY unlocked_at, achievement_key`
+
+	rows, err := r.q.Query(ctx, query, discordIDs, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get achievements for %d users: %w", len(discordIDs), err)
+	}
+
+	achievements, err := scanAchievements(rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, achievement := range achievements {
+		byUser[achievement.DiscordID] = append(byUser[achievement.DiscordID], achievement)
+	}
+
+	return byUser, nil
+}
+
+// RecordResult extends the user's win streak on a win or resets it on a loss, and returns the updated progress
+func (r *AchievementRepository) RecordResult(ctx context.Context, discordID int64, won bool) (*entities.AchievementProgress, error) {
+	query := `
+		INSERT INTO achievement_progress (discord_id, guild_id, current_win_streak, best_win_streak)
+		VALUES ($1, $2, CASE WHEN $3 THEN 1 ELSE 0 END, CASE WHEN $3 THEN 1 ELSE 0 END)
+		ON CONFLICT (discord_id, guild_id) DO UPDATE
+		SET current_win_streak = CASE WHEN $3 THEN achievement_progress.current_win_streak + 1 ELSE 0 END,
+			best_win_streak = GREATEST(achievement_progress.best_win_streak,
+				CASE WHEN $3 THEN achievement_progress.current_win_streak + 1 ELSE 0 END),
+			updated_at = NOW()
+		RETURNING current_win_streak, best_win_streak`
+
+	progress := &entities.AchievementProgress{DiscordID: discordID, GuildID: r.guildID}
+	err := r.q.QueryRow(ctx, query, discordID, r.guildID, won).Scan(&progress.CurrentWinStreak, &progress.BestWinStreak)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record result for user %d: %w", discordID, err)
+	}
+
+	return progress, nil
+}
+
+// scanAchievements reads achievement rows and closes them
+func scanAchievements(rows pgx.Rows) ([]*entities.Achievement, error) {
+	defer rows.Close()
+
+	var achievements []*entities.Achievement
+	for rows.Next() {
+		var achievement entities.Achievement
+		if err := rows.Scan(&achievement.DiscordID, &achievement.GuildID, &achievement.Key, &achievement.UnlockedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan achievement: %w", err)
+		}
+		achievements = append(achievements, &achievement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate achievements: %w", err)
+	}
+
+	return achievements, nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/repository/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAchievementRepository_UnlockAndStreaks(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	ctx := context.Background()
+	testGuildID := int64(1018733499869577296)
+
+	userRepo := NewUserRepository(testDB.DB)
+	alice, err := userRepo.Create(ctx, 123456789, "alice", 100000)
+	require.NoError(t, err)
+	bob, err := userRepo.Create(ctx, 987654321, "bob", 100000)
+	require.NoError(t, err)
+
+	repo := NewAchievementRepositoryScoped(testDB.DB.Pool, testGuildID)
+
+	unlocked, err := repo.Unlock(ctx, alice.DiscordID, entities.AchievementFirstWin)
+	require.NoError(t, err)
+	assert.True(t, unlocked)
+
+	// Achievements are only unlocked once
+	unlocked, err = repo.Unlock(ctx, alice.DiscordID, entities.AchievementFirstWin)
+	require.NoError(t, err)
+	assert.False(t, unlocked)
+
+	_, err = repo.Unlock(ctx, alice.DiscordID, entities.AchievementLotteryWinner)
+	require.NoError(t, err)
+
+	achievements, err := repo.GetByDiscordID(ctx, alice.DiscordID)
+	require.NoError(t, err)
+	require.Len(t, achievements, 2)
+	assert.Equal(t, testGuildID, achievements[0].GuildID)
+
+	byUser, err := repo.GetByDiscordIDs(ctx, []int64{alice.DiscordID, bob.DiscordID})
+	require.NoError(t, err)
+	assert.Len(t, byUser[alice.DiscordID], 2)
+	assert.NotContains(t, byUser, bob.DiscordID)
+
+	// Wins extend the streak and a loss resets it, keeping the best streak
+	for range 3 {
+		_, err = repo.RecordResult(ctx, bob.DiscordID, true)
+		require.NoError(t, err)
+	}
+	progress, err := repo.RecordResult(ctx, bob.DiscordID, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, progress.CurrentWinStreak)
+	assert.Equal(t, 3, progress.BestWinStreak)
+
+	progress, err = repo.RecordResult(ctx, bob.DiscordID, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, progress.CurrentWinStreak)
+	assert.Equal(t, 3, progress.BestWinStreak)
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const balanceFreezeColumns = `id, guild_id, discord_id, moderator_discord_id, reason, expires_at,
+		       created_at, unfrozen_at, unfrozen_by_discord_id`
+
+// BalanceFreezeRepository implements balance freeze data access
+type BalanceFreezeRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewBalanceFreezeRepositoryScoped creates a new balance freeze repository with guild scope
+func NewBalanceFreezeRepositoryScoped(tx Queryable, guildID int64) *BalanceFreezeRepository {
+	return &BalanceFreezeRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// Create records a new freeze in the current guild
+func (r *BalanceFreezeRepository) Create(ctx context.Context, freeze *entities.BalanceFreeze) error {
+	if freeze.GuildID != r.guildID {
+		return fmt.Errorf("guild ID mismatch: freeze has %d, repository scoped to %d", freeze.GuildID, r.guildID)
+	}
+
+	query := `
+		INSERT INTO balance_freezes (guild_id, discord_id, moderator_discord_id, reason, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	err := r.q.QueryRow(ctx, query,
+		freeze.GuildID,
+		freeze.DiscordID,
+		freeze.ModeratorDiscordID,
+		freeze.Reason,
+		freeze.ExpiresAt,
+		freeze.CreatedAt,
+	).Scan(&freeze.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create balance freeze: %w", err)
+	}
+
+	return nil
+}
+
+// GetByIDForUpdate retrieves a freeze in the current guild by ID with row lock for update
+func (r *BalanceFreezeRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.BalanceFreeze, error) {
+	query := `
+		SELECT ` + balanceFreezeColumns + `
+		FROM balance_freezes
+		WHERE id = $1 AND guild_id = $2
+		FOR UPDATE
+	`
+
+	freeze, err := scanBalanceFreeze(r.q.QueryRow(ctx, query, id, r.guildID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance freeze for update by ID %d: %w", id, err)
+	}
+
+	return freeze, nil
+}
+
+// GetOpenByUserForUpdate retrieves the user's freeze in the current guild that has not been lifted, with row lock.
+// It may have expired without the expiry worker having lifted it yet.
+func (r *BalanceFreezeRepository) GetOpenByUserForUpdate(ctx context.Context, discordID int64) (*entities.BalanceFreeze, error) {
+	query := `
+		SELECT ` + balanceFreezeColumns + `
+		FROM balance_freezes
+		WHERE discord_id = $1 AND guild_id = $2 AND unfrozen_at IS NULL
+		FOR UPDATE
+	`
+
+	freeze, err := scanBalanceFreeze(r.q.QueryRow(ctx, query, discordID, r.guildID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open balance freeze for user %d: %w", discordID, err)
+	}
+
+	return freeze, nil
+}
+
+// Update records that a freeze in the current guild was lifted
+func (r *BalanceFreezeRepository) Update(ctx context.Context, freeze *entities.BalanceFreeze) error {
+	query := `
+		UPDATE balance_freezes
+		SET unfrozen_at = $1, unfrozen_by_discord_id = $2
+		WHERE id = $3 AND guild_id = $4
+	`
+
+	result, err := r.q.Exec(ctx, query, freeze.UnfrozenAt, freeze.UnfrozenByDiscordID, freeze.ID, r.guildID)
+	if err != nil {
+		return fmt.Errorf("failed to update balance freeze: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("balance freeze %d not found in guild %d", freeze.ID, r.guildID)
+	}
+
+	return nil
+}
+
+// GetExpiredFreezes returns freezes across all guilds that expired at or before the given time and have not been lifted
+func (r *BalanceFreezeRepository) GetExpiredFreezes(ctx context.Context, asOf time.Time) ([]*entities.BalanceFreeze, error) {
+	query := `
+		SELECT ` + balanceFreezeColumns + `
+		FROM balance_freezes
+		WHERE unfrozen_at IS NULL AND expires_at <= $1
+		ORDER BY expires_at ASC, id ASC
+	`
+
+	rows, err := r.q.Query(ctx, query, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired balance freezes: %w", err)
+	}
+	defer rows.Close()
+
+	var freezes []*entities.BalanceFreeze
+	for rows.Next() {
+		freeze, err := scanBalanceFreeze(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan balance freeze: %w", err)
+		}
+		freezes = append(freezes, freeze)
+	}
+
+	return freezes, rows.Err()
+}
+
+// scanBalanceFreeze scans a single balance freeze row
+func scanBalanceFreeze(row pgx.Row) (*entities.BalanceFreeze, error) {
+	var freeze entities.BalanceFreeze
+	err := row.Scan(
+		&freeze.ID,
+		&freeze.GuildID,
+		&freeze.DiscordID,
+		&freeze.ModeratorDiscordID,
+		&freeze.Reason,
+		&freeze.ExpiresAt,
+		&freeze.CreatedAt,
+		&freeze.UnfrozenAt,
+		&freeze.UnfrozenByDiscordID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &freeze, nil
+}
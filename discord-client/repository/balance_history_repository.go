@@ -8,6 +8,8 @@ import (
 
 	"gambler/discord-client/database"
 	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
 )
 
 // BalanceHistoryRepository implements the BalanceHistoryRepository interface
@@ -29,8 +31,18 @@ func NewBalanceHistoryRepositoryScoped(tx Queryable, guildID int64) *BalanceHist
 	}
 }
 
+// NewBalanceHistoryRepositoryReadOnly creates a guild-scoped balance history repository on the read replica for heavy read paths.
+func NewBalanceHistoryRepositoryReadOnly(db *database.DB, guildID int64) *BalanceHistoryRepository {
+	return NewBalanceHistoryRepositoryScoped(db.ReadPool(), guildID)
+}
+
 // Record creates a new balance history entry
 func (r *BalanceHistoryRepository) Record(ctx context.Context, history *entities.BalanceHistory) error {
+	// Reject unregistered transaction types and changes that move money the wrong way
+	if err := history.TransactionType.ValidateBalanceChange(history.ChangeAmount, history.RelatedType); err != nil {
+		return fmt.Errorf("invalid balance history for user %d: %w", history.DiscordID, err)
+	}
+
 	// Convert metadata to JSON
 	metadataJSON, err := json.Marshal(history.TransactionMetadata)
 	if err != nil {
@@ -39,8 +51,8 @@ func (r *BalanceHistoryRepository) Record(ctx context.Context, history *entities
 
 	query := `
 		INSERT INTO balance_history 
-		(discord_id, guild_id, balance_before, balance_after, change_amount, transaction_type, transaction_metadata, related_id, related_type)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		(discord_id, guild_id, balance_before, balance_after, change_amount, transaction_type, transaction_metadata, related_id, related_type, reversal_of_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 		RETURNING id, created_at
 	`
 
@@ -54,6 +66,7 @@ func (r *BalanceHistoryRepository) Record(ctx context.Context, history *entities
 		metadataJSON,
 		history.RelatedID,
 		history.RelatedType,
+		history.ReversalOfID,
 	).Scan(&history.ID, &history.CreatedAt)
 
 	if err != nil {
@@ -66,6 +79,127 @@ func (r *BalanceHistoryRepository) Record(ctx context.Context, history *entities
 	return nil
 }
 
+// GetByIDForUpdate returns a guild balance history entry and locks it until the transaction ends
+func (r *BalanceHistoryRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.BalanceHistory, error) {
+	query := `
+		SELECT id, discord_id, guild_id, balance_before, balance_after, change_amount,
+		       transaction_type, transaction_metadata, related_id, related_type, created_at,
+		       reversal_of_id, reversed_by_id
+		FROM balance_history
+		WHERE id = $1 AND guild_id = $2
+		FOR UPDATE
+	`
+
+	var history entities.BalanceHistory
+	var metadataJSON []byte
+
+	err := r.q.QueryRow(ctx, query, id, r.guildID).Scan(
+		&history.ID,
+		&history.DiscordID,
+		&history.GuildID,
+		&history.BalanceBefore,
+		&history.BalanceAfter,
+		&history.ChangeAmount,
+		&history.TransactionType,
+		&metadataJSON,
+		&history.RelatedID,
+		&history.RelatedType,
+		&history.CreatedAt,
+		&history.ReversalOfID,
+		&history.ReversedByID,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance history %d: %w", id, err)
+	}
+
+	if len(metadataJSON) > 0 {
+		if err := json.Unmarshal(metadataJSON, &history.TransactionMetadata); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal transaction metadata: %w", err)
+		}
+	}
+
+	return &history, nil
+}
+
+// MarkReversed links an entry to the compensating entry that reverses it
+func (r *BalanceHistoryRepository) MarkReversed(ctx context.Context, id, reversalID int64) error {
+	query := `
+		UPDATE balance_history
+		SET reversed_by_id = $2
+		WHERE id = $1 AND guild_id = $3 AND reversed_by_id IS NULL
+	`
+
+	result, err := r.q.Exec(ctx, query, id, reversalID, r.guildID)
+	if err != nil {
+		return fmt.Errorf("failed to mark balance history %d as reversed: %w", id, err)
+	}
+	if result.RowsAffected() == 0 {
+		return entities.ErrTransactionAlreadyReversed
+	}
+
+	return nil
+}
+
+// GetByRelated returns the guild balance history entries recorded for a related entity, oldest first
+func (r *BalanceHistoryRepository) GetByRelated(ctx context.Context, relatedType entities.RelatedType, relatedID int64) ([]*entities.BalanceHistory, error) {
+	query := `
+		SELECT id, discord_id, guild_id, balance_before, balance_after, change_amount,
+		       transaction_type, transaction_metadata, related_id, related_type, created_at,
+		       reversal_of_id, reversed_by_id
+		FROM balance_history
+		WHERE related_type = $1 AND related_id = $2 AND guild_id = $3
+		ORDER BY id
+	`
+
+	rows, err := r.q.Query(ctx, query, relatedType, relatedID, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance history for %s %d: %w", relatedType, relatedID, err)
+	}
+	defer rows.Close()
+
+	var histories []*entities.BalanceHistory
+	for rows.Next() {
+		var history entities.BalanceHistory
+		var metadataJSON []byte
+
+		err := rows.Scan(
+			&history.ID,
+			&history.DiscordID,
+			&history.GuildID,
+			&history.BalanceBefore,
+			&history.BalanceAfter,
+			&history.ChangeAmount,
+			&history.TransactionType,
+			&metadataJSON,
+			&history.RelatedID,
+			&history.RelatedType,
+			&history.CreatedAt,
+			&history.ReversalOfID,
+			&history.ReversedByID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan balance history: %w", err)
+		}
+
+		if len(metadataJSON) > 0 {
+			if err := json.Unmarshal(metadataJSON, &history.TransactionMetadata); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal transaction metadata: %w", err)
+			}
+		}
+
+		histories = append(histories, &history)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate balance history: %w", err)
+	}
+
+	return histories, nil
+}
+
 // GetByUser returns balance history for a specific user
 func (r *BalanceHistoryRepository) GetByUser(ctx context.Context, discordID int64, limit int) ([]*entities.BalanceHistory, error) {
 	query := `
@@ -173,11 +307,79 @@ func (r *BalanceHistoryRepository) GetByDateRange(ctx context.Context, discordID
 	return histories, nil
 }
 
-// GetTotalVolumeByUser returns the total volume (sum of absolute balance changes) for a user
+// StreamByDateRange calls fn for every balance history entry in the guild within a date range, oldest first.
+// Rows are scanned one at a time so large ranges are never held in memory.
+func (r *BalanceHistoryRepository) StreamByDateRange(ctx context.Context, from, to time.Time, fn func(*entities.BalanceHistory) error) error {
+	query := `
+		SELECT id, discord_id, guild_id, balance_before, balance_after, change_amount,
+		       transaction_type, transaction_metadata, related_id, related_type, created_at
+		FROM balance_history
+		WHERE guild_id = $1 AND created_at >= $2 AND created_at < $3
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.q.Query(ctx, query, r.guildID, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to stream balance history for guild %d: %w", r.guildID, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var history entities.BalanceHistory
+		var metadataJSON []byte
+
+		err := rows.Scan(
+			&history.ID,
+			&history.DiscordID,
+			&history.GuildID,
+			&history.BalanceBefore,
+			&history.BalanceAfter,
+			&history.ChangeAmount,
+			&history.TransactionType,
+			&metadataJSON,
+			&history.RelatedID,
+			&history.RelatedType,
+			&history.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan balance history: %w", err)
+		}
+
+		if len(metadataJSON) > 0 {
+			if err := json.Unmarshal(metadataJSON, &history.TransactionMetadata); err != nil {
+				return fmt.Errorf("failed to unmarshal transaction metadata: %w", err)
+			}
+		}
+
+		if err := fn(&history); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate balance history: %w", err)
+	}
+
+	return nil
+}
+
+// balanceHistoryWithArchive reads live and archived entries together, for lifetime stats that archiving
+// old entries must not shrink
+const balanceHistoryWithArchive = `(
+		SELECT id, discord_id, guild_id, balance_before, balance_after, change_amount,
+		       transaction_type, transaction_metadata, related_id, related_type, created_at
+		FROM balance_history
+		UNION ALL
+		SELECT id, discord_id, guild_id, balance_before, balance_after, change_amount,
+		       transaction_type, transaction_metadata, related_id, related_type, created_at
+		FROM balance_history_archive
+	)`
+
+// GetTotalVolumeByUser returns the total volume (sum of absolute balance changes) for a user, including archived entries
 func (r *BalanceHistoryRepository) GetTotalVolumeByUser(ctx context.Context, discordID int64) (int64, error) {
 	query := `
 		SELECT COALESCE(SUM(ABS(change_amount)), 0)
-		FROM balance_history
+		FROM ` + balanceHistoryWithArchive + ` bh
 		WHERE discord_id = $1 AND guild_id = $2
 	`
 
@@ -190,11 +392,11 @@ func (r *BalanceHistoryRepository) GetTotalVolumeByUser(ctx context.Context, dis
 	return totalVolume, nil
 }
 
-// GetTotalDonationsByUser returns the total amount donated (transfer_out) by a user
+// GetTotalDonationsByUser returns the total amount donated (transfer_out) by a user, including archived entries
 func (r *BalanceHistoryRepository) GetTotalDonationsByUser(ctx context.Context, discordID int64) (int64, error) {
 	query := `
 		SELECT COALESCE(SUM(ABS(change_amount)), 0)
-		FROM balance_history
+		FROM ` + balanceHistoryWithArchive + ` bh
 		WHERE discord_id = $1 AND guild_id = $2 AND transaction_type = 'transfer_out'
 	`
 
@@ -205,4 +407,181 @@ func (r *BalanceHistoryRepository) GetTotalDonationsByUser(ctx context.Context,
 	}
 
 	return totalDonations, nil
-}
\ No newline at end of file
+}
+// GetDailyStats returns a user's balance history aggregated by UTC day for days with entries in the range,
+// oldest first. When system is set, only wins and losses on group wagers for that system are counted;
+// balances always cover every entry
+func (r *BalanceHistoryRepository) GetDailyStats(ctx context.Context, discordID int64, from, to time.Time, system *entities.ExternalSystem) ([]*entities.StatsTimeSeriesBucket, error) {
+	systemFilter := externalSystemFilter(system)
+
+	query := `
+		WITH entries AS (
+			SELECT bh.id, bh.created_at, bh.balance_before, bh.balance_after, bh.change_amount, bh.transaction_type,
+			       date_trunc('day', bh.created_at AT TIME ZONE 'UTC') AS day,
+			       ($6::text IS NULL OR gw.external_system = $6::text) AS counted
+			FROM balance_history bh
+			LEFT JOIN group_wagers gw ON bh.related_type = 'group_wager' AND gw.id = bh.related_id
+			WHERE bh.discord_id = $1 AND bh.guild_id = $2 AND bh.created_at >= $3 AND bh.created_at < $4
+		)
+		SELECT day,
+		       COUNT(*) FILTER (WHERE counted AND transaction_type = ANY($5)) AS wins,
+		       COUNT(*) FILTER (WHERE counted AND transaction_type = ANY($7)) AS losses,
+		       COALESCE(SUM(change_amount) FILTER (WHERE counted AND (transaction_type = ANY($5) OR transaction_type = ANY($7))), 0) AS net_profit,
+		       (ARRAY_AGG(balance_before ORDER BY created_at ASC, id ASC))[1] AS opening_balance,
+		       (ARRAY_AGG(balance_after ORDER BY created_at DESC, id DESC))[1] AS closing_balance
+		FROM entries
+		GROUP BY day
+		ORDER BY day ASC
+	`
+
+	rows, err := r.q.Query(ctx, query, discordID, r.guildID, from, to, dailyStatsWinTypes, systemFilter, dailyStatsLossTypes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily stats for user %d: %w", discordID, err)
+	}
+	defer rows.Close()
+
+	return scanDailyStats(rows)
+}
+
+// dailyStatsWinTypes and dailyStatsLossTypes are the entries counted as wins and losses in daily stats and rollups
+var (
+	dailyStatsWinTypes = []string{
+		string(entities.TransactionTypeBetWin),
+		string(entities.TransactionTypeWagerWin),
+		string(entities.TransactionTypeGroupWagerWin),
+		string(entities.TransactionTypeParlayWin),
+		string(entities.TransactionTypeDuelWin),
+	}
+	dailyStatsLossTypes = []string{
+		string(entities.TransactionTypeBetLoss),
+		string(entities.TransactionTypeWagerLoss),
+		string(entities.TransactionTypeGroupWagerLoss),
+		string(entities.TransactionTypeParlayLoss),
+		string(entities.TransactionTypeDuelLoss),
+	}
+)
+
+// externalSystemFilter converts an optional external system into a nullable query parameter
+func externalSystemFilter(system *entities.ExternalSystem) *string {
+	if system == nil {
+		return nil
+	}
+	value := string(*system)
+	return &value
+}
+
+// scanDailyStats scans day, wins, losses, net profit, opening and closing balance rows into buckets
+func scanDailyStats(rows pgx.Rows) ([]*entities.StatsTimeSeriesBucket, error) {
+	var buckets []*entities.StatsTimeSeriesBucket
+	for rows.Next() {
+		var bucket entities.StatsTimeSeriesBucket
+		if err := rows.Scan(
+			&bucket.Day,
+			&bucket.Wins,
+			&bucket.Losses,
+			&bucket.NetProfit,
+			&bucket.OpeningBalance,
+			&bucket.ClosingBalance,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan daily stats: %w", err)
+		}
+		buckets = append(buckets, &bucket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate daily stats: %w", err)
+	}
+
+	return buckets, nil
+}
+
+// GetBiggestWins returns a user's largest winning entries with the wager or lottery they came from
+func (r *BalanceHistoryRepository) GetBiggestWins(ctx context.Context, discordID int64, limit int) ([]*entities.BalanceHistoryWithContext, error) {
+	winTypes := []string{
+		string(entities.TransactionTypeBetWin),
+		string(entities.TransactionTypeWagerWin),
+		string(entities.TransactionTypeGroupWagerWin),
+		string(entities.TransactionTypeLottoWin),
+	}
+	return r.getBiggestChanges(ctx, discordID, winTypes, true, limit)
+}
+
+// GetBiggestLosses returns a user's largest losing entries with the wager they came from
+func (r *BalanceHistoryRepository) GetBiggestLosses(ctx context.Context, discordID int64, limit int) ([]*entities.BalanceHistoryWithContext, error) {
+	lossTypes := []string{
+		string(entities.TransactionTypeBetLoss),
+		string(entities.TransactionTypeWagerLoss),
+		string(entities.TransactionTypeGroupWagerLoss),
+	}
+	return r.getBiggestChanges(ctx, discordID, lossTypes, false, limit)
+}
+
+// getBiggestChanges returns entries of the given types, archived ones included, joined with their related wager,
+// group wager or lottery draw
+func (r *BalanceHistoryRepository) getBiggestChanges(ctx context.Context, discordID int64, transactionTypes []string, wins bool, limit int) ([]*entities.BalanceHistoryWithContext, error) {
+	filter, order := "bh.change_amount < 0", "bh.change_amount ASC"
+	if wins {
+		filter, order = "bh.change_amount > 0", "bh.change_amount DESC"
+	}
+
+	query := `
+		SELECT bh.id, bh.discord_id, bh.guild_id, bh.balance_before, bh.balance_after, bh.change_amount,
+		       bh.transaction_type, bh.transaction_metadata, bh.related_id, bh.related_type, bh.created_at,
+		       w.condition, gw.condition, ld.draw_time
+		FROM ` + balanceHistoryWithArchive + ` bh
+		LEFT JOIN wagers w ON bh.related_type = 'wager' AND w.id = bh.related_id
+		LEFT JOIN group_wagers gw ON bh.related_type = 'group_wager' AND gw.id = bh.related_id
+		LEFT JOIN lottery_draws ld ON bh.transaction_type = 'lotto_win'
+		     AND ld.id = (bh.transaction_metadata->>'draw_id')::bigint
+		WHERE bh.discord_id = $1 AND bh.guild_id = $2 AND bh.transaction_type = ANY($3)
+		  AND ` + filter + `
+		ORDER BY ` + order + `, bh.created_at DESC
+		LIMIT $4
+	`
+
+	rows, err := r.q.Query(ctx, query, discordID, r.guildID, transactionTypes, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get biggest balance changes for user %d: %w", discordID, err)
+	}
+	defer rows.Close()
+
+	var histories []*entities.BalanceHistoryWithContext
+	for rows.Next() {
+		var history entities.BalanceHistoryWithContext
+		var metadataJSON []byte
+
+		err := rows.Scan(
+			&history.ID,
+			&history.DiscordID,
+			&history.GuildID,
+			&history.BalanceBefore,
+			&history.BalanceAfter,
+			&history.ChangeAmount,
+			&history.TransactionType,
+			&metadataJSON,
+			&history.RelatedID,
+			&history.RelatedType,
+			&history.CreatedAt,
+			&history.WagerCondition,
+			&history.GroupWagerCondition,
+			&history.LotteryDrawTime,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan balance history: %w", err)
+		}
+
+		if len(metadataJSON) > 0 {
+			if err := json.Unmarshal(metadataJSON, &history.TransactionMetadata); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal transaction metadata: %w", err)
+			}
+		}
+
+		histories = append(histories, &history)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate balance history: %w", err)
+	}
+
+	return histories, nil
+}
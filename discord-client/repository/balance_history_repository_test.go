@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -34,6 +35,23 @@ func TestBalanceHistoryRepository_Record(t *testing.T) {
 		assert.False(t, history.CreatedAt.IsZero())
 	})
 
+	t.Run("rejects unregistered transaction type", func(t *testing.T) {
+		history := testutil.CreateTestBalanceHistory(testUser.DiscordID, entities.TransactionType("made_up"))
+
+		err := repo.Record(ctx, history)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown transaction type")
+	})
+
+	t.Run("rejects change against the transaction type's sign", func(t *testing.T) {
+		history := testutil.CreateTestBalanceHistoryWithAmounts(
+			testUser.DiscordID, 100000, 90000, -10000, entities.TransactionTypeBetWin)
+
+		err := repo.Record(ctx, history)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must not decrease")
+	})
+
 	t.Run("record with metadata", func(t *testing.T) {
 		history := testutil.CreateTestBalanceHistoryWithAmounts(
 			testUser.DiscordID, 100000, 150000, 50000, entities.TransactionTypeBetWin)
@@ -255,6 +273,58 @@ func TestBalanceHistoryRepository_GetByDateRange(t *testing.T) {
 	})
 }
 
+func TestBalanceHistoryRepository_StreamByDateRange(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+
+	repo := NewBalanceHistoryRepository(testDB.DB)
+	userRepo := NewUserRepository(testDB.DB)
+	ctx := context.Background()
+
+	user := testutil.CreateTestUser(123456, "testuser")
+	_, err := userRepo.Create(ctx, user.DiscordID, user.Username, user.Balance)
+	require.NoError(t, err)
+
+	first := testutil.CreateTestBalanceHistory(user.DiscordID, entities.TransactionTypeBetLoss)
+	require.NoError(t, repo.Record(ctx, first))
+	time.Sleep(10 * time.Millisecond)
+	second := testutil.CreateTestBalanceHistory(user.DiscordID, entities.TransactionTypeBetWin)
+	require.NoError(t, repo.Record(ctx, second))
+
+	now := time.Now()
+
+	t.Run("streams entries oldest first", func(t *testing.T) {
+		var ids []int64
+		err := repo.StreamByDateRange(ctx, now.Add(-time.Hour), now.Add(time.Hour), func(h *entities.BalanceHistory) error {
+			ids = append(ids, h.ID)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{first.ID, second.ID}, ids)
+	})
+
+	t.Run("stops on callback error", func(t *testing.T) {
+		calls := 0
+		stop := errors.New("stop")
+		err := repo.StreamByDateRange(ctx, now.Add(-time.Hour), now.Add(time.Hour), func(h *entities.BalanceHistory) error {
+			calls++
+			return stop
+		})
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("no entries in range", func(t *testing.T) {
+		calls := 0
+		err := repo.StreamByDateRange(ctx, now.Add(-72*time.Hour), now.Add(-48*time.Hour), func(h *entities.BalanceHistory) error {
+			calls++
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Zero(t, calls)
+	})
+}
+
 func TestBalanceHistoryRepository_GetTotalVolumeByUser(t *testing.T) {
 	t.Parallel()
 	testDB := testutil.SetupTestDatabase(t)
@@ -289,13 +359,17 @@ func TestBalanceHistoryRepository_GetTotalVolumeByUser(t *testing.T) {
 
 		balance := int64(10000)
 		for _, entry := range entries {
+			transactionType := entities.TransactionTypeBetWin
+			if entry.changeAmount < 0 {
+				transactionType = entities.TransactionTypeBetLoss
+			}
 			history := &entities.BalanceHistory{
 				DiscordID:           userID,
 				GuildID:             guildID,
 				BalanceBefore:       balance,
 				BalanceAfter:        balance + entry.changeAmount,
 				ChangeAmount:        entry.changeAmount,
-				TransactionType:     entities.TransactionTypeBetWin,
+				TransactionType:     transactionType,
 				TransactionMetadata: map[string]any{},
 			}
 			balance += entry.changeAmount
@@ -356,6 +430,30 @@ func TestBalanceHistoryRepository_GetTotalVolumeByUser(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, int64(2000), volume)
 	})
+
+	t.Run("includes archived entries", func(t *testing.T) {
+		userID := int64(300)
+		_, err := userRepo.Create(ctx, userID, "testuser300", 10000)
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Record(ctx, &entities.BalanceHistory{
+			DiscordID:           userID,
+			GuildID:             guildID,
+			BalanceBefore:       10000,
+			BalanceAfter:        13000,
+			ChangeAmount:        3000,
+			TransactionType:     entities.TransactionTypeBetWin,
+			TransactionMetadata: map[string]any{},
+		}))
+		rollupRepo := NewBalanceHistoryRollupRepositoryScoped(testDB.DB.Pool, guildID)
+		archived, err := rollupRepo.ArchiveEntriesBefore(ctx, time.Now().Add(time.Hour), 1000)
+		require.NoError(t, err)
+		require.Positive(t, archived)
+
+		volume, err := repo.GetTotalVolumeByUser(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3000), volume)
+	})
 }
 
 func TestBalanceHistoryRepository_GetTotalDonationsByUser(t *testing.T) {
@@ -414,3 +512,74 @@ func TestBalanceHistoryRepository_GetTotalDonationsByUser(t *testing.T) {
 		assert.Equal(t, int64(3000), donations)
 	})
 }
+
+func TestBalanceHistoryRepository_GetBiggestWinsAndLosses(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+
+	repo := NewBalanceHistoryRepository(testDB.DB)
+	userRepo := NewUserRepository(testDB.DB)
+	groupWagerRepo := NewGroupWagerRepository(testDB.DB)
+	ctx := context.Background()
+
+	userID := int64(400)
+	_, err := userRepo.Create(ctx, userID, "testuser400", 100000)
+	require.NoError(t, err)
+
+	// Create a group wager to hydrate the biggest win from
+	wager := testutil.CreateTestGroupWager(userID, "Will T1 win Worlds?")
+	option1 := testutil.CreateTestGroupWagerOption(0, "Yes", 0)
+	option2 := testutil.CreateTestGroupWagerOption(0, "No", 1)
+	err = groupWagerRepo.CreateWithOptions(ctx, wager, []*entities.GroupWagerOption{option1, option2})
+	require.NoError(t, err)
+
+	groupWagerType := entities.RelatedTypeGroupWager
+	entries := []struct {
+		changeAmount    int64
+		transactionType entities.TransactionType
+		relatedID       *int64
+		relatedType     *entities.RelatedType
+	}{
+		{changeAmount: 5000, transactionType: entities.TransactionTypeBetWin},
+		{changeAmount: 250000, transactionType: entities.TransactionTypeGroupWagerWin, relatedID: &wager.ID, relatedType: &groupWagerType},
+		{changeAmount: 100000, transactionType: entities.TransactionTypeTransferIn}, // Not a win
+		{changeAmount: -3000, transactionType: entities.TransactionTypeBetLoss},
+		{changeAmount: -8000, transactionType: entities.TransactionTypeBetLoss},
+		{changeAmount: -50000, transactionType: entities.TransactionTypeTransferOut}, // Not a loss
+	}
+
+	balance := int64(100000)
+	for _, entry := range entries {
+		history := &entities.BalanceHistory{
+			DiscordID:           userID,
+			BalanceBefore:       balance,
+			BalanceAfter:        balance + entry.changeAmount,
+			ChangeAmount:        entry.changeAmount,
+			TransactionType:     entry.transactionType,
+			TransactionMetadata: map[string]any{},
+			RelatedID:           entry.relatedID,
+			RelatedType:         entry.relatedType,
+		}
+		balance += entry.changeAmount
+		require.NoError(t, repo.Record(ctx, history))
+	}
+
+	t.Run("wins are ordered largest first with context", func(t *testing.T) {
+		wins, err := repo.GetBiggestWins(ctx, userID, 5)
+		require.NoError(t, err)
+		require.Len(t, wins, 2)
+
+		assert.Equal(t, int64(250000), wins[0].ChangeAmount)
+		require.NotNil(t, wins[0].GroupWagerCondition)
+		assert.Equal(t, "on 'Will T1 win Worlds?'", wins[0].ContextDescription())
+		assert.Equal(t, int64(5000), wins[1].ChangeAmount)
+		assert.Nil(t, wins[1].GroupWagerCondition)
+	})
+
+	t.Run("losses are ordered largest first", func(t *testing.T) {
+		losses, err := repo.GetBiggestLosses(ctx, userID, 1)
+		require.NoError(t, err)
+		require.Len(t, losses, 1)
+		assert.Equal(t, int64(-8000), losses[0].ChangeAmount)
+	})
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/database"
+	"gambler/discord-client/domain/entities"
+)
+
+// BalanceHistoryRollupRepository implements the BalanceHistoryRollupRepository interface
+type BalanceHistoryRollupRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewBalanceHistoryRollupRepositoryScoped creates a new balance history rollup repository with a transaction and guild scope
+func NewBalanceHistoryRollupRepositoryScoped(tx Queryable, guildID int64) *BalanceHistoryRollupRepository {
+	return &BalanceHistoryRollupRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// NewBalanceHistoryRollupRepositoryReadOnly creates a guild-scoped balance history rollup repository on the read replica
+func NewBalanceHistoryRollupRepositoryReadOnly(db *database.DB, guildID int64) *BalanceHistoryRollupRepository {
+	return NewBalanceHistoryRollupRepositoryScoped(db.ReadPool(), guildID)
+}
+
+// GetRolledUpThrough returns the exclusive end of the rolled up days, nil before the first rollup
+func (r *BalanceHistoryRollupRepository) GetRolledUpThrough(ctx context.Context) (*time.Time, error) {
+	var through *time.Time
+	if err := r.q.QueryRow(ctx, `SELECT rolled_up_through FROM balance_history_rollup_state`).Scan(&through); err != nil {
+		return nil, fmt.Errorf("failed to get balance history rollup state: %w", err)
+	}
+	return through, nil
+}
+
+// GetEarliestEntryTime returns when the oldest raw balance history entry was recorded, nil if there are none
+func (r *BalanceHistoryRollupRepository) GetEarliestEntryTime(ctx context.Context) (*time.Time, error) {
+	var earliest *time.Time
+	if err := r.q.QueryRow(ctx, `SELECT MIN(created_at) FROM balance_history`).Scan(&earliest); err != nil {
+		return nil, fmt.Errorf("failed to get earliest balance history entry: %w", err)
+	}
+	return earliest, nil
+}
+
+// RollupDays aggregates the raw entries of every guild from from up to to and advances the watermark to to.
+// Rows for days already rolled up are replaced, so a pass can safely be retried.
+func (r *BalanceHistoryRollupRepository) RollupDays(ctx context.Context, from, to time.Time) (int64, error) {
+	query := `
+		INSERT INTO balance_history_daily_rollups
+		(guild_id, discord_id, day, external_system, entries, wins, losses, net_profit,
+		 first_entry_at, first_entry_id, opening_balance, last_entry_at, last_entry_id, closing_balance)
+		SELECT bh.guild_id, bh.discord_id, (bh.created_at AT TIME ZONE 'UTC')::date, COALESCE(gw.external_system, ''),
+		       COUNT(*),
+		       COUNT(*) FILTER (WHERE bh.transaction_type = ANY($3)),
+		       COUNT(*) FILTER (WHERE bh.transaction_type = ANY($4)),
+		       COALESCE(SUM(bh.change_amount) FILTER (WHERE bh.transaction_type = ANY($3) OR bh.transaction_type = ANY($4)), 0),
+		       MIN(bh.created_at),
+		       (ARRAY_AGG(bh.id ORDER BY bh.created_at ASC, bh.id ASC))[1],
+		       (ARRAY_AGG(bh.balance_before ORDER BY bh.created_at ASC, bh.id ASC))[1],
+		       MAX(bh.created_at),
+		       (ARRAY_AGG(bh.id ORDER BY bh.created_at DESC, bh.id DESC))[1],
+		       (ARRAY_AGG(bh.balance_after ORDER BY bh.created_at DESC, bh.id DESC))[1]
+		FROM balance_history bh
+		LEFT JOIN group_wagers gw ON bh.related_type = 'group_wager' AND gw.id = bh.related_id
+		WHERE bh.created_at >= $1 AND bh.created_at < $2
+		GROUP BY 1, 2, 3, 4
+		ON CONFLICT (guild_id, discord_id, day, external_system) DO UPDATE SET
+			entries = EXCLUDED.entries,
+			wins = EXCLUDED.wins,
+			losses = EXCLUDED.losses,
+			net_profit = EXCLUDED.net_profit,
+			first_entry_at = EXCLUDED.first_entry_at,
+			first_entry_id = EXCLUDED.first_entry_id,
+			opening_balance = EXCLUDED.opening_balance,
+			last_entry_at = EXCLUDED.last_entry_at,
+			last_entry_id = EXCLUDED.last_entry_id,
+			closing_balance = EXCLUDED.closing_balance
+	`
+
+	result, err := r.q.Exec(ctx, query, from, to, dailyStatsWinTypes, dailyStatsLossTypes)
+	if err != nil {
+		return 0, fmt.Errorf("failed to roll up balance history from %s to %s: %w", from.Format(time.DateOnly), to.Format(time.DateOnly), err)
+	}
+
+	_, err = r.q.Exec(ctx, `
+		UPDATE balance_history_rollup_state
+		SET rolled_up_through = ($1::timestamptz AT TIME ZONE 'UTC')::date, updated_at = NOW()
+	`, to)
+	if err != nil {
+		return 0, fmt.Errorf("failed to advance balance history rollup state: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// GetDailyStats returns a user's rolled up stats by UTC day for days with entries in the range, oldest first.
+// Opening and closing balances come from the day's first and last entries across every system.
+func (r *BalanceHistoryRollupRepository) GetDailyStats(ctx context.Context, discordID int64, from, to time.Time, system *entities.ExternalSystem) ([]*entities.StatsTimeSeriesBucket, error) {
+	query := `
+		SELECT day,
+		       COALESCE(SUM(wins) FILTER (WHERE $5::text IS NULL OR external_system = $5::text), 0) AS wins,
+		       COALESCE(SUM(losses) FILTER (WHERE $5::text IS NULL OR external_system = $5::text), 0) AS losses,
+		       COALESCE(SUM(net_profit) FILTER (WHERE $5::text IS NULL OR external_system = $5::text), 0) AS net_profit,
+		       (ARRAY_AGG(opening_balance ORDER BY first_entry_at ASC, first_entry_id ASC))[1] AS opening_balance,
+		       (ARRAY_AGG(closing_balance ORDER BY last_entry_at DESC, last_entry_id DESC))[1] AS closing_balance
+		FROM balance_history_daily_rollups
+		WHERE discord_id = $1 AND guild_id = $2
+		  AND day >= ($3::timestamptz AT TIME ZONE 'UTC')::date AND day < ($4::timestamptz AT TIME ZONE 'UTC')::date
+		GROUP BY day
+		ORDER BY day ASC
+	`
+
+	rows, err := r.q.Query(ctx, query, discordID, r.guildID, from, to, externalSystemFilter(system))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rolled up daily stats for user %d: %w", discordID, err)
+	}
+	defer rows.Close()
+
+	return scanDailyStats(rows)
+}
+
+// ArchiveEntriesBefore moves up to limit of the oldest raw entries recorded before before into the archive
+func (r *BalanceHistoryRollupRepository) ArchiveEntriesBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	query := `
+		WITH moved AS (
+			DELETE FROM balance_history
+			WHERE id IN (
+				SELECT id FROM balance_history
+				WHERE created_at < $1
+				ORDER BY created_at ASC, id ASC
+				LIMIT $2
+			)
+			RETURNING id, discord_id, guild_id, balance_before, balance_after, change_amount,
+			          transaction_type, transaction_metadata, related_id, related_type,
+			          reversal_of_id, reversed_by_id, created_at
+		)
+		INSERT INTO balance_history_archive
+		(id, discord_id, guild_id, balance_before, balance_after, change_amount,
+		 transaction_type, transaction_metadata, related_id, related_type,
+		 reversal_of_id, reversed_by_id, created_at)
+		SELECT id, discord_id, guild_id, balance_before, balance_after, change_amount,
+		       transaction_type, transaction_metadata, related_id, related_type,
+		       reversal_of_id, reversed_by_id, created_at
+		FROM moved
+	`
+
+	result, err := r.q.Exec(ctx, query, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to archive balance history before %s: %w", before.Format(time.DateOnly), err)
+	}
+
+	return result.RowsAffected(), nil
+}
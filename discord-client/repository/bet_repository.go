@@ -34,6 +34,11 @@ func NewBetRepositoryScoped(tx Queryable, guildID int64) interfaces.BetRepositor
 	}
 }
 
+// NewBetRepositoryReadOnly creates a guild-scoped bet repository on the read replica for heavy read paths.
+func NewBetRepositoryReadOnly(db *database.DB, guildID int64) interfaces.BetRepository {
+	return NewBetRepositoryScoped(db.ReadPool(), guildID)
+}
+
 func (r *betRepository) Create(ctx context.Context, bet *entities.Bet) error {
 	query := `
 		INSERT INTO bets (discord_id, guild_id, amount, win_probability, won, win_amount, balance_history_id)
@@ -191,3 +196,50 @@ func (r *betRepository) GetByUserSince(ctx context.Context, discordID int64, sin
 
 	return bets, nil
 }
+
+// GetLeaderboardData aggregates bets per guild member in a single query. Users without an account
+// in the guild are excluded, and users with no bets never qualify.
+func (r *betRepository) GetLeaderboardData(ctx context.Context, minBets int) ([]*entities.GamblingLeaderboardEntry, error) {
+	query := `
+		SELECT
+			b.discord_id,
+			COUNT(*) as total_bets,
+			COUNT(CASE WHEN b.won = true THEN 1 END) as total_wins,
+			COALESCE(SUM(b.amount), 0) as total_wagered,
+			COALESCE(SUM(CASE WHEN b.won = true THEN b.win_amount ELSE 0 END), 0)
+				- COALESCE(SUM(CASE WHEN b.won = false THEN b.amount ELSE 0 END), 0) as net_profit
+		FROM bets b
+		JOIN user_guild_accounts uga ON uga.discord_id = b.discord_id AND uga.guild_id = b.guild_id
+		WHERE b.guild_id = $1
+		GROUP BY b.discord_id
+		HAVING COUNT(*) >= GREATEST($2::int, 1)
+		ORDER BY net_profit DESC, total_bets DESC, b.discord_id`
+
+	rows, err := r.q.Query(ctx, query, r.guildID, minBets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query gambling leaderboard: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*entities.GamblingLeaderboardEntry
+	for rows.Next() {
+		var entry entities.GamblingLeaderboardEntry
+		err := rows.Scan(
+			&entry.DiscordID,
+			&entry.TotalBets,
+			&entry.TotalWins,
+			&entry.TotalWagered,
+			&entry.NetProfit,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan gambling leaderboard entry: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate gambling leaderboard: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// guildPurgeTables lists every table with guild scoped rows, ordered so rows are deleted before the
+// rows they reference. Deleting group wagers also removes their options, participants, odds history,
+// events and parlay legs, and deleting lottery draws removes their tickets and winners.
+var guildPurgeTables = []string{
+	"discord_outbox", // Before group wagers so queued posts can't outlive them
+	"group_wagers",
+	"parlays",
+	"wager_votes",
+	"wagers",
+	"bets",
+	"duels",
+	"savings_deposits",
+	"lottery_draws",
+	"lottery_subscriptions",
+	"high_roller_purchases",
+	"wordle_completions",
+	"gambling_breaks",
+	"balance_freezes",
+	"experiment_exposures",
+	"season_token_history",
+	"season_token_balances",
+	"achievements",
+	"achievement_progress",
+	"user_stats", // After wagers, whose delete trigger refreshes the stats of both sides
+	"guild_summoner_watches",
+	"guild_resolvers",
+	"guild_feature_flags",
+	"guild_webhooks", // Also removes their deliveries
+	"guild_snapshots",
+	"balance_history_daily_rollups",
+	"balance_history_archive",
+	"balance_history", // After everything that references ledger entries
+	"user_guild_accounts",
+	"guild_settings",
+}
+
+// sharedHistoryColumns lists the user ID columns of history other users also take part in. These
+// are reassigned to the pseudonymous user rather than deleted, so other players' records stay intact.
+// A table's columns are updated together to keep checks such as a wager winner being one of its
+// two sides satisfied.
+var sharedHistoryColumns = []struct {
+	table   string
+	columns []string
+}{
+	{"balance_history", []string{"discord_id"}},
+	{"balance_history_archive", []string{"discord_id"}},
+	{"balance_history_daily_rollups", []string{"discord_id"}},
+	{"bets", []string{"discord_id"}},
+	{"wagers", []string{"proposer_discord_id", "target_discord_id", "winner_discord_id"}},
+	{"wager_votes", []string{"voter_discord_id", "vote_for_discord_id"}},
+	{"group_wagers", []string{"creator_discord_id", "resolver_discord_id", "subject_discord_id", "assigned_resolver_discord_id"}},
+	{"group_wager_participants", []string{"discord_id"}},
+	{"group_wager_odds_history", []string{"changed_by_discord_id"}},
+	{"group_wager_events", []string{"actor_discord_id"}},
+	{"group_wager_bet_changes", []string{"discord_id"}},
+	{"lottery_tickets", []string{"discord_id"}},
+	{"lottery_winners", []string{"discord_id"}},
+	{"high_roller_purchases", []string{"discord_id"}},
+	{"duels", []string{"challenger_discord_id", "target_discord_id", "winner_discord_id"}},
+	{"parlays", []string{"discord_id"}},
+	{"savings_deposits", []string{"discord_id"}},
+	{"balance_freezes", []string{"moderator_discord_id", "unfrozen_by_discord_id"}},
+}
+
+// userDataDeletes removes what is only about the user, once their shared history has been reassigned
+var userDataDeletes = []struct {
+	name  string
+	query string
+}{
+	{"group_wager_invites", `UPDATE group_wagers SET invited_discord_ids = array_remove(invited_discord_ids, $1) WHERE $1 = ANY(invited_discord_ids)`},
+	{"guild_resolvers", `DELETE FROM guild_resolvers WHERE resolver_type = 'user' AND target_id = $1`},
+	{"guild_snapshot_balances", `
+		UPDATE guild_snapshots
+		SET data = jsonb_set(data, '{balances}', COALESCE((
+			SELECT jsonb_agg(balance)
+			FROM jsonb_array_elements(data->'balances') balance
+			WHERE balance->>'discord_id' <> $1::bigint::text
+		), '[]'::jsonb))
+		WHERE data->'balances' @> jsonb_build_array(jsonb_build_object('discord_id', $1::bigint::text))`},
+	{"group_wager_followers", `DELETE FROM group_wager_followers WHERE discord_id = $1`},
+	{"user_preferences", `DELETE FROM user_preferences WHERE discord_id = $1`},
+	{"riot_account_links", `DELETE FROM riot_account_links WHERE discord_id = $1`},
+	{"gambling_breaks", `DELETE FROM gambling_breaks WHERE discord_id = $1`},
+	{"balance_freezes", `DELETE FROM balance_freezes WHERE discord_id = $1`},
+	{"lottery_subscriptions", `DELETE FROM lottery_subscriptions WHERE discord_id = $1`},
+	{"experiment_exposures", `DELETE FROM experiment_exposures WHERE discord_id = $1`},
+	{"season_token_history", `DELETE FROM season_token_history WHERE discord_id = $1`},
+	{"season_token_balances", `DELETE FROM season_token_balances WHERE discord_id = $1`},
+	{"wordle_completions", `DELETE FROM wordle_completions WHERE discord_id = $1`},
+	{"achievements", `DELETE FROM achievements WHERE discord_id = $1`},
+	{"achievement_progress", `DELETE FROM achievement_progress WHERE discord_id = $1`},
+	{"user_stats", `DELETE FROM user_stats WHERE discord_id = $1`},
+	{"user_guild_accounts", `DELETE FROM user_guild_accounts WHERE discord_id = $1`},
+	{"users", `DELETE FROM users WHERE discord_id = $1`},
+}
+
+// DataRetentionRepository implements the DataRetentionRepository interface
+type DataRetentionRepository struct {
+	q Queryable
+}
+
+// NewDataRetentionRepositoryWithTx creates a new data retention repository with a transaction.
+// It takes no guild scope because user data is deleted across every guild.
+func NewDataRetentionRepositoryWithTx(tx Queryable) interfaces.DataRetentionRepository {
+	return &DataRetentionRepository{q: tx}
+}
+
+// PurgeGuild deletes every row belonging to the guild
+func (r *DataRetentionRepository) PurgeGuild(ctx context.Context, guildID int64) (*entities.DataRetentionReport, error) {
+	report := entities.NewDataRetentionReport()
+	for _, table := range guildPurgeTables {
+		result, err := r.q.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE guild_id = $1", table), guildID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to purge %s for guild %d: %w", table, guildID, err)
+		}
+		if count := result.RowsAffected(); count > 0 {
+			report.Deleted[table] = count
+		}
+	}
+
+	return report, nil
+}
+
+// AnonymizeUser reassigns the user's shared history to a new pseudonymous user and deletes
+// everything else stored about them
+func (r *DataRetentionRepository) AnonymizeUser(ctx context.Context, discordID int64) (*entities.DataRetentionReport, error) {
+	var exists bool
+	if err := r.q.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM users WHERE discord_id = $1)`, discordID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to check user %d: %w", discordID, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	report := entities.NewDataRetentionReport()
+	if err := r.q.QueryRow(ctx, `SELECT -nextval('anonymized_user_seq')`).Scan(&report.AnonymizedID); err != nil {
+		return nil, fmt.Errorf("failed to allocate anonymized user ID: %w", err)
+	}
+
+	// The pseudonymous user gets an empty account in each of the user's guilds, since duels, parlays
+	// and savings deposits must belong to a guild account. Empty accounts stay off the scoreboard.
+	if _, err := r.q.Exec(ctx, `INSERT INTO users (discord_id, username) VALUES ($1, $2)`,
+		report.AnonymizedID, entities.AnonymizedUsername); err != nil {
+		return nil, fmt.Errorf("failed to create anonymized user: %w", err)
+	}
+	if _, err := r.q.Exec(ctx, `
+		INSERT INTO user_guild_accounts (discord_id, guild_id, balance)
+		SELECT $2, guild_id, 0 FROM user_guild_accounts WHERE discord_id = $1`,
+		discordID, report.AnonymizedID); err != nil {
+		return nil, fmt.Errorf("failed to create anonymized guild accounts: %w", err)
+	}
+
+	for _, shared := range sharedHistoryColumns {
+		assignments := make([]string, len(shared.columns))
+		matches := make([]string, len(shared.columns))
+		for i, column := range shared.columns {
+			assignments[i] = fmt.Sprintf("%[1]s = CASE WHEN %[1]s = $1 THEN $2 ELSE %[1]s END", column)
+			matches[i] = column + " = $1"
+		}
+		query := fmt.Sprintf("UPDATE %s SET %s WHERE %s",
+			shared.table, strings.Join(assignments, ", "), strings.Join(matches, " OR "))
+
+		result, err := r.q.Exec(ctx, query, discordID, report.AnonymizedID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to anonymize %s for user %d: %w", shared.table, discordID, err)
+		}
+		if count := result.RowsAffected(); count > 0 {
+			report.Anonymized[shared.table] = count
+		}
+	}
+
+	for _, del := range userDataDeletes {
+		result, err := r.q.Exec(ctx, del.query, discordID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete %s for user %d: %w", del.name, discordID, err)
+		}
+		if count := result.RowsAffected(); count > 0 {
+			report.Deleted[del.name] = count
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/repository/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedDataRetention creates two users in guildA, the first also in guildB, with ledger entries and a
+// group wager the first user created and both bet on
+func seedDataRetention(t *testing.T, testDB *testutil.TestDatabase, guildA, guildB, userID, otherID int64) int64 {
+	t.Helper()
+	ctx := context.Background()
+	pool := testDB.DB.Pool
+
+	for _, guildID := range []int64{guildA, guildB} {
+		_, err := NewUserRepositoryScoped(pool, guildID).Create(ctx, userID, "leaving", 100000)
+		require.NoError(t, err)
+		require.NoError(t, NewBalanceHistoryRepositoryScoped(pool, guildID).Record(ctx,
+			testutil.CreateTestBalanceHistory(userID, entities.TransactionTypeBetWin)))
+	}
+	_, err := NewUserRepositoryScoped(pool, guildA).Create(ctx, otherID, "staying", 100000)
+	require.NoError(t, err)
+
+	groupWagerRepo := NewGroupWagerRepositoryScoped(pool, guildA)
+	wager := testutil.CreateTestGroupWager(userID, "Retention wager")
+	options := []*entities.GroupWagerOption{
+		testutil.CreateTestGroupWagerOption(0, "Yes", 0),
+		testutil.CreateTestGroupWagerOption(0, "No", 1),
+	}
+	require.NoError(t, groupWagerRepo.CreateWithOptions(ctx, wager, options))
+	require.NoError(t, groupWagerRepo.SaveParticipant(ctx, testutil.CreateTestGroupWagerParticipant(wager.ID, userID, options[0].ID, 1000)))
+	require.NoError(t, groupWagerRepo.SaveParticipant(ctx, testutil.CreateTestGroupWagerParticipant(wager.ID, otherID, options[1].ID, 2000)))
+
+	require.NoError(t, NewGuildSnapshotRepositoryScoped(pool, guildA).Create(ctx, &entities.GuildSnapshot{
+		GuildID: guildA,
+		Data: entities.GuildSnapshotData{
+			Balances: []entities.GuildSnapshotBalance{{DiscordID: userID, Balance: 100000}, {DiscordID: otherID, Balance: 100000}},
+		},
+	}))
+
+	return wager.ID
+}
+
+func countRows(t *testing.T, testDB *testutil.TestDatabase, query string, args ...any) int64 {
+	t.Helper()
+	var count int64
+	require.NoError(t, testDB.DB.Pool.QueryRow(context.Background(), query, args...).Scan(&count))
+	return count
+}
+
+func TestDataRetentionRepository_AnonymizeUser(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	ctx := context.Background()
+	guildA, guildB := int64(1111), int64(2222)
+	userID, otherID := int64(5001), int64(5002)
+	wagerID := seedDataRetention(t, testDB, guildA, guildB, userID, otherID)
+
+	repo := NewDataRetentionRepositoryWithTx(testDB.DB.Pool)
+	report, err := repo.AnonymizeUser(ctx, userID)
+	require.NoError(t, err)
+	require.NotNil(t, report)
+
+	pseudoID := report.AnonymizedID
+	assert.Less(t, pseudoID, int64(0))
+	assert.Equal(t, int64(2), report.Anonymized["balance_history"])
+	assert.Equal(t, int64(1), report.Anonymized["group_wagers"])
+	assert.Equal(t, int64(2), report.Deleted["user_guild_accounts"])
+	assert.Equal(t, int64(1), report.Deleted["users"])
+
+	// Nothing refers to the user any more
+	assert.Zero(t, countRows(t, testDB, `SELECT COUNT(*) FROM users WHERE discord_id = $1`, userID))
+	assert.Zero(t, countRows(t, testDB, `SELECT COUNT(*) FROM balance_history WHERE discord_id = $1`, userID))
+	assert.Zero(t, countRows(t, testDB, `SELECT COUNT(*) FROM group_wager_participants WHERE discord_id = $1`, userID))
+
+	// Their shared history belongs to the pseudonymous user, whose accounts are empty
+	assert.Equal(t, int64(2), countRows(t, testDB, `SELECT COUNT(*) FROM balance_history WHERE discord_id = $1`, pseudoID))
+	assert.Equal(t, int64(1), countRows(t, testDB, `SELECT COUNT(*) FROM group_wagers WHERE id = $1 AND creator_discord_id = $2`, wagerID, pseudoID))
+	assert.Equal(t, int64(2), countRows(t, testDB, `SELECT COUNT(*) FROM user_guild_accounts WHERE discord_id = $1 AND balance = 0`, pseudoID))
+	var username string
+	require.NoError(t, testDB.DB.Pool.QueryRow(ctx, `SELECT username FROM users WHERE discord_id = $1`, pseudoID).Scan(&username))
+	assert.Equal(t, entities.AnonymizedUsername, username)
+
+	// Snapshots no longer hold their balance
+	assert.Equal(t, int64(1), report.Deleted["guild_snapshot_balances"])
+	assert.Zero(t, countRows(t, testDB, `SELECT COUNT(*) FROM guild_snapshots, jsonb_array_elements(data->'balances') b WHERE b->>'discord_id' = $1::bigint::text`, userID))
+
+	// Other users are untouched
+	assert.Equal(t, int64(1), countRows(t, testDB, `SELECT COUNT(*) FROM group_wager_participants WHERE discord_id = $1 AND amount = 2000`, otherID))
+	assert.Equal(t, int64(1), countRows(t, testDB, `SELECT COUNT(*) FROM guild_snapshots, jsonb_array_elements(data->'balances') b WHERE b->>'discord_id' = $1::bigint::text`, otherID))
+
+	// Unknown users are not an error
+	report, err = repo.AnonymizeUser(ctx, userID)
+	require.NoError(t, err)
+	assert.Nil(t, report)
+}
+
+func TestDataRetentionRepository_PurgeGuild(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	ctx := context.Background()
+	guildA, guildB := int64(1111), int64(2222)
+	userID, otherID := int64(5001), int64(5002)
+	seedDataRetention(t, testDB, guildA, guildB, userID, otherID)
+
+	report, err := NewDataRetentionRepositoryWithTx(testDB.DB.Pool).PurgeGuild(ctx, guildA)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1), report.Deleted["group_wagers"])
+	assert.Equal(t, int64(2), report.Deleted["user_guild_accounts"])
+	assert.Zero(t, countRows(t, testDB, `SELECT COUNT(*) FROM group_wager_participants`))
+	assert.Zero(t, countRows(t, testDB, `SELECT COUNT(*) FROM balance_history WHERE guild_id = $1`, guildA))
+
+	// The other guild and the users themselves remain
+	assert.Equal(t, int64(1), countRows(t, testDB, `SELECT COUNT(*) FROM balance_history WHERE guild_id = $1`, guildB))
+	assert.Equal(t, int64(1), countRows(t, testDB, `SELECT COUNT(*) FROM user_guild_accounts WHERE guild_id = $1`, guildB))
+	assert.Equal(t, int64(2), countRows(t, testDB, `SELECT COUNT(*) FROM users`))
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const discordOutboxColumns = `id, guild_id, action, kind, channel_id, message_id, group_wager_id, payload, dedup_key,
+		       status, attempts, last_error, next_attempt_at, created_at, sent_at`
+
+// DiscordOutboxRepository implements Discord outbox data access
+type DiscordOutboxRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewDiscordOutboxRepositoryScoped creates a new Discord outbox repository with guild scope
+func NewDiscordOutboxRepositoryScoped(tx Queryable, guildID int64) *DiscordOutboxRepository {
+	return &DiscordOutboxRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// Create queues a message in the current guild. A pending message with the same dedup key is
+// updated in place instead, taking the new payload, and its ID is set on the message.
+func (r *DiscordOutboxRepository) Create(ctx context.Context, message *entities.DiscordOutboxMessage) error {
+	if message.GuildID != r.guildID {
+		return fmt.Errorf("guild ID mismatch: outbox message has %d, repository scoped to %d", message.GuildID, r.guildID)
+	}
+
+	query := `
+		INSERT INTO discord_outbox (guild_id, action, kind, channel_id, message_id, group_wager_id, payload, dedup_key, status, next_attempt_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (guild_id, dedup_key) WHERE status = 'pending' AND dedup_key IS NOT NULL
+		DO UPDATE SET payload = EXCLUDED.payload,
+		              channel_id = EXCLUDED.channel_id,
+		              message_id = EXCLUDED.message_id
+		RETURNING id, created_at
+	`
+
+	err := r.q.QueryRow(ctx, query,
+		message.GuildID,
+		message.Action,
+		message.Kind,
+		message.ChannelID,
+		message.MessageID,
+		message.GroupWagerID,
+		message.Payload,
+		message.DedupKey,
+		message.Status,
+		message.NextAttemptAt,
+	).Scan(&message.ID, &message.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create discord outbox message: %w", err)
+	}
+
+	return nil
+}
+
+// GetByIDForUpdate retrieves a message in the current guild with row lock for update, returning nil if it does not exist
+func (r *DiscordOutboxRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.DiscordOutboxMessage, error) {
+	query := `
+		SELECT ` + discordOutboxColumns + `
+		FROM discord_outbox
+		WHERE id = $1 AND guild_id = $2
+		FOR UPDATE
+	`
+
+	message, err := scanDiscordOutboxMessage(r.q.QueryRow(ctx, query, id, r.guildID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get discord outbox message for update by ID %d: %w", id, err)
+	}
+
+	return message, nil
+}
+
+// Update updates the status, attempts and outcome of a message
+func (r *DiscordOutboxRepository) Update(ctx context.Context, message *entities.DiscordOutboxMessage) error {
+	query := `
+		UPDATE discord_outbox
+		SET status = $3,
+		    attempts = $4,
+		    last_error = $5,
+		    next_attempt_at = $6,
+		    sent_at = $7
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	result, err := r.q.Exec(ctx, query,
+		message.ID,
+		r.guildID,
+		message.Status,
+		message.Attempts,
+		message.LastError,
+		message.NextAttemptAt,
+		message.SentAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update discord outbox message %d: %w", message.ID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("discord outbox message %d not found", message.ID)
+	}
+
+	return nil
+}
+
+// GetDueMessages returns pending messages across all guilds due at or before the given time, oldest first
+func (r *DiscordOutboxRepository) GetDueMessages(ctx context.Context, asOf time.Time, limit int) ([]*entities.DiscordOutboxMessage, error) {
+	query := `
+		SELECT ` + discordOutboxColumns + `
+		FROM discord_outbox
+		WHERE status = 'pending' AND next_attempt_at <= $1
+		ORDER BY next_attempt_at ASC, id ASC
+		LIMIT $2
+	`
+
+	rows, err := r.q.Query(ctx, query, asOf, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due discord outbox messages: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []*entities.DiscordOutboxMessage
+	for rows.Next() {
+		message, err := scanDiscordOutboxMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan discord outbox message: %w", err)
+		}
+		messages = append(messages, message)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating discord outbox rows: %w", err)
+	}
+
+	return messages, nil
+}
+
+// scanDiscordOutboxMessage scans a single outbox row
+func scanDiscordOutboxMessage(row pgx.Row) (*entities.DiscordOutboxMessage, error) {
+	var message entities.DiscordOutboxMessage
+	err := row.Scan(
+		&message.ID,
+		&message.GuildID,
+		&message.Action,
+		&message.Kind,
+		&message.ChannelID,
+		&message.MessageID,
+		&message.GroupWagerID,
+		&message.Payload,
+		&message.DedupKey,
+		&message.Status,
+		&message.Attempts,
+		&message.LastError,
+		&message.NextAttemptAt,
+		&message.CreatedAt,
+		&message.SentAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
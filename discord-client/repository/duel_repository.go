@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// DuelRepository implements duel data access
+type DuelRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewDuelRepositoryScoped creates a new duel repository with guild scope
+func NewDuelRepositoryScoped(tx Queryable, guildID int64) *DuelRepository {
+	return &DuelRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// Create creates a new duel challenge in the current guild
+func (r *DuelRepository) Create(ctx context.Context, duel *entities.Duel) error {
+	if duel.GuildID != r.guildID {
+		return fmt.Errorf("guild ID mismatch: duel has %d, repository scoped to %d", duel.GuildID, r.guildID)
+	}
+
+	query := `
+		INSERT INTO duels (guild_id, challenger_discord_id, target_discord_id, amount, best_of, status, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	err := r.q.QueryRow(ctx, query,
+		duel.GuildID,
+		duel.ChallengerDiscordID,
+		duel.TargetDiscordID,
+		duel.Amount,
+		duel.BestOf,
+		duel.Status,
+		duel.CreatedAt,
+		duel.ExpiresAt,
+	).Scan(&duel.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create duel: %w", err)
+	}
+
+	return nil
+}
+
+// GetByIDForUpdate retrieves a duel in the current guild by ID with a row lock for update
+func (r *DuelRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.Duel, error) {
+	query := `
+		SELECT id, guild_id, challenger_discord_id, target_discord_id, amount, best_of, status,
+		       flips, winner_discord_id, created_at, expires_at, resolved_at
+		FROM duels
+		WHERE id = $1 AND guild_id = $2
+		FOR UPDATE
+	`
+
+	var duel entities.Duel
+	err := r.q.QueryRow(ctx, query, id, r.guildID).Scan(
+		&duel.ID,
+		&duel.GuildID,
+		&duel.ChallengerDiscordID,
+		&duel.TargetDiscordID,
+		&duel.Amount,
+		&duel.BestOf,
+		&duel.Status,
+		&duel.Flips,
+		&duel.WinnerDiscordID,
+		&duel.CreatedAt,
+		&duel.ExpiresAt,
+		&duel.ResolvedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get duel for update by ID %d: %w", id, err)
+	}
+
+	return &duel, nil
+}
+
+// Update updates the status and outcome of a duel
+func (r *DuelRepository) Update(ctx context.Context, duel *entities.Duel) error {
+	query := `
+		UPDATE duels
+		SET status = $3,
+		    flips = $4,
+		    winner_discord_id = $5,
+		    resolved_at = $6
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	flips := duel.Flips
+	if flips == nil {
+		flips = []bool{}
+	}
+
+	result, err := r.q.Exec(ctx, query,
+		duel.ID,
+		r.guildID,
+		duel.Status,
+		flips,
+		duel.WinnerDiscordID,
+		duel.ResolvedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update duel %d: %w", duel.ID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("duel %d not found", duel.ID)
+	}
+
+	return nil
+}
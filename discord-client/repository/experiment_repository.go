@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/database"
+	"gambler/discord-client/domain/entities"
+	"github.com/jackc/pgx/v5"
+)
+
+// ExperimentRepository implements the ExperimentRepository interface
+type ExperimentRepository struct {
+	q Queryable
+}
+
+// NewExperimentRepository creates a new experiment repository
+func NewExperimentRepository(db *database.DB) *ExperimentRepository {
+	return &ExperimentRepository{q: db.Pool}
+}
+
+// NewExperimentRepositoryWithTx creates a new experiment repository with a transaction
+func NewExperimentRepositoryWithTx(tx Queryable) *ExperimentRepository {
+	return &ExperimentRepository{q: tx}
+}
+
+// GetByKey retrieves an experiment by its key
+func (r *ExperimentRepository) GetByKey(ctx context.Context, key string) (*entities.Experiment, error) {
+	query := `
+		SELECT key, description, unit, rollout_percent, enabled, created_at, updated_at
+		FROM experiments
+		WHERE key = $1
+	`
+
+	var experiment entities.Experiment
+	err := r.q.QueryRow(ctx, query, key).Scan(
+		&experiment.Key,
+		&experiment.Description,
+		&experiment.Unit,
+		&experiment.RolloutPercent,
+		&experiment.Enabled,
+		&experiment.CreatedAt,
+		&experiment.UpdatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get experiment %s: %w", key, err)
+	}
+
+	return &experiment, nil
+}
+
+// UpdateRollout sets the rollout percentage and enabled flag of an experiment
+func (r *ExperimentRepository) UpdateRollout(ctx context.Context, key string, rolloutPercent int, enabled bool) error {
+	query := `
+		UPDATE experiments
+		SET rollout_percent = $2, enabled = $3, updated_at = NOW()
+		WHERE key = $1
+	`
+
+	result, err := r.q.Exec(ctx, query, key, rolloutPercent, enabled)
+	if err != nil {
+		return fmt.Errorf("failed to update experiment %s: %w", key, err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("experiment %s not found", key)
+	}
+
+	return nil
+}
+
+// RecordExposure records a user's first exposure to an experiment; later exposures are ignored
+func (r *ExperimentRepository) RecordExposure(ctx context.Context, exposure *entities.ExperimentExposure) error {
+	query := `
+		INSERT INTO experiment_exposures (experiment_key, guild_id, discord_id, variant)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (experiment_key, guild_id, discord_id) DO NOTHING
+	`
+
+	_, err := r.q.Exec(ctx, query, exposure.ExperimentKey, exposure.GuildID, exposure.DiscordID, exposure.Variant)
+	if err != nil {
+		return fmt.Errorf("failed to record exposure to experiment %s: %w", exposure.ExperimentKey, err)
+	}
+
+	return nil
+}
+
+// GetReport compares balance activity after first exposure between the variants of an experiment
+func (r *ExperimentRepository) GetReport(ctx context.Context, key string) ([]*entities.ExperimentVariantReport, error) {
+	query := `
+		SELECT
+			ee.variant,
+			COUNT(DISTINCT ee.id) as exposed_users,
+			COUNT(DISTINCT ee.id) FILTER (WHERE bh.id IS NOT NULL) as active_users,
+			COUNT(bh.id) as transactions,
+			COALESCE(SUM(ABS(bh.change_amount)), 0) as volume
+		FROM experiment_exposures ee
+		LEFT JOIN balance_history bh
+			ON bh.discord_id = ee.discord_id
+			AND bh.guild_id = ee.guild_id
+			AND bh.created_at >= ee.first_exposed_at
+		WHERE ee.experiment_key = $1
+		GROUP BY ee.variant
+		ORDER BY ee.variant
+	`
+
+	rows, err := r.q.Query(ctx, query, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query report for experiment %s: %w", key, err)
+	}
+	defer rows.Close()
+
+	var reports []*entities.ExperimentVariantReport
+	for rows.Next() {
+		var report entities.ExperimentVariantReport
+		if err := rows.Scan(
+			&report.Variant,
+			&report.ExposedUsers,
+			&report.ActiveUsers,
+			&report.Transactions,
+			&report.Volume,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan experiment report: %w", err)
+		}
+		reports = append(reports, &report)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating experiment report: %w", err)
+	}
+
+	return reports, nil
+}
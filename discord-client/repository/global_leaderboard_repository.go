@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/database"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+)
+
+// GlobalLeaderboardRepository aggregates group wager predictions across every guild that shares its
+// stats. It is deliberately not guild-scoped; guilds and users that haven't opted in are filtered out.
+type GlobalLeaderboardRepository struct {
+	q Queryable
+}
+
+// NewGlobalLeaderboardRepositoryReadOnly creates a cross-guild leaderboard repository on the read replica.
+func NewGlobalLeaderboardRepositoryReadOnly(db *database.DB) interfaces.GlobalLeaderboardRepository {
+	return &GlobalLeaderboardRepository{q: db.ReadPool()}
+}
+
+// GetPredictionEntries returns unranked prediction totals for every player with at least minPredictions
+// resolved predictions in sharing guilds, flagging the players who are members of viewerGuildID
+func (r *GlobalLeaderboardRepository) GetPredictionEntries(ctx context.Context, viewerGuildID int64, minPredictions int) ([]*entities.GlobalLeaderboardEntry, error) {
+	query := `
+		SELECT
+			gwp.discord_id,
+			COUNT(DISTINCT gw.guild_id) AS guild_count,
+			EXISTS (
+				SELECT 1 FROM user_guild_accounts uga
+				WHERE uga.discord_id = gwp.discord_id AND uga.guild_id = $1
+			) AS in_viewer_guild,
+			COUNT(*) FILTER (WHERE gwp.option_id = gw.winning_option_id) AS correct_predictions,
+			COUNT(*) AS total_predictions,
+			COALESCE(SUM(gwp.amount), 0) AS total_wagered,
+			COALESCE(SUM(gwp.payout_amount - gwp.amount) FILTER (WHERE gwp.payout_amount IS NOT NULL), 0) AS net_profit
+		FROM group_wager_participants gwp
+		JOIN group_wagers gw ON gw.id = gwp.group_wager_id
+		JOIN guild_settings gs ON gs.guild_id = gw.guild_id AND gs.share_global_leaderboard
+		LEFT JOIN user_preferences up ON up.discord_id = gwp.discord_id
+		WHERE gw.state = 'resolved'
+		AND gw.winning_option_id IS NOT NULL
+		AND NOT COALESCE(up.hide_from_global_leaderboard, FALSE)
+		GROUP BY gwp.discord_id
+		HAVING COUNT(*) >= $2
+	`
+
+	rows, err := r.q.Query(ctx, query, viewerGuildID, minPredictions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query global leaderboard: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*entities.GlobalLeaderboardEntry
+	for rows.Next() {
+		var entry entities.GlobalLeaderboardEntry
+		if err := rows.Scan(
+			&entry.DiscordID,
+			&entry.GuildCount,
+			&entry.InViewerGuild,
+			&entry.CorrectPredictions,
+			&entry.TotalPredictions,
+			&entry.TotalWagered,
+			&entry.NetProfit,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan global leaderboard entry: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating global leaderboard: %w", err)
+	}
+
+	return entries, nil
+}
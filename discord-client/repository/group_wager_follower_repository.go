@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+// GroupWagerFollowerRepository implements group wager follower data access
+type GroupWagerFollowerRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewGroupWagerFollowerRepositoryScoped creates a new group wager follower repository with guild scope
+func NewGroupWagerFollowerRepositoryScoped(tx Queryable, guildID int64) *GroupWagerFollowerRepository {
+	return &GroupWagerFollowerRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// Follow adds the user as a follower of the wager. Returns false if they were already following it.
+func (r *GroupWagerFollowerRepository) Follow(ctx context.Context, groupWagerID, discordID int64) (bool, error) {
+	query := `
+		INSERT INTO group_wager_followers (group_wager_id, guild_id, discord_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (group_wager_id, discord_id) DO NOTHING
+	`
+
+	result, err := r.q.Exec(ctx, query, groupWagerID, r.guildID, discordID)
+	if err != nil {
+		return false, fmt.Errorf("failed to follow group wager: %w", err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// Unfollow removes the user as a follower of the wager. Returns false if they weren't following it.
+func (r *GroupWagerFollowerRepository) Unfollow(ctx context.Context, groupWagerID, discordID int64) (bool, error) {
+	query := `
+		DELETE FROM group_wager_followers
+		WHERE group_wager_id = $1 AND guild_id = $2 AND discord_id = $3
+	`
+
+	result, err := r.q.Exec(ctx, query, groupWagerID, r.guildID, discordID)
+	if err != nil {
+		return false, fmt.Errorf("failed to unfollow group wager: %w", err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// GetFollowers returns the Discord IDs following the wager, in the order they followed it
+func (r *GroupWagerFollowerRepository) GetFollowers(ctx context.Context, groupWagerID int64) ([]int64, error) {
+	query := `
+		SELECT discord_id
+		FROM group_wager_followers
+		WHERE group_wager_id = $1 AND guild_id = $2
+		ORDER BY created_at, discord_id
+	`
+
+	rows, err := r.q.Query(ctx, query, groupWagerID, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group wager followers: %w", err)
+	}
+	defer rows.Close()
+
+	var followers []int64
+	for rows.Next() {
+		var discordID int64
+		if err := rows.Scan(&discordID); err != nil {
+			return nil, fmt.Errorf("failed to scan group wager follower: %w", err)
+		}
+		followers = append(followers, discordID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating group wager follower rows: %w", err)
+	}
+
+	return followers, nil
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"gambler/discord-client/database"
 	"gambler/discord-client/domain/entities"
@@ -30,6 +32,11 @@ func NewGroupWagerRepositoryScoped(tx Queryable, guildID int64) interfaces.Group
 	}
 }
 
+// NewGroupWagerRepositoryReadOnly creates a guild-scoped group wager repository on the read replica for heavy read paths.
+func NewGroupWagerRepositoryReadOnly(db *database.DB, guildID int64) interfaces.GroupWagerRepository {
+	return NewGroupWagerRepositoryScoped(db.ReadPool(), guildID)
+}
+
 // CreateWithOptions creates a new group wager with its options atomically
 func (r *GroupWagerRepository) CreateWithOptions(ctx context.Context, wager *entities.GroupWager, options []*entities.GroupWagerOption) error {
 	// Create the group wager
@@ -37,9 +44,10 @@ func (r *GroupWagerRepository) CreateWithOptions(ctx context.Context, wager *ent
 		INSERT INTO group_wagers (
 			creator_discord_id, guild_id, condition, state, wager_type, total_pot, 
 			min_participants, message_id, channel_id, voting_period_minutes,
-			voting_starts_at, voting_ends_at, external_id, external_system
+			voting_starts_at, voting_ends_at, external_id, external_system,
+			max_participants, allowed_role_id, invited_discord_ids
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 		RETURNING id, created_at
 	`
 
@@ -58,6 +66,9 @@ func (r *GroupWagerRepository) CreateWithOptions(ctx context.Context, wager *ent
 		wager.VotingEndsAt,
 		wager.GetExternalID(),
 		wager.GetExternalSystem(),
+		wager.MaxParticipants,
+		wager.AllowedRoleID,
+		invitedDiscordIDs(wager.InvitedDiscordIDs),
 	).Scan(&wager.ID, &wager.CreatedAt)
 
 	if err != nil {
@@ -68,7 +79,8 @@ func (r *GroupWagerRepository) CreateWithOptions(ctx context.Context, wager *ent
 	if len(options) > 0 {
 		optionQuery := `
 			INSERT INTO group_wager_options (
-				group_wager_id, option_text, option_order, total_amount, odds_multiplier
+				group_wager_id, option_text, option_order, total_amount, odds_multiplier, max_total_amount,
+				button_emoji, button_style
 			)
 			VALUES
 		`
@@ -78,9 +90,9 @@ func (r *GroupWagerRepository) CreateWithOptions(ctx context.Context, wager *ent
 			if i > 0 {
 				optionQuery += ","
 			}
-			paramIndex := i * 5
-			optionQuery += fmt.Sprintf(" ($%d, $%d, $%d, $%d, $%d)",
-				paramIndex+1, paramIndex+2, paramIndex+3, paramIndex+4, paramIndex+5)
+			paramIndex := i * 8
+			optionQuery += fmt.Sprintf(" ($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+				paramIndex+1, paramIndex+2, paramIndex+3, paramIndex+4, paramIndex+5, paramIndex+6, paramIndex+7, paramIndex+8)
 
 			args = append(args,
 				wager.ID, // Use the newly created wager ID
@@ -88,6 +100,9 @@ func (r *GroupWagerRepository) CreateWithOptions(ctx context.Context, wager *ent
 				option.OptionOrder,
 				option.TotalAmount,
 				option.OddsMultiplier,
+				option.MaxTotalAmount,
+				option.ButtonEmoji,
+				option.ButtonStyle,
 			)
 		}
 
@@ -119,8 +134,30 @@ func (r *GroupWagerRepository) CreateWithOptions(ctx context.Context, wager *ent
 
 // GetDetailByID retrieves a group wager with all its options and participants
 func (r *GroupWagerRepository) GetDetailByID(ctx context.Context, id int64) (*entities.GroupWagerDetail, error) {
+	return r.getDetailByID(ctx, id, false)
+}
+
+// GetDetailByIDForUpdate retrieves a group wager with all its options and participants, locking the
+// wager and option rows until the transaction ends
+func (r *GroupWagerRepository) GetDetailByIDForUpdate(ctx context.Context, id int64) (*entities.GroupWagerDetail, error) {
+	return r.getDetailByID(ctx, id, true)
+}
+
+// getDetailByID retrieves a group wager detail, optionally locking the wager and its options.
+// The wager, options and participants are queued as one batch so the detail costs a single round trip.
+// Batched statements still run in order, so the wager row is always locked before its options and
+// concurrent callers cannot deadlock.
+func (r *GroupWagerRepository) getDetailByID(ctx context.Context, id int64, forUpdate bool) (*entities.GroupWagerDetail, error) {
+	batch := &pgx.Batch{}
+	batch.Queue(groupWagerByIDQuery(forUpdate), id)
+	batch.Queue(groupWagerOptionsQuery(forUpdate), id)
+	batch.Queue(groupWagerParticipantsQuery, id)
+
+	results := r.q.SendBatch(ctx, batch)
+	defer results.Close()
+
 	// Get the wager
-	wager, err := r.GetByID(ctx, id)
+	wager, err := scanGroupWager(results.QueryRow())
 	if err != nil {
 		return nil, err
 	}
@@ -129,17 +166,29 @@ func (r *GroupWagerRepository) GetDetailByID(ctx context.Context, id int64) (*en
 	}
 
 	// Get options
-	options, err := r.getOptionsByGroupWager(ctx, id)
+	rows, err := results.Query()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query group wager options: %w", err)
+	}
+	options, err := scanGroupWagerOptions(rows)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get options: %w", err)
 	}
 
 	// Get participants
-	participants, err := r.getParticipantsByGroupWager(ctx, id)
+	rows, err = results.Query()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query group wager participants: %w", err)
+	}
+	participants, err := scanGroupWagerParticipants(rows)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get participants: %w", err)
 	}
 
+	if err := results.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close group wager detail batch: %w", err)
+	}
+
 	return &entities.GroupWagerDetail{
 		Wager:        wager,
 		Options:      options,
@@ -160,59 +209,36 @@ func (r *GroupWagerRepository) GetDetailByMessageID(ctx context.Context, message
 	return r.GetDetailByID(ctx, wager.ID)
 }
 
-// GetByID retrieves a group wager by its ID
-func (r *GroupWagerRepository) GetByID(ctx context.Context, id int64) (*entities.GroupWager, error) {
+// GetDetailByReactionMessageID retrieves the group wager taking bets from reactions on a Discord message
+func (r *GroupWagerRepository) GetDetailByReactionMessageID(ctx context.Context, messageID int64) (*entities.GroupWagerDetail, error) {
 	query := `
-		SELECT 
-			id, creator_discord_id, guild_id, condition, state, wager_type, resolver_discord_id,
-			winning_option_id, total_pot, min_participants, message_id, 
-			channel_id, voting_period_minutes, voting_starts_at, voting_ends_at,
-			created_at, resolved_at, external_id, external_system
+		SELECT id
 		FROM group_wagers
-		WHERE id = $1
+		WHERE reaction_message_id = $1 AND guild_id = $2
+		ORDER BY created_at DESC
+		LIMIT 1
 	`
 
-	var wager entities.GroupWager
-	var externalID, externalSystem *string
-
-	err := r.q.QueryRow(ctx, query, id).Scan(
-		&wager.ID,
-		&wager.CreatorDiscordID,
-		&wager.GuildID,
-		&wager.Condition,
-		&wager.State,
-		&wager.WagerType,
-		&wager.ResolverDiscordID,
-		&wager.WinningOptionID,
-		&wager.TotalPot,
-		&wager.MinParticipants,
-		&wager.MessageID,
-		&wager.ChannelID,
-		&wager.VotingPeriodMinutes,
-		&wager.VotingStartsAt,
-		&wager.VotingEndsAt,
-		&wager.CreatedAt,
-		&wager.ResolvedAt,
-		&externalID,
-		&externalSystem,
-	)
-
+	var id int64
+	err := r.q.QueryRow(ctx, query, messageID, r.guildID).Scan(&id)
 	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get group wager: %w", err)
+		return nil, fmt.Errorf("failed to get group wager by reaction message: %w", err)
 	}
 
-	// Set the external reference if both fields are present
-	if externalID != nil && externalSystem != nil {
-		wager.ExternalRef = &entities.ExternalReference{
-			System: entities.ExternalSystem(*externalSystem),
-			ID:     *externalID,
-		}
-	}
+	return r.GetDetailByID(ctx, id)
+}
 
-	return &wager, nil
+// GetByID retrieves a group wager by its ID
+func (r *GroupWagerRepository) GetByID(ctx context.Context, id int64) (*entities.GroupWager, error) {
+	return r.getByID(ctx, id, false)
+}
+
+// getByID retrieves a group wager by its ID, optionally locking the row until the transaction ends
+func (r *GroupWagerRepository) getByID(ctx context.Context, id int64, forUpdate bool) (*entities.GroupWager, error) {
+	return scanGroupWager(r.q.QueryRow(ctx, groupWagerByIDQuery(forUpdate), id))
 }
 
 // GetByMessageID retrieves a group wager by its Discord message ID
@@ -221,8 +247,8 @@ func (r *GroupWagerRepository) GetByMessageID(ctx context.Context, messageID int
 		SELECT 
 			id, creator_discord_id, guild_id, condition, state, wager_type, resolver_discord_id,
 			winning_option_id, total_pot, min_participants, message_id, 
-			channel_id, voting_period_minutes, voting_starts_at, voting_ends_at,
-			created_at, resolved_at, external_id, external_system
+			channel_id, thread_id, subject_discord_id, thumbnail_url, image_url, voting_period_minutes, voting_starts_at, voting_ends_at,
+			created_at, resolved_at, cancelled_at, external_id, external_system
 		FROM group_wagers
 		WHERE message_id = $1
 	`
@@ -243,11 +269,16 @@ func (r *GroupWagerRepository) GetByMessageID(ctx context.Context, messageID int
 		&wager.MinParticipants,
 		&wager.MessageID,
 		&wager.ChannelID,
+		&wager.ThreadID,
+		&wager.SubjectDiscordID,
+		&wager.ThumbnailURL,
+		&wager.ImageURL,
 		&wager.VotingPeriodMinutes,
 		&wager.VotingStartsAt,
 		&wager.VotingEndsAt,
 		&wager.CreatedAt,
 		&wager.ResolvedAt,
+		&wager.CancelledAt,
 		&externalID,
 		&externalSystem,
 	)
@@ -276,8 +307,8 @@ func (r *GroupWagerRepository) GetByExternalReference(ctx context.Context, ref e
 		SELECT 
 			id, creator_discord_id, guild_id, condition, state, wager_type, resolver_discord_id,
 			winning_option_id, total_pot, min_participants, message_id, 
-			channel_id, voting_period_minutes, voting_starts_at, voting_ends_at,
-			created_at, resolved_at, external_id, external_system
+			channel_id, thread_id, subject_discord_id, thumbnail_url, image_url, voting_period_minutes, voting_starts_at, voting_ends_at,
+			created_at, resolved_at, cancelled_at, external_id, external_system
 		FROM group_wagers
 		WHERE external_id = $1 AND external_system = $2 AND guild_id = $3
 	`
@@ -298,11 +329,16 @@ func (r *GroupWagerRepository) GetByExternalReference(ctx context.Context, ref e
 		&wager.MinParticipants,
 		&wager.MessageID,
 		&wager.ChannelID,
+		&wager.ThreadID,
+		&wager.SubjectDiscordID,
+		&wager.ThumbnailURL,
+		&wager.ImageURL,
 		&wager.VotingPeriodMinutes,
 		&wager.VotingStartsAt,
 		&wager.VotingEndsAt,
 		&wager.CreatedAt,
 		&wager.ResolvedAt,
+		&wager.CancelledAt,
 		&externalID,
 		&externalSystem,
 	)
@@ -332,7 +368,8 @@ func (r *GroupWagerRepository) Update(ctx context.Context, wager *entities.Group
 		SET state = $2, resolver_discord_id = $3, winning_option_id = $4,
 		    total_pot = $5, resolved_at = $6, message_id = $7, channel_id = $8,
 		    voting_period_minutes = $9, voting_starts_at = $10, voting_ends_at = $11,
-		    external_id = $12, external_system = $13
+		    external_id = $12, external_system = $13, thread_id = $14, cancelled_at = $15,
+		    subject_discord_id = $16, thumbnail_url = $17, image_url = $18
 		WHERE id = $1
 	`
 
@@ -350,6 +387,11 @@ func (r *GroupWagerRepository) Update(ctx context.Context, wager *entities.Group
 		wager.VotingEndsAt,
 		wager.GetExternalID(),
 		wager.GetExternalSystem(),
+		wager.ThreadID,
+		wager.CancelledAt,
+		wager.SubjectDiscordID,
+		wager.ThumbnailURL,
+		wager.ImageURL,
 	)
 
 	if err != nil {
@@ -369,7 +411,7 @@ func (r *GroupWagerRepository) GetActiveByUser(ctx context.Context, discordID in
 		SELECT DISTINCT
 			gw.id, gw.creator_discord_id, gw.guild_id, gw.condition, gw.state, gw.wager_type, gw.resolver_discord_id,
 			gw.winning_option_id, gw.total_pot, gw.min_participants, gw.message_id, 
-			gw.channel_id, gw.voting_period_minutes, gw.voting_starts_at, gw.voting_ends_at,
+			gw.channel_id, gw.thread_id, gw.subject_discord_id, gw.thumbnail_url, gw.image_url, gw.voting_period_minutes, gw.voting_starts_at, gw.voting_ends_at,
 			gw.created_at, gw.resolved_at
 		FROM group_wagers gw
 		JOIN group_wager_participants gwp ON gwp.group_wager_id = gw.id
@@ -399,6 +441,10 @@ func (r *GroupWagerRepository) GetActiveByUser(ctx context.Context, discordID in
 			&wager.MinParticipants,
 			&wager.MessageID,
 			&wager.ChannelID,
+			&wager.ThreadID,
+			&wager.SubjectDiscordID,
+			&wager.ThumbnailURL,
+			&wager.ImageURL,
 			&wager.VotingPeriodMinutes,
 			&wager.VotingStartsAt,
 			&wager.VotingEndsAt,
@@ -424,7 +470,7 @@ func (r *GroupWagerRepository) GetAll(ctx context.Context, state *entities.Group
 			SELECT 
 				id, creator_discord_id, guild_id, condition, state, wager_type, resolver_discord_id,
 				winning_option_id, total_pot, min_participants, message_id, 
-				channel_id, voting_period_minutes, voting_starts_at, voting_ends_at,
+				channel_id, thread_id, subject_discord_id, thumbnail_url, image_url, voting_period_minutes, voting_starts_at, voting_ends_at,
 				created_at, resolved_at
 			FROM group_wagers
 			WHERE state = $1 AND guild_id = $2
@@ -436,7 +482,7 @@ func (r *GroupWagerRepository) GetAll(ctx context.Context, state *entities.Group
 			SELECT 
 				id, creator_discord_id, guild_id, condition, state, wager_type, resolver_discord_id,
 				winning_option_id, total_pot, min_participants, message_id, 
-				channel_id, voting_period_minutes, voting_starts_at, voting_ends_at,
+				channel_id, thread_id, subject_discord_id, thumbnail_url, image_url, voting_period_minutes, voting_starts_at, voting_ends_at,
 				created_at, resolved_at
 			FROM group_wagers
 			WHERE guild_id = $1
@@ -467,6 +513,10 @@ func (r *GroupWagerRepository) GetAll(ctx context.Context, state *entities.Group
 			&wager.MinParticipants,
 			&wager.MessageID,
 			&wager.ChannelID,
+			&wager.ThreadID,
+			&wager.SubjectDiscordID,
+			&wager.ThumbnailURL,
+			&wager.ImageURL,
 			&wager.VotingPeriodMinutes,
 			&wager.VotingStartsAt,
 			&wager.VotingEndsAt,
@@ -490,7 +540,7 @@ func (r *GroupWagerRepository) SaveParticipant(ctx context.Context, participant
 		// Update existing participant
 		query := `
 			UPDATE group_wager_participants
-			SET option_id = $2, amount = $3, updated_at = CURRENT_TIMESTAMP
+			SET option_id = $2, amount = $3, locked_multiplier = $4, updated_at = CURRENT_TIMESTAMP
 			WHERE id = $1
 			RETURNING updated_at
 		`
@@ -499,6 +549,7 @@ func (r *GroupWagerRepository) SaveParticipant(ctx context.Context, participant
 			participant.ID,
 			participant.OptionID,
 			participant.Amount,
+			participant.LockedMultiplier,
 		).Scan(&participant.UpdatedAt)
 
 		if err != nil {
@@ -508,9 +559,9 @@ func (r *GroupWagerRepository) SaveParticipant(ctx context.Context, participant
 		// Create new participant
 		query := `
 			INSERT INTO group_wager_participants (
-				group_wager_id, discord_id, option_id, amount
+				group_wager_id, discord_id, option_id, amount, locked_multiplier
 			)
-			VALUES ($1, $2, $3, $4)
+			VALUES ($1, $2, $3, $4, $5)
 			RETURNING id, created_at, updated_at
 		`
 
@@ -519,6 +570,7 @@ func (r *GroupWagerRepository) SaveParticipant(ctx context.Context, participant
 			participant.DiscordID,
 			participant.OptionID,
 			participant.Amount,
+			participant.LockedMultiplier,
 		).Scan(&participant.ID, &participant.CreatedAt, &participant.UpdatedAt)
 
 		if err != nil {
@@ -529,12 +581,30 @@ func (r *GroupWagerRepository) SaveParticipant(ctx context.Context, participant
 	return nil
 }
 
+// DeleteParticipant removes a user's participation in a group wager
+func (r *GroupWagerRepository) DeleteParticipant(ctx context.Context, groupWagerID int64, discordID int64) error {
+	query := `
+		DELETE FROM group_wager_participants
+		WHERE group_wager_id = $1 AND discord_id = $2
+	`
+
+	result, err := r.q.Exec(ctx, query, groupWagerID, discordID)
+	if err != nil {
+		return fmt.Errorf("failed to delete group wager participant: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("participant not found for user %d in group wager %d", discordID, groupWagerID)
+	}
+
+	return nil
+}
+
 // GetParticipant returns a participant entry for a specific user in a group wager
 func (r *GroupWagerRepository) GetParticipant(ctx context.Context, groupWagerID int64, discordID int64) (*entities.GroupWagerParticipant, error) {
 	query := `
 		SELECT 
 			id, group_wager_id, discord_id, option_id, amount,
-			payout_amount, balance_history_id, created_at, updated_at
+			payout_amount, balance_history_id, locked_multiplier, created_at, updated_at
 		FROM group_wager_participants
 		WHERE group_wager_id = $1 AND discord_id = $2
 	`
@@ -548,6 +618,7 @@ func (r *GroupWagerRepository) GetParticipant(ctx context.Context, groupWagerID
 		&participant.Amount,
 		&participant.PayoutAmount,
 		&participant.BalanceHistoryID,
+		&participant.LockedMultiplier,
 		&participant.CreatedAt,
 		&participant.UpdatedAt,
 	)
@@ -567,7 +638,7 @@ func (r *GroupWagerRepository) GetActiveParticipationsByUser(ctx context.Context
 	query := `
 		SELECT 
 			gwp.id, gwp.group_wager_id, gwp.discord_id, gwp.option_id, gwp.amount,
-			gwp.payout_amount, gwp.balance_history_id, gwp.created_at, gwp.updated_at
+			gwp.payout_amount, gwp.balance_history_id, gwp.locked_multiplier, gwp.created_at, gwp.updated_at
 		FROM group_wager_participants gwp
 		JOIN group_wagers gw ON gw.id = gwp.group_wager_id
 		WHERE gwp.discord_id = $1 AND gw.state = 'active' AND gw.guild_id = $2
@@ -591,6 +662,7 @@ func (r *GroupWagerRepository) GetActiveParticipationsByUser(ctx context.Context
 			&participant.Amount,
 			&participant.PayoutAmount,
 			&participant.BalanceHistoryID,
+			&participant.LockedMultiplier,
 			&participant.CreatedAt,
 			&participant.UpdatedAt,
 		)
@@ -702,23 +774,450 @@ func (r *GroupWagerRepository) UpdateAllOptionOdds(ctx context.Context, groupWag
 	return nil
 }
 
+// UpdateOptionOrders moves options to new display positions
+func (r *GroupWagerRepository) UpdateOptionOrders(ctx context.Context, groupWagerID int64, orders map[int64]int16) error {
+	for optionID, order := range orders {
+		query := `
+			UPDATE group_wager_options
+			SET option_order = $2
+			WHERE id = $1 AND group_wager_id = $3
+		`
+
+		result, err := r.q.Exec(ctx, query, optionID, order, groupWagerID)
+		if err != nil {
+			return fmt.Errorf("failed to update order for option %d: %w", optionID, err)
+		}
+
+		if result.RowsAffected() == 0 {
+			return fmt.Errorf("group wager option %d not found in wager %d", optionID, groupWagerID)
+		}
+	}
+
+	return nil
+}
+
+// Odds history operations
+
+// CreateOddsHistory records a batch of odds changes for a group wager
+func (r *GroupWagerRepository) CreateOddsHistory(ctx context.Context, changes []*entities.GroupWagerOddsChange) error {
+	for _, change := range changes {
+		query := `
+			INSERT INTO group_wager_odds_history (
+				group_wager_id, option_id, old_multiplier, new_multiplier, source, changed_by_discord_id
+			)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id, created_at
+		`
+
+		err := r.q.QueryRow(ctx, query,
+			change.GroupWagerID,
+			change.OptionID,
+			change.OldMultiplier,
+			change.NewMultiplier,
+			change.Source,
+			change.ChangedByDiscordID,
+		).Scan(&change.ID, &change.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to record odds change for option %d: %w", change.OptionID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetOddsHistory returns all odds changes for a group wager in chronological order
+func (r *GroupWagerRepository) GetOddsHistory(ctx context.Context, groupWagerID int64) ([]*entities.GroupWagerOddsChange, error) {
+	query := `
+		SELECT 
+			h.id, h.group_wager_id, h.option_id, h.old_multiplier, h.new_multiplier,
+			h.source, h.changed_by_discord_id, h.created_at
+		FROM group_wager_odds_history h
+		JOIN group_wagers gw ON gw.id = h.group_wager_id
+		WHERE h.group_wager_id = $1 AND gw.guild_id = $2
+		ORDER BY h.created_at, h.id
+	`
+
+	rows, err := r.q.Query(ctx, query, groupWagerID, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query group wager odds history: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []*entities.GroupWagerOddsChange
+	for rows.Next() {
+		var change entities.GroupWagerOddsChange
+		err := rows.Scan(
+			&change.ID,
+			&change.GroupWagerID,
+			&change.OptionID,
+			&change.OldMultiplier,
+			&change.NewMultiplier,
+			&change.Source,
+			&change.ChangedByDiscordID,
+			&change.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan group wager odds change: %w", err)
+		}
+		changes = append(changes, &change)
+	}
+
+	return changes, nil
+}
+
+// Event log operations
+
+// RecordEvent appends an action to a group wager's event log
+func (r *GroupWagerRepository) RecordEvent(ctx context.Context, event *entities.GroupWagerEvent) error {
+	payload := event.Payload
+	if payload == nil {
+		payload = map[string]any{}
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event payload: %w", err)
+	}
+
+	query := `
+		INSERT INTO group_wager_events (group_wager_id, event_type, actor_discord_id, payload)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+
+	err = r.q.QueryRow(ctx, query,
+		event.GroupWagerID,
+		event.EventType,
+		event.ActorDiscordID,
+		payloadJSON,
+	).Scan(&event.ID, &event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record %s event for group wager %d: %w", event.EventType, event.GroupWagerID, err)
+	}
+
+	return nil
+}
+
+// GetEvents returns a group wager's full event log in the order it was written
+func (r *GroupWagerRepository) GetEvents(ctx context.Context, groupWagerID int64) ([]*entities.GroupWagerEvent, error) {
+	query := `
+		SELECT e.id, e.group_wager_id, e.event_type, e.actor_discord_id, e.payload, e.created_at
+		FROM group_wager_events e
+		JOIN group_wagers gw ON gw.id = e.group_wager_id
+		WHERE e.group_wager_id = $1 AND gw.guild_id = $2
+		ORDER BY e.id
+	`
+
+	rows, err := r.q.Query(ctx, query, groupWagerID, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query group wager events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*entities.GroupWagerEvent
+	for rows.Next() {
+		var event entities.GroupWagerEvent
+		var payloadJSON []byte
+		err := rows.Scan(
+			&event.ID,
+			&event.GroupWagerID,
+			&event.EventType,
+			&event.ActorDiscordID,
+			&payloadJSON,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan group wager event: %w", err)
+		}
+		if err := json.Unmarshal(payloadJSON, &event.Payload); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event payload: %w", err)
+		}
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+// Bet change operations
+
+// RecordBetChange appends a participant's bet change to the wager's audit trail
+func (r *GroupWagerRepository) RecordBetChange(ctx context.Context, change *entities.GroupWagerBetChange) error {
+	query := `
+		INSERT INTO group_wager_bet_changes (
+			group_wager_id, discord_id, previous_option_id, previous_amount, new_option_id, new_amount
+		)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+
+	err := r.q.QueryRow(ctx, query,
+		change.GroupWagerID,
+		change.DiscordID,
+		change.PreviousOptionID,
+		change.PreviousAmount,
+		change.NewOptionID,
+		change.NewAmount,
+	).Scan(&change.ID, &change.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record bet change for user %d on group wager %d: %w", change.DiscordID, change.GroupWagerID, err)
+	}
+
+	return nil
+}
+
+// GetBetChanges returns every bet change on a wager, oldest first
+func (r *GroupWagerRepository) GetBetChanges(ctx context.Context, groupWagerID int64) ([]*entities.GroupWagerBetChange, error) {
+	query := `
+		SELECT c.id, c.group_wager_id, c.discord_id, c.previous_option_id, c.previous_amount,
+		       c.new_option_id, c.new_amount, c.created_at
+		FROM group_wager_bet_changes c
+		JOIN group_wagers gw ON gw.id = c.group_wager_id
+		WHERE c.group_wager_id = $1 AND gw.guild_id = $2
+		ORDER BY c.id
+	`
+
+	return r.queryBetChanges(ctx, query, groupWagerID, r.guildID)
+}
+
+// GetParticipantBetChanges returns a participant's bet changes on a wager, oldest first
+func (r *GroupWagerRepository) GetParticipantBetChanges(ctx context.Context, groupWagerID int64, discordID int64) ([]*entities.GroupWagerBetChange, error) {
+	query := `
+		SELECT c.id, c.group_wager_id, c.discord_id, c.previous_option_id, c.previous_amount,
+		       c.new_option_id, c.new_amount, c.created_at
+		FROM group_wager_bet_changes c
+		JOIN group_wagers gw ON gw.id = c.group_wager_id
+		WHERE c.group_wager_id = $1 AND c.discord_id = $2 AND gw.guild_id = $3
+		ORDER BY c.id
+	`
+
+	return r.queryBetChanges(ctx, query, groupWagerID, discordID, r.guildID)
+}
+
+// queryBetChanges runs a bet change query and scans the rows
+func (r *GroupWagerRepository) queryBetChanges(ctx context.Context, query string, args ...any) ([]*entities.GroupWagerBetChange, error) {
+	rows, err := r.q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query group wager bet changes: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []*entities.GroupWagerBetChange
+	for rows.Next() {
+		var change entities.GroupWagerBetChange
+		err := rows.Scan(
+			&change.ID,
+			&change.GroupWagerID,
+			&change.DiscordID,
+			&change.PreviousOptionID,
+			&change.PreviousAmount,
+			&change.NewOptionID,
+			&change.NewAmount,
+			&change.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan group wager bet change: %w", err)
+		}
+		changes = append(changes, &change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating group wager bet change rows: %w", err)
+	}
+
+	return changes, nil
+}
+
+// House wager player operations
+
+// AddHousePlayer adds a watched summoner to a house wager in the current guild.
+// Returns false if they were already on it.
+func (r *GroupWagerRepository) AddHousePlayer(ctx context.Context, groupWagerID int64, summonerName, tagLine string) (bool, error) {
+	query := `
+		INSERT INTO house_wager_players (group_wager_id, summoner_name, tag_line)
+		SELECT id, $2, $3 FROM group_wagers WHERE id = $1 AND guild_id = $4
+		ON CONFLICT DO NOTHING
+	`
+
+	result, err := r.q.Exec(ctx, query, groupWagerID, summonerName, tagLine, r.guildID)
+	if err != nil {
+		return false, fmt.Errorf("failed to add %s#%s to house wager %d: %w", summonerName, tagLine, groupWagerID, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// RecordHousePlayerResult records a player's game result on a house wager in the current guild.
+// Wagers created before players were recorded have no row to update.
+func (r *GroupWagerRepository) RecordHousePlayerResult(ctx context.Context, groupWagerID int64, summonerName, tagLine string, won bool) error {
+	query := `
+		UPDATE house_wager_players p
+		SET won = $4
+		FROM group_wagers gw
+		WHERE gw.id = p.group_wager_id AND p.group_wager_id = $1 AND gw.guild_id = $5
+		  AND LOWER(p.summoner_name) = LOWER($2) AND LOWER(p.tag_line) = LOWER($3)
+	`
+
+	_, err := r.q.Exec(ctx, query, groupWagerID, summonerName, tagLine, won, r.guildID)
+	if err != nil {
+		return fmt.Errorf("failed to record result of %s#%s on house wager %d: %w", summonerName, tagLine, groupWagerID, err)
+	}
+
+	return nil
+}
+
+// GetHousePlayers returns a house wager's players in the order they joined
+func (r *GroupWagerRepository) GetHousePlayers(ctx context.Context, groupWagerID int64) ([]*entities.HouseWagerPlayer, error) {
+	query := `
+		SELECT p.id, p.group_wager_id, p.summoner_name, p.tag_line, p.won, p.created_at
+		FROM house_wager_players p
+		JOIN group_wagers gw ON gw.id = p.group_wager_id
+		WHERE p.group_wager_id = $1 AND gw.guild_id = $2
+		ORDER BY p.id
+	`
+
+	rows, err := r.q.Query(ctx, query, groupWagerID, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query house wager players: %w", err)
+	}
+	defer rows.Close()
+
+	var players []*entities.HouseWagerPlayer
+	for rows.Next() {
+		var player entities.HouseWagerPlayer
+		err := rows.Scan(
+			&player.ID,
+			&player.GroupWagerID,
+			&player.SummonerName,
+			&player.TagLine,
+			&player.Won,
+			&player.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan house wager player: %w", err)
+		}
+		players = append(players, &player)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating house wager player rows: %w", err)
+	}
+
+	return players, nil
+}
+
 // Internal helper methods
 
-// getOptionsByGroupWager returns all options for a group wager
-func (r *GroupWagerRepository) getOptionsByGroupWager(ctx context.Context, groupWagerID int64) ([]*entities.GroupWagerOption, error) {
+// groupWagerByIDQuery selects a group wager by its ID, optionally locking the row until the transaction ends
+func groupWagerByIDQuery(forUpdate bool) string {
+	query := `
+		SELECT 
+			id, creator_discord_id, guild_id, condition, state, wager_type, resolver_discord_id,
+			winning_option_id, total_pot, min_participants, message_id, 
+			channel_id, thread_id, subject_discord_id, thumbnail_url, image_url, voting_period_minutes, voting_starts_at, voting_ends_at,
+			created_at, resolved_at, cancelled_at, external_id, external_system,
+			max_participants, allowed_role_id, invited_discord_ids,
+			reaction_message_id, reaction_channel_id, reaction_stake
+		FROM group_wagers
+		WHERE id = $1
+	`
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+	return query
+}
+
+// groupWagerOptionsQuery selects all options for a group wager, optionally locking them until the transaction ends
+func groupWagerOptionsQuery(forUpdate bool) string {
 	query := `
 		SELECT 
 			id, group_wager_id, option_text, option_order, 
-			total_amount, odds_multiplier, created_at
+			total_amount, odds_multiplier, max_total_amount,
+			button_emoji, button_style, created_at
 		FROM group_wager_options
 		WHERE group_wager_id = $1
 		ORDER BY option_order
 	`
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+	return query
+}
 
-	rows, err := r.q.Query(ctx, query, groupWagerID)
+// groupWagerParticipantsQuery selects all participants for a group wager
+const groupWagerParticipantsQuery = `
+	SELECT 
+		id, group_wager_id, discord_id, option_id, amount,
+		payout_amount, balance_history_id, locked_multiplier, created_at, updated_at
+	FROM group_wager_participants
+	WHERE group_wager_id = $1
+	ORDER BY created_at
+`
+
+// scanGroupWager scans a row selected by groupWagerByIDQuery, returning nil when there is no row
+func scanGroupWager(row pgx.Row) (*entities.GroupWager, error) {
+	var wager entities.GroupWager
+	var externalID, externalSystem *string
+
+	err := row.Scan(
+		&wager.ID,
+		&wager.CreatorDiscordID,
+		&wager.GuildID,
+		&wager.Condition,
+		&wager.State,
+		&wager.WagerType,
+		&wager.ResolverDiscordID,
+		&wager.WinningOptionID,
+		&wager.TotalPot,
+		&wager.MinParticipants,
+		&wager.MessageID,
+		&wager.ChannelID,
+		&wager.ThreadID,
+		&wager.SubjectDiscordID,
+		&wager.ThumbnailURL,
+		&wager.ImageURL,
+		&wager.VotingPeriodMinutes,
+		&wager.VotingStartsAt,
+		&wager.VotingEndsAt,
+		&wager.CreatedAt,
+		&wager.ResolvedAt,
+		&wager.CancelledAt,
+		&externalID,
+		&externalSystem,
+		&wager.MaxParticipants,
+		&wager.AllowedRoleID,
+		&wager.InvitedDiscordIDs,
+		&wager.ReactionMessageID,
+		&wager.ReactionChannelID,
+		&wager.ReactionStake,
+	)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to query group wager options: %w", err)
+		return nil, fmt.Errorf("failed to get group wager: %w", err)
+	}
+
+	// Set the external reference if both fields are present
+	if externalID != nil && externalSystem != nil {
+		wager.ExternalRef = &entities.ExternalReference{
+			System: entities.ExternalSystem(*externalSystem),
+			ID:     *externalID,
+		}
+	}
+
+	return &wager, nil
+}
+
+// invitedDiscordIDs returns ids, or an empty list for the NOT NULL invited_discord_ids column
+func invitedDiscordIDs(ids []int64) []int64 {
+	if ids == nil {
+		return []int64{}
 	}
+	return ids
+}
+
+// scanGroupWagerOptions scans and closes rows selected by groupWagerOptionsQuery
+func scanGroupWagerOptions(rows pgx.Rows) ([]*entities.GroupWagerOption, error) {
 	defer rows.Close()
 
 	var options []*entities.GroupWagerOption
@@ -731,6 +1230,9 @@ func (r *GroupWagerRepository) getOptionsByGroupWager(ctx context.Context, group
 			&option.OptionOrder,
 			&option.TotalAmount,
 			&option.OddsMultiplier,
+			&option.MaxTotalAmount,
+			&option.ButtonEmoji,
+			&option.ButtonStyle,
 			&option.CreatedAt,
 		)
 		if err != nil {
@@ -739,24 +1241,11 @@ func (r *GroupWagerRepository) getOptionsByGroupWager(ctx context.Context, group
 		options = append(options, &option)
 	}
 
-	return options, nil
+	return options, rows.Err()
 }
 
-// getParticipantsByGroupWager returns all participants for a group wager
-func (r *GroupWagerRepository) getParticipantsByGroupWager(ctx context.Context, groupWagerID int64) ([]*entities.GroupWagerParticipant, error) {
-	query := `
-		SELECT 
-			id, group_wager_id, discord_id, option_id, amount,
-			payout_amount, balance_history_id, created_at, updated_at
-		FROM group_wager_participants
-		WHERE group_wager_id = $1
-		ORDER BY created_at
-	`
-
-	rows, err := r.q.Query(ctx, query, groupWagerID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query group wager participants: %w", err)
-	}
+// scanGroupWagerParticipants scans and closes rows selected by groupWagerParticipantsQuery
+func scanGroupWagerParticipants(rows pgx.Rows) ([]*entities.GroupWagerParticipant, error) {
 	defer rows.Close()
 
 	var participants []*entities.GroupWagerParticipant
@@ -770,6 +1259,7 @@ func (r *GroupWagerRepository) getParticipantsByGroupWager(ctx context.Context,
 			&participant.Amount,
 			&participant.PayoutAmount,
 			&participant.BalanceHistoryID,
+			&participant.LockedMultiplier,
 			&participant.CreatedAt,
 			&participant.UpdatedAt,
 		)
@@ -779,12 +1269,12 @@ func (r *GroupWagerRepository) getParticipantsByGroupWager(ctx context.Context,
 		participants = append(participants, &participant)
 	}
 
-	return participants, nil
+	return participants, rows.Err()
 }
 
 // GetGroupWagerPredictions returns all group wager predictions for resolved wagers in the guild
-// Can optionally filter by external system (pass nil for all wagers)
-func (r *GroupWagerRepository) GetGroupWagerPredictions(ctx context.Context, externalSystem *entities.ExternalSystem) ([]*entities.GroupWagerPrediction, error) {
+// Can optionally filter by external system (pass nil for all wagers) and to wagers created at or after since (pass nil for all time)
+func (r *GroupWagerRepository) GetGroupWagerPredictions(ctx context.Context, externalSystem *entities.ExternalSystem, since *time.Time) ([]*entities.GroupWagerPrediction, error) {
 	query := `
 		SELECT 
 			gwp.discord_id,
@@ -809,8 +1299,14 @@ func (r *GroupWagerRepository) GetGroupWagerPredictions(ctx context.Context, ext
 
 	// Add external system filter if specified
 	if externalSystem != nil {
-		query += " AND gw.external_system = $2"
 		args = append(args, *externalSystem)
+		query += fmt.Sprintf(" AND gw.external_system = $%d", len(args))
+	}
+
+	// Add time window filter if specified
+	if since != nil {
+		args = append(args, *since)
+		query += fmt.Sprintf(" AND gw.created_at >= $%d", len(args))
 	}
 
 	query += " ORDER BY gwp.discord_id, gwp.created_at"
@@ -856,13 +1352,75 @@ func (r *GroupWagerRepository) GetGroupWagerPredictions(ctx context.Context, ext
 	return predictions, nil
 }
 
+// StreamOutcomesByDateRange calls fn for every participant of group wagers in the guild resolved within a date range,
+// ordered by resolution time. Rows are scanned one at a time so large ranges are never held in memory.
+func (r *GroupWagerRepository) StreamOutcomesByDateRange(ctx context.Context, from, to time.Time, fn func(*entities.GroupWagerOutcome) error) error {
+	query := `
+		SELECT
+			gw.id,
+			gw.condition,
+			gw.wager_type,
+			gw.resolved_at,
+			wo.option_text,
+			gwp.discord_id,
+			gwo.option_text,
+			gwp.amount,
+			gwp.payout_amount,
+			gwp.option_id = gw.winning_option_id AS won
+		FROM group_wager_participants gwp
+		JOIN group_wagers gw ON gw.id = gwp.group_wager_id
+		JOIN group_wager_options gwo ON gwo.id = gwp.option_id
+		JOIN group_wager_options wo ON wo.id = gw.winning_option_id
+		WHERE gw.guild_id = $1
+		AND gw.state = 'resolved'
+		AND gw.resolved_at >= $2
+		AND gw.resolved_at < $3
+		ORDER BY gw.resolved_at, gw.id, gwp.id
+	`
+
+	rows, err := r.q.Query(ctx, query, r.guildID, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to stream group wager outcomes for guild %d: %w", r.guildID, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var outcome entities.GroupWagerOutcome
+		err := rows.Scan(
+			&outcome.GroupWagerID,
+			&outcome.Condition,
+			&outcome.WagerType,
+			&outcome.ResolvedAt,
+			&outcome.WinningOptionText,
+			&outcome.DiscordID,
+			&outcome.OptionText,
+			&outcome.Amount,
+			&outcome.PayoutAmount,
+			&outcome.Won,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan group wager outcome: %w", err)
+		}
+
+		if err := fn(&outcome); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate group wager outcomes: %w", err)
+	}
+
+	return nil
+}
+
 // GetExpiredActiveWagers returns all active group wagers where voting period has expired
 func (r *GroupWagerRepository) GetExpiredActiveWagers(ctx context.Context) ([]*entities.GroupWager, error) {
 	query := `
 		SELECT 
 			id, creator_discord_id, guild_id, condition, state, wager_type, resolver_discord_id,
 			winning_option_id, total_pot, min_participants, message_id, 
-			channel_id, voting_period_minutes, voting_starts_at, voting_ends_at,
+			channel_id, thread_id, subject_discord_id, thumbnail_url, image_url, voting_period_minutes, voting_starts_at, voting_ends_at,
 			created_at, resolved_at, external_id, external_system
 		FROM group_wagers
 		WHERE state = 'active' 
@@ -896,6 +1454,10 @@ func (r *GroupWagerRepository) GetExpiredActiveWagers(ctx context.Context) ([]*e
 			&wager.MinParticipants,
 			&wager.MessageID,
 			&wager.ChannelID,
+			&wager.ThreadID,
+			&wager.SubjectDiscordID,
+			&wager.ThumbnailURL,
+			&wager.ImageURL,
 			&wager.VotingPeriodMinutes,
 			&wager.VotingStartsAt,
 			&wager.VotingEndsAt,
@@ -928,8 +1490,8 @@ func (r *GroupWagerRepository) GetWagersPendingResolution(ctx context.Context) (
 		SELECT 
 			id, creator_discord_id, guild_id, condition, state, wager_type, resolver_discord_id,
 			winning_option_id, total_pot, min_participants, message_id, 
-			channel_id, voting_period_minutes, voting_starts_at, voting_ends_at,
-			created_at, resolved_at
+			channel_id, thread_id, subject_discord_id, thumbnail_url, image_url, voting_period_minutes, voting_starts_at, voting_ends_at,
+			created_at, resolved_at, resolution_reminded_at, assigned_resolver_discord_id
 		FROM group_wagers
 		WHERE state = 'pending_resolution' AND guild_id = $1
 		ORDER BY voting_ends_at ASC
@@ -957,11 +1519,17 @@ func (r *GroupWagerRepository) GetWagersPendingResolution(ctx context.Context) (
 			&wager.MinParticipants,
 			&wager.MessageID,
 			&wager.ChannelID,
+			&wager.ThreadID,
+			&wager.SubjectDiscordID,
+			&wager.ThumbnailURL,
+			&wager.ImageURL,
 			&wager.VotingPeriodMinutes,
 			&wager.VotingStartsAt,
 			&wager.VotingEndsAt,
 			&wager.CreatedAt,
 			&wager.ResolvedAt,
+			&wager.RemindedAt,
+			&wager.AssignedResolverID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan wager pending resolution: %w", err)
@@ -1016,6 +1584,142 @@ func (r *GroupWagerRepository) GetStats(ctx context.Context, discordID int64) (*
 	}, nil
 }
 
+// GetSubjectGameResults returns the outcome of every resolved house wager on a linked player's own games.
+// Props carry a ":market" suffix on the game's external ID and are left out so each game counts once.
+func (r *GroupWagerRepository) GetSubjectGameResults(ctx context.Context, discordID int64) ([]*entities.PlayerGameResult, error) {
+	query := `
+		SELECT gw.external_system, gwo.option_text,
+		       (SELECT COUNT(*) FROM group_wager_options o WHERE o.group_wager_id = gw.id) AS option_count
+		FROM group_wagers gw
+		JOIN group_wager_options gwo ON gwo.id = gw.winning_option_id
+		WHERE gw.subject_discord_id = $1 AND gw.guild_id = $2
+		  AND gw.state = 'resolved' AND gw.external_system IS NOT NULL
+		  AND gw.external_id NOT LIKE '%:%'
+		ORDER BY gw.resolved_at`
+
+	rows, err := r.q.Query(ctx, query, discordID, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query subject game results: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*entities.PlayerGameResult
+	for rows.Next() {
+		var result entities.PlayerGameResult
+		if err := rows.Scan(&result.System, &result.WinningOption, &result.OptionCount); err != nil {
+			return nil, fmt.Errorf("failed to scan subject game result: %w", err)
+		}
+		results = append(results, &result)
+	}
+
+	return results, rows.Err()
+}
+
+// GetSummonerGameResults returns the outcomes of the most recent resolved LoL game wagers on a summoner, newest first.
+// Game wagers are matched on their recorded players, or on the "<name> - **" prefix the condition of wagers
+// from before players were recorded starts with.
+// Props and streak wagers carry a ":" in their external ID and are left out so each game counts once.
+func (r *GroupWagerRepository) GetSummonerGameResults(ctx context.Context, summonerName string, limit int) ([]*entities.PlayerGameResult, error) {
+	query := `
+		SELECT gw.external_system, gwo.option_text,
+		       (SELECT COUNT(*) FROM group_wager_options o WHERE o.group_wager_id = gw.id) AS option_count
+		FROM group_wagers gw
+		JOIN group_wager_options gwo ON gwo.id = gw.winning_option_id
+		WHERE gw.guild_id = $1 AND gw.state = 'resolved'
+		  AND gw.external_system = $2 AND gw.external_id NOT LIKE '%:%'
+		  AND (LEFT(gw.condition, LENGTH($3)) = $3 OR EXISTS (
+		      SELECT 1 FROM house_wager_players p
+		      WHERE p.group_wager_id = gw.id AND LOWER(p.summoner_name) = LOWER($5)))
+		ORDER BY gw.resolved_at DESC
+		LIMIT $4`
+
+	rows, err := r.q.Query(ctx, query, r.guildID, entities.SystemLeagueOfLegends, summonerName+" - **", limit, summonerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query summoner game results: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*entities.PlayerGameResult
+	for rows.Next() {
+		var result entities.PlayerGameResult
+		if err := rows.Scan(&result.System, &result.WinningOption, &result.OptionCount); err != nil {
+			return nil, fmt.Errorf("failed to scan summoner game result: %w", err)
+		}
+		results = append(results, &result)
+	}
+
+	return results, rows.Err()
+}
+
+// GetCreatorStats returns totals and averages across the group wagers a user has created.
+// Pot and participant averages leave out cancelled wagers, whose bets were refunded.
+func (r *GroupWagerRepository) GetCreatorStats(ctx context.Context, creatorID int64) (*entities.GroupWagerCreatorStats, error) {
+	query := `
+		SELECT
+			COUNT(*) AS total_created,
+			COUNT(*) FILTER (WHERE gw.state = 'resolved') AS total_resolved,
+			COUNT(*) FILTER (WHERE gw.state = 'cancelled') AS total_cancelled,
+			COALESCE(AVG(gw.total_pot) FILTER (WHERE gw.state != 'cancelled'), 0) AS average_pot,
+			COALESCE(AVG(p.participant_count) FILTER (WHERE gw.state != 'cancelled'), 0) AS average_participants,
+			COALESCE(EXTRACT(EPOCH FROM AVG(gw.resolved_at - gw.created_at) FILTER (WHERE gw.state = 'resolved')), 0) AS average_seconds_to_resolve
+		FROM group_wagers gw
+		CROSS JOIN LATERAL (
+			SELECT COUNT(*) AS participant_count
+			FROM group_wager_participants gwp
+			WHERE gwp.group_wager_id = gw.id
+		) p
+		WHERE gw.creator_discord_id = $1 AND gw.guild_id = $2`
+
+	stats := &entities.GroupWagerCreatorStats{DiscordID: creatorID}
+	var averageSecondsToResolve float64
+
+	err := r.q.QueryRow(ctx, query, creatorID, r.guildID).Scan(
+		&stats.TotalCreated,
+		&stats.TotalResolved,
+		&stats.TotalCancelled,
+		&stats.AveragePot,
+		&stats.AverageParticipants,
+		&averageSecondsToResolve,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get creator stats: %w", err)
+	}
+
+	stats.AverageTimeToResolve = time.Duration(averageSecondsToResolve * float64(time.Second))
+	return stats, nil
+}
+
+// GetCreatorPopularOptions returns the option texts that drew the most bets on a creator's wagers.
+// Options are grouped case-insensitively so "Yes" and "yes" on different wagers count together.
+func (r *GroupWagerRepository) GetCreatorPopularOptions(ctx context.Context, creatorID int64, limit int) ([]*entities.GroupWagerPopularOption, error) {
+	query := `
+		SELECT MIN(gwo.option_text) AS option_text, COUNT(gwp.id) AS bet_count, COALESCE(SUM(gwp.amount), 0) AS total_amount
+		FROM group_wager_options gwo
+		JOIN group_wagers gw ON gw.id = gwo.group_wager_id
+		JOIN group_wager_participants gwp ON gwp.option_id = gwo.id
+		WHERE gw.creator_discord_id = $1 AND gw.guild_id = $2 AND gw.state != 'cancelled'
+		GROUP BY LOWER(gwo.option_text)
+		ORDER BY bet_count DESC, total_amount DESC, option_text
+		LIMIT $3`
+
+	rows, err := r.q.Query(ctx, query, creatorID, r.guildID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query creator popular options: %w", err)
+	}
+	defer rows.Close()
+
+	var options []*entities.GroupWagerPopularOption
+	for rows.Next() {
+		var option entities.GroupWagerPopularOption
+		if err := rows.Scan(&option.OptionText, &option.BetCount, &option.TotalAmount); err != nil {
+			return nil, fmt.Errorf("failed to scan creator popular option: %w", err)
+		}
+		options = append(options, &option)
+	}
+
+	return options, rows.Err()
+}
+
 // GetGuildsWithActiveWagers returns all guild IDs that have active group wagers
 func (r *GroupWagerRepository) GetGuildsWithActiveWagers(ctx context.Context) ([]int64, error) {
 	query := `
@@ -1045,3 +1749,215 @@ func (r *GroupWagerRepository) GetGuildsWithActiveWagers(ctx context.Context) ([
 
 	return guildIDs, nil
 }
+
+// GetGuildsWithOpenExternalReference returns the guilds with an active or pending resolution wager
+// linked to the external reference. This is a cross-guild query used to route external match results.
+func (r *GroupWagerRepository) GetGuildsWithOpenExternalReference(ctx context.Context, ref entities.ExternalReference) ([]int64, error) {
+	query := `
+		SELECT DISTINCT guild_id
+		FROM group_wagers
+		WHERE external_id = $1 AND external_system = $2
+		  AND state IN ('active', 'pending_resolution')
+		ORDER BY guild_id
+	`
+
+	rows, err := r.q.Query(ctx, query, ref.ID, string(ref.System))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query guilds with external reference: %w", err)
+	}
+	defer rows.Close()
+
+	var guildIDs []int64
+	for rows.Next() {
+		var guildID int64
+		if err := rows.Scan(&guildID); err != nil {
+			return nil, fmt.Errorf("failed to scan guild ID: %w", err)
+		}
+		guildIDs = append(guildIDs, guildID)
+	}
+
+	return guildIDs, rows.Err()
+}
+
+// GetGuildsWithWagersPendingResolution returns all guild IDs that have group wagers awaiting resolution
+func (r *GroupWagerRepository) GetGuildsWithWagersPendingResolution(ctx context.Context) ([]int64, error) {
+	query := `
+		SELECT DISTINCT guild_id
+		FROM group_wagers
+		WHERE state = 'pending_resolution'
+		ORDER BY guild_id
+	`
+
+	rows, err := r.q.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query guilds with wagers pending resolution: %w", err)
+	}
+	defer rows.Close()
+
+	var guildIDs []int64
+	for rows.Next() {
+		var guildID int64
+		if err := rows.Scan(&guildID); err != nil {
+			return nil, fmt.Errorf("failed to scan guild ID: %w", err)
+		}
+		guildIDs = append(guildIDs, guildID)
+	}
+
+	return guildIDs, rows.Err()
+}
+
+// MarkResolutionReminded records when resolvers were last reminded about a wager awaiting resolution
+func (r *GroupWagerRepository) MarkResolutionReminded(ctx context.Context, groupWagerID int64, remindedAt time.Time) error {
+	query := `
+		UPDATE group_wagers
+		SET resolution_reminded_at = $3
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	result, err := r.q.Exec(ctx, query, groupWagerID, r.guildID, remindedAt)
+	if err != nil {
+		return fmt.Errorf("failed to mark group wager %d reminded: %w", groupWagerID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("group wager %d not found", groupWagerID)
+	}
+
+	return nil
+}
+
+// AssignResolver records which resolver a wager awaiting resolution was handed to
+func (r *GroupWagerRepository) AssignResolver(ctx context.Context, groupWagerID int64, resolverID int64, assignedAt time.Time) error {
+	query := `
+		UPDATE group_wagers
+		SET assigned_resolver_discord_id = $3, resolver_assigned_at = $4
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	result, err := r.q.Exec(ctx, query, groupWagerID, r.guildID, resolverID, assignedAt)
+	if err != nil {
+		return fmt.Errorf("failed to assign resolver to group wager %d: %w", groupWagerID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("group wager %d not found", groupWagerID)
+	}
+
+	return nil
+}
+
+// GetLastAssignedResolver returns the resolver most recently assigned a wager in the guild, nil if none has been
+func (r *GroupWagerRepository) GetLastAssignedResolver(ctx context.Context) (*int64, error) {
+	query := `
+		SELECT assigned_resolver_discord_id
+		FROM group_wagers
+		WHERE guild_id = $1 AND resolver_assigned_at IS NOT NULL
+		ORDER BY resolver_assigned_at DESC, id DESC
+		LIMIT 1
+	`
+
+	var resolverID int64
+	err := r.q.QueryRow(ctx, query, r.guildID).Scan(&resolverID)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last assigned resolver: %w", err)
+	}
+
+	return &resolverID, nil
+}
+
+// HasSettledParlayLegs checks if any parlay leg on the wager has been settled by its resolution or cancellation
+func (r *GroupWagerRepository) HasSettledParlayLegs(ctx context.Context, groupWagerID int64) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM parlay_legs pl
+			JOIN parlays p ON p.id = pl.parlay_id
+			WHERE pl.group_wager_id = $1 AND p.guild_id = $2 AND pl.status != 'pending'
+		)
+	`
+
+	var settled bool
+	if err := r.q.QueryRow(ctx, query, groupWagerID, r.guildID).Scan(&settled); err != nil {
+		return false, fmt.Errorf("failed to check settled parlay legs for group wager %d: %w", groupWagerID, err)
+	}
+
+	return settled, nil
+}
+
+// UpdateReactionBetting sets the message whose reactions place bets on a group wager, and the stake they bet
+func (r *GroupWagerRepository) UpdateReactionBetting(ctx context.Context, groupWagerID int64, messageID, channelID int64, stake int64) error {
+	query := `
+		UPDATE group_wagers
+		SET reaction_message_id = $3, reaction_channel_id = $4, reaction_stake = $5
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	result, err := r.q.Exec(ctx, query, groupWagerID, r.guildID, messageID, channelID, stake)
+	if err != nil {
+		return fmt.Errorf("failed to update reaction betting for group wager %d: %w", groupWagerID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("group wager %d not found", groupWagerID)
+	}
+
+	return nil
+}
+
+// UpdateMessageIDs sets the message and channel a group wager is posted in
+func (r *GroupWagerRepository) UpdateMessageIDs(ctx context.Context, groupWagerID int64, messageID, channelID int64) error {
+	query := `
+		UPDATE group_wagers
+		SET message_id = $3, channel_id = $4
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	result, err := r.q.Exec(ctx, query, groupWagerID, r.guildID, messageID, channelID)
+	if err != nil {
+		return fmt.Errorf("failed to update message IDs for group wager %d: %w", groupWagerID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("group wager %d not found", groupWagerID)
+	}
+
+	return nil
+}
+
+// UpdateThreadID sets the discussion thread attached to a group wager's message
+func (r *GroupWagerRepository) UpdateThreadID(ctx context.Context, groupWagerID int64, threadID int64) error {
+	query := `
+		UPDATE group_wagers
+		SET thread_id = $3
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	result, err := r.q.Exec(ctx, query, groupWagerID, r.guildID, threadID)
+	if err != nil {
+		return fmt.Errorf("failed to update thread ID for group wager %d: %w", groupWagerID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("group wager %d not found", groupWagerID)
+	}
+
+	return nil
+}
+
+// UpdateAccess sets the participant limit, allowed role and invite list of a group wager
+func (r *GroupWagerRepository) UpdateAccess(ctx context.Context, groupWagerID int64, access entities.GroupWagerAccess) error {
+	query := `
+		UPDATE group_wagers
+		SET max_participants = $3, allowed_role_id = $4, invited_discord_ids = $5
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	result, err := r.q.Exec(ctx, query, groupWagerID, r.guildID,
+		access.MaxParticipants, access.AllowedRoleID, invitedDiscordIDs(access.InvitedDiscordIDs))
+	if err != nil {
+		return fmt.Errorf("failed to update access for group wager %d: %w", groupWagerID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("group wager %d not found", groupWagerID)
+	}
+
+	return nil
+}
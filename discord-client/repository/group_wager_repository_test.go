@@ -279,7 +279,7 @@ func TestGroupWagerRepository_GetGroupWagerPredictions(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("no resolved wagers - returns empty predictions", func(t *testing.T) {
-		predictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, nil)
+		predictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, nil, nil)
 		require.NoError(t, err)
 		assert.Empty(t, predictions)
 	})
@@ -298,7 +298,7 @@ func TestGroupWagerRepository_GetGroupWagerPredictions(t *testing.T) {
 		err = groupWagerRepo.SaveParticipant(ctx, participant)
 		require.NoError(t, err)
 
-		predictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, nil)
+		predictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, nil, nil)
 		require.NoError(t, err)
 		assert.Empty(t, predictions)
 	})
@@ -329,7 +329,7 @@ func TestGroupWagerRepository_GetGroupWagerPredictions(t *testing.T) {
 		err = groupWagerRepo.SaveParticipant(ctx, participant2)
 		require.NoError(t, err)
 
-		predictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, nil)
+		predictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, nil, nil)
 		require.NoError(t, err)
 		require.Len(t, predictions, 2)
 
@@ -393,7 +393,7 @@ func TestGroupWagerRepository_GetGroupWagerPredictions(t *testing.T) {
 
 		// Test filtering by external system
 		lolSystem := entities.SystemLeagueOfLegends
-		predictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, &lolSystem)
+		predictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, &lolSystem, nil)
 		require.NoError(t, err)
 		require.Len(t, predictions, 2)
 
@@ -458,13 +458,13 @@ func TestGroupWagerRepository_GetGroupWagerPredictions(t *testing.T) {
 
 		// Test filtering by LoL should not return TFT predictions
 		lolSystem := entities.SystemLeagueOfLegends
-		lolPredictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, &lolSystem)
+		lolPredictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, &lolSystem, nil)
 		require.NoError(t, err)
 		require.Len(t, lolPredictions, 2) // Only the LoL predictions from previous test
 
 		// Test filtering by TFT should only return TFT predictions
 		tftSystem := entities.SystemTFT
-		tftPredictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, &tftSystem)
+		tftPredictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, &tftSystem, nil)
 		require.NoError(t, err)
 		require.Len(t, tftPredictions, 1)
 
@@ -481,7 +481,7 @@ func TestGroupWagerRepository_GetGroupWagerPredictions(t *testing.T) {
 		assert.Equal(t, "TFT_67890", *tftPredictions[0].ExternalID)
 
 		// Test no filter should return all predictions
-		allPredictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, nil)
+		allPredictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, nil, nil)
 		require.NoError(t, err)
 		require.Len(t, allPredictions, 5) // All predictions from all tests
 	})
@@ -510,7 +510,7 @@ func TestGroupWagerRepository_GetGroupWagerPredictions(t *testing.T) {
 		require.NoError(t, err)
 
 		// Should still return the same number of predictions as before (cancelled wager excluded)
-		predictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, nil)
+		predictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, nil, nil)
 		require.NoError(t, err)
 		require.Len(t, predictions, 5) // Same as before, cancelled wager not included
 
@@ -520,9 +520,21 @@ func TestGroupWagerRepository_GetGroupWagerPredictions(t *testing.T) {
 		}
 	})
 
+	t.Run("time window excludes wagers created before since", func(t *testing.T) {
+		before := time.Now().Add(-time.Hour)
+		predictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, nil, &before)
+		require.NoError(t, err)
+		assert.Len(t, predictions, 5)
+
+		after := time.Now().Add(time.Hour)
+		predictions, err = groupWagerRepo.GetGroupWagerPredictions(ctx, nil, &after)
+		require.NoError(t, err)
+		assert.Empty(t, predictions)
+	})
+
 	t.Run("sorting is consistent", func(t *testing.T) {
 		// Test that results are consistently ordered by discord_id, created_at
-		predictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, nil)
+		predictions, err := groupWagerRepo.GetGroupWagerPredictions(ctx, nil, nil)
 		require.NoError(t, err)
 		require.Greater(t, len(predictions), 0)
 
@@ -537,3 +549,273 @@ func TestGroupWagerRepository_GetGroupWagerPredictions(t *testing.T) {
 		}
 	})
 }
+
+func TestGroupWagerRepository_Events(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+
+	groupWagerRepo := NewGroupWagerRepository(testDB.DB)
+	userRepo := NewUserRepository(testDB.DB)
+	ctx := context.Background()
+
+	creator := testutil.CreateTestUser(111111, "creator")
+	_, err := userRepo.Create(ctx, creator.DiscordID, creator.Username, creator.Balance)
+	require.NoError(t, err)
+
+	wager := testutil.CreateTestGroupWager(creator.DiscordID, "Event log wager")
+	optionA := testutil.CreateTestGroupWagerOption(0, "Option A", 0)
+	optionB := testutil.CreateTestGroupWagerOption(0, "Option B", 1)
+	require.NoError(t, groupWagerRepo.CreateWithOptions(ctx, wager, []*entities.GroupWagerOption{optionA, optionB}))
+
+	t.Run("events are returned in the order they were recorded", func(t *testing.T) {
+		created := &entities.GroupWagerEvent{
+			GroupWagerID:   wager.ID,
+			EventType:      entities.GroupWagerEventCreated,
+			ActorDiscordID: &creator.DiscordID,
+			Payload:        map[string]any{"condition": "Event log wager"},
+		}
+		require.NoError(t, groupWagerRepo.RecordEvent(ctx, created))
+		assert.NotZero(t, created.ID)
+		assert.False(t, created.CreatedAt.IsZero())
+
+		cancelled := &entities.GroupWagerEvent{
+			GroupWagerID: wager.ID,
+			EventType:    entities.GroupWagerEventCancelled,
+		}
+		require.NoError(t, groupWagerRepo.RecordEvent(ctx, cancelled))
+
+		events, err := groupWagerRepo.GetEvents(ctx, wager.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+
+		assert.Equal(t, entities.GroupWagerEventCreated, events[0].EventType)
+		require.NotNil(t, events[0].ActorDiscordID)
+		assert.Equal(t, creator.DiscordID, *events[0].ActorDiscordID)
+		assert.Equal(t, "Event log wager", events[0].Payload["condition"])
+
+		assert.Equal(t, entities.GroupWagerEventCancelled, events[1].EventType)
+		assert.Nil(t, events[1].ActorDiscordID)
+		assert.Empty(t, events[1].Payload)
+	})
+
+	t.Run("events cannot be rewritten", func(t *testing.T) {
+		_, err := testDB.DB.Pool.Exec(ctx, "UPDATE group_wager_events SET event_type = 'resolved' WHERE group_wager_id = $1", wager.ID)
+		assert.Error(t, err)
+	})
+}
+
+// seedGroupWagerDetail creates a wager with two options and the given number of participants
+func seedGroupWagerDetail(tb testing.TB, testDB *testutil.TestDatabase, participants int) (*GroupWagerRepository, int64) {
+	tb.Helper()
+	ctx := context.Background()
+
+	groupWagerRepo := NewGroupWagerRepository(testDB.DB)
+	userRepo := NewUserRepository(testDB.DB)
+
+	wager := testutil.CreateTestGroupWager(999999, "Detail wager")
+	options := []*entities.GroupWagerOption{
+		testutil.CreateTestGroupWagerOption(0, "Option A", 0),
+		testutil.CreateTestGroupWagerOption(0, "Option B", 1),
+	}
+	require.NoError(tb, groupWagerRepo.CreateWithOptions(ctx, wager, options))
+
+	for i := 0; i < participants; i++ {
+		discordID := int64(100000 + i)
+		_, err := userRepo.Create(ctx, discordID, "participant", 100000)
+		require.NoError(tb, err)
+
+		participant := testutil.CreateTestGroupWagerParticipant(wager.ID, discordID, options[i%2].ID, 1000)
+		require.NoError(tb, groupWagerRepo.SaveParticipant(ctx, participant))
+	}
+
+	return groupWagerRepo, wager.ID
+}
+
+func TestGroupWagerRepository_GetDetailByID(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	groupWagerRepo, wagerID := seedGroupWagerDetail(t, testDB, 5)
+	ctx := context.Background()
+
+	t.Run("loads wager, options and participants", func(t *testing.T) {
+		detail, err := groupWagerRepo.GetDetailByID(ctx, wagerID)
+		require.NoError(t, err)
+		require.NotNil(t, detail)
+
+		assert.Equal(t, wagerID, detail.Wager.ID)
+		require.Len(t, detail.Options, 2)
+		assert.Equal(t, "Option A", detail.Options[0].OptionText)
+		assert.Equal(t, "Option B", detail.Options[1].OptionText)
+		assert.Len(t, detail.Participants, 5)
+	})
+
+	t.Run("locks inside a transaction", func(t *testing.T) {
+		tx, err := testDB.DB.Pool.Begin(ctx)
+		require.NoError(t, err)
+		defer tx.Rollback(ctx)
+
+		detail, err := NewGroupWagerRepositoryScoped(tx, 0).GetDetailByIDForUpdate(ctx, wagerID)
+		require.NoError(t, err)
+		require.NotNil(t, detail)
+		assert.Len(t, detail.Options, 2)
+		assert.Len(t, detail.Participants, 5)
+	})
+
+	t.Run("returns nil for a missing wager", func(t *testing.T) {
+		detail, err := groupWagerRepo.GetDetailByID(ctx, wagerID+1000)
+		require.NoError(t, err)
+		assert.Nil(t, detail)
+	})
+}
+
+func TestGroupWagerRepository_LockedMultiplierPrecision(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	groupWagerRepo, wagerID := seedGroupWagerDetail(t, testDB, 1)
+	ctx := context.Background()
+
+	detail, err := groupWagerRepo.GetDetailByID(ctx, wagerID)
+	require.NoError(t, err)
+	participant := detail.Participants[0]
+
+	// A bet locked at 1.5x topped up by one bit at 3.0x averages to more decimals than odds are posted with
+	locked := 1.5
+	participant.LockedMultiplier = &locked
+	participant.Amount = 10000
+	topUp := participant.LockedMultiplierForChange(participant.OptionID, 10001, 3.0)
+	participant.Amount = 10001
+	participant.LockedMultiplier = &topUp
+	require.NoError(t, groupWagerRepo.SaveParticipant(ctx, participant))
+
+	saved, err := groupWagerRepo.GetParticipant(ctx, wagerID, participant.DiscordID)
+	require.NoError(t, err)
+	require.NotNil(t, saved.LockedMultiplier)
+	assert.Equal(t, topUp, *saved.LockedMultiplier)
+}
+
+func TestGroupWagerRepository_UpdateAccess(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	groupWagerRepo, wagerID := seedGroupWagerDetail(t, testDB, 1)
+	ctx := context.Background()
+
+	wager, err := groupWagerRepo.GetByID(ctx, wagerID)
+	require.NoError(t, err)
+	assert.Nil(t, wager.MaxParticipants)
+	assert.False(t, wager.Access().IsInviteOnly())
+
+	limit := 8
+	role := int64(4242)
+	access := entities.GroupWagerAccess{MaxParticipants: &limit, AllowedRoleID: &role, InvitedDiscordIDs: []int64{11, 12}}
+	require.NoError(t, groupWagerRepo.UpdateAccess(ctx, wagerID, access))
+
+	detail, err := groupWagerRepo.GetDetailByID(ctx, wagerID)
+	require.NoError(t, err)
+	assert.Equal(t, access, detail.Wager.Access())
+
+	require.NoError(t, groupWagerRepo.UpdateAccess(ctx, wagerID, entities.GroupWagerAccess{}))
+	wager, err = groupWagerRepo.GetByID(ctx, wagerID)
+	require.NoError(t, err)
+	assert.Nil(t, wager.MaxParticipants)
+	assert.Nil(t, wager.AllowedRoleID)
+	assert.Empty(t, wager.InvitedDiscordIDs)
+
+	assert.Error(t, groupWagerRepo.UpdateAccess(ctx, wagerID+1000, access))
+}
+
+func TestGroupWagerRepository_UpdateImages(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	groupWagerRepo, wagerID := seedGroupWagerDetail(t, testDB, 1)
+	ctx := context.Background()
+
+	wager, err := groupWagerRepo.GetByID(ctx, wagerID)
+	require.NoError(t, err)
+	assert.Nil(t, wager.ThumbnailURL)
+	assert.Nil(t, wager.ImageURL)
+
+	thumbnail := "https://example.com/queue.png"
+	image := "https://example.com/champion.jpg"
+	wager.ThumbnailURL = &thumbnail
+	wager.ImageURL = &image
+	require.NoError(t, groupWagerRepo.Update(ctx, wager))
+
+	detail, err := groupWagerRepo.GetDetailByID(ctx, wagerID)
+	require.NoError(t, err)
+	require.NotNil(t, detail.Wager.ThumbnailURL)
+	require.NotNil(t, detail.Wager.ImageURL)
+	assert.Equal(t, thumbnail, *detail.Wager.ThumbnailURL)
+	assert.Equal(t, image, *detail.Wager.ImageURL)
+}
+
+// BenchmarkGroupWagerRepository_GetDetailByID compares the batched detail load against
+// the three sequential round trips it replaced
+func BenchmarkGroupWagerRepository_GetDetailByID(b *testing.B) {
+	testDB := testutil.SetupTestDatabase(b)
+	groupWagerRepo, wagerID := seedGroupWagerDetail(b, testDB, 20)
+	ctx := context.Background()
+
+	b.Run("batched", func(b *testing.B) {
+		for b.Loop() {
+			if _, err := groupWagerRepo.GetDetailByID(ctx, wagerID); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("sequential", func(b *testing.B) {
+		for b.Loop() {
+			if _, err := groupWagerRepo.getByID(ctx, wagerID, false); err != nil {
+				b.Fatal(err)
+			}
+			rows, err := groupWagerRepo.q.Query(ctx, groupWagerOptionsQuery(false), wagerID)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := scanGroupWagerOptions(rows); err != nil {
+				b.Fatal(err)
+			}
+			rows, err = groupWagerRepo.q.Query(ctx, groupWagerParticipantsQuery, wagerID)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := scanGroupWagerParticipants(rows); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestGroupWagerRepository_GetGuildsWithOpenExternalReference(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	ctx := context.Background()
+
+	ref := entities.ExternalReference{System: entities.SystemEsports, ID: "worlds-final"}
+
+	createLinked := func(guildID int64, state entities.GroupWagerState) {
+		repo := NewGroupWagerRepositoryScoped(testDB.DB.Pool, guildID)
+		wager := testutil.CreateTestGroupWager(111111, "Who wins the final?")
+		wager.State = state
+		wager.ExternalRef = &ref
+		options := []*entities.GroupWagerOption{
+			testutil.CreateTestGroupWagerOption(0, "Team A", 0),
+			testutil.CreateTestGroupWagerOption(0, "Team B", 1),
+		}
+		require.NoError(t, repo.CreateWithOptions(ctx, wager, options))
+	}
+
+	createLinked(1001, entities.GroupWagerStateActive)
+	createLinked(1002, entities.GroupWagerStatePendingResolution)
+	createLinked(1003, entities.GroupWagerStateCancelled)
+
+	repo := NewGroupWagerRepository(testDB.DB)
+
+	guildIDs, err := repo.GetGuildsWithOpenExternalReference(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1001, 1002}, guildIDs)
+
+	guildIDs, err = repo.GetGuildsWithOpenExternalReference(ctx, entities.ExternalReference{System: entities.SystemEsports, ID: "other"})
+	require.NoError(t, err)
+	assert.Empty(t, guildIDs)
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+)
+
+// GuildFeatureFlagRepository implements guild feature flag data access
+type GuildFeatureFlagRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewGuildFeatureFlagRepositoryScoped creates a new guild feature flag repository with guild scope
+func NewGuildFeatureFlagRepositoryScoped(tx Queryable, guildID int64) *GuildFeatureFlagRepository {
+	return &GuildFeatureFlagRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// GetAll returns the features the current guild has explicitly turned on or off
+func (r *GuildFeatureFlagRepository) GetAll(ctx context.Context) ([]*entities.GuildFeatureFlag, error) {
+	query := `
+		SELECT guild_id, feature, enabled, updated_by, updated_at
+		FROM guild_feature_flags
+		WHERE guild_id = $1
+		ORDER BY feature
+	`
+
+	rows, err := r.q.Query(ctx, query, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild feature flags: %w", err)
+	}
+	defer rows.Close()
+
+	var flags []*entities.GuildFeatureFlag
+	for rows.Next() {
+		var flag entities.GuildFeatureFlag
+		err := rows.Scan(
+			&flag.GuildID,
+			&flag.Feature,
+			&flag.Enabled,
+			&flag.UpdatedBy,
+			&flag.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan guild feature flag: %w", err)
+		}
+		flags = append(flags, &flag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating guild feature flag rows: %w", err)
+	}
+
+	return flags, nil
+}
+
+// Set stores whether a feature is enabled in the current guild, replacing any earlier override
+func (r *GuildFeatureFlagRepository) Set(ctx context.Context, flag *entities.GuildFeatureFlag) error {
+	if flag.GuildID != r.guildID {
+		return fmt.Errorf("guild ID mismatch: feature flag has %d, repository scoped to %d", flag.GuildID, r.guildID)
+	}
+
+	query := `
+		INSERT INTO guild_feature_flags (guild_id, feature, enabled, updated_by, updated_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (guild_id, feature) DO UPDATE
+		SET enabled = EXCLUDED.enabled, updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`
+
+	err := r.q.QueryRow(ctx, query,
+		flag.GuildID,
+		flag.Feature,
+		flag.Enabled,
+		flag.UpdatedBy,
+	).Scan(&flag.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set guild feature flag: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+)
+
+// GuildResolverRepository implements guild resolver data access
+type GuildResolverRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewGuildResolverRepositoryScoped creates a new guild resolver repository with guild scope
+func NewGuildResolverRepositoryScoped(tx Queryable, guildID int64) *GuildResolverRepository {
+	return &GuildResolverRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// GetAll returns the current guild's resolver grants, users before roles
+func (r *GuildResolverRepository) GetAll(ctx context.Context) ([]*entities.GuildResolver, error) {
+	query := `
+		SELECT id, guild_id, resolver_type, target_id, added_by, created_at
+		FROM guild_resolvers
+		WHERE guild_id = $1
+		ORDER BY resolver_type DESC, created_at, id
+	`
+
+	rows, err := r.q.Query(ctx, query, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild resolvers: %w", err)
+	}
+	defer rows.Close()
+
+	var resolvers []*entities.GuildResolver
+	for rows.Next() {
+		var resolver entities.GuildResolver
+		err := rows.Scan(
+			&resolver.ID,
+			&resolver.GuildID,
+			&resolver.ResolverType,
+			&resolver.TargetID,
+			&resolver.AddedBy,
+			&resolver.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan guild resolver: %w", err)
+		}
+		resolvers = append(resolvers, &resolver)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating guild resolver rows: %w", err)
+	}
+
+	return resolvers, nil
+}
+
+// Add grants resolver permission in the current guild. Returns false if the grant already existed.
+func (r *GuildResolverRepository) Add(ctx context.Context, resolver *entities.GuildResolver) (bool, error) {
+	if resolver.GuildID != r.guildID {
+		return false, fmt.Errorf("guild ID mismatch: resolver has %d, repository scoped to %d", resolver.GuildID, r.guildID)
+	}
+
+	query := `
+		INSERT INTO guild_resolvers (guild_id, resolver_type, target_id, added_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (guild_id, resolver_type, target_id) DO NOTHING
+	`
+
+	result, err := r.q.Exec(ctx, query,
+		resolver.GuildID,
+		resolver.ResolverType,
+		resolver.TargetID,
+		resolver.AddedBy,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to add guild resolver: %w", err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// Remove revokes a resolver grant in the current guild. Returns false if there was no such grant.
+func (r *GuildResolverRepository) Remove(ctx context.Context, resolverType entities.GuildResolverType, targetID int64) (bool, error) {
+	query := `
+		DELETE FROM guild_resolvers
+		WHERE guild_id = $1 AND resolver_type = $2 AND target_id = $3
+	`
+
+	result, err := r.q.Exec(ctx, query, r.guildID, resolverType, targetID)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove guild resolver: %w", err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
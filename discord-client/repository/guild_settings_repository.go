@@ -29,7 +29,14 @@ func (r *GuildSettingsRepository) GetOrCreateGuildSettings(ctx context.Context,
 	// First try to get existing settings
 	query := `
 		SELECT guild_id, primary_channel_id, lol_channel_id, high_roller_role_id, wordle_channel_id, tft_channel_id,
-		       high_roller_tracking_start_time, lotto_channel_id, lotto_ticket_cost, lotto_difficulty
+		       high_roller_tracking_start_time, lotto_channel_id, lotto_ticket_cost, lotto_difficulty,
+		       audit_channel_id, audit_threshold, curfew_start_hour, curfew_end_hour, rules_text,
+		       savings_bonus_percent, stuck_wager_reminder_hours, stuck_wager_cancel_hours, house_option_cap, lotto_rollover_cap,
+		       starting_balance, welcome_new_members, rate_limit_per_minute, rate_limit_burst, tft_placement_layout,
+		       block_own_game_bets, digest_channel_id, snipe_window_minutes, snipe_extension_minutes, min_balance_floor,
+		       season_token_name, season_token_rate, current_season, default_voting_period_minutes, pot_tax_percent,
+		       group_wager_bet_mode, share_global_leaderboard, min_bet_amount, lol_non_ranked_wagers, curfew_timezone,
+		       auto_assign_resolvers
 		FROM guild_settings
 		WHERE guild_id = $1
 	`
@@ -46,6 +53,37 @@ func (r *GuildSettingsRepository) GetOrCreateGuildSettings(ctx context.Context,
 		&settings.LottoChannelID,
 		&settings.LottoTicketCost,
 		&settings.LottoDifficulty,
+		&settings.AuditChannelID,
+		&settings.AuditThreshold,
+		&settings.CurfewStartHour,
+		&settings.CurfewEndHour,
+		&settings.RulesText,
+		&settings.SavingsBonusPercent,
+		&settings.StuckWagerReminderHours,
+		&settings.StuckWagerCancelHours,
+		&settings.HouseOptionCap,
+		&settings.LottoRolloverCap,
+		&settings.StartingBalance,
+		&settings.WelcomeNewMembers,
+		&settings.RateLimitPerMinute,
+		&settings.RateLimitBurst,
+		&settings.TftPlacementLayout,
+		&settings.BlockOwnGameBets,
+		&settings.DigestChannelID,
+		&settings.SnipeWindowMinutes,
+		&settings.SnipeExtensionMinutes,
+		&settings.MinBalanceFloor,
+		&settings.SeasonTokenName,
+		&settings.SeasonTokenRate,
+		&settings.CurrentSeason,
+		&settings.DefaultVotingPeriodMinutes,
+		&settings.PotTaxPercent,
+		&settings.GroupWagerBetMode,
+		&settings.ShareGlobalLeaderboard,
+		&settings.MinBetAmount,
+		&settings.LolNonRankedWagers,
+		&settings.CurfewTimezone,
+		&settings.AutoAssignResolvers,
 	)
 
 	if err == nil {
@@ -59,10 +97,24 @@ func (r *GuildSettingsRepository) GetOrCreateGuildSettings(ctx context.Context,
 	// If not found, create default settings
 	insertQuery := `
 		INSERT INTO guild_settings (guild_id, primary_channel_id, lol_channel_id, high_roller_role_id, wordle_channel_id, tft_channel_id,
-		                            high_roller_tracking_start_time, lotto_channel_id, lotto_ticket_cost, lotto_difficulty)
-		VALUES ($1, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL)
+		                            high_roller_tracking_start_time, lotto_channel_id, lotto_ticket_cost, lotto_difficulty,
+		                            audit_channel_id, audit_threshold, curfew_start_hour, curfew_end_hour, rules_text,
+		                            savings_bonus_percent, stuck_wager_reminder_hours, stuck_wager_cancel_hours, house_option_cap, lotto_rollover_cap,
+		                            starting_balance, welcome_new_members, rate_limit_per_minute, rate_limit_burst, tft_placement_layout,
+		                            block_own_game_bets, digest_channel_id, snipe_window_minutes, snipe_extension_minutes, min_balance_floor,
+		                            season_token_name, season_token_rate, current_season, default_voting_period_minutes, pot_tax_percent,
+		                            group_wager_bet_mode, share_global_leaderboard, min_bet_amount, lol_non_ranked_wagers, curfew_timezone,
+		                            auto_assign_resolvers)
+		VALUES ($1, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, FALSE, NULL, NULL, NULL, TRUE, NULL, NULL, NULL, NULL, NULL, NULL, 1, NULL, NULL, NULL, FALSE, NULL, FALSE, NULL, FALSE)
 		RETURNING guild_id, primary_channel_id, lol_channel_id, high_roller_role_id, wordle_channel_id, tft_channel_id,
-		          high_roller_tracking_start_time, lotto_channel_id, lotto_ticket_cost, lotto_difficulty
+		          high_roller_tracking_start_time, lotto_channel_id, lotto_ticket_cost, lotto_difficulty,
+		          audit_channel_id, audit_threshold, curfew_start_hour, curfew_end_hour, rules_text,
+		          savings_bonus_percent, stuck_wager_reminder_hours, stuck_wager_cancel_hours, house_option_cap, lotto_rollover_cap,
+		          starting_balance, welcome_new_members, rate_limit_per_minute, rate_limit_burst, tft_placement_layout,
+		          block_own_game_bets, digest_channel_id, snipe_window_minutes, snipe_extension_minutes, min_balance_floor,
+		          season_token_name, season_token_rate, current_season, default_voting_period_minutes, pot_tax_percent,
+		          group_wager_bet_mode, share_global_leaderboard, min_bet_amount, lol_non_ranked_wagers, curfew_timezone,
+		          auto_assign_resolvers
 	`
 
 	err = r.q.QueryRow(ctx, insertQuery, guildID).Scan(
@@ -76,6 +128,37 @@ func (r *GuildSettingsRepository) GetOrCreateGuildSettings(ctx context.Context,
 		&settings.LottoChannelID,
 		&settings.LottoTicketCost,
 		&settings.LottoDifficulty,
+		&settings.AuditChannelID,
+		&settings.AuditThreshold,
+		&settings.CurfewStartHour,
+		&settings.CurfewEndHour,
+		&settings.RulesText,
+		&settings.SavingsBonusPercent,
+		&settings.StuckWagerReminderHours,
+		&settings.StuckWagerCancelHours,
+		&settings.HouseOptionCap,
+		&settings.LottoRolloverCap,
+		&settings.StartingBalance,
+		&settings.WelcomeNewMembers,
+		&settings.RateLimitPerMinute,
+		&settings.RateLimitBurst,
+		&settings.TftPlacementLayout,
+		&settings.BlockOwnGameBets,
+		&settings.DigestChannelID,
+		&settings.SnipeWindowMinutes,
+		&settings.SnipeExtensionMinutes,
+		&settings.MinBalanceFloor,
+		&settings.SeasonTokenName,
+		&settings.SeasonTokenRate,
+		&settings.CurrentSeason,
+		&settings.DefaultVotingPeriodMinutes,
+		&settings.PotTaxPercent,
+		&settings.GroupWagerBetMode,
+		&settings.ShareGlobalLeaderboard,
+		&settings.MinBetAmount,
+		&settings.LolNonRankedWagers,
+		&settings.CurfewTimezone,
+		&settings.AutoAssignResolvers,
 	)
 
 	if err != nil {
@@ -97,7 +180,38 @@ func (r *GuildSettingsRepository) UpdateGuildSettings(ctx context.Context, setti
 		    high_roller_tracking_start_time = $7,
 		    lotto_channel_id = $8,
 		    lotto_ticket_cost = $9,
-		    lotto_difficulty = $10
+		    lotto_difficulty = $10,
+		    audit_channel_id = $11,
+		    audit_threshold = $12,
+		    curfew_start_hour = $13,
+		    curfew_end_hour = $14,
+		    rules_text = $15,
+		    savings_bonus_percent = $16,
+		    stuck_wager_reminder_hours = $17,
+		    stuck_wager_cancel_hours = $18,
+		    house_option_cap = $19,
+		    lotto_rollover_cap = $20,
+		    starting_balance = $21,
+		    welcome_new_members = $22,
+		    rate_limit_per_minute = $23,
+		    rate_limit_burst = $24,
+		    tft_placement_layout = $25,
+		    block_own_game_bets = $26,
+		    digest_channel_id = $27,
+		    snipe_window_minutes = $28,
+		    snipe_extension_minutes = $29,
+		    min_balance_floor = $30,
+		    season_token_name = $31,
+		    season_token_rate = $32,
+		    current_season = $33,
+		    default_voting_period_minutes = $34,
+		    pot_tax_percent = $35,
+		    group_wager_bet_mode = $36,
+		    share_global_leaderboard = $37,
+		    min_bet_amount = $38,
+		    lol_non_ranked_wagers = $39,
+		    curfew_timezone = $40,
+		    auto_assign_resolvers = $41
 		WHERE guild_id = $1
 	`
 
@@ -112,6 +226,37 @@ func (r *GuildSettingsRepository) UpdateGuildSettings(ctx context.Context, setti
 		settings.LottoChannelID,
 		settings.LottoTicketCost,
 		settings.LottoDifficulty,
+		settings.AuditChannelID,
+		settings.AuditThreshold,
+		settings.CurfewStartHour,
+		settings.CurfewEndHour,
+		settings.RulesText,
+		settings.SavingsBonusPercent,
+		settings.StuckWagerReminderHours,
+		settings.StuckWagerCancelHours,
+		settings.HouseOptionCap,
+		settings.LottoRolloverCap,
+		settings.StartingBalance,
+		settings.WelcomeNewMembers,
+		settings.RateLimitPerMinute,
+		settings.RateLimitBurst,
+		settings.TftPlacementLayout,
+		settings.BlockOwnGameBets,
+		settings.DigestChannelID,
+		settings.SnipeWindowMinutes,
+		settings.SnipeExtensionMinutes,
+		settings.MinBalanceFloor,
+		settings.SeasonTokenName,
+		settings.SeasonTokenRate,
+		settings.CurrentSeason,
+		settings.DefaultVotingPeriodMinutes,
+		settings.PotTaxPercent,
+		settings.GroupWagerBetMode,
+		settings.ShareGlobalLeaderboard,
+		settings.MinBetAmount,
+		settings.LolNonRankedWagers,
+		settings.CurfewTimezone,
+		settings.AutoAssignResolvers,
 	)
 
 	if err != nil {
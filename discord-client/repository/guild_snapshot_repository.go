@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// GuildSnapshotRepository implements guild economy snapshot data access
+type GuildSnapshotRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewGuildSnapshotRepositoryScoped creates a new guild snapshot repository with guild scope
+func NewGuildSnapshotRepositoryScoped(tx Queryable, guildID int64) *GuildSnapshotRepository {
+	return &GuildSnapshotRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// Create stores a snapshot of the current guild
+func (r *GuildSnapshotRepository) Create(ctx context.Context, snapshot *entities.GuildSnapshot) error {
+	if snapshot.GuildID != r.guildID {
+		return fmt.Errorf("guild ID mismatch: snapshot has %d, repository scoped to %d", snapshot.GuildID, r.guildID)
+	}
+
+	dataJSON, err := json.Marshal(snapshot.Data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot data: %w", err)
+	}
+
+	query := `
+		INSERT INTO guild_snapshots (guild_id, reason, data)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`
+
+	err = r.q.QueryRow(ctx, query, snapshot.GuildID, snapshot.Reason, dataJSON).Scan(&snapshot.ID, &snapshot.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create guild snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a snapshot in the current guild, returning nil if it does not exist
+func (r *GuildSnapshotRepository) GetByID(ctx context.Context, id int64) (*entities.GuildSnapshot, error) {
+	query := `
+		SELECT id, guild_id, reason, data, created_at
+		FROM guild_snapshots
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	snapshot, err := scanGuildSnapshot(r.q.QueryRow(ctx, query, id, r.guildID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild snapshot by ID %d: %w", id, err)
+	}
+
+	return snapshot, nil
+}
+
+// GetRecent returns the guild's most recent snapshots, newest first
+func (r *GuildSnapshotRepository) GetRecent(ctx context.Context, limit int) ([]*entities.GuildSnapshot, error) {
+	query := `
+		SELECT id, guild_id, reason, data, created_at
+		FROM guild_snapshots
+		WHERE guild_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`
+
+	rows, err := r.q.Query(ctx, query, r.guildID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent guild snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	var snapshots []*entities.GuildSnapshot
+	for rows.Next() {
+		snapshot, err := scanGuildSnapshot(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan guild snapshot: %w", err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating guild snapshot rows: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+// scanGuildSnapshot scans a snapshot row and decodes its data
+func scanGuildSnapshot(row pgx.Row) (*entities.GuildSnapshot, error) {
+	var snapshot entities.GuildSnapshot
+	var dataJSON []byte
+	if err := row.Scan(&snapshot.ID, &snapshot.GuildID, &snapshot.Reason, &dataJSON, &snapshot.CreatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(dataJSON, &snapshot.Data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot data: %w", err)
+	}
+	return &snapshot, nil
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const guildWebhookColumns = `id, guild_id, url, secret, event_types, created_by, created_at`
+
+// GuildWebhookRepository implements outbound webhook data access
+type GuildWebhookRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewGuildWebhookRepositoryScoped creates a new guild webhook repository with guild scope
+func NewGuildWebhookRepositoryScoped(tx Queryable, guildID int64) *GuildWebhookRepository {
+	return &GuildWebhookRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// Create registers a webhook in the current guild
+func (r *GuildWebhookRepository) Create(ctx context.Context, webhook *entities.GuildWebhook) error {
+	if webhook.GuildID != r.guildID {
+		return fmt.Errorf("guild ID mismatch: webhook has %d, repository scoped to %d", webhook.GuildID, r.guildID)
+	}
+
+	eventTypes := make([]string, len(webhook.EventTypes))
+	for i, eventType := range webhook.EventTypes {
+		eventTypes[i] = string(eventType)
+	}
+
+	query := `
+		INSERT INTO guild_webhooks (guild_id, url, secret, event_types, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+
+	err := r.q.QueryRow(ctx, query,
+		webhook.GuildID,
+		webhook.URL,
+		webhook.Secret,
+		eventTypes,
+		webhook.CreatedBy,
+	).Scan(&webhook.ID, &webhook.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create guild webhook: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID returns a webhook in the current guild, returning nil if it does not exist
+func (r *GuildWebhookRepository) GetByID(ctx context.Context, id int64) (*entities.GuildWebhook, error) {
+	query := `
+		SELECT ` + guildWebhookColumns + `
+		FROM guild_webhooks
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	webhook, err := scanGuildWebhook(r.q.QueryRow(ctx, query, id, r.guildID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild webhook by ID %d: %w", id, err)
+	}
+
+	return webhook, nil
+}
+
+// GetAll returns the current guild's webhooks, oldest first
+func (r *GuildWebhookRepository) GetAll(ctx context.Context) ([]*entities.GuildWebhook, error) {
+	query := `
+		SELECT ` + guildWebhookColumns + `
+		FROM guild_webhooks
+		WHERE guild_id = $1
+		ORDER BY id ASC
+	`
+
+	rows, err := r.q.Query(ctx, query, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	return collectGuildWebhooks(rows)
+}
+
+// GetSubscribed returns the current guild's webhooks subscribed to the event type
+func (r *GuildWebhookRepository) GetSubscribed(ctx context.Context, eventType entities.WebhookEventType) ([]*entities.GuildWebhook, error) {
+	query := `
+		SELECT ` + guildWebhookColumns + `
+		FROM guild_webhooks
+		WHERE guild_id = $1 AND $2 = ANY(event_types)
+		ORDER BY id ASC
+	`
+
+	rows, err := r.q.Query(ctx, query, r.guildID, string(eventType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild webhooks subscribed to %s: %w", eventType, err)
+	}
+	defer rows.Close()
+
+	return collectGuildWebhooks(rows)
+}
+
+// Delete removes a webhook and its deliveries from the current guild. Returns false if there was no such webhook.
+func (r *GuildWebhookRepository) Delete(ctx context.Context, id int64) (bool, error) {
+	query := `
+		DELETE FROM guild_webhooks
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	result, err := r.q.Exec(ctx, query, id, r.guildID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete guild webhook %d: %w", id, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// scanGuildWebhook scans a single guild webhook row
+func scanGuildWebhook(row pgx.Row) (*entities.GuildWebhook, error) {
+	var webhook entities.GuildWebhook
+	var eventTypes []string
+	err := row.Scan(
+		&webhook.ID,
+		&webhook.GuildID,
+		&webhook.URL,
+		&webhook.Secret,
+		&eventTypes,
+		&webhook.CreatedBy,
+		&webhook.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.EventTypes = make([]entities.WebhookEventType, len(eventTypes))
+	for i, eventType := range eventTypes {
+		webhook.EventTypes[i] = entities.WebhookEventType(eventType)
+	}
+
+	return &webhook, nil
+}
+
+// collectGuildWebhooks scans every guild webhook row
+func collectGuildWebhooks(rows pgx.Rows) ([]*entities.GuildWebhook, error) {
+	var webhooks []*entities.GuildWebhook
+	for rows.Next() {
+		webhook, err := scanGuildWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan guild webhook: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating guild webhook rows: %w", err)
+	}
+
+	return webhooks, nil
+}
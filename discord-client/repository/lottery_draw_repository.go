@@ -40,7 +40,7 @@ func (r *LotteryDrawRepository) GetOrCreateCurrentDraw(ctx context.Context, guil
 		INSERT INTO lottery_draws (guild_id, difficulty, ticket_cost, draw_time, total_pot)
 		VALUES ($1, $2, $3, $4, 0)
 		RETURNING id, guild_id, difficulty, ticket_cost, winning_number, draw_time,
-		          total_pot, completed_at, message_id, channel_id, created_at
+		          total_pot, tax_pot, completed_at, message_id, channel_id, created_at
 	`
 
 	var newDraw entities.LotteryDraw
@@ -52,6 +52,7 @@ func (r *LotteryDrawRepository) GetOrCreateCurrentDraw(ctx context.Context, guil
 		&newDraw.WinningNumber,
 		&newDraw.DrawTime,
 		&newDraw.TotalPot,
+		&newDraw.TaxPot,
 		&newDraw.CompletedAt,
 		&newDraw.MessageID,
 		&newDraw.ChannelID,
@@ -68,7 +69,7 @@ func (r *LotteryDrawRepository) GetOrCreateCurrentDraw(ctx context.Context, guil
 func (r *LotteryDrawRepository) GetByID(ctx context.Context, id int64) (*entities.LotteryDraw, error) {
 	query := `
 		SELECT id, guild_id, difficulty, ticket_cost, winning_number, draw_time,
-		       total_pot, completed_at, message_id, channel_id, created_at
+		       total_pot, tax_pot, completed_at, message_id, channel_id, created_at
 		FROM lottery_draws
 		WHERE id = $1
 	`
@@ -82,6 +83,7 @@ func (r *LotteryDrawRepository) GetByID(ctx context.Context, id int64) (*entitie
 		&draw.WinningNumber,
 		&draw.DrawTime,
 		&draw.TotalPot,
+		&draw.TaxPot,
 		&draw.CompletedAt,
 		&draw.MessageID,
 		&draw.ChannelID,
@@ -102,7 +104,7 @@ func (r *LotteryDrawRepository) GetByID(ctx context.Context, id int64) (*entitie
 func (r *LotteryDrawRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.LotteryDraw, error) {
 	query := `
 		SELECT id, guild_id, difficulty, ticket_cost, winning_number, draw_time,
-		       total_pot, completed_at, message_id, channel_id, created_at
+		       total_pot, tax_pot, completed_at, message_id, channel_id, created_at
 		FROM lottery_draws
 		WHERE id = $1
 		FOR UPDATE
@@ -117,6 +119,7 @@ func (r *LotteryDrawRepository) GetByIDForUpdate(ctx context.Context, id int64)
 		&draw.WinningNumber,
 		&draw.DrawTime,
 		&draw.TotalPot,
+		&draw.TaxPot,
 		&draw.CompletedAt,
 		&draw.MessageID,
 		&draw.ChannelID,
@@ -133,6 +136,21 @@ func (r *LotteryDrawRepository) GetByIDForUpdate(ctx context.Context, id int64)
 	return &draw, nil
 }
 
+// TryLockDraw takes a transaction-scoped advisory lock on the draw without waiting.
+// Returns false if another transaction, possibly on another instance, already holds it.
+// The lock is released when the transaction commits or rolls back. Draws are locked on their
+// full bigint ID, and single bigint advisory keys are reserved for draws, so two draws never
+// share a lock.
+func (r *LotteryDrawRepository) TryLockDraw(ctx context.Context, drawID int64) (bool, error) {
+	var acquired bool
+	err := r.q.QueryRow(ctx, "SELECT pg_try_advisory_xact_lock($1::bigint)", drawID).Scan(&acquired)
+	if err != nil {
+		return false, fmt.Errorf("failed to lock lottery draw %d: %w", drawID, err)
+	}
+
+	return acquired, nil
+}
+
 // Update updates a draw record
 func (r *LotteryDrawRepository) Update(ctx context.Context, draw *entities.LotteryDraw) error {
 	query := `
@@ -141,7 +159,8 @@ func (r *LotteryDrawRepository) Update(ctx context.Context, draw *entities.Lotte
 		    total_pot = $3,
 		    completed_at = $4,
 		    message_id = $5,
-		    channel_id = $6
+		    channel_id = $6,
+		    tax_pot = $7
 		WHERE id = $1
 	`
 
@@ -152,6 +171,7 @@ func (r *LotteryDrawRepository) Update(ctx context.Context, draw *entities.Lotte
 		draw.CompletedAt,
 		draw.MessageID,
 		draw.ChannelID,
+		draw.TaxPot,
 	)
 
 	if err != nil {
@@ -169,7 +189,7 @@ func (r *LotteryDrawRepository) Update(ctx context.Context, draw *entities.Lotte
 func (r *LotteryDrawRepository) GetPendingDrawsForTime(ctx context.Context, beforeTime time.Time) ([]*entities.LotteryDraw, error) {
 	query := `
 		SELECT id, guild_id, difficulty, ticket_cost, winning_number, draw_time,
-		       total_pot, completed_at, message_id, channel_id, created_at
+		       total_pot, tax_pot, completed_at, message_id, channel_id, created_at
 		FROM lottery_draws
 		WHERE completed_at IS NULL
 		  AND draw_time <= $1
@@ -193,6 +213,7 @@ func (r *LotteryDrawRepository) GetPendingDrawsForTime(ctx context.Context, befo
 			&draw.WinningNumber,
 			&draw.DrawTime,
 			&draw.TotalPot,
+			&draw.TaxPot,
 			&draw.CompletedAt,
 			&draw.MessageID,
 			&draw.ChannelID,
@@ -232,11 +253,50 @@ func (r *LotteryDrawRepository) IncrementPot(ctx context.Context, drawID, amount
 	return nil
 }
 
+// AddPotTax adds a group wager's pot tax to an open draw's pot. Returns false without adding it if the draw
+// has already been completed.
+func (r *LotteryDrawRepository) AddPotTax(ctx context.Context, drawID, amount int64) (bool, error) {
+	query := `
+		UPDATE lottery_draws
+		SET total_pot = total_pot + $2,
+		    tax_pot = tax_pot + $2
+		WHERE id = $1
+		  AND completed_at IS NULL
+	`
+
+	result, err := r.q.Exec(ctx, query, drawID, amount)
+	if err != nil {
+		return false, fmt.Errorf("failed to add pot tax to draw %d: %w", drawID, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// RemovePotTax takes a refunded pot tax back out of an open draw's pot. Returns false without removing it
+// if the draw has already been completed.
+func (r *LotteryDrawRepository) RemovePotTax(ctx context.Context, drawID, amount int64) (bool, error) {
+	query := `
+		UPDATE lottery_draws
+		SET total_pot = total_pot - $2,
+		    tax_pot = tax_pot - $2
+		WHERE id = $1
+		  AND completed_at IS NULL
+		  AND tax_pot >= $2
+	`
+
+	result, err := r.q.Exec(ctx, query, drawID, amount)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove pot tax from draw %d: %w", drawID, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
 // GetCurrentOpenDraw returns the current open draw for a guild if one exists
 func (r *LotteryDrawRepository) GetCurrentOpenDraw(ctx context.Context, guildID int64) (*entities.LotteryDraw, error) {
 	query := `
 		SELECT id, guild_id, difficulty, ticket_cost, winning_number, draw_time,
-		       total_pot, completed_at, message_id, channel_id, created_at
+		       total_pot, tax_pot, completed_at, message_id, channel_id, created_at
 		FROM lottery_draws
 		WHERE guild_id = $1
 		  AND completed_at IS NULL
@@ -253,6 +313,7 @@ func (r *LotteryDrawRepository) GetCurrentOpenDraw(ctx context.Context, guildID
 		&draw.WinningNumber,
 		&draw.DrawTime,
 		&draw.TotalPot,
+		&draw.TaxPot,
 		&draw.CompletedAt,
 		&draw.MessageID,
 		&draw.ChannelID,
@@ -269,6 +330,54 @@ func (r *LotteryDrawRepository) GetCurrentOpenDraw(ctx context.Context, guildID
 	return &draw, nil
 }
 
+// GetOpenDrawsWithoutMessage returns open draws across all guilds that have not been posted to Discord yet
+func (r *LotteryDrawRepository) GetOpenDrawsWithoutMessage(ctx context.Context, afterTime time.Time) ([]*entities.LotteryDraw, error) {
+	query := `
+		SELECT id, guild_id, difficulty, ticket_cost, winning_number, draw_time,
+		       total_pot, tax_pot, completed_at, message_id, channel_id, created_at
+		FROM lottery_draws
+		WHERE completed_at IS NULL
+		  AND message_id IS NULL
+		  AND draw_time > $1
+		ORDER BY draw_time ASC
+	`
+
+	rows, err := r.q.Query(ctx, query, afterTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unposted lottery draws: %w", err)
+	}
+	defer rows.Close()
+
+	var draws []*entities.LotteryDraw
+	for rows.Next() {
+		var draw entities.LotteryDraw
+		err := rows.Scan(
+			&draw.ID,
+			&draw.GuildID,
+			&draw.Difficulty,
+			&draw.TicketCost,
+			&draw.WinningNumber,
+			&draw.DrawTime,
+			&draw.TotalPot,
+			&draw.TaxPot,
+			&draw.CompletedAt,
+			&draw.MessageID,
+			&draw.ChannelID,
+			&draw.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan lottery draw: %w", err)
+		}
+		draws = append(draws, &draw)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate lottery draws: %w", err)
+	}
+
+	return draws, nil
+}
+
 // GetNextPendingDrawTime returns the earliest draw_time of pending draws
 func (r *LotteryDrawRepository) GetNextPendingDrawTime(ctx context.Context) (*time.Time, error) {
 	query := `
@@ -285,3 +394,204 @@ func (r *LotteryDrawRepository) GetNextPendingDrawTime(ctx context.Context) (*ti
 
 	return drawTime, nil
 }
+
+// GetRecentCompletedDraws returns the most recently completed draws for a guild
+func (r *LotteryDrawRepository) GetRecentCompletedDraws(ctx context.Context, guildID int64, limit int) ([]*entities.LotteryDraw, error) {
+	query := `
+		SELECT id, guild_id, difficulty, ticket_cost, winning_number, draw_time,
+		       total_pot, tax_pot, completed_at, message_id, channel_id, created_at
+		FROM lottery_draws
+		WHERE guild_id = $1
+		  AND completed_at IS NOT NULL
+		  AND winning_number IS NOT NULL
+		ORDER BY completed_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.q.Query(ctx, query, guildID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent completed draws for guild %d: %w", guildID, err)
+	}
+	defer rows.Close()
+
+	var draws []*entities.LotteryDraw
+	for rows.Next() {
+		var draw entities.LotteryDraw
+		err := rows.Scan(
+			&draw.ID,
+			&draw.GuildID,
+			&draw.Difficulty,
+			&draw.TicketCost,
+			&draw.WinningNumber,
+			&draw.DrawTime,
+			&draw.TotalPot,
+			&draw.TaxPot,
+			&draw.CompletedAt,
+			&draw.MessageID,
+			&draw.ChannelID,
+			&draw.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan lottery draw: %w", err)
+		}
+		draws = append(draws, &draw)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate lottery draws: %w", err)
+	}
+
+	return draws, nil
+}
+
+// StreamCompletedDrawResults calls fn for every draw in a guild completed within a date range, oldest first
+func (r *LotteryDrawRepository) StreamCompletedDrawResults(ctx context.Context, guildID int64, from, to time.Time, fn func(*entities.LotteryDrawResult) error) error {
+	query := `
+		SELECT d.id, d.completed_at, d.winning_number, d.difficulty, d.ticket_cost, d.total_pot,
+		       (SELECT COUNT(*) FROM lottery_tickets t WHERE t.draw_id = d.id) AS tickets_sold,
+		       (SELECT COUNT(*) FROM lottery_winners w WHERE w.draw_id = d.id) AS winner_count,
+		       (SELECT COALESCE(SUM(w.winning_amount), 0) FROM lottery_winners w WHERE w.draw_id = d.id) AS total_paid_out
+		FROM lottery_draws d
+		WHERE d.guild_id = $1
+		  AND d.completed_at >= $2
+		  AND d.completed_at < $3
+		  AND d.winning_number IS NOT NULL
+		ORDER BY d.completed_at, d.id
+	`
+
+	rows, err := r.q.Query(ctx, query, guildID, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to stream completed draws for guild %d: %w", guildID, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var result entities.LotteryDrawResult
+		err := rows.Scan(
+			&result.DrawID,
+			&result.CompletedAt,
+			&result.WinningNumber,
+			&result.Difficulty,
+			&result.TicketCost,
+			&result.TotalPot,
+			&result.TicketsSold,
+			&result.WinnerCount,
+			&result.TotalPaidOut,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan lottery draw result: %w", err)
+		}
+
+		if err := fn(&result); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate lottery draw results: %w", err)
+	}
+
+	return nil
+}
+
+// GetCompletedDrawResults returns a page of a guild's completed draws, newest first
+func (r *LotteryDrawRepository) GetCompletedDrawResults(ctx context.Context, guildID int64, limit, offset int) ([]*entities.LotteryDrawResult, error) {
+	query := `
+		SELECT d.id, d.completed_at, d.winning_number, d.difficulty, d.ticket_cost, d.total_pot,
+		       (SELECT COUNT(*) FROM lottery_tickets t WHERE t.draw_id = d.id) AS tickets_sold,
+		       (SELECT COUNT(*) FROM lottery_winners w WHERE w.draw_id = d.id) AS winner_count,
+		       (SELECT COALESCE(SUM(w.winning_amount), 0) FROM lottery_winners w WHERE w.draw_id = d.id) AS total_paid_out
+		FROM lottery_draws d
+		WHERE d.guild_id = $1
+		  AND d.completed_at IS NOT NULL
+		  AND d.winning_number IS NOT NULL
+		ORDER BY d.completed_at DESC, d.id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.q.Query(ctx, query, guildID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get completed draws for guild %d: %w", guildID, err)
+	}
+	defer rows.Close()
+
+	var results []*entities.LotteryDrawResult
+	for rows.Next() {
+		var result entities.LotteryDrawResult
+		err := rows.Scan(
+			&result.DrawID,
+			&result.CompletedAt,
+			&result.WinningNumber,
+			&result.Difficulty,
+			&result.TicketCost,
+			&result.TotalPot,
+			&result.TicketsSold,
+			&result.WinnerCount,
+			&result.TotalPaidOut,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan lottery draw result: %w", err)
+		}
+		results = append(results, &result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate lottery draw results: %w", err)
+	}
+
+	return results, nil
+}
+
+// CountCompletedDraws returns how many draws a guild has completed
+func (r *LotteryDrawRepository) CountCompletedDraws(ctx context.Context, guildID int64) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM lottery_draws
+		WHERE guild_id = $1
+		  AND completed_at IS NOT NULL
+		  AND winning_number IS NOT NULL
+	`
+
+	var count int64
+	if err := r.q.QueryRow(ctx, query, guildID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count completed draws for guild %d: %w", guildID, err)
+	}
+
+	return count, nil
+}
+
+// GetWinningNumberFrequency returns how often each number has won for a guild at the given difficulty.
+// Results are ordered from most to least frequent, most recently drawn first on ties.
+func (r *LotteryDrawRepository) GetWinningNumberFrequency(ctx context.Context, guildID, difficulty int64) ([]*entities.LotteryNumberFrequency, error) {
+	query := `
+		SELECT winning_number, COUNT(*) AS count, MAX(completed_at) AS last_seen_at
+		FROM lottery_draws
+		WHERE guild_id = $1
+		  AND difficulty = $2
+		  AND completed_at IS NOT NULL
+		  AND winning_number IS NOT NULL
+		GROUP BY winning_number
+		ORDER BY count DESC, last_seen_at DESC
+	`
+
+	rows, err := r.q.Query(ctx, query, guildID, difficulty)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get winning number frequency for guild %d: %w", guildID, err)
+	}
+	defer rows.Close()
+
+	var frequencies []*entities.LotteryNumberFrequency
+	for rows.Next() {
+		var f entities.LotteryNumberFrequency
+		if err := rows.Scan(&f.Number, &f.Count, &f.LastSeenAt); err != nil {
+			return nil, fmt.Errorf("failed to scan winning number frequency: %w", err)
+		}
+		frequencies = append(frequencies, &f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate winning number frequency: %w", err)
+	}
+
+	return frequencies, nil
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// LotterySubscriptionRepository implements lottery subscription data access
+type LotterySubscriptionRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewLotterySubscriptionRepositoryScoped creates a new lottery subscription repository with guild scope
+func NewLotterySubscriptionRepositoryScoped(tx Queryable, guildID int64) *LotterySubscriptionRepository {
+	return &LotterySubscriptionRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// Upsert creates the user's subscription in the current guild or replaces its ticket count
+func (r *LotterySubscriptionRepository) Upsert(ctx context.Context, subscription *entities.LotterySubscription) error {
+	if subscription.GuildID != r.guildID {
+		return fmt.Errorf("guild ID mismatch: subscription has %d, repository scoped to %d", subscription.GuildID, r.guildID)
+	}
+
+	query := `
+		INSERT INTO lottery_subscriptions (guild_id, discord_id, ticket_count)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (guild_id, discord_id)
+		DO UPDATE SET ticket_count = EXCLUDED.ticket_count, updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.q.QueryRow(ctx, query,
+		subscription.GuildID,
+		subscription.DiscordID,
+		subscription.TicketCount,
+	).Scan(&subscription.ID, &subscription.CreatedAt, &subscription.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert lottery subscription: %w", err)
+	}
+
+	return nil
+}
+
+// GetByUser returns the user's subscription in the current guild, or nil if they have none
+func (r *LotterySubscriptionRepository) GetByUser(ctx context.Context, discordID int64) (*entities.LotterySubscription, error) {
+	query := `
+		SELECT id, guild_id, discord_id, ticket_count, created_at, updated_at
+		FROM lottery_subscriptions
+		WHERE guild_id = $1 AND discord_id = $2
+	`
+
+	var subscription entities.LotterySubscription
+	err := r.q.QueryRow(ctx, query, r.guildID, discordID).Scan(
+		&subscription.ID,
+		&subscription.GuildID,
+		&subscription.DiscordID,
+		&subscription.TicketCount,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lottery subscription: %w", err)
+	}
+
+	return &subscription, nil
+}
+
+// GetAll returns every subscription in the current guild, oldest first
+func (r *LotterySubscriptionRepository) GetAll(ctx context.Context) ([]*entities.LotterySubscription, error) {
+	query := `
+		SELECT id, guild_id, discord_id, ticket_count, created_at, updated_at
+		FROM lottery_subscriptions
+		WHERE guild_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.q.Query(ctx, query, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lottery subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*entities.LotterySubscription
+	for rows.Next() {
+		var subscription entities.LotterySubscription
+		err := rows.Scan(
+			&subscription.ID,
+			&subscription.GuildID,
+			&subscription.DiscordID,
+			&subscription.TicketCount,
+			&subscription.CreatedAt,
+			&subscription.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan lottery subscription: %w", err)
+		}
+		subscriptions = append(subscriptions, &subscription)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating lottery subscription rows: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// Delete removes the user's subscription in the current guild. Returns false if they had none.
+func (r *LotterySubscriptionRepository) Delete(ctx context.Context, discordID int64) (bool, error) {
+	query := `
+		DELETE FROM lottery_subscriptions
+		WHERE guild_id = $1 AND discord_id = $2
+	`
+
+	result, err := r.q.Exec(ctx, query, r.guildID, discordID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete lottery subscription: %w", err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
 )
 
 // LotteryTicketRepository implements lottery ticket data access
@@ -21,12 +23,45 @@ func NewLotteryTicketRepositoryScoped(tx Queryable, guildID int64) *LotteryTicke
 	}
 }
 
-// CreateBatch creates multiple lottery tickets in a single batch insert
+// lotteryTicketInsertChunkSize caps the rows in one multi-row INSERT so a batch stays well
+// under Postgres' 65535 bind parameter limit (6 parameters per ticket)
+const lotteryTicketInsertChunkSize = 1000
+
+// lotteryTicketCopyThreshold is the batch size from which tickets are written with COPY instead of INSERT
+const lotteryTicketCopyThreshold = 100
+
+// lotteryTicketColumns are the columns written by the COPY path
+var lotteryTicketColumns = []string{"id", "draw_id", "guild_id", "discord_id", "ticket_number", "purchase_price", "purchased_at", "balance_history_id"}
+
+// copier is implemented by pgx transactions and pools that support the COPY protocol
+type copier interface {
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
+}
+
+// CreateBatch creates multiple lottery tickets, filling in each ticket's ID and purchase time.
+// Large batches are written with COPY when the connection supports it; otherwise tickets are
+// inserted in chunks small enough to stay under the bind parameter limit.
 func (r *LotteryTicketRepository) CreateBatch(ctx context.Context, tickets []*entities.LotteryTicket) error {
 	if len(tickets) == 0 {
 		return nil
 	}
 
+	if c, ok := r.q.(copier); ok && len(tickets) >= lotteryTicketCopyThreshold {
+		return r.copyBatch(ctx, c, tickets)
+	}
+
+	for start := 0; start < len(tickets); start += lotteryTicketInsertChunkSize {
+		end := min(start+lotteryTicketInsertChunkSize, len(tickets))
+		if err := r.insertBatch(ctx, tickets[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertBatch creates tickets with a single multi-row INSERT
+func (r *LotteryTicketRepository) insertBatch(ctx context.Context, tickets []*entities.LotteryTicket) error {
 	// Build batch insert query with parameterized values
 	query := `
 		INSERT INTO lottery_tickets (draw_id, guild_id, discord_id, ticket_number, purchase_price, balance_history_id)
@@ -62,6 +97,50 @@ func (r *LotteryTicketRepository) CreateBatch(ctx context.Context, tickets []*en
 	return rows.Err()
 }
 
+// copyBatch creates tickets with COPY. COPY can't return generated columns, so the IDs are
+// reserved from the table's sequence and the purchase time taken from the database up front,
+// leaving the tickets with the same values the INSERT path would have returned.
+func (r *LotteryTicketRepository) copyBatch(ctx context.Context, c copier, tickets []*entities.LotteryTicket) error {
+	rows, err := r.q.Query(ctx, `
+		SELECT nextval(pg_get_serial_sequence('lottery_tickets', 'id')), NOW()::timestamp
+		FROM generate_series(1, $1)
+	`, len(tickets))
+	if err != nil {
+		return fmt.Errorf("failed to reserve lottery ticket ids: %w", err)
+	}
+
+	i := 0
+	for rows.Next() {
+		if err := rows.Scan(&tickets[i].ID, &tickets[i].PurchasedAt); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan reserved ticket id: %w", err)
+		}
+		i++
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to reserve lottery ticket ids: %w", err)
+	}
+	if i != len(tickets) {
+		return fmt.Errorf("reserved %d lottery ticket ids for %d tickets", i, len(tickets))
+	}
+
+	copied, err := c.CopyFrom(ctx, pgx.Identifier{"lottery_tickets"}, lotteryTicketColumns,
+		pgx.CopyFromSlice(len(tickets), func(i int) ([]any, error) {
+			ticket := tickets[i]
+			return []any{ticket.ID, ticket.DrawID, r.guildID, ticket.DiscordID,
+				ticket.TicketNumber, ticket.PurchasePrice, ticket.PurchasedAt, ticket.BalanceHistoryID}, nil
+		}))
+	if err != nil {
+		return fmt.Errorf("failed to copy lottery tickets: %w", err)
+	}
+	if copied != int64(len(tickets)) {
+		return fmt.Errorf("copied %d of %d lottery tickets", copied, len(tickets))
+	}
+
+	return nil
+}
+
 // GetByUserForDraw returns all tickets for a user in a specific draw
 func (r *LotteryTicketRepository) GetByUserForDraw(ctx context.Context, drawID, discordID int64) ([]*entities.LotteryTicket, error) {
 	query := `
@@ -214,3 +293,39 @@ func (r *LotteryTicketRepository) GetUsedNumbersByUser(ctx context.Context, draw
 
 	return numbers, nil
 }
+
+// GetUserNumberFrequency returns the numbers a user has held most often across draws at the given difficulty
+func (r *LotteryTicketRepository) GetUserNumberFrequency(ctx context.Context, discordID, difficulty int64, limit int) ([]*entities.LotteryNumberFrequency, error) {
+	query := `
+		SELECT t.ticket_number, COUNT(*) AS count, MAX(t.purchased_at) AS last_seen_at
+		FROM lottery_tickets t
+		JOIN lottery_draws d ON d.id = t.draw_id
+		WHERE t.guild_id = $1
+		  AND t.discord_id = $2
+		  AND d.difficulty = $3
+		GROUP BY t.ticket_number
+		ORDER BY count DESC, last_seen_at DESC
+		LIMIT $4
+	`
+
+	rows, err := r.q.Query(ctx, query, r.guildID, discordID, difficulty, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get number frequency for user %d: %w", discordID, err)
+	}
+	defer rows.Close()
+
+	var frequencies []*entities.LotteryNumberFrequency
+	for rows.Next() {
+		var f entities.LotteryNumberFrequency
+		if err := rows.Scan(&f.Number, &f.Count, &f.LastSeenAt); err != nil {
+			return nil, fmt.Errorf("failed to scan user number frequency: %w", err)
+		}
+		frequencies = append(frequencies, &f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate user number frequency: %w", err)
+	}
+
+	return frequencies, nil
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/repository/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// insertOnlyQueryable hides CopyFrom so CreateBatch takes the INSERT path
+type insertOnlyQueryable struct {
+	Queryable
+}
+
+func makeLotteryTickets(drawID, discordID int64, count int) []*entities.LotteryTicket {
+	tickets := make([]*entities.LotteryTicket, count)
+	for i := range tickets {
+		tickets[i] = &entities.LotteryTicket{
+			DrawID:           drawID,
+			DiscordID:        discordID,
+			TicketNumber:     int64(i),
+			PurchasePrice:    1000,
+			BalanceHistoryID: 1,
+		}
+	}
+	return tickets
+}
+
+func TestLotteryTicketRepository_CreateBatch(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	ctx := context.Background()
+
+	guildID := int64(123456789)
+	drawRepo := NewLotteryDrawRepositoryScoped(testDB.DB.Pool, guildID)
+	draw, err := drawRepo.GetOrCreateCurrentDraw(ctx, guildID, time.Now().Add(24*time.Hour), 16, 1000)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		repo      *LotteryTicketRepository
+		discordID int64
+		count     int
+	}{
+		{"small batch", NewLotteryTicketRepositoryScoped(testDB.DB.Pool, guildID), 1, 5},
+		{"copy", NewLotteryTicketRepositoryScoped(testDB.DB.Pool, guildID), 2, lotteryTicketCopyThreshold + 50},
+		{"chunked insert", NewLotteryTicketRepositoryScoped(insertOnlyQueryable{testDB.DB.Pool}, guildID), 3, lotteryTicketInsertChunkSize + 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tickets := makeLotteryTickets(draw.ID, tt.discordID, tt.count)
+			require.NoError(t, tt.repo.CreateBatch(ctx, tickets))
+
+			stored, err := tt.repo.GetByUserForDraw(ctx, draw.ID, tt.discordID)
+			require.NoError(t, err)
+			require.Len(t, stored, tt.count)
+
+			// Stored tickets come back ordered by number, matching the order they were created in
+			for i, ticket := range tickets {
+				assert.NotZero(t, ticket.ID)
+				assert.False(t, ticket.PurchasedAt.IsZero())
+				assert.Equal(t, ticket.ID, stored[i].ID)
+				assert.Equal(t, guildID, stored[i].GuildID)
+				assert.True(t, ticket.PurchasedAt.Equal(stored[i].PurchasedAt))
+			}
+		})
+	}
+
+	t.Run("ids keep advancing after copy", func(t *testing.T) {
+		repo := NewLotteryTicketRepositoryScoped(testDB.DB.Pool, guildID)
+		copied := makeLotteryTickets(draw.ID, 4, lotteryTicketCopyThreshold)
+		require.NoError(t, repo.CreateBatch(ctx, copied))
+
+		inserted := makeLotteryTickets(draw.ID, 5, 1)
+		require.NoError(t, repo.CreateBatch(ctx, inserted))
+
+		assert.Greater(t, inserted[0].ID, copied[len(copied)-1].ID)
+	})
+}
+
+func BenchmarkLotteryTicketRepository_CreateBatch(b *testing.B) {
+	testDB := testutil.SetupTestDatabase(b)
+	ctx := context.Background()
+
+	guildID := int64(123456789)
+	drawRepo := NewLotteryDrawRepositoryScoped(testDB.DB.Pool, guildID)
+	draw, err := drawRepo.GetOrCreateCurrentDraw(ctx, guildID, time.Now().Add(24*time.Hour), 16, 1000)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	// Every iteration buys for a new user to avoid the unique ticket number constraint
+	discordID := int64(0)
+
+	b.Run("insert", func(b *testing.B) {
+		repo := NewLotteryTicketRepositoryScoped(insertOnlyQueryable{testDB.DB.Pool}, guildID)
+		for b.Loop() {
+			discordID++
+			if err := repo.CreateBatch(ctx, makeLotteryTickets(draw.ID, discordID, 500)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("copy", func(b *testing.B) {
+		repo := NewLotteryTicketRepositoryScoped(testDB.DB.Pool, guildID)
+		for b.Loop() {
+			discordID++
+			if err := repo.CreateBatch(ctx, makeLotteryTickets(draw.ID, discordID, 500)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
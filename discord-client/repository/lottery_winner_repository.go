@@ -123,3 +123,111 @@ func (r *LotteryWinnerRepository) GetByUserID(ctx context.Context, discordID int
 
 	return winners, nil
 }
+
+// GetByDrawIDs returns the winners of several draws, grouped by draw
+func (r *LotteryWinnerRepository) GetByDrawIDs(ctx context.Context, drawIDs []int64) ([]*entities.LotteryWinner, error) {
+	if len(drawIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT id, draw_id, discord_id, ticket_id, winning_amount, balance_history_id, created_at
+		FROM lottery_winners
+		WHERE draw_id = ANY($1)
+		ORDER BY draw_id, created_at ASC
+	`
+
+	rows, err := r.q.Query(ctx, query, drawIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get winners for draws: %w", err)
+	}
+	defer rows.Close()
+
+	var winners []*entities.LotteryWinner
+	for rows.Next() {
+		var winner entities.LotteryWinner
+		err := rows.Scan(
+			&winner.ID,
+			&winner.DrawID,
+			&winner.DiscordID,
+			&winner.TicketID,
+			&winner.WinningAmount,
+			&winner.BalanceHistoryID,
+			&winner.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan lottery winner: %w", err)
+		}
+		winners = append(winners, &winner)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate lottery winners: %w", err)
+	}
+
+	return winners, nil
+}
+
+// GetUserWins returns a page of a user's wins in the guild, newest first
+func (r *LotteryWinnerRepository) GetUserWins(ctx context.Context, discordID int64, limit, offset int) ([]*entities.LotteryUserWin, error) {
+	query := `
+		SELECT d.id, d.winning_number, d.difficulty, d.total_pot, w.winning_amount,
+		       (SELECT COUNT(*) FROM lottery_winners o WHERE o.draw_id = d.id) AS winner_count,
+		       d.completed_at
+		FROM lottery_winners w
+		JOIN lottery_draws d ON d.id = w.draw_id
+		WHERE w.discord_id = $1
+		  AND d.guild_id = $2
+		  AND d.completed_at IS NOT NULL
+		ORDER BY d.completed_at DESC, w.id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.q.Query(ctx, query, discordID, r.guildID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wins for user %d: %w", discordID, err)
+	}
+	defer rows.Close()
+
+	var wins []*entities.LotteryUserWin
+	for rows.Next() {
+		var win entities.LotteryUserWin
+		err := rows.Scan(
+			&win.DrawID,
+			&win.WinningNumber,
+			&win.Difficulty,
+			&win.TotalPot,
+			&win.WinningAmount,
+			&win.WinnerCount,
+			&win.CompletedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan lottery win: %w", err)
+		}
+		wins = append(wins, &win)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate lottery wins: %w", err)
+	}
+
+	return wins, nil
+}
+
+// GetUserWinTotals returns the number and total amount of a user's wins in the guild
+func (r *LotteryWinnerRepository) GetUserWinTotals(ctx context.Context, discordID int64) (*entities.LotteryUserWinTotals, error) {
+	query := `
+		SELECT COUNT(*), COALESCE(SUM(w.winning_amount), 0)
+		FROM lottery_winners w
+		JOIN lottery_draws d ON d.id = w.draw_id
+		WHERE w.discord_id = $1
+		  AND d.guild_id = $2
+	`
+
+	var totals entities.LotteryUserWinTotals
+	if err := r.q.QueryRow(ctx, query, discordID, r.guildID).Scan(&totals.WinCount, &totals.TotalWon); err != nil {
+		return nil, fmt.Errorf("failed to get win totals for user %d: %w", discordID, err)
+	}
+
+	return &totals, nil
+}
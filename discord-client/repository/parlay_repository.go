@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const parlayColumns = `p.id, p.guild_id, p.discord_id, p.amount, p.status, p.payout_amount,
+		       p.balance_history_id, p.created_at, p.settled_at`
+
+// ParlayRepository implements parlay data access
+type ParlayRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewParlayRepositoryScoped creates a new parlay repository with guild scope
+func NewParlayRepositoryScoped(tx Queryable, guildID int64) *ParlayRepository {
+	return &ParlayRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// Create creates a parlay and its legs in the current guild
+func (r *ParlayRepository) Create(ctx context.Context, parlay *entities.Parlay) error {
+	if parlay.GuildID != r.guildID {
+		return fmt.Errorf("guild ID mismatch: parlay has %d, repository scoped to %d", parlay.GuildID, r.guildID)
+	}
+
+	query := `
+		INSERT INTO parlays (guild_id, discord_id, amount, status, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+
+	err := r.q.QueryRow(ctx, query,
+		parlay.GuildID,
+		parlay.DiscordID,
+		parlay.Amount,
+		parlay.Status,
+		parlay.CreatedAt,
+	).Scan(&parlay.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create parlay: %w", err)
+	}
+
+	legQuery := `
+		INSERT INTO parlay_legs (parlay_id, group_wager_id, option_id, odds_multiplier, status)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+	for _, leg := range parlay.Legs {
+		leg.ParlayID = parlay.ID
+		err := r.q.QueryRow(ctx, legQuery,
+			leg.ParlayID,
+			leg.GroupWagerID,
+			leg.OptionID,
+			leg.OddsMultiplier,
+			leg.Status,
+		).Scan(&leg.ID)
+		if err != nil {
+			return fmt.Errorf("failed to create leg on group wager %d for parlay %d: %w", leg.GroupWagerID, parlay.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetByIDForUpdate retrieves a parlay in the current guild by ID with its legs and a row lock for update
+func (r *ParlayRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.Parlay, error) {
+	query := `
+		SELECT ` + parlayColumns + `
+		FROM parlays p
+		WHERE p.id = $1 AND p.guild_id = $2
+		FOR UPDATE
+	`
+
+	parlay, err := scanParlay(r.q.QueryRow(ctx, query, id, r.guildID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parlay for update by ID %d: %w", id, err)
+	}
+
+	if err := r.loadLegs(ctx, []*entities.Parlay{parlay}); err != nil {
+		return nil, err
+	}
+
+	return parlay, nil
+}
+
+// GetByUser returns a user's most recent parlays in the current guild with their legs, newest first
+func (r *ParlayRepository) GetByUser(ctx context.Context, discordID int64, limit int) ([]*entities.Parlay, error) {
+	query := `
+		SELECT ` + parlayColumns + `
+		FROM parlays p
+		WHERE p.discord_id = $1 AND p.guild_id = $2
+		ORDER BY p.created_at DESC, p.id DESC
+		LIMIT $3
+	`
+
+	rows, err := r.q.Query(ctx, query, discordID, r.guildID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parlays for user %d: %w", discordID, err)
+	}
+	defer rows.Close()
+
+	parlays, err := collectParlays(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLegs(ctx, parlays); err != nil {
+		return nil, err
+	}
+
+	return parlays, nil
+}
+
+// GetPendingByGroupWagerForUpdate returns pending parlays in the current guild with a leg on the wager, locked for update
+func (r *ParlayRepository) GetPendingByGroupWagerForUpdate(ctx context.Context, groupWagerID int64) ([]*entities.Parlay, error) {
+	query := `
+		SELECT ` + parlayColumns + `
+		FROM parlays p
+		WHERE p.guild_id = $2
+		  AND p.status = 'pending'
+		  AND EXISTS (
+		      SELECT 1 FROM parlay_legs pl
+		      WHERE pl.parlay_id = p.id AND pl.group_wager_id = $1
+		  )
+		ORDER BY p.id
+		FOR UPDATE
+	`
+
+	rows, err := r.q.Query(ctx, query, groupWagerID, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending parlays for group wager %d: %w", groupWagerID, err)
+	}
+	defer rows.Close()
+
+	parlays, err := collectParlays(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLegs(ctx, parlays); err != nil {
+		return nil, err
+	}
+
+	return parlays, nil
+}
+
+// Update updates the settlement of a parlay and its legs
+func (r *ParlayRepository) Update(ctx context.Context, parlay *entities.Parlay) error {
+	query := `
+		UPDATE parlays
+		SET status = $3,
+		    payout_amount = $4,
+		    balance_history_id = $5,
+		    settled_at = $6
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	result, err := r.q.Exec(ctx, query,
+		parlay.ID,
+		r.guildID,
+		parlay.Status,
+		parlay.PayoutAmount,
+		parlay.BalanceHistoryID,
+		parlay.SettledAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update parlay %d: %w", parlay.ID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("parlay %d not found", parlay.ID)
+	}
+
+	legQuery := `
+		UPDATE parlay_legs
+		SET status = $3,
+		    settled_at = $4
+		WHERE id = $1 AND parlay_id = $2
+	`
+	for _, leg := range parlay.Legs {
+		if _, err := r.q.Exec(ctx, legQuery, leg.ID, parlay.ID, leg.Status, leg.SettledAt); err != nil {
+			return fmt.Errorf("failed to update parlay leg %d: %w", leg.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// loadLegs attaches legs to the given parlays with a single query
+func (r *ParlayRepository) loadLegs(ctx context.Context, parlays []*entities.Parlay) error {
+	if len(parlays) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*entities.Parlay, len(parlays))
+	ids := make([]int64, len(parlays))
+	for i, parlay := range parlays {
+		byID[parlay.ID] = parlay
+		ids[i] = parlay.ID
+	}
+
+	query := `
+		SELECT id, parlay_id, group_wager_id, option_id, odds_multiplier, status, settled_at
+		FROM parlay_legs
+		WHERE parlay_id = ANY($1)
+		ORDER BY parlay_id, id
+	`
+
+	rows, err := r.q.Query(ctx, query, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get parlay legs: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var leg entities.ParlayLeg
+		err := rows.Scan(
+			&leg.ID,
+			&leg.ParlayID,
+			&leg.GroupWagerID,
+			&leg.OptionID,
+			&leg.OddsMultiplier,
+			&leg.Status,
+			&leg.SettledAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan parlay leg: %w", err)
+		}
+		byID[leg.ParlayID].Legs = append(byID[leg.ParlayID].Legs, &leg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating parlay leg rows: %w", err)
+	}
+
+	return nil
+}
+
+// scanParlay scans a single parlay row without its legs
+func scanParlay(row pgx.Row) (*entities.Parlay, error) {
+	var parlay entities.Parlay
+	err := row.Scan(
+		&parlay.ID,
+		&parlay.GuildID,
+		&parlay.DiscordID,
+		&parlay.Amount,
+		&parlay.Status,
+		&parlay.PayoutAmount,
+		&parlay.BalanceHistoryID,
+		&parlay.CreatedAt,
+		&parlay.SettledAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &parlay, nil
+}
+
+// collectParlays scans all rows into parlays
+func collectParlays(rows pgx.Rows) ([]*entities.Parlay, error) {
+	var parlays []*entities.Parlay
+	for rows.Next() {
+		parlay, err := scanParlay(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan parlay: %w", err)
+		}
+		parlays = append(parlays, parlay)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating parlay rows: %w", err)
+	}
+
+	return parlays, nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// RiotAccountLinkRepository implements Riot account link data access
+type RiotAccountLinkRepository struct {
+	q Queryable
+}
+
+// NewRiotAccountLinkRepositoryWithTx creates a new Riot account link repository with a transaction
+func NewRiotAccountLinkRepositoryWithTx(tx Queryable) *RiotAccountLinkRepository {
+	return &RiotAccountLinkRepository{q: tx}
+}
+
+// GetByDiscordID returns the user's link, or nil if they have not linked an account
+func (r *RiotAccountLinkRepository) GetByDiscordID(ctx context.Context, discordID int64) (*entities.RiotAccountLink, error) {
+	query := `
+		SELECT discord_id, game_name, tag_line, linked_at
+		FROM riot_account_links
+		WHERE discord_id = $1
+	`
+
+	return r.scanLink(r.q.QueryRow(ctx, query, discordID))
+}
+
+// GetByRiotID returns the link for a Riot ID ignoring case, or nil if nobody has claimed it
+func (r *RiotAccountLinkRepository) GetByRiotID(ctx context.Context, gameName, tagLine string) (*entities.RiotAccountLink, error) {
+	query := `
+		SELECT discord_id, game_name, tag_line, linked_at
+		FROM riot_account_links
+		WHERE LOWER(game_name) = LOWER($1) AND LOWER(tag_line) = LOWER($2)
+	`
+
+	return r.scanLink(r.q.QueryRow(ctx, query, gameName, tagLine))
+}
+
+// Upsert creates or replaces the user's link
+func (r *RiotAccountLinkRepository) Upsert(ctx context.Context, link *entities.RiotAccountLink) error {
+	query := `
+		INSERT INTO riot_account_links (discord_id, game_name, tag_line)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (discord_id)
+		DO UPDATE SET game_name = EXCLUDED.game_name, tag_line = EXCLUDED.tag_line, linked_at = NOW()
+		RETURNING linked_at
+	`
+
+	err := r.q.QueryRow(ctx, query, link.DiscordID, link.GameName, link.TagLine).Scan(&link.LinkedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save riot account link: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the user's link. Returns false if they had none.
+func (r *RiotAccountLinkRepository) Delete(ctx context.Context, discordID int64) (bool, error) {
+	result, err := r.q.Exec(ctx, `DELETE FROM riot_account_links WHERE discord_id = $1`, discordID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete riot account link: %w", err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// scanLink reads a single link row, returning nil if there was none
+func (r *RiotAccountLinkRepository) scanLink(row pgx.Row) (*entities.RiotAccountLink, error) {
+	var link entities.RiotAccountLink
+	err := row.Scan(
+		&link.DiscordID,
+		&link.GameName,
+		&link.TagLine,
+		&link.LinkedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get riot account link: %w", err)
+	}
+
+	return &link, nil
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const savingsDepositColumns = `id, guild_id, discord_id, amount, term_weeks, bonus_amount, status,
+		       locked_at, matures_at, closed_at, balance_history_id`
+
+// SavingsDepositRepository implements savings deposit data access
+type SavingsDepositRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewSavingsDepositRepositoryScoped creates a new savings deposit repository with guild scope
+func NewSavingsDepositRepositoryScoped(tx Queryable, guildID int64) *SavingsDepositRepository {
+	return &SavingsDepositRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// Create creates a new savings deposit in the current guild
+func (r *SavingsDepositRepository) Create(ctx context.Context, deposit *entities.SavingsDeposit) error {
+	if deposit.GuildID != r.guildID {
+		return fmt.Errorf("guild ID mismatch: deposit has %d, repository scoped to %d", deposit.GuildID, r.guildID)
+	}
+
+	query := `
+		INSERT INTO savings_deposits (guild_id, discord_id, amount, term_weeks, bonus_amount, status, locked_at, matures_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	err := r.q.QueryRow(ctx, query,
+		deposit.GuildID,
+		deposit.DiscordID,
+		deposit.Amount,
+		deposit.TermWeeks,
+		deposit.BonusAmount,
+		deposit.Status,
+		deposit.LockedAt,
+		deposit.MaturesAt,
+	).Scan(&deposit.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create savings deposit: %w", err)
+	}
+
+	return nil
+}
+
+// GetByIDForUpdate retrieves a deposit in the current guild by ID with row lock for update
+func (r *SavingsDepositRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.SavingsDeposit, error) {
+	query := `
+		SELECT ` + savingsDepositColumns + `
+		FROM savings_deposits
+		WHERE id = $1 AND guild_id = $2
+		FOR UPDATE
+	`
+
+	deposit, err := scanSavingsDeposit(r.q.QueryRow(ctx, query, id, r.guildID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get savings deposit for update by ID %d: %w", id, err)
+	}
+
+	return deposit, nil
+}
+
+// GetLockedByUser returns a user's locked deposits in the current guild ordered by maturity
+func (r *SavingsDepositRepository) GetLockedByUser(ctx context.Context, discordID int64) ([]*entities.SavingsDeposit, error) {
+	query := `
+		SELECT ` + savingsDepositColumns + `
+		FROM savings_deposits
+		WHERE discord_id = $1 AND guild_id = $2 AND status = 'locked'
+		ORDER BY matures_at ASC, id ASC
+	`
+
+	rows, err := r.q.Query(ctx, query, discordID, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get locked savings for user %d: %w", discordID, err)
+	}
+	defer rows.Close()
+
+	return collectSavingsDeposits(rows)
+}
+
+// Update updates the status, close time and bonus transaction of a deposit
+func (r *SavingsDepositRepository) Update(ctx context.Context, deposit *entities.SavingsDeposit) error {
+	query := `
+		UPDATE savings_deposits
+		SET status = $3,
+		    closed_at = $4,
+		    balance_history_id = $5
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	result, err := r.q.Exec(ctx, query,
+		deposit.ID,
+		r.guildID,
+		deposit.Status,
+		deposit.ClosedAt,
+		deposit.BalanceHistoryID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update savings deposit %d: %w", deposit.ID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("savings deposit %d not found", deposit.ID)
+	}
+
+	return nil
+}
+
+// GetMaturedDeposits returns locked deposits across all guilds that matured at or before the given time
+func (r *SavingsDepositRepository) GetMaturedDeposits(ctx context.Context, asOf time.Time) ([]*entities.SavingsDeposit, error) {
+	query := `
+		SELECT ` + savingsDepositColumns + `
+		FROM savings_deposits
+		WHERE status = 'locked' AND matures_at <= $1
+		ORDER BY matures_at ASC, id ASC
+	`
+
+	rows, err := r.q.Query(ctx, query, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matured savings deposits: %w", err)
+	}
+	defer rows.Close()
+
+	return collectSavingsDeposits(rows)
+}
+
+// scanSavingsDeposit scans a single savings deposit row
+func scanSavingsDeposit(row pgx.Row) (*entities.SavingsDeposit, error) {
+	var deposit entities.SavingsDeposit
+	err := row.Scan(
+		&deposit.ID,
+		&deposit.GuildID,
+		&deposit.DiscordID,
+		&deposit.Amount,
+		&deposit.TermWeeks,
+		&deposit.BonusAmount,
+		&deposit.Status,
+		&deposit.LockedAt,
+		&deposit.MaturesAt,
+		&deposit.ClosedAt,
+		&deposit.BalanceHistoryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &deposit, nil
+}
+
+// collectSavingsDeposits scans all rows into savings deposits
+func collectSavingsDeposits(rows pgx.Rows) ([]*entities.SavingsDeposit, error) {
+	var deposits []*entities.SavingsDeposit
+	for rows.Next() {
+		deposit, err := scanSavingsDeposit(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan savings deposit: %w", err)
+		}
+		deposits = append(deposits, deposit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating savings deposit rows: %w", err)
+	}
+
+	return deposits, nil
+}
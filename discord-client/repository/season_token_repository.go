@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SeasonTokenRepository implements the SeasonTokenRepository interface over the season_token_balances
+// and season_token_history tables
+type SeasonTokenRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewSeasonTokenRepositoryScoped creates a new season token repository with a transaction and guild scope
+func NewSeasonTokenRepositoryScoped(tx Queryable, guildID int64) interfaces.SeasonTokenRepository {
+	return &SeasonTokenRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// GetBalance returns the user's token balance for a season, zero if they have never earned any
+func (r *SeasonTokenRepository) GetBalance(ctx context.Context, discordID int64, season int) (int64, error) {
+	query := `
+		SELECT balance
+		FROM season_token_balances
+		WHERE discord_id = $1 AND guild_id = $2 AND season = $3`
+
+	var balance int64
+	err := r.q.QueryRow(ctx, query, discordID, r.guildID, season).Scan(&balance)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get season token balance for user %d: %w", discordID, err)
+	}
+
+	return balance, nil
+}
+
+// Credit adds tokens to the user's season balance and returns the new balance
+func (r *SeasonTokenRepository) Credit(ctx context.Context, discordID int64, season int, amount int64) (int64, error) {
+	if amount <= 0 {
+		return 0, fmt.Errorf("season token credit must be positive, got %d", amount)
+	}
+
+	query := `
+		INSERT INTO season_token_balances (discord_id, guild_id, season, balance)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (discord_id, guild_id, season) DO UPDATE
+		SET balance = season_token_balances.balance + EXCLUDED.balance,
+			updated_at = NOW()
+		RETURNING balance`
+
+	var balance int64
+	if err := r.q.QueryRow(ctx, query, discordID, r.guildID, season, amount).Scan(&balance); err != nil {
+		return 0, fmt.Errorf("failed to credit season tokens to user %d: %w", discordID, err)
+	}
+
+	return balance, nil
+}
+
+// Debit removes tokens from the user's season balance and returns the new balance.
+// The balance check and the update happen in one statement so concurrent debits cannot overdraw.
+func (r *SeasonTokenRepository) Debit(ctx context.Context, discordID int64, season int, amount int64) (int64, bool, error) {
+	if amount <= 0 {
+		return 0, false, fmt.Errorf("season token debit must be positive, got %d", amount)
+	}
+
+	query := `
+		UPDATE season_token_balances
+		SET balance = balance - $4,
+			updated_at = NOW()
+		WHERE discord_id = $1 AND guild_id = $2 AND season = $3 AND balance >= $4
+		RETURNING balance`
+
+	var balance int64
+	err := r.q.QueryRow(ctx, query, discordID, r.guildID, season, amount).Scan(&balance)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to debit season tokens from user %d: %w", discordID, err)
+	}
+
+	return balance, true, nil
+}
+
+// RecordHistory records a token credit or debit in the season token ledger
+func (r *SeasonTokenRepository) RecordHistory(ctx context.Context, history *entities.SeasonTokenHistory) error {
+	if !history.TransactionType.IsSeasonTokenType() {
+		return fmt.Errorf("invalid season token history for user %d: %s is not a season token transaction type", history.DiscordID, history.TransactionType)
+	}
+
+	metadataJSON, err := json.Marshal(history.TransactionMetadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal transaction metadata: %w", err)
+	}
+
+	query := `
+		INSERT INTO season_token_history
+		(discord_id, guild_id, season, balance_before, balance_after, change_amount, transaction_type, transaction_metadata)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at`
+
+	err = r.q.QueryRow(ctx, query,
+		history.DiscordID,
+		r.guildID,
+		history.Season,
+		history.BalanceBefore,
+		history.BalanceAfter,
+		history.ChangeAmount,
+		history.TransactionType,
+		metadataJSON,
+	).Scan(&history.ID, &history.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record season token history for user %d: %w", history.DiscordID, err)
+	}
+
+	history.GuildID = r.guildID
+
+	return nil
+}
+
+// GetHistory returns the user's most recent token movements for a season, newest first
+func (r *SeasonTokenRepository) GetHistory(ctx context.Context, discordID int64, season int, limit int) ([]*entities.SeasonTokenHistory, error) {
+	query := `
+		SELECT id, discord_id, guild_id, season, balance_before, balance_after, change_amount,
+			transaction_type, transaction_metadata, created_at
+		FROM season_token_history
+		WHERE discord_id = $1 AND guild_id = $2 AND season = $3
+		ORDER BY created_at DESC, id DESC
+		LIMIT $4`
+
+	rows, err := r.q.Query(ctx, query, discordID, r.guildID, season, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season token history for user %d: %w", discordID, err)
+	}
+	defer rows.Close()
+
+	var histories []*entities.SeasonTokenHistory
+	for rows.Next() {
+		var history entities.SeasonTokenHistory
+		var metadataJSON []byte
+
+		err := rows.Scan(
+			&history.ID,
+			&history.DiscordID,
+			&history.GuildID,
+			&history.Season,
+			&history.BalanceBefore,
+			&history.BalanceAfter,
+			&history.ChangeAmount,
+			&history.TransactionType,
+			&metadataJSON,
+			&history.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan season token history: %w", err)
+		}
+
+		if len(metadataJSON) > 0 {
+			if err := json.Unmarshal(metadataJSON, &history.TransactionMetadata); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal transaction metadata: %w", err)
+			}
+		}
+
+		histories = append(histories, &history)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate season token history: %w", err)
+	}
+
+	return histories, nil
+}
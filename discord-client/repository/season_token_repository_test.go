@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/repository/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeasonTokenRepository_CreditDebitAndHistory(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	ctx := context.Background()
+	testGuildID := int64(1018733499869577296)
+
+	userRepo := NewUserRepository(testDB.DB)
+	user, err := userRepo.Create(ctx, 123456789, "gambler", 100000)
+	require.NoError(t, err)
+
+	repo := NewSeasonTokenRepositoryScoped(testDB.DB.Pool, testGuildID)
+
+	balance, err := repo.GetBalance(ctx, user.DiscordID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), balance)
+
+	balance, err = repo.Credit(ctx, user.DiscordID, 1, 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), balance)
+
+	balance, err = repo.Credit(ctx, user.DiscordID, 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), balance)
+
+	// Debits larger than the balance leave it untouched
+	_, ok, err := repo.Debit(ctx, user.DiscordID, 1, 9)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	balance, ok, err = repo.Debit(ctx, user.DiscordID, 1, 6)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), balance)
+
+	// Balances are kept per season
+	balance, err = repo.GetBalance(ctx, user.DiscordID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), balance)
+
+	require.NoError(t, repo.RecordHistory(ctx, &entities.SeasonTokenHistory{
+		DiscordID:           user.DiscordID,
+		Season:              1,
+		BalanceBefore:       8,
+		BalanceAfter:        2,
+		ChangeAmount:        -6,
+		TransactionType:     entities.TransactionTypeSeasonTokenSpent,
+		TransactionMetadata: map[string]interface{}{"item": "badge"},
+	}))
+
+	history, err := repo.GetHistory(ctx, user.DiscordID, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, int64(-6), history[0].ChangeAmount)
+	assert.Equal(t, testGuildID, history[0].GuildID)
+	assert.Equal(t, "badge", history[0].TransactionMetadata["item"])
+
+	// Bit transaction types belong in balance_history, not the token ledger
+	err = repo.RecordHistory(ctx, &entities.SeasonTokenHistory{
+		DiscordID:       user.DiscordID,
+		Season:          1,
+		ChangeAmount:    1,
+		TransactionType: entities.TransactionTypeBetWin,
+	})
+	assert.Error(t, err)
+}
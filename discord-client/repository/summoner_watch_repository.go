@@ -6,6 +6,8 @@ import (
 
 	"gambler/discord-client/database"
 	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
 )
 
 // SummonerWatchRepository implements the SummonerWatchRepository interface
@@ -199,3 +201,41 @@ func (r *SummonerWatchRepository) GetWatch(ctx context.Context, guildID int64, s
 
 	return &watch, nil
 }
+
+// RecordGameResult updates the summoner's win streak with a finished game.
+// Returns the streak before and after the game, or nils if the summoner is unknown or the game was already recorded.
+func (r *SummonerWatchRepository) RecordGameResult(ctx context.Context, summonerName, tagLine, gameID string, won bool) (*entities.SummonerStreak, *entities.SummonerStreak, error) {
+	query := `
+		SELECT id, win_streak, COALESCE(win_streak_start_game_id, ''), COALESCE(last_game_id, '')
+		FROM summoners
+		WHERE LOWER(game_name) = LOWER($1) AND LOWER(tag_line) = LOWER($2)
+		FOR UPDATE`
+
+	var summonerID int64
+	var previous entities.SummonerStreak
+	err := r.q.QueryRow(ctx, query, summonerName, tagLine).Scan(
+		&summonerID, &previous.Length, &previous.StartGameID, &previous.LastGameID,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get win streak for summoner %s#%s: %w", summonerName, tagLine, err)
+	}
+
+	current := previous
+	if !current.RecordGame(gameID, won) {
+		return nil, nil, nil
+	}
+
+	updateQuery := `
+		UPDATE summoners
+		SET win_streak = $2, win_streak_start_game_id = NULLIF($3, ''), last_game_id = $4
+		WHERE id = $1`
+	_, err = r.q.Exec(ctx, updateQuery, summonerID, current.Length, current.StartGameID, current.LastGameID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update win streak for summoner %s#%s: %w", summonerName, tagLine, err)
+	}
+
+	return &previous, &current, nil
+}
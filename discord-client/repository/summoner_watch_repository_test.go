@@ -399,3 +399,50 @@ func TestSummonerWatchRepository_GetWatch(t *testing.T) {
 		assert.NotNil(t, watch)
 	})
 }
+
+func TestSummonerWatchRepository_RecordGameResult(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+
+	repo := NewSummonerWatchRepository(testDB.DB)
+	ctx := context.Background()
+
+	t.Run("unknown summoner", func(t *testing.T) {
+		previous, current, err := repo.RecordGameResult(ctx, "Nobody", "NA1", "game-1", true)
+		require.NoError(t, err)
+		assert.Nil(t, previous)
+		assert.Nil(t, current)
+	})
+
+	_, err := repo.CreateWatch(ctx, 123456789, "StreakPlayer", "NA1")
+	require.NoError(t, err)
+
+	t.Run("wins build the streak", func(t *testing.T) {
+		for _, gameID := range []string{"game-1", "game-2"} {
+			_, _, err := repo.RecordGameResult(ctx, "streakplayer", "na1", gameID, true)
+			require.NoError(t, err)
+		}
+
+		previous, current, err := repo.RecordGameResult(ctx, "StreakPlayer", "NA1", "game-3", true)
+		require.NoError(t, err)
+		require.NotNil(t, current)
+		assert.Equal(t, 2, previous.Length)
+		assert.Equal(t, 3, current.Length)
+		assert.Equal(t, "game-1", current.StartGameID)
+	})
+
+	t.Run("repeated game is ignored", func(t *testing.T) {
+		previous, current, err := repo.RecordGameResult(ctx, "StreakPlayer", "NA1", "game-3", true)
+		require.NoError(t, err)
+		assert.Nil(t, previous)
+		assert.Nil(t, current)
+	})
+
+	t.Run("loss breaks the streak", func(t *testing.T) {
+		previous, current, err := repo.RecordGameResult(ctx, "StreakPlayer", "NA1", "game-4", false)
+		require.NoError(t, err)
+		assert.Equal(t, 3, previous.Length)
+		assert.Equal(t, 0, current.Length)
+		assert.Empty(t, current.StartGameID)
+	})
+}
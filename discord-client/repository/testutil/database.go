@@ -22,7 +22,7 @@ type TestDatabase struct {
 }
 
 // SetupTestDatabase creates a new PostgreSQL test container and runs migrations
-func SetupTestDatabase(t *testing.T) *TestDatabase {
+func SetupTestDatabase(t testing.TB) *TestDatabase {
 	ctx := context.Background()
 
 	// Generate unique labels for this test container
@@ -87,12 +87,12 @@ func SetupTestDatabase(t *testing.T) *TestDatabase {
 
 // Cleanup closes the database connection and terminates the container
 // Deprecated: Use robustCleanup instead, which is automatically registered
-func (td *TestDatabase) Cleanup(t *testing.T) {
+func (td *TestDatabase) Cleanup(t testing.TB) {
 	td.robustCleanup(t)
 }
 
 // robustCleanup provides robust container cleanup with panic recovery
-func (td *TestDatabase) robustCleanup(t *testing.T) {
+func (td *TestDatabase) robustCleanup(t testing.TB) {
 	// Recover from any panics during cleanup
 	defer func() {
 		if r := recover(); r != nil {
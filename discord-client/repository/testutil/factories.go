@@ -25,13 +25,18 @@ func CreateTestUserWithBalance(discordID int64, username string, balance int64)
 	return user
 }
 
-// CreateTestBalanceHistory creates a test balance history entry
+// CreateTestBalanceHistory creates a test balance history entry moving 10000 bits in the direction the
+// transaction type expects
 func CreateTestBalanceHistory(discordID int64, transactionType entities.TransactionType) *entities.BalanceHistory {
+	change := int64(-10000)
+	if info, ok := transactionType.Info(); ok && info.Sign == entities.TransactionSignCredit {
+		change = 10000
+	}
 	return &entities.BalanceHistory{
 		DiscordID:       discordID,
 		BalanceBefore:   100000,
-		BalanceAfter:    90000,
-		ChangeAmount:    -10000,
+		BalanceAfter:    100000 + change,
+		ChangeAmount:    change,
 		TransactionType: transactionType,
 		TransactionMetadata: map[string]interface{}{
 			"test": true,
@@ -0,0 +1,144 @@
+package factories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/repository"
+
+	"github.com/stretchr/testify/require"
+)
+
+// SummonerWatchBuilder builds a guild's watch on a League of Legends summoner
+type SummonerWatchBuilder struct {
+	f            *Factory
+	summonerName string
+	tagLine      string
+}
+
+// SummonerWatch starts a watch on a uniquely named summoner
+func (f *Factory) SummonerWatch() *SummonerWatchBuilder {
+	return &SummonerWatchBuilder{
+		f:            f,
+		summonerName: fmt.Sprintf("Summoner%d", uniqueID()),
+		tagLine:      "NA1",
+	}
+}
+
+// WithSummoner sets the watched Riot ID
+func (b *SummonerWatchBuilder) WithSummoner(summonerName, tagLine string) *SummonerWatchBuilder {
+	b.summonerName = summonerName
+	b.tagLine = tagLine
+	return b
+}
+
+// Create persists the watch, creating the summoner if needed
+func (b *SummonerWatchBuilder) Create(ctx context.Context) *entities.SummonerWatchDetail {
+	b.f.t.Helper()
+
+	repo := repository.NewSummonerWatchRepositoryScoped(b.f.db.Pool, b.f.guildID)
+	watch, err := repo.CreateWatch(ctx, b.f.guildID, b.summonerName, b.tagLine)
+	require.NoError(b.f.t, err, "factory: create summoner watch")
+	return watch
+}
+
+// WordleCompletionBuilder builds a user's daily Wordle result
+type WordleCompletionBuilder struct {
+	f           *Factory
+	user        *entities.User
+	guesses     int
+	completedAt time.Time
+}
+
+// WordleCompletion starts a four guess completion finished now
+func (f *Factory) WordleCompletion() *WordleCompletionBuilder {
+	return &WordleCompletionBuilder{f: f, guesses: 4, completedAt: time.Now()}
+}
+
+// WithUser sets the player
+func (b *WordleCompletionBuilder) WithUser(user *entities.User) *WordleCompletionBuilder {
+	b.user = user
+	return b
+}
+
+// WithGuesses sets how many guesses the puzzle took
+func (b *WordleCompletionBuilder) WithGuesses(guesses int) *WordleCompletionBuilder {
+	b.guesses = guesses
+	return b
+}
+
+// CompletedAt sets when the puzzle was solved
+func (b *WordleCompletionBuilder) CompletedAt(completedAt time.Time) *WordleCompletionBuilder {
+	b.completedAt = completedAt
+	return b
+}
+
+// Create persists the completion
+func (b *WordleCompletionBuilder) Create(ctx context.Context) *entities.WordleCompletion {
+	b.f.t.Helper()
+
+	if b.user == nil {
+		b.user = b.f.User().Create(ctx)
+	}
+
+	score, err := entities.NewWordleScore(b.guesses)
+	require.NoError(b.f.t, err, "factory: wordle score")
+	completion, err := entities.NewWordleCompletion(b.user.DiscordID, b.f.guildID, score, b.completedAt)
+	require.NoError(b.f.t, err, "factory: wordle completion")
+
+	repo := repository.NewWordleCompletionRepositoryScoped(b.f.db.Pool, b.f.guildID)
+	require.NoError(b.f.t, repo.Create(ctx, completion), "factory: create wordle completion")
+	return completion
+}
+
+// HighRollerPurchaseBuilder builds a purchase of the high roller role
+type HighRollerPurchaseBuilder struct {
+	f           *Factory
+	buyer       *entities.User
+	price       int64
+	purchasedAt time.Time
+}
+
+// HighRollerPurchase starts a 10000 bit purchase made now
+func (f *Factory) HighRollerPurchase() *HighRollerPurchaseBuilder {
+	return &HighRollerPurchaseBuilder{f: f, price: 10000, purchasedAt: time.Now()}
+}
+
+// WithBuyer sets the user who bought the role
+func (b *HighRollerPurchaseBuilder) WithBuyer(buyer *entities.User) *HighRollerPurchaseBuilder {
+	b.buyer = buyer
+	return b
+}
+
+// WithPrice sets the purchase price
+func (b *HighRollerPurchaseBuilder) WithPrice(price int64) *HighRollerPurchaseBuilder {
+	b.price = price
+	return b
+}
+
+// PurchasedAt sets when the role was bought
+func (b *HighRollerPurchaseBuilder) PurchasedAt(purchasedAt time.Time) *HighRollerPurchaseBuilder {
+	b.purchasedAt = purchasedAt
+	return b
+}
+
+// Create persists the purchase
+func (b *HighRollerPurchaseBuilder) Create(ctx context.Context) *entities.HighRollerPurchase {
+	b.f.t.Helper()
+
+	if b.buyer == nil {
+		b.buyer = b.f.User().Create(ctx)
+	}
+
+	purchase := &entities.HighRollerPurchase{
+		GuildID:       b.f.guildID,
+		DiscordID:     b.buyer.DiscordID,
+		PurchasePrice: b.price,
+		PurchasedAt:   b.purchasedAt,
+	}
+	repo := repository.NewHighRollerPurchaseRepositoryScoped(b.f.db.Pool, b.f.guildID)
+	require.NoError(b.f.t, repo.CreatePurchase(ctx, purchase), "factory: create high roller purchase")
+	return purchase
+}
@@ -0,0 +1,179 @@
+// Package factories provides fluent builders that persist valid test data for repository and
+// service integration tests, e.g. f.User().WithBalance(10000).Create(ctx).
+//
+// Every builder starts from defaults that satisfy the schema and domain rules, so tests only
+// set the fields they care about. Related rows a builder needs (such as the creator of a
+// wager) are created automatically unless the test supplies them.
+package factories
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"gambler/discord-client/database"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/repository"
+
+	"github.com/stretchr/testify/require"
+)
+
+// DefaultBalance is the balance given to users created without an explicit balance
+const DefaultBalance int64 = 100000
+
+// nextID hands out IDs that are unique across every factory in the test binary so
+// parallel tests sharing a guild never collide
+var nextID atomic.Int64
+
+func init() {
+	nextID.Store(900000000)
+}
+
+// uniqueID returns a fresh ID for Discord snowflakes and message IDs
+func uniqueID() int64 {
+	return nextID.Add(1)
+}
+
+// Factory creates test data for a single guild
+type Factory struct {
+	t       testing.TB
+	db      *database.DB
+	guildID int64
+}
+
+// New creates a factory that writes to db on behalf of the given guild
+func New(t testing.TB, db *database.DB, guildID int64) *Factory {
+	return &Factory{t: t, db: db, guildID: guildID}
+}
+
+// GuildID returns the guild the factory writes to
+func (f *Factory) GuildID() int64 {
+	return f.guildID
+}
+
+// ForGuild returns a factory for another guild sharing the same database
+func (f *Factory) ForGuild(guildID int64) *Factory {
+	return New(f.t, f.db, guildID)
+}
+
+// UserBuilder builds a guild member
+type UserBuilder struct {
+	f         *Factory
+	discordID int64
+	username  string
+	balance   int64
+}
+
+// User starts a user with a unique Discord ID and the default balance
+func (f *Factory) User() *UserBuilder {
+	return &UserBuilder{f: f, balance: DefaultBalance}
+}
+
+// WithDiscordID sets the user's Discord ID
+func (b *UserBuilder) WithDiscordID(discordID int64) *UserBuilder {
+	b.discordID = discordID
+	return b
+}
+
+// WithUsername sets the user's username
+func (b *UserBuilder) WithUsername(username string) *UserBuilder {
+	b.username = username
+	return b
+}
+
+// WithBalance sets the user's starting balance
+func (b *UserBuilder) WithBalance(balance int64) *UserBuilder {
+	b.balance = balance
+	return b
+}
+
+// Create persists the user
+func (b *UserBuilder) Create(ctx context.Context) *entities.User {
+	b.f.t.Helper()
+
+	if b.discordID == 0 {
+		b.discordID = uniqueID()
+	}
+	if b.username == "" {
+		b.username = fmt.Sprintf("user%d", b.discordID)
+	}
+
+	user, err := repository.NewUserRepositoryScoped(b.f.db.Pool, b.f.guildID).Create(ctx, b.discordID, b.username, b.balance)
+	require.NoError(b.f.t, err, "factory: create user")
+	return user
+}
+
+// GuildSettingsBuilder builds the settings row of the factory's guild
+type GuildSettingsBuilder struct {
+	f       *Factory
+	changes []func(*entities.GuildSettings)
+}
+
+// GuildSettings starts from the guild's default settings
+func (f *Factory) GuildSettings() *GuildSettingsBuilder {
+	return &GuildSettingsBuilder{f: f}
+}
+
+// With applies an arbitrary change for settings without a dedicated helper
+func (b *GuildSettingsBuilder) With(change func(*entities.GuildSettings)) *GuildSettingsBuilder {
+	b.changes = append(b.changes, change)
+	return b
+}
+
+// WithPrimaryChannel sets the primary channel
+func (b *GuildSettingsBuilder) WithPrimaryChannel(channelID int64) *GuildSettingsBuilder {
+	return b.With(func(s *entities.GuildSettings) { s.PrimaryChannelID = &channelID })
+}
+
+// WithLolChannel sets the League of Legends channel
+func (b *GuildSettingsBuilder) WithLolChannel(channelID int64) *GuildSettingsBuilder {
+	return b.With(func(s *entities.GuildSettings) { s.LolChannelID = &channelID })
+}
+
+// WithTftChannel sets the TFT channel
+func (b *GuildSettingsBuilder) WithTftChannel(channelID int64) *GuildSettingsBuilder {
+	return b.With(func(s *entities.GuildSettings) { s.TftChannelID = &channelID })
+}
+
+// WithWordleChannel sets the Wordle channel
+func (b *GuildSettingsBuilder) WithWordleChannel(channelID int64) *GuildSettingsBuilder {
+	return b.With(func(s *entities.GuildSettings) { s.WordleChannelID = &channelID })
+}
+
+// WithLottoChannel sets the lottery channel
+func (b *GuildSettingsBuilder) WithLottoChannel(channelID int64) *GuildSettingsBuilder {
+	return b.With(func(s *entities.GuildSettings) { s.LottoChannelID = &channelID })
+}
+
+// WithLottery sets the lottery difficulty and ticket cost
+func (b *GuildSettingsBuilder) WithLottery(difficulty, ticketCost int64) *GuildSettingsBuilder {
+	return b.With(func(s *entities.GuildSettings) {
+		s.LottoDifficulty = &difficulty
+		s.LottoTicketCost = &ticketCost
+	})
+}
+
+// WithStartingBalance sets the balance given to new members
+func (b *GuildSettingsBuilder) WithStartingBalance(balance int64) *GuildSettingsBuilder {
+	return b.With(func(s *entities.GuildSettings) { s.StartingBalance = &balance })
+}
+
+// Create persists the settings. Changes are stored as given so tests can set up values
+// the settings commands would reject, such as a tiny lottery difficulty to force collisions.
+func (b *GuildSettingsBuilder) Create(ctx context.Context) *entities.GuildSettings {
+	b.f.t.Helper()
+
+	repo := repository.NewGuildSettingsRepositoryWithTx(b.f.db.Pool)
+	settings, err := repo.GetOrCreateGuildSettings(ctx, b.f.guildID)
+	require.NoError(b.f.t, err, "factory: get guild settings")
+
+	if len(b.changes) == 0 {
+		return settings
+	}
+	for _, change := range b.changes {
+		change(settings)
+	}
+	require.NoError(b.f.t, repo.UpdateGuildSettings(ctx, settings), "factory: update guild settings")
+	return settings
+}
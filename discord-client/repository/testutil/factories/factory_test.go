@@ -0,0 +1,82 @@
+package factories_test
+
+import (
+	"context"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/repository"
+	"gambler/discord-client/repository/testutil"
+	"gambler/discord-client/repository/testutil/factories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactory_Integration(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDatabase(t)
+	guildID := int64(424242)
+	f := factories.New(t, testDB.DB, guildID)
+	ctx := context.Background()
+
+	t.Run("user defaults are unique and persisted", func(t *testing.T) {
+		first := f.User().Create(ctx)
+		second := f.User().WithBalance(10000).Create(ctx)
+
+		assert.NotEqual(t, first.DiscordID, second.DiscordID)
+		assert.Equal(t, factories.DefaultBalance, first.Balance)
+
+		stored, err := repository.NewUserRepositoryScoped(testDB.DB.Pool, guildID).GetByDiscordID(ctx, second.DiscordID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10000), stored.Balance)
+	})
+
+	t.Run("group wager bets keep totals in step", func(t *testing.T) {
+		detail := f.GroupWager().AsHouse(1.5, 3.0).Create(ctx)
+		require.Len(t, detail.Options, 2)
+
+		f.GroupWagerBet(detail).OnOption(1).WithAmount(500).Create(ctx)
+		f.GroupWagerBet(detail).OnOption(1).WithAmount(250).Create(ctx)
+
+		stored, err := repository.NewGroupWagerRepositoryScoped(testDB.DB.Pool, guildID).GetDetailByID(ctx, detail.Wager.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entities.GroupWagerTypeHouse, stored.Wager.WagerType)
+		assert.Equal(t, int64(750), stored.Wager.TotalPot)
+		assert.Equal(t, int64(750), stored.Options[1].TotalAmount)
+		require.Len(t, stored.Participants, 2)
+		require.NotNil(t, stored.Participants[0].LockedMultiplier)
+		assert.Equal(t, 3.0, *stored.Participants[0].LockedMultiplier)
+	})
+
+	t.Run("lottery tickets charge the owner and fund the pot", func(t *testing.T) {
+		f.GuildSettings().WithLottery(8, 500).Create(ctx)
+		draw := f.LotteryDraw().WithTicketCost(500).Create(ctx)
+		owner := f.User().WithBalance(2000).Create(ctx)
+
+		f.LotteryTicket(draw).WithOwner(owner).WithNumber(7).Create(ctx)
+
+		assert.Equal(t, int64(1500), owner.Balance)
+		count, err := repository.NewLotteryTicketRepositoryScoped(testDB.DB.Pool, guildID).CountTicketsForDraw(ctx, draw.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("remaining builders persist valid rows", func(t *testing.T) {
+		wager := f.Wager().WithAmount(2500).Create(ctx)
+		assert.NotZero(t, wager.ID)
+
+		deposit := f.SavingsDeposit().WithTerm(2, 5).Create(ctx)
+		assert.Equal(t, int64(100), deposit.BonusAmount)
+
+		watch := f.SummonerWatch().WithSummoner("Faker", "KR1").Create(ctx)
+		assert.Equal(t, guildID, watch.GuildID)
+
+		assert.NotZero(t, f.WordleCompletion().WithGuesses(3).Create(ctx).ID)
+		assert.NotZero(t, f.HighRollerPurchase().Create(ctx).ID)
+	})
+}
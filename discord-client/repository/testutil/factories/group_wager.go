@@ -0,0 +1,199 @@
+package factories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/repository"
+
+	"github.com/stretchr/testify/require"
+)
+
+// DefaultHouseOdds is the multiplier given to house wager options created without explicit odds
+const DefaultHouseOdds = 2.0
+
+// GroupWagerBuilder builds a pool or house group wager with its options
+type GroupWagerBuilder struct {
+	f            *Factory
+	creator      *entities.User
+	condition    string
+	wagerType    entities.GroupWagerType
+	state        entities.GroupWagerState
+	options      []string
+	odds         []float64
+	votingEndsAt time.Time
+	externalRef  *entities.ExternalReference
+}
+
+// GroupWager starts an active pool wager with "Yes" and "No" options and an hour of betting left
+func (f *Factory) GroupWager() *GroupWagerBuilder {
+	return &GroupWagerBuilder{
+		f:            f,
+		condition:    "Test group wager",
+		wagerType:    entities.GroupWagerTypePool,
+		state:        entities.GroupWagerStateActive,
+		options:      []string{"Yes", "No"},
+		votingEndsAt: time.Now().Add(time.Hour),
+	}
+}
+
+// WithCreator sets the user who created the wager
+func (b *GroupWagerBuilder) WithCreator(creator *entities.User) *GroupWagerBuilder {
+	b.creator = creator
+	return b
+}
+
+// WithCondition sets the wager condition
+func (b *GroupWagerBuilder) WithCondition(condition string) *GroupWagerBuilder {
+	b.condition = condition
+	return b
+}
+
+// WithOptions replaces the default options
+func (b *GroupWagerBuilder) WithOptions(options ...string) *GroupWagerBuilder {
+	b.options = options
+	return b
+}
+
+// AsHouse makes the wager a house wager. Odds are matched to options by position and
+// options without odds get DefaultHouseOdds.
+func (b *GroupWagerBuilder) AsHouse(odds ...float64) *GroupWagerBuilder {
+	b.wagerType = entities.GroupWagerTypeHouse
+	b.odds = odds
+	return b
+}
+
+// WithState sets the wager state
+func (b *GroupWagerBuilder) WithState(state entities.GroupWagerState) *GroupWagerBuilder {
+	b.state = state
+	return b
+}
+
+// WithVotingEndsAt sets when betting closes
+func (b *GroupWagerBuilder) WithVotingEndsAt(endsAt time.Time) *GroupWagerBuilder {
+	b.votingEndsAt = endsAt
+	return b
+}
+
+// WithExternalRef links the wager to an external game
+func (b *GroupWagerBuilder) WithExternalRef(system entities.ExternalSystem, id string) *GroupWagerBuilder {
+	b.externalRef = &entities.ExternalReference{System: system, ID: id}
+	return b
+}
+
+// Create persists the wager and its options and returns the stored detail
+func (b *GroupWagerBuilder) Create(ctx context.Context) *entities.GroupWagerDetail {
+	b.f.t.Helper()
+	require.GreaterOrEqual(b.f.t, len(b.options), 2, "factory: group wager needs at least two options")
+
+	if b.creator == nil {
+		b.creator = b.f.User().Create(ctx)
+	}
+
+	votingStartsAt := time.Now()
+	votingEndsAt := b.votingEndsAt
+	wager := &entities.GroupWager{
+		CreatorDiscordID:    &b.creator.DiscordID,
+		GuildID:             b.f.guildID,
+		Condition:           b.condition,
+		State:               b.state,
+		WagerType:           b.wagerType,
+		MinParticipants:     2,
+		VotingPeriodMinutes: int(votingEndsAt.Sub(votingStartsAt).Minutes()),
+		VotingStartsAt:      &votingStartsAt,
+		VotingEndsAt:        &votingEndsAt,
+		MessageID:           uniqueID(),
+		ChannelID:           uniqueID(),
+		ExternalRef:         b.externalRef,
+	}
+
+	options := make([]*entities.GroupWagerOption, len(b.options))
+	for i, text := range b.options {
+		options[i] = &entities.GroupWagerOption{
+			OptionText:  text,
+			OptionOrder: int16(i),
+		}
+		if b.wagerType == entities.GroupWagerTypeHouse {
+			options[i].OddsMultiplier = DefaultHouseOdds
+			if i < len(b.odds) {
+				options[i].OddsMultiplier = b.odds[i]
+			}
+		}
+	}
+
+	repo := repository.NewGroupWagerRepositoryScoped(b.f.db.Pool, b.f.guildID)
+	require.NoError(b.f.t, repo.CreateWithOptions(ctx, wager, options), "factory: create group wager")
+
+	detail, err := repo.GetDetailByID(ctx, wager.ID)
+	require.NoError(b.f.t, err, "factory: load group wager")
+	return detail
+}
+
+// GroupWagerBetBuilder builds a participant's bet on a group wager
+type GroupWagerBetBuilder struct {
+	f      *Factory
+	detail *entities.GroupWagerDetail
+	user   *entities.User
+	option int
+	amount int64
+}
+
+// GroupWagerBet starts a 1000 bit bet on the first option of the wager
+func (f *Factory) GroupWagerBet(detail *entities.GroupWagerDetail) *GroupWagerBetBuilder {
+	return &GroupWagerBetBuilder{f: f, detail: detail, amount: 1000}
+}
+
+// WithUser sets the user placing the bet
+func (b *GroupWagerBetBuilder) WithUser(user *entities.User) *GroupWagerBetBuilder {
+	b.user = user
+	return b
+}
+
+// OnOption sets the option by its position in the wager
+func (b *GroupWagerBetBuilder) OnOption(index int) *GroupWagerBetBuilder {
+	b.option = index
+	return b
+}
+
+// WithAmount sets the bet amount
+func (b *GroupWagerBetBuilder) WithAmount(amount int64) *GroupWagerBetBuilder {
+	b.amount = amount
+	return b
+}
+
+// Create persists the bet and keeps the option total and wager pot in step with it.
+// The passed detail is updated so later bets build on the new totals.
+func (b *GroupWagerBetBuilder) Create(ctx context.Context) *entities.GroupWagerParticipant {
+	b.f.t.Helper()
+	require.Less(b.f.t, b.option, len(b.detail.Options), fmt.Sprintf("factory: wager has no option %d", b.option))
+
+	if b.user == nil {
+		b.user = b.f.User().Create(ctx)
+	}
+
+	option := b.detail.Options[b.option]
+	participant := &entities.GroupWagerParticipant{
+		GroupWagerID: b.detail.Wager.ID,
+		DiscordID:    b.user.DiscordID,
+		OptionID:     option.ID,
+		Amount:       b.amount,
+	}
+	if b.detail.Wager.WagerType == entities.GroupWagerTypeHouse {
+		multiplier := option.OddsMultiplier
+		participant.LockedMultiplier = &multiplier
+	}
+
+	repo := repository.NewGroupWagerRepositoryScoped(b.f.db.Pool, b.f.guildID)
+	require.NoError(b.f.t, repo.SaveParticipant(ctx, participant), "factory: save participant")
+
+	option.TotalAmount += b.amount
+	require.NoError(b.f.t, repo.UpdateOptionTotal(ctx, option.ID, option.TotalAmount), "factory: update option total")
+
+	b.detail.Wager.TotalPot += b.amount
+	require.NoError(b.f.t, repo.Update(ctx, b.detail.Wager), "factory: update wager pot")
+
+	b.detail.Participants = append(b.detail.Participants, participant)
+	return participant
+}
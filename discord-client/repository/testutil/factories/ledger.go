@@ -0,0 +1,226 @@
+package factories
+
+import (
+	"context"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/repository"
+
+	"github.com/stretchr/testify/require"
+)
+
+// BalanceHistoryBuilder builds a balance history entry and applies it to the user's balance
+type BalanceHistoryBuilder struct {
+	f           *Factory
+	user        *entities.User
+	txType      entities.TransactionType
+	change      int64
+	relatedID   *int64
+	relatedType *entities.RelatedType
+	metadata    map[string]interface{}
+}
+
+// BalanceHistory starts a 1000 bit incoming transfer
+func (f *Factory) BalanceHistory() *BalanceHistoryBuilder {
+	return &BalanceHistoryBuilder{
+		f:        f,
+		txType:   entities.TransactionTypeTransferIn,
+		change:   1000,
+		metadata: map[string]interface{}{},
+	}
+}
+
+// WithUser sets the user whose balance changes
+func (b *BalanceHistoryBuilder) WithUser(user *entities.User) *BalanceHistoryBuilder {
+	b.user = user
+	return b
+}
+
+// WithType sets the transaction type
+func (b *BalanceHistoryBuilder) WithType(txType entities.TransactionType) *BalanceHistoryBuilder {
+	b.txType = txType
+	return b
+}
+
+// WithChange sets the signed balance change
+func (b *BalanceHistoryBuilder) WithChange(change int64) *BalanceHistoryBuilder {
+	b.change = change
+	return b
+}
+
+// WithRelated links the entry to the wager, bet or other entity that caused it
+func (b *BalanceHistoryBuilder) WithRelated(relatedType entities.RelatedType, relatedID int64) *BalanceHistoryBuilder {
+	b.relatedType = &relatedType
+	b.relatedID = &relatedID
+	return b
+}
+
+// WithMetadata adds a transaction metadata value
+func (b *BalanceHistoryBuilder) WithMetadata(key string, value interface{}) *BalanceHistoryBuilder {
+	b.metadata[key] = value
+	return b
+}
+
+// Create records the entry and updates the user's stored balance to match it
+func (b *BalanceHistoryBuilder) Create(ctx context.Context) *entities.BalanceHistory {
+	b.f.t.Helper()
+
+	if b.user == nil {
+		b.user = b.f.User().Create(ctx)
+	}
+
+	history := &entities.BalanceHistory{
+		DiscordID:           b.user.DiscordID,
+		GuildID:             b.f.guildID,
+		BalanceBefore:       b.user.Balance,
+		BalanceAfter:        b.user.Balance + b.change,
+		ChangeAmount:        b.change,
+		TransactionType:     b.txType,
+		TransactionMetadata: b.metadata,
+		RelatedID:           b.relatedID,
+		RelatedType:         b.relatedType,
+	}
+	histories := repository.NewBalanceHistoryRepositoryScoped(b.f.db.Pool, b.f.guildID)
+	require.NoError(b.f.t, histories.Record(ctx, history), "factory: record balance history")
+
+	users := repository.NewUserRepositoryScoped(b.f.db.Pool, b.f.guildID)
+	require.NoError(b.f.t, users.UpdateBalance(ctx, b.user.DiscordID, history.BalanceAfter), "factory: update balance")
+	b.user.Balance = history.BalanceAfter
+
+	return history
+}
+
+// WagerBuilder builds a 1v1 wager
+type WagerBuilder struct {
+	f         *Factory
+	proposer  *entities.User
+	target    *entities.User
+	amount    int64
+	condition string
+	state     entities.WagerState
+}
+
+// Wager starts a proposed 1000 bit wager between two new users
+func (f *Factory) Wager() *WagerBuilder {
+	return &WagerBuilder{
+		f:         f,
+		amount:    1000,
+		condition: "Test wager",
+		state:     entities.WagerStateProposed,
+	}
+}
+
+// Between sets the proposer and target
+func (b *WagerBuilder) Between(proposer, target *entities.User) *WagerBuilder {
+	b.proposer = proposer
+	b.target = target
+	return b
+}
+
+// WithAmount sets the amount each side stakes
+func (b *WagerBuilder) WithAmount(amount int64) *WagerBuilder {
+	b.amount = amount
+	return b
+}
+
+// WithCondition sets the wager condition
+func (b *WagerBuilder) WithCondition(condition string) *WagerBuilder {
+	b.condition = condition
+	return b
+}
+
+// WithState sets the wager state
+func (b *WagerBuilder) WithState(state entities.WagerState) *WagerBuilder {
+	b.state = state
+	return b
+}
+
+// Create persists the wager
+func (b *WagerBuilder) Create(ctx context.Context) *entities.Wager {
+	b.f.t.Helper()
+
+	if b.proposer == nil {
+		b.proposer = b.f.User().Create(ctx)
+	}
+	if b.target == nil {
+		b.target = b.f.User().Create(ctx)
+	}
+
+	messageID, channelID := uniqueID(), uniqueID()
+	wager := &entities.Wager{
+		ProposerDiscordID: b.proposer.DiscordID,
+		TargetDiscordID:   b.target.DiscordID,
+		GuildID:           b.f.guildID,
+		Amount:            b.amount,
+		Condition:         b.condition,
+		State:             b.state,
+		MessageID:         &messageID,
+		ChannelID:         &channelID,
+	}
+	repo := repository.NewWagerRepositoryScoped(b.f.db.Pool, b.f.guildID)
+	require.NoError(b.f.t, repo.Create(ctx, wager), "factory: create wager")
+	return wager
+}
+
+// SavingsDepositBuilder builds a locked savings deposit
+type SavingsDepositBuilder struct {
+	f            *Factory
+	owner        *entities.User
+	amount       int64
+	termWeeks    int
+	bonusPercent int
+	lockedAt     time.Time
+}
+
+// SavingsDeposit starts a one week 1000 bit deposit locked now
+func (f *Factory) SavingsDeposit() *SavingsDepositBuilder {
+	return &SavingsDepositBuilder{
+		f:            f,
+		amount:       1000,
+		termWeeks:    entities.MinSavingsTermWeeks,
+		bonusPercent: entities.DefaultSavingsBonusPercent,
+		lockedAt:     time.Now(),
+	}
+}
+
+// WithOwner sets the depositor
+func (b *SavingsDepositBuilder) WithOwner(owner *entities.User) *SavingsDepositBuilder {
+	b.owner = owner
+	return b
+}
+
+// WithAmount sets the deposited amount
+func (b *SavingsDepositBuilder) WithAmount(amount int64) *SavingsDepositBuilder {
+	b.amount = amount
+	return b
+}
+
+// WithTerm sets the term and weekly bonus percent
+func (b *SavingsDepositBuilder) WithTerm(weeks, bonusPercentPerWeek int) *SavingsDepositBuilder {
+	b.termWeeks = weeks
+	b.bonusPercent = bonusPercentPerWeek
+	return b
+}
+
+// LockedAt sets when the deposit was made, e.g. in the past to create a matured deposit
+func (b *SavingsDepositBuilder) LockedAt(lockedAt time.Time) *SavingsDepositBuilder {
+	b.lockedAt = lockedAt
+	return b
+}
+
+// Create persists the deposit
+func (b *SavingsDepositBuilder) Create(ctx context.Context) *entities.SavingsDeposit {
+	b.f.t.Helper()
+
+	if b.owner == nil {
+		b.owner = b.f.User().Create(ctx)
+	}
+
+	deposit, err := entities.NewSavingsDeposit(b.f.guildID, b.owner.DiscordID, b.amount, b.termWeeks, b.bonusPercent, b.lockedAt)
+	require.NoError(b.f.t, err, "factory: savings deposit")
+
+	repo := repository.NewSavingsDepositRepositoryScoped(b.f.db.Pool, b.f.guildID)
+	require.NoError(b.f.t, repo.Create(ctx, deposit), "factory: create savings deposit")
+	return deposit
+}
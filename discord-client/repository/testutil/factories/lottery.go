@@ -0,0 +1,127 @@
+package factories
+
+import (
+	"context"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/repository"
+
+	"github.com/stretchr/testify/require"
+)
+
+// LotteryDrawBuilder builds the guild's open lottery draw
+type LotteryDrawBuilder struct {
+	f          *Factory
+	difficulty int64
+	ticketCost int64
+	drawTime   time.Time
+	pot        int64
+}
+
+// LotteryDraw starts an open draw a day out using the default difficulty and ticket cost
+func (f *Factory) LotteryDraw() *LotteryDrawBuilder {
+	return &LotteryDrawBuilder{
+		f:          f,
+		difficulty: entities.DefaultLottoDifficulty,
+		ticketCost: entities.DefaultLottoTicketCost,
+		drawTime:   time.Now().Add(24 * time.Hour),
+	}
+}
+
+// WithDifficulty sets the draw difficulty
+func (b *LotteryDrawBuilder) WithDifficulty(difficulty int64) *LotteryDrawBuilder {
+	b.difficulty = difficulty
+	return b
+}
+
+// WithTicketCost sets the ticket cost
+func (b *LotteryDrawBuilder) WithTicketCost(ticketCost int64) *LotteryDrawBuilder {
+	b.ticketCost = ticketCost
+	return b
+}
+
+// WithDrawTime sets when the draw happens
+func (b *LotteryDrawBuilder) WithDrawTime(drawTime time.Time) *LotteryDrawBuilder {
+	b.drawTime = drawTime
+	return b
+}
+
+// WithPot seeds the pot
+func (b *LotteryDrawBuilder) WithPot(pot int64) *LotteryDrawBuilder {
+	b.pot = pot
+	return b
+}
+
+// Create persists the draw, or returns the guild's current open draw if it already has one
+func (b *LotteryDrawBuilder) Create(ctx context.Context) *entities.LotteryDraw {
+	b.f.t.Helper()
+
+	repo := repository.NewLotteryDrawRepositoryScoped(b.f.db.Pool, b.f.guildID)
+	draw, err := repo.GetOrCreateCurrentDraw(ctx, b.f.guildID, b.drawTime, b.difficulty, b.ticketCost)
+	require.NoError(b.f.t, err, "factory: create lottery draw")
+
+	if b.pot > 0 {
+		require.NoError(b.f.t, repo.IncrementPot(ctx, draw.ID, b.pot), "factory: seed lottery pot")
+		draw.TotalPot += b.pot
+	}
+	return draw
+}
+
+// LotteryTicketBuilder builds a ticket in a draw
+type LotteryTicketBuilder struct {
+	f      *Factory
+	draw   *entities.LotteryDraw
+	owner  *entities.User
+	number int64
+}
+
+// LotteryTicket starts a ticket for number 0 in the draw
+func (f *Factory) LotteryTicket(draw *entities.LotteryDraw) *LotteryTicketBuilder {
+	return &LotteryTicketBuilder{f: f, draw: draw}
+}
+
+// WithOwner sets the ticket holder
+func (b *LotteryTicketBuilder) WithOwner(owner *entities.User) *LotteryTicketBuilder {
+	b.owner = owner
+	return b
+}
+
+// WithNumber sets the ticket number
+func (b *LotteryTicketBuilder) WithNumber(number int64) *LotteryTicketBuilder {
+	b.number = number
+	return b
+}
+
+// Create persists the ticket along with the purchase's balance history entry and pot increase
+func (b *LotteryTicketBuilder) Create(ctx context.Context) *entities.LotteryTicket {
+	b.f.t.Helper()
+
+	if b.owner == nil {
+		b.owner = b.f.User().Create(ctx)
+	}
+
+	history := b.f.BalanceHistory().
+		WithUser(b.owner).
+		WithType(entities.TransactionTypeLottoTicket).
+		WithChange(-b.draw.TicketCost).
+		Create(ctx)
+
+	ticket := &entities.LotteryTicket{
+		DrawID:           b.draw.ID,
+		GuildID:          b.f.guildID,
+		DiscordID:        b.owner.DiscordID,
+		TicketNumber:     b.number,
+		PurchasePrice:    b.draw.TicketCost,
+		PurchasedAt:      time.Now(),
+		BalanceHistoryID: history.ID,
+	}
+	tickets := repository.NewLotteryTicketRepositoryScoped(b.f.db.Pool, b.f.guildID)
+	require.NoError(b.f.t, tickets.CreateBatch(ctx, []*entities.LotteryTicket{ticket}), "factory: create lottery ticket")
+
+	draws := repository.NewLotteryDrawRepositoryScoped(b.f.db.Pool, b.f.guildID)
+	require.NoError(b.f.t, draws.IncrementPot(ctx, b.draw.ID, b.draw.TicketCost), "factory: add ticket to pot")
+	b.draw.TotalPot += b.draw.TicketCost
+
+	return ticket
+}
@@ -621,4 +621,104 @@ func testGetAllAvailableBalance(t *testing.T, testDB *testutil.TestDatabase, ctx
 	// user2: 60000 - 15000 = 45000
 	assert.Equal(t, int64(60000), user2.Balance)
 	assert.Equal(t, int64(45000), user2.AvailableBalance)
-}
\ No newline at end of file
+}
+
+// TestUserRepository_GetLockedBalanceBreakdown tests the single-statement locked balance breakdown
+func TestUserRepository_GetLockedBalanceBreakdown(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	ctx := context.Background()
+	guildID := int64(123456789)
+
+	userRepo := NewUserRepositoryScoped(testDB.DB.Pool, guildID)
+	wagerRepo := NewWagerRepositoryScoped(testDB.DB.Pool, guildID)
+	groupWagerRepo := NewGroupWagerRepositoryScoped(testDB.DB.Pool, guildID)
+	drawRepo := NewLotteryDrawRepositoryScoped(testDB.DB.Pool, guildID)
+	ticketRepo := NewLotteryTicketRepositoryScoped(testDB.DB.Pool, guildID)
+
+	userID := int64(987654321)
+	otherUserID := int64(987654322)
+	_, err := userRepo.Create(ctx, userID, "testuser", 100000)
+	require.NoError(t, err)
+	_, err = userRepo.Create(ctx, otherUserID, "otheruser", 100000)
+	require.NoError(t, err)
+
+	t.Run("nothing locked", func(t *testing.T) {
+		breakdown, err := userRepo.GetLockedBalanceBreakdown(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, entities.LockedBalanceBreakdown{}, *breakdown)
+	})
+
+	// Voting wagers lock for both sides; proposed wagers do not
+	for _, w := range []*entities.Wager{
+		{ProposerDiscordID: userID, TargetDiscordID: otherUserID, Amount: 5000, Condition: "as proposer", State: entities.WagerStateVoting, GuildID: guildID},
+		{ProposerDiscordID: otherUserID, TargetDiscordID: userID, Amount: 3000, Condition: "as target", State: entities.WagerStateVoting, GuildID: guildID},
+		{ProposerDiscordID: userID, TargetDiscordID: otherUserID, Amount: 7000, Condition: "not accepted", State: entities.WagerStateProposed, GuildID: guildID},
+	} {
+		require.NoError(t, wagerRepo.Create(ctx, w))
+	}
+
+	// Active group wager participation
+	now := time.Now()
+	votingEnds := now.Add(time.Hour)
+	groupWager := &entities.GroupWager{
+		CreatorDiscordID:    &userID,
+		Condition:           "Locked breakdown group wager",
+		State:               entities.GroupWagerStateActive,
+		WagerType:           entities.GroupWagerTypePool,
+		MinParticipants:     2,
+		VotingPeriodMinutes: 60,
+		VotingStartsAt:      &now,
+		VotingEndsAt:        &votingEnds,
+		GuildID:             guildID,
+	}
+	options := []*entities.GroupWagerOption{
+		{OptionText: "Option A", OptionOrder: 0, OddsMultiplier: 1.0},
+		{OptionText: "Option B", OptionOrder: 1, OddsMultiplier: 1.0},
+	}
+	require.NoError(t, groupWagerRepo.CreateWithOptions(ctx, groupWager, options))
+	require.NoError(t, groupWagerRepo.SaveParticipant(ctx, &entities.GroupWagerParticipant{
+		GroupWagerID: groupWager.ID,
+		DiscordID:    userID,
+		OptionID:     options[0].ID,
+		Amount:       20000,
+	}))
+
+	// Tickets in the open lottery draw
+	draw, err := drawRepo.GetOrCreateCurrentDraw(ctx, guildID, now.Add(24*time.Hour), 8, 1000)
+	require.NoError(t, err)
+	require.NoError(t, ticketRepo.CreateBatch(ctx, []*entities.LotteryTicket{
+		{DrawID: draw.ID, DiscordID: userID, TicketNumber: 1, PurchasePrice: 1000, BalanceHistoryID: 1},
+		{DrawID: draw.ID, DiscordID: userID, TicketNumber: 2, PurchasePrice: 1000, BalanceHistoryID: 1},
+	}))
+
+	// An open duel challenge the user issued
+	_, err = testDB.DB.Pool.Exec(ctx, `
+		INSERT INTO duels (guild_id, challenger_discord_id, target_discord_id, amount, expires_at)
+		VALUES ($1, $2, $3, 4000, NOW() + INTERVAL '1 hour')`,
+		guildID, userID, otherUserID)
+	require.NoError(t, err)
+
+	// A pending parlay stake; settled parlays no longer lock anything
+	_, err = testDB.DB.Pool.Exec(ctx, `
+		INSERT INTO parlays (guild_id, discord_id, amount, status)
+		VALUES ($1, $2, 1500, 'pending'), ($1, $2, 9000, 'lost')`,
+		guildID, userID)
+	require.NoError(t, err)
+
+	t.Run("all locks summed", func(t *testing.T) {
+		breakdown, err := userRepo.GetLockedBalanceBreakdown(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(8000), breakdown.InWagers)
+		assert.Equal(t, int64(20000), breakdown.InGroupWagers)
+		assert.Equal(t, int64(2000), breakdown.InLottery)
+		assert.Equal(t, int64(1500), breakdown.InParlays)
+		assert.Equal(t, int64(4000), breakdown.InDuels)
+		assert.Equal(t, int64(33500), breakdown.Total())
+
+		// Total matches the available balance calculation
+		user, err := userRepo.GetByDiscordID(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, user.Balance-breakdown.Total(), user.AvailableBalance)
+	})
+}
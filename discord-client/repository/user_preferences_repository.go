@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// UserPreferencesRepository implements user preferences data access
+type UserPreferencesRepository struct {
+	q Queryable
+}
+
+// NewUserPreferencesRepositoryWithTx creates a new user preferences repository with a transaction
+func NewUserPreferencesRepositoryWithTx(tx Queryable) *UserPreferencesRepository {
+	return &UserPreferencesRepository{q: tx}
+}
+
+// GetByDiscordID returns a user's preferences, or nil if they have never set any
+func (r *UserPreferencesRepository) GetByDiscordID(ctx context.Context, discordID int64) (*entities.UserPreferences, error) {
+	query := `
+		SELECT discord_id, odds_format, hide_from_global_leaderboard, created_at, updated_at
+		FROM user_preferences
+		WHERE discord_id = $1
+	`
+
+	var prefs entities.UserPreferences
+	err := r.q.QueryRow(ctx, query, discordID).Scan(
+		&prefs.DiscordID,
+		&prefs.OddsFormat,
+		&prefs.HideFromGlobalLeaderboard,
+		&prefs.CreatedAt,
+		&prefs.UpdatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user preferences: %w", err)
+	}
+
+	return &prefs, nil
+}
+
+// Upsert creates or replaces a user's preferences
+func (r *UserPreferencesRepository) Upsert(ctx context.Context, prefs *entities.UserPreferences) error {
+	query := `
+		INSERT INTO user_preferences (discord_id, odds_format, hide_from_global_leaderboard)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (discord_id)
+		DO UPDATE SET odds_format = EXCLUDED.odds_format,
+		              hide_from_global_leaderboard = EXCLUDED.hide_from_global_leaderboard,
+		              updated_at = NOW()
+		RETURNING created_at, updated_at
+	`
+
+	err := r.q.QueryRow(ctx, query, prefs.DiscordID, prefs.OddsFormat, prefs.HideFromGlobalLeaderboard).Scan(&prefs.CreatedAt, &prefs.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save user preferences: %w", err)
+	}
+
+	return nil
+}
@@ -12,7 +12,7 @@ import (
 )
 
 // availableBalanceSQL is a reusable SQL fragment that calculates available balance
-// by subtracting locked amounts in active wagers and group wagers from total balance
+// by subtracting locked amounts in active wagers, group wagers, savings, parlays and open duels from total balance
 const availableBalanceSQL = `uga.balance - COALESCE(
 	(SELECT SUM(w.amount) 
 	 FROM wagers w 
@@ -28,6 +28,28 @@ const availableBalanceSQL = `uga.balance - COALESCE(
 	   AND gw.guild_id = uga.guild_id
 	   AND gw.state IN ('active', 'pending_resolution')),
 	0
+) - COALESCE(
+	(SELECT SUM(sd.amount)
+	 FROM savings_deposits sd
+	 WHERE sd.discord_id = uga.discord_id
+	   AND sd.guild_id = uga.guild_id
+	   AND sd.status = 'locked'),
+	0
+) - COALESCE(
+	(SELECT SUM(p.amount)
+	 FROM parlays p
+	 WHERE p.discord_id = uga.discord_id
+	   AND p.guild_id = uga.guild_id
+	   AND p.status = 'pending'),
+	0
+) - COALESCE(
+	(SELECT SUM(d.amount)
+	 FROM duels d
+	 WHERE d.challenger_discord_id = uga.discord_id
+	   AND d.guild_id = uga.guild_id
+	   AND d.status = 'pending'
+	   AND d.expires_at > NOW()),
+	0
 )`
 
 // UserRepository implements the UserRepository interface
@@ -49,6 +71,11 @@ func NewUserRepositoryScoped(tx Queryable, guildID int64) *UserRepository {
 	}
 }
 
+// NewUserRepositoryReadOnly creates a guild-scoped user repository on the read replica for heavy read paths.
+func NewUserRepositoryReadOnly(db *database.DB, guildID int64) *UserRepository {
+	return NewUserRepositoryScoped(db.ReadPool(), guildID)
+}
+
 // GetByDiscordID retrieves a user by their Discord ID in the current guild
 func (r *UserRepository) GetByDiscordID(ctx context.Context, discordID int64) (*entities.User, error) {
 	query := `
@@ -60,14 +87,31 @@ func (r *UserRepository) GetByDiscordID(ctx context.Context, discordID int64) (*
 			uga.created_at,
 			uga.updated_at,
 			u.username,
-			` + availableBalanceSQL + ` as available_balance
+			` + availableBalanceSQL + ` as available_balance,
+			gb.ends_at,
+			bf.id,
+			bf.moderator_discord_id,
+			bf.reason,
+			bf.expires_at,
+			bf.created_at
 		FROM user_guild_accounts uga
 		JOIN users u ON uga.discord_id = u.discord_id
+		LEFT JOIN gambling_breaks gb ON gb.discord_id = uga.discord_id
+			AND gb.guild_id = uga.guild_id
+			AND gb.ends_at > NOW()
+		LEFT JOIN balance_freezes bf ON bf.discord_id = uga.discord_id
+			AND bf.guild_id = uga.guild_id
+			AND bf.unfrozen_at IS NULL
+			AND (bf.expires_at IS NULL OR bf.expires_at > NOW())
 		WHERE uga.discord_id = $1 AND uga.guild_id = $2
 	`
 
 	var account entities.UserGuildAccount
 	var username string
+	var gamblingBreakEndsAt *time.Time
+	var freezeID, freezeModeratorID *int64
+	var freezeReason *string
+	var freezeExpiresAt, freezeCreatedAt *time.Time
 	err := r.q.QueryRow(ctx, query, discordID, r.guildID).Scan(
 		&account.ID,
 		&account.DiscordID,
@@ -77,6 +121,12 @@ func (r *UserRepository) GetByDiscordID(ctx context.Context, discordID int64) (*
 		&account.UpdatedAt,
 		&username,
 		&account.AvailableBalance,
+		&gamblingBreakEndsAt,
+		&freezeID,
+		&freezeModeratorID,
+		&freezeReason,
+		&freezeExpiresAt,
+		&freezeCreatedAt,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -88,12 +138,25 @@ func (r *UserRepository) GetByDiscordID(ctx context.Context, discordID int64) (*
 
 	// Return User model with balance information from guild account
 	user := &entities.User{
-		DiscordID:        account.DiscordID,
-		Username:         username,
-		Balance:          account.Balance,
-		AvailableBalance: account.AvailableBalance,
-		CreatedAt:        account.CreatedAt,
-		UpdatedAt:        account.UpdatedAt,
+		DiscordID:           account.DiscordID,
+		Username:            username,
+		Balance:             account.Balance,
+		AvailableBalance:    account.AvailableBalance,
+		GamblingBreakEndsAt: gamblingBreakEndsAt,
+		CreatedAt:           account.CreatedAt,
+		UpdatedAt:           account.UpdatedAt,
+	}
+
+	if freezeID != nil {
+		user.BalanceFreeze = &entities.BalanceFreeze{
+			ID:                 *freezeID,
+			GuildID:            account.GuildID,
+			DiscordID:          account.DiscordID,
+			ModeratorDiscordID: *freezeModeratorID,
+			Reason:             *freezeReason,
+			ExpiresAt:          freezeExpiresAt,
+			CreatedAt:          *freezeCreatedAt,
+		}
 	}
 
 	return user, nil
@@ -166,6 +229,118 @@ func (r *UserRepository) UpdateBalance(ctx context.Context, discordID int64, new
 	return nil
 }
 
+// SetGamblingBreak starts or extends a user's gambling break in the current guild.
+// An active break is never shortened; the later of the existing and requested end times wins.
+func (r *UserRepository) SetGamblingBreak(ctx context.Context, discordID int64, endsAt time.Time) (time.Time, error) {
+	query := `
+		INSERT INTO gambling_breaks (discord_id, guild_id, ends_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (discord_id, guild_id) DO UPDATE SET
+			ends_at = GREATEST(gambling_breaks.ends_at, EXCLUDED.ends_at),
+			updated_at = NOW()
+		RETURNING ends_at
+	`
+
+	var effectiveEndsAt time.Time
+	err := r.q.QueryRow(ctx, query, discordID, r.guildID, endsAt).Scan(&effectiveEndsAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to set gambling break for user %d in guild %d: %w", discordID, r.guildID, err)
+	}
+
+	return effectiveEndsAt, nil
+}
+
+// GetLockedBalanceBreakdown returns the amounts a user has tied up in wagers, group wagers,
+// pending lottery draws, savings and open duels in the current guild, computed in a single statement
+func (r *UserRepository) GetLockedBalanceBreakdown(ctx context.Context, discordID int64) (*entities.LockedBalanceBreakdown, error) {
+	// Proposer and target are summed separately so each side can use its covering index
+	query := `
+		SELECT
+			COALESCE((SELECT SUM(w.amount) FROM wagers w
+			          WHERE w.proposer_discord_id = $1 AND w.guild_id = $2 AND w.state = 'voting'), 0)
+			+ COALESCE((SELECT SUM(w.amount) FROM wagers w
+			            WHERE w.target_discord_id = $1 AND w.guild_id = $2 AND w.state = 'voting'), 0),
+			COALESCE((SELECT SUM(gwp.amount)
+			          FROM group_wager_participants gwp
+			          JOIN group_wagers gw ON gw.id = gwp.group_wager_id
+			          WHERE gwp.discord_id = $1
+			            AND gw.guild_id = $2
+			            AND gw.state IN ('active', 'pending_resolution')), 0),
+			COALESCE((SELECT SUM(lt.purchase_price)
+			          FROM lottery_tickets lt
+			          JOIN lottery_draws ld ON ld.id = lt.draw_id
+			          WHERE lt.discord_id = $1
+			            AND lt.guild_id = $2
+			            AND ld.completed_at IS NULL), 0),
+			COALESCE((SELECT SUM(sd.amount)
+			          FROM savings_deposits sd
+			          WHERE sd.discord_id = $1
+			            AND sd.guild_id = $2
+			            AND sd.status = 'locked'), 0),
+			COALESCE((SELECT SUM(p.amount)
+			          FROM parlays p
+			          WHERE p.discord_id = $1
+			            AND p.guild_id = $2
+			            AND p.status = 'pending'), 0),
+			COALESCE((SELECT SUM(d.amount)
+			          FROM duels d
+			          WHERE d.challenger_discord_id = $1
+			            AND d.guild_id = $2
+			            AND d.status = 'pending'
+			            AND d.expires_at > NOW()), 0)
+	`
+
+	var breakdown entities.LockedBalanceBreakdown
+	err := r.q.QueryRow(ctx, query, discordID, r.guildID).Scan(
+		&breakdown.InWagers,
+		&breakdown.InGroupWagers,
+		&breakdown.InLottery,
+		&breakdown.InSavings,
+		&breakdown.InParlays,
+		&breakdown.InDuels,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get locked balance breakdown for user %d in guild %d: %w", discordID, r.guildID, err)
+	}
+
+	return &breakdown, nil
+}
+
+// GetEconomyGauges returns a snapshot of the current guild's economy computed in a single statement
+func (r *UserRepository) GetEconomyGauges(ctx context.Context) (*entities.EconomyGauges, error) {
+	query := `
+		SELECT
+			COALESCE((SELECT SUM(uga.balance) FROM user_guild_accounts uga WHERE uga.guild_id = $1), 0),
+			COALESCE((SELECT SUM(gwp.amount)
+			          FROM group_wager_participants gwp
+			          JOIN group_wagers gw ON gw.id = gwp.group_wager_id
+			          WHERE gw.guild_id = $1
+			            AND gw.state IN ('active', 'pending_resolution')), 0)
+			+ COALESCE((SELECT SUM(w.amount) * 2 FROM wagers w
+			            WHERE w.guild_id = $1 AND w.state = 'voting'), 0),
+			COALESCE((SELECT SUM(ld.total_pot) FROM lottery_draws ld
+			          WHERE ld.guild_id = $1 AND ld.completed_at IS NULL), 0),
+			(SELECT COUNT(*) FROM group_wagers gw
+			 WHERE gw.guild_id = $1 AND gw.state IN ('active', 'pending_resolution'))
+			+ (SELECT COUNT(*) FROM wagers w WHERE w.guild_id = $1 AND w.state = 'voting'),
+			NOW()
+	`
+
+	gauges := entities.EconomyGauges{GuildID: r.guildID}
+	err := r.q.QueryRow(ctx, query, r.guildID).Scan(
+		&gauges.BitsInCirculation,
+		&gauges.BitsLockedInWagers,
+		&gauges.LotteryPot,
+		&gauges.ActiveWagers,
+		&gauges.RecordedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get economy gauges for guild %d: %w", r.guildID, err)
+	}
+
+	return &gauges, nil
+}
+
 // GetUsersWithPositiveBalance returns all users with balance > 0 in the current guild
 func (r *UserRepository) GetUsersWithPositiveBalance(ctx context.Context) ([]*entities.User, error) {
 	query := `
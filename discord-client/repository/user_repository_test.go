@@ -533,3 +533,36 @@ func TestUserRepository_GetScoreboardData(t *testing.T) {
 		assert.Equal(t, int64(47000), entry.AvailableBalance) // 50000 - 3000
 	})
 }
+
+func TestUserRepository_GetEconomyGauges(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	ctx := context.Background()
+
+	guildID := int64(123456789)
+	repo := NewUserRepositoryScoped(testDB.DB.Pool, guildID)
+
+	t.Run("empty guild", func(t *testing.T) {
+		gauges, err := repo.GetEconomyGauges(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, guildID, gauges.GuildID)
+		assert.Zero(t, gauges.BitsInCirculation)
+		assert.Zero(t, gauges.BitsLockedInWagers)
+		assert.Zero(t, gauges.LotteryPot)
+		assert.Zero(t, gauges.ActiveWagers)
+	})
+
+	t.Run("sums balances in the guild only", func(t *testing.T) {
+		_, err := repo.Create(ctx, 111111, "user1", 50000)
+		require.NoError(t, err)
+		_, err = repo.Create(ctx, 222222, "user2", 25000)
+		require.NoError(t, err)
+		_, err = NewUserRepositoryScoped(testDB.DB.Pool, guildID+1).Create(ctx, 333333, "elsewhere", 99999)
+		require.NoError(t, err)
+
+		gauges, err := repo.GetEconomyGauges(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(75000), gauges.BitsInCirculation)
+		assert.False(t, gauges.RecordedAt.IsZero())
+	})
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gambler/discord-client/database"
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/domain/interfaces"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// UserStatsRepository implements the UserStatsRepository interface over the user_stats table.
+// Rows are written by triggers on bets and wagers, so this repository only reads and refreshes them.
+type UserStatsRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewUserStatsRepositoryScoped creates a new user stats repository with a transaction and guild scope
+func NewUserStatsRepositoryScoped(tx Queryable, guildID int64) interfaces.UserStatsRepository {
+	return &UserStatsRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// NewUserStatsRepositoryReadOnly creates a guild-scoped user stats repository on the read replica.
+func NewUserStatsRepositoryReadOnly(db *database.DB, guildID int64) interfaces.UserStatsRepository {
+	return NewUserStatsRepositoryScoped(db.ReadPool(), guildID)
+}
+
+// GetByDiscordID returns the user's cached stats, zeroed if they have never bet or wagered
+func (r *UserStatsRepository) GetByDiscordID(ctx context.Context, discordID int64) (*entities.CachedUserStats, error) {
+	query := `
+		SELECT total_bets, total_bet_wins, total_bet_losses, total_bet_wagered, total_bet_won,
+			total_bet_lost, biggest_bet_win, biggest_bet_loss,
+			total_wagers, total_wagers_proposed, total_wagers_accepted, total_wagers_declined,
+			total_wagers_resolved, total_wagers_won, total_wagers_lost, total_wager_amount,
+			total_wager_won_amount, biggest_wager_win, biggest_wager_loss, updated_at
+		FROM user_stats
+		WHERE discord_id = $1 AND guild_id = $2`
+
+	stats := &entities.CachedUserStats{DiscordID: discordID, GuildID: r.guildID}
+	err := r.q.QueryRow(ctx, query, discordID, r.guildID).Scan(
+		&stats.Bets.TotalBets,
+		&stats.Bets.TotalWins,
+		&stats.Bets.TotalLosses,
+		&stats.Bets.TotalWagered,
+		&stats.Bets.TotalWon,
+		&stats.Bets.TotalLost,
+		&stats.Bets.BiggestWin,
+		&stats.Bets.BiggestLoss,
+		&stats.Wagers.TotalWagers,
+		&stats.Wagers.TotalProposed,
+		&stats.Wagers.TotalAccepted,
+		&stats.Wagers.TotalDeclined,
+		&stats.Wagers.TotalResolved,
+		&stats.Wagers.TotalWon,
+		&stats.Wagers.TotalLost,
+		&stats.Wagers.TotalAmount,
+		&stats.Wagers.TotalWonAmount,
+		&stats.Wagers.BiggestWin,
+		&stats.Wagers.BiggestLoss,
+		&stats.UpdatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return stats, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// GetDrifted returns the users whose cached stats no longer match the bets and wagers tables.
+// Missing rows count as zero on both sides so users without history are never reported.
+func (r *UserStatsRepository) GetDrifted(ctx context.Context) ([]int64, error) {
+	query := `
+		WITH bet_totals AS (
+			SELECT
+				discord_id,
+				COUNT(*) AS total_bets,
+				COUNT(CASE WHEN won = true THEN 1 END) AS total_bet_wins,
+				COUNT(CASE WHEN won = false THEN 1 END) AS total_bet_losses,
+				COALESCE(SUM(amount), 0) AS total_bet_wagered,
+				COALESCE(SUM(CASE WHEN won = true THEN win_amount ELSE 0 END), 0) AS total_bet_won,
+				COALESCE(SUM(CASE WHEN won = false THEN amount ELSE 0 END), 0) AS total_bet_lost,
+				COALESCE(MAX(CASE WHEN won = true THEN win_amount ELSE 0 END), 0) AS biggest_bet_win,
+				COALESCE(MAX(CASE WHEN won = false THEN amount ELSE 0 END), 0) AS biggest_bet_loss
+			FROM bets
+			WHERE guild_id = $1
+			GROUP BY discord_id
+		),
+		wager_sides AS (
+			SELECT proposer_discord_id AS discord_id, state, amount, winner_discord_id FROM wagers WHERE guild_id = $1
+			UNION ALL
+			SELECT target_discord_id AS discord_id, state, amount, winner_discord_id FROM wagers WHERE guild_id = $1
+		),
+		wager_totals AS (
+			SELECT
+				discord_id,
+				COUNT(*) AS total_wagers,
+				COUNT(CASE WHEN state = 'proposed' THEN 1 END) AS total_wagers_proposed,
+				COUNT(CASE WHEN state = 'voting' THEN 1 END) AS total_wagers_accepted,
+				COUNT(CASE WHEN state = 'declined' THEN 1 END) AS total_wagers_declined,
+				COUNT(CASE WHEN state = 'resolved' THEN 1 END) AS total_wagers_resolved,
+				COUNT(CASE WHEN state = 'resolved' AND winner_discord_id = discord_id THEN 1 END) AS total_wagers_won,
+				COUNT(CASE WHEN state = 'resolved' AND winner_discord_id != discord_id THEN 1 END) AS total_wagers_lost,
+				COALESCE(SUM(amount), 0) AS total_wager_amount,
+				COALESCE(SUM(CASE WHEN state = 'resolved' AND winner_discord_id = discord_id THEN amount ELSE 0 END), 0) AS total_wager_won_amount,
+				COALESCE(MAX(CASE WHEN state = 'resolved' AND winner_discord_id = discord_id THEN amount ELSE 0 END), 0) AS biggest_wager_win,
+				COALESCE(MAX(CASE WHEN state = 'resolved' AND winner_discord_id != discord_id THEN amount ELSE 0 END), 0) AS biggest_wager_loss
+			FROM wager_sides
+			GROUP BY discord_id
+		),
+		raw AS (
+			SELECT
+				COALESCE(b.discord_id, w.discord_id) AS discord_id,
+				b.total_bets, b.total_bet_wins, b.total_bet_losses, b.total_bet_wagered,
+				b.total_bet_won, b.total_bet_lost, b.biggest_bet_win, b.biggest_bet_loss,
+				w.total_wagers, w.total_wagers_proposed, w.total_wagers_accepted, w.total_wagers_declined,
+				w.total_wagers_resolved, w.total_wagers_won, w.total_wagers_lost, w.total_wager_amount,
+				w.total_wager_won_amount, w.biggest_wager_win, w.biggest_wager_loss
+			FROM bet_totals b
+			FULL OUTER JOIN wager_totals w ON w.discord_id = b.discord_id
+		),
+		cached AS (
+			SELECT * FROM user_stats WHERE guild_id = $1
+		)
+		SELECT COALESCE(raw.discord_id, cached.discord_id)
+		FROM raw
+		FULL OUTER JOIN cached ON cached.discord_id = raw.discord_id
+		WHERE (
+			COALESCE(raw.total_bets, 0), COALESCE(raw.total_bet_wins, 0), COALESCE(raw.total_bet_losses, 0),
+			COALESCE(raw.total_bet_wagered, 0), COALESCE(raw.total_bet_won, 0), COALESCE(raw.total_bet_lost, 0),
+			COALESCE(raw.biggest_bet_win, 0), COALESCE(raw.biggest_bet_loss, 0),
+			COALESCE(raw.total_wagers, 0), COALESCE(raw.total_wagers_proposed, 0), COALESCE(raw.total_wagers_accepted, 0),
+			COALESCE(raw.total_wagers_declined, 0), COALESCE(raw.total_wagers_resolved, 0), COALESCE(raw.total_wagers_won, 0),
+			COALESCE(raw.total_wagers_lost, 0), COALESCE(raw.total_wager_amount, 0), COALESCE(raw.total_wager_won_amount, 0),
+			COALESCE(raw.biggest_wager_win, 0), COALESCE(raw.biggest_wager_loss, 0)
+		) IS DISTINCT FROM (
+			COALESCE(cached.total_bets, 0), COALESCE(cached.total_bet_wins, 0), COALESCE(cached.total_bet_losses, 0),
+			COALESCE(cached.total_bet_wagered, 0), COALESCE(cached.total_bet_won, 0), COALESCE(cached.total_bet_lost, 0),
+			COALESCE(cached.biggest_bet_win, 0), COALESCE(cached.biggest_bet_loss, 0),
+			COALESCE(cached.total_wagers, 0), COALESCE(cached.total_wagers_proposed, 0), COALESCE(cached.total_wagers_accepted, 0),
+			COALESCE(cached.total_wagers_declined, 0), COALESCE(cached.total_wagers_resolved, 0), COALESCE(cached.total_wagers_won, 0),
+			COALESCE(cached.total_wagers_lost, 0), COALESCE(cached.total_wager_amount, 0), COALESCE(cached.total_wager_won_amount, 0),
+			COALESCE(cached.biggest_wager_win, 0), COALESCE(cached.biggest_wager_loss, 0)
+		)
+		ORDER BY 1`
+
+	rows, err := r.q.Query(ctx, query, r.guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query drifted user stats: %w", err)
+	}
+	defer rows.Close()
+
+	var discordIDs []int64
+	for rows.Next() {
+		var discordID int64
+		if err := rows.Scan(&discordID); err != nil {
+			return nil, fmt.Errorf("failed to scan discord ID: %w", err)
+		}
+		discordIDs = append(discordIDs, discordID)
+	}
+
+	return discordIDs, rows.Err()
+}
+
+// Refresh recomputes a user's cached stats from the bets and wagers tables
+func (r *UserStatsRepository) Refresh(ctx context.Context, discordID int64) error {
+	query := `SELECT refresh_user_bet_stats($1, $2), refresh_user_wager_stats($1, $2)`
+
+	if _, err := r.q.Exec(ctx, query, discordID, r.guildID); err != nil {
+		return fmt.Errorf("failed to refresh user stats: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"gambler/discord-client/domain/entities"
+	"gambler/discord-client/repository/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserStatsRepository_TracksBetsAndReconcilesDrift(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	ctx := context.Background()
+	testGuildID := int64(1018733499869577296)
+
+	userRepo := NewUserRepository(testDB.DB)
+	user, err := userRepo.Create(ctx, 123456789, "gambler", 100000)
+	require.NoError(t, err)
+
+	betRepo := NewBetRepositoryScoped(testDB.DB.Pool, testGuildID)
+	bets := []*entities.Bet{
+		{DiscordID: user.DiscordID, GuildID: testGuildID, Amount: 1000, WinProbability: 0.5, Won: true, WinAmount: 1000},
+		{DiscordID: user.DiscordID, GuildID: testGuildID, Amount: 2500, WinProbability: 0.5, Won: false},
+		{DiscordID: user.DiscordID, GuildID: testGuildID, Amount: 500, WinProbability: 0.25, Won: true, WinAmount: 1500},
+	}
+	for _, bet := range bets {
+		require.NoError(t, betRepo.Create(ctx, bet))
+	}
+
+	statsRepo := NewUserStatsRepositoryScoped(testDB.DB.Pool, testGuildID)
+
+	// The insert trigger keeps the cached row in step with the raw aggregation
+	expected, err := betRepo.GetStats(ctx, user.DiscordID)
+	require.NoError(t, err)
+	cached, err := statsRepo.GetByDiscordID(ctx, user.DiscordID)
+	require.NoError(t, err)
+	assert.Equal(t, *expected, cached.Bets)
+
+	drifted, err := statsRepo.GetDrifted(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, drifted)
+
+	// Corrupt the cached row and make sure reconciliation finds and repairs it
+	_, err = testDB.DB.Pool.Exec(ctx, "UPDATE user_stats SET total_bets = 0, biggest_bet_win = 0 WHERE discord_id = $1 AND guild_id = $2", user.DiscordID, testGuildID)
+	require.NoError(t, err)
+
+	drifted, err = statsRepo.GetDrifted(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{user.DiscordID}, drifted)
+
+	require.NoError(t, statsRepo.Refresh(ctx, user.DiscordID))
+
+	cached, err = statsRepo.GetByDiscordID(ctx, user.DiscordID)
+	require.NoError(t, err)
+	assert.Equal(t, *expected, cached.Bets)
+
+	drifted, err = statsRepo.GetDrifted(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, drifted)
+}
+
+func TestUserStatsRepository_GetByDiscordID_NoActivity(t *testing.T) {
+	t.Parallel()
+	testDB := testutil.SetupTestDatabase(t)
+	ctx := context.Background()
+	testGuildID := int64(1018733499869577296)
+
+	statsRepo := NewUserStatsRepositoryScoped(testDB.DB.Pool, testGuildID)
+	cached, err := statsRepo.GetByDiscordID(ctx, 555)
+	require.NoError(t, err)
+	assert.Equal(t, int64(555), cached.DiscordID)
+	assert.Equal(t, entities.BetStats{}, cached.Bets)
+	assert.Equal(t, entities.WagerStats{}, cached.Wagers)
+}
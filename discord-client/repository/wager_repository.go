@@ -33,6 +33,11 @@ func NewWagerRepositoryScoped(tx Queryable, guildID int64) *WagerRepository {
 	}
 }
 
+// NewWagerRepositoryReadOnly creates a guild-scoped wager repository on the read replica for heavy read paths.
+func NewWagerRepositoryReadOnly(db *database.DB, guildID int64) *WagerRepository {
+	return NewWagerRepositoryScoped(db.ReadPool(), guildID)
+}
+
 // Create creates a new wager
 func (r *WagerRepository) Create(ctx context.Context, wager *entities.Wager) error {
 	query := `
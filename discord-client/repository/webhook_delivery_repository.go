@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gambler/discord-client/domain/entities"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const webhookDeliveryColumns = `id, webhook_id, guild_id, event_type, payload, status, attempts,
+		       response_status, last_error, next_attempt_at, created_at, delivered_at`
+
+// WebhookDeliveryRepository implements webhook delivery data access
+type WebhookDeliveryRepository struct {
+	q       Queryable
+	guildID int64
+}
+
+// NewWebhookDeliveryRepositoryScoped creates a new webhook delivery repository with guild scope
+func NewWebhookDeliveryRepositoryScoped(tx Queryable, guildID int64) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{
+		q:       tx,
+		guildID: guildID,
+	}
+}
+
+// Create queues a delivery in the current guild
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	if delivery.GuildID != r.guildID {
+		return fmt.Errorf("guild ID mismatch: delivery has %d, repository scoped to %d", delivery.GuildID, r.guildID)
+	}
+
+	query := `
+		INSERT INTO webhook_deliveries (webhook_id, guild_id, event_type, payload, status, next_attempt_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+
+	err := r.q.QueryRow(ctx, query,
+		delivery.WebhookID,
+		delivery.GuildID,
+		delivery.EventType,
+		delivery.Payload,
+		delivery.Status,
+		delivery.NextAttemptAt,
+	).Scan(&delivery.ID, &delivery.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// GetByIDForUpdate retrieves a delivery in the current guild with row lock for update, returning nil if it does not exist
+func (r *WebhookDeliveryRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entities.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE id = $1 AND guild_id = $2
+		FOR UPDATE
+	`
+
+	delivery, err := scanWebhookDelivery(r.q.QueryRow(ctx, query, id, r.guildID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook delivery for update by ID %d: %w", id, err)
+	}
+
+	return delivery, nil
+}
+
+// GetRecentByWebhook returns a webhook's most recent deliveries, newest first
+func (r *WebhookDeliveryRepository) GetRecentByWebhook(ctx context.Context, webhookID int64, limit int) ([]*entities.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE webhook_id = $1 AND guild_id = $2
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3
+	`
+
+	rows, err := r.q.Query(ctx, query, webhookID, r.guildID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deliveries for webhook %d: %w", webhookID, err)
+	}
+	defer rows.Close()
+
+	return collectWebhookDeliveries(rows)
+}
+
+// Update updates the status, attempts and outcome of a delivery
+func (r *WebhookDeliveryRepository) Update(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $3,
+		    attempts = $4,
+		    response_status = $5,
+		    last_error = $6,
+		    next_attempt_at = $7,
+		    delivered_at = $8
+		WHERE id = $1 AND guild_id = $2
+	`
+
+	result, err := r.q.Exec(ctx, query,
+		delivery.ID,
+		r.guildID,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.ResponseStatus,
+		delivery.LastError,
+		delivery.NextAttemptAt,
+		delivery.DeliveredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery %d: %w", delivery.ID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("webhook delivery %d not found", delivery.ID)
+	}
+
+	return nil
+}
+
+// GetDueDeliveries returns pending deliveries across all guilds due at or before the given time, oldest first
+func (r *WebhookDeliveryRepository) GetDueDeliveries(ctx context.Context, asOf time.Time, limit int) ([]*entities.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE status = 'pending' AND next_attempt_at <= $1
+		ORDER BY next_attempt_at ASC, id ASC
+		LIMIT $2
+	`
+
+	rows, err := r.q.Query(ctx, query, asOf, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	return collectWebhookDeliveries(rows)
+}
+
+// scanWebhookDelivery scans a single webhook delivery row
+func scanWebhookDelivery(row pgx.Row) (*entities.WebhookDelivery, error) {
+	var delivery entities.WebhookDelivery
+	err := row.Scan(
+		&delivery.ID,
+		&delivery.WebhookID,
+		&delivery.GuildID,
+		&delivery.EventType,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.ResponseStatus,
+		&delivery.LastError,
+		&delivery.NextAttemptAt,
+		&delivery.CreatedAt,
+		&delivery.DeliveredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// collectWebhookDeliveries scans every webhook delivery row
+func collectWebhookDeliveries(rows pgx.Rows) ([]*entities.WebhookDelivery, error) {
+	var deliveries []*entities.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating webhook delivery rows: %w", err)
+	}
+
+	return deliveries, nil
+}
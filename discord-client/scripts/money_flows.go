@@ -0,0 +1,57 @@
+//go:build moneyflows
+// +build moneyflows
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"gambler/discord-client/domain/entities"
+)
+
+func main() {
+	output := flag.String("output", "", "File to write the markdown to (default: stdout)")
+	flag.Parse()
+
+	doc := renderMoneyFlows(entities.RegisteredTransactionTypes())
+
+	if *output == "" {
+		fmt.Print(doc)
+		return
+	}
+	if err := os.WriteFile(*output, []byte(doc), 0644); err != nil {
+		log.Fatalf("Failed to write %s: %v", *output, err)
+	}
+}
+
+// renderMoneyFlows renders the transaction type registry as a markdown table grouped by category
+func renderMoneyFlows(types []entities.TransactionTypeInfo) string {
+	var b strings.Builder
+
+	b.WriteString("# Money Flows\n\n")
+	b.WriteString("<!-- Code generated by scripts/money_flows.go; DO NOT EDIT. Run `make docs-money-flows` to regenerate. -->\n\n")
+	b.WriteString("Every balance change is recorded in `balance_history` with one of the transaction types below. ")
+	b.WriteString("`BalanceHistoryRepository.Record` rejects unregistered types and changes that move bits against the expected direction.\n")
+
+	var category entities.TransactionCategory
+	for _, info := range types {
+		if info.Category != category {
+			category = info.Category
+			fmt.Fprintf(&b, "\n## %s\n\n", strings.ToUpper(string(category[:1]))+string(category[1:]))
+			b.WriteString("| Type | Name | Direction | Related entity | Flow |\n")
+			b.WriteString("|------|------|-----------|----------------|------|\n")
+		}
+
+		related := "-"
+		if info.RelatedType != "" {
+			related = "`" + string(info.RelatedType) + "`"
+		}
+		fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s |\n", info.Type, info.DisplayName, info.Sign, related, info.Flow)
+	}
+
+	return b.String()
+}
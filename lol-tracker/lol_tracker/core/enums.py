@@ -81,6 +81,8 @@ class QueueType(Enum):
     TFT_NORMAL_HYPER_ROLL = ("TFT_NORMAL_HYPER_ROLL", 1120)  # Teamfight Tactics (Normal Hyper Roll)
     TFT_NORMAL_DOUBLE_UP = ("TFT_NORMAL_DOUBLE_UP", 1140)    # Teamfight Tactics (Normal Double Up)
     TFT_RANKED_DOUBLE_UP = ("TFT_RANKED_DOUBLE_UP", 1160)  # Teamfight Tactics (Ranked Double Up)
+    TFT_CHONCCS_TREASURE = ("TFT_CHONCCS_TREASURE", 1210)  # Teamfight Tactics (Choncc's Treasure event)
+    TFT_SET_REVIVAL = ("TFT_SET_REVIVAL", 6000)    # Teamfight Tactics (Set Revival event)
     
     def __init__(self, value: str, queue_id: int):
         """Initialize queue type with metadata."""